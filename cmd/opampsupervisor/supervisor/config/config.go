@@ -19,6 +19,7 @@ type Supervisor struct {
 	Agent        *Agent
 	Capabilities *Capabilities `mapstructure:"capabilities"`
 	Storage      Storage       `mapstructure:"storage"`
+	Packages     Packages      `mapstructure:"packages"`
 }
 
 type Storage struct {
@@ -58,6 +59,25 @@ type Capabilities struct {
 	ReportsOwnMetrics              *bool `mapstructure:"reports_own_metrics"`
 	ReportsHealth                  *bool `mapstructure:"reports_health"`
 	ReportsRemoteConfig            *bool `mapstructure:"reports_remote_config"`
+	AcceptsPackages                *bool `mapstructure:"accepts_packages"`
+}
+
+// Packages is the config of the upgrades of the agent executable offered by the OpAMP server.
+type Packages struct {
+	// SignaturePublicKeyFile is the PEM file of the public key verifying the signatures of
+	// the downloaded executables. The signatures aren't verified if empty.
+	SignaturePublicKeyFile string `mapstructure:"signature_public_key_file"`
+
+	// HealthyTimeout is the duration an upgraded agent has to become healthy before it is
+	// rolled back to the previous executable.
+	HealthyTimeout time.Duration `mapstructure:"healthy_timeout"`
+
+	// MaxRestarts is the number of unexpected exits of an upgraded agent, before it becomes
+	// healthy, after which it is rolled back to the previous executable.
+	MaxRestarts int `mapstructure:"max_restarts"`
+
+	// DownloadTimeout is the maximum duration of the download of a package file.
+	DownloadTimeout time.Duration `mapstructure:"download_timeout"`
 }
 
 type OpAMPServer struct {
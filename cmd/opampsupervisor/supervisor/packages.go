@@ -0,0 +1,512 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisor
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	packagesDir                     = "packages"
+	lastReportedPackageStatusesFile = "last_reported_package_statuses.dat"
+
+	// maxPackageSize bounds the size of the package files whose size isn't advertised by the
+	// server with the Content-Length header.
+	maxPackageSize = 1 << 30
+)
+
+var (
+	// errPackageSyncNotSupported is returned by the PackagesStateProvider methods only used by the
+	// package syncer of the OpAMP client: the Supervisor processes the offered packages itself to
+	// verify their signature and roll back failed upgrades.
+	errPackageSyncNotSupported = errors.New("packages are synced by the supervisor")
+	errInvalidSignature        = errors.New("invalid signature")
+)
+
+// agentPackage is the agent package installed from the OpAMP server.
+type agentPackage struct {
+	Name       string `yaml:"name"`
+	Version    string `yaml:"version"`
+	Hash       []byte `yaml:"hash"`
+	Executable string `yaml:"executable"`
+}
+
+// stagedPackage is a downloaded and verified agent package waiting to be installed.
+type stagedPackage struct {
+	agentPackage
+	allPackagesHash []byte
+}
+
+// packageManager downloads and verifies the packages offered by the OpAMP server and keeps
+// track of their statuses. Only the top-level package, the agent executable, is supported.
+type packageManager struct {
+	logger         *zap.Logger
+	dir            string
+	statusesFile   string
+	executableName string
+	publicKey      crypto.PublicKey
+	client         *http.Client
+	maxSize        int64
+
+	// syncMu serializes the processing of the offered packages.
+	syncMu sync.Mutex
+
+	// mu guards the persistent state and the statuses.
+	mu       sync.Mutex
+	state    *persistentState
+	statuses *protobufs.PackageStatuses
+}
+
+var _ types.PackagesStateProvider = (*packageManager)(nil)
+
+func newPackageManager(logger *zap.Logger, storageDir string, state *persistentState, executable string, publicKeyFile string, downloadTimeout time.Duration) (*packageManager, error) {
+	m := &packageManager{
+		logger:         logger,
+		dir:            filepath.Join(storageDir, packagesDir),
+		statusesFile:   filepath.Join(storageDir, lastReportedPackageStatusesFile),
+		executableName: filepath.Base(executable),
+		client:         &http.Client{Timeout: downloadTimeout},
+		maxSize:        maxPackageSize,
+		state:          state,
+	}
+
+	if publicKeyFile != "" {
+		var err error
+		if m.publicKey, err = loadPublicKey(publicKeyFile); err != nil {
+			return nil, fmt.Errorf("cannot load the package signature public key: %w", err)
+		}
+	}
+
+	statuses, err := m.LastReportedStatuses()
+	if err != nil {
+		logger.Error("Cannot read the last reported package statuses", zap.Error(err))
+	}
+	m.statuses = statuses
+
+	return m, nil
+}
+
+func loadPublicKey(file string) (crypto.PublicKey, error) {
+	by, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(by)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found in %s", file)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// installedExecutable returns the executable of the installed agent package, or an empty
+// string if no package was installed or its executable is missing.
+func (m *packageManager) installedExecutable() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.state.AgentPackage == nil {
+		return ""
+	}
+	if _, err := os.Stat(m.state.AgentPackage.Executable); err != nil {
+		m.logger.Error("Cannot find the executable of the installed agent package", zap.Error(err))
+		return ""
+	}
+	return m.state.AgentPackage.Executable
+}
+
+// stage downloads and verifies the top-level package offered by the OpAMP server if it differs
+// from the installed one. It returns the staged package, if any, and the statuses to report,
+// nil if the offer didn't change since it was last processed.
+func (m *packageManager) stage(ctx context.Context, available *protobufs.PackagesAvailable) (*stagedPackage, *protobufs.PackageStatuses) {
+	m.syncMu.Lock()
+	defer m.syncMu.Unlock()
+
+	m.mu.Lock()
+	installed := m.state.AgentPackage
+	upToDate := available.AllPackagesHash != nil && bytes.Equal(m.state.AllPackagesHash, available.AllPackagesHash)
+	m.mu.Unlock()
+
+	if upToDate {
+		m.logger.Debug("All packages are already up to date")
+		return nil, nil
+	}
+
+	statuses := &protobufs.PackageStatuses{
+		ServerProvidedAllPackagesHash: available.AllPackagesHash,
+		Packages:                      map[string]*protobufs.PackageStatus{},
+	}
+
+	var staged *stagedPackage
+	failed := false
+	for name, pkg := range available.Packages {
+		status := &protobufs.PackageStatus{
+			Name:                 name,
+			ServerOfferedVersion: pkg.Version,
+			ServerOfferedHash:    pkg.Hash,
+		}
+		statuses.Packages[name] = status
+
+		switch {
+		case pkg.Type != protobufs.PackageType_PackageType_TopLevel:
+			status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_InstallFailed
+			status.ErrorMessage = "only the top-level package of the agent executable is supported"
+			failed = true
+		case staged != nil:
+			status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_InstallFailed
+			status.ErrorMessage = "only one top-level package is supported"
+			failed = true
+		case installed != nil && installed.Name == name && bytes.Equal(installed.Hash, pkg.Hash):
+			status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_Installed
+			status.AgentHasVersion = installed.Version
+			status.AgentHasHash = installed.Hash
+		default:
+			executable, err := m.download(ctx, pkg.File)
+			if err != nil {
+				m.logger.Error("Cannot download the agent package", zap.String("package", name), zap.Error(err))
+				status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_InstallFailed
+				status.ErrorMessage = err.Error()
+				failed = true
+				continue
+			}
+			status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_Installing
+			staged = &stagedPackage{
+				agentPackage: agentPackage{
+					Name:       name,
+					Version:    pkg.Version,
+					Hash:       pkg.Hash,
+					Executable: executable,
+				},
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if staged != nil && !failed {
+		// The hash of all the packages is only remembered once the staged package is installed.
+		staged.allPackagesHash = available.AllPackagesHash
+	} else if !failed {
+		m.state.AllPackagesHash = available.AllPackagesHash
+		if err := m.state.writeState(); err != nil {
+			m.logger.Error("Cannot save the hash of all the packages", zap.Error(err))
+		}
+	}
+
+	return staged, m.setStatuses(statuses)
+}
+
+// installed records the staged package as the installed agent package and removes the
+// executable of the package it replaced.
+func (m *packageManager) installed(pkg *stagedPackage) (*protobufs.PackageStatuses, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous := m.state.AgentPackage
+	installed := pkg.agentPackage
+	if err := m.state.SetAgentPackage(&installed, pkg.allPackagesHash); err != nil {
+		return nil, fmt.Errorf("cannot save the installed agent package: %w", err)
+	}
+	if previous != nil && previous.Executable != pkg.Executable {
+		m.removeExecutable(previous.Executable)
+	}
+
+	return m.updateStatus(pkg, func(status *protobufs.PackageStatus) {
+		status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_Installed
+		status.AgentHasVersion = pkg.Version
+		status.AgentHasHash = pkg.Hash
+		status.ErrorMessage = ""
+	}), nil
+}
+
+// failed records that the staged package failed to be installed and removes its executable.
+func (m *packageManager) failed(pkg *stagedPackage, reason error) *protobufs.PackageStatuses {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.removeExecutable(pkg.Executable)
+
+	return m.updateStatus(pkg, func(status *protobufs.PackageStatus) {
+		status.Status = protobufs.PackageStatusEnum_PackageStatusEnum_InstallFailed
+		status.ErrorMessage = reason.Error()
+	})
+}
+
+// discard removes the executable of a staged package superseded by another one.
+func (m *packageManager) discard(pkg *stagedPackage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.removeExecutable(pkg.Executable)
+}
+
+// updateStatus updates the status of the package if it is still the offered one, and returns
+// the statuses to report.
+func (m *packageManager) updateStatus(pkg *stagedPackage, update func(status *protobufs.PackageStatus)) *protobufs.PackageStatuses {
+	if m.statuses == nil {
+		return nil
+	}
+	status, ok := m.statuses.Packages[pkg.Name]
+	if !ok || !bytes.Equal(status.ServerOfferedHash, pkg.Hash) {
+		return nil
+	}
+	update(status)
+	return m.setStatuses(m.statuses)
+}
+
+// setStatuses saves the statuses and returns a copy of them to report.
+func (m *packageManager) setStatuses(statuses *protobufs.PackageStatuses) *protobufs.PackageStatuses {
+	m.statuses = statuses
+	if err := m.saveStatuses(statuses); err != nil {
+		m.logger.Error("Cannot save the package statuses", zap.Error(err))
+	}
+	return proto.Clone(statuses).(*protobufs.PackageStatuses)
+}
+
+func (m *packageManager) removeExecutable(executable string) {
+	// Only the executables downloaded in their own directory of the packages directory are removed.
+	dir := filepath.Dir(executable)
+	if filepath.Dir(dir) != m.dir {
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		m.logger.Error("Cannot remove the agent package executable", zap.String("executable", executable), zap.Error(err))
+	}
+}
+
+// download downloads the file of a package into the packages directory after verifying its
+// content hash and signature, and returns the path of the executable.
+func (m *packageManager) download(ctx context.Context, file *protobufs.DownloadableFile) (string, error) {
+	if file == nil || file.DownloadUrl == "" {
+		return "", errors.New("the package has no file to download")
+	}
+	if len(file.ContentHash) == 0 {
+		return "", errors.New("the package file has no content hash")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, file.DownloadUrl, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot download the package file from %s: %w", file.DownloadUrl, err)
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("cannot download the package file from %s: %w", file.DownloadUrl, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot download the package file from %s: unexpected status %q", file.DownloadUrl, resp.Status)
+	}
+	size := m.maxSize
+	if resp.ContentLength > m.maxSize {
+		return "", fmt.Errorf("cannot download the package file from %s: its size %d exceeds %d bytes", file.DownloadUrl, resp.ContentLength, m.maxSize)
+	} else if resp.ContentLength >= 0 {
+		size = resp.ContentLength
+	}
+
+	if err = os.MkdirAll(m.dir, 0700); err != nil {
+		return "", err
+	}
+	tmp, err := os.CreateTemp(m.dir, "download-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	h := sha256.New()
+	// One more byte than the size is read to find out whether the file is larger.
+	n, err := io.Copy(io.MultiWriter(tmp, h), io.LimitReader(resp.Body, size+1))
+	if err == nil && n > size {
+		err = fmt.Errorf("the file exceeds %d bytes", size)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot download the package file from %s: %w", file.DownloadUrl, err)
+	}
+
+	digest := h.Sum(nil)
+	if !bytes.Equal(digest, file.ContentHash) {
+		return "", fmt.Errorf("the SHA-256 hash of the package file %x doesn't match its content hash %x", digest, file.ContentHash)
+	}
+	if err = m.verifySignature(digest, file.Signature); err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(m.dir, hex.EncodeToString(digest))
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	executable := filepath.Join(dir, m.executableName)
+	if err = os.Chmod(tmp.Name(), 0700); err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmp.Name(), executable); err != nil {
+		return "", err
+	}
+	return executable, nil
+}
+
+// verifySignature verifies the signature of the SHA-256 digest of a package file with the
+// configured public key. Unsigned files are accepted if there is no public key, but signed
+// files are rejected as their signature can't be verified.
+func (m *packageManager) verifySignature(digest, signature []byte) error {
+	if m.publicKey == nil {
+		if len(signature) != 0 {
+			return errors.New("the package file is signed but no signature public key is configured")
+		}
+		return nil
+	}
+	if len(signature) == 0 {
+		return errors.New("the package file isn't signed")
+	}
+
+	valid := false
+	switch key := m.publicKey.(type) {
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, signature) == nil
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest, signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, digest, signature)
+	}
+	if !valid {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func (m *packageManager) saveStatuses(statuses *protobufs.PackageStatuses) error {
+	by, err := proto.Marshal(statuses)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(m.statusesFile, by, 0600)
+}
+
+// AllPackagesHash implements types.PackagesStateProvider.
+func (m *packageManager) AllPackagesHash() ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.state.AllPackagesHash, nil
+}
+
+// SetAllPackagesHash implements types.PackagesStateProvider.
+func (m *packageManager) SetAllPackagesHash(hash []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.state.AllPackagesHash = hash
+	return m.state.writeState()
+}
+
+// Packages implements types.PackagesStateProvider.
+func (m *packageManager) Packages() ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.state.AgentPackage == nil {
+		return nil, nil
+	}
+	return []string{m.state.AgentPackage.Name}, nil
+}
+
+// PackageState implements types.PackagesStateProvider.
+func (m *packageManager) PackageState(packageName string) (types.PackageState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pkg := m.state.AgentPackage
+	if pkg == nil || pkg.Name != packageName {
+		return types.PackageState{}, nil
+	}
+	return types.PackageState{
+		Exists:  true,
+		Type:    protobufs.PackageType_PackageType_TopLevel,
+		Hash:    pkg.Hash,
+		Version: pkg.Version,
+	}, nil
+}
+
+// SetPackageState implements types.PackagesStateProvider.
+func (m *packageManager) SetPackageState(string, types.PackageState) error {
+	return errPackageSyncNotSupported
+}
+
+// CreatePackage implements types.PackagesStateProvider.
+func (m *packageManager) CreatePackage(string, protobufs.PackageType) error {
+	return errPackageSyncNotSupported
+}
+
+// FileContentHash implements types.PackagesStateProvider.
+func (m *packageManager) FileContentHash(string) ([]byte, error) {
+	return nil, errPackageSyncNotSupported
+}
+
+// UpdateContent implements types.PackagesStateProvider.
+func (m *packageManager) UpdateContent(context.Context, string, io.Reader, []byte) error {
+	return errPackageSyncNotSupported
+}
+
+// DeletePackage implements types.PackagesStateProvider.
+func (m *packageManager) DeletePackage(string) error {
+	return errPackageSyncNotSupported
+}
+
+// LastReportedStatuses implements types.PackagesStateProvider.
+func (m *packageManager) LastReportedStatuses() (*protobufs.PackageStatuses, error) {
+	by, err := os.ReadFile(m.statusesFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	statuses := &protobufs.PackageStatuses{}
+	if err := proto.Unmarshal(by, statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// SetLastReportedStatuses implements types.PackagesStateProvider.
+func (m *packageManager) SetLastReportedStatuses(statuses *protobufs.PackageStatuses) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.statuses = statuses
+	return m.saveStatuses(statuses)
+}
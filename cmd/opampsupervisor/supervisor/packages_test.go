@@ -0,0 +1,270 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package supervisor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var agentBinary = []byte("#!/bin/sh\necho otelcol\n")
+
+func newTestPackageManager(t *testing.T, publicKeyFile string) *packageManager {
+	dir := t.TempDir()
+	state, err := createNewPersistentState(filepath.Join(dir, persistentStateFile))
+	require.NoError(t, err)
+	m, err := newPackageManager(zap.NewNop(), dir, state, "/usr/bin/otelcol-contrib", publicKeyFile, time.Minute)
+	require.NoError(t, err)
+	return m
+}
+
+func newAgentPackagesServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/otelcol-contrib" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(agentBinary)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func agentPackagesAvailable(server *httptest.Server, signature []byte) *protobufs.PackagesAvailable {
+	digest := sha256.Sum256(agentBinary)
+	return &protobufs.PackagesAvailable{
+		AllPackagesHash: []byte("all-v2"),
+		Packages: map[string]*protobufs.PackageAvailable{
+			"otelcol-contrib": {
+				Type:    protobufs.PackageType_PackageType_TopLevel,
+				Version: "v2",
+				Hash:    []byte("v2"),
+				File: &protobufs.DownloadableFile{
+					DownloadUrl: server.URL + "/otelcol-contrib",
+					ContentHash: digest[:],
+					Signature:   signature,
+				},
+			},
+		},
+	}
+}
+
+func TestPackageManagerStageAndInstall(t *testing.T) {
+	server := newAgentPackagesServer(t)
+	m := newTestPackageManager(t, "")
+
+	pkg, statuses := m.stage(context.Background(), agentPackagesAvailable(server, nil))
+	require.NotNil(t, pkg)
+	require.NotNil(t, statuses)
+	assert.Equal(t, protobufs.PackageStatusEnum_PackageStatusEnum_Installing, statuses.Packages["otelcol-contrib"].Status)
+	assert.Equal(t, "otelcol-contrib", filepath.Base(pkg.Executable))
+	content, err := os.ReadFile(pkg.Executable)
+	require.NoError(t, err)
+	assert.Equal(t, agentBinary, content)
+
+	statuses, err = m.installed(pkg)
+	require.NoError(t, err)
+	status := statuses.Packages["otelcol-contrib"]
+	assert.Equal(t, protobufs.PackageStatusEnum_PackageStatusEnum_Installed, status.Status)
+	assert.Equal(t, "v2", status.AgentHasVersion)
+	assert.Equal(t, pkg.Executable, m.installedExecutable())
+
+	// The installed package is persisted with the statuses.
+	state, err := loadPersistentState(m.state.configPath)
+	require.NoError(t, err)
+	require.NotNil(t, state.AgentPackage)
+	assert.Equal(t, "v2", state.AgentPackage.Version)
+	assert.Equal(t, []byte("all-v2"), state.AllPackagesHash)
+	lastReported, err := m.LastReportedStatuses()
+	require.NoError(t, err)
+	assert.Equal(t, protobufs.PackageStatusEnum_PackageStatusEnum_Installed, lastReported.Packages["otelcol-contrib"].Status)
+
+	// The same offer isn't processed again.
+	pkg, statuses = m.stage(context.Background(), agentPackagesAvailable(server, nil))
+	assert.Nil(t, pkg)
+	assert.Nil(t, statuses)
+}
+
+func TestPackageManagerStageFailures(t *testing.T) {
+	server := newAgentPackagesServer(t)
+
+	tests := []struct {
+		name          string
+		modify        func(available *protobufs.PackagesAvailable)
+		expectedError string
+	}{
+		{
+			name: "content hash mismatch",
+			modify: func(available *protobufs.PackagesAvailable) {
+				available.Packages["otelcol-contrib"].File.ContentHash = []byte("invalid")
+			},
+			expectedError: "doesn't match its content hash",
+		},
+		{
+			name: "missing content hash",
+			modify: func(available *protobufs.PackagesAvailable) {
+				available.Packages["otelcol-contrib"].File.ContentHash = nil
+			},
+			expectedError: "the package file has no content hash",
+		},
+		{
+			name: "download failure",
+			modify: func(available *protobufs.PackagesAvailable) {
+				available.Packages["otelcol-contrib"].File.DownloadUrl = server.URL + "/missing"
+			},
+			expectedError: "unexpected status",
+		},
+		{
+			name: "addon package",
+			modify: func(available *protobufs.PackagesAvailable) {
+				available.Packages["otelcol-contrib"].Type = protobufs.PackageType_PackageType_Addon
+			},
+			expectedError: "only the top-level package of the agent executable is supported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestPackageManager(t, "")
+			available := agentPackagesAvailable(server, nil)
+			tt.modify(available)
+
+			pkg, statuses := m.stage(context.Background(), available)
+			assert.Nil(t, pkg)
+			require.NotNil(t, statuses)
+			status := statuses.Packages["otelcol-contrib"]
+			assert.Equal(t, protobufs.PackageStatusEnum_PackageStatusEnum_InstallFailed, status.Status)
+			assert.Contains(t, status.ErrorMessage, tt.expectedError)
+			assert.Nil(t, m.state.AllPackagesHash)
+		})
+	}
+}
+
+func TestPackageManagerSignature(t *testing.T) {
+	server := newAgentPackagesServer(t)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	publicKeyFile := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(publicKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	digest := sha256.Sum256(agentBinary)
+	signature := ed25519.Sign(privateKey, digest[:])
+
+	t.Run("valid signature", func(t *testing.T) {
+		m := newTestPackageManager(t, publicKeyFile)
+		pkg, _ := m.stage(context.Background(), agentPackagesAvailable(server, signature))
+		assert.NotNil(t, pkg)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		m := newTestPackageManager(t, publicKeyFile)
+		pkg, statuses := m.stage(context.Background(), agentPackagesAvailable(server, []byte("invalid")))
+		assert.Nil(t, pkg)
+		assert.Equal(t, errInvalidSignature.Error(), statuses.Packages["otelcol-contrib"].ErrorMessage)
+		entries, err := os.ReadDir(m.dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		m := newTestPackageManager(t, publicKeyFile)
+		pkg, statuses := m.stage(context.Background(), agentPackagesAvailable(server, nil))
+		assert.Nil(t, pkg)
+		assert.Equal(t, "the package file isn't signed", statuses.Packages["otelcol-contrib"].ErrorMessage)
+	})
+
+	t.Run("signature without public key", func(t *testing.T) {
+		m := newTestPackageManager(t, "")
+		pkg, statuses := m.stage(context.Background(), agentPackagesAvailable(server, signature))
+		assert.Nil(t, pkg)
+		assert.Equal(t, "the package file is signed but no signature public key is configured", statuses.Packages["otelcol-contrib"].ErrorMessage)
+	})
+}
+
+func TestPackageManagerDownloadLimits(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/chunked":
+			// Flushing before writing the whole file leaves its size unknown.
+			_, _ = w.Write(agentBinary[:1])
+			w.(http.Flusher).Flush()
+			_, _ = w.Write(agentBinary[1:])
+		case "/slow":
+			<-release
+		default:
+			_, _ = w.Write(agentBinary)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedError string
+	}{
+		{
+			name:          "advertised size too large",
+			path:          "/otelcol-contrib",
+			expectedError: "exceeds 8 bytes",
+		},
+		{
+			name:          "unknown size too large",
+			path:          "/chunked",
+			expectedError: "the file exceeds 8 bytes",
+		},
+		{
+			name:          "timeout",
+			path:          "/slow",
+			expectedError: "Client.Timeout exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestPackageManager(t, "")
+			m.maxSize = 8
+			m.client.Timeout = 50 * time.Millisecond
+			available := agentPackagesAvailable(server, nil)
+			available.Packages["otelcol-contrib"].File.DownloadUrl = server.URL + tt.path
+
+			pkg, statuses := m.stage(context.Background(), available)
+			assert.Nil(t, pkg)
+			assert.Contains(t, statuses.Packages["otelcol-contrib"].ErrorMessage, tt.expectedError)
+		})
+	}
+}
+
+func TestPackageManagerFailed(t *testing.T) {
+	server := newAgentPackagesServer(t)
+	m := newTestPackageManager(t, "")
+
+	pkg, _ := m.stage(context.Background(), agentPackagesAvailable(server, nil))
+	require.NotNil(t, pkg)
+
+	statuses := m.failed(pkg, errors.New("the upgraded agent didn't become healthy within 1m0s"))
+	status := statuses.Packages["otelcol-contrib"]
+	assert.Equal(t, protobufs.PackageStatusEnum_PackageStatusEnum_InstallFailed, status.Status)
+	assert.Equal(t, "the upgraded agent didn't become healthy within 1m0s", status.ErrorMessage)
+	assert.NoFileExists(t, pkg.Executable)
+	assert.Empty(t, m.installedExecutable())
+}
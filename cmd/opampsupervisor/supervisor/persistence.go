@@ -17,6 +17,13 @@ import (
 type persistentState struct {
 	InstanceID ulid.ULID `yaml:"instance_id"`
 
+	// AgentPackage is the agent package installed from the OpAMP server, if any.
+	AgentPackage *agentPackage `yaml:"agent_package,omitempty"`
+
+	// AllPackagesHash is the hash of all the packages offered by the OpAMP server which
+	// were last successfully installed.
+	AllPackagesHash []byte `yaml:"all_packages_hash,omitempty"`
+
 	// Path to the config file that the state should be saved to.
 	// This is not marshaled.
 	configPath string `yaml:"-"`
@@ -27,6 +34,12 @@ func (p *persistentState) SetInstanceID(id ulid.ULID) error {
 	return p.writeState()
 }
 
+func (p *persistentState) SetAgentPackage(pkg *agentPackage, allPackagesHash []byte) error {
+	p.AgentPackage = pkg
+	p.AllPackagesHash = allPackagesHash
+	return p.writeState()
+}
+
 func (p *persistentState) writeState() error {
 	by, err := yaml.Marshal(p)
 	if err != nil {
@@ -116,6 +116,24 @@ type Supervisor struct {
 	agentRestarting               atomic.Bool
 
 	connectedToOpAMPServer chan struct{}
+
+	// Downloads and keeps track of the agent packages offered by the OpAMP Server.
+	packages *packageManager
+
+	// A channel of the downloaded agent packages to install.
+	agentUpgrades chan *stagedPackage
+
+	// The agent upgrade waiting for the upgraded agent to become healthy.
+	pendingUpgrade *agentUpgrade
+}
+
+// agentUpgrade is an upgrade of the agent executable which is rolled back if the upgraded
+// agent doesn't become healthy in time or keeps exiting.
+type agentUpgrade struct {
+	pkg                *stagedPackage
+	previousExecutable string
+	restarts           int
+	timer              *time.Timer
 }
 
 func NewSupervisor(logger *zap.Logger, configFile string) (*Supervisor, error) {
@@ -127,6 +145,7 @@ func NewSupervisor(logger *zap.Logger, configFile string) (*Supervisor, error) {
 		effectiveConfig:              &atomic.Value{},
 		connectedToOpAMPServer:       make(chan struct{}),
 		doneChan:                     make(chan struct{}),
+		agentUpgrades:                make(chan *stagedPackage),
 	}
 
 	if err := s.createTemplates(); err != nil {
@@ -148,6 +167,12 @@ func NewSupervisor(logger *zap.Logger, configFile string) (*Supervisor, error) {
 		return nil, err
 	}
 
+	if s.acceptsPackages() {
+		if err = s.setupPackages(storageDir); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = s.getBootstrapInfo(); err != nil {
 		return nil, fmt.Errorf("could not get bootstrap info from the Collector: %w", err)
 	}
@@ -193,6 +218,30 @@ func NewSupervisor(logger *zap.Logger, configFile string) (*Supervisor, error) {
 	return s, nil
 }
 
+func (s *Supervisor) acceptsPackages() bool {
+	return s.config.Capabilities != nil && s.config.Capabilities.AcceptsPackages != nil && *s.config.Capabilities.AcceptsPackages
+}
+
+// setupPackages creates the package manager and starts the executable of the agent package
+// installed from the OpAMP Server, if any, instead of the configured one.
+func (s *Supervisor) setupPackages(storageDir string) error {
+	if s.config.Agent == nil {
+		return errors.New("agent config must be specified to accept packages")
+	}
+
+	var err error
+	s.packages, err = newPackageManager(s.logger, storageDir, s.persistentState, s.config.Agent.Executable, s.config.Packages.SignaturePublicKeyFile, s.packageDownloadTimeout())
+	if err != nil {
+		return err
+	}
+
+	if executable := s.packages.installedExecutable(); executable != "" {
+		s.logger.Debug("Using the executable of the installed agent package", zap.String("executable", executable))
+		s.config.Agent.Executable = executable
+	}
+	return nil
+}
+
 func (s *Supervisor) createTemplates() error {
 	var err error
 
@@ -371,6 +420,11 @@ func (s *Supervisor) Capabilities() protobufs.AgentCapabilities {
 		if c.AcceptsOpAMPConnectionSettings != nil && *c.AcceptsOpAMPConnectionSettings {
 			supportedCapabilities |= protobufs.AgentCapabilities_AgentCapabilities_AcceptsOpAMPConnectionSettings
 		}
+
+		if c.AcceptsPackages != nil && *c.AcceptsPackages {
+			supportedCapabilities |= protobufs.AgentCapabilities_AgentCapabilities_AcceptsPackages |
+				protobufs.AgentCapabilities_AgentCapabilities_ReportsPackageStatuses
+		}
 	}
 	return supportedCapabilities
 }
@@ -422,6 +476,9 @@ func (s *Supervisor) startOpAMP() error {
 		},
 		Capabilities: s.Capabilities(),
 	}
+	if s.packages != nil {
+		settings.PackagesStateProvider = s.packages
+	}
 	err = s.opampClient.SetAgentDescription(s.agentDescription)
 	if err != nil {
 		return err
@@ -855,6 +912,10 @@ func (s *Supervisor) healthCheck() {
 	err := s.healthChecker.Check(ctx)
 	cancel()
 
+	if err == nil && s.pendingUpgrade != nil {
+		s.completeAgentUpgrade()
+	}
+
 	if errors.Is(err, s.lastHealthCheckErr) {
 		// No difference from last check. Nothing new to report.
 		return
@@ -913,6 +974,15 @@ func (s *Supervisor) runAgentProcess() {
 				continue
 			}
 
+			if s.pendingUpgrade != nil {
+				s.pendingUpgrade.restarts++
+				if s.pendingUpgrade.restarts >= s.maxUpgradeRestarts() {
+					restartTimer.Stop()
+					s.rollbackAgentUpgrade(fmt.Errorf("the upgraded agent exited unexpectedly %d times, exit code=%d", s.pendingUpgrade.restarts, s.commander.ExitCode()))
+					continue
+				}
+			}
+
 			s.logger.Debug("Agent process exited unexpectedly. Will restart in a bit...", zap.Int("pid", s.commander.Pid()), zap.Int("exit_code", s.commander.ExitCode()))
 			errMsg := fmt.Sprintf(
 				"Agent process PID=%d exited unexpectedly, exit code=%d. Will restart in a bit...",
@@ -940,6 +1010,13 @@ func (s *Supervisor) runAgentProcess() {
 			s.logger.Debug("Agent starting after start backoff")
 			s.startAgent()
 
+		case pkg := <-s.agentUpgrades:
+			restartTimer.Stop()
+			s.upgradeAgent(pkg)
+
+		case <-s.upgradeTimeout():
+			s.rollbackAgentUpgrade(fmt.Errorf("the upgraded agent didn't become healthy within %s", s.upgradeHealthyTimeout()))
+
 		case <-s.healthCheckTicker.C:
 			s.healthCheck()
 
@@ -953,6 +1030,117 @@ func (s *Supervisor) runAgentProcess() {
 	}
 }
 
+// upgradeAgent restarts the agent with the executable of a downloaded package. The upgrade
+// is completed once the upgraded agent is healthy.
+func (s *Supervisor) upgradeAgent(pkg *stagedPackage) {
+	previousExecutable := s.config.Agent.Executable
+	if s.pendingUpgrade != nil {
+		// The package is superseded before the upgrade completed, the rollback restores the
+		// executable which was running before it.
+		s.pendingUpgrade.timer.Stop()
+		previousExecutable = s.pendingUpgrade.previousExecutable
+		s.packages.discard(s.pendingUpgrade.pkg)
+	}
+
+	s.logger.Info("Upgrading the agent", zap.String("package", pkg.Name), zap.String("version", pkg.Version))
+	s.pendingUpgrade = &agentUpgrade{
+		pkg:                pkg,
+		previousExecutable: previousExecutable,
+		timer:              time.NewTimer(s.upgradeHealthyTimeout()),
+	}
+
+	if err := s.commander.Stop(context.Background()); err != nil {
+		s.logger.Error("Could not stop agent process", zap.Error(err))
+	}
+	s.config.Agent.Executable = pkg.Executable
+	s.startAgent()
+}
+
+func (s *Supervisor) completeAgentUpgrade() {
+	upgrade := s.pendingUpgrade
+	s.pendingUpgrade = nil
+	upgrade.timer.Stop()
+
+	s.logger.Info("Agent upgraded", zap.String("package", upgrade.pkg.Name), zap.String("version", upgrade.pkg.Version))
+	statuses, err := s.packages.installed(upgrade.pkg)
+	if err != nil {
+		s.logger.Error("Could not record the installed agent package", zap.Error(err))
+		return
+	}
+	s.reportPackageStatuses(statuses)
+}
+
+// rollbackAgentUpgrade restarts the agent with the executable which was running before
+// the pending upgrade.
+func (s *Supervisor) rollbackAgentUpgrade(reason error) {
+	upgrade := s.pendingUpgrade
+	s.pendingUpgrade = nil
+	upgrade.timer.Stop()
+
+	s.logger.Error("Rolling back the agent upgrade", zap.String("package", upgrade.pkg.Name), zap.String("version", upgrade.pkg.Version), zap.Error(reason))
+	if err := s.commander.Stop(context.Background()); err != nil {
+		s.logger.Error("Could not stop agent process", zap.Error(err))
+	}
+	s.config.Agent.Executable = upgrade.previousExecutable
+	s.reportPackageStatuses(s.packages.failed(upgrade.pkg, reason))
+	s.startAgent()
+}
+
+// upgradeTimeout returns a channel which is readable when the pending upgrade timed out,
+// or nil if there is no pending upgrade.
+func (s *Supervisor) upgradeTimeout() <-chan time.Time {
+	if s.pendingUpgrade == nil {
+		return nil
+	}
+	return s.pendingUpgrade.timer.C
+}
+
+func (s *Supervisor) upgradeHealthyTimeout() time.Duration {
+	if s.config.Packages.HealthyTimeout > 0 {
+		return s.config.Packages.HealthyTimeout
+	}
+	return time.Minute
+}
+
+func (s *Supervisor) packageDownloadTimeout() time.Duration {
+	if s.config.Packages.DownloadTimeout > 0 {
+		return s.config.Packages.DownloadTimeout
+	}
+	return 10 * time.Minute
+}
+
+func (s *Supervisor) maxUpgradeRestarts() int {
+	if s.config.Packages.MaxRestarts > 0 {
+		return s.config.Packages.MaxRestarts
+	}
+	return 3
+}
+
+func (s *Supervisor) onPackagesAvailable(available *protobufs.PackagesAvailable) {
+	defer s.supervisorWG.Done()
+
+	pkg, statuses := s.packages.stage(context.Background(), available)
+	s.reportPackageStatuses(statuses)
+	if pkg == nil {
+		return
+	}
+
+	select {
+	case s.agentUpgrades <- pkg:
+	case <-s.doneChan:
+		s.packages.discard(pkg)
+	}
+}
+
+func (s *Supervisor) reportPackageStatuses(statuses *protobufs.PackageStatuses) {
+	if statuses == nil {
+		return
+	}
+	if err := s.opampClient.SetPackageStatuses(statuses); err != nil {
+		s.logger.Error("Could not report package statuses to OpAMP server", zap.Error(err))
+	}
+}
+
 func (s *Supervisor) stopAgentApplyConfig() {
 	s.logger.Debug("Stopping the agent to apply new config")
 	cfg := s.effectiveConfig.Load().(string)
@@ -1064,6 +1252,11 @@ func (s *Supervisor) onMessage(ctx context.Context, msg *types.MessageData) {
 		configChanged = s.setupOwnMetrics(ctx, msg.OwnMetricsConnSettings) || configChanged
 	}
 
+	if msg.PackagesAvailable != nil && s.packages != nil {
+		s.supervisorWG.Add(1)
+		go s.onPackagesAvailable(msg.PackagesAvailable)
+	}
+
 	if msg.AgentIdentification != nil {
 		newInstanceID, err := ulid.Parse(msg.AgentIdentification.NewInstanceUid)
 		if err != nil {
@@ -39,6 +39,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/sigv4authextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/dbstorage"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 )
 
@@ -70,6 +71,14 @@ func TestDefaultExtensions(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			extension: "trigger",
+			getConfigFn: func() component.Config {
+				cfg := extFactories["trigger"].CreateDefaultConfig().(*triggerextension.Config)
+				cfg.Endpoint = endpoint
+				return cfg
+			},
+		},
 		{
 			extension: "pprof",
 			getConfigFn: func() component.Config {
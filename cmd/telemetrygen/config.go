@@ -12,9 +12,11 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/distributed"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/replay"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/traces"
 )
 
@@ -22,6 +24,10 @@ var (
 	tracesCfg  *traces.Config
 	metricsCfg *metrics.Config
 	logsCfg    *logs.Config
+	replayCfg  *replay.Config
+
+	agentCfg       *distributed.AgentConfig
+	coordinatorCfg *distributed.CoordinatorConfig
 )
 
 // rootCmd is the root command on which will be run children commands
@@ -61,8 +67,39 @@ var logsCmd = &cobra.Command{
 	},
 }
 
+// replayCmd is the command responsible for replaying recorded telemetry
+var replayCmd = &cobra.Command{
+	Use:     "replay",
+	Short:   "Replays recorded OTLP traces, metrics, and logs with their original timing",
+	Example: "telemetrygen replay --file traces.json --speed 2 --rewrite-timestamps --rewrite-ids",
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return replay.Start(replayCfg)
+	},
+}
+
+// agentCmd is the command running the test scenarios requested by a coordinator
+var agentCmd = &cobra.Command{
+	Use:     "agent",
+	Short:   "Runs the test scenarios requested by a coordinator and reports the achieved rates",
+	Example: "telemetrygen agent --listen 0.0.0.0:8090",
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return distributed.StartAgent(agentCfg)
+	},
+}
+
+// coordinatorCmd is the command running a test scenario on multiple agents
+var coordinatorCmd = &cobra.Command{
+	Use:     "coordinator -- <traces|metrics|logs> [flags]",
+	Short:   "Runs a test scenario on multiple agents and reports the aggregate achieved rate",
+	Example: "telemetrygen coordinator --agents host1:8090,host2:8090 -- traces --otlp-endpoint collector:4317 --otlp-insecure --rate 1000 --duration 5m",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return distributed.StartCoordinator(coordinatorCfg, args)
+	},
+}
+
 func init() {
-	rootCmd.AddCommand(tracesCmd, metricsCmd, logsCmd)
+	rootCmd.AddCommand(tracesCmd, metricsCmd, logsCmd, replayCmd, agentCmd, coordinatorCmd)
 
 	tracesCfg = new(traces.Config)
 	tracesCfg.Flags(tracesCmd.Flags())
@@ -73,6 +110,15 @@ func init() {
 	logsCfg = new(logs.Config)
 	logsCfg.Flags(logsCmd.Flags())
 
+	replayCfg = new(replay.Config)
+	replayCfg.Flags(replayCmd.Flags())
+
+	agentCfg = new(distributed.AgentConfig)
+	agentCfg.Flags(agentCmd.Flags())
+
+	coordinatorCfg = new(distributed.CoordinatorConfig)
+	coordinatorCfg.Flags(coordinatorCmd.Flags())
+
 	// Disabling completion command for end user
 	// https://github.com/spf13/cobra/blob/master/shell_completions.md
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
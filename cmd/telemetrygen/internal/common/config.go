@@ -70,6 +70,9 @@ type Config struct {
 
 	// OTLP mTLS configuration
 	ClientAuth ClientAuth
+
+	// Stats, when set, counts the telemetry sent by the workers.
+	Stats *Stats
 }
 
 type ClientAuth struct {
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import "sync/atomic"
+
+// Stats counts the telemetry items sent and the exports that failed during a test scenario. When a
+// scenario runs with stats, export errors are counted instead of stopping the generator.
+type Stats struct {
+	sent   atomic.Int64
+	failed atomic.Int64
+}
+
+// AddSent records that n telemetry items were sent.
+func (s *Stats) AddSent(n int64) {
+	s.sent.Add(n)
+}
+
+// AddFailed records that n exports failed.
+func (s *Stats) AddFailed(n int64) {
+	s.failed.Add(n)
+}
+
+// Sent returns the number of telemetry items sent.
+func (s *Stats) Sent() int64 {
+	return s.sent.Load()
+}
+
+// Failed returns the number of exports that failed.
+func (s *Stats) Failed() int64 {
+	return s.failed.Load()
+}
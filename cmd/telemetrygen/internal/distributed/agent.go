@@ -0,0 +1,234 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package distributed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/logs"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/traces"
+)
+
+const (
+	runPath    = "/run"
+	statusPath = "/status"
+)
+
+// RunRequest is sent by the coordinator to start a test scenario on an agent.
+type RunRequest struct {
+	// Signal is the telemetry to generate: traces, metrics or logs.
+	Signal string `json:"signal"`
+	// Args are the flags of the telemetrygen command of the signal, e.g. --rate and --duration.
+	Args []string `json:"args"`
+}
+
+// Status is the state of the test scenario of an agent.
+type Status struct {
+	Signal  string    `json:"signal"`
+	Running bool      `json:"running"`
+	Started time.Time `json:"started"`
+	// Sent is the number of telemetry items sent: spans, metrics or logs.
+	Sent int64 `json:"sent"`
+	// Failed is the number of exports that failed.
+	Failed int64 `json:"failed"`
+	// Error is the error that stopped the test scenario, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// scenario parses the flags of a test scenario and returns the function running it, counting the
+// telemetry sent in stats.
+type scenario func(args []string) (func(stats *common.Stats) error, error)
+
+// Agent runs the test scenarios requested by a coordinator, one at a time, and reports their status.
+type Agent struct {
+	logger    *zap.Logger
+	scenarios map[string]scenario
+
+	mu      sync.Mutex
+	current *run
+}
+
+type run struct {
+	signal  string
+	started time.Time
+	stats   *common.Stats
+	done    bool
+	err     error
+}
+
+// NewAgent creates an agent running the traces, metrics and logs test scenarios.
+func NewAgent(logger *zap.Logger) *Agent {
+	return &Agent{
+		logger: logger,
+		scenarios: map[string]scenario{
+			"traces": func(args []string) (func(*common.Stats) error, error) {
+				cfg := new(traces.Config)
+				if err := parseFlags(cfg.Flags, args); err != nil {
+					return nil, err
+				}
+				return func(stats *common.Stats) error {
+					cfg.Stats = stats
+					return traces.Start(cfg)
+				}, nil
+			},
+			"metrics": func(args []string) (func(*common.Stats) error, error) {
+				cfg := new(metrics.Config)
+				if err := parseFlags(cfg.Flags, args); err != nil {
+					return nil, err
+				}
+				return func(stats *common.Stats) error {
+					cfg.Stats = stats
+					return metrics.Start(cfg)
+				}, nil
+			},
+			"logs": func(args []string) (func(*common.Stats) error, error) {
+				cfg := new(logs.Config)
+				if err := parseFlags(cfg.Flags, args); err != nil {
+					return nil, err
+				}
+				return func(stats *common.Stats) error {
+					cfg.Stats = stats
+					return logs.Start(cfg)
+				}, nil
+			},
+		},
+	}
+}
+
+func parseFlags(register func(fs *pflag.FlagSet), args []string) error {
+	fs := pflag.NewFlagSet("telemetrygen", pflag.ContinueOnError)
+	register(fs)
+	return fs.Parse(args)
+}
+
+// Handler returns the HTTP handler of the agent API.
+func (a *Agent) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(runPath, a.handleRun)
+	mux.HandleFunc(statusPath, a.handleStatus)
+	return mux
+}
+
+func (a *Agent) handleRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var req RunRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if err := a.start(req); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, errRunning) {
+			status = http.StatusConflict
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (a *Agent) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(a.status()); err != nil {
+		a.logger.Error("failed to write the status", zap.Error(err))
+	}
+}
+
+var errRunning = errors.New("a test scenario is already running")
+
+// start starts a test scenario in the background.
+func (a *Agent) start(req RunRequest) error {
+	s, ok := a.scenarios[req.Signal]
+	if !ok {
+		return fmt.Errorf("unknown signal %q, expected one of traces, metrics or logs", req.Signal)
+	}
+	runScenario, err := s(req.Args)
+	if err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.current != nil && !a.current.done {
+		return errRunning
+	}
+
+	current := &run{signal: req.Signal, started: time.Now(), stats: &common.Stats{}}
+	a.current = current
+	a.logger.Info("starting the test scenario", zap.String("signal", req.Signal), zap.Strings("args", req.Args))
+	go func() {
+		err := runScenario(current.stats)
+		if err != nil {
+			a.logger.Error("the test scenario failed", zap.Error(err))
+		} else {
+			a.logger.Info("the test scenario is complete", zap.Int64("sent", current.stats.Sent()), zap.Int64("failed", current.stats.Failed()))
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		current.done = true
+		current.err = err
+	}()
+	return nil
+}
+
+func (a *Agent) status() Status {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.current == nil {
+		return Status{}
+	}
+	status := Status{
+		Signal:  a.current.signal,
+		Running: !a.current.done,
+		Started: a.current.started,
+		Sent:    a.current.stats.Sent(),
+		Failed:  a.current.stats.Failed(),
+	}
+	if a.current.err != nil {
+		status.Error = a.current.err.Error()
+	}
+	return status
+}
+
+// AgentConfig describes the agent server.
+type AgentConfig struct {
+	ListenAddress string
+}
+
+// Flags registers the agent flags.
+func (c *AgentConfig) Flags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ListenAddress, "listen", "localhost:8090", "Address the agent listens on for the requests of the coordinator")
+}
+
+// StartAgent serves the agent API until the server fails.
+func StartAgent(cfg *AgentConfig) error {
+	logger, err := common.CreateLogger(false)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Addr:              cfg.ListenAddress,
+		Handler:           NewAgent(logger).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	logger.Info("waiting for the coordinator", zap.String("listen", cfg.ListenAddress))
+	return server.ListenAndServe()
+}
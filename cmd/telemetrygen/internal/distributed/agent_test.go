@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package distributed
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+// newTestAgent returns an agent whose traces scenario sends the number of spans given as argument,
+// once release is closed, and whose logs scenario fails.
+func newTestAgent(release chan struct{}) *Agent {
+	a := NewAgent(zap.NewNop())
+	a.scenarios = map[string]scenario{
+		"traces": func(args []string) (func(*common.Stats) error, error) {
+			if len(args) != 1 {
+				return nil, errors.New("expected the number of spans")
+			}
+			var spans int64
+			if err := json.Unmarshal([]byte(args[0]), &spans); err != nil {
+				return nil, err
+			}
+			return func(stats *common.Stats) error {
+				<-release
+				stats.AddSent(spans)
+				stats.AddFailed(1)
+				return nil
+			}, nil
+		},
+		"logs": func(_ []string) (func(*common.Stats) error, error) {
+			return func(_ *common.Stats) error {
+				return errors.New("either `logs` or `duration` must be greater than 0")
+			}, nil
+		},
+	}
+	return a
+}
+
+func postRun(t *testing.T, url string, req RunRequest) *http.Response {
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+	resp, err := http.Post(url+runPath, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp
+}
+
+func getStatus(t *testing.T, url string) Status {
+	resp, err := http.Get(url + statusPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var status Status
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	return status
+}
+
+func TestAgent(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(newTestAgent(release).Handler())
+	defer server.Close()
+
+	assert.Equal(t, Status{}, getStatus(t, server.URL))
+
+	resp := postRun(t, server.URL, RunRequest{Signal: "traces", Args: []string{"10"}})
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	status := getStatus(t, server.URL)
+	assert.True(t, status.Running)
+	assert.Equal(t, "traces", status.Signal)
+
+	// Only one test scenario runs at a time.
+	resp = postRun(t, server.URL, RunRequest{Signal: "traces", Args: []string{"10"}})
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		return !getStatus(t, server.URL).Running
+	}, 5*time.Second, 10*time.Millisecond)
+	status = getStatus(t, server.URL)
+	assert.Equal(t, int64(10), status.Sent)
+	assert.Equal(t, int64(1), status.Failed)
+	assert.Empty(t, status.Error)
+
+	// The failure of a test scenario is reported in its status.
+	resp = postRun(t, server.URL, RunRequest{Signal: "logs"})
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	require.Eventually(t, func() bool {
+		return !getStatus(t, server.URL).Running
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "either `logs` or `duration` must be greater than 0", getStatus(t, server.URL).Error)
+}
+
+func TestAgentInvalidRequest(t *testing.T) {
+	server := httptest.NewServer(newTestAgent(make(chan struct{})).Handler())
+	defer server.Close()
+
+	assert.Equal(t, http.StatusBadRequest, postRun(t, server.URL, RunRequest{Signal: "profiles"}).StatusCode)
+	assert.Equal(t, http.StatusBadRequest, postRun(t, server.URL, RunRequest{Signal: "traces"}).StatusCode)
+
+	resp, err := http.Get(server.URL + runPath)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestAgentParsesScenarioFlags(t *testing.T) {
+	a := NewAgent(zap.NewNop())
+	for _, signal := range []string{"traces", "metrics", "logs"} {
+		_, err := a.scenarios[signal]([]string{"--rate", "100", "--duration", "1m"})
+		assert.NoError(t, err, signal)
+		_, err = a.scenarios[signal]([]string{"--unknown"})
+		assert.Error(t, err, signal)
+	}
+}
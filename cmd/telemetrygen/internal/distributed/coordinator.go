@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package distributed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+// CoordinatorConfig describes the agents driven by the coordinator.
+type CoordinatorConfig struct {
+	Agents            []string
+	ReportingInterval time.Duration
+	RequestTimeout    time.Duration
+}
+
+// Flags registers the coordinator flags.
+func (c *CoordinatorConfig) Flags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&c.Agents, "agents", nil, "Comma separated list of the host:port addresses of the agents")
+	fs.DurationVar(&c.ReportingInterval, "interval", time.Second, "Interval at which the achieved rate is reported")
+	fs.DurationVar(&c.RequestTimeout, "request-timeout", 5*time.Second, "Timeout of the requests sent to the agents")
+}
+
+// Validate validates the coordinator configuration.
+func (c *CoordinatorConfig) Validate() error {
+	if len(c.Agents) == 0 {
+		return errors.New("at least one agent must be specified with --agents")
+	}
+	if c.ReportingInterval <= 0 {
+		return errors.New("the reporting interval must be positive")
+	}
+	return nil
+}
+
+// AgentReport is the outcome of the test scenario of an agent.
+type AgentReport struct {
+	Agent  string
+	Sent   int64
+	Failed int64
+	Error  string
+}
+
+// Report is the consolidated outcome of a distributed test scenario.
+type Report struct {
+	Agents   []AgentReport
+	Sent     int64
+	Failed   int64
+	Duration time.Duration
+}
+
+// Rate returns the aggregate number of telemetry items sent per second.
+func (r Report) Rate() float64 {
+	if r.Duration <= 0 {
+		return 0
+	}
+	return float64(r.Sent) / r.Duration.Seconds()
+}
+
+// Coordinator starts a test scenario on each agent and consolidates the rates they achieve.
+type Coordinator struct {
+	cfg    *CoordinatorConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewCoordinator creates a coordinator driving the configured agents.
+func NewCoordinator(cfg *CoordinatorConfig, logger *zap.Logger) *Coordinator {
+	return &Coordinator{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.RequestTimeout},
+		logger: logger,
+	}
+}
+
+// Run starts the test scenario of the signal on all the agents, reports the aggregate achieved rate
+// at each interval and returns the consolidated report once all the agents are done.
+func (c *Coordinator) Run(ctx context.Context, signal string, args []string) (Report, error) {
+	started := time.Now()
+	for _, agent := range c.cfg.Agents {
+		if err := c.start(ctx, agent, RunRequest{Signal: signal, Args: args}); err != nil {
+			return Report{}, fmt.Errorf("failed to start the test scenario on agent %s: %w", agent, err)
+		}
+		c.logger.Info("started the test scenario", zap.String("agent", agent), zap.String("signal", signal))
+	}
+
+	ticker := time.NewTicker(c.cfg.ReportingInterval)
+	defer ticker.Stop()
+
+	previous := make(map[string]int64, len(c.cfg.Agents))
+	lastTick := started
+	for {
+		select {
+		case <-ctx.Done():
+			return Report{}, ctx.Err()
+		case now := <-ticker.C:
+			statuses := c.poll(ctx)
+
+			var sent, failed, delta int64
+			running := 0
+			for agent, status := range statuses {
+				sent += status.Sent
+				failed += status.Failed
+				delta += status.Sent - previous[agent]
+				previous[agent] = status.Sent
+				if status.Running {
+					running++
+				}
+			}
+			c.logger.Info("achieved rate",
+				zap.Float64("per-second", float64(delta)/now.Sub(lastTick).Seconds()),
+				zap.Int64("sent", sent),
+				zap.Int64("failed", failed),
+				zap.Int("agents-running", running),
+				zap.Int("agents-unreachable", len(c.cfg.Agents)-len(statuses)))
+			lastTick = now
+
+			if running == 0 && len(statuses) == len(c.cfg.Agents) {
+				return c.report(statuses, now.Sub(started))
+			}
+		}
+	}
+}
+
+func (c *Coordinator) report(statuses map[string]Status, duration time.Duration) (Report, error) {
+	report := Report{Duration: duration}
+	var errs error
+	for _, agent := range c.cfg.Agents {
+		status := statuses[agent]
+		report.Agents = append(report.Agents, AgentReport{Agent: agent, Sent: status.Sent, Failed: status.Failed, Error: status.Error})
+		report.Sent += status.Sent
+		report.Failed += status.Failed
+		if status.Error != "" {
+			errs = errors.Join(errs, fmt.Errorf("agent %s: %s", agent, status.Error))
+		}
+	}
+	return report, errs
+}
+
+// poll returns the status of the reachable agents.
+func (c *Coordinator) poll(ctx context.Context) map[string]Status {
+	statuses := make(map[string]Status, len(c.cfg.Agents))
+	for _, agent := range c.cfg.Agents {
+		status, err := c.status(ctx, agent)
+		if err != nil {
+			c.logger.Warn("failed to get the status of the agent", zap.String("agent", agent), zap.Error(err))
+			continue
+		}
+		statuses[agent] = status
+	}
+	return statuses
+}
+
+func (c *Coordinator) start(ctx context.Context, agent string, req RunRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, agentURL(agent, runPath), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (c *Coordinator) status(ctx context.Context, agent string) (Status, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, agentURL(agent, statusPath), nil)
+	if err != nil {
+		return Status{}, err
+	}
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return Status{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Status{}, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	var status Status
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return Status{}, fmt.Errorf("invalid status: %w", err)
+	}
+	return status, nil
+}
+
+// agentURL returns the URL of an endpoint of the agent, which is addressed as host:port or as a URL.
+func agentURL(agent string, path string) string {
+	if !strings.HasPrefix(agent, "http://") && !strings.HasPrefix(agent, "https://") {
+		agent = "http://" + agent
+	}
+	return strings.TrimSuffix(agent, "/") + path
+}
+
+// StartCoordinator runs the test scenario described by args, the signal followed by its flags, on the
+// agents and logs the consolidated report.
+func StartCoordinator(cfg *CoordinatorConfig, args []string) error {
+	logger, err := common.CreateLogger(false)
+	if err != nil {
+		return err
+	}
+	if err = cfg.Validate(); err != nil {
+		logger.Error("failed to validate the parameters for the test scenario.", zap.Error(err))
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("the signal of the test scenario must be specified, e.g. `coordinator --agents host:8090 -- traces --duration 1m`")
+	}
+
+	report, err := NewCoordinator(cfg, logger).Run(context.Background(), args[0], args[1:])
+	for _, agent := range report.Agents {
+		logger.Info("agent report", zap.String("agent", agent.Agent), zap.Int64("sent", agent.Sent), zap.Int64("failed", agent.Failed), zap.String("error", agent.Error))
+	}
+	logger.Info("test scenario complete",
+		zap.Int64("sent", report.Sent),
+		zap.Int64("failed", report.Failed),
+		zap.Duration("duration", report.Duration),
+		zap.Float64("per-second", report.Rate()))
+	if err != nil {
+		logger.Error("failed to execute the test scenario.", zap.Error(err))
+	}
+	return err
+}
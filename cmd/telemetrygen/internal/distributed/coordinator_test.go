@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package distributed
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCoordinator(t *testing.T) {
+	release := make(chan struct{})
+	first := httptest.NewServer(newTestAgent(release).Handler())
+	defer first.Close()
+	second := httptest.NewServer(newTestAgent(release).Handler())
+	defer second.Close()
+
+	cfg := &CoordinatorConfig{
+		Agents:            []string{first.URL, strings.TrimPrefix(second.URL, "http://")},
+		ReportingInterval: 10 * time.Millisecond,
+		RequestTimeout:    time.Second,
+	}
+	require.NoError(t, cfg.Validate())
+
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+	report, err := NewCoordinator(cfg, zap.NewNop()).Run(context.Background(), "traces", []string{"25"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []AgentReport{
+		{Agent: cfg.Agents[0], Sent: 25, Failed: 1},
+		{Agent: cfg.Agents[1], Sent: 25, Failed: 1},
+	}, report.Agents)
+	assert.Equal(t, int64(50), report.Sent)
+	assert.Equal(t, int64(2), report.Failed)
+	assert.GreaterOrEqual(t, report.Duration, 50*time.Millisecond)
+	assert.Positive(t, report.Rate())
+}
+
+func TestCoordinatorAgentFailure(t *testing.T) {
+	agent := httptest.NewServer(newTestAgent(make(chan struct{})).Handler())
+	defer agent.Close()
+
+	cfg := &CoordinatorConfig{Agents: []string{agent.URL}, ReportingInterval: 10 * time.Millisecond, RequestTimeout: time.Second}
+	report, err := NewCoordinator(cfg, zap.NewNop()).Run(context.Background(), "logs", nil)
+	assert.EqualError(t, err, "agent "+agent.URL+": either `logs` or `duration` must be greater than 0")
+	require.Len(t, report.Agents, 1)
+
+	_, err = NewCoordinator(cfg, zap.NewNop()).Run(context.Background(), "profiles", nil)
+	assert.ErrorContains(t, err, `failed to start the test scenario on agent `+agent.URL+`: unexpected status 400: unknown signal "profiles"`)
+}
+
+func TestCoordinatorConfigValidate(t *testing.T) {
+	assert.EqualError(t, (&CoordinatorConfig{ReportingInterval: time.Second}).Validate(), "at least one agent must be specified with --agents")
+	assert.EqualError(t, (&CoordinatorConfig{Agents: []string{"localhost:8090"}}).Validate(), "the reporting interval must be positive")
+}
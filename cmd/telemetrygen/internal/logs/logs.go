@@ -85,6 +85,7 @@ func Run(c *Config, exp exporter, logger *zap.Logger) error {
 			index:          i,
 			traceID:        c.TraceID,
 			spanID:         c.SpanID,
+			stats:          c.Stats,
 		}
 
 		go w.simulateLogs(res, exp, c.GetTelemetryAttributes())
@@ -16,6 +16,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
 )
 
 type worker struct {
@@ -31,6 +33,7 @@ type worker struct {
 	index          int                 // worker index
 	traceID        string              // traceID string
 	spanID         string              // spanID string
+	stats          *common.Stats       // counts the logs sent, if set
 }
 
 func (w worker) simulateLogs(res *resource.Resource, exporter exporter, telemetryAttributes []attribute.KeyValue) {
@@ -78,7 +81,12 @@ func (w worker) simulateLogs(res *resource.Resource, exporter exporter, telemetr
 		}
 
 		if err := exporter.export(logs); err != nil {
-			w.logger.Fatal("exporter failed", zap.Error(err))
+			if w.stats == nil {
+				w.logger.Fatal("exporter failed", zap.Error(err))
+			}
+			w.stats.AddFailed(1)
+		} else if w.stats != nil {
+			w.stats.AddSent(1)
 		}
 		if err := limiter.Wait(context.Background()); err != nil {
 			w.logger.Fatal("limiter wait failed, retry", zap.Error(err))
@@ -4,6 +4,7 @@
 package logs
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -53,6 +54,37 @@ func TestFixedNumberOfLogs(t *testing.T) {
 	require.Len(t, exp.logs, 5)
 }
 
+// failingExporter fails every other export.
+type failingExporter struct {
+	exports int
+}
+
+func (f *failingExporter) export(_ plog.Logs) error {
+	f.exports++
+	if f.exports%2 == 0 {
+		return errors.New("export failed")
+	}
+	return nil
+}
+
+func TestLogsStats(t *testing.T) {
+	stats := &common.Stats{}
+	cfg := &Config{
+		Config: common.Config{
+			WorkerCount: 1,
+			Stats:       stats,
+		},
+		NumLogs:        5,
+		SeverityText:   "Info",
+		SeverityNumber: 9,
+	}
+
+	// export errors are counted instead of stopping the generator
+	require.NoError(t, Run(cfg, &failingExporter{}, zap.NewNop()))
+	assert.Equal(t, int64(3), stats.Sent())
+	assert.Equal(t, int64(2), stats.Failed())
+}
+
 func TestRateOfLogs(t *testing.T) {
 	cfg := &Config{
 		Config: common.Config{
@@ -104,6 +104,7 @@ func Run(c *Config, exp func() (sdkmetric.Exporter, error), logger *zap.Logger)
 			wg:             &wg,
 			logger:         logger.With(zap.Int("worker", i)),
 			index:          i,
+			stats:          c.Stats,
 		}
 
 		go w.simulateMetrics(res, exp, c.GetTelemetryAttributes())
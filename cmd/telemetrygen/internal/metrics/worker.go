@@ -15,6 +15,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
 )
 
 type worker struct {
@@ -28,6 +30,7 @@ type worker struct {
 	wg             *sync.WaitGroup              // notify when done
 	logger         *zap.Logger                  // logger
 	index          int                          // worker index
+	stats          *common.Stats                // counts the metrics sent, if set
 }
 
 func (w worker) simulateMetrics(res *resource.Resource, exporterFunc func() (sdkmetric.Exporter, error), signalAttrs []attribute.KeyValue) {
@@ -92,7 +95,12 @@ func (w worker) simulateMetrics(res *resource.Resource, exporterFunc func() (sdk
 		}
 
 		if err := exporter.Export(context.Background(), &rm); err != nil {
-			w.logger.Fatal("exporter failed", zap.Error(err))
+			if w.stats == nil {
+				w.logger.Fatal("exporter failed", zap.Error(err))
+			}
+			w.stats.AddFailed(1)
+		} else if w.stats != nil {
+			w.stats.AddSent(int64(len(metrics)))
 		}
 		if err := limiter.Wait(context.Background()); err != nil {
 			w.logger.Fatal("limiter wait failed, retry", zap.Error(err))
@@ -0,0 +1,293 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"crypto/rand"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// batch is a recorded export request of a signal.
+type batch struct {
+	signal  string
+	traces  ptrace.Traces
+	metrics pmetric.Metrics
+	logs    plog.Logs
+	// time is the time the batch was recorded at, estimated as the latest timestamp of its
+	// telemetry, zero when it has none.
+	time pcommon.Timestamp
+}
+
+// dataPoint is implemented by the data points of all the metric types.
+type dataPoint interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+	Attributes() pcommon.Map
+}
+
+func (b *batch) clone() *batch {
+	c := &batch{signal: b.signal, time: b.time}
+	switch b.signal {
+	case signalTraces:
+		c.traces = ptrace.NewTraces()
+		b.traces.CopyTo(c.traces)
+	case signalMetrics:
+		c.metrics = pmetric.NewMetrics()
+		b.metrics.CopyTo(c.metrics)
+	default:
+		c.logs = plog.NewLogs()
+		b.logs.CopyTo(c.logs)
+	}
+	return c
+}
+
+// count returns the number of spans, data points or log records of the batch.
+func (b *batch) count() int {
+	switch b.signal {
+	case signalTraces:
+		return b.traces.SpanCount()
+	case signalMetrics:
+		return b.metrics.DataPointCount()
+	default:
+		return b.logs.LogRecordCount()
+	}
+}
+
+func (b *batch) resources(f func(resource pcommon.Resource)) {
+	switch b.signal {
+	case signalTraces:
+		for i := 0; i < b.traces.ResourceSpans().Len(); i++ {
+			f(b.traces.ResourceSpans().At(i).Resource())
+		}
+	case signalMetrics:
+		for i := 0; i < b.metrics.ResourceMetrics().Len(); i++ {
+			f(b.metrics.ResourceMetrics().At(i).Resource())
+		}
+	default:
+		for i := 0; i < b.logs.ResourceLogs().Len(); i++ {
+			f(b.logs.ResourceLogs().At(i).Resource())
+		}
+	}
+}
+
+func (b *batch) spans(f func(span ptrace.Span)) {
+	for i := 0; i < b.traces.ResourceSpans().Len(); i++ {
+		scopeSpans := b.traces.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				f(spans.At(k))
+			}
+		}
+	}
+}
+
+func (b *batch) logRecords(f func(logRecord plog.LogRecord)) {
+	for i := 0; i < b.logs.ResourceLogs().Len(); i++ {
+		scopeLogs := b.logs.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			logRecords := scopeLogs.At(j).LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				f(logRecords.At(k))
+			}
+		}
+	}
+}
+
+// dataPoints calls f with the data points of all the metrics, and their exemplars if any.
+func (b *batch) dataPoints(f func(dp dataPoint, exemplars pmetric.ExemplarSlice)) {
+	for i := 0; i < b.metrics.ResourceMetrics().Len(); i++ {
+		scopeMetrics := b.metrics.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < scopeMetrics.Len(); j++ {
+			metrics := scopeMetrics.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					for l := 0; l < metric.Gauge().DataPoints().Len(); l++ {
+						dp := metric.Gauge().DataPoints().At(l)
+						f(dp, dp.Exemplars())
+					}
+				case pmetric.MetricTypeSum:
+					for l := 0; l < metric.Sum().DataPoints().Len(); l++ {
+						dp := metric.Sum().DataPoints().At(l)
+						f(dp, dp.Exemplars())
+					}
+				case pmetric.MetricTypeHistogram:
+					for l := 0; l < metric.Histogram().DataPoints().Len(); l++ {
+						dp := metric.Histogram().DataPoints().At(l)
+						f(dp, dp.Exemplars())
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					for l := 0; l < metric.ExponentialHistogram().DataPoints().Len(); l++ {
+						dp := metric.ExponentialHistogram().DataPoints().At(l)
+						f(dp, dp.Exemplars())
+					}
+				case pmetric.MetricTypeSummary:
+					for l := 0; l < metric.Summary().DataPoints().Len(); l++ {
+						f(metric.Summary().DataPoints().At(l), pmetric.NewExemplarSlice())
+					}
+				}
+			}
+		}
+	}
+}
+
+func (b *batch) recordedTime() pcommon.Timestamp {
+	var latest pcommon.Timestamp
+	observe := func(ts pcommon.Timestamp) {
+		if ts > latest {
+			latest = ts
+		}
+	}
+	switch b.signal {
+	case signalTraces:
+		b.spans(func(span ptrace.Span) { observe(span.EndTimestamp()) })
+	case signalMetrics:
+		b.dataPoints(func(dp dataPoint, _ pmetric.ExemplarSlice) { observe(dp.Timestamp()) })
+	default:
+		b.logRecords(func(logRecord plog.LogRecord) {
+			if logRecord.ObservedTimestamp() != 0 {
+				observe(logRecord.ObservedTimestamp())
+			} else {
+				observe(logRecord.Timestamp())
+			}
+		})
+	}
+	return latest
+}
+
+// shiftTimestamps adds delta to the timestamps of the batch which are set.
+func (b *batch) shiftTimestamps(delta time.Duration) {
+	shift := func(ts pcommon.Timestamp) pcommon.Timestamp {
+		if ts == 0 {
+			return 0
+		}
+		return pcommon.Timestamp(int64(ts) + delta.Nanoseconds())
+	}
+	switch b.signal {
+	case signalTraces:
+		b.spans(func(span ptrace.Span) {
+			span.SetStartTimestamp(shift(span.StartTimestamp()))
+			span.SetEndTimestamp(shift(span.EndTimestamp()))
+			for i := 0; i < span.Events().Len(); i++ {
+				event := span.Events().At(i)
+				event.SetTimestamp(shift(event.Timestamp()))
+			}
+		})
+	case signalMetrics:
+		b.dataPoints(func(dp dataPoint, exemplars pmetric.ExemplarSlice) {
+			dp.SetStartTimestamp(shift(dp.StartTimestamp()))
+			dp.SetTimestamp(shift(dp.Timestamp()))
+			for i := 0; i < exemplars.Len(); i++ {
+				exemplars.At(i).SetTimestamp(shift(exemplars.At(i).Timestamp()))
+			}
+		})
+	default:
+		b.logRecords(func(logRecord plog.LogRecord) {
+			logRecord.SetTimestamp(shift(logRecord.Timestamp()))
+			logRecord.SetObservedTimestamp(shift(logRecord.ObservedTimestamp()))
+		})
+	}
+	b.time = shift(b.time)
+}
+
+// rewriteIDs replaces the trace and span IDs of the batch with the IDs of the rewriter.
+func (b *batch) rewriteIDs(r *idRewriter) {
+	switch b.signal {
+	case signalTraces:
+		b.spans(func(span ptrace.Span) {
+			span.SetTraceID(r.traceID(span.TraceID()))
+			span.SetSpanID(r.spanID(span.SpanID()))
+			span.SetParentSpanID(r.spanID(span.ParentSpanID()))
+			for i := 0; i < span.Links().Len(); i++ {
+				link := span.Links().At(i)
+				link.SetTraceID(r.traceID(link.TraceID()))
+				link.SetSpanID(r.spanID(link.SpanID()))
+			}
+		})
+	case signalMetrics:
+		b.dataPoints(func(_ dataPoint, exemplars pmetric.ExemplarSlice) {
+			for i := 0; i < exemplars.Len(); i++ {
+				exemplar := exemplars.At(i)
+				exemplar.SetTraceID(r.traceID(exemplar.TraceID()))
+				exemplar.SetSpanID(r.spanID(exemplar.SpanID()))
+			}
+		})
+	default:
+		b.logRecords(func(logRecord plog.LogRecord) {
+			logRecord.SetTraceID(r.traceID(logRecord.TraceID()))
+			logRecord.SetSpanID(r.spanID(logRecord.SpanID()))
+		})
+	}
+}
+
+// setAttributes sets the resource attributes of the batch, and the attributes of its spans,
+// data points or log records.
+func (b *batch) setAttributes(resourceAttributes, telemetryAttributes map[string]string) {
+	put := func(attributes pcommon.Map, values map[string]string) {
+		for k, v := range values {
+			attributes.PutStr(k, v)
+		}
+	}
+	if len(resourceAttributes) > 0 {
+		b.resources(func(resource pcommon.Resource) { put(resource.Attributes(), resourceAttributes) })
+	}
+	if len(telemetryAttributes) == 0 {
+		return
+	}
+	switch b.signal {
+	case signalTraces:
+		b.spans(func(span ptrace.Span) { put(span.Attributes(), telemetryAttributes) })
+	case signalMetrics:
+		b.dataPoints(func(dp dataPoint, _ pmetric.ExemplarSlice) { put(dp.Attributes(), telemetryAttributes) })
+	default:
+		b.logRecords(func(logRecord plog.LogRecord) { put(logRecord.Attributes(), telemetryAttributes) })
+	}
+}
+
+// idRewriter maps the recorded trace and span IDs to random ones, so that the relationships
+// between the spans, and the logs and exemplars referencing them, are preserved.
+type idRewriter struct {
+	traceIDs map[pcommon.TraceID]pcommon.TraceID
+	spanIDs  map[pcommon.SpanID]pcommon.SpanID
+}
+
+func newIDRewriter() *idRewriter {
+	return &idRewriter{
+		traceIDs: make(map[pcommon.TraceID]pcommon.TraceID),
+		spanIDs:  make(map[pcommon.SpanID]pcommon.SpanID),
+	}
+}
+
+func (r *idRewriter) traceID(id pcommon.TraceID) pcommon.TraceID {
+	if id.IsEmpty() {
+		return id
+	}
+	newID, ok := r.traceIDs[id]
+	if !ok {
+		_, _ = rand.Read(newID[:])
+		r.traceIDs[id] = newID
+	}
+	return newID
+}
+
+func (r *idRewriter) spanID(id pcommon.SpanID) pcommon.SpanID {
+	if id.IsEmpty() {
+		return id
+	}
+	newID, ok := r.spanIDs[id]
+	if !ok {
+		_, _ = rand.Read(newID[:])
+		r.spanIDs[id] = newID
+	}
+	return newID
+}
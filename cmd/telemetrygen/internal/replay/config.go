@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+const (
+	formatJSON  = "json"
+	formatProto = "proto"
+
+	signalTraces  = "traces"
+	signalMetrics = "metrics"
+	signalLogs    = "logs"
+)
+
+// Config describes the replay scenario.
+type Config struct {
+	common.Config
+	Files             []string
+	Format            string
+	Signal            string
+	Speed             float64
+	Loops             int
+	RewriteTimestamps bool
+	RewriteIDs        bool
+	RequestTimeout    time.Duration
+}
+
+// Flags registers config flags.
+func (c *Config) Flags(fs *pflag.FlagSet) {
+	c.CommonFlags(fs)
+	// The recorded data is sent as is, by a single worker.
+	for _, name := range []string{"workers", "rate", "interval"} {
+		_ = fs.MarkHidden(name)
+	}
+
+	fs.StringVar(&c.HTTPPath, "otlp-http-url-path", "", "Which URL path to write to, /v1/ followed by the signal by default")
+	fs.StringSliceVar(&c.Files, "file", nil, "File of recorded OTLP data, e.g. written by the file exporter. "+
+		"Flag may be repeated to replay several files one after the other")
+	fs.StringVar(&c.Format, "format", formatJSON, "Format of the files: json, one OTLP-JSON export request per line, "+
+		"or proto, OTLP protobuf export requests each preceded by its size in 4 bytes")
+	fs.StringVar(&c.Signal, "signal", "", "Signal of the files in the proto format: traces, metrics or logs")
+	fs.Float64Var(&c.Speed, "speed", 1, "Speed multiplier of the replay relative to the original timing, e.g. 2 to send the data twice as fast. "+
+		"Zero sends the data as fast as possible")
+	fs.IntVar(&c.Loops, "loops", 1, "Number of times the files are replayed. Zero replays them until the duration elapses")
+	fs.BoolVar(&c.RewriteTimestamps, "rewrite-timestamps", false, "Whether to shift the timestamps of the data as if it was produced when sent")
+	fs.BoolVar(&c.RewriteIDs, "rewrite-ids", false, "Whether to replace the trace and span IDs by random ones, consistently within each replay loop")
+	fs.DurationVar(&c.RequestTimeout, "request-timeout", 5*time.Second, "Timeout of the export requests")
+}
+
+// Validate validates the replay scenario parameters.
+func (c *Config) Validate() error {
+	if len(c.Files) == 0 {
+		return errors.New("at least one file must be specified")
+	}
+	switch c.Format {
+	case formatJSON:
+	case formatProto:
+		switch c.Signal {
+		case signalTraces, signalMetrics, signalLogs:
+		default:
+			return fmt.Errorf("the signal of the files in the proto format must be traces, metrics or logs, got %q", c.Signal)
+		}
+	default:
+		return fmt.Errorf("unsupported format %q, must be json or proto", c.Format)
+	}
+	if c.Speed < 0 {
+		return errors.New("`speed` must not be negative")
+	}
+	if c.Loops < 0 {
+		return errors.New("`loops` must not be negative")
+	}
+	if c.Loops == 0 && c.TotalDuration <= 0 {
+		return errors.New("`duration` must be greater than 0 when the files are replayed in a loop")
+	}
+	if c.RequestTimeout <= 0 {
+		return errors.New("`request-timeout` must be greater than 0")
+	}
+	return nil
+}
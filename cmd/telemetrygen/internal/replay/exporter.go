@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type exporter interface {
+	export(*batch) error
+}
+
+func newExporter(cfg *Config) (exporter, error) {
+	if cfg.UseHTTP {
+		if cfg.Insecure {
+			return &httpClientExporter{
+				client: &http.Client{Timeout: cfg.RequestTimeout},
+				cfg:    cfg,
+			}, nil
+		}
+		creds, err := common.GetTLSCredentialsForHTTPExporter(cfg.CaFile, cfg.ClientAuth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		return &httpClientExporter{
+			client: &http.Client{Transport: &http.Transport{TLSClientConfig: creds}, Timeout: cfg.RequestTimeout},
+			cfg:    cfg,
+		}, nil
+	}
+
+	// Exporter with GRPC
+	var err error
+	var clientConn *grpc.ClientConn
+	if cfg.Insecure {
+		clientConn, err = grpc.NewClient(cfg.Endpoint(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		creds, err := common.GetTLSCredentialsForGRPCExporter(cfg.CaFile, cfg.ClientAuth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get TLS credentials: %w", err)
+		}
+		clientConn, err = grpc.NewClient(cfg.Endpoint(), grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &gRPCClientExporter{
+		traces:  ptraceotlp.NewGRPCClient(clientConn),
+		metrics: pmetricotlp.NewGRPCClient(clientConn),
+		logs:    plogotlp.NewGRPCClient(clientConn),
+		cfg:     cfg,
+	}, nil
+}
+
+type gRPCClientExporter struct {
+	traces  ptraceotlp.GRPCClient
+	metrics pmetricotlp.GRPCClient
+	logs    plogotlp.GRPCClient
+	cfg     *Config
+}
+
+func (e *gRPCClientExporter) export(b *batch) error {
+	md := metadata.New(map[string]string{})
+	for k, v := range e.cfg.Headers {
+		md.Set(k, v)
+	}
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), e.cfg.RequestTimeout)
+	defer cancel()
+	var err error
+	switch b.signal {
+	case signalTraces:
+		_, err = e.traces.Export(ctx, ptraceotlp.NewExportRequestFromTraces(b.traces))
+	case signalMetrics:
+		_, err = e.metrics.Export(ctx, pmetricotlp.NewExportRequestFromMetrics(b.metrics))
+	default:
+		_, err = e.logs.Export(ctx, plogotlp.NewExportRequestFromLogs(b.logs))
+	}
+	return err
+}
+
+type httpClientExporter struct {
+	client *http.Client
+	cfg    *Config
+}
+
+func (e *httpClientExporter) export(b *batch) error {
+	scheme := "https"
+	if e.cfg.Insecure {
+		scheme = "http"
+	}
+	path := e.cfg.HTTPPath
+	if path == "" {
+		path = "/v1/" + b.signal
+	}
+	url := fmt.Sprintf("%s://%s%s", scheme, e.cfg.Endpoint(), path)
+
+	var body []byte
+	var err error
+	switch b.signal {
+	case signalTraces:
+		body, err = ptraceotlp.NewExportRequestFromTraces(b.traces).MarshalProto()
+	case signalMetrics:
+		body, err = pmetricotlp.NewExportRequestFromMetrics(b.metrics).MarshalProto()
+	default:
+		body, err = plogotlp.NewExportRequestFromLogs(b.logs).MarshalProto()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s to protobuf: %w", b.signal, err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(context.Background(), "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create %s HTTP request: %w", b.signal, err)
+	}
+	for k, v := range e.cfg.Headers {
+		httpReq.Header.Set(k, v)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := e.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("failed to execute %s HTTP request: %w", b.signal, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var respData bytes.Buffer
+		_, _ = io.Copy(&respData, resp.Body)
+		return fmt.Errorf("%s request failed with status %s (%s)", b.signal, resp.Status, respData.String())
+	}
+
+	return nil
+}
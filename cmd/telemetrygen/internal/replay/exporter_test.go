@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPExporterRequestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	cfg := &Config{RequestTimeout: 50 * time.Millisecond}
+	cfg.Insecure = true
+	cfg.UseHTTP = true
+	cfg.CustomEndpoint = strings.TrimPrefix(srv.URL, "http://")
+	exp, err := newExporter(cfg)
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = exp.export(&batch{signal: signalLogs, logs: testLogs(start)})
+	assert.ErrorContains(t, err, "failed to execute logs HTTP request")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// readFiles reads the batches recorded in the files, in order.
+func readFiles(c *Config) ([]*batch, error) {
+	var batches []*batch
+	for _, file := range c.Files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		var fileBatches []*batch
+		if c.Format == formatProto {
+			fileBatches, err = readProto(f, c.Signal)
+		} else {
+			fileBatches, err = readJSON(f)
+		}
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		batches = append(batches, fileBatches...)
+	}
+	if len(batches) == 0 {
+		return nil, errors.New("the files contain no data")
+	}
+	return batches, nil
+}
+
+// readJSON reads OTLP-JSON export requests, one per line, as written by the file exporter.
+// The signal of each request is detected from its content.
+func readJSON(r io.Reader) ([]*batch, error) {
+	var batches []*batch
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if data = bytes.TrimSpace(data); len(data) > 0 {
+			b, unmarshalErr := unmarshalJSON(data)
+			if unmarshalErr != nil {
+				return nil, fmt.Errorf("line %d: %w", line, unmarshalErr)
+			}
+			batches = append(batches, b)
+		}
+		if err != nil {
+			return batches, nil
+		}
+	}
+}
+
+func unmarshalJSON(data []byte) (*batch, error) {
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, err
+	}
+	has := func(keys ...string) bool {
+		for _, key := range keys {
+			if _, ok := probe[key]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case has("resourceSpans", "resource_spans"):
+		return jsonUnmarshalers.unmarshal(signalTraces, data)
+	case has("resourceMetrics", "resource_metrics"):
+		return jsonUnmarshalers.unmarshal(signalMetrics, data)
+	case has("resourceLogs", "resource_logs"):
+		return jsonUnmarshalers.unmarshal(signalLogs, data)
+	default:
+		return nil, errors.New("the line isn't an OTLP-JSON export request")
+	}
+}
+
+// readProto reads OTLP protobuf export requests of a signal, each preceded by its size as
+// a 4 bytes big-endian integer, as written by the file exporter.
+func readProto(r io.Reader, signal string) ([]*batch, error) {
+	var batches []*batch
+	reader := bufio.NewReader(r)
+	for {
+		var size uint32
+		if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+			if errors.Is(err, io.EOF) {
+				return batches, nil
+			}
+			return nil, err
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, fmt.Errorf("message %d: %w", len(batches)+1, err)
+		}
+		b, err := protoUnmarshalers.unmarshal(signal, data)
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", len(batches)+1, err)
+		}
+		batches = append(batches, b)
+	}
+}
+
+// unmarshalers unmarshal the export requests of the signals in a format.
+type unmarshalers struct {
+	traces  ptrace.Unmarshaler
+	metrics pmetric.Unmarshaler
+	logs    plog.Unmarshaler
+}
+
+var (
+	jsonUnmarshalers  = unmarshalers{traces: &ptrace.JSONUnmarshaler{}, metrics: &pmetric.JSONUnmarshaler{}, logs: &plog.JSONUnmarshaler{}}
+	protoUnmarshalers = unmarshalers{traces: &ptrace.ProtoUnmarshaler{}, metrics: &pmetric.ProtoUnmarshaler{}, logs: &plog.ProtoUnmarshaler{}}
+)
+
+func (u unmarshalers) unmarshal(signal string, data []byte) (*batch, error) {
+	b := &batch{signal: signal}
+	var err error
+	switch signal {
+	case signalTraces:
+		b.traces, err = u.traces.UnmarshalTraces(data)
+	case signalMetrics:
+		b.metrics, err = u.metrics.UnmarshalMetrics(data)
+	default:
+		b.logs, err = u.logs.UnmarshalLogs(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.time = b.recordedTime()
+	return b, nil
+}
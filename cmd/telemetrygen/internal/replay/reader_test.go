@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var recordedAt = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func testTraces(end time.Time) ptrace.Traces {
+	traces := ptrace.NewTraces()
+	spans := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	parent := spans.AppendEmpty()
+	parent.SetName("parent")
+	parent.SetTraceID(pcommon.TraceID{1})
+	parent.SetSpanID(pcommon.SpanID{1})
+	parent.SetStartTimestamp(pcommon.NewTimestampFromTime(end.Add(-time.Second)))
+	parent.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+	child := spans.AppendEmpty()
+	child.SetName("child")
+	child.SetTraceID(pcommon.TraceID{1})
+	child.SetSpanID(pcommon.SpanID{2})
+	child.SetParentSpanID(pcommon.SpanID{1})
+	child.SetStartTimestamp(pcommon.NewTimestampFromTime(end.Add(-500 * time.Millisecond)))
+	child.SetEndTimestamp(pcommon.NewTimestampFromTime(end.Add(-100 * time.Millisecond)))
+	return traces
+}
+
+func testMetrics(ts time.Time) pmetric.Metrics {
+	metrics := pmetric.NewMetrics()
+	metric := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("requests")
+	dp := metric.SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetIntValue(10)
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(ts.Add(-time.Minute)))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	exemplar := dp.Exemplars().AppendEmpty()
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(ts.Add(-time.Second)))
+	exemplar.SetTraceID(pcommon.TraceID{1})
+	exemplar.SetSpanID(pcommon.SpanID{2})
+	return metrics
+}
+
+func testLogs(observed time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	logRecord := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	logRecord.Body().SetStr("request handled")
+	logRecord.SetObservedTimestamp(pcommon.NewTimestampFromTime(observed))
+	logRecord.SetTraceID(pcommon.TraceID{1})
+	logRecord.SetSpanID(pcommon.SpanID{2})
+	return logs
+}
+
+func writeFile(t *testing.T, content []byte) string {
+	file := filepath.Join(t.TempDir(), "recorded")
+	require.NoError(t, os.WriteFile(file, content, 0600))
+	return file
+}
+
+func TestReadJSON(t *testing.T) {
+	var content bytes.Buffer
+	data, err := (&ptrace.JSONMarshaler{}).MarshalTraces(testTraces(recordedAt))
+	require.NoError(t, err)
+	content.Write(data)
+	content.WriteString("\n\n")
+	data, err = (&pmetric.JSONMarshaler{}).MarshalMetrics(testMetrics(recordedAt.Add(time.Second)))
+	require.NoError(t, err)
+	content.Write(data)
+	content.WriteString("\n")
+	// The last line may not be terminated.
+	data, err = (&plog.JSONMarshaler{}).MarshalLogs(testLogs(recordedAt.Add(2 * time.Second)))
+	require.NoError(t, err)
+	content.Write(data)
+
+	batches, err := readFiles(&Config{Files: []string{writeFile(t, content.Bytes())}, Format: formatJSON})
+	require.NoError(t, err)
+	require.Len(t, batches, 3)
+
+	assert.Equal(t, signalTraces, batches[0].signal)
+	assert.Equal(t, 2, batches[0].count())
+	assert.Equal(t, pcommon.NewTimestampFromTime(recordedAt), batches[0].time)
+	assert.Equal(t, signalMetrics, batches[1].signal)
+	assert.Equal(t, 1, batches[1].count())
+	assert.Equal(t, pcommon.NewTimestampFromTime(recordedAt.Add(time.Second)), batches[1].time)
+	assert.Equal(t, signalLogs, batches[2].signal)
+	assert.Equal(t, 1, batches[2].count())
+	assert.Equal(t, pcommon.NewTimestampFromTime(recordedAt.Add(2*time.Second)), batches[2].time)
+}
+
+func TestReadJSONInvalid(t *testing.T) {
+	_, err := readFiles(&Config{Files: []string{writeFile(t, []byte("{\"resourceSpans\":[]}\n{\"foo\":1}\n"))}, Format: formatJSON})
+	assert.ErrorContains(t, err, "line 2: the line isn't an OTLP-JSON export request")
+
+	_, err = readFiles(&Config{Files: []string{writeFile(t, []byte("not json\n"))}, Format: formatJSON})
+	assert.ErrorContains(t, err, "line 1")
+
+	_, err = readFiles(&Config{Files: []string{writeFile(t, nil)}, Format: formatJSON})
+	assert.EqualError(t, err, "the files contain no data")
+
+	_, err = readFiles(&Config{Files: []string{filepath.Join(t.TempDir(), "missing")}, Format: formatJSON})
+	assert.Error(t, err)
+}
+
+func TestReadProto(t *testing.T) {
+	var content bytes.Buffer
+	for i := 0; i < 2; i++ {
+		data, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(testTraces(recordedAt.Add(time.Duration(i) * time.Second)))
+		require.NoError(t, err)
+		require.NoError(t, binary.Write(&content, binary.BigEndian, uint32(len(data))))
+		content.Write(data)
+	}
+	file := writeFile(t, content.Bytes())
+
+	batches, err := readFiles(&Config{Files: []string{file, file}, Format: formatProto, Signal: signalTraces})
+	require.NoError(t, err)
+	require.Len(t, batches, 4)
+	for i, b := range batches {
+		assert.Equal(t, signalTraces, b.signal)
+		assert.Equal(t, 2, b.count())
+		assert.Equal(t, pcommon.NewTimestampFromTime(recordedAt.Add(time.Duration(i%2)*time.Second)), b.time)
+	}
+
+	// A truncated message is reported.
+	_, err = readFiles(&Config{Files: []string{writeFile(t, content.Bytes()[:content.Len()-1])}, Format: formatProto, Signal: signalTraces})
+	assert.ErrorContains(t, err, "message 2")
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+// Start starts the replay of the recorded telemetry
+func Start(cfg *Config) error {
+	logger, err := common.CreateLogger(cfg.SkipSettingGRPCLogger)
+	if err != nil {
+		return err
+	}
+
+	if err = cfg.Validate(); err != nil {
+		logger.Error("failed to validate the parameters for the test scenario.", zap.Error(err))
+		return err
+	}
+
+	batches, err := readFiles(cfg)
+	if err != nil {
+		logger.Error("failed to read the recorded telemetry.", zap.Error(err))
+		return err
+	}
+
+	e, err := newExporter(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err = Run(cfg, batches, e, logger); err != nil {
+		logger.Error("failed to execute the test scenario.", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// Run sends the batches with the intervals they were recorded at, divided by the speed.
+func Run(c *Config, batches []*batch, exp exporter, logger *zap.Logger) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	offsets := recordedOffsets(batches)
+	var deadline time.Time
+	if c.TotalDuration > 0 {
+		deadline = time.Now().Add(c.TotalDuration)
+	}
+	if c.Speed == 0 {
+		logger.Info("replay of the recorded telemetry isn't being throttled")
+	} else {
+		logger.Info("replaying the recorded telemetry", zap.Float64("speed", c.Speed), zap.Duration("recorded-duration", offsets[len(offsets)-1]))
+	}
+
+	for loop := 0; c.Loops == 0 || loop < c.Loops; loop++ {
+		loopStart := time.Now()
+		var ids *idRewriter
+		if c.RewriteIDs {
+			ids = newIDRewriter()
+		}
+		for i, b := range batches {
+			sendAt := loopStart
+			if c.Speed > 0 {
+				sendAt = loopStart.Add(time.Duration(float64(offsets[i]) / c.Speed))
+			}
+			if !deadline.IsZero() && sendAt.After(deadline) {
+				time.Sleep(time.Until(deadline))
+				return nil
+			}
+			time.Sleep(time.Until(sendAt))
+
+			b = prepare(c, b, ids, sendAt)
+			if err := exp.export(b); err != nil {
+				logger.Error("failed to export the recorded telemetry", zap.String("signal", b.signal), zap.Error(err))
+				if c.Stats != nil {
+					c.Stats.AddFailed(int64(b.count()))
+				}
+				continue
+			}
+			if c.Stats != nil {
+				c.Stats.AddSent(int64(b.count()))
+			}
+		}
+		logger.Info("replayed the recorded telemetry", zap.Int("loop", loop+1))
+	}
+	return nil
+}
+
+// recordedOffsets returns the offsets of the batches from the first one, as recorded. Batches
+// without timestamps, or recorded before the previous one, are sent right after the previous one.
+func recordedOffsets(batches []*batch) []time.Duration {
+	offsets := make([]time.Duration, len(batches))
+	var first pcommon.Timestamp
+	for i, b := range batches {
+		if i > 0 {
+			offsets[i] = offsets[i-1]
+		}
+		if b.time == 0 {
+			continue
+		}
+		if first == 0 {
+			first = b.time
+		}
+		if offset := time.Duration(b.time - first); b.time > first && offset > offsets[i] {
+			offsets[i] = offset
+		}
+	}
+	return offsets
+}
+
+// prepare returns the batch to send, a rewritten copy of the recorded one if needed.
+func prepare(c *Config, b *batch, ids *idRewriter, sendAt time.Time) *batch {
+	if !c.RewriteTimestamps && ids == nil && len(c.ResourceAttributes) == 0 && len(c.TelemetryAttributes) == 0 {
+		return b
+	}
+	b = b.clone()
+	if c.RewriteTimestamps && b.time != 0 {
+		b.shiftTimestamps(sendAt.Sub(b.time.AsTime()))
+	}
+	if ids != nil {
+		b.rewriteIDs(ids)
+	}
+	b.setAttributes(c.ResourceAttributes, c.TelemetryAttributes)
+	return b
+}
@@ -0,0 +1,239 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package replay
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
+)
+
+type mockExporter struct {
+	mu      sync.Mutex
+	batches []*batch
+	sentAt  []time.Time
+	err     error
+}
+
+func (m *mockExporter) export(b *batch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, b)
+	m.sentAt = append(m.sentAt, time.Now())
+	return m.err
+}
+
+func recordedBatches(t *testing.T) []*batch {
+	var batches []*batch
+	for i, b := range []*batch{
+		{signal: signalTraces, traces: testTraces(recordedAt)},
+		{signal: signalMetrics, metrics: testMetrics(recordedAt.Add(time.Second))},
+		{signal: signalLogs, logs: testLogs(recordedAt.Add(2 * time.Second))},
+	} {
+		b.time = b.recordedTime()
+		require.NotZero(t, b.time, "batch %d", i)
+		batches = append(batches, b)
+	}
+	return batches
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		err  string
+	}{
+		{
+			name: "valid",
+			cfg:  Config{Files: []string{"recorded.json"}, Format: formatJSON, Speed: 1, Loops: 1, RequestTimeout: time.Second},
+		},
+		{
+			name: "no files",
+			cfg:  Config{Format: formatJSON, Speed: 1, Loops: 1},
+			err:  "at least one file must be specified",
+		},
+		{
+			name: "unsupported format",
+			cfg:  Config{Files: []string{"recorded.json"}, Format: "csv", Speed: 1, Loops: 1},
+			err:  `unsupported format "csv", must be json or proto`,
+		},
+		{
+			name: "proto without signal",
+			cfg:  Config{Files: []string{"recorded.pb"}, Format: formatProto, Speed: 1, Loops: 1},
+			err:  `the signal of the files in the proto format must be traces, metrics or logs, got ""`,
+		},
+		{
+			name: "negative speed",
+			cfg:  Config{Files: []string{"recorded.json"}, Format: formatJSON, Speed: -1, Loops: 1},
+			err:  "`speed` must not be negative",
+		},
+		{
+			name: "negative loops",
+			cfg:  Config{Files: []string{"recorded.json"}, Format: formatJSON, Speed: 1, Loops: -1},
+			err:  "`loops` must not be negative",
+		},
+		{
+			name: "endless loop",
+			cfg:  Config{Files: []string{"recorded.json"}, Format: formatJSON, Speed: 1},
+			err:  "`duration` must be greater than 0 when the files are replayed in a loop",
+		},
+		{
+			name: "no request timeout",
+			cfg:  Config{Files: []string{"recorded.json"}, Format: formatJSON, Speed: 1, Loops: 1},
+			err:  "`request-timeout` must be greater than 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestRecordedOffsets(t *testing.T) {
+	second := pcommon.Timestamp(time.Second)
+	batches := []*batch{
+		{time: 0},
+		{time: 10 * second},
+		{time: 12 * second},
+		{time: 0},
+		// Recorded before the previous batch.
+		{time: 11 * second},
+		{time: 15 * second},
+	}
+	assert.Equal(t, []time.Duration{0, 0, 2 * time.Second, 2 * time.Second, 2 * time.Second, 5 * time.Second}, recordedOffsets(batches))
+}
+
+func TestRunOriginalTiming(t *testing.T) {
+	exp := &mockExporter{}
+	cfg := &Config{
+		Files:  []string{"recorded.json"},
+		Format: formatJSON,
+		// The batches recorded a second apart are sent 100ms apart.
+		Speed:          10,
+		Loops:          1,
+		RequestTimeout: time.Second,
+	}
+
+	require.NoError(t, Run(cfg, recordedBatches(t), exp, zap.NewNop()))
+
+	require.Len(t, exp.batches, 3)
+	for i := 1; i < len(exp.sentAt); i++ {
+		assert.GreaterOrEqual(t, exp.sentAt[i].Sub(exp.sentAt[i-1]), 90*time.Millisecond)
+	}
+	// The recorded batches are sent as is.
+	assert.Equal(t, pcommon.NewTimestampFromTime(recordedAt), exp.batches[0].traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).EndTimestamp())
+}
+
+func TestRunRewrite(t *testing.T) {
+	exp := &mockExporter{}
+	cfg := &Config{
+		Config: common.Config{
+			ResourceAttributes:  common.KeyValue{"service.name": "replay"},
+			TelemetryAttributes: common.KeyValue{"replayed": "true"},
+		},
+		Files:             []string{"recorded.json"},
+		Format:            formatJSON,
+		Loops:             2,
+		RewriteTimestamps: true,
+		RewriteIDs:        true,
+		RequestTimeout:    time.Second,
+	}
+	batches := recordedBatches(t)
+
+	start := time.Now()
+	require.NoError(t, Run(cfg, batches, exp, zap.NewNop()))
+	require.Len(t, exp.batches, 6)
+
+	// The recorded batches are left untouched.
+	assert.Equal(t, pcommon.NewTimestampFromTime(recordedAt), batches[0].time)
+	assert.Equal(t, pcommon.TraceID{1}, batches[0].traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+
+	for loop := 0; loop < 2; loop++ {
+		traces, metrics, logs := exp.batches[3*loop], exp.batches[3*loop+1], exp.batches[3*loop+2]
+
+		spans := traces.traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+		parent, child := spans.At(0), spans.At(1)
+		assert.WithinDuration(t, start, parent.EndTimestamp().AsTime(), time.Second)
+		assert.Equal(t, time.Second, parent.EndTimestamp().AsTime().Sub(parent.StartTimestamp().AsTime()))
+		assert.NotEqual(t, pcommon.TraceID{1}, parent.TraceID())
+		assert.Equal(t, parent.TraceID(), child.TraceID())
+		assert.Equal(t, parent.SpanID(), child.ParentSpanID())
+		attr, _ := traces.traces.ResourceSpans().At(0).Resource().Attributes().Get("service.name")
+		assert.Equal(t, "replay", attr.Str())
+		attr, _ = child.Attributes().Get("replayed")
+		assert.Equal(t, "true", attr.Str())
+
+		// The logs and exemplars keep referencing the rewritten spans.
+		exemplar := metrics.metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).Exemplars().At(0)
+		assert.Equal(t, child.TraceID(), exemplar.TraceID())
+		assert.Equal(t, child.SpanID(), exemplar.SpanID())
+		assert.WithinDuration(t, start, exemplar.Timestamp().AsTime(), time.Second)
+		logRecord := logs.logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		assert.Equal(t, child.TraceID(), logRecord.TraceID())
+		assert.Equal(t, child.SpanID(), logRecord.SpanID())
+		assert.WithinDuration(t, start, logRecord.ObservedTimestamp().AsTime(), time.Second)
+		assert.Zero(t, logRecord.Timestamp())
+	}
+
+	// The IDs are rewritten differently in each loop.
+	assert.NotEqual(t,
+		exp.batches[0].traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID(),
+		exp.batches[3].traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceID())
+}
+
+func TestRunDuration(t *testing.T) {
+	exp := &mockExporter{}
+	cfg := &Config{
+		Config: common.Config{
+			TotalDuration: 250 * time.Millisecond,
+		},
+		Files:          []string{"recorded.json"},
+		Format:         formatJSON,
+		Speed:          20,
+		RequestTimeout: time.Second,
+	}
+
+	start := time.Now()
+	require.NoError(t, Run(cfg, recordedBatches(t), exp, zap.NewNop()))
+
+	// The files are replayed in a loop, every 100ms, until the duration elapses.
+	assert.GreaterOrEqual(t, time.Since(start), 250*time.Millisecond)
+	assert.GreaterOrEqual(t, len(exp.batches), 6)
+	assert.LessOrEqual(t, len(exp.batches), 9)
+}
+
+func TestRunStats(t *testing.T) {
+	stats := &common.Stats{}
+	cfg := &Config{
+		Config: common.Config{
+			Stats: stats,
+		},
+		Files:          []string{"recorded.json"},
+		Format:         formatJSON,
+		Loops:          1,
+		RequestTimeout: time.Second,
+	}
+
+	require.NoError(t, Run(cfg, recordedBatches(t), &mockExporter{}, zap.NewNop()))
+	assert.Equal(t, int64(4), stats.Sent())
+	assert.Equal(t, int64(0), stats.Failed())
+
+	require.NoError(t, Run(cfg, recordedBatches(t), &mockExporter{err: errors.New("export failed")}, zap.NewNop()))
+	assert.Equal(t, int64(4), stats.Sent())
+	assert.Equal(t, int64(4), stats.Failed())
+}
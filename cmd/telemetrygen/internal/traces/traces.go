@@ -91,6 +91,13 @@ func Start(cfg *Config) error {
 		tracerProvider.RegisterSpanProcessor(ssp)
 	}
 	otel.SetTracerProvider(tracerProvider)
+	if cfg.Stats != nil {
+		// The spans are exported by the SDK, which reports the export errors to the global handler.
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			cfg.Stats.AddFailed(1)
+			logger.Debug("failed to export the spans", zap.Error(err))
+		}))
+	}
 
 	if err = Run(cfg, logger); err != nil {
 		logger.Error("failed to execute the test scenario.", zap.Error(err))
@@ -150,6 +157,7 @@ func Run(c *Config, logger *zap.Logger) error {
 			logger:           logger.With(zap.Int("worker", i)),
 			loadSize:         c.LoadSize,
 			spanDuration:     c.SpanDuration,
+			stats:            c.Stats,
 		}
 
 		go w.simulateTraces(telemetryAttributes)
@@ -19,6 +19,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/cmd/telemetrygen/internal/common"
 )
 
 type worker struct {
@@ -33,6 +35,7 @@ type worker struct {
 	loadSize         int             // desired minimum size in MB of string data for each generated trace
 	spanDuration     time.Duration   // duration of generated spans
 	logger           *zap.Logger
+	stats            *common.Stats // counts the spans ended, if set
 }
 
 const (
@@ -97,6 +100,9 @@ func (w worker) simulateTraces(telemetryAttributes []attribute.KeyValue) {
 		}
 		sp.SetStatus(w.statusCode, "")
 		sp.End(endTimestamp)
+		if w.stats != nil {
+			w.stats.AddSent(int64(w.numChildSpans + 1))
+		}
 
 		i++
 		if w.numTraces != 0 {
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"go.uber.org/zap"
+)
+
+const loginRetryInterval = 10 * time.Second
+
+// login authenticates the client with the configured auth method, and returns the secret
+// of the token it obtained. It returns nil with the token auth method.
+func login(ctx context.Context, client *api.Client, cfg *config) (*api.Secret, error) {
+	var data map[string]any
+	switch cfg.authMethod {
+	case authMethodAppRole:
+		secretID, err := readCredentialFile(cfg.secretIDFile)
+		if err != nil {
+			return nil, err
+		}
+		data = map[string]any{"role_id": cfg.roleID, "secret_id": secretID}
+	case authMethodKubernetes:
+		// The projected service account token is renewed by the kubelet and read at every login.
+		jwt, err := readCredentialFile(cfg.tokenFile)
+		if err != nil {
+			return nil, err
+		}
+		data = map[string]any{"role": cfg.role, "jwt": jwt}
+	default:
+		return nil, nil
+	}
+
+	secret, err := client.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", cfg.mount), data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in to Vault with the %s auth method: %w", cfg.authMethod, err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, errors.New("no token was returned by the Vault login")
+	}
+	client.SetToken(secret.Auth.ClientToken)
+	return secret, nil
+}
+
+func readCredentialFile(filename string) (string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// renewToken renews the token obtained by the login until its maximum TTL, and then
+// logs in again to obtain a new one.
+func (p *provider) renewToken(ctx context.Context, token *api.Secret) {
+	defer p.wg.Done()
+
+	for {
+		if token.Auth.LeaseDuration == 0 {
+			// The token never expires.
+			return
+		}
+		if token.Auth.Renewable {
+			if err := p.watchLifetime(ctx, token); err != nil {
+				p.logger.Warn("Failed to renew the Vault token", zap.Error(err))
+			}
+		} else {
+			timer := time.NewTimer(renewalDelay(token.Auth.LeaseDuration))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			var err error
+			if token, err = login(ctx, p.client, p.cfg); err == nil {
+				p.logger.Debug("Logged in to Vault again")
+				break
+			}
+			p.logger.Error("Failed to log in to Vault", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(loginRetryInterval):
+			}
+		}
+	}
+}
+
+// watchLifetime renews the lease of a secret until it can't be renewed anymore.
+func (p *provider) watchLifetime(ctx context.Context, secret *api.Secret) error {
+	watcher, err := p.client.NewLifetimeWatcher(&api.LifetimeWatcherInput{Secret: secret})
+	if err != nil {
+		return err
+	}
+	go watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.DoneCh():
+			return err
+		case <-watcher.RenewCh():
+		}
+	}
+}
+
+// renewalDelay returns when a lease which can't be renewed must be replaced, after two thirds
+// of its duration in seconds.
+func renewalDelay(leaseDuration int) time.Duration {
+	return time.Duration(leaseDuration) * time.Second * 2 / 3
+}
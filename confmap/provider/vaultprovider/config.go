@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The provider isn't configured in the collector configuration, whose values it resolves,
+// but with environment variables. The address of the Vault server and its TLS settings
+// are read by the Vault client from the standard VAULT_* environment variables.
+const (
+	envAuthMethod          = "VAULT_AUTH_METHOD"
+	envAuthMount           = "VAULT_AUTH_MOUNT"
+	envAppRoleRoleID       = "VAULT_APPROLE_ROLE_ID"
+	envAppRoleSecretIDFile = "VAULT_APPROLE_SECRET_ID_FILE"
+	envKubernetesRole      = "VAULT_KUBERNETES_ROLE"
+	envKubernetesTokenFile = "VAULT_KUBERNETES_TOKEN_FILE"
+	envPollInterval        = "VAULT_POLL_INTERVAL"
+
+	defaultKubernetesTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultPollInterval        = 5 * time.Minute
+)
+
+type authMethod string
+
+const (
+	// authMethodToken uses the token of the VAULT_TOKEN environment variable.
+	authMethodToken      authMethod = "token"
+	authMethodAppRole    authMethod = "approle"
+	authMethodKubernetes authMethod = "kubernetes"
+)
+
+var (
+	errMissingRoleID       = fmt.Errorf("%s must be set with the approle auth method", envAppRoleRoleID)
+	errMissingSecretIDFile = fmt.Errorf("%s must be set with the approle auth method", envAppRoleSecretIDFile)
+	errMissingRole         = fmt.Errorf("%s must be set with the kubernetes auth method", envKubernetesRole)
+	errInvalidPollInterval = errors.New("the poll interval must be positive")
+)
+
+type config struct {
+	authMethod authMethod
+	// mount is the path the auth method is enabled at.
+	mount string
+
+	roleID       string
+	secretIDFile string
+
+	role      string
+	tokenFile string
+
+	// pollInterval is the interval at which the secrets without lease are read again
+	// to detect their rotation.
+	pollInterval time.Duration
+}
+
+func configFromEnv(getenv func(string) string) (*config, error) {
+	cfg := &config{
+		authMethod:   authMethod(getenv(envAuthMethod)),
+		mount:        getenv(envAuthMount),
+		roleID:       getenv(envAppRoleRoleID),
+		secretIDFile: getenv(envAppRoleSecretIDFile),
+		role:         getenv(envKubernetesRole),
+		tokenFile:    getenv(envKubernetesTokenFile),
+		pollInterval: defaultPollInterval,
+	}
+	if cfg.authMethod == "" {
+		cfg.authMethod = authMethodToken
+	}
+	if cfg.mount == "" {
+		cfg.mount = string(cfg.authMethod)
+	}
+	if cfg.tokenFile == "" {
+		cfg.tokenFile = defaultKubernetesTokenFile
+	}
+	if pollInterval := getenv(envPollInterval); pollInterval != "" {
+		var err error
+		if cfg.pollInterval, err = time.ParseDuration(pollInterval); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", envPollInterval, err)
+		}
+	}
+
+	switch cfg.authMethod {
+	case authMethodToken:
+	case authMethodAppRole:
+		if cfg.roleID == "" {
+			return nil, errMissingRoleID
+		}
+		if cfg.secretIDFile == "" {
+			return nil, errMissingSecretIDFile
+		}
+	case authMethodKubernetes:
+		if cfg.role == "" {
+			return nil, errMissingRole
+		}
+	default:
+		return nil, fmt.Errorf("unsupported %s %q, must be one of token, approle or kubernetes", envAuthMethod, cfg.authMethod)
+	}
+	if cfg.pollInterval <= 0 {
+		return nil, errInvalidPollInterval
+	}
+	return cfg, nil
+}
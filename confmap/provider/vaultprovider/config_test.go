@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		expected    *config
+		expectedErr string
+	}{
+		{
+			name: "default",
+			expected: &config{
+				authMethod:   authMethodToken,
+				mount:        "token",
+				tokenFile:    defaultKubernetesTokenFile,
+				pollInterval: defaultPollInterval,
+			},
+		},
+		{
+			name: "approle",
+			env: map[string]string{
+				envAuthMethod:          "approle",
+				envAppRoleRoleID:       "otelcol",
+				envAppRoleSecretIDFile: "/etc/vault/secret-id",
+				envPollInterval:        "1m",
+			},
+			expected: &config{
+				authMethod:   authMethodAppRole,
+				mount:        "approle",
+				roleID:       "otelcol",
+				secretIDFile: "/etc/vault/secret-id",
+				tokenFile:    defaultKubernetesTokenFile,
+				pollInterval: time.Minute,
+			},
+		},
+		{
+			name: "kubernetes",
+			env: map[string]string{
+				envAuthMethod:     "kubernetes",
+				envAuthMount:      "k8s-prod",
+				envKubernetesRole: "otelcol",
+			},
+			expected: &config{
+				authMethod:   authMethodKubernetes,
+				mount:        "k8s-prod",
+				role:         "otelcol",
+				tokenFile:    defaultKubernetesTokenFile,
+				pollInterval: defaultPollInterval,
+			},
+		},
+		{
+			name:        "missing role ID",
+			env:         map[string]string{envAuthMethod: "approle", envAppRoleSecretIDFile: "/etc/vault/secret-id"},
+			expectedErr: errMissingRoleID.Error(),
+		},
+		{
+			name:        "missing secret ID file",
+			env:         map[string]string{envAuthMethod: "approle", envAppRoleRoleID: "otelcol"},
+			expectedErr: errMissingSecretIDFile.Error(),
+		},
+		{
+			name:        "missing role",
+			env:         map[string]string{envAuthMethod: "kubernetes"},
+			expectedErr: errMissingRole.Error(),
+		},
+		{
+			name:        "unsupported auth method",
+			env:         map[string]string{envAuthMethod: "userpass"},
+			expectedErr: `unsupported VAULT_AUTH_METHOD "userpass", must be one of token, approle or kubernetes`,
+		},
+		{
+			name:        "invalid poll interval",
+			env:         map[string]string{envPollInterval: "often"},
+			expectedErr: "invalid VAULT_POLL_INTERVAL",
+		},
+		{
+			name:        "negative poll interval",
+			env:         map[string]string{envPollInterval: "-1m"},
+			expectedErr: errInvalidPollInterval.Error(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := configFromEnv(func(key string) string { return tt.env[key] })
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
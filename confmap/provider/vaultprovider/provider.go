@@ -0,0 +1,334 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/confmap/provider/vaultprovider"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+const (
+	schemeName = "vault"
+)
+
+type provider struct {
+	logger *zap.Logger
+
+	// mu protects the client and the secrets.
+	mu     sync.Mutex
+	client *api.Client
+	cfg    *config
+	// secrets caches the secrets read by path, so that the keys of a dynamic secret,
+	// e.g. the username and password of database credentials, are read from the same lease.
+	secrets map[string]*secret
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// secret is a secret read from Vault, and the retrieved configurations referencing it.
+type secret struct {
+	path string
+	data map[string]any
+
+	watchers map[int]confmap.WatcherFunc
+	nextID   int
+	// refs counts the retrieved configurations, with or without watcher.
+	refs int
+
+	cancel context.CancelFunc
+}
+
+// NewFactory returns a new confmap.ProviderFactory that creates a confmap.Provider
+// which reads configuration values from the secrets of HashiCorp Vault.
+//
+// This Provider supports "vault" scheme, and can be called with a selector:
+// `vault:PATH#KEY`, e.g. `vault:secret/data/otel#api_key`. The whole secret is
+// returned as a map when the key is omitted.
+func NewFactory() confmap.ProviderFactory {
+	return confmap.NewProviderFactory(newWithSettings)
+}
+
+func newWithSettings(settings confmap.ProviderSettings) confmap.Provider {
+	logger := settings.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &provider{
+		logger:  logger,
+		secrets: make(map[string]*secret),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+func (p *provider) Retrieve(ctx context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if !strings.HasPrefix(uri, schemeName+":") {
+		return nil, fmt.Errorf("%q uri is not supported by %q provider", uri, schemeName)
+	}
+	path, key, _ := strings.Cut(strings.TrimPrefix(uri, schemeName+":"), "#")
+	if path == "" {
+		return nil, fmt.Errorf("%q uri has no secret path", uri)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	s, ok := p.secrets[path]
+	if !ok {
+		var err error
+		if s, err = p.readSecret(ctx, path); err != nil {
+			return nil, err
+		}
+	}
+
+	value, err := s.value(key)
+	if err != nil {
+		if !ok {
+			s.cancel()
+		}
+		return nil, err
+	}
+
+	p.secrets[path] = s
+	s.refs++
+	id := s.nextID
+	s.nextID++
+	if watcher != nil {
+		s.watchers[id] = watcher
+	}
+
+	var once sync.Once
+	return confmap.NewRetrieved(value, confmap.WithRetrievedClose(func(context.Context) error {
+		once.Do(func() { p.release(s, id) })
+		return nil
+	}))
+}
+
+// connect creates the Vault client and logs in, the first time a secret is retrieved.
+func (p *provider) connect(ctx context.Context) error {
+	if p.client != nil {
+		return nil
+	}
+
+	cfg, err := configFromEnv(os.Getenv)
+	if err != nil {
+		return err
+	}
+	clientConfig := api.DefaultConfig()
+	if clientConfig.Error != nil {
+		return clientConfig.Error
+	}
+	client, err := api.NewClient(clientConfig)
+	if err != nil {
+		return err
+	}
+	token, err := login(ctx, client, cfg)
+	if err != nil {
+		return err
+	}
+
+	p.client, p.cfg = client, cfg
+	if token != nil {
+		p.wg.Add(1)
+		go p.renewToken(p.ctx, token)
+	}
+	return nil
+}
+
+func (p *provider) readSecret(ctx context.Context, path string) (*secret, error) {
+	vaultSecret, err := p.client.Logical().ReadWithContext(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Vault secret %q: %w", path, err)
+	}
+	data, err := secretData(path, vaultSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	s := &secret{
+		path:     path,
+		data:     data,
+		watchers: make(map[int]confmap.WatcherFunc),
+		cancel:   cancel,
+	}
+	p.wg.Add(1)
+	go p.watch(ctx, s, vaultSecret)
+	return s, nil
+}
+
+// secretData returns the data of a secret, unwrapping the data of the KV version 2 engine.
+func secretData(path string, vaultSecret *api.Secret) (map[string]any, error) {
+	if vaultSecret == nil || vaultSecret.Data == nil {
+		return nil, fmt.Errorf("the Vault secret %q doesn't exist", path)
+	}
+	data := vaultSecret.Data
+	if _, ok := data["metadata"]; ok {
+		if _, ok := data["data"]; ok {
+			kvData, ok := data["data"].(map[string]any)
+			if !ok {
+				// A deleted version of a KV version 2 secret has no data.
+				return nil, fmt.Errorf("the Vault secret %q doesn't exist", path)
+			}
+			data = kvData
+		}
+	}
+	return normalize(data).(map[string]any), nil
+}
+
+// normalize converts the numbers decoded by the Vault client to the types supported by confmap.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			m[key] = normalize(value)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, value := range v {
+			s[i] = normalize(value)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func (s *secret) value(key string) (any, error) {
+	if key == "" {
+		return s.data, nil
+	}
+	value, ok := s.data[key]
+	if !ok {
+		return nil, fmt.Errorf("the Vault secret %q has no key %q", s.path, key)
+	}
+	return value, nil
+}
+
+// watch renews the lease of a dynamic secret, and notifies the watchers when the secret must
+// be read again: when its lease can't be renewed anymore, or when the value of a secret
+// without lease was rotated.
+func (p *provider) watch(ctx context.Context, s *secret, vaultSecret *api.Secret) {
+	defer p.wg.Done()
+
+	switch {
+	case vaultSecret.Renewable:
+		if err := p.watchLifetime(ctx, vaultSecret); err != nil {
+			p.logger.Warn("Failed to renew the lease of a Vault secret", zap.String("path", s.path), zap.Error(err))
+		}
+	case vaultSecret.LeaseDuration > 0:
+		timer := time.NewTimer(renewalDelay(vaultSecret.LeaseDuration))
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+	default:
+		p.pollRotation(ctx, s)
+	}
+
+	if ctx.Err() == nil {
+		p.notify(s)
+	}
+}
+
+// pollRotation returns when the data of the secret changed.
+func (p *provider) pollRotation(ctx context.Context, s *secret) {
+	ticker := time.NewTicker(p.cfg.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			vaultSecret, err := p.client.Logical().ReadWithContext(ctx, s.path)
+			if err != nil {
+				p.logger.Warn("Failed to read a Vault secret", zap.String("path", s.path), zap.Error(err))
+				continue
+			}
+			data, err := secretData(s.path, vaultSecret)
+			if err != nil {
+				p.logger.Warn("Failed to read a Vault secret", zap.String("path", s.path), zap.Error(err))
+				continue
+			}
+			if !reflect.DeepEqual(data, s.data) {
+				return
+			}
+		}
+	}
+}
+
+// notify requests the configurations referencing the secret to be retrieved again.
+func (p *provider) notify(s *secret) {
+	p.mu.Lock()
+	// The secret is read again by the next retrievals.
+	if p.secrets[s.path] == s {
+		delete(p.secrets, s.path)
+	}
+	watchers := make([]confmap.WatcherFunc, 0, len(s.watchers))
+	for _, watcher := range s.watchers {
+		watchers = append(watchers, watcher)
+	}
+	p.mu.Unlock()
+
+	p.logger.Info("Vault secret changed", zap.String("path", s.path))
+	for _, watcher := range watchers {
+		watcher(&confmap.ChangeEvent{})
+	}
+}
+
+func (p *provider) release(s *secret, id int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(s.watchers, id)
+	s.refs--
+	if s.refs > 0 {
+		return
+	}
+	s.cancel()
+	if p.secrets[s.path] == s {
+		delete(p.secrets, s.path)
+	}
+}
+
+func (*provider) Scheme() string {
+	return schemeName
+}
+
+func (p *provider) Shutdown(context.Context) error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
+
+var _ confmap.Provider = (*provider)(nil)
@@ -0,0 +1,256 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vaultprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+)
+
+// fakeVault serves the subset of the Vault HTTP API used by the provider.
+type fakeVault struct {
+	t *testing.T
+
+	mu          sync.Mutex
+	apiKey      string
+	credentials int
+	leaseTTL    int
+	logins      int
+}
+
+func newFakeVault(t *testing.T) *fakeVault {
+	v := &fakeVault{t: t, apiKey: "secret-1", leaseTTL: 3600}
+	server := httptest.NewServer(v)
+	t.Cleanup(server.Close)
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "")
+	return v
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if r.Method == http.MethodPut || r.Method == http.MethodPost {
+		var body map[string]any
+		require.NoError(v.t, json.NewDecoder(r.Body).Decode(&body))
+		switch {
+		case r.URL.Path == "/v1/auth/approle/login" && body["role_id"] == "otelcol" && body["secret_id"] == "approle-secret",
+			r.URL.Path == "/v1/auth/kubernetes/login" && body["role"] == "otelcol" && body["jwt"] == "service-account-token":
+			v.logins++
+			v.write(w, map[string]any{
+				"auth": map[string]any{"client_token": "client-token", "lease_duration": 3600, "renewable": false},
+			})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+		return
+	}
+
+	if r.Header.Get("X-Vault-Token") != "client-token" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch r.URL.Path {
+	case "/v1/secret/data/otel":
+		v.write(w, map[string]any{
+			"data": map[string]any{
+				"data":     map[string]any{"api_key": v.apiKey, "port": 4317},
+				"metadata": map[string]any{"version": 1},
+			},
+		})
+	case "/v1/kv/otel":
+		v.write(w, map[string]any{"data": map[string]any{"api_key": v.apiKey}})
+	case "/v1/database/creds/readonly":
+		v.credentials++
+		v.write(w, map[string]any{
+			"lease_id":       fmt.Sprintf("database/creds/readonly/%d", v.credentials),
+			"lease_duration": v.leaseTTL,
+			"renewable":      false,
+			"data": map[string]any{
+				"username": fmt.Sprintf("user-%d", v.credentials),
+				"password": fmt.Sprintf("password-%d", v.credentials),
+			},
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (v *fakeVault) write(w http.ResponseWriter, response map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(v.t, json.NewEncoder(w).Encode(response))
+}
+
+func (v *fakeVault) setAPIKey(apiKey string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.apiKey = apiKey
+}
+
+func setupAppRole(t *testing.T) {
+	secretIDFile := filepath.Join(t.TempDir(), "secret-id")
+	require.NoError(t, os.WriteFile(secretIDFile, []byte("approle-secret\n"), 0600))
+	t.Setenv(envAuthMethod, "approle")
+	t.Setenv(envAppRoleRoleID, "otelcol")
+	t.Setenv(envAppRoleSecretIDFile, secretIDFile)
+}
+
+func newTestProvider(t *testing.T) confmap.Provider {
+	p := NewFactory().Create(confmap.ProviderSettings{Logger: zap.NewNop()})
+	t.Cleanup(func() { assert.NoError(t, p.Shutdown(context.Background())) })
+	return p
+}
+
+func retrieveValue(t *testing.T, p confmap.Provider, uri string, watcher confmap.WatcherFunc) (any, *confmap.Retrieved) {
+	retrieved, err := p.Retrieve(context.Background(), uri, watcher)
+	require.NoError(t, err)
+	value, err := retrieved.AsRaw()
+	require.NoError(t, err)
+	return value, retrieved
+}
+
+func TestRetrieve(t *testing.T) {
+	newFakeVault(t)
+	setupAppRole(t)
+	p := newTestProvider(t)
+	assert.Equal(t, "vault", p.Scheme())
+
+	value, _ := retrieveValue(t, p, "vault:secret/data/otel#api_key", nil)
+	assert.Equal(t, "secret-1", value)
+
+	value, _ = retrieveValue(t, p, "vault:secret/data/otel#port", nil)
+	assert.Equal(t, int64(4317), value)
+
+	value, _ = retrieveValue(t, p, "vault:secret/data/otel", nil)
+	assert.Equal(t, map[string]any{"api_key": "secret-1", "port": int64(4317)}, value)
+
+	value, _ = retrieveValue(t, p, "vault:kv/otel#api_key", nil)
+	assert.Equal(t, "secret-1", value)
+
+	_, err := p.Retrieve(context.Background(), "vault:secret/data/otel#password", nil)
+	assert.EqualError(t, err, `the Vault secret "secret/data/otel" has no key "password"`)
+
+	_, err = p.Retrieve(context.Background(), "vault:secret/data/missing#api_key", nil)
+	assert.EqualError(t, err, `the Vault secret "secret/data/missing" doesn't exist`)
+
+	_, err = p.Retrieve(context.Background(), "vault:", nil)
+	assert.EqualError(t, err, `"vault:" uri has no secret path`)
+
+	_, err = p.Retrieve(context.Background(), "file:config.yaml", nil)
+	assert.EqualError(t, err, `"file:config.yaml" uri is not supported by "vault" provider`)
+}
+
+func TestRetrieveKubernetesAuth(t *testing.T) {
+	vault := newFakeVault(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("service-account-token"), 0600))
+	t.Setenv(envAuthMethod, "kubernetes")
+	t.Setenv(envKubernetesRole, "otelcol")
+	t.Setenv(envKubernetesTokenFile, tokenFile)
+	p := newTestProvider(t)
+
+	value, _ := retrieveValue(t, p, "vault:secret/data/otel#api_key", nil)
+	assert.Equal(t, "secret-1", value)
+	value, _ = retrieveValue(t, p, "vault:kv/otel#api_key", nil)
+	assert.Equal(t, "secret-1", value)
+	assert.Equal(t, 1, vault.logins)
+}
+
+func TestRetrieveLoginFails(t *testing.T) {
+	newFakeVault(t)
+	setupAppRole(t)
+	t.Setenv(envAppRoleRoleID, "unknown")
+	p := newTestProvider(t)
+
+	_, err := p.Retrieve(context.Background(), "vault:secret/data/otel#api_key", nil)
+	assert.ErrorContains(t, err, "failed to log in to Vault with the approle auth method")
+}
+
+func TestRetrieveDynamicSecret(t *testing.T) {
+	vault := newFakeVault(t)
+	vault.leaseTTL = 1
+	setupAppRole(t)
+	p := newTestProvider(t)
+
+	changed := make(chan *confmap.ChangeEvent, 2)
+	watcher := func(event *confmap.ChangeEvent) { changed <- event }
+
+	// The username and password are read from the same lease.
+	username, retrievedUsername := retrieveValue(t, p, "vault:database/creds/readonly#username", watcher)
+	password, retrievedPassword := retrieveValue(t, p, "vault:database/creds/readonly#password", watcher)
+	assert.Equal(t, "user-1", username)
+	assert.Equal(t, "password-1", password)
+
+	// The watchers are notified before the lease expires.
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-changed:
+			assert.NoError(t, event.Error)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the watchers weren't notified")
+		}
+	}
+	require.NoError(t, retrievedUsername.Close(context.Background()))
+	require.NoError(t, retrievedPassword.Close(context.Background()))
+
+	// New credentials are read by the next retrieval.
+	vault.leaseTTL = 3600
+	username, retrieved := retrieveValue(t, p, "vault:database/creds/readonly#username", nil)
+	assert.Equal(t, "user-2", username)
+	require.NoError(t, retrieved.Close(context.Background()))
+}
+
+func TestRetrieveRotatedSecret(t *testing.T) {
+	vault := newFakeVault(t)
+	setupAppRole(t)
+	t.Setenv(envPollInterval, "10ms")
+	p := newTestProvider(t)
+
+	changed := make(chan *confmap.ChangeEvent, 1)
+	value, retrieved := retrieveValue(t, p, "vault:secret/data/otel#api_key", func(event *confmap.ChangeEvent) { changed <- event })
+	assert.Equal(t, "secret-1", value)
+
+	vault.setAPIKey("secret-2")
+	select {
+	case event := <-changed:
+		assert.NoError(t, event.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watcher wasn't notified")
+	}
+	require.NoError(t, retrieved.Close(context.Background()))
+
+	value, _ = retrieveValue(t, p, "vault:secret/data/otel#api_key", nil)
+	assert.Equal(t, "secret-2", value)
+}
+
+func TestRetrievedClose(t *testing.T) {
+	newFakeVault(t)
+	setupAppRole(t)
+	p := newTestProvider(t).(*provider)
+
+	_, first := retrieveValue(t, p, "vault:secret/data/otel#api_key", nil)
+	_, second := retrieveValue(t, p, "vault:secret/data/otel#port", nil)
+	require.Len(t, p.secrets, 1)
+
+	// The secret is released with the last configuration referencing it.
+	require.NoError(t, first.Close(context.Background()))
+	require.NoError(t, first.Close(context.Background()))
+	assert.Len(t, p.secrets, 1)
+	require.NoError(t, second.Close(context.Background()))
+	assert.Empty(t, p.secrets)
+}
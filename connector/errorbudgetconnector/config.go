@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package errorbudgetconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	defaultServiceAttribute     = "service.name"
+	defaultWindow               = 30 * 24 * time.Hour
+	defaultBurnRateWindow       = time.Hour
+	defaultMetricsFlushInterval = time.Minute
+
+	defaultSpanErrorCondition = "status.code == STATUS_CODE_ERROR"
+	defaultLogErrorCondition  = "severity_number >= SEVERITY_NUMBER_ERROR"
+)
+
+// Config for the connector
+type Config struct {
+	// ServiceAttribute is the resource attribute identifying the service of the spans and log records.
+	ServiceAttribute string `mapstructure:"service_attribute"`
+	// Window is the duration of the sliding window over which the errors are counted against the budgets.
+	Window time.Duration `mapstructure:"window"`
+	// BurnRateWindow is the duration of the recent period whose error rate is used to forecast the exhaustion of the budgets.
+	BurnRateWindow time.Duration `mapstructure:"burn_rate_window"`
+	// MetricsFlushInterval is the interval at which the budget metrics are emitted.
+	MetricsFlushInterval time.Duration `mapstructure:"metrics_flush_interval"`
+
+	// Spans defines which spans are errors.
+	Spans ErrorConditions `mapstructure:"spans"`
+	// Logs defines which log records are errors.
+	Logs ErrorConditions `mapstructure:"logs"`
+
+	// Services are the budgets of the services, keyed by service name.
+	Services map[string]Budget `mapstructure:"services"`
+	// DefaultBudget is the budget of the services which are not listed in Services.
+	// If not set, the other services are ignored.
+	DefaultBudget *Budget `mapstructure:"default_budget"`
+}
+
+// ErrorConditions are the OTTL conditions classifying spans or log records as errors.
+// A span or log record is an error if any of the conditions matches.
+type ErrorConditions struct {
+	Conditions []string `mapstructure:"conditions"`
+}
+
+// Budget is the number of errors allowed in the window, either as an absolute number of errors or
+// as a fraction of the spans and log records of the service.
+type Budget struct {
+	// Objective is the fraction of the spans and log records which must not be errors, e.g. 0.999.
+	Objective float64 `mapstructure:"objective"`
+	// MaxErrors is the number of errors allowed in the window.
+	MaxErrors int64 `mapstructure:"max_errors"`
+}
+
+func (b Budget) validate() error {
+	switch {
+	case b.Objective != 0 && b.MaxErrors != 0:
+		return errors.New("only one of objective and max_errors can be set")
+	case b.Objective != 0:
+		if b.Objective <= 0 || b.Objective >= 1 {
+			return fmt.Errorf("objective must be between 0 and 1 exclusive, got %v", b.Objective)
+		}
+	case b.MaxErrors < 0:
+		return fmt.Errorf("max_errors must be positive, got %d", b.MaxErrors)
+	case b.MaxErrors == 0:
+		return errors.New("one of objective and max_errors must be set")
+	}
+	return nil
+}
+
+func (c *Config) Validate() error {
+	if c.ServiceAttribute == "" {
+		return errors.New("service_attribute must not be empty")
+	}
+	if c.Window <= 0 {
+		return errors.New("window must be positive")
+	}
+	if c.BurnRateWindow <= 0 || c.BurnRateWindow > c.Window {
+		return errors.New("burn_rate_window must be positive and not greater than window")
+	}
+	if c.MetricsFlushInterval <= 0 {
+		return errors.New("metrics_flush_interval must be positive")
+	}
+
+	settings := component.TelemetrySettings{Logger: zap.NewNop()}
+	if _, err := filterottl.NewBoolExprForSpan(c.Spans.Conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, settings); err != nil {
+		return fmt.Errorf("spans condition: %w", err)
+	}
+	if _, err := filterottl.NewBoolExprForLog(c.Logs.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, settings); err != nil {
+		return fmt.Errorf("logs condition: %w", err)
+	}
+
+	if len(c.Services) == 0 && c.DefaultBudget == nil {
+		return errors.New("at least one of services and default_budget must be set")
+	}
+	for name, budget := range c.Services {
+		if err := budget.validate(); err != nil {
+			return fmt.Errorf("service %q: %w", name, err)
+		}
+	}
+	if c.DefaultBudget != nil {
+		if err := c.DefaultBudget.validate(); err != nil {
+			return fmt.Errorf("default_budget: %w", err)
+		}
+	}
+	return nil
+}
+
+// budget returns the budget of the service, or false if the service has no budget.
+func (c *Config) budget(service string) (Budget, bool) {
+	if budget, ok := c.Services[service]; ok {
+		return budget, true
+	}
+	if c.DefaultBudget != nil {
+		return *c.DefaultBudget, true
+	}
+	return Budget{}, false
+}
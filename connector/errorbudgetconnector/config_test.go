@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package errorbudgetconnector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expect    *Config
+		expectErr string
+	}{
+		{
+			name:      "",
+			expectErr: "at least one of services and default_budget must be set",
+		},
+		{
+			name: "custom",
+			expect: &Config{
+				ServiceAttribute:     "service.namespace",
+				Window:               168 * time.Hour,
+				BurnRateWindow:       30 * time.Minute,
+				MetricsFlushInterval: 30 * time.Second,
+				Spans: ErrorConditions{Conditions: []string{
+					`status.code == STATUS_CODE_ERROR and attributes["http.response.status_code"] >= 500`,
+				}},
+				Logs: ErrorConditions{Conditions: []string{"severity_number >= SEVERITY_NUMBER_FATAL"}},
+				Services: map[string]Budget{
+					"checkout": {Objective: 0.999},
+					"payments": {MaxErrors: 100},
+				},
+				DefaultBudget: &Budget{Objective: 0.99},
+			},
+		},
+		{
+			name:      "no_budget",
+			expectErr: "at least one of services and default_budget must be set",
+		},
+		{
+			name:      "both",
+			expectErr: `service "checkout": only one of objective and max_errors can be set`,
+		},
+		{
+			name:      "invalid_objective",
+			expectErr: "default_budget: objective must be between 0 and 1 exclusive, got 1.5",
+		},
+		{
+			name:      "invalid_burn_rate_window",
+			expectErr: "burn_rate_window must be positive and not greater than window",
+		},
+		{
+			name:      "invalid_condition",
+			expectErr: "spans condition:",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tc.name).String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tc.expectErr != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tc.expectErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tc.expect, cfg)
+		})
+	}
+}
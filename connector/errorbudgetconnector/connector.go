@@ -0,0 +1,300 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package errorbudgetconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector"
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+const (
+	scopeName = "otelcol/errorbudgetconnector"
+
+	metricRemaining          = "error_budget.remaining"
+	metricErrors             = "error_budget.errors"
+	metricEvents             = "error_budget.events"
+	metricExhaustionForecast = "error_budget.exhaustion_forecast"
+)
+
+// serviceState holds the counts of a service over the window and the burn rate window.
+type serviceState struct {
+	window   *slidingWindow
+	burnRate *slidingWindow
+}
+
+// errorBudget tracks the errors of the services against their budgets, and periodically emits
+// the remaining budgets as metrics.
+type errorBudget struct {
+	config          *Config
+	logger          *zap.Logger
+	metricsConsumer consumer.Metrics
+
+	spanCondition expr.BoolExpr[ottlspan.TransformContext]
+	logCondition  expr.BoolExpr[ottllog.TransformContext]
+
+	mu       sync.Mutex
+	services map[string]*serviceState
+
+	now      func() time.Time
+	shutdown chan struct{}
+	done     chan struct{}
+}
+
+func (c *errorBudget) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: false}
+}
+
+func (c *errorBudget) Start(context.Context, component.Host) error {
+	c.shutdown = make(chan struct{})
+	c.done = make(chan struct{})
+	go c.flushLoop()
+	return nil
+}
+
+func (c *errorBudget) Shutdown(context.Context) error {
+	if c.shutdown == nil {
+		return nil
+	}
+	close(c.shutdown)
+	<-c.done
+	return nil
+}
+
+func (c *errorBudget) flushLoop() {
+	defer close(c.done)
+	ticker := time.NewTicker(c.config.MetricsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-ticker.C:
+			if err := c.flush(context.Background()); err != nil {
+				c.logger.Error("Failed to emit error budget metrics", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (c *errorBudget) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	var multiError error
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		resourceSpan := td.ResourceSpans().At(i)
+		service, ok := c.service(resourceSpan.Resource())
+		if !ok {
+			continue
+		}
+
+		var cnt counts
+		for j := 0; j < resourceSpan.ScopeSpans().Len(); j++ {
+			scopeSpan := resourceSpan.ScopeSpans().At(j)
+			for k := 0; k < scopeSpan.Spans().Len(); k++ {
+				sCtx := ottlspan.NewTransformContext(scopeSpan.Spans().At(k), scopeSpan.Scope(), resourceSpan.Resource())
+				isError, err := c.spanCondition.Eval(ctx, sCtx)
+				if err != nil {
+					multiError = errors.Join(multiError, err)
+					continue
+				}
+				cnt.events++
+				if isError {
+					cnt.errors++
+				}
+			}
+		}
+		c.record(service, cnt)
+	}
+	return multiError
+}
+
+func (c *errorBudget) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	var multiError error
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		resourceLog := ld.ResourceLogs().At(i)
+		service, ok := c.service(resourceLog.Resource())
+		if !ok {
+			continue
+		}
+
+		var cnt counts
+		for j := 0; j < resourceLog.ScopeLogs().Len(); j++ {
+			scopeLogs := resourceLog.ScopeLogs().At(j)
+			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
+				lCtx := ottllog.NewTransformContext(scopeLogs.LogRecords().At(k), scopeLogs.Scope(), resourceLog.Resource())
+				isError, err := c.logCondition.Eval(ctx, lCtx)
+				if err != nil {
+					multiError = errors.Join(multiError, err)
+					continue
+				}
+				cnt.events++
+				if isError {
+					cnt.errors++
+				}
+			}
+		}
+		c.record(service, cnt)
+	}
+	return multiError
+}
+
+// service returns the service of the resource, or false if it has no budget.
+func (c *errorBudget) service(resource pcommon.Resource) (string, bool) {
+	v, ok := resource.Attributes().Get(c.config.ServiceAttribute)
+	if !ok {
+		return "", false
+	}
+	service := v.AsString()
+	if _, ok := c.config.budget(service); !ok {
+		return "", false
+	}
+	return service, true
+}
+
+func (c *errorBudget) record(service string, cnt counts) {
+	if cnt.events == 0 {
+		return
+	}
+	now := c.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state, ok := c.services[service]
+	if !ok {
+		state = &serviceState{
+			window:   newSlidingWindow(c.config.Window),
+			burnRate: newSlidingWindow(c.config.BurnRateWindow),
+		}
+		c.services[service] = state
+	}
+	state.window.add(now, cnt)
+	state.burnRate.add(now, cnt)
+}
+
+// flush emits the budget metrics of the services.
+func (c *errorBudget) flush(ctx context.Context) error {
+	md := c.buildMetrics()
+	if md.ResourceMetrics().Len() == 0 {
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, md)
+}
+
+func (c *errorBudget) buildMetrics() pmetric.Metrics {
+	now := c.now()
+	timestamp := pcommon.NewTimestampFromTime(now)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The configured services are always reported, the others only while they have events in the window.
+	for service := range c.config.Services {
+		if _, ok := c.services[service]; !ok {
+			c.services[service] = &serviceState{
+				window:   newSlidingWindow(c.config.Window),
+				burnRate: newSlidingWindow(c.config.BurnRateWindow),
+			}
+		}
+	}
+	names := make([]string, 0, len(c.services))
+	for service, state := range c.services {
+		if _, configured := c.config.Services[service]; !configured && state.window.sum(now).events == 0 {
+			delete(c.services, service)
+			continue
+		}
+		names = append(names, service)
+	}
+	sort.Strings(names)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().EnsureCapacity(len(names))
+	for _, service := range names {
+		state := c.services[service]
+		budget, _ := c.config.budget(service)
+		status := computeStatus(budget, state.window.sum(now), state.burnRate.sum(now), c.config.BurnRateWindow)
+
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr(c.config.ServiceAttribute, service)
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.Scope().SetName(scopeName)
+		metrics := sm.Metrics()
+
+		appendGauge(metrics, metricRemaining, "The fraction of the error budget remaining in the window. Negative once the budget is exceeded.", "1", timestamp).SetDoubleValue(status.remaining)
+		appendGauge(metrics, metricErrors, "The number of errors in the window.", "{errors}", timestamp).SetIntValue(status.window.errors)
+		appendGauge(metrics, metricEvents, "The number of spans and log records in the window.", "{events}", timestamp).SetIntValue(status.window.events)
+		if status.hasForecast {
+			appendGauge(metrics, metricExhaustionForecast, "The estimated time until the error budget is exhausted at the current burn rate.", "s", timestamp).SetDoubleValue(status.exhaustionForecast.Seconds())
+		}
+	}
+	return md
+}
+
+func appendGauge(metrics pmetric.MetricSlice, name, description, unit string, timestamp pcommon.Timestamp) pmetric.NumberDataPoint {
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit(unit)
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(timestamp)
+	return dp
+}
+
+// budgetStatus is the state of the budget of a service.
+type budgetStatus struct {
+	window             counts
+	remaining          float64
+	hasForecast        bool
+	exhaustionForecast time.Duration
+}
+
+// computeStatus computes the remaining budget from the counts of the window, and forecasts its
+// exhaustion from the counts of the burn rate window.
+func computeStatus(budget Budget, window, burnRate counts, burnRateWindow time.Duration) budgetStatus {
+	status := budgetStatus{window: window}
+
+	seconds := burnRateWindow.Seconds()
+	errorRate := float64(burnRate.errors) / seconds
+	var allowed, netRate float64
+	if budget.MaxErrors > 0 {
+		allowed = float64(budget.MaxErrors)
+		netRate = errorRate
+	} else {
+		// The budget grows with the number of events, so it is only consumed by the errors exceeding the objective.
+		allowedRatio := 1 - budget.Objective
+		allowed = allowedRatio * float64(window.events)
+		netRate = errorRate - allowedRatio*float64(burnRate.events)/seconds
+	}
+
+	left := allowed - float64(window.errors)
+	switch {
+	case allowed > 0:
+		status.remaining = left / allowed
+	case window.errors > 0:
+		status.remaining = -1
+	default:
+		status.remaining = 1
+	}
+
+	switch {
+	case left <= 0 && window.errors > 0:
+		status.hasForecast = true
+	case netRate > 0:
+		status.hasForecast = true
+		status.exhaustionForecast = time.Duration(left / netRate * float64(time.Second))
+	}
+	return status
+}
@@ -0,0 +1,211 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package errorbudgetconnector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/connector/connectortest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTestConnector(t *testing.T, cfg *Config) (*sharedConnector, *consumertest.MetricsSink, *time.Time) {
+	require.NoError(t, cfg.Validate())
+	sink := new(consumertest.MetricsSink)
+	c := getOrCreateConnector(connectortest.NewNopCreateSettings(), cfg, sink)
+	t.Cleanup(func() { require.NoError(t, c.Shutdown(context.Background())) })
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	c.budget.now = func() time.Time { return now }
+	return c, sink, &now
+}
+
+func testTraces(service string, ok, failed int) ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", service)
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	for i := 0; i < ok; i++ {
+		spans.AppendEmpty().Status().SetCode(ptrace.StatusCodeOk)
+	}
+	for i := 0; i < failed; i++ {
+		spans.AppendEmpty().Status().SetCode(ptrace.StatusCodeError)
+	}
+	return td
+}
+
+func testLogs(service string, ok, failed int) plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", service)
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < ok; i++ {
+		records.AppendEmpty().SetSeverityNumber(plog.SeverityNumberInfo)
+	}
+	for i := 0; i < failed; i++ {
+		records.AppendEmpty().SetSeverityNumber(plog.SeverityNumberError)
+	}
+	return ld
+}
+
+// budgetMetrics returns the values of the metrics emitted for each service.
+func budgetMetrics(md pmetric.Metrics) map[string]map[string]float64 {
+	values := map[string]map[string]float64{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		service, _ := rm.Resource().Attributes().Get("service.name")
+		serviceValues := map[string]float64{}
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			dp := metrics.At(j).Gauge().DataPoints().At(0)
+			if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+				serviceValues[metrics.At(j).Name()] = float64(dp.IntValue())
+			} else {
+				serviceValues[metrics.At(j).Name()] = dp.DoubleValue()
+			}
+		}
+		values[service.Str()] = serviceValues
+	}
+	return values
+}
+
+func TestConnector(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Window = 10 * time.Hour
+	cfg.BurnRateWindow = time.Hour
+	cfg.Services = map[string]Budget{
+		"checkout": {Objective: 0.9},
+		"payments": {MaxErrors: 10},
+		"idle":     {MaxErrors: 10},
+	}
+	c, _, now := newTestConnector(t, cfg)
+	ctx := context.Background()
+
+	// 1000 events, of which 50 errors, over the window.
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("checkout", 450, 30)))
+	require.NoError(t, c.ConsumeLogs(ctx, testLogs("checkout", 500, 20)))
+	// Services without a budget are ignored.
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("unknown", 10, 10)))
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("payments", 0, 2)))
+
+	*now = now.Add(2 * time.Hour)
+	// Over the burn rate window, 200 events of which 40 errors: 20 more errors than allowed per hour.
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("checkout", 160, 40)))
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("payments", 0, 4)))
+
+	md := c.budget.buildMetrics()
+	values := budgetMetrics(md)
+	require.Len(t, values, 3)
+	assert.Equal(t, "otelcol/errorbudgetconnector", md.ResourceMetrics().At(0).ScopeMetrics().At(0).Scope().Name())
+
+	// 120 errors allowed out of 1200 events, 90 consumed, 30 left at 20 per hour.
+	checkout := values["checkout"]
+	assert.InDelta(t, 0.25, checkout[metricRemaining], 1e-9)
+	assert.Equal(t, 90.0, checkout[metricErrors])
+	assert.Equal(t, 1200.0, checkout[metricEvents])
+	assert.InDelta(t, (90 * time.Minute).Seconds(), checkout[metricExhaustionForecast], 1e-6)
+
+	// 4 errors left at 4 per hour.
+	payments := values["payments"]
+	assert.InDelta(t, 0.4, payments[metricRemaining], 1e-9)
+	assert.Equal(t, 6.0, payments[metricErrors])
+	assert.InDelta(t, time.Hour.Seconds(), payments[metricExhaustionForecast], 1e-6)
+
+	// Configured services are reported without events, but have no forecast.
+	assert.Equal(t, map[string]float64{metricRemaining: 1, metricErrors: 0, metricEvents: 0}, values["idle"])
+
+	// Exhausted budgets have a forecast of 0 and a negative remaining budget.
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("payments", 0, 9)))
+	payments = budgetMetrics(c.budget.buildMetrics())["payments"]
+	assert.InDelta(t, -0.5, payments[metricRemaining], 1e-9)
+	assert.Equal(t, 0.0, payments[metricExhaustionForecast])
+
+	// Events leave the window.
+	*now = now.Add(11 * time.Hour)
+	values = budgetMetrics(c.budget.buildMetrics())
+	assert.Equal(t, 1.0, values["payments"][metricRemaining])
+	assert.Equal(t, 0.0, values["payments"][metricEvents])
+	assert.NotContains(t, values["payments"], metricExhaustionForecast)
+}
+
+func TestConnectorDefaultBudget(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Window = time.Hour
+	cfg.BurnRateWindow = time.Hour
+	cfg.DefaultBudget = &Budget{Objective: 0.5}
+	c, sink, now := newTestConnector(t, cfg)
+	ctx := context.Background()
+
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("frontend", 9, 1)))
+	require.NoError(t, c.ConsumeTraces(ctx, testTraces("backend", 10, 0)))
+
+	require.NoError(t, c.budget.flush(ctx))
+	require.Len(t, sink.AllMetrics(), 1)
+	values := budgetMetrics(sink.AllMetrics()[0])
+	require.Len(t, values, 2)
+	// 5 errors allowed, 1 consumed, and the error rate is below the objective.
+	assert.InDelta(t, 0.8, values["frontend"][metricRemaining], 1e-9)
+	assert.NotContains(t, values["frontend"], metricExhaustionForecast)
+	assert.Equal(t, 1.0, values["backend"][metricRemaining])
+
+	// Services without events in the window are no longer reported.
+	*now = now.Add(2 * time.Hour)
+	require.NoError(t, c.budget.flush(ctx))
+	assert.Len(t, sink.AllMetrics(), 1)
+	assert.Empty(t, c.budget.services)
+}
+
+func TestConnectorShared(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.DefaultBudget = &Budget{MaxErrors: 10}
+	cfg.MetricsFlushInterval = 10 * time.Millisecond
+	sink := new(consumertest.MetricsSink)
+	factory := NewFactory()
+	set := connectortest.NewNopCreateSettings()
+
+	traces, err := factory.CreateTracesToMetrics(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+	logs, err := factory.CreateLogsToMetrics(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, traces.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, logs.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, traces.ConsumeTraces(context.Background(), testTraces("checkout", 0, 1)))
+	require.NoError(t, logs.ConsumeLogs(context.Background(), testLogs("checkout", 0, 1)))
+
+	assert.Eventually(t, func() bool {
+		for _, md := range sink.AllMetrics() {
+			if budgetMetrics(md)["checkout"][metricErrors] == 2 {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, traces.Shutdown(context.Background()))
+	require.NoError(t, logs.Shutdown(context.Background()))
+}
+
+func TestSlidingWindow(t *testing.T) {
+	w := newSlidingWindow(time.Hour)
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	w.add(start, counts{events: 2, errors: 1})
+	w.add(start.Add(30*time.Minute), counts{events: 3})
+	assert.Equal(t, counts{events: 5, errors: 1}, w.sum(start.Add(30*time.Minute)))
+	assert.Equal(t, counts{events: 3}, w.sum(start.Add(time.Hour)))
+	assert.Equal(t, counts{}, w.sum(start.Add(2*time.Hour)))
+
+	// Buckets are reused once they left the window.
+	w.add(start.Add(time.Hour), counts{events: 1, errors: 1})
+	assert.Equal(t, counts{events: 4, errors: 1}, w.sum(start.Add(time.Hour)))
+}
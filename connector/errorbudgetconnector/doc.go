@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package errorbudgetconnector implements a connector tracking the error budgets of services
+// from their spans and log records, and emitting the remaining budgets as metrics.
+package errorbudgetconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector"
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package errorbudgetconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// connectors shares a single connector between the traces and logs pipelines of a configuration,
+// so that the spans and log records of a service are counted against the same budget.
+var connectors = sharedcomponent.NewSharedComponents()
+
+// NewFactory returns a ConnectorFactory.
+func NewFactory() connector.Factory {
+	return connector.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		connector.WithTracesToMetrics(createTracesToMetrics, metadata.TracesToMetricsStability),
+		connector.WithLogsToMetrics(createLogsToMetrics, metadata.LogsToMetricsStability),
+	)
+}
+
+// createDefaultConfig creates the default configuration.
+func createDefaultConfig() component.Config {
+	return &Config{
+		ServiceAttribute:     defaultServiceAttribute,
+		Window:               defaultWindow,
+		BurnRateWindow:       defaultBurnRateWindow,
+		MetricsFlushInterval: defaultMetricsFlushInterval,
+		Spans:                ErrorConditions{Conditions: []string{defaultSpanErrorCondition}},
+		Logs:                 ErrorConditions{Conditions: []string{defaultLogErrorCondition}},
+	}
+}
+
+// sharedConnector is the connector shared by the traces and logs pipelines.
+type sharedConnector struct {
+	*sharedcomponent.SharedComponent
+	budget *errorBudget
+}
+
+func (s *sharedConnector) Capabilities() consumer.Capabilities {
+	return s.budget.Capabilities()
+}
+
+func (s *sharedConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	return s.budget.ConsumeTraces(ctx, td)
+}
+
+func (s *sharedConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	return s.budget.ConsumeLogs(ctx, ld)
+}
+
+func getOrCreateConnector(set connector.CreateSettings, cfg component.Config, nextConsumer consumer.Metrics) *sharedConnector {
+	shared := connectors.GetOrAdd(cfg, func() component.Component {
+		c := cfg.(*Config)
+		// Errors checked in Config.Validate()
+		spanCondition, _ := filterottl.NewBoolExprForSpan(c.Spans.Conditions, filterottl.StandardSpanFuncs(), ottl.PropagateError, set.TelemetrySettings)
+		logCondition, _ := filterottl.NewBoolExprForLog(c.Logs.Conditions, filterottl.StandardLogFuncs(), ottl.PropagateError, set.TelemetrySettings)
+		return &errorBudget{
+			config:          c,
+			logger:          set.Logger,
+			metricsConsumer: nextConsumer,
+			spanCondition:   spanCondition,
+			logCondition:    logCondition,
+			services:        map[string]*serviceState{},
+			now:             time.Now,
+		}
+	})
+	return &sharedConnector{SharedComponent: shared, budget: shared.Unwrap().(*errorBudget)}
+}
+
+// createTracesToMetrics creates a traces to metrics connector based on provided config.
+func createTracesToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Traces, error) {
+	return getOrCreateConnector(set, cfg, nextConsumer), nil
+}
+
+// createLogsToMetrics creates a logs to metrics connector based on provided config.
+func createLogsToMetrics(
+	_ context.Context,
+	set connector.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (connector.Logs, error) {
+	return getOrCreateConnector(set, cfg, nextConsumer), nil
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package errorbudgetconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/errorbudgetconnector"
+
+import "time"
+
+// windowBuckets is the number of buckets of a sliding window, which bounds its precision
+// to 1/windowBuckets of its duration.
+const windowBuckets = 60
+
+type counts struct {
+	events int64
+	errors int64
+}
+
+type bucket struct {
+	// index is the number of bucket durations between the Unix epoch and the start of the bucket.
+	index int64
+	counts
+}
+
+// slidingWindow counts the events and errors which occurred during the last duration.
+type slidingWindow struct {
+	width   time.Duration
+	buckets [windowBuckets]bucket
+}
+
+func newSlidingWindow(duration time.Duration) *slidingWindow {
+	width := duration / windowBuckets
+	if width <= 0 {
+		width = 1
+	}
+	return &slidingWindow{width: width}
+}
+
+func (w *slidingWindow) index(t time.Time) int64 {
+	return t.UnixNano() / int64(w.width)
+}
+
+func (w *slidingWindow) add(t time.Time, c counts) {
+	i := w.index(t)
+	b := &w.buckets[i%windowBuckets]
+	if b.index != i {
+		*b = bucket{index: i}
+	}
+	b.events += c.events
+	b.errors += c.errors
+}
+
+// sum returns the counts of the window ending at now.
+func (w *slidingWindow) sum(now time.Time) counts {
+	var total counts
+	last := w.index(now)
+	for _, b := range w.buckets {
+		if b.index > last-windowBuckets && b.index <= last {
+			total.events += b.events
+			total.errors += b.errors
+		}
+	}
+	return total
+}
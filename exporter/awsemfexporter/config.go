@@ -4,6 +4,8 @@
 package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/zap"
 
@@ -59,6 +61,9 @@ type Config struct {
 	// MetricDeclarations is the list of rules to be used to set dimensions for exported metrics.
 	MetricDeclarations []*MetricDeclaration `mapstructure:"metric_declarations"`
 
+	// NamespaceRules is the list of metric filters and dimension policies applied to the metrics of CloudWatch namespaces.
+	NamespaceRules []*NamespaceRule `mapstructure:"namespace_rules"`
+
 	// MetricDescriptors is the list of override metric descriptors that are sent to the CloudWatch
 	MetricDescriptors []MetricDescriptor `mapstructure:"metric_descriptors"`
 
@@ -116,6 +121,17 @@ func (config *Config) Validate() error {
 	}
 	config.MetricDeclarations = validDeclarations
 
+	namespaces := make(map[string]bool, len(config.NamespaceRules))
+	for _, rule := range config.NamespaceRules {
+		if err := rule.init(); err != nil {
+			return err
+		}
+		if namespaces[rule.Namespace] {
+			return fmt.Errorf("invalid namespace rule %q: duplicated namespace", rule.Namespace)
+		}
+		namespaces[rule.Namespace] = true
+	}
+
 	var validDescriptors []MetricDescriptor
 	for _, descriptor := range config.MetricDescriptors {
 		if descriptor.MetricName == "" {
@@ -137,6 +153,24 @@ func (config *Config) Validate() error {
 
 }
 
+// namespaceRule returns the rule of the given namespace, or nil if there is none.
+func (config *Config) namespaceRule(namespace string) *NamespaceRule {
+	for _, rule := range config.NamespaceRules {
+		if rule.Namespace == namespace {
+			return rule
+		}
+	}
+	return nil
+}
+
+// dimensionRollupOption returns the dimension rollup option of the given namespace.
+func (config *Config) dimensionRollupOption(namespace string) string {
+	if rule := config.namespaceRule(namespace); rule != nil && rule.DimensionRollupOption != "" {
+		return rule.DimensionRollupOption
+	}
+	return config.DimensionRollupOption
+}
+
 func newEMFSupportedUnits() map[string]any {
 	unitIndexer := map[string]any{}
 	for _, unit := range []string{"Seconds", "Microseconds", "Milliseconds", "Bytes", "Kilobytes", "Megabytes",
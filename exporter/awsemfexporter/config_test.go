@@ -147,6 +147,35 @@ func TestConfigValidate(t *testing.T) {
 	}, cfg.MetricDescriptors)
 }
 
+func TestNamespaceRulesConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "namespace_rules").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	require.Len(t, cfg.NamespaceRules, 1)
+	rule := cfg.NamespaceRules[0]
+	assert.Equal(t, "ECS/ContainerInsights", rule.Namespace)
+	assert.Equal(t, "NoDimensionRollup", rule.DimensionRollupOption)
+	assert.Equal(t, []string{"^container_"}, rule.IncludeMetrics)
+	assert.Equal(t, []string{"_bucket$"}, rule.ExcludeMetrics)
+	assert.Equal(t, 100, rule.MaxDimensionValues)
+	assert.Same(t, rule, cfg.namespaceRule("ECS/ContainerInsights"))
+	assert.Nil(t, cfg.namespaceRule("other"))
+	assert.Equal(t, "NoDimensionRollup", cfg.dimensionRollupOption("ECS/ContainerInsights"))
+	assert.Equal(t, "ZeroAndSingleDimensionRollup", cfg.dimensionRollupOption("other"))
+
+	cfg.NamespaceRules = append(cfg.NamespaceRules, &NamespaceRule{Namespace: "ECS/ContainerInsights"})
+	assert.EqualError(t, component.ValidateConfig(cfg), `invalid namespace rule "ECS/ContainerInsights": duplicated namespace`)
+
+	cfg.NamespaceRules = []*NamespaceRule{{Namespace: "ns", DimensionRollupOption: "All"}}
+	assert.EqualError(t, component.ValidateConfig(cfg), `invalid namespace rule "ns": unknown dimension rollup option "All"`)
+}
+
 func TestRetentionValidateCorrect(t *testing.T) {
 	cfg := &Config{
 		AWSSessionSettings: awsutil.AWSSessionSettings{
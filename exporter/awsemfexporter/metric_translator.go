@@ -25,6 +25,7 @@ const (
 	// DimensionRollupOptions
 	zeroAndSingleDimensionRollup = "ZeroAndSingleDimensionRollup"
 	singleDimensionRollupOnly    = "SingleDimensionRollupOnly"
+	noDimensionRollup            = "NoDimensionRollup"
 
 	prometheusReceiver        = "prometheus"
 	attributeReceiver         = "receiver"
@@ -118,6 +119,7 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 	cWNamespace := getNamespace(rm, config.Namespace)
 	logGroup, logStream, patternReplaceSucceeded := getLogInfo(rm, cWNamespace, config)
 	deltaInitialValue := config.RetainInitialValueOfDeltaMetric
+	namespaceRule := config.namespaceRule(cWNamespace)
 
 	ilms := rm.ScopeMetrics()
 	var metricReceiver string
@@ -133,6 +135,14 @@ func (mt metricTranslator) translateOTelToGroupedMetric(rm pmetric.ResourceMetri
 		metrics := ilm.Metrics()
 		for k := 0; k < metrics.Len(); k++ {
 			metric := metrics.At(k)
+			if namespaceRule != nil && !namespaceRule.MatchesName(metric.Name()) {
+				config.logger.Debug(
+					"Dropped metric: excluded by namespace rule",
+					zap.String("Namespace", cWNamespace),
+					zap.String("Metric name", metric.Name()),
+				)
+				continue
+			}
 			metadata := cWMetricMetadata{
 				groupedMetricMetadata: groupedMetricMetadata{
 					namespace:                  cWNamespace,
@@ -189,6 +199,11 @@ func translateGroupedMetricToCWMetric(groupedMetric *groupedMetric, config *Conf
 		cWMeasurements = groupedMetricToCWMeasurementsWithFilters(groupedMetric, config)
 	}
 
+	if namespaceRule := config.namespaceRule(groupedMetric.metadata.namespace); namespaceRule != nil {
+		// Drop the dimension sets which would create CloudWatch metrics beyond the cardinality cap of the namespace
+		cWMeasurements = namespaceRule.capDimensions(cWMeasurements, labels, config.logger)
+	}
+
 	return &cWMetrics{
 		measurements: cWMeasurements,
 		timestampMs:  groupedMetric.metadata.timestampMs,
@@ -199,7 +214,7 @@ func translateGroupedMetricToCWMetric(groupedMetric *groupedMetric, config *Conf
 // groupedMetricToCWMeasurement creates a single CW Measurement from a grouped metric.
 func groupedMetricToCWMeasurement(groupedMetric *groupedMetric, config *Config) cWMeasurement {
 	labels := groupedMetric.labels
-	dimensionRollupOption := config.DimensionRollupOption
+	dimensionRollupOption := config.dimensionRollupOption(groupedMetric.metadata.namespace)
 
 	// Create a dimension set containing list of label names
 	dimSet := make([]string, len(labels))
@@ -321,7 +336,7 @@ func groupedMetricToCWMeasurementsWithFilters(groupedMetric *groupedMetric, conf
 	}
 
 	// Apply single/zero dimension rollup to labels
-	rollupDimensionArray := dimensionRollup(config.DimensionRollupOption, labels)
+	rollupDimensionArray := dimensionRollup(config.dimensionRollupOption(groupedMetric.metadata.namespace), labels)
 
 	// Translate each group into a CW Measurement
 	cWMeasurements = make([]cWMeasurement, 0, len(metricDeclGroups))
@@ -2375,6 +2375,55 @@ func TestTranslateOtToGroupedMetricForInitialDeltaValue(t *testing.T) {
 	}
 }
 
+func TestTranslateWithNamespaceRule(t *testing.T) {
+	rule := &NamespaceRule{
+		Namespace:             "ns",
+		DimensionRollupOption: singleDimensionRollupOnly,
+		IncludeMetrics:        []string{"^metric_"},
+		ExcludeMetrics:        []string{"_2$"},
+		MaxDimensionValues:    1,
+	}
+	require.NoError(t, rule.init())
+	config := &Config{
+		Namespace:             "ns",
+		DimensionRollupOption: zeroAndSingleDimensionRollup,
+		NamespaceRules:        []*NamespaceRule{rule},
+		logger:                zap.NewNop(),
+	}
+	translator := newMetricTranslator(*config)
+
+	translate := func(host string) *cWMetrics {
+		md := generateTestMetrics(testMetric{
+			metricNames:  []string{"metric_1", "metric_2", "other"},
+			metricValues: [][]float64{{100}, {4}, {1}},
+			attributeMap: map[string]any{"host": host},
+		})
+		groupedMetrics := make(map[any]*groupedMetric)
+		require.NoError(t, translator.translateOTelToGroupedMetric(md.ResourceMetrics().At(0), groupedMetrics, config))
+		require.Len(t, groupedMetrics, 1)
+		for _, grouped := range groupedMetrics {
+			return translateGroupedMetricToCWMetric(grouped, config)
+		}
+		return nil
+	}
+
+	// Only the included metrics which are not excluded are exported, with the dimension rollup of the namespace
+	cWMetric := translate("host1")
+	assert.Equal(t, []cWMeasurement{
+		{
+			Namespace:  "ns",
+			Dimensions: [][]string{{"host"}},
+			Metrics:    []map[string]string{{"Name": "metric_1"}},
+		},
+	}, cWMetric.measurements)
+	assert.Equal(t, map[string]any{"host": "host1", "metric_1": float64(100)}, cWMetric.fields)
+
+	// Dimension sets with values beyond the cap are dropped, the values are still in the fields
+	cWMetric = translate("host2")
+	assert.Empty(t, cWMetric.measurements)
+	assert.Equal(t, map[string]any{"host": "host2", "metric_1": float64(100)}, cWMetric.fields)
+}
+
 func generateTestMetrics(tm testMetric) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
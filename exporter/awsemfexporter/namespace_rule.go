@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsemfexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awsemfexporter"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// NamespaceRule characterizes the metric filters and dimension policies applied to the
+// metrics exported to a CloudWatch namespace.
+type NamespaceRule struct {
+	// Namespace is the CloudWatch namespace the rule applies to.
+	Namespace string `mapstructure:"namespace"`
+	// (Optional) DimensionRollupOption overrides the dimension rollup option of the exporter
+	// for the metrics of the namespace.
+	DimensionRollupOption string `mapstructure:"dimension_rollup_option"`
+	// (Optional) IncludeMetrics is a list of regex strings to be matched against metric names.
+	// If set, only the matching metrics are exported to the namespace.
+	IncludeMetrics []string `mapstructure:"include_metrics"`
+	// (Optional) ExcludeMetrics is a list of regex strings to be matched against metric names.
+	// The matching metrics are not exported to the namespace, even if included.
+	ExcludeMetrics []string `mapstructure:"exclude_metrics"`
+	// (Optional) MaxDimensionValues is the maximum number of distinct values of each dimension
+	// of the namespace. Once a dimension reached it, the dimension sets of the metrics with a
+	// new value of the dimension are dropped, so that no new CloudWatch metric is created.
+	// The values are still sent in the log events. 0 means no limit.
+	MaxDimensionValues int `mapstructure:"max_dimension_values"`
+
+	includeRegexList []*regexp.Regexp
+	excludeRegexList []*regexp.Regexp
+	dimensionValues  *dimensionValueTracker
+}
+
+// init validates the NamespaceRule struct and compiles regex strings.
+func (r *NamespaceRule) init() (err error) {
+	if r.Namespace == "" {
+		return errors.New("invalid namespace rule: no namespace defined")
+	}
+	switch r.DimensionRollupOption {
+	case "", zeroAndSingleDimensionRollup, singleDimensionRollupOnly, noDimensionRollup:
+	default:
+		return fmt.Errorf("invalid namespace rule %q: unknown dimension rollup option %q", r.Namespace, r.DimensionRollupOption)
+	}
+	if r.MaxDimensionValues < 0 {
+		return fmt.Errorf("invalid namespace rule %q: max_dimension_values must not be negative", r.Namespace)
+	}
+
+	if r.includeRegexList, err = compileRegexList(r.IncludeMetrics); err != nil {
+		return fmt.Errorf("invalid namespace rule %q: %w", r.Namespace, err)
+	}
+	if r.excludeRegexList, err = compileRegexList(r.ExcludeMetrics); err != nil {
+		return fmt.Errorf("invalid namespace rule %q: %w", r.Namespace, err)
+	}
+
+	if r.MaxDimensionValues > 0 {
+		r.dimensionValues = newDimensionValueTracker(r.MaxDimensionValues)
+	}
+	return nil
+}
+
+func compileRegexList(selectors []string) ([]*regexp.Regexp, error) {
+	regexList := make([]*regexp.Regexp, len(selectors))
+	for i, selector := range selectors {
+		regex, err := regexp.Compile(selector)
+		if err != nil {
+			return nil, err
+		}
+		regexList[i] = regex
+	}
+	return regexList, nil
+}
+
+// MatchesName returns true if the metric with the given name is to be exported to the namespace.
+func (r *NamespaceRule) MatchesName(metricName string) bool {
+	if len(r.includeRegexList) > 0 && !matchesAny(r.includeRegexList, metricName) {
+		return false
+	}
+	return !matchesAny(r.excludeRegexList, metricName)
+}
+
+func matchesAny(regexList []*regexp.Regexp, s string) bool {
+	for _, regex := range regexList {
+		if regex.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// capDimensions drops the dimension sets containing a dimension which reached the maximum number of
+// distinct values, and the measurements left without dimension sets.
+func (r *NamespaceRule) capDimensions(measurements []cWMeasurement, labels map[string]string, logger *zap.Logger) []cWMeasurement {
+	if r.dimensionValues == nil {
+		return measurements
+	}
+
+	capped := measurements[:0]
+	for _, measurement := range measurements {
+		dimensions := make([][]string, 0, len(measurement.Dimensions))
+		for _, dimSet := range measurement.Dimensions {
+			if dim, ok := r.dimensionValues.admit(dimSet, labels); !ok {
+				logger.Debug(
+					"Dropped dimension set: dimension reached the maximum number of values",
+					zap.String("Namespace", r.Namespace),
+					zap.String("Dimension", dim),
+					zap.Strings("Dimensions", dimSet),
+				)
+				continue
+			}
+			dimensions = append(dimensions, dimSet)
+		}
+		if len(dimensions) > 0 {
+			measurement.Dimensions = dimensions
+			capped = append(capped, measurement)
+		}
+	}
+	return capped
+}
+
+// dimensionValueTracker keeps track of the distinct values of the dimensions of a namespace, up to a maximum
+// number of values per dimension.
+type dimensionValueTracker struct {
+	sync.Mutex
+	maxValues int
+	values    map[string]map[string]struct{}
+}
+
+func newDimensionValueTracker(maxValues int) *dimensionValueTracker {
+	return &dimensionValueTracker{
+		maxValues: maxValues,
+		values:    map[string]map[string]struct{}{},
+	}
+}
+
+// admit records the values of the dimensions of the dimension set, and returns false and the first dimension
+// which reached the maximum number of values if one of the values is new and cannot be recorded.
+func (t *dimensionValueTracker) admit(dimSet []string, labels map[string]string) (string, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, dim := range dimSet {
+		values, ok := t.values[dim]
+		if !ok {
+			values = map[string]struct{}{}
+			t.values[dim] = values
+		}
+		value := labels[dim]
+		if _, ok := values[value]; ok {
+			continue
+		}
+		if len(values) >= t.maxValues {
+			return dim, false
+		}
+	}
+	// Only record the values once all of them are admitted, so that dropped dimension sets don't use up room.
+	for _, dim := range dimSet {
+		t.values[dim][labels[dim]] = struct{}{}
+	}
+	return "", true
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsemfexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNamespaceRuleInit(t *testing.T) {
+	testCases := []struct {
+		name   string
+		rule   *NamespaceRule
+		errMsg string
+	}{
+		{
+			name: "Valid rule",
+			rule: &NamespaceRule{
+				Namespace:             "ns",
+				DimensionRollupOption: noDimensionRollup,
+				IncludeMetrics:        []string{"^a"},
+				ExcludeMetrics:        []string{"b$"},
+				MaxDimensionValues:    10,
+			},
+		},
+		{
+			name:   "No namespace",
+			rule:   &NamespaceRule{},
+			errMsg: "invalid namespace rule: no namespace defined",
+		},
+		{
+			name:   "Unknown dimension rollup option",
+			rule:   &NamespaceRule{Namespace: "ns", DimensionRollupOption: "AllDimensions"},
+			errMsg: `invalid namespace rule "ns": unknown dimension rollup option "AllDimensions"`,
+		},
+		{
+			name:   "Negative max dimension values",
+			rule:   &NamespaceRule{Namespace: "ns", MaxDimensionValues: -1},
+			errMsg: `invalid namespace rule "ns": max_dimension_values must not be negative`,
+		},
+		{
+			name:   "Invalid include regex",
+			rule:   &NamespaceRule{Namespace: "ns", IncludeMetrics: []string{"("}},
+			errMsg: `invalid namespace rule "ns": error parsing regexp: missing closing ): ` + "`(`",
+		},
+		{
+			name:   "Invalid exclude regex",
+			rule:   &NamespaceRule{Namespace: "ns", ExcludeMetrics: []string{"["}},
+			errMsg: `invalid namespace rule "ns": error parsing regexp: missing closing ]: ` + "`[`",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.init()
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNamespaceRuleMatchesName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     *NamespaceRule
+		expected map[string]bool
+	}{
+		{
+			name:     "No filters",
+			rule:     &NamespaceRule{Namespace: "ns"},
+			expected: map[string]bool{"a": true, "b": true},
+		},
+		{
+			name:     "Include",
+			rule:     &NamespaceRule{Namespace: "ns", IncludeMetrics: []string{"^http_", "^grpc_"}},
+			expected: map[string]bool{"http_requests": true, "grpc_calls": true, "db_queries": false},
+		},
+		{
+			name:     "Exclude",
+			rule:     &NamespaceRule{Namespace: "ns", ExcludeMetrics: []string{"_bucket$"}},
+			expected: map[string]bool{"http_requests": true, "http_latency_bucket": false},
+		},
+		{
+			name: "Exclude takes precedence over include",
+			rule: &NamespaceRule{
+				Namespace:      "ns",
+				IncludeMetrics: []string{"^http_"},
+				ExcludeMetrics: []string{"_bucket$"},
+			},
+			expected: map[string]bool{"http_requests": true, "http_latency_bucket": false, "db_queries": false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.rule.init())
+			for metricName, expected := range tc.expected {
+				assert.Equal(t, expected, tc.rule.MatchesName(metricName), metricName)
+			}
+		})
+	}
+}
+
+func TestNamespaceRuleCapDimensions(t *testing.T) {
+	rule := &NamespaceRule{Namespace: "ns", MaxDimensionValues: 2}
+	require.NoError(t, rule.init())
+
+	measurements := func() []cWMeasurement {
+		return []cWMeasurement{
+			{
+				Namespace:  "ns",
+				Dimensions: [][]string{{"host", "service"}, {"service"}, {}},
+				Metrics:    []map[string]string{{"Name": "requests"}},
+			},
+			{
+				Namespace:  "ns",
+				Dimensions: [][]string{{"host"}},
+				Metrics:    []map[string]string{{"Name": "errors"}},
+			},
+		}
+	}
+
+	// Values of the dimensions are admitted up to the cap
+	for _, host := range []string{"host1", "host2", "host1"} {
+		capped := rule.capDimensions(measurements(), map[string]string{"host": host, "service": "api"}, zap.NewNop())
+		assert.Equal(t, measurements(), capped)
+	}
+
+	// Dimension sets with a new host are dropped, as well as measurements without dimension sets left
+	capped := rule.capDimensions(measurements(), map[string]string{"host": "host3", "service": "api"}, zap.NewNop())
+	assert.Equal(t, []cWMeasurement{
+		{
+			Namespace:  "ns",
+			Dimensions: [][]string{{"service"}, {}},
+			Metrics:    []map[string]string{{"Name": "requests"}},
+		},
+	}, capped)
+
+	// Values of dropped dimension sets were not recorded
+	capped = rule.capDimensions(measurements(), map[string]string{"host": "host1", "service": "web"}, zap.NewNop())
+	assert.Equal(t, measurements(), capped)
+	capped = rule.capDimensions(measurements(), map[string]string{"host": "host2", "service": "db"}, zap.NewNop())
+	assert.Equal(t, [][]string{{}}, capped[0].Dimensions)
+
+	// Rules without cap don't change measurements
+	uncapped := &NamespaceRule{Namespace: "ns"}
+	require.NoError(t, uncapped.init())
+	assert.Equal(t, measurements(), uncapped.capDimensions(measurements(), map[string]string{"host": "host3"}, zap.NewNop()))
+}
@@ -5,7 +5,10 @@ package awss3exporter // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 
+	"github.com/aws/aws-sdk-go/service/s3"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.uber.org/multierr"
@@ -24,6 +27,66 @@ type S3UploaderConfig struct {
 	S3ForcePathStyle bool                   `mapstructure:"s3_force_path_style"`
 	DisableSSL       bool                   `mapstructure:"disable_ssl"`
 	Compression      configcompression.Type `mapstructure:"compression"`
+	// ServerSideEncryption configures how S3 encrypts the uploaded objects.
+	ServerSideEncryption ServerSideEncryptionConfig `mapstructure:"server_side_encryption"`
+	// StorageClass is the S3 storage class of the uploaded objects, e.g. INTELLIGENT_TIERING.
+	// The bucket default applies when empty.
+	StorageClass string `mapstructure:"storage_class"`
+	// ObjectTags are the tags set on the uploaded objects.
+	ObjectTags []ObjectTagConfig `mapstructure:"object_tags"`
+}
+
+// ServerSideEncryptionConfig contains the server-side encryption settings of the uploaded objects.
+type ServerSideEncryptionConfig struct {
+	// Type is the server-side encryption algorithm: AES256, aws:kms or aws:kms:dsse.
+	// The bucket default applies when empty.
+	Type string `mapstructure:"type"`
+	// KMSKeyID is the ID or ARN of the KMS key used with the aws:kms and aws:kms:dsse types.
+	// The AWS managed key applies when empty.
+	KMSKeyID string `mapstructure:"kms_key_id"`
+	// BucketKeyEnabled enables S3 Bucket Keys, reducing the calls to KMS.
+	BucketKeyEnabled bool `mapstructure:"bucket_key_enabled"`
+}
+
+// ObjectTagConfig defines a tag set on the uploaded objects, either with a static value
+// or with the value of a resource attribute.
+type ObjectTagConfig struct {
+	Key                   string `mapstructure:"key"`
+	Value                 string `mapstructure:"value"`
+	FromResourceAttribute string `mapstructure:"from_resource_attribute"`
+}
+
+// maxObjectTags is the maximum number of tags of an S3 object.
+const maxObjectTags = 10
+
+func (c S3UploaderConfig) validate() error {
+	var errs error
+	sse := c.ServerSideEncryption
+	if sse.Type != "" && !slices.Contains(s3.ServerSideEncryption_Values(), sse.Type) {
+		errs = multierr.Append(errs, fmt.Errorf("unknown server side encryption type %q", sse.Type))
+	}
+	if sse.KMSKeyID != "" && sse.Type != s3.ServerSideEncryptionAwsKms && sse.Type != s3.ServerSideEncryptionAwsKmsDsse {
+		errs = multierr.Append(errs, errors.New("kms_key_id requires server side encryption type aws:kms or aws:kms:dsse"))
+	}
+	if c.StorageClass != "" && !slices.Contains(s3.StorageClass_Values(), c.StorageClass) {
+		errs = multierr.Append(errs, fmt.Errorf("unknown storage class %q", c.StorageClass))
+	}
+	if len(c.ObjectTags) > maxObjectTags {
+		errs = multierr.Append(errs, fmt.Errorf("at most %d object tags are supported", maxObjectTags))
+	}
+	keys := map[string]bool{}
+	for _, tag := range c.ObjectTags {
+		switch {
+		case tag.Key == "":
+			errs = multierr.Append(errs, errors.New("object tag key is required"))
+		case keys[tag.Key]:
+			errs = multierr.Append(errs, fmt.Errorf("duplicate object tag %q", tag.Key))
+		case (tag.Value == "") == (tag.FromResourceAttribute == ""):
+			errs = multierr.Append(errs, fmt.Errorf("object tag %q requires exactly one of value or from_resource_attribute", tag.Key))
+		}
+		keys[tag.Key] = true
+	}
+	return errs
 }
 
 type MarshalerType string
@@ -63,5 +126,6 @@ func (c *Config) Validate() error {
 			errs = multierr.Append(errs, errors.New("marshaler does not support compression"))
 		}
 	}
+	errs = multierr.Append(errs, c.S3Uploader.validate())
 	return errs
 }
@@ -102,6 +102,42 @@ func TestConfigForS3CompatibleSystems(t *testing.T) {
 	)
 }
 
+func TestEncryptionStorageClassAndTags(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Exporters[factory.Type()] = factory
+	cfg, err := otelcoltest.LoadConfigAndValidate(
+		filepath.Join("testdata", "encryption.yaml"), factories)
+
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	e := cfg.Exporters[component.MustNewID("awss3")].(*Config)
+
+	assert.Equal(t, e,
+		&Config{
+			S3Uploader: S3UploaderConfig{
+				Region:       "us-east-1",
+				S3Bucket:     "foo",
+				S3Partition:  "minute",
+				StorageClass: "INTELLIGENT_TIERING",
+				ServerSideEncryption: ServerSideEncryptionConfig{
+					Type:             "aws:kms",
+					KMSKeyID:         "arn:aws:kms:us-east-1:123456789012:key/archive",
+					BucketKeyEnabled: true,
+				},
+				ObjectTags: []ObjectTagConfig{
+					{Key: "environment", FromResourceAttribute: "deployment.environment"},
+					{Key: "retention", Value: "7y"},
+				},
+			},
+			MarshalerName: "otlp_json",
+		},
+	)
+}
+
 func TestConfig_Validate(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -150,6 +186,45 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			errExpected: errors.New("region is required"),
 		},
+		{
+			name: "unknown encryption and storage class",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.ServerSideEncryption.Type = "aws:foo"
+				c.S3Uploader.StorageClass = "COLD"
+				return c
+			}(),
+			errExpected: multierr.Combine(errors.New(`unknown server side encryption type "aws:foo"`),
+				errors.New(`unknown storage class "COLD"`)),
+		},
+		{
+			name: "kms key without kms encryption",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.ServerSideEncryption = ServerSideEncryptionConfig{Type: "AES256", KMSKeyID: "key"}
+				return c
+			}(),
+			errExpected: errors.New("kms_key_id requires server side encryption type aws:kms or aws:kms:dsse"),
+		},
+		{
+			name: "invalid object tags",
+			config: func() *Config {
+				c := createDefaultConfig().(*Config)
+				c.S3Uploader.S3Bucket = "foo"
+				c.S3Uploader.ObjectTags = []ObjectTagConfig{
+					{Value: "foo"},
+					{Key: "env", Value: "prod", FromResourceAttribute: "deployment.environment"},
+					{Key: "team", Value: "a"},
+					{Key: "team", Value: "b"},
+				}
+				return c
+			}(),
+			errExpected: multierr.Combine(errors.New("object tag key is required"),
+				errors.New(`object tag "env" requires exactly one of value or from_resource_attribute`),
+				errors.New(`duplicate object tag "team"`)),
+		},
 	}
 
 	for _, tt := range tests {
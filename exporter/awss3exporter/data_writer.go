@@ -3,8 +3,11 @@
 
 package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
 
-import "context"
+import (
+	"context"
+	"net/url"
+)
 
 type dataWriter interface {
-	writeBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string) error
+	writeBuffer(ctx context.Context, buf []byte, config *Config, metadata string, format string, tags url.Values) error
 }
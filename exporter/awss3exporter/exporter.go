@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -57,30 +58,40 @@ func (e *s3Exporter) Capabilities() consumer.Capabilities {
 }
 
 func (e *s3Exporter) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
-	buf, err := e.marshaler.MarshalMetrics(md)
-
-	if err != nil {
-		return err
+	var errs error
+	for _, group := range splitMetricsByTags(e.config.S3Uploader.ObjectTags, md) {
+		buf, err := e.marshaler.MarshalMetrics(group.data)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		errs = multierr.Append(errs, e.dataWriter.writeBuffer(ctx, buf, e.config, "metrics", e.marshaler.format(), group.tags))
 	}
-
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "metrics", e.marshaler.format())
+	return errs
 }
 
 func (e *s3Exporter) ConsumeLogs(ctx context.Context, logs plog.Logs) error {
-	buf, err := e.marshaler.MarshalLogs(logs)
-
-	if err != nil {
-		return err
+	var errs error
+	for _, group := range splitLogsByTags(e.config.S3Uploader.ObjectTags, logs) {
+		buf, err := e.marshaler.MarshalLogs(group.data)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		errs = multierr.Append(errs, e.dataWriter.writeBuffer(ctx, buf, e.config, "logs", e.marshaler.format(), group.tags))
 	}
-
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "logs", e.marshaler.format())
+	return errs
 }
 
 func (e *s3Exporter) ConsumeTraces(ctx context.Context, traces ptrace.Traces) error {
-	buf, err := e.marshaler.MarshalTraces(traces)
-	if err != nil {
-		return err
+	var errs error
+	for _, group := range splitTracesByTags(e.config.S3Uploader.ObjectTags, traces) {
+		buf, err := e.marshaler.MarshalTraces(group.data)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		errs = multierr.Append(errs, e.dataWriter.writeBuffer(ctx, buf, e.config, "traces", e.marshaler.format(), group.tags))
 	}
-
-	return e.dataWriter.writeBuffer(ctx, buf, e.config, "traces", e.marshaler.format())
+	return errs
 }
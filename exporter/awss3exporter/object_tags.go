@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/awss3exporter"
+
+import (
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// maxObjectTagValueLength is the maximum length of the value of an S3 object tag.
+const maxObjectTagValueLength = 256
+
+// taggedData is the part of a batch written to a single object, with the tags of that object.
+type taggedData[T any] struct {
+	tags url.Values
+	data T
+}
+
+// resourceTags returns the object tags of the data of a resource. Tags from missing resource
+// attributes are omitted.
+func resourceTags(tagConfigs []ObjectTagConfig, resource pcommon.Resource) url.Values {
+	tags := url.Values{}
+	for _, tag := range tagConfigs {
+		value := tag.Value
+		if tag.FromResourceAttribute != "" {
+			attr, ok := resource.Attributes().Get(tag.FromResourceAttribute)
+			if !ok {
+				continue
+			}
+			value = attr.AsString()
+		}
+		tags.Set(tag.Key, sanitizeTagValue(value))
+	}
+	return tags
+}
+
+// sanitizeTagValue replaces the characters S3 doesn't accept in tag values and truncates long values.
+func sanitizeTagValue(value string) string {
+	value = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune(" +-=._:/@", r):
+			return r
+		}
+		return '_'
+	}, value)
+	if len(value) > maxObjectTagValueLength {
+		value = value[:maxObjectTagValueLength]
+	}
+	return value
+}
+
+// splitByTags groups the resources of a batch by object tags, so that every object only holds
+// data matching its tags. copyResource appends the i-th resource of the batch to the given group.
+func splitByTags[T any](
+	tagConfigs []ObjectTagConfig,
+	batch T,
+	numResources int,
+	resource func(i int) pcommon.Resource,
+	newGroup func() T,
+	copyResource func(i int, group T),
+) []taggedData[T] {
+	if numResources == 0 {
+		return []taggedData[T]{{tags: resourceTags(tagConfigs, pcommon.NewResource()), data: batch}}
+	}
+
+	resourcesTags := make([]url.Values, numResources)
+	encoded := make([]string, numResources)
+	single := true
+	for i := 0; i < numResources; i++ {
+		resourcesTags[i] = resourceTags(tagConfigs, resource(i))
+		encoded[i] = resourcesTags[i].Encode()
+		single = single && encoded[i] == encoded[0]
+	}
+	if single {
+		return []taggedData[T]{{tags: resourcesTags[0], data: batch}}
+	}
+
+	var groups []taggedData[T]
+	indexes := map[string]int{}
+	for i := 0; i < numResources; i++ {
+		index, ok := indexes[encoded[i]]
+		if !ok {
+			index = len(groups)
+			indexes[encoded[i]] = index
+			groups = append(groups, taggedData[T]{tags: resourcesTags[i], data: newGroup()})
+		}
+		copyResource(i, groups[index].data)
+	}
+	return groups
+}
+
+func splitLogsByTags(tagConfigs []ObjectTagConfig, logs plog.Logs) []taggedData[plog.Logs] {
+	rls := logs.ResourceLogs()
+	return splitByTags(tagConfigs, logs, rls.Len(),
+		func(i int) pcommon.Resource { return rls.At(i).Resource() },
+		plog.NewLogs,
+		func(i int, group plog.Logs) { rls.At(i).CopyTo(group.ResourceLogs().AppendEmpty()) })
+}
+
+func splitMetricsByTags(tagConfigs []ObjectTagConfig, metrics pmetric.Metrics) []taggedData[pmetric.Metrics] {
+	rms := metrics.ResourceMetrics()
+	return splitByTags(tagConfigs, metrics, rms.Len(),
+		func(i int) pcommon.Resource { return rms.At(i).Resource() },
+		pmetric.NewMetrics,
+		func(i int, group pmetric.Metrics) { rms.At(i).CopyTo(group.ResourceMetrics().AppendEmpty()) })
+}
+
+func splitTracesByTags(tagConfigs []ObjectTagConfig, traces ptrace.Traces) []taggedData[ptrace.Traces] {
+	rss := traces.ResourceSpans()
+	return splitByTags(tagConfigs, traces, rss.Len(),
+		func(i int) pcommon.Resource { return rss.At(i).Resource() },
+		ptrace.NewTraces,
+		func(i int, group ptrace.Traces) { rss.At(i).CopyTo(group.ResourceSpans().AppendEmpty()) })
+}
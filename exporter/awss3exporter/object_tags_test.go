@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awss3exporter
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var testObjectTags = []ObjectTagConfig{
+	{Key: "environment", FromResourceAttribute: "deployment.environment"},
+	{Key: "retention", Value: "7y"},
+}
+
+func TestSplitLogsByTags(t *testing.T) {
+	logs := plog.NewLogs()
+	for _, env := range []string{"prod", "dev", "prod", ""} {
+		rl := logs.ResourceLogs().AppendEmpty()
+		if env != "" {
+			rl.Resource().Attributes().PutStr("deployment.environment", env)
+		}
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(env)
+	}
+
+	groups := splitLogsByTags(testObjectTags, logs)
+	require.Len(t, groups, 3)
+	assert.Equal(t, url.Values{"environment": {"prod"}, "retention": {"7y"}}, groups[0].tags)
+	assert.Equal(t, 2, groups[0].data.ResourceLogs().Len())
+	assert.Equal(t, url.Values{"environment": {"dev"}, "retention": {"7y"}}, groups[1].tags)
+	assert.Equal(t, 1, groups[1].data.ResourceLogs().Len())
+	assert.Equal(t, url.Values{"retention": {"7y"}}, groups[2].tags)
+	assert.Equal(t, 1, groups[2].data.ResourceLogs().Len())
+}
+
+func TestSplitByTagsSingleGroup(t *testing.T) {
+	metrics := pmetric.NewMetrics()
+	metrics.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("deployment.environment", "prod")
+	metrics.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("deployment.environment", "prod")
+	groups := splitMetricsByTags(testObjectTags, metrics)
+	require.Len(t, groups, 1)
+	assert.Equal(t, metrics, groups[0].data)
+
+	traces := ptrace.NewTraces()
+	groups2 := splitTracesByTags(nil, traces)
+	require.Len(t, groups2, 1)
+	assert.Empty(t, groups2[0].tags)
+	assert.Equal(t, traces, groups2[0].data)
+}
+
+func TestSanitizeTagValue(t *testing.T) {
+	assert.Equal(t, "my-service_v1.2 +=:/@", sanitizeTagValue("my-service,v1.2 +=:/@"))
+	assert.Equal(t, "_", sanitizeTagValue("é"))
+	assert.Len(t, sanitizeTagValue(strings.Repeat("a", 300)), maxObjectTagValueLength)
+}
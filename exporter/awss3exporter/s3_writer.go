@@ -8,7 +8,9 @@ import (
 	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"math/rand"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -84,7 +86,34 @@ func getSession(config *Config, sessionConfig *aws.Config) (*session.Session, er
 	return sess, err
 }
 
-func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Config, metadata string, format string) error {
+func getUploadInput(config *Config, key string, body io.Reader, encoding string, tags url.Values) *s3manager.UploadInput {
+	input := &s3manager.UploadInput{
+		Bucket:          aws.String(config.S3Uploader.S3Bucket),
+		Key:             aws.String(key),
+		Body:            body,
+		ContentEncoding: &encoding,
+	}
+
+	sse := config.S3Uploader.ServerSideEncryption
+	if sse.Type != "" {
+		input.ServerSideEncryption = aws.String(sse.Type)
+	}
+	if sse.KMSKeyID != "" {
+		input.SSEKMSKeyId = aws.String(sse.KMSKeyID)
+	}
+	if sse.BucketKeyEnabled {
+		input.BucketKeyEnabled = aws.Bool(true)
+	}
+	if config.S3Uploader.StorageClass != "" {
+		input.StorageClass = aws.String(config.S3Uploader.StorageClass)
+	}
+	if len(tags) > 0 {
+		input.Tagging = aws.String(tags.Encode())
+	}
+	return input
+}
+
+func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Config, metadata string, format string, tags url.Values) error {
 	now := time.Now()
 	key := getS3Key(now,
 		config.S3Uploader.S3Prefix, config.S3Uploader.S3Partition,
@@ -120,12 +149,7 @@ func (s3writer *s3Writer) writeBuffer(_ context.Context, buf []byte, config *Con
 
 	uploader := s3manager.NewUploader(sess)
 
-	_, err = uploader.Upload(&s3manager.UploadInput{
-		Bucket:          aws.String(config.S3Uploader.S3Bucket),
-		Key:             aws.String(key),
-		Body:            reader,
-		ContentEncoding: &encoding,
-	})
+	_, err = uploader.Upload(getUploadInput(config, key, reader, encoding, tags))
 	if err != nil {
 		return err
 	}
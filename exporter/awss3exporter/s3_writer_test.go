@@ -4,6 +4,7 @@
 package awss3exporter
 
 import (
+	"net/url"
 	"regexp"
 	"testing"
 	"time"
@@ -146,3 +147,31 @@ func TestGetSessionConfigWithoutRoleArn(t *testing.T) {
 	assert.Equal(t, sessionConfig.Region, aws.String(region))
 	assert.NotEqual(t, creds.ProviderName, "AssumeRoleProvider")
 }
+
+func TestGetUploadInput(t *testing.T) {
+	config := createDefaultConfig().(*Config)
+	config.S3Uploader.S3Bucket = "foo"
+
+	input := getUploadInput(config, "key", nil, "", nil)
+	assert.Equal(t, "foo", *input.Bucket)
+	assert.Equal(t, "key", *input.Key)
+	assert.Nil(t, input.ServerSideEncryption)
+	assert.Nil(t, input.SSEKMSKeyId)
+	assert.Nil(t, input.BucketKeyEnabled)
+	assert.Nil(t, input.StorageClass)
+	assert.Nil(t, input.Tagging)
+
+	config.S3Uploader.ServerSideEncryption = ServerSideEncryptionConfig{
+		Type:             "aws:kms",
+		KMSKeyID:         "arn:aws:kms:us-east-1:123456789012:key/archive",
+		BucketKeyEnabled: true,
+	}
+	config.S3Uploader.StorageClass = "INTELLIGENT_TIERING"
+	input = getUploadInput(config, "key", nil, "gzip", url.Values{"retention": {"7y"}, "environment": {"prod"}})
+	assert.Equal(t, "aws:kms", *input.ServerSideEncryption)
+	assert.Equal(t, "arn:aws:kms:us-east-1:123456789012:key/archive", *input.SSEKMSKeyId)
+	assert.True(t, *input.BucketKeyEnabled)
+	assert.Equal(t, "INTELLIGENT_TIERING", *input.StorageClass)
+	assert.Equal(t, "environment=prod&retention=7y", *input.Tagging)
+	assert.Equal(t, "gzip", *input.ContentEncoding)
+}
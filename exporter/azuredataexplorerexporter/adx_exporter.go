@@ -164,14 +164,21 @@ func newExporter(config *Config, logger *zap.Logger, telemetryDataType int, vers
 	if refOption := getMappingRef(config, telemetryDataType); refOption != nil {
 		ingestOptions = append(ingestOptions, refOption)
 	}
-	// The exporter could be configured to run in either modes. Using managedstreaming or batched queueing
-	if strings.ToLower(config.IngestionType) == managedIngestType {
+	// The exporter could be configured to run in any of the modes. Using managedstreaming, streaming or batched queueing
+	switch strings.ToLower(config.IngestionType) {
+	case managedIngestType:
 		mi, err := createManagedStreamingIngestor(config, metricClient, tableName)
 		if err != nil {
 			return nil, err
 		}
 		ingestor = mi
-	} else {
+	case streamingIngestType:
+		si, err := createStreamingIngestor(config, metricClient, tableName)
+		if err != nil {
+			return nil, err
+		}
+		ingestor = si
+	default:
 		qi, err := createQueuedIngestor(config, metricClient, tableName)
 		if err != nil {
 			return nil, err
@@ -214,8 +221,15 @@ func createKcsb(config *Config, version string) *kusto.ConnectionStringBuilder {
 	var kcsb *kusto.ConnectionStringBuilder
 	isManagedIdentity := len(strings.TrimSpace(config.ManagedIdentityID)) > 0
 	isSystemManagedIdentity := strings.EqualFold(strings.TrimSpace(config.ManagedIdentityID), "SYSTEM")
-	// If the user has managed identity done, use it. For System managed identity use the MI as system
+	// If the user has managed identity done, use it. For System managed identity use the MI as system.
+	// Workload identity and the default Azure credential chain take precedence when enabled
 	switch {
+	case config.UseWorkloadIdentity:
+		// The client and tenant IDs default to the AZURE_CLIENT_ID and AZURE_TENANT_ID environment variables,
+		// and the token file to AZURE_FEDERATED_TOKEN_FILE, as set by the workload identity webhook
+		kcsb = kusto.NewConnectionStringBuilder(config.ClusterURI).WithKubernetesWorkloadIdentity(config.ApplicationID, "", config.TenantID)
+	case config.UseAzureAuth:
+		kcsb = kusto.NewConnectionStringBuilder(config.ClusterURI).WithDefaultAzureCredential()
 	case !isManagedIdentity:
 		kcsb = kusto.NewConnectionStringBuilder(config.ClusterURI).WithAadAppKey(config.ApplicationID, string(config.ApplicationKey), config.TenantID)
 	case isManagedIdentity && isSystemManagedIdentity:
@@ -233,6 +247,12 @@ func createManagedStreamingIngestor(config *Config, adxclient *kusto.Client, tab
 	return ingestor, err
 }
 
+// A streaming ingestor, for the tables with the streaming ingestion policy enabled
+func createStreamingIngestor(config *Config, adxclient *kusto.Client, tablename string) (*ingest.Streaming, error) {
+	ingestor, err := ingest.NewStreaming(adxclient, config.Database, tablename)
+	return ingestor, err
+}
+
 // A queued ingestor in case that is provided as the config option
 func createQueuedIngestor(config *Config, adxclient *kusto.Client, tablename string) (*ingest.Ingestion, error) {
 	ingestor, err := ingest.New(adxclient, config.Database, tablename)
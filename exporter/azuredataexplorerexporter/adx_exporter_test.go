@@ -55,6 +55,13 @@ func TestNewExporter(t *testing.T) {
 	fexp, err := newExporter(&c, logger, 5, component.NewDefaultBuildInfo().Version)
 	assert.Error(t, err)
 	assert.Nil(t, fexp)
+
+	c.IngestionType = streamingIngestType
+	sexp, err := newExporter(&c, logger, metricsType, component.NewDefaultBuildInfo().Version)
+	assert.NoError(t, err)
+	assert.NotNil(t, sexp)
+	assert.IsType(t, &ingest.Streaming{}, sexp.ingestor)
+	assert.NoError(t, sexp.Close(context.Background()))
 }
 
 func TestMetricsDataPusherStreaming(t *testing.T) {
@@ -178,6 +185,8 @@ func TestCreateKcsb(t *testing.T) {
 		name              string // name of the test
 		config            Config // config for the test
 		isMsi             bool   // is MSI enabled
+		isWorkload        bool   // is workload identity enabled
+		isDefaultAuth     bool   // is the default Azure credential enabled
 		applicationID     string // application id
 		managedIdentityID string // managed identity id
 	}{
@@ -216,6 +225,27 @@ func TestCreateKcsb(t *testing.T) {
 			managedIdentityID: "636d798f-b005-41c9-9809-81a5e5a12b2e",
 			applicationID:     "",
 		},
+		{
+			name: "workload identity",
+			config: Config{
+				ClusterURI:          "https://CLUSTER.kusto.windows.net",
+				Database:            "tests",
+				ApplicationID:       "an-application-id",
+				TenantID:            "tenant",
+				UseWorkloadIdentity: true,
+			},
+			isWorkload:    true,
+			applicationID: "an-application-id",
+		},
+		{
+			name: "azure auth",
+			config: Config{
+				ClusterURI:   "https://CLUSTER.kusto.windows.net",
+				Database:     "tests",
+				UseAzureAuth: true,
+			},
+			isDefaultAuth: true,
+		},
 	}
 	for i := range tests {
 		tt := tests[i]
@@ -228,6 +258,8 @@ func TestCreateKcsb(t *testing.T) {
 			assert.Equal(t, wantIsMsi, gotKcsb.MsiAuthentication)
 			wantManagedID := tt.managedIdentityID
 			assert.Equal(t, wantManagedID, gotKcsb.ManagedServiceIdentity)
+			assert.Equal(t, tt.isWorkload, gotKcsb.WorkloadAuthentication)
+			assert.Equal(t, tt.isDefaultAuth, gotKcsb.DefaultAuth)
 			assert.Equal(t, "https://CLUSTER.kusto.windows.net", gotKcsb.DataSource)
 		})
 	}
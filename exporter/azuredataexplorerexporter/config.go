@@ -24,6 +24,8 @@ type Config struct {
 	ApplicationKey                 configopaque.String `mapstructure:"application_key"`
 	TenantID                       string              `mapstructure:"tenant_id"`
 	ManagedIdentityID              string              `mapstructure:"managed_identity_id"`
+	UseAzureAuth                   bool                `mapstructure:"use_azure_auth"`
+	UseWorkloadIdentity            bool                `mapstructure:"use_workload_identity"`
 	Database                       string              `mapstructure:"db_name"`
 	MetricTable                    string              `mapstructure:"metrics_table_name"`
 	LogTable                       string              `mapstructure:"logs_table_name"`
@@ -41,18 +43,28 @@ func (adxCfg *Config) Validate() error {
 	}
 	isAppAuthEmpty := isEmpty(adxCfg.ApplicationID) || isEmpty(string(adxCfg.ApplicationKey)) || isEmpty(adxCfg.TenantID)
 	isManagedAuthEmpty := isEmpty(adxCfg.ManagedIdentityID)
+	identityAuthCount := 0
+	for _, isSet := range []bool{!isManagedAuthEmpty, adxCfg.UseAzureAuth, adxCfg.UseWorkloadIdentity} {
+		if isSet {
+			identityAuthCount++
+		}
+	}
 	isClusterURIEmpty := isEmpty(adxCfg.ClusterURI)
 	// Cluster URI is the target ADX cluster
 	if isClusterURIEmpty {
 		return errors.New(`clusterURI config is mandatory`)
 	}
-	// Parameters for AD App Auth or Managed Identity Auth are mandatory
-	if isAppAuthEmpty && isManagedAuthEmpty {
-		return errors.New(`either ["application_id" , "application_key" , "tenant_id"] or ["managed_identity_id"] are needed for auth`)
+	// Parameters for AD App Auth, Managed Identity, Azure Auth or Workload Identity Auth are mandatory
+	if isAppAuthEmpty && identityAuthCount == 0 {
+		return errors.New(`either ["application_id" , "application_key" , "tenant_id"] or ["managed_identity_id"] or ["use_azure_auth"] or ["use_workload_identity"] are needed for auth`)
+	}
+	// The identity based auth methods are exclusive
+	if identityAuthCount > 1 {
+		return errors.New(`only one of ["managed_identity_id"], ["use_azure_auth"] and ["use_workload_identity"] can be set for auth`)
 	}
 
-	if !(adxCfg.IngestionType == managedIngestType || adxCfg.IngestionType == queuedIngestTest || isEmpty(adxCfg.IngestionType)) {
-		return fmt.Errorf("unsupported configuration for ingestion_type. Accepted types [%s, %s] Provided [%s]", managedIngestType, queuedIngestTest, adxCfg.IngestionType)
+	if !(adxCfg.IngestionType == managedIngestType || adxCfg.IngestionType == queuedIngestTest || adxCfg.IngestionType == streamingIngestType || isEmpty(adxCfg.IngestionType)) {
+		return fmt.Errorf("unsupported configuration for ingestion_type. Accepted types [%s, %s, %s] Provided [%s]", managedIngestType, queuedIngestTest, streamingIngestType, adxCfg.IngestionType)
 	}
 	// Validate managed identity ID. Use system for system assigned managed identity or UserManagedIdentityID (objectID) for user assigned managed identity
 	if !isEmpty(adxCfg.ManagedIdentityID) && !strings.EqualFold(strings.TrimSpace(adxCfg.ManagedIdentityID), "SYSTEM") {
@@ -45,11 +45,11 @@ func TestLoadConfig(t *testing.T) {
 		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "2"),
-			errorMessage: `either ["application_id" , "application_key" , "tenant_id"] or ["managed_identity_id"] are needed for auth`,
+			errorMessage: `either ["application_id" , "application_key" , "tenant_id"] or ["managed_identity_id"] or ["use_azure_auth"] or ["use_workload_identity"] are needed for auth`,
 		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "3"),
-			errorMessage: `unsupported configuration for ingestion_type. Accepted types [managed, queued] Provided [streaming]`,
+			errorMessage: `unsupported configuration for ingestion_type. Accepted types [managed, queued, streaming] Provided [batched]`,
 		},
 		{
 			id: component.NewIDWithName(metadata.Type, "4"),
@@ -111,6 +111,36 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "9"),
+			expected: &Config{
+				ClusterURI:          "https://CLUSTER.kusto.windows.net",
+				ApplicationID:       "f80da32c-108c-415c-a19e-643f461a677a",
+				TenantID:            "21ff9e36-fbaa-43c8-98ba-00431ea10bc3",
+				UseWorkloadIdentity: true,
+				Database:            "oteldb",
+				MetricTable:         "OTELMetrics",
+				LogTable:            "OTELLogs",
+				TraceTable:          "OTELTraces",
+				IngestionType:       streamingIngestType,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "10"),
+			expected: &Config{
+				ClusterURI:    "https://CLUSTER.kusto.windows.net",
+				UseAzureAuth:  true,
+				Database:      "oteldb",
+				MetricTable:   "OTELMetrics",
+				LogTable:      "OTELLogs",
+				TraceTable:    "OTELTraces",
+				IngestionType: queuedIngestTest,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "11"),
+			errorMessage: `only one of ["managed_identity_id"], ["use_azure_auth"] and ["use_workload_identity"] can be set for auth`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -17,15 +17,16 @@ import (
 
 const (
 	// The value of "type" key in configuration.
-	managedIngestType  = "managed"
-	queuedIngestTest   = "queued"
-	otelDb             = "oteldb"
-	defaultMetricTable = "OTELMetrics"
-	defaultLogTable    = "OTELLogs"
-	defaultTraceTable  = "OTELTraces"
-	metricsType        = 1
-	logsType           = 2
-	tracesType         = 3
+	managedIngestType   = "managed"
+	queuedIngestTest    = "queued"
+	streamingIngestType = "streaming"
+	otelDb              = "oteldb"
+	defaultMetricTable  = "OTELMetrics"
+	defaultLogTable     = "OTELLogs"
+	defaultTraceTable   = "OTELTraces"
+	metricsType         = 1
+	logsType            = 2
+	tracesType          = 3
 )
 
 // Creates a factory for the ADX Exporter
@@ -4,12 +4,20 @@
 package azuremonitorexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter"
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 )
 
+// maxCustomMetricsDimensions is the maximum number of dimensions accepted by the
+// Azure Monitor custom metrics API for a single metric.
+const maxCustomMetricsDimensions = 10
+
 // Config defines configuration for Azure Monitor
 type Config struct {
 	exporterhelper.QueueSettings `mapstructure:"sending_queue"`
@@ -19,4 +27,80 @@ type Config struct {
 	MaxBatchSize                 int                 `mapstructure:"maxbatchsize"`
 	MaxBatchInterval             time.Duration       `mapstructure:"maxbatchinterval"`
 	SpanEventsEnabled            bool                `mapstructure:"spaneventsenabled"`
+	CustomMetrics                CustomMetricsConfig `mapstructure:"custom_metrics"`
+}
+
+// CustomMetricsConfig defines configuration for publishing metrics to the
+// Azure Monitor custom metrics ingestion API instead of Application Insights.
+type CustomMetricsConfig struct {
+	confighttp.ClientConfig `mapstructure:",squash"`
+
+	// Enabled switches the metrics pipeline to the custom metrics API.
+	Enabled bool `mapstructure:"enabled"`
+	// Region is the Azure region of the resource, used to derive the regional
+	// ingestion endpoint when no endpoint is set (e.g. "westeurope").
+	Region string `mapstructure:"region"`
+	// ResourceID is the Azure resource ID the metrics are published against.
+	ResourceID string `mapstructure:"resource_id"`
+	// Namespace is the custom metrics namespace.
+	Namespace string `mapstructure:"namespace"`
+	// Dimensions maps datapoint or resource attributes to metric dimensions.
+	Dimensions []DimensionMapping `mapstructure:"dimensions"`
+}
+
+// DimensionMapping maps an attribute to a custom metrics dimension.
+type DimensionMapping struct {
+	// Attribute is the datapoint attribute, or resource attribute as a fallback, to read.
+	Attribute string `mapstructure:"attribute"`
+	// Name is the dimension name. Defaults to the attribute name.
+	Name string `mapstructure:"name"`
+}
+
+// Validate checks if the exporter configuration is valid
+func (cfg *Config) Validate() error {
+	if !cfg.CustomMetrics.Enabled {
+		return nil
+	}
+	cm := cfg.CustomMetrics
+	if cm.ResourceID == "" {
+		return errors.New("custom_metrics: resource_id must be specified")
+	}
+	if !strings.HasPrefix(cm.ResourceID, "/subscriptions/") {
+		return fmt.Errorf("custom_metrics: resource_id %q must start with \"/subscriptions/\"", cm.ResourceID)
+	}
+	if cm.Region == "" && cm.Endpoint == "" {
+		return errors.New("custom_metrics: one of region or endpoint must be specified")
+	}
+	if len(cm.Dimensions) > maxCustomMetricsDimensions {
+		return fmt.Errorf("custom_metrics: at most %d dimensions are supported, got %d", maxCustomMetricsDimensions, len(cm.Dimensions))
+	}
+	names := make(map[string]struct{}, len(cm.Dimensions))
+	for _, d := range cm.Dimensions {
+		if d.Attribute == "" {
+			return errors.New("custom_metrics: dimension attribute must be specified")
+		}
+		name := d.dimensionName()
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("custom_metrics: duplicate dimension name %q", name)
+		}
+		names[name] = struct{}{}
+	}
+	return nil
+}
+
+func (d DimensionMapping) dimensionName() string {
+	if d.Name != "" {
+		return d.Name
+	}
+	return d.Attribute
+}
+
+// metricsURL returns the ingestion URL for the configured resource.
+func (cm CustomMetricsConfig) metricsURL() string {
+	endpoint := cm.Endpoint
+	if endpoint == "" {
+		region := strings.ToLower(strings.ReplaceAll(cm.Region, " ", ""))
+		endpoint = fmt.Sprintf("https://%s.monitoring.azure.com", region)
+	}
+	return strings.TrimSuffix(endpoint, "/") + "/" + strings.TrimPrefix(cm.ResourceID, "/") + "/metrics"
 }
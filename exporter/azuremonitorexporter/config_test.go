@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
@@ -49,8 +50,27 @@ func TestLoadConfig(t *testing.T) {
 					NumConsumers: 10,
 					StorageID:    &disk,
 				},
+				CustomMetrics: CustomMetricsConfig{
+					ClientConfig: confighttp.NewDefaultClientConfig(),
+					Namespace:    defaultCustomMetricsNamespace,
+				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom_metrics"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.CustomMetrics.Enabled = true
+				cfg.CustomMetrics.Region = "westeurope"
+				cfg.CustomMetrics.ResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm"
+				cfg.CustomMetrics.Namespace = "collector"
+				cfg.CustomMetrics.Dimensions = []DimensionMapping{
+					{Attribute: "host.name", Name: "Host"},
+					{Attribute: "http.route"},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
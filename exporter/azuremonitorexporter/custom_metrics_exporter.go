@@ -0,0 +1,329 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/azuremonitorexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/microsoft/ApplicationInsights-Go/appinsights/contracts"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+// customMetric is the payload accepted by the Azure Monitor custom metrics API.
+// See https://learn.microsoft.com/azure/azure-monitor/essentials/metrics-store-custom-rest-api
+type customMetric struct {
+	Time string           `json:"time"`
+	Data customMetricData `json:"data"`
+
+	// source is the metric the payload is built from.
+	source metricSource
+}
+
+// metricSource locates a metric by its indexes in the pmetric.Metrics.
+type metricSource struct {
+	resource int
+	scope    int
+	metric   int
+}
+
+type customMetricData struct {
+	BaseData customMetricBaseData `json:"baseData"`
+}
+
+type customMetricBaseData struct {
+	Metric    string               `json:"metric"`
+	Namespace string               `json:"namespace"`
+	DimNames  []string             `json:"dimNames,omitempty"`
+	Series    []customMetricSeries `json:"series"`
+}
+
+type customMetricSeries struct {
+	DimValues []string `json:"dimValues,omitempty"`
+	Min       float64  `json:"min"`
+	Max       float64  `json:"max"`
+	Sum       float64  `json:"sum"`
+	Count     int      `json:"count"`
+}
+
+// maxCustomMetricsBodySize is the size above which the payloads are split in several requests.
+const maxCustomMetricsBodySize = 1 << 20
+
+type customMetricsExporter struct {
+	config   *Config
+	settings component.TelemetrySettings
+	logger   *zap.Logger
+	packer   *metricPacker
+	url      string
+	dimNames []string
+	client   *http.Client
+}
+
+func (exporter *customMetricsExporter) start(ctx context.Context, host component.Host) error {
+	client, err := exporter.config.CustomMetrics.ToClient(ctx, host, exporter.settings)
+	if err != nil {
+		return err
+	}
+	exporter.client = client
+	return nil
+}
+
+// onMetricData sends the payloads of all the metrics in as few requests as possible. When some
+// requests fail with a retryable error, only the datapoints of their payloads are returned to be
+// retried, so that the payloads already accepted are not sent again.
+func (exporter *customMetricsExporter) onMetricData(ctx context.Context, metricData pmetric.Metrics) error {
+	requests, err := toRequests(exporter.toCustomMetrics(metricData))
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+
+	var errs, retryErrs error
+	var failed []*customMetric
+	for _, request := range requests {
+		err = exporter.send(ctx, request.body)
+		switch {
+		case err == nil:
+		case consumererror.IsPermanent(err):
+			errs = errors.Join(errs, err)
+		default:
+			retryErrs = errors.Join(retryErrs, err)
+			failed = append(failed, request.payloads...)
+		}
+	}
+	if retryErrs == nil {
+		return errs
+	}
+	if errs != nil {
+		exporter.logger.Error("Dropping custom metrics rejected by the API", zap.Error(errs))
+	}
+	return consumererror.NewMetrics(retryErrs, retryMetrics(metricData, failed))
+}
+
+// customMetricsRequest is a request body along with the payloads it encodes.
+type customMetricsRequest struct {
+	body     []byte
+	payloads []*customMetric
+}
+
+// toRequests encodes the payloads as newline-delimited JSON, in bodies of up to
+// maxCustomMetricsBodySize bytes unless a single payload is larger.
+func toRequests(payloads []*customMetric) ([]customMetricsRequest, error) {
+	var requests []customMetricsRequest
+	var request customMetricsRequest
+	for _, payload := range payloads {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		if len(request.body) > 0 && len(request.body)+len(encoded)+1 > maxCustomMetricsBodySize {
+			requests = append(requests, request)
+			request = customMetricsRequest{}
+		}
+		if len(request.body) > 0 {
+			request.body = append(request.body, '\n')
+		}
+		request.body = append(request.body, encoded...)
+		request.payloads = append(request.payloads, payload)
+	}
+	if len(request.body) > 0 {
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// retryMetrics copies from metricData the metrics of the payloads, keeping only the datapoints
+// sent in these payloads.
+func retryMetrics(metricData pmetric.Metrics, payloads []*customMetric) pmetric.Metrics {
+	var sources []metricSource
+	times := make(map[metricSource]map[string]bool)
+	for _, payload := range payloads {
+		if times[payload.source] == nil {
+			times[payload.source] = make(map[string]bool)
+			sources = append(sources, payload.source)
+		}
+		times[payload.source][payload.Time] = true
+	}
+
+	retry := pmetric.NewMetrics()
+	var resourceMetrics pmetric.ResourceMetrics
+	var scopeMetrics pmetric.ScopeMetrics
+	last := metricSource{resource: -1, scope: -1}
+	for _, source := range sources {
+		srcResourceMetrics := metricData.ResourceMetrics().At(source.resource)
+		if source.resource != last.resource {
+			resourceMetrics = retry.ResourceMetrics().AppendEmpty()
+			srcResourceMetrics.Resource().CopyTo(resourceMetrics.Resource())
+			resourceMetrics.SetSchemaUrl(srcResourceMetrics.SchemaUrl())
+			last.scope = -1
+		}
+		srcScopeMetrics := srcResourceMetrics.ScopeMetrics().At(source.scope)
+		if source.scope != last.scope {
+			scopeMetrics = resourceMetrics.ScopeMetrics().AppendEmpty()
+			srcScopeMetrics.Scope().CopyTo(scopeMetrics.Scope())
+			scopeMetrics.SetSchemaUrl(srcScopeMetrics.SchemaUrl())
+		}
+		last = source
+
+		metric := scopeMetrics.Metrics().AppendEmpty()
+		srcScopeMetrics.Metrics().At(source.metric).CopyTo(metric)
+		payloadTimes := times[source]
+		removeDataPointsIf(metric, func(ts pcommon.Timestamp) bool {
+			return !payloadTimes[customMetricTime(ts)]
+		})
+	}
+	return retry
+}
+
+// removeDataPointsIf removes the datapoints of the metric whose timestamp matches f.
+func removeDataPointsIf(metric pmetric.Metric, f func(pcommon.Timestamp) bool) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		metric.Gauge().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool { return f(dp.Timestamp()) })
+	case pmetric.MetricTypeSum:
+		metric.Sum().DataPoints().RemoveIf(func(dp pmetric.NumberDataPoint) bool { return f(dp.Timestamp()) })
+	case pmetric.MetricTypeHistogram:
+		metric.Histogram().DataPoints().RemoveIf(func(dp pmetric.HistogramDataPoint) bool { return f(dp.Timestamp()) })
+	case pmetric.MetricTypeExponentialHistogram:
+		metric.ExponentialHistogram().DataPoints().RemoveIf(func(dp pmetric.ExponentialHistogramDataPoint) bool { return f(dp.Timestamp()) })
+	case pmetric.MetricTypeSummary:
+		metric.Summary().DataPoints().RemoveIf(func(dp pmetric.SummaryDataPoint) bool { return f(dp.Timestamp()) })
+	}
+}
+
+// customMetricTime formats the timestamp with the precision of the custom metrics API.
+func customMetricTime(ts pcommon.Timestamp) string {
+	return toTime(ts).UTC().Format(time.RFC3339)
+}
+
+// toCustomMetrics groups the datapoints of every metric by timestamp, one payload per metric and time.
+func (exporter *customMetricsExporter) toCustomMetrics(metricData pmetric.Metrics) []*customMetric {
+	var payloads []*customMetric
+	resourceMetrics := metricData.ResourceMetrics()
+	for i := 0; i < resourceMetrics.Len(); i++ {
+		resource := resourceMetrics.At(i).Resource()
+		scopeMetrics := resourceMetrics.At(i).ScopeMetrics()
+		for j := 0; j < scopeMetrics.Len(); j++ {
+			metrics := scopeMetrics.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				mtd := exporter.packer.getMetricTimedData(metrics.At(k))
+				if mtd == nil {
+					continue
+				}
+				byTime := make(map[string]*customMetric)
+				for _, timedDataPoint := range mtd.getTimedDataPoints() {
+					ts := customMetricTime(timedDataPoint.timestamp)
+					payload, ok := byTime[ts]
+					if !ok {
+						payload = &customMetric{
+							Time: ts,
+							Data: customMetricData{BaseData: customMetricBaseData{
+								Metric:    metrics.At(k).Name(),
+								Namespace: exporter.config.CustomMetrics.Namespace,
+								DimNames:  exporter.dimNames,
+							}},
+							source: metricSource{resource: i, scope: j, metric: k},
+						}
+						byTime[ts] = payload
+						payloads = append(payloads, payload)
+					}
+					payload.Data.BaseData.Series = append(payload.Data.BaseData.Series,
+						exporter.toSeries(timedDataPoint, resource.Attributes()))
+				}
+			}
+		}
+	}
+	return payloads
+}
+
+func (exporter *customMetricsExporter) toSeries(timedDataPoint *timedMetricDataPoint, resourceAttributes pcommon.Map) customMetricSeries {
+	dataPoint := timedDataPoint.dataPoint
+	series := customMetricSeries{
+		Sum:   dataPoint.Value,
+		Count: dataPoint.Count,
+		Min:   dataPoint.Min,
+		Max:   dataPoint.Max,
+	}
+	// Measurements carry a single value, and aggregations without min/max (e.g. summaries) fall back to the mean.
+	if dataPoint.Kind == contracts.Measurement {
+		series.Min, series.Max = dataPoint.Value, dataPoint.Value
+	} else if dataPoint.Min == 0 && dataPoint.Max == 0 && dataPoint.Count > 0 {
+		mean := dataPoint.Value / float64(dataPoint.Count)
+		series.Min, series.Max = mean, mean
+	}
+
+	if len(exporter.dimNames) > 0 {
+		series.DimValues = make([]string, len(exporter.config.CustomMetrics.Dimensions))
+		for i, d := range exporter.config.CustomMetrics.Dimensions {
+			if v, ok := timedDataPoint.attributes.Get(d.Attribute); ok {
+				series.DimValues[i] = v.AsString()
+			} else if v, ok := resourceAttributes.Get(d.Attribute); ok {
+				series.DimValues[i] = v.AsString()
+			}
+		}
+	}
+	return series
+}
+
+func (exporter *customMetricsExporter) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, exporter.url, bytes.NewReader(body))
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := exporter.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		exporter.logger.Debug("Custom metrics are sent", zap.Int("bytes", len(body)))
+		return nil
+	}
+
+	err = fmt.Errorf("custom metrics request failed with status %d: %s", resp.StatusCode, respBody)
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return consumererror.NewPermanent(err)
+}
+
+// Returns a new instance of the metric exporter publishing to the custom metrics API
+func newCustomMetricsExporter(config *Config, set exporter.CreateSettings) (exporter.Metrics, error) {
+	dimNames := make([]string, 0, len(config.CustomMetrics.Dimensions))
+	for _, d := range config.CustomMetrics.Dimensions {
+		dimNames = append(dimNames, d.dimensionName())
+	}
+
+	exporter := &customMetricsExporter{
+		config:   config,
+		settings: set.TelemetrySettings,
+		logger:   set.Logger,
+		packer:   newMetricPacker(set.Logger),
+		url:      config.CustomMetrics.metricsURL(),
+		dimNames: dimNames,
+	}
+
+	return exporterhelper.NewMetricsExporter(
+		context.TODO(),
+		set,
+		config,
+		exporter.onMetricData,
+		exporterhelper.WithStart(exporter.start),
+		exporterhelper.WithQueue(config.QueueSettings))
+}
@@ -0,0 +1,331 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuremonitorexporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+const testResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm"
+
+func TestCustomMetricsURL(t *testing.T) {
+	cm := CustomMetricsConfig{Region: "West Europe", ResourceID: testResourceID}
+	assert.Equal(t, "https://westeurope.monitoring.azure.com"+testResourceID+"/metrics", cm.metricsURL())
+
+	cm.Endpoint = "https://localhost:8080/"
+	assert.Equal(t, "https://localhost:8080"+testResourceID+"/metrics", cm.metricsURL())
+}
+
+func TestCustomMetricsConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*CustomMetricsConfig)
+		err    string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:   "missing resource id",
+			mutate: func(cm *CustomMetricsConfig) { cm.ResourceID = "" },
+			err:    "custom_metrics: resource_id must be specified",
+		},
+		{
+			name:   "malformed resource id",
+			mutate: func(cm *CustomMetricsConfig) { cm.ResourceID = "vm" },
+			err:    `custom_metrics: resource_id "vm" must start with "/subscriptions/"`,
+		},
+		{
+			name:   "missing region and endpoint",
+			mutate: func(cm *CustomMetricsConfig) { cm.Region = "" },
+			err:    "custom_metrics: one of region or endpoint must be specified",
+		},
+		{
+			name: "duplicate dimension",
+			mutate: func(cm *CustomMetricsConfig) {
+				cm.Dimensions = []DimensionMapping{{Attribute: "a", Name: "dim"}, {Attribute: "b", Name: "dim"}}
+			},
+			err: `custom_metrics: duplicate dimension name "dim"`,
+		},
+		{
+			name: "too many dimensions",
+			mutate: func(cm *CustomMetricsConfig) {
+				for _, a := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"} {
+					cm.Dimensions = append(cm.Dimensions, DimensionMapping{Attribute: a})
+				}
+			},
+			err: "custom_metrics: at most 10 dimensions are supported, got 11",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.CustomMetrics.Enabled = true
+			cfg.CustomMetrics.Region = "westeurope"
+			cfg.CustomMetrics.ResourceID = testResourceID
+			if tt.mutate != nil {
+				tt.mutate(&cfg.CustomMetrics)
+			}
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCustomMetricsExporter(t *testing.T) {
+	var mu sync.Mutex
+	var received []customMetric
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testResourceID+"/metrics", r.URL.Path)
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		decoder := json.NewDecoder(r.Body)
+		for decoder.More() {
+			var payload customMetric
+			assert.NoError(t, decoder.Decode(&payload))
+			received = append(received, payload)
+		}
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.QueueSettings.Enabled = false
+	cfg.CustomMetrics.Enabled = true
+	cfg.CustomMetrics.Endpoint = server.URL
+	cfg.CustomMetrics.ResourceID = testResourceID
+	cfg.CustomMetrics.Dimensions = []DimensionMapping{
+		{Attribute: "str_attribute", Name: "Kind"},
+		{Attribute: "service.name"},
+		{Attribute: "missing"},
+	}
+	require.NoError(t, cfg.Validate())
+
+	exp, err := NewFactory().CreateMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, exp.Shutdown(context.Background())) }()
+
+	metrics := getTestMetrics()
+	metrics.ResourceMetrics().At(0).Resource().Attributes().PutStr("service.name", "checkout")
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), metrics))
+
+	assert.Equal(t, 1, requests)
+	require.Len(t, received, 5)
+	byName := make(map[string]customMetricBaseData, len(received))
+	for _, payload := range received {
+		byName[payload.Data.BaseData.Metric] = payload.Data.BaseData
+	}
+
+	gauge := byName["Gauge"]
+	assert.Equal(t, defaultCustomMetricsNamespace, gauge.Namespace)
+	assert.Equal(t, []string{"Kind", "service.name", "missing"}, gauge.DimNames)
+	assert.Equal(t, []customMetricSeries{{DimValues: []string{"str_value", "checkout", ""}, Min: 1, Max: 1, Sum: 1, Count: 1}}, gauge.Series)
+
+	histogram := byName["Histogram"]
+	assert.Equal(t, customMetricSeries{DimValues: []string{"str_value", "checkout", ""}, Min: 0, Max: 2, Sum: 3, Count: 3}, histogram.Series[0])
+
+	summary := byName["Summary"]
+	assert.Equal(t, customMetricSeries{DimValues: []string{"str_value", "checkout", ""}, Min: 1, Max: 1, Sum: 5, Count: 5}, summary.Series[0])
+}
+
+func TestCustomMetricsExporterGroupsByTimestamp(t *testing.T) {
+	exporter := &customMetricsExporter{
+		config: createDefaultConfig().(*Config),
+		packer: getMetricPacker(),
+	}
+
+	metrics := pmetric.NewMetrics()
+	metric := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("Gauge")
+	dps := metric.SetEmptyGauge().DataPoints()
+	dps.AppendEmpty().SetIntValue(1)
+	dps.AppendEmpty().SetIntValue(2)
+	late := dps.AppendEmpty()
+	late.SetIntValue(3)
+	late.SetTimestamp(60_000_000_000)
+
+	payloads := exporter.toCustomMetrics(metrics)
+	require.Len(t, payloads, 2)
+	assert.Len(t, payloads[0].Data.BaseData.Series, 2)
+	assert.Len(t, payloads[1].Data.BaseData.Series, 1)
+	assert.Equal(t, "1970-01-01T00:01:00Z", payloads[1].Time)
+	assert.Empty(t, payloads[0].Data.BaseData.DimNames)
+}
+
+func TestCustomMetricsExporterErrors(t *testing.T) {
+	status := http.StatusBadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomMetrics.Endpoint = server.URL
+	cfg.CustomMetrics.ResourceID = testResourceID
+	exporter := &customMetricsExporter{
+		config: cfg,
+		packer: getMetricPacker(),
+		logger: zap.NewNop(),
+		url:    cfg.CustomMetrics.metricsURL(),
+		client: server.Client(),
+	}
+
+	metrics := getTestMetrics()
+	err := exporter.onMetricData(context.Background(), metrics)
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+
+	status = http.StatusServiceUnavailable
+	err = exporter.onMetricData(context.Background(), metrics)
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestCustomMetricsExporterPartialFailure(t *testing.T) {
+	requests := 0
+	accepted := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		decoder := json.NewDecoder(r.Body)
+		for decoder.More() {
+			var payload customMetric
+			assert.NoError(t, decoder.Decode(&payload))
+			accepted++
+		}
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomMetrics.Endpoint = server.URL
+	cfg.CustomMetrics.ResourceID = testResourceID
+	exporter := &customMetricsExporter{
+		config: cfg,
+		packer: getMetricPacker(),
+		logger: zap.NewNop(),
+		url:    cfg.CustomMetrics.metricsURL(),
+		client: server.Client(),
+	}
+
+	// One payload per timestamp, more than fit in a single request.
+	metrics := pmetric.NewMetrics()
+	resourceMetrics := metrics.ResourceMetrics().AppendEmpty()
+	resourceMetrics.Resource().Attributes().PutStr("service.name", "checkout")
+	metric := resourceMetrics.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("Gauge")
+	dps := metric.SetEmptyGauge().DataPoints()
+	for i := 0; i < 10000; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetIntValue(int64(i))
+		dp.SetTimestamp(pcommon.Timestamp(int64(i) * 1_000_000_000))
+	}
+
+	err := exporter.onMetricData(context.Background(), metrics)
+	require.Error(t, err)
+	assert.Greater(t, requests, 1)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	// Only the datapoints of the failed requests are retried.
+	var metricsErr consumererror.Metrics
+	require.ErrorAs(t, err, &metricsErr)
+	retry := metricsErr.Data()
+	require.Equal(t, 1, retry.ResourceMetrics().Len())
+	serviceName, _ := retry.ResourceMetrics().At(0).Resource().Attributes().Get("service.name")
+	assert.Equal(t, "checkout", serviceName.Str())
+	retryDps := retry.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	assert.Equal(t, 10000-accepted, retryDps.Len())
+	assert.Equal(t, int64(accepted), retryDps.At(0).IntValue())
+}
+
+func TestCustomMetricsExporterDropsRejectedRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomMetrics.Endpoint = server.URL
+	cfg.CustomMetrics.ResourceID = testResourceID
+	cfg.CustomMetrics.Dimensions = []DimensionMapping{{Attribute: "value"}}
+	exporter := &customMetricsExporter{
+		config:   cfg,
+		packer:   getMetricPacker(),
+		logger:   zap.NewNop(),
+		url:      cfg.CustomMetrics.metricsURL(),
+		client:   server.Client(),
+		dimNames: []string{"value"},
+	}
+
+	// The payload of the first metric fills a request of its own.
+	metrics := pmetric.NewMetrics()
+	scopeMetrics := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	rejected := scopeMetrics.Metrics().AppendEmpty()
+	rejected.SetName("rejected")
+	rejected.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("value", strings.Repeat("x", maxCustomMetricsBodySize))
+	throttled := scopeMetrics.Metrics().AppendEmpty()
+	throttled.SetName("throttled")
+	throttled.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+
+	err := exporter.onMetricData(context.Background(), metrics)
+	require.Error(t, err)
+	assert.Equal(t, 2, requests)
+	assert.False(t, consumererror.IsPermanent(err))
+
+	var metricsErr consumererror.Metrics
+	require.ErrorAs(t, err, &metricsErr)
+	retry := metricsErr.Data()
+	require.Equal(t, 1, retry.MetricCount())
+	assert.Equal(t, "throttled", retry.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestToRequests(t *testing.T) {
+	payloads := []*customMetric{{Time: "a"}, {Time: "b"}}
+	requests, err := toRequests(payloads)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, payloads, requests[0].payloads)
+	lines := bytes.Split(requests[0].body, []byte("\n"))
+	require.Len(t, lines, 2)
+	var payload customMetric
+	require.NoError(t, json.Unmarshal(lines[1], &payload))
+	assert.Equal(t, "b", payload.Time)
+
+	large := &customMetric{Time: strings.Repeat("x", maxCustomMetricsBodySize)}
+	requests, err = toRequests([]*customMetric{{Time: "a"}, large, {Time: "b"}})
+	require.NoError(t, err)
+	require.Len(t, requests, 3)
+	assert.Equal(t, []*customMetric{large}, requests[1].payloads)
+}
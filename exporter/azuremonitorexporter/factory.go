@@ -12,6 +12,7 @@ import (
 
 	"github.com/microsoft/ApplicationInsights-Go/appinsights"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
@@ -22,6 +23,8 @@ import (
 
 const (
 	defaultEndpoint = "https://dc.services.visualstudio.com/v2/track"
+
+	defaultCustomMetricsNamespace = "otel"
 )
 
 var (
@@ -51,6 +54,10 @@ func createDefaultConfig() component.Config {
 		MaxBatchInterval:  10 * time.Second,
 		SpanEventsEnabled: false,
 		QueueSettings:     exporterhelper.NewDefaultQueueSettings(),
+		CustomMetrics: CustomMetricsConfig{
+			ClientConfig: confighttp.NewDefaultClientConfig(),
+			Namespace:    defaultCustomMetricsNamespace,
+		},
 	}
 }
 
@@ -103,6 +110,10 @@ func (f *factory) createMetricsExporter(
 		return nil, errUnexpectedConfigurationType
 	}
 
+	if exporterConfig.CustomMetrics.Enabled {
+		return newCustomMetricsExporter(exporterConfig, set)
+	}
+
 	tc, errInstrumentationKeyOrConnectionString := f.getTransportChannel(exporterConfig, set.Logger)
 	if errInstrumentationKeyOrConnectionString != nil {
 		return nil, errInstrumentationKeyOrConnectionString
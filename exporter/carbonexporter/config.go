@@ -31,6 +31,19 @@ type Config struct {
 
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetryConfig resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
+
+	// Tags defines which attributes are written as Graphite tags.
+	Tags TagsConfig `mapstructure:"tags"`
+}
+
+// TagsConfig defines which attributes of the data points are written as Graphite
+// tags, the metric name being kept as is.
+type TagsConfig struct {
+	// Include lists the attributes written as tags. All the attributes are written when empty.
+	Include []string `mapstructure:"include"`
+
+	// Exclude lists the attributes which are never written as tags.
+	Exclude []string `mapstructure:"exclude"`
 }
 
 func (cfg *Config) Validate() error {
@@ -49,5 +62,13 @@ func (cfg *Config) Validate() error {
 		return errors.New("'max_idle_conns' must be non-negative")
 	}
 
+	for _, keys := range [][]string{cfg.Tags.Include, cfg.Tags.Exclude} {
+		for _, key := range keys {
+			if key == "" {
+				return errors.New("'tags' must not list empty attribute names")
+			}
+		}
+	}
+
 	return nil
 }
@@ -62,6 +62,10 @@ func TestLoadConfig(t *testing.T) {
 				ResourceToTelemetryConfig: resourcetotelemetry.Settings{
 					Enabled: true,
 				},
+				Tags: TagsConfig{
+					Include: []string{"host.name", "service.name"},
+					Exclude: []string{"service.instance.id"},
+				},
 			},
 		},
 	}
@@ -118,6 +122,16 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "empty_tag",
+			config: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{Endpoint: defaultEndpoint},
+				Tags: TagsConfig{
+					Exclude: []string{""},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -23,6 +23,7 @@ func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.CreateSett
 	sender := carbonSender{
 		writeTimeout: cfg.Timeout,
 		conns:        newConnPool(cfg.TCPAddrConfig, cfg.Timeout, cfg.MaxIdleConns),
+		tags:         newTagFilter(cfg.Tags),
 	}
 
 	exp, err := exporterhelper.NewMetricsExporter(
@@ -47,10 +48,11 @@ func newCarbonExporter(ctx context.Context, cfg *Config, set exporter.CreateSett
 type carbonSender struct {
 	writeTimeout time.Duration
 	conns        connPool
+	tags         tagFilter
 }
 
 func (cs *carbonSender) pushMetricsData(_ context.Context, md pmetric.Metrics) error {
-	lines := metricDataToPlaintext(md)
+	lines := metricDataToPlaintext(md, cs.tags)
 
 	// There is no way to do a call equivalent to recvfrom with an empty buffer
 	// to check if the connection was terminated (if the size of the buffer is
@@ -177,7 +177,7 @@ func TestNopConnPool(t *testing.T) {
 
 	conn, err := cp.get()
 	require.NoError(t, err)
-	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch(), tagFilter{})))
 	assert.NoError(t, err)
 	cp.put(conn)
 
@@ -185,7 +185,7 @@ func TestNopConnPool(t *testing.T) {
 	conn2, err2 := cp.get()
 	require.NoError(t, err2)
 	assert.NotSame(t, conn, conn2)
-	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch(), tagFilter{})))
 	assert.NoError(t, err)
 	cp.put(conn2)
 
@@ -208,7 +208,7 @@ func TestConnPoolWithIdle(t *testing.T) {
 
 	conn, err := cp.get()
 	require.NoError(t, err)
-	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch(), tagFilter{})))
 	assert.NoError(t, err)
 	cp.put(conn)
 
@@ -216,7 +216,7 @@ func TestConnPoolWithIdle(t *testing.T) {
 	conn2, err2 := cp.get()
 	require.NoError(t, err2)
 	assert.Same(t, conn, conn2)
-	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+	_, err = conn2.Write([]byte(metricDataToPlaintext(generateSmallBatch(), tagFilter{})))
 	assert.NoError(t, err)
 	cp.put(conn2)
 
@@ -256,7 +256,7 @@ func TestConnPoolWithIdleMaxConnections(t *testing.T) {
 	for i := 0; i < maxIdleConns+1; i++ {
 		conn, err := cp.get()
 		require.NoError(t, err)
-		_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch())))
+		_, err = conn.Write([]byte(metricDataToPlaintext(generateSmallBatch(), tagFilter{})))
 		assert.NoError(t, err)
 		if i != maxIdleConns {
 			assert.Same(t, conn, conns[maxIdleConns-i-1])
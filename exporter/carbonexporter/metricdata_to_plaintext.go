@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"unicode"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -79,7 +80,10 @@ var writerPool = sync.Pool{
 //     a single Carbon metric.
 //   - number of time series successfully converted to carbon.
 //   - number of time series that could not be converted to Carbon.
-func metricDataToPlaintext(md pmetric.Metrics) string {
+//
+// Only the attributes selected by the tag filter are written as tags, and the
+// invalid characters of the metric name and of the tags are replaced.
+func metricDataToPlaintext(md pmetric.Metrics, tags tagFilter) string {
 	if md.DataPointCount() == 0 {
 		return ""
 	}
@@ -98,15 +102,16 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 					// TODO: log error info
 					continue
 				}
+				name := sanitizeMetricName(metric.Name())
 				switch metric.Type() {
 				case pmetric.MetricTypeGauge:
-					writeNumberDataPoints(buf, metric.Name(), metric.Gauge().DataPoints())
+					writeNumberDataPoints(buf, name, metric.Gauge().DataPoints(), tags)
 				case pmetric.MetricTypeSum:
-					writeNumberDataPoints(buf, metric.Name(), metric.Sum().DataPoints())
+					writeNumberDataPoints(buf, name, metric.Sum().DataPoints(), tags)
 				case pmetric.MetricTypeHistogram:
-					formatHistogramDataPoints(buf, metric.Name(), metric.Histogram().DataPoints())
+					formatHistogramDataPoints(buf, name, metric.Histogram().DataPoints(), tags)
 				case pmetric.MetricTypeSummary:
-					formatSummaryDataPoints(buf, metric.Name(), metric.Summary().DataPoints())
+					formatSummaryDataPoints(buf, name, metric.Summary().DataPoints(), tags)
 				}
 			}
 		}
@@ -115,7 +120,7 @@ func metricDataToPlaintext(md pmetric.Metrics) string {
 	return buf.String()
 }
 
-func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.NumberDataPointSlice) {
+func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.NumberDataPointSlice, tags tagFilter) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 		var valueStr string
@@ -129,7 +134,7 @@ func writeNumberDataPoints(buf *bytes.Buffer, metricName string, dps pmetric.Num
 		}
 		writeLine(
 			buf,
-			buildPath(metricName, dp.Attributes()),
+			buildPath(metricName, dp.Attributes(), tags),
 			valueStr,
 			formatTimestamp(dp.Timestamp()))
 	}
@@ -153,12 +158,13 @@ func formatHistogramDataPoints(
 	buf *bytes.Buffer,
 	metricName string,
 	dps pmetric.HistogramDataPointSlice,
+	tags tagFilter,
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		formatCountAndSum(buf, metricName, dp.Attributes(), tags, dp.Count(), dp.Sum(), timestampStr)
 		if dp.ExplicitBounds().Len() == 0 {
 			continue
 		}
@@ -170,7 +176,7 @@ func formatHistogramDataPoints(
 		}
 		carbonBounds[len(carbonBounds)-1] = infinityCarbonValue
 
-		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes())
+		bucketPath := buildPath(metricName+distributionBucketSuffix, dp.Attributes(), tags)
 		for j := 0; j < dp.BucketCounts().Len(); j++ {
 			writeLine(
 				buf,
@@ -197,18 +203,19 @@ func formatSummaryDataPoints(
 	buf *bytes.Buffer,
 	metricName string,
 	dps pmetric.SummaryDataPointSlice,
+	tags tagFilter,
 ) {
 	for i := 0; i < dps.Len(); i++ {
 		dp := dps.At(i)
 
 		timestampStr := formatTimestamp(dp.Timestamp())
-		formatCountAndSum(buf, metricName, dp.Attributes(), dp.Count(), dp.Sum(), timestampStr)
+		formatCountAndSum(buf, metricName, dp.Attributes(), tags, dp.Count(), dp.Sum(), timestampStr)
 
 		if dp.QuantileValues().Len() == 0 {
 			continue
 		}
 
-		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes())
+		quantilePath := buildPath(metricName+summaryQuantileSuffix, dp.Attributes(), tags)
 		for j := 0; j < dp.QuantileValues().Len(); j++ {
 			writeLine(
 				buf,
@@ -230,6 +237,7 @@ func formatCountAndSum(
 	buf *bytes.Buffer,
 	metricName string,
 	attributes pcommon.Map,
+	tags tagFilter,
 	count uint64,
 	sum float64,
 	timestampStr string,
@@ -237,19 +245,55 @@ func formatCountAndSum(
 	// Write count and sum metrics.
 	writeLine(
 		buf,
-		buildPath(metricName+countSuffix, attributes),
+		buildPath(metricName+countSuffix, attributes, tags),
 		formatUint64(count),
 		timestampStr)
 
 	writeLine(
 		buf,
-		buildPath(metricName, attributes),
+		buildPath(metricName, attributes, tags),
 		formatFloatForValue(sum),
 		timestampStr)
 }
 
+// tagFilter selects the attributes written as tags, the zero value selecting
+// all of them.
+type tagFilter struct {
+	include map[string]struct{}
+	exclude map[string]struct{}
+}
+
+func newTagFilter(cfg TagsConfig) tagFilter {
+	toSet := func(keys []string) map[string]struct{} {
+		if len(keys) == 0 {
+			return nil
+		}
+		set := make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			set[key] = struct{}{}
+		}
+		return set
+	}
+	return tagFilter{
+		include: toSet(cfg.Include),
+		exclude: toSet(cfg.Exclude),
+	}
+}
+
+// keep returns whether the attribute is written as a tag.
+func (f tagFilter) keep(key string) bool {
+	if _, ok := f.exclude[key]; ok {
+		return false
+	}
+	if f.include == nil {
+		return true
+	}
+	_, ok := f.include[key]
+	return ok
+}
+
 // buildPath is used to build the <metric_path> per description above.
-func buildPath(name string, attributes pcommon.Map) string {
+func buildPath(name string, attributes pcommon.Map, tags tagFilter) string {
 	if attributes.Len() == 0 {
 		return name
 	}
@@ -260,7 +304,10 @@ func buildPath(name string, attributes pcommon.Map) string {
 
 	buf.WriteString(name)
 	attributes.Range(func(k string, v pcommon.Value) bool {
-		value := v.AsString()
+		if !tags.keep(k) {
+			return true
+		}
+		value := sanitizeTagValue(v.AsString())
 		if value == "" {
 			value = tagValueEmptyPlaceholder
 		}
@@ -285,14 +332,31 @@ func writeLine(buf *bytes.Buffer, path, value, timestamp string) {
 	buf.WriteString(tagLineNewLine)
 }
 
+// sanitizeMetricName removes any invalid character from the metric name, the
+// invalid characters are ';', which starts the tags, and the white spaces, which
+// separate the fields of a line.
+func sanitizeMetricName(name string) string {
+	mapRune := func(r rune) rune {
+		if r == ';' || unicode.IsSpace(r) {
+			return sanitizedRune
+		}
+		return r
+	}
+
+	return strings.Map(mapRune, name)
+}
+
 // sanitizeTagKey removes any invalid character from the tag key, the invalid
-// characters are ";!^=".
+// characters are ";!^=" and the white spaces.
 func sanitizeTagKey(key string) string {
 	mapRune := func(r rune) rune {
 		switch r {
 		case ';', '!', '^', '=':
 			return sanitizedRune
 		default:
+			if unicode.IsSpace(r) {
+				return sanitizedRune
+			}
 			return r
 		}
 	}
@@ -301,13 +365,16 @@ func sanitizeTagKey(key string) string {
 }
 
 // sanitizeTagValue removes any invalid character from the tag value, the invalid
-// characters are ";~".
+// characters are ";~" and the white spaces.
 func sanitizeTagValue(value string) string {
 	mapRune := func(r rune) rune {
 		switch r {
 		case ';', '~':
 			return sanitizedRune
 		default:
+			if unicode.IsSpace(r) {
+				return sanitizedRune
+			}
 			return r
 		}
 	}
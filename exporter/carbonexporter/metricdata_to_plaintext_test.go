@@ -22,8 +22,13 @@ func TestSanitizeTagKey(t *testing.T) {
 	}{
 		{
 			name: "no_changes",
-			key:  "a valid tag key",
-			want: "a valid tag key",
+			key:  "a_valid.tag-key",
+			want: "a_valid.tag-key",
+		},
+		{
+			name: "replace_spaces",
+			key:  "a tag\tkey",
+			want: "a_tag_key",
 		},
 		{
 			name: "remove_tag_set",
@@ -47,8 +52,13 @@ func TestSanitizeTagValue(t *testing.T) {
 	}{
 		{
 			name:  "no_changes",
-			value: "a valid tag value",
-			want:  "a valid tag value",
+			value: "a_valid.tag-value",
+			want:  "a_valid.tag-value",
+		},
+		{
+			name:  "replace_spaces",
+			value: "a tag\nvalue",
+			want:  "a_tag_value",
 		},
 		{
 			name:  "replace_tilde",
@@ -69,10 +79,16 @@ func TestSanitizeTagValue(t *testing.T) {
 	}
 }
 
+func TestSanitizeMetricName(t *testing.T) {
+	assert.Equal(t, "a.valid_metric-name", sanitizeMetricName("a.valid_metric-name"))
+	assert.Equal(t, "a_metric_name", sanitizeMetricName("a;metric name"))
+}
+
 func TestBuildPath(t *testing.T) {
 	tests := []struct {
 		name       string
 		attributes pcommon.Map
+		tags       TagsConfig
 		want       string
 	}{
 		{
@@ -103,10 +119,53 @@ func TestBuildPath(t *testing.T) {
 			}(),
 			want: "int_value;k=1",
 		},
+		{
+			name: "sanitized_value",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k", "a value;~")
+				return attr
+			}(),
+			want: "sanitized_value;k=a_value__",
+		},
+		{
+			name: "include",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k0", "v0")
+				attr.PutStr("k1", "v1")
+				attr.PutStr("k2", "v2")
+				return attr
+			}(),
+			tags: TagsConfig{Include: []string{"k0", "k2"}},
+			want: "include;k0=v0;k2=v2",
+		},
+		{
+			name: "exclude",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k0", "v0")
+				attr.PutStr("k1", "v1")
+				return attr
+			}(),
+			tags: TagsConfig{Exclude: []string{"k0"}},
+			want: "exclude;k1=v1",
+		},
+		{
+			name: "include_and_exclude",
+			attributes: func() pcommon.Map {
+				attr := pcommon.NewMap()
+				attr.PutStr("k0", "v0")
+				attr.PutStr("k1", "v1")
+				return attr
+			}(),
+			tags: TagsConfig{Include: []string{"k0", "k1"}, Exclude: []string{"k1"}},
+			want: "include_and_exclude;k0=v0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildPath(tt.name, tt.attributes)
+			got := buildPath(tt.name, tt.attributes, newTagFilter(tt.tags))
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -270,7 +329,7 @@ func TestToPlaintext(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotLines := metricDataToPlaintext(tt.metricsDataFn())
+			gotLines := metricDataToPlaintext(tt.metricsDataFn(), tagFilter{})
 			got := strings.Split(gotLines, "\n")
 			got = got[:len(got)-1]
 			assert.Len(t, got, len(tt.wantLines)+tt.wantExtraLinesCount)
@@ -328,6 +387,6 @@ func BenchmarkConsumeMetricsDefault(b *testing.B) {
 	b.ResetTimer()
 	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
-		assert.Len(b, metricDataToPlaintext(md), 62)
+		assert.Len(b, metricDataToPlaintext(md, tagFilter{}), 62)
 	}
 }
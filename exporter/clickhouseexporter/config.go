@@ -49,6 +49,8 @@ type Config struct {
 	ClusterName string `mapstructure:"cluster_name"`
 	// CreateSchema if set to true will run the DDL for creating the database and tables. default is true.
 	CreateSchema *bool `mapstructure:"create_schema"`
+	// Routing resolves the database and the tables from resource attributes.
+	Routing RoutingConfig `mapstructure:"routing"`
 }
 
 // TableEngine defines the ENGINE string value when creating the table.
@@ -77,6 +77,18 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "routing"),
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoint = defaultEndpoint
+				cfg.Routing = RoutingConfig{
+					DatabaseAttribute:  "tenant",
+					LogsTableAttribute: "logs.table",
+					MaxDatabases:       10,
+					MaxOpenConnections: 5,
+				}
+			}),
+		},
 	}
 
 	for _, tt := range tests {
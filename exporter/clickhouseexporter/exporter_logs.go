@@ -6,6 +6,7 @@ package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 type logsExporter struct {
 	client    *sql.DB
 	insertSQL string
+	router    *router
 
 	logger *zap.Logger
 	cfg    *Config
@@ -36,8 +38,13 @@ func newLogsExporter(logger *zap.Logger, cfg *Config) (*logsExporter, error) {
 	return &logsExporter{
 		client:    client,
 		insertSQL: renderInsertLogsSQL(cfg),
-		logger:    logger,
-		cfg:       cfg,
+		router: newRouter(cfg, logger, client, cfg.Routing.LogsTableAttribute, cfg.LogsTableName,
+			func(cfg Config, table string) Config {
+				cfg.LogsTableName = table
+				return cfg
+			}, createLogsTable),
+		logger: logger,
+		cfg:    cfg,
 	}, nil
 }
 
@@ -55,16 +62,53 @@ func (e *logsExporter) start(ctx context.Context, _ component.Host) error {
 
 // shutdown will shut down the exporter.
 func (e *logsExporter) shutdown(_ context.Context) error {
+	var err error
+	if e.router != nil {
+		err = e.router.shutdown()
+	}
 	if e.client != nil {
-		return e.client.Close()
+		return errors.Join(err, e.client.Close())
 	}
-	return nil
+	return err
 }
 
 func (e *logsExporter) pushLogsData(ctx context.Context, ld plog.Logs) error {
+	if e.router == nil {
+		return e.insertLogs(ctx, e.client, e.insertSQL, ld)
+	}
+
+	targets := map[target]plog.Logs{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		t, err := e.router.resolve(rl.Resource())
+		if err != nil {
+			e.logger.Warn("Dropping logs that cannot be routed", zap.Error(err))
+			continue
+		}
+		logs, ok := targets[t]
+		if !ok {
+			logs = plog.NewLogs()
+			targets[t] = logs
+		}
+		rl.CopyTo(logs.ResourceLogs().AppendEmpty())
+	}
+
+	var errs error
+	for t, logs := range targets {
+		client, cfg, err := e.router.client(ctx, t)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		errs = errors.Join(errs, e.insertLogs(ctx, client, renderInsertLogsSQL(cfg), logs))
+	}
+	return errs
+}
+
+func (e *logsExporter) insertLogs(ctx context.Context, client *sql.DB, insertSQL string, ld plog.Logs) error {
 	start := time.Now()
-	err := doWithTx(ctx, e.client, func(tx *sql.Tx) error {
-		statement, err := tx.PrepareContext(ctx, e.insertSQL)
+	err := doWithTx(ctx, client, func(tx *sql.Tx) error {
+		statement, err := tx.PrepareContext(ctx, insertSQL)
 		if err != nil {
 			return fmt.Errorf("PrepareContext:%w", err)
 		}
@@ -18,6 +18,7 @@ import (
 
 type metricsExporter struct {
 	client *sql.DB
+	router *router
 
 	logger *zap.Logger
 	cfg    *Config
@@ -31,6 +32,11 @@ func newMetricsExporter(logger *zap.Logger, cfg *Config) (*metricsExporter, erro
 
 	return &metricsExporter{
 		client: client,
+		router: newRouter(cfg, logger, client, cfg.Routing.MetricsTableAttribute, cfg.MetricsTableName,
+			func(cfg Config, table string) Config {
+				cfg.MetricsTableName = table
+				return cfg
+			}, createMetricsTables),
 		logger: logger,
 		cfg:    cfg,
 	}, nil
@@ -47,20 +53,61 @@ func (e *metricsExporter) start(ctx context.Context, _ component.Host) error {
 		return err
 	}
 
-	ttlExpr := generateTTLExpr(e.cfg.TTLDays, e.cfg.TTL, "TimeUnix")
-	return internal.NewMetricsTable(ctx, e.cfg.MetricsTableName, e.cfg.ClusterString(), e.cfg.TableEngineString(), ttlExpr, e.client)
+	return createMetricsTables(ctx, e.cfg, e.client)
+}
+
+func createMetricsTables(ctx context.Context, cfg *Config, db *sql.DB) error {
+	ttlExpr := generateTTLExpr(cfg.TTLDays, cfg.TTL, "TimeUnix")
+	return internal.NewMetricsTable(ctx, cfg.MetricsTableName, cfg.ClusterString(), cfg.TableEngineString(), ttlExpr, db)
 }
 
 // shutdown will shut down the exporter.
 func (e *metricsExporter) shutdown(_ context.Context) error {
+	var err error
+	if e.router != nil {
+		err = e.router.shutdown()
+	}
 	if e.client != nil {
-		return e.client.Close()
+		return errors.Join(err, e.client.Close())
 	}
-	return nil
+	return err
 }
 
 func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metrics) error {
-	metricsMap := internal.NewMetricsModel(e.cfg.MetricsTableName)
+	if e.router == nil {
+		return e.insertMetrics(ctx, e.client, e.cfg.MetricsTableName, md)
+	}
+
+	targets := map[target]pmetric.Metrics{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		t, err := e.router.resolve(rm.Resource())
+		if err != nil {
+			e.logger.Warn("Dropping metrics that cannot be routed", zap.Error(err))
+			continue
+		}
+		metrics, ok := targets[t]
+		if !ok {
+			metrics = pmetric.NewMetrics()
+			targets[t] = metrics
+		}
+		rm.CopyTo(metrics.ResourceMetrics().AppendEmpty())
+	}
+
+	var errs error
+	for t, metrics := range targets {
+		client, cfg, err := e.router.client(ctx, t)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		errs = errors.Join(errs, e.insertMetrics(ctx, client, cfg.MetricsTableName, metrics))
+	}
+	return errs
+}
+
+func (e *metricsExporter) insertMetrics(ctx context.Context, client *sql.DB, tableName string, md pmetric.Metrics) error {
+	metricsMap := internal.NewMetricsModel(tableName)
 	for i := 0; i < md.ResourceMetrics().Len(); i++ {
 		metrics := md.ResourceMetrics().At(i)
 		resAttr := attributesToMap(metrics.Resource().Attributes())
@@ -95,5 +142,5 @@ func (e *metricsExporter) pushMetricsData(ctx context.Context, md pmetric.Metric
 		}
 	}
 	// batch insert https://clickhouse.com/docs/en/about-us/performance/#performance-when-inserting-data
-	return internal.InsertMetrics(ctx, e.client, metricsMap)
+	return internal.InsertMetrics(ctx, client, metricsMap)
 }
@@ -6,6 +6,7 @@ package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-co
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -22,6 +23,7 @@ import (
 type tracesExporter struct {
 	client    *sql.DB
 	insertSQL string
+	router    *router
 
 	logger *zap.Logger
 	cfg    *Config
@@ -36,8 +38,13 @@ func newTracesExporter(logger *zap.Logger, cfg *Config) (*tracesExporter, error)
 	return &tracesExporter{
 		client:    client,
 		insertSQL: renderInsertTracesSQL(cfg),
-		logger:    logger,
-		cfg:       cfg,
+		router: newRouter(cfg, logger, client, cfg.Routing.TracesTableAttribute, cfg.TracesTableName,
+			func(cfg Config, table string) Config {
+				cfg.TracesTableName = table
+				return cfg
+			}, createTracesTable),
+		logger: logger,
+		cfg:    cfg,
 	}, nil
 }
 
@@ -55,16 +62,53 @@ func (e *tracesExporter) start(ctx context.Context, _ component.Host) error {
 
 // shutdown will shut down the exporter.
 func (e *tracesExporter) shutdown(_ context.Context) error {
+	var err error
+	if e.router != nil {
+		err = e.router.shutdown()
+	}
 	if e.client != nil {
-		return e.client.Close()
+		return errors.Join(err, e.client.Close())
 	}
-	return nil
+	return err
 }
 
 func (e *tracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) error {
+	if e.router == nil {
+		return e.insertTraces(ctx, e.client, e.insertSQL, td)
+	}
+
+	targets := map[target]ptrace.Traces{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		t, err := e.router.resolve(rs.Resource())
+		if err != nil {
+			e.logger.Warn("Dropping spans that cannot be routed", zap.Error(err))
+			continue
+		}
+		traces, ok := targets[t]
+		if !ok {
+			traces = ptrace.NewTraces()
+			targets[t] = traces
+		}
+		rs.CopyTo(traces.ResourceSpans().AppendEmpty())
+	}
+
+	var errs error
+	for t, traces := range targets {
+		client, cfg, err := e.router.client(ctx, t)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		errs = errors.Join(errs, e.insertTraces(ctx, client, renderInsertTracesSQL(cfg), traces))
+	}
+	return errs
+}
+
+func (e *tracesExporter) insertTraces(ctx context.Context, client *sql.DB, insertSQL string, td ptrace.Traces) error {
 	start := time.Now()
-	err := doWithTx(ctx, e.client, func(tx *sql.Tx) error {
-		statement, err := tx.PrepareContext(ctx, e.insertSQL)
+	err := doWithTx(ctx, client, func(tx *sql.Tx) error {
+		statement, err := tx.PrepareContext(ctx, insertSQL)
 		if err != nil {
 			return fmt.Errorf("PrepareContext:%w", err)
 		}
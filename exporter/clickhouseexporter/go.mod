@@ -13,6 +13,7 @@ require (
 	go.opentelemetry.io/collector/config/configopaque v1.9.0
 	go.opentelemetry.io/collector/config/configretry v0.102.0
 	go.opentelemetry.io/collector/confmap v0.102.0
+	go.opentelemetry.io/collector/consumer v0.102.0
 	go.opentelemetry.io/collector/exporter v0.102.0
 	go.opentelemetry.io/collector/pdata v1.9.0
 	go.opentelemetry.io/collector/semconv v0.102.0
@@ -85,7 +86,6 @@ require (
 	github.com/yusufpapurcu/wmi v1.2.4 // indirect
 	go.opentelemetry.io/collector v0.102.0 // indirect
 	go.opentelemetry.io/collector/config/configtelemetry v0.102.0 // indirect
-	go.opentelemetry.io/collector/consumer v0.102.0 // indirect
 	go.opentelemetry.io/collector/extension v0.102.0 // indirect
 	go.opentelemetry.io/collector/receiver v0.102.0 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.49.0 // indirect
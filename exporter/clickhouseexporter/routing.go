@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/clickhouseexporter"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+)
+
+// RoutingConfig defines how the database and the tables of the telemetry are resolved from the
+// attributes of its resource.
+type RoutingConfig struct {
+	// DatabaseAttribute is the resource attribute whose value is the database the telemetry is exported to.
+	DatabaseAttribute string `mapstructure:"database_attribute"`
+	// LogsTableAttribute is the resource attribute whose value is the table the logs are exported to.
+	LogsTableAttribute string `mapstructure:"logs_table_attribute"`
+	// TracesTableAttribute is the resource attribute whose value is the table the traces are exported to.
+	TracesTableAttribute string `mapstructure:"traces_table_attribute"`
+	// MetricsTableAttribute is the resource attribute whose value is the prefix of the tables the metrics are exported to.
+	MetricsTableAttribute string `mapstructure:"metrics_table_attribute"`
+	// MaxDatabases is the maximum number of databases the telemetry is exported to, 0 means no limit.
+	MaxDatabases int `mapstructure:"max_databases"`
+	// MaxOpenConnections is the maximum number of open connections to each database, 0 means no limit.
+	MaxOpenConnections int `mapstructure:"max_open_connections"`
+}
+
+var (
+	errRoutingNegativeMaxDatabases       = errors.New("'routing.max_databases' must not be negative")
+	errRoutingNegativeMaxOpenConnections = errors.New("'routing.max_open_connections' must not be negative")
+)
+
+// identifierRegexp matches the names of databases and tables that can be used without quoting.
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][0-9a-zA-Z_]*$`)
+
+// Validate the routing configuration.
+func (cfg *RoutingConfig) Validate() (err error) {
+	if cfg.MaxDatabases < 0 {
+		err = errors.Join(err, errRoutingNegativeMaxDatabases)
+	}
+	if cfg.MaxOpenConnections < 0 {
+		err = errors.Join(err, errRoutingNegativeMaxOpenConnections)
+	}
+	return err
+}
+
+// target is the database and the table the telemetry of a resource is exported to.
+type target struct {
+	database string
+	table    string
+}
+
+// router resolves the target of the telemetry of each resource and maintains a connection pool to
+// each database, so that a slow or overloaded database doesn't use the connections of the others.
+type router struct {
+	cfg            *Config
+	logger         *zap.Logger
+	tableAttribute string
+	defaultTable   string
+	// withTable returns the configuration of the exporter to a target.
+	withTable func(cfg Config, table string) Config
+	// createTable creates the tables of a target.
+	createTable func(ctx context.Context, cfg *Config, db *sql.DB) error
+
+	mu      sync.Mutex
+	clients map[string]*sql.DB
+	// created are the targets whose schema was created, or that don't need one.
+	created map[target]bool
+}
+
+// newRouter returns a router for a signal, or nil if the telemetry of the signal isn't routed.
+// The client of the configured database is shared with the exporter.
+func newRouter(cfg *Config, logger *zap.Logger, client *sql.DB, tableAttribute string, defaultTable string,
+	withTable func(cfg Config, table string) Config, createTable func(ctx context.Context, cfg *Config, db *sql.DB) error) *router {
+	if cfg.Routing.DatabaseAttribute == "" && tableAttribute == "" {
+		return nil
+	}
+	if cfg.Routing.MaxOpenConnections > 0 {
+		client.SetMaxOpenConns(cfg.Routing.MaxOpenConnections)
+	}
+	return &router{
+		cfg:            cfg,
+		logger:         logger,
+		tableAttribute: tableAttribute,
+		defaultTable:   defaultTable,
+		withTable:      withTable,
+		createTable:    createTable,
+		clients:        map[string]*sql.DB{cfg.Database: client},
+		// The schema of the configured database and table is created when the exporter starts.
+		created: map[target]bool{{database: cfg.Database, table: defaultTable}: true},
+	}
+}
+
+// resolve returns the target of the telemetry of a resource. The configured database and table are
+// used when the resource doesn't have the routing attributes.
+func (r *router) resolve(res pcommon.Resource) (target, error) {
+	t := target{
+		database: resourceAttribute(res, r.cfg.Routing.DatabaseAttribute, r.cfg.Database),
+		table:    resourceAttribute(res, r.tableAttribute, r.defaultTable),
+	}
+	// The resolved names are used as identifiers in the queries.
+	if t.database != r.cfg.Database && !identifierRegexp.MatchString(t.database) {
+		return target{}, fmt.Errorf("invalid database name %q", t.database)
+	}
+	if t.table != r.defaultTable && !identifierRegexp.MatchString(t.table) {
+		return target{}, fmt.Errorf("invalid table name %q", t.table)
+	}
+	return t, nil
+}
+
+func resourceAttribute(res pcommon.Resource, name string, defaultValue string) string {
+	if name == "" {
+		return defaultValue
+	}
+	if v, ok := res.Attributes().Get(name); ok && v.AsString() != "" {
+		return v.AsString()
+	}
+	return defaultValue
+}
+
+// client returns the client and the configuration of the exporter to a target, creating the
+// connection pool and the database the first time the database is used, and the tables the first
+// time the target is used.
+func (r *router) client(ctx context.Context, t target) (*sql.DB, *Config, error) {
+	cfg := r.withTable(*r.cfg, t.table)
+	cfg.Database = t.database
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, ok := r.clients[t.database]
+	if !ok {
+		if r.cfg.Routing.MaxDatabases > 0 && len(r.clients) >= r.cfg.Routing.MaxDatabases {
+			return nil, nil, consumererror.NewPermanent(fmt.Errorf("cannot export to database %q: the maximum number of databases (%d) is reached", t.database, r.cfg.Routing.MaxDatabases))
+		}
+		if cfg.ShouldCreateSchema() {
+			if err := createDatabase(ctx, &cfg); err != nil {
+				return nil, nil, err
+			}
+		}
+		var err error
+		if client, err = newClickhouseClient(&cfg); err != nil {
+			return nil, nil, err
+		}
+		if r.cfg.Routing.MaxOpenConnections > 0 {
+			client.SetMaxOpenConns(r.cfg.Routing.MaxOpenConnections)
+		}
+		r.clients[t.database] = client
+		r.logger.Debug("Created connection pool", zap.String("database", t.database))
+	}
+
+	if !r.created[t] {
+		if cfg.ShouldCreateSchema() {
+			if err := r.createTable(ctx, &cfg, client); err != nil {
+				return nil, nil, err
+			}
+		}
+		r.created[t] = true
+	}
+	return client, &cfg, nil
+}
+
+// shutdown closes the connection pools of the routed databases. The client of the configured
+// database is closed by the exporter.
+func (r *router) shutdown() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs error
+	for database, client := range r.clients {
+		if database == r.cfg.Database {
+			continue
+		}
+		errs = errors.Join(errs, client.Close())
+	}
+	return errs
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clickhouseexporter
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestRoutingConfigValidate(t *testing.T) {
+	assert.NoError(t, (&RoutingConfig{MaxDatabases: 10, MaxOpenConnections: 5}).Validate())
+
+	err := (&RoutingConfig{MaxDatabases: -1, MaxOpenConnections: -1}).Validate()
+	assert.ErrorIs(t, err, errRoutingNegativeMaxDatabases)
+	assert.ErrorIs(t, err, errRoutingNegativeMaxOpenConnections)
+}
+
+// queryRecorder records the queries executed by the test driver.
+type queryRecorder struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (r *queryRecorder) record(query string, _ []driver.Value) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = append(r.queries, strings.TrimSpace(query))
+	return nil
+}
+
+// count returns the number of recorded queries starting with the given prefix.
+func (r *queryRecorder) count(prefix string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int
+	for _, q := range r.queries {
+		if strings.HasPrefix(q, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func withRouting(cfg *Config) {
+	cfg.Database = "otel"
+	cfg.Routing = RoutingConfig{
+		DatabaseAttribute:     "tenant",
+		LogsTableAttribute:    "logs.table",
+		TracesTableAttribute:  "traces.table",
+		MetricsTableAttribute: "metrics.table",
+	}
+}
+
+func routedLogs(attributes ...map[string]any) plog.Logs {
+	logs := plog.NewLogs()
+	for _, attrs := range attributes {
+		simpleLogs(1).ResourceLogs().At(0).CopyTo(logs.ResourceLogs().AppendEmpty())
+		for k, v := range attrs {
+			logs.ResourceLogs().At(logs.ResourceLogs().Len()-1).Resource().Attributes().PutStr(k, v.(string))
+		}
+	}
+	return logs
+}
+
+func TestLogsRouting(t *testing.T) {
+	recorder := &queryRecorder{}
+	initClickhouseTestServer(t, recorder.record)
+	exporter := newTestLogsExporter(t, defaultEndpoint, withRouting)
+
+	logs := routedLogs(
+		map[string]any{},
+		map[string]any{"tenant": "tenant_a"},
+		map[string]any{"tenant": "tenant_a", "logs.table": "audit_logs"},
+		map[string]any{"tenant": "tenant_b"},
+		map[string]any{"tenant": "tenant_a"},
+		// Resources whose routing attributes aren't valid identifiers are dropped.
+		map[string]any{"tenant": "tenant_a; DROP TABLE otel_logs"},
+		map[string]any{"logs.table": "audit-logs"},
+	)
+	mustPushLogsData(t, exporter, logs)
+	mustPushLogsData(t, exporter, logs)
+
+	assert.Equal(t, 1, recorder.count("CREATE DATABASE IF NOT EXISTS otel"))
+	assert.Equal(t, 1, recorder.count("CREATE DATABASE IF NOT EXISTS tenant_a"))
+	assert.Equal(t, 1, recorder.count("CREATE DATABASE IF NOT EXISTS tenant_b"))
+	// The tables are created once in each database.
+	assert.Equal(t, 3, recorder.count("CREATE TABLE IF NOT EXISTS otel_logs"))
+	assert.Equal(t, 1, recorder.count("CREATE TABLE IF NOT EXISTS audit_logs"))
+	assert.Equal(t, 8, recorder.count("INSERT INTO otel_logs"))
+	assert.Equal(t, 2, recorder.count("INSERT INTO audit_logs"))
+
+	assert.Len(t, exporter.router.clients, 3)
+}
+
+func TestLogsRoutingMaxDatabases(t *testing.T) {
+	recorder := &queryRecorder{}
+	initClickhouseTestServer(t, recorder.record)
+	exporter := newTestLogsExporter(t, defaultEndpoint, withRouting, func(cfg *Config) {
+		cfg.Routing.MaxDatabases = 2
+	})
+
+	mustPushLogsData(t, exporter, routedLogs(map[string]any{"tenant": "tenant_a"}))
+
+	err := exporter.pushLogsData(context.TODO(), routedLogs(map[string]any{}, map[string]any{"tenant": "tenant_b"}))
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.ErrorContains(t, err, `cannot export to database "tenant_b"`)
+	// The telemetry of the databases that are already used is still exported.
+	assert.Equal(t, 2, recorder.count("INSERT INTO otel_logs"))
+}
+
+func TestTracesRouting(t *testing.T) {
+	recorder := &queryRecorder{}
+	initClickhouseTestServer(t, recorder.record)
+	exporter := newTestTracesExporter(t, defaultEndpoint, withRouting)
+
+	traces := ptrace.NewTraces()
+	simpleTraces(1).ResourceSpans().At(0).CopyTo(traces.ResourceSpans().AppendEmpty())
+	rs := traces.ResourceSpans().AppendEmpty()
+	simpleTraces(2).ResourceSpans().At(0).CopyTo(rs)
+	rs.Resource().Attributes().PutStr("traces.table", "checkout_traces")
+
+	mustPushTracesData(t, exporter, traces)
+
+	assert.Equal(t, 1, recorder.count("CREATE TABLE IF NOT EXISTS checkout_traces"))
+	assert.Equal(t, 1, recorder.count("INSERT INTO otel_traces"))
+	assert.Equal(t, 2, recorder.count("INSERT INTO checkout_traces"))
+	assert.Len(t, exporter.router.clients, 1)
+}
+
+func TestMetricsRouting(t *testing.T) {
+	recorder := &queryRecorder{}
+	initClickhouseTestServer(t, recorder.record)
+	exporter := newTestMetricsExporter(t, defaultEndpoint, withRouting)
+
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	simpleMetrics(1).ResourceMetrics().At(0).CopyTo(rm)
+	rm.Resource().Attributes().PutStr("tenant", "tenant_a")
+	rm.Resource().Attributes().PutStr("metrics.table", "tenant_metrics")
+
+	mustPushMetricsData(t, exporter, metrics)
+
+	assert.Equal(t, 1, recorder.count("CREATE DATABASE IF NOT EXISTS tenant_a"))
+	assert.Equal(t, 1, recorder.count("CREATE TABLE IF NOT EXISTS tenant_metrics_gauge"))
+	assert.Equal(t, 1, recorder.count("INSERT INTO tenant_metrics_gauge"))
+	assert.Equal(t, 0, recorder.count("INSERT INTO otel_metrics_gauge"))
+}
@@ -11,6 +11,9 @@ const (
 	indexSuffix = "elasticsearch.index.suffix"
 )
 
+// dynamic pipeline attribute key constant
+const ingestPipeline = "elasticsearch.ingest_pipeline"
+
 // resource is higher priotized than record attribute
 type attrGetter interface {
 	Attributes() pcommon.Map
@@ -57,6 +57,19 @@ type Config struct {
 	//
 	// https://www.elastic.co/guide/en/elasticsearch/reference/current/ingest.html
 	Pipeline string `mapstructure:"pipeline"`
+	// LogsPipeline configures the ingest node pipeline used for log records, overriding Pipeline.
+	LogsPipeline string `mapstructure:"logs_pipeline"`
+	// TracesPipeline configures the ingest node pipeline used for spans, overriding Pipeline.
+	TracesPipeline string `mapstructure:"traces_pipeline"`
+	// use the pipeline from the 'elasticsearch.ingest_pipeline' resource, scope or record attribute if present (prio: resource > attribute)
+	DynamicPipeline DynamicPipelineSetting `mapstructure:"dynamic_pipeline"`
+
+	// RoutingAttribute configures the resource, scope or record attribute (prio: resource > attribute)
+	// whose value is used as the routing value of the documents, which determines the shard they are stored in.
+	// Documents without the attribute use the default routing.
+	//
+	// https://www.elastic.co/guide/en/elasticsearch/reference/current/mapping-routing-field.html
+	RoutingAttribute string `mapstructure:"routing_attribute"`
 
 	ClientConfig   `mapstructure:",squash"`
 	Discovery      DiscoverySettings      `mapstructure:"discover"`
@@ -76,6 +89,10 @@ type DynamicIndexSetting struct {
 	Enabled bool `mapstructure:"enabled"`
 }
 
+type DynamicPipelineSetting struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
 type ClientConfig struct {
 	Authentication AuthenticationSettings `mapstructure:",squash"`
 
@@ -167,6 +167,18 @@ func TestConfig(t *testing.T) {
 				cfg.Index = "my_log_index"
 			}),
 		},
+		{
+			id:         component.NewIDWithName(metadata.Type, "pipeline_routing"),
+			configFile: "config.yaml",
+			expected: withDefaultConfig(func(cfg *Config) {
+				cfg.Endpoints = []string{"https://elastic.example.com:9200"}
+				cfg.Pipeline = "mypipeline"
+				cfg.LogsPipeline = "mylogspipeline"
+				cfg.TracesPipeline = "mytracespipeline"
+				cfg.DynamicPipeline.Enabled = true
+				cfg.RoutingAttribute = "tenant.id"
+			}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -28,7 +28,17 @@ type esConfigCurrent = elasticsearch7.Config
 
 type esBulkIndexerCurrent = bulkIndexerPool
 
-type esBulkIndexerItem = docappender.BulkIndexerItem
+type esBulkIndexerItem struct {
+	docappender.BulkIndexerItem
+	params bulkRequestParams
+}
+
+// bulkRequestParams holds the bulk request parameters that apply to a document.
+// Documents with different parameters are sent in separate bulk requests.
+type bulkRequestParams struct {
+	pipeline string
+	routing  string
+}
 
 // clientLogger implements the estransport.Logger interface
 // that is required by the Elasticsearch client for logging.
@@ -160,8 +170,21 @@ func createElasticsearchBackoffFunc(config *RetrySettings) func(int) time.Durati
 	}
 }
 
-func pushDocuments(ctx context.Context, index string, document []byte, bulkIndexer *esBulkIndexerCurrent) error {
-	return bulkIndexer.Add(ctx, index, bytes.NewReader(document))
+func pushDocuments(ctx context.Context, index string, params bulkRequestParams, document []byte, bulkIndexer *esBulkIndexerCurrent) error {
+	return bulkIndexer.Add(ctx, index, params, bytes.NewReader(document))
+}
+
+// routingTransport sets the routing parameter of every request it performs.
+type routingTransport struct {
+	client  *elasticsearch7.Client
+	routing string
+}
+
+func (t *routingTransport) Perform(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	query.Set("routing", t.routing)
+	req.URL.RawQuery = query.Encode()
+	return t.client.Perform(req)
 }
 
 func newBulkIndexer(logger *zap.Logger, client *elasticsearch7.Client, config *Config) (*esBulkIndexerCurrent, error) {
@@ -194,18 +217,23 @@ func newBulkIndexer(logger *zap.Logger, client *elasticsearch7.Client, config *C
 	}
 	pool.wg.Add(numWorkers)
 
-	for i := 0; i < numWorkers; i++ {
-		bi, err := docappender.NewBulkIndexer(docappender.BulkIndexerConfig{
+	newIndexer := func(params bulkRequestParams) (*docappender.BulkIndexer, error) {
+		cfg := docappender.BulkIndexerConfig{
 			Client:                client,
 			MaxDocumentRetries:    maxDocRetry,
-			Pipeline:              config.Pipeline,
+			Pipeline:              params.pipeline,
 			RetryOnDocumentStatus: config.Retry.RetryOnStatus,
-		})
-		if err != nil {
-			return nil, err
 		}
+		if params.routing != "" {
+			cfg.Client = &routingTransport{client: client, routing: params.routing}
+		}
+		return docappender.NewBulkIndexer(cfg)
+	}
+
+	for i := 0; i < numWorkers; i++ {
 		w := worker{
-			indexer:       bi,
+			indexers:      make(map[bulkRequestParams]*workerIndexer),
+			newIndexer:    newIndexer,
 			items:         pool.items,
 			flushInterval: flushInterval,
 			flushTimeout:  config.Timeout,
@@ -234,10 +262,13 @@ type bulkIndexerPool struct {
 // Add adds an item to the bulk indexer pool.
 //
 // Adding an item after a call to Close() will panic.
-func (p *bulkIndexerPool) Add(ctx context.Context, index string, document io.WriterTo) error {
+func (p *bulkIndexerPool) Add(ctx context.Context, index string, params bulkRequestParams, document io.WriterTo) error {
 	item := esBulkIndexerItem{
-		Index: index,
-		Body:  document,
+		BulkIndexerItem: docappender.BulkIndexerItem{
+			Index: index,
+			Body:  document,
+		},
+		params: params,
 	}
 	select {
 	case <-ctx.Done():
@@ -263,8 +294,23 @@ func (p *bulkIndexerPool) Close(ctx context.Context) error {
 	}
 }
 
+// maxWorkerIndexers caps the number of bulk indexers of a worker. When the cap is reached, the
+// least recently used indexer is flushed and dropped to make room for a new one.
+const maxWorkerIndexers = 64
+
+// workerIndexer is a bulk indexer of a worker, flushed on its own schedule.
+type workerIndexer struct {
+	*docappender.BulkIndexer
+	// lastFlush is when the indexer was created or last flushed.
+	lastFlush time.Time
+	// lastUsed is when a document was last added to the indexer.
+	lastUsed time.Time
+}
+
 type worker struct {
-	indexer       *docappender.BulkIndexer
+	// indexers holds one bulk indexer per set of bulk request parameters.
+	indexers      map[bulkRequestParams]*workerIndexer
+	newIndexer    func(bulkRequestParams) (*docappender.BulkIndexer, error)
 	items         <-chan esBulkIndexerItem
 	flushInterval time.Duration
 	flushTimeout  time.Duration
@@ -276,38 +322,98 @@ type worker struct {
 }
 
 func (w *worker) run() {
-	flushTick := time.NewTicker(w.flushInterval)
-	defer flushTick.Stop()
+	flushTimer := time.NewTimer(w.flushInterval)
+	defer flushTimer.Stop()
 	for {
 		select {
 		case item, ok := <-w.items:
 			// if channel is closed, flush and return
 			if !ok {
-				w.flush()
+				for _, indexer := range w.indexers {
+					w.flushIndexer(indexer)
+				}
 				return
 			}
 
-			if err := w.indexer.Add(item); err != nil {
+			indexer, err := w.indexer(item.params)
+			if err != nil {
+				w.logger.Error("error creating bulk indexer", zap.Error(err))
+				continue
+			}
+			if err := indexer.Add(item.BulkIndexerItem); err != nil {
 				w.logger.Error("error adding item to bulk indexer", zap.Error(err))
 			}
+			indexer.lastUsed = time.Now()
 
-			// w.indexer.Len() can be either compressed or uncompressed bytes
-			if w.indexer.Len() >= w.flushBytes {
-				w.flush()
-				flushTick.Reset(w.flushInterval)
+			// indexer.Len() can be either compressed or uncompressed bytes
+			if indexer.Len() >= w.flushBytes {
+				w.flushIndexer(indexer)
 			}
-		case <-flushTick.C:
-			// bulk indexer needs to be flushed every flush interval because
-			// there may be pending bytes in bulk indexer buffer due to e.g. document level 429
-			w.flush()
+		case <-flushTimer.C:
+			flushTimer.Reset(w.flushDue(time.Now()))
+		}
+	}
+}
+
+// indexer returns the bulk indexer for the given parameters, creating it if needed.
+func (w *worker) indexer(params bulkRequestParams) (*workerIndexer, error) {
+	if indexer, ok := w.indexers[params]; ok {
+		return indexer, nil
+	}
+	if len(w.indexers) >= maxWorkerIndexers {
+		w.evictLeastRecentlyUsed()
+	}
+	bulkIndexer, err := w.newIndexer(params)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	indexer := &workerIndexer{BulkIndexer: bulkIndexer, lastFlush: now, lastUsed: now}
+	w.indexers[params] = indexer
+	return indexer, nil
+}
+
+// evictLeastRecentlyUsed flushes and drops the indexer which received a document the longest ago.
+func (w *worker) evictLeastRecentlyUsed() {
+	var oldestParams bulkRequestParams
+	var oldest *workerIndexer
+	for params, indexer := range w.indexers {
+		if oldest == nil || indexer.lastUsed.Before(oldest.lastUsed) {
+			oldestParams, oldest = params, indexer
+		}
+	}
+	if oldest != nil {
+		w.flushIndexer(oldest)
+		delete(w.indexers, oldestParams)
+	}
+}
+
+// flushDue flushes the indexers which were not flushed for a flush interval, and returns the
+// delay until the next indexer is due. Bulk indexers need to be flushed every flush interval
+// because there may be pending bytes in their buffer due to e.g. document level 429. Indexers
+// that did not receive any document for a flush interval are dropped, except for the last one.
+func (w *worker) flushDue(now time.Time) time.Duration {
+	next := w.flushInterval
+	for params, indexer := range w.indexers {
+		due := indexer.lastFlush.Add(w.flushInterval)
+		if now.Before(due) {
+			next = min(next, due.Sub(now))
+			continue
+		}
+		if indexer.Items() == 0 && now.Sub(indexer.lastUsed) >= w.flushInterval && len(w.indexers) > 1 {
+			delete(w.indexers, params)
+			continue
 		}
+		w.flushIndexer(indexer)
 	}
+	return next
 }
 
-func (w *worker) flush() {
+func (w *worker) flushIndexer(indexer *workerIndexer) {
+	indexer.lastFlush = time.Now()
 	ctx, cancel := context.WithTimeout(context.Background(), w.flushTimeout)
 	defer cancel()
-	stat, err := w.indexer.Flush(ctx)
+	stat, err := indexer.Flush(ctx)
 	w.stats.docsIndexed.Add(stat.Indexed)
 	if err != nil {
 		w.logger.Error("bulk indexer flush error", zap.Error(err))
@@ -6,12 +6,14 @@ package elasticsearchexporter
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/elastic/go-docappender/v2"
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,7 +65,7 @@ func TestBulkIndexer_flushOnClose(t *testing.T) {
 	require.NoError(t, err)
 	bulkIndexer, err := newBulkIndexer(zap.NewNop(), client, &cfg)
 	require.NoError(t, err)
-	assert.NoError(t, bulkIndexer.Add(context.Background(), "foo", strings.NewReader(`{"foo": "bar"}`)))
+	assert.NoError(t, bulkIndexer.Add(context.Background(), "foo", bulkRequestParams{}, strings.NewReader(`{"foo": "bar"}`)))
 	assert.NoError(t, bulkIndexer.Close(context.Background()))
 	assert.Equal(t, int64(1), bulkIndexer.stats.docsIndexed.Load())
 }
@@ -98,7 +100,7 @@ func TestBulkIndexer_flush(t *testing.T) {
 			require.NoError(t, err)
 			bulkIndexer, err := newBulkIndexer(zap.NewNop(), client, &tt.config)
 			require.NoError(t, err)
-			assert.NoError(t, bulkIndexer.Add(context.Background(), "foo", strings.NewReader(`{"foo": "bar"}`)))
+			assert.NoError(t, bulkIndexer.Add(context.Background(), "foo", bulkRequestParams{}, strings.NewReader(`{"foo": "bar"}`)))
 			// should flush
 			time.Sleep(100 * time.Millisecond)
 			assert.Equal(t, int64(1), bulkIndexer.stats.docsIndexed.Load())
@@ -152,7 +154,7 @@ func TestBulkIndexer_flush_error(t *testing.T) {
 			core, observed := observer.New(zap.NewAtomicLevelAt(zapcore.DebugLevel))
 			bulkIndexer, err := newBulkIndexer(zap.New(core), client, &cfg)
 			require.NoError(t, err)
-			assert.NoError(t, bulkIndexer.Add(context.Background(), "foo", strings.NewReader(`{"foo": "bar"}`)))
+			assert.NoError(t, bulkIndexer.Add(context.Background(), "foo", bulkRequestParams{}, strings.NewReader(`{"foo": "bar"}`)))
 			// should flush
 			time.Sleep(100 * time.Millisecond)
 			assert.Equal(t, int64(0), bulkIndexer.stats.docsIndexed.Load())
@@ -161,3 +163,74 @@ func TestBulkIndexer_flush_error(t *testing.T) {
 		})
 	}
 }
+
+func newTestWorker(t *testing.T) *worker {
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Transport: &mockTransport{
+		RoundTripFunc: func(*http.Request) (*http.Response, error) {
+			return &http.Response{
+				Header: http.Header{"X-Elastic-Product": []string{"Elasticsearch"}},
+				Body:   io.NopCloser(strings.NewReader(successResp)),
+			}, nil
+		},
+	}})
+	require.NoError(t, err)
+	return &worker{
+		indexers: make(map[bulkRequestParams]*workerIndexer),
+		newIndexer: func(bulkRequestParams) (*docappender.BulkIndexer, error) {
+			return docappender.NewBulkIndexer(docappender.BulkIndexerConfig{Client: client})
+		},
+		flushInterval: time.Minute,
+		flushTimeout:  time.Second,
+		flushBytes:    2 << 30,
+		stats:         &bulkIndexerStats{},
+		logger:        zap.NewNop(),
+	}
+}
+
+func addTestDocument(t *testing.T, w *worker, params bulkRequestParams) *workerIndexer {
+	indexer, err := w.indexer(params)
+	require.NoError(t, err)
+	require.NoError(t, indexer.Add(docappender.BulkIndexerItem{Index: "foo", Body: strings.NewReader(`{"foo": "bar"}`)}))
+	indexer.lastUsed = time.Now()
+	return indexer
+}
+
+func TestWorker_evictsLeastRecentlyUsedIndexer(t *testing.T) {
+	w := newTestWorker(t)
+	for i := 0; i < maxWorkerIndexers; i++ {
+		addTestDocument(t, w, bulkRequestParams{routing: fmt.Sprint(i)})
+	}
+	// routing 0 is the least recently used one once routing 1 to 63 received documents again.
+	for i := 1; i < maxWorkerIndexers; i++ {
+		addTestDocument(t, w, bulkRequestParams{routing: fmt.Sprint(i)})
+	}
+
+	addTestDocument(t, w, bulkRequestParams{routing: "new"})
+	assert.Len(t, w.indexers, maxWorkerIndexers)
+	assert.NotContains(t, w.indexers, bulkRequestParams{routing: "0"})
+	assert.Contains(t, w.indexers, bulkRequestParams{routing: "new"})
+	// The document of the evicted indexer was flushed.
+	assert.Equal(t, int64(1), w.stats.docsIndexed.Load())
+}
+
+func TestWorker_flushDue(t *testing.T) {
+	w := newTestWorker(t)
+	now := time.Now()
+	pending := addTestDocument(t, w, bulkRequestParams{routing: "pending"})
+	pending.lastFlush = now.Add(-2 * time.Minute)
+	idle, err := w.indexer(bulkRequestParams{routing: "idle"})
+	require.NoError(t, err)
+	idle.lastFlush = now.Add(-2 * time.Minute)
+	idle.lastUsed = now.Add(-2 * time.Minute)
+	recent := addTestDocument(t, w, bulkRequestParams{routing: "recent"})
+	recent.lastFlush = now.Add(-30 * time.Second)
+
+	next := w.flushDue(now)
+	// Only the indexer due for a flush was flushed, the idle one was dropped.
+	assert.Equal(t, int64(1), w.stats.docsIndexed.Load())
+	assert.Equal(t, 1, recent.Items())
+	assert.NotContains(t, w.indexers, bulkRequestParams{routing: "idle"})
+	assert.Len(t, w.indexers, 2)
+	// The next flush is due when the recent indexer reaches the flush interval.
+	assert.Equal(t, 30*time.Second, next)
+}
@@ -22,12 +22,16 @@ type elasticsearchExporter struct {
 	logstashFormat LogstashFormatSettings
 	dynamicIndex   bool
 
+	pipeline         string
+	dynamicPipeline  bool
+	routingAttribute string
+
 	client      *esClientCurrent
 	bulkIndexer *esBulkIndexerCurrent
 	model       mappingModel
 }
 
-func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex bool) (*elasticsearchExporter, error) {
+func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex bool, pipeline string) (*elasticsearchExporter, error) {
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
@@ -57,6 +61,10 @@ func newExporter(logger *zap.Logger, cfg *Config, index string, dynamicIndex boo
 		dynamicIndex:   dynamicIndex,
 		model:          model,
 		logstashFormat: cfg.LogstashFormat,
+
+		pipeline:         pipeline,
+		dynamicPipeline:  cfg.DynamicPipeline.Enabled,
+		routingAttribute: cfg.RoutingAttribute,
 	}, nil
 }
 
@@ -112,7 +120,7 @@ func (e *elasticsearchExporter) pushLogRecord(ctx context.Context, resource pcom
 	if err != nil {
 		return fmt.Errorf("Failed to encode log event: %w", err)
 	}
-	return pushDocuments(ctx, fIndex, document, e.bulkIndexer)
+	return pushDocuments(ctx, fIndex, e.bulkRequestParams(resource, scope, record), document, e.bulkIndexer)
 }
 
 func (e *elasticsearchExporter) pushTraceData(
@@ -165,5 +173,19 @@ func (e *elasticsearchExporter) pushTraceRecord(ctx context.Context, resource pc
 	if err != nil {
 		return fmt.Errorf("Failed to encode trace record: %w", err)
 	}
-	return pushDocuments(ctx, fIndex, document, e.bulkIndexer)
+	return pushDocuments(ctx, fIndex, e.bulkRequestParams(resource, scope, span), document, e.bulkIndexer)
+}
+
+// bulkRequestParams returns the ingest pipeline and routing of the document of a record.
+func (e *elasticsearchExporter) bulkRequestParams(resource, scope, record attrGetter) bulkRequestParams {
+	params := bulkRequestParams{pipeline: e.pipeline}
+	if e.dynamicPipeline {
+		if pipeline := getFromAttributes(ingestPipeline, resource, scope, record); pipeline != "" {
+			params.pipeline = pipeline
+		}
+	}
+	if e.routingAttribute != "" {
+		params.routing = getFromAttributes(e.routingAttribute, resource, scope, record)
+	}
+	return params
 }
@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"runtime"
 	"strings"
 	"sync"
@@ -215,6 +217,42 @@ func TestExporterLogs(t *testing.T) {
 		rec.WaitItems(1)
 	})
 
+	t.Run("publish with ingest pipeline and routing", func(t *testing.T) {
+		queries := make(chan url.Values, 2)
+		server := newESTestServerBulkHandlerFunc(t, func(w http.ResponseWriter, r *http.Request) {
+			queries <- r.URL.Query()
+			_, _ = io.Copy(io.Discard, r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(successResp))
+		})
+
+		exporter := newTestLogsExporter(t, server.URL, func(cfg *Config) {
+			cfg.Pipeline = "default-pipeline"
+			cfg.LogsPipeline = "logs-pipeline"
+			cfg.DynamicPipeline.Enabled = true
+			cfg.RoutingAttribute = "tenant"
+		})
+		logs := newLogsWithAttributeAndResourceMap(
+			map[string]string{"tenant": "a"},
+			nil,
+		)
+		record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().AppendEmpty()
+		record.Attributes().PutStr("tenant", "b")
+		record.Attributes().PutStr(ingestPipeline, "custom-pipeline")
+		mustSendLogs(t, exporter, logs)
+
+		var got []string
+		for i := 0; i < 2; i++ {
+			select {
+			case q := <-queries:
+				got = append(got, q.Get("pipeline")+"/"+q.Get("routing"))
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for bulk requests")
+			}
+		}
+		assert.ElementsMatch(t, []string{"logs-pipeline/a", "custom-pipeline/b"}, got)
+	})
+
 	t.Run("publish with logstash index format enabled and dynamic index disabled", func(t *testing.T) {
 		rec := newBulkRecorder()
 		server := newESTestServer(t, func(docs []itemRequest) ([]itemResponse, error) {
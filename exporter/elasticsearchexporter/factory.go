@@ -91,7 +91,12 @@ func createLogsExporter(
 
 	setDefaultUserAgentHeader(cf, set.BuildInfo)
 
-	exporter, err := newExporter(set.Logger, cf, index, cf.LogsDynamicIndex.Enabled)
+	pipeline := cf.Pipeline
+	if cf.LogsPipeline != "" {
+		pipeline = cf.LogsPipeline
+	}
+
+	exporter, err := newExporter(set.Logger, cf, index, cf.LogsDynamicIndex.Enabled, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("cannot configure Elasticsearch exporter: %w", err)
 	}
@@ -114,7 +119,12 @@ func createTracesExporter(ctx context.Context,
 
 	setDefaultUserAgentHeader(cf, set.BuildInfo)
 
-	exporter, err := newExporter(set.Logger, cf, cf.TracesIndex, cf.TracesDynamicIndex.Enabled)
+	pipeline := cf.Pipeline
+	if cf.TracesPipeline != "" {
+		pipeline = cf.TracesPipeline
+	}
+
+	exporter, err := newExporter(set.Logger, cf, cf.TracesIndex, cf.TracesDynamicIndex.Enabled, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("cannot configure Elasticsearch exporter: %w", err)
 	}
@@ -6,9 +6,11 @@ package fileexporter // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/lestrrat-go/strftime"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 )
@@ -38,8 +40,12 @@ type Config struct {
 	// Options:
 	// - json[default]:  OTLP json bytes.
 	// - proto:  OTLP binary protobuf bytes.
+	// - parquet:  Parquet file with a columnar schema derived from OTLP.
 	FormatType string `mapstructure:"format"`
 
+	// Parquet defines the settings of the parquet format.
+	Parquet ParquetConfig `mapstructure:"parquet"`
+
 	// Encoding defines the encoding of the telemetry data.
 	// If specified, it overrides `FormatType` and applies an encoding extension.
 	Encoding *component.ID `mapstructure:"encoding"`
@@ -77,6 +83,31 @@ type Rotation struct {
 	// backup files is the computer's local time.  The default is to use UTC
 	// time.
 	LocalTime bool `mapstructure:"localtime"`
+
+	// Interval is the duration after which the file is rotated, the intervals
+	// being aligned on the wall clock. The default is to rotate files on their
+	// size only.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Compression is the codec used to compress the rotated files.
+	// Supported compression algorithms:`zstd`
+	Compression string `mapstructure:"compression"`
+
+	// DirectoryLayout is a strftime template of the directory, relative to the
+	// directory of Path, to which the rotated files are moved, for instance
+	// `dt=%Y-%m-%d/hour=%H`. It is rendered with the time the file was opened.
+	DirectoryLayout string `mapstructure:"directory_layout"`
+}
+
+// ParquetConfig defines how the telemetry data is written with the parquet format.
+type ParquetConfig struct {
+	// Compression is the codec used to compress the column chunks.
+	// Options: none, snappy[default], gzip, zstd.
+	Compression string `mapstructure:"compression"`
+
+	// RowGroupSize is the number of rows buffered in memory before they are
+	// written to the file as a row group. Default is 10000.
+	RowGroupSize int `mapstructure:"row_group_size"`
 }
 
 type GroupBy struct {
@@ -107,15 +138,28 @@ func (cfg *Config) Validate() error {
 	if cfg.Append && cfg.Rotation != nil {
 		return fmt.Errorf("append and rotation enabled at the same time is not supported")
 	}
-	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
+	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto && cfg.FormatType != formatTypeParquet {
 		return errors.New("format type is not supported")
 	}
+	if cfg.FormatType == formatTypeParquet {
+		if err := cfg.validateParquet(); err != nil {
+			return err
+		}
+	}
 	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
 		return errors.New("compression is not supported")
 	}
 	if cfg.FlushInterval < 0 {
 		return errors.New("flush_interval must be larger than zero")
 	}
+	if cfg.Rotation != nil {
+		if err := cfg.Rotation.validate(); err != nil {
+			return err
+		}
+		if cfg.Compression != "" && cfg.Rotation.Compression != "" {
+			return errors.New("compression and rotation::compression enabled at the same time is not supported")
+		}
+	}
 
 	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
 		pathParts := strings.Split(cfg.Path, "*")
@@ -135,6 +179,46 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+func (r *Rotation) validate() error {
+	if r.Interval < 0 {
+		return errors.New("rotation interval must not be negative")
+	}
+	if r.Compression != "" && r.Compression != compressionZSTD {
+		return errors.New("rotation compression is not supported")
+	}
+	if r.DirectoryLayout != "" {
+		if !filepath.IsLocal(r.DirectoryLayout) {
+			return errors.New("rotation directory_layout must be a relative path within the directory of path")
+		}
+		if _, err := strftime.New(r.DirectoryLayout); err != nil {
+			return fmt.Errorf("invalid rotation directory_layout: %w", err)
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) validateParquet() error {
+	if cfg.Encoding != nil {
+		return errors.New("encoding is not supported with the parquet format")
+	}
+	if cfg.Append {
+		return errors.New("append is not supported with the parquet format")
+	}
+	if cfg.Compression != "" {
+		return errors.New("compression is not supported with the parquet format, use parquet::compression instead")
+	}
+	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
+		return errors.New("group_by is not supported with the parquet format")
+	}
+	if _, ok := parquetCompressions[cfg.Parquet.Compression]; !ok {
+		return fmt.Errorf("parquet compression %q is not supported", cfg.Parquet.Compression)
+	}
+	if cfg.Parquet.RowGroupSize <= 0 {
+		return errors.New("parquet row_group_size must be larger than zero")
+	}
+	return nil
+}
+
 // Unmarshal a confmap.Conf into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
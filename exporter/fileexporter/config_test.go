@@ -38,6 +38,7 @@ func TestLoadConfig(t *testing.T) {
 					LocalTime:    true,
 				},
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				FlushInterval: time.Second,
 				GroupBy: &GroupBy{
 					MaxOpenFiles:      defaultMaxOpenFiles,
@@ -56,6 +57,7 @@ func TestLoadConfig(t *testing.T) {
 					LocalTime:    true,
 				},
 				FormatType:    formatTypeProto,
+				Parquet:       defaultParquetConfig(),
 				Compression:   compressionZSTD,
 				FlushInterval: time.Second,
 				GroupBy: &GroupBy{
@@ -69,6 +71,7 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				Path:       "./foo",
 				FormatType: formatTypeJSON,
+				Parquet:    defaultParquetConfig(),
 				Rotation: &Rotation{
 					MaxBackups: defaultMaxBackups,
 				},
@@ -88,6 +91,7 @@ func TestLoadConfig(t *testing.T) {
 					MaxBackups:   defaultMaxBackups,
 				},
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				FlushInterval: time.Second,
 				GroupBy: &GroupBy{
 					MaxOpenFiles:      defaultMaxOpenFiles,
@@ -109,6 +113,7 @@ func TestLoadConfig(t *testing.T) {
 				Path:          "./flushed",
 				FlushInterval: 5,
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				GroupBy: &GroupBy{
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
@@ -121,6 +126,7 @@ func TestLoadConfig(t *testing.T) {
 				Path:          "./flushed",
 				FlushInterval: 5 * time.Second,
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				GroupBy: &GroupBy{
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
@@ -133,6 +139,7 @@ func TestLoadConfig(t *testing.T) {
 				Path:          "./flushed",
 				FlushInterval: 500 * time.Millisecond,
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				GroupBy: &GroupBy{
 					MaxOpenFiles:      defaultMaxOpenFiles,
 					ResourceAttribute: defaultResourceAttribute,
@@ -153,6 +160,7 @@ func TestLoadConfig(t *testing.T) {
 				Path:          "./group_by/*.json",
 				FlushInterval: time.Second,
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				GroupBy: &GroupBy{
 					Enabled:           true,
 					MaxOpenFiles:      10,
@@ -166,6 +174,7 @@ func TestLoadConfig(t *testing.T) {
 				Path:          "./group_by/*.json",
 				FlushInterval: time.Second,
 				FormatType:    formatTypeJSON,
+				Parquet:       defaultParquetConfig(),
 				GroupBy: &GroupBy{
 					Enabled:           true,
 					MaxOpenFiles:      defaultMaxOpenFiles,
@@ -185,6 +194,78 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "group_by_empty_resource_attribute"),
 			errorMessage: "resource_attribute must not be empty when group_by is enabled",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "rotation_data_lake"),
+			expected: &Config{
+				Path:       "./landing/traces.json",
+				FormatType: formatTypeJSON,
+				Parquet:    defaultParquetConfig(),
+				Rotation: &Rotation{
+					MaxMegabytes:    64,
+					MaxBackups:      defaultMaxBackups,
+					Interval:        time.Hour,
+					Compression:     compressionZSTD,
+					DirectoryLayout: "dt=%Y-%m-%d/hour=%H",
+				},
+				FlushInterval: time.Second,
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "rotation_interval_error"),
+			errorMessage: "rotation interval must not be negative",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "rotation_compression_error"),
+			errorMessage: "rotation compression is not supported",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "rotation_directory_layout_error"),
+			errorMessage: "rotation directory_layout must be a relative path within the directory of path",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "rotation_double_compression_error"),
+			errorMessage: "compression and rotation::compression enabled at the same time is not supported",
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "parquet"),
+			expected: &Config{
+				Path:       "./telemetry.parquet",
+				FormatType: formatTypeParquet,
+				Parquet: ParquetConfig{
+					Compression:  "zstd",
+					RowGroupSize: 50_000,
+				},
+				Rotation: &Rotation{
+					MaxMegabytes: 256,
+					MaxBackups:   defaultMaxBackups,
+				},
+				FlushInterval: time.Second,
+				GroupBy: &GroupBy{
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_compression_error"),
+			errorMessage: `parquet compression "lzo" is not supported`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_row_group_size_error"),
+			errorMessage: "parquet row_group_size must be larger than zero",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_append_error"),
+			errorMessage: "append is not supported with the parquet format",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "parquet_group_by_error"),
+			errorMessage: "group_by is not supported with the parquet format",
+		},
 	}
 
 	for _, tt := range tests {
@@ -206,3 +287,10 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func defaultParquetConfig() ParquetConfig {
+	return ParquetConfig{
+		Compression:  defaultParquetCompression,
+		RowGroupSize: defaultParquetRowGroupSize,
+	}
+}
@@ -30,6 +30,8 @@ const (
 	// the format of encoded telemetry data
 	formatTypeJSON  = "json"
 	formatTypeProto = "proto"
+	// formatTypeParquet writes the telemetry data as a parquet file
+	formatTypeParquet = "parquet"
 
 	// the type of compression codec
 	compressionZSTD = "zstd"
@@ -37,6 +39,9 @@ const (
 	defaultMaxOpenFiles = 100
 
 	defaultResourceAttribute = "fileexporter.path_segment"
+
+	defaultParquetCompression  = "snappy"
+	defaultParquetRowGroupSize = 10_000
 )
 
 type FileExporter interface {
@@ -59,7 +64,11 @@ func NewFactory() exporter.Factory {
 func createDefaultConfig() component.Config {
 	return &Config{
 		FormatType: formatTypeJSON,
-		Rotation:   &Rotation{MaxBackups: defaultMaxBackups},
+		Parquet: ParquetConfig{
+			Compression:  defaultParquetCompression,
+			RowGroupSize: defaultParquetRowGroupSize,
+		},
+		Rotation: &Rotation{MaxBackups: defaultMaxBackups},
 		GroupBy: &GroupBy{
 			ResourceAttribute: defaultResourceAttribute,
 			MaxOpenFiles:      defaultMaxOpenFiles,
@@ -160,6 +169,12 @@ func newFileWriter(path string, shouldAppend bool, rotation *Rotation, flushInte
 			return nil, err
 		}
 		wc = newBufferedWriteCloser(f)
+	} else if rotation.usesRollingWriter() {
+		rw, err := newRollingWriter(path, rotation, true)
+		if err != nil {
+			return nil, err
+		}
+		wc = rw
 	} else {
 		wc = &lumberjack.Logger{
 			Filename:   path,
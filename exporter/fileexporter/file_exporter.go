@@ -17,9 +17,13 @@ type fileExporter struct {
 	conf       *Config
 	marshaller *marshaller
 	writer     *fileWriter
+	parquet    *parquetWriter
 }
 
 func (e *fileExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	if e.parquet != nil {
+		return e.parquet.writeTraces(td)
+	}
 	buf, err := e.marshaller.marshalTraces(td)
 	if err != nil {
 		return err
@@ -28,6 +32,9 @@ func (e *fileExporter) consumeTraces(_ context.Context, td ptrace.Traces) error
 }
 
 func (e *fileExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	if e.parquet != nil {
+		return e.parquet.writeMetrics(md)
+	}
 	buf, err := e.marshaller.marshalMetrics(md)
 	if err != nil {
 		return err
@@ -36,6 +43,9 @@ func (e *fileExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) err
 }
 
 func (e *fileExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	if e.parquet != nil {
+		return e.parquet.writeLogs(ld)
+	}
 	buf, err := e.marshaller.marshalLogs(ld)
 	if err != nil {
 		return err
@@ -46,6 +56,11 @@ func (e *fileExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
 // Start starts the flush timer if set.
 func (e *fileExporter) Start(_ context.Context, host component.Host) error {
 	var err error
+	if e.conf.FormatType == formatTypeParquet {
+		e.parquet, err = newParquetWriter(e.conf.Path, e.conf.Rotation, e.conf.Parquet, e.conf.FlushInterval)
+		return err
+	}
+
 	e.marshaller, err = newMarshaller(e.conf, host)
 	if err != nil {
 		return err
@@ -63,6 +78,11 @@ func (e *fileExporter) Start(_ context.Context, host component.Host) error {
 // Shutdown stops the exporter and is invoked during shutdown.
 // It stops the flush ticker if set.
 func (e *fileExporter) Shutdown(context.Context) error {
+	if e.parquet != nil {
+		p := e.parquet
+		e.parquet = nil
+		return p.shutdown()
+	}
 	if e.writer == nil {
 		return nil
 	}
@@ -0,0 +1,458 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// The parquet schemas flatten the OTLP structures to one row per span, log record
+// or metric data point, the resource and scope being repeated on each row.
+// Attributes are stored as maps of strings, so that they can be queried by key.
+// All the columns are nullable, the columns which don't apply to a row are null.
+var (
+	attributesType = arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)
+
+	tracesSchema = arrow.NewSchema(withResourceFields(
+		nullable("trace_id", arrow.BinaryTypes.String),
+		nullable("span_id", arrow.BinaryTypes.String),
+		nullable("parent_span_id", arrow.BinaryTypes.String),
+		nullable("trace_state", arrow.BinaryTypes.String),
+		nullable("name", arrow.BinaryTypes.String),
+		nullable("kind", arrow.BinaryTypes.String),
+		nullable("start_time", arrow.FixedWidthTypes.Timestamp_ns),
+		nullable("end_time", arrow.FixedWidthTypes.Timestamp_ns),
+		nullable("duration_ns", arrow.PrimitiveTypes.Int64),
+		nullable("attributes", attributesType),
+		nullable("status_code", arrow.BinaryTypes.String),
+		nullable("status_message", arrow.BinaryTypes.String),
+		nullable("events", arrow.ListOf(arrow.StructOf(
+			nullable("time", arrow.FixedWidthTypes.Timestamp_ns),
+			nullable("name", arrow.BinaryTypes.String),
+			nullable("attributes", attributesType),
+		))),
+		nullable("links", arrow.ListOf(arrow.StructOf(
+			nullable("trace_id", arrow.BinaryTypes.String),
+			nullable("span_id", arrow.BinaryTypes.String),
+			nullable("trace_state", arrow.BinaryTypes.String),
+			nullable("attributes", attributesType),
+		))),
+	), nil)
+
+	logsSchema = arrow.NewSchema(withResourceFields(
+		nullable("time", arrow.FixedWidthTypes.Timestamp_ns),
+		nullable("observed_time", arrow.FixedWidthTypes.Timestamp_ns),
+		nullable("trace_id", arrow.BinaryTypes.String),
+		nullable("span_id", arrow.BinaryTypes.String),
+		nullable("flags", arrow.PrimitiveTypes.Uint32),
+		nullable("severity_number", arrow.PrimitiveTypes.Int32),
+		nullable("severity_text", arrow.BinaryTypes.String),
+		nullable("body", arrow.BinaryTypes.String),
+		nullable("attributes", attributesType),
+	), nil)
+
+	metricsSchema = arrow.NewSchema(withResourceFields(
+		nullable("metric_name", arrow.BinaryTypes.String),
+		nullable("metric_description", arrow.BinaryTypes.String),
+		nullable("metric_unit", arrow.BinaryTypes.String),
+		nullable("metric_type", arrow.BinaryTypes.String),
+		nullable("aggregation_temporality", arrow.BinaryTypes.String),
+		nullable("is_monotonic", arrow.FixedWidthTypes.Boolean),
+		nullable("start_time", arrow.FixedWidthTypes.Timestamp_ns),
+		nullable("time", arrow.FixedWidthTypes.Timestamp_ns),
+		nullable("attributes", attributesType),
+		nullable("flags", arrow.PrimitiveTypes.Uint32),
+		nullable("int_value", arrow.PrimitiveTypes.Int64),
+		nullable("double_value", arrow.PrimitiveTypes.Float64),
+		nullable("count", arrow.PrimitiveTypes.Uint64),
+		nullable("sum", arrow.PrimitiveTypes.Float64),
+		nullable("min", arrow.PrimitiveTypes.Float64),
+		nullable("max", arrow.PrimitiveTypes.Float64),
+		nullable("bucket_counts", arrow.ListOf(arrow.PrimitiveTypes.Uint64)),
+		nullable("explicit_bounds", arrow.ListOf(arrow.PrimitiveTypes.Float64)),
+		nullable("scale", arrow.PrimitiveTypes.Int32),
+		nullable("zero_count", arrow.PrimitiveTypes.Uint64),
+		nullable("positive_offset", arrow.PrimitiveTypes.Int32),
+		nullable("positive_bucket_counts", arrow.ListOf(arrow.PrimitiveTypes.Uint64)),
+		nullable("negative_offset", arrow.PrimitiveTypes.Int32),
+		nullable("negative_bucket_counts", arrow.ListOf(arrow.PrimitiveTypes.Uint64)),
+		nullable("quantiles", arrow.ListOf(arrow.StructOf(
+			nullable("quantile", arrow.PrimitiveTypes.Float64),
+			nullable("value", arrow.PrimitiveTypes.Float64),
+		))),
+	), nil)
+)
+
+func nullable(name string, dt arrow.DataType) arrow.Field {
+	return arrow.Field{Name: name, Type: dt, Nullable: true}
+}
+
+// withResourceFields prepends the resource and scope columns, the resource attributes
+// column being the first one as it is set on every row.
+func withResourceFields(fields ...arrow.Field) []arrow.Field {
+	return append([]arrow.Field{
+		nullable("resource_attributes", attributesType),
+		nullable("scope_name", arrow.BinaryTypes.String),
+		nullable("scope_version", arrow.BinaryTypes.String),
+	}, fields...)
+}
+
+// column returns the builder of the named column.
+func column[T array.Builder](b *array.RecordBuilder, name string) T {
+	return b.Field(b.Schema().FieldIndices(name)[0]).(T)
+}
+
+// endRow appends a null to the columns which weren't set for the current row.
+func endRow(b *array.RecordBuilder) {
+	rows := b.Field(0).Len()
+	for _, f := range b.Fields() {
+		if f.Len() < rows {
+			f.AppendNull()
+		}
+	}
+}
+
+// appendResource appends the columns added by withResourceFields.
+func appendResource(b *array.RecordBuilder, resource pcommon.Resource, scope pcommon.InstrumentationScope) {
+	appendAttributes(b.Field(0).(*array.MapBuilder), resource.Attributes())
+	appendString(b.Field(1).(*array.StringBuilder), scope.Name())
+	appendString(b.Field(2).(*array.StringBuilder), scope.Version())
+}
+
+func appendAttributes(b *array.MapBuilder, attributes pcommon.Map) {
+	b.Append(true)
+	keys := b.KeyBuilder().(*array.StringBuilder)
+	items := b.ItemBuilder().(*array.StringBuilder)
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		keys.Append(k)
+		items.Append(v.AsString())
+		return true
+	})
+}
+
+func appendString(b *array.StringBuilder, s string) {
+	if s == "" {
+		b.AppendNull()
+		return
+	}
+	b.Append(s)
+}
+
+func appendTimestamp(b *array.TimestampBuilder, ts pcommon.Timestamp) {
+	if ts == 0 {
+		b.AppendNull()
+		return
+	}
+	b.Append(arrow.Timestamp(ts))
+}
+
+func appendTraceID(b *array.StringBuilder, id pcommon.TraceID) {
+	if id.IsEmpty() {
+		b.AppendNull()
+		return
+	}
+	b.Append(id.String())
+}
+
+func appendSpanID(b *array.StringBuilder, id pcommon.SpanID) {
+	if id.IsEmpty() {
+		b.AppendNull()
+		return
+	}
+	b.Append(id.String())
+}
+
+func appendUint64s(b *array.ListBuilder, values pcommon.UInt64Slice) {
+	b.Append(true)
+	b.ValueBuilder().(*array.Uint64Builder).AppendValues(values.AsRaw(), nil)
+}
+
+func appendTraces(b *array.RecordBuilder, td ptrace.Traces) {
+	traceIDs := column[*array.StringBuilder](b, "trace_id")
+	spanIDs := column[*array.StringBuilder](b, "span_id")
+	parentSpanIDs := column[*array.StringBuilder](b, "parent_span_id")
+	traceStates := column[*array.StringBuilder](b, "trace_state")
+	names := column[*array.StringBuilder](b, "name")
+	kinds := column[*array.StringBuilder](b, "kind")
+	startTimes := column[*array.TimestampBuilder](b, "start_time")
+	endTimes := column[*array.TimestampBuilder](b, "end_time")
+	durations := column[*array.Int64Builder](b, "duration_ns")
+	attributes := column[*array.MapBuilder](b, "attributes")
+	statusCodes := column[*array.StringBuilder](b, "status_code")
+	statusMessages := column[*array.StringBuilder](b, "status_message")
+	events := column[*array.ListBuilder](b, "events")
+	links := column[*array.ListBuilder](b, "links")
+
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				appendResource(b, rs.Resource(), ss.Scope())
+				appendTraceID(traceIDs, span.TraceID())
+				appendSpanID(spanIDs, span.SpanID())
+				appendSpanID(parentSpanIDs, span.ParentSpanID())
+				appendString(traceStates, span.TraceState().AsRaw())
+				names.Append(span.Name())
+				kinds.Append(span.Kind().String())
+				appendTimestamp(startTimes, span.StartTimestamp())
+				appendTimestamp(endTimes, span.EndTimestamp())
+				durations.Append(int64(span.EndTimestamp() - span.StartTimestamp()))
+				appendAttributes(attributes, span.Attributes())
+				statusCodes.Append(span.Status().Code().String())
+				appendString(statusMessages, span.Status().Message())
+				appendEvents(events, span.Events())
+				appendLinks(links, span.Links())
+				endRow(b)
+			}
+		}
+	}
+}
+
+func appendEvents(b *array.ListBuilder, events ptrace.SpanEventSlice) {
+	b.Append(true)
+	sb := b.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		sb.Append(true)
+		appendTimestamp(sb.FieldBuilder(0).(*array.TimestampBuilder), event.Timestamp())
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(event.Name())
+		appendAttributes(sb.FieldBuilder(2).(*array.MapBuilder), event.Attributes())
+	}
+}
+
+func appendLinks(b *array.ListBuilder, links ptrace.SpanLinkSlice) {
+	b.Append(true)
+	sb := b.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		sb.Append(true)
+		appendTraceID(sb.FieldBuilder(0).(*array.StringBuilder), link.TraceID())
+		appendSpanID(sb.FieldBuilder(1).(*array.StringBuilder), link.SpanID())
+		appendString(sb.FieldBuilder(2).(*array.StringBuilder), link.TraceState().AsRaw())
+		appendAttributes(sb.FieldBuilder(3).(*array.MapBuilder), link.Attributes())
+	}
+}
+
+func appendLogs(b *array.RecordBuilder, ld plog.Logs) {
+	times := column[*array.TimestampBuilder](b, "time")
+	observedTimes := column[*array.TimestampBuilder](b, "observed_time")
+	traceIDs := column[*array.StringBuilder](b, "trace_id")
+	spanIDs := column[*array.StringBuilder](b, "span_id")
+	flags := column[*array.Uint32Builder](b, "flags")
+	severityNumbers := column[*array.Int32Builder](b, "severity_number")
+	severityTexts := column[*array.StringBuilder](b, "severity_text")
+	bodies := column[*array.StringBuilder](b, "body")
+	attributes := column[*array.MapBuilder](b, "attributes")
+
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				record := sl.LogRecords().At(k)
+				appendResource(b, rl.Resource(), sl.Scope())
+				appendTimestamp(times, record.Timestamp())
+				appendTimestamp(observedTimes, record.ObservedTimestamp())
+				appendTraceID(traceIDs, record.TraceID())
+				appendSpanID(spanIDs, record.SpanID())
+				flags.Append(uint32(record.Flags()))
+				severityNumbers.Append(int32(record.SeverityNumber()))
+				appendString(severityTexts, record.SeverityText())
+				if record.Body().Type() != pcommon.ValueTypeEmpty {
+					bodies.Append(record.Body().AsString())
+				}
+				appendAttributes(attributes, record.Attributes())
+				endRow(b)
+			}
+		}
+	}
+}
+
+func appendMetrics(b *array.RecordBuilder, md pmetric.Metrics) {
+	c := newMetricColumns(b)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				c.appendMetric(b, rm.Resource(), sm.Scope(), sm.Metrics().At(k))
+			}
+		}
+	}
+}
+
+// metricColumns holds the builders of the metrics schema.
+type metricColumns struct {
+	names                *array.StringBuilder
+	descriptions         *array.StringBuilder
+	units                *array.StringBuilder
+	types                *array.StringBuilder
+	temporalities        *array.StringBuilder
+	monotonic            *array.BooleanBuilder
+	startTimes           *array.TimestampBuilder
+	times                *array.TimestampBuilder
+	attributes           *array.MapBuilder
+	flags                *array.Uint32Builder
+	intValues            *array.Int64Builder
+	doubleValues         *array.Float64Builder
+	counts               *array.Uint64Builder
+	sums                 *array.Float64Builder
+	mins                 *array.Float64Builder
+	maxs                 *array.Float64Builder
+	bucketCounts         *array.ListBuilder
+	explicitBounds       *array.ListBuilder
+	scales               *array.Int32Builder
+	zeroCounts           *array.Uint64Builder
+	positiveOffsets      *array.Int32Builder
+	positiveBucketCounts *array.ListBuilder
+	negativeOffsets      *array.Int32Builder
+	negativeBucketCounts *array.ListBuilder
+	quantiles            *array.ListBuilder
+}
+
+func newMetricColumns(b *array.RecordBuilder) *metricColumns {
+	return &metricColumns{
+		names:                column[*array.StringBuilder](b, "metric_name"),
+		descriptions:         column[*array.StringBuilder](b, "metric_description"),
+		units:                column[*array.StringBuilder](b, "metric_unit"),
+		types:                column[*array.StringBuilder](b, "metric_type"),
+		temporalities:        column[*array.StringBuilder](b, "aggregation_temporality"),
+		monotonic:            column[*array.BooleanBuilder](b, "is_monotonic"),
+		startTimes:           column[*array.TimestampBuilder](b, "start_time"),
+		times:                column[*array.TimestampBuilder](b, "time"),
+		attributes:           column[*array.MapBuilder](b, "attributes"),
+		flags:                column[*array.Uint32Builder](b, "flags"),
+		intValues:            column[*array.Int64Builder](b, "int_value"),
+		doubleValues:         column[*array.Float64Builder](b, "double_value"),
+		counts:               column[*array.Uint64Builder](b, "count"),
+		sums:                 column[*array.Float64Builder](b, "sum"),
+		mins:                 column[*array.Float64Builder](b, "min"),
+		maxs:                 column[*array.Float64Builder](b, "max"),
+		bucketCounts:         column[*array.ListBuilder](b, "bucket_counts"),
+		explicitBounds:       column[*array.ListBuilder](b, "explicit_bounds"),
+		scales:               column[*array.Int32Builder](b, "scale"),
+		zeroCounts:           column[*array.Uint64Builder](b, "zero_count"),
+		positiveOffsets:      column[*array.Int32Builder](b, "positive_offset"),
+		positiveBucketCounts: column[*array.ListBuilder](b, "positive_bucket_counts"),
+		negativeOffsets:      column[*array.Int32Builder](b, "negative_offset"),
+		negativeBucketCounts: column[*array.ListBuilder](b, "negative_bucket_counts"),
+		quantiles:            column[*array.ListBuilder](b, "quantiles"),
+	}
+}
+
+// startRow appends the columns shared by all the data points of a metric.
+func (c *metricColumns) startRow(b *array.RecordBuilder, resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric) {
+	appendResource(b, resource, scope)
+	c.names.Append(metric.Name())
+	appendString(c.descriptions, metric.Description())
+	appendString(c.units, metric.Unit())
+	c.types.Append(metric.Type().String())
+}
+
+func (c *metricColumns) appendPoint(startTime, time pcommon.Timestamp, attributes pcommon.Map, flags pmetric.DataPointFlags) {
+	appendTimestamp(c.startTimes, startTime)
+	appendTimestamp(c.times, time)
+	appendAttributes(c.attributes, attributes)
+	c.flags.Append(uint32(flags))
+}
+
+func (c *metricColumns) appendNumberPoints(b *array.RecordBuilder, resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric, points pmetric.NumberDataPointSlice) {
+	for i := 0; i < points.Len(); i++ {
+		dp := points.At(i)
+		c.startRow(b, resource, scope, metric)
+		if metric.Type() == pmetric.MetricTypeSum {
+			c.temporalities.Append(metric.Sum().AggregationTemporality().String())
+			c.monotonic.Append(metric.Sum().IsMonotonic())
+		}
+		c.appendPoint(dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			c.intValues.Append(dp.IntValue())
+		case pmetric.NumberDataPointValueTypeDouble:
+			c.doubleValues.Append(dp.DoubleValue())
+		}
+		endRow(b)
+	}
+}
+
+func (c *metricColumns) appendMetric(b *array.RecordBuilder, resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		c.appendNumberPoints(b, resource, scope, metric, metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		c.appendNumberPoints(b, resource, scope, metric, metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		points := metric.Histogram().DataPoints()
+		for i := 0; i < points.Len(); i++ {
+			dp := points.At(i)
+			c.startRow(b, resource, scope, metric)
+			c.temporalities.Append(metric.Histogram().AggregationTemporality().String())
+			c.appendPoint(dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			c.counts.Append(dp.Count())
+			if dp.HasSum() {
+				c.sums.Append(dp.Sum())
+			}
+			if dp.HasMin() {
+				c.mins.Append(dp.Min())
+			}
+			if dp.HasMax() {
+				c.maxs.Append(dp.Max())
+			}
+			appendUint64s(c.bucketCounts, dp.BucketCounts())
+			c.explicitBounds.Append(true)
+			c.explicitBounds.ValueBuilder().(*array.Float64Builder).AppendValues(dp.ExplicitBounds().AsRaw(), nil)
+			endRow(b)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		points := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < points.Len(); i++ {
+			dp := points.At(i)
+			c.startRow(b, resource, scope, metric)
+			c.temporalities.Append(metric.ExponentialHistogram().AggregationTemporality().String())
+			c.appendPoint(dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			c.counts.Append(dp.Count())
+			if dp.HasSum() {
+				c.sums.Append(dp.Sum())
+			}
+			if dp.HasMin() {
+				c.mins.Append(dp.Min())
+			}
+			if dp.HasMax() {
+				c.maxs.Append(dp.Max())
+			}
+			c.scales.Append(dp.Scale())
+			c.zeroCounts.Append(dp.ZeroCount())
+			c.positiveOffsets.Append(dp.Positive().Offset())
+			appendUint64s(c.positiveBucketCounts, dp.Positive().BucketCounts())
+			c.negativeOffsets.Append(dp.Negative().Offset())
+			appendUint64s(c.negativeBucketCounts, dp.Negative().BucketCounts())
+			endRow(b)
+		}
+	case pmetric.MetricTypeSummary:
+		points := metric.Summary().DataPoints()
+		for i := 0; i < points.Len(); i++ {
+			dp := points.At(i)
+			c.startRow(b, resource, scope, metric)
+			c.appendPoint(dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			c.counts.Append(dp.Count())
+			c.sums.Append(dp.Sum())
+			c.quantiles.Append(true)
+			sb := c.quantiles.ValueBuilder().(*array.StructBuilder)
+			for j := 0; j < dp.QuantileValues().Len(); j++ {
+				q := dp.QuantileValues().At(j)
+				sb.Append(true)
+				sb.FieldBuilder(0).(*array.Float64Builder).Append(q.Quantile())
+				sb.FieldBuilder(1).(*array.Float64Builder).Append(q.Value())
+			}
+			endRow(b)
+		}
+	}
+}
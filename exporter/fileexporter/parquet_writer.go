@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/compress"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var parquetCompressions = map[string]compress.Compression{
+	"none":   compress.Codecs.Uncompressed,
+	"snappy": compress.Codecs.Snappy,
+	"gzip":   compress.Codecs.Gzip,
+	"zstd":   compress.Codecs.Zstd,
+}
+
+// parquetWriter writes telemetry data to a parquet file. The rows are buffered in
+// memory until a row group is complete, and the file footer is written when the
+// file is closed, the file being readable only then.
+type parquetWriter struct {
+	mutex sync.Mutex
+
+	file         io.WriteCloser
+	rolling      *rollingWriter
+	maxFileSize  int64
+	props        *parquet.WriterProperties
+	rowGroupSize int
+
+	builder *array.RecordBuilder
+	sink    *parquetSink
+	writer  *pqarrow.FileWriter
+
+	checkTicker *time.Ticker
+	stopTicker  chan struct{}
+}
+
+// parquetSink counts the bytes written to the current parquet file, and keeps
+// the underlying file open when the parquet writer is closed, so that the
+// following parquet file can be written to it after a rotation.
+type parquetSink struct {
+	w    io.Writer
+	size int64
+}
+
+func (s *parquetSink) Write(p []byte) (int, error) {
+	n, err := s.w.Write(p)
+	s.size += int64(n)
+	return n, err
+}
+
+func (s *parquetSink) Close() error {
+	return nil
+}
+
+func newParquetWriter(path string, rotation *Rotation, cfg ParquetConfig, checkInterval time.Duration) (*parquetWriter, error) {
+	w := &parquetWriter{
+		props: parquet.NewWriterProperties(
+			parquet.WithCompression(parquetCompressions[cfg.Compression]),
+			parquet.WithMaxRowGroupLength(int64(cfg.RowGroupSize)),
+		),
+		rowGroupSize: cfg.RowGroupSize,
+	}
+	if rotation == nil {
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return nil, err
+		}
+		w.file = newBufferedWriteCloser(f)
+		return w, nil
+	}
+
+	w.maxFileSize = int64(rotation.MaxMegabytes) << 20
+	if w.maxFileSize == 0 {
+		w.maxFileSize = defaultRotationMaxMegabytes << 20
+	}
+	if rotation.usesRollingWriter() {
+		rw, err := newRollingWriter(path, rotation, false)
+		if err != nil {
+			return nil, err
+		}
+		w.file = rw
+		w.rolling = rw
+		if rotation.Interval > 0 && checkInterval > 0 {
+			w.startIntervalCheck(checkInterval)
+		}
+		return w, nil
+	}
+	w.file = &lumberjack.Logger{
+		Filename: path,
+		// the files are rotated by the parquet writer once a row group has been
+		// written, as a parquet file must not be split.
+		MaxSize:    math.MaxInt32,
+		MaxAge:     rotation.MaxDays,
+		MaxBackups: rotation.MaxBackups,
+		LocalTime:  rotation.LocalTime,
+	}
+	return w, nil
+}
+
+// startIntervalCheck periodically closes the current file once the rotation interval
+// has elapsed, so that files are rotated even when no data is received.
+func (w *parquetWriter) startIntervalCheck(checkInterval time.Duration) {
+	w.stopTicker = make(chan struct{})
+	w.checkTicker = time.NewTicker(checkInterval)
+	go func() {
+		for {
+			select {
+			case <-w.checkTicker.C:
+				w.mutex.Lock()
+				if w.writer != nil && w.rolling.intervalElapsed() {
+					_ = w.closeFile()
+				}
+				w.mutex.Unlock()
+			case <-w.stopTicker:
+				w.checkTicker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (w *parquetWriter) writeTraces(td ptrace.Traces) error {
+	return w.write(tracesSchema, func(b *array.RecordBuilder) {
+		appendTraces(b, td)
+	})
+}
+
+func (w *parquetWriter) writeMetrics(md pmetric.Metrics) error {
+	return w.write(metricsSchema, func(b *array.RecordBuilder) {
+		appendMetrics(b, md)
+	})
+}
+
+func (w *parquetWriter) writeLogs(ld plog.Logs) error {
+	return w.write(logsSchema, func(b *array.RecordBuilder) {
+		appendLogs(b, ld)
+	})
+}
+
+func (w *parquetWriter) write(schema *arrow.Schema, appendRows func(b *array.RecordBuilder)) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.builder == nil {
+		w.builder = array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	} else if w.builder.Schema() != schema {
+		return errors.New("a parquet file holds a single signal, the file exporter must be used in pipelines of a single signal type")
+	}
+
+	if w.writer != nil && w.rolling != nil && w.rolling.intervalElapsed() {
+		if err := w.closeFile(); err != nil {
+			return err
+		}
+	}
+
+	appendRows(w.builder)
+	rows := w.builder.Field(0).Len()
+	if rows == 0 {
+		return nil
+	}
+	// the file is opened with the first row, so that its rotation interval starts then
+	if w.writer == nil {
+		if err := w.open(schema); err != nil {
+			return err
+		}
+	}
+	if rows < w.rowGroupSize {
+		return nil
+	}
+	return w.flushRowGroup()
+}
+
+// flushRowGroup writes the buffered rows as a row group, and closes the current
+// parquet file when it has to be rotated.
+func (w *parquetWriter) flushRowGroup() error {
+	rec := w.builder.NewRecord()
+	defer rec.Release()
+
+	if err := w.writer.Write(rec); err != nil {
+		return err
+	}
+	if ff, ok := w.file.(interface{ flush() error }); ok {
+		if err := ff.flush(); err != nil {
+			return err
+		}
+	}
+
+	if (w.maxFileSize > 0 && w.sink.size >= w.maxFileSize) || (w.rolling != nil && w.rolling.intervalElapsed()) {
+		return w.closeFile()
+	}
+	return nil
+}
+
+// open starts a new parquet file, the previous file being rotated when rotation is enabled.
+func (w *parquetWriter) open(schema *arrow.Schema) error {
+	if r, ok := w.file.(interface{ Rotate() error }); ok {
+		if err := r.Rotate(); err != nil {
+			return err
+		}
+	}
+	w.sink = &parquetSink{w: w.file}
+	writer, err := pqarrow.NewFileWriter(schema, w.sink, w.props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return fmt.Errorf("failed to create the parquet writer: %w", err)
+	}
+	w.writer = writer
+	return nil
+}
+
+// closeFile writes the buffered rows and the footer of the current parquet file.
+// The rolling writer rotates the file right away, so that it is moved to its layout
+// directory, while lumberjack rotates it when the next file is opened.
+func (w *parquetWriter) closeFile() error {
+	if w.builder.Field(0).Len() > 0 {
+		rec := w.builder.NewRecord()
+		defer rec.Release()
+		if err := w.writer.Write(rec); err != nil {
+			return err
+		}
+	}
+	writer := w.writer
+	w.writer = nil
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if w.rolling != nil {
+		return w.rolling.Rotate()
+	}
+	return nil
+}
+
+// shutdown writes the buffered rows and closes the file.
+func (w *parquetWriter) shutdown() error {
+	if w.checkTicker != nil {
+		close(w.stopTicker)
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	var errs error
+	if w.writer != nil {
+		errs = w.closeFile()
+	}
+	if w.builder != nil {
+		w.builder.Release()
+		w.builder = nil
+	}
+	return errors.Join(errs, w.file.Close())
+}
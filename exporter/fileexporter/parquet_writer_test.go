@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+func newParquetExporter(t *testing.T, path string, rowGroupSize int) *fileExporter {
+	fe := &fileExporter{
+		conf: &Config{
+			Path:       path,
+			FormatType: formatTypeParquet,
+			Parquet: ParquetConfig{
+				Compression:  defaultParquetCompression,
+				RowGroupSize: rowGroupSize,
+			},
+		},
+	}
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+	return fe
+}
+
+// readParquet returns the rows of a parquet file and its number of row groups.
+func readParquet(t *testing.T, path string) (arrow.Record, int) {
+	reader, err := file.OpenParquetFile(path, false)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	fr, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+
+	tr := array.NewTableReader(table, -1)
+	defer tr.Release()
+	require.True(t, tr.Next())
+	rec := tr.Record()
+	rec.Retain()
+	t.Cleanup(rec.Release)
+	return rec, reader.NumRowGroups()
+}
+
+// assertParquetSchema compares the columns of a schema read from a parquet file,
+// which holds additional field metadata.
+func assertParquetSchema(t *testing.T, expected, actual *arrow.Schema) {
+	require.Equal(t, expected.NumFields(), actual.NumFields())
+	for i, f := range expected.Fields() {
+		assert.Equal(t, f.Name, actual.Field(i).Name)
+		assert.Truef(t, arrow.TypeEqual(f.Type, actual.Field(i).Type), "column %q has the type %s", f.Name, actual.Field(i).Type)
+	}
+}
+
+func stringColumn(t *testing.T, rec arrow.Record, name string) *array.String {
+	indices := rec.Schema().FieldIndices(name)
+	require.Len(t, indices, 1)
+	return rec.Column(indices[0]).(*array.String)
+}
+
+func TestParquetTracesExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.parquet")
+	fe := newParquetExporter(t, path, 10)
+
+	td := testdata.GenerateTracesTwoSpansSameResourceOneDifferent()
+	require.NoError(t, fe.consumeTraces(context.Background(), td))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	rec, rowGroups := readParquet(t, path)
+	assertParquetSchema(t, tracesSchema, rec.Schema())
+	assert.EqualValues(t, 3, rec.NumRows())
+	assert.Equal(t, 1, rowGroups)
+
+	names := stringColumn(t, rec, "name")
+	assert.Equal(t, "operationA", names.Value(0))
+	assert.Equal(t, "operationB", names.Value(1))
+	assert.Equal(t, "operationC", names.Value(2))
+
+	statusCodes := stringColumn(t, rec, "status_code")
+	assert.Equal(t, "Error", statusCodes.Value(0))
+	assert.True(t, stringColumn(t, rec, "trace_id").IsNull(0))
+
+	events := rec.Column(rec.Schema().FieldIndices("events")[0]).(*array.List)
+	start, end := events.ValueOffsets(0)
+	assert.EqualValues(t, 2, end-start)
+	links := rec.Column(rec.Schema().FieldIndices("links")[0]).(*array.List)
+	start, end = links.ValueOffsets(1)
+	assert.EqualValues(t, 2, end-start)
+
+	resourceAttributes := rec.Column(0).(*array.Map)
+	keys := resourceAttributes.Keys().(*array.String)
+	items := resourceAttributes.Items().(*array.String)
+	start, _ = resourceAttributes.ValueOffsets(0)
+	assert.Equal(t, "resource-attr", keys.Value(int(start)))
+	assert.Equal(t, "resource-attr-val-1", items.Value(int(start)))
+}
+
+func TestParquetLogsExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.parquet")
+	fe := newParquetExporter(t, path, 10)
+
+	ld := testdata.GenerateLogsTwoLogRecordsSameResource()
+	require.NoError(t, fe.consumeLogs(context.Background(), ld))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	rec, _ := readParquet(t, path)
+	assertParquetSchema(t, logsSchema, rec.Schema())
+	assert.EqualValues(t, 2, rec.NumRows())
+
+	record := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, record.Body().AsString(), stringColumn(t, rec, "body").Value(0))
+	assert.Equal(t, record.SeverityText(), stringColumn(t, rec, "severity_text").Value(0))
+	assert.Equal(t, record.TraceID().String(), stringColumn(t, rec, "trace_id").Value(0))
+}
+
+func TestParquetMetricsExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.parquet")
+	fe := newParquetExporter(t, path, 100)
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := ms.AppendEmpty()
+	gauge.SetName("gauge")
+	gauge.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(42)
+	sum := ms.AppendEmpty()
+	sum.SetName("sum")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	sum.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	sum.Sum().DataPoints().AppendEmpty().SetDoubleValue(1.5)
+	histogram := ms.AppendEmpty()
+	histogram.SetName("histogram")
+	hdp := histogram.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetCount(3)
+	hdp.SetSum(6)
+	hdp.BucketCounts().FromRaw([]uint64{1, 2})
+	hdp.ExplicitBounds().FromRaw([]float64{2})
+	expHistogram := ms.AppendEmpty()
+	expHistogram.SetName("exponential_histogram")
+	edp := expHistogram.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	edp.SetScale(2)
+	edp.Positive().BucketCounts().FromRaw([]uint64{1, 1})
+	summary := ms.AppendEmpty()
+	summary.SetName("summary")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.QuantileValues().AppendEmpty().SetQuantile(0.5)
+
+	require.NoError(t, fe.consumeMetrics(context.Background(), md))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	rec, _ := readParquet(t, path)
+	assertParquetSchema(t, metricsSchema, rec.Schema())
+	require.EqualValues(t, 5, rec.NumRows())
+
+	types := stringColumn(t, rec, "metric_type")
+	for i, expected := range []string{"Gauge", "Sum", "Histogram", "ExponentialHistogram", "Summary"} {
+		assert.Equal(t, expected, types.Value(i))
+	}
+
+	columnOf := func(name string) arrow.Array {
+		return rec.Column(rec.Schema().FieldIndices(name)[0])
+	}
+	assert.Equal(t, int64(42), columnOf("int_value").(*array.Int64).Value(0))
+	assert.True(t, columnOf("double_value").IsNull(0))
+	assert.Equal(t, 1.5, columnOf("double_value").(*array.Float64).Value(1))
+	assert.True(t, columnOf("is_monotonic").(*array.Boolean).Value(1))
+	assert.Equal(t, "Cumulative", stringColumn(t, rec, "aggregation_temporality").Value(1))
+	assert.Equal(t, uint64(3), columnOf("count").(*array.Uint64).Value(2))
+	assert.True(t, columnOf("count").IsNull(0))
+	assert.Equal(t, int32(2), columnOf("scale").(*array.Int32).Value(3))
+	assert.True(t, columnOf("quantiles").IsNull(2))
+	assert.False(t, columnOf("quantiles").IsNull(4))
+}
+
+func TestParquetRowGroups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.parquet")
+	fe := newParquetExporter(t, path, 2)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, fe.consumeTraces(context.Background(), testdata.GenerateTracesOneSpan()))
+	}
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	rec, rowGroups := readParquet(t, path)
+	assert.EqualValues(t, 3, rec.NumRows())
+	assert.Equal(t, 2, rowGroups)
+}
+
+func TestParquetSingleSignal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.parquet")
+	fe := newParquetExporter(t, path, 10)
+
+	require.NoError(t, fe.consumeTraces(context.Background(), testdata.GenerateTracesOneSpan()))
+	assert.ErrorContains(t, fe.consumeLogs(context.Background(), testdata.GenerateLogsOneLogRecord()), "a parquet file holds a single signal")
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	rec, _ := readParquet(t, path)
+	assert.EqualValues(t, 1, rec.NumRows())
+}
+
+func TestParquetRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "traces.parquet")
+
+	w, err := newParquetWriter(path, &Rotation{MaxBackups: defaultMaxBackups}, ParquetConfig{
+		Compression:  defaultParquetCompression,
+		RowGroupSize: 1,
+	}, 0)
+	require.NoError(t, err)
+	// close the file after each row group
+	w.maxFileSize = 1
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.writeTraces(testdata.GenerateTracesOneSpan()))
+	}
+	require.NoError(t, w.shutdown())
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	for _, f := range files {
+		rec, _ := readParquet(t, filepath.Join(dir, f.Name()))
+		assert.EqualValues(t, 1, rec.NumRows())
+	}
+}
+
+func TestParquetDirectoryLayout(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "traces.parquet")
+
+	w, err := newParquetWriter(path, &Rotation{Interval: time.Hour, DirectoryLayout: "dt=%Y-%m-%d/hour=%H"}, ParquetConfig{
+		Compression:  defaultParquetCompression,
+		RowGroupSize: 10,
+	}, 0)
+	require.NoError(t, err)
+	now := time.Date(2024, 6, 1, 10, 59, 0, 0, time.UTC)
+	w.rolling.now = func() time.Time { return now }
+
+	require.NoError(t, w.writeTraces(testdata.GenerateTracesOneSpan()))
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, w.writeTraces(testdata.GenerateTracesOneSpan()))
+	require.NoError(t, w.shutdown())
+
+	first, _ := readParquet(t, filepath.Join(dir, "dt=2024-06-01", "hour=10", "traces-2024-06-01T10-59-00.000.parquet"))
+	assert.EqualValues(t, 1, first.NumRows())
+	second, _ := readParquet(t, filepath.Join(dir, "dt=2024-06-01", "hour=11", "traces-2024-06-01T11-01-00.000.parquet"))
+	assert.EqualValues(t, 1, second.NumRows())
+	assert.NoFileExists(t, path)
+}
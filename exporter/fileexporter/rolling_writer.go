@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/lestrrat-go/strftime"
+)
+
+const (
+	// rotatedTimeFormat is the format of the time in the name of the rotated files,
+	// the same as the one used by lumberjack for the backups.
+	rotatedTimeFormat = "2006-01-02T15-04-05.000"
+
+	defaultRotationMaxMegabytes = 100
+)
+
+// rollingWriter writes telemetry data to the file at path, and rotates it once it has
+// reached its maximum size or when the rotation interval has elapsed. The rotated files
+// are compressed if configured so, and moved to the directory given by the layout for
+// the time the file was opened, so that only complete files are found in the layout.
+type rollingWriter struct {
+	path        string
+	maxSize     int64
+	interval    time.Duration
+	compression string
+	layout      *strftime.Strftime
+	localTime   bool
+	// autoRotate is disabled when the rotation is driven by the caller, for formats whose
+	// files can't be split at any point.
+	autoRotate bool
+	now        func() time.Time
+
+	file     *os.File
+	buffered *bufio.Writer
+	size     int64
+	openedAt time.Time
+}
+
+var _ io.WriteCloser = (*rollingWriter)(nil)
+
+// usesRollingWriter returns whether the rotation requires the rolling writer rather than lumberjack.
+func (r *Rotation) usesRollingWriter() bool {
+	return r.Interval > 0 || r.Compression != "" || r.DirectoryLayout != ""
+}
+
+func newRollingWriter(path string, rotation *Rotation, autoRotate bool) (*rollingWriter, error) {
+	maxMegabytes := rotation.MaxMegabytes
+	if maxMegabytes == 0 {
+		maxMegabytes = defaultRotationMaxMegabytes
+	}
+	w := &rollingWriter{
+		path:        path,
+		maxSize:     int64(maxMegabytes) << 20,
+		interval:    rotation.Interval,
+		compression: rotation.Compression,
+		localTime:   rotation.LocalTime,
+		autoRotate:  autoRotate,
+		now:         time.Now,
+	}
+	if rotation.DirectoryLayout != "" {
+		layout, err := strftime.New(rotation.DirectoryLayout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid directory_layout: %w", err)
+		}
+		w.layout = layout
+	}
+	return w, nil
+}
+
+func (w *rollingWriter) Write(p []byte) (int, error) {
+	if w.file != nil && w.autoRotate && (w.intervalElapsed() || w.size+int64(len(p)) > w.maxSize) {
+		if err := w.Rotate(); err != nil {
+			return 0, err
+		}
+	}
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.buffered.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// flush writes the buffered data to the file, and rotates it if the rotation interval
+// has elapsed, so that files are rotated even when no data is received.
+func (w *rollingWriter) flush() error {
+	if w.file == nil {
+		return nil
+	}
+	if w.autoRotate && w.intervalElapsed() {
+		return w.Rotate()
+	}
+	return w.buffered.Flush()
+}
+
+// Rotate closes the current file and moves it to the layout, the next file being
+// opened on the next write.
+func (w *rollingWriter) Rotate() error {
+	if w.file == nil {
+		return nil
+	}
+	file := w.file
+	w.file = nil
+	if err := errors.Join(w.buffered.Flush(), file.Close()); err != nil {
+		return err
+	}
+	return w.finalize(w.openedAt)
+}
+
+// Close rotates the current file, as it is complete.
+func (w *rollingWriter) Close() error {
+	return w.Rotate()
+}
+
+// intervalElapsed returns whether the current file has been opened in a previous
+// interval, the intervals being aligned on the wall clock.
+func (w *rollingWriter) intervalElapsed() bool {
+	return w.file != nil && w.interval > 0 && !w.now().Before(w.openedAt.Truncate(w.interval).Add(w.interval))
+}
+
+func (w *rollingWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.path), 0755); err != nil {
+		return err
+	}
+	// a file left by a previous run is rotated, rather than being truncated
+	if info, err := os.Stat(w.path); err == nil {
+		if err = w.finalize(info.ModTime()); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	w.file = f
+	w.buffered = bufio.NewWriter(f)
+	w.size = 0
+	w.openedAt = w.now()
+	return nil
+}
+
+// finalize compresses the file at path if configured so, and moves it to the directory
+// given by the layout for the time it was opened.
+func (w *rollingWriter) finalize(openedAt time.Time) error {
+	if !w.localTime {
+		openedAt = openedAt.UTC()
+	}
+	dir := filepath.Dir(w.path)
+	if w.layout != nil {
+		dir = filepath.Join(dir, w.layout.FormatString(openedAt))
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	ext := filepath.Ext(w.path)
+	name := strings.TrimSuffix(filepath.Base(w.path), ext) + "-" + openedAt.Format(rotatedTimeFormat)
+	src := w.path
+	if w.compression == compressionZSTD {
+		ext += ".zst"
+		src = w.path + ".zst"
+		if err := compressFile(w.path, src); err != nil {
+			return err
+		}
+		if err := os.Remove(w.path); err != nil {
+			return err
+		}
+	}
+	return os.Rename(src, uniquePath(filepath.Join(dir, name), ext))
+}
+
+// uniquePath returns a path which doesn't exist yet, adding a counter to the name if needed.
+func uniquePath(name string, ext string) string {
+	path := name + ext
+	for i := 1; ; i++ {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return path
+		}
+		path = name + "-" + strconv.Itoa(i) + ext
+	}
+}
+
+// compressFile writes the zstd compression of the src file to dst.
+func compressFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	zw, err := zstd.NewWriter(out)
+	if err != nil {
+		return errors.Join(err, out.Close())
+	}
+	if _, err = io.Copy(zw, in); err != nil {
+		return errors.Join(err, zw.Close(), out.Close())
+	}
+	return errors.Join(zw.Close(), out.Close())
+}
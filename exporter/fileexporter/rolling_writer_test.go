@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRollingWriter(t *testing.T, path string, rotation *Rotation, now *time.Time) *rollingWriter {
+	w, err := newRollingWriter(path, rotation, true)
+	require.NoError(t, err)
+	w.now = func() time.Time { return *now }
+	return w
+}
+
+func TestRollingWriterSizeRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	w := newTestRollingWriter(t, path, &Rotation{}, &now)
+	w.maxSize = 10
+
+	_, err := w.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	_, err = w.Write([]byte("abcdef"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	content, err := os.ReadFile(filepath.Join(dir, "data-2024-06-01T10-00-00.000.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789", string(content))
+	content, err = os.ReadFile(filepath.Join(dir, "data-2024-06-01T10-00-01.000.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", string(content))
+	assert.NoFileExists(t, path)
+}
+
+func TestRollingWriterIntervalRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+	now := time.Date(2024, 6, 1, 10, 59, 30, 0, time.UTC)
+
+	w := newTestRollingWriter(t, path, &Rotation{
+		Interval:        time.Hour,
+		DirectoryLayout: "dt=%Y-%m-%d/hour=%H",
+	}, &now)
+
+	_, err := w.Write([]byte("first"))
+	require.NoError(t, err)
+	require.NoError(t, w.flush())
+	assert.FileExists(t, path)
+
+	// the interval is aligned on the wall clock
+	now = now.Add(time.Minute)
+	require.NoError(t, w.flush())
+	assert.NoFileExists(t, path)
+	content, err := os.ReadFile(filepath.Join(dir, "dt=2024-06-01", "hour=10", "data-2024-06-01T10-59-30.000.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(content))
+
+	_, err = w.Write([]byte("second"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	content, err = os.ReadFile(filepath.Join(dir, "dt=2024-06-01", "hour=11", "data-2024-06-01T11-00-30.000.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+}
+
+func TestRollingWriterCompression(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	w := newTestRollingWriter(t, path, &Rotation{Compression: compressionZSTD}, &now)
+	_, err := w.Write([]byte("compressed"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	compressed, err := os.ReadFile(filepath.Join(dir, "data-2024-06-01T10-00-00.000.json.zst"))
+	require.NoError(t, err)
+	decoder, err := zstd.NewReader(nil)
+	require.NoError(t, err)
+	defer decoder.Close()
+	content, err := decoder.DecodeAll(compressed, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "compressed", string(content))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestRollingWriterLeftoverFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+	require.NoError(t, os.WriteFile(path, []byte("previous run"), 0600))
+	modTime := time.Date(2024, 5, 31, 23, 0, 0, 0, time.UTC)
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	w := newTestRollingWriter(t, path, &Rotation{DirectoryLayout: "dt=%Y-%m-%d"}, &now)
+	_, err := w.Write([]byte("current run"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	content, err := os.ReadFile(filepath.Join(dir, "dt=2024-05-31", "data-2024-05-31T23-00-00.000.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "previous run", string(content))
+	content, err = os.ReadFile(filepath.Join(dir, "dt=2024-06-01", "data-2024-06-01T10-00-00.000.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "current run", string(content))
+}
+
+func TestRollingWriterNameCollision(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+	now := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	w := newTestRollingWriter(t, path, &Rotation{}, &now)
+	w.maxSize = 1
+	for _, data := range []string{"a", "b"} {
+		_, err := w.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	assert.FileExists(t, filepath.Join(dir, "data-2024-06-01T10-00-00.000.json"))
+	assert.FileExists(t, filepath.Join(dir, "data-2024-06-01T10-00-00.000-1.json"))
+}
+
+func TestFileExporterRollingWriter(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.json")
+
+	w, err := newFileWriter(path, false, &Rotation{Interval: time.Hour}, time.Second, exportMessageAsLine)
+	require.NoError(t, err)
+	require.IsType(t, &rollingWriter{}, w.file)
+
+	w.start()
+	require.NoError(t, w.export([]byte("line")))
+	require.NoError(t, w.shutdown())
+
+	files, err := filepath.Glob(filepath.Join(dir, "data-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, "line\n", string(content))
+}
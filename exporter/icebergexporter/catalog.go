@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	errNoSuchTable        = errors.New("table does not exist")
+	errTableAlreadyExists = errors.New("table already exists")
+	// errCommitConflict is returned when the table was modified since its metadata was
+	// loaded, the commit being retried with the new metadata.
+	errCommitConflict = errors.New("table was concurrently modified")
+)
+
+// table is an Iceberg table loaded from the catalog.
+type table struct {
+	name             string
+	metadataLocation string
+	metadata         *tableMetadata
+	// version is the version of the catalog entry of the table, used by the Glue catalog
+	// to detect the concurrent updates.
+	version string
+	// parameters are the parameters of the catalog entry of the table, used by the Glue catalog.
+	parameters map[string]*string
+}
+
+// catalog is an Iceberg catalog, tracking the current metadata of the tables of the namespace.
+type catalog interface {
+	// loadTable returns the table, or errNoSuchTable if it does not exist.
+	loadTable(ctx context.Context, name string) (*table, error)
+	// createTable creates the table, or returns errTableAlreadyExists if it exists.
+	createTable(ctx context.Context, name string, s *schema, spec *partitionSpec) (*table, error)
+	// commitTable applies the updates to the table if the requirements are met, or
+	// returns errCommitConflict if they aren't.
+	commitTable(ctx context.Context, t *table, requirements []tableRequirement, updates []tableUpdate) (*table, error)
+}
@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/google/uuid"
+)
+
+// The parameters of the Glue tables registering Iceberg tables.
+const (
+	glueTableTypeParameter                = "table_type"
+	glueTableTypeIceberg                  = "ICEBERG"
+	glueMetadataLocationParameter         = "metadata_location"
+	gluePreviousMetadataLocationParameter = "previous_metadata_location"
+)
+
+// glueAPI is the subset of the Glue API used by the catalog.
+type glueAPI interface {
+	GetTableWithContext(aws.Context, *glue.GetTableInput, ...request.Option) (*glue.GetTableOutput, error)
+	CreateTableWithContext(aws.Context, *glue.CreateTableInput, ...request.Option) (*glue.CreateTableOutput, error)
+	UpdateTableWithContext(aws.Context, *glue.UpdateTableInput, ...request.Option) (*glue.UpdateTableOutput, error)
+}
+
+// glueCatalog is the AWS Glue Data Catalog. Glue only stores the location of the current
+// metadata file of the tables: the exporter writes the metadata files, and updates the
+// location with the Glue table version as an optimistic lock, as the Iceberg Java
+// library does.
+type glueCatalog struct {
+	client    glueAPI
+	store     objectStore
+	catalogID *string
+	database  string
+	warehouse string
+}
+
+func newGlueCatalog(client glueAPI, store objectStore, cfg GlueCatalogConfig, database string) *glueCatalog {
+	c := &glueCatalog{
+		client:    client,
+		store:     store,
+		database:  database,
+		warehouse: strings.TrimSuffix(cfg.Warehouse, "/"),
+	}
+	if cfg.CatalogID != "" {
+		c.catalogID = aws.String(cfg.CatalogID)
+	}
+	return c
+}
+
+func (c *glueCatalog) loadTable(ctx context.Context, name string) (*table, error) {
+	out, err := c.client.GetTableWithContext(ctx, &glue.GetTableInput{
+		CatalogId:    c.catalogID,
+		DatabaseName: aws.String(c.database),
+		Name:         aws.String(name),
+	})
+	if isAWSError(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, errNoSuchTable
+	}
+	if err != nil {
+		return nil, err
+	}
+	params := out.Table.Parameters
+	if !strings.EqualFold(aws.StringValue(params[glueTableTypeParameter]), glueTableTypeIceberg) {
+		return nil, fmt.Errorf("glue table %s.%s is not an Iceberg table", c.database, name)
+	}
+	location := aws.StringValue(params[glueMetadataLocationParameter])
+	data, err := c.store.get(ctx, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the table metadata %q: %w", location, err)
+	}
+	md := &tableMetadata{}
+	if err = json.Unmarshal(data, md); err != nil {
+		return nil, fmt.Errorf("failed to decode the table metadata %q: %w", location, err)
+	}
+	return &table{
+		name:             name,
+		metadataLocation: location,
+		metadata:         md,
+		version:          aws.StringValue(out.Table.VersionId),
+		parameters:       params,
+	}, nil
+}
+
+func (c *glueCatalog) createTable(ctx context.Context, name string, s *schema, spec *partitionSpec) (*table, error) {
+	location := fmt.Sprintf("%s/%s.db/%s", c.warehouse, c.database, name)
+	md := newTableMetadata(location, s, spec, map[string]string{"write.format.default": "parquet"})
+	md.LastUpdatedMs = time.Now().UnixMilli()
+	metadataLocation, err := c.writeMetadata(ctx, md, 0)
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]*string{
+		glueTableTypeParameter:        aws.String(glueTableTypeIceberg),
+		glueMetadataLocationParameter: aws.String(metadataLocation),
+	}
+	_, err = c.client.CreateTableWithContext(ctx, &glue.CreateTableInput{
+		CatalogId:    c.catalogID,
+		DatabaseName: aws.String(c.database),
+		TableInput: &glue.TableInput{
+			Name:              aws.String(name),
+			TableType:         aws.String("EXTERNAL_TABLE"),
+			Parameters:        params,
+			StorageDescriptor: &glue.StorageDescriptor{Location: aws.String(location)},
+		},
+	})
+	if isAWSError(err, glue.ErrCodeAlreadyExistsException) {
+		return nil, errTableAlreadyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.loadTable(ctx, name)
+}
+
+func (c *glueCatalog) commitTable(ctx context.Context, t *table, requirements []tableRequirement, updates []tableUpdate) (*table, error) {
+	if err := checkRequirements(t.metadata, requirements); err != nil {
+		return nil, err
+	}
+	md, err := t.metadata.clone()
+	if err != nil {
+		return nil, err
+	}
+	if err = applyUpdates(md, updates); err != nil {
+		return nil, err
+	}
+	md.MetadataLog = append(md.MetadataLog, metadataLogEntry{TimestampMs: t.metadata.LastUpdatedMs, MetadataFile: t.metadataLocation})
+	md.LastUpdatedMs = time.Now().UnixMilli()
+	metadataLocation, err := c.writeMetadata(ctx, md, metadataVersion(t.metadataLocation)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]*string, len(t.parameters)+1)
+	for k, v := range t.parameters {
+		params[k] = v
+	}
+	params[glueMetadataLocationParameter] = aws.String(metadataLocation)
+	params[gluePreviousMetadataLocationParameter] = aws.String(t.metadataLocation)
+	_, err = c.client.UpdateTableWithContext(ctx, &glue.UpdateTableInput{
+		CatalogId:    c.catalogID,
+		DatabaseName: aws.String(c.database),
+		VersionId:    aws.String(t.version),
+		SkipArchive:  aws.Bool(true),
+		TableInput: &glue.TableInput{
+			Name:              aws.String(t.name),
+			TableType:         aws.String("EXTERNAL_TABLE"),
+			Parameters:        params,
+			StorageDescriptor: &glue.StorageDescriptor{Location: aws.String(md.Location)},
+		},
+	})
+	if isAWSError(err, glue.ErrCodeConcurrentModificationException) {
+		return nil, fmt.Errorf("%w: %w", errCommitConflict, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The new version of the Glue table isn't returned by the update.
+	out, err := c.client.GetTableWithContext(ctx, &glue.GetTableInput{
+		CatalogId:    c.catalogID,
+		DatabaseName: aws.String(c.database),
+		Name:         aws.String(t.name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(out.Table.Parameters[glueMetadataLocationParameter]) != metadataLocation {
+		// updated concurrently since the commit
+		return c.loadTable(ctx, t.name)
+	}
+	return &table{
+		name:             t.name,
+		metadataLocation: metadataLocation,
+		metadata:         md,
+		version:          aws.StringValue(out.Table.VersionId),
+		parameters:       out.Table.Parameters,
+	}, nil
+}
+
+// writeMetadata writes a metadata file, named as the Iceberg Java library names them.
+func (c *glueCatalog) writeMetadata(ctx context.Context, md *tableMetadata, version int) (string, error) {
+	data, err := json.Marshal(md)
+	if err != nil {
+		return "", err
+	}
+	location := fmt.Sprintf("%s/%05d-%s.metadata.json", metadataLocation(md), version, uuid.NewString())
+	return location, c.store.put(ctx, location, data)
+}
+
+// metadataVersion returns the version of a metadata file, 0 if its name has no version.
+func metadataVersion(location string) int {
+	name := path.Base(location)
+	version, _, found := strings.Cut(name, "-")
+	if !found {
+		return 0
+	}
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func isAWSError(err error, code string) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == code
+}
+
+var _ catalog = (*glueCatalog)(nil)
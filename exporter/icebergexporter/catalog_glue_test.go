@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+// fakeGlue is an in-memory Glue catalog.
+type fakeGlue struct {
+	tables map[string]*glue.TableData
+	// conflicts is the number of updates to reject as concurrent modifications.
+	conflicts int
+}
+
+func (f *fakeGlue) GetTableWithContext(_ aws.Context, in *glue.GetTableInput, _ ...request.Option) (*glue.GetTableOutput, error) {
+	t, ok := f.tables[aws.StringValue(in.DatabaseName)+"."+aws.StringValue(in.Name)]
+	if !ok {
+		return nil, awserr.New(glue.ErrCodeEntityNotFoundException, "table not found", nil)
+	}
+	return &glue.GetTableOutput{Table: t}, nil
+}
+
+func (f *fakeGlue) CreateTableWithContext(_ aws.Context, in *glue.CreateTableInput, _ ...request.Option) (*glue.CreateTableOutput, error) {
+	name := aws.StringValue(in.DatabaseName) + "." + aws.StringValue(in.TableInput.Name)
+	if _, ok := f.tables[name]; ok {
+		return nil, awserr.New(glue.ErrCodeAlreadyExistsException, "table already exists", nil)
+	}
+	f.tables[name] = &glue.TableData{
+		Name:       in.TableInput.Name,
+		Parameters: in.TableInput.Parameters,
+		VersionId:  aws.String("0"),
+	}
+	return &glue.CreateTableOutput{}, nil
+}
+
+func (f *fakeGlue) UpdateTableWithContext(_ aws.Context, in *glue.UpdateTableInput, _ ...request.Option) (*glue.UpdateTableOutput, error) {
+	t := f.tables[aws.StringValue(in.DatabaseName)+"."+aws.StringValue(in.TableInput.Name)]
+	if f.conflicts > 0 || aws.StringValue(in.VersionId) != aws.StringValue(t.VersionId) {
+		f.conflicts--
+		return nil, awserr.New(glue.ErrCodeConcurrentModificationException, "version mismatch", nil)
+	}
+	version, _ := strconv.Atoi(aws.StringValue(t.VersionId))
+	t.Parameters = in.TableInput.Parameters
+	t.VersionId = aws.String(strconv.Itoa(version + 1))
+	return &glue.UpdateTableOutput{}, nil
+}
+
+func TestGlueCatalog(t *testing.T) {
+	client := &fakeGlue{tables: map[string]*glue.TableData{}, conflicts: 1}
+	store := &locationStore{}
+	cfg := createDefaultConfig().(*Config)
+	cfg.Catalog.Glue.Warehouse = "file://" + t.TempDir()
+	c := newGlueCatalog(client, store, cfg.Catalog.Glue, "otel")
+	w := newTableWriter(cfg, "logs", logsTable, c, store, zap.NewNop())
+
+	logs := plog.NewLogs()
+	lr := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+	lr.Body().SetStr("payment accepted")
+	for i := 0; i < 2; i++ {
+		require.NoError(t, w.write(context.Background(), func(r *partitionedRecords) {
+			appendLogs(r, logs)
+		}))
+	}
+
+	params := client.tables["otel.logs"].Parameters
+	assert.Equal(t, glueTableTypeIceberg, aws.StringValue(params[glueTableTypeParameter]))
+	location := aws.StringValue(params[glueMetadataLocationParameter])
+	assert.Equal(t, 2, metadataVersion(location))
+	assert.Equal(t, 1, metadataVersion(aws.StringValue(params[gluePreviousMetadataLocationParameter])))
+	assert.Equal(t, "2", aws.StringValue(client.tables["otel.logs"].VersionId))
+
+	data, err := store.get(context.Background(), location)
+	require.NoError(t, err)
+	md := &tableMetadata{}
+	require.NoError(t, json.Unmarshal(data, md))
+	assert.Equal(t, cfg.Catalog.Glue.Warehouse+"/otel.db/logs", md.Location)
+	assert.Len(t, md.Snapshots, 2)
+	assert.Len(t, md.MetadataLog, 2)
+	assert.Equal(t, "2", md.branchHead(mainBranch).Summary["total-records"])
+	// the fields unknown to the exporter are preserved
+	assert.JSONEq(t, `[{"order-id":0,"fields":[]}]`, string(md.raw["sort-orders"]))
+}
+
+func TestMetadataVersion(t *testing.T) {
+	assert.Equal(t, 12, metadataVersion("s3://bucket/otel.db/logs/metadata/00012-7f8c3a9e.metadata.json"))
+	assert.Equal(t, 0, metadataVersion("s3://bucket/otel.db/logs/metadata/v3.metadata.json"))
+}
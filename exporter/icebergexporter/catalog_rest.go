@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// restCatalog is a catalog implementing the Iceberg REST catalog API.
+type restCatalog struct {
+	client    *http.Client
+	endpoint  string
+	token     string
+	namespace []string
+	warehouse string
+	// prefix is the path prefix returned by the catalog configuration, which is
+	// fetched by the first request so that the exporter starts without the catalog.
+	prefix     string
+	configured bool
+}
+
+// restError is the error response of the REST catalog API.
+type restError struct {
+	Error struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+		Code    int    `json:"code"`
+	} `json:"error"`
+}
+
+type loadTableResponse struct {
+	MetadataLocation string         `json:"metadata-location"`
+	Metadata         *tableMetadata `json:"metadata"`
+}
+
+type tableIdentifier struct {
+	Namespace []string `json:"namespace"`
+	Name      string   `json:"name"`
+}
+
+func newRESTCatalog(client *http.Client, cfg RESTCatalogConfig, namespace string) *restCatalog {
+	return &restCatalog{
+		client:    client,
+		endpoint:  strings.TrimSuffix(cfg.Endpoint, "/"),
+		token:     string(cfg.Token),
+		warehouse: cfg.Warehouse,
+		namespace: strings.Split(namespace, "."),
+	}
+}
+
+// configure gets the catalog configuration if it wasn't yet.
+func (c *restCatalog) configure(ctx context.Context) error {
+	if c.configured {
+		return nil
+	}
+	path := "/v1/config"
+	if c.warehouse != "" {
+		path += "?warehouse=" + url.QueryEscape(c.warehouse)
+	}
+	var config struct {
+		Defaults  map[string]string `json:"defaults"`
+		Overrides map[string]string `json:"overrides"`
+	}
+	if _, err := c.do(ctx, http.MethodGet, path, nil, &config); err != nil {
+		return fmt.Errorf("failed to get the catalog configuration: %w", err)
+	}
+	c.prefix = config.Defaults["prefix"]
+	if prefix, ok := config.Overrides["prefix"]; ok {
+		c.prefix = prefix
+	}
+	c.configured = true
+	return nil
+}
+
+// path returns the path of a catalog resource, the namespace levels being separated by
+// the unit separator as required by the API.
+func (c *restCatalog) path(elem ...string) string {
+	path := "/v1"
+	if c.prefix != "" {
+		path += "/" + url.PathEscape(c.prefix)
+	}
+	path += "/namespaces/" + url.PathEscape(strings.Join(c.namespace, "\x1f"))
+	for _, e := range elem {
+		path += "/" + url.PathEscape(e)
+	}
+	return path
+}
+
+func (c *restCatalog) loadTable(ctx context.Context, name string) (*table, error) {
+	if err := c.configure(ctx); err != nil {
+		return nil, err
+	}
+	var resp loadTableResponse
+	status, err := c.do(ctx, http.MethodGet, c.path("tables", name), nil, &resp)
+	if status == http.StatusNotFound {
+		return nil, errNoSuchTable
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &table{name: name, metadataLocation: resp.MetadataLocation, metadata: resp.Metadata}, nil
+}
+
+func (c *restCatalog) createTable(ctx context.Context, name string, s *schema, spec *partitionSpec) (*table, error) {
+	if err := c.configure(ctx); err != nil {
+		return nil, err
+	}
+	request := map[string]any{
+		"name":           name,
+		"schema":         s,
+		"partition-spec": spec,
+		"properties":     map[string]string{"write.format.default": "parquet"},
+	}
+	var resp loadTableResponse
+	status, err := c.do(ctx, http.MethodPost, c.path("tables"), request, &resp)
+	if status == http.StatusNotFound {
+		// the namespace does not exist
+		if err = c.createNamespace(ctx); err != nil {
+			return nil, err
+		}
+		status, err = c.do(ctx, http.MethodPost, c.path("tables"), request, &resp)
+	}
+	if status == http.StatusConflict {
+		return nil, errTableAlreadyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &table{name: name, metadataLocation: resp.MetadataLocation, metadata: resp.Metadata}, nil
+}
+
+func (c *restCatalog) createNamespace(ctx context.Context) error {
+	path := "/v1"
+	if c.prefix != "" {
+		path += "/" + url.PathEscape(c.prefix)
+	}
+	status, err := c.do(ctx, http.MethodPost, path+"/namespaces", map[string]any{"namespace": c.namespace}, nil)
+	if status == http.StatusConflict {
+		// created concurrently
+		return nil
+	}
+	return err
+}
+
+func (c *restCatalog) commitTable(ctx context.Context, t *table, requirements []tableRequirement, updates []tableUpdate) (*table, error) {
+	if err := c.configure(ctx); err != nil {
+		return nil, err
+	}
+	request := map[string]any{
+		"identifier":   tableIdentifier{Namespace: c.namespace, Name: t.name},
+		"requirements": requirements,
+		"updates":      updates,
+	}
+	var resp loadTableResponse
+	status, err := c.do(ctx, http.MethodPost, c.path("tables", t.name), request, &resp)
+	if status == http.StatusConflict {
+		return nil, fmt.Errorf("%w: %w", errCommitConflict, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &table{name: t.name, metadataLocation: resp.MetadataLocation, metadata: resp.Metadata}, nil
+}
+
+// do sends the request, and decodes the response to out. The status code of the response
+// is returned with the error of the failed requests.
+func (c *restCatalog) do(ctx context.Context, method, path string, in, out any) (int, error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, body)
+	if err != nil {
+		return 0, err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode >= 300 {
+		var restErr restError
+		if json.Unmarshal(data, &restErr) == nil && restErr.Error.Message != "" {
+			return resp.StatusCode, fmt.Errorf("%s %s failed with %q: %s: %s", method, path, resp.Status, restErr.Error.Type, restErr.Error.Message)
+		}
+		return resp.StatusCode, fmt.Errorf("%s %s failed with %q", method, path, resp.Status)
+	}
+	if out == nil || len(data) == 0 {
+		return resp.StatusCode, nil
+	}
+	if err = json.Unmarshal(data, out); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode the response of %s %s: %w", method, path, err)
+	}
+	return resp.StatusCode, nil
+}
+
+var _ catalog = (*restCatalog)(nil)
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.uber.org/multierr"
+)
+
+const (
+	// catalogTypeREST uses a catalog implementing the Iceberg REST catalog API.
+	catalogTypeREST = "rest"
+	// catalogTypeGlue uses the AWS Glue Data Catalog.
+	catalogTypeGlue = "glue"
+)
+
+// Config defines configuration for the Iceberg exporter.
+type Config struct {
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`     // squash ensures fields are correctly decoded in embedded struct.
+	QueueSettings                  exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+	configretry.BackOffConfig      `mapstructure:"retry_on_failure"`
+
+	// Catalog defines the catalog the tables are registered in.
+	Catalog CatalogConfig `mapstructure:"catalog"`
+
+	// Storage defines the access to the object storage the data files are written to.
+	Storage StorageConfig `mapstructure:"storage"`
+
+	// Namespace is the namespace of the tables, the levels of a nested namespace being
+	// separated by dots. With the Glue catalog, it is the name of the database.
+	Namespace string `mapstructure:"namespace"`
+
+	// Tables defines the names of the tables of each signal.
+	Tables TablesConfig `mapstructure:"tables"`
+
+	// Partitioning defines the partition spec of the tables created or updated by the exporter.
+	Partitioning PartitioningConfig `mapstructure:"partitioning"`
+
+	// Compression is the compression codec of the Parquet data files: none, snappy, gzip or zstd.
+	// The default value is zstd.
+	Compression string `mapstructure:"compression"`
+
+	// CommitRetries is the number of times a commit is retried when the table was
+	// concurrently modified by another writer. The default value is 4.
+	CommitRetries int `mapstructure:"commit_retries"`
+}
+
+// CatalogConfig defines the catalog of the tables.
+type CatalogConfig struct {
+	// Type is the type of the catalog: rest or glue. The default value is rest.
+	Type string `mapstructure:"type"`
+
+	// REST defines the REST catalog, used with the rest type.
+	REST RESTCatalogConfig `mapstructure:"rest"`
+
+	// Glue defines the AWS Glue Data Catalog, used with the glue type.
+	Glue GlueCatalogConfig `mapstructure:"glue"`
+}
+
+// RESTCatalogConfig defines a catalog implementing the Iceberg REST catalog API.
+type RESTCatalogConfig struct {
+	// The endpoint is the base URI of the catalog, the API paths starting with /v1 being
+	// appended to it.
+	confighttp.ClientConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// Warehouse is the warehouse location or identifier requested from the catalog.
+	Warehouse string `mapstructure:"warehouse"`
+
+	// Token is sent as a bearer token in the Authorization header.
+	Token configopaque.String `mapstructure:"token"`
+}
+
+// GlueCatalogConfig defines the AWS Glue Data Catalog.
+type GlueCatalogConfig struct {
+	// Region is the AWS region of the catalog. The storage region is used when empty.
+	Region string `mapstructure:"region"`
+
+	// CatalogID is the ID of the catalog, the account ID of the caller being used when empty.
+	CatalogID string `mapstructure:"catalog_id"`
+
+	// Warehouse is the root location of the tables created by the exporter, each table
+	// being created at <warehouse>/<database>.db/<table>, e.g. s3://bucket/warehouse.
+	Warehouse string `mapstructure:"warehouse"`
+}
+
+// StorageConfig defines the access to the object storage of the tables. The S3 client
+// is used for the s3, s3a, s3n and gs locations, the Google Cloud Storage buckets being
+// accessed through its S3 compatible XML API.
+type StorageConfig struct {
+	// Region is the AWS region of the buckets.
+	Region string `mapstructure:"region"`
+
+	// Endpoint overrides the S3 endpoint, e.g. https://storage.googleapis.com or the
+	// endpoint of a MinIO deployment.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// RoleArn is the ARN of a role assumed to access the buckets and the Glue catalog.
+	RoleArn string `mapstructure:"role_arn"`
+
+	// S3ForcePathStyle addresses the buckets with the path style, required by MinIO.
+	S3ForcePathStyle bool `mapstructure:"s3_force_path_style"`
+}
+
+// TablesConfig defines the names of the tables of each signal.
+type TablesConfig struct {
+	// Traces is the name of the spans table. The default value is "traces".
+	Traces string `mapstructure:"traces"`
+	// Metrics is the name of the metric data points table. The default value is "metrics".
+	Metrics string `mapstructure:"metrics"`
+	// Logs is the name of the log records table. The default value is "logs".
+	Logs string `mapstructure:"logs"`
+}
+
+// PartitioningConfig defines the partition spec of the tables.
+type PartitioningConfig struct {
+	// Time is the granularity of the time partition: hour, day, month, year or none.
+	// The default value is day.
+	Time string `mapstructure:"time"`
+
+	// ResourceAttributes are the resource attributes the tables are partitioned by,
+	// each one being written to a resource_<attribute> column.
+	ResourceAttributes []string `mapstructure:"resource_attributes"`
+}
+
+var timeTransforms = []string{transformHour, transformDay, transformMonth, transformYear, timePartitionNone}
+
+func (cfg *Config) Validate() error {
+	var errs error
+	switch cfg.Catalog.Type {
+	case catalogTypeREST:
+		if cfg.Catalog.REST.Endpoint == "" {
+			errs = multierr.Append(errs, errors.New("'catalog::rest::endpoint' must be specified"))
+		}
+	case catalogTypeGlue:
+		if cfg.Catalog.Glue.Warehouse == "" {
+			errs = multierr.Append(errs, errors.New("'catalog::glue::warehouse' must be specified"))
+		}
+		if strings.Contains(cfg.Namespace, ".") {
+			errs = multierr.Append(errs, errors.New("'namespace' must not be nested with the glue catalog"))
+		}
+	default:
+		errs = multierr.Append(errs, fmt.Errorf("unknown catalog type %q, must be %q or %q", cfg.Catalog.Type, catalogTypeREST, catalogTypeGlue))
+	}
+	if cfg.Namespace == "" {
+		errs = multierr.Append(errs, errors.New("'namespace' must be specified"))
+	}
+	if cfg.Tables.Traces == "" || cfg.Tables.Metrics == "" || cfg.Tables.Logs == "" {
+		errs = multierr.Append(errs, errors.New("'tables' must specify the name of the traces, metrics and logs tables"))
+	}
+	if !slices.Contains(timeTransforms, cfg.Partitioning.Time) {
+		errs = multierr.Append(errs, fmt.Errorf("'partitioning::time' must be one of %s", strings.Join(timeTransforms, ", ")))
+	}
+	columns := map[string]string{}
+	for _, attr := range cfg.Partitioning.ResourceAttributes {
+		column := resourceColumn(attr)
+		if column == columnResourceAttributes {
+			errs = multierr.Append(errs, fmt.Errorf("'partitioning::resource_attributes' %q can't be written to the %q column", attr, column))
+		}
+		if other, ok := columns[column]; ok {
+			errs = multierr.Append(errs, fmt.Errorf("'partitioning::resource_attributes' %q and %q are written to the same column %q", other, attr, column))
+		}
+		columns[column] = attr
+	}
+	if _, ok := parquetCompressions[cfg.Compression]; !ok {
+		errs = multierr.Append(errs, fmt.Errorf("unknown compression %q", cfg.Compression))
+	}
+	if cfg.CommitRetries < 0 {
+		errs = multierr.Append(errs, errors.New("'commit_retries' must be non-negative"))
+	}
+	if cfg.Timeout < 0 {
+		errs = multierr.Append(errs, errors.New("'timeout' must be non-negative"))
+	}
+	return errs
+}
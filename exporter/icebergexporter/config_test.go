@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected func() *Config
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				return cfg
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "allsettings"),
+			expected: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.TimeoutSettings = exporterhelper.TimeoutSettings{Timeout: 2 * time.Minute}
+				cfg.QueueSettings = exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				}
+				cfg.BackOffConfig = configretry.BackOffConfig{
+					Enabled:             true,
+					InitialInterval:     10 * time.Second,
+					RandomizationFactor: 0.7,
+					Multiplier:          3.14,
+					MaxInterval:         1 * time.Minute,
+					MaxElapsedTime:      10 * time.Minute,
+				}
+				cfg.Catalog.REST.Endpoint = "https://catalog.example.com/api/catalog"
+				cfg.Catalog.REST.Warehouse = "observability"
+				cfg.Catalog.REST.Token = "secret"
+				cfg.Storage = StorageConfig{
+					Region:           "eu-west-1",
+					Endpoint:         "http://localhost:9000",
+					S3ForcePathStyle: true,
+				}
+				cfg.Namespace = "telemetry.prod"
+				cfg.Tables = TablesConfig{
+					Traces:  "spans",
+					Metrics: "data_points",
+					Logs:    "log_records",
+				}
+				cfg.Partitioning = PartitioningConfig{
+					Time:               transformHour,
+					ResourceAttributes: []string{"service.name", "deployment.environment"},
+				}
+				cfg.Compression = "snappy"
+				cfg.CommitRetries = 10
+				return cfg
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "glue"),
+			expected: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Catalog.Type = catalogTypeGlue
+				cfg.Catalog.Glue = GlueCatalogConfig{
+					Region:    "us-east-1",
+					CatalogID: "123456789012",
+					Warehouse: "s3://bucket/warehouse",
+				}
+				cfg.Storage = StorageConfig{
+					Region:  "us-east-1",
+					RoleArn: "arn:aws:iam::123456789012:role/iceberg-writer",
+				}
+				return cfg
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected(), cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name: "valid_config",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+			},
+		},
+		{
+			name:    "missing_rest_endpoint",
+			modify:  func(*Config) {},
+			wantErr: "'catalog::rest::endpoint' must be specified",
+		},
+		{
+			name: "missing_glue_warehouse",
+			modify: func(cfg *Config) {
+				cfg.Catalog.Type = catalogTypeGlue
+			},
+			wantErr: "'catalog::glue::warehouse' must be specified",
+		},
+		{
+			name: "nested_glue_namespace",
+			modify: func(cfg *Config) {
+				cfg.Catalog.Type = catalogTypeGlue
+				cfg.Catalog.Glue.Warehouse = "s3://bucket/warehouse"
+				cfg.Namespace = "telemetry.prod"
+			},
+			wantErr: "'namespace' must not be nested with the glue catalog",
+		},
+		{
+			name: "unknown_catalog_type",
+			modify: func(cfg *Config) {
+				cfg.Catalog.Type = "hive"
+			},
+			wantErr: `unknown catalog type "hive"`,
+		},
+		{
+			name: "missing_namespace",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Namespace = ""
+			},
+			wantErr: "'namespace' must be specified",
+		},
+		{
+			name: "missing_table",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Tables.Metrics = ""
+			},
+			wantErr: "'tables' must specify the name of the traces, metrics and logs tables",
+		},
+		{
+			name: "invalid_time_partition",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Partitioning.Time = "week"
+			},
+			wantErr: "'partitioning::time' must be one of hour, day, month, year, none",
+		},
+		{
+			name: "attributes_column",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Partitioning.ResourceAttributes = []string{"attributes"}
+			},
+			wantErr: `"attributes" can't be written to the "resource_attributes" column`,
+		},
+		{
+			name: "same_column",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Partitioning.ResourceAttributes = []string{"service.name", "service_name"}
+			},
+			wantErr: `"service.name" and "service_name" are written to the same column "resource_service_name"`,
+		},
+		{
+			name: "unknown_compression",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Compression = "lz4"
+			},
+			wantErr: `unknown compression "lz4"`,
+		},
+		{
+			name: "negative_commit_retries",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.CommitRetries = -1
+			},
+			wantErr: "'commit_retries' must be non-negative",
+		},
+		{
+			name: "invalid_timeout",
+			modify: func(cfg *Config) {
+				cfg.Catalog.REST.Endpoint = "http://localhost:8181"
+				cfg.Timeout = -5 * time.Second
+			},
+			wantErr: "'timeout' must be non-negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, cfg.Validate())
+			} else {
+				assert.ErrorContains(t, cfg.Validate(), tt.wantErr)
+			}
+		})
+	}
+}
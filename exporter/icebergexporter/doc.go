@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package icebergexporter writes traces, metrics and logs as Parquet data files into Apache Iceberg tables.
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
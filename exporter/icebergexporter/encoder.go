@@ -0,0 +1,374 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"encoding/json"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// partitionedRecords builds a record per partition, each record being written to its own data file.
+type partitionedRecords struct {
+	schema             *arrow.Schema
+	index              map[string]int
+	partitioner        *partitioner
+	resourceAttributes []string
+
+	partitions []*partitionRecord
+	byKey      map[string]*partitionRecord
+}
+
+// partitionRecord is the record of the rows of a partition.
+type partitionRecord struct {
+	values  []any
+	builder *array.RecordBuilder
+	rows    int
+}
+
+func newPartitionedRecords(schema *arrow.Schema, p *partitioner, resourceAttributes []string) *partitionedRecords {
+	index := make(map[string]int, schema.NumFields())
+	for i, f := range schema.Fields() {
+		index[f.Name] = i
+	}
+	return &partitionedRecords{
+		schema:             schema,
+		index:              index,
+		partitioner:        p,
+		resourceAttributes: resourceAttributes,
+		byKey:              map[string]*partitionRecord{},
+	}
+}
+
+func (r *partitionedRecords) release() {
+	for _, p := range r.partitions {
+		p.builder.Release()
+	}
+}
+
+// row starts a row in the record of its partition, and appends the resource and scope columns.
+func (r *partitionedRecords) row(resource pcommon.Resource, scope pcommon.InstrumentationScope, ts pcommon.Timestamp) row {
+	values := r.partitioner.values(resource.Attributes(), ts)
+	key := r.partitioner.key(values)
+	p, ok := r.byKey[key]
+	if !ok {
+		p = &partitionRecord{values: values, builder: array.NewRecordBuilder(memory.DefaultAllocator, r.schema)}
+		r.byKey[key] = p
+		r.partitions = append(r.partitions, p)
+	}
+	p.rows++
+	w := row{partition: p, builder: p.builder, index: r.index}
+	w.json(columnResourceAttributes, resource.Attributes())
+	for _, attr := range r.resourceAttributes {
+		if v, ok := resource.Attributes().Get(attr); ok {
+			w.string(resourceColumn(attr), v.AsString())
+		}
+	}
+	w.string("scope_name", scope.Name())
+	w.string("scope_version", scope.Version())
+	return w
+}
+
+// row appends the values of a row to the builders of its columns, the columns which
+// aren't set being null.
+type row struct {
+	partition *partitionRecord
+	builder   *array.RecordBuilder
+	index     map[string]int
+}
+
+func (w row) field(name string) array.Builder {
+	return w.builder.Field(w.index[name])
+}
+
+func (w row) string(name, value string) {
+	if value != "" {
+		w.field(name).(*array.StringBuilder).Append(value)
+	}
+}
+
+func (w row) long(name string, value int64) {
+	w.field(name).(*array.Int64Builder).Append(value)
+}
+
+func (w row) int(name string, value int32) {
+	w.field(name).(*array.Int32Builder).Append(value)
+}
+
+func (w row) double(name string, value float64) {
+	w.field(name).(*array.Float64Builder).Append(value)
+}
+
+func (w row) boolean(name string, value bool) {
+	w.field(name).(*array.BooleanBuilder).Append(value)
+}
+
+func (w row) timestamp(name string, ts pcommon.Timestamp) {
+	if ts != 0 {
+		w.field(name).(*array.TimestampBuilder).Append(arrow.Timestamp(ts / 1000))
+	}
+}
+
+// json writes the value as a JSON string, pcommon maps and slices being written
+// with their raw values, and empty maps and slices being null.
+func (w row) json(name string, value any) {
+	switch v := value.(type) {
+	case pcommon.Map:
+		if v.Len() == 0 {
+			return
+		}
+		value = v.AsRaw()
+	case pcommon.Slice:
+		if v.Len() == 0 {
+			return
+		}
+		value = v.AsRaw()
+	case []map[string]any:
+		if len(v) == 0 {
+			return
+		}
+	case []uint64:
+		if len(v) == 0 {
+			return
+		}
+	case []float64:
+		if len(v) == 0 {
+			return
+		}
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	w.field(name).(*array.StringBuilder).Append(string(data))
+}
+
+// end appends a null to the columns which weren't set for the row.
+func (w row) end() {
+	for _, f := range w.builder.Fields() {
+		if f.Len() < w.partition.rows {
+			f.AppendNull()
+		}
+	}
+}
+
+func formatTimestamp(ts pcommon.Timestamp) any {
+	if ts == 0 {
+		return nil
+	}
+	return ts.AsTime().Format("2006-01-02T15:04:05.999999999Z07:00")
+}
+
+func appendTraces(r *partitionedRecords, td ptrace.Traces) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				w := r.row(rs.Resource(), ss.Scope(), span.StartTimestamp())
+				if !span.TraceID().IsEmpty() {
+					w.string("trace_id", span.TraceID().String())
+				}
+				if !span.SpanID().IsEmpty() {
+					w.string("span_id", span.SpanID().String())
+				}
+				if !span.ParentSpanID().IsEmpty() {
+					w.string("parent_span_id", span.ParentSpanID().String())
+				}
+				w.string("trace_state", span.TraceState().AsRaw())
+				w.string("name", span.Name())
+				w.string("kind", span.Kind().String())
+				w.timestamp("start_time", span.StartTimestamp())
+				w.timestamp("end_time", span.EndTimestamp())
+				w.long("duration_ns", int64(span.EndTimestamp()-span.StartTimestamp()))
+				w.json("attributes", span.Attributes())
+				w.string("status_code", span.Status().Code().String())
+				w.string("status_message", span.Status().Message())
+				w.json("events", spanEvents(span.Events()))
+				w.json("links", spanLinks(span.Links()))
+				w.end()
+			}
+		}
+	}
+}
+
+func spanEvents(events ptrace.SpanEventSlice) []map[string]any {
+	var values []map[string]any
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		values = append(values, map[string]any{
+			"time":       formatTimestamp(event.Timestamp()),
+			"name":       event.Name(),
+			"attributes": event.Attributes().AsRaw(),
+		})
+	}
+	return values
+}
+
+func spanLinks(links ptrace.SpanLinkSlice) []map[string]any {
+	var values []map[string]any
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		values = append(values, map[string]any{
+			"trace_id":    link.TraceID().String(),
+			"span_id":     link.SpanID().String(),
+			"trace_state": link.TraceState().AsRaw(),
+			"attributes":  link.Attributes().AsRaw(),
+		})
+	}
+	return values
+}
+
+func appendLogs(r *partitionedRecords, ld plog.Logs) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				record := sl.LogRecords().At(k)
+				// The time of the records without a timestamp is the time they were observed.
+				ts := record.Timestamp()
+				if ts == 0 {
+					ts = record.ObservedTimestamp()
+				}
+				w := r.row(rl.Resource(), sl.Scope(), ts)
+				w.timestamp("time", ts)
+				w.timestamp("observed_time", record.ObservedTimestamp())
+				if !record.TraceID().IsEmpty() {
+					w.string("trace_id", record.TraceID().String())
+				}
+				if !record.SpanID().IsEmpty() {
+					w.string("span_id", record.SpanID().String())
+				}
+				w.long("flags", int64(record.Flags()))
+				w.int("severity_number", int32(record.SeverityNumber()))
+				w.string("severity_text", record.SeverityText())
+				if record.Body().Type() != pcommon.ValueTypeEmpty {
+					w.field("body").(*array.StringBuilder).Append(record.Body().AsString())
+				}
+				w.json("attributes", record.Attributes())
+				w.end()
+			}
+		}
+	}
+}
+
+func appendMetrics(r *partitionedRecords, md pmetric.Metrics) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				appendMetric(r, rm.Resource(), sm.Scope(), sm.Metrics().At(k))
+			}
+		}
+	}
+}
+
+// metricRow starts the row of a data point, and appends the columns shared by all the data points.
+func metricRow(r *partitionedRecords, resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric, startTime, time pcommon.Timestamp, attributes pcommon.Map, flags pmetric.DataPointFlags) row {
+	w := r.row(resource, scope, time)
+	w.string("metric_name", metric.Name())
+	w.string("metric_description", metric.Description())
+	w.string("metric_unit", metric.Unit())
+	w.string("metric_type", metric.Type().String())
+	w.timestamp("start_time", startTime)
+	w.timestamp("time", time)
+	w.json("attributes", attributes)
+	w.long("flags", int64(flags))
+	return w
+}
+
+func appendNumberPoints(r *partitionedRecords, resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric, points pmetric.NumberDataPointSlice) {
+	for i := 0; i < points.Len(); i++ {
+		dp := points.At(i)
+		w := metricRow(r, resource, scope, metric, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+		if metric.Type() == pmetric.MetricTypeSum {
+			w.string("aggregation_temporality", metric.Sum().AggregationTemporality().String())
+			w.boolean("is_monotonic", metric.Sum().IsMonotonic())
+		}
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			w.long("int_value", dp.IntValue())
+		case pmetric.NumberDataPointValueTypeDouble:
+			w.double("double_value", dp.DoubleValue())
+		}
+		w.end()
+	}
+}
+
+func appendMetric(r *partitionedRecords, resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		appendNumberPoints(r, resource, scope, metric, metric.Gauge().DataPoints())
+	case pmetric.MetricTypeSum:
+		appendNumberPoints(r, resource, scope, metric, metric.Sum().DataPoints())
+	case pmetric.MetricTypeHistogram:
+		points := metric.Histogram().DataPoints()
+		for i := 0; i < points.Len(); i++ {
+			dp := points.At(i)
+			w := metricRow(r, resource, scope, metric, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			w.string("aggregation_temporality", metric.Histogram().AggregationTemporality().String())
+			w.long("count", int64(dp.Count()))
+			if dp.HasSum() {
+				w.double("sum", dp.Sum())
+			}
+			if dp.HasMin() {
+				w.double("min", dp.Min())
+			}
+			if dp.HasMax() {
+				w.double("max", dp.Max())
+			}
+			w.json("bucket_counts", dp.BucketCounts().AsRaw())
+			w.json("explicit_bounds", dp.ExplicitBounds().AsRaw())
+			w.end()
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		points := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < points.Len(); i++ {
+			dp := points.At(i)
+			w := metricRow(r, resource, scope, metric, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			w.string("aggregation_temporality", metric.ExponentialHistogram().AggregationTemporality().String())
+			w.long("count", int64(dp.Count()))
+			if dp.HasSum() {
+				w.double("sum", dp.Sum())
+			}
+			if dp.HasMin() {
+				w.double("min", dp.Min())
+			}
+			if dp.HasMax() {
+				w.double("max", dp.Max())
+			}
+			w.int("scale", dp.Scale())
+			w.long("zero_count", int64(dp.ZeroCount()))
+			w.int("positive_offset", dp.Positive().Offset())
+			w.json("positive_bucket_counts", dp.Positive().BucketCounts().AsRaw())
+			w.int("negative_offset", dp.Negative().Offset())
+			w.json("negative_bucket_counts", dp.Negative().BucketCounts().AsRaw())
+			w.end()
+		}
+	case pmetric.MetricTypeSummary:
+		points := metric.Summary().DataPoints()
+		for i := 0; i < points.Len(); i++ {
+			dp := points.At(i)
+			w := metricRow(r, resource, scope, metric, dp.StartTimestamp(), dp.Timestamp(), dp.Attributes(), dp.Flags())
+			w.long("count", int64(dp.Count()))
+			w.double("sum", dp.Sum())
+			var quantiles []map[string]any
+			for j := 0; j < dp.QuantileValues().Len(); j++ {
+				q := dp.QuantileValues().At(j)
+				quantiles = append(quantiles, map[string]any{"quantile": q.Quantile(), "value": q.Value()})
+			}
+			w.json("quantiles", quantiles)
+			w.end()
+		}
+	}
+}
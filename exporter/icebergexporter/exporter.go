@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// icebergExporter writes a signal to its Iceberg table.
+type icebergExporter struct {
+	cfg       *Config
+	set       component.TelemetrySettings
+	tableName string
+	table     signalTable
+
+	writer *tableWriter
+}
+
+func newIcebergExporter(cfg *Config, set component.TelemetrySettings, tableName string, table signalTable) *icebergExporter {
+	return &icebergExporter{
+		cfg:       cfg,
+		set:       set,
+		tableName: tableName,
+		table:     table,
+	}
+}
+
+func (e *icebergExporter) start(ctx context.Context, host component.Host) error {
+	sess, err := newAWSSession(e.cfg.Storage)
+	if err != nil {
+		return err
+	}
+	store := newLocationStore(sess, e.cfg.Storage)
+
+	var c catalog
+	switch e.cfg.Catalog.Type {
+	case catalogTypeGlue:
+		glueConfig := aws.NewConfig()
+		if e.cfg.Catalog.Glue.Region != "" {
+			glueConfig = glueConfig.WithRegion(e.cfg.Catalog.Glue.Region)
+		}
+		c = newGlueCatalog(glue.New(sess, glueConfig), store, e.cfg.Catalog.Glue, e.cfg.Namespace)
+	default:
+		client, err := e.cfg.Catalog.REST.ToClient(ctx, host, e.set)
+		if err != nil {
+			return err
+		}
+		c = newRESTCatalog(client, e.cfg.Catalog.REST, e.cfg.Namespace)
+	}
+	e.writer = newTableWriter(e.cfg, e.tableName, e.table, c, store, e.set.Logger)
+	return nil
+}
+
+func (e *icebergExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	return e.writer.write(ctx, func(r *partitionedRecords) {
+		appendTraces(r, td)
+	})
+}
+
+func (e *icebergExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	return e.writer.write(ctx, func(r *partitionedRecords) {
+		appendMetrics(r, md)
+	})
+}
+
+func (e *icebergExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	return e.writer.write(ctx, func(r *partitionedRecords) {
+		appendLogs(r, ld)
+	})
+}
@@ -0,0 +1,376 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v15/parquet/file"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// fakeRESTCatalog is an in-memory Iceberg REST catalog, with the tables located in a
+// local directory.
+type fakeRESTCatalog struct {
+	t         *testing.T
+	warehouse string
+
+	mutex      sync.Mutex
+	namespaces map[string]bool
+	tables     map[string]*tableMetadata
+	// conflicts is the number of commits to reject as concurrent modifications.
+	conflicts int
+	commits   int
+}
+
+func newFakeRESTCatalog(t *testing.T) (*fakeRESTCatalog, *httptest.Server) {
+	c := &fakeRESTCatalog{
+		t:          t,
+		warehouse:  "file://" + t.TempDir(),
+		namespaces: map[string]bool{},
+		tables:     map[string]*tableMetadata{},
+	}
+	srv := httptest.NewServer(c)
+	t.Cleanup(srv.Close)
+	return c, srv
+}
+
+func (c *fakeRESTCatalog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Path == "/v1/config" {
+		assert.Equal(c.t, "observability", r.URL.Query().Get("warehouse"))
+		c.respond(w, http.StatusOK, map[string]any{"overrides": map[string]string{"prefix": "observability"}})
+		return
+	}
+	path, ok := strings.CutPrefix(r.URL.EscapedPath(), "/v1/observability/namespaces")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if path == "" && r.Method == http.MethodPost {
+		var req struct {
+			Namespace []string `json:"namespace"`
+		}
+		require.NoError(c.t, json.NewDecoder(r.Body).Decode(&req))
+		c.namespaces[strings.Join(req.Namespace, ".")] = true
+		c.respond(w, http.StatusOK, req)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	namespace, err := url.PathUnescape(parts[0])
+	require.NoError(c.t, err)
+	namespace = strings.ReplaceAll(namespace, "\x1f", ".")
+	if !c.namespaces[namespace] {
+		c.respondError(w, http.StatusNotFound, "NoSuchNamespaceException")
+		return
+	}
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodPost:
+		c.createTable(w, r, namespace)
+	case len(parts) == 3 && r.Method == http.MethodGet:
+		md, ok := c.tables[namespace+"."+parts[2]]
+		if !ok {
+			c.respondError(w, http.StatusNotFound, "NoSuchTableException")
+			return
+		}
+		c.respond(w, http.StatusOK, loadTableResponse{MetadataLocation: "unused", Metadata: md})
+	case len(parts) == 3 && r.Method == http.MethodPost:
+		c.commitTable(w, r, namespace+"."+parts[2])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (c *fakeRESTCatalog) createTable(w http.ResponseWriter, r *http.Request, namespace string) {
+	var req struct {
+		Name   string         `json:"name"`
+		Schema *schema        `json:"schema"`
+		Spec   *partitionSpec `json:"partition-spec"`
+	}
+	require.NoError(c.t, json.NewDecoder(r.Body).Decode(&req))
+	name := namespace + "." + req.Name
+	if _, ok := c.tables[name]; ok {
+		c.respondError(w, http.StatusConflict, "AlreadyExistsException")
+		return
+	}
+	md := newTableMetadata(c.warehouse+"/"+strings.ReplaceAll(name, ".", "/"), req.Schema, req.Spec, nil)
+	c.tables[name] = md
+	c.respond(w, http.StatusOK, loadTableResponse{MetadataLocation: "unused", Metadata: md})
+}
+
+func (c *fakeRESTCatalog) commitTable(w http.ResponseWriter, r *http.Request, name string) {
+	var req struct {
+		Requirements []struct {
+			Type                    string `json:"type"`
+			Ref                     string `json:"ref"`
+			SnapshotID              *int64 `json:"snapshot-id"`
+			CurrentSchemaID         int    `json:"current-schema-id"`
+			LastAssignedFieldID     int    `json:"last-assigned-field-id"`
+			DefaultSpecID           int    `json:"default-spec-id"`
+			LastAssignedPartitionID int    `json:"last-assigned-partition-id"`
+		} `json:"requirements"`
+		Updates []tableUpdate `json:"updates"`
+	}
+	require.NoError(c.t, json.NewDecoder(r.Body).Decode(&req))
+	c.commits++
+	if c.conflicts > 0 {
+		c.conflicts--
+		c.respondError(w, http.StatusConflict, "CommitFailedException")
+		return
+	}
+	requirements := make([]tableRequirement, len(req.Requirements))
+	for i, r := range req.Requirements {
+		requirements[i] = tableRequirement{
+			Type:       r.Type,
+			Ref:        r.Ref,
+			SnapshotID: r.SnapshotID,
+			ID:         r.CurrentSchemaID + r.LastAssignedFieldID + r.DefaultSpecID + r.LastAssignedPartitionID,
+		}
+	}
+	md, err := c.tables[name].clone()
+	require.NoError(c.t, err)
+	if err = checkRequirements(md, requirements); err != nil {
+		c.respondError(w, http.StatusConflict, "CommitFailedException")
+		return
+	}
+	require.NoError(c.t, applyUpdates(md, req.Updates))
+	c.tables[name] = md
+	c.respond(w, http.StatusOK, loadTableResponse{MetadataLocation: "unused", Metadata: md})
+}
+
+func (c *fakeRESTCatalog) table(name string) *tableMetadata {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.tables[name]
+}
+
+func (c *fakeRESTCatalog) respond(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(c.t, json.NewEncoder(w).Encode(body))
+}
+
+func (c *fakeRESTCatalog) respondError(w http.ResponseWriter, status int, typ string) {
+	var body restError
+	body.Error.Message = typ
+	body.Error.Type = typ
+	body.Error.Code = status
+	c.respond(w, status, body)
+}
+
+func testConfig(endpoint string) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.QueueSettings.Enabled = false
+	cfg.BackOffConfig.Enabled = false
+	cfg.Catalog.REST.Endpoint = endpoint
+	cfg.Catalog.REST.Warehouse = "observability"
+	cfg.Catalog.REST.Token = "secret"
+	cfg.Namespace = "telemetry.prod"
+	cfg.Partitioning.ResourceAttributes = []string{"service.name"}
+	return cfg
+}
+
+func readLocation(t *testing.T, location string) []byte {
+	data, err := os.ReadFile(strings.TrimPrefix(location, "file://"))
+	require.NoError(t, err)
+	return data
+}
+
+// readManifestEntries returns the data files of the manifests of the snapshot.
+func readManifestEntries(t *testing.T, s *snapshot) []map[string]any {
+	manifests, err := readManifestList(readLocation(t, s.ManifestList))
+	require.NoError(t, err)
+	var entries []map[string]any
+	for _, m := range manifests {
+		r, err := goavro.NewOCFReader(bytes.NewReader(readLocation(t, m["manifest_path"].(string))))
+		require.NoError(t, err)
+		for r.Scan() {
+			datum, err := r.Read()
+			require.NoError(t, err)
+			entries = append(entries, datum.(map[string]any)["data_file"].(map[string]any))
+		}
+	}
+	return entries
+}
+
+func TestExporterTraces(t *testing.T) {
+	c, srv := newFakeRESTCatalog(t)
+	exp, err := createTracesExporter(context.Background(), exportertest.NewNopCreateSettings(), testConfig(srv.URL))
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	traces := ptrace.NewTraces()
+	for _, service := range []string{"checkout", "cart"} {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetTraceID(pcommon.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+		span.SetSpanID(pcommon.SpanID{1, 2, 3, 4, 5, 6, 7, 8})
+		span.SetName("GET /cart")
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Second)))
+		span.Attributes().PutInt("http.status_code", 200)
+	}
+	require.NoError(t, exp.ConsumeTraces(context.Background(), traces))
+	require.NoError(t, exp.ConsumeTraces(context.Background(), traces))
+
+	md := c.table("telemetry.prod.traces")
+	require.NotNil(t, md)
+	require.Len(t, md.Snapshots, 2)
+	head := md.branchHead(mainBranch)
+	require.NotNil(t, head)
+	assert.Equal(t, md.Snapshots[0].SnapshotID, *head.ParentSnapshotID)
+	assert.Equal(t, int64(2), head.SequenceNumber)
+	assert.Equal(t, "4", head.Summary["total-records"])
+	assert.Equal(t, "4", head.Summary["total-data-files"])
+
+	require.Len(t, md.PartitionSpecs, 1)
+	spec := md.PartitionSpecs[0]
+	require.Len(t, spec.Fields, 2)
+	assert.Equal(t, "start_time_day", spec.Fields[0].Name)
+	assert.Equal(t, transformDay, spec.Fields[0].Transform)
+	assert.Equal(t, "resource_service_name", spec.Fields[1].Name)
+
+	entries := readManifestEntries(t, head)
+	require.Len(t, entries, 4)
+	for _, entry := range entries {
+		location := entry["file_path"].(string)
+		assert.Equal(t, int64(1), entry["record_count"])
+		assert.Contains(t, location, "/telemetry/prod/traces/data/start_time_day=2024-05-01/resource_service_name=")
+
+		reader, err := file.NewParquetReader(bytes.NewReader(readLocation(t, location)))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), reader.NumRows())
+		columns := reader.MetaData().Schema
+		s := md.currentSchema()
+		for i := 0; i < columns.NumColumns(); i++ {
+			node := columns.Column(i).SchemaNode()
+			field := s.field(node.Name())
+			require.NotNil(t, field, node.Name())
+			assert.Equal(t, int32(field.ID), node.FieldID(), node.Name())
+		}
+		require.NoError(t, reader.Close())
+	}
+}
+
+func TestExporterSchemaEvolution(t *testing.T) {
+	c, srv := newFakeRESTCatalog(t)
+	cfg := testConfig(srv.URL)
+	cfg.Partitioning.ResourceAttributes = nil
+	exp, err := createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+	lr.Body().SetStr("payment accepted")
+	require.NoError(t, exp.ConsumeLogs(context.Background(), logs))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	md := c.table("telemetry.prod.logs")
+	require.NotNil(t, md)
+	assert.Nil(t, md.currentSchema().field("resource_service_name"))
+	lastColumnID := md.LastColumnID
+
+	// A new resource attribute partition adds a column and a partition spec.
+	cfg.Partitioning.ResourceAttributes = []string{"service.name"}
+	cfg.Partitioning.Time = transformHour
+	exp, err = createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exp.ConsumeLogs(context.Background(), logs))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	md = c.table("telemetry.prod.logs")
+	require.Len(t, md.Schemas, 2)
+	assert.Equal(t, 1, md.CurrentSchemaID)
+	field := md.currentSchema().field("resource_service_name")
+	require.NotNil(t, field)
+	assert.Equal(t, lastColumnID+1, field.ID)
+	require.Len(t, md.PartitionSpecs, 2)
+	assert.Equal(t, 1, md.DefaultSpecID)
+	spec := md.spec(md.DefaultSpecID)
+	require.Len(t, spec.Fields, 2)
+	assert.Equal(t, "time_hour", spec.Fields[0].Name)
+	assert.Equal(t, field.ID, spec.Fields[1].SourceID)
+	assert.Len(t, md.Snapshots, 2)
+	assert.Len(t, readManifestEntries(t, md.branchHead(mainBranch)), 2)
+}
+
+func TestExporterCommitConflict(t *testing.T) {
+	c, srv := newFakeRESTCatalog(t)
+	cfg := testConfig(srv.URL)
+	cfg.CommitRetries = 1
+	exp, err := createMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	dp := m.SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+	dp.SetIntValue(42)
+
+	c.mutex.Lock()
+	c.conflicts = 1
+	c.mutex.Unlock()
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), metrics))
+	assert.Len(t, c.table("telemetry.prod.metrics").Snapshots, 1)
+
+	c.mutex.Lock()
+	c.conflicts = 2
+	c.mutex.Unlock()
+	err = exp.ConsumeMetrics(context.Background(), metrics)
+	assert.True(t, errors.Is(err, errCommitConflict), err)
+	assert.Len(t, c.table("telemetry.prod.metrics").Snapshots, 1)
+	assert.Equal(t, 4, c.commits)
+}
+
+func TestExporterCatalogUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+	exp, err := createLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), testConfig(srv.URL))
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	err = exp.ConsumeLogs(context.Background(), plog.NewLogs())
+	assert.ErrorContains(t, err, "failed to get the catalog configuration")
+}
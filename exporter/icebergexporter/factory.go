@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter/internal/metadata"
+)
+
+// Defaults for not specified configuration settings.
+const (
+	// defaultTimeout leaves the time to write the data files and commit the snapshot.
+	defaultTimeout       = time.Minute
+	defaultNamespace     = "otel"
+	defaultTracesTable   = "traces"
+	defaultMetricsTable  = "metrics"
+	defaultLogsTable     = "logs"
+	defaultCompression   = "zstd"
+	defaultCommitRetries = 4
+)
+
+// NewFactory creates a factory for the Iceberg exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TimeoutSettings: exporterhelper.TimeoutSettings{Timeout: defaultTimeout},
+		QueueSettings:   exporterhelper.NewDefaultQueueSettings(),
+		BackOffConfig:   configretry.NewDefaultBackOffConfig(),
+		Catalog: CatalogConfig{
+			Type: catalogTypeREST,
+			REST: RESTCatalogConfig{ClientConfig: confighttp.NewDefaultClientConfig()},
+		},
+		Namespace: defaultNamespace,
+		Tables: TablesConfig{
+			Traces:  defaultTracesTable,
+			Metrics: defaultMetricsTable,
+			Logs:    defaultLogsTable,
+		},
+		Partitioning: PartitioningConfig{
+			Time: transformDay,
+		},
+		Compression:   defaultCompression,
+		CommitRetries: defaultCommitRetries,
+	}
+}
+
+func createTracesExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	config component.Config,
+) (exporter.Traces, error) {
+	cfg := config.(*Config)
+	exp := newIcebergExporter(cfg, set.TelemetrySettings, cfg.Tables.Traces, tracesTable)
+
+	return exporterhelper.NewTracesExporter(
+		ctx,
+		set,
+		cfg,
+		exp.pushTraces,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithRetry(cfg.BackOffConfig))
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	config component.Config,
+) (exporter.Metrics, error) {
+	cfg := config.(*Config)
+	exp := newIcebergExporter(cfg, set.TelemetrySettings, cfg.Tables.Metrics, metricsTable)
+
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.pushMetrics,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithRetry(cfg.BackOffConfig))
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	config component.Config,
+) (exporter.Logs, error) {
+	cfg := config.(*Config)
+	exp := newIcebergExporter(cfg, set.TelemetrySettings, cfg.Tables.Logs, logsTable)
+
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.pushLogs,
+		exporterhelper.WithStart(exp.start),
+		exporterhelper.WithTimeout(cfg.TimeoutSettings),
+		exporterhelper.WithQueue(cfg.QueueSettings),
+		exporterhelper.WithRetry(cfg.BackOffConfig))
+}
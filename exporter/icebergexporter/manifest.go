@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// The manifests and manifest lists are Avro files, their schemas being the ones of the
+// format version 2 of the Iceberg specification. The optional fields which aren't
+// written by the exporter, such as the column metrics, are omitted from the schemas.
+
+// dataFile is a Parquet data file written to the table.
+type dataFile struct {
+	location    string
+	partition   []any
+	recordCount int64
+	size        int64
+}
+
+// Status of the manifest entries.
+const manifestEntryAdded = 1
+
+// avroField returns the definition of an Avro field with its Iceberg field ID.
+func avroField(name string, typ any, fieldID int) map[string]any {
+	return map[string]any{"name": name, "type": typ, "field-id": fieldID}
+}
+
+// optionalAvroField returns the definition of a nullable Avro field, null by default.
+func optionalAvroField(name string, typ any, fieldID int) map[string]any {
+	return map[string]any{"name": name, "type": []any{"null", typ}, "default": nil, "field-id": fieldID}
+}
+
+// partitionAvroType returns the Avro type and union branch of the values of a partition field.
+func partitionAvroType(transform string) (any, string) {
+	switch transform {
+	case transformIdentity:
+		return "string", "string"
+	case transformDay:
+		return map[string]any{"type": "int", "logicalType": "date"}, "int.date"
+	}
+	return "int", "int"
+}
+
+func manifestEntrySchema(spec *partitionSpec) (string, error) {
+	partitionFields := make([]any, 0, len(spec.Fields))
+	for _, f := range spec.Fields {
+		typ, _ := partitionAvroType(f.Transform)
+		partitionFields = append(partitionFields, optionalAvroField(f.Name, typ, f.FieldID))
+	}
+	s := map[string]any{
+		"type": "record",
+		"name": "manifest_entry",
+		"fields": []any{
+			avroField("status", "int", 0),
+			optionalAvroField("snapshot_id", "long", 1),
+			optionalAvroField("sequence_number", "long", 3),
+			optionalAvroField("file_sequence_number", "long", 4),
+			avroField("data_file", map[string]any{
+				"type": "record",
+				"name": "r2",
+				"fields": []any{
+					avroField("content", "int", 134),
+					avroField("file_path", "string", 100),
+					avroField("file_format", "string", 101),
+					avroField("partition", map[string]any{"type": "record", "name": "r102", "fields": partitionFields}, 102),
+					avroField("record_count", "long", 103),
+					avroField("file_size_in_bytes", "long", 104),
+					optionalAvroField("sort_order_id", "int", 140),
+				},
+			}, 2),
+		},
+	}
+	data, err := json.Marshal(s)
+	return string(data), err
+}
+
+// writeManifest returns a manifest adding the data files to the table. The snapshot ID
+// and sequence numbers of the entries are null, so that they are inherited from the
+// manifest list, and the manifest can be reused when the commit is retried.
+func writeManifest(s *schema, spec *partitionSpec, files []dataFile) ([]byte, error) {
+	avroSchema, err := manifestEntrySchema(spec)
+	if err != nil {
+		return nil, err
+	}
+	schemaJSON, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	specJSON, err := json.Marshal(spec.Fields)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W:               &buf,
+		Schema:          avroSchema,
+		CompressionName: goavro.CompressionDeflateLabel,
+		MetaData: map[string][]byte{
+			"schema":            schemaJSON,
+			"schema-id":         []byte(strconv.Itoa(s.SchemaID)),
+			"partition-spec":    specJSON,
+			"partition-spec-id": []byte(strconv.Itoa(spec.SpecID)),
+			"format-version":    []byte("2"),
+			"content":           []byte("data"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]any, len(files))
+	for i, f := range files {
+		partition := make(map[string]any, len(spec.Fields))
+		for j, pf := range spec.Fields {
+			if f.partition[j] == nil {
+				partition[pf.Name] = nil
+				continue
+			}
+			_, branch := partitionAvroType(pf.Transform)
+			partition[pf.Name] = goavro.Union(branch, f.partition[j])
+		}
+		entries[i] = map[string]any{
+			"status":               manifestEntryAdded,
+			"snapshot_id":          nil,
+			"sequence_number":      nil,
+			"file_sequence_number": nil,
+			"data_file": map[string]any{
+				"content":            0,
+				"file_path":          f.location,
+				"file_format":        "PARQUET",
+				"partition":          partition,
+				"record_count":       f.recordCount,
+				"file_size_in_bytes": f.size,
+				"sort_order_id":      nil,
+			},
+		}
+	}
+	if err = w.Append(entries); err != nil {
+		return nil, fmt.Errorf("failed to encode the manifest: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// manifestFileSchema is the schema of the manifest list entries.
+var manifestFileSchema = func() string {
+	data, _ := json.Marshal(map[string]any{
+		"type": "record",
+		"name": "manifest_file",
+		"fields": []any{
+			avroField("manifest_path", "string", 500),
+			avroField("manifest_length", "long", 501),
+			avroField("partition_spec_id", "int", 502),
+			avroField("content", "int", 517),
+			avroField("sequence_number", "long", 515),
+			avroField("min_sequence_number", "long", 516),
+			avroField("added_snapshot_id", "long", 503),
+			avroField("added_files_count", "int", 504),
+			avroField("existing_files_count", "int", 505),
+			avroField("deleted_files_count", "int", 506),
+			avroField("added_rows_count", "long", 512),
+			avroField("existing_rows_count", "long", 513),
+			avroField("deleted_rows_count", "long", 514),
+			optionalAvroField("partitions", map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "record",
+					"name": "r508",
+					"fields": []any{
+						avroField("contains_null", "boolean", 509),
+						optionalAvroField("contains_nan", "boolean", 518),
+						optionalAvroField("lower_bound", "bytes", 510),
+						optionalAvroField("upper_bound", "bytes", 511),
+					},
+				},
+				"element-id": 508,
+			}, 507),
+			optionalAvroField("key_metadata", "bytes", 519),
+		},
+	})
+	return string(data)
+}()
+
+// manifestFile is a manifest added by a snapshot.
+type manifestFile struct {
+	location string
+	length   int64
+	specID   int
+	files    []dataFile
+}
+
+// manifestListEntry returns the manifest list entry of the manifest.
+func (m manifestFile) manifestListEntry(sequenceNumber, snapshotID int64) map[string]any {
+	summaries := make([]partitionSummary, 0)
+	var rows int64
+	for i, f := range m.files {
+		rows += f.recordCount
+		if i == 0 {
+			summaries = make([]partitionSummary, len(f.partition))
+		}
+		for j, v := range f.partition {
+			summaries[j].add(v)
+		}
+	}
+	partitions := make([]any, len(summaries))
+	for i, s := range summaries {
+		summary := map[string]any{"contains_null": s.containsNull, "contains_nan": nil, "lower_bound": nil, "upper_bound": nil}
+		if s.lower != nil {
+			summary["lower_bound"] = goavro.Union("bytes", serializeBound(s.lower))
+			summary["upper_bound"] = goavro.Union("bytes", serializeBound(s.upper))
+		}
+		partitions[i] = summary
+	}
+	return map[string]any{
+		"manifest_path":        m.location,
+		"manifest_length":      m.length,
+		"partition_spec_id":    m.specID,
+		"content":              0,
+		"sequence_number":      sequenceNumber,
+		"min_sequence_number":  sequenceNumber,
+		"added_snapshot_id":    snapshotID,
+		"added_files_count":    len(m.files),
+		"existing_files_count": 0,
+		"deleted_files_count":  0,
+		"added_rows_count":     rows,
+		"existing_rows_count":  int64(0),
+		"deleted_rows_count":   int64(0),
+		"partitions":           goavro.Union("array", partitions),
+		"key_metadata":         nil,
+	}
+}
+
+// readManifestList returns the entries of a manifest list. The entries are keyed by the
+// field names of manifestFileSchema, matched by field ID with the fields of the file,
+// as the writers don't all use the same names.
+func readManifestList(data []byte) ([]map[string]any, error) {
+	r, err := goavro.NewOCFReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	names, err := avroFieldNames(r.Codec().Schema())
+	if err != nil {
+		return nil, err
+	}
+	ours, err := avroFieldNames(manifestFileSchema)
+	if err != nil {
+		return nil, err
+	}
+	var entries []map[string]any
+	for r.Scan() {
+		datum, err := r.Read()
+		if err != nil {
+			return nil, err
+		}
+		record, ok := datum.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected manifest list entry %T", datum)
+		}
+		entry := make(map[string]any, len(record))
+		for id, name := range ours {
+			if v, ok := record[names[id]]; ok {
+				entry[name] = v
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, r.Err()
+}
+
+// avroFieldNames returns the names of the top-level fields of an Avro record schema by field ID.
+func avroFieldNames(avroSchema string) (map[int]string, error) {
+	var record struct {
+		Fields []struct {
+			Name    string `json:"name"`
+			FieldID *int   `json:"field-id"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal([]byte(avroSchema), &record); err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(record.Fields))
+	for _, f := range record.Fields {
+		if f.FieldID != nil {
+			names[*f.FieldID] = f.Name
+		}
+	}
+	return names, nil
+}
+
+// writeManifestList returns a manifest list with the entries of the parent snapshot,
+// followed by the entry of the new manifest.
+func writeManifestList(entries []map[string]any, snapshotID int64, parentID *int64, sequenceNumber int64) ([]byte, error) {
+	parent := "null"
+	if parentID != nil {
+		parent = strconv.FormatInt(*parentID, 10)
+	}
+	var buf bytes.Buffer
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W:               &buf,
+		Schema:          manifestFileSchema,
+		CompressionName: goavro.CompressionDeflateLabel,
+		MetaData: map[string][]byte{
+			"snapshot-id":        []byte(strconv.FormatInt(snapshotID, 10)),
+			"parent-snapshot-id": []byte(parent),
+			"sequence-number":    []byte(strconv.FormatInt(sequenceNumber, 10)),
+			"format-version":     []byte("2"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]any, len(entries))
+	for i, e := range entries {
+		records[i] = e
+	}
+	if err = w.Append(records); err != nil {
+		return nil, fmt.Errorf("failed to encode the manifest list: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,377 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// mainBranch is the branch the snapshots are committed to.
+const mainBranch = "main"
+
+// tableMetadata is the Iceberg table metadata, format version 2. The fields which
+// aren't read or updated by the exporter, such as the sort orders, are preserved
+// as they are when the metadata is written back.
+type tableMetadata struct {
+	FormatVersion      int                     `json:"format-version"`
+	TableUUID          string                  `json:"table-uuid"`
+	Location           string                  `json:"location"`
+	LastSequenceNumber int64                   `json:"last-sequence-number"`
+	LastUpdatedMs      int64                   `json:"last-updated-ms"`
+	LastColumnID       int                     `json:"last-column-id"`
+	CurrentSchemaID    int                     `json:"current-schema-id"`
+	Schemas            []*schema               `json:"schemas"`
+	DefaultSpecID      int                     `json:"default-spec-id"`
+	PartitionSpecs     []*partitionSpec        `json:"partition-specs"`
+	LastPartitionID    int                     `json:"last-partition-id"`
+	Properties         map[string]string       `json:"properties,omitempty"`
+	CurrentSnapshotID  *int64                  `json:"current-snapshot-id,omitempty"`
+	Snapshots          []*snapshot             `json:"snapshots,omitempty"`
+	SnapshotLog        []snapshotLogEntry      `json:"snapshot-log,omitempty"`
+	MetadataLog        []metadataLogEntry      `json:"metadata-log,omitempty"`
+	Refs               map[string]*snapshotRef `json:"refs,omitempty"`
+
+	// raw holds all the fields of the decoded metadata.
+	raw map[string]json.RawMessage
+}
+
+// metadataFields are the JSON fields of tableMetadata.
+var metadataFields = []string{
+	"format-version", "table-uuid", "location", "last-sequence-number", "last-updated-ms",
+	"last-column-id", "current-schema-id", "schemas", "default-spec-id", "partition-specs",
+	"last-partition-id", "properties", "current-snapshot-id", "snapshots", "snapshot-log",
+	"metadata-log", "refs",
+}
+
+type tableMetadataFields tableMetadata
+
+func (md *tableMetadata) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*tableMetadataFields)(md)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &md.raw)
+}
+
+func (md *tableMetadata) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal((*tableMetadataFields)(md))
+	if err != nil || len(md.raw) == 0 {
+		return data, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	merged := make(map[string]json.RawMessage, len(md.raw))
+	for k, v := range md.raw {
+		merged[k] = v
+	}
+	for _, k := range metadataFields {
+		delete(merged, k)
+		if v, ok := fields[k]; ok {
+			merged[k] = v
+		}
+	}
+	return json.Marshal(merged)
+}
+
+// clone returns a deep copy of the metadata.
+func (md *tableMetadata) clone() (*tableMetadata, error) {
+	data, err := json.Marshal(md)
+	if err != nil {
+		return nil, err
+	}
+	clone := &tableMetadata{}
+	return clone, json.Unmarshal(data, clone)
+}
+
+func (md *tableMetadata) currentSchema() *schema {
+	for _, s := range md.Schemas {
+		if s.SchemaID == md.CurrentSchemaID {
+			return s
+		}
+	}
+	return nil
+}
+
+func (md *tableMetadata) spec(id int) *partitionSpec {
+	for _, s := range md.PartitionSpecs {
+		if s.SpecID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+func (md *tableMetadata) snapshot(id int64) *snapshot {
+	for _, s := range md.Snapshots {
+		if s.SnapshotID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// branchHead returns the snapshot at the head of the branch, nil if the branch has no snapshot.
+func (md *tableMetadata) branchHead(branch string) *snapshot {
+	if ref, ok := md.Refs[branch]; ok {
+		return md.snapshot(ref.SnapshotID)
+	}
+	if branch == mainBranch && md.CurrentSnapshotID != nil {
+		return md.snapshot(*md.CurrentSnapshotID)
+	}
+	return nil
+}
+
+// newTableMetadata returns the metadata of a new table, used by the catalogs which don't create it.
+func newTableMetadata(location string, s *schema, spec *partitionSpec, properties map[string]string) *tableMetadata {
+	lastPartitionID := firstPartitionFieldID - 1
+	for _, f := range spec.Fields {
+		lastPartitionID = max(lastPartitionID, f.FieldID)
+	}
+	lastColumnID := 0
+	for _, f := range s.Fields {
+		lastColumnID = max(lastColumnID, f.ID)
+	}
+	noSnapshot := int64(-1)
+	return &tableMetadata{
+		FormatVersion:     2,
+		TableUUID:         uuid.NewString(),
+		Location:          location,
+		LastColumnID:      lastColumnID,
+		Schemas:           []*schema{s},
+		PartitionSpecs:    []*partitionSpec{spec},
+		LastPartitionID:   lastPartitionID,
+		Properties:        properties,
+		CurrentSnapshotID: &noSnapshot,
+		raw: map[string]json.RawMessage{
+			"default-sort-order-id": json.RawMessage(`0`),
+			"sort-orders":           json.RawMessage(`[{"order-id":0,"fields":[]}]`),
+		},
+	}
+}
+
+// snapshot is an Iceberg snapshot.
+type snapshot struct {
+	SnapshotID       int64             `json:"snapshot-id"`
+	ParentSnapshotID *int64            `json:"parent-snapshot-id,omitempty"`
+	SequenceNumber   int64             `json:"sequence-number"`
+	TimestampMs      int64             `json:"timestamp-ms"`
+	ManifestList     string            `json:"manifest-list"`
+	Summary          map[string]string `json:"summary"`
+	SchemaID         *int              `json:"schema-id,omitempty"`
+}
+
+type snapshotRef struct {
+	SnapshotID int64  `json:"snapshot-id"`
+	Type       string `json:"type"`
+}
+
+type snapshotLogEntry struct {
+	TimestampMs int64 `json:"timestamp-ms"`
+	SnapshotID  int64 `json:"snapshot-id"`
+}
+
+type metadataLogEntry struct {
+	TimestampMs  int64  `json:"timestamp-ms"`
+	MetadataFile string `json:"metadata-file"`
+}
+
+// newSnapshotID returns a random positive snapshot ID, as the Iceberg Java library does.
+func newSnapshotID() int64 {
+	id := uuid.New()
+	var msb, lsb uint64
+	for i := 0; i < 8; i++ {
+		msb = msb<<8 | uint64(id[i])
+		lsb = lsb<<8 | uint64(id[i+8])
+	}
+	return int64((msb ^ lsb) & math.MaxInt64)
+}
+
+// tableUpdate is a change of the table metadata committed to the catalog, as defined by
+// the Iceberg REST catalog API.
+type tableUpdate struct {
+	Action       string         `json:"action"`
+	Schema       *schema        `json:"schema,omitempty"`
+	LastColumnID int            `json:"last-column-id,omitempty"`
+	SchemaID     *int           `json:"schema-id,omitempty"`
+	Spec         *partitionSpec `json:"spec,omitempty"`
+	SpecID       *int           `json:"spec-id,omitempty"`
+	Snapshot     *snapshot      `json:"snapshot,omitempty"`
+	RefName      string         `json:"ref-name,omitempty"`
+	Type         string         `json:"type,omitempty"`
+	SnapshotID   *int64         `json:"snapshot-id,omitempty"`
+}
+
+// lastAdded is the ID referencing the last schema or partition spec added by the commit.
+const lastAdded = -1
+
+func addSchema(s *schema, lastColumnID int) tableUpdate {
+	return tableUpdate{Action: "add-schema", Schema: s, LastColumnID: lastColumnID}
+}
+
+func setCurrentSchema(id int) tableUpdate {
+	return tableUpdate{Action: "set-current-schema", SchemaID: &id}
+}
+
+func addSpec(spec *partitionSpec) tableUpdate {
+	return tableUpdate{Action: "add-spec", Spec: spec}
+}
+
+func setDefaultSpec(id int) tableUpdate {
+	return tableUpdate{Action: "set-default-spec", SpecID: &id}
+}
+
+func addSnapshot(s *snapshot) tableUpdate {
+	return tableUpdate{Action: "add-snapshot", Snapshot: s}
+}
+
+func setBranchHead(branch string, id int64) tableUpdate {
+	return tableUpdate{Action: "set-snapshot-ref", RefName: branch, Type: "branch", SnapshotID: &id}
+}
+
+// tableRequirement is an assertion on the table metadata checked by the catalog before
+// applying the updates of a commit, as defined by the Iceberg REST catalog API.
+type tableRequirement struct {
+	Type string
+	Ref  string
+	// SnapshotID is nil when the reference must not exist.
+	SnapshotID *int64
+	ID         int
+}
+
+func (r tableRequirement) MarshalJSON() ([]byte, error) {
+	fields := map[string]any{"type": r.Type}
+	switch r.Type {
+	case "assert-ref-snapshot-id":
+		fields["ref"] = r.Ref
+		fields["snapshot-id"] = r.SnapshotID
+	case "assert-current-schema-id":
+		fields["current-schema-id"] = r.ID
+	case "assert-last-assigned-field-id":
+		fields["last-assigned-field-id"] = r.ID
+	case "assert-default-spec-id":
+		fields["default-spec-id"] = r.ID
+	case "assert-last-assigned-partition-id":
+		fields["last-assigned-partition-id"] = r.ID
+	}
+	return json.Marshal(fields)
+}
+
+// assertBranchHead asserts that the branch head is the snapshot, nil meaning that the branch has no snapshot.
+func assertBranchHead(branch string, s *snapshot) tableRequirement {
+	r := tableRequirement{Type: "assert-ref-snapshot-id", Ref: branch}
+	if s != nil {
+		r.SnapshotID = &s.SnapshotID
+	}
+	return r
+}
+
+// assertUnchangedSchemaAndSpec asserts that the schema and partition spec of the table
+// weren't changed since the metadata was loaded.
+func assertUnchangedSchemaAndSpec(md *tableMetadata) []tableRequirement {
+	return []tableRequirement{
+		{Type: "assert-current-schema-id", ID: md.CurrentSchemaID},
+		{Type: "assert-last-assigned-field-id", ID: md.LastColumnID},
+		{Type: "assert-default-spec-id", ID: md.DefaultSpecID},
+		{Type: "assert-last-assigned-partition-id", ID: md.LastPartitionID},
+	}
+}
+
+// checkRequirements returns errCommitConflict if a requirement isn't met by the metadata.
+func checkRequirements(md *tableMetadata, requirements []tableRequirement) error {
+	for _, r := range requirements {
+		var met bool
+		switch r.Type {
+		case "assert-ref-snapshot-id":
+			head := md.branchHead(r.Ref)
+			met = r.SnapshotID == nil && head == nil || r.SnapshotID != nil && head != nil && head.SnapshotID == *r.SnapshotID
+		case "assert-current-schema-id":
+			met = md.CurrentSchemaID == r.ID
+		case "assert-last-assigned-field-id":
+			met = md.LastColumnID == r.ID
+		case "assert-default-spec-id":
+			met = md.DefaultSpecID == r.ID
+		case "assert-last-assigned-partition-id":
+			met = md.LastPartitionID == r.ID
+		default:
+			return fmt.Errorf("unsupported requirement %q", r.Type)
+		}
+		if !met {
+			return fmt.Errorf("%w: %s failed", errCommitConflict, r.Type)
+		}
+	}
+	return nil
+}
+
+// applyUpdates applies the updates to the metadata, as done by the REST catalogs, for
+// the catalogs which only store the location of the metadata file.
+func applyUpdates(md *tableMetadata, updates []tableUpdate) error {
+	lastSchemaID, lastSpecID := -1, -1
+	for _, u := range updates {
+		switch u.Action {
+		case "add-schema":
+			s := *u.Schema
+			s.SchemaID = 0
+			for _, existing := range md.Schemas {
+				s.SchemaID = max(s.SchemaID, existing.SchemaID+1)
+			}
+			md.Schemas = append(md.Schemas, &s)
+			md.LastColumnID = max(md.LastColumnID, u.LastColumnID)
+			lastSchemaID = s.SchemaID
+		case "set-current-schema":
+			id := *u.SchemaID
+			if id == lastAdded {
+				id = lastSchemaID
+			}
+			if id < 0 {
+				return errors.New("no schema was added before set-current-schema")
+			}
+			md.CurrentSchemaID = id
+		case "add-spec":
+			spec := *u.Spec
+			spec.SpecID = 0
+			for _, existing := range md.PartitionSpecs {
+				spec.SpecID = max(spec.SpecID, existing.SpecID+1)
+			}
+			for _, f := range spec.Fields {
+				md.LastPartitionID = max(md.LastPartitionID, f.FieldID)
+			}
+			md.PartitionSpecs = append(md.PartitionSpecs, &spec)
+			lastSpecID = spec.SpecID
+		case "set-default-spec":
+			id := *u.SpecID
+			if id == lastAdded {
+				id = lastSpecID
+			}
+			if id < 0 {
+				return errors.New("no partition spec was added before set-default-spec")
+			}
+			md.DefaultSpecID = id
+		case "add-snapshot":
+			md.Snapshots = append(md.Snapshots, u.Snapshot)
+			md.LastSequenceNumber = max(md.LastSequenceNumber, u.Snapshot.SequenceNumber)
+		case "set-snapshot-ref":
+			s := md.snapshot(*u.SnapshotID)
+			if s == nil {
+				return fmt.Errorf("unknown snapshot %d", *u.SnapshotID)
+			}
+			if md.Refs == nil {
+				md.Refs = map[string]*snapshotRef{}
+			}
+			md.Refs[u.RefName] = &snapshotRef{SnapshotID: s.SnapshotID, Type: u.Type}
+			if u.RefName == mainBranch {
+				md.CurrentSnapshotID = &s.SnapshotID
+				md.SnapshotLog = append(md.SnapshotLog, snapshotLogEntry{TimestampMs: s.TimestampMs, SnapshotID: s.SnapshotID})
+			}
+		default:
+			return fmt.Errorf("unsupported update %q", u.Action)
+		}
+	}
+	return nil
+}
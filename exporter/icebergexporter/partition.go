@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// The partition transforms supported by the exporter.
+const (
+	transformIdentity = "identity"
+	transformHour     = "hour"
+	transformDay      = "day"
+	transformMonth    = "month"
+	transformYear     = "year"
+
+	// timePartitionNone disables the time partition.
+	timePartitionNone = "none"
+)
+
+// firstPartitionFieldID is the ID of the first partition field, the IDs of the
+// partition fields being assigned from 1000.
+const firstPartitionFieldID = 1000
+
+// partitionField is a field of an Iceberg partition spec.
+type partitionField struct {
+	SourceID  int    `json:"source-id"`
+	FieldID   int    `json:"field-id"`
+	Name      string `json:"name"`
+	Transform string `json:"transform"`
+}
+
+// partitionSpec is an Iceberg partition spec.
+type partitionSpec struct {
+	SpecID int              `json:"spec-id"`
+	Fields []partitionField `json:"fields"`
+}
+
+// partitionFields returns the fields of the partition spec configured for the table,
+// without their field IDs.
+func partitionFields(cfg PartitioningConfig, table signalTable, ids map[string]int) []partitionField {
+	var fields []partitionField
+	if cfg.Time != timePartitionNone {
+		fields = append(fields, partitionField{
+			SourceID:  ids[table.timeColumn],
+			Name:      table.timeColumn + "_" + cfg.Time,
+			Transform: cfg.Time,
+		})
+	}
+	for _, attr := range cfg.ResourceAttributes {
+		column := resourceColumn(attr)
+		fields = append(fields, partitionField{
+			SourceID:  ids[column],
+			Name:      column,
+			Transform: transformIdentity,
+		})
+	}
+	return fields
+}
+
+// reconcileSpec returns the ID of the table partition spec with the given fields, or
+// a new partition spec to add to the table when there is none. The field IDs of the
+// partition fields already in a spec of the table are reused.
+func reconcileSpec(md *tableMetadata, fields []partitionField) (int, *partitionSpec) {
+	for _, spec := range md.PartitionSpecs {
+		if samePartitionFields(spec.Fields, fields) {
+			return spec.SpecID, nil
+		}
+	}
+	spec := &partitionSpec{}
+	lastPartitionID := md.LastPartitionID
+	for _, f := range fields {
+		f.FieldID = 0
+		for _, existing := range md.PartitionSpecs {
+			for _, ef := range existing.Fields {
+				if ef.SourceID == f.SourceID && ef.Transform == f.Transform && ef.Name == f.Name {
+					f.FieldID = ef.FieldID
+				}
+			}
+		}
+		if f.FieldID == 0 {
+			lastPartitionID++
+			f.FieldID = lastPartitionID
+		}
+		spec.Fields = append(spec.Fields, f)
+	}
+	return -1, spec
+}
+
+func samePartitionFields(a, b []partitionField) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].SourceID != b[i].SourceID || a[i].Transform != b[i].Transform {
+			return false
+		}
+	}
+	return true
+}
+
+// partitioner computes the partition of the rows written to a table. The time partition
+// is computed from the timestamp of the row, and the identity partitions from the
+// resource attributes.
+type partitioner struct {
+	spec *partitionSpec
+	// attributes holds the resource attribute of each identity field, and is empty for the time field.
+	attributes []string
+}
+
+func newPartitioner(spec *partitionSpec, s *schema, table signalTable, resourceAttributes []string) (*partitioner, error) {
+	p := &partitioner{spec: spec, attributes: make([]string, len(spec.Fields))}
+	for i, f := range spec.Fields {
+		source := s.fieldByID(f.SourceID)
+		if source == nil {
+			return nil, fmt.Errorf("partition field %q has an unknown source column %d", f.Name, f.SourceID)
+		}
+		switch f.Transform {
+		case transformHour, transformDay, transformMonth, transformYear:
+			if source.Name != table.timeColumn {
+				return nil, fmt.Errorf("partition field %q has an unsupported source column %q", f.Name, source.Name)
+			}
+		case transformIdentity:
+			for _, attr := range resourceAttributes {
+				if resourceColumn(attr) == source.Name {
+					p.attributes[i] = attr
+				}
+			}
+			if p.attributes[i] == "" {
+				return nil, fmt.Errorf("partition field %q has an unsupported source column %q", f.Name, source.Name)
+			}
+		default:
+			return nil, fmt.Errorf("partition field %q has an unsupported transform %q", f.Name, f.Transform)
+		}
+	}
+	return p, nil
+}
+
+// values returns the partition values of a row, an int32 for the time transforms and a
+// string for the identity transforms, nil when the source value is missing.
+func (p *partitioner) values(resource pcommon.Map, ts pcommon.Timestamp) []any {
+	values := make([]any, len(p.spec.Fields))
+	for i, f := range p.spec.Fields {
+		if f.Transform == transformIdentity {
+			if v, ok := resource.Get(p.attributes[i]); ok {
+				values[i] = v.AsString()
+			}
+			continue
+		}
+		if ts != 0 {
+			values[i] = transformTime(f.Transform, ts)
+		}
+	}
+	return values
+}
+
+// key returns a string uniquely identifying the partition values.
+func (p *partitioner) key(values []any) string {
+	var b strings.Builder
+	for _, v := range values {
+		switch v := v.(type) {
+		case nil:
+			b.WriteString("n")
+		case int32:
+			b.WriteString("i" + strconv.Itoa(int(v)))
+		case string:
+			b.WriteString("s" + strconv.Itoa(len(v)) + ":" + v)
+		}
+		b.WriteByte('/')
+	}
+	return b.String()
+}
+
+// path returns the path of the partition relative to the data location, following the
+// layout used by the Iceberg Java library, e.g. start_time_day=2024-05-01/resource_service_name=checkout.
+func (p *partitioner) path(values []any) string {
+	parts := make([]string, len(values))
+	for i, f := range p.spec.Fields {
+		parts[i] = url.QueryEscape(f.Name) + "=" + url.QueryEscape(humanPartitionValue(f.Transform, values[i]))
+	}
+	return strings.Join(parts, "/")
+}
+
+func transformTime(transform string, ts pcommon.Timestamp) int32 {
+	t := ts.AsTime()
+	switch transform {
+	case transformHour:
+		return int32(t.Unix() / 3600)
+	case transformDay:
+		return int32(t.Unix() / 86400)
+	case transformMonth:
+		return int32((t.Year()-1970)*12 + int(t.Month()) - 1)
+	default:
+		return int32(t.Year() - 1970)
+	}
+}
+
+func humanPartitionValue(transform string, value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return v
+	case int32:
+		switch transform {
+		case transformHour:
+			return time.Unix(int64(v)*3600, 0).UTC().Format("2006-01-02-15")
+		case transformDay:
+			return time.Unix(int64(v)*86400, 0).UTC().Format("2006-01-02")
+		case transformMonth:
+			return time.Date(1970, time.Month(v+1), 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+		case transformYear:
+			return strconv.Itoa(1970 + int(v))
+		}
+		return strconv.Itoa(int(v))
+	}
+	return fmt.Sprint(value)
+}
+
+// partitionSummary summarizes the values of a partition field in a manifest.
+type partitionSummary struct {
+	containsNull bool
+	lower, upper any
+}
+
+func (s *partitionSummary) add(value any) {
+	switch v := value.(type) {
+	case nil:
+		s.containsNull = true
+	case int32:
+		if s.lower == nil || v < s.lower.(int32) {
+			s.lower = v
+		}
+		if s.upper == nil || v > s.upper.(int32) {
+			s.upper = v
+		}
+	case string:
+		if s.lower == nil || v < s.lower.(string) {
+			s.lower = v
+		}
+		if s.upper == nil || v > s.upper.(string) {
+			s.upper = v
+		}
+	}
+}
+
+// serializeBound returns the Iceberg single-value serialization of a partition value.
+func serializeBound(value any) []byte {
+	switch v := value.(type) {
+	case int32:
+		return binary.LittleEndian.AppendUint32(nil, uint32(v))
+	case string:
+		return []byte(v)
+	}
+	return nil
+}
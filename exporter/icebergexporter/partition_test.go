@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestTransformTime(t *testing.T) {
+	ts := pcommon.NewTimestampFromTime(time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC))
+	tests := []struct {
+		transform string
+		value     int32
+		human     string
+	}{
+		{transform: transformHour, value: 476266, human: "2024-05-01-10"},
+		{transform: transformDay, value: 19844, human: "2024-05-01"},
+		{transform: transformMonth, value: 652, human: "2024-05"},
+		{transform: transformYear, value: 54, human: "2024"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.transform, func(t *testing.T) {
+			value := transformTime(tt.transform, ts)
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.human, humanPartitionValue(tt.transform, value))
+		})
+	}
+}
+
+func TestPartitioner(t *testing.T) {
+	resourceAttributes := []string{"service.name", "k8s.namespace.name"}
+	columns := logsTable.tableColumns(resourceAttributes)
+	s := newSchema(columns)
+	ids := map[string]int{}
+	for _, f := range s.Fields {
+		ids[f.Name] = f.ID
+	}
+	md := newTableMetadata("file:///tmp/logs", s, &partitionSpec{}, nil)
+	specID, spec := reconcileSpec(md, partitionFields(PartitioningConfig{Time: transformDay, ResourceAttributes: resourceAttributes}, logsTable, ids))
+	assert.Equal(t, -1, specID)
+	require.NotNil(t, spec)
+	assert.Equal(t, []partitionField{
+		{SourceID: ids["time"], FieldID: 1000, Name: "time_day", Transform: transformDay},
+		{SourceID: ids["resource_service_name"], FieldID: 1001, Name: "resource_service_name", Transform: transformIdentity},
+		{SourceID: ids["resource_k8s_namespace_name"], FieldID: 1002, Name: "resource_k8s_namespace_name", Transform: transformIdentity},
+	}, spec.Fields)
+
+	p, err := newPartitioner(spec, s, logsTable, resourceAttributes)
+	require.NoError(t, err)
+	resource := pcommon.NewMap()
+	resource.PutStr("service.name", "check out/v2")
+	values := p.values(resource, pcommon.NewTimestampFromTime(time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)))
+	assert.Equal(t, []any{int32(19844), "check out/v2", nil}, values)
+	assert.Equal(t, "time_day=2024-05-01/resource_service_name=check+out%2Fv2/resource_k8s_namespace_name=null", p.path(values))
+	assert.NotEqual(t, p.key(values), p.key([]any{int32(19844), "check out/v2", "null"}))
+
+	_, err = newPartitioner(spec, s, logsTable, resourceAttributes[:1])
+	assert.ErrorContains(t, err, `partition field "resource_k8s_namespace_name" has an unsupported source column`)
+}
+
+func TestReconcileSpec(t *testing.T) {
+	s := newSchema(tracesTable.tableColumns(nil))
+	startTime := s.field("start_time").ID
+	spec := &partitionSpec{Fields: []partitionField{{SourceID: startTime, FieldID: 1000, Name: "start_time_day", Transform: transformDay}}}
+	md := newTableMetadata("file:///tmp/traces", s, spec, nil)
+
+	specID, added := reconcileSpec(md, []partitionField{{SourceID: startTime, Name: "start_time_day", Transform: transformDay}})
+	assert.Equal(t, 0, specID)
+	assert.Nil(t, added)
+
+	specID, added = reconcileSpec(md, []partitionField{{SourceID: startTime, Name: "start_time_hour", Transform: transformHour}})
+	assert.Equal(t, -1, specID)
+	require.NotNil(t, added)
+	assert.Equal(t, 1001, added.Fields[0].FieldID)
+
+	specID, added = reconcileSpec(md, nil)
+	assert.Equal(t, -1, specID)
+	require.NotNil(t, added)
+	assert.Empty(t, added.Fields)
+}
+
+func TestPartitionSummary(t *testing.T) {
+	var s partitionSummary
+	for _, v := range []any{int32(19845), nil, int32(19844)} {
+		s.add(v)
+	}
+	assert.True(t, s.containsNull)
+	assert.Equal(t, []byte{0x84, 0x4d, 0, 0}, serializeBound(s.lower))
+	assert.Equal(t, []byte{0x85, 0x4d, 0, 0}, serializeBound(s.upper))
+	assert.Equal(t, []byte("cart"), serializeBound("cart"))
+}
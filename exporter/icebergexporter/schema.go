@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v15/arrow"
+)
+
+// The Iceberg types of the columns written by the exporter. The tables only have
+// columns of primitive types, the nested values such as the attributes or the span
+// events being written as JSON strings: the Parquet writer doesn't set the field IDs
+// of the nested types, which the Iceberg readers need to read them.
+const (
+	typeString      = "string"
+	typeLong        = "long"
+	typeInt         = "int"
+	typeDouble      = "double"
+	typeBoolean     = "boolean"
+	typeTimestamptz = "timestamptz"
+)
+
+// fieldIDKey is the metadata key of the Parquet field ID of an arrow field.
+const fieldIDKey = "PARQUET:field_id"
+
+// column is a column written by the exporter.
+type column struct {
+	name string
+	typ  string
+}
+
+// columnResourceAttributes is the column of the resource attributes, the first column of each table.
+const columnResourceAttributes = "resource_attributes"
+
+// signalTable describes the table of a signal.
+type signalTable struct {
+	// columns are the columns of the signal, the resource and scope columns excluded.
+	columns []column
+	// timeColumn is the source column of the time partition.
+	timeColumn string
+}
+
+// The tables have one row per span, log record or metric data point, the resource and
+// scope being repeated on each row. The columns which don't apply to a row are null.
+var (
+	tracesTable = signalTable{
+		columns: []column{
+			{"trace_id", typeString},
+			{"span_id", typeString},
+			{"parent_span_id", typeString},
+			{"trace_state", typeString},
+			{"name", typeString},
+			{"kind", typeString},
+			{"start_time", typeTimestamptz},
+			{"end_time", typeTimestamptz},
+			{"duration_ns", typeLong},
+			{"attributes", typeString},
+			{"status_code", typeString},
+			{"status_message", typeString},
+			{"events", typeString},
+			{"links", typeString},
+		},
+		timeColumn: "start_time",
+	}
+
+	logsTable = signalTable{
+		columns: []column{
+			{"time", typeTimestamptz},
+			{"observed_time", typeTimestamptz},
+			{"trace_id", typeString},
+			{"span_id", typeString},
+			{"flags", typeLong},
+			{"severity_number", typeInt},
+			{"severity_text", typeString},
+			{"body", typeString},
+			{"attributes", typeString},
+		},
+		timeColumn: "time",
+	}
+
+	metricsTable = signalTable{
+		columns: []column{
+			{"metric_name", typeString},
+			{"metric_description", typeString},
+			{"metric_unit", typeString},
+			{"metric_type", typeString},
+			{"aggregation_temporality", typeString},
+			{"is_monotonic", typeBoolean},
+			{"start_time", typeTimestamptz},
+			{"time", typeTimestamptz},
+			{"attributes", typeString},
+			{"flags", typeLong},
+			{"int_value", typeLong},
+			{"double_value", typeDouble},
+			{"count", typeLong},
+			{"sum", typeDouble},
+			{"min", typeDouble},
+			{"max", typeDouble},
+			{"bucket_counts", typeString},
+			{"explicit_bounds", typeString},
+			{"scale", typeInt},
+			{"zero_count", typeLong},
+			{"positive_offset", typeInt},
+			{"positive_bucket_counts", typeString},
+			{"negative_offset", typeInt},
+			{"negative_bucket_counts", typeString},
+			{"quantiles", typeString},
+		},
+		timeColumn: "time",
+	}
+)
+
+// tableColumns returns all the columns of the table, the resource and scope columns
+// being prepended to the signal columns.
+func (t signalTable) tableColumns(resourceAttributes []string) []column {
+	columns := []column{{columnResourceAttributes, typeString}}
+	for _, attr := range resourceAttributes {
+		columns = append(columns, column{resourceColumn(attr), typeString})
+	}
+	columns = append(columns, column{"scope_name", typeString}, column{"scope_version", typeString})
+	return append(columns, t.columns...)
+}
+
+// resourceColumn returns the name of the column a resource attribute is written to.
+func resourceColumn(attr string) string {
+	return "resource_" + sanitizeName(attr)
+}
+
+// sanitizeName replaces the characters which aren't letters, digits or underscores,
+// so that the name is a valid column name and Avro field name.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// nestedField is a field of an Iceberg schema. Its type is kept as raw JSON, as the
+// tables can have columns of nested types added by other writers.
+type nestedField struct {
+	ID       int             `json:"id"`
+	Name     string          `json:"name"`
+	Required bool            `json:"required"`
+	Type     json.RawMessage `json:"type"`
+	Doc      string          `json:"doc,omitempty"`
+}
+
+// primitiveType returns the type of the field, or an empty string if it isn't a primitive type.
+func (f *nestedField) primitiveType() string {
+	var typ string
+	if err := json.Unmarshal(f.Type, &typ); err != nil {
+		return ""
+	}
+	return typ
+}
+
+// schema is an Iceberg table schema.
+type schema struct {
+	Type               string         `json:"type"`
+	SchemaID           int            `json:"schema-id"`
+	IdentifierFieldIDs []int          `json:"identifier-field-ids,omitempty"`
+	Fields             []*nestedField `json:"fields"`
+}
+
+func (s *schema) field(name string) *nestedField {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *schema) fieldByID(id int) *nestedField {
+	for _, f := range s.Fields {
+		if f.ID == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// newSchema returns the schema of a new table, the field IDs being assigned from 1.
+func newSchema(columns []column) *schema {
+	s := &schema{Type: "struct"}
+	for i, c := range columns {
+		s.Fields = append(s.Fields, newField(i+1, c))
+	}
+	return s
+}
+
+func newField(id int, c column) *nestedField {
+	typ, _ := json.Marshal(c.typ)
+	return &nestedField{ID: id, Name: c.name, Type: typ}
+}
+
+// reconcileSchema returns the IDs of the columns in the table schema. The columns
+// missing from the table are added to a copy of the schema, which is returned
+// with the last assigned column ID when there are any.
+func reconcileSchema(current *schema, lastColumnID int, columns []column) (map[string]int, *schema, int, error) {
+	ids := make(map[string]int, len(columns))
+	var added []*nestedField
+	for _, c := range columns {
+		f := current.field(c.name)
+		if f == nil {
+			lastColumnID++
+			f = newField(lastColumnID, c)
+			added = append(added, f)
+		} else if typ := f.primitiveType(); typ != c.typ {
+			return nil, nil, 0, fmt.Errorf("column %q has the type %s instead of %s", c.name, f.Type, c.typ)
+		}
+		ids[c.name] = f.ID
+	}
+	if len(added) == 0 {
+		return ids, nil, lastColumnID, nil
+	}
+	evolved := &schema{
+		Type:               "struct",
+		IdentifierFieldIDs: current.IdentifierFieldIDs,
+		Fields:             append(append([]*nestedField{}, current.Fields...), added...),
+	}
+	return ids, evolved, lastColumnID, nil
+}
+
+// arrowSchema returns the schema of the records written to the data files, the Parquet
+// field ID of each column being the ID of the column in the table schema.
+func arrowSchema(columns []column, ids map[string]int) *arrow.Schema {
+	fields := make([]arrow.Field, len(columns))
+	for i, c := range columns {
+		fields[i] = arrow.Field{
+			Name:     c.name,
+			Type:     arrowTypes[c.typ],
+			Nullable: true,
+			Metadata: arrow.NewMetadata([]string{fieldIDKey}, []string{strconv.Itoa(ids[c.name])}),
+		}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+var arrowTypes = map[string]arrow.DataType{
+	typeString:      arrow.BinaryTypes.String,
+	typeLong:        arrow.PrimitiveTypes.Int64,
+	typeInt:         arrow.PrimitiveTypes.Int32,
+	typeDouble:      arrow.PrimitiveTypes.Float64,
+	typeBoolean:     arrow.FixedWidthTypes.Boolean,
+	typeTimestamptz: &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"},
+}
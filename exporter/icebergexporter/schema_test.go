@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileSchema(t *testing.T) {
+	columns := logsTable.tableColumns(nil)
+	current := newSchema(columns)
+	// a column added by another writer
+	current.Fields = append(current.Fields, &nestedField{ID: 100, Name: "tags", Type: json.RawMessage(`{"type":"list","element-id":101,"element":"string","element-required":false}`)})
+
+	ids, evolved, lastColumnID, err := reconcileSchema(current, 101, columns)
+	require.NoError(t, err)
+	assert.Nil(t, evolved)
+	assert.Equal(t, 101, lastColumnID)
+	assert.Equal(t, 1, ids[columnResourceAttributes])
+
+	columns = logsTable.tableColumns([]string{"service.name"})
+	ids, evolved, lastColumnID, err = reconcileSchema(current, 101, columns)
+	require.NoError(t, err)
+	require.NotNil(t, evolved)
+	assert.Equal(t, 102, lastColumnID)
+	assert.Equal(t, 102, ids["resource_service_name"])
+	assert.Len(t, evolved.Fields, len(current.Fields)+1)
+	assert.Equal(t, "tags", evolved.fieldByID(100).Name)
+
+	arrow := arrowSchema(columns, ids)
+	field, ok := arrow.FieldsByName("resource_service_name")
+	require.True(t, ok)
+	id, ok := field[0].Metadata.GetValue(fieldIDKey)
+	require.True(t, ok)
+	assert.Equal(t, "102", id)
+}
+
+func TestReconcileSchemaTypeMismatch(t *testing.T) {
+	columns := logsTable.tableColumns(nil)
+	current := newSchema(columns)
+	current.field("severity_number").Type = json.RawMessage(`"string"`)
+
+	_, _, _, err := reconcileSchema(current, len(columns), columns)
+	assert.EqualError(t, err, `column "severity_number" has the type "string" instead of int`)
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// objectStore reads and writes the files of the tables, identified by their location.
+type objectStore interface {
+	put(ctx context.Context, location string, data []byte) error
+	get(ctx context.Context, location string) ([]byte, error)
+}
+
+// newAWSSession returns the session of the S3 and Glue clients.
+func newAWSSession(cfg StorageConfig) (client.ConfigProvider, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cfg.RoleArn != "" {
+		sess.Config.Credentials = stscreds.NewCredentials(sess, cfg.RoleArn)
+	}
+	return sess, nil
+}
+
+// locationStore dispatches the operations on the scheme of the location: the local
+// files for file locations, and the S3 client for the object storage locations.
+type locationStore struct {
+	s3 s3iface.S3API
+}
+
+func newLocationStore(sess client.ConfigProvider, cfg StorageConfig) *locationStore {
+	s3Config := aws.NewConfig()
+	if cfg.Endpoint != "" {
+		s3Config = s3Config.WithEndpoint(cfg.Endpoint)
+	}
+	return &locationStore{s3: s3.New(sess, s3Config)}
+}
+
+func (s *locationStore) put(ctx context.Context, location string, data []byte) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "file", "":
+		if err = os.MkdirAll(filepath.Dir(u.Path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(u.Path, data, 0o600)
+	case "s3", "s3a", "s3n", "gs":
+		_, err = s.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(u.Host),
+			Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+			Body:   bytes.NewReader(data),
+		})
+		return err
+	}
+	return fmt.Errorf("unsupported location %q", location)
+}
+
+func (s *locationStore) get(ctx context.Context, location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "file", "":
+		return os.ReadFile(u.Path)
+	case "s3", "s3a", "s3n", "gs":
+		out, err := s.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(u.Host),
+			Key:    aws.String(strings.TrimPrefix(u.Path, "/")),
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer out.Body.Close()
+		return io.ReadAll(out.Body)
+	}
+	return nil, fmt.Errorf("unsupported location %q", location)
+}
@@ -0,0 +1,362 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package icebergexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/icebergexporter"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/parquet"
+	"github.com/apache/arrow/go/v15/parquet/compress"
+	"github.com/apache/arrow/go/v15/parquet/pqarrow"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.uber.org/zap"
+)
+
+var parquetCompressions = map[string]compress.Compression{
+	"none":   compress.Codecs.Uncompressed,
+	"snappy": compress.Codecs.Snappy,
+	"gzip":   compress.Codecs.Gzip,
+	"zstd":   compress.Codecs.Zstd,
+}
+
+// tableWriter appends the telemetry of a signal to its table. Each batch is written as
+// a data file per partition and a manifest, and committed to the catalog as a new
+// snapshot of the table.
+type tableWriter struct {
+	name               string
+	table              signalTable
+	columns            []column
+	resourceAttributes []string
+	partitioning       PartitioningConfig
+	commitRetries      int
+	props              *parquet.WriterProperties
+	logger             *zap.Logger
+
+	catalog catalog
+	store   objectStore
+
+	// mutex serializes the commits of the exporter.
+	mutex sync.Mutex
+	// current is the table loaded from the catalog, nil until the first write.
+	current     *table
+	arrowSchema *arrow.Schema
+	partitioner *partitioner
+}
+
+func newTableWriter(cfg *Config, name string, table signalTable, c catalog, store objectStore, logger *zap.Logger) *tableWriter {
+	return &tableWriter{
+		name:               name,
+		table:              table,
+		columns:            table.tableColumns(cfg.Partitioning.ResourceAttributes),
+		resourceAttributes: cfg.Partitioning.ResourceAttributes,
+		partitioning:       cfg.Partitioning,
+		commitRetries:      cfg.CommitRetries,
+		props: parquet.NewWriterProperties(
+			parquet.WithCompression(parquetCompressions[cfg.Compression]),
+		),
+		logger:  logger,
+		catalog: c,
+		store:   store,
+	}
+}
+
+// write appends the rows added by the append function to the table.
+func (w *tableWriter) write(ctx context.Context, appendRows func(*partitionedRecords)) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if err := w.prepare(ctx); err != nil {
+		return err
+	}
+	records := newPartitionedRecords(w.arrowSchema, w.partitioner, w.resourceAttributes)
+	defer records.release()
+	appendRows(records)
+	if len(records.partitions) == 0 {
+		return nil
+	}
+
+	md := w.current.metadata
+	files := make([]dataFile, 0, len(records.partitions))
+	for _, p := range records.partitions {
+		f, err := w.writeDataFile(ctx, md, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+	}
+	spec := w.partitioner.spec
+	data, err := writeManifest(md.currentSchema(), spec, files)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	manifest := manifestFile{
+		location: fmt.Sprintf("%s/%s-m0.avro", metadataLocation(md), uuid.NewString()),
+		length:   int64(len(data)),
+		specID:   spec.SpecID,
+		files:    files,
+	}
+	if err = w.store.put(ctx, manifest.location, data); err != nil {
+		return fmt.Errorf("failed to write the manifest: %w", err)
+	}
+	return w.commit(ctx, manifest)
+}
+
+func (w *tableWriter) writeDataFile(ctx context.Context, md *tableMetadata, p *partitionRecord) (dataFile, error) {
+	record := p.builder.NewRecord()
+	defer record.Release()
+
+	var buf bytes.Buffer
+	fw, err := pqarrow.NewFileWriter(w.arrowSchema, &buf, w.props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return dataFile{}, consumererror.NewPermanent(err)
+	}
+	if err = fw.Write(record); err != nil {
+		return dataFile{}, consumererror.NewPermanent(err)
+	}
+	if err = fw.Close(); err != nil {
+		return dataFile{}, consumererror.NewPermanent(err)
+	}
+
+	location := dataLocation(md)
+	if path := w.partitioner.path(p.values); path != "" {
+		location += "/" + path
+	}
+	location += "/" + uuid.NewString() + ".parquet"
+	if err = w.store.put(ctx, location, buf.Bytes()); err != nil {
+		return dataFile{}, fmt.Errorf("failed to write the data file: %w", err)
+	}
+	return dataFile{location: location, partition: p.values, recordCount: record.NumRows(), size: int64(buf.Len())}, nil
+}
+
+// prepare loads the table, creating it if it does not exist, and evolves its schema
+// and partition spec to the ones written by the exporter.
+func (w *tableWriter) prepare(ctx context.Context) error {
+	for attempt := 0; ; attempt++ {
+		if w.current == nil {
+			t, err := w.loadOrCreate(ctx)
+			if err != nil {
+				return err
+			}
+			w.current = t
+		}
+		err := w.evolve(ctx)
+		if errors.Is(err, errCommitConflict) && attempt < w.commitRetries {
+			w.current = nil
+			continue
+		}
+		return err
+	}
+}
+
+func (w *tableWriter) loadOrCreate(ctx context.Context) (*table, error) {
+	t, err := w.catalog.loadTable(ctx, w.name)
+	if !errors.Is(err, errNoSuchTable) {
+		return t, w.checkTable(t, err)
+	}
+	s := newSchema(w.columns)
+	ids := make(map[string]int, len(s.Fields))
+	for _, f := range s.Fields {
+		ids[f.Name] = f.ID
+	}
+	spec := &partitionSpec{}
+	for i, f := range partitionFields(w.partitioning, w.table, ids) {
+		f.FieldID = firstPartitionFieldID + i
+		spec.Fields = append(spec.Fields, f)
+	}
+	t, err = w.catalog.createTable(ctx, w.name, s, spec)
+	if errors.Is(err, errTableAlreadyExists) {
+		t, err = w.catalog.loadTable(ctx, w.name)
+		return t, w.checkTable(t, err)
+	}
+	if err == nil {
+		w.logger.Info("Created the Iceberg table", zap.String("table", w.name), zap.String("location", t.metadata.Location))
+	}
+	return t, w.checkTable(t, err)
+}
+
+func (w *tableWriter) checkTable(t *table, err error) error {
+	if err != nil {
+		return fmt.Errorf("failed to load the table %q: %w", w.name, err)
+	}
+	if t.metadata == nil {
+		return fmt.Errorf("the catalog returned no metadata for the table %q", w.name)
+	}
+	if t.metadata.FormatVersion != 2 {
+		return consumererror.NewPermanent(fmt.Errorf("table %q has the unsupported format version %d", w.name, t.metadata.FormatVersion))
+	}
+	return nil
+}
+
+// evolve adds the missing columns to the table schema, and sets the default partition
+// spec to the configured one.
+func (w *tableWriter) evolve(ctx context.Context) error {
+	md := w.current.metadata
+	current := md.currentSchema()
+	if current == nil {
+		return consumererror.NewPermanent(fmt.Errorf("table %q has no current schema", w.name))
+	}
+	ids, evolved, lastColumnID, err := reconcileSchema(current, md.LastColumnID, w.columns)
+	if err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("table %q can't be written: %w", w.name, err))
+	}
+	var updates []tableUpdate
+	if evolved != nil {
+		updates = append(updates, addSchema(evolved, lastColumnID), setCurrentSchema(lastAdded))
+	}
+	specID, spec := reconcileSpec(md, partitionFields(w.partitioning, w.table, ids))
+	switch {
+	case spec != nil:
+		updates = append(updates, addSpec(spec), setDefaultSpec(lastAdded))
+	case specID != md.DefaultSpecID:
+		updates = append(updates, setDefaultSpec(specID))
+	}
+	if len(updates) > 0 {
+		t, err := w.catalog.commitTable(ctx, w.current, assertUnchangedSchemaAndSpec(md), updates)
+		if err != nil {
+			return fmt.Errorf("failed to update the schema of the table %q: %w", w.name, err)
+		}
+		w.logger.Info("Updated the schema of the Iceberg table", zap.String("table", w.name))
+		w.current = t
+		md = t.metadata
+		if ids, _, _, err = reconcileSchema(md.currentSchema(), md.LastColumnID, w.columns); err != nil {
+			return consumererror.NewPermanent(err)
+		}
+	}
+
+	w.arrowSchema = arrowSchema(w.columns, ids)
+	w.partitioner, err = newPartitioner(md.spec(md.DefaultSpecID), md.currentSchema(), w.table, w.resourceAttributes)
+	if err != nil {
+		return consumererror.NewPermanent(fmt.Errorf("table %q can't be written: %w", w.name, err))
+	}
+	return nil
+}
+
+// commit appends a snapshot adding the manifest to the main branch. When the table was
+// modified concurrently, the table is reloaded and the commit retried with the new head
+// of the branch.
+func (w *tableWriter) commit(ctx context.Context, manifest manifestFile) error {
+	for attempt := 0; ; attempt++ {
+		err := w.commitSnapshot(ctx, manifest)
+		if !errors.Is(err, errCommitConflict) || attempt >= w.commitRetries {
+			return err
+		}
+		w.logger.Debug("Retrying the commit of a concurrently modified table", zap.String("table", w.name), zap.Error(err))
+		t, err := w.catalog.loadTable(ctx, w.name)
+		if err = w.checkTable(t, err); err != nil {
+			return err
+		}
+		w.current = t
+	}
+}
+
+func (w *tableWriter) commitSnapshot(ctx context.Context, manifest manifestFile) error {
+	md := w.current.metadata
+	parent := md.branchHead(mainBranch)
+	var entries []map[string]any
+	var parentID *int64
+	if parent != nil {
+		parentID = &parent.SnapshotID
+		data, err := w.store.get(ctx, parent.ManifestList)
+		if err != nil {
+			return fmt.Errorf("failed to read the manifest list %q: %w", parent.ManifestList, err)
+		}
+		if entries, err = readManifestList(data); err != nil {
+			return consumererror.NewPermanent(fmt.Errorf("failed to decode the manifest list %q: %w", parent.ManifestList, err))
+		}
+	}
+
+	snapshotID := newSnapshotID()
+	sequenceNumber := md.LastSequenceNumber + 1
+	entries = append(entries, manifest.manifestListEntry(sequenceNumber, snapshotID))
+	data, err := writeManifestList(entries, snapshotID, parentID, sequenceNumber)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	manifestList := fmt.Sprintf("%s/snap-%d-1-%s.avro", metadataLocation(md), snapshotID, uuid.NewString())
+	if err = w.store.put(ctx, manifestList, data); err != nil {
+		return fmt.Errorf("failed to write the manifest list: %w", err)
+	}
+
+	schemaID := md.CurrentSchemaID
+	s := &snapshot{
+		SnapshotID:       snapshotID,
+		ParentSnapshotID: parentID,
+		SequenceNumber:   sequenceNumber,
+		TimestampMs:      time.Now().UnixMilli(),
+		ManifestList:     manifestList,
+		Summary:          snapshotSummary(parent, manifest),
+		SchemaID:         &schemaID,
+	}
+	t, err := w.catalog.commitTable(ctx, w.current,
+		[]tableRequirement{assertBranchHead(mainBranch, parent)},
+		[]tableUpdate{addSnapshot(s), setBranchHead(mainBranch, snapshotID)})
+	if err != nil {
+		return fmt.Errorf("failed to commit to the table %q: %w", w.name, err)
+	}
+	w.current = t
+	return nil
+}
+
+// snapshotSummary returns the summary of an append snapshot, the totals being computed
+// from the ones of the parent snapshot.
+func snapshotSummary(parent *snapshot, manifest manifestFile) map[string]string {
+	var records, size int64
+	for _, f := range manifest.files {
+		records += f.recordCount
+		size += f.size
+	}
+	summary := map[string]string{
+		"operation":               "append",
+		"added-data-files":        strconv.Itoa(len(manifest.files)),
+		"added-records":           strconv.FormatInt(records, 10),
+		"added-files-size":        strconv.FormatInt(size, 10),
+		"changed-partition-count": strconv.Itoa(len(manifest.files)),
+	}
+	totals := map[string]int64{
+		"total-data-files": int64(len(manifest.files)),
+		"total-records":    records,
+		"total-files-size": size,
+	}
+	for name, added := range totals {
+		total := added
+		if parent != nil {
+			v, ok := parent.Summary[name]
+			if !ok {
+				continue
+			}
+			previous, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				continue
+			}
+			total += previous
+		}
+		summary[name] = strconv.FormatInt(total, 10)
+	}
+	return summary
+}
+
+// dataLocation returns the location of the data files of the table.
+func dataLocation(md *tableMetadata) string {
+	if path, ok := md.Properties["write.data.path"]; ok {
+		return strings.TrimSuffix(path, "/")
+	}
+	return strings.TrimSuffix(md.Location, "/") + "/data"
+}
+
+// metadataLocation returns the location of the metadata files of the table.
+func metadataLocation(md *tableMetadata) string {
+	if path, ok := md.Properties["write.metadata.path"]; ok {
+		return strings.TrimSuffix(path, "/")
+	}
+	return strings.TrimSuffix(md.Location, "/") + "/metadata"
+}
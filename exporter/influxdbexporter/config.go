@@ -4,6 +4,7 @@
 package influxdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter"
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -26,6 +27,30 @@ type V1Compatibility struct {
 	Password configopaque.String `mapstructure:"password"`
 }
 
+// V3 is used to specify if the exporter should use the InfluxDB 3 write API.
+type V3 struct {
+	// Enabled is used to specify if the exporter should use the InfluxDB 3 write API
+	Enabled bool `mapstructure:"enabled"`
+	// Database is the name of the InfluxDB 3 database that telemetry will be written to.
+	Database string `mapstructure:"database"`
+	// AcceptPartial is used to write the valid lines of a request which also holds invalid lines.
+	AcceptPartial bool `mapstructure:"accept_partial"`
+	// NoSync is used to acknowledge the writes before they are persisted to the write-ahead log.
+	NoSync bool `mapstructure:"no_sync"`
+	// Tables are the names of the tables that traces and logs are written to.
+	Tables V3Tables `mapstructure:"tables"`
+}
+
+// V3Tables are the names of the InfluxDB 3 tables that traces and logs are written to.
+type V3Tables struct {
+	// Spans is the name of the table that spans are written to.
+	Spans string `mapstructure:"spans"`
+	// SpanLinks is the name of the table that span links are written to.
+	SpanLinks string `mapstructure:"span_links"`
+	// Logs is the name of the table that log records are written to.
+	Logs string `mapstructure:"logs"`
+}
+
 // Config defines configuration for the InfluxDB exporter.
 type Config struct {
 	confighttp.ClientConfig      `mapstructure:",squash"`
@@ -40,6 +65,8 @@ type Config struct {
 	Token configopaque.String `mapstructure:"token"`
 	// V1Compatibility is used to specify if the exporter should use the v1.X InfluxDB API schema.
 	V1Compatibility V1Compatibility `mapstructure:"v1_compatibility"`
+	// V3 is used to specify if the exporter should use the InfluxDB 3 write API.
+	V3 V3 `mapstructure:"v3"`
 
 	// SpanDimensions are span attributes to be used as line protocol tags.
 	// These are always included as tags:
@@ -77,6 +104,18 @@ type Config struct {
 }
 
 func (cfg *Config) Validate() error {
+	if cfg.V3.Enabled {
+		if cfg.V1Compatibility.Enabled {
+			return errors.New("v1_compatibility and v3 cannot be enabled at the same time")
+		}
+		if cfg.V3.Database == "" {
+			return errors.New("v3::database must be specified")
+		}
+		if cfg.V3.Tables.Spans == "" || cfg.V3.Tables.SpanLinks == "" || cfg.V3.Tables.Logs == "" {
+			return errors.New("v3::tables must not be empty")
+		}
+	}
+
 	spanDimensions := make(map[string]struct{}, len(cfg.SpanDimensions))
 	duplicateSpanDimensions := make(map[string]struct{})
 	for _, k := range cfg.SpanDimensions {
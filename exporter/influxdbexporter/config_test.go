@@ -56,9 +56,17 @@ func TestLoadConfig(t *testing.T) {
 					RandomizationFactor: backoff.DefaultRandomizationFactor,
 					Multiplier:          backoff.DefaultMultiplier,
 				},
-				Org:                 "my-org",
-				Bucket:              "my-bucket",
-				Token:               "my-token",
+				Org:    "my-org",
+				Bucket: "my-bucket",
+				Token:  "my-token",
+				V3: V3{
+					AcceptPartial: true,
+					Tables: V3Tables{
+						Spans:     "spans",
+						SpanLinks: "span_links",
+						Logs:      "logs",
+					},
+				},
 				SpanDimensions:      []string{"service.name", "span.name"},
 				LogRecordDimensions: []string{"service.name"},
 				MetricsSchema:       "telegraf-prometheus-v1",
@@ -66,6 +74,26 @@ func TestLoadConfig(t *testing.T) {
 				PayloadMaxBytes:     27,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v3-config"),
+			expected: func() component.Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.Endpoint = "http://localhost:8181"
+				cfg.Token = "my-token"
+				cfg.V3 = V3{
+					Enabled:       true,
+					Database:      "my-database",
+					AcceptPartial: false,
+					NoSync:        true,
+					Tables: V3Tables{
+						Spans:     "otel_spans",
+						SpanLinks: "otel_span_links",
+						Logs:      "otel_logs",
+					},
+				}
+				return cfg
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -82,3 +110,41 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateV3Config(t *testing.T) {
+	for _, testCase := range []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name: "v1 compatibility",
+			modify: func(cfg *Config) {
+				cfg.V1Compatibility.Enabled = true
+			},
+			expectedErr: "v1_compatibility and v3 cannot be enabled at the same time",
+		},
+		{
+			name: "missing database",
+			modify: func(cfg *Config) {
+				cfg.V3.Database = ""
+			},
+			expectedErr: "v3::database must be specified",
+		},
+		{
+			name: "empty table",
+			modify: func(cfg *Config) {
+				cfg.V3.Tables.SpanLinks = ""
+			},
+			expectedErr: "v3::tables must not be empty",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.V3.Enabled = true
+			cfg.V3.Database = "my-database"
+			testCase.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), testCase.expectedErr)
+		})
+	}
+}
@@ -22,6 +22,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/influxdbexporter/internal/metadata"
 )
 
+// defaultV3SpanLinksTable replaces the hyphen of the span links measurement name,
+// which would have to be quoted in the SQL queries of InfluxDB 3.
+const defaultV3SpanLinksTable = "span_links"
+
 // NewFactory creates a factory for InfluxDB exporter.
 func NewFactory() exporter.Factory {
 	return exporter.NewFactory(
@@ -41,8 +45,16 @@ func createDefaultConfig() component.Config {
 				"User-Agent": "OpenTelemetry -> Influx",
 			},
 		},
-		QueueSettings:       exporterhelper.NewDefaultQueueSettings(),
-		BackOffConfig:       configretry.NewDefaultBackOffConfig(),
+		QueueSettings: exporterhelper.NewDefaultQueueSettings(),
+		BackOffConfig: configretry.NewDefaultBackOffConfig(),
+		V3: V3{
+			AcceptPartial: true,
+			Tables: V3Tables{
+				Spans:     common.MeasurementSpans,
+				SpanLinks: defaultV3SpanLinksTable,
+				Logs:      common.MeasurementLogs,
+			},
+		},
 		MetricsSchema:       common.MetricsSchemaTelegrafPrometheusV1.String(),
 		SpanDimensions:      otel2influx.DefaultOtelTracesToLineProtocolConfig().SpanDimensions,
 		LogRecordDimensions: otel2influx.DefaultOtelLogsToLineProtocolConfig().LogRecordDimensions,
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -35,6 +36,8 @@ type influxHTTPWriter struct {
 	writeURL           string
 	payloadMaxLines    int
 	payloadMaxBytes    int
+	// measurements maps the measurement names of the traces and logs to the InfluxDB 3 tables.
+	measurements map[string]string
 
 	logger common.Logger
 }
@@ -45,6 +48,15 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, telemetrySettings
 		return nil, err
 	}
 
+	var measurements map[string]string
+	if config.V3.Enabled {
+		measurements = map[string]string{
+			common.MeasurementSpans:     config.V3.Tables.Spans,
+			common.MeasurementSpanLinks: config.V3.Tables.SpanLinks,
+			common.MeasurementLogs:      config.V3.Tables.Logs,
+		}
+	}
+
 	return &influxHTTPWriter{
 		encoderPool: sync.Pool{
 			New: func() any {
@@ -59,6 +71,7 @@ func newInfluxHTTPWriter(logger common.Logger, config *Config, telemetrySettings
 		writeURL:           writeURL,
 		payloadMaxLines:    config.PayloadMaxLines,
 		payloadMaxBytes:    config.PayloadMaxBytes,
+		measurements:       measurements,
 		logger:             logger,
 	}, nil
 }
@@ -69,12 +82,18 @@ func composeWriteURL(config *Config) (string, error) {
 		return "", err
 	}
 	if writeURL.Path == "" || writeURL.Path == "/" {
-		if config.V1Compatibility.Enabled {
+		switch {
+		case config.V3.Enabled:
+			writeURL, err = writeURL.Parse("api/v3/write_lp")
+			if err != nil {
+				return "", err
+			}
+		case config.V1Compatibility.Enabled:
 			writeURL, err = writeURL.Parse("write")
 			if err != nil {
 				return "", err
 			}
-		} else {
+		default:
 			writeURL, err = writeURL.Parse("api/v2/write")
 			if err != nil {
 				return "", err
@@ -82,8 +101,25 @@ func composeWriteURL(config *Config) (string, error) {
 		}
 	}
 	queryValues := writeURL.Query()
-	queryValues.Set("precision", "ns")
 
+	if config.V3.Enabled {
+		queryValues.Set("precision", "nanosecond")
+		queryValues.Set("db", config.V3.Database)
+		queryValues.Set("accept_partial", strconv.FormatBool(config.V3.AcceptPartial))
+		queryValues.Set("no_sync", strconv.FormatBool(config.V3.NoSync))
+
+		if config.Token != "" {
+			if config.ClientConfig.Headers == nil {
+				config.ClientConfig.Headers = make(map[string]configopaque.String, 1)
+			}
+			config.ClientConfig.Headers["Authorization"] = "Bearer " + config.Token
+		}
+
+		writeURL.RawQuery = queryValues.Encode()
+		return writeURL.String(), nil
+	}
+
+	queryValues.Set("precision", "ns")
 	if config.V1Compatibility.Enabled {
 		queryValues.Set("db", config.V1Compatibility.DB)
 
@@ -148,6 +184,10 @@ func (b *influxHTTPWriterBatch) EnqueuePoint(ctx context.Context, measurement st
 		b.encoder = b.encoderPool.Get().(*lineprotocol.Encoder)
 	}
 
+	if table, found := b.measurements[measurement]; found {
+		measurement = table
+	}
+
 	b.encoder.StartLine(measurement)
 	for _, tag := range b.optimizeTags(tags) {
 		b.encoder.AddTag(tag.k, tag.v)
@@ -189,7 +229,13 @@ func (b *influxHTTPWriterBatch) WriteBatch(ctx context.Context) error {
 		b.payloadLines = 0
 	}()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.writeURL, bytes.NewReader(b.encoder.Bytes()))
+	return b.write(ctx, b.encoder.Bytes())
+}
+
+// write sends the line protocol payload to InfluxDB. A payload exceeding the maximum
+// request size of the server is split in two halves, which are sent separately.
+func (b *influxHTTPWriterBatch) write(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.writeURL, bytes.NewReader(payload))
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -205,6 +251,15 @@ func (b *influxHTTPWriterBatch) WriteBatch(ctx context.Context) error {
 	if err = res.Body.Close(); err != nil {
 		return err
 	}
+	if res.StatusCode == http.StatusRequestEntityTooLarge {
+		if first, second, ok := splitPayload(payload); ok {
+			b.logger.Debug("payload too large, splitting it", "bytes", len(payload))
+			if err = b.write(ctx, first); err != nil {
+				return err
+			}
+			return b.write(ctx, second)
+		}
+	}
 	switch res.StatusCode / 100 {
 	case 2: // Success
 		break
@@ -217,6 +272,19 @@ func (b *influxHTTPWriterBatch) WriteBatch(ctx context.Context) error {
 	return nil
 }
 
+// splitPayload splits the line protocol payload in two halves at the line boundary
+// closest to its middle, and returns false if it holds a single line.
+func splitPayload(payload []byte) ([]byte, []byte, bool) {
+	middle := len(payload) / 2
+	if i := bytes.IndexByte(payload[middle:], '\n'); i >= 0 && middle+i+1 < len(payload) {
+		return payload[:middle+i+1], payload[middle+i+1:], true
+	}
+	if i := bytes.LastIndexByte(payload[:middle], '\n'); i >= 0 {
+		return payload[:i+1], payload[i+1:], true
+	}
+	return nil, nil, false
+}
+
 type tag struct {
 	k, v string
 }
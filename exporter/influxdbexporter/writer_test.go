@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 )
 
 func Test_influxHTTPWriterBatch_optimizeTags(t *testing.T) {
@@ -207,3 +209,105 @@ func Test_composeWriteURL_doesNotPanic(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_composeWriteURL_v3(t *testing.T) {
+	cfg := &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: "http://localhost:8181",
+		},
+		Token: "my-token",
+		V3: V3{
+			Enabled:       true,
+			Database:      "my-database",
+			AcceptPartial: true,
+		},
+	}
+	writeURL, err := composeWriteURL(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8181/api/v3/write_lp?accept_partial=true&db=my-database&no_sync=false&precision=nanosecond", writeURL)
+	assert.Equal(t, configopaque.String("Bearer my-token"), cfg.ClientConfig.Headers["Authorization"])
+}
+
+func Test_influxHTTPWriterBatch_EnqueuePoint_v3Tables(t *testing.T) {
+	var recordedRequestBody []byte
+	noopHTTPServer := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		recordedRequestBody, _ = io.ReadAll(r.Body)
+	}))
+	t.Cleanup(noopHTTPServer.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = noopHTTPServer.URL
+	cfg.V3.Enabled = true
+	cfg.V3.Database = "my-database"
+	influxWriter, err := newInfluxHTTPWriter(new(common.NoopLogger), cfg, component.TelemetrySettings{})
+	require.NoError(t, err)
+	influxWriter.httpClient = noopHTTPServer.Client()
+	influxWriterBatch := influxWriter.NewBatch()
+
+	for _, measurement := range []string{common.MeasurementSpans, common.MeasurementSpanLinks, "cpu"} {
+		err = influxWriterBatch.EnqueuePoint(context.Background(), measurement, map[string]string{"k": "v"}, map[string]any{"f": int64(1)}, time.Unix(1, 0), common.InfluxMetricValueTypeUntyped)
+		require.NoError(t, err)
+	}
+	require.NoError(t, influxWriterBatch.WriteBatch(context.Background()))
+
+	assert.Equal(t, "spans,k=v f=1i 1000000000\nspan_links,k=v f=1i 1000000000\ncpu,k=v f=1i 1000000000\n", string(recordedRequestBody))
+}
+
+func Test_influxHTTPWriterBatch_WriteBatch_splitsTooLargePayload(t *testing.T) {
+	var recordedBodies []string
+	mockHTTPService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Count(string(body), "\n") > 1 {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		recordedBodies = append(recordedBodies, string(body))
+	}))
+	t.Cleanup(mockHTTPService.Close)
+
+	influxWriter, err := newInfluxHTTPWriter(new(common.NoopLogger), &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: mockHTTPService.URL,
+		},
+		PayloadMaxLines: 10_000,
+		PayloadMaxBytes: 10_000_000,
+	}, component.TelemetrySettings{})
+	require.NoError(t, err)
+	influxWriter.httpClient = mockHTTPService.Client()
+	batch := influxWriter.NewBatch()
+
+	for i := 1; i <= 3; i++ {
+		err = batch.EnqueuePoint(context.Background(), "m", map[string]string{"k": "v"}, map[string]any{"f": int64(i)}, time.Unix(1, 0), common.InfluxMetricValueTypeUntyped)
+		require.NoError(t, err)
+	}
+	require.NoError(t, batch.WriteBatch(context.Background()))
+
+	assert.Equal(t, []string{
+		"m,k=v f=1i 1000000000\n",
+		"m,k=v f=2i 1000000000\n",
+		"m,k=v f=3i 1000000000\n",
+	}, recordedBodies)
+}
+
+func Test_influxHTTPWriterBatch_WriteBatch_singleLineTooLarge(t *testing.T) {
+	mockHTTPService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	}))
+	t.Cleanup(mockHTTPService.Close)
+
+	influxWriter, err := newInfluxHTTPWriter(new(common.NoopLogger), &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: mockHTTPService.URL,
+		},
+		PayloadMaxLines: 10_000,
+		PayloadMaxBytes: 10_000_000,
+	}, component.TelemetrySettings{})
+	require.NoError(t, err)
+	influxWriter.httpClient = mockHTTPService.Client()
+	batch := influxWriter.NewBatch()
+
+	err = batch.EnqueuePoint(context.Background(), "m", nil, map[string]any{"f": int64(1)}, time.Unix(1, 0), common.InfluxMetricValueTypeUntyped)
+	require.NoError(t, err)
+	err = batch.WriteBatch(context.Background())
+	assert.True(t, consumererror.IsPermanent(err))
+}
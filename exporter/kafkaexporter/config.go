@@ -4,6 +4,7 @@
 package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
 
 import (
+	"compress/gzip"
 	"fmt"
 	"time"
 
@@ -83,6 +84,10 @@ type Producer struct {
 	// Maximum message bytes the producer will accept to produce.
 	MaxMessageBytes int `mapstructure:"max_message_bytes"`
 
+	// SplitOversizedMessages splits the batches encoded into messages larger than MaxMessageBytes
+	// across several messages, instead of failing to produce the whole batch.
+	SplitOversizedMessages bool `mapstructure:"split_oversized_messages"`
+
 	// RequiredAcks Number of acknowledgements required to assume that a message has been sent.
 	// https://pkg.go.dev/github.com/IBM/sarama@v1.30.0#RequiredAcks
 	// The options are:
@@ -96,12 +101,53 @@ type Producer struct {
 	// The options are: 'none', 'gzip', 'snappy', 'lz4', and 'zstd'
 	Compression string `mapstructure:"compression"`
 
+	// CompressionLevel is the level used by the compression codec. 0 uses the
+	// default level of the codec. It has no effect with 'none' and 'snappy'.
+	CompressionLevel int `mapstructure:"compression_level"`
+
+	// Traces, Metrics and Logs override the compression settings for a single signal.
+	Traces  SignalProducer `mapstructure:"traces"`
+	Metrics SignalProducer `mapstructure:"metrics"`
+	Logs    SignalProducer `mapstructure:"logs"`
+
 	// The maximum number of messages the producer will send in a single
 	// broker request. Defaults to 0 for unlimited. Similar to
 	// `queue.buffering.max.messages` in the JVM producer.
 	FlushMaxMessages int `mapstructure:"flush_max_messages"`
 }
 
+// SignalProducer defines producer configuration overrides for a single signal.
+type SignalProducer struct {
+	// Compression overrides producer.compression. Empty uses producer.compression.
+	Compression string `mapstructure:"compression"`
+
+	// CompressionLevel overrides producer.compression_level. Unset uses producer.compression_level,
+	// 0 uses the default level of the codec.
+	CompressionLevel *int `mapstructure:"compression_level"`
+}
+
+// compression returns the compression codec and level used for the given signal.
+func (p Producer) compression(signal component.DataType) (string, int) {
+	var override SignalProducer
+	switch signal {
+	case component.DataTypeTraces:
+		override = p.Traces
+	case component.DataTypeMetrics:
+		override = p.Metrics
+	case component.DataTypeLogs:
+		override = p.Logs
+	}
+
+	codec, level := p.Compression, p.CompressionLevel
+	if override.Compression != "" {
+		codec = override.Compression
+	}
+	if override.CompressionLevel != nil {
+		level = *override.CompressionLevel
+	}
+	return codec, level
+}
+
 // MetadataRetry defines retry configuration for Metadata.
 type MetadataRetry struct {
 	// The total number of times to retry a metadata request when the
@@ -120,11 +166,25 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("producer.required_acks has to be between -1 and 1. configured value %v", cfg.Producer.RequiredAcks)
 	}
 
+	if cfg.Producer.SplitOversizedMessages && cfg.Producer.MaxMessageBytes <= messageOverhead+splitHeadersOverhead {
+		return fmt.Errorf("producer.max_message_bytes has to be greater than %d to split oversized messages. configured value %v", messageOverhead+splitHeadersOverhead, cfg.Producer.MaxMessageBytes)
+	}
+
 	_, err := saramaProducerCompressionCodec(cfg.Producer.Compression)
 	if err != nil {
 		return err
 	}
 
+	for _, signal := range []component.DataType{component.DataTypeTraces, component.DataTypeMetrics, component.DataTypeLogs} {
+		codec, level := cfg.Producer.compression(signal)
+		if _, err = saramaProducerCompressionCodec(codec); err != nil {
+			return fmt.Errorf("%s: %w", signal, err)
+		}
+		if codec == "gzip" && (level < gzip.HuffmanOnly || level > gzip.BestCompression) {
+			return fmt.Errorf("%s: gzip compression level has to be between %d and %d. configured value %v", signal, gzip.HuffmanOnly, gzip.BestCompression, level)
+		}
+	}
+
 	return validateSASLConfig(cfg.Authentication.SASL)
 }
 
@@ -155,6 +215,14 @@ func validateSASLConfig(c *kafka.SASLConfig) error {
 	return nil
 }
 
+// saramaProducerCompressionLevel maps a configured compression level to a sarama compression level.
+func saramaProducerCompressionLevel(level int) int {
+	if level == 0 {
+		return sarama.CompressionLevelDefault
+	}
+	return level
+}
+
 func saramaProducerCompressionCodec(compression string) (sarama.CompressionCodec, error) {
 	switch compression {
 	case "none":
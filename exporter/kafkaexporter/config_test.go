@@ -78,6 +78,10 @@ func TestLoadConfig(t *testing.T) {
 					MaxMessageBytes: 10000000,
 					RequiredAcks:    sarama.WaitForAll,
 					Compression:     "none",
+					Logs: SignalProducer{
+						Compression:      "zstd",
+						CompressionLevel: ptr(3),
+					},
 				},
 			},
 		},
@@ -139,6 +143,10 @@ func TestLoadConfig(t *testing.T) {
 					MaxMessageBytes: 10000000,
 					RequiredAcks:    sarama.WaitForAll,
 					Compression:     "none",
+					Logs: SignalProducer{
+						Compression:      "zstd",
+						CompressionLevel: ptr(3),
+					},
 				},
 			},
 		},
@@ -188,6 +196,10 @@ func TestLoadConfig(t *testing.T) {
 					MaxMessageBytes: 10000000,
 					RequiredAcks:    sarama.WaitForAll,
 					Compression:     "none",
+					Logs: SignalProducer{
+						Compression:      "zstd",
+						CompressionLevel: ptr(3),
+					},
 				},
 			},
 		},
@@ -218,6 +230,85 @@ func TestValidate_err_compression(t *testing.T) {
 	assert.EqualError(t, err, "producer.compression should be one of 'none', 'gzip', 'snappy', 'lz4', or 'zstd'. configured value idk")
 }
 
+func TestValidate_err_signal_compression(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression: "none",
+			Metrics: SignalProducer{
+				Compression: "idk",
+			},
+		},
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, "metrics: producer.compression should be one of 'none', 'gzip', 'snappy', 'lz4', or 'zstd'. configured value idk")
+}
+
+func TestValidate_err_gzip_compression_level(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression:      "none",
+			CompressionLevel: 12,
+			Traces: SignalProducer{
+				Compression: "gzip",
+			},
+		},
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, "traces: gzip compression level has to be between -2 and 9. configured value 12")
+}
+
+func TestValidate_err_split_max_message_bytes(t *testing.T) {
+	config := &Config{
+		Producer: Producer{
+			Compression:            "none",
+			MaxMessageBytes:        64,
+			SplitOversizedMessages: true,
+		},
+	}
+
+	err := config.Validate()
+	assert.EqualError(t, err, "producer.max_message_bytes has to be greater than 108 to split oversized messages. configured value 64")
+}
+
+func TestProducerCompression(t *testing.T) {
+	producer := Producer{
+		Compression:      "gzip",
+		CompressionLevel: 6,
+		Traces: SignalProducer{
+			Compression: "zstd",
+		},
+		Logs: SignalProducer{
+			Compression:      "lz4",
+			CompressionLevel: ptr(9),
+		},
+		Metrics: SignalProducer{
+			CompressionLevel: ptr(0),
+		},
+	}
+
+	tests := map[component.DataType]struct {
+		codec string
+		level int
+	}{
+		component.DataTypeTraces: {codec: "zstd", level: 6},
+		component.DataTypeLogs:   {codec: "lz4", level: 9},
+		// The metrics override the level back to the default level of the codec.
+		component.DataTypeMetrics: {codec: "gzip", level: 0},
+	}
+	for signal, want := range tests {
+		t.Run(signal.String(), func(t *testing.T) {
+			codec, level := producer.compression(signal)
+			assert.Equal(t, want.codec, codec)
+			assert.Equal(t, want.level, level)
+		})
+	}
+
+	assert.Equal(t, sarama.CompressionLevelDefault, saramaProducerCompressionLevel(0))
+	assert.Equal(t, 3, saramaProducerCompressionLevel(3))
+}
+
 func TestValidate_sasl_username(t *testing.T) {
 	config := &Config{
 		Producer: Producer{
@@ -41,7 +41,24 @@ func (ke kafkaErrors) Error() string {
 }
 
 func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, getTopic(&e.cfg, td.ResourceSpans()))
+	topic := getTopic(&e.cfg, td.ResourceSpans())
+	marshal := func(td ptrace.Traces) ([]*sarama.ProducerMessage, error) {
+		return e.marshaler.Marshal(td, topic)
+	}
+
+	var messages []*sarama.ProducerMessage
+	var dropped int
+	var err error
+	if e.cfg.Producer.SplitOversizedMessages {
+		messages, dropped, err = splitMarshaler[ptrace.Traces]{
+			maxBytes: e.cfg.Producer.MaxMessageBytes,
+			marshal:  marshal,
+			count:    ptrace.Traces.SpanCount,
+			split:    splitTraces,
+		}.Marshal(td)
+	} else {
+		messages, err = marshal(td)
+	}
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -55,6 +72,9 @@ func (e *kafkaTracesProducer) tracesPusher(_ context.Context, td ptrace.Traces)
 		}
 		return err
 	}
+	if dropped > 0 {
+		return consumererror.NewPermanent(errOversizedItems(dropped, e.cfg.Producer.MaxMessageBytes))
+	}
 	return nil
 }
 
@@ -66,7 +86,7 @@ func (e *kafkaTracesProducer) Close(context.Context) error {
 }
 
 func (e *kafkaTracesProducer) start(_ context.Context, _ component.Host) error {
-	producer, err := newSaramaProducer(e.cfg)
+	producer, err := newSaramaProducer(e.cfg, component.DataTypeTraces)
 	if err != nil {
 		return err
 	}
@@ -83,7 +103,24 @@ type kafkaMetricsProducer struct {
 }
 
 func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, getTopic(&e.cfg, md.ResourceMetrics()))
+	topic := getTopic(&e.cfg, md.ResourceMetrics())
+	marshal := func(md pmetric.Metrics) ([]*sarama.ProducerMessage, error) {
+		return e.marshaler.Marshal(md, topic)
+	}
+
+	var messages []*sarama.ProducerMessage
+	var dropped int
+	var err error
+	if e.cfg.Producer.SplitOversizedMessages {
+		messages, dropped, err = splitMarshaler[pmetric.Metrics]{
+			maxBytes: e.cfg.Producer.MaxMessageBytes,
+			marshal:  marshal,
+			count:    pmetric.Metrics.DataPointCount,
+			split:    splitMetrics,
+		}.Marshal(md)
+	} else {
+		messages, err = marshal(md)
+	}
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -97,6 +134,9 @@ func (e *kafkaMetricsProducer) metricsDataPusher(_ context.Context, md pmetric.M
 		}
 		return err
 	}
+	if dropped > 0 {
+		return consumererror.NewPermanent(errOversizedItems(dropped, e.cfg.Producer.MaxMessageBytes))
+	}
 	return nil
 }
 
@@ -108,7 +148,7 @@ func (e *kafkaMetricsProducer) Close(context.Context) error {
 }
 
 func (e *kafkaMetricsProducer) start(_ context.Context, _ component.Host) error {
-	producer, err := newSaramaProducer(e.cfg)
+	producer, err := newSaramaProducer(e.cfg, component.DataTypeMetrics)
 	if err != nil {
 		return err
 	}
@@ -125,7 +165,24 @@ type kafkaLogsProducer struct {
 }
 
 func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, getTopic(&e.cfg, ld.ResourceLogs()))
+	topic := getTopic(&e.cfg, ld.ResourceLogs())
+	marshal := func(ld plog.Logs) ([]*sarama.ProducerMessage, error) {
+		return e.marshaler.Marshal(ld, topic)
+	}
+
+	var messages []*sarama.ProducerMessage
+	var dropped int
+	var err error
+	if e.cfg.Producer.SplitOversizedMessages {
+		messages, dropped, err = splitMarshaler[plog.Logs]{
+			maxBytes: e.cfg.Producer.MaxMessageBytes,
+			marshal:  marshal,
+			count:    plog.Logs.LogRecordCount,
+			split:    splitLogs,
+		}.Marshal(ld)
+	} else {
+		messages, err = marshal(ld)
+	}
 	if err != nil {
 		return consumererror.NewPermanent(err)
 	}
@@ -139,6 +196,9 @@ func (e *kafkaLogsProducer) logsDataPusher(_ context.Context, ld plog.Logs) erro
 		}
 		return err
 	}
+	if dropped > 0 {
+		return consumererror.NewPermanent(errOversizedItems(dropped, e.cfg.Producer.MaxMessageBytes))
+	}
 	return nil
 }
 
@@ -150,7 +210,7 @@ func (e *kafkaLogsProducer) Close(context.Context) error {
 }
 
 func (e *kafkaLogsProducer) start(_ context.Context, _ component.Host) error {
-	producer, err := newSaramaProducer(e.cfg)
+	producer, err := newSaramaProducer(e.cfg, component.DataTypeLogs)
 	if err != nil {
 		return err
 	}
@@ -158,7 +218,7 @@ func (e *kafkaLogsProducer) start(_ context.Context, _ component.Host) error {
 	return nil
 }
 
-func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
+func newSaramaProducer(config Config, signal component.DataType) (sarama.SyncProducer, error) {
 	c := sarama.NewConfig()
 
 	c.ClientID = config.ClientID
@@ -191,11 +251,13 @@ func newSaramaProducer(config Config) (sarama.SyncProducer, error) {
 		return nil, err
 	}
 
-	compression, err := saramaProducerCompressionCodec(config.Producer.Compression)
+	codec, level := config.Producer.compression(signal)
+	compression, err := saramaProducerCompressionCodec(codec)
 	if err != nil {
 		return nil, err
 	}
 	c.Producer.Compression = compression
+	c.Producer.CompressionLevel = saramaProducerCompressionLevel(level)
 
 	producer, err := sarama.NewSyncProducer(config.Brokers, c)
 	if err != nil {
@@ -6,6 +6,7 @@ package kafkaexporter
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/IBM/sarama"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exportertest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -187,6 +189,62 @@ func TestTracesPusher_err(t *testing.T) {
 	assert.EqualError(t, err, expErr.Error())
 }
 
+func TestTracesPusher_split(t *testing.T) {
+	half, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(testdata.GenerateTraces(2))
+	require.NoError(t, err)
+
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	for i := 0; i < 2; i++ {
+		index := strconv.Itoa(i)
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			assert.Equal(t, []sarama.RecordHeader{
+				{Key: []byte(splitIndexHeader), Value: []byte(index)},
+				{Key: []byte(splitCountHeader), Value: []byte("2")},
+			}, msg.Headers)
+			return nil
+		})
+	}
+
+	p := kafkaTracesProducer{
+		cfg: Config{
+			Producer: Producer{
+				MaxMessageBytes:        len(half) + messageOverhead + splitHeadersOverhead,
+				SplitOversizedMessages: true,
+			},
+		},
+		producer:  producer,
+		marshaler: newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	err = p.tracesPusher(context.Background(), testdata.GenerateTraces(4))
+	require.NoError(t, err)
+}
+
+func TestTracesPusher_split_oversized(t *testing.T) {
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+
+	p := kafkaTracesProducer{
+		cfg: Config{
+			Producer: Producer{
+				MaxMessageBytes:        messageOverhead + splitHeadersOverhead + 1,
+				SplitOversizedMessages: true,
+			},
+		},
+		producer:  producer,
+		marshaler: newPdataTracesMarshaler(&ptrace.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	err := p.tracesPusher(context.Background(), testdata.GenerateTraces(2))
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.ErrorContains(t, err, "dropped 2 items larger than producer.max_message_bytes")
+}
+
 func TestTracesPusher_marshal_error(t *testing.T) {
 	expErr := fmt.Errorf("failed to marshal")
 	p := kafkaTracesProducer{
@@ -281,6 +339,32 @@ func TestLogsDataPusher(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestLogsDataPusher_split(t *testing.T) {
+	full, err := (&plog.ProtoMarshaler{}).MarshalLogs(testdata.GenerateLogs(5))
+	require.NoError(t, err)
+
+	c := sarama.NewConfig()
+	producer := mocks.NewSyncProducer(t, c)
+	producer.ExpectSendMessageAndSucceed()
+	producer.ExpectSendMessageAndSucceed()
+
+	p := kafkaLogsProducer{
+		cfg: Config{
+			Producer: Producer{
+				MaxMessageBytes:        len(full) + messageOverhead + splitHeadersOverhead - 1,
+				SplitOversizedMessages: true,
+			},
+		},
+		producer:  producer,
+		marshaler: newPdataLogsMarshaler(&plog.ProtoMarshaler{}, defaultEncoding),
+	}
+	t.Cleanup(func() {
+		require.NoError(t, p.Close(context.Background()))
+	})
+	err = p.logsDataPusher(context.Background(), testdata.GenerateLogs(5))
+	require.NoError(t, err)
+}
+
 func TestLogsDataPusher_attr(t *testing.T) {
 	c := sarama.NewConfig()
 	producer := mocks.NewSyncProducer(t, c)
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/kafkaexporter"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	// splitIndexHeader and splitCountHeader are set on the messages of a batch split across
+	// several parts, to the index of the part of the message and to the number of parts.
+	splitIndexHeader = "otel.split.index"
+	splitCountHeader = "otel.split.count"
+
+	// messageOverhead is the largest overhead sarama adds to the key, value and headers of a
+	// message when checking its size against producer.max_message_bytes.
+	messageOverhead = 5*binary.MaxVarintLen32 + binary.MaxVarintLen64 + 1
+	// splitHeadersOverhead is the largest size of the split headers.
+	splitHeadersOverhead = len(splitIndexHeader) + len(splitCountHeader) + 2*len("2147483647") + 4*binary.MaxVarintLen32
+)
+
+// splitMarshaler marshals data of type T into messages, splitting the data in halves until
+// the messages fit within a maximum size.
+type splitMarshaler[T any] struct {
+	maxBytes int
+	marshal  func(T) ([]*sarama.ProducerMessage, error)
+	count    func(T) int
+	split    func(T) (T, T)
+}
+
+// Marshal returns the messages of data and the number of items dropped because they couldn't
+// be split into messages small enough. The messages of every part of a split batch carry the
+// split headers.
+func (s splitMarshaler[T]) Marshal(data T) ([]*sarama.ProducerMessage, int, error) {
+	var parts [][]*sarama.ProducerMessage
+	dropped, err := s.marshalParts(data, &parts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var messages []*sarama.ProducerMessage
+	for i, part := range parts {
+		for _, msg := range part {
+			if len(parts) > 1 {
+				msg.Headers = append(msg.Headers,
+					sarama.RecordHeader{Key: []byte(splitIndexHeader), Value: []byte(strconv.Itoa(i))},
+					sarama.RecordHeader{Key: []byte(splitCountHeader), Value: []byte(strconv.Itoa(len(parts)))},
+				)
+			}
+			messages = append(messages, msg)
+		}
+	}
+	return messages, dropped, nil
+}
+
+func (s splitMarshaler[T]) marshalParts(data T, parts *[][]*sarama.ProducerMessage) (int, error) {
+	count := s.count(data)
+	if count == 0 {
+		return 0, nil
+	}
+	messages, err := s.marshal(data)
+	if err != nil {
+		return 0, err
+	}
+	if s.fit(messages) {
+		*parts = append(*parts, messages)
+		return 0, nil
+	}
+	if count == 1 {
+		return 1, nil
+	}
+
+	first, second := s.split(data)
+	droppedFirst, err := s.marshalParts(first, parts)
+	if err != nil {
+		return 0, err
+	}
+	droppedSecond, err := s.marshalParts(second, parts)
+	if err != nil {
+		return 0, err
+	}
+	return droppedFirst + droppedSecond, nil
+}
+
+func (s splitMarshaler[T]) fit(messages []*sarama.ProducerMessage) bool {
+	for _, msg := range messages {
+		if messageSize(msg)+splitHeadersOverhead > s.maxBytes {
+			return false
+		}
+	}
+	return true
+}
+
+// messageSize returns the size of a message as checked by sarama against producer.max_message_bytes.
+func messageSize(msg *sarama.ProducerMessage) int {
+	size := messageOverhead
+	if msg.Key != nil {
+		size += msg.Key.Length()
+	}
+	if msg.Value != nil {
+		size += msg.Value.Length()
+	}
+	for _, header := range msg.Headers {
+		size += len(header.Key) + len(header.Value) + 2*binary.MaxVarintLen32
+	}
+	return size
+}
+
+// errOversizedItems is returned when some items can't be produced even when alone in a message.
+func errOversizedItems(dropped int, maxBytes int) error {
+	return fmt.Errorf("dropped %d items larger than producer.max_message_bytes (%d) once encoded", dropped, maxBytes)
+}
+
+// splitTraces splits the spans of td in two halves.
+func splitTraces(td ptrace.Traces) (ptrace.Traces, ptrace.Traces) {
+	half := td.SpanCount() / 2
+	first, second := ptrace.NewTraces(), ptrace.NewTraces()
+	td.CopyTo(first)
+	td.CopyTo(second)
+	keepSpans(first, func(i int) bool { return i < half })
+	keepSpans(second, func(i int) bool { return i >= half })
+	return first, second
+}
+
+func keepSpans(td ptrace.Traces, keep func(int) bool) {
+	i := 0
+	td.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			ss.Spans().RemoveIf(func(ptrace.Span) bool {
+				i++
+				return !keep(i - 1)
+			})
+			return ss.Spans().Len() == 0
+		})
+		return rs.ScopeSpans().Len() == 0
+	})
+}
+
+// splitMetrics splits the data points of md in two halves.
+func splitMetrics(md pmetric.Metrics) (pmetric.Metrics, pmetric.Metrics) {
+	half := md.DataPointCount() / 2
+	first, second := pmetric.NewMetrics(), pmetric.NewMetrics()
+	md.CopyTo(first)
+	md.CopyTo(second)
+	keepDataPoints(first, func(i int) bool { return i < half })
+	keepDataPoints(second, func(i int) bool { return i >= half })
+	return first, second
+}
+
+func keepDataPoints(md pmetric.Metrics, keep func(int) bool) {
+	i := 0
+	remove := func() bool {
+		i++
+		return !keep(i - 1)
+	}
+	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			sm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					m.Gauge().DataPoints().RemoveIf(func(pmetric.NumberDataPoint) bool { return remove() })
+					return m.Gauge().DataPoints().Len() == 0
+				case pmetric.MetricTypeSum:
+					m.Sum().DataPoints().RemoveIf(func(pmetric.NumberDataPoint) bool { return remove() })
+					return m.Sum().DataPoints().Len() == 0
+				case pmetric.MetricTypeHistogram:
+					m.Histogram().DataPoints().RemoveIf(func(pmetric.HistogramDataPoint) bool { return remove() })
+					return m.Histogram().DataPoints().Len() == 0
+				case pmetric.MetricTypeExponentialHistogram:
+					m.ExponentialHistogram().DataPoints().RemoveIf(func(pmetric.ExponentialHistogramDataPoint) bool { return remove() })
+					return m.ExponentialHistogram().DataPoints().Len() == 0
+				case pmetric.MetricTypeSummary:
+					m.Summary().DataPoints().RemoveIf(func(pmetric.SummaryDataPoint) bool { return remove() })
+					return m.Summary().DataPoints().Len() == 0
+				}
+				return true
+			})
+			return sm.Metrics().Len() == 0
+		})
+		return rm.ScopeMetrics().Len() == 0
+	})
+}
+
+// splitLogs splits the log records of ld in two halves.
+func splitLogs(ld plog.Logs) (plog.Logs, plog.Logs) {
+	half := ld.LogRecordCount() / 2
+	first, second := plog.NewLogs(), plog.NewLogs()
+	ld.CopyTo(first)
+	ld.CopyTo(second)
+	keepLogRecords(first, func(i int) bool { return i < half })
+	keepLogRecords(second, func(i int) bool { return i >= half })
+	return first, second
+}
+
+func keepLogRecords(ld plog.Logs, keep func(int) bool) {
+	i := 0
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			sl.LogRecords().RemoveIf(func(plog.LogRecord) bool {
+				i++
+				return !keep(i - 1)
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkaexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestSplitTraces(t *testing.T) {
+	td := testdata.GenerateTraces(5)
+	first, second := splitTraces(td)
+	assert.Equal(t, 2, first.SpanCount())
+	assert.Equal(t, 3, second.SpanCount())
+	assert.Equal(t, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name(), first.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(2).Name(), second.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Equal(t, 5, td.SpanCount())
+}
+
+func TestSplitMetrics(t *testing.T) {
+	md := testdata.GenerateMetrics(2)
+	md.ResourceMetrics().At(0).Resource().Attributes().PutStr("service.name", "checkout")
+	first, second := splitMetrics(md)
+	assert.Equal(t, md.DataPointCount()/2, first.DataPointCount())
+	assert.Equal(t, md.DataPointCount()-md.DataPointCount()/2, second.DataPointCount())
+	for _, part := range []pmetric.Metrics{first, second} {
+		assert.Equal(t, 1, part.ResourceMetrics().Len())
+		serviceName, _ := part.ResourceMetrics().At(0).Resource().Attributes().Get("service.name")
+		assert.Equal(t, "checkout", serviceName.Str())
+	}
+}
+
+func TestSplitLogs(t *testing.T) {
+	ld := testdata.GenerateLogs(3)
+	first, second := splitLogs(ld)
+	assert.Equal(t, 1, first.LogRecordCount())
+	assert.Equal(t, 2, second.LogRecordCount())
+	assert.Equal(t, ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Body().AsString(), second.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().AsString())
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultAffinityTTL     = 30 * time.Second
+	defaultMaxAffinityKeys = 100000
+)
+
+// affinityCache remembers the endpoint each routing key was routed to, for at least the TTL after the key
+// was last seen and at most twice the TTL. Keys are kept in two generations, rotated every TTL, so that
+// expired keys are dropped without tracking the expiration of each key. A key is in at most one generation.
+// The generations are also rotated early once the current one holds half of maxKeys, forgetting the keys of
+// the previous one before their TTL elapsed, so that both hold at most maxKeys keys.
+type affinityCache struct {
+	ttl     time.Duration
+	maxKeys int
+
+	lock     sync.Mutex
+	current  map[string]string
+	previous map[string]string
+	rotated  time.Time
+	// loads is the number of keys of both generations routed to each endpoint.
+	loads map[string]int
+}
+
+func newAffinityCache(ttl time.Duration, maxKeys int, now time.Time) *affinityCache {
+	return &affinityCache{
+		ttl:      ttl,
+		maxKeys:  maxKeys,
+		current:  map[string]string{},
+		previous: map[string]string{},
+		rotated:  now,
+		loads:    map[string]int{},
+	}
+}
+
+// get returns the endpoint the key was routed to, if it was seen within the TTL.
+func (c *affinityCache) get(key string, now time.Time) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.rotate(now)
+
+	if endpoint, ok := c.current[key]; ok {
+		return endpoint, true
+	}
+	endpoint, ok := c.previous[key]
+	if ok {
+		delete(c.previous, key)
+		c.current[key] = endpoint
+	}
+	return endpoint, ok
+}
+
+func (c *affinityCache) set(key string, endpoint string, now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.rotate(now)
+
+	if previous, ok := c.current[key]; ok {
+		c.unload(previous)
+	} else if previous, ok = c.previous[key]; ok {
+		delete(c.previous, key)
+		c.unload(previous)
+	} else {
+		// Keys seen again move to the current generation, which may then hold more than half of maxKeys.
+		for 2*len(c.current) >= c.maxKeys || len(c.current)+len(c.previous) >= c.maxKeys {
+			c.dropPrevious(now)
+		}
+	}
+	c.current[key] = endpoint
+	c.loads[endpoint]++
+}
+
+// load returns the number of keys routed to the endpoint, and the number of keys routed to all the endpoints.
+func (c *affinityCache) load(endpoint string, now time.Time) (int, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.rotate(now)
+	return c.loads[endpoint], len(c.current) + len(c.previous)
+}
+
+func (c *affinityCache) unload(endpoint string) {
+	if c.loads[endpoint]--; c.loads[endpoint] <= 0 {
+		delete(c.loads, endpoint)
+	}
+}
+
+func (c *affinityCache) rotate(now time.Time) {
+	switch elapsed := now.Sub(c.rotated); {
+	case elapsed >= 2*c.ttl:
+		c.previous = map[string]string{}
+		c.current = map[string]string{}
+		c.loads = map[string]int{}
+		c.rotated = now
+	case elapsed >= c.ttl:
+		c.dropPrevious(now)
+	}
+}
+
+// dropPrevious forgets the keys of the previous generation, the current one becoming the previous one.
+func (c *affinityCache) dropPrevious(now time.Time) {
+	for _, endpoint := range c.previous {
+		c.unload(endpoint)
+	}
+	c.previous = c.current
+	c.current = map[string]string{}
+	c.rotated = now
+}
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+)
+
+func TestAffinityCache(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache := newAffinityCache(time.Minute, defaultMaxAffinityKeys, now)
+
+	_, ok := cache.get("trace-1", now)
+	assert.False(t, ok)
+
+	cache.set("trace-1", "endpoint-1", now)
+	cache.set("trace-2", "endpoint-2", now)
+
+	// Keys are kept for at least the TTL.
+	endpoint, ok := cache.get("trace-1", now.Add(90*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, "endpoint-1", endpoint)
+
+	// Keys seen again are kept for another TTL, the others expire.
+	endpoint, ok = cache.get("trace-1", now.Add(150*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, "endpoint-1", endpoint)
+	_, ok = cache.get("trace-2", now.Add(150*time.Second))
+	assert.False(t, ok)
+
+	// All keys expire after twice the TTL without being seen.
+	_, ok = cache.get("trace-1", now.Add(10*time.Minute))
+	assert.False(t, ok)
+}
+
+func TestAffinityCacheLoads(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache := newAffinityCache(time.Minute, defaultMaxAffinityKeys, now)
+
+	cache.set("trace-1", "endpoint-1", now)
+	cache.set("trace-2", "endpoint-1", now)
+	cache.set("trace-3", "endpoint-2", now)
+	load, total := cache.load("endpoint-1", now)
+	assert.Equal(t, 2, load)
+	assert.Equal(t, 3, total)
+
+	// Rerouting a key moves its load to the new endpoint.
+	cache.set("trace-2", "endpoint-2", now)
+	load, _ = cache.load("endpoint-1", now)
+	assert.Equal(t, 1, load)
+
+	// Keys seen again are counted once across generations.
+	_, ok := cache.get("trace-1", now.Add(90*time.Second))
+	require.True(t, ok)
+	load, total = cache.load("endpoint-1", now.Add(90*time.Second))
+	assert.Equal(t, 1, load)
+	assert.Equal(t, 3, total)
+
+	// The load of the expired keys is dropped.
+	load, total = cache.load("endpoint-2", now.Add(150*time.Second))
+	assert.Equal(t, 0, load)
+	assert.Equal(t, 1, total)
+}
+
+func TestAffinityCacheMaxKeys(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cache := newAffinityCache(time.Minute, 4, now)
+
+	cache.set("trace-1", "endpoint-1", now)
+	cache.set("trace-2", "endpoint-2", now)
+	// Updating a key doesn't count as a new key.
+	cache.set("trace-2", "endpoint-2", now)
+	cache.set("trace-3", "endpoint-2", now)
+	_, ok := cache.get("trace-1", now)
+	require.True(t, ok)
+
+	// The keys seen the least recently are forgotten to make room for the new ones.
+	cache.set("trace-4", "endpoint-2", now)
+	_, ok = cache.get("trace-2", now)
+	assert.False(t, ok)
+	for _, key := range []string{"trace-1", "trace-3", "trace-4"} {
+		_, ok = cache.get(key, now)
+		assert.True(t, ok, key)
+	}
+
+	load, total := cache.load("endpoint-2", now)
+	assert.Equal(t, 2, load)
+	assert.Equal(t, 3, total)
+}
+
+func TestWrappedExporterOverloaded(t *testing.T) {
+	cfg := &AdaptiveRouting{MaxPendingRequests: 1, MaxLatency: 100 * time.Millisecond}
+	exp := newNopMockExporter()
+	now := time.Now()
+	assert.False(t, exp.overloaded(cfg, now))
+
+	done1, done2 := exp.track(), exp.track()
+	assert.True(t, exp.overloaded(cfg, now))
+	done1()
+	done2()
+	assert.False(t, exp.overloaded(cfg, time.Now()))
+
+	exp.latency = time.Second
+	exp.latencyUpdated = now
+	assert.True(t, exp.overloaded(cfg, now))
+	// The latency is no longer considered once no request completed for a while.
+	assert.False(t, exp.overloaded(cfg, now.Add(latencyStaleness+time.Second)))
+
+	// The latency is averaged across requests.
+	exp.track()()
+	assert.Less(t, exp.latency, time.Second)
+	assert.Greater(t, exp.latency, 500*time.Millisecond)
+}
+
+func TestNewLoadBalancerAdaptiveRouting(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.AdaptiveRouting = &AdaptiveRouting{}
+	_, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	assert.Equal(t, errNoAdaptiveThreshold, err)
+
+	cfg.AdaptiveRouting = &AdaptiveRouting{LoadFactor: 0.5}
+	_, err = newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	assert.Equal(t, errInvalidLoadFactor, err)
+
+	cfg.AdaptiveRouting = &AdaptiveRouting{MaxLatency: time.Second, MaxAffinityKeys: -1}
+	_, err = newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	assert.Equal(t, errInvalidMaxAffinityKeys, err)
+
+	cfg.AdaptiveRouting = &AdaptiveRouting{MaxLatency: time.Second}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, defaultAffinityTTL, lb.adaptive.AffinityTTL)
+	assert.Equal(t, defaultMaxAffinityKeys, lb.adaptive.MaxAffinityKeys)
+}
+
+func TestAdaptiveEndpointFor(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.AdaptiveRouting = &AdaptiveRouting{MaxPendingRequests: 1, AffinityTTL: time.Minute}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	require.NoError(t, err)
+
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	lb.ring = newHashRing(endpoints)
+	for _, endpoint := range endpoints {
+		lb.exporters[endpointWithPort(endpoint)] = newNopMockExporter()
+	}
+
+	now := time.Now()
+	id := []byte("trace-1")
+	candidates := lb.ring.endpointsFor(id)
+	assert.Equal(t, candidates[0], lb.adaptiveEndpointFor(id, now))
+
+	// Overload the primary endpoint: the trace already routed keeps its endpoint, new ones are routed
+	// to the next endpoint of the ring.
+	primary := lb.exporters[endpointWithPort(candidates[0])]
+	done1, done2 := primary.track(), primary.track()
+	assert.Equal(t, candidates[0], lb.adaptiveEndpointFor(id, now))
+	assert.Equal(t, candidates[1], lb.adaptiveEndpointFor(id, now.Add(3*time.Minute)))
+
+	// Rerouted traces keep their endpoint once the primary endpoint recovered.
+	done1()
+	done2()
+	assert.Equal(t, candidates[1], lb.adaptiveEndpointFor(id, now.Add(4*time.Minute)))
+
+	// When all the endpoints are overloaded, the primary endpoint is used.
+	for _, exp := range lb.exporters {
+		exp.pending.Add(2)
+	}
+	assert.Equal(t, candidates[0], lb.adaptiveEndpointFor([]byte("trace-1"), now.Add(10*time.Minute)))
+
+	// Traces routed to removed endpoints are routed again.
+	delete(lb.exporters, endpointWithPort(candidates[0]))
+	lb.ring = newHashRing(endpoints[1:])
+	assert.NotEqual(t, candidates[0], lb.adaptiveEndpointFor(id, now.Add(10*time.Minute)))
+}
+
+func TestAdaptiveEndpointForBoundedLoads(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.AdaptiveRouting = &AdaptiveRouting{LoadFactor: 1.25, AffinityTTL: time.Minute}
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, nil)
+	require.NoError(t, err)
+
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3", "endpoint-4"}
+	lb.ring = newHashRing(endpoints)
+	for _, endpoint := range endpoints {
+		lb.exporters[endpointWithPort(endpoint)] = newNopMockExporter()
+	}
+
+	now := time.Now()
+	routed := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		routed[lb.adaptiveEndpointFor([]byte(fmt.Sprintf("trace-%d", i)), now)]++
+	}
+
+	// No endpoint gets more than the load factor of the average number of keys.
+	require.Len(t, routed, len(endpoints))
+	for endpoint, keys := range routed {
+		assert.LessOrEqual(t, keys, 313, endpoint)
+	}
+
+	// Routed keys keep their endpoint.
+	id := []byte("trace-1")
+	endpoint := lb.adaptiveEndpointFor(id, now)
+	assert.Equal(t, endpoint, lb.adaptiveEndpointFor(id, now))
+}
+
+func TestConsumeTracesAdaptiveRouting(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.AdaptiveRouting = &AdaptiveRouting{MaxPendingRequests: 1}
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, p.loadBalancer.adaptive)
+
+	p.loadBalancer.ring = newHashRing([]string{"endpoint-1"})
+	p.loadBalancer.exporters["endpoint-1:4317"] = newWrappedExporter(newNopMockTracesExporter())
+
+	require.NoError(t, p.ConsumeTraces(context.Background(), simpleTraces()))
+	assert.Equal(t, int64(0), p.loadBalancer.exporters["endpoint-1:4317"].pending.Load())
+}
@@ -4,6 +4,7 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
@@ -24,6 +25,45 @@ type Config struct {
 	Protocol   Protocol         `mapstructure:"protocol"`
 	Resolver   ResolverSettings `mapstructure:"resolver"`
 	RoutingKey string           `mapstructure:"routing_key"`
+
+	// RoutingExpression is an OTTL value expression evaluated against the resource of each batch, the value
+	// it resolves to being the routing key. It can't be used along with RoutingKey.
+	RoutingExpression string `mapstructure:"routing_expression"`
+
+	// AdaptiveRouting enables routing away from overloaded backends. Disabled when not set.
+	AdaptiveRouting *AdaptiveRouting `mapstructure:"adaptive_routing"`
+}
+
+// AdaptiveRouting defines the thresholds above which a backend is considered overloaded. Routing keys
+// are then routed to the next backend of the ring that isn't overloaded.
+type AdaptiveRouting struct {
+	// MaxPendingRequests is the number of export requests waiting for a backend above which it is overloaded.
+	MaxPendingRequests int64 `mapstructure:"max_pending_requests"`
+	// MaxLatency is the average latency of the export requests to a backend above which it is overloaded.
+	MaxLatency time.Duration `mapstructure:"max_latency"`
+	// LoadFactor bounds the number of routing keys of a backend to the factor of the average number of routing
+	// keys per backend, following the consistent hashing with bounded loads of Mirrokni et al. The keys are
+	// counted for the AffinityTTL. It must be at least 1 when set.
+	LoadFactor float64 `mapstructure:"load_factor"`
+	// AffinityTTL is how long a routing key keeps being routed to the same backend after it was last seen,
+	// so that the spans of a trace aren't split between backends as their load changes.
+	AffinityTTL time.Duration `mapstructure:"affinity_ttl"`
+	// MaxAffinityKeys is the number of routing keys whose backend is remembered, above which the keys
+	// seen the least recently are forgotten before their AffinityTTL elapsed.
+	MaxAffinityKeys int `mapstructure:"max_affinity_keys"`
+}
+
+var errAdaptiveRoutingWithQueue = errors.New("adaptive_routing: max_pending_requests and max_latency require the sending_queue of the otlp protocol to be disabled")
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	// The export requests are accepted by the sending queue of the exporters without waiting for the backends,
+	// so that neither the pending requests nor the latency would reflect their load.
+	if cfg.AdaptiveRouting != nil && cfg.Protocol.OTLP.QueueConfig.Enabled &&
+		(cfg.AdaptiveRouting.MaxPendingRequests > 0 || cfg.AdaptiveRouting.MaxLatency > 0) {
+		return errAdaptiveRoutingWithQueue
+	}
+	return nil
 }
 
 // Protocol holds the individual protocol-specific settings. Only OTLP is supported at the moment.
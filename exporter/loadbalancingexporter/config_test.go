@@ -6,7 +6,9 @@ package loadbalancingexporter
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
@@ -25,3 +27,38 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, component.UnmarshalConfig(sub, cfg))
 	require.NotNil(t, cfg)
 }
+
+func TestLoadConfigAdaptiveRouting(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "5").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	assert.Equal(t, &AdaptiveRouting{
+		MaxPendingRequests: 10,
+		MaxLatency:         500 * time.Millisecond,
+		LoadFactor:         1.25,
+		AffinityTTL:        time.Minute,
+		MaxAffinityKeys:    50000,
+	}, cfg.(*Config).AdaptiveRouting)
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
+
+func TestValidateConfigAdaptiveRoutingWithQueue(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Resolver.Static = &StaticResolver{Hostnames: []string{"endpoint-1"}}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	// The bounded loads don't depend on the requests in progress.
+	cfg.AdaptiveRouting = &AdaptiveRouting{LoadFactor: 1.25}
+	assert.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.AdaptiveRouting = &AdaptiveRouting{MaxPendingRequests: 10}
+	assert.ErrorIs(t, component.ValidateConfig(cfg), errAdaptiveRoutingWithQueue)
+
+	cfg.Protocol.OTLP.QueueConfig.Enabled = false
+	assert.NoError(t, component.ValidateConfig(cfg))
+}
@@ -4,7 +4,9 @@
 package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
 
 import (
+	"cmp"
 	"hash/crc32"
+	"slices"
 	"sort"
 )
 
@@ -49,6 +51,29 @@ func (h *hashRing) endpointFor(identifier []byte) string {
 	return h.findEndpoint(position(pos))
 }
 
+// endpointsFor returns the distinct endpoints of the ring in the order they are found starting from the
+// position of the given identifier, the first one being the endpoint returned by endpointFor.
+func (h *hashRing) endpointsFor(identifier []byte) []string {
+	if h == nil || len(h.items) == 0 {
+		return nil
+	}
+	pos := position(crc32.ChecksumIEEE(identifier) % maxPositions)
+	start := sort.Search(len(h.items), func(i int) bool {
+		return h.items[i].pos >= pos
+	})
+
+	var endpoints []string
+	seen := map[string]bool{}
+	for i := 0; i < len(h.items); i++ {
+		endpoint := h.items[(start+i)%len(h.items)].endpoint
+		if !seen[endpoint] {
+			seen[endpoint] = true
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
 // findEndpoint returns the "next" endpoint starting from the given position, or an empty string in case no endpoints are available
 func (h *hashRing) findEndpoint(pos position) string {
 	ringSize := len(h.items)
@@ -101,11 +126,11 @@ func bsearch(pos position, left []ringItem, right []ringItem) ringItem {
 // The slice length of the result matches the numPoints.
 func positionsFor(endpoint string, numPoints int) []position {
 	res := make([]position, 0, numPoints)
+	sum := crc32.ChecksumIEEE([]byte(endpoint))
 	for i := 0; i < numPoints; i++ {
-		h := crc32.NewIEEE()
-		h.Write([]byte(endpoint))
-		h.Write([]byte{byte(i)})
-		hash := h.Sum32()
+		// equivalent to hashing the endpoint followed by the point index
+		point := [1]byte{byte(i)}
+		hash := crc32.Update(sum, crc32.IEEETable, point[:])
 		pos := hash % maxPositions
 		res = append(res, position(pos))
 	}
@@ -115,30 +140,101 @@ func positionsFor(endpoint string, numPoints int) []position {
 
 // positionsForEndpoints calculates all the positions for all the given endpoints
 func positionsForEndpoints(endpoints []string, weight int) []ringItem {
-	var items []ringItem
-	positions := map[position]bool{} // tracking the used positions
-	for _, endpoint := range endpoints {
+	return appendRingItems(nil, endpoints, func(endpoint string) []position {
 		// for this initial implementation, we don't allow endpoints to have custom weights
-		for _, pos := range positionsFor(endpoint, weight) {
-			// if this position is occupied already, skip this item
-			if _, found := positions[pos]; found {
-				continue
-			}
-			positions[pos] = true
-
-			item := ringItem{
+		return positionsFor(endpoint, weight)
+	})
+}
+
+// appendRingItems appends the positions of the endpoints to items, sorted by position. When a position
+// is calculated for several endpoints, it is assigned to the first of them.
+func appendRingItems(items []ringItem, endpoints []string, positionsOf func(endpoint string) []position) []ringItem {
+	for _, endpoint := range endpoints {
+		for _, pos := range positionsOf(endpoint) {
+			items = append(items, ringItem{
 				pos:      pos,
 				endpoint: endpoint,
-			}
-			items = append(items, item)
+			})
 		}
 	}
 
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].pos < items[j].pos
+	// the stable sort keeps the items of a position in the order of the endpoints
+	slices.SortStableFunc(items, func(a, b ringItem) int {
+		return cmp.Compare(a.pos, b.pos)
+	})
+	return slices.CompactFunc(items, func(a, b ringItem) bool {
+		return a.pos == b.pos
 	})
+}
+
+// ringBuilder builds the rings of the load balancer without allocating once the positions of the endpoints
+// are known: the positions are calculated once per endpoint, and the items of the ring that is no longer
+// in use are reused. It isn't safe for concurrent use.
+type ringBuilder struct {
+	positions map[string]cachedPositions
+	rings     [2]hashRing
+	builds    uint64
+}
 
-	return items
+type cachedPositions struct {
+	positions []position
+	// build is the last build using the positions, so that the ones of the removed endpoints are dropped
+	build uint64
+}
+
+func newRingBuilder() *ringBuilder {
+	return &ringBuilder{positions: map[string]cachedPositions{}}
+}
+
+// build returns the ring of the endpoints, reusing the ring that isn't current.
+// The ring returned by the previous build is overwritten unless it became current.
+func (b *ringBuilder) build(endpoints []string, current *hashRing) *hashRing {
+	b.builds++
+	ring := &b.rings[0]
+	if ring == current {
+		ring = &b.rings[1]
+	}
+	ring.items = appendRingItems(ring.items[:0], endpoints, b.positionsFor)
+
+	for endpoint, cached := range b.positions {
+		if cached.build != b.builds {
+			delete(b.positions, endpoint)
+		}
+	}
+	return ring
+}
+
+func (b *ringBuilder) positionsFor(endpoint string) []position {
+	cached, ok := b.positions[endpoint]
+	if !ok {
+		cached.positions = positionsFor(endpoint, defaultWeight)
+	}
+	cached.build = b.builds
+	b.positions[endpoint] = cached
+	return cached.positions
+}
+
+// imbalance returns the ratio of the largest share of the ring owned by an endpoint to the average share,
+// 1 meaning that the identifiers are evenly distributed across the endpoints.
+func (h *hashRing) imbalance() float64 {
+	if h == nil || len(h.items) == 0 {
+		return 0
+	}
+	shares := map[string]uint32{}
+	previous := h.items[len(h.items)-1].pos
+	for _, item := range h.items {
+		// an item owns the positions after the previous item, up to its own position
+		shares[item.endpoint] += uint32(item.pos-previous+position(maxPositions)) % maxPositions
+		previous = item.pos
+	}
+	if len(shares) == 1 {
+		return 1
+	}
+	var largest uint32
+	for _, share := range shares {
+		largest = max(largest, share)
+	}
+	return float64(largest) * float64(len(shares)) / float64(maxPositions)
 }
 
 func (h *hashRing) equal(candidate *hashRing) bool {
@@ -47,6 +47,22 @@ func TestEndpointFor(t *testing.T) {
 	}
 }
 
+func TestEndpointsFor(t *testing.T) {
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+	ring := newHashRing(endpoints)
+
+	for i := 0; i < 1000; i++ {
+		id := []byte(fmt.Sprintf("id-%d", i))
+		candidates := ring.endpointsFor(id)
+		// the first candidate is the endpoint of the consistent hashing, followed by all the others
+		assert.Equal(t, ring.endpointFor(id), candidates[0])
+		assert.ElementsMatch(t, endpoints, candidates)
+	}
+
+	var nilRing *hashRing
+	assert.Empty(t, nilRing.endpointsFor([]byte("id")))
+}
+
 func TestPositionsFor(t *testing.T) {
 	// prepare
 	endpoint := "host1"
@@ -219,3 +235,51 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestRingBuilder(t *testing.T) {
+	// prepare
+	builder := newRingBuilder()
+	endpoints := []string{"endpoint-1", "endpoint-2", "endpoint-3"}
+
+	// test
+	ring := builder.build(endpoints, nil)
+
+	// verify
+	assert.True(t, ring.equal(newHashRing(endpoints)))
+
+	// the ring in use isn't overwritten by the next build
+	next := builder.build(endpoints[:2], ring)
+	assert.NotSame(t, ring, next)
+	assert.True(t, ring.equal(newHashRing(endpoints)))
+	assert.True(t, next.equal(newHashRing(endpoints[:2])))
+
+	// the positions of the removed endpoints are dropped
+	assert.Len(t, builder.positions, 2)
+
+	// rebuilding the ring of known endpoints doesn't allocate
+	allocs := testing.AllocsPerRun(100, func() {
+		builder.build(endpoints[:2], ring)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestRingImbalance(t *testing.T) {
+	var nilRing *hashRing
+	assert.Zero(t, nilRing.imbalance())
+	assert.Equal(t, 1.0, newHashRing([]string{"endpoint-1"}).imbalance())
+
+	balanced := &hashRing{[]ringItem{
+		{pos: 0, endpoint: "endpoint-1"},
+		{pos: position(maxPositions / 2), endpoint: "endpoint-2"},
+	}}
+	assert.Equal(t, 1.0, balanced.imbalance())
+
+	// endpoint-2 owns three quarters of the ring, 1.5 times the average share
+	unbalanced := &hashRing{[]ringItem{
+		{pos: position(maxPositions / 4), endpoint: "endpoint-1"},
+		{pos: position(maxPositions - 1), endpoint: "endpoint-2"},
+	}}
+	assert.InDelta(t, 1.5, unbalanced.imbalance(), 0.001)
+
+	assert.Less(t, newHashRing([]string{"endpoint-1", "endpoint-2", "endpoint-3"}).imbalance(), 2.0)
+}
@@ -7,21 +7,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
+	"time"
 
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
 const (
 	defaultPort = "4317"
+
+	// defaultExporterRetention is how long the exporter of an endpoint removed by the resolver is kept,
+	// so that it is reused when the endpoint comes back, e.g. when a backend briefly fails its health checks.
+	defaultExporterRetention = 30 * time.Second
 )
 
 var (
 	errNoResolver                = errors.New("no resolvers specified for the exporter")
 	errMultipleResolversProvided = errors.New("only one resolver should be specified")
+	errNoAdaptiveThreshold       = errors.New("adaptive routing requires max_pending_requests, max_latency or load_factor to be set")
+	errInvalidLoadFactor         = errors.New("load_factor must be at least 1")
+	errInvalidMaxAffinityKeys    = errors.New("max_affinity_keys must not be negative")
 )
 
 type componentFactory func(ctx context.Context, endpoint string) (component.Component, error)
@@ -30,11 +42,20 @@ type loadBalancer struct {
 	logger *zap.Logger
 	host   component.Host
 
-	res  resolver
-	ring *hashRing
+	res     resolver
+	ring    *hashRing
+	builder *ringBuilder
 
 	componentFactory componentFactory
 	exporters        map[string]*wrappedExporter
+	// retired holds the exporters of the endpoints removed by the resolver, until they are shut down
+	// once the retention elapsed.
+	retired   map[string]*wrappedExporter
+	retention time.Duration
+
+	// adaptive is nil unless adaptive routing is enabled.
+	adaptive *AdaptiveRouting
+	affinity *affinityCache
 
 	stopped    bool
 	updateLock sync.RWMutex
@@ -104,12 +125,38 @@ func newLoadBalancer(params exporter.CreateSettings, cfg component.Config, facto
 		return nil, errNoResolver
 	}
 
-	return &loadBalancer{
+	lb := &loadBalancer{
 		logger:           params.Logger,
 		res:              res,
+		builder:          newRingBuilder(),
 		componentFactory: factory,
 		exporters:        map[string]*wrappedExporter{},
-	}, nil
+		retired:          map[string]*wrappedExporter{},
+		retention:        defaultExporterRetention,
+	}
+
+	if oCfg.AdaptiveRouting != nil {
+		adaptive := *oCfg.AdaptiveRouting
+		if adaptive.MaxPendingRequests <= 0 && adaptive.MaxLatency <= 0 && adaptive.LoadFactor == 0 {
+			return nil, errNoAdaptiveThreshold
+		}
+		if adaptive.LoadFactor != 0 && adaptive.LoadFactor < 1 {
+			return nil, errInvalidLoadFactor
+		}
+		if adaptive.MaxAffinityKeys < 0 {
+			return nil, errInvalidMaxAffinityKeys
+		}
+		if adaptive.AffinityTTL <= 0 {
+			adaptive.AffinityTTL = defaultAffinityTTL
+		}
+		if adaptive.MaxAffinityKeys == 0 {
+			adaptive.MaxAffinityKeys = defaultMaxAffinityKeys
+		}
+		lb.adaptive = &adaptive
+		lb.affinity = newAffinityCache(adaptive.AffinityTTL, adaptive.MaxAffinityKeys, time.Now())
+	}
+
+	return lb, nil
 }
 
 func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
@@ -119,21 +166,23 @@ func (lb *loadBalancer) Start(ctx context.Context, host component.Host) error {
 }
 
 func (lb *loadBalancer) onBackendChanges(resolved []string) {
-	newRing := newHashRing(resolved)
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
 
-	if !newRing.equal(lb.ring) {
-		lb.updateLock.Lock()
-		defer lb.updateLock.Unlock()
-
-		lb.ring = newRing
+	// the ring is built in place, so that resolutions that didn't change the endpoints don't allocate
+	newRing := lb.builder.build(resolved, lb.ring)
+	if newRing.equal(lb.ring) {
+		return
+	}
+	lb.ring = newRing
+	stats.Record(context.Background(), mRingImbalance.M(newRing.imbalance()))
 
-		// TODO: set a timeout?
-		ctx := context.Background()
+	// TODO: set a timeout?
+	ctx := context.Background()
 
-		// add the missing exporters first
-		lb.addMissingExporters(ctx, resolved)
-		lb.removeExtraExporters(ctx, resolved)
-	}
+	// add the missing exporters first
+	lb.addMissingExporters(ctx, resolved)
+	lb.removeExtraExporters(ctx, resolved)
 }
 
 func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []string) {
@@ -141,6 +190,11 @@ func (lb *loadBalancer) addMissingExporters(ctx context.Context, endpoints []str
 		endpoint = endpointWithPort(endpoint)
 
 		if _, exists := lb.exporters[endpoint]; !exists {
+			if we, retired := lb.retired[endpoint]; retired {
+				delete(lb.retired, endpoint)
+				lb.exporters[endpoint] = we
+				continue
+			}
 			exp, err := lb.componentFactory(ctx, endpoint)
 			if err != nil {
 				lb.logger.Error("failed to create new exporter for endpoint", zap.String("endpoint", endpoint), zap.Error(err))
@@ -170,16 +224,30 @@ func (lb *loadBalancer) removeExtraExporters(ctx context.Context, endpoints []st
 	}
 	for existing := range lb.exporters {
 		if !endpointFound(existing, endpointsWithPort) {
-			exp := lb.exporters[existing]
-			// Shutdown the exporter asynchronously to avoid blocking the resolver
-			go func() {
-				_ = exp.Shutdown(ctx)
-			}()
+			lb.retire(ctx, existing, lb.exporters[existing])
 			delete(lb.exporters, existing)
 		}
 	}
 }
 
+// retire keeps the exporter of a removed endpoint for the retention, and shuts it down afterwards
+// unless the endpoint came back in the meantime. It must be called with the update lock held.
+func (lb *loadBalancer) retire(ctx context.Context, endpoint string, exp *wrappedExporter) {
+	lb.retired[endpoint] = exp
+	time.AfterFunc(lb.retention, func() {
+		lb.updateLock.Lock()
+		defer lb.updateLock.Unlock()
+		if lb.retired[endpoint] != exp {
+			return
+		}
+		delete(lb.retired, endpoint)
+		// Shutdown the exporter asynchronously to avoid blocking the routing
+		go func() {
+			_ = exp.Shutdown(ctx)
+		}()
+	})
+}
+
 func endpointFound(endpoint string, endpoints []string) bool {
 	for _, candidate := range endpoints {
 		if candidate == endpoint {
@@ -193,6 +261,13 @@ func endpointFound(endpoint string, endpoints []string) bool {
 func (lb *loadBalancer) Shutdown(ctx context.Context) error {
 	err := lb.res.shutdown(ctx)
 	lb.stopped = true
+
+	lb.updateLock.Lock()
+	defer lb.updateLock.Unlock()
+	for endpoint, exp := range lb.retired {
+		err = multierr.Append(err, exp.Shutdown(ctx))
+		delete(lb.retired, endpoint)
+	}
 	return err
 }
 
@@ -203,7 +278,12 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 	// for details: https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/1690
 	lb.updateLock.RLock()
 	defer lb.updateLock.RUnlock()
-	endpoint := lb.ring.endpointFor(identifier)
+	var endpoint string
+	if lb.adaptive != nil {
+		endpoint = lb.adaptiveEndpointFor(identifier, time.Now())
+	} else {
+		endpoint = lb.ring.endpointFor(identifier)
+	}
 	exp, found := lb.exporters[endpointWithPort(endpoint)]
 	if !found {
 		// something is really wrong... how come we couldn't find the exporter??
@@ -212,3 +292,47 @@ func (lb *loadBalancer) exporterAndEndpoint(identifier []byte) (*wrappedExporter
 
 	return exp, endpoint, nil
 }
+
+// adaptiveEndpointFor returns the endpoint for the given identifier, skipping the overloaded endpoints of the
+// ring, and the ones exceeding their bounded load, unless the identifier was routed within the affinity TTL. When all the endpoints are overloaded, the
+// endpoint of the consistent hashing is used. It must be called with the update lock held.
+func (lb *loadBalancer) adaptiveEndpointFor(identifier []byte, now time.Time) string {
+	key := string(identifier)
+	if endpoint, ok := lb.affinity.get(key, now); ok {
+		// The endpoint may have been removed since the identifier was routed.
+		if _, found := lb.exporters[endpointWithPort(endpoint)]; found {
+			return endpoint
+		}
+	}
+
+	candidates := lb.ring.endpointsFor(identifier)
+	if len(candidates) == 0 {
+		return ""
+	}
+	endpoint := candidates[0]
+	for _, candidate := range candidates {
+		exp, found := lb.exporters[endpointWithPort(candidate)]
+		if found && !exp.overloaded(lb.adaptive, now) && !lb.exceedsBoundedLoad(candidate, len(candidates), now) {
+			if candidate != endpoint {
+				_ = stats.RecordWithTags(context.Background(),
+					[]tag.Mutator{tag.Upsert(endpointTagKey, endpoint)},
+					mNumRerouted.M(1))
+			}
+			endpoint = candidate
+			break
+		}
+	}
+	lb.affinity.set(key, endpoint, now)
+	return endpoint
+}
+
+// exceedsBoundedLoad returns whether routing a new key to the endpoint would exceed the load factor of the
+// average number of keys per endpoint, when bounded loads are enabled.
+func (lb *loadBalancer) exceedsBoundedLoad(endpoint string, numEndpoints int, now time.Time) bool {
+	if lb.adaptive.LoadFactor == 0 {
+		return false
+	}
+	load, total := lb.affinity.load(endpoint, now)
+	capacity := math.Ceil(lb.adaptive.LoadFactor * float64(total+1) / float64(numEndpoints))
+	return float64(load+1) > capacity
+}
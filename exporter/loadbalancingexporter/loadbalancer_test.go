@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -241,6 +242,42 @@ func TestRemoveExtraExporters(t *testing.T) {
 	assert.NotContains(t, p.exporters, endpointWithPort("endpoint-2"))
 }
 
+func TestRetiredExporters(t *testing.T) {
+	// prepare
+	cfg := simpleConfig()
+	created := 0
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		created++
+		return newNopMockExporter(), nil
+	}
+	p, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NotNil(t, p)
+	require.NoError(t, err)
+
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+	exp := p.exporters[endpointWithPort("endpoint-2")]
+
+	// test
+	p.onBackendChanges([]string{"endpoint-1"})
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+
+	// verify
+	assert.Equal(t, 2, created)
+	assert.Same(t, exp, p.exporters[endpointWithPort("endpoint-2")])
+	assert.Empty(t, p.retired)
+
+	// the retired exporters are dropped once the retention elapsed
+	p.retention = time.Millisecond
+	p.onBackendChanges([]string{"endpoint-1"})
+	assert.Eventually(t, func() bool {
+		p.updateLock.RLock()
+		defer p.updateLock.RUnlock()
+		return len(p.retired) == 0
+	}, time.Second, time.Millisecond)
+	p.onBackendChanges([]string{"endpoint-1", "endpoint-2"})
+	assert.Equal(t, 3, created)
+}
+
 func TestAddMissingExporters(t *testing.T) {
 	// prepare
 	cfg := simpleConfig()
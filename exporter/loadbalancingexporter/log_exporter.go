@@ -5,6 +5,7 @@ package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"sync"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"go.opencensus.io/tag"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -25,7 +27,8 @@ import (
 var _ exporter.Logs = (*logExporterImp)(nil)
 
 type logExporterImp struct {
-	loadBalancer *loadBalancer
+	loadBalancer      *loadBalancer
+	routingExpression *routingExpression
 
 	started    bool
 	shutdownWg sync.WaitGroup
@@ -43,8 +46,14 @@ func newLogsExporter(params exporter.CreateSettings, cfg component.Config) (*log
 		return nil, err
 	}
 
+	routingExpr, err := newRoutingExpression(cfg.(*Config), params.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
 	return &logExporterImp{
-		loadBalancer: lb,
+		loadBalancer:      lb,
+		routingExpression: routingExpr,
 	}, nil
 }
 
@@ -78,16 +87,12 @@ func (e *logExporterImp) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 }
 
 func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
-	traceID := traceIDFromLogs(ld)
-	balancingKey := traceID
-	if traceID == pcommon.NewTraceIDEmpty() {
-		// every log may not contain a traceID
-		// generate a random traceID as balancingKey
-		// so the log can be routed to a random backend
-		balancingKey = random()
+	balancingKey, err := e.balancingKey(ctx, ld)
+	if err != nil {
+		return err
 	}
 
-	le, endpoint, err := e.loadBalancer.exporterAndEndpoint(balancingKey[:])
+	le, endpoint, err := e.loadBalancer.exporterAndEndpoint(balancingKey)
 	if err != nil {
 		return err
 	}
@@ -113,6 +118,29 @@ func (e *logExporterImp) consumeLog(ctx context.Context, ld plog.Logs) error {
 	return err
 }
 
+// balancingKey returns the key the batch is routed by, which is the value of the routing expression for
+// its resource when set and not nil, and its trace ID otherwise.
+func (e *logExporterImp) balancingKey(ctx context.Context, ld plog.Logs) ([]byte, error) {
+	if e.routingExpression != nil && ld.ResourceLogs().Len() > 0 {
+		key, err := e.routingExpression.routingKey(ctx, ld.ResourceLogs().At(0).Resource())
+		switch {
+		case err == nil:
+			return []byte(key), nil
+		case !errors.Is(err, errNilRoutingKey):
+			return nil, consumererror.NewPermanent(err)
+		}
+	}
+
+	traceID := traceIDFromLogs(ld)
+	if traceID == pcommon.NewTraceIDEmpty() {
+		// every log may not contain a traceID
+		// generate a random traceID as balancingKey
+		// so the log can be routed to a random backend
+		traceID = random()
+	}
+	return traceID[:], nil
+}
+
 func traceIDFromLogs(ld plog.Logs) pcommon.TraceID {
 	rl := ld.ResourceLogs()
 	if rl.Len() == 0 {
@@ -13,6 +13,9 @@ var (
 	mNumResolutions = stats.Int64("loadbalancer_num_resolutions", "Number of times the resolver triggered a new resolutions", stats.UnitDimensionless)
 	mNumBackends    = stats.Int64("loadbalancer_num_backends", "Current number of backends in use", stats.UnitDimensionless)
 	mBackendLatency = stats.Int64("loadbalancer_backend_latency", "Response latency in ms for the backends", stats.UnitMilliseconds)
+	mNumRerouted    = stats.Int64("loadbalancer_num_rerouted", "Number of routing keys routed away from their overloaded backend", stats.UnitDimensionless)
+	mRoutedSpans    = stats.Int64("loadbalancer_routed_spans", "Number of spans routed to the backends", stats.UnitDimensionless)
+	mRingImbalance  = stats.Float64("loadbalancer_ring_imbalance", "Ratio of the largest share of the ring owned by a backend to the average share", stats.UnitDimensionless)
 
 	endpointTagKey      = tag.MustNewKey("endpoint")
 	successTrueMutator  = tag.Upsert(tag.MustNewKey("success"), "true")
@@ -69,5 +72,29 @@ func metricViews() []*view.View {
 			},
 			Aggregation: view.Count(),
 		},
+		{
+			Name:        mNumRerouted.Name(),
+			Measure:     mNumRerouted,
+			Description: mNumRerouted.Description(),
+			TagKeys: []tag.Key{
+				tag.MustNewKey("endpoint"),
+			},
+			Aggregation: view.Count(),
+		},
+		{
+			Name:        mRoutedSpans.Name(),
+			Measure:     mRoutedSpans,
+			Description: mRoutedSpans.Description(),
+			TagKeys: []tag.Key{
+				tag.MustNewKey("endpoint"),
+			},
+			Aggregation: view.Sum(),
+		},
+		{
+			Name:        mRingImbalance.Name(),
+			Measure:     mRingImbalance,
+			Description: mRingImbalance.Description(),
+			Aggregation: view.LastValue(),
+		},
 	}
 }
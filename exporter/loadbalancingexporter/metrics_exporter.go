@@ -31,8 +31,9 @@ var _ exporter.Metrics = (*metricExporterImp)(nil)
 type exporterMetrics map[*wrappedExporter]pmetric.Metrics
 
 type metricExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer      *loadBalancer
+	routingKey        routingKey
+	routingExpression *routingExpression
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -49,7 +50,12 @@ func newMetricsExporter(params exporter.CreateSettings, cfg component.Config) (*
 		return nil, err
 	}
 
-	metricExporter := metricExporterImp{loadBalancer: lb, routingKey: svcRouting}
+	routingExpr, err := newRoutingExpression(cfg.(*Config), params.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	metricExporter := metricExporterImp{loadBalancer: lb, routingKey: svcRouting, routingExpression: routingExpr}
 
 	switch cfg.(*Config).RoutingKey {
 	case "service", "":
@@ -84,18 +90,27 @@ func (e *metricExporterImp) Shutdown(ctx context.Context) error {
 func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	batches := batchpersignal.SplitMetrics(md)
 
-	exporterSegregatedMetrics := make(exporterMetrics)
-	endpoints := make(map[*wrappedExporter]string)
-
-	for _, batch := range batches {
-		routingIDs, err := routingIdentifiersFromMetrics(batch, e.routingKey)
+	// The routing keys are resolved before any exporter is acquired, so that an error doesn't leave
+	// the exporters acquired for the previous batches waiting forever.
+	routingIDs := make([]map[string]bool, len(batches))
+	for i, batch := range batches {
+		ids, err := e.routingIdentifiers(ctx, batch)
 		if err != nil {
 			return err
 		}
+		routingIDs[i] = ids
+	}
 
-		for rid := range routingIDs {
+	exporterSegregatedMetrics := make(exporterMetrics)
+	endpoints := make(map[*wrappedExporter]string)
+
+	for i, batch := range batches {
+		for rid := range routingIDs[i] {
 			exp, endpoint, err := e.loadBalancer.exporterAndEndpoint([]byte(rid))
 			if err != nil {
+				for exp := range exporterSegregatedMetrics {
+					exp.consumeWG.Done()
+				}
 				return err
 			}
 
@@ -135,6 +150,18 @@ func (e *metricExporterImp) ConsumeMetrics(ctx context.Context, md pmetric.Metri
 	return errs
 }
 
+func (e *metricExporterImp) routingIdentifiers(ctx context.Context, md pmetric.Metrics) (map[string]bool, error) {
+	if e.routingExpression == nil {
+		return routingIdentifiersFromMetrics(md, e.routingKey)
+	}
+	rms := md.ResourceMetrics()
+	return e.routingExpression.routingIdentifiers(ctx, rms.Len(), func(i int) pcommon.Resource {
+		return rms.At(i).Resource()
+	}, func() (map[string]bool, error) {
+		return routingIdentifiersFromMetrics(md, e.routingKey)
+	})
+}
+
 func routingIdentifiersFromMetrics(mds pmetric.Metrics, key routingKey) (map[string]bool, error) {
 	ids := make(map[string]bool)
 
@@ -15,6 +15,10 @@ func TestProcessorMetrics(t *testing.T) {
 		"loadbalancer_num_backends",
 		"loadbalancer_num_backend_updates",
 		"loadbalancer_backend_latency",
+		"loadbalancer_backend_outcome",
+		"loadbalancer_num_rerouted",
+		"loadbalancer_routed_spans",
+		"loadbalancer_ring_imbalance",
 	}
 
 	views := metricViews()
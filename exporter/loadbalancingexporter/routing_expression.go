@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/loadbalancingexporter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+var (
+	errRoutingKeyAndExpression = errors.New("routing_key and routing_expression can't be both set")
+	errNilRoutingKey           = errors.New("the routing expression resolved to nil")
+)
+
+// routingExpression resolves the routing keys of the batches from an OTTL value expression,
+// evaluated against their resources.
+type routingExpression struct {
+	expression *ottl.ValueExpression[ottlresource.TransformContext]
+}
+
+// newRoutingExpression parses the routing expression of the configuration, it returns nil when none is set.
+func newRoutingExpression(cfg *Config, settings component.TelemetrySettings) (*routingExpression, error) {
+	if cfg.RoutingExpression == "" {
+		return nil, nil
+	}
+	if cfg.RoutingKey != "" {
+		return nil, errRoutingKeyAndExpression
+	}
+
+	parser, err := ottlresource.NewParser(ottlfuncs.StandardConverters[ottlresource.TransformContext](), settings)
+	if err != nil {
+		return nil, err
+	}
+	expression, err := parser.ParseValueExpression(cfg.RoutingExpression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid routing_expression %q: %w", cfg.RoutingExpression, err)
+	}
+	return &routingExpression{expression: expression}, nil
+}
+
+// routingKey returns the value the expression resolves to for the resource, as a string.
+func (r *routingExpression) routingKey(ctx context.Context, resource pcommon.Resource) (string, error) {
+	val, err := r.expression.Eval(ctx, ottlresource.NewTransformContext(resource))
+	if err != nil {
+		return "", err
+	}
+	switch v := val.(type) {
+	case nil:
+		return "", errNilRoutingKey
+	case string:
+		return v, nil
+	case pcommon.Value:
+		if v.Type() == pcommon.ValueTypeEmpty {
+			return "", errNilRoutingKey
+		}
+		return v.AsString(), nil
+	case pcommon.Map:
+		key := pcommon.NewValueMap()
+		v.CopyTo(key.Map())
+		return key.AsString(), nil
+	case pcommon.Slice:
+		key := pcommon.NewValueSlice()
+		v.CopyTo(key.Slice())
+		return key.AsString(), nil
+	default:
+		key := pcommon.NewValueEmpty()
+		if err = key.FromRaw(v); err != nil {
+			return "", fmt.Errorf("unsupported routing key type %T: %w", v, err)
+		}
+		return key.AsString(), nil
+	}
+}
+
+// routingIdentifiers returns the routing keys of the resources. When the expression resolves to nil
+// for one of them, the batch is routed by the keys returned by fallback instead.
+func (r *routingExpression) routingIdentifiers(ctx context.Context, numResources int, resource func(int) pcommon.Resource, fallback func() (map[string]bool, error)) (map[string]bool, error) {
+	ids := make(map[string]bool)
+	for i := 0; i < numResources; i++ {
+		key, err := r.routingKey(ctx, resource(i))
+		if errors.Is(err, errNilRoutingKey) {
+			return fallback()
+		}
+		if err != nil {
+			return nil, consumererror.NewPermanent(err)
+		}
+		ids[key] = true
+	}
+	return ids, nil
+}
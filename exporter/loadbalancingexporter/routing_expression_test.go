@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package loadbalancingexporter
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestNewRoutingExpression(t *testing.T) {
+	cfg := simpleConfig()
+	expr, err := newRoutingExpression(cfg, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	assert.Nil(t, expr)
+
+	cfg.RoutingExpression = `attributes["tenant"]`
+	cfg.RoutingKey = "service"
+	_, err = newRoutingExpression(cfg, componenttest.NewNopTelemetrySettings())
+	assert.Equal(t, errRoutingKeyAndExpression, err)
+
+	cfg.RoutingKey = ""
+	cfg.RoutingExpression = `attributes["tenant"] == "acme"`
+	_, err = newRoutingExpression(cfg, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, "invalid routing_expression")
+
+	cfg.RoutingExpression = `span.name`
+	_, err = newRoutingExpression(cfg, componenttest.NewNopTelemetrySettings())
+	assert.ErrorContains(t, err, "invalid routing_expression")
+}
+
+func TestRoutingExpressionRoutingKey(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("tenant", "acme")
+	resource.Attributes().PutStr("deployment.environment", "prod")
+	resource.Attributes().PutInt("shard", 3)
+	resource.Attributes().PutEmptySlice("regions").AppendEmpty().SetStr("eu")
+
+	for _, tt := range []struct {
+		name       string
+		expression string
+		expected   string
+		err        error
+	}{
+		{
+			name:       "attribute",
+			expression: `attributes["tenant"]`,
+			expected:   "acme",
+		},
+		{
+			name:       "concatenation",
+			expression: `Concat([attributes["tenant"], attributes["deployment.environment"]], "/")`,
+			expected:   "acme/prod",
+		},
+		{
+			name:       "integer",
+			expression: `attributes["shard"]`,
+			expected:   "3",
+		},
+		{
+			name:       "slice",
+			expression: `attributes["regions"]`,
+			expected:   `["eu"]`,
+		},
+		{
+			name:       "literal",
+			expression: `"fixed"`,
+			expected:   "fixed",
+		},
+		{
+			name:       "missing attribute",
+			expression: `attributes["missing"]`,
+			err:        errNilRoutingKey,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := simpleConfig()
+			cfg.RoutingExpression = tt.expression
+			expr, err := newRoutingExpression(cfg, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+
+			key, err := expr.routingKey(context.Background(), resource)
+			if tt.err != nil {
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, key)
+		})
+	}
+}
+
+func TestTracesRoutingExpression(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingExpression = `attributes["tenant"]`
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NotNil(t, p.routingExpression)
+
+	td := ptrace.NewTraces()
+	for _, tenant := range []string{"acme", "globex"} {
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("tenant", tenant)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetTraceID([16]byte{1, 2, 3, 4})
+	}
+
+	ids, err := p.routingIdentifiers(context.Background(), td)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"acme": true, "globex": true}, ids)
+}
+
+func TestLogsRoutingExpression(t *testing.T) {
+	cfg := simpleConfig()
+	cfg.RoutingExpression = `attributes["tenant"]`
+	p, err := newLogsExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	ld := simpleLogs()
+	ld.ResourceLogs().At(0).Resource().Attributes().PutStr("tenant", "acme")
+
+	key, err := p.balancingKey(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("acme"), key)
+
+	// Without the attribute, the logs are routed by trace ID.
+	ld.ResourceLogs().At(0).Resource().Attributes().Remove("tenant")
+	key, err = p.balancingKey(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3, 4, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, key)
+}
+
+func TestConsumeTracesRoutingExpressionMissingAttribute(t *testing.T) {
+	var spans atomic.Int64
+	componentFactory := func(_ context.Context, _ string) (component.Component, error) {
+		return newMockTracesExporter(func(_ context.Context, td ptrace.Traces) error {
+			spans.Add(int64(td.SpanCount()))
+			return nil
+		}), nil
+	}
+	cfg := simpleConfig()
+	cfg.RoutingExpression = `attributes["tenant"]`
+	lb, err := newLoadBalancer(exportertest.NewNopCreateSettings(), cfg, componentFactory)
+	require.NoError(t, err)
+	p, err := newTracesExporter(exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	lb.addMissingExporters(context.Background(), []string{"endpoint-1"})
+	lb.res = &mockResolver{
+		triggerCallbacks: true,
+		onResolve: func(_ context.Context) ([]string, error) {
+			return []string{"endpoint-1"}, nil
+		},
+	}
+	p.loadBalancer = lb
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The second trace has no tenant, and is routed by its trace ID.
+	td := ptrace.NewTraces()
+	for i, tenant := range []string{"acme", ""} {
+		rs := td.ResourceSpans().AppendEmpty()
+		if tenant != "" {
+			rs.Resource().Attributes().PutStr("tenant", tenant)
+		}
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetTraceID([16]byte{byte(i + 1)})
+	}
+	require.NoError(t, p.ConsumeTraces(context.Background(), td))
+	assert.EqualValues(t, 2, spans.Load())
+
+	shutdown := make(chan error)
+	go func() {
+		shutdown <- p.Shutdown(context.Background())
+	}()
+	select {
+	case err = <-shutdown:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the exporter didn't shut down")
+	}
+}
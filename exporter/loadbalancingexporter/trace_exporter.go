@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
 
@@ -27,8 +28,9 @@ var _ exporter.Traces = (*traceExporterImp)(nil)
 type exporterTraces map[*wrappedExporter]ptrace.Traces
 
 type traceExporterImp struct {
-	loadBalancer *loadBalancer
-	routingKey   routingKey
+	loadBalancer      *loadBalancer
+	routingKey        routingKey
+	routingExpression *routingExpression
 
 	stopped    bool
 	shutdownWg sync.WaitGroup
@@ -46,7 +48,12 @@ func newTracesExporter(params exporter.CreateSettings, cfg component.Config) (*t
 		return nil, err
 	}
 
-	traceExporter := traceExporterImp{loadBalancer: lb, routingKey: traceIDRouting}
+	routingExpr, err := newRoutingExpression(cfg.(*Config), params.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	traceExporter := traceExporterImp{loadBalancer: lb, routingKey: traceIDRouting, routingExpression: routingExpr}
 
 	switch cfg.(*Config).RoutingKey {
 	case "service":
@@ -82,17 +89,26 @@ func (e *traceExporterImp) Shutdown(ctx context.Context) error {
 func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	batches := batchpersignal.SplitTraces(td)
 
-	exporterSegregatedTraces := make(exporterTraces)
-	endpoints := make(map[*wrappedExporter]string)
-	for _, batch := range batches {
-		routingID, err := routingIdentifiersFromTraces(batch, e.routingKey)
+	// The routing keys are resolved before any exporter is acquired, so that an error doesn't leave
+	// the exporters acquired for the previous batches waiting forever.
+	routingIDs := make([]map[string]bool, len(batches))
+	for i, batch := range batches {
+		ids, err := e.routingIdentifiers(ctx, batch)
 		if err != nil {
 			return err
 		}
+		routingIDs[i] = ids
+	}
 
-		for rid := range routingID {
+	exporterSegregatedTraces := make(exporterTraces)
+	endpoints := make(map[*wrappedExporter]string)
+	for i, batch := range batches {
+		for rid := range routingIDs[i] {
 			exp, endpoint, err := e.loadBalancer.exporterAndEndpoint([]byte(rid))
 			if err != nil {
+				for exp := range exporterSegregatedTraces {
+					exp.consumeWG.Done()
+				}
 				return err
 			}
 
@@ -110,6 +126,11 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 	var errs error
 
 	for exp, td := range exporterSegregatedTraces {
+		_ = stats.RecordWithTags(
+			ctx,
+			[]tag.Mutator{tag.Upsert(endpointTagKey, endpoints[exp])},
+			mRoutedSpans.M(int64(td.SpanCount())))
+
 		start := time.Now()
 		err := exp.ConsumeTraces(ctx, td)
 		exp.consumeWG.Done()
@@ -132,6 +153,18 @@ func (e *traceExporterImp) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 	return errs
 }
 
+func (e *traceExporterImp) routingIdentifiers(ctx context.Context, td ptrace.Traces) (map[string]bool, error) {
+	if e.routingExpression == nil {
+		return routingIdentifiersFromTraces(td, e.routingKey)
+	}
+	rss := td.ResourceSpans()
+	return e.routingExpression.routingIdentifiers(ctx, rss.Len(), func(i int) pcommon.Resource {
+		return rss.At(i).Resource()
+	}, func() (map[string]bool, error) {
+		return routingIdentifiersFromTraces(td, e.routingKey)
+	})
+}
+
 func routingIdentifiersFromTraces(td ptrace.Traces, key routingKey) (map[string]bool, error) {
 	ids := make(map[string]bool)
 	rs := td.ResourceSpans()
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
@@ -20,6 +22,15 @@ import (
 type wrappedExporter struct {
 	component.Component
 	consumeWG sync.WaitGroup
+
+	// pending is the number of export requests in progress.
+	pending atomic.Int64
+
+	latencyLock sync.Mutex
+	// latency is the exponentially weighted moving average of the latency of the export requests.
+	latency time.Duration
+	// latencyUpdated is when the last export request completed.
+	latencyUpdated time.Time
 }
 
 func newWrappedExporter(exp component.Component) *wrappedExporter {
@@ -36,6 +47,7 @@ func (we *wrappedExporter) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 	if !ok {
 		return fmt.Errorf("unable to export traces, unexpected exporter type: expected exporter.Traces but got %T", we.Component)
 	}
+	defer we.track()()
 	return te.ConsumeTraces(ctx, td)
 }
 
@@ -44,6 +56,7 @@ func (we *wrappedExporter) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 	if !ok {
 		return fmt.Errorf("unable to export metrics, unexpected exporter type: expected exporter.Metrics but got %T", we.Component)
 	}
+	defer we.track()()
 	return me.ConsumeMetrics(ctx, md)
 }
 
@@ -52,5 +65,46 @@ func (we *wrappedExporter) ConsumeLogs(ctx context.Context, ld plog.Logs) error
 	if !ok {
 		return fmt.Errorf("unable to export logs, unexpected exporter type: expected exporter.Logs but got %T", we.Component)
 	}
+	defer we.track()()
 	return le.ConsumeLogs(ctx, ld)
 }
+
+// latencySmoothing is the weight of the latest export request in the average latency.
+const latencySmoothing = 0.3
+
+// latencyStaleness is how long the average latency is considered once no export request completed,
+// so that a backend doesn't stay overloaded once no more data is routed to it.
+const latencyStaleness = 10 * time.Second
+
+// track records an export request in progress, and returns the function to call once it completed.
+func (we *wrappedExporter) track() func() {
+	we.pending.Add(1)
+	start := time.Now()
+	return func() {
+		we.pending.Add(-1)
+		now := time.Now()
+		latency := now.Sub(start)
+
+		we.latencyLock.Lock()
+		defer we.latencyLock.Unlock()
+		if we.latencyUpdated.IsZero() || now.Sub(we.latencyUpdated) > latencyStaleness {
+			we.latency = latency
+		} else {
+			we.latency += time.Duration(latencySmoothing * float64(latency-we.latency))
+		}
+		we.latencyUpdated = now
+	}
+}
+
+// overloaded returns whether the load of the backend exceeds the thresholds.
+func (we *wrappedExporter) overloaded(cfg *AdaptiveRouting, now time.Time) bool {
+	if cfg.MaxPendingRequests > 0 && we.pending.Load() > cfg.MaxPendingRequests {
+		return true
+	}
+	if cfg.MaxLatency <= 0 {
+		return false
+	}
+	we.latencyLock.Lock()
+	defer we.latencyLock.Unlock()
+	return we.latency > cfg.MaxLatency && now.Sub(we.latencyUpdated) <= latencyStaleness
+}
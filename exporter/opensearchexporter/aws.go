@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/opensearch-project/opensearch-go/v2/signer"
+	"github.com/opensearch-project/opensearch-go/v2/signer/awsv2"
+)
+
+// Signing names of the AWS services hosting OpenSearch.
+const (
+	awsServiceOpenSearch = "es"
+	awsServiceServerless = "aoss"
+)
+
+// newAWSSigner returns a signer of the requests with the credentials of the default AWS
+// credential chain. The service and region not configured are inferred from the endpoint.
+func newAWSSigner(ctx context.Context, cfg AWSConfig, endpoint string) (signer.Signer, error) {
+	service, region := inferAWSServiceAndRegion(endpoint)
+	if cfg.Service != "" {
+		service = cfg.Service
+	}
+	if cfg.Region != "" {
+		region = cfg.Region
+	}
+
+	var opts []func(*awsconfig.LoadOptions) error
+	if region != "" {
+		opts = append(opts, awsconfig.WithRegion(region))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the AWS configuration: %w", err)
+	}
+	if awsCfg.Region == "" {
+		return nil, fmt.Errorf("unable to infer the AWS region from the endpoint %q, aws.region must be set", endpoint)
+	}
+	return awsv2.NewSignerWithService(awsCfg, service)
+}
+
+// inferAWSServiceAndRegion infers the signing name and the region from the host of the
+// endpoint of a domain (search-<name>-<id>.<region>.es.amazonaws.com) or of a collection
+// (<id>.<region>.aoss.amazonaws.com). The service defaults to es for the other hosts.
+func inferAWSServiceAndRegion(endpoint string) (service string, region string) {
+	service = awsServiceOpenSearch
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return service, ""
+	}
+
+	labels := strings.Split(u.Hostname(), ".")
+	if len(labels) < 5 || labels[len(labels)-2] != "amazonaws" {
+		return service, ""
+	}
+	switch labels[len(labels)-3] {
+	case awsServiceServerless:
+		service = awsServiceServerless
+	case awsServiceOpenSearch:
+	default:
+		return service, ""
+	}
+	return service, labels[len(labels)-4]
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearchexporter
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferAWSServiceAndRegion(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		service  string
+		region   string
+	}{
+		{endpoint: "https://abc123.us-east-1.aoss.amazonaws.com", service: "aoss", region: "us-east-1"},
+		{endpoint: "https://search-logs-abc123.eu-west-3.es.amazonaws.com:443", service: "es", region: "eu-west-3"},
+		{endpoint: "https://opensearch.example.com:9200", service: "es"},
+		{endpoint: "https://abc123.us-east-1.s3.amazonaws.com", service: "es"},
+		{endpoint: "://invalid", service: "es"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			service, region := inferAWSServiceAndRegion(tt.endpoint)
+			assert.Equal(t, tt.service, service)
+			assert.Equal(t, tt.region, region)
+		})
+	}
+}
+
+func TestNewAWSSigner(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_CONFIG_FILE", "/nonexistent")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/nonexistent")
+
+	tests := []struct {
+		name     string
+		cfg      AWSConfig
+		endpoint string
+		scope    string
+	}{
+		{
+			name:     "inferred from the collection endpoint",
+			cfg:      AWSConfig{Enabled: true},
+			endpoint: "https://abc123.us-east-1.aoss.amazonaws.com",
+			scope:    "/us-east-1/aoss/aws4_request",
+		},
+		{
+			name:     "configured",
+			cfg:      AWSConfig{Enabled: true, Region: "eu-west-1", Service: "aoss"},
+			endpoint: "https://opensearch.example.com",
+			scope:    "/eu-west-1/aoss/aws4_request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newAWSSigner(context.Background(), tt.cfg, tt.endpoint)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, tt.endpoint+"/_bulk", strings.NewReader(`{"create":{}}`))
+			require.NoError(t, err)
+			require.NoError(t, s.SignRequest(req))
+			assert.Contains(t, req.Header.Get("Authorization"), tt.scope)
+			assert.NotEmpty(t, req.Header.Get("X-Amz-Content-Sha256"))
+		})
+	}
+
+	_, err := newAWSSigner(context.Background(), AWSConfig{Enabled: true}, "https://opensearch.example.com")
+	assert.ErrorContains(t, err, "aws.region must be set")
+}
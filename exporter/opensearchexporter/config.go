@@ -47,6 +47,37 @@ type Config struct {
 	// BulkAction configures the action for ingesting data. Only `create` and `index` are allowed here.
 	// If not specified, the default value `create` will be used.
 	BulkAction string `mapstructure:"bulk_action"`
+
+	// AWS configures the signing of the requests with AWS Signature Version 4, which Amazon
+	// OpenSearch Service domains and Amazon OpenSearch Serverless collections require.
+	AWS AWSConfig `mapstructure:"aws"`
+
+	// RolloverAlias configures writing the documents through a rollover alias.
+	RolloverAlias RolloverAliasConfig `mapstructure:"rollover_alias"`
+}
+
+// AWSConfig defines the signing of the requests to an AWS hosted OpenSearch.
+type AWSConfig struct {
+	// Enabled signs the requests with the credentials of the default AWS credential chain.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Region of the domain or collection. If not specified, it is inferred from the
+	// endpoint, then read from the default AWS configuration.
+	Region string `mapstructure:"region"`
+
+	// Service is the signing name of the service: `es` for Amazon OpenSearch Service
+	// and `aoss` for Amazon OpenSearch Serverless. If not specified, it is inferred
+	// from the endpoint.
+	Service string `mapstructure:"service"`
+}
+
+// RolloverAliasConfig defines the writing of the documents through a rollover alias.
+type RolloverAliasConfig struct {
+	// Enabled writes the documents to the index name as a rollover alias. If the alias
+	// does not exist, it is created on the first write, with the "<alias>-000001"
+	// index as its write index.
+	// https://opensearch.org/docs/latest/api-reference/index-apis/rollover/
+	Enabled bool `mapstructure:"enabled"`
 }
 
 var (
@@ -55,6 +86,7 @@ var (
 	errNamespaceNoValue   = errors.New("namespace must be specified")
 	errBulkActionInvalid  = errors.New("bulk_action can either be `create` or `index`")
 	errMappingModeInvalid = errors.New("mapping.mode is invalid")
+	errAWSServiceInvalid  = errors.New("aws.service can either be `es` or `aoss`")
 )
 
 type MappingsSettings struct {
@@ -148,5 +180,9 @@ func (cfg *Config) Validate() error {
 		multiErr = append(multiErr, errMappingModeInvalid)
 	}
 
+	if cfg.AWS.Service != "" && cfg.AWS.Service != awsServiceOpenSearch && cfg.AWS.Service != awsServiceServerless {
+		multiErr = append(multiErr, errAWSServiceInvalid)
+	}
+
 	return errors.Join(multiErr...)
 }
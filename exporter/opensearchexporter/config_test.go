@@ -106,6 +106,27 @@ func TestLoadConfig(t *testing.T) {
 				return assert.ErrorContains(t, err, errBulkActionInvalid.Error())
 			},
 		},
+
+		{
+			id: component.NewIDWithName(metadata.Type, "aoss"),
+			expected: withDefaultConfig(func(config *Config) {
+				config.Endpoint = "https://abc123.us-east-1.aoss.amazonaws.com"
+				config.LogsIndex = "otel-logs"
+				config.AWS = AWSConfig{Enabled: true, Region: "us-east-1", Service: "aoss"}
+				config.RolloverAlias = RolloverAliasConfig{Enabled: true}
+			}),
+			configValidateAssert: assert.NoError,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_aws_service"),
+			expected: withDefaultConfig(func(config *Config) {
+				config.Endpoint = sampleEndpoint
+				config.AWS = AWSConfig{Enabled: true, Service: "s3"}
+			}),
+			configValidateAssert: func(t assert.TestingT, err error, _ ...any) bool {
+				return assert.ErrorContains(t, err, errAWSServiceInvalid.Error())
+			},
+		},
 	}
 
 	for _, tt := range tests {
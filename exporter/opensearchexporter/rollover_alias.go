@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearchexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/opensearchexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+)
+
+// rolloverAlias is a rollover alias the documents are written through. The alias is
+// created on the first write, along with its first backing index, unless it exists.
+type rolloverAlias struct {
+	name string
+
+	mu      sync.Mutex
+	created bool
+}
+
+func newRolloverAlias(cfg RolloverAliasConfig, name string) *rolloverAlias {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &rolloverAlias{name: name}
+}
+
+// firstIndex is the name of the first backing index of the alias, the rollover API
+// increments its numeric suffix.
+func (a *rolloverAlias) firstIndex() string {
+	return a.name + "-000001"
+}
+
+// ensure creates the alias if it does not exist. It returns without any request once the
+// alias is known to exist.
+func (a *rolloverAlias) ensure(ctx context.Context, client *opensearch.Client) error {
+	if a == nil {
+		return nil
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.created {
+		return nil
+	}
+
+	resp, err := client.Indices.ExistsAlias([]string{a.name}, client.Indices.ExistsAlias.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to check the rollover alias %q: %w", a.name, err)
+	}
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		a.created = true
+		return nil
+	case http.StatusNotFound:
+	default:
+		return fmt.Errorf("failed to check the rollover alias %q: %s", a.name, resp.Status())
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"aliases": map[string]any{
+			a.name: map[string]any{"is_write_index": true},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err = client.Indices.Create(a.firstIndex(),
+		client.Indices.Create.WithBody(bytes.NewReader(body)),
+		client.Indices.Create.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to create the rollover alias %q: %w", a.name, err)
+	}
+	defer resp.Body.Close()
+	if resp.IsError() {
+		// Another collector may have created the index in the meantime.
+		respBody, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusBadRequest || !strings.Contains(string(respBody), "resource_already_exists_exception") {
+			return fmt.Errorf("failed to create the rollover alias %q: %s %s", a.name, resp.Status(), respBody)
+		}
+	}
+	a.created = true
+	return nil
+}
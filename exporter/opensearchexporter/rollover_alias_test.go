@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opensearchexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloverAliasEnsure(t *testing.T) {
+	tests := []struct {
+		name           string
+		aliasStatus    int
+		createStatus   int
+		createResponse string
+		expectedCalls  []string
+		expectedErr    string
+	}{
+		{
+			name:          "exists",
+			aliasStatus:   http.StatusOK,
+			expectedCalls: []string{"HEAD /_alias/logs"},
+		},
+		{
+			name:          "created",
+			aliasStatus:   http.StatusNotFound,
+			createStatus:  http.StatusOK,
+			expectedCalls: []string{"HEAD /_alias/logs", `PUT /logs-000001 {"aliases":{"logs":{"is_write_index":true}}}`},
+		},
+		{
+			name:           "created concurrently",
+			aliasStatus:    http.StatusNotFound,
+			createStatus:   http.StatusBadRequest,
+			createResponse: `{"error":{"type":"resource_already_exists_exception"}}`,
+			expectedCalls:  []string{"HEAD /_alias/logs", `PUT /logs-000001 {"aliases":{"logs":{"is_write_index":true}}}`},
+		},
+		{
+			name:           "create failure",
+			aliasStatus:    http.StatusNotFound,
+			createStatus:   http.StatusForbidden,
+			createResponse: `{"error":{"type":"security_exception"}}`,
+			expectedErr:    `failed to create the rollover alias "logs": 403 Forbidden`,
+		},
+		{
+			name:        "check failure",
+			aliasStatus: http.StatusInternalServerError,
+			expectedErr: `failed to check the rollover alias "logs": 500 Internal Server Error`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := r.Method + " " + r.URL.Path
+				if body, _ := io.ReadAll(r.Body); len(body) != 0 {
+					call += " " + string(body)
+				}
+				calls = append(calls, call)
+				if r.Method == http.MethodHead {
+					w.WriteHeader(tt.aliasStatus)
+					return
+				}
+				w.WriteHeader(tt.createStatus)
+				_, _ = w.Write([]byte(tt.createResponse))
+			}))
+			defer ts.Close()
+
+			client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{ts.URL}, DisableRetry: true})
+			require.NoError(t, err)
+
+			alias := newRolloverAlias(RolloverAliasConfig{Enabled: true}, "logs")
+			err = alias.ensure(context.Background(), client)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The alias is only checked once.
+			require.NoError(t, alias.ensure(context.Background(), client))
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestRolloverAliasDisabled(t *testing.T) {
+	alias := newRolloverAlias(RolloverAliasConfig{}, "logs")
+	assert.Nil(t, alias)
+	assert.NoError(t, alias.ensure(context.Background(), nil))
+}
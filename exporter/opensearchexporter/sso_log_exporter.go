@@ -15,12 +15,14 @@ import (
 )
 
 type logExporter struct {
-	client       *opensearch.Client
-	Index        string
-	bulkAction   string
-	model        mappingModel
-	httpSettings confighttp.ClientConfig
-	telemetry    component.TelemetrySettings
+	client        *opensearch.Client
+	Index         string
+	bulkAction    string
+	model         mappingModel
+	httpSettings  confighttp.ClientConfig
+	awsSettings   AWSConfig
+	rolloverAlias *rolloverAlias
+	telemetry     component.TelemetrySettings
 }
 
 func newLogExporter(cfg *Config, set exporter.CreateSettings) (*logExporter, error) {
@@ -39,12 +41,15 @@ func newLogExporter(cfg *Config, set exporter.CreateSettings) (*logExporter, err
 		namespace:         cfg.Namespace,
 	}
 
+	index := getIndexName(cfg.Dataset, cfg.Namespace, cfg.LogsIndex)
 	return &logExporter{
-		telemetry:    set.TelemetrySettings,
-		Index:        getIndexName(cfg.Dataset, cfg.Namespace, cfg.LogsIndex),
-		bulkAction:   cfg.BulkAction,
-		httpSettings: cfg.ClientConfig,
-		model:        model,
+		telemetry:     set.TelemetrySettings,
+		Index:         index,
+		bulkAction:    cfg.BulkAction,
+		httpSettings:  cfg.ClientConfig,
+		awsSettings:   cfg.AWS,
+		rolloverAlias: newRolloverAlias(cfg.RolloverAlias, index),
+		model:         model,
 	}, nil
 }
 
@@ -54,7 +59,7 @@ func (l *logExporter) Start(ctx context.Context, host component.Host) error {
 		return err
 	}
 
-	client, err := newOpenSearchClient(l.httpSettings.Endpoint, httpClient, l.telemetry.Logger)
+	client, err := newOpenSearchClient(ctx, l.httpSettings.Endpoint, httpClient, l.awsSettings, l.telemetry.Logger)
 	if err != nil {
 		return err
 	}
@@ -64,6 +69,9 @@ func (l *logExporter) Start(ctx context.Context, host component.Host) error {
 }
 
 func (l *logExporter) pushLogData(ctx context.Context, ld plog.Logs) error {
+	if err := l.rolloverAlias.ensure(ctx, l.client); err != nil {
+		return err
+	}
 	indexer := newLogBulkIndexer(l.Index, l.bulkAction, l.model)
 	startErr := indexer.start(l.client)
 	if startErr != nil {
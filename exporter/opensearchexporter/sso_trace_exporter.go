@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/signer"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/exporter"
@@ -16,13 +17,15 @@ import (
 )
 
 type ssoTracesExporter struct {
-	client       *opensearch.Client
-	Namespace    string
-	Dataset      string
-	bulkAction   string
-	model        mappingModel
-	httpSettings confighttp.ClientConfig
-	telemetry    component.TelemetrySettings
+	client        *opensearch.Client
+	Namespace     string
+	Dataset       string
+	bulkAction    string
+	model         mappingModel
+	httpSettings  confighttp.ClientConfig
+	awsSettings   AWSConfig
+	rolloverAlias *rolloverAlias
+	telemetry     component.TelemetrySettings
 }
 
 func newSSOTracesExporter(cfg *Config, set exporter.CreateSettings) (*ssoTracesExporter, error) {
@@ -36,12 +39,14 @@ func newSSOTracesExporter(cfg *Config, set exporter.CreateSettings) (*ssoTracesE
 	}
 
 	return &ssoTracesExporter{
-		telemetry:    set.TelemetrySettings,
-		Namespace:    cfg.Namespace,
-		Dataset:      cfg.Dataset,
-		bulkAction:   cfg.BulkAction,
-		model:        model,
-		httpSettings: cfg.ClientConfig,
+		telemetry:     set.TelemetrySettings,
+		Namespace:     cfg.Namespace,
+		Dataset:       cfg.Dataset,
+		bulkAction:    cfg.BulkAction,
+		model:         model,
+		httpSettings:  cfg.ClientConfig,
+		awsSettings:   cfg.AWS,
+		rolloverAlias: newRolloverAlias(cfg.RolloverAlias, getTracesIndexName(cfg.Dataset, cfg.Namespace)),
 	}, nil
 }
 
@@ -51,7 +56,7 @@ func (s *ssoTracesExporter) Start(ctx context.Context, host component.Host) erro
 		return err
 	}
 
-	client, err := newOpenSearchClient(s.httpSettings.Endpoint, httpClient, s.telemetry.Logger)
+	client, err := newOpenSearchClient(ctx, s.httpSettings.Endpoint, httpClient, s.awsSettings, s.telemetry.Logger)
 	if err != nil {
 		return err
 	}
@@ -61,6 +66,9 @@ func (s *ssoTracesExporter) Start(ctx context.Context, host component.Host) erro
 }
 
 func (s *ssoTracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces) error {
+	if err := s.rolloverAlias.ensure(ctx, s.client); err != nil {
+		return err
+	}
 	indexer := newTraceBulkIndexer(s.Dataset, s.Namespace, s.bulkAction, s.model)
 	startErr := indexer.start(s.client)
 	if startErr != nil {
@@ -71,10 +79,19 @@ func (s *ssoTracesExporter) pushTraceData(ctx context.Context, td ptrace.Traces)
 	return indexer.joinedError()
 }
 
-func newOpenSearchClient(endpoint string, httpClient *http.Client, logger *zap.Logger) (*opensearch.Client, error) {
+func newOpenSearchClient(ctx context.Context, endpoint string, httpClient *http.Client, awsSettings AWSConfig, logger *zap.Logger) (*opensearch.Client, error) {
+	var requestSigner signer.Signer
+	if awsSettings.Enabled {
+		var err error
+		if requestSigner, err = newAWSSigner(ctx, awsSettings, endpoint); err != nil {
+			return nil, err
+		}
+	}
+
 	transport := httpClient.Transport
 	return opensearch.NewClient(opensearch.Config{
 		Transport: transport,
+		Signer:    requestSigner,
 
 		// configure connection setup
 		Addresses:    []string{endpoint},
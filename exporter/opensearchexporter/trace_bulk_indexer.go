@@ -144,7 +144,11 @@ func (tbi *traceBulkIndexer) newBulkIndexerItem(document []byte) opensearchutil.
 }
 
 func (tbi *traceBulkIndexer) getIndexName() string {
-	return strings.Join([]string{"ss4o_traces", tbi.dataset, tbi.namespace}, "-")
+	return getTracesIndexName(tbi.dataset, tbi.namespace)
+}
+
+func getTracesIndexName(dataset, namespace string) string {
+	return strings.Join([]string{"ss4o_traces", dataset, namespace}, "-")
 }
 
 func newOpenSearchBulkIndexer(client *opensearch.Client, onIndexerError func(context.Context, error)) (opensearchutil.BulkIndexer, error) {
@@ -5,6 +5,8 @@ package prometheusexporter // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"fmt"
+	"math"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -36,6 +38,19 @@ type accumulator interface {
 	// Collect returns a slice with relevant aggregated metrics and their resource attributes.
 	// The number or metrics and attributes returned will be the same.
 	Collect() (metrics []pmetric.Metric, resourceAttrs []pcommon.Map)
+	// CollectStale returns the gauges and sums which expired or were marked with no recorded value
+	// within the metric expiration, with their value set to the Prometheus staleness marker.
+	CollectStale() (metrics []pmetric.Metric, resourceAttrs []pcommon.Map)
+}
+
+// staleNaN is the bit pattern of the Prometheus staleness marker, a signaling NaN.
+// See github.com/prometheus/prometheus/model/value.
+const staleNaN uint64 = 0x7ff0000000000002
+
+// expirationPolicy overrides the expiration of the metrics whose name matches the pattern.
+type expirationPolicy struct {
+	pattern    *regexp.Regexp
+	expiration time.Duration
 }
 
 // LastValueAccumulator keeps last value for accumulated metrics
@@ -47,13 +62,24 @@ type lastValueAccumulator struct {
 	// metricExpiration contains duration for which metric
 	// should be served after it was updated
 	metricExpiration time.Duration
+
+	// expirationPolicies override metricExpiration for the metrics matching them, the first match applies.
+	expirationPolicies []expirationPolicy
+	// expirations caches the expiration of every metric name.
+	expirations sync.Map
+
+	// trackStale enables keeping the series that became stale, until they are collected by CollectStale.
+	trackStale   bool
+	staleMetrics sync.Map
 }
 
 // NewAccumulator returns LastValueAccumulator
-func newAccumulator(logger *zap.Logger, metricExpiration time.Duration) accumulator {
+func newAccumulator(logger *zap.Logger, metricExpiration time.Duration, expirationPolicies []expirationPolicy, trackStale bool) accumulator {
 	return &lastValueAccumulator{
-		logger:           logger,
-		metricExpiration: metricExpiration,
+		logger:             logger,
+		metricExpiration:   metricExpiration,
+		expirationPolicies: expirationPolicies,
+		trackStale:         trackStale,
 	}
 }
 
@@ -133,7 +159,9 @@ func (a *lastValueAccumulator) accumulateGauge(metric pmetric.Metric, il pcommon
 
 		signature := timeseriesSignature(il.Name(), metric, ip.Attributes(), resourceAttrs)
 		if ip.Flags().NoRecordedValue() {
-			a.registeredMetrics.Delete(signature)
+			if v, ok := a.registeredMetrics.LoadAndDelete(signature); ok {
+				a.markStale(signature, v.(*accumulatedValue), now)
+			}
 			return 0
 		}
 
@@ -179,7 +207,9 @@ func (a *lastValueAccumulator) accumulateSum(metric pmetric.Metric, il pcommon.I
 
 		signature := timeseriesSignature(il.Name(), metric, ip.Attributes(), resourceAttrs)
 		if ip.Flags().NoRecordedValue() {
-			a.registeredMetrics.Delete(signature)
+			if v, ok := a.registeredMetrics.LoadAndDelete(signature); ok {
+				a.markStale(signature, v.(*accumulatedValue), now)
+			}
 			return 0
 		}
 
@@ -297,13 +327,14 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 
 	var metrics []pmetric.Metric
 	var resourceAttrs []pcommon.Map
-	expirationTime := time.Now().Add(-a.metricExpiration)
+	now := time.Now()
 
 	a.registeredMetrics.Range(func(key, value any) bool {
 		v := value.(*accumulatedValue)
-		if expirationTime.After(v.updated) {
+		if now.Add(-a.expiration(v.value.Name())).After(v.updated) {
 			a.logger.Debug(fmt.Sprintf("metric expired: %s", v.value.Name()))
 			a.registeredMetrics.Delete(key)
+			a.markStale(key.(string), v, now)
 			return true
 		}
 
@@ -312,9 +343,88 @@ func (a *lastValueAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 		return true
 	})
 
+	// Nothing may scrape the staleness markers.
+	a.pruneStale(now)
+
+	return metrics, resourceAttrs
+}
+
+// expiration returns the duration for which a metric is served after it was updated.
+func (a *lastValueAccumulator) expiration(name string) time.Duration {
+	if len(a.expirationPolicies) == 0 {
+		return a.metricExpiration
+	}
+	if expiration, ok := a.expirations.Load(name); ok {
+		return expiration.(time.Duration)
+	}
+	expiration := a.metricExpiration
+	for _, policy := range a.expirationPolicies {
+		if policy.pattern.MatchString(name) {
+			expiration = policy.expiration
+			break
+		}
+	}
+	a.expirations.Store(name, expiration)
+	return expiration
+}
+
+// markStale keeps a copy of a gauge or sum which stopped being served, with the staleness marker as value.
+// Histograms and summaries have no staleness marker, as their count can't be NaN.
+func (a *lastValueAccumulator) markStale(signature string, v *accumulatedValue, now time.Time) {
+	if !a.trackStale {
+		return
+	}
+	// The value may still be converted by a concurrent Collect.
+	m := pmetric.NewMetric()
+	var dp pmetric.NumberDataPoint
+	switch v.value.Type() {
+	case pmetric.MetricTypeGauge:
+		v.value.CopyTo(m)
+		dp = m.Gauge().DataPoints().At(0)
+	case pmetric.MetricTypeSum:
+		v.value.CopyTo(m)
+		dp = m.Sum().DataPoints().At(0)
+	default:
+		return
+	}
+	dp.SetDoubleValue(math.Float64frombits(staleNaN))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	dp.Exemplars().RemoveIf(func(pmetric.Exemplar) bool { return true })
+	a.staleMetrics.Store(signature, &accumulatedValue{value: m, resourceAttrs: v.resourceAttrs, scope: v.scope, updated: now})
+}
+
+// CollectStale returns the series which became stale within the metric expiration, except the ones
+// served again. The staleness markers aren't consumed by a scrape, so that every scraper sees them.
+func (a *lastValueAccumulator) CollectStale() ([]pmetric.Metric, []pcommon.Map) {
+	var metrics []pmetric.Metric
+	var resourceAttrs []pcommon.Map
+
+	a.pruneStale(time.Now())
+	a.staleMetrics.Range(func(key, value any) bool {
+		v := value.(*accumulatedValue)
+		if _, ok := a.registeredMetrics.Load(key); ok {
+			a.staleMetrics.Delete(key)
+			return true
+		}
+		metrics = append(metrics, v.value)
+		resourceAttrs = append(resourceAttrs, v.resourceAttrs)
+		return true
+	})
+
 	return metrics, resourceAttrs
 }
 
+// pruneStale removes the series which became stale more than the metric expiration ago.
+func (a *lastValueAccumulator) pruneStale(now time.Time) {
+	retentionTime := now.Add(-a.metricExpiration)
+	a.staleMetrics.Range(func(key, value any) bool {
+		if retentionTime.After(value.(*accumulatedValue).updated) {
+			a.staleMetrics.Delete(key)
+		}
+		return true
+	})
+}
+
 func timeseriesSignature(ilmName string, metric pmetric.Metric, attributes pcommon.Map, resourceAttrs pcommon.Map) string {
 	var b strings.Builder
 	b.WriteString(metric.Type().String())
@@ -5,6 +5,8 @@ package prometheusexporter
 
 import (
 	"log"
+	"math"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -229,7 +231,7 @@ func TestAccumulateMetrics(t *testing.T) {
 			tt.metric(ts2, 21, ilm2.Metrics())
 			tt.metric(ts1, 13, ilm2.Metrics())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 
 			// 2 metric arrived
 			n := a.Accumulate(resourceMetrics2)
@@ -335,7 +337,7 @@ func TestAccumulateDeltaToCumulative(t *testing.T) {
 			resourceMetrics := pmetric.NewResourceMetrics()
 			ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
 			ilm.Scope().SetName("test")
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 
 			dataPointValue1 := float64(11)
 			dataPointValue2 := float64(32)
@@ -411,7 +413,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m2 := ilm.Metrics().At(1).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -445,7 +447,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
 		// should ignore metric with different buckets from the past
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 1, n)
 
@@ -479,7 +481,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
 		// should ignore metric with different buckets from the past
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -513,7 +515,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m1 := ilm.Metrics().At(0).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m1.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 1, n)
 
@@ -547,7 +549,7 @@ func TestAccumulateDeltaToCumulativeHistogram(t *testing.T) {
 		m2 := ilm.Metrics().At(1).Histogram().DataPoints().At(0)
 		signature := timeseriesSignature(ilm.Scope().Name(), ilm.Metrics().At(0), m2.Attributes(), pcommon.NewMap())
 
-		a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+		a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 		n := a.Accumulate(resourceMetrics)
 		require.Equal(t, 2, n)
 
@@ -632,7 +634,7 @@ func TestAccumulateDroppedMetrics(t *testing.T) {
 			ilm.Scope().SetName("test")
 			tt.fillMetric(time.Now(), ilm.Metrics().AppendEmpty())
 
-			a := newAccumulator(zap.NewNop(), 1*time.Hour).(*lastValueAccumulator)
+			a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, false).(*lastValueAccumulator)
 			n := a.Accumulate(resourceMetrics)
 			require.Equal(t, 0, n)
 
@@ -702,3 +704,87 @@ func getMetricProperties(metric pmetric.Metric) (
 
 	return
 }
+
+func TestAccumulateExpirationPolicies(t *testing.T) {
+	resourceMetrics := pmetric.NewResourceMetrics()
+	ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+	ilm.Scope().SetName("test")
+	for _, name := range []string{"job_duration", "node_load"} {
+		metric := ilm.Metrics().AppendEmpty()
+		metric.SetName(name)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetDoubleValue(42)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	}
+
+	policies := []expirationPolicy{
+		{pattern: regexp.MustCompile("^(?:job_.*)$"), expiration: time.Minute},
+	}
+	a := newAccumulator(zap.NewNop(), 1*time.Hour, policies, true).(*lastValueAccumulator)
+	require.Equal(t, 2, a.Accumulate(resourceMetrics))
+
+	setUpdated(a, time.Now().Add(-2*time.Minute))
+	metrics, _ := a.Collect()
+	require.Len(t, metrics, 1)
+	require.Equal(t, "node_load", metrics[0].Name())
+
+	stale, _ := a.CollectStale()
+	require.Len(t, stale, 1)
+	require.Equal(t, "job_duration", stale[0].Name())
+	require.Equal(t, staleNaN, math.Float64bits(stale[0].Gauge().DataPoints().At(0).DoubleValue()))
+
+	// The staleness markers are reported to every scrape until they expire.
+	stale, _ = a.CollectStale()
+	require.Len(t, stale, 1)
+	a.staleMetrics.Range(func(_, value any) bool {
+		value.(*accumulatedValue).updated = time.Now().Add(-2 * time.Hour)
+		return true
+	})
+	stale, _ = a.CollectStale()
+	require.Empty(t, stale)
+}
+
+// setUpdated sets the time at which all the accumulated metrics were last updated.
+func setUpdated(a *lastValueAccumulator, updated time.Time) {
+	a.registeredMetrics.Range(func(_, value any) bool {
+		value.(*accumulatedValue).updated = updated
+		return true
+	})
+}
+
+func TestAccumulateNoRecordedValueStale(t *testing.T) {
+	newResourceMetrics := func(noRecordedValue bool) pmetric.ResourceMetrics {
+		resourceMetrics := pmetric.NewResourceMetrics()
+		ilm := resourceMetrics.ScopeMetrics().AppendEmpty()
+		ilm.Scope().SetName("test")
+		metric := ilm.Metrics().AppendEmpty()
+		metric.SetName("test_metric")
+		metric.SetEmptySum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		metric.Sum().SetIsMonotonic(true)
+		dp := metric.Sum().DataPoints().AppendEmpty()
+		dp.SetIntValue(42)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+		dp.Exemplars().AppendEmpty().SetIntValue(1)
+		dp.SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(noRecordedValue))
+		return resourceMetrics
+	}
+
+	a := newAccumulator(zap.NewNop(), 1*time.Hour, nil, true).(*lastValueAccumulator)
+	require.Equal(t, 1, a.Accumulate(newResourceMetrics(false)))
+	require.Equal(t, 0, a.Accumulate(newResourceMetrics(true)))
+
+	metrics, _ := a.Collect()
+	require.Empty(t, metrics)
+	stale, _ := a.CollectStale()
+	require.Len(t, stale, 1)
+	dp := stale[0].Sum().DataPoints().At(0)
+	require.Equal(t, staleNaN, math.Float64bits(dp.DoubleValue()))
+	require.Equal(t, 0, dp.Exemplars().Len())
+
+	// A series served again is not stale.
+	require.Equal(t, 1, a.Accumulate(newResourceMetrics(false)))
+	require.Equal(t, 0, a.Accumulate(newResourceMetrics(true)))
+	require.Equal(t, 1, a.Accumulate(newResourceMetrics(false)))
+	stale, _ = a.CollectStale()
+	require.Empty(t, stale)
+}
@@ -32,15 +32,19 @@ type collector struct {
 	constLabels       prometheus.Labels
 }
 
-func newCollector(config *Config, logger *zap.Logger) *collector {
+func newCollector(config *Config, logger *zap.Logger) (*collector, error) {
+	expirationPolicies, err := config.expirationPolicies()
+	if err != nil {
+		return nil, err
+	}
 	return &collector{
-		accumulator:       newAccumulator(logger, config.MetricExpiration),
+		accumulator:       newAccumulator(logger, config.MetricExpiration, expirationPolicies, config.StalenessMarkers),
 		logger:            logger,
 		namespace:         prometheustranslator.CleanUpString(config.Namespace),
 		sendTimestamps:    config.SendTimestamps,
 		constLabels:       config.ConstLabels,
 		addMetricSuffixes: config.AddMetricSuffixes,
-	}
+	}, nil
 }
 
 func convertExemplars(exemplars pmetric.ExemplarSlice) []prometheus.Exemplar {
@@ -387,3 +391,22 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 		c.logger.Debug(fmt.Sprintf("metric served: %s", m.Desc().String()))
 	}
 }
+
+// staleCollector serves the staleness markers of the series which stopped being served by the collector.
+type staleCollector struct {
+	*collector
+}
+
+func (c staleCollector) Collect(ch chan<- prometheus.Metric) {
+	inMetrics, resourceAttrs := c.accumulator.CollectStale()
+	for i := range inMetrics {
+		m, err := c.convertMetric(inMetrics[i], resourceAttrs[i])
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("failed to convert stale metric %s: %s", inMetrics[i].Name(), err.Error()))
+			continue
+		}
+
+		ch <- m
+		c.logger.Debug(fmt.Sprintf("staleness marker served: %s", m.Desc().String()))
+	}
+}
@@ -39,6 +39,10 @@ func (a *mockAccumulator) Collect() ([]pmetric.Metric, []pcommon.Map) {
 	return a.metrics, rAttrs
 }
 
+func (a *mockAccumulator) CollectStale() ([]pmetric.Metric, []pcommon.Map) {
+	return nil, nil
+}
+
 func TestConvertInvalidDataType(t *testing.T) {
 	metric := pmetric.NewMetric()
 	c := collector{
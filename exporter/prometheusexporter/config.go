@@ -4,6 +4,8 @@
 package prometheusexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusexporter"
 
 import (
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -29,6 +31,14 @@ type Config struct {
 	// MetricExpiration defines how long metrics are kept without updates
 	MetricExpiration time.Duration `mapstructure:"metric_expiration"`
 
+	// MetricExpirationPolicies override MetricExpiration for the metrics whose name matches them.
+	// The first matching policy applies.
+	MetricExpirationPolicies []MetricExpirationPolicy `mapstructure:"metric_expiration_policies"`
+
+	// StalenessMarkers enables serving staleness markers for the expired gauges and sums to the scrapers
+	// negotiating the protobuf format.
+	StalenessMarkers bool `mapstructure:"staleness_markers"`
+
 	// ResourceToTelemetrySettings defines configuration for converting resource attributes to metric labels.
 	ResourceToTelemetrySettings resourcetotelemetry.Settings `mapstructure:"resource_to_telemetry_conversion"`
 
@@ -39,9 +49,35 @@ type Config struct {
 	AddMetricSuffixes bool `mapstructure:"add_metric_suffixes"`
 }
 
+// MetricExpirationPolicy defines how long the metrics whose name matches a pattern are kept without updates.
+type MetricExpirationPolicy struct {
+	// MetricNamePattern is a regular expression matching the whole name of the metrics, before
+	// it is translated to a Prometheus name.
+	MetricNamePattern string `mapstructure:"metric_name_pattern"`
+
+	// Expiration defines how long the matching metrics are kept without updates.
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-	return nil
+	_, err := cfg.expirationPolicies()
+	return err
+}
+
+func (cfg *Config) expirationPolicies() ([]expirationPolicy, error) {
+	policies := make([]expirationPolicy, 0, len(cfg.MetricExpirationPolicies))
+	for i, policy := range cfg.MetricExpirationPolicies {
+		pattern, err := regexp.Compile("^(?:" + policy.MetricNamePattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("metric_expiration_policies[%d]: invalid metric_name_pattern: %w", i, err)
+		}
+		if policy.Expiration <= 0 {
+			return nil, fmt.Errorf("metric_expiration_policies[%d]: expiration must be positive", i)
+		}
+		policies = append(policies, expirationPolicy{pattern: pattern, expiration: policy.Expiration})
+	}
+	return policies, nil
 }
@@ -55,6 +55,22 @@ func TestLoadConfig(t *testing.T) {
 				AddMetricSuffixes: false,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "3"),
+			expected: &Config{
+				ServerConfig: confighttp.ServerConfig{
+					Endpoint: "localhost:8889",
+				},
+				ConstLabels:      map[string]string{},
+				MetricExpiration: 5 * time.Minute,
+				MetricExpirationPolicies: []MetricExpirationPolicy{
+					{MetricNamePattern: "job_.*", Expiration: 30 * time.Second},
+					{MetricNamePattern: `system\..*`, Expiration: time.Hour},
+				},
+				StalenessMarkers:  true,
+				AddMetricSuffixes: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -71,3 +87,42 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateExpirationPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []MetricExpirationPolicy
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			policies: []MetricExpirationPolicy{{MetricNamePattern: "job_.*", Expiration: time.Second}},
+		},
+		{
+			name:     "invalid pattern",
+			policies: []MetricExpirationPolicy{{MetricNamePattern: "job_(", Expiration: time.Second}},
+			wantErr:  "metric_expiration_policies[0]: invalid metric_name_pattern",
+		},
+		{
+			name: "invalid expiration",
+			policies: []MetricExpirationPolicy{
+				{MetricNamePattern: "job_.*", Expiration: time.Second},
+				{MetricNamePattern: "node_.*"},
+			},
+			wantErr: "metric_expiration_policies[1]: expiration must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.MetricExpirationPolicies = tt.policies
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
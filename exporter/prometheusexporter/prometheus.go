@@ -11,6 +11,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -35,9 +36,27 @@ func newPrometheusExporter(config *Config, set exporter.CreateSettings) (*promet
 		return nil, errBlankPrometheusAddress
 	}
 
-	collector := newCollector(config, set.Logger)
+	collector, err := newCollector(config, set.Logger)
+	if err != nil {
+		return nil, err
+	}
 	registry := prometheus.NewRegistry()
 	_ = registry.Register(collector)
+	opts := promhttp.HandlerOpts{
+		ErrorHandling:     promhttp.ContinueOnError,
+		ErrorLog:          newPromLogger(set.Logger),
+		EnableOpenMetrics: config.EnableOpenMetrics,
+	}
+	handler := promhttp.HandlerFor(registry, opts)
+	if config.StalenessMarkers {
+		// The text formats can't carry the staleness markers, which are told from other NaN values by their bits.
+		staleRegistry := prometheus.NewRegistry()
+		_ = staleRegistry.Register(staleCollector{collector})
+		handler = &stalenessHandler{
+			handler:         handler,
+			protobufHandler: promhttp.HandlerFor(prometheus.Gatherers{registry, staleRegistry}, opts),
+		}
+	}
 	return &prometheusExporter{
 		config:       *config,
 		name:         set.ID.String(),
@@ -45,18 +64,25 @@ func newPrometheusExporter(config *Config, set exporter.CreateSettings) (*promet
 		collector:    collector,
 		registry:     registry,
 		shutdownFunc: func() error { return nil },
-		handler: promhttp.HandlerFor(
-			registry,
-			promhttp.HandlerOpts{
-				ErrorHandling:     promhttp.ContinueOnError,
-				ErrorLog:          newPromLogger(set.Logger),
-				EnableOpenMetrics: config.EnableOpenMetrics,
-			},
-		),
-		settings: set.TelemetrySettings,
+		handler:      handler,
+		settings:     set.TelemetrySettings,
 	}, nil
 }
 
+// stalenessHandler serves the staleness markers to the scrapers negotiating the protobuf format.
+type stalenessHandler struct {
+	handler         http.Handler
+	protobufHandler http.Handler
+}
+
+func (h *stalenessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if expfmt.Negotiate(r.Header).FormatType() == expfmt.TypeProtoDelim {
+		h.protobufHandler.ServeHTTP(w, r)
+		return
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
 func (pe *prometheusExporter) Start(ctx context.Context, host component.Host) error {
 	ln, err := pe.config.ToListener(ctx)
 	if err != nil {
@@ -5,13 +5,18 @@ package prometheusexporter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -519,3 +524,72 @@ func metricBuilder(delta int64, prefix, job, instance string) pmetric.Metrics {
 
 	return md
 }
+
+func TestPrometheusExporter_stalenessMarkers(t *testing.T) {
+	cfg := &Config{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: "localhost:8999",
+		},
+		MetricExpiration: time.Hour,
+		MetricExpirationPolicies: []MetricExpirationPolicy{
+			{MetricNamePattern: "job_.*", Expiration: time.Minute},
+		},
+		StalenessMarkers:  true,
+		AddMetricSuffixes: true,
+	}
+	pe, err := newPrometheusExporter(cfg, exportertest.NewNopCreateSettings())
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"job_duration", "node_load"} {
+		metric := metrics.AppendEmpty()
+		metric.SetName(name)
+		dp := metric.SetEmptyGauge().DataPoints().AppendEmpty()
+		dp.SetDoubleValue(42)
+		dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	}
+	accumulator := pe.collector.accumulator.(*lastValueAccumulator)
+	require.NoError(t, pe.ConsumeMetrics(context.Background(), md))
+	setUpdated(accumulator, time.Now().Add(-2*time.Minute))
+
+	// The text format doesn't serve the staleness markers, and expires job_duration.
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	pe.handler.ServeHTTP(rec, req)
+	body := rec.Body.String()
+	assert.Contains(t, body, "node_load 42")
+	assert.NotContains(t, body, "job_duration")
+
+	require.NoError(t, pe.ConsumeMetrics(context.Background(), md))
+	setUpdated(accumulator, time.Now().Add(-2*time.Minute))
+	scrape := func() map[string]*dto.MetricFamily {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Accept", `application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited`)
+		rec := httptest.NewRecorder()
+		pe.handler.ServeHTTP(rec, req)
+
+		families := map[string]*dto.MetricFamily{}
+		decoder := expfmt.NewDecoder(rec.Body, expfmt.NewFormat(expfmt.TypeProtoDelim))
+		for {
+			family := &dto.MetricFamily{}
+			err := decoder.Decode(family)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			families[family.GetName()] = family
+		}
+		return families
+	}
+
+	// Every scraper sees the staleness markers, e.g. both Prometheus servers of an HA pair.
+	for i := 0; i < 2; i++ {
+		families := scrape()
+		require.Contains(t, families, "job_duration")
+		require.Len(t, families["job_duration"].GetMetric(), 1)
+		assert.Equal(t, staleNaN, math.Float64bits(families["job_duration"].GetMetric()[0].GetGauge().GetValue()))
+		require.Contains(t, families, "node_load")
+		assert.Equal(t, 42.0, families["node_load"].GetMetric()[0].GetGauge().GetValue())
+	}
+}
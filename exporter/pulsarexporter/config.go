@@ -4,6 +4,7 @@
 package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.uber.org/multierr"
 )
 
 // Config defines configuration for Pulsar exporter.
@@ -24,6 +26,16 @@ type Config struct {
 	Endpoint string `mapstructure:"endpoint"`
 	// The name of the pulsar topic to export to (default otlp_spans for traces, otlp_metrics for metrics)
 	Topic string `mapstructure:"topic"`
+	// TopicTemplates define the topics of each signal from the resource attributes, the resources
+	// missing an attribute of the template being exported to Topic.
+	TopicTemplates TopicTemplates `mapstructure:"topic_templates"`
+	// MaxTopicProducers bounds the number of producers kept open for the topics rendered from the
+	// topic templates, the least recently used ones being closed (default 100).
+	MaxTopicProducers int `mapstructure:"max_topic_producers"`
+	// PartitionByResourceAttributes splits the messages per resource, keyed by the hash of the resource attributes.
+	PartitionByResourceAttributes bool `mapstructure:"partition_by_resource_attributes"`
+	// Transaction configures the delivery of each batch in a Pulsar transaction.
+	Transaction Transaction `mapstructure:"transaction"`
 	// Encoding of messages (default "otlp_proto")
 	Encoding string `mapstructure:"encoding"`
 	// Producer configuration of the Pulsar producer
@@ -38,6 +50,23 @@ type Config struct {
 	MaxConnectionsPerBroker    int            `mapstructure:"map_connections_per_broker"`
 }
 
+// TopicTemplates define the topic templates of each signal, e.g. "otel-spans-{service.name}",
+// the {attribute} placeholders being replaced by the values of the resource attributes.
+type TopicTemplates struct {
+	Traces  string `mapstructure:"traces"`
+	Metrics string `mapstructure:"metrics"`
+	Logs    string `mapstructure:"logs"`
+}
+
+// Transaction defines the Pulsar transactions of the batches.
+type Transaction struct {
+	// Enabled delivers the messages of each batch atomically in a transaction. The transactions
+	// must be enabled on the Pulsar cluster.
+	Enabled bool `mapstructure:"enabled"`
+	// Timeout of the transactions (default 1m).
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 type Authentication struct {
 	TLS    *TLS    `mapstructure:"tls"`
 	Token  *Token  `mapstructure:"token"`
@@ -90,8 +119,27 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks if the exporter configuration is valid
 func (cfg *Config) Validate() error {
-
-	return nil
+	var errs error
+	templates := []struct{ signal, template string }{
+		{"traces", cfg.TopicTemplates.Traces},
+		{"metrics", cfg.TopicTemplates.Metrics},
+		{"logs", cfg.TopicTemplates.Logs},
+	}
+	for _, t := range templates {
+		if t.template == "" {
+			continue
+		}
+		if _, err := parseTopicTemplate(t.template); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("topic_templates::%s: %w", t.signal, err))
+		}
+	}
+	if cfg.MaxTopicProducers <= 0 {
+		errs = multierr.Append(errs, errors.New("max_topic_producers must be positive"))
+	}
+	if cfg.Transaction.Enabled && cfg.Transaction.Timeout <= 0 {
+		errs = multierr.Append(errs, errors.New("transaction::timeout must be positive"))
+	}
+	return errs
 }
 
 func (cfg *Config) auth() pulsar.Authentication {
@@ -130,6 +178,7 @@ func (cfg *Config) clientOptions() pulsar.ClientOptions {
 		ConnectionTimeout:       cfg.ConnectionTimeout,
 		OperationTimeout:        cfg.OperationTimeout,
 		MaxConnectionsPerBroker: cfg.MaxConnectionsPerBroker,
+		EnableTransaction:       cfg.Transaction.Enabled,
 	}
 
 	options.TLSAllowInsecureConnection = cfg.TLSAllowInsecureConnection
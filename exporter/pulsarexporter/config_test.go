@@ -57,6 +57,10 @@ func TestLoadConfig(t *testing.T) {
 				MaxConnectionsPerBroker: 1,
 				ConnectionTimeout:       5 * time.Second,
 				OperationTimeout:        30 * time.Second,
+				MaxTopicProducers:       defaultMaxTopicProducers,
+				Transaction: Transaction{
+					Timeout: time.Minute,
+				},
 				Producer: Producer{
 					MaxReconnectToBroker:            nil,
 					HashingScheme:                   "java_string_hash",
@@ -73,6 +77,32 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "templates"),
+			expected: &Config{
+				TimeoutSettings:         exporterhelper.NewDefaultTimeoutSettings(),
+				BackOffConfig:           configretry.NewDefaultBackOffConfig(),
+				QueueSettings:           exporterhelper.NewDefaultQueueSettings(),
+				Endpoint:                "pulsar://localhost:6650",
+				Encoding:                defaultEncoding,
+				MaxConnectionsPerBroker: 1,
+				ConnectionTimeout:       5 * time.Second,
+				OperationTimeout:        30 * time.Second,
+				TopicTemplates: TopicTemplates{
+					Traces:  "persistent://public/default/otel-spans-{service.name}",
+					Metrics: "persistent://public/default/otel-metrics-{service.namespace}-{service.name}",
+				},
+				MaxTopicProducers:             50,
+				PartitionByResourceAttributes: true,
+				Transaction: Transaction{
+					Enabled: true,
+					Timeout: 30 * time.Second,
+				},
+				Producer: Producer{
+					BatcherBuilderType: "key_based",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -112,3 +142,63 @@ func TestClientOptions(t *testing.T) {
 	}, &options)
 
 }
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name: "unmatched_brace",
+			modify: func(cfg *Config) {
+				cfg.TopicTemplates.Logs = "otel-logs-{service.name"
+			},
+			wantErr: `topic_templates::logs: topic template "otel-logs-{service.name" has an unmatched '{'`,
+		},
+		{
+			name: "empty_placeholder",
+			modify: func(cfg *Config) {
+				cfg.TopicTemplates.Traces = "otel-spans-{}"
+			},
+			wantErr: `topic_templates::traces: topic template "otel-spans-{}" has an empty placeholder`,
+		},
+		{
+			name: "no_placeholder",
+			modify: func(cfg *Config) {
+				cfg.TopicTemplates.Metrics = "otel-metrics"
+			},
+			wantErr: "topic_templates::metrics: topic template must reference at least a resource attribute",
+		},
+		{
+			name: "transaction_timeout",
+			modify: func(cfg *Config) {
+				cfg.Transaction.Enabled = true
+				cfg.Transaction.Timeout = 0
+			},
+			wantErr: "transaction::timeout must be positive",
+		},
+		{
+			name: "max_topic_producers",
+			modify: func(cfg *Config) {
+				cfg.MaxTopicProducers = 0
+			},
+			wantErr: "max_topic_producers must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, cfg.Validate())
+			} else {
+				assert.EqualError(t, cfg.Validate(), tt.wantErr)
+			}
+		})
+	}
+}
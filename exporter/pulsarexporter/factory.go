@@ -24,6 +24,8 @@ const (
 	defaultLogsTopic    = "otlp_logs"
 	defaultEncoding     = "otlp_proto"
 	defaultBroker       = "pulsar://localhost:6650"
+
+	defaultMaxTopicProducers = 100
 )
 
 // FactoryOption applies changes to pulsarExporterFactory.
@@ -70,6 +72,10 @@ func createDefaultConfig() component.Config {
 		MaxConnectionsPerBroker: 1,
 		ConnectionTimeout:       5 * time.Second,
 		OperationTimeout:        30 * time.Second,
+		MaxTopicProducers:       defaultMaxTopicProducers,
+		Transaction: Transaction{
+			Timeout: time.Minute,
+		},
 	}
 }
 
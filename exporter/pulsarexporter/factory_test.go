@@ -30,6 +30,10 @@ func Test_createDefaultConfig(t *testing.T) {
 		MaxConnectionsPerBroker: 1,
 		ConnectionTimeout:       5 * time.Second,
 		OperationTimeout:        30 * time.Second,
+		MaxTopicProducers:       defaultMaxTopicProducers,
+		Transaction: Transaction{
+			Timeout: time.Minute,
+		},
 	})
 }
 
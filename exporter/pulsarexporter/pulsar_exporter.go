@@ -4,164 +4,335 @@
 package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
 
 import (
+	"container/list"
 	"context"
+	"encoding/hex"
 	"fmt"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 )
 
 var errUnrecognizedEncoding = fmt.Errorf("unrecognized encoding")
 
-type PulsarTracesProducer struct {
-	cfg       Config
-	client    pulsar.Client
-	producer  pulsar.Producer
-	topic     string
-	marshaler TracesMarshaler
-	logger    *zap.Logger
+// pulsarProducer sends the messages of an exporter. The messages are sent to the configured
+// topic, or to the topics rendered from the topic template, a producer being created for each
+// topic on first use. At most max_topic_producers of these are kept open, the least recently used
+// ones being closed once no message is being sent with them.
+type pulsarProducer struct {
+	cfg      Config
+	client   pulsar.Client
+	producer pulsar.Producer
+	topic    string
+	template *topicTemplate
+	logger   *zap.Logger
+
+	mutex     sync.Mutex
+	producers map[string]*topicProducer
+	// recent orders the producers of the topics from the most to the least recently used.
+	recent *list.List
 }
 
-func (e *PulsarTracesProducer) tracesPusher(ctx context.Context, td ptrace.Traces) error {
-	messages, err := e.marshaler.Marshal(td, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+// topicProducer is the producer of a topic rendered from the topic template.
+type topicProducer struct {
+	topic    string
+	producer pulsar.Producer
+	// refs counts the sends using the producer, which isn't closed while they are running.
+	refs    int
+	element *list.Element
+}
+
+// resourceGroup holds the indexes of the resources sent to a topic with a message key.
+type resourceGroup struct {
+	topic   string
+	key     string
+	indexes []int
+}
+
+// topicMessage is a message sent to a topic.
+type topicMessage struct {
+	topic   string
+	message *pulsar.ProducerMessage
+}
+
+// groupResources groups the resources by topic, and by the hash of their attributes when the
+// messages are partitioned by resource. A single group with nil indexes is returned when the
+// data isn't split.
+func (p *pulsarProducer) groupResources(n int, resource func(i int) pcommon.Resource) []resourceGroup {
+	if p.template == nil && !p.cfg.PartitionByResourceAttributes {
+		return []resourceGroup{{topic: p.topic}}
 	}
+	var groups []resourceGroup
+	byTopicKey := map[[2]string]int{}
+	for i := 0; i < n; i++ {
+		attributes := resource(i).Attributes()
+		topic := p.topic
+		if p.template != nil {
+			if rendered, ok := p.template.render(attributes); ok {
+				topic = rendered
+			}
+		}
+		var key string
+		if p.cfg.PartitionByResourceAttributes {
+			hash := pdatautil.MapHash(attributes)
+			key = hex.EncodeToString(hash[:])
+		}
+		idx, ok := byTopicKey[[2]string{topic, key}]
+		if !ok {
+			idx = len(groups)
+			byTopicKey[[2]string{topic, key}] = idx
+			groups = append(groups, resourceGroup{topic: topic, key: key})
+		}
+		groups[idx].indexes = append(groups[idx].indexes, i)
+	}
+	return groups
+}
 
-	var errs error
-	for _, message := range messages {
+// topicMessages returns the messages of a group, keyed by the group key unless already keyed by the marshaler.
+func (g resourceGroup) topicMessages(messages []*pulsar.ProducerMessage) []topicMessage {
+	result := make([]topicMessage, len(messages))
+	for i, message := range messages {
+		if g.key != "" && message.Key == "" {
+			message.Key = g.key
+		}
+		result[i] = topicMessage{topic: g.topic, message: message}
+	}
+	return result
+}
+
+// send sends the messages and waits for their acknowledgement. With transactions enabled, the
+// messages are sent in a transaction, committed when they were all sent and aborted otherwise.
+func (p *pulsarProducer) send(ctx context.Context, messages []topicMessage) error {
+	var txn pulsar.Transaction
+	if p.cfg.Transaction.Enabled {
+		var err error
+		if txn, err = p.client.NewTransaction(p.cfg.Transaction.Timeout); err != nil {
+			return fmt.Errorf("failed to open a transaction: %w", err)
+		}
+	}
 
-		e.producer.SendAsync(ctx, message, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errs error
+	var releases []func()
+	defer func() {
+		for _, release := range releases {
+			release()
+		}
+	}()
+	for _, m := range messages {
+		producer, release, err := p.acquire(m.topic)
+		if err != nil {
+			mutex.Lock()
+			errs = multierr.Append(errs, err)
+			mutex.Unlock()
+			continue
+		}
+		releases = append(releases, release)
+		m.message.Transaction = txn
+		wg.Add(1)
+		producer.SendAsync(ctx, m.message, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
 			if err != nil {
+				mutex.Lock()
 				errs = multierr.Append(errs, err)
+				mutex.Unlock()
 			}
+			wg.Done()
 		})
-
 	}
+	wg.Wait()
 
-	return errs
-}
-
-func (e *PulsarTracesProducer) Close(context.Context) error {
-	if e.producer == nil {
-		return nil
+	if txn == nil {
+		return errs
+	}
+	if errs != nil {
+		if err := txn.Abort(ctx); err != nil {
+			p.logger.Warn("Failed to abort the transaction", zap.Error(err))
+		}
+		return errs
+	}
+	if err := txn.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit the transaction: %w", err)
 	}
-	e.producer.Close()
-	e.client.Close()
 	return nil
 }
 
-func (e *PulsarTracesProducer) start(_ context.Context, _ component.Host) error {
-	client, producer, err := newPulsarProducer(e.cfg)
+// acquire returns the producer of a topic, creating it if needed, and the function releasing it
+// once the messages were sent.
+func (p *pulsarProducer) acquire(topic string) (pulsar.Producer, func(), error) {
+	if topic == p.topic && p.producer != nil {
+		return p.producer, func() {}, nil
+	}
+	p.mutex.Lock()
+	tp, ok := p.producers[topic]
+	if ok {
+		tp.refs++
+		p.recent.MoveToFront(tp.element)
+		p.mutex.Unlock()
+		return tp.producer, func() { p.release(tp) }, nil
+	}
+	options := p.cfg.getProducerOptions()
+	options.Topic = topic
+	producer, err := p.client.CreateProducer(options)
 	if err != nil {
-		return err
+		p.mutex.Unlock()
+		return nil, nil, fmt.Errorf("failed to create the producer of the topic %q: %w", topic, err)
 	}
-	e.client = client
-	e.producer = producer
-	return nil
+	if p.producers == nil {
+		p.producers = map[string]*topicProducer{}
+		p.recent = list.New()
+	}
+	tp = &topicProducer{topic: topic, producer: producer, refs: 1}
+	tp.element = p.recent.PushFront(tp)
+	p.producers[topic] = tp
+	evicted := p.evictLocked()
+	p.mutex.Unlock()
+	closeProducers(evicted)
+	return producer, func() { p.release(tp) }, nil
 }
 
-type PulsarMetricsProducer struct {
-	cfg       Config
-	client    pulsar.Client
-	producer  pulsar.Producer
-	topic     string
-	marshaler MetricsMarshaler
-	logger    *zap.Logger
+// release releases a producer acquired for a send.
+func (p *pulsarProducer) release(tp *topicProducer) {
+	p.mutex.Lock()
+	tp.refs--
+	evicted := p.evictLocked()
+	p.mutex.Unlock()
+	closeProducers(evicted)
 }
 
-func (e *PulsarMetricsProducer) metricsDataPusher(ctx context.Context, md pmetric.Metrics) error {
-	messages, err := e.marshaler.Marshal(md, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+// evictLocked removes the least recently used producers not used by a send, until at most
+// max_topic_producers are left, and returns them to be closed outside the lock.
+func (p *pulsarProducer) evictLocked() []pulsar.Producer {
+	var evicted []pulsar.Producer
+	for e := p.recent.Back(); e != nil && len(p.producers) > p.cfg.MaxTopicProducers; {
+		tp := e.Value.(*topicProducer)
+		e = e.Prev()
+		if tp.refs > 0 {
+			continue
+		}
+		p.recent.Remove(tp.element)
+		delete(p.producers, tp.topic)
+		evicted = append(evicted, tp.producer)
 	}
+	return evicted
+}
 
-	var errs error
-	for _, message := range messages {
-
-		e.producer.SendAsync(ctx, message, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
-			if err != nil {
-				errs = multierr.Append(errs, err)
-			}
-		})
-
+func closeProducers(producers []pulsar.Producer) {
+	for _, producer := range producers {
+		producer.Close()
 	}
-
-	return errs
 }
 
-func (e *PulsarMetricsProducer) Close(context.Context) error {
-	if e.producer == nil {
-		return nil
+func (p *pulsarProducer) start(_ context.Context, _ component.Host) error {
+	client, producer, err := newPulsarProducer(p.cfg)
+	if err != nil {
+		return err
 	}
-	e.producer.Close()
-	e.client.Close()
+	p.client = client
+	p.producer = producer
 	return nil
 }
 
-func (e *PulsarMetricsProducer) start(_ context.Context, _ component.Host) error {
-	client, producer, err := newPulsarProducer(e.cfg)
-	if err != nil {
-		return err
+func (p *pulsarProducer) Close(context.Context) error {
+	if p.producer == nil {
+		return nil
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, tp := range p.producers {
+		tp.producer.Close()
 	}
-	e.client = client
-	e.producer = producer
+	p.producers = nil
+	p.recent = nil
+	p.producer.Close()
+	p.client.Close()
 	return nil
 }
 
-type PulsarLogsProducer struct {
-	cfg       Config
-	client    pulsar.Client
-	producer  pulsar.Producer
-	topic     string
-	marshaler LogsMarshaler
-	logger    *zap.Logger
+type PulsarTracesProducer struct {
+	pulsarProducer
+	marshaler TracesMarshaler
 }
 
-func (e *PulsarLogsProducer) logsDataPusher(ctx context.Context, ld plog.Logs) error {
-	messages, err := e.marshaler.Marshal(ld, e.topic)
-	if err != nil {
-		return consumererror.NewPermanent(err)
+func (e *PulsarTracesProducer) tracesPusher(ctx context.Context, td ptrace.Traces) error {
+	resourceSpans := td.ResourceSpans()
+	var messages []topicMessage
+	for _, g := range e.groupResources(resourceSpans.Len(), func(i int) pcommon.Resource { return resourceSpans.At(i).Resource() }) {
+		data := td
+		if g.indexes != nil {
+			data = ptrace.NewTraces()
+			for _, i := range g.indexes {
+				resourceSpans.At(i).CopyTo(data.ResourceSpans().AppendEmpty())
+			}
+		}
+		groupMessages, err := e.marshaler.Marshal(data, g.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		messages = append(messages, g.topicMessages(groupMessages)...)
 	}
+	return e.send(ctx, messages)
+}
 
-	var errs error
-	for _, message := range messages {
+type PulsarMetricsProducer struct {
+	pulsarProducer
+	marshaler MetricsMarshaler
+}
 
-		e.producer.SendAsync(ctx, message, func(_ pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
-			if err != nil {
-				errs = multierr.Append(errs, err)
+func (e *PulsarMetricsProducer) metricsDataPusher(ctx context.Context, md pmetric.Metrics) error {
+	resourceMetrics := md.ResourceMetrics()
+	var messages []topicMessage
+	for _, g := range e.groupResources(resourceMetrics.Len(), func(i int) pcommon.Resource { return resourceMetrics.At(i).Resource() }) {
+		data := md
+		if g.indexes != nil {
+			data = pmetric.NewMetrics()
+			for _, i := range g.indexes {
+				resourceMetrics.At(i).CopyTo(data.ResourceMetrics().AppendEmpty())
 			}
-		})
-
+		}
+		groupMessages, err := e.marshaler.Marshal(data, g.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		messages = append(messages, g.topicMessages(groupMessages)...)
 	}
-
-	return errs
+	return e.send(ctx, messages)
 }
 
-func (e *PulsarLogsProducer) Close(context.Context) error {
-	if e.producer == nil {
-		return nil
-	}
-	e.producer.Close()
-	e.client.Close()
-	return nil
+type PulsarLogsProducer struct {
+	pulsarProducer
+	marshaler LogsMarshaler
 }
 
-func (e *PulsarLogsProducer) start(_ context.Context, _ component.Host) error {
-	client, producer, err := newPulsarProducer(e.cfg)
-	if err != nil {
-		return err
+func (e *PulsarLogsProducer) logsDataPusher(ctx context.Context, ld plog.Logs) error {
+	resourceLogs := ld.ResourceLogs()
+	var messages []topicMessage
+	for _, g := range e.groupResources(resourceLogs.Len(), func(i int) pcommon.Resource { return resourceLogs.At(i).Resource() }) {
+		data := ld
+		if g.indexes != nil {
+			data = plog.NewLogs()
+			for _, i := range g.indexes {
+				resourceLogs.At(i).CopyTo(data.ResourceLogs().AppendEmpty())
+			}
+		}
+		groupMessages, err := e.marshaler.Marshal(data, g.topic)
+		if err != nil {
+			return consumererror.NewPermanent(err)
+		}
+		messages = append(messages, g.topicMessages(groupMessages)...)
 	}
-	e.client = client
-	e.producer = producer
-	return nil
+	return e.send(ctx, messages)
 }
 
 func newPulsarProducer(config Config) (pulsar.Client, pulsar.Producer, error) {
@@ -184,18 +355,26 @@ func newPulsarProducer(config Config) (pulsar.Client, pulsar.Producer, error) {
 	return client, producer, nil
 }
 
+// init configures the producer of an exporter, with the topic template of its signal.
+func (p *pulsarProducer) init(config Config, set exporter.CreateSettings, template string) {
+	p.cfg = config
+	p.topic = config.Topic
+	p.logger = set.Logger
+	if template != "" {
+		// the template was validated with the configuration
+		p.template, _ = parseTopicTemplate(template)
+	}
+}
+
 func newMetricsExporter(config Config, set exporter.CreateSettings, marshalers map[string]MetricsMarshaler) (*PulsarMetricsProducer, error) {
 	marshaler := marshalers[config.Encoding]
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
 
-	return &PulsarMetricsProducer{
-		cfg:       config,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
-	}, nil
+	e := &PulsarMetricsProducer{marshaler: marshaler}
+	e.init(config, set, config.TopicTemplates.Metrics)
+	return e, nil
 
 }
 
@@ -204,12 +383,9 @@ func newTracesExporter(config Config, set exporter.CreateSettings, marshalers ma
 	if marshaler == nil {
 		return nil, errUnrecognizedEncoding
 	}
-	return &PulsarTracesProducer{
-		cfg:       config,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
-	}, nil
+	e := &PulsarTracesProducer{marshaler: marshaler}
+	e.init(config, set, config.TopicTemplates.Traces)
+	return e, nil
 }
 
 func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[string]LogsMarshaler) (*PulsarLogsProducer, error) {
@@ -218,11 +394,8 @@ func newLogsExporter(config Config, set exporter.CreateSettings, marshalers map[
 		return nil, errUnrecognizedEncoding
 	}
 
-	return &PulsarLogsProducer{
-		cfg:       config,
-		topic:     config.Topic,
-		marshaler: marshaler,
-		logger:    set.Logger,
-	}, nil
+	e := &PulsarLogsProducer{marshaler: marshaler}
+	e.init(config, set, config.TopicTemplates.Logs)
+	return e, nil
 
 }
@@ -6,12 +6,16 @@ package pulsarexporter
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
@@ -48,15 +52,198 @@ func TestNewLogsExporter_err_traces_encoding(t *testing.T) {
 
 func Test_tracerPublisher(t *testing.T) {
 	mProducer := &mockProducer{name: "producer1", topic: "default"}
-	producer := PulsarTracesProducer{client: nil, producer: mProducer, marshaler: tracesMarshalers()["jaeger_proto"]}
+	producer := PulsarTracesProducer{pulsarProducer: pulsarProducer{producer: mProducer, topic: "default"}, marshaler: tracesMarshalers()["jaeger_proto"]}
 	err := producer.tracesPusher(context.Background(), testdata.GenerateTracesManySpansSameResource(10))
 
 	assert.NoError(t, err)
+	assert.Len(t, mProducer.messages, 1)
+}
+
+func Test_tracerPublisher_send_err(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "default", err: errors.New("producer closed")}
+	producer := PulsarTracesProducer{pulsarProducer: pulsarProducer{producer: mProducer, topic: "default"}, marshaler: tracesMarshalers()["otlp_proto"]}
+	err := producer.tracesPusher(context.Background(), testdata.GenerateTracesManySpansSameResource(10))
+
+	assert.EqualError(t, err, "producer closed")
+}
+
+func Test_tracerPublisher_partition_by_resource(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "default"}
+	producer := PulsarTracesProducer{
+		pulsarProducer: pulsarProducer{cfg: Config{PartitionByResourceAttributes: true}, producer: mProducer, topic: "default"},
+		marshaler:      tracesMarshalers()["otlp_proto"],
+	}
+	traces := ptrace.NewTraces()
+	for _, service := range []string{"frontend", "backend", "frontend"} {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", service)
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	}
+	require.NoError(t, producer.tracesPusher(context.Background(), traces))
+
+	require.Len(t, mProducer.messages, 2)
+	frontend, backend := mProducer.messages[0], mProducer.messages[1]
+	assert.NotEmpty(t, frontend.Key)
+	assert.NotEmpty(t, backend.Key)
+	assert.NotEqual(t, frontend.Key, backend.Key)
+
+	unmarshaled, err := (&ptrace.ProtoUnmarshaler{}).UnmarshalTraces(frontend.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, 2, unmarshaled.ResourceSpans().Len())
+}
+
+func Test_metricsPublisher_topic_templates(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "otlp_metrics"}
+	client := &mockClient{producers: map[string]*mockProducer{}}
+	producer := PulsarMetricsProducer{
+		pulsarProducer: pulsarProducer{
+			cfg:      Config{MaxTopicProducers: defaultMaxTopicProducers},
+			client:   client,
+			producer: mProducer,
+			topic:    "otlp_metrics",
+		},
+		marshaler: metricsMarshalers()["otlp_proto"],
+	}
+	producer.template, _ = parseTopicTemplate("otel-metrics-{service.name}")
+
+	metrics := pmetric.NewMetrics()
+	for _, service := range []string{"checkout/v2", "", "cart"} {
+		rm := metrics.ResourceMetrics().AppendEmpty()
+		if service != "" {
+			rm.Resource().Attributes().PutStr("service.name", service)
+		}
+		rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+	}
+	require.NoError(t, producer.metricsDataPusher(context.Background(), metrics))
+
+	assert.Len(t, mProducer.messages, 1)
+	require.Contains(t, client.producers, "otel-metrics-checkout_v2")
+	require.Contains(t, client.producers, "otel-metrics-cart")
+	assert.Len(t, client.producers["otel-metrics-checkout_v2"].messages, 1)
+	assert.Len(t, client.producers["otel-metrics-cart"].messages, 1)
+
+	// the producers are reused
+	require.NoError(t, producer.metricsDataPusher(context.Background(), metrics))
+	assert.Len(t, client.producers, 2)
+	assert.Len(t, client.producers["otel-metrics-cart"].messages, 2)
+
+	require.NoError(t, producer.Close(context.Background()))
+	assert.True(t, client.producers["otel-metrics-cart"].closed)
+	assert.True(t, mProducer.closed)
+	assert.True(t, client.closed)
+}
+
+func Test_metricsPublisher_topic_producers_evicted(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "otlp_metrics"}
+	client := &mockClient{producers: map[string]*mockProducer{}}
+	producer := PulsarMetricsProducer{
+		pulsarProducer: pulsarProducer{
+			cfg:      Config{MaxTopicProducers: 1},
+			client:   client,
+			producer: mProducer,
+			topic:    "otlp_metrics",
+		},
+		marshaler: metricsMarshalers()["otlp_proto"],
+	}
+	producer.template, _ = parseTopicTemplate("otel-metrics-{service.name}")
+
+	push := func(services ...string) {
+		metrics := pmetric.NewMetrics()
+		for _, service := range services {
+			rm := metrics.ResourceMetrics().AppendEmpty()
+			rm.Resource().Attributes().PutStr("service.name", service)
+			rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetName("metric")
+		}
+		require.NoError(t, producer.metricsDataPusher(context.Background(), metrics))
+	}
+
+	// the producers used by a send are closed once it completed
+	push("checkout", "cart")
+	assert.Len(t, client.producers["otel-metrics-checkout"].messages, 1)
+	assert.Len(t, client.producers["otel-metrics-cart"].messages, 1)
+	assert.Len(t, producer.producers, 1)
+	assert.True(t, client.producers["otel-metrics-checkout"].closed)
+	assert.False(t, client.producers["otel-metrics-cart"].closed)
+
+	push("cart")
+	assert.Len(t, client.producers["otel-metrics-cart"].messages, 2)
+
+	// the least recently used producer is closed
+	push("payment")
+	assert.True(t, client.producers["otel-metrics-cart"].closed)
+	assert.False(t, client.producers["otel-metrics-payment"].closed)
+	assert.Contains(t, producer.producers, "otel-metrics-payment")
+
+	require.NoError(t, producer.Close(context.Background()))
+	assert.True(t, client.producers["otel-metrics-payment"].closed)
+}
+
+func Test_logsPublisher_transaction(t *testing.T) {
+	tests := []struct {
+		name        string
+		producerErr error
+		committed   bool
+		aborted     bool
+	}{
+		{
+			name:      "committed",
+			committed: true,
+		},
+		{
+			name:        "aborted",
+			producerErr: errors.New("producer closed"),
+			aborted:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mProducer := &mockProducer{name: "producer1", topic: "otlp_logs", err: tt.producerErr}
+			client := &mockClient{}
+			producer := PulsarLogsProducer{
+				pulsarProducer: pulsarProducer{
+					cfg:      Config{Transaction: Transaction{Enabled: true, Timeout: time.Minute}},
+					client:   client,
+					producer: mProducer,
+					topic:    "otlp_logs",
+				},
+				marshaler: logsMarshalers()["otlp_proto"],
+			}
+			err := producer.logsDataPusher(context.Background(), testdata.GenerateLogsManyLogRecordsSameResource(10))
+			if tt.producerErr != nil {
+				assert.ErrorIs(t, err, tt.producerErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			require.NotNil(t, client.transaction)
+			require.Len(t, mProducer.messages, 1)
+			assert.Equal(t, client.transaction, mProducer.messages[0].Transaction)
+			assert.Equal(t, tt.committed, client.transaction.committed)
+			assert.Equal(t, tt.aborted, client.transaction.aborted)
+		})
+	}
+}
+
+func Test_logsPublisher_transaction_err(t *testing.T) {
+	mProducer := &mockProducer{name: "producer1", topic: "otlp_logs"}
+	producer := PulsarLogsProducer{
+		pulsarProducer: pulsarProducer{
+			cfg:      Config{Transaction: Transaction{Enabled: true, Timeout: time.Minute}},
+			client:   &mockClient{transactionErr: errors.New("transactions disabled")},
+			producer: mProducer,
+			topic:    "otlp_logs",
+		},
+		marshaler: logsMarshalers()["otlp_proto"],
+	}
+	err := producer.logsDataPusher(context.Background(), testdata.GenerateLogsManyLogRecordsSameResource(10))
+
+	assert.EqualError(t, err, "failed to open a transaction: transactions disabled")
+	assert.Empty(t, mProducer.messages)
 }
 
 func Test_tracerPublisher_marshaler_err(t *testing.T) {
 	mProducer := &mockProducer{name: "producer1", topic: "default"}
-	producer := PulsarTracesProducer{client: nil, producer: mProducer, marshaler: &customTraceMarshaler{encoding: "unknown"}}
+	producer := PulsarTracesProducer{pulsarProducer: pulsarProducer{producer: mProducer, topic: "default"}, marshaler: &customTraceMarshaler{encoding: "unknown"}}
 	err := producer.tracesPusher(context.Background(), testdata.GenerateTracesManySpansSameResource(10))
 
 	assert.Error(t, err)
@@ -78,6 +265,12 @@ func (c *customTraceMarshaler) Encoding() string {
 type mockProducer struct {
 	topic string
 	name  string
+	// err fails the sent messages
+	err error
+
+	mutex    sync.Mutex
+	messages []*pulsar.ProducerMessage
+	closed   bool
 }
 
 func (c *mockProducer) Topic() string {
@@ -92,8 +285,11 @@ func (c *mockProducer) Send(context.Context, *pulsar.ProducerMessage) (pulsar.Me
 	return nil, nil
 }
 
-func (c *mockProducer) SendAsync(context.Context, *pulsar.ProducerMessage, func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
-
+func (c *mockProducer) SendAsync(_ context.Context, message *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+	c.mutex.Lock()
+	c.messages = append(c.messages, message)
+	c.mutex.Unlock()
+	go callback(nil, message, c.err)
 }
 
 func (c *mockProducer) LastSequenceID() int64 {
@@ -104,5 +300,52 @@ func (c *mockProducer) Flush() error {
 	return nil
 }
 
+func (c *mockProducer) FlushWithCtx(context.Context) error {
+	return nil
+}
+
 func (c *mockProducer) Close() {
+	c.closed = true
+}
+
+type mockClient struct {
+	pulsar.Client
+	producers      map[string]*mockProducer
+	transaction    *mockTransaction
+	transactionErr error
+	closed         bool
+}
+
+func (c *mockClient) CreateProducer(options pulsar.ProducerOptions) (pulsar.Producer, error) {
+	producer := &mockProducer{name: options.Topic, topic: options.Topic}
+	c.producers[options.Topic] = producer
+	return producer, nil
+}
+
+func (c *mockClient) NewTransaction(time.Duration) (pulsar.Transaction, error) {
+	if c.transactionErr != nil {
+		return nil, c.transactionErr
+	}
+	c.transaction = &mockTransaction{}
+	return c.transaction, nil
+}
+
+func (c *mockClient) Close() {
+	c.closed = true
+}
+
+type mockTransaction struct {
+	pulsar.Transaction
+	committed bool
+	aborted   bool
+}
+
+func (t *mockTransaction) Commit(context.Context) error {
+	t.committed = true
+	return nil
+}
+
+func (t *mockTransaction) Abort(context.Context) error {
+	t.aborted = true
+	return nil
 }
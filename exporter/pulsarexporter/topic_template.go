@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pulsarexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/pulsarexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// topicTemplate is a topic name referencing resource attributes with {attribute} placeholders,
+// e.g. "persistent://public/default/otel-{service.name}".
+type topicTemplate struct {
+	// literals holds the text around the placeholders, one more than the attributes.
+	literals   []string
+	attributes []string
+}
+
+func parseTopicTemplate(template string) (*topicTemplate, error) {
+	t := &topicTemplate{}
+	rest := template
+	for {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			if strings.IndexByte(rest, '}') >= 0 {
+				return nil, fmt.Errorf("topic template %q has an unmatched '}'", template)
+			}
+			t.literals = append(t.literals, rest)
+			break
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("topic template %q has an unmatched '{'", template)
+		}
+		if strings.IndexByte(rest[start+1:start+end], '{') >= 0 {
+			return nil, fmt.Errorf("topic template %q has an unmatched '{'", template)
+		}
+		if strings.IndexByte(rest[:start], '}') >= 0 {
+			return nil, fmt.Errorf("topic template %q has an unmatched '}'", template)
+		}
+		attribute := rest[start+1 : start+end]
+		if strings.TrimSpace(attribute) == "" {
+			return nil, fmt.Errorf("topic template %q has an empty placeholder", template)
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.attributes = append(t.attributes, attribute)
+		rest = rest[start+end+1:]
+	}
+	if len(t.attributes) == 0 {
+		return nil, errors.New("topic template must reference at least a resource attribute")
+	}
+	return t, nil
+}
+
+// render returns the topic of a resource, false if the resource misses an attribute
+// of the template. The characters of the attribute values which aren't allowed in
+// a topic name are replaced by '_'.
+func (t *topicTemplate) render(attributes pcommon.Map) (string, bool) {
+	var b strings.Builder
+	for i, attribute := range t.attributes {
+		b.WriteString(t.literals[i])
+		v, ok := attributes.Get(attribute)
+		if !ok || v.AsString() == "" {
+			return "", false
+		}
+		b.WriteString(strings.Map(sanitizeTopicRune, v.AsString()))
+	}
+	b.WriteString(t.literals[len(t.literals)-1])
+	return b.String(), true
+}
+
+func sanitizeTopicRune(r rune) rune {
+	if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("-_=:.", r) {
+		return r
+	}
+	return '_'
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pulsarexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestParseTopicTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  string
+	}{
+		{template: "{service.name}"},
+		{template: "persistent://public/default/otel-{service.namespace}-{service.name}"},
+		{template: "otel-{service.name", wantErr: `topic template "otel-{service.name" has an unmatched '{'`},
+		{template: "otel-{{service.name}", wantErr: `topic template "otel-{{service.name}" has an unmatched '{'`},
+		{template: "otel-service.name}", wantErr: `topic template "otel-service.name}" has an unmatched '}'`},
+		{template: "otel-}{service.name}", wantErr: `topic template "otel-}{service.name}" has an unmatched '}'`},
+		{template: "otel-{ }", wantErr: `topic template "otel-{ }" has an empty placeholder`},
+		{template: "otel", wantErr: "topic template must reference at least a resource attribute"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			_, err := parseTopicTemplate(tt.template)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTopicTemplateRender(t *testing.T) {
+	template, err := parseTopicTemplate("persistent://public/default/otel-{service.namespace}-{service.name}")
+	require.NoError(t, err)
+
+	attributes := pcommon.NewMap()
+	attributes.PutStr("service.namespace", "shop")
+	attributes.PutStr("service.name", "checkout service/v2")
+	topic, ok := template.render(attributes)
+	assert.True(t, ok)
+	assert.Equal(t, "persistent://public/default/otel-shop-checkout_service_v2", topic)
+
+	attributes.PutInt("service.namespace", 42)
+	topic, ok = template.render(attributes)
+	assert.True(t, ok)
+	assert.Equal(t, "persistent://public/default/otel-42-checkout_service_v2", topic)
+
+	attributes.PutStr("service.namespace", "")
+	_, ok = template.render(attributes)
+	assert.False(t, ok)
+
+	attributes.Remove("service.namespace")
+	_, ok = template.render(attributes)
+	assert.False(t, ok)
+}
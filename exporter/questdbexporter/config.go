@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+)
+
+const (
+	// metricsModeTablePerMetric writes each metric to its own table.
+	metricsModeTablePerMetric = "table_per_metric"
+	// metricsModeWide writes all the metrics to a single table, with a column per metric.
+	metricsModeWide = "wide"
+)
+
+// Config defines configuration for the QuestDB exporter.
+type Config struct {
+	// Specifies the address of the ILP/TCP listener of QuestDB. The default value is "localhost:9009".
+	confignet.TCPAddrConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// Timeout is the maximum duration allowed to connecting and sending the
+	// data to QuestDB. The default value is 5s.
+	exporterhelper.TimeoutSettings `mapstructure:",squash"`     // squash ensures fields are correctly decoded in embedded struct.
+	QueueConfig                    exporterhelper.QueueSettings `mapstructure:"sending_queue"`
+	RetryConfig                    configretry.BackOffConfig    `mapstructure:"retry_on_failure"`
+
+	// MetricsMode is the layout of the metrics tables, either "table_per_metric" where each
+	// metric is written to its own table, or "wide" where all the metrics are written to a
+	// single table with a column per metric. The default value is "table_per_metric".
+	MetricsMode string `mapstructure:"metrics_mode"`
+
+	// TablePrefix is prepended to the name of the metric tables in the "table_per_metric" mode.
+	TablePrefix string `mapstructure:"table_prefix"`
+
+	// MetricsTable is the name of the table the metrics are written to in the "wide" mode.
+	// The default value is "metrics".
+	MetricsTable string `mapstructure:"metrics_table"`
+
+	// LogsTable is the name of the table the log records are written to. The default value is "logs".
+	LogsTable string `mapstructure:"logs_table"`
+}
+
+func (cfg *Config) Validate() error {
+	// Resolve TCP address just to ensure that it is a valid one. It is better
+	// to fail here than at when the exporter is started.
+	if _, err := net.ResolveTCPAddr("tcp", cfg.Endpoint); err != nil {
+		return fmt.Errorf("exporter has an invalid TCP endpoint: %w", err)
+	}
+
+	// Negative timeouts are not acceptable, since all sends will fail.
+	if cfg.Timeout < 0 {
+		return errors.New("'timeout' must be non-negative")
+	}
+
+	switch cfg.MetricsMode {
+	case metricsModeTablePerMetric:
+	case metricsModeWide:
+		if cfg.MetricsTable == "" {
+			return errors.New("'metrics_table' must be specified in the wide mode")
+		}
+	default:
+		return fmt.Errorf("'metrics_mode' must be %q or %q", metricsModeTablePerMetric, metricsModeWide)
+	}
+
+	if cfg.LogsTable == "" {
+		return errors.New("'logs_table' must be specified")
+	}
+
+	return nil
+}
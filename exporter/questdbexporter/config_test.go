@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: createDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "allsettings"),
+			expected: &Config{
+				TCPAddrConfig: confignet.TCPAddrConfig{
+					Endpoint: "localhost:9019",
+				},
+				TimeoutSettings: exporterhelper.TimeoutSettings{
+					Timeout: 10 * time.Second,
+				},
+				RetryConfig: configretry.BackOffConfig{
+					Enabled:             true,
+					InitialInterval:     10 * time.Second,
+					RandomizationFactor: 0.7,
+					Multiplier:          3.14,
+					MaxInterval:         1 * time.Minute,
+					MaxElapsedTime:      10 * time.Minute,
+				},
+				QueueConfig: exporterhelper.QueueSettings{
+					Enabled:      true,
+					NumConsumers: 2,
+					QueueSize:    10,
+				},
+				MetricsMode:  metricsModeWide,
+				MetricsTable: "otel_metrics",
+				LogsTable:    "otel_logs",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name:   "default_config",
+			modify: func(*Config) {},
+		},
+		{
+			name: "invalid_tcp_addr",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "http://localhost:9009"
+			},
+			wantErr: "exporter has an invalid TCP endpoint",
+		},
+		{
+			name: "invalid_timeout",
+			modify: func(cfg *Config) {
+				cfg.Timeout = -5 * time.Second
+			},
+			wantErr: "'timeout' must be non-negative",
+		},
+		{
+			name: "invalid_metrics_mode",
+			modify: func(cfg *Config) {
+				cfg.MetricsMode = "narrow"
+			},
+			wantErr: `'metrics_mode' must be "table_per_metric" or "wide"`,
+		},
+		{
+			name: "missing_metrics_table",
+			modify: func(cfg *Config) {
+				cfg.MetricsMode = metricsModeWide
+				cfg.MetricsTable = ""
+			},
+			wantErr: "'metrics_table' must be specified in the wide mode",
+		},
+		{
+			name: "missing_logs_table",
+			modify: func(cfg *Config) {
+				cfg.LogsTable = ""
+			},
+			wantErr: "'logs_table' must be specified",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			if tt.wantErr == "" {
+				assert.NoError(t, cfg.Validate())
+			} else {
+				assert.ErrorContains(t, cfg.Validate(), tt.wantErr)
+			}
+		})
+	}
+}
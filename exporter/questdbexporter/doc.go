@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package questdbexporter writes metrics and logs to QuestDB over ILP/TCP.
+package questdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter"
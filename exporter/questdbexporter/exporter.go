@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter"
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+)
+
+// questdbExporter writes the telemetry as ILP lines to a single TCP connection, which
+// is opened on the first write and opened again after a failed write.
+//
+// QuestDB doesn't acknowledge the ILP/TCP writes, and closes the connection when it
+// receives an invalid line, so that a batch is only known to be lost by the following
+// write on the connection.
+type questdbExporter struct {
+	config *Config
+	logger *zap.Logger
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newQuestDBExporter(config *Config, logger *zap.Logger) *questdbExporter {
+	return &questdbExporter{
+		config: config,
+		logger: logger,
+	}
+}
+
+func (e *questdbExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	payload, dropped := metricsToILP(md, e.config)
+	if dropped > 0 {
+		e.logger.Debug("Dropped the data points which can't be written as ILP", zap.Int("dropped", dropped))
+	}
+	return e.write(ctx, payload)
+}
+
+func (e *questdbExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	payload, dropped := logsToILP(ld, e.config)
+	if dropped > 0 {
+		e.logger.Debug("Dropped the log records which can't be written as ILP", zap.Int("dropped", dropped))
+	}
+	return e.write(ctx, payload)
+}
+
+func (e *questdbExporter) write(ctx context.Context, payload []byte) error {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn == nil {
+		dialCtx := ctx
+		if e.config.Timeout > 0 {
+			var cancel context.CancelFunc
+			dialCtx, cancel = context.WithTimeout(ctx, e.config.Timeout)
+			defer cancel()
+		}
+		conn, err := e.config.TCPAddrConfig.Dial(dialCtx)
+		if err != nil {
+			return err
+		}
+		e.conn = conn
+	}
+
+	if e.config.Timeout > 0 {
+		if err := e.conn.SetWriteDeadline(time.Now().Add(e.config.Timeout)); err != nil {
+			return errors.Join(err, e.closeConn())
+		}
+	}
+	if _, err := e.conn.Write(payload); err != nil {
+		// The connection is dropped, as a part of the payload might have been written.
+		return errors.Join(err, e.closeConn())
+	}
+	return nil
+}
+
+func (e *questdbExporter) closeConn() error {
+	conn := e.conn
+	e.conn = nil
+	return conn.Close()
+}
+
+func (e *questdbExporter) shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn == nil {
+		return nil
+	}
+	return e.closeConn()
+}
@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+// newILPListener accepts ILP/TCP connections, and sends the received lines to the returned channel.
+func newILPListener(t *testing.T) (net.Listener, chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	lines := make(chan string, 100)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					lines <- scanner.Text()
+				}
+			}()
+		}
+	}()
+	return ln, lines
+}
+
+func receiveLine(t *testing.T, lines chan string) string {
+	select {
+	case line := <-lines:
+		return line
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no line received")
+		return ""
+	}
+}
+
+func TestExporterWritesMetrics(t *testing.T) {
+	ln, lines := newILPListener(t)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Endpoint = ln.Addr().String()
+	cfg.QueueConfig.Enabled = false
+	exp, err := factory.CreateMetricsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+
+	require.NoError(t, exp.ConsumeMetrics(context.Background(), generateMetrics()))
+	assert.Equal(t, "system_cpu_utilization,host_name=host-1,state=idle value=0.5 1700000000000000000", receiveLine(t, lines))
+	assert.Equal(t, "http_requests,host_name=host-1,state=idle value=42 1700000000000000000", receiveLine(t, lines))
+	assert.Equal(t, "http_latency,host_name=host-1 count=3i,sum=1.5,le_0_5=2i,le_inf=3i 1700000000000000000", receiveLine(t, lines))
+	require.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestExporterReconnects(t *testing.T) {
+	ln, lines := newILPListener(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ln.Addr().String()
+	exp := newQuestDBExporter(cfg, zap.NewNop())
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("first")
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	assert.Equal(t, `logs severity_number=0i,body="first"`, receiveLine(t, lines))
+
+	// a failed write drops the connection, the next write opening a new one
+	require.NoError(t, exp.conn.Close())
+	ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetStr("second")
+	assert.Error(t, exp.pushLogs(context.Background(), ld))
+	assert.Nil(t, exp.conn)
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	assert.Equal(t, `logs severity_number=0i,body="second"`, receiveLine(t, lines))
+
+	require.NoError(t, exp.shutdown(context.Background()))
+}
+
+func TestExporterConnectionRefused(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	exp := newQuestDBExporter(cfg, zap.NewNop())
+	assert.Error(t, exp.pushMetrics(context.Background(), generateMetrics()))
+	require.NoError(t, exp.shutdown(context.Background()))
+}
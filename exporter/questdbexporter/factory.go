@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter/internal/metadata"
+)
+
+// Defaults for not specified configuration settings.
+const (
+	defaultEndpoint     = "localhost:9009"
+	defaultMetricsTable = "metrics"
+	defaultLogsTable    = "logs"
+)
+
+// NewFactory creates a factory for the QuestDB exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		TCPAddrConfig: confignet.TCPAddrConfig{
+			Endpoint: defaultEndpoint,
+		},
+		TimeoutSettings: exporterhelper.NewDefaultTimeoutSettings(),
+		QueueConfig:     exporterhelper.NewDefaultQueueSettings(),
+		RetryConfig:     configretry.NewDefaultBackOffConfig(),
+		MetricsMode:     metricsModeTablePerMetric,
+		MetricsTable:    defaultMetricsTable,
+		LogsTable:       defaultLogsTable,
+	}
+}
+
+func createMetricsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	config component.Config,
+) (exporter.Metrics, error) {
+	cfg := config.(*Config)
+	exp := newQuestDBExporter(cfg, set.Logger)
+
+	return exporterhelper.NewMetricsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.pushMetrics,
+		// We don't use exporterhelper.WithTimeout because the TCP connection does not accept writing with context.
+		exporterhelper.WithQueue(cfg.QueueConfig),
+		exporterhelper.WithRetry(cfg.RetryConfig),
+		exporterhelper.WithShutdown(exp.shutdown))
+}
+
+func createLogsExporter(
+	ctx context.Context,
+	set exporter.CreateSettings,
+	config component.Config,
+) (exporter.Logs, error) {
+	cfg := config.(*Config)
+	exp := newQuestDBExporter(cfg, set.Logger)
+
+	return exporterhelper.NewLogsExporter(
+		ctx,
+		set,
+		cfg,
+		exp.pushLogs,
+		exporterhelper.WithQueue(cfg.QueueConfig),
+		exporterhelper.WithRetry(cfg.RetryConfig),
+		exporterhelper.WithShutdown(exp.shutdown))
+}
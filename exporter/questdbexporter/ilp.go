@@ -0,0 +1,394 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/questdbexporter"
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/influxdata/line-protocol/v2/lineprotocol"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// The values of the number data points are always written as doubles, so that the
+// type of the column created by QuestDB doesn't depend on the first point received.
+const (
+	valueColumn     = "value"
+	countColumn     = "count"
+	sumColumn       = "sum"
+	minColumn       = "min"
+	maxColumn       = "max"
+	scaleColumn     = "scale"
+	zeroCountColumn = "zero_count"
+	bucketPrefix    = "le_"
+	quantilePrefix  = "quantile_"
+	infinityBound   = "inf"
+
+	severityTextSymbol = "severity_text"
+
+	severityNumberColumn = "severity_number"
+	bodyColumn           = "body"
+	traceIDColumn        = "trace_id"
+	spanIDColumn         = "span_id"
+)
+
+type column struct {
+	name  string
+	value lineprotocol.Value
+}
+
+// row is a single ILP line. The symbols are the indexed columns of QuestDB, written
+// as the tags of the line, and the columns are written as its fields.
+type row struct {
+	table   string
+	symbols map[string]string
+	columns []column
+	indices map[string]int
+	ts      time.Time
+}
+
+func newRow(table string, symbols map[string]string, ts pcommon.Timestamp) *row {
+	r := &row{
+		table:   table,
+		symbols: symbols,
+		indices: map[string]int{},
+	}
+	if ts != 0 {
+		r.ts = ts.AsTime()
+	}
+	return r
+}
+
+// set adds the column to the row, replacing the column of the same name. Values which
+// can't be written as ILP, such as NaN, are skipped.
+func (r *row) set(name string, value any) {
+	v, ok := lineprotocol.NewValue(value)
+	if !ok {
+		return
+	}
+	if i, found := r.indices[name]; found {
+		r.columns[i].value = v
+		return
+	}
+	r.indices[name] = len(r.columns)
+	r.columns = append(r.columns, column{name: name, value: v})
+}
+
+// encode writes the row to the encoder, and returns false if it is invalid.
+func (r *row) encode(enc *lineprotocol.Encoder) bool {
+	if len(r.columns) == 0 {
+		return false
+	}
+	keys := make([]string, 0, len(r.symbols))
+	for k, v := range r.symbols {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	enc.StartLine(r.table)
+	for _, k := range keys {
+		enc.AddTag(k, r.symbols[k])
+	}
+	for _, c := range r.columns {
+		enc.AddField(c.name, c.value)
+	}
+	enc.EndLine(r.ts)
+	if enc.Err() != nil {
+		enc.ClearErr()
+		return false
+	}
+	return true
+}
+
+func newEncoder() *lineprotocol.Encoder {
+	enc := &lineprotocol.Encoder{}
+	enc.SetPrecision(lineprotocol.Nanosecond)
+	return enc
+}
+
+// metricsToILP converts the metrics to ILP lines, and returns the number of data
+// points which couldn't be converted.
+func metricsToILP(md pmetric.Metrics, config *Config) ([]byte, int) {
+	enc := newEncoder()
+	dropped := 0
+	encodeRows := func(rows []*row) {
+		for _, r := range rows {
+			if !r.encode(enc) {
+				dropped++
+			}
+		}
+	}
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceSymbols := attributesToSymbols(rm.Resource().Attributes(), nil)
+		var wide *wideRows
+		if config.MetricsMode == metricsModeWide {
+			wide = newWideRows(sanitizeName(config.MetricsTable))
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				if metric.Name() == "" {
+					dropped += metricDataPointCount(metric)
+					continue
+				}
+				if wide != nil {
+					forEachDataPoint(metric, func(attributes pcommon.Map, ts pcommon.Timestamp, setColumns func(set func(string, any))) {
+						r := wide.get(attributesToSymbols(attributes, resourceSymbols), ts)
+						name := sanitizeName(metric.Name())
+						setColumns(func(column string, value any) {
+							if column == valueColumn {
+								r.set(name, value)
+							} else {
+								r.set(name+"_"+column, value)
+							}
+						})
+					})
+					continue
+				}
+
+				table := sanitizeName(config.TablePrefix + metric.Name())
+				var rows []*row
+				forEachDataPoint(metric, func(attributes pcommon.Map, ts pcommon.Timestamp, setColumns func(set func(string, any))) {
+					r := newRow(table, attributesToSymbols(attributes, resourceSymbols), ts)
+					setColumns(r.set)
+					rows = append(rows, r)
+				})
+				encodeRows(rows)
+			}
+		}
+		if wide != nil {
+			encodeRows(wide.rows)
+		}
+	}
+	return enc.Bytes(), dropped
+}
+
+// wideRows groups the data points of the metrics sharing the same attributes and
+// timestamp in a single row, with a column per metric.
+type wideRows struct {
+	table string
+	rows  []*row
+	index map[string]*row
+}
+
+func newWideRows(table string) *wideRows {
+	return &wideRows{
+		table: table,
+		index: map[string]*row{},
+	}
+}
+
+func (w *wideRows) get(symbols map[string]string, ts pcommon.Timestamp) *row {
+	keys := make([]string, 0, len(symbols))
+	for k := range symbols {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(strconv.FormatUint(uint64(ts), 10))
+	for _, k := range keys {
+		b.WriteByte(0)
+		b.WriteString(k)
+		b.WriteByte(0)
+		b.WriteString(symbols[k])
+	}
+	key := b.String()
+
+	if r, ok := w.index[key]; ok {
+		return r
+	}
+	r := newRow(w.table, symbols, ts)
+	w.index[key] = r
+	w.rows = append(w.rows, r)
+	return r
+}
+
+// forEachDataPoint calls fn for each data point of the metric, with a function setting
+// the columns of the data point.
+func forEachDataPoint(metric pmetric.Metric, fn func(attributes pcommon.Map, ts pcommon.Timestamp, setColumns func(set func(string, any)))) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		forEachNumberDataPoint(metric.Gauge().DataPoints(), fn)
+	case pmetric.MetricTypeSum:
+		forEachNumberDataPoint(metric.Sum().DataPoints(), fn)
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			fn(dp.Attributes(), dp.Timestamp(), func(set func(string, any)) {
+				set(countColumn, int64(dp.Count()))
+				set(sumColumn, dp.Sum())
+				if dp.HasMin() {
+					set(minColumn, dp.Min())
+				}
+				if dp.HasMax() {
+					set(maxColumn, dp.Max())
+				}
+				// the bucket counts are written as cumulative counts, as the "le" bounds of Prometheus
+				var cumulative uint64
+				for j := 0; j < dp.BucketCounts().Len(); j++ {
+					cumulative += dp.BucketCounts().At(j)
+					bound := infinityBound
+					if j < dp.ExplicitBounds().Len() {
+						bound = strconv.FormatFloat(dp.ExplicitBounds().At(j), 'g', -1, 64)
+					}
+					set(sanitizeName(bucketPrefix+bound), int64(cumulative))
+				}
+			})
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			fn(dp.Attributes(), dp.Timestamp(), func(set func(string, any)) {
+				set(countColumn, int64(dp.Count()))
+				set(sumColumn, dp.Sum())
+				if dp.HasMin() {
+					set(minColumn, dp.Min())
+				}
+				if dp.HasMax() {
+					set(maxColumn, dp.Max())
+				}
+				set(scaleColumn, int64(dp.Scale()))
+				set(zeroCountColumn, int64(dp.ZeroCount()))
+			})
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			fn(dp.Attributes(), dp.Timestamp(), func(set func(string, any)) {
+				set(countColumn, int64(dp.Count()))
+				set(sumColumn, dp.Sum())
+				for j := 0; j < dp.QuantileValues().Len(); j++ {
+					qv := dp.QuantileValues().At(j)
+					set(sanitizeName(quantilePrefix+strconv.FormatFloat(qv.Quantile(), 'g', -1, 64)), qv.Value())
+				}
+			})
+		}
+	}
+}
+
+func forEachNumberDataPoint(dps pmetric.NumberDataPointSlice, fn func(attributes pcommon.Map, ts pcommon.Timestamp, setColumns func(set func(string, any)))) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		fn(dp.Attributes(), dp.Timestamp(), func(set func(string, any)) {
+			switch dp.ValueType() {
+			case pmetric.NumberDataPointValueTypeInt:
+				set(valueColumn, float64(dp.IntValue()))
+			case pmetric.NumberDataPointValueTypeDouble:
+				set(valueColumn, dp.DoubleValue())
+			}
+		})
+	}
+}
+
+func metricDataPointCount(metric pmetric.Metric) int {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		return metric.Gauge().DataPoints().Len()
+	case pmetric.MetricTypeSum:
+		return metric.Sum().DataPoints().Len()
+	case pmetric.MetricTypeHistogram:
+		return metric.Histogram().DataPoints().Len()
+	case pmetric.MetricTypeExponentialHistogram:
+		return metric.ExponentialHistogram().DataPoints().Len()
+	case pmetric.MetricTypeSummary:
+		return metric.Summary().DataPoints().Len()
+	}
+	return 0
+}
+
+// logsToILP converts the log records to ILP lines, and returns the number of log
+// records which couldn't be converted.
+func logsToILP(ld plog.Logs, config *Config) ([]byte, int) {
+	enc := newEncoder()
+	table := sanitizeName(config.LogsTable)
+	dropped := 0
+
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceSymbols := attributesToSymbols(rl.Resource().Attributes(), nil)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				symbols := attributesToSymbols(pcommon.NewMap(), resourceSymbols)
+				if lr.SeverityText() != "" {
+					symbols[severityTextSymbol] = lr.SeverityText()
+				}
+				ts := lr.Timestamp()
+				if ts == 0 {
+					ts = lr.ObservedTimestamp()
+				}
+
+				r := newRow(table, symbols, ts)
+				// the attributes are written as columns rather than symbols, as they are
+				// often of a high cardinality; the columns of the record take precedence.
+				lr.Attributes().Range(func(k string, v pcommon.Value) bool {
+					r.set(sanitizeName(k), v.AsString())
+					return true
+				})
+				r.set(severityNumberColumn, int64(lr.SeverityNumber()))
+				r.set(bodyColumn, lr.Body().AsString())
+				if !lr.TraceID().IsEmpty() {
+					r.set(traceIDColumn, lr.TraceID().String())
+				}
+				if !lr.SpanID().IsEmpty() {
+					r.set(spanIDColumn, lr.SpanID().String())
+				}
+				if !r.encode(enc) {
+					dropped++
+				}
+			}
+		}
+	}
+	return enc.Bytes(), dropped
+}
+
+// attributesToSymbols returns the attributes as symbols, added to a copy of the base
+// symbols.
+func attributesToSymbols(attributes pcommon.Map, base map[string]string) map[string]string {
+	symbols := make(map[string]string, len(base)+attributes.Len())
+	for k, v := range base {
+		symbols[k] = v
+	}
+	attributes.Range(func(k string, v pcommon.Value) bool {
+		symbols[sanitizeName(k)] = sanitizeSymbolValue(v.AsString())
+		return true
+	})
+	return symbols
+}
+
+// sanitizeName replaces the characters which are not valid in the name of a QuestDB
+// table or column, such as '.', '-' or white spaces, by '_'.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// sanitizeSymbolValue replaces the control characters, which can't be written in an
+// ILP tag value, by ' '.
+func sanitizeSymbolValue(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, value)
+}
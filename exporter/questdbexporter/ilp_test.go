@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package questdbexporter
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var testTimestamp = pcommon.NewTimestampFromTime(time.Unix(1700000000, 0))
+
+func generateMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("host.name", "host-1")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+
+	cpu := ms.AppendEmpty()
+	cpu.SetName("system.cpu.utilization")
+	dp := cpu.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(testTimestamp)
+	dp.Attributes().PutStr("state", "idle")
+	dp.SetDoubleValue(0.5)
+
+	requests := ms.AppendEmpty()
+	requests.SetName("http.requests")
+	sdp := requests.SetEmptySum().DataPoints().AppendEmpty()
+	sdp.SetTimestamp(testTimestamp)
+	sdp.Attributes().PutStr("state", "idle")
+	sdp.SetIntValue(42)
+
+	latency := ms.AppendEmpty()
+	latency.SetName("http.latency")
+	hdp := latency.SetEmptyHistogram().DataPoints().AppendEmpty()
+	hdp.SetTimestamp(testTimestamp)
+	hdp.SetCount(3)
+	hdp.SetSum(1.5)
+	hdp.ExplicitBounds().FromRaw([]float64{0.5})
+	hdp.BucketCounts().FromRaw([]uint64{2, 1})
+	return md
+}
+
+func TestMetricsToILPTablePerMetric(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TablePrefix = "otel_"
+
+	payload, dropped := metricsToILP(generateMetrics(), cfg)
+	assert.Zero(t, dropped)
+	assert.Equal(t, strings.Join([]string{
+		"otel_system_cpu_utilization,host_name=host-1,state=idle value=0.5 1700000000000000000",
+		"otel_http_requests,host_name=host-1,state=idle value=42 1700000000000000000",
+		"otel_http_latency,host_name=host-1 count=3i,sum=1.5,le_0_5=2i,le_inf=3i 1700000000000000000",
+		"",
+	}, "\n"), string(payload))
+}
+
+func TestMetricsToILPWide(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.MetricsMode = metricsModeWide
+
+	payload, dropped := metricsToILP(generateMetrics(), cfg)
+	assert.Zero(t, dropped)
+	assert.Equal(t, strings.Join([]string{
+		"metrics,host_name=host-1,state=idle system_cpu_utilization=0.5,http_requests=42 1700000000000000000",
+		"metrics,host_name=host-1 http_latency_count=3i,http_latency_sum=1.5,http_latency_le_0_5=2i,http_latency_le_inf=3i 1700000000000000000",
+		"",
+	}, "\n"), string(payload))
+}
+
+func TestMetricsToILPSummaryAndExponentialHistogram(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	summary := ms.AppendEmpty()
+	summary.SetName("rpc.duration")
+	sdp := summary.SetEmptySummary().DataPoints().AppendEmpty()
+	sdp.SetCount(2)
+	sdp.SetSum(3)
+	qv := sdp.QuantileValues().AppendEmpty()
+	qv.SetQuantile(0.99)
+	qv.SetValue(2.5)
+	expHistogram := ms.AppendEmpty()
+	expHistogram.SetName("rpc.size")
+	edp := expHistogram.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	edp.SetCount(4)
+	edp.SetScale(2)
+	edp.SetZeroCount(1)
+	edp.SetMax(10)
+
+	payload, dropped := metricsToILP(md, createDefaultConfig().(*Config))
+	assert.Zero(t, dropped)
+	assert.Equal(t, strings.Join([]string{
+		"rpc_duration count=2i,sum=3,quantile_0_99=2.5",
+		"rpc_size count=4i,sum=0,max=10,scale=2i,zero_count=1i",
+		"",
+	}, "\n"), string(payload))
+}
+
+func TestMetricsToILPDropped(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	unnamed := ms.AppendEmpty()
+	unnamed.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(1)
+	nan := ms.AppendEmpty()
+	nan.SetName("nan")
+	nan.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(math.NaN())
+	valid := ms.AppendEmpty()
+	valid.SetName("valid")
+	valid.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(1)
+
+	payload, dropped := metricsToILP(md, createDefaultConfig().(*Config))
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, "valid value=1\n", string(payload))
+}
+
+func TestLogsToILP(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(testTimestamp)
+	lr.SetSeverityText("ERROR")
+	lr.SetSeverityNumber(plog.SeverityNumberError)
+	lr.Body().SetStr(`payment "declined"`)
+	lr.SetTraceID([16]byte{1})
+	lr.Attributes().PutStr("http.method", "POST")
+	lr.Attributes().PutStr("body", "replaced by the record body")
+
+	payload, dropped := logsToILP(ld, createDefaultConfig().(*Config))
+	assert.Zero(t, dropped)
+	assert.Equal(t,
+		`logs,service_name=checkout,severity_text=ERROR http_method="POST",body="payment \"declined\"",severity_number=17i,trace_id="01000000000000000000000000000000" 1700000000000000000`+"\n",
+		string(payload))
+}
+
+func TestSanitizeName(t *testing.T) {
+	assert.Equal(t, "http_server_duration", sanitizeName("http.server.duration"))
+	assert.Equal(t, "k8s_pod_name_", sanitizeName("k8s-pod name?"))
+	assert.Equal(t, "température", sanitizeName("température"))
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampleddebugexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sampleddebugexporter"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// Fields which can be selected for output. Fields that don't apply to a signal are ignored for it.
+const (
+	fieldResource          = "resource"
+	fieldScope             = "scope"
+	fieldAttributes        = "attributes"
+	fieldTraceID           = "trace_id"
+	fieldSpanID            = "span_id"
+	fieldParentSpanID      = "parent_span_id"
+	fieldName              = "name"
+	fieldKind              = "kind"
+	fieldStartTime         = "start_time"
+	fieldEndTime           = "end_time"
+	fieldStatus            = "status"
+	fieldEvents            = "events"
+	fieldLinks             = "links"
+	fieldTimestamp         = "timestamp"
+	fieldObservedTimestamp = "observed_timestamp"
+	fieldSeverity          = "severity"
+	fieldBody              = "body"
+	fieldMetric            = "metric"
+	fieldValue             = "value"
+)
+
+var allFields = []string{
+	fieldResource, fieldScope, fieldAttributes, fieldTraceID, fieldSpanID, fieldParentSpanID, fieldName, fieldKind,
+	fieldStartTime, fieldEndTime, fieldStatus, fieldEvents, fieldLinks, fieldTimestamp, fieldObservedTimestamp,
+	fieldSeverity, fieldBody, fieldMetric, fieldValue,
+}
+
+// Config defines configuration for the sampled debug exporter.
+type Config struct {
+	// Conditions are the OTTL conditions selecting the telemetry to output.
+	Conditions ConditionsConfig `mapstructure:"conditions"`
+
+	// ErrorMode determines how errors returned by the conditions are handled.
+	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+
+	// RateLimit limits the number of records output.
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+
+	// Fields is the list of fields output for every record. All the fields are output if empty.
+	Fields []string `mapstructure:"fields"`
+}
+
+// ConditionsConfig holds the OTTL conditions of every signal. A record is output if any of the conditions
+// of its signal matches, the records of a signal without conditions are never output.
+type ConditionsConfig struct {
+	// Span is the list of ottlspan conditions.
+	Span []string `mapstructure:"span"`
+	// DataPoint is the list of ottldatapoint conditions.
+	DataPoint []string `mapstructure:"datapoint"`
+	// Log is the list of ottllog conditions.
+	Log []string `mapstructure:"log"`
+}
+
+// RateLimitConfig defines the token bucket limiting the output of every signal.
+type RateLimitConfig struct {
+	// RecordsPerSecond is the sustained number of records output per second.
+	RecordsPerSecond float64 `mapstructure:"records_per_second"`
+	// Burst is the maximum number of records output at once.
+	Burst int `mapstructure:"burst"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	if len(cfg.Conditions.Span) == 0 && len(cfg.Conditions.DataPoint) == 0 && len(cfg.Conditions.Log) == 0 {
+		return errors.New("at least one condition must be configured")
+	}
+
+	set := component.TelemetrySettings{Logger: zap.NewNop()}
+	if len(cfg.Conditions.Span) > 0 {
+		if _, err := filterottl.NewBoolExprForSpan(cfg.Conditions.Span, filterottl.StandardSpanFuncs(), cfg.ErrorMode, set); err != nil {
+			return fmt.Errorf("invalid span conditions: %w", err)
+		}
+	}
+	if len(cfg.Conditions.DataPoint) > 0 {
+		if _, err := filterottl.NewBoolExprForDataPoint(cfg.Conditions.DataPoint, filterottl.StandardDataPointFuncs(), cfg.ErrorMode, set); err != nil {
+			return fmt.Errorf("invalid datapoint conditions: %w", err)
+		}
+	}
+	if len(cfg.Conditions.Log) > 0 {
+		if _, err := filterottl.NewBoolExprForLog(cfg.Conditions.Log, filterottl.StandardLogFuncs(), cfg.ErrorMode, set); err != nil {
+			return fmt.Errorf("invalid log conditions: %w", err)
+		}
+	}
+
+	if cfg.RateLimit.RecordsPerSecond <= 0 {
+		return errors.New("rate_limit::records_per_second must be positive")
+	}
+	if cfg.RateLimit.Burst <= 0 {
+		return errors.New("rate_limit::burst must be positive")
+	}
+
+	for _, field := range cfg.Fields {
+		if !isKnownField(field) {
+			return fmt.Errorf("unknown field %q, must be one of %v", field, allFields)
+		}
+	}
+	return nil
+}
+
+func isKnownField(field string) bool {
+	for _, known := range allFields {
+		if field == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampleddebugexporter
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sampleddebugexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: &Config{
+				Conditions: ConditionsConfig{
+					Log: []string{"severity_number >= SEVERITY_NUMBER_ERROR"},
+				},
+				ErrorMode: ottl.IgnoreError,
+				RateLimit: RateLimitConfig{
+					RecordsPerSecond: defaultRecordsPerSecond,
+					Burst:            defaultBurst,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_fields"),
+			expected: &Config{
+				Conditions: ConditionsConfig{
+					Span:      []string{`attributes["http.response.status_code"] >= 500`},
+					DataPoint: []string{`metric.name == "http.server.request.duration"`},
+					Log:       []string{"severity_number >= SEVERITY_NUMBER_ERROR"},
+				},
+				ErrorMode: ottl.PropagateError,
+				RateLimit: RateLimitConfig{
+					RecordsPerSecond: 0.5,
+					Burst:            5,
+				},
+				Fields: []string{fieldResource, fieldName, fieldAttributes, fieldBody, fieldValue},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "no_conditions"),
+			expectedErr: "at least one condition must be configured",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "bad_syntax_span"),
+			expectedErr: "invalid span conditions",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "bad_syntax_datapoint"),
+			expectedErr: "invalid datapoint conditions",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "bad_syntax_log"),
+			expectedErr: "invalid log conditions",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_records_per_second"),
+			expectedErr: "rate_limit::records_per_second must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_burst"),
+			expectedErr: "rate_limit::burst must be positive",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknown_field"),
+			expectedErr: `unknown field "unknown"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package sampleddebugexporter logs the telemetry matching OTTL conditions, at a limited rate.
+package sampleddebugexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sampleddebugexporter"
@@ -0,0 +1,346 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampleddebugexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sampleddebugexporter"
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+)
+
+// sampledDebugExporter logs the records matching the conditions of its signal, as long as the rate limit allows it.
+type sampledDebugExporter struct {
+	logger  *zap.Logger
+	fields  map[string]bool
+	limiter *rate.Limiter
+	// rateLimited is the number of matching records not output since the last output record.
+	rateLimited atomic.Int64
+
+	spanExpr      expr.BoolExpr[ottlspan.TransformContext]
+	dataPointExpr expr.BoolExpr[ottldatapoint.TransformContext]
+	logExpr       expr.BoolExpr[ottllog.TransformContext]
+}
+
+func newSampledDebugExporter(cfg *Config, set component.TelemetrySettings) *sampledDebugExporter {
+	var fields map[string]bool
+	if len(cfg.Fields) > 0 {
+		fields = make(map[string]bool, len(cfg.Fields))
+		for _, field := range cfg.Fields {
+			fields[field] = true
+		}
+	}
+	return &sampledDebugExporter{
+		logger:  set.Logger,
+		fields:  fields,
+		limiter: rate.NewLimiter(rate.Limit(cfg.RateLimit.RecordsPerSecond), cfg.RateLimit.Burst),
+	}
+}
+
+func newTracesExporter(cfg *Config, set component.TelemetrySettings) (*sampledDebugExporter, error) {
+	e := newSampledDebugExporter(cfg, set)
+	if len(cfg.Conditions.Span) > 0 {
+		var err error
+		if e.spanExpr, err = filterottl.NewBoolExprForSpan(cfg.Conditions.Span, filterottl.StandardSpanFuncs(), cfg.ErrorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func newMetricsExporter(cfg *Config, set component.TelemetrySettings) (*sampledDebugExporter, error) {
+	e := newSampledDebugExporter(cfg, set)
+	if len(cfg.Conditions.DataPoint) > 0 {
+		var err error
+		if e.dataPointExpr, err = filterottl.NewBoolExprForDataPoint(cfg.Conditions.DataPoint, filterottl.StandardDataPointFuncs(), cfg.ErrorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func newLogsExporter(cfg *Config, set component.TelemetrySettings) (*sampledDebugExporter, error) {
+	e := newSampledDebugExporter(cfg, set)
+	if len(cfg.Conditions.Log) > 0 {
+		var err error
+		if e.logExpr, err = filterottl.NewBoolExprForLog(cfg.Conditions.Log, filterottl.StandardLogFuncs(), cfg.ErrorMode, set); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func (e *sampledDebugExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
+	if e.spanExpr == nil {
+		return nil
+	}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				match, err := e.spanExpr.Eval(ctx, ottlspan.NewTransformContext(span, ss.Scope(), rs.Resource()))
+				if err != nil {
+					return err
+				}
+				if match && e.allow() {
+					e.logger.Info("Span", e.spanFields(rs.Resource(), ss.Scope(), span)...)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (e *sampledDebugExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
+	if e.dataPointExpr == nil {
+		return nil
+	}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				metric := sm.Metrics().At(k)
+				err := forEachDataPoint(metric, func(dp any, attributes pcommon.Map, value func() zap.Field, start, timestamp pcommon.Timestamp) error {
+					tCtx := ottldatapoint.NewTransformContext(dp, metric, sm.Metrics(), sm.Scope(), rm.Resource())
+					match, err := e.dataPointExpr.Eval(ctx, tCtx)
+					if err != nil {
+						return err
+					}
+					if match && e.allow() {
+						e.logger.Info("Data point", e.dataPointFields(rm.Resource(), sm.Scope(), metric, attributes, value, start, timestamp)...)
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (e *sampledDebugExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
+	if e.logExpr == nil {
+		return nil
+	}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				record := sl.LogRecords().At(k)
+				match, err := e.logExpr.Eval(ctx, ottllog.NewTransformContext(record, sl.Scope(), rl.Resource()))
+				if err != nil {
+					return err
+				}
+				if match && e.allow() {
+					e.logger.Info("Log record", e.logFields(rl.Resource(), sl.Scope(), record)...)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// allow reports whether a matching record can be output, and counts it if it can't.
+func (e *sampledDebugExporter) allow() bool {
+	if e.limiter.Allow() {
+		return true
+	}
+	e.rateLimited.Add(1)
+	return false
+}
+
+// fieldsBuilder collects the selected fields of a record.
+type fieldsBuilder struct {
+	selected map[string]bool
+	fields   []zap.Field
+}
+
+func (e *sampledDebugExporter) newFieldsBuilder(resource pcommon.Resource, scope pcommon.InstrumentationScope) *fieldsBuilder {
+	b := &fieldsBuilder{selected: e.fields}
+	if rateLimited := e.rateLimited.Swap(0); rateLimited > 0 {
+		b.fields = append(b.fields, zap.Int64("rate_limited_records", rateLimited))
+	}
+	b.add(fieldResource, func() zap.Field { return zap.Any(fieldResource, resource.Attributes().AsRaw()) })
+	b.add(fieldScope, func() zap.Field {
+		return zap.Dict(fieldScope, zap.String("name", scope.Name()), zap.String("version", scope.Version()))
+	})
+	return b
+}
+
+// add adds a field if it is selected. The field is only built in that case.
+func (b *fieldsBuilder) add(name string, field func() zap.Field) {
+	if b.selected == nil || b.selected[name] {
+		b.fields = append(b.fields, field())
+	}
+}
+
+func (b *fieldsBuilder) addTime(name string, timestamp pcommon.Timestamp) {
+	b.add(name, func() zap.Field { return zap.Time(name, timestamp.AsTime()) })
+}
+
+func (b *fieldsBuilder) addAttributes(attributes pcommon.Map) {
+	b.add(fieldAttributes, func() zap.Field { return zap.Any(fieldAttributes, attributes.AsRaw()) })
+}
+
+func (e *sampledDebugExporter) spanFields(resource pcommon.Resource, scope pcommon.InstrumentationScope, span ptrace.Span) []zap.Field {
+	b := e.newFieldsBuilder(resource, scope)
+	b.add(fieldTraceID, func() zap.Field { return zap.Stringer(fieldTraceID, span.TraceID()) })
+	b.add(fieldSpanID, func() zap.Field { return zap.Stringer(fieldSpanID, span.SpanID()) })
+	b.add(fieldParentSpanID, func() zap.Field { return zap.Stringer(fieldParentSpanID, span.ParentSpanID()) })
+	b.add(fieldName, func() zap.Field { return zap.String(fieldName, span.Name()) })
+	b.add(fieldKind, func() zap.Field { return zap.String(fieldKind, span.Kind().String()) })
+	b.addTime(fieldStartTime, span.StartTimestamp())
+	b.addTime(fieldEndTime, span.EndTimestamp())
+	b.add(fieldStatus, func() zap.Field {
+		return zap.Dict(fieldStatus, zap.String("code", span.Status().Code().String()), zap.String("message", span.Status().Message()))
+	})
+	b.addAttributes(span.Attributes())
+	b.add(fieldEvents, func() zap.Field {
+		events := make([]map[string]any, 0, span.Events().Len())
+		for i := 0; i < span.Events().Len(); i++ {
+			event := span.Events().At(i)
+			events = append(events, map[string]any{
+				"name":       event.Name(),
+				"timestamp":  event.Timestamp().AsTime(),
+				"attributes": event.Attributes().AsRaw(),
+			})
+		}
+		return zap.Any(fieldEvents, events)
+	})
+	b.add(fieldLinks, func() zap.Field {
+		links := make([]map[string]any, 0, span.Links().Len())
+		for i := 0; i < span.Links().Len(); i++ {
+			link := span.Links().At(i)
+			links = append(links, map[string]any{
+				"trace_id":   link.TraceID().String(),
+				"span_id":    link.SpanID().String(),
+				"attributes": link.Attributes().AsRaw(),
+			})
+		}
+		return zap.Any(fieldLinks, links)
+	})
+	return b.fields
+}
+
+func (e *sampledDebugExporter) dataPointFields(resource pcommon.Resource, scope pcommon.InstrumentationScope, metric pmetric.Metric,
+	attributes pcommon.Map, value func() zap.Field, start, timestamp pcommon.Timestamp) []zap.Field {
+	b := e.newFieldsBuilder(resource, scope)
+	b.add(fieldMetric, func() zap.Field {
+		return zap.Dict(fieldMetric, zap.String("name", metric.Name()), zap.String("type", metric.Type().String()), zap.String("unit", metric.Unit()))
+	})
+	b.addAttributes(attributes)
+	b.addTime(fieldStartTime, start)
+	b.addTime(fieldTimestamp, timestamp)
+	b.add(fieldValue, value)
+	return b.fields
+}
+
+func (e *sampledDebugExporter) logFields(resource pcommon.Resource, scope pcommon.InstrumentationScope, record plog.LogRecord) []zap.Field {
+	b := e.newFieldsBuilder(resource, scope)
+	b.addTime(fieldTimestamp, record.Timestamp())
+	b.addTime(fieldObservedTimestamp, record.ObservedTimestamp())
+	b.add(fieldSeverity, func() zap.Field {
+		return zap.Dict(fieldSeverity, zap.String("text", record.SeverityText()), zap.String("number", record.SeverityNumber().String()))
+	})
+	b.add(fieldBody, func() zap.Field { return zap.Any(fieldBody, record.Body().AsRaw()) })
+	b.addAttributes(record.Attributes())
+	b.add(fieldTraceID, func() zap.Field { return zap.Stringer(fieldTraceID, record.TraceID()) })
+	b.add(fieldSpanID, func() zap.Field { return zap.Stringer(fieldSpanID, record.SpanID()) })
+	return b.fields
+}
+
+// forEachDataPoint calls fn with every data point of a metric, its attributes, timestamps and a function
+// building its value field.
+func forEachDataPoint(metric pmetric.Metric, fn func(dp any, attributes pcommon.Map, value func() zap.Field, start, timestamp pcommon.Timestamp) error) error {
+	var dps pmetric.NumberDataPointSlice
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		dps = metric.Gauge().DataPoints()
+	case pmetric.MetricTypeSum:
+		dps = metric.Sum().DataPoints()
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			dp := metric.Histogram().DataPoints().At(i)
+			value := func() zap.Field {
+				return zap.Dict(fieldValue,
+					zap.Uint64("count", dp.Count()),
+					zap.Float64("sum", dp.Sum()),
+					zap.Uint64s("bucket_counts", dp.BucketCounts().AsRaw()),
+					zap.Float64s("explicit_bounds", dp.ExplicitBounds().AsRaw()))
+			}
+			if err := fn(dp, dp.Attributes(), value, dp.StartTimestamp(), dp.Timestamp()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			dp := metric.ExponentialHistogram().DataPoints().At(i)
+			value := func() zap.Field {
+				return zap.Dict(fieldValue,
+					zap.Uint64("count", dp.Count()),
+					zap.Float64("sum", dp.Sum()),
+					zap.Int32("scale", dp.Scale()),
+					zap.Uint64("zero_count", dp.ZeroCount()))
+			}
+			if err := fn(dp, dp.Attributes(), value, dp.StartTimestamp(), dp.Timestamp()); err != nil {
+				return err
+			}
+		}
+		return nil
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			dp := metric.Summary().DataPoints().At(i)
+			value := func() zap.Field {
+				quantiles := make(map[string]any, dp.QuantileValues().Len())
+				for j := 0; j < dp.QuantileValues().Len(); j++ {
+					quantile := dp.QuantileValues().At(j)
+					quantiles[pcommon.NewValueDouble(quantile.Quantile()).AsString()] = quantile.Value()
+				}
+				return zap.Dict(fieldValue,
+					zap.Uint64("count", dp.Count()),
+					zap.Float64("sum", dp.Sum()),
+					zap.Any("quantiles", quantiles))
+			}
+			if err := fn(dp, dp.Attributes(), value, dp.StartTimestamp(), dp.Timestamp()); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		value := func() zap.Field {
+			if dp.ValueType() == pmetric.NumberDataPointValueTypeInt {
+				return zap.Int64(fieldValue, dp.IntValue())
+			}
+			return zap.Float64(fieldValue, dp.DoubleValue())
+		}
+		if err := fn(dp, dp.Attributes(), value, dp.StartTimestamp(), dp.Timestamp()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
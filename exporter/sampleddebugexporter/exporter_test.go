@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampleddebugexporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"golang.org/x/time/rate"
+)
+
+func newTestConfig(fns ...func(*Config)) *Config {
+	cfg := createDefaultConfig().(*Config)
+	for _, fn := range fns {
+		fn(cfg)
+	}
+	return cfg
+}
+
+func newObservedSettings() (component.TelemetrySettings, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	set := componenttest.NewNopTelemetrySettings()
+	set.Logger = zap.New(core)
+	return set, logs
+}
+
+func TestPushTraces(t *testing.T) {
+	set, logs := newObservedSettings()
+	cfg := newTestConfig(func(cfg *Config) {
+		cfg.Conditions.Span = []string{`attributes["http.response.status_code"] >= 500`}
+		cfg.Fields = []string{fieldName, fieldAttributes}
+	})
+	exp, err := newTracesExporter(cfg, set)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	spans := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+	ok := spans.AppendEmpty()
+	ok.SetName("ok")
+	ok.Attributes().PutInt("http.response.status_code", 200)
+	failed := spans.AppendEmpty()
+	failed.SetName("failed")
+	failed.Attributes().PutInt("http.response.status_code", 503)
+
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "Span", entry.Message)
+	assert.Equal(t, map[string]any{
+		fieldName:       "failed",
+		fieldAttributes: map[string]any{"http.response.status_code": int64(503)},
+	}, entry.ContextMap())
+}
+
+func TestPushMetrics(t *testing.T) {
+	set, logs := newObservedSettings()
+	cfg := newTestConfig(func(cfg *Config) {
+		cfg.Conditions.DataPoint = []string{`metric.name == "queue.size" and value_int > 100`}
+		cfg.Fields = []string{fieldMetric, fieldValue}
+	})
+	exp, err := newMetricsExporter(cfg, set)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("queue.size")
+	metric.SetUnit("{item}")
+	dps := metric.SetEmptyGauge().DataPoints()
+	dps.AppendEmpty().SetIntValue(10)
+	dps.AppendEmpty().SetIntValue(1000)
+
+	require.NoError(t, exp.pushMetrics(context.Background(), md))
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, "Data point", entry.Message)
+	assert.Equal(t, map[string]any{
+		fieldMetric: map[string]any{"name": "queue.size", "type": "Gauge", "unit": "{item}"},
+		fieldValue:  int64(1000),
+	}, entry.ContextMap())
+}
+
+func TestPushLogsRateLimited(t *testing.T) {
+	set, logs := newObservedSettings()
+	cfg := newTestConfig(func(cfg *Config) {
+		cfg.Conditions.Log = []string{"severity_number >= SEVERITY_NUMBER_ERROR"}
+		cfg.RateLimit = RateLimitConfig{RecordsPerSecond: 1e-9, Burst: 2}
+		cfg.Fields = []string{fieldBody}
+	})
+	exp, err := newLogsExporter(cfg, set)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for _, body := range []string{"first", "debug", "second", "third", "fourth"} {
+		record := records.AppendEmpty()
+		record.Body().SetStr(body)
+		record.SetSeverityNumber(plog.SeverityNumberError)
+		if body == "debug" {
+			record.SetSeverityNumber(plog.SeverityNumberDebug)
+		}
+	}
+
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	require.Equal(t, 2, logs.Len())
+	assert.Equal(t, map[string]any{fieldBody: "first"}, logs.All()[0].ContextMap())
+	assert.Equal(t, map[string]any{fieldBody: "second"}, logs.All()[1].ContextMap())
+	assert.Equal(t, int64(2), exp.rateLimited.Load())
+
+	// The next output record reports the records dropped by the rate limit.
+	exp.limiter = rate.NewLimiter(rate.Inf, 1)
+	records.RemoveIf(func(record plog.LogRecord) bool { return record.Body().Str() != "fourth" })
+	require.NoError(t, exp.pushLogs(context.Background(), ld))
+	require.Equal(t, 3, logs.Len())
+	assert.Equal(t, map[string]any{"rate_limited_records": int64(2), fieldBody: "fourth"}, logs.All()[2].ContextMap())
+	assert.Zero(t, exp.rateLimited.Load())
+}
+
+func TestPushWithoutConditions(t *testing.T) {
+	set, logs := newObservedSettings()
+	cfg := newTestConfig(func(cfg *Config) {
+		cfg.Conditions.Log = []string{"true"}
+	})
+	exp, err := newTracesExporter(cfg, set)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	require.NoError(t, exp.pushTraces(context.Background(), td))
+	assert.Zero(t, logs.Len())
+}
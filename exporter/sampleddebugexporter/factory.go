@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sampleddebugexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sampleddebugexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/sampleddebugexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+const (
+	defaultRecordsPerSecond = 1
+	defaultBurst            = 10
+)
+
+// exporterOptions are the options of the exporters of all the signals. Records are only logged,
+// there is nothing to time out, retry or queue.
+var exporterOptions = []exporterhelper.Option{
+	exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+	exporterhelper.WithTimeout(exporterhelper.TimeoutSettings{Timeout: 0}),
+}
+
+// NewFactory creates a factory for the sampled debug exporter.
+func NewFactory() exporter.Factory {
+	return exporter.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporter.WithTraces(createTracesExporter, metadata.TracesStability),
+		exporter.WithMetrics(createMetricsExporter, metadata.MetricsStability),
+		exporter.WithLogs(createLogsExporter, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ErrorMode: ottl.IgnoreError,
+		RateLimit: RateLimitConfig{
+			RecordsPerSecond: defaultRecordsPerSecond,
+			Burst:            defaultBurst,
+		},
+	}
+}
+
+func createTracesExporter(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Traces, error) {
+	exp, err := newTracesExporter(cfg.(*Config), set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return exporterhelper.NewTracesExporter(ctx, set, cfg,
+		exp.pushTraces,
+		exporterOptions...,
+	)
+}
+
+func createMetricsExporter(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Metrics, error) {
+	exp, err := newMetricsExporter(cfg.(*Config), set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return exporterhelper.NewMetricsExporter(ctx, set, cfg,
+		exp.pushMetrics,
+		exporterOptions...,
+	)
+}
+
+func createLogsExporter(ctx context.Context, set exporter.CreateSettings, cfg component.Config) (exporter.Logs, error) {
+	exp, err := newLogsExporter(cfg.(*Config), set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	return exporterhelper.NewLogsExporter(ctx, set, cfg,
+		exp.pushLogs,
+		exporterOptions...,
+	)
+}
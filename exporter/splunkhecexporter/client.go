@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"sync"
 
+	"github.com/google/uuid"
 	jsoniter "github.com/json-iterator/go"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumererror"
@@ -42,6 +43,34 @@ func (s iterState) empty() bool {
 	return s.resource == 0 && s.library == 0 && s.record == 0
 }
 
+// atOrBefore returns whether the state is at or before the record (i, j, k).
+func (s iterState) atOrBefore(i, j, k int) bool {
+	if s.resource != i {
+		return s.resource < i
+	}
+	if s.library != j {
+		return s.library < j
+	}
+	return s.record <= k
+}
+
+// iterRange is the range of the records of a buffer, from the state it started at to the state
+// the next buffer starts at.
+type iterRange struct {
+	from iterState
+	to   iterState
+}
+
+// inRanges returns whether the record (i, j, k) is in one of the ranges.
+func inRanges(ranges []iterRange, i, j, k int) bool {
+	for _, r := range ranges {
+		if r.from.atOrBefore(i, j, k) && (r.to.done || !r.to.atOrBefore(i, j, k)) {
+			return true
+		}
+	}
+	return false
+}
+
 // client sends the data to the splunk backend.
 type client struct {
 	config            *Config
@@ -51,6 +80,8 @@ type client struct {
 	hecWorker         hecWorker
 	buildInfo         component.BuildInfo
 	heartbeater       *heartbeater
+	ackTracker        *ackTracker
+	ackWorker         *ackHecWorker
 	bufferPool        bufferPool
 	exporterName      string
 }
@@ -172,18 +203,26 @@ func (c *client) pushLogDataInBatches(ctx context.Context, ld plog.Logs, headers
 	defer c.bufferPool.put(buf)
 	is := iterState{}
 	var permanentErrors []error
+	acks := c.ackWorker.newBatches(headers)
 
 	for !is.done {
 		buf.Reset()
 		latestIterState, batchPermanentErrors := c.fillLogsBuffer(ld, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
-				return consumererror.NewLogs(err, subLogs(ld, is))
+			if err := c.postEvents(ctx, buf, headers, acks, iterRange{is, latestIterState}); err != nil {
+				unacked, ackErr := acks.wait(ctx)
+				if len(unacked) == 0 {
+					return consumererror.NewLogs(err, subLogs(ld, is))
+				}
+				return consumererror.NewLogs(multierr.Append(err, ackErr), logsInRanges(ld, append(unacked, iterRange{is, iterState{done: true}})))
 			}
 		}
 		is = latestIterState
 	}
+	if unacked, err := acks.wait(ctx); err != nil {
+		return consumererror.NewLogs(err, logsInRanges(ld, unacked))
+	}
 
 	return multierr.Combine(permanentErrors...)
 }
@@ -413,7 +452,8 @@ func (c *client) pushMultiMetricsDataInBatches(ctx context.Context, md pmetric.M
 		latestIterState, batchPermanentErrors := c.fillMetricsBufferMultiMetrics(merged, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
+			// the indexer acknowledgement isn't supported with the multi-metric format
+			if err := c.postEvents(ctx, buf, headers, nil, iterRange{}); err != nil {
 				return consumererror.NewMetrics(err, md)
 			}
 		}
@@ -432,19 +472,27 @@ func (c *client) pushMetricsDataInBatches(ctx context.Context, md pmetric.Metric
 	defer c.bufferPool.put(buf)
 	is := iterState{}
 	var permanentErrors []error
+	acks := c.ackWorker.newBatches(headers)
 
 	for !is.done {
 		buf.Reset()
 		latestIterState, batchPermanentErrors := c.fillMetricsBuffer(md, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
-				return consumererror.NewMetrics(err, subMetrics(md, is))
+			if err := c.postEvents(ctx, buf, headers, acks, iterRange{is, latestIterState}); err != nil {
+				unacked, ackErr := acks.wait(ctx)
+				if len(unacked) == 0 {
+					return consumererror.NewMetrics(err, subMetrics(md, is))
+				}
+				return consumererror.NewMetrics(multierr.Append(err, ackErr), metricsInRanges(md, append(unacked, iterRange{is, iterState{done: true}})))
 			}
 		}
 
 		is = latestIterState
 	}
+	if unacked, err := acks.wait(ctx); err != nil {
+		return consumererror.NewMetrics(err, metricsInRanges(md, unacked))
+	}
 
 	return multierr.Combine(permanentErrors...)
 }
@@ -457,26 +505,39 @@ func (c *client) pushTracesDataInBatches(ctx context.Context, td ptrace.Traces,
 	defer c.bufferPool.put(buf)
 	is := iterState{}
 	var permanentErrors []error
+	acks := c.ackWorker.newBatches(headers)
 
 	for !is.done {
 		buf.Reset()
 		latestIterState, batchPermanentErrors := c.fillTracesBuffer(td, buf, is)
 		permanentErrors = append(permanentErrors, batchPermanentErrors...)
 		if !buf.Empty() {
-			if err := c.postEvents(ctx, buf, headers); err != nil {
-				return consumererror.NewTraces(err, subTraces(td, is))
+			if err := c.postEvents(ctx, buf, headers, acks, iterRange{is, latestIterState}); err != nil {
+				unacked, ackErr := acks.wait(ctx)
+				if len(unacked) == 0 {
+					return consumererror.NewTraces(err, subTraces(td, is))
+				}
+				return consumererror.NewTraces(multierr.Append(err, ackErr), tracesInRanges(td, append(unacked, iterRange{is, iterState{done: true}})))
 			}
 		}
 		is = latestIterState
 	}
+	if unacked, err := acks.wait(ctx); err != nil {
+		return consumererror.NewTraces(err, tracesInRanges(td, unacked))
+	}
 
 	return multierr.Combine(permanentErrors...)
 }
 
-func (c *client) postEvents(ctx context.Context, buf buffer, headers map[string]string) error {
+// postEvents sends the buffer holding the data of the range. With the indexer acknowledgement, it
+// doesn't wait for the buffer to be acknowledged, which is tracked by the batches of the push.
+func (c *client) postEvents(ctx context.Context, buf buffer, headers map[string]string, acks *ackBatches, data iterRange) error {
 	if err := buf.Close(); err != nil {
 		return err
 	}
+	if acks != nil {
+		return acks.send(ctx, buf, headers, data)
+	}
 	return c.hecWorker.send(ctx, buf, headers)
 }
 
@@ -608,11 +669,80 @@ func subTraces(src ptrace.Traces, state iterState) ptrace.Traces {
 	return dst
 }
 
+// logsInRanges returns the logs of the ranges.
+func logsInRanges(src plog.Logs, ranges []iterRange) plog.Logs {
+	dst := plog.NewLogs()
+	src.CopyTo(dst)
+	i := 0
+	dst.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		j := 0
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			k := 0
+			sl.LogRecords().RemoveIf(func(plog.LogRecord) bool {
+				k++
+				return !inRanges(ranges, i, j, k-1)
+			})
+			j++
+			return sl.LogRecords().Len() == 0
+		})
+		i++
+		return rl.ScopeLogs().Len() == 0
+	})
+	return dst
+}
+
+// metricsInRanges returns the metrics of the ranges.
+func metricsInRanges(src pmetric.Metrics, ranges []iterRange) pmetric.Metrics {
+	dst := pmetric.NewMetrics()
+	src.CopyTo(dst)
+	i := 0
+	dst.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
+		j := 0
+		rm.ScopeMetrics().RemoveIf(func(sm pmetric.ScopeMetrics) bool {
+			k := 0
+			sm.Metrics().RemoveIf(func(pmetric.Metric) bool {
+				k++
+				return !inRanges(ranges, i, j, k-1)
+			})
+			j++
+			return sm.Metrics().Len() == 0
+		})
+		i++
+		return rm.ScopeMetrics().Len() == 0
+	})
+	return dst
+}
+
+// tracesInRanges returns the traces of the ranges.
+func tracesInRanges(src ptrace.Traces, ranges []iterRange) ptrace.Traces {
+	dst := ptrace.NewTraces()
+	src.CopyTo(dst)
+	i := 0
+	dst.ResourceSpans().RemoveIf(func(rs ptrace.ResourceSpans) bool {
+		j := 0
+		rs.ScopeSpans().RemoveIf(func(ss ptrace.ScopeSpans) bool {
+			k := 0
+			ss.Spans().RemoveIf(func(ptrace.Span) bool {
+				k++
+				return !inRanges(ranges, i, j, k-1)
+			})
+			j++
+			return ss.Spans().Len() == 0
+		})
+		i++
+		return rs.ScopeSpans().Len() == 0
+	})
+	return dst
+}
+
 func (c *client) stop(context.Context) error {
 	c.wg.Wait()
 	if c.heartbeater != nil {
 		c.heartbeater.shutdown()
 	}
+	if c.ackTracker != nil {
+		c.ackTracker.shutdown()
+	}
 	return nil
 }
 
@@ -631,7 +761,18 @@ func (c *client) start(ctx context.Context, host component.Host) (err error) {
 		}
 	}
 	url, _ := c.config.getURL()
-	c.hecWorker = &defaultHecWorker{url, httpClient, buildHTTPHeaders(c.config, c.buildInfo), c.logger}
+	headers := buildHTTPHeaders(c.config, c.buildInfo)
+	worker := &defaultHecWorker{url, httpClient, headers, c.logger}
+	c.hecWorker = worker
+	if c.config.Ack.Enabled {
+		// the events are sent on a channel, which is required to query their acknowledgement
+		headers[splunk.HTTPSplunkChannelHeader] = uuid.NewString()
+		ackURL, _ := c.config.getURL()
+		ackURL.Path = c.config.Ack.Path
+		c.ackTracker = newAckTracker(ackURL, httpClient, headers, c.config.Ack, c.logger)
+		c.ackTracker.start()
+		c.ackWorker = &ackHecWorker{worker, c.ackTracker}
+	}
 	c.heartbeater = newHeartbeater(c.config, c.buildInfo, getPushLogFn(c))
 	if c.config.Heartbeat.Startup {
 		if err := c.heartbeater.sendHeartbeat(c.config, c.buildInfo, getPushLogFn(c)); err != nil {
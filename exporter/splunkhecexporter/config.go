@@ -63,6 +63,23 @@ type HecTelemetry struct {
 	ExtraAttributes map[string]string `mapstructure:"extra_attributes"`
 }
 
+// HecAck defines the indexer acknowledgement configuration for the exporter
+type HecAck struct {
+	// Enabled sends the events on a channel, and only completes the send once Splunk has
+	// acknowledged that the events were indexed. Indexer acknowledgement must be enabled on the HEC token.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Path for the ack API, default is '/services/collector/ack'.
+	Path string `mapstructure:"path"`
+
+	// PollInterval is the interval at which the ack API is queried for the pending acknowledgements.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+
+	// Timeout is the maximum duration to wait for the acknowledgement of a batch of events,
+	// the batch being retried once it has elapsed.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 // Config defines configuration for Splunk exporter.
 type Config struct {
 	confighttp.ClientConfig      `mapstructure:",squash"`
@@ -138,6 +155,9 @@ type Config struct {
 
 	// Telemetry is the configuration for splunk hec exporter telemetry
 	Telemetry HecTelemetry `mapstructure:"telemetry"`
+
+	// Ack is the configuration of the indexer acknowledgement
+	Ack HecAck `mapstructure:"ack"`
 }
 
 func (cfg *Config) getURL() (out *url.URL, err error) {
@@ -185,5 +205,19 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf(`requires "max_event_size" <= %d`, maxMaxEventSize)
 	}
 
+	if cfg.Ack.Enabled {
+		if cfg.Ack.PollInterval <= 0 {
+			return errors.New(`requires "ack::poll_interval" > 0 when the indexer acknowledgement is enabled`)
+		}
+		if cfg.Ack.Timeout <= 0 {
+			return errors.New(`requires "ack::timeout" > 0 when the indexer acknowledgement is enabled`)
+		}
+		// the multi-metric events merge the data points of several metrics, so that the metrics
+		// of the events not acknowledged can't be retried alone
+		if cfg.UseMultiMetricFormat {
+			return errors.New(`requires "use_multi_metric_format" to be disabled when the indexer acknowledgement is enabled`)
+		}
+	}
+
 	return nil
 }
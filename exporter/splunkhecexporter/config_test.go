@@ -126,6 +126,12 @@ func TestLoadConfig(t *testing.T) {
 						"customKey": "customVal",
 					},
 				},
+				Ack: HecAck{
+					Enabled:      true,
+					Path:         "/services/collector/ack",
+					PollInterval: 2 * time.Second,
+					Timeout:      time.Minute,
+				},
 			},
 		},
 	}
@@ -231,6 +237,42 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			wantErr: "queue size must be positive",
 		},
+		{
+			name: "ack without poll interval",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.Ack.Enabled = true
+				cfg.Ack.PollInterval = 0
+				return cfg
+			}(),
+			wantErr: "requires \"ack::poll_interval\" > 0 when the indexer acknowledgement is enabled",
+		},
+		{
+			name: "ack without timeout",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.Ack.Enabled = true
+				cfg.Ack.Timeout = 0
+				return cfg
+			}(),
+			wantErr: "requires \"ack::timeout\" > 0 when the indexer acknowledgement is enabled",
+		},
+		{
+			name: "ack with the multi-metric format",
+			cfg: func() *Config {
+				cfg := createDefaultConfig().(*Config)
+				cfg.ClientConfig.Endpoint = "http://foo_bar.com"
+				cfg.Token = "foo"
+				cfg.Ack.Enabled = true
+				cfg.UseMultiMetricFormat = true
+				return cfg
+			}(),
+			wantErr: "requires \"use_multi_metric_format\" to be disabled when the indexer acknowledgement is enabled",
+		},
 	}
 
 	for _, tt := range tests {
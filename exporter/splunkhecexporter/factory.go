@@ -27,6 +27,8 @@ const (
 	defaultHTTP2ReadIdleTimeout = time.Second * 10
 	defaultHTTP2PingTimeout     = time.Second * 10
 	defaultIdleConnTimeout      = 10 * time.Second
+	defaultAckPollInterval      = 5 * time.Second
+	defaultAckTimeout           = 2 * time.Minute
 	defaultSplunkAppName        = "OpenTelemetry Collector Contrib"
 )
 
@@ -102,6 +104,11 @@ func createDefaultConfig() component.Config {
 			OverrideMetricsNames: map[string]string{},
 			ExtraAttributes:      map[string]string{},
 		},
+		Ack: HecAck{
+			Path:         splunk.DefaultAckPath,
+			PollInterval: defaultAckPollInterval,
+			Timeout:      defaultAckTimeout,
+		},
 	}
 }
 
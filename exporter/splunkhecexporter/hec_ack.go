@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/splunkhecexporter"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+var errAckTrackerShutdown = errors.New("exporter shut down before the events were acknowledged")
+
+// ackResponse is the response of the ack API, giving whether each ack ID has been indexed.
+type ackResponse struct {
+	Acks map[uint64]bool `json:"acks"`
+}
+
+// ackTracker polls the ack API for the ack IDs returned by Splunk on the channel of the
+// exporter, and releases the senders waiting for them once the events have been indexed.
+// The ack IDs are tracked per token, as each token has its own ack IDs on a channel.
+type ackTracker struct {
+	url          *url.URL
+	client       *http.Client
+	headers      map[string]string
+	pollInterval time.Duration
+	timeout      time.Duration
+	logger       *zap.Logger
+
+	mu      sync.Mutex
+	pending map[string]map[uint64]chan struct{}
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newAckTracker(ackURL *url.URL, client *http.Client, headers map[string]string, config HecAck, logger *zap.Logger) *ackTracker {
+	return &ackTracker{
+		url:          ackURL,
+		client:       client,
+		headers:      headers,
+		pollInterval: config.PollInterval,
+		timeout:      config.Timeout,
+		logger:       logger,
+		pending:      map[string]map[uint64]chan struct{}{},
+		stopCh:       make(chan struct{}),
+	}
+}
+
+func (t *ackTracker) start() {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(t.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.poll()
+			case <-t.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (t *ackTracker) shutdown() {
+	close(t.stopCh)
+	t.wg.Wait()
+}
+
+// ackBatch is a buffer sent on the channel, waiting for its events to be acknowledged.
+type ackBatch struct {
+	ackID uint64
+	acked chan struct{}
+	data  iterRange
+}
+
+// ackBatches are the buffers sent by a push.
+type ackBatches struct {
+	worker        *ackHecWorker
+	authorization string
+	batches       []*ackBatch
+}
+
+// send sends the buffer holding the data of the range, and tracks its ack ID.
+func (b *ackBatches) send(ctx context.Context, buf buffer, headers map[string]string, data iterRange) error {
+	ackID, err := b.worker.post(ctx, buf, headers)
+	if err != nil {
+		return err
+	}
+	b.batches = append(b.batches, &ackBatch{ackID: ackID, acked: b.worker.tracker.register(b.authorization, ackID), data: data})
+	return nil
+}
+
+// wait waits for the acknowledgement of the buffers sent, and returns the ranges of the data of the
+// buffers which were not acknowledged, with the reason. A timeout is returned as a retryable error,
+// the events of these buffers being sent again as their indexing can't be confirmed.
+func (b *ackBatches) wait(ctx context.Context) ([]iterRange, error) {
+	if b == nil || len(b.batches) == 0 {
+		return nil, nil
+	}
+	unacked, err := b.worker.tracker.wait(ctx, b.authorization, b.batches)
+	ranges := make([]iterRange, 0, len(unacked))
+	for _, batch := range unacked {
+		ranges = append(ranges, batch.data)
+	}
+	return ranges, err
+}
+
+// wait blocks until the batches have been acknowledged, or the timeout elapsed, and returns the
+// batches which were not acknowledged with the reason. The batches are untracked once it returns.
+func (t *ackTracker) wait(ctx context.Context, authorization string, batches []*ackBatch) ([]*ackBatch, error) {
+	defer func() {
+		for _, batch := range batches {
+			t.unregister(authorization, batch.ackID)
+		}
+	}()
+
+	timer := time.NewTimer(t.timeout)
+	defer timer.Stop()
+	for i, batch := range batches {
+		var err error
+		select {
+		case <-batch.acked:
+			continue
+		case <-timer.C:
+			err = fmt.Errorf("not acknowledged within %s", t.timeout)
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-t.stopCh:
+			err = errAckTrackerShutdown
+		}
+		// the batches acknowledged meanwhile aren't returned
+		var unacked []*ackBatch
+		var ids []uint64
+		for _, b := range batches[i:] {
+			select {
+			case <-b.acked:
+			default:
+				unacked = append(unacked, b)
+				ids = append(ids, b.ackID)
+			}
+		}
+		if len(unacked) == 0 {
+			return nil, nil
+		}
+		return unacked, fmt.Errorf("events with ackIds %v: %w", ids, err)
+	}
+	return nil, nil
+}
+
+func (t *ackTracker) register(authorization string, ackID uint64) chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ids, ok := t.pending[authorization]
+	if !ok {
+		ids = map[uint64]chan struct{}{}
+		t.pending[authorization] = ids
+	}
+	acked := make(chan struct{})
+	ids[ackID] = acked
+	return acked
+}
+
+func (t *ackTracker) unregister(authorization string, ackID uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if ids, ok := t.pending[authorization]; ok {
+		delete(ids, ackID)
+		if len(ids) == 0 {
+			delete(t.pending, authorization)
+		}
+	}
+}
+
+// poll queries the status of the pending ack IDs of each token, and releases the acknowledged ones.
+func (t *ackTracker) poll() {
+	t.mu.Lock()
+	pending := make(map[string][]uint64, len(t.pending))
+	for authorization, ids := range t.pending {
+		for id := range ids {
+			pending[authorization] = append(pending[authorization], id)
+		}
+	}
+	t.mu.Unlock()
+
+	for authorization, ids := range pending {
+		acks, err := t.queryAcks(authorization, ids)
+		if err != nil {
+			t.logger.Warn("Failed to query the indexer acknowledgements", zap.Error(err), zap.String("host", t.url.String()))
+			continue
+		}
+		t.mu.Lock()
+		for id, acked := range acks {
+			if acked {
+				if ch, ok := t.pending[authorization][id]; ok {
+					close(ch)
+					delete(t.pending[authorization], id)
+				}
+			}
+		}
+		t.mu.Unlock()
+	}
+}
+
+func (t *ackTracker) queryAcks(authorization string, ids []uint64) (map[uint64]bool, error) {
+	body, err := json.Marshal(splunk.AckRequest{Acks: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), t.pollInterval)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+	if err = splunk.HandleHTTPCode(resp); err != nil {
+		return nil, err
+	}
+
+	var ackResp ackResponse
+	if err = json.NewDecoder(resp.Body).Decode(&ackResp); err != nil {
+		return nil, fmt.Errorf("failed to decode the ack response: %w", err)
+	}
+	return ackResp.Acks, nil
+}
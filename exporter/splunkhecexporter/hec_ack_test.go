@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package splunkhecexporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/splunk"
+)
+
+// ackServer mimics the HEC endpoint and the ack API of a Splunk indexer.
+type ackServer struct {
+	mu         sync.Mutex
+	ackEnabled bool
+	indexed    bool
+	// indexedIDs are the ack IDs acknowledged when indexed is false.
+	indexedIDs   map[uint64]bool
+	nextAckID    uint64
+	channels     []string
+	ackRequests  []splunk.AckRequest
+	ackRequested chan struct{}
+}
+
+func (s *ackServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.channels = append(s.channels, r.Header.Get(splunk.HTTPSplunkChannelHeader))
+
+	switch r.URL.Path {
+	case "/services/collector":
+		if !s.ackEnabled {
+			_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"text":"Success","code":0,"ackId":%d}`, s.nextAckID)
+		s.nextAckID++
+	case splunk.DefaultAckPath:
+		var req splunk.AckRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.ackRequests = append(s.ackRequests, req)
+		acks := map[uint64]bool{}
+		for _, id := range req.Acks {
+			acks[id] = s.indexed || s.indexedIDs[id]
+		}
+		_ = json.NewEncoder(w).Encode(ackResponse{Acks: acks})
+		select {
+		case s.ackRequested <- struct{}{}:
+		default:
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newAckTestExporter(t *testing.T, endpoint string, timeout time.Duration, opts ...func(*Config)) exporter.Logs {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.ClientConfig.Endpoint = endpoint + "/services/collector"
+	cfg.QueueSettings.Enabled = false
+	cfg.BackOffConfig.Enabled = false
+	cfg.DisableCompression = true
+	cfg.Token = "1234-1234"
+	cfg.Ack.Enabled = true
+	cfg.Ack.PollInterval = 10 * time.Millisecond
+	cfg.Ack.Timeout = timeout
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	exp, err := factory.CreateLogsExporter(context.Background(), exportertest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	})
+	return exp
+}
+
+func TestAckExporterWaitsForIndexing(t *testing.T) {
+	server := &ackServer{ackEnabled: true, ackRequested: make(chan struct{}, 1)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	exp := newAckTestExporter(t, ts.URL, time.Minute)
+
+	done := make(chan error)
+	go func() {
+		done <- exp.ConsumeLogs(context.Background(), createLogData(1, 1, 1))
+	}()
+
+	// the send isn't complete as long as the events aren't indexed
+	<-server.ackRequested
+	select {
+	case err := <-done:
+		t.Fatalf("send completed before the events were indexed: %v", err)
+	default:
+	}
+	server.mu.Lock()
+	server.indexed = true
+	server.mu.Unlock()
+	require.NoError(t, <-done)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, []uint64{0}, server.ackRequests[0].Acks)
+	require.NotEmpty(t, server.channels)
+	for _, channel := range server.channels {
+		assert.Equal(t, server.channels[0], channel)
+	}
+	assert.NotEmpty(t, server.channels[0])
+}
+
+func TestAckExporterTimeout(t *testing.T) {
+	server := &ackServer{ackEnabled: true, ackRequested: make(chan struct{}, 1)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	exp := newAckTestExporter(t, ts.URL, 50*time.Millisecond)
+
+	err := exp.ConsumeLogs(context.Background(), createLogData(1, 1, 1))
+	assert.ErrorContains(t, err, "events with ackIds [0]: not acknowledged within 50ms")
+	assert.False(t, consumererror.IsPermanent(err))
+}
+
+func TestAckExporterRetriesUnacknowledgedBuffers(t *testing.T) {
+	server := &ackServer{ackEnabled: true, indexedIDs: map[uint64]bool{0: true, 2: true}, ackRequested: make(chan struct{}, 1)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	exp := newAckTestExporter(t, ts.URL, 200*time.Millisecond, func(cfg *Config) {
+		// a buffer per log record
+		cfg.MaxContentLengthLogs = 300
+	})
+
+	err := exp.ConsumeLogs(context.Background(), createLogData(1, 1, 3))
+	assert.ErrorContains(t, err, "events with ackIds [1]: not acknowledged within 200ms")
+	assert.False(t, consumererror.IsPermanent(err))
+
+	// the buffers are all sent before waiting for their acknowledgement
+	server.mu.Lock()
+	assert.Equal(t, uint64(3), server.nextAckID)
+	server.mu.Unlock()
+
+	// only the log record of the buffer which wasn't acknowledged is retried
+	var logsErr consumererror.Logs
+	require.ErrorAs(t, err, &logsErr)
+	retried := logsErr.Data()
+	require.Equal(t, 1, retried.LogRecordCount())
+	name, _ := retried.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(splunk.DefaultNameLabel)
+	assert.Equal(t, "0_0_1", name.Str())
+}
+
+func TestAckExporterAckDisabledOnToken(t *testing.T) {
+	server := &ackServer{ackRequested: make(chan struct{}, 1)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	exp := newAckTestExporter(t, ts.URL, time.Minute)
+
+	err := exp.ConsumeLogs(context.Background(), createLogData(1, 1, 1))
+	assert.ErrorContains(t, err, "indexer acknowledgement must be enabled on the HEC token")
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
+func TestAckTrackerShutdown(t *testing.T) {
+	tracker := newAckTracker(nil, http.DefaultClient, nil, HecAck{PollInterval: time.Hour, Timeout: time.Hour}, nil)
+	tracker.start()
+
+	done := make(chan error)
+	go func() {
+		batch := &ackBatch{ackID: 1, acked: tracker.register("Splunk 1234", 1)}
+		_, err := tracker.wait(context.Background(), "Splunk 1234", []*ackBatch{batch})
+		done <- err
+	}()
+	tracker.shutdown()
+	assert.ErrorIs(t, <-done, errAckTrackerShutdown)
+}
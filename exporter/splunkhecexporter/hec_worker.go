@@ -5,6 +5,9 @@ package splunkhecexporter // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -27,9 +30,29 @@ type defaultHecWorker struct {
 }
 
 func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[string]string) error {
+	resp, err := hec.post(ctx, buf, headers)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Do not drain the response when 429 or 502 status code is returned.
+	// HTTP client will not reuse the same connection unless it is drained.
+	// See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/18281 for more details.
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusBadGateway {
+		if _, errCopy := httputil.DumpResponse(resp, true); errCopy != nil {
+			return errCopy
+		}
+	}
+	return nil
+}
+
+// post sends the buffer to Splunk, and returns the response if it was successful.
+// The caller is responsible for closing the response body.
+func (hec *defaultHecWorker) post(ctx context.Context, buf buffer, headers map[string]string) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, "POST", hec.url.String(), buf)
 	if err != nil {
-		return consumererror.NewPermanent(err)
+		return nil, consumererror.NewPermanent(err)
 	}
 	req.ContentLength = int64(buf.Len())
 
@@ -49,9 +72,8 @@ func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[s
 
 	resp, err := hec.client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
 		hec.logger.Error("Splunk is unable to receive data. Please investigate the health of the cluster", zap.Int("status", resp.StatusCode), zap.String("host", hec.url.String()))
@@ -59,18 +81,49 @@ func (hec *defaultHecWorker) send(ctx context.Context, buf buffer, headers map[s
 
 	err = splunk.HandleHTTPCode(resp)
 	if err != nil {
-		return err
+		resp.Body.Close()
+		return nil, err
 	}
+	return resp, nil
+}
 
-	// Do not drain the response when 429 or 502 status code is returned.
-	// HTTP client will not reuse the same connection unless it is drained.
-	// See https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/18281 for more details.
-	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusBadGateway {
-		if _, errCopy := httputil.DumpResponse(resp, true); errCopy != nil {
-			return errCopy
-		}
+var _ hecWorker = &defaultHecWorker{}
+
+// ackHecWorker sends the events on the channel of the ack tracker. The buffers of a push are all
+// sent before waiting for their acknowledgement, so that the indexing latency isn't paid per buffer.
+type ackHecWorker struct {
+	*defaultHecWorker
+	tracker *ackTracker
+}
+
+// newBatches returns the batches of a push, or nil if the indexer acknowledgement isn't enabled.
+func (hec *ackHecWorker) newBatches(headers map[string]string) *ackBatches {
+	if hec == nil {
+		return nil
 	}
-	return nil
+	authorization := hec.headers["Authorization"]
+	if auth, ok := headers["Authorization"]; ok {
+		authorization = auth
+	}
+	return &ackBatches{worker: hec, authorization: authorization}
 }
 
-var _ hecWorker = &defaultHecWorker{}
+// post sends the buffer and returns the ack ID of its events.
+func (hec *ackHecWorker) post(ctx context.Context, buf buffer, headers map[string]string) (uint64, error) {
+	resp, err := hec.defaultHecWorker.post(ctx, buf, headers)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	var sendResp struct {
+		AckID *uint64 `json:"ackId"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&sendResp); err != nil {
+		return 0, fmt.Errorf("failed to decode the HEC response: %w", err)
+	}
+	if sendResp.AckID == nil {
+		return 0, consumererror.NewPermanent(errors.New("no ackId in the HEC response, indexer acknowledgement must be enabled on the HEC token"))
+	}
+	return *sendResp.AckID, nil
+}
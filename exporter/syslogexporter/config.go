@@ -5,6 +5,7 @@ package syslogexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"go.opentelemetry.io/collector/config/confignet"
@@ -19,6 +20,7 @@ var (
 	errUnsupportedNetwork  = errors.New("unsupported network: network is required, only tcp/udp supported")
 	errUnsupportedProtocol = errors.New("unsupported protocol: Only rfc5424 and rfc3164 supported")
 	errOctetCounting       = errors.New("octet counting is only supported for rfc5424 protocol")
+	errFields              = errors.New("fields are only supported for rfc5424 protocol")
 )
 
 // Config defines configuration for Syslog exporter.
@@ -37,6 +39,9 @@ type Config struct {
 	// Wether or not to enable RFC 6587 Octet Counting.
 	EnableOctetCounting bool `mapstructure:"enable_octet_counting"`
 
+	// Fields configures how the attributes populate the fields of the rfc5424 messages.
+	Fields FieldsConfig `mapstructure:"fields"`
+
 	// TLSSetting struct exposes TLS client configuration.
 	TLSSetting configtls.ClientConfig `mapstructure:"tls"`
 
@@ -72,6 +77,13 @@ func (cfg *Config) Validate() error {
 		invalidFields = append(invalidFields, errOctetCounting)
 	}
 
+	if cfg.Fields.isSet() && cfg.Protocol != protocolRFC5424Str {
+		invalidFields = append(invalidFields, errFields)
+	}
+	if err := cfg.Fields.validate(); err != nil {
+		invalidFields = append(invalidFields, err)
+	}
+
 	if len(invalidFields) > 0 {
 		return errors.Join(invalidFields...)
 	}
@@ -79,6 +91,78 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// FieldsConfig defines the templates of the fields of the rfc5424 messages. A template is literal text and
+// references to attributes, written %{attributes.<key>} for the attributes of the log record and
+// %{resource.<key>} for the attributes of its resource. A field keeps its default mapping if its
+// template is empty.
+type FieldsConfig struct {
+	// AppName is the template of the APP-NAME field.
+	AppName string `mapstructure:"app_name"`
+	// ProcID is the template of the PROCID field.
+	ProcID string `mapstructure:"proc_id"`
+	// MsgID is the template of the MSGID field.
+	MsgID string `mapstructure:"msg_id"`
+	// StructuredData is the list of the SD-ELEMENTs of the messages, the structured_data attribute
+	// is ignored if it is set. The elements with the same SD-ID are grouped into one element.
+	StructuredData []StructuredDataConfig `mapstructure:"structured_data"`
+}
+
+// StructuredDataConfig defines an SD-ELEMENT of the rfc5424 messages.
+type StructuredDataConfig struct {
+	// ID is the SD-ID of the element, for example origin or example@32473.
+	ID string `mapstructure:"id"`
+	// Params maps the names of the SD-PARAMs of the element to their templates. A parameter is omitted
+	// if its template renders empty, an element without parameters is omitted.
+	Params map[string]string `mapstructure:"params"`
+}
+
+func (c FieldsConfig) isSet() bool {
+	return c.AppName != "" || c.ProcID != "" || c.MsgID != "" || len(c.StructuredData) > 0
+}
+
+func (c FieldsConfig) validate() error {
+	for _, field := range []struct{ name, template string }{
+		{"app_name", c.AppName},
+		{"proc_id", c.ProcID},
+		{"msg_id", c.MsgID},
+	} {
+		if _, err := parseFieldTemplate(field.template); err != nil {
+			return fmt.Errorf("invalid fields::%s: %w", field.name, err)
+		}
+	}
+	for _, element := range c.StructuredData {
+		if !isValidSDName(element.ID) {
+			return fmt.Errorf("invalid SD-ID %q: must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '\"'", element.ID)
+		}
+		if len(element.Params) == 0 {
+			return fmt.Errorf("structured data element %q has no params", element.ID)
+		}
+		for name, template := range element.Params {
+			if !isValidSDName(name) {
+				return fmt.Errorf("invalid SD-PARAM name %q of element %q: must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '\"'", name, element.ID)
+			}
+			if _, err := parseFieldTemplate(template); err != nil {
+				return fmt.Errorf("invalid param %q of element %q: %w", name, element.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// isValidSDName checks an SD-NAME as defined by rfc5424.
+func isValidSDName(name string) bool {
+	if len(name) == 0 || len(name) > 32 {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c < 33 || c > 126 || c == '=' || c == ']' || c == '"' {
+			return false
+		}
+	}
+	return true
+}
+
 const (
 	// Syslog Network
 	DefaultNetwork = string(confignet.TransportTypeTCP)
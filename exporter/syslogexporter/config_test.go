@@ -57,6 +57,71 @@ func TestValidate(t *testing.T) {
 			},
 			err: "unsupported protocol: Only rfc5424 and rfc3164 supported",
 		},
+		{
+			name: "Fields",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Fields: FieldsConfig{
+					AppName: "%{resource.service.name}",
+					StructuredData: []StructuredDataConfig{
+						{ID: "origin", Params: map[string]string{"ip": "%{resource.host.ip}"}},
+					},
+				},
+			},
+		},
+		{
+			name: "Fields with rfc3164",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc3164",
+				Fields:   FieldsConfig{AppName: "%{resource.service.name}"},
+			},
+			err: "fields are only supported for rfc5424 protocol",
+		},
+		{
+			name: "Invalid field template",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Fields:   FieldsConfig{MsgID: "%{event.name}"},
+			},
+			err: `invalid fields::msg_id: invalid reference "event.name" in template "%{event.name}", must be attributes.<key> or resource.<key>`,
+		},
+		{
+			name: "Invalid SD-ID",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Fields: FieldsConfig{
+					StructuredData: []StructuredDataConfig{
+						{ID: "my origin", Params: map[string]string{"ip": "%{resource.host.ip}"}},
+					},
+				},
+			},
+			err: `invalid SD-ID "my origin": must be 1 to 32 printable US-ASCII characters except '=', ' ', ']' and '"'`,
+		},
+		{
+			name: "Structured data without params",
+			cfg: &Config{
+				Port:     514,
+				Endpoint: "host.domain.com",
+				Network:  "tcp",
+				Protocol: "rfc5424",
+				Fields: FieldsConfig{
+					StructuredData: []StructuredDataConfig{{ID: "origin"}},
+				},
+			},
+			err: `structured data element "origin" has no params`,
+		},
 	}
 	for _, testInstance := range tests {
 		t.Run(testInstance.name, func(t *testing.T) {
@@ -35,11 +35,16 @@ func initExporter(cfg *Config, createSettings exporter.CreateSettings) (*sysloge
 		}
 	}
 
+	logFormatter, err := createFormatter(cfg.Protocol, cfg.EnableOctetCounting, cfg.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &syslogexporter{
 		config:    cfg,
 		logger:    createSettings.Logger,
 		tlsConfig: loadedTLSConfig,
-		formatter: createFormatter(cfg.Protocol, cfg.EnableOctetCounting),
+		formatter: logFormatter,
 	}
 
 	s.logger.Info("Syslog Exporter configured",
@@ -92,7 +97,7 @@ func (se *syslogexporter) exportBatch(logs plog.Logs) error {
 			scopeLogs := resourceLogs.ScopeLogs().At(j)
 			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
 				logRecord := scopeLogs.LogRecords().At(k)
-				formatted := se.formatter.format(logRecord)
+				formatted := se.formatter.format(logRecord, resourceLogs.Resource())
 				payload.WriteString(formatted)
 			}
 		}
@@ -129,7 +134,7 @@ func (se *syslogexporter) exportNonBatch(logs plog.Logs) error {
 			droppedScopeLogs := droppedResourceLogs.ScopeLogs().AppendEmpty()
 			for k := 0; k < scopeLogs.LogRecords().Len(); k++ {
 				logRecord := scopeLogs.LogRecords().At(k)
-				formatted := se.formatter.format(logRecord)
+				formatted := se.formatter.format(logRecord, resourceLogs.Resource())
 				err = sender.Write(formatted)
 				if err != nil {
 					errs = append(errs, err)
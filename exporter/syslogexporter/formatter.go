@@ -4,18 +4,23 @@
 package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
 
 import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
-func createFormatter(protocol string, octetCounting bool) formatter {
+func createFormatter(protocol string, octetCounting bool, fieldsConfig FieldsConfig) (formatter, error) {
 	if protocol == protocolRFC5424Str {
-		return newRFC5424Formatter(octetCounting)
+		fields, err := newRFC5424Fields(fieldsConfig)
+		if err != nil {
+			return nil, err
+		}
+		return newRFC5424Formatter(octetCounting, fields), nil
 	}
-	return newRFC3164Formatter()
+	return newRFC3164Formatter(), nil
 }
 
 type formatter interface {
-	format(plog.LogRecord) string
+	format(plog.LogRecord, pcommon.Resource) string
 }
 
 // getAttributeValueOrDefault returns the value of the requested log record's attribute as a string.
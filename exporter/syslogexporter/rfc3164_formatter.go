@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
@@ -17,7 +18,7 @@ func newRFC3164Formatter() *rfc3164Formatter {
 	return &rfc3164Formatter{}
 }
 
-func (f *rfc3164Formatter) format(logRecord plog.LogRecord) string {
+func (f *rfc3164Formatter) format(logRecord plog.LogRecord, _ pcommon.Resource) string {
 	priorityString := f.formatPriority(logRecord)
 	timestampString := f.formatTimestamp(logRecord)
 	hostnameString := f.formatHostname(logRecord)
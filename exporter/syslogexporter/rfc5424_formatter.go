@@ -5,24 +5,103 @@ package syslogexporter // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 )
 
+// Maximum lengths of the header fields defined by rfc5424.
+const (
+	maxAppNameLength = 48
+	maxProcIDLength  = 128
+	maxMsgIDLength   = 32
+)
+
 type rfc5424Formatter struct {
 	octetCounting bool
+	fields        *rfc5424Fields
+}
+
+// rfc5424Fields holds the templates of the fields configured with FieldsConfig, a nil template keeps
+// the default mapping of its field.
+type rfc5424Fields struct {
+	appName        *fieldTemplate
+	procID         *fieldTemplate
+	msgID          *fieldTemplate
+	structuredData []sdElementTemplate
+}
+
+type sdElementTemplate struct {
+	id     string
+	params []sdParamTemplate
 }
 
-func newRFC5424Formatter(octetCounting bool) *rfc5424Formatter {
+type sdParamTemplate struct {
+	name     string
+	template *fieldTemplate
+}
+
+func newRFC5424Formatter(octetCounting bool, fields *rfc5424Fields) *rfc5424Formatter {
 	return &rfc5424Formatter{
 		octetCounting: octetCounting,
+		fields:        fields,
+	}
+}
+
+// newRFC5424Fields parses the templates of the fields, the elements with the same SD-ID are grouped
+// into one element.
+func newRFC5424Fields(cfg FieldsConfig) (*rfc5424Fields, error) {
+	if !cfg.isSet() {
+		return nil, nil
+	}
+	fields := &rfc5424Fields{}
+	for _, field := range []struct {
+		template string
+		parsed   **fieldTemplate
+	}{
+		{cfg.AppName, &fields.appName},
+		{cfg.ProcID, &fields.procID},
+		{cfg.MsgID, &fields.msgID},
+	} {
+		if field.template == "" {
+			continue
+		}
+		parsed, err := parseFieldTemplate(field.template)
+		if err != nil {
+			return nil, err
+		}
+		*field.parsed = parsed
+	}
+
+	elements := map[string]int{}
+	for _, element := range cfg.StructuredData {
+		i, ok := elements[element.ID]
+		if !ok {
+			i = len(fields.structuredData)
+			elements[element.ID] = i
+			fields.structuredData = append(fields.structuredData, sdElementTemplate{id: element.ID})
+		}
+		names := make([]string, 0, len(element.Params))
+		for name := range element.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			parsed, err := parseFieldTemplate(element.Params[name])
+			if err != nil {
+				return nil, err
+			}
+			fields.structuredData[i].params = append(fields.structuredData[i].params, sdParamTemplate{name: name, template: parsed})
+		}
 	}
+	return fields, nil
 }
 
-func (f *rfc5424Formatter) format(logRecord plog.LogRecord) string {
+func (f *rfc5424Formatter) format(logRecord plog.LogRecord, resource pcommon.Resource) string {
 	priorityString := f.formatPriority(logRecord)
 	versionString := f.formatVersion(logRecord)
 	timestampString := f.formatTimestamp(logRecord)
@@ -31,6 +110,14 @@ func (f *rfc5424Formatter) format(logRecord plog.LogRecord) string {
 	pidString := f.formatPid(logRecord)
 	messageIDString := f.formatMessageID(logRecord)
 	structuredData := f.formatStructuredData(logRecord)
+	if f.fields != nil {
+		appnameString = f.fields.renderHeaderField(f.fields.appName, logRecord, resource, maxAppNameLength, appnameString)
+		pidString = f.fields.renderHeaderField(f.fields.procID, logRecord, resource, maxProcIDLength, pidString)
+		messageIDString = f.fields.renderHeaderField(f.fields.msgID, logRecord, resource, maxMsgIDLength, messageIDString)
+		if len(f.fields.structuredData) > 0 {
+			structuredData = f.fields.renderStructuredData(logRecord, resource)
+		}
+	}
 	messageString := f.formatMessage(logRecord)
 	formatted := fmt.Sprintf("<%s>%s %s %s %s %s %s %s%s\n", priorityString, versionString, timestampString, hostnameString, appnameString, pidString, messageIDString, structuredData, messageString)
 
@@ -104,3 +191,49 @@ func (f *rfc5424Formatter) formatMessage(logRecord plog.LogRecord) string {
 	}
 	return formatted
 }
+
+// renderHeaderField renders the template of a header field, the characters that are not printable US-ASCII
+// are replaced with '_' and the value is truncated to the maximum length of the field.
+func (fields *rfc5424Fields) renderHeaderField(template *fieldTemplate, logRecord plog.LogRecord, resource pcommon.Resource, maxLength int, defaultValue string) string {
+	if template == nil {
+		return defaultValue
+	}
+	rendered := []byte(template.render(logRecord, resource))
+	if len(rendered) == 0 {
+		return emptyValue
+	}
+	if len(rendered) > maxLength {
+		rendered = rendered[:maxLength]
+	}
+	for i, c := range rendered {
+		if c < 33 || c > 126 {
+			rendered[i] = '_'
+		}
+	}
+	return string(rendered)
+}
+
+func (fields *rfc5424Fields) renderStructuredData(logRecord plog.LogRecord, resource pcommon.Resource) string {
+	var sd strings.Builder
+	for _, element := range fields.structuredData {
+		var params strings.Builder
+		for _, param := range element.params {
+			value := param.template.render(logRecord, resource)
+			if value == "" {
+				continue
+			}
+			fmt.Fprintf(&params, " %s=\"%s\"", param.name, sdParamValueEscaper.Replace(value))
+		}
+		if params.Len() == 0 {
+			continue
+		}
+		sd.WriteString("[" + element.id + params.String() + "]")
+	}
+	if sd.Len() == 0 {
+		return emptyValue
+	}
+	return sd.String()
+}
+
+// sdParamValueEscaper escapes the characters of a PARAM-VALUE as required by rfc5424.
+var sdParamValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
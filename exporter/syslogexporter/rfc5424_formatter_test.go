@@ -29,9 +29,9 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual := newRFC5424Formatter(false).format(logRecord)
+	actual := newRFC5424Formatter(false, nil).format(logRecord, pcommon.NewResource())
 	assert.Equal(t, expected, actual)
-	octetCounting := newRFC5424Formatter(true).format(logRecord)
+	octetCounting := newRFC5424Formatter(true, nil).format(logRecord, pcommon.NewResource())
 	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), octetCounting)
 
 	expected = "<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog 111 ID47 - BOMAn application event log entry...\n"
@@ -47,9 +47,9 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord, pcommon.NewResource())
 	assert.Equal(t, expected, actual)
-	octetCounting = newRFC5424Formatter(true).format(logRecord)
+	octetCounting = newRFC5424Formatter(true, nil).format(logRecord, pcommon.NewResource())
 	assert.Equal(t, fmt.Sprintf("%d %s", len(expected), expected), octetCounting)
 
 	// Test structured data
@@ -76,7 +76,7 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord, pcommon.NewResource())
 	assert.NoError(t, err)
 	matched, err := regexp.MatchString(expectedRegex, actual)
 	assert.NoError(t, err)
@@ -93,6 +93,94 @@ func TestRFC5424Formatter(t *testing.T) {
 	require.NoError(t, err)
 	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
-	actual = newRFC5424Formatter(false).format(logRecord)
+	actual = newRFC5424Formatter(false, nil).format(logRecord, pcommon.NewResource())
 	assert.Equal(t, expected, actual)
 }
+
+func TestRFC5424FormatterFields(t *testing.T) {
+	fields, err := newRFC5424Fields(FieldsConfig{
+		AppName: "%{resource.service.name}",
+		ProcID:  "%{attributes.process.pid}",
+		MsgID:   "%{attributes.event.name}",
+		StructuredData: []StructuredDataConfig{
+			{
+				ID: "origin",
+				Params: map[string]string{
+					"ip":       "%{resource.host.ip}",
+					"software": "otelcol",
+				},
+			},
+			{
+				ID: "siem@32473",
+				Params: map[string]string{
+					"user":    "%{attributes.user}",
+					"missing": "%{attributes.missing}",
+				},
+			},
+			{
+				ID: "origin",
+				Params: map[string]string{
+					"enterpriseId": "32473",
+				},
+			},
+			{
+				ID: "empty@32473",
+				Params: map[string]string{
+					"missing": "%{attributes.missing}",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	formatter := newRFC5424Formatter(false, fields)
+
+	logRecord := plog.NewLogRecord()
+	logRecord.Attributes().PutStr("hostname", "192.0.2.1")
+	logRecord.Attributes().PutStr("message", "User logged in")
+	logRecord.Attributes().PutInt("priority", 165)
+	logRecord.Attributes().PutStr("appname", "ignored")
+	logRecord.Attributes().PutInt("process.pid", 8710)
+	logRecord.Attributes().PutStr("event.name", "login")
+	logRecord.Attributes().PutStr("user", `jane "doe" [admin] \ root`)
+	timestamp, err := time.Parse(time.RFC3339Nano, "2003-08-24T05:14:15.000003Z")
+	require.NoError(t, err)
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "auth service")
+	resource.Attributes().PutStr("host.ip", "10.0.0.1")
+
+	expected := `<165>1 2003-08-24T05:14:15.000003Z 192.0.2.1 auth_service 8710 login ` +
+		`[origin ip="10.0.0.1" software="otelcol" enterpriseId="32473"]` +
+		`[siem@32473 user="jane \"doe\" [admin\] \\ root"] User logged in` + "\n"
+	assert.Equal(t, expected, formatter.format(logRecord, resource))
+
+	// Fields rendering empty are NILVALUE, long fields are truncated
+	logRecord = plog.NewLogRecord()
+	logRecord.Attributes().PutStr("event.name", strings.Repeat("x", 40))
+	timestamp, err = time.Parse(time.RFC3339Nano, "2003-08-24T05:14:15.000003Z")
+	require.NoError(t, err)
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	expected = "<165>1 2003-08-24T05:14:15.000003Z - - - " + strings.Repeat("x", 32) + " [origin software=\"otelcol\" enterpriseId=\"32473\"]\n"
+	assert.Equal(t, expected, formatter.format(logRecord, pcommon.NewResource()))
+}
+
+func TestRFC5424FormatterFieldsDefaults(t *testing.T) {
+	fields, err := newRFC5424Fields(FieldsConfig{MsgID: "%{attributes.event.name}"})
+	require.NoError(t, err)
+
+	logRecord := plog.NewLogRecord()
+	logRecord.Attributes().PutStr("appname", "myproc")
+	logRecord.Attributes().PutStr("proc_id", "8710")
+	logRecord.Attributes().PutStr("event.name", "ID47")
+	logRecord.Attributes().PutStr("message", "It's time to make the do-nuts.")
+	timestamp, err := time.Parse(time.RFC3339Nano, "2003-08-24T05:14:15.000003Z")
+	require.NoError(t, err)
+	logRecord.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+
+	expected := "<165>1 2003-08-24T05:14:15.000003Z - myproc 8710 ID47 - It's time to make the do-nuts.\n"
+	assert.Equal(t, expected, newRFC5424Formatter(false, fields).format(logRecord, pcommon.NewResource()))
+
+	fields, err = newRFC5424Fields(FieldsConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, fields)
+}
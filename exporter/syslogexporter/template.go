@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/syslogexporter"
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	templateSourceAttributes = "attributes"
+	templateSourceResource   = "resource"
+)
+
+// fieldTemplate renders a field of a syslog message from literal text and references to attributes,
+// written %{attributes.<key>} for the attributes of the log record and %{resource.<key>} for the
+// attributes of its resource. A reference to a missing attribute renders empty.
+type fieldTemplate struct {
+	parts []templatePart
+}
+
+type templatePart struct {
+	literal string
+	source  string
+	key     string
+}
+
+func parseFieldTemplate(template string) (*fieldTemplate, error) {
+	t := &fieldTemplate{}
+	rest := template
+	for rest != "" {
+		start := strings.Index(rest, "%{")
+		if start < 0 {
+			t.parts = append(t.parts, templatePart{literal: rest})
+			break
+		}
+		if start > 0 {
+			t.parts = append(t.parts, templatePart{literal: rest[:start]})
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed reference in template %q", template)
+		}
+		reference := rest[start+2 : start+end]
+		source, key, found := strings.Cut(reference, ".")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid reference %q in template %q, must be attributes.<key> or resource.<key>", reference, template)
+		}
+		if source != templateSourceAttributes && source != templateSourceResource {
+			return nil, fmt.Errorf("invalid reference %q in template %q, must be attributes.<key> or resource.<key>", reference, template)
+		}
+		t.parts = append(t.parts, templatePart{source: source, key: key})
+		rest = rest[start+end+1:]
+	}
+	return t, nil
+}
+
+func (t *fieldTemplate) render(logRecord plog.LogRecord, resource pcommon.Resource) string {
+	var rendered strings.Builder
+	for _, part := range t.parts {
+		switch part.source {
+		case templateSourceAttributes:
+			if value, found := logRecord.Attributes().Get(part.key); found {
+				rendered.WriteString(value.AsString())
+			}
+		case templateSourceResource:
+			if value, found := resource.Attributes().Get(part.key); found {
+				rendered.WriteString(value.AsString())
+			}
+		default:
+			rendered.WriteString(part.literal)
+		}
+	}
+	return rendered.String()
+}
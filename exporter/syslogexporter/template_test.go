@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslogexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestFieldTemplate(t *testing.T) {
+	logRecord := plog.NewLogRecord()
+	logRecord.Attributes().PutStr("event.name", "login")
+	logRecord.Attributes().PutInt("process.pid", 8710)
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("service.name", "auth")
+
+	tests := []struct {
+		template string
+		expected string
+	}{
+		{template: "", expected: ""},
+		{template: "literal", expected: "literal"},
+		{template: "%{resource.service.name}", expected: "auth"},
+		{template: "%{resource.service.name}-%{attributes.event.name}", expected: "auth-login"},
+		{template: "pid:%{attributes.process.pid}!", expected: "pid:8710!"},
+		{template: "%{attributes.missing}", expected: ""},
+		{template: "%{resource.event.name}", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			template, err := parseFieldTemplate(tt.template)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, template.render(logRecord, resource))
+		})
+	}
+}
+
+func TestParseFieldTemplateErrors(t *testing.T) {
+	tests := []struct {
+		template string
+		err      string
+	}{
+		{
+			template: "%{attributes.name",
+			err:      `unclosed reference in template "%{attributes.name"`,
+		},
+		{
+			template: "%{name}",
+			err:      `invalid reference "name" in template "%{name}", must be attributes.<key> or resource.<key>`,
+		},
+		{
+			template: "%{body.name}",
+			err:      `invalid reference "body.name" in template "%{body.name}", must be attributes.<key> or resource.<key>`,
+		},
+		{
+			template: "%{attributes.}",
+			err:      `invalid reference "attributes." in template "%{attributes.}", must be attributes.<key> or resource.<key>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			_, err := parseFieldTemplate(tt.template)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -16,18 +16,22 @@ import (
 	"go.opentelemetry.io/collector/extension/auth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/credentials"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 var _ credentials.PerRPCCredentials = (*PerRPCAuth)(nil)
 
 // PerRPCAuth is a gRPC credentials.PerRPCCredentials implementation that returns an 'authorization' header.
 type PerRPCAuth struct {
-	metadata map[string]string
+	bearerTokenFunc func() string
 }
 
-// GetRequestMetadata returns the request metadata to be used with the RPC.
+// GetRequestMetadata returns the request metadata to be used with the RPC. The current token
+// is read for every RPC, so that renewed tokens are picked up by long-lived connections.
 func (c *PerRPCAuth) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
-	return c.metadata, nil
+	return map[string]string{"authorization": c.bearerTokenFunc()}, nil
 }
 
 // RequireTransportSecurity always returns true for this implementation. Passing bearer tokens in plain-text connections is a bad idea.
@@ -49,7 +53,12 @@ type BearerTokenAuth struct {
 	shutdownCH chan struct{}
 
 	filename string
-	logger   *zap.Logger
+
+	kubernetes *KubernetesConfig
+	// makeClient is used to create the Kubernetes client, for mocking purposes.
+	makeClient func(apiConf k8sconfig.APIConfig) (k8s.Interface, error)
+
+	logger *zap.Logger
 }
 
 var _ auth.Client = (*BearerTokenAuth)(nil)
@@ -62,15 +71,17 @@ func newBearerTokenAuth(cfg *Config, logger *zap.Logger) *BearerTokenAuth {
 		scheme:      cfg.Scheme,
 		tokenString: string(cfg.BearerToken),
 		filename:    cfg.Filename,
+		kubernetes:  cfg.Kubernetes,
+		makeClient:  k8sconfig.MakeClient,
 		logger:      logger,
 	}
 }
 
-// Start of BearerTokenAuth does nothing and returns nil if no filename
-// is specified. Otherwise a routine is started to monitor the file containing
-// the token to be transferred.
+// Start of BearerTokenAuth does nothing and returns nil if neither a filename
+// nor kubernetes are specified. Otherwise a routine is started to monitor the file
+// containing the token to be transferred, or to renew the service account token.
 func (b *BearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
-	if b.filename == "" {
+	if b.filename == "" && b.kubernetes == nil {
 		return nil
 	}
 
@@ -78,6 +89,13 @@ func (b *BearerTokenAuth) Start(ctx context.Context, _ component.Host) error {
 		return fmt.Errorf("bearerToken file monitoring is already running")
 	}
 
+	if b.kubernetes != nil {
+		if err := b.startTokenRequests(ctx); err != nil {
+			return fmt.Errorf("failed to request the service account token: %w", err)
+		}
+		return nil
+	}
+
 	// Read file once
 	b.refreshToken()
 
@@ -135,14 +153,18 @@ func (b *BearerTokenAuth) refreshToken() {
 		b.logger.Error(err.Error())
 		return
 	}
+	b.setToken(string(token))
+}
+
+func (b *BearerTokenAuth) setToken(token string) {
 	b.muTokenString.Lock()
-	b.tokenString = string(token)
+	b.tokenString = token
 	b.muTokenString.Unlock()
 }
 
 // Shutdown of BearerTokenAuth does nothing and returns nil
 func (b *BearerTokenAuth) Shutdown(_ context.Context) error {
-	if b.filename == "" {
+	if b.filename == "" && b.kubernetes == nil {
 		return nil
 	}
 
@@ -158,7 +180,7 @@ func (b *BearerTokenAuth) Shutdown(_ context.Context) error {
 // PerRPCCredentials returns PerRPCAuth an implementation of credentials.PerRPCCredentials that
 func (b *BearerTokenAuth) PerRPCCredentials() (credentials.PerRPCCredentials, error) {
 	return &PerRPCAuth{
-		metadata: map[string]string{"authorization": b.bearerToken()},
+		bearerTokenFunc: b.bearerToken,
 	}, nil
 }
 
@@ -187,7 +209,9 @@ func (b *BearerTokenAuth) Authenticate(ctx context.Context, headers map[string][
 		return ctx, errors.New("authentication didn't succeed")
 	}
 	token := auth[0]
+	b.muTokenString.RLock()
 	expect := b.tokenString
+	b.muTokenString.RUnlock()
 	if len(b.scheme) != 0 {
 		expect = fmt.Sprintf("%s %s", b.scheme, expect)
 	}
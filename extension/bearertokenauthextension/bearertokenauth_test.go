@@ -22,7 +22,7 @@ func TestPerRPCAuth(t *testing.T) {
 	}
 
 	// test meta data is properly
-	perRPCAuth := &PerRPCAuth{metadata: metadata}
+	perRPCAuth := &PerRPCAuth{bearerTokenFunc: func() string { return metadata["authorization"] }}
 	md, err := perRPCAuth.GetRequestMetadata(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, md, metadata)
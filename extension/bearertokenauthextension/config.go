@@ -5,9 +5,22 @@ package bearertokenauthextension // import "github.com/open-telemetry/openteleme
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/confmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+const (
+	kubernetesKey = "kubernetes"
+
+	defaultTokenExpiration = time.Hour
+	// minTokenExpiration is the shortest validity the Kubernetes API server accepts for a token.
+	minTokenExpiration = 10 * time.Minute
 )
 
 // Config specifies how the Per-RPC bearer token based authentication data should be obtained.
@@ -21,15 +34,76 @@ type Config struct {
 
 	// Filename points to a file that contains the bearer token to use for every RPC.
 	Filename string `mapstructure:"filename,omitempty"`
+
+	// Kubernetes configures the extension to request short-lived, audience-bound service
+	// account tokens with the Kubernetes TokenRequest API, and to renew them before they expire.
+	Kubernetes *KubernetesConfig `mapstructure:"kubernetes,omitempty"`
+}
+
+// KubernetesConfig specifies the service account tokens to request.
+type KubernetesConfig struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// Namespace of the service account.
+	Namespace string `mapstructure:"namespace"`
+
+	// ServiceAccount is the name of the service account to request tokens for.
+	ServiceAccount string `mapstructure:"service_account"`
+
+	// Audiences are the intended audiences of the tokens. Defaults to the audiences of the API server.
+	Audiences []string `mapstructure:"audiences"`
+
+	// Expiration is the requested validity of the tokens. Defaults to 1h.
+	// The tokens are renewed after 80% of their actual validity.
+	Expiration time.Duration `mapstructure:"expiration"`
 }
 
 var _ component.Config = (*Config)(nil)
-var errNoTokenProvided = errors.New("no bearer token provided")
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+var (
+	errNoTokenProvided        = errors.New("no bearer token provided")
+	errConflictingKubernetes  = errors.New("kubernetes can't be combined with token or filename")
+	errMissingNamespace       = errors.New("kubernetes namespace must be specified")
+	errMissingServiceAccount  = errors.New("kubernetes service_account must be specified")
+	errInvalidTokenExpiration = fmt.Errorf("kubernetes expiration must be at least %s", minTokenExpiration)
+)
+
+// Unmarshal applies the defaults of the kubernetes section when it is set.
+func (cfg *Config) Unmarshal(conf *confmap.Conf) error {
+	if conf.IsSet(kubernetesKey) && cfg.Kubernetes == nil {
+		cfg.Kubernetes = &KubernetesConfig{
+			APIConfig:  k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+			Expiration: defaultTokenExpiration,
+		}
+	}
+	return conf.Unmarshal(cfg)
+}
 
 // Validate checks if the extension configuration is valid
 func (cfg *Config) Validate() error {
+	if cfg.Kubernetes != nil {
+		if cfg.BearerToken != "" || cfg.Filename != "" {
+			return errConflictingKubernetes
+		}
+		return nil
+	}
 	if cfg.BearerToken == "" && cfg.Filename == "" {
 		return errNoTokenProvided
 	}
 	return nil
 }
+
+// Validate checks if the kubernetes configuration is valid
+func (cfg *KubernetesConfig) Validate() error {
+	if cfg.Namespace == "" {
+		return errMissingNamespace
+	}
+	if cfg.ServiceAccount == "" {
+		return errMissingServiceAccount
+	}
+	if cfg.Expiration < minTokenExpiration {
+		return errInvalidTokenExpiration
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package bearertokenauthextension
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -41,6 +43,27 @@ func TestLoadConfig(t *testing.T) {
 				BearerToken: "my-token",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "kubernetes"),
+			expected: &Config{
+				Scheme: defaultScheme,
+				Kubernetes: &KubernetesConfig{
+					APIConfig:      k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+					Namespace:      "observability",
+					ServiceAccount: "otelcol",
+					Audiences:      []string{"otlp-gateway"},
+					Expiration:     time.Hour,
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "kubernetesshortexpiration"),
+			expectedErr: true,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "kubernetesandtoken"),
+			expectedErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bearertokenauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/bearertokenauthextension"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	tokenRequestTimeout       = 30 * time.Second
+	tokenRequestRetryInterval = 10 * time.Second
+)
+
+// tokenRequester requests service account tokens with the Kubernetes TokenRequest API.
+type tokenRequester struct {
+	client k8s.Interface
+	cfg    *KubernetesConfig
+}
+
+// request returns a new token and when it should be renewed, i.e. after 80% of its validity
+// like the kubelet does for projected service account tokens.
+func (r *tokenRequester) request(ctx context.Context) (string, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, tokenRequestTimeout)
+	defer cancel()
+
+	expirationSeconds := int64(r.cfg.Expiration / time.Second)
+	tokenRequest, err := r.client.CoreV1().ServiceAccounts(r.cfg.Namespace).CreateToken(ctx, r.cfg.ServiceAccount, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         r.cfg.Audiences,
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", 0, err
+	}
+	if tokenRequest.Status.Token == "" {
+		return "", 0, errors.New("the API server returned an empty token")
+	}
+	return tokenRequest.Status.Token, time.Until(tokenRequest.Status.ExpirationTimestamp.Time) * 4 / 5, nil
+}
+
+// startTokenRequests requests the first token and starts a routine renewing it.
+func (b *BearerTokenAuth) startTokenRequests(ctx context.Context) error {
+	client, err := b.makeClient(b.kubernetes.APIConfig)
+	if err != nil {
+		return err
+	}
+	requester := &tokenRequester{client: client, cfg: b.kubernetes}
+
+	token, renewAfter, err := requester.request(ctx)
+	if err != nil {
+		return err
+	}
+	b.setToken(token)
+
+	b.shutdownCH = make(chan struct{})
+	go b.renewTokens(requester, renewAfter, b.shutdownCH)
+	return nil
+}
+
+func (b *BearerTokenAuth) renewTokens(requester *tokenRequester, renewAfter time.Duration, shutdownCH chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	timer := time.NewTimer(renewAfter)
+	defer timer.Stop()
+	for {
+		select {
+		case <-shutdownCH:
+			return
+		case <-timer.C:
+			token, renewAfter, err := requester.request(ctx)
+			if err != nil {
+				b.logger.Error("failed to renew the service account token", zap.Error(err))
+				timer.Reset(tokenRequestRetryInterval)
+				continue
+			}
+			b.logger.Debug("renewed the service account token", zap.Duration("renew_after", renewAfter))
+			b.setToken(token)
+			timer.Reset(renewAfter)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bearertokenauthextension
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap/zaptest"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func newKubernetesBearerTokenAuth(t *testing.T, validity time.Duration, reactor func(request *authenticationv1.TokenRequest) error) *BearerTokenAuth {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Kubernetes = &KubernetesConfig{
+		APIConfig:      k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+		Namespace:      "observability",
+		ServiceAccount: "otelcol",
+		Audiences:      []string{"otlp-gateway"},
+		Expiration:     defaultTokenExpiration,
+	}
+
+	client := fake.NewSimpleClientset()
+	var count atomic.Int32
+	client.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		createAction := action.(k8stesting.CreateAction)
+		if createAction.GetSubresource() != "token" || createAction.GetNamespace() != "observability" {
+			return true, nil, errors.New("unexpected request")
+		}
+		request := createAction.GetObject().(*authenticationv1.TokenRequest)
+		if err := reactor(request); err != nil {
+			return true, nil, err
+		}
+		request.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", count.Add(1)),
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(validity)),
+		}
+		return true, request, nil
+	})
+
+	bauth := newBearerTokenAuth(cfg, zaptest.NewLogger(t))
+	bauth.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return client, nil
+	}
+	return bauth
+}
+
+func TestBearerTokenKubernetesRenewal(t *testing.T) {
+	bauth := newKubernetesBearerTokenAuth(t, 500*time.Millisecond, func(request *authenticationv1.TokenRequest) error {
+		assert.Equal(t, []string{"otlp-gateway"}, request.Spec.Audiences)
+		assert.Equal(t, int64(3600), *request.Spec.ExpirationSeconds)
+		return nil
+	})
+
+	require.NoError(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, bauth.Shutdown(context.Background())) }()
+
+	credential, err := bauth.PerRPCCredentials()
+	require.NoError(t, err)
+	md, err := credential.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Bearer token-1"}, md)
+
+	// The renewed tokens are attached to the next RPCs.
+	assert.Eventually(t, func() bool {
+		md, err = credential.GetRequestMetadata(context.Background())
+		return err == nil && md["authorization"] != "Bearer token-1"
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestBearerTokenKubernetesRetry(t *testing.T) {
+	var failed atomic.Bool
+	bauth := newKubernetesBearerTokenAuth(t, 0, func(*authenticationv1.TokenRequest) error {
+		if failed.CompareAndSwap(false, true) {
+			return nil
+		}
+		return errors.New("forbidden")
+	})
+
+	require.NoError(t, bauth.Start(context.Background(), componenttest.NewNopHost()))
+	// The token is kept while it can't be renewed.
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, "Bearer token-1", bauth.bearerToken())
+	assert.NoError(t, bauth.Shutdown(context.Background()))
+}
+
+func TestBearerTokenKubernetesStartFails(t *testing.T) {
+	bauth := newKubernetesBearerTokenAuth(t, time.Hour, func(*authenticationv1.TokenRequest) error {
+		return errors.New("forbidden")
+	})
+	assert.ErrorContains(t, bauth.Start(context.Background(), componenttest.NewNopHost()), "failed to request the service account token: forbidden")
+
+	bauth.makeClient = func(k8sconfig.APIConfig) (k8s.Interface, error) {
+		return nil, errors.New("no in-cluster configuration")
+	}
+	assert.ErrorContains(t, bauth.Start(context.Background(), componenttest.NewNopHost()), "no in-cluster configuration")
+}
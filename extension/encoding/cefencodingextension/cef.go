@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	cefPrefix = "CEF:"
+
+	attributeCEFVersion            = "cef.version"
+	attributeCEFDeviceVendor       = "cef.device_vendor"
+	attributeCEFDeviceProduct      = "cef.device_product"
+	attributeCEFDeviceVersion      = "cef.device_version"
+	attributeCEFDeviceEventClassID = "cef.device_event_class_id"
+	attributeCEFName               = "cef.name"
+	attributeCEFSeverity           = "cef.severity"
+	attributeCEFExtensions         = "cef.extensions"
+
+	cefExtensionReceiptTime = "rt"
+)
+
+var errNotCEF = errors.New("the event isn't a CEF event")
+
+// unmarshalCEF parses a CEF event into a log record. The syslog header preceding the event, if any, is ignored.
+func unmarshalCEF(event string, lr plog.LogRecord) error {
+	start := strings.Index(event, cefPrefix)
+	if start < 0 {
+		return errNotCEF
+	}
+	fields, extension, ok := splitHeader(event[start+len(cefPrefix):], 7)
+	if !ok {
+		return fmt.Errorf("the CEF event has an incomplete header: %q", event)
+	}
+	version, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("the CEF event has an invalid version %q", fields[0])
+	}
+	extensions, err := parseCEFExtensions(extension)
+	if err != nil {
+		return err
+	}
+
+	attributes := lr.Attributes()
+	attributes.PutInt(attributeCEFVersion, version)
+	attributes.PutStr(attributeCEFDeviceVendor, fields[1])
+	attributes.PutStr(attributeCEFDeviceProduct, fields[2])
+	attributes.PutStr(attributeCEFDeviceVersion, fields[3])
+	attributes.PutStr(attributeCEFDeviceEventClassID, fields[4])
+	attributes.PutStr(attributeCEFName, fields[5])
+	attributes.PutStr(attributeCEFSeverity, fields[6])
+	extensionsMap := attributes.PutEmptyMap(attributeCEFExtensions)
+	for _, kv := range extensions {
+		extensionsMap.PutStr(kv.key, kv.value)
+	}
+
+	lr.Body().SetStr(event)
+	lr.SetSeverityText(fields[6])
+	lr.SetSeverityNumber(severityNumber(fields[6]))
+	if v, ok := extensionsMap.Get(cefExtensionReceiptTime); ok {
+		if ts, ok := parseEventTime(v.Str()); ok {
+			lr.SetTimestamp(ts)
+		}
+	}
+	return nil
+}
+
+type keyValue struct {
+	key   string
+	value string
+}
+
+// parseCEFExtensions parses the space separated key=value pairs of a CEF extension. The values may
+// contain spaces: they end at the last space preceding the next key.
+func parseCEFExtensions(s string) ([]keyValue, error) {
+	s = strings.TrimLeft(s, " ")
+	type position struct {
+		key        string
+		keyStart   int
+		valueStart int
+	}
+	var positions []position
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '=':
+			keyStart := strings.LastIndexByte(s[:i], ' ') + 1
+			if len(positions) > 0 && keyStart < positions[len(positions)-1].valueStart {
+				// The equal sign belongs to the value of the previous key.
+				continue
+			}
+			key := s[keyStart:i]
+			if !isValidKey(key) {
+				continue
+			}
+			positions = append(positions, position{key: key, keyStart: keyStart, valueStart: i + 1})
+		}
+	}
+	if len(positions) == 0 {
+		if strings.TrimSpace(s) != "" {
+			return nil, fmt.Errorf("the CEF extension has no key=value pairs: %q", s)
+		}
+		return nil, nil
+	}
+	if positions[0].keyStart != 0 {
+		return nil, fmt.Errorf("the CEF extension doesn't start with a key: %q", s)
+	}
+
+	extensions := make([]keyValue, len(positions))
+	for i, p := range positions {
+		end := len(s)
+		if i+1 < len(positions) {
+			end = positions[i+1].keyStart
+		}
+		extensions[i] = keyValue{
+			key:   p.key,
+			value: unescapeCEFValue(strings.TrimRight(s[p.valueStart:end], " ")),
+		}
+	}
+	return extensions, nil
+}
+
+func unescapeCEFValue(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case '\\', '=', '|':
+			b.WriteByte(s[i])
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+var cefValueReplacer = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+
+// marshalCEF formats a log record as a CEF event. The header fields are read from the attributes
+// set by unmarshalCEF, with the configured defaults. The name defaults to the body of the record.
+func marshalCEF(cfg *Config, lr plog.LogRecord) (string, error) {
+	attributes := lr.Attributes()
+	severity, ok := attributes.Get(attributeCEFSeverity)
+	var severityValue string
+	if ok {
+		severityValue = severity.AsString()
+	} else if severityValue, ok = severityLevel(lr.SeverityNumber()); !ok {
+		severityValue = "Unknown"
+	}
+
+	var b strings.Builder
+	b.WriteString(cefPrefix)
+	b.WriteString(header(attributes, attributeCEFVersion, "0"))
+	for _, field := range []string{
+		header(attributes, attributeCEFDeviceVendor, cfg.DeviceVendor),
+		header(attributes, attributeCEFDeviceProduct, cfg.DeviceProduct),
+		header(attributes, attributeCEFDeviceVersion, cfg.DeviceVersion),
+		header(attributes, attributeCEFDeviceEventClassID, cfg.DeviceEventClassID),
+		header(attributes, attributeCEFName, lr.Body().AsString()),
+		severityValue,
+	} {
+		b.WriteByte('|')
+		b.WriteString(escapeHeader(field))
+	}
+	b.WriteByte('|')
+
+	first := true
+	writeExtension := func(key, value string) {
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(cefValueReplacer.Replace(value))
+	}
+
+	hasReceiptTime := false
+	if extensions, ok := attributes.Get(attributeCEFExtensions); ok {
+		if extensions.Type() != pcommon.ValueTypeMap {
+			return "", fmt.Errorf("the %s attribute must be a map", attributeCEFExtensions)
+		}
+		var err error
+		extensions.Map().Range(func(k string, v pcommon.Value) bool {
+			if !isValidKey(k) {
+				err = fmt.Errorf("invalid CEF extension key %q", k)
+				return false
+			}
+			hasReceiptTime = hasReceiptTime || k == cefExtensionReceiptTime
+			writeExtension(k, v.AsString())
+			return true
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	if !hasReceiptTime && lr.Timestamp() != 0 {
+		writeExtension(cefExtensionReceiptTime, strconv.FormatInt(lr.Timestamp().AsTime().UnixMilli(), 10))
+	}
+	return b.String(), nil
+}
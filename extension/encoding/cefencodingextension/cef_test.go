@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestUnmarshalCEF(t *testing.T) {
+	event := `<134>Oct 15 10:00:00 fw01 CEF:0|Security|threat\|manager|1.0|100|worm successfully stopped|10|src=10.0.0.1 dst=2.1.2.2 spt=1232 msg=Detected a threat. No action needed\= rt=1728986400000 cs1Label=path cs1=C:\\Windows`
+	lr := plog.NewLogRecord()
+	require.NoError(t, unmarshalCEF(event, lr))
+
+	assert.Equal(t, map[string]any{
+		"cef.version":               int64(0),
+		"cef.device_vendor":         "Security",
+		"cef.device_product":        "threat|manager",
+		"cef.device_version":        "1.0",
+		"cef.device_event_class_id": "100",
+		"cef.name":                  "worm successfully stopped",
+		"cef.severity":              "10",
+		"cef.extensions": map[string]any{
+			"src":      "10.0.0.1",
+			"dst":      "2.1.2.2",
+			"spt":      "1232",
+			"msg":      "Detected a threat. No action needed=",
+			"rt":       "1728986400000",
+			"cs1Label": "path",
+			"cs1":      `C:\Windows`,
+		},
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, event, lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberFatal, lr.SeverityNumber())
+	assert.Equal(t, "10", lr.SeverityText())
+	assert.Equal(t, time.UnixMilli(1728986400000).UTC(), lr.Timestamp().AsTime())
+}
+
+func TestUnmarshalCEFErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		event       string
+		expectedErr string
+	}{
+		{
+			name:        "not CEF",
+			event:       "LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|",
+			expectedErr: "the event isn't a CEF event",
+		},
+		{
+			name:        "incomplete header",
+			event:       "CEF:0|Security|threatmanager|1.0|100",
+			expectedErr: "the CEF event has an incomplete header",
+		},
+		{
+			name:        "invalid version",
+			event:       "CEF:zero|Security|threatmanager|1.0|100|worm|10|",
+			expectedErr: `the CEF event has an invalid version "zero"`,
+		},
+		{
+			name:        "invalid extension",
+			event:       "CEF:0|Security|threatmanager|1.0|100|worm|10|stopped",
+			expectedErr: "the CEF extension has no key=value pairs",
+		},
+		{
+			name:        "extension without key",
+			event:       "CEF:0|Security|threatmanager|1.0|100|worm|10|stopped src=10.0.0.1",
+			expectedErr: "the CEF extension doesn't start with a key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, unmarshalCEF(tt.event, plog.NewLogRecord()), tt.expectedErr)
+		})
+	}
+}
+
+func TestParseCEFExtensions(t *testing.T) {
+	extensions, err := parseCEFExtensions(`msg=a value with spaces  and=escaped\=sign\nnewline act=blocked a=b=c`)
+	require.NoError(t, err)
+	assert.Equal(t, []keyValue{
+		{key: "msg", value: "a value with spaces"},
+		{key: "and", value: "escaped=sign\nnewline"},
+		{key: "act", value: "blocked"},
+		{key: "a", value: "b=c"},
+	}, extensions)
+
+	extensions, err = parseCEFExtensions(" ")
+	require.NoError(t, err)
+	assert.Empty(t, extensions)
+}
+
+func TestMarshalCEF(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+
+	t.Run("from attributes", func(t *testing.T) {
+		lr := plog.NewLogRecord()
+		lr.Body().SetStr("original event")
+		lr.Attributes().PutStr(attributeCEFDeviceVendor, "Security")
+		lr.Attributes().PutStr(attributeCEFDeviceProduct, "threat|manager")
+		lr.Attributes().PutStr(attributeCEFDeviceVersion, "1.0")
+		lr.Attributes().PutStr(attributeCEFDeviceEventClassID, "100")
+		lr.Attributes().PutStr(attributeCEFName, "worm stopped")
+		lr.Attributes().PutStr(attributeCEFSeverity, "High")
+		extensions := lr.Attributes().PutEmptyMap(attributeCEFExtensions)
+		extensions.PutStr("msg", "a=b\nc")
+		extensions.PutInt("spt", 1232)
+		extensions.PutStr("cs1", `C:\Windows`)
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(1728986400000)))
+
+		event, err := marshalCEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, `CEF:0|Security|threat\|manager|1.0|100|worm stopped|High|msg=a\=b\nc spt=1232 cs1=C:\\Windows rt=1728986400000`, event)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		lr := plog.NewLogRecord()
+		lr.Body().SetStr("disk | full")
+		lr.SetSeverityNumber(plog.SeverityNumberWarn)
+
+		event, err := marshalCEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, `CEF:0|OpenTelemetry|Collector||log|disk \| full|6|`, event)
+
+		lr.SetSeverityNumber(plog.SeverityNumberUnspecified)
+		event, err = marshalCEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, `CEF:0|OpenTelemetry|Collector||log|disk \| full|Unknown|`, event)
+	})
+
+	t.Run("invalid extensions", func(t *testing.T) {
+		lr := plog.NewLogRecord()
+		lr.Attributes().PutStr(attributeCEFExtensions, "src=10.0.0.1")
+		_, err := marshalCEF(cfg, lr)
+		assert.EqualError(t, err, "the cef.extensions attribute must be a map")
+
+		lr.Attributes().PutEmptyMap(attributeCEFExtensions).PutStr("source address", "10.0.0.1")
+		_, err = marshalCEF(cfg, lr)
+		assert.EqualError(t, err, `invalid CEF extension key "source address"`)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		event := `CEF:0|Security|threat\|manager|1.0|100|worm successfully stopped|10|src=10.0.0.1 msg=Detected a threat\= cs1=C:\\Windows rt=1728986400000`
+		lr := plog.NewLogRecord()
+		require.NoError(t, unmarshalCEF(event, lr))
+		marshaled, err := marshalCEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, event, marshaled)
+	})
+}
+
+func TestSeverity(t *testing.T) {
+	for severity, expected := range map[string]plog.SeverityNumber{
+		"0":         plog.SeverityNumberInfo,
+		"3":         plog.SeverityNumberInfo,
+		"Low":       plog.SeverityNumberInfo,
+		"5":         plog.SeverityNumberWarn,
+		"Medium":    plog.SeverityNumberWarn,
+		"8":         plog.SeverityNumberError,
+		"high":      plog.SeverityNumberError,
+		"9":         plog.SeverityNumberFatal,
+		"Very-High": plog.SeverityNumberFatal,
+		"11":        plog.SeverityNumberUnspecified,
+		"Unknown":   plog.SeverityNumberUnspecified,
+	} {
+		assert.Equal(t, expected, severityNumber(severity), severity)
+	}
+}
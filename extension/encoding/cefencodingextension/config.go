@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+type Format string
+
+const (
+	// FormatCEF is the ArcSight Common Event Format.
+	FormatCEF Format = "cef"
+	// FormatLEEF is the IBM QRadar Log Event Extended Format.
+	FormatLEEF Format = "leef"
+)
+
+type Config struct {
+	// Format of the events, cef or leef.
+	Format Format `mapstructure:"format"`
+
+	// DeviceVendor, DeviceProduct and DeviceVersion are the header fields of the marshaled
+	// events whose log records don't have the corresponding attribute.
+	DeviceVendor  string `mapstructure:"device_vendor"`
+	DeviceProduct string `mapstructure:"device_product"`
+	DeviceVersion string `mapstructure:"device_version"`
+	// DeviceEventClassID is the event class ID of CEF, or the event ID of LEEF, of the marshaled
+	// events whose log records don't have the corresponding attribute.
+	DeviceEventClassID string `mapstructure:"device_event_class_id"`
+
+	// MarshalingSeparator is appended to each marshaled event.
+	MarshalingSeparator string `mapstructure:"marshaling_separator"`
+	// UnmarshalingSeparator is the regular expression separating the events to unmarshal.
+	UnmarshalingSeparator string `mapstructure:"unmarshaling_separator"`
+}
+
+func (c *Config) Validate() error {
+	switch c.Format {
+	case FormatCEF, FormatLEEF:
+	default:
+		return fmt.Errorf("invalid format %q", c.Format)
+	}
+	if c.DeviceVendor == "" || c.DeviceProduct == "" {
+		return errors.New("device_vendor and device_product must be specified")
+	}
+	if c.DeviceEventClassID == "" {
+		return errors.New("device_event_class_id must be specified")
+	}
+	if c.UnmarshalingSeparator == "" {
+		return errors.New("unmarshaling_separator must be specified")
+	}
+	if _, err := regexp.Compile(c.UnmarshalingSeparator); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name:   "leef",
+			modify: func(cfg *Config) { cfg.Format = FormatLEEF },
+		},
+		{
+			name:        "invalid format",
+			modify:      func(cfg *Config) { cfg.Format = "syslog" },
+			expectedErr: `invalid format "syslog"`,
+		},
+		{
+			name:        "missing vendor",
+			modify:      func(cfg *Config) { cfg.DeviceVendor = "" },
+			expectedErr: "device_vendor and device_product must be specified",
+		},
+		{
+			name:        "missing event class ID",
+			modify:      func(cfg *Config) { cfg.DeviceEventClassID = "" },
+			expectedErr: "device_event_class_id must be specified",
+		},
+		{
+			name:        "missing unmarshaling separator",
+			modify:      func(cfg *Config) { cfg.UnmarshalingSeparator = "" },
+			expectedErr: "unmarshaling_separator must be specified",
+		},
+		{
+			name:        "invalid unmarshaling separator",
+			modify:      func(cfg *Config) { cfg.UnmarshalingSeparator = `??\` },
+			expectedErr: "error parsing regexp",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension"
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding"
+)
+
+var (
+	_ encoding.LogsMarshalerExtension   = (*cefExtension)(nil)
+	_ encoding.LogsUnmarshalerExtension = (*cefExtension)(nil)
+)
+
+type cefExtension struct {
+	config                *Config
+	unmarshalingSeparator *regexp.Regexp
+}
+
+// UnmarshalLogs unmarshals the events separated by the unmarshaling separator, one log record
+// per event. The blank lines are skipped.
+func (e *cefExtension) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	p := plog.NewLogs()
+	logRecords := p.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	unmarshal := unmarshalCEF
+	if e.config.Format == FormatLEEF {
+		unmarshal = unmarshalLEEF
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(buf))
+	s.Split(func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if loc := e.unmarshalingSeparator.FindIndex(data); loc != nil && loc[0] >= 0 {
+			return loc[1], data[0:loc[0]], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+	for s.Scan() {
+		event := strings.TrimSpace(s.Text())
+		if event == "" {
+			continue
+		}
+		lr := logRecords.AppendEmpty()
+		lr.SetObservedTimestamp(now)
+		if err := unmarshal(event, lr); err != nil {
+			return p, err
+		}
+	}
+	return p, s.Err()
+}
+
+func (e *cefExtension) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	marshal := marshalCEF
+	if e.config.Format == FormatLEEF {
+		marshal = marshalLEEF
+	}
+
+	var b []byte
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				event, err := marshal(e.config, sl.LogRecords().At(k))
+				if err != nil {
+					return nil, err
+				}
+				b = append(b, event...)
+				b = append(b, e.config.MarshalingSeparator...)
+			}
+		}
+	}
+	return b, nil
+}
+
+func (e *cefExtension) Start(_ context.Context, _ component.Host) error {
+	var err error
+	e.unmarshalingSeparator, err = regexp.Compile(e.config.UnmarshalingSeparator)
+	return err
+}
+
+func (e *cefExtension) Shutdown(_ context.Context) error {
+	return nil
+}
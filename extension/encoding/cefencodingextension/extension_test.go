@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+func newTestExtension(t *testing.T, format Format) *cefExtension {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Format = format
+	ext, err := NewFactory().CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, ext.Shutdown(context.Background())) })
+	return ext.(*cefExtension)
+}
+
+func TestMarshalUnmarshalLogs(t *testing.T) {
+	for format, events := range map[Format]string{
+		FormatCEF: "CEF:0|Security|threatmanager|1.0|100|worm stopped|10|src=10.0.0.1\n" +
+			"CEF:0|Security|threatmanager|1.0|101|worm detected|5|src=10.0.0.2 msg=multi word message\n",
+		FormatLEEF: "LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src=192.0.2.0\tsev=5\n" +
+			"LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15346|src=192.0.2.1\n",
+	} {
+		t.Run(string(format), func(t *testing.T) {
+			ext := newTestExtension(t, format)
+
+			ld, err := ext.UnmarshalLogs([]byte(events + "\r\n\n"))
+			require.NoError(t, err)
+			require.Equal(t, 2, ld.LogRecordCount())
+			assert.NotZero(t, ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).ObservedTimestamp())
+
+			buf, err := ext.MarshalLogs(ld)
+			require.NoError(t, err)
+			assert.Equal(t, events, string(buf))
+		})
+	}
+}
+
+func TestUnmarshalLogsInvalidEvent(t *testing.T) {
+	ext := newTestExtension(t, FormatCEF)
+	_, err := ext.UnmarshalLogs([]byte("CEF:0|Security|threatmanager|1.0|100|worm stopped|10|src=10.0.0.1\nnot an event"))
+	assert.ErrorIs(t, err, errNotCEF)
+}
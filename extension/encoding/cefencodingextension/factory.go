@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension/internal/metadata"
+)
+
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
+
+func createExtension(_ context.Context, _ extension.CreateSettings, config component.Config) (extension.Extension, error) {
+	return &cefExtension{
+		config: config.(*Config),
+	}, nil
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Format:                FormatCEF,
+		DeviceVendor:          "OpenTelemetry",
+		DeviceProduct:         "Collector",
+		DeviceEventClassID:    "log",
+		MarshalingSeparator:   "\n",
+		UnmarshalingSeparator: "\r?\n",
+	}
+}
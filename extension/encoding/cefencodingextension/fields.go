@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension"
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// splitHeader splits the first n fields of a header delimited by unescaped pipes, and returns
+// them unescaped with the rest of the event.
+func splitHeader(s string, n int) ([]string, string, bool) {
+	fields := make([]string, 0, n)
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && (s[i+1] == '\\' || s[i+1] == '|'):
+			b.WriteByte(s[i+1])
+			i++
+		case c == '|':
+			fields = append(fields, b.String())
+			b.Reset()
+			if len(fields) == n {
+				return fields, s[i+1:], true
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return nil, "", false
+}
+
+var headerReplacer = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+
+func escapeHeader(s string) string {
+	return headerReplacer.Replace(s)
+}
+
+// isValidKey returns whether a string is a valid key of a CEF extension or a LEEF attribute.
+func isValidKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_.-[]", r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// header returns the value of a string attribute, or the default value when the attribute is missing.
+func header(attributes pcommon.Map, key string, defaultValue string) string {
+	if v, ok := attributes.Get(key); ok {
+		return v.AsString()
+	}
+	return defaultValue
+}
+
+// severityNumber maps the severity of an event, from 0 to 10 or from Low to Very-High, to a
+// severity number.
+func severityNumber(severity string) plog.SeverityNumber {
+	switch strings.ToLower(severity) {
+	case "low":
+		return plog.SeverityNumberInfo
+	case "medium":
+		return plog.SeverityNumberWarn
+	case "high":
+		return plog.SeverityNumberError
+	case "very-high":
+		return plog.SeverityNumberFatal
+	}
+	n, err := strconv.Atoi(severity)
+	switch {
+	case err != nil || n < 0 || n > 10:
+		return plog.SeverityNumberUnspecified
+	case n <= 3:
+		return plog.SeverityNumberInfo
+	case n <= 6:
+		return plog.SeverityNumberWarn
+	case n <= 8:
+		return plog.SeverityNumberError
+	default:
+		return plog.SeverityNumberFatal
+	}
+}
+
+// severityLevel maps a severity number to the severity of an event, from 1 to 10.
+func severityLevel(severityNumber plog.SeverityNumber) (string, bool) {
+	switch {
+	case severityNumber == plog.SeverityNumberUnspecified:
+		return "", false
+	case severityNumber < plog.SeverityNumberInfo:
+		return "1", true
+	case severityNumber < plog.SeverityNumberWarn:
+		return "3", true
+	case severityNumber < plog.SeverityNumberError:
+		return "6", true
+	case severityNumber < plog.SeverityNumberFatal:
+		return "8", true
+	default:
+		return "10", true
+	}
+}
+
+var eventTimeLayouts = []string{
+	"Jan 02 2006 15:04:05.000 MST",
+	"Jan 02 2006 15:04:05 MST",
+	"Jan 02 2006 15:04:05.000",
+	"Jan 02 2006 15:04:05",
+	time.RFC3339Nano,
+}
+
+// parseEventTime parses the time of an event, in milliseconds since the epoch or in one of
+// the date formats of CEF and LEEF.
+func parseEventTime(value string) (pcommon.Timestamp, bool) {
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return pcommon.NewTimestampFromTime(time.UnixMilli(ms)), true
+	}
+	for _, layout := range eventTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return pcommon.NewTimestampFromTime(t), true
+		}
+	}
+	return 0, false
+}
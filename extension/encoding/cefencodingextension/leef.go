@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/encoding/cefencodingextension"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	leefPrefix = "LEEF:"
+
+	attributeLEEFVersion        = "leef.version"
+	attributeLEEFVendor         = "leef.vendor"
+	attributeLEEFProduct        = "leef.product"
+	attributeLEEFProductVersion = "leef.product_version"
+	attributeLEEFEventID        = "leef.event_id"
+	attributeLEEFAttributes     = "leef.attributes"
+
+	leefAttributeDeviceTime       = "devTime"
+	leefAttributeDeviceTimeFormat = "devTimeFormat"
+	leefAttributeSeverity         = "sev"
+	leefAttributeMessage          = "msg"
+
+	leefDeviceTimeLayout = "Jan 02 2006 15:04:05.000 MST"
+	leefDeviceTimeFormat = "MMM dd yyyy HH:mm:ss.SSS z"
+)
+
+var errNotLEEF = errors.New("the event isn't a LEEF event")
+
+// unmarshalLEEF parses a LEEF event into a log record. The syslog header preceding the event, if any, is ignored.
+func unmarshalLEEF(event string, lr plog.LogRecord) error {
+	start := strings.Index(event, leefPrefix)
+	if start < 0 {
+		return errNotLEEF
+	}
+	fields, rest, ok := splitHeader(event[start+len(leefPrefix):], 5)
+	if !ok {
+		return fmt.Errorf("the LEEF event has an incomplete header: %q", event)
+	}
+	delimiter := "\t"
+	if fields[0] == "2.0" {
+		// The delimiter field of LEEF 2.0 is optional.
+		if delimiterField, attributesField, ok := strings.Cut(rest, "|"); ok {
+			if d, ok := parseLEEFDelimiter(delimiterField); ok {
+				delimiter, rest = d, attributesField
+			}
+		}
+	}
+
+	attributes := lr.Attributes()
+	attributes.PutStr(attributeLEEFVersion, fields[0])
+	attributes.PutStr(attributeLEEFVendor, fields[1])
+	attributes.PutStr(attributeLEEFProduct, fields[2])
+	attributes.PutStr(attributeLEEFProductVersion, fields[3])
+	attributes.PutStr(attributeLEEFEventID, fields[4])
+	eventAttributes := attributes.PutEmptyMap(attributeLEEFAttributes)
+	for _, attribute := range strings.Split(rest, delimiter) {
+		if strings.TrimSpace(attribute) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(attribute, "=")
+		if !ok || !isValidKey(key) {
+			return fmt.Errorf("the LEEF event has an invalid attribute %q", attribute)
+		}
+		eventAttributes.PutStr(key, value)
+	}
+
+	lr.Body().SetStr(event)
+	if v, ok := eventAttributes.Get(leefAttributeSeverity); ok {
+		lr.SetSeverityText(v.Str())
+		lr.SetSeverityNumber(severityNumber(v.Str()))
+	}
+	if v, ok := eventAttributes.Get(leefAttributeDeviceTime); ok {
+		if ts, ok := parseEventTime(v.Str()); ok {
+			lr.SetTimestamp(ts)
+		}
+	}
+	return nil
+}
+
+// parseLEEFDelimiter parses the delimiter of LEEF 2.0, a character or its hexadecimal code
+// such as x09 or 0x09.
+func parseLEEFDelimiter(s string) (string, bool) {
+	if len(s) == 1 {
+		return s, true
+	}
+	lower := strings.ToLower(s)
+	code, ok := strings.CutPrefix(lower, "0x")
+	if !ok {
+		code, ok = strings.CutPrefix(lower, "x")
+	}
+	if !ok || len(code) != 2 {
+		return "", false
+	}
+	c, err := strconv.ParseUint(code, 16, 8)
+	if err != nil {
+		return "", false
+	}
+	return string(rune(c)), true
+}
+
+var leefValueReplacer = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+
+// marshalLEEF formats a log record as a LEEF event delimited by tabs. The header fields are read
+// from the attributes set by unmarshalLEEF, with the configured defaults. The body of the records
+// which weren't unmarshaled from LEEF is added as the msg attribute.
+func marshalLEEF(cfg *Config, lr plog.LogRecord) (string, error) {
+	attributes := lr.Attributes()
+	version := header(attributes, attributeLEEFVersion, "1.0")
+
+	var b strings.Builder
+	b.WriteString(leefPrefix)
+	b.WriteString(escapeHeader(version))
+	for _, field := range []string{
+		header(attributes, attributeLEEFVendor, cfg.DeviceVendor),
+		header(attributes, attributeLEEFProduct, cfg.DeviceProduct),
+		header(attributes, attributeLEEFProductVersion, cfg.DeviceVersion),
+		header(attributes, attributeLEEFEventID, cfg.DeviceEventClassID),
+	} {
+		b.WriteByte('|')
+		b.WriteString(escapeHeader(field))
+	}
+	b.WriteByte('|')
+	if version == "2.0" {
+		b.WriteString("x09|")
+	}
+
+	first := true
+	written := map[string]bool{}
+	writeAttribute := func(key, value string) {
+		if !first {
+			b.WriteByte('\t')
+		}
+		first = false
+		written[key] = true
+		b.WriteString(key)
+		b.WriteByte('=')
+		b.WriteString(leefValueReplacer.Replace(value))
+	}
+
+	if eventAttributes, ok := attributes.Get(attributeLEEFAttributes); ok {
+		if eventAttributes.Type() != pcommon.ValueTypeMap {
+			return "", fmt.Errorf("the %s attribute must be a map", attributeLEEFAttributes)
+		}
+		var err error
+		eventAttributes.Map().Range(func(k string, v pcommon.Value) bool {
+			if !isValidKey(k) {
+				err = fmt.Errorf("invalid LEEF attribute key %q", k)
+				return false
+			}
+			writeAttribute(k, v.AsString())
+			return true
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	if !written[leefAttributeDeviceTime] && lr.Timestamp() != 0 {
+		writeAttribute(leefAttributeDeviceTime, lr.Timestamp().AsTime().UTC().Format(leefDeviceTimeLayout))
+		if !written[leefAttributeDeviceTimeFormat] {
+			writeAttribute(leefAttributeDeviceTimeFormat, leefDeviceTimeFormat)
+		}
+	}
+	if !written[leefAttributeSeverity] {
+		if severity, ok := severityLevel(lr.SeverityNumber()); ok {
+			writeAttribute(leefAttributeSeverity, severity)
+		}
+	}
+	if _, ok := attributes.Get(attributeLEEFEventID); !ok && !written[leefAttributeMessage] {
+		if body := lr.Body().AsString(); body != "" {
+			writeAttribute(leefAttributeMessage, body)
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package cefencodingextension
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func TestUnmarshalLEEF(t *testing.T) {
+	tests := []struct {
+		name  string
+		event string
+		attrs map[string]any
+	}{
+		{
+			name:  "LEEF 1.0",
+			event: "Oct 15 10:00:00 mail01 LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src=192.0.2.0\tdst=172.50.123.1\tsev=5\tdevTime=Oct 15 2024 10:00:00",
+			attrs: map[string]any{
+				"src":     "192.0.2.0",
+				"dst":     "172.50.123.1",
+				"sev":     "5",
+				"devTime": "Oct 15 2024 10:00:00",
+			},
+		},
+		{
+			name:  "LEEF 2.0 with delimiter",
+			event: "LEEF:2.0|Microsoft|MSExchange|4.0 SP1|15345|^|src=192.0.2.0^dst=172.50.123.1^sev=5^devTime=Oct 15 2024 10:00:00",
+			attrs: map[string]any{
+				"src":     "192.0.2.0",
+				"dst":     "172.50.123.1",
+				"sev":     "5",
+				"devTime": "Oct 15 2024 10:00:00",
+			},
+		},
+		{
+			name:  "LEEF 2.0 with hexadecimal delimiter",
+			event: "LEEF:2.0|Microsoft|MSExchange|4.0 SP1|15345|0x7C|src=192.0.2.0|sev=5|devTime=Oct 15 2024 10:00:00",
+			attrs: map[string]any{
+				"src":     "192.0.2.0",
+				"sev":     "5",
+				"devTime": "Oct 15 2024 10:00:00",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := plog.NewLogRecord()
+			require.NoError(t, unmarshalLEEF(tt.event, lr))
+
+			version := "1.0"
+			if tt.name != "LEEF 1.0" {
+				version = "2.0"
+			}
+			assert.Equal(t, map[string]any{
+				"leef.version":         version,
+				"leef.vendor":          "Microsoft",
+				"leef.product":         "MSExchange",
+				"leef.product_version": "4.0 SP1",
+				"leef.event_id":        "15345",
+				"leef.attributes":      tt.attrs,
+			}, lr.Attributes().AsRaw())
+			assert.Equal(t, tt.event, lr.Body().Str())
+			assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+			assert.Equal(t, "5", lr.SeverityText())
+			assert.Equal(t, time.Date(2024, time.October, 15, 10, 0, 0, 0, time.UTC), lr.Timestamp().AsTime())
+		})
+	}
+}
+
+func TestUnmarshalLEEFErrors(t *testing.T) {
+	assert.ErrorIs(t, unmarshalLEEF("CEF:0|Security|threatmanager|1.0|100|worm|10|", plog.NewLogRecord()), errNotLEEF)
+	assert.ErrorContains(t, unmarshalLEEF("LEEF:1.0|Microsoft|MSExchange", plog.NewLogRecord()), "the LEEF event has an incomplete header")
+	assert.ErrorContains(t, unmarshalLEEF("LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src", plog.NewLogRecord()), `the LEEF event has an invalid attribute "src"`)
+}
+
+func TestMarshalLEEF(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+
+	t.Run("from attributes", func(t *testing.T) {
+		lr := plog.NewLogRecord()
+		lr.Body().SetStr("original event")
+		lr.Attributes().PutStr(attributeLEEFVersion, "2.0")
+		lr.Attributes().PutStr(attributeLEEFVendor, "Microsoft")
+		lr.Attributes().PutStr(attributeLEEFProduct, "MSExchange")
+		lr.Attributes().PutStr(attributeLEEFProductVersion, "4.0 SP1")
+		lr.Attributes().PutStr(attributeLEEFEventID, "15345")
+		attributes := lr.Attributes().PutEmptyMap(attributeLEEFAttributes)
+		attributes.PutStr("src", "192.0.2.0")
+		attributes.PutStr("usrName", "tab\tseparated")
+
+		event, err := marshalLEEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, "LEEF:2.0|Microsoft|MSExchange|4.0 SP1|15345|x09|src=192.0.2.0\tusrName=tab separated", event)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		lr := plog.NewLogRecord()
+		lr.Body().SetStr("disk full")
+		lr.SetSeverityNumber(plog.SeverityNumberError)
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(time.Date(2024, time.October, 15, 10, 0, 0, 0, time.UTC)))
+
+		event, err := marshalLEEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, "LEEF:1.0|OpenTelemetry|Collector||log|devTime=Oct 15 2024 10:00:00.000 UTC\tdevTimeFormat=MMM dd yyyy HH:mm:ss.SSS z\tsev=8\tmsg=disk full", event)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		event := "LEEF:1.0|Microsoft|MSExchange|4.0 SP1|15345|src=192.0.2.0\tdst=172.50.123.1\tsev=5\tdevTime=Oct 15 2024 10:00:00"
+		lr := plog.NewLogRecord()
+		require.NoError(t, unmarshalLEEF(event, lr))
+		marshaled, err := marshalLEEF(cfg, lr)
+		require.NoError(t, err)
+		assert.Equal(t, event, marshaled)
+	})
+}
@@ -10,8 +10,9 @@ import (
 var (
 	errMissingHeader        = fmt.Errorf("missing header name")
 	errMissingHeadersConfig = fmt.Errorf("missing headers configuration")
-	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context' or 'value'")
-	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context' or 'value'")
+	errMissingSource        = fmt.Errorf("missing header source, must be 'from_context', 'from_auth' or 'value'")
+	errConflictingSources   = fmt.Errorf("invalid header source, must either 'from_context', 'from_auth' or 'value'")
+	errUnexpectedDefault    = fmt.Errorf("invalid header default, 'default_value' requires 'from_context' or 'from_auth'")
 )
 
 type Config struct {
@@ -19,10 +20,12 @@ type Config struct {
 }
 
 type HeaderConfig struct {
-	Action      ActionValue `mapstructure:"action"`
-	Key         *string     `mapstructure:"key"`
-	Value       *string     `mapstructure:"value"`
-	FromContext *string     `mapstructure:"from_context"`
+	Action       ActionValue `mapstructure:"action"`
+	Key          *string     `mapstructure:"key"`
+	Value        *string     `mapstructure:"value"`
+	FromContext  *string     `mapstructure:"from_context"`
+	FromAuth     *string     `mapstructure:"from_auth"`
+	DefaultValue *string     `mapstructure:"default_value"`
 }
 
 // ActionValue is the enum to capture the four types of actions to perform on a header
@@ -54,12 +57,21 @@ func (cfg *Config) Validate() error {
 		}
 
 		if header.Action != DELETE {
-			if header.FromContext == nil && header.Value == nil {
+			sources := 0
+			for _, source := range []*string{header.Value, header.FromContext, header.FromAuth} {
+				if source != nil {
+					sources++
+				}
+			}
+			if sources == 0 {
 				return errMissingSource
 			}
-			if header.FromContext != nil && header.Value != nil {
+			if sources > 1 {
 				return errConflictingSources
 			}
+			if header.DefaultValue != nil && header.Value != nil {
+				return errUnexpectedDefault
+			}
 		}
 	}
 	return nil
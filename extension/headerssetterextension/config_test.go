@@ -53,6 +53,12 @@ func TestLoadConfig(t *testing.T) {
 						Key:    stringp("User-ID"),
 						Action: DELETE,
 					},
+					{
+						Key:          stringp("X-Tenant"),
+						Action:       UPSERT,
+						FromAuth:     stringp("tenant"),
+						DefaultValue: stringp("anonymous"),
+					},
 				},
 			},
 		},
@@ -106,6 +112,18 @@ func TestValidateConfig(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			"header value from auth with default",
+			[]HeaderConfig{
+				{
+					Key:          stringp("name"),
+					Action:       INSERT,
+					FromAuth:     stringp("tenant"),
+					DefaultValue: stringp("anonymous"),
+				},
+			},
+			nil,
+		},
 		{
 			"missing header name for from value",
 			[]HeaderConfig{
@@ -138,6 +156,30 @@ func TestValidateConfig(t *testing.T) {
 			},
 			errConflictingSources,
 		},
+		{
+			"header value from context and auth",
+			[]HeaderConfig{
+				{
+					Key:         stringp("name"),
+					Action:      INSERT,
+					FromContext: stringp("from context"),
+					FromAuth:    stringp("from auth"),
+				},
+			},
+			errConflictingSources,
+		},
+		{
+			"header default with value",
+			[]HeaderConfig{
+				{
+					Key:          stringp("name"),
+					Action:       INSERT,
+					Value:        stringp("from config"),
+					DefaultValue: stringp("default"),
+				},
+			},
+			errUnexpectedDefault,
+		},
 		{
 			"header value source is missing",
 			[]HeaderConfig{
@@ -12,6 +12,20 @@ import (
 	"go.opentelemetry.io/collector/client"
 )
 
+type mockAuthData map[string]any
+
+func (m mockAuthData) GetAttribute(name string) any {
+	return m[name]
+}
+
+func (m mockAuthData) GetAttributeNames() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
+
 type mockRoundTripper struct{}
 
 func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -37,6 +51,7 @@ func TestRoundTripper(t *testing.T) {
 				context.Background(),
 				client.Info{
 					Metadata: tt.metadata,
+					Auth:     tt.auth,
 				},
 			)
 			req, err := http.NewRequestWithContext(ctx, "GET", "", nil)
@@ -70,7 +85,7 @@ func TestPerRPCCredentials(t *testing.T) {
 
 			ctx := client.NewContext(
 				context.Background(),
-				client.Info{Metadata: tt.metadata},
+				client.Info{Metadata: tt.metadata, Auth: tt.auth},
 			)
 
 			metadata, err := perRPC.GetRequestMetadata(ctx)
@@ -94,6 +109,7 @@ var (
 	tests         = []struct {
 		cfg             *Config
 		metadata        client.Metadata
+		auth            client.AuthData
 		expectedHeaders map[string]string
 	}{
 		{
@@ -218,6 +234,43 @@ var (
 				"header_name": "",
 			},
 		},
+		{
+			cfg: &Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:      &header,
+						Action:   INSERT,
+						FromAuth: stringp("tenant"),
+					},
+				},
+			},
+			auth: mockAuthData{"tenant": "acme"},
+			expectedHeaders: map[string]string{
+				"header_name": "acme",
+			},
+		},
+		{
+			cfg: &Config{
+				HeadersConfig: []HeaderConfig{
+					{
+						Key:          &header,
+						Action:       INSERT,
+						FromAuth:     stringp("tenant"),
+						DefaultValue: stringp("anonymous"),
+					},
+					{
+						Key:          &anotherHeader,
+						Action:       INSERT,
+						FromContext:  stringp("tenant"),
+						DefaultValue: stringp("anonymous"),
+					},
+				},
+			},
+			expectedHeaders: map[string]string{
+				"header_name":         "anonymous",
+				"another_header_name": "anonymous",
+			},
+		},
 	}
 )
 
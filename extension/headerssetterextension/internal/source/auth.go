@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/headerssetterextension/internal/source"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/client"
+)
+
+var _ Source = (*AuthSource)(nil)
+
+// AuthSource reads the header value from an attribute of the authentication data of
+// the incoming request, as set by the server authenticator of the receiver.
+type AuthSource struct {
+	Key          string
+	DefaultValue *string
+}
+
+func (ts *AuthSource) Get(ctx context.Context) (string, error) {
+	cl := client.FromContext(ctx)
+	if cl.Auth == nil {
+		return defaultValue(ts.DefaultValue), nil
+	}
+
+	var value string
+	switch v := cl.Auth.GetAttribute(ts.Key).(type) {
+	case nil:
+	case string:
+		value = v
+	case []string:
+		if len(v) > 1 {
+			return "", fmt.Errorf("%d values found for the auth attribute %q, can't determine which one to use", len(v), ts.Key)
+		}
+		if len(v) == 1 {
+			value = v[0]
+		}
+	default:
+		value = fmt.Sprint(v)
+	}
+
+	if value == "" {
+		return defaultValue(ts.DefaultValue), nil
+	}
+	return value, nil
+}
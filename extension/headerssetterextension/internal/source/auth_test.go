@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/client"
+)
+
+type mockAuthData map[string]any
+
+func (m mockAuthData) GetAttribute(name string) any {
+	return m[name]
+}
+
+func (m mockAuthData) GetAttributeNames() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestAuthSource(t *testing.T) {
+	defaultValue := "anonymous"
+	tests := []struct {
+		name        string
+		source      *AuthSource
+		auth        client.AuthData
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "string",
+			source:   &AuthSource{Key: "tenant"},
+			auth:     mockAuthData{"tenant": "acme"},
+			expected: "acme",
+		},
+		{
+			name:     "single value",
+			source:   &AuthSource{Key: "tenant"},
+			auth:     mockAuthData{"tenant": []string{"acme"}},
+			expected: "acme",
+		},
+		{
+			name:     "non string value",
+			source:   &AuthSource{Key: "tenant"},
+			auth:     mockAuthData{"tenant": 42},
+			expected: "42",
+		},
+		{
+			name:        "multiple values",
+			source:      &AuthSource{Key: "tenant"},
+			auth:        mockAuthData{"tenant": []string{"acme", "globex"}},
+			expectedErr: true,
+		},
+		{
+			name:   "not found",
+			source: &AuthSource{Key: "tenant"},
+			auth:   mockAuthData{"subject": "acme"},
+		},
+		{
+			name:     "not found with default",
+			source:   &AuthSource{Key: "tenant", DefaultValue: &defaultValue},
+			auth:     mockAuthData{"subject": "acme"},
+			expected: "anonymous",
+		},
+		{
+			name:     "no auth data with default",
+			source:   &AuthSource{Key: "tenant", DefaultValue: &defaultValue},
+			expected: "anonymous",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := client.NewContext(context.Background(), client.Info{Auth: tt.auth})
+
+			header, err := tt.source.Get(ctx)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, header)
+		})
+	}
+}
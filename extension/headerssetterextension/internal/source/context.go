@@ -13,15 +13,16 @@ import (
 var _ Source = (*ContextSource)(nil)
 
 type ContextSource struct {
-	Key string
+	Key          string
+	DefaultValue *string
 }
 
 func (ts *ContextSource) Get(ctx context.Context) (string, error) {
 	cl := client.FromContext(ctx)
 	ss := cl.Metadata.Get(ts.Key)
 
-	if len(ss) == 0 {
-		return "", nil
+	if len(ss) == 0 || (len(ss) == 1 && ss[0] == "") {
+		return defaultValue(ts.DefaultValue), nil
 	}
 
 	if len(ss) > 1 {
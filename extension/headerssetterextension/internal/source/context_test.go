@@ -46,3 +46,16 @@ func TestContextSourceMultipleFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, header)
 }
+
+func TestContextSourceNotFoundDefault(t *testing.T) {
+	defaultValue := "anonymous"
+	ts := &ContextSource{Key: "X-Scope-OrgID", DefaultValue: &defaultValue}
+	cl := client.FromContext(context.Background())
+	cl.Metadata = client.NewMetadata(map[string][]string{"Not-Scope-OrgID": {"acme"}})
+	ctx := client.NewContext(context.Background(), cl)
+
+	header, err := ts.Get(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "anonymous", header)
+}
@@ -8,3 +8,10 @@ import "context"
 type Source interface {
 	Get(context.Context) (string, error)
 }
+
+func defaultValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
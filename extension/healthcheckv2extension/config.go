@@ -11,13 +11,15 @@ import (
 	"go.opentelemetry.io/collector/confmap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/grpc"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/http"
 )
 
 const (
-	httpConfigKey = "http"
-	grpcConfigKey = "grpc"
+	httpConfigKey     = "http"
+	grpcConfigKey     = "grpc"
+	dataFlowConfigKey = "data_flow"
 )
 
 var (
@@ -41,6 +43,9 @@ type Config struct {
 
 	// ComponentHealthConfig is v2 config shared between http and grpc services
 	ComponentHealthConfig *common.ComponentHealthConfig `mapstructure:"component_health"`
+
+	// DataFlowConfig is v2 config for the watchdogs checking that data flows through components
+	DataFlowConfig *dataflow.Config `mapstructure:"data_flow"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -95,5 +100,9 @@ func (c *Config) Unmarshal(conf *confmap.Conf) error {
 		c.GRPCConfig = nil
 	}
 
+	if !conf.IsSet(dataFlowConfigKey) {
+		c.DataFlowConfig = nil
+	}
+
 	return nil
 }
@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/common"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/grpc"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/http"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/metadata"
@@ -170,6 +171,69 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "v2noprotocols"),
 			expectedErr: errMissingProtocol,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "v2dataflow"),
+			expected: &Config{
+				LegacyConfig: http.LegacyConfig{
+					UseV2: true,
+					ServerConfig: confighttp.ServerConfig{
+						Endpoint: localhostgate.EndpointForPort(defaultHTTPPort),
+					},
+					Path: "/",
+				},
+				HTTPConfig: &http.Config{
+					ServerConfig: confighttp.ServerConfig{
+						Endpoint: localhostgate.EndpointForPort(defaultHTTPPort),
+					},
+					Status: http.PathConfig{
+						Enabled: true,
+						Path:    "/status",
+					},
+					Config: http.PathConfig{
+						Enabled: false,
+						Path:    "/config",
+					},
+				},
+				DataFlowConfig: &dataflow.Config{
+					MetricsEndpoint: defaultMetricsEndpoint,
+					CheckInterval:   time.Minute,
+					Watchdogs: []dataflow.WatchdogConfig{
+						{
+							Pipeline:  component.MustNewID("traces"),
+							Component: "receiver/otlp",
+							Timeout:   10 * time.Minute,
+							ActiveHours: &dataflow.ActiveHoursConfig{
+								Days:     []string{"mon", "tue", "wed", "thu", "fri"},
+								Start:    "08:00",
+								End:      "18:00",
+								Timezone: "Europe/Paris",
+							},
+						},
+						{
+							Pipeline:  component.MustNewIDWithName("logs", "archive"),
+							Component: "exporter/file/archive",
+							Timeout:   time.Hour,
+						},
+					},
+					Exporters: []dataflow.ExporterConfig{
+						{
+							Pipeline:            component.MustNewID("traces"),
+							Exporter:            component.MustNewID("otlp"),
+							MaxQueueUtilization: 0.8,
+							MaxErrorRate:        0.1,
+						},
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2dataflowinvalidcomponent"),
+			expectedErr: dataflow.ErrInvalidComponent,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "v2dataflowmissingthreshold"),
+			expectedErr: dataflow.ErrMissingThreshold,
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,15 +5,23 @@ package healthcheckv2extension // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"context"
+	"net/http"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
 )
 
 type healthCheckExtension struct {
 	config    Config
 	telemetry component.TelemetrySettings
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
 }
 
 var _ component.Component = (*healthCheckExtension)(nil)
@@ -33,10 +41,64 @@ func newExtension(
 func (hc *healthCheckExtension) Start(context.Context, component.Host) error {
 	hc.telemetry.Logger.Debug("Starting health check extension V2", zap.Any("config", hc.config))
 
+	if !hc.config.UseV2 || hc.config.DataFlowConfig == nil || !hc.config.DataFlowConfig.Enabled() {
+		return nil
+	}
+
+	cfg := *hc.config.DataFlowConfig
+	monitor, err := dataflow.NewMonitor(cfg, &http.Client{Timeout: cfg.CheckInterval}, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var ctx context.Context
+	ctx, hc.cancel = context.WithCancel(context.Background())
+	hc.wg.Add(1)
+	go hc.watchDataFlow(ctx, monitor, cfg.CheckInterval)
+
 	return nil
 }
 
+// watchDataFlow periodically checks the data flowing through the watched components and the
+// checked exporters. The extension reports a recoverable error status while data stopped flowing
+// through any of the components or any of the exporters is above its thresholds.
+func (hc *healthCheckExtension) watchDataFlow(ctx context.Context, monitor *dataflow.Monitor, interval time.Duration) {
+	defer hc.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	stalled := false
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := monitor.Check(ctx, time.Now()); err != nil {
+			hc.telemetry.Logger.Warn("Failed to check the data flow", zap.Error(err))
+			continue
+		}
+
+		if err := monitor.Err(); err != nil {
+			hc.telemetry.Logger.Warn("Data flow is unhealthy", zap.Error(err))
+			hc.telemetry.ReportStatus(component.NewRecoverableErrorEvent(err))
+			stalled = true
+		} else if stalled {
+			hc.telemetry.Logger.Info("Data flow is healthy again")
+			hc.telemetry.ReportStatus(component.NewStatusEvent(component.StatusOK))
+			stalled = false
+		}
+	}
+}
+
 // Shutdown implements the component.Component interface.
 func (hc *healthCheckExtension) Shutdown(context.Context) error {
+	if hc.cancel != nil {
+		hc.cancel()
+	}
+	hc.wg.Wait()
+
 	return nil
 }
@@ -7,6 +7,7 @@ package healthcheckv2extension // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -14,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/extension"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/grpc"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/http"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/metadata"
@@ -23,6 +25,9 @@ import (
 const (
 	defaultGRPCPort = 13132
 	defaultHTTPPort = 13133
+
+	defaultMetricsEndpoint = "http://localhost:8888/metrics"
+	defaultCheckInterval   = 30 * time.Second
 )
 
 // NewFactory creates a factory for HealthCheck extension.
@@ -64,6 +69,10 @@ func createDefaultConfig() component.Config {
 				},
 			},
 		},
+		DataFlowConfig: &dataflow.Config{
+			MetricsEndpoint: defaultMetricsEndpoint,
+			CheckInterval:   defaultCheckInterval,
+		},
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/extension/extensiontest"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/grpc"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/http"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/localhostgate"
@@ -51,6 +52,10 @@ func TestCreateDefaultConfig(t *testing.T) {
 				},
 			},
 		},
+		DataFlowConfig: &dataflow.Config{
+			MetricsEndpoint: defaultMetricsEndpoint,
+			CheckInterval:   defaultCheckInterval,
+		},
 	}, cfg)
 
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
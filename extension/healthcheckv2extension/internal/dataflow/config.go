@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+const (
+	kindReceiver = "receiver"
+	kindExporter = "exporter"
+
+	clockLayout = "15:04"
+)
+
+var (
+	ErrMissingEndpoint  = errors.New("metrics_endpoint required")
+	ErrInvalidInterval  = errors.New("check_interval must be positive")
+	ErrMissingPipeline  = errors.New("pipeline required")
+	ErrInvalidPipeline  = errors.New("pipeline must be a traces, metrics or logs pipeline")
+	ErrInvalidComponent = errors.New("component must be a receiver or an exporter, as receiver/<id> or exporter/<id>")
+	ErrInvalidTimeout   = errors.New("timeout must be positive")
+	ErrInvalidHours     = errors.New("active_hours start and end must be set together")
+	ErrMissingExporter  = errors.New("exporter required")
+	ErrMissingThreshold = errors.New("max_queue_utilization or max_error_rate required")
+	ErrInvalidThreshold = errors.New("max_queue_utilization and max_error_rate must be between 0 and 1")
+)
+
+var signalSuffixes = map[string]string{
+	"traces":  "spans",
+	"metrics": "metric_points",
+	"logs":    "log_records",
+}
+
+// Config contains the config for the data-flow liveness checks.
+type Config struct {
+	// MetricsEndpoint is the URL of the Prometheus endpoint serving the internal metrics of
+	// the collector, the data flowing through the components is observed from these metrics.
+	MetricsEndpoint string `mapstructure:"metrics_endpoint"`
+
+	// CheckInterval is the interval between two scrapes of the internal metrics.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+
+	// Watchdogs is the list of components expected to have data flowing through them.
+	Watchdogs []WatchdogConfig `mapstructure:"watchdogs"`
+
+	// Exporters is the list of exporters whose sending queue and failures are checked.
+	Exporters []ExporterConfig `mapstructure:"exporters"`
+}
+
+// WatchdogConfig contains the config of the watchdog of a component.
+type WatchdogConfig struct {
+	// Pipeline is the pipeline the component belongs to, its type selects the signal watched.
+	Pipeline component.ID `mapstructure:"pipeline"`
+
+	// Component is the watched receiver or exporter, as receiver/<id> or exporter/<id>.
+	Component string `mapstructure:"component"`
+
+	// Timeout is the duration without any data flowing through the component after which
+	// the pipeline is considered unhealthy.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// ActiveHours restricts the checks to the hours data is expected to flow. The checks are
+	// always active if unset.
+	ActiveHours *ActiveHoursConfig `mapstructure:"active_hours"`
+}
+
+// ActiveHoursConfig contains the hours data is expected to flow through a component.
+type ActiveHoursConfig struct {
+	// Days is the list of the days of the week the checks are active, as monday or mon.
+	// All the days are active if empty.
+	Days []string `mapstructure:"days"`
+
+	// Start is the time of the day the checks start, as HH:MM.
+	Start string `mapstructure:"start"`
+
+	// End is the time of the day the checks end, as HH:MM. The checks end on the next day
+	// if it is before Start.
+	End string `mapstructure:"end"`
+
+	// Timezone is the IANA name of the timezone of Start and End, the local timezone is used if empty.
+	Timezone string `mapstructure:"timezone"`
+}
+
+// ExporterConfig contains the config of the checks of an exporter.
+type ExporterConfig struct {
+	// Pipeline is the pipeline the exporter belongs to, its type selects the signal of the
+	// failures counted.
+	Pipeline component.ID `mapstructure:"pipeline"`
+
+	// Exporter is the ID of the checked exporter.
+	Exporter component.ID `mapstructure:"exporter"`
+
+	// MaxQueueUtilization is the fraction of the capacity of the sending queue above which the
+	// exporter is considered unhealthy. The queue isn't checked if zero.
+	MaxQueueUtilization float64 `mapstructure:"max_queue_utilization"`
+
+	// MaxErrorRate is the fraction of the items failing to be sent between two checks above
+	// which the exporter is considered unhealthy. The failures aren't checked if zero.
+	MaxErrorRate float64 `mapstructure:"max_error_rate"`
+}
+
+// Enabled returns whether any watchdog or exporter check is configured.
+func (c *Config) Enabled() bool {
+	return len(c.Watchdogs) > 0 || len(c.Exporters) > 0
+}
+
+// Validate checks if the data-flow configuration is valid.
+func (c *Config) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.MetricsEndpoint == "" {
+		return ErrMissingEndpoint
+	}
+	if c.CheckInterval <= 0 {
+		return ErrInvalidInterval
+	}
+	return nil
+}
+
+// Validate checks if the watchdog configuration is valid.
+func (c *WatchdogConfig) Validate() error {
+	if c.Pipeline.Type().String() == "" {
+		return ErrMissingPipeline
+	}
+	if _, ok := signalSuffixes[c.Pipeline.Type().String()]; !ok {
+		return ErrInvalidPipeline
+	}
+	if _, _, err := parseComponent(c.Component); err != nil {
+		return err
+	}
+	if c.Timeout <= 0 {
+		return ErrInvalidTimeout
+	}
+	return nil
+}
+
+// Validate checks if the exporter configuration is valid.
+func (c *ExporterConfig) Validate() error {
+	if c.Pipeline.Type().String() == "" {
+		return ErrMissingPipeline
+	}
+	if _, ok := signalSuffixes[c.Pipeline.Type().String()]; !ok {
+		return ErrInvalidPipeline
+	}
+	if c.Exporter.Type().String() == "" {
+		return ErrMissingExporter
+	}
+	if c.MaxQueueUtilization < 0 || c.MaxQueueUtilization > 1 || c.MaxErrorRate < 0 || c.MaxErrorRate > 1 {
+		return ErrInvalidThreshold
+	}
+	if c.MaxQueueUtilization == 0 && c.MaxErrorRate == 0 {
+		return ErrMissingThreshold
+	}
+	return nil
+}
+
+// Validate checks if the active hours configuration is valid.
+func (c *ActiveHoursConfig) Validate() error {
+	_, err := newSchedule(c)
+	return err
+}
+
+// parseComponent returns the kind and the ID of a component formatted as <kind>/<id>.
+func parseComponent(s string) (string, component.ID, error) {
+	kind, rawID, _ := strings.Cut(s, "/")
+	if kind != kindReceiver && kind != kindExporter {
+		return "", component.ID{}, ErrInvalidComponent
+	}
+	var id component.ID
+	if err := id.UnmarshalText([]byte(rawID)); err != nil {
+		return "", component.ID{}, fmt.Errorf("%w: %w", ErrInvalidComponent, err)
+	}
+	return kind, id, nil
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
+
+import (
+	"fmt"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	queueSizeMetric     = "otelcol_exporter_queue_size"
+	queueCapacityMetric = "otelcol_exporter_queue_capacity"
+	sendFailedPrefix    = "otelcol_exporter_send_failed_"
+)
+
+// exporterCheck checks the utilization of the sending queue of an exporter, in memory or
+// persistent, and the rate of the items it failed to send since the previous check.
+type exporterCheck struct {
+	pipeline            string
+	id                  string
+	signal              string
+	sentMetric          string
+	failedMetric        string
+	maxQueueUtilization float64
+	maxErrorRate        float64
+
+	sent    float64
+	failed  float64
+	started bool
+	errs    []error
+}
+
+func newExporterCheck(cfg ExporterConfig) *exporterCheck {
+	suffix := signalSuffixes[cfg.Pipeline.Type().String()]
+	return &exporterCheck{
+		pipeline:            cfg.Pipeline.String(),
+		id:                  cfg.Exporter.String(),
+		signal:              suffix,
+		sentMetric:          metricPrefixes[kindExporter] + suffix,
+		failedMetric:        sendFailedPrefix + suffix,
+		maxQueueUtilization: cfg.MaxQueueUtilization,
+		maxErrorRate:        cfg.MaxErrorRate,
+	}
+}
+
+// check updates the errors of the exporter from the scraped metrics.
+func (e *exporterCheck) check(families map[string]*dto.MetricFamily) {
+	e.errs = nil
+
+	if e.maxQueueUtilization > 0 {
+		size, sizeFound := sum(families, queueSizeMetric, kindExporter, e.id)
+		capacity, capacityFound := sum(families, queueCapacityMetric, kindExporter, e.id)
+		// The queue metrics are missing if the sending queue is disabled.
+		if sizeFound && capacityFound && capacity > 0 {
+			if utilization := size / capacity; utilization > e.maxQueueUtilization {
+				e.errs = append(e.errs, fmt.Errorf("sending queue of exporter %q of pipeline %q is %.0f%% full, above %.0f%%",
+					e.id, e.pipeline, 100*utilization, 100*e.maxQueueUtilization))
+			}
+		}
+	}
+
+	sent, _ := sum(families, e.sentMetric, kindExporter, e.id)
+	failed, _ := sum(families, e.failedMetric, kindExporter, e.id)
+	sentDelta, failedDelta := sent-e.sent, failed-e.failed
+	started := e.started
+	e.sent, e.failed, e.started = sent, failed, true
+	// The first check and counter resets only set the baseline of the next check.
+	if e.maxErrorRate == 0 || !started || sentDelta < 0 || failedDelta < 0 || sentDelta+failedDelta == 0 {
+		return
+	}
+	if rate := failedDelta / (sentDelta + failedDelta); rate > e.maxErrorRate {
+		e.errs = append(e.errs, fmt.Errorf("exporter %q of pipeline %q failed to send %.0f%% of the %s since the last check, above %.0f%%",
+			e.id, e.pipeline, 100*rate, e.signal, 100*e.maxErrorRate))
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// metricPrefixes are the prefixes of the internal metrics counting the data flowing through
+// the components of every kind, the signal suffix completes the metric name.
+var metricPrefixes = map[string]string{
+	kindReceiver: "otelcol_receiver_accepted_",
+	kindExporter: "otelcol_exporter_sent_",
+}
+
+// Monitor observes the data flowing through the watched components and the checked exporters
+// from the internal metrics of the collector.
+type Monitor struct {
+	endpoint  string
+	client    *http.Client
+	watchdogs []*watchdog
+	exporters []*exporterCheck
+}
+
+type watchdog struct {
+	pipeline string
+	kind     string
+	id       string
+	metric   string
+	timeout  time.Duration
+	schedule *schedule
+
+	count    float64
+	lastSeen time.Time
+	active   bool
+	stalled  bool
+}
+
+// NewMonitor creates a Monitor for the watchdogs of cfg, the components are considered to
+// have had data flowing through them at start.
+func NewMonitor(cfg Config, client *http.Client, start time.Time) (*Monitor, error) {
+	m := &Monitor{
+		endpoint: cfg.MetricsEndpoint,
+		client:   client,
+	}
+	for _, wc := range cfg.Watchdogs {
+		kind, id, err := parseComponent(wc.Component)
+		if err != nil {
+			return nil, err
+		}
+		s, err := newSchedule(wc.ActiveHours)
+		if err != nil {
+			return nil, err
+		}
+		m.watchdogs = append(m.watchdogs, &watchdog{
+			pipeline: wc.Pipeline.String(),
+			kind:     kind,
+			id:       id.String(),
+			metric:   metricPrefixes[kind] + signalSuffixes[wc.Pipeline.Type().String()],
+			timeout:  wc.Timeout,
+			schedule: s,
+			lastSeen: start,
+			active:   s.active(start),
+		})
+	}
+	for _, ec := range cfg.Exporters {
+		m.exporters = append(m.exporters, newExporterCheck(ec))
+	}
+	return m, nil
+}
+
+// Check scrapes the internal metrics and updates the state of the watchdogs at now. The state
+// is left untouched if the metrics can't be scraped.
+func (m *Monitor) Check(ctx context.Context, now time.Time) error {
+	families, err := m.scrape(ctx)
+	if err != nil {
+		return err
+	}
+	for _, w := range m.watchdogs {
+		count := w.total(families)
+		active := w.schedule.active(now)
+		if count != w.count || !active || !w.active {
+			// Time outside of the active hours doesn't count towards the timeout.
+			w.count = count
+			w.lastSeen = now
+		}
+		w.active = active
+		w.stalled = now.Sub(w.lastSeen) >= w.timeout
+	}
+	for _, e := range m.exporters {
+		e.check(families)
+	}
+	return nil
+}
+
+// Err returns an error for every watchdog which had no data flowing through its component for
+// longer than its timeout and for every exporter above its thresholds at the last check, or nil
+// if there are none.
+func (m *Monitor) Err() error {
+	var errs []error
+	for _, w := range m.watchdogs {
+		if w.stalled {
+			errs = append(errs, fmt.Errorf("no data flowed through %s %q of pipeline %q for %s", w.kind, w.id, w.pipeline, w.timeout))
+		}
+	}
+	for _, e := range m.exporters {
+		errs = append(errs, e.errs...)
+	}
+	return errors.Join(errs...)
+}
+
+func (m *Monitor) scrape(ctx context.Context) (map[string]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape the internal metrics: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to scrape the internal metrics: unexpected status %q", resp.Status)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the internal metrics: %w", err)
+	}
+	return families, nil
+}
+
+// total returns the sum of the series of the metric of the watchdog for its component. The
+// metric is missing until data flowed through a component.
+func (w *watchdog) total(families map[string]*dto.MetricFamily) float64 {
+	total, _ := sum(families, w.metric, w.kind, w.id)
+	return total
+}
+
+// sum returns the sum of the series of a metric with the label, and whether any was found.
+func sum(families map[string]*dto.MetricFamily, metricName, labelName, labelValue string) (float64, bool) {
+	var total float64
+	found := false
+	for _, name := range []string{metricName, metricName + "_total"} {
+		family, ok := families[name]
+		if !ok {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if !hasLabel(metric, labelName, labelValue) {
+				continue
+			}
+			switch {
+			case metric.GetCounter() != nil:
+				total += metric.GetCounter().GetValue()
+			case metric.GetGauge() != nil:
+				total += metric.GetGauge().GetValue()
+			case metric.GetUntyped() != nil:
+				total += metric.GetUntyped().GetValue()
+			default:
+				continue
+			}
+			found = true
+		}
+	}
+	return total, found
+}
+
+func hasLabel(metric *dto.Metric, name, value string) bool {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name && label.GetValue() == value {
+			return true
+		}
+	}
+	return false
+}
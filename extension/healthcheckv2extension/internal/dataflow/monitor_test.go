@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+)
+
+const metricsTemplate = `# HELP otelcol_receiver_accepted_spans Number of spans successfully pushed into the pipeline.
+# TYPE otelcol_receiver_accepted_spans counter
+otelcol_receiver_accepted_spans{receiver="otlp",service_instance_id="abc",transport="grpc"} %d
+otelcol_receiver_accepted_spans{receiver="otlp",service_instance_id="abc",transport="http"} 3
+otelcol_receiver_accepted_spans{receiver="zipkin",service_instance_id="abc",transport="http"} %d
+# HELP otelcol_exporter_sent_log_records Number of log record successfully sent to destination.
+# TYPE otelcol_exporter_sent_log_records counter
+otelcol_exporter_sent_log_records{exporter="file/archive",service_instance_id="abc"} %d
+`
+
+func TestMonitor(t *testing.T) {
+	var otlpSpans, zipkinSpans, archivedLogs atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, metricsTemplate, otlpSpans.Load(), zipkinSpans.Load(), archivedLogs.Load())
+	}))
+	defer server.Close()
+
+	start := time.Date(2024, 6, 3, 7, 0, 0, 0, time.UTC)
+	monitor, err := NewMonitor(Config{
+		MetricsEndpoint: server.URL,
+		Watchdogs: []WatchdogConfig{
+			{
+				Pipeline:  component.MustNewID("traces"),
+				Component: "receiver/otlp",
+				Timeout:   10 * time.Minute,
+			},
+			{
+				Pipeline:  component.MustNewIDWithName("logs", "archive"),
+				Component: "exporter/file/archive",
+				Timeout:   10 * time.Minute,
+				ActiveHours: &ActiveHoursConfig{
+					Start:    "08:00",
+					End:      "18:00",
+					Timezone: "UTC",
+				},
+			},
+		},
+	}, server.Client(), start)
+	require.NoError(t, err)
+
+	check := func(now time.Time) error {
+		require.NoError(t, monitor.Check(context.Background(), now))
+		return monitor.Err()
+	}
+
+	// Data flowing through other components doesn't reset the watchdogs.
+	zipkinSpans.Store(10)
+	otlpSpans.Store(5)
+	assert.NoError(t, check(start.Add(5*time.Minute)))
+	zipkinSpans.Store(20)
+	assert.NoError(t, check(start.Add(10*time.Minute)))
+	assert.EqualError(t, check(start.Add(15*time.Minute)), `no data flowed through receiver "otlp" of pipeline "traces" for 10m0s`)
+
+	otlpSpans.Store(6)
+	assert.NoError(t, check(start.Add(20*time.Minute)))
+
+	// The archive watchdog only starts counting at the first check within the active hours.
+	otlpSpans.Store(7)
+	assert.NoError(t, check(start.Add(65*time.Minute)))
+	otlpSpans.Store(8)
+	assert.NoError(t, check(start.Add(70*time.Minute)))
+	otlpSpans.Store(9)
+	assert.EqualError(t, check(start.Add(75*time.Minute)), `no data flowed through exporter "file/archive" of pipeline "logs/archive" for 10m0s`)
+	otlpSpans.Store(10)
+	archivedLogs.Store(1)
+	assert.NoError(t, check(start.Add(80*time.Minute)))
+}
+
+func TestMonitorScrapeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	monitor, err := NewMonitor(Config{
+		MetricsEndpoint: server.URL,
+		Watchdogs: []WatchdogConfig{
+			{
+				Pipeline:  component.MustNewID("traces"),
+				Component: "receiver/otlp",
+				Timeout:   time.Minute,
+			},
+		},
+	}, server.Client(), start)
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, monitor.Check(context.Background(), start.Add(time.Hour)), "unexpected status")
+	assert.NoError(t, monitor.Err())
+}
+
+const exporterMetricsTemplate = `# HELP otelcol_exporter_queue_capacity Fixed capacity of the retry queue (in batches)
+# TYPE otelcol_exporter_queue_capacity gauge
+otelcol_exporter_queue_capacity{exporter="otlp",service_instance_id="abc"} 100
+otelcol_exporter_queue_capacity{exporter="otlp/backup",service_instance_id="abc"} 100
+# HELP otelcol_exporter_queue_size Current size of the retry queue (in batches)
+# TYPE otelcol_exporter_queue_size gauge
+otelcol_exporter_queue_size{exporter="otlp",service_instance_id="abc"} %d
+otelcol_exporter_queue_size{exporter="otlp/backup",service_instance_id="abc"} 100
+# HELP otelcol_exporter_sent_spans Number of spans successfully sent to destination.
+# TYPE otelcol_exporter_sent_spans counter
+otelcol_exporter_sent_spans{exporter="otlp",service_instance_id="abc"} %d
+# HELP otelcol_exporter_send_failed_spans Number of spans in failed attempts to send to destination.
+# TYPE otelcol_exporter_send_failed_spans counter
+otelcol_exporter_send_failed_spans{exporter="otlp",service_instance_id="abc"} %d
+`
+
+func TestMonitorExporters(t *testing.T) {
+	var queueSize, sentSpans, failedSpans atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, exporterMetricsTemplate, queueSize.Load(), sentSpans.Load(), failedSpans.Load())
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	monitor, err := NewMonitor(Config{
+		MetricsEndpoint: server.URL,
+		Exporters: []ExporterConfig{
+			{
+				Pipeline:            component.MustNewID("traces"),
+				Exporter:            component.MustNewID("otlp"),
+				MaxQueueUtilization: 0.8,
+				MaxErrorRate:        0.1,
+			},
+		},
+	}, server.Client(), start)
+	require.NoError(t, err)
+
+	check := func(now time.Time) error {
+		require.NoError(t, monitor.Check(context.Background(), now))
+		return monitor.Err()
+	}
+
+	// The failures before the first check don't count towards the error rate.
+	sentSpans.Store(10)
+	failedSpans.Store(90)
+	assert.NoError(t, check(start.Add(time.Minute)))
+
+	queueSize.Store(90)
+	sentSpans.Store(100)
+	assert.EqualError(t, check(start.Add(2*time.Minute)), `sending queue of exporter "otlp" of pipeline "traces" is 90% full, above 80%`)
+
+	queueSize.Store(10)
+	sentSpans.Store(150)
+	failedSpans.Store(140)
+	assert.EqualError(t, check(start.Add(3*time.Minute)), `exporter "otlp" of pipeline "traces" failed to send 50% of the spans since the last check, above 10%`)
+
+	sentSpans.Store(250)
+	failedSpans.Store(145)
+	assert.NoError(t, check(start.Add(4*time.Minute)))
+
+	// Nothing sent since the last check isn't a failure.
+	assert.NoError(t, check(start.Add(5*time.Minute)))
+}
+
+func TestExporterConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         ExporterConfig
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			cfg:  ExporterConfig{Pipeline: component.MustNewID("traces"), Exporter: component.MustNewID("otlp"), MaxErrorRate: 0.5},
+		},
+		{
+			name:        "missing pipeline",
+			cfg:         ExporterConfig{Exporter: component.MustNewID("otlp"), MaxErrorRate: 0.5},
+			expectedErr: ErrMissingPipeline,
+		},
+		{
+			name:        "invalid pipeline",
+			cfg:         ExporterConfig{Pipeline: component.MustNewID("profiles"), Exporter: component.MustNewID("otlp"), MaxErrorRate: 0.5},
+			expectedErr: ErrInvalidPipeline,
+		},
+		{
+			name:        "missing exporter",
+			cfg:         ExporterConfig{Pipeline: component.MustNewID("traces"), MaxErrorRate: 0.5},
+			expectedErr: ErrMissingExporter,
+		},
+		{
+			name:        "missing threshold",
+			cfg:         ExporterConfig{Pipeline: component.MustNewID("traces"), Exporter: component.MustNewID("otlp")},
+			expectedErr: ErrMissingThreshold,
+		},
+		{
+			name:        "invalid threshold",
+			cfg:         ExporterConfig{Pipeline: component.MustNewID("traces"), Exporter: component.MustNewID("otlp"), MaxQueueUtilization: 1.5},
+			expectedErr: ErrInvalidThreshold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedErr == nil {
+				assert.NoError(t, tt.cfg.Validate())
+				return
+			}
+			assert.ErrorIs(t, tt.cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
+
+func TestWatchdogConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         WatchdogConfig
+		expectedErr error
+	}{
+		{
+			name:        "missing pipeline",
+			cfg:         WatchdogConfig{Component: "receiver/otlp", Timeout: time.Minute},
+			expectedErr: ErrMissingPipeline,
+		},
+		{
+			name:        "invalid pipeline",
+			cfg:         WatchdogConfig{Pipeline: component.MustNewID("profiles"), Component: "receiver/otlp", Timeout: time.Minute},
+			expectedErr: ErrInvalidPipeline,
+		},
+		{
+			name:        "processor",
+			cfg:         WatchdogConfig{Pipeline: component.MustNewID("traces"), Component: "processor/batch", Timeout: time.Minute},
+			expectedErr: ErrInvalidComponent,
+		},
+		{
+			name:        "missing component ID",
+			cfg:         WatchdogConfig{Pipeline: component.MustNewID("traces"), Component: "receiver", Timeout: time.Minute},
+			expectedErr: ErrInvalidComponent,
+		},
+		{
+			name:        "missing timeout",
+			cfg:         WatchdogConfig{Pipeline: component.MustNewID("traces"), Component: "receiver/otlp"},
+			expectedErr: ErrInvalidTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.cfg.Validate(), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/healthcheckv2extension/internal/dataflow"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// schedule tells whether the checks of a watchdog are active at a given time. The zero value
+// is always active.
+type schedule struct {
+	days     map[time.Weekday]bool
+	start    time.Duration
+	end      time.Duration
+	location *time.Location
+}
+
+func newSchedule(cfg *ActiveHoursConfig) (*schedule, error) {
+	s := &schedule{location: time.Local}
+	if cfg == nil {
+		return s, nil
+	}
+
+	if cfg.Timezone != "" {
+		location, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", cfg.Timezone, err)
+		}
+		s.location = location
+	}
+
+	for _, day := range cfg.Days {
+		weekday, ok := parseWeekday(day)
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q", day)
+		}
+		if s.days == nil {
+			s.days = map[time.Weekday]bool{}
+		}
+		s.days[weekday] = true
+	}
+
+	if (cfg.Start == "") != (cfg.End == "") {
+		return nil, ErrInvalidHours
+	}
+	if cfg.Start != "" {
+		var err error
+		if s.start, err = parseClock(cfg.Start); err != nil {
+			return nil, err
+		}
+		if s.end, err = parseClock(cfg.End); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// active returns true if the checks are active at t.
+func (s *schedule) active(t time.Time) bool {
+	t = t.In(s.location)
+	if s.days != nil && !s.days[t.Weekday()] {
+		return false
+	}
+	if s.start == s.end {
+		return true
+	}
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if s.start < s.end {
+		return clock >= s.start && clock < s.end
+	}
+	return clock >= s.start || clock < s.end
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	s = strings.ToLower(s)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if s == name || s == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse(clockLayout, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, must be formatted as HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
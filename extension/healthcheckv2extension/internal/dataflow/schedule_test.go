@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dataflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleActive(t *testing.T) {
+	// 2024-06-03 is a Monday.
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2024, 6, 3, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		cfg      *ActiveHoursConfig
+		time     time.Time
+		expected bool
+	}{
+		{
+			name:     "unset",
+			time:     monday(3, 0),
+			expected: true,
+		},
+		{
+			name:     "within hours",
+			cfg:      &ActiveHoursConfig{Start: "08:00", End: "18:00", Timezone: "UTC"},
+			time:     monday(8, 0),
+			expected: true,
+		},
+		{
+			name:     "after hours",
+			cfg:      &ActiveHoursConfig{Start: "08:00", End: "18:00", Timezone: "UTC"},
+			time:     monday(18, 0),
+			expected: false,
+		},
+		{
+			name:     "overnight hours",
+			cfg:      &ActiveHoursConfig{Start: "22:00", End: "06:00", Timezone: "UTC"},
+			time:     monday(2, 30),
+			expected: true,
+		},
+		{
+			name:     "outside overnight hours",
+			cfg:      &ActiveHoursConfig{Start: "22:00", End: "06:00", Timezone: "UTC"},
+			time:     monday(12, 0),
+			expected: false,
+		},
+		{
+			name:     "active day",
+			cfg:      &ActiveHoursConfig{Days: []string{"Monday", "tue"}, Timezone: "UTC"},
+			time:     monday(12, 0),
+			expected: true,
+		},
+		{
+			name:     "inactive day",
+			cfg:      &ActiveHoursConfig{Days: []string{"sat", "sun"}, Timezone: "UTC"},
+			time:     monday(12, 0),
+			expected: false,
+		},
+		{
+			name:     "timezone",
+			cfg:      &ActiveHoursConfig{Days: []string{"sun"}, Start: "20:00", End: "23:00", Timezone: "America/New_York"},
+			time:     monday(1, 0),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newSchedule(tt.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, s.active(tt.time))
+		})
+	}
+}
+
+func TestNewScheduleErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *ActiveHoursConfig
+		expectedErr string
+	}{
+		{
+			name:        "invalid day",
+			cfg:         &ActiveHoursConfig{Days: []string{"someday"}},
+			expectedErr: `invalid day "someday"`,
+		},
+		{
+			name:        "missing end",
+			cfg:         &ActiveHoursConfig{Start: "08:00"},
+			expectedErr: ErrInvalidHours.Error(),
+		},
+		{
+			name:        "invalid start",
+			cfg:         &ActiveHoursConfig{Start: "8am", End: "18:00"},
+			expectedErr: `invalid time of day "8am", must be formatted as HH:MM`,
+		},
+		{
+			name:        "invalid timezone",
+			cfg:         &ActiveHoursConfig{Timezone: "Nowhere/Atlantis"},
+			expectedErr: `invalid timezone "Nowhere/Atlantis"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newSchedule(tt.cfg)
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
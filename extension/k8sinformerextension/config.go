@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sinformerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
+
+import (
+	"errors"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// lastAppliedConfigAnnotation holds a full copy of the object as applied by kubectl.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Config defines configuration for the k8s informer extension.
+type Config struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	// ResyncPeriod is the interval at which the cached objects are redelivered to the
+	// event handlers of the informers. Zero (the default) disables resyncs.
+	ResyncPeriod time.Duration `mapstructure:"resync_period"`
+
+	// StripManagedFields removes the managed fields of objects before they are cached.
+	// They are rarely useful to telemetry and account for a large share of the size of
+	// every object. `true` by default.
+	StripManagedFields bool `mapstructure:"strip_managed_fields"`
+
+	// StripAnnotations is the list of annotations removed from objects before they are
+	// cached. Defaults to `kubectl.kubernetes.io/last-applied-configuration`.
+	StripAnnotations []string `mapstructure:"strip_annotations"`
+
+	// MaxObjectsPerInformer bounds the number of objects cached by each informer. Once it is
+	// reached, the objects which aren't cached yet are dropped. Zero (the default) means
+	// no limit.
+	MaxObjectsPerInformer int `mapstructure:"max_objects_per_informer"`
+}
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	if cfg.ResyncPeriod < 0 {
+		return errors.New("resync_period must not be negative")
+	}
+	if cfg.MaxObjectsPerInformer < 0 {
+		return errors.New("max_objects_per_informer must not be negative")
+	}
+	return nil
+}
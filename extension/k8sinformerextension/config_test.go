@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sinformerextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: NewFactory().CreateDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				APIConfig:             k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeKubeConfig, Context: "kind-otel"},
+				ResyncPeriod:          10 * time.Minute,
+				StripManagedFields:    false,
+				StripAnnotations:      []string{lastAppliedConfigAnnotation, "deployment.kubernetes.io/revision"},
+				MaxObjectsPerInformer: 5000,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_auth"),
+			expectedErr: "invalid authType for kubernetes: invalid",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_resync"),
+			expectedErr: "resync_period must not be negative",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_max_objects"),
+			expectedErr: "max_objects_per_informer must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package k8sinformerextension provides Kubernetes informers that are shared by
+// all the components of a collector, so that each resource is listed and watched
+// only once.
+package k8sinformerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sinformerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var errNotStarted = errors.New("k8s informer extension is not started")
+
+// InformerOptions scope the objects watched by an informer. Informers requested
+// with different options are backed by separate list/watch connections.
+type InformerOptions struct {
+	// Namespace limits the informer to a single namespace. Empty means all namespaces.
+	Namespace string
+	// FieldSelector restricts the objects by their fields, e.g. `spec.nodeName=node-1`.
+	FieldSelector string
+	// LabelSelector restricts the objects by their labels, e.g. `app=web`.
+	LabelSelector string
+}
+
+// Provider gives components access to the informers shared by the collector.
+type Provider interface {
+	extension.Extension
+
+	// Informer returns the informer for the resource and options, creating and starting it
+	// the first time it is requested. Built-in resources are cached as typed objects, any other
+	// resource as *unstructured.Unstructured. Callers must not modify the cached objects and
+	// should wait for the informer to be synced before reading its store.
+	Informer(gvr schema.GroupVersionResource, opts InformerOptions) (cache.SharedIndexInformer, error)
+}
+
+type informerKey struct {
+	gvr  schema.GroupVersionResource
+	opts InformerOptions
+}
+
+type informerExtension struct {
+	config            *Config
+	logger            *zap.Logger
+	makeDynamicClient func(apiConfig k8sconfig.APIConfig) (dynamic.Interface, error)
+
+	mu            sync.Mutex
+	dynamicClient dynamic.Interface
+	stopCh        chan struct{}
+	wg            sync.WaitGroup
+	informers     map[informerKey]cache.SharedIndexInformer
+}
+
+var _ Provider = (*informerExtension)(nil)
+
+func newInformerExtension(config *Config, set extension.CreateSettings) *informerExtension {
+	return &informerExtension{
+		config:            config,
+		logger:            set.Logger,
+		makeDynamicClient: k8sconfig.MakeDynamicClient,
+		informers:         map[informerKey]cache.SharedIndexInformer{},
+	}
+}
+
+func (e *informerExtension) Start(_ context.Context, _ component.Host) error {
+	dynamicClient, err := e.makeDynamicClient(e.config.APIConfig)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.dynamicClient = dynamicClient
+	e.stopCh = make(chan struct{})
+	return nil
+}
+
+func (e *informerExtension) Shutdown(_ context.Context) error {
+	e.mu.Lock()
+	if e.stopCh != nil {
+		close(e.stopCh)
+		e.stopCh = nil
+	}
+	e.mu.Unlock()
+
+	e.wg.Wait()
+	return nil
+}
+
+func (e *informerExtension) Informer(gvr schema.GroupVersionResource, opts InformerOptions) (cache.SharedIndexInformer, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stopCh == nil {
+		return nil, errNotStarted
+	}
+	key := informerKey{gvr: gvr, opts: opts}
+	if informer, ok := e.informers[key]; ok {
+		return informer, nil
+	}
+
+	logger := e.logger.With(
+		zap.String("resource", gvr.String()),
+		zap.String("namespace", opts.Namespace),
+		zap.String("field_selector", opts.FieldSelector),
+		zap.String("label_selector", opts.LabelSelector))
+	lw := newBoundedListWatch(e.dynamicClient.Resource(gvr).Namespace(opts.Namespace), gvr, e.config.MaxObjectsPerInformer, logger)
+	tweaked := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = opts.FieldSelector
+			options.LabelSelector = opts.LabelSelector
+			return lw.List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = opts.FieldSelector
+			options.LabelSelector = opts.LabelSelector
+			return lw.Watch(options)
+		},
+	}
+	informer := cache.NewSharedIndexInformer(tweaked, lw.exampleObject(), e.config.ResyncPeriod,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := informer.SetTransform(e.transform); err != nil {
+		return nil, err
+	}
+
+	stopCh := e.stopCh
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		informer.Run(stopCh)
+	}()
+
+	logger.Debug("Started shared informer")
+	e.informers[key] = informer
+	return informer, nil
+}
+
+// transform trims the objects before they are stored, keeping the memory used by the caches
+// proportional to the data components actually read.
+func (e *informerExtension) transform(obj any) (any, error) {
+	// Tombstones of deleted objects are passed through as is.
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return obj, nil
+	}
+	if e.config.StripManagedFields {
+		accessor.SetManagedFields(nil)
+	}
+	if annotations := accessor.GetAnnotations(); len(annotations) > 0 {
+		for _, annotation := range e.config.StripAnnotations {
+			delete(annotations, annotation)
+		}
+		accessor.SetAnnotations(annotations)
+	}
+	return obj, nil
+}
@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sinformerextension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var (
+	podsGVR    = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	widgetsGVR = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+)
+
+func newTestExtension(objects ...runtime.Object) (*informerExtension, *dynamicfake.FakeDynamicClient) {
+	widget := &unstructured.Unstructured{}
+	widget.SetAPIVersion("example.com/v1")
+	widget.SetKind("Widget")
+	widget.SetName("widget-1")
+	widget.SetNamespace("default")
+	widget.SetAnnotations(map[string]string{lastAppliedConfigAnnotation: "{}"})
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme.Scheme,
+		map[schema.GroupVersionResource]string{widgetsGVR: "WidgetList"}, append(objects, widget)...)
+
+	ext := newInformerExtension(NewFactory().CreateDefaultConfig().(*Config), extensiontest.NewNopCreateSettings())
+	ext.makeDynamicClient = func(k8sconfig.APIConfig) (dynamic.Interface, error) { return dynamicClient, nil }
+	return ext, dynamicClient
+}
+
+func waitForSync(t *testing.T, informer cache.SharedIndexInformer) {
+	require.Eventually(t, informer.HasSynced, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestInformerNotStarted(t *testing.T) {
+	ext, _ := newTestExtension()
+	_, err := ext.Informer(podsGVR, InformerOptions{})
+	assert.ErrorIs(t, err, errNotStarted)
+	assert.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestSharedInformer(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-1",
+			Namespace: "default",
+			Annotations: map[string]string{
+				lastAppliedConfigAnnotation: "{}",
+				"team":                      "checkout",
+			},
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+	}
+	ext, _ := newTestExtension(pod)
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, ext.Shutdown(context.Background())) }()
+
+	informer, err := ext.Informer(podsGVR, InformerOptions{Namespace: "default"})
+	require.NoError(t, err)
+	waitForSync(t, informer)
+
+	same, err := ext.Informer(podsGVR, InformerOptions{Namespace: "default"})
+	require.NoError(t, err)
+	assert.Same(t, informer, same)
+
+	other, err := ext.Informer(podsGVR, InformerOptions{Namespace: "kube-system"})
+	require.NoError(t, err)
+	assert.NotSame(t, informer, other)
+	waitForSync(t, other)
+	assert.Empty(t, other.GetStore().List())
+
+	obj, exists, err := informer.GetStore().GetByKey("default/pod-1")
+	require.NoError(t, err)
+	require.True(t, exists)
+	cached := obj.(*v1.Pod)
+	assert.Empty(t, cached.ManagedFields)
+	assert.Equal(t, map[string]string{"team": "checkout"}, cached.Annotations)
+}
+
+func TestDynamicInformer(t *testing.T) {
+	ext, _ := newTestExtension()
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, ext.Shutdown(context.Background())) }()
+
+	informer, err := ext.Informer(widgetsGVR, InformerOptions{})
+	require.NoError(t, err)
+	waitForSync(t, informer)
+
+	obj, exists, err := informer.GetStore().GetByKey("default/widget-1")
+	require.NoError(t, err)
+	require.True(t, exists)
+	assert.Empty(t, obj.(*unstructured.Unstructured).GetAnnotations())
+}
+
+func newPod(name string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+}
+
+func TestMaxObjectsPerInformer(t *testing.T) {
+	ext, client := newTestExtension(newPod("pod-1"), newPod("pod-2"))
+	ext.config.MaxObjectsPerInformer = 1
+	watching := make(chan struct{}, 1)
+	client.PrependWatchReactor("pods", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		w, err := client.Tracker().Watch(podsGVR, action.GetNamespace())
+		select {
+		case watching <- struct{}{}:
+		default:
+		}
+		return true, w, err
+	})
+	require.NoError(t, ext.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, ext.Shutdown(context.Background())) }()
+
+	informer, err := ext.Informer(podsGVR, InformerOptions{})
+	require.NoError(t, err)
+	waitForSync(t, informer)
+	<-watching
+	require.Len(t, informer.GetStore().List(), 1)
+	cached := informer.GetStore().List()[0].(*v1.Pod)
+
+	// The cache is full, new objects are dropped.
+	pods := client.Resource(podsGVR).Namespace("default")
+	created, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newPod("pod-3"))
+	require.NoError(t, err)
+	_, err = pods.Create(context.Background(), &unstructured.Unstructured{Object: created}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// Once a cached object is deleted, the next object changed is admitted.
+	require.NoError(t, pods.Delete(context.Background(), cached.Name, metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool { return len(informer.GetStore().List()) == 0 }, 5*time.Second, 10*time.Millisecond)
+	updated, err := pods.Get(context.Background(), "pod-3", metav1.GetOptions{})
+	require.NoError(t, err)
+	updated.SetLabels(map[string]string{"app": "web"})
+	_, err = pods.Update(context.Background(), updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, exists, _ := informer.GetStore().GetByKey("default/pod-3")
+		return exists
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, informer.GetStore().List(), 1)
+}
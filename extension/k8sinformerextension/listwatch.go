@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sinformerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// builtinKind returns the kind of a built-in resource, false if the resource isn't known to
+// the client-go scheme.
+func builtinKind(gvr schema.GroupVersionResource) (schema.GroupVersionKind, bool) {
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		if gvk.Group != gvr.Group || gvk.Version != gvr.Version || strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		if plural, _ := meta.UnsafeGuessKindToResource(gvk); plural == gvr {
+			return gvk, true
+		}
+	}
+	return schema.GroupVersionKind{}, false
+}
+
+// boundedListWatch lists and watches a resource with the dynamic client, converting the objects
+// of built-in resources to their typed representation. When maxObjects is set, it admits at most
+// maxObjects objects in the cache of the informer: the other ones are dropped until admitted
+// objects are deleted.
+type boundedListWatch struct {
+	client dynamic.ResourceInterface
+	// kind is the kind of the typed objects, empty to keep the objects unstructured.
+	kind       schema.GroupVersionKind
+	maxObjects int
+	logger     *zap.Logger
+
+	mu       sync.Mutex
+	admitted map[string]struct{}
+	warned   bool
+}
+
+func newBoundedListWatch(client dynamic.ResourceInterface, gvr schema.GroupVersionResource, maxObjects int, logger *zap.Logger) *boundedListWatch {
+	kind, _ := builtinKind(gvr)
+	return &boundedListWatch{
+		client:     client,
+		kind:       kind,
+		maxObjects: maxObjects,
+		logger:     logger,
+		admitted:   map[string]struct{}{},
+	}
+}
+
+// exampleObject returns an object of the type stored by the informer.
+func (lw *boundedListWatch) exampleObject() runtime.Object {
+	if lw.kind.Empty() {
+		return &unstructured.Unstructured{}
+	}
+	obj, err := scheme.Scheme.New(lw.kind)
+	if err != nil {
+		return &unstructured.Unstructured{}
+	}
+	return obj
+}
+
+// List lists a page of the resource. The reflector pages through the list, so the objects admitted
+// in the cache are reset by the first page only, and the dropped objects of every page are released
+// before the next one is requested.
+func (lw *boundedListWatch) List(options metav1.ListOptions) (runtime.Object, error) {
+	list, err := lw.client.List(context.Background(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	lw.mu.Lock()
+	defer lw.mu.Unlock()
+	if options.Continue == "" {
+		lw.admitted = map[string]struct{}{}
+	}
+	var items []unstructured.Unstructured
+	for _, item := range list.Items {
+		if !lw.admitLocked(objectKey(&item)) {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if lw.kind.Empty() {
+		list.Items = items
+		return list, nil
+	}
+	typedList, err := scheme.Scheme.New(lw.kind.GroupVersion().WithKind(lw.kind.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	typedItems := make([]runtime.Object, 0, len(items))
+	for i := range items {
+		obj, err := lw.convert(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		typedItems = append(typedItems, obj)
+	}
+	if err = meta.SetList(typedList, typedItems); err != nil {
+		return nil, err
+	}
+	listAccessor, err := meta.ListAccessor(typedList)
+	if err != nil {
+		return nil, err
+	}
+	listAccessor.SetResourceVersion(list.GetResourceVersion())
+	listAccessor.SetContinue(list.GetContinue())
+	return typedList, nil
+}
+
+func (lw *boundedListWatch) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	w, err := lw.client.Watch(context.Background(), options)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, lw.filter), nil
+}
+
+// filter drops the events of the objects which aren't admitted, and converts the objects of the
+// other events.
+func (lw *boundedListWatch) filter(event watch.Event) (watch.Event, bool) {
+	if event.Type == watch.Error {
+		if obj, ok := event.Object.(*unstructured.Unstructured); ok {
+			status := &metav1.Status{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, status); err == nil {
+				event.Object = status
+			}
+		}
+		return event, true
+	}
+
+	accessor, err := meta.Accessor(event.Object)
+	if err != nil {
+		return event, true
+	}
+	key := objectKey(accessor)
+	switch event.Type {
+	case watch.Added, watch.Modified:
+		lw.mu.Lock()
+		admitted := lw.admitLocked(key)
+		lw.mu.Unlock()
+		if !admitted {
+			return event, false
+		}
+	case watch.Deleted:
+		lw.mu.Lock()
+		_, admitted := lw.admitted[key]
+		delete(lw.admitted, key)
+		lw.mu.Unlock()
+		if !admitted {
+			return event, false
+		}
+	}
+
+	if obj, ok := event.Object.(*unstructured.Unstructured); ok {
+		converted, err := lw.convert(obj)
+		if err != nil {
+			lw.logger.Warn("Dropping an object which can't be converted", zap.String("key", key), zap.Error(err))
+			return event, false
+		}
+		event.Object = converted
+	}
+	return event, true
+}
+
+// admitLocked reports whether the object is admitted in the cache, admitting it if there is room.
+func (lw *boundedListWatch) admitLocked(key string) bool {
+	if _, ok := lw.admitted[key]; ok {
+		return true
+	}
+	if lw.maxObjects > 0 && len(lw.admitted) >= lw.maxObjects {
+		if !lw.warned {
+			lw.warned = true
+			lw.logger.Warn("The informer cache is full, objects are dropped",
+				zap.String("kind", lw.kind.String()),
+				zap.Int("max_objects_per_informer", lw.maxObjects))
+		}
+		return false
+	}
+	lw.admitted[key] = struct{}{}
+	return true
+}
+
+func (lw *boundedListWatch) convert(obj *unstructured.Unstructured) (runtime.Object, error) {
+	if lw.kind.Empty() {
+		return obj, nil
+	}
+	typed, err := scheme.Scheme.New(lw.kind)
+	if err != nil {
+		return nil, err
+	}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+		return nil, err
+	}
+	return typed, nil
+}
+
+func objectKey(obj metav1.Object) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
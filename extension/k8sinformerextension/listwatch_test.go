@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sinformerextension
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+// pagedPods lists pods in two pages.
+type pagedPods struct {
+	dynamic.ResourceInterface
+	limits []int64
+}
+
+func (p *pagedPods) List(_ context.Context, options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	p.limits = append(p.limits, options.Limit)
+	list := &unstructured.UnstructuredList{}
+	names := []string{"pod-1"}
+	if options.Continue == "" {
+		list.SetContinue("page-2")
+	} else {
+		list.SetResourceVersion("10")
+		names = []string{"pod-2", "pod-3"}
+	}
+	for _, name := range names {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newPod(name))
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, unstructured.Unstructured{Object: obj})
+	}
+	return list, nil
+}
+
+func TestBoundedListWatchPages(t *testing.T) {
+	client := &pagedPods{}
+	lw := newBoundedListWatch(client, podsGVR, 2, zap.NewNop())
+
+	// The pages are requested as the reflector asks for them, and the bound applies to the whole list.
+	first, err := lw.List(metav1.ListOptions{Limit: 500})
+	require.NoError(t, err)
+	firstPage := first.(*v1.PodList)
+	assert.Equal(t, "page-2", firstPage.Continue)
+	assert.Len(t, firstPage.Items, 1)
+
+	second, err := lw.List(metav1.ListOptions{Limit: 500, Continue: "page-2"})
+	require.NoError(t, err)
+	secondPage := second.(*v1.PodList)
+	assert.Equal(t, "10", secondPage.ResourceVersion)
+	require.Len(t, secondPage.Items, 1)
+	assert.Equal(t, "pod-2", secondPage.Items[0].Name)
+	assert.Equal(t, []int64{500, 500}, client.limits)
+
+	// A new list starts over.
+	first, err = lw.List(metav1.ListOptions{Limit: 500})
+	require.NoError(t, err)
+	assert.Len(t, first.(*v1.PodList).Items, 1)
+	assert.Len(t, lw.admitted, 1)
+}
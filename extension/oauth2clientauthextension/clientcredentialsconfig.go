@@ -6,8 +6,10 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"go.uber.org/multierr"
 	"golang.org/x/oauth2"
@@ -36,6 +38,11 @@ type clientCredentialsConfig struct {
 
 	ClientIDFile     string
 	ClientSecretFile string
+
+	// ClientAuthMethod and PrivateKeyJWT configure the authentication of the client
+	// when it isn't authenticated with its secret.
+	ClientAuthMethod string
+	PrivateKeyJWT    PrivateKeyJWTConfig
 }
 
 type clientCredentialsTokenSource struct {
@@ -75,6 +82,33 @@ func (c *clientCredentialsConfig) createConfig() (*clientcredentials.Config, err
 		return nil, multierr.Combine(errNoClientIDProvided, err)
 	}
 
+	switch c.ClientAuthMethod {
+	case authMethodPrivateKeyJWT:
+		assertion, err := c.PrivateKeyJWT.clientAssertion(clientID, c.TokenURL, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the client assertion: %w", err)
+		}
+		params := c.endpointParams()
+		params.Set("client_assertion_type", clientAssertionType)
+		params.Set("client_assertion", assertion)
+		return &clientcredentials.Config{
+			ClientID:       clientID,
+			TokenURL:       c.TokenURL,
+			Scopes:         c.Scopes,
+			EndpointParams: params,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}, nil
+	case authMethodTLSClientAuth:
+		// the client is authenticated by the certificate of the TLS connection
+		return &clientcredentials.Config{
+			ClientID:       clientID,
+			TokenURL:       c.TokenURL,
+			Scopes:         c.Scopes,
+			EndpointParams: c.EndpointParams,
+			AuthStyle:      oauth2.AuthStyleInParams,
+		}, nil
+	}
+
 	clientSecret, err := getActualValue(c.ClientSecret, c.ClientSecretFile)
 	if err != nil {
 		return nil, multierr.Combine(errNoClientSecretProvided, err)
@@ -89,6 +123,15 @@ func (c *clientCredentialsConfig) createConfig() (*clientcredentials.Config, err
 	}, nil
 }
 
+// endpointParams returns a copy of the endpoint parameters.
+func (c *clientCredentialsConfig) endpointParams() url.Values {
+	params := url.Values{}
+	for key, values := range c.EndpointParams {
+		params[key] = append([]string(nil), values...)
+	}
+	return params
+}
+
 func (c *clientCredentialsConfig) TokenSource(ctx context.Context) oauth2.TokenSource {
 	return oauth2.ReuseTokenSource(nil, clientCredentialsTokenSource{ctx: ctx, config: c})
 }
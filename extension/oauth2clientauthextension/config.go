@@ -5,6 +5,7 @@ package oauth2clientauthextension // import "github.com/open-telemetry/opentelem
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
 	"time"
 
@@ -14,9 +15,23 @@ import (
 )
 
 var (
-	errNoClientIDProvided     = errors.New("no ClientID provided in the OAuth2 exporter configuration")
-	errNoTokenURLProvided     = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
-	errNoClientSecretProvided = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoClientIDProvided          = errors.New("no ClientID provided in the OAuth2 exporter configuration")
+	errNoTokenURLProvided          = errors.New("no TokenURL provided in OAuth Client Credentials configuration")
+	errNoClientSecretProvided      = errors.New("no ClientSecret provided in OAuth Client Credentials configuration")
+	errNoPrivateKeyProvided        = errors.New("no private key provided for the private_key_jwt client authentication")
+	errNoClientCertificateProvided = errors.New("no client certificate provided in the TLS configuration for the tls_client_auth client authentication")
+)
+
+const (
+	// authMethodClientSecret authenticates the client with its secret.
+	// See https://datatracker.ietf.org/doc/html/rfc6749#section-2.3.1
+	authMethodClientSecret = "client_secret"
+	// authMethodPrivateKeyJWT authenticates the client with a JWT assertion signed with its private key.
+	// See https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+	authMethodPrivateKeyJWT = "private_key_jwt"
+	// authMethodTLSClientAuth authenticates the client with its TLS certificate, the tokens being bound to the certificate.
+	// See https://datatracker.ietf.org/doc/html/rfc8705#section-2
+	authMethodTLSClientAuth = "tls_client_auth"
 )
 
 // Config stores the configuration for OAuth2 Client Credentials (2-legged OAuth2 flow) setup.
@@ -36,6 +51,13 @@ type Config struct {
 	// ClientSecretFile is the file pathg to read the application's secret from.
 	ClientSecretFile string `mapstructure:"client_secret_file"`
 
+	// ClientAuthMethod is the method authenticating the client to the token endpoint, one of
+	// client_secret (default), private_key_jwt or tls_client_auth.
+	ClientAuthMethod string `mapstructure:"client_auth_method,omitempty"`
+
+	// PrivateKeyJWT configures the JWT assertions of the private_key_jwt client authentication.
+	PrivateKeyJWT PrivateKeyJWTConfig `mapstructure:"private_key_jwt,omitempty"`
+
 	// EndpointParams specifies additional parameters for requests to the token endpoint.
 	EndpointParams url.Values `mapstructure:"endpoint_params"`
 
@@ -56,6 +78,30 @@ type Config struct {
 	Timeout time.Duration `mapstructure:"timeout,omitempty"`
 }
 
+// PrivateKeyJWTConfig defines the JWT assertions authenticating the client.
+// See https://datatracker.ietf.org/doc/html/rfc7523#section-3
+type PrivateKeyJWTConfig struct {
+	// Key is the PEM encoded private key signing the assertions.
+	Key configopaque.String `mapstructure:"key"`
+
+	// KeyFile is the file path to read the PEM encoded private key from. The file is read for
+	// each assertion, so that the key can be rotated.
+	KeyFile string `mapstructure:"key_file"`
+
+	// KeyID is the "kid" header of the assertions, identifying the key registered for the client.
+	KeyID string `mapstructure:"key_id"`
+
+	// Algorithm signing the assertions, one of RS256 (default), RS384, RS512, PS256, PS384, PS512,
+	// ES256, ES384 or ES512.
+	Algorithm string `mapstructure:"algorithm"`
+
+	// Audience of the assertions (default: the token URL).
+	Audience string `mapstructure:"audience"`
+
+	// Expiration is the lifetime of the assertions (default: 5m).
+	Expiration time.Duration `mapstructure:"expiration"`
+}
+
 var _ component.Config = (*Config)(nil)
 
 // Validate checks if the extension configuration is valid
@@ -63,11 +109,42 @@ func (cfg *Config) Validate() error {
 	if cfg.ClientID == "" && cfg.ClientIDFile == "" {
 		return errNoClientIDProvided
 	}
-	if cfg.ClientSecret == "" && cfg.ClientSecretFile == "" {
-		return errNoClientSecretProvided
+	switch cfg.ClientAuthMethod {
+	case "", authMethodClientSecret:
+		if cfg.ClientSecret == "" && cfg.ClientSecretFile == "" {
+			return errNoClientSecretProvided
+		}
+	case authMethodPrivateKeyJWT:
+		if err := cfg.PrivateKeyJWT.validate(); err != nil {
+			return err
+		}
+	case authMethodTLSClientAuth:
+		if (cfg.TLSSetting.CertFile == "" && cfg.TLSSetting.CertPem == "") ||
+			(cfg.TLSSetting.KeyFile == "" && cfg.TLSSetting.KeyPem == "") {
+			return errNoClientCertificateProvided
+		}
+	default:
+		return fmt.Errorf("unknown client_auth_method %q, must be one of %s, %s or %s",
+			cfg.ClientAuthMethod, authMethodClientSecret, authMethodPrivateKeyJWT, authMethodTLSClientAuth)
 	}
 	if cfg.TokenURL == "" {
 		return errNoTokenURLProvided
 	}
 	return nil
 }
+
+func (cfg *PrivateKeyJWTConfig) validate() error {
+	if cfg.Key == "" && cfg.KeyFile == "" {
+		return errNoPrivateKeyProvided
+	}
+	if cfg.Key != "" && cfg.KeyFile != "" {
+		return errors.New("private_key_jwt::key and private_key_jwt::key_file cannot be both specified")
+	}
+	if _, err := signingMethod(cfg.Algorithm); err != nil {
+		return err
+	}
+	if cfg.Expiration < 0 {
+		return errors.New("private_key_jwt::expiration cannot be negative")
+	}
+	return nil
+}
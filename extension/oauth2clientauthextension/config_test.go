@@ -57,6 +57,44 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "privatekeyjwt"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				ClientAuthMethod: authMethodPrivateKeyJWT,
+				PrivateKeyJWT: PrivateKeyJWTConfig{
+					KeyFile:    "testdata/test-key.pem",
+					KeyID:      "somekeyid",
+					Algorithm:  "PS256",
+					Audience:   "https://example.com/oauth2/default",
+					Expiration: time.Minute,
+				},
+				Scopes:   []string{"api.metrics"},
+				TokenURL: "https://example.com/oauth2/default/v1/token",
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "tlsclientauth"),
+			expected: &Config{
+				ClientID:         "someclientid",
+				ClientAuthMethod: authMethodTLSClientAuth,
+				TokenURL:         "https://example.com/oauth2/default/v1/token",
+				TLSSetting: configtls.ClientConfig{
+					Config: configtls.Config{
+						CertFile: "certfile",
+						KeyFile:  "keyfile",
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingprivatekey"),
+			expectedErr: errNoPrivateKeyProvided,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missingclientcertificate"),
+			expectedErr: errNoClientCertificateProvided,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "missingurl"),
 			expectedErr: errNoTokenURLProvided,
@@ -88,3 +126,56 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateClientAuthMethod(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name: "unknown_method",
+			modify: func(cfg *Config) {
+				cfg.ClientAuthMethod = "client_secret_jwt"
+			},
+			wantErr: `unknown client_auth_method "client_secret_jwt", must be one of client_secret, private_key_jwt or tls_client_auth`,
+		},
+		{
+			name: "key_and_key_file",
+			modify: func(cfg *Config) {
+				cfg.ClientAuthMethod = authMethodPrivateKeyJWT
+				cfg.PrivateKeyJWT.Key = "key"
+				cfg.PrivateKeyJWT.KeyFile = "key.pem"
+			},
+			wantErr: "private_key_jwt::key and private_key_jwt::key_file cannot be both specified",
+		},
+		{
+			name: "unsupported_algorithm",
+			modify: func(cfg *Config) {
+				cfg.ClientAuthMethod = authMethodPrivateKeyJWT
+				cfg.PrivateKeyJWT.KeyFile = "key.pem"
+				cfg.PrivateKeyJWT.Algorithm = "HS256"
+			},
+			wantErr: `unsupported private_key_jwt::algorithm "HS256"`,
+		},
+		{
+			name: "negative_expiration",
+			modify: func(cfg *Config) {
+				cfg.ClientAuthMethod = authMethodPrivateKeyJWT
+				cfg.PrivateKeyJWT.KeyFile = "key.pem"
+				cfg.PrivateKeyJWT.Expiration = -time.Minute
+			},
+			wantErr: "private_key_jwt::expiration cannot be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{
+				ClientID: "someclientid",
+				TokenURL: "https://example.com/oauth2/default/v1/token",
+			}
+			tt.modify(cfg)
+			assert.EqualError(t, cfg.Validate(), tt.wantErr)
+		})
+	}
+}
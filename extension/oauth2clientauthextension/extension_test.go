@@ -5,6 +5,7 @@ package oauth2clientauthextension
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -313,3 +314,37 @@ func TestFailContactingOAuth(t *testing.T) {
 	assert.ErrorIs(t, err, errFailedToGetSecurityToken)
 	assert.Contains(t, err.Error(), serverURL.String())
 }
+
+func TestTLSClientAuthTokenRequest(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Len(t, r.TLS.PeerCertificates, 1)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "testclientid", r.PostForm.Get("client_id"))
+		assert.Empty(t, r.PostForm.Get("client_secret"))
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"access_token": "boundtoken", "token_type": "Bearer"}`))
+		assert.NoError(t, err)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	oauth2Authenticator, err := newClientAuthenticator(&Config{
+		ClientID:         "testclientid",
+		ClientAuthMethod: authMethodTLSClientAuth,
+		TokenURL:         server.URL,
+		TLSSetting: configtls.ClientConfig{
+			Config: configtls.Config{
+				CertFile: "testdata/test-cert.pem",
+				KeyFile:  "testdata/test-key.pem",
+			},
+			InsecureSkipVerify: true,
+		},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	token, err := fetchToken(oauth2Authenticator)
+	require.NoError(t, err)
+	assert.Equal(t, "boundtoken", token.AccessToken)
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/oauth2clientauthextension"
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// clientAssertionType is the type of the JWT client assertions.
+	// See https://datatracker.ietf.org/doc/html/rfc7523#section-2.2
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	defaultAssertionAlgorithm  = "RS256"
+	defaultAssertionExpiration = 5 * time.Minute
+)
+
+func signingMethod(algorithm string) (jwt.SigningMethod, error) {
+	if algorithm == "" {
+		algorithm = defaultAssertionAlgorithm
+	}
+	switch algorithm {
+	case "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512":
+		return jwt.GetSigningMethod(algorithm), nil
+	default:
+		return nil, fmt.Errorf("unsupported private_key_jwt::algorithm %q", algorithm)
+	}
+}
+
+// signingKey parses the PEM encoded private key of the algorithm.
+func signingKey(method jwt.SigningMethod, pem []byte) (any, error) {
+	switch method.(type) {
+	case *jwt.SigningMethodECDSA:
+		return jwt.ParseECPrivateKeyFromPEM(pem)
+	default:
+		return jwt.ParseRSAPrivateKeyFromPEM(pem)
+	}
+}
+
+// clientAssertion returns a JWT assertion authenticating the client to the token endpoint,
+// signed with the private key.
+func (c *PrivateKeyJWTConfig) clientAssertion(clientID, tokenURL string, now time.Time) (string, error) {
+	method, err := signingMethod(c.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	pem := []byte(c.Key)
+	if c.KeyFile != "" {
+		if pem, err = os.ReadFile(c.KeyFile); err != nil {
+			return "", fmt.Errorf("failed to read private key file %q: %w", c.KeyFile, err)
+		}
+	}
+	key, err := signingKey(method, pem)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the private key: %w", err)
+	}
+
+	jti := make([]byte, 16)
+	if _, err = rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	audience := c.Audience
+	if audience == "" {
+		audience = tokenURL
+	}
+	expiration := c.Expiration
+	if expiration == 0 {
+		expiration = defaultAssertionExpiration
+	}
+
+	token := jwt.NewWithClaims(method, jwt.RegisteredClaims{
+		Issuer:    clientID,
+		Subject:   clientID,
+		Audience:  jwt.ClaimStrings{audience},
+		ExpiresAt: jwt.NewNumericDate(now.Add(expiration)),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ID:        hex.EncodeToString(jti),
+	})
+	if c.KeyID != "" {
+		token.Header["kid"] = c.KeyID
+	}
+	return token.SignedString(key)
+}
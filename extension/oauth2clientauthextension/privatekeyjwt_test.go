@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2clientauthextension
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+func TestClientAssertion(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		algorithm string
+		key       crypto.Signer
+	}{
+		{name: "default", key: rsaKey},
+		{name: "RS512", algorithm: "RS512", key: rsaKey},
+		{name: "PS256", algorithm: "PS256", key: rsaKey},
+		{name: "ES256", algorithm: "ES256", key: ecKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := PrivateKeyJWTConfig{
+				Key:       configopaque.String(encodePrivateKey(t, tt.key)),
+				KeyID:     "somekeyid",
+				Algorithm: tt.algorithm,
+			}
+			now := time.Now()
+			assertion, err := cfg.clientAssertion("someclientid", "https://example.com/token", now)
+			require.NoError(t, err)
+
+			claims := jwt.RegisteredClaims{}
+			token, err := jwt.ParseWithClaims(assertion, &claims, func(*jwt.Token) (any, error) {
+				return tt.key.Public(), nil
+			})
+			require.NoError(t, err)
+			expectedAlgorithm := tt.algorithm
+			if expectedAlgorithm == "" {
+				expectedAlgorithm = "RS256"
+			}
+			assert.Equal(t, expectedAlgorithm, token.Method.Alg())
+			assert.Equal(t, "somekeyid", token.Header["kid"])
+			assert.Equal(t, "someclientid", claims.Issuer)
+			assert.Equal(t, "someclientid", claims.Subject)
+			assert.Equal(t, jwt.ClaimStrings{"https://example.com/token"}, claims.Audience)
+			assert.Equal(t, now.Add(5*time.Minute).Unix(), claims.ExpiresAt.Unix())
+			assert.NotEmpty(t, claims.ID)
+		})
+	}
+}
+
+func TestClientAssertionUniqueID(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	cfg := PrivateKeyJWTConfig{
+		Key:        configopaque.String(encodePrivateKey(t, key)),
+		Audience:   "https://example.com",
+		Expiration: time.Minute,
+	}
+
+	ids := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		assertion, err := cfg.clientAssertion("someclientid", "https://example.com/token", time.Now())
+		require.NoError(t, err)
+		claims := jwt.RegisteredClaims{}
+		_, err = jwt.ParseWithClaims(assertion, &claims, func(*jwt.Token) (any, error) {
+			return key.Public(), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, jwt.ClaimStrings{"https://example.com"}, claims.Audience)
+		ids[claims.ID] = true
+	}
+	assert.Len(t, ids, 2)
+}
+
+func TestClientAssertionInvalidKey(t *testing.T) {
+	cfg := PrivateKeyJWTConfig{KeyFile: "testdata/test-cred.txt"}
+	_, err := cfg.clientAssertion("someclientid", "https://example.com/token", time.Now())
+	assert.ErrorContains(t, err, "failed to parse the private key")
+
+	cfg = PrivateKeyJWTConfig{KeyFile: "testdata/missing.pem"}
+	_, err = cfg.clientAssertion("someclientid", "https://example.com/token", time.Now())
+	assert.ErrorContains(t, err, `failed to read private key file "testdata/missing.pem"`)
+}
+
+func TestPrivateKeyJWTTokenRequest(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, encodePrivateKey(t, key), 0600))
+
+	var publicKey crypto.PublicKey
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "someclientid", r.PostForm.Get("client_id"))
+		assert.Empty(t, r.PostForm.Get("client_secret"))
+		assert.Equal(t, "someaudience", r.PostForm.Get("audience"))
+		assert.Equal(t, clientAssertionType, r.PostForm.Get("client_assertion_type"))
+		_, err := jwt.Parse(r.PostForm.Get("client_assertion"), func(*jwt.Token) (any, error) {
+			return publicKey, nil
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"access_token": "sometoken", "token_type": "Bearer"}))
+	}))
+	defer server.Close()
+
+	newTokenSource := func() *clientAuthenticator {
+		oauth2Authenticator, err := newClientAuthenticator(&Config{
+			ClientID:         "someclientid",
+			ClientAuthMethod: authMethodPrivateKeyJWT,
+			PrivateKeyJWT:    PrivateKeyJWTConfig{KeyFile: keyFile},
+			EndpointParams:   map[string][]string{"audience": {"someaudience"}},
+			TokenURL:         server.URL,
+		}, zap.NewNop())
+		require.NoError(t, err)
+		return oauth2Authenticator
+	}
+
+	publicKey = key.Public()
+	token, err := fetchToken(newTokenSource())
+	require.NoError(t, err)
+	assert.Equal(t, "sometoken", token.AccessToken)
+
+	// the key file is read for each assertion, so that the key can be rotated
+	rotatedKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, encodePrivateKey(t, rotatedKey), 0600))
+	publicKey = rotatedKey.Public()
+
+	oauth2Authenticator := newTokenSource()
+	oauth2Authenticator.clientCredentials.PrivateKeyJWT.Algorithm = "ES384"
+	_, err = fetchToken(oauth2Authenticator)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+// fetchToken requests a token with the client of the authenticator.
+func fetchToken(o *clientAuthenticator) (*oauth2.Token, error) {
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, o.client)
+	return o.clientCredentials.TokenSource(ctx).Token()
+}
+
+func encodePrivateKey(t *testing.T, key crypto.Signer) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
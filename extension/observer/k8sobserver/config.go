@@ -6,6 +6,8 @@ package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector
 import (
 	"fmt"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
@@ -36,6 +38,10 @@ type Config struct {
 	ObserveNodes bool `mapstructure:"observe_nodes"`
 	// ObserveServices determines whether to report observer service and port endpoints. `false` by default.
 	ObserveServices bool `mapstructure:"observe_services"`
+	// InformerExtension is the ID of a k8s_informer extension whose shared informers are used
+	// instead of opening list/watch connections of the observer's own. The API settings of the
+	// observer are ignored when it is set.
+	InformerExtension *component.ID `mapstructure:"informer_extension"`
 }
 
 // Validate checks if the extension configuration is valid
@@ -19,6 +19,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	informerID := component.MustNewID("k8s_informer")
+
 	tests := []struct {
 		id          component.ID
 		expected    component.Config
@@ -46,6 +48,15 @@ func TestLoadConfig(t *testing.T) {
 				ObserveServices: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "shared-informer"),
+			expected: &Config{
+				APIConfig:         k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				ObservePods:       true,
+				ObserveNodes:      true,
+				InformerExtension: &informerID,
+			},
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "invalid_auth"),
 			expectedErr: "invalid authType for kubernetes: not a real auth type",
@@ -5,6 +5,7 @@ package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -14,14 +15,17 @@ import (
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 var _ extension.Extension = (*k8sObserver)(nil)
 var _ observer.Observable = (*k8sObserver)(nil)
+var _ extension.Dependent = (*k8sObserver)(nil)
 
 type k8sObserver struct {
 	*observer.EndpointsWatcher
@@ -33,16 +37,21 @@ type k8sObserver struct {
 	once                 *sync.Once
 	stop                 chan struct{}
 	config               *Config
+	// sharedRegistrations are the handlers added to the informers of the informer extension.
+	sharedRegistrations map[cache.SharedIndexInformer]cache.ResourceEventHandlerRegistration
 }
 
 // Start will populate the cache.SharedInformers for pods and nodes as configured and run them as goroutines.
-func (k *k8sObserver) Start(_ context.Context, _ component.Host) error {
+func (k *k8sObserver) Start(_ context.Context, host component.Host) error {
 	if k.once == nil {
 		return fmt.Errorf("cannot Start() partial k8sObserver (nil *sync.Once)")
 	}
 	if k.handler == nil {
 		return fmt.Errorf("cannot Start() partial k8sObserver (nil *handler)")
 	}
+	if k.config.InformerExtension != nil {
+		return k.startSharedInformers(host)
+	}
 
 	k.once.Do(func() {
 		if k.podListerWatcher != nil {
@@ -73,61 +82,118 @@ func (k *k8sObserver) Start(_ context.Context, _ component.Host) error {
 	return nil
 }
 
+// Dependencies returns the informer extension, when configured, so that it is started before the observer.
+func (k *k8sObserver) Dependencies() []component.ID {
+	if k.config.InformerExtension == nil {
+		return nil
+	}
+	return []component.ID{*k.config.InformerExtension}
+}
+
+// startSharedInformers adds the handler to the informers of the informer extension instead of
+// running informers of its own.
+func (k *k8sObserver) startSharedInformers(host component.Host) error {
+	ext, ok := host.GetExtensions()[*k.config.InformerExtension]
+	if !ok {
+		return fmt.Errorf("informer extension %q not found", k.config.InformerExtension)
+	}
+	provider, ok := ext.(k8sinformerextension.Provider)
+	if !ok {
+		return fmt.Errorf("extension %q is not a k8s informer extension", k.config.InformerExtension)
+	}
+
+	var err error
+	k.once.Do(func() {
+		if k.config.ObservePods {
+			k.telemetry.Logger.Debug("observing pods with the shared informer")
+			err = errors.Join(err, k.addSharedHandler(provider, "pods", podSelector(k.config.Node)))
+		}
+		if k.config.ObserveServices {
+			k.telemetry.Logger.Debug("observing services with the shared informer")
+			err = errors.Join(err, k.addSharedHandler(provider, "services", fields.Everything()))
+		}
+		if k.config.ObserveNodes {
+			k.telemetry.Logger.Debug("observing nodes with the shared informer")
+			err = errors.Join(err, k.addSharedHandler(provider, "nodes", nodeSelector(k.config.Node)))
+		}
+	})
+	return err
+}
+
+func (k *k8sObserver) addSharedHandler(provider k8sinformerextension.Provider, resource string, selector fields.Selector) error {
+	informer, err := provider.Informer(
+		schema.GroupVersionResource{Version: "v1", Resource: resource},
+		k8sinformerextension.InformerOptions{FieldSelector: selector.String()},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get the shared %s informer: %w", resource, err)
+	}
+	registration, err := informer.AddEventHandler(k.handler)
+	if err != nil {
+		return fmt.Errorf("error adding event handler to the shared %s informer: %w", resource, err)
+	}
+	k.sharedRegistrations[informer] = registration
+	return nil
+}
+
 // Shutdown tells any cache.SharedInformers to stop running.
 func (k *k8sObserver) Shutdown(_ context.Context) error {
 	close(k.stop)
-	return nil
+	var errs error
+	for informer, registration := range k.sharedRegistrations {
+		errs = errors.Join(errs, informer.RemoveEventHandler(registration))
+	}
+	return errs
+}
+
+func podSelector(node string) fields.Selector {
+	if node == "" {
+		return fields.Everything()
+	}
+	return fields.OneTermEqualSelector("spec.nodeName", node)
+}
+
+func nodeSelector(node string) fields.Selector {
+	if node == "" {
+		return fields.Everything()
+	}
+	return fields.OneTermEqualSelector("metadata.name", node)
 }
 
 // newObserver creates a new k8s observer extension.
 func newObserver(config *Config, set extension.CreateSettings) (extension.Extension, error) {
+	h := &handler{idNamespace: set.ID.String(), endpoints: &sync.Map{}, logger: set.TelemetrySettings.Logger}
+	obs := &k8sObserver{
+		EndpointsWatcher:    observer.NewEndpointsWatcher(h, time.Second, set.TelemetrySettings.Logger),
+		telemetry:           set.TelemetrySettings,
+		stop:                make(chan struct{}),
+		config:              config,
+		handler:             h,
+		once:                &sync.Once{},
+		sharedRegistrations: map[cache.SharedIndexInformer]cache.ResourceEventHandlerRegistration{},
+	}
+	if config.InformerExtension != nil {
+		// The informers and their connection to the API server are owned by the informer extension.
+		return obs, nil
+	}
+
 	client, err := k8sconfig.MakeClient(config.APIConfig)
 	if err != nil {
 		return nil, err
 	}
 	restClient := client.CoreV1().RESTClient()
 
-	var podListerWatcher cache.ListerWatcher
 	if config.ObservePods {
-		var podSelector fields.Selector
-		if config.Node == "" {
-			podSelector = fields.Everything()
-		} else {
-			podSelector = fields.OneTermEqualSelector("spec.nodeName", config.Node)
-		}
 		set.Logger.Debug("observing pods")
-		podListerWatcher = cache.NewListWatchFromClient(restClient, "pods", v1.NamespaceAll, podSelector)
+		obs.podListerWatcher = cache.NewListWatchFromClient(restClient, "pods", v1.NamespaceAll, podSelector(config.Node))
 	}
-
-	var serviceListerWatcher cache.ListerWatcher
 	if config.ObserveServices {
-		var serviceSelector = fields.Everything()
 		set.Logger.Debug("observing services")
-		serviceListerWatcher = cache.NewListWatchFromClient(restClient, "services", v1.NamespaceAll, serviceSelector)
+		obs.serviceListerWatcher = cache.NewListWatchFromClient(restClient, "services", v1.NamespaceAll, fields.Everything())
 	}
-
-	var nodeListerWatcher cache.ListerWatcher
 	if config.ObserveNodes {
-		var nodeSelector fields.Selector
-		if config.Node == "" {
-			nodeSelector = fields.Everything()
-		} else {
-			nodeSelector = fields.OneTermEqualSelector("metadata.name", config.Node)
-		}
 		set.Logger.Debug("observing nodes")
-		nodeListerWatcher = cache.NewListWatchFromClient(restClient, "nodes", v1.NamespaceAll, nodeSelector)
-	}
-	h := &handler{idNamespace: set.ID.String(), endpoints: &sync.Map{}, logger: set.TelemetrySettings.Logger}
-	obs := &k8sObserver{
-		EndpointsWatcher:     observer.NewEndpointsWatcher(h, time.Second, set.TelemetrySettings.Logger),
-		telemetry:            set.TelemetrySettings,
-		podListerWatcher:     podListerWatcher,
-		serviceListerWatcher: serviceListerWatcher,
-		nodeListerWatcher:    nodeListerWatcher,
-		stop:                 make(chan struct{}),
-		config:               config,
-		handler:              h,
-		once:                 &sync.Once{},
+		obs.nodeListerWatcher = cache.NewListWatchFromClient(restClient, "nodes", v1.NamespaceAll, nodeSelector(config.Node))
 	}
 
 	return obs, nil
@@ -11,9 +11,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/extension/extensiontest"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -309,3 +314,84 @@ func TestExtensionObserveNodes(t *testing.T) {
 
 	require.NoError(t, ext.Shutdown(context.Background()))
 }
+
+type fakeInformerProvider struct {
+	component.StartFunc
+	component.ShutdownFunc
+	informers map[string]cache.SharedIndexInformer
+	options   map[string]k8sinformerextension.InformerOptions
+}
+
+func (p *fakeInformerProvider) Informer(gvr schema.GroupVersionResource, opts k8sinformerextension.InformerOptions) (cache.SharedIndexInformer, error) {
+	p.options[gvr.Resource] = opts
+	return p.informers[gvr.Resource], nil
+}
+
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestExtensionObservePodsWithSharedInformer(t *testing.T) {
+	informerID := component.MustNewID("k8s_informer")
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	config.Node = "node1"
+	config.InformerExtension = &informerID
+
+	set := extensiontest.NewNopCreateSettings()
+	set.ID = component.NewID(metadata.Type)
+	ext, err := newObserver(config, set)
+	require.NoError(t, err)
+
+	podListerWatcher := framework.NewFakeControllerSource()
+	podListerWatcher.Add(pod1V1)
+	podInformer := cache.NewSharedIndexInformer(podListerWatcher, &v1.Pod{}, 0, cache.Indexers{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go podInformer.Run(stop)
+
+	provider := &fakeInformerProvider{
+		informers: map[string]cache.SharedIndexInformer{"pods": podInformer},
+		options:   map[string]k8sinformerextension.InformerOptions{},
+	}
+	host := extensionsHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{informerID: provider}}
+	require.NoError(t, ext.Start(context.Background(), host))
+	assert.Equal(t, map[string]k8sinformerextension.InformerOptions{"pods": {FieldSelector: "spec.nodeName=node1"}}, provider.options)
+
+	sink := &endpointSink{}
+	obs := ext.(*k8sObserver)
+	obs.ListAndWatch(sink)
+
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 1
+	})
+	assert.Equal(t, "k8s_observer/pod1-UID", string(sink.added[0].ID))
+
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestExtensionDependencies(t *testing.T) {
+	config := NewFactory().CreateDefaultConfig().(*Config)
+	var ext extension.Dependent = &k8sObserver{config: config}
+	assert.Empty(t, ext.Dependencies())
+
+	informerID := component.MustNewID("k8s_informer")
+	config.InformerExtension = &informerID
+	assert.Equal(t, []component.ID{informerID}, ext.Dependencies())
+}
+
+func TestExtensionMissingSharedInformer(t *testing.T) {
+	informerID := component.MustNewID("k8s_informer")
+	config := NewFactory().CreateDefaultConfig().(*Config)
+	config.InformerExtension = &informerID
+
+	ext, err := newObserver(config, extensiontest.NewNopCreateSettings())
+	require.NoError(t, err)
+	assert.EqualError(t, ext.Start(context.Background(), componenttest.NewNopHost()), `informer extension "k8s_informer" not found`)
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
@@ -3,6 +3,7 @@ module github.com/open-telemetry/opentelemetry-collector-contrib/extension/obser
 go 1.21.0
 
 require (
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension v0.102.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer v0.102.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig v0.102.0
 	github.com/stretchr/testify v1.9.0
@@ -85,6 +86,8 @@ require (
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer => ../
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension => ../../k8sinformerextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig => ../../../internal/k8sconfig
 
 // openshift removed all tags from their repo, use the pseudoversion from the release-3.9 branch HEAD
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampcustommessages // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampcustommessages"
+
+import (
+	"context"
+	"errors"
+)
+
+// ComponentControlCapability is the custom capability of the messages of an OpAMP server
+// requesting the components of the collector to perform actions at runtime.
+const ComponentControlCapability = "io.opentelemetry.collector.component_control"
+
+const (
+	// ComponentControlRequestType is the type of the messages requesting a component to
+	// perform an action, their data is a JSON encoded ComponentControlRequest.
+	ComponentControlRequestType = "request"
+
+	// ComponentControlResponseType is the type of the messages reporting the outcome of a
+	// request, their data is a JSON encoded ComponentControlResponse.
+	ComponentControlResponseType = "response"
+)
+
+// ErrUnknownAction may be returned by a ComponentActionHandler for the actions it doesn't support.
+var ErrUnknownAction = errors.New("unknown action")
+
+// ComponentControlRequest is a request of the OpAMP server for a component to perform an action.
+type ComponentControlRequest struct {
+	// ID identifies the request, it is reported back in its response.
+	ID string `json:"id"`
+	// Component is the component performing the action, as <kind>/<id>, e.g. exporter/otlp.
+	Component string `json:"component"`
+	// Action is the name of the action, e.g. flush.
+	Action string `json:"action"`
+	// Arguments are the optional arguments of the action.
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+// ComponentControlResponse is the outcome of a ComponentControlRequest.
+type ComponentControlResponse struct {
+	ID        string `json:"id"`
+	Component string `json:"component"`
+	Action    string `json:"action"`
+	// Result is the optional result of the action if it succeeded.
+	Result string `json:"result,omitempty"`
+	// Error is the error of the action if it failed.
+	Error string `json:"error,omitempty"`
+}
+
+// ComponentActionHandler performs an action requested to a component and returns its result.
+type ComponentActionHandler func(ctx context.Context, action string, arguments map[string]string) (result string, err error)
+
+// ComponentController allows components to perform the actions requested by an OpAMP server.
+type ComponentController interface {
+	// RegisterComponent registers the handler of the actions requested to a component, as
+	// <kind>/<id>, e.g. receiver/filelog. It returns a function unregistering the handler.
+	RegisterComponent(component string, handler ComponentActionHandler) (unregister func(), err error)
+}
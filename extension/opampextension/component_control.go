@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampcustommessages"
+)
+
+// componentController dispatches the component control requests of the OpAMP server to the
+// action handlers registered by the components, and sends back their responses. The component
+// control capability is only registered while at least one component is.
+type componentController struct {
+	mux      *sync.Mutex
+	registry opampcustommessages.CustomCapabilityRegistry
+	logger   *zap.Logger
+
+	handlers map[string]opampcustommessages.ComponentActionHandler
+
+	capabilityHandler opampcustommessages.CustomCapabilityHandler
+	cancel            context.CancelFunc
+	wg                sync.WaitGroup
+}
+
+var _ opampcustommessages.ComponentController = (*componentController)(nil)
+
+func newComponentController(logger *zap.Logger, registry opampcustommessages.CustomCapabilityRegistry) *componentController {
+	return &componentController{
+		mux:      &sync.Mutex{},
+		registry: registry,
+		logger:   logger,
+		handlers: make(map[string]opampcustommessages.ComponentActionHandler),
+	}
+}
+
+// RegisterComponent implements ComponentController.RegisterComponent
+func (cc *componentController) RegisterComponent(component string, handler opampcustommessages.ComponentActionHandler) (func(), error) {
+	cc.mux.Lock()
+	defer cc.mux.Unlock()
+
+	if _, ok := cc.handlers[component]; ok {
+		return nil, fmt.Errorf("component %q is already registered", component)
+	}
+
+	if cc.capabilityHandler == nil {
+		capabilityHandler, err := cc.registry.Register(opampcustommessages.ComponentControlCapability)
+		if err != nil {
+			return nil, err
+		}
+		var ctx context.Context
+		ctx, cc.cancel = context.WithCancel(context.Background())
+		cc.capabilityHandler = capabilityHandler
+		cc.wg.Add(1)
+		go cc.processRequests(ctx, capabilityHandler)
+	}
+
+	cc.handlers[component] = handler
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cc.unregisterComponent(component)
+		})
+	}, nil
+}
+
+func (cc *componentController) unregisterComponent(component string) {
+	cc.mux.Lock()
+	delete(cc.handlers, component)
+	if len(cc.handlers) > 0 {
+		cc.mux.Unlock()
+		return
+	}
+	cc.mux.Unlock()
+
+	cc.stop()
+}
+
+// stop unregisters the component control capability and waits for the request being
+// processed, if any.
+func (cc *componentController) stop() {
+	cc.mux.Lock()
+	capabilityHandler, cancel := cc.capabilityHandler, cc.cancel
+	cc.capabilityHandler, cc.cancel = nil, nil
+	cc.mux.Unlock()
+
+	if capabilityHandler == nil {
+		return
+	}
+	cancel()
+	capabilityHandler.Unregister()
+	cc.wg.Wait()
+}
+
+func (cc *componentController) processRequests(ctx context.Context, capabilityHandler opampcustommessages.CustomCapabilityHandler) {
+	defer cc.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-capabilityHandler.Message():
+			if msg.Type != opampcustommessages.ComponentControlRequestType {
+				cc.logger.Debug("Ignoring component control message", zap.String("type", msg.Type))
+				continue
+			}
+			response := cc.handleRequest(ctx, msg)
+			cc.sendResponse(ctx, capabilityHandler, response)
+		}
+	}
+}
+
+func (cc *componentController) handleRequest(ctx context.Context, msg *protobufs.CustomMessage) *opampcustommessages.ComponentControlResponse {
+	var request opampcustommessages.ComponentControlRequest
+	if err := json.Unmarshal(msg.Data, &request); err != nil {
+		return &opampcustommessages.ComponentControlResponse{
+			Error: fmt.Sprintf("invalid request: %v", err),
+		}
+	}
+
+	response := &opampcustommessages.ComponentControlResponse{
+		ID:        request.ID,
+		Component: request.Component,
+		Action:    request.Action,
+	}
+
+	cc.mux.Lock()
+	handler, ok := cc.handlers[request.Component]
+	cc.mux.Unlock()
+	if !ok {
+		response.Error = fmt.Sprintf("component %q doesn't accept actions", request.Component)
+		return response
+	}
+
+	cc.logger.Debug("Performing component action",
+		zap.String("id", request.ID),
+		zap.String("component", request.Component),
+		zap.String("action", request.Action))
+	result, err := handler(ctx, request.Action, request.Arguments)
+	if err != nil {
+		response.Error = err.Error()
+		return response
+	}
+	response.Result = result
+	return response
+}
+
+func (cc *componentController) sendResponse(ctx context.Context, capabilityHandler opampcustommessages.CustomCapabilityHandler, response *opampcustommessages.ComponentControlResponse) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		cc.logger.Error("Failed to marshal component control response", zap.Error(err))
+		return
+	}
+
+	for {
+		sendingChan, err := capabilityHandler.SendMessage(opampcustommessages.ComponentControlResponseType, data)
+		switch {
+		case err == nil:
+			return
+		case errors.Is(err, types.ErrCustomMessagePending):
+			select {
+			case <-sendingChan:
+			case <-ctx.Done():
+				return
+			}
+		default:
+			cc.logger.Error("Failed to send component control response", zap.String("id", response.ID), zap.Error(err))
+			return
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package opampextension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/client/types"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/opampcustommessages"
+)
+
+func controlRequest(t *testing.T, request opampcustommessages.ComponentControlRequest) *protobufs.CustomMessage {
+	data, err := json.Marshal(request)
+	require.NoError(t, err)
+	return &protobufs.CustomMessage{
+		Capability: opampcustommessages.ComponentControlCapability,
+		Type:       opampcustommessages.ComponentControlRequestType,
+		Data:       data,
+	}
+}
+
+func TestComponentController(t *testing.T) {
+	sent := make(chan *protobufs.CustomMessage, 1)
+	var capabilities *protobufs.CustomCapabilities
+	pending := true
+	client := mockCustomCapabilityClient{
+		setCustomCapabilites: func(customCapabilities *protobufs.CustomCapabilities) error {
+			capabilities = customCapabilities
+			return nil
+		},
+		sendCustomMessage: func(message *protobufs.CustomMessage) (chan struct{}, error) {
+			sendingChan := make(chan struct{})
+			close(sendingChan)
+			// The first response is sent once the previous message is.
+			if pending {
+				pending = false
+				return sendingChan, types.ErrCustomMessagePending
+			}
+			sent <- message
+			return sendingChan, nil
+		},
+	}
+	registry := newCustomCapabilityRegistry(zap.NewNop(), client)
+	controller := newComponentController(zap.NewNop(), registry)
+
+	unregister, err := controller.RegisterComponent("exporter/otlp", func(_ context.Context, action string, arguments map[string]string) (string, error) {
+		if action != "flush" {
+			return "", opampcustommessages.ErrUnknownAction
+		}
+		return "flushed " + arguments["count"] + " batches", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{opampcustommessages.ComponentControlCapability}, capabilities.Capabilities)
+
+	_, err = controller.RegisterComponent("exporter/otlp", nil)
+	assert.EqualError(t, err, `component "exporter/otlp" is already registered`)
+
+	receive := func() opampcustommessages.ComponentControlResponse {
+		select {
+		case msg := <-sent:
+			assert.Equal(t, opampcustommessages.ComponentControlCapability, msg.Capability)
+			assert.Equal(t, opampcustommessages.ComponentControlResponseType, msg.Type)
+			var response opampcustommessages.ComponentControlResponse
+			require.NoError(t, json.Unmarshal(msg.Data, &response))
+			return response
+		case <-time.After(5 * time.Second):
+			t.Fatal("no response sent")
+			return opampcustommessages.ComponentControlResponse{}
+		}
+	}
+
+	registry.ProcessMessage(controlRequest(t, opampcustommessages.ComponentControlRequest{
+		ID:        "1",
+		Component: "exporter/otlp",
+		Action:    "flush",
+		Arguments: map[string]string{"count": "3"},
+	}))
+	assert.Equal(t, opampcustommessages.ComponentControlResponse{
+		ID:        "1",
+		Component: "exporter/otlp",
+		Action:    "flush",
+		Result:    "flushed 3 batches",
+	}, receive())
+
+	registry.ProcessMessage(controlRequest(t, opampcustommessages.ComponentControlRequest{
+		ID:        "2",
+		Component: "exporter/otlp",
+		Action:    "rotate",
+	}))
+	assert.Equal(t, opampcustommessages.ComponentControlResponse{
+		ID:        "2",
+		Component: "exporter/otlp",
+		Action:    "rotate",
+		Error:     "unknown action",
+	}, receive())
+
+	registry.ProcessMessage(controlRequest(t, opampcustommessages.ComponentControlRequest{
+		ID:        "3",
+		Component: "receiver/filelog",
+		Action:    "rotate",
+	}))
+	assert.Equal(t, `component "receiver/filelog" doesn't accept actions`, receive().Error)
+
+	registry.ProcessMessage(&protobufs.CustomMessage{
+		Capability: opampcustommessages.ComponentControlCapability,
+		Type:       opampcustommessages.ComponentControlRequestType,
+		Data:       []byte("{"),
+	})
+	assert.Contains(t, receive().Error, "invalid request")
+
+	// The capability is unregistered with the last component.
+	unregister()
+	unregister()
+	assert.Empty(t, capabilities.Capabilities)
+	assert.Empty(t, registry.capabilityToMsgChannels)
+}
+
+func TestComponentControllerRegisterFails(t *testing.T) {
+	client := mockCustomCapabilityClient{
+		setCustomCapabilites: func(*protobufs.CustomCapabilities) error {
+			return errors.New("network error")
+		},
+	}
+	controller := newComponentController(zap.NewNop(), newCustomCapabilityRegistry(zap.NewNop(), client))
+
+	_, err := controller.RegisterComponent("exporter/otlp", func(context.Context, string, map[string]string) (string, error) {
+		return "", nil
+	})
+	assert.ErrorContains(t, err, "network error")
+
+	// Stopping a controller without components is a no-op.
+	controller.stop()
+}
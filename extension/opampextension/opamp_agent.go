@@ -60,9 +60,12 @@ type opampAgent struct {
 	opampClient client.OpAMPClient
 
 	customCapabilityRegistry *customCapabilityRegistry
+
+	componentController *componentController
 }
 
 var _ opampcustommessages.CustomCapabilityRegistry = (*opampAgent)(nil)
+var _ opampcustommessages.ComponentController = (*opampAgent)(nil)
 
 func (o *opampAgent) Start(ctx context.Context, _ component.Host) error {
 	header := http.Header{}
@@ -129,6 +132,7 @@ func (o *opampAgent) Shutdown(ctx context.Context) error {
 	}
 
 	o.logger.Debug("OpAMP agent shutting down...")
+	o.componentController.stop()
 	if o.opampClient == nil {
 		return nil
 	}
@@ -154,6 +158,10 @@ func (o *opampAgent) Register(capability string, opts ...opampcustommessages.Cus
 	return o.customCapabilityRegistry.Register(capability, opts...)
 }
 
+func (o *opampAgent) RegisterComponent(component string, handler opampcustommessages.ComponentActionHandler) (func(), error) {
+	return o.componentController.RegisterComponent(component, handler)
+}
+
 func (o *opampAgent) updateEffectiveConfig(conf *confmap.Conf) {
 	o.eclk.Lock()
 	defer o.eclk.Unlock()
@@ -196,6 +204,7 @@ func newOpampAgent(cfg *Config, set extension.CreateSettings) (*opampAgent, erro
 	}
 
 	opampClient := cfg.Server.GetClient(set.Logger)
+	registry := newCustomCapabilityRegistry(set.Logger, opampClient)
 	agent := &opampAgent{
 		cfg:                      cfg,
 		logger:                   set.Logger,
@@ -204,7 +213,8 @@ func newOpampAgent(cfg *Config, set extension.CreateSettings) (*opampAgent, erro
 		instanceID:               uid,
 		capabilities:             cfg.Capabilities,
 		opampClient:              opampClient,
-		customCapabilityRegistry: newCustomCapabilityRegistry(set.Logger, opampClient),
+		customCapabilityRegistry: registry,
+		componentController:      newComponentController(set.Logger, registry),
 		reportFunc:               set.ReportStatus,
 	}
 
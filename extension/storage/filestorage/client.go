@@ -5,6 +5,7 @@ package filestorage // import "github.com/open-telemetry/opentelemetry-collector
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"os"
@@ -17,7 +18,12 @@ import (
 	"go.uber.org/zap"
 )
 
-var defaultBucket = []byte(`default`)
+var (
+	defaultBucket = []byte(`default`)
+	// lastAccessBucket holds the time each key of the default bucket was last read or written,
+	// when the keys expire
+	lastAccessBucket = []byte(`last_access`)
+)
 
 const (
 	TempDbPrefix = "tempdb"
@@ -29,6 +35,8 @@ const (
 	oneMiB = 1048576
 )
 
+var errClientClosed = errors.New("client is closed")
+
 type fileStorageClient struct {
 	logger          *zap.Logger
 	compactionMutex sync.RWMutex
@@ -37,6 +45,10 @@ type fileStorageClient struct {
 	openTimeout     time.Duration
 	cancel          context.CancelFunc
 	closed          bool
+	// ttl is the time after which a key which is not accessed is deleted, zero when keys don't expire
+	ttl      time.Duration
+	gcCancel context.CancelFunc
+	onClose  func()
 }
 
 func bboltOptions(timeout time.Duration, noSync bool) *bbolt.Options {
@@ -101,6 +113,15 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...storage.Operation) e
 			return errors.New("storage not initialized")
 		}
 
+		var accessBucket *bbolt.Bucket
+		var now []byte
+		if c.ttl > 0 {
+			if accessBucket = tx.Bucket(lastAccessBucket); accessBucket == nil {
+				return errors.New("storage not initialized")
+			}
+			now = encodeAccessTime(time.Now())
+		}
+
 		var err error
 		for _, op := range ops {
 			switch op.Type {
@@ -111,13 +132,22 @@ func (c *fileStorageClient) Batch(_ context.Context, ops ...storage.Operation) e
 					// to be able to return the value
 					op.Value = make([]byte, len(value))
 					copy(op.Value, value)
+					if accessBucket != nil {
+						err = accessBucket.Put([]byte(op.Key), now)
+					}
 				} else {
 					op.Value = nil
 				}
 			case storage.Set:
 				err = bucket.Put([]byte(op.Key), op.Value)
+				if err == nil && accessBucket != nil {
+					err = accessBucket.Put([]byte(op.Key), now)
+				}
 			case storage.Delete:
 				err = bucket.Delete([]byte(op.Key))
+				if err == nil && accessBucket != nil {
+					err = accessBucket.Delete([]byte(op.Key))
+				}
 			default:
 				return errors.New("wrong operation type")
 			}
@@ -143,6 +173,12 @@ func (c *fileStorageClient) Close(_ context.Context) error {
 	if c.cancel != nil {
 		c.cancel()
 	}
+	if c.gcCancel != nil {
+		c.gcCancel()
+	}
+	if c.onClose != nil {
+		c.onClose()
+	}
 	c.closed = true
 	return c.db.Close()
 }
@@ -297,6 +333,140 @@ func (c *fileStorageClient) shouldCompact() bool {
 	return true
 }
 
+// startGarbageCollection tracks the last access of the keys and periodically deletes the keys which were not
+// accessed within the ttl. A zero ttl drops the access times, as they are no longer maintained.
+func (c *fileStorageClient) startGarbageCollection(ctx context.Context, ttl time.Duration, checkInterval time.Duration) error {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+
+	if ttl <= 0 {
+		return c.db.Update(func(tx *bbolt.Tx) error {
+			if tx.Bucket(lastAccessBucket) == nil {
+				return nil
+			}
+			return tx.DeleteBucket(lastAccessBucket)
+		})
+	}
+
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(lastAccessBucket)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	c.ttl = ttl
+
+	ctx, c.gcCancel = context.WithCancel(ctx)
+	go func() {
+		c.logger.Debug("starting garbage collection loop",
+			zap.Duration("ttl", ttl),
+			zap.Duration("garbage_collection_check_interval", checkInterval))
+
+		// the first purge happens after an interval, which lets the components access their keys after a restart
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				purged, err := c.purgeExpiredKeys(time.Now())
+				if err != nil {
+					c.logger.Error("garbage collection failure", zap.Error(err))
+				} else if purged > 0 {
+					c.logger.Debug("purged expired keys", zap.Int("keys", purged))
+				}
+			case <-ctx.Done():
+				c.logger.Debug("shutting down garbage collection loop")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// purgeExpiredKeys deletes the keys which were not accessed within the ttl and returns their number.
+// The keys without access time, e.g. written before the expiration was enabled, are considered accessed now.
+func (c *fileStorageClient) purgeExpiredKeys(now time.Time) (int, error) {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	if c.closed {
+		return 0, nil
+	}
+
+	expiry := now.Add(-c.ttl)
+	var purged int
+	err := c.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(defaultBucket)
+		accessBucket := tx.Bucket(lastAccessBucket)
+		if bucket == nil || accessBucket == nil {
+			return errors.New("storage not initialized")
+		}
+
+		// the buckets can't be modified while iterating over them
+		var expired, untracked [][]byte
+		cursor := bucket.Cursor()
+		for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+			accessTime := accessBucket.Get(key)
+			switch {
+			case accessTime == nil:
+				untracked = append(untracked, append([]byte(nil), key...))
+			case decodeAccessTime(accessTime).Before(expiry):
+				expired = append(expired, append([]byte(nil), key...))
+			}
+		}
+
+		for _, key := range untracked {
+			if err := accessBucket.Put(key, encodeAccessTime(now)); err != nil {
+				return err
+			}
+		}
+		for _, key := range expired {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+			if err := accessBucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		purged = len(expired)
+		return nil
+	})
+	return purged, err
+}
+
+// getStorageStats returns the number of keys stored by the client and the size of the database
+func (c *fileStorageClient) getStorageStats() (keyCount int64, totalSize int64, err error) {
+	c.compactionMutex.RLock()
+	defer c.compactionMutex.RUnlock()
+	if c.closed {
+		return 0, 0, errClientClosed
+	}
+
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(defaultBucket)
+		if bucket == nil {
+			return errors.New("storage not initialized")
+		}
+		keyCount = int64(bucket.Stats().KeyN)
+		totalSize = tx.Size()
+		return nil
+	})
+	return keyCount, totalSize, err
+}
+
+func encodeAccessTime(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
+
+func decodeAccessTime(b []byte) time.Time {
+	if len(b) != 8 {
+		// unexpected value, consider the key as expired
+		return time.Time{}
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(b)))
+}
+
 func (c *fileStorageClient) getDbSize() (totalSizeResult int64, dataSizeResult int64, errResult error) {
 	var totalSize int64
 
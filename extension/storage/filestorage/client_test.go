@@ -211,6 +211,97 @@ func TestNewClientErrorsOnInvalidBucket(t *testing.T) {
 	defaultBucket = temp
 }
 
+func TestClientGarbageCollection(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+	ctx := context.Background()
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, false)
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "untracked", []byte("value")))
+	require.NoError(t, client.startGarbageCollection(ctx, time.Hour, time.Hour))
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+
+	require.NoError(t, client.Batch(ctx,
+		storage.SetOperation("read", []byte("value")),
+		storage.SetOperation("written", []byte("value")),
+		storage.SetOperation("deleted", []byte("value")),
+		storage.DeleteOperation("deleted"),
+	))
+
+	// the keys without access time are tracked from the first purge
+	now := time.Now()
+	purged, err := client.purgeExpiredKeys(now)
+	require.NoError(t, err)
+	require.Equal(t, 0, purged)
+
+	purged, err = client.purgeExpiredKeys(now.Add(30 * time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 0, purged)
+
+	// reading a key refreshes its access time
+	require.NoError(t, setAccessTime(client, "read", now.Add(-2*time.Hour)))
+	require.NoError(t, setAccessTime(client, "written", now.Add(-2*time.Hour)))
+	value, err := client.Get(ctx, "read")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	purged, err = client.purgeExpiredKeys(now)
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+
+	value, err = client.Get(ctx, "written")
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	purged, err = client.purgeExpiredKeys(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, purged)
+
+	keyCount, _, err := client.getStorageStats()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), keyCount)
+	require.NoError(t, client.db.View(func(tx *bbolt.Tx) error {
+		require.Equal(t, 0, tx.Bucket(lastAccessBucket).Stats().KeyN)
+		return nil
+	}))
+}
+
+func TestClientGarbageCollectionDisabled(t *testing.T) {
+	dbFile := filepath.Join(t.TempDir(), "my_db")
+	ctx := context.Background()
+
+	client, err := newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, false)
+	require.NoError(t, err)
+	require.NoError(t, client.startGarbageCollection(ctx, time.Hour, time.Hour))
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	require.NoError(t, client.Close(ctx))
+
+	// the access times are dropped when the keys no longer expire, as they would become stale
+	client, err = newClient(zap.NewNop(), dbFile, time.Second, &CompactionConfig{}, false)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+	require.NoError(t, client.startGarbageCollection(ctx, 0, time.Hour))
+	require.NoError(t, client.Set(ctx, "other", []byte("value")))
+
+	require.NoError(t, client.db.View(func(tx *bbolt.Tx) error {
+		require.Nil(t, tx.Bucket(lastAccessBucket))
+		return nil
+	}))
+	keyCount, _, err := client.getStorageStats()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), keyCount)
+}
+
+func setAccessTime(client *fileStorageClient, key string, accessTime time.Time) error {
+	return client.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(lastAccessBucket).Put([]byte(key), encodeAccessTime(accessTime))
+	})
+}
+
 func TestClientReboundCompaction(t *testing.T) {
 	testCases := []struct {
 		testName                   string
@@ -9,6 +9,8 @@ import (
 	"io/fs"
 	"os"
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 // Config defines configuration for file storage extension.
@@ -18,6 +20,8 @@ type Config struct {
 
 	Compaction *CompactionConfig `mapstructure:"compaction,omitempty"`
 
+	GarbageCollection *GarbageCollectionConfig `mapstructure:"garbage_collection,omitempty"`
+
 	// FSync specifies that fsync should be called after each database write
 	FSync bool `mapstructure:"fsync,omitempty"`
 }
@@ -51,6 +55,26 @@ type CompactionConfig struct {
 	CleanupOnStart bool `mapstructure:"cleanup_on_start,omitempty"`
 }
 
+// GarbageCollectionConfig defines configuration for the optional expiration of the keys which are no longer used,
+// e.g. the checkpoints of removed files or components.
+type GarbageCollectionConfig struct {
+	// TTL specifies the time after which a key which was neither read nor written is deleted.
+	// A value of zero disables the expiration.
+	TTL time.Duration `mapstructure:"ttl,omitempty"`
+	// Clients overrides the TTL of the clients of a component, keyed by the component ID (e.g. filelog/app).
+	Clients map[string]time.Duration `mapstructure:"clients,omitempty"`
+	// CheckInterval specifies frequency of the purge of the expired keys
+	CheckInterval time.Duration `mapstructure:"check_interval,omitempty"`
+}
+
+// ttl returns the TTL of the keys of a component.
+func (cfg *GarbageCollectionConfig) ttl(id component.ID) time.Duration {
+	if ttl, ok := cfg.Clients[id.String()]; ok {
+		return ttl
+	}
+	return cfg.TTL
+}
+
 func (cfg *Config) Validate() error {
 	var dirs []string
 	if cfg.Compaction.OnStart {
@@ -83,5 +107,21 @@ func (cfg *Config) Validate() error {
 		return errors.New("compaction check interval must be positive when rebound compaction is set")
 	}
 
+	if cfg.GarbageCollection.TTL < 0 {
+		return errors.New("garbage collection ttl cannot be less than 0")
+	}
+
+	expiration := cfg.GarbageCollection.TTL > 0
+	for id, ttl := range cfg.GarbageCollection.Clients {
+		if ttl < 0 {
+			return fmt.Errorf("garbage collection ttl of %q cannot be less than 0", id)
+		}
+		expiration = expiration || ttl > 0
+	}
+
+	if expiration && cfg.GarbageCollection.CheckInterval <= 0 {
+		return errors.New("garbage collection check interval must be positive when a ttl is set")
+	}
+
 	return nil
 }
@@ -47,6 +47,14 @@ func TestLoadConfig(t *testing.T) {
 					CheckInterval:              time.Second * 5,
 					CleanupOnStart:             true,
 				},
+				GarbageCollection: &GarbageCollectionConfig{
+					TTL: 168 * time.Hour,
+					Clients: map[string]time.Duration{
+						"filelog/app": 24 * time.Hour,
+						"otlp":        0,
+					},
+					CheckInterval: 30 * time.Minute,
+				},
 				Timeout: 2 * time.Second,
 				FSync:   true,
 			},
@@ -95,3 +103,54 @@ func TestHandleProvidingFilePathAsDirWithAnError(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, file.Name()+" is not a directory")
 }
+
+func TestGarbageCollectionValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		gcCfg   GarbageCollectionConfig
+		wantErr string
+	}{
+		{
+			name:  "disabled",
+			gcCfg: GarbageCollectionConfig{},
+		},
+		{
+			name:  "ttl",
+			gcCfg: GarbageCollectionConfig{TTL: time.Hour, CheckInterval: time.Minute},
+		},
+		{
+			name:    "negative ttl",
+			gcCfg:   GarbageCollectionConfig{TTL: -time.Hour, CheckInterval: time.Minute},
+			wantErr: "garbage collection ttl cannot be less than 0",
+		},
+		{
+			name:    "negative client ttl",
+			gcCfg:   GarbageCollectionConfig{Clients: map[string]time.Duration{"filelog": -time.Hour}, CheckInterval: time.Minute},
+			wantErr: `garbage collection ttl of "filelog" cannot be less than 0`,
+		},
+		{
+			name:    "missing check interval",
+			gcCfg:   GarbageCollectionConfig{TTL: time.Hour},
+			wantErr: "garbage collection check interval must be positive when a ttl is set",
+		},
+		{
+			name:    "missing check interval for client ttl",
+			gcCfg:   GarbageCollectionConfig{Clients: map[string]time.Duration{"filelog": time.Hour}},
+			wantErr: "garbage collection check interval must be positive when a ttl is set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Directory = t.TempDir()
+			cfg.GarbageCollection = &tt.gcCfg
+
+			err := component.ValidateConfig(cfg)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -10,26 +10,87 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/filestorage/internal/metadata"
 )
 
 type localFileStorage struct {
 	cfg    *Config
 	logger *zap.Logger
+
+	keyCount     metric.Int64ObservableGauge
+	dbSize       metric.Int64ObservableGauge
+	registration metric.Registration
+
+	clientsMutex sync.Mutex
+	clients      map[*fileStorageClient]string
 }
 
 // Ensure this storage extension implements the appropriate interface
 var _ storage.Extension = (*localFileStorage)(nil)
 
-func newLocalFileStorage(logger *zap.Logger, config *Config) (extension.Extension, error) {
-	return &localFileStorage{
-		cfg:    config,
-		logger: logger,
-	}, nil
+func newLocalFileStorage(set component.TelemetrySettings, config *Config) (extension.Extension, error) {
+	lfs := &localFileStorage{
+		cfg:     config,
+		logger:  set.Logger,
+		clients: map[*fileStorageClient]string{},
+	}
+
+	meter := metadata.Meter(set)
+	var err error
+	lfs.keyCount, err = meter.Int64ObservableGauge(
+		"file_storage_key_count",
+		metric.WithDescription("Number of keys stored by a client"),
+		metric.WithUnit("{keys}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	lfs.dbSize, err = meter.Int64ObservableGauge(
+		"file_storage_db_size",
+		metric.WithDescription("Size of the database file of a client, including the free pages"),
+		metric.WithUnit("By"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	lfs.registration, err = meter.RegisterCallback(lfs.observeClients, lfs.keyCount, lfs.dbSize)
+	if err != nil {
+		return nil, err
+	}
+	return lfs, nil
+}
+
+// observeClients records the key count and the database size of the open clients
+func (lfs *localFileStorage) observeClients(_ context.Context, observer metric.Observer) error {
+	lfs.clientsMutex.Lock()
+	clients := make(map[*fileStorageClient]string, len(lfs.clients))
+	for client, name := range lfs.clients {
+		clients[client] = name
+	}
+	lfs.clientsMutex.Unlock()
+
+	for client, name := range clients {
+		keyCount, dbSize, err := client.getStorageStats()
+		if err != nil {
+			if !errors.Is(err, errClientClosed) {
+				lfs.logger.Debug("failed to get storage stats", zap.String("client", name), zap.Error(err))
+			}
+			continue
+		}
+		attrs := metric.WithAttributes(attribute.String("client", name))
+		observer.ObserveInt64(lfs.keyCount, keyCount, attrs)
+		observer.ObserveInt64(lfs.dbSize, dbSize, attrs)
+	}
+	return nil
 }
 
 // Start runs cleanup if configured
@@ -44,6 +105,9 @@ func (lfs *localFileStorage) Start(context.Context, component.Host) error {
 func (lfs *localFileStorage) Shutdown(context.Context) error {
 	// TODO clean up data files that did not have a client
 	// and are older than a threshold (possibly configurable)
+	if lfs.registration != nil {
+		return lfs.registration.Unregister()
+	}
 	return nil
 }
 
@@ -72,6 +136,21 @@ func (lfs *localFileStorage) GetClient(_ context.Context, kind component.Kind, e
 		}
 	}
 
+	gcCfg := lfs.cfg.GarbageCollection
+	if err = client.startGarbageCollection(context.Background(), gcCfg.ttl(ent), gcCfg.CheckInterval); err != nil {
+		_ = client.Close(context.Background())
+		return nil, err
+	}
+
+	lfs.clientsMutex.Lock()
+	lfs.clients[client] = rawName
+	lfs.clientsMutex.Unlock()
+	client.onClose = func() {
+		lfs.clientsMutex.Lock()
+		delete(lfs.clients, client)
+		lfs.clientsMutex.Unlock()
+	}
+
 	return client, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +18,8 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/extension/extensiontest"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 func TestExtensionIntegrity(t *testing.T) {
@@ -485,3 +488,85 @@ func TestCleanupOnStart(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 1, len(files))
 }
+
+func TestGarbageCollectionClientTTL(t *testing.T) {
+	ctx := context.Background()
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	cfg.GarbageCollection.TTL = time.Hour
+	cfg.GarbageCollection.Clients = map[string]time.Duration{"nop/short": time.Minute, "nop/never": 0}
+	extension, err := f.CreateExtension(ctx, extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+
+	expected := map[string]time.Duration{"short": time.Minute, "never": 0, "other": time.Hour}
+	for name, ttl := range expected {
+		client, err := se.GetClient(ctx, component.KindReceiver, newTestEntity(name), "")
+		require.NoError(t, err)
+		require.Equal(t, ttl, client.(*fileStorageClient).ttl)
+		require.NoError(t, client.Close(ctx))
+	}
+	require.NoError(t, se.Shutdown(ctx))
+}
+
+func TestStorageMetrics(t *testing.T) {
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	settings := extensiontest.NewNopCreateSettings()
+	settings.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	f := NewFactory()
+	cfg := f.CreateDefaultConfig().(*Config)
+	cfg.Directory = t.TempDir()
+	extension, err := f.CreateExtension(ctx, settings, cfg)
+	require.NoError(t, err)
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+
+	one, err := se.GetClient(ctx, component.KindReceiver, newTestEntity("one"), "")
+	require.NoError(t, err)
+	two, err := se.GetClient(ctx, component.KindExporter, newTestEntity("two"), "")
+	require.NoError(t, err)
+	require.NoError(t, one.Batch(ctx, storage.SetOperation("a", []byte("1")), storage.SetOperation("b", []byte("2"))))
+	require.NoError(t, two.Set(ctx, "a", []byte("1")))
+
+	keyCounts, dbSizes := collectStorageMetrics(t, reader)
+	require.Equal(t, map[string]int64{"receiver_nop_one": 2, "exporter_nop_two": 1}, keyCounts)
+	require.Len(t, dbSizes, 2)
+	require.Positive(t, dbSizes["receiver_nop_one"])
+
+	// closed clients are no longer reported
+	require.NoError(t, one.Close(ctx))
+	keyCounts, _ = collectStorageMetrics(t, reader)
+	require.Equal(t, map[string]int64{"exporter_nop_two": 1}, keyCounts)
+
+	require.NoError(t, two.Close(ctx))
+	require.NoError(t, se.Shutdown(ctx))
+}
+
+func collectStorageMetrics(t *testing.T, reader sdkmetric.Reader) (keyCounts map[string]int64, dbSizes map[string]int64) {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	keyCounts = map[string]int64{}
+	dbSizes = map[string]int64{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			values := map[string]int64{}
+			for _, dp := range m.Data.(metricdata.Gauge[int64]).DataPoints {
+				client, _ := dp.Attributes.Value("client")
+				values[client.AsString()] = dp.Value
+			}
+			switch m.Name {
+			case "file_storage_key_count":
+				keyCounts = values
+			case "file_storage_db_size":
+				dbSizes = values
+			}
+		}
+	}
+	return keyCounts, dbSizes
+}
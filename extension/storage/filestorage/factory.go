@@ -22,6 +22,7 @@ const (
 	defaultReboundTriggerThresholdMib = 10
 	defaultReboundNeededThresholdMib  = 100
 	defaultCompactionInterval         = time.Second * 5
+	defaultGarbageCollectionInterval  = time.Hour
 )
 
 // NewFactory creates a factory for HostObserver extension.
@@ -47,6 +48,9 @@ func createDefaultConfig() component.Config {
 			CheckInterval:              defaultCompactionInterval,
 			CleanupOnStart:             false,
 		},
+		GarbageCollection: &GarbageCollectionConfig{
+			CheckInterval: defaultGarbageCollectionInterval,
+		},
 		Timeout: time.Second,
 		FSync:   false,
 	}
@@ -57,5 +61,5 @@ func createExtension(
 	params extension.CreateSettings,
 	cfg component.Config,
 ) (extension.Extension, error) {
-	return newLocalFileStorage(params.Logger, cfg.(*Config))
+	return newLocalFileStorage(params.TelemetrySettings, cfg.(*Config))
 }
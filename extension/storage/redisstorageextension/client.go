@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorageextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorageextension"
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+type redisStorageClient struct {
+	client     redis.UniversalClient
+	keyPrefix  string
+	expiration time.Duration
+}
+
+func newClient(client redis.UniversalClient, prefix string, clientName string, expiration time.Duration) *redisStorageClient {
+	// the client name is a hash tag, so that the keys of a client are stored in the same slot of a cluster,
+	// which lets the operations of a batch run in a transaction. The braces are removed from the name to
+	// keep the hash tag unambiguous.
+	clientName = strings.NewReplacer("{", "", "}", "").Replace(clientName)
+	return &redisStorageClient{
+		client:     client,
+		keyPrefix:  prefix + "{" + clientName + "}:",
+		expiration: expiration,
+	}
+}
+
+// Get will retrieve data from storage that corresponds to the specified key
+func (c *redisStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	if err := c.Batch(ctx, op); err != nil {
+		return nil, err
+	}
+	return op.Value, nil
+}
+
+// Set will store data. The data can be retrieved using the same key
+func (c *redisStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+// Delete will delete data associated with the specified key
+func (c *redisStorageClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+// Batch executes the specified operations in order, in a transaction. Get operation results are updated in place
+func (c *redisStorageClient) Batch(ctx context.Context, ops ...storage.Operation) error {
+	gets := make(map[storage.Operation]*redis.StringCmd)
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, op := range ops {
+			switch op.Type {
+			case storage.Get:
+				gets[op] = pipe.Get(ctx, c.keyPrefix+op.Key)
+			case storage.Set:
+				pipe.Set(ctx, c.keyPrefix+op.Key, op.Value, c.expiration)
+			case storage.Delete:
+				pipe.Del(ctx, c.keyPrefix+op.Key)
+			default:
+				return errors.New("wrong operation type")
+			}
+		}
+		return nil
+	})
+	// a missing key is reported as a redis.Nil error of the transaction
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	for op, cmd := range gets {
+		value, err := cmd.Bytes()
+		switch {
+		case errors.Is(err, redis.Nil):
+			op.Value = nil
+		case err != nil:
+			return err
+		default:
+			op.Value = value
+		}
+	}
+	return nil
+}
+
+// Close will release the client, the connections being shared by the clients of the extension
+func (c *redisStorageClient) Close(_ context.Context) error {
+	return nil
+}
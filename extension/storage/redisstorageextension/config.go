@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorageextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorageextension"
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// Config defines configuration for the Redis storage extension.
+type Config struct {
+	// Endpoint is the address of a standalone Redis server.
+	Endpoint string `mapstructure:"endpoint"`
+	// Cluster configures the connection to a Redis cluster, used instead of Endpoint.
+	Cluster ClusterConfig `mapstructure:"cluster"`
+
+	// Username authenticates the connection with the Redis ACL system.
+	Username string `mapstructure:"username"`
+	// Password must match the requirepass server option, or the password of Username.
+	Password configopaque.String `mapstructure:"password"`
+	// DB is the database selected after connecting to a standalone server.
+	DB int `mapstructure:"db"`
+
+	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
+
+	// Prefix is prepended to the keys, so that several collectors can share a Redis server.
+	Prefix string `mapstructure:"prefix"`
+	// Expiration is the time after which a key which is not written expires. Zero disables the expiration.
+	Expiration time.Duration `mapstructure:"expiration"`
+	// Timeout bounds the establishment of the connections and the operations.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// ClusterConfig defines the connection to a Redis cluster.
+type ClusterConfig struct {
+	// Endpoints are the addresses of the nodes used to discover the cluster.
+	Endpoints []string `mapstructure:"endpoints"`
+}
+
+func (cfg *Config) Validate() error {
+	clusterMode := len(cfg.Cluster.Endpoints) > 0
+	if cfg.Endpoint == "" && !clusterMode {
+		return errors.New("endpoint or cluster::endpoints must be specified")
+	}
+	if cfg.Endpoint != "" && clusterMode {
+		return errors.New("endpoint and cluster::endpoints cannot be both specified")
+	}
+	if clusterMode && cfg.DB != 0 {
+		return errors.New("db cannot be selected in cluster mode")
+	}
+	if cfg.DB < 0 {
+		return errors.New("db cannot be less than 0")
+	}
+	// the client names are used as hash tags, so that the keys of a client belong to the same cluster slot
+	if strings.ContainsAny(cfg.Prefix, "{}") {
+		return errors.New("prefix cannot contain '{' or '}'")
+	}
+	if cfg.Expiration < 0 {
+		return errors.New("expiration cannot be less than 0")
+	}
+	if cfg.Timeout <= 0 {
+		return errors.New("timeout must be positive")
+	}
+	return nil
+}
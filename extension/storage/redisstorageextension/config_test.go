@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorageextension
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorageextension/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id       component.ID
+		expected component.Config
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: NewFactory().CreateDefaultConfig(),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "all_settings"),
+			expected: &Config{
+				Endpoint: "redis.example.com:6380",
+				Username: "otelcol",
+				Password: "secret",
+				DB:       2,
+				TLS: configtls.ClientConfig{
+					Config: configtls.Config{
+						CAFile: "ca.pem",
+					},
+				},
+				Prefix:     "gateway-0:",
+				Expiration: 72 * time.Hour,
+				Timeout:    2 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "cluster"),
+			expected: func() component.Config {
+				cfg := NewFactory().CreateDefaultConfig().(*Config)
+				cfg.Endpoint = ""
+				cfg.Cluster.Endpoints = []string{"redis-0:6379", "redis-1:6379", "redis-2:6379"}
+				return cfg
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *Config)
+		wantErr string
+	}{
+		{
+			name: "missing_endpoint",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = ""
+			},
+			wantErr: "endpoint or cluster::endpoints must be specified",
+		},
+		{
+			name: "endpoint_and_cluster",
+			modify: func(cfg *Config) {
+				cfg.Cluster.Endpoints = []string{"redis-0:6379"}
+			},
+			wantErr: "endpoint and cluster::endpoints cannot be both specified",
+		},
+		{
+			name: "cluster_db",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = ""
+				cfg.Cluster.Endpoints = []string{"redis-0:6379"}
+				cfg.DB = 1
+			},
+			wantErr: "db cannot be selected in cluster mode",
+		},
+		{
+			name: "negative_db",
+			modify: func(cfg *Config) {
+				cfg.DB = -1
+			},
+			wantErr: "db cannot be less than 0",
+		},
+		{
+			name: "prefix_hash_tag",
+			modify: func(cfg *Config) {
+				cfg.Prefix = "{otelcol}:"
+			},
+			wantErr: "prefix cannot contain '{' or '}'",
+		},
+		{
+			name: "negative_expiration",
+			modify: func(cfg *Config) {
+				cfg.Expiration = -time.Hour
+			},
+			wantErr: "expiration cannot be less than 0",
+		},
+		{
+			name: "missing_timeout",
+			modify: func(cfg *Config) {
+				cfg.Timeout = 0
+			},
+			wantErr: "timeout must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			assert.EqualError(t, component.ValidateConfig(cfg), tt.wantErr)
+		})
+	}
+}
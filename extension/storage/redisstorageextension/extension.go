@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorageextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/redisstorageextension"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.uber.org/zap"
+)
+
+type redisStorage struct {
+	cfg    *Config
+	logger *zap.Logger
+	client redis.UniversalClient
+}
+
+// Ensure this storage extension implements the appropriate interface
+var _ storage.Extension = (*redisStorage)(nil)
+
+func newRedisStorage(logger *zap.Logger, config *Config) *redisStorage {
+	return &redisStorage{
+		cfg:    config,
+		logger: logger,
+	}
+}
+
+// Start connects to the Redis server or cluster
+func (rs *redisStorage) Start(ctx context.Context, _ component.Host) error {
+	tlsConfig, err := rs.cfg.TLS.LoadTLSConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load the TLS configuration: %w", err)
+	}
+
+	if len(rs.cfg.Cluster.Endpoints) > 0 {
+		rs.client = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:        rs.cfg.Cluster.Endpoints,
+			Username:     rs.cfg.Username,
+			Password:     string(rs.cfg.Password),
+			TLSConfig:    tlsConfig,
+			DialTimeout:  rs.cfg.Timeout,
+			ReadTimeout:  rs.cfg.Timeout,
+			WriteTimeout: rs.cfg.Timeout,
+		})
+	} else {
+		rs.client = redis.NewClient(&redis.Options{
+			Addr:         rs.cfg.Endpoint,
+			Username:     rs.cfg.Username,
+			Password:     string(rs.cfg.Password),
+			DB:           rs.cfg.DB,
+			TLSConfig:    tlsConfig,
+			DialTimeout:  rs.cfg.Timeout,
+			ReadTimeout:  rs.cfg.Timeout,
+			WriteTimeout: rs.cfg.Timeout,
+		})
+	}
+
+	// the connection failures are only logged, as the server may become available before a client is used
+	if err := rs.client.Ping(ctx).Err(); err != nil {
+		rs.logger.Warn("failed to connect to redis", zap.Error(err))
+	}
+	return nil
+}
+
+// Shutdown closes the connections to Redis
+func (rs *redisStorage) Shutdown(context.Context) error {
+	if rs.client == nil {
+		return nil
+	}
+	return rs.client.Close()
+}
+
+// GetClient returns a storage client for an individual component
+func (rs *redisStorage) GetClient(_ context.Context, kind component.Kind, ent component.ID, name string) (storage.Client, error) {
+	if rs.client == nil {
+		return nil, errors.New("the redis storage extension is not started")
+	}
+
+	var clientName string
+	if name == "" {
+		clientName = fmt.Sprintf("%s_%s_%s", kindString(kind), ent.Type(), ent.Name())
+	} else {
+		clientName = fmt.Sprintf("%s_%s_%s_%s", kindString(kind), ent.Type(), ent.Name(), name)
+	}
+	return newClient(rs.client, rs.cfg.Prefix, clientName, rs.cfg.Expiration), nil
+}
+
+func kindString(k component.Kind) string {
+	switch k {
+	case component.KindReceiver:
+		return "receiver"
+	case component.KindProcessor:
+		return "processor"
+	case component.KindExporter:
+		return "exporter"
+	case component.KindExtension:
+		return "extension"
+	case component.KindConnector:
+		return "connector"
+	default:
+		return "other" // not expected
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisstorageextension
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+)
+
+func TestClientOperations(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, func(cfg *Config) { cfg.Endpoint = server.Addr() })
+
+	client, err := se.GetClient(ctx, component.KindReceiver, component.MustNewIDWithName("filelog", "app"), "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, client.Close(ctx))
+	})
+
+	value, err := client.Get(ctx, "offsets")
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	require.NoError(t, client.Set(ctx, "offsets", []byte("42")))
+	value, err = client.Get(ctx, "offsets")
+	require.NoError(t, err)
+	require.Equal(t, []byte("42"), value)
+
+	stored, err := server.Get("otelcol:{receiver_filelog_app}:offsets")
+	require.NoError(t, err)
+	assert.Equal(t, "42", stored)
+	assert.Zero(t, server.TTL("otelcol:{receiver_filelog_app}:offsets"))
+
+	require.NoError(t, client.Delete(ctx, "offsets"))
+	value, err = client.Get(ctx, "offsets")
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestClientBatchOperations(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, func(cfg *Config) { cfg.Endpoint = server.Addr() })
+
+	client, err := se.GetClient(ctx, component.KindExporter, component.MustNewID("otlp"), "queue")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Set(ctx, "deleted", []byte("value")))
+
+	ops := []storage.Operation{
+		storage.SetOperation("first", []byte("1")),
+		storage.GetOperation("first"),
+		storage.GetOperation("missing"),
+		storage.DeleteOperation("deleted"),
+		storage.GetOperation("deleted"),
+	}
+	require.NoError(t, client.Batch(ctx, ops...))
+	assert.Equal(t, []byte("1"), ops[1].Value)
+	assert.Nil(t, ops[2].Value)
+	assert.Nil(t, ops[4].Value)
+
+	assert.ElementsMatch(t, []string{"otelcol:{exporter_otlp__queue}:first"}, server.Keys())
+}
+
+func TestClientsAreIsolated(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, func(cfg *Config) {
+		cfg.Endpoint = server.Addr()
+		cfg.Prefix = "gateway-0:"
+	})
+
+	one, err := se.GetClient(ctx, component.KindReceiver, component.MustNewIDWithName("filelog", "one"), "")
+	require.NoError(t, err)
+	two, err := se.GetClient(ctx, component.KindReceiver, component.MustNewIDWithName("filelog", "two"), "")
+	require.NoError(t, err)
+
+	require.NoError(t, one.Set(ctx, "key", []byte("one")))
+	require.NoError(t, two.Set(ctx, "key", []byte("two")))
+
+	value, err := one.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("one"), value)
+	value, err = two.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("two"), value)
+	assert.ElementsMatch(t, []string{"gateway-0:{receiver_filelog_one}:key", "gateway-0:{receiver_filelog_two}:key"}, server.Keys())
+}
+
+func TestExpiration(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, func(cfg *Config) {
+		cfg.Endpoint = server.Addr()
+		cfg.Expiration = time.Hour
+	})
+
+	client, err := se.GetClient(ctx, component.KindReceiver, component.MustNewID("filelog"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+	assert.Equal(t, time.Hour, server.TTL("otelcol:{receiver_filelog_}:key"))
+
+	server.FastForward(2 * time.Hour)
+	value, err := client.Get(ctx, "key")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestAuthentication(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	server.RequireUserAuth("otelcol", "secret")
+
+	se := newTestExtension(t, func(cfg *Config) {
+		cfg.Endpoint = server.Addr()
+		cfg.Username = "otelcol"
+		cfg.Password = "secret"
+	})
+	client, err := se.GetClient(ctx, component.KindReceiver, component.MustNewID("filelog"), "")
+	require.NoError(t, err)
+	require.NoError(t, client.Set(ctx, "key", []byte("value")))
+
+	se = newTestExtension(t, func(cfg *Config) {
+		cfg.Endpoint = server.Addr()
+		cfg.Username = "otelcol"
+		cfg.Password = "wrong"
+	})
+	client, err = se.GetClient(ctx, component.KindReceiver, component.MustNewID("filelog"), "")
+	require.NoError(t, err)
+	assert.Error(t, client.Set(ctx, "key", []byte("value")))
+}
+
+func TestServerUnavailable(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	se := newTestExtension(t, func(cfg *Config) { cfg.Endpoint = server.Addr() })
+	client, err := se.GetClient(ctx, component.KindReceiver, component.MustNewID("filelog"), "")
+	require.NoError(t, err)
+
+	server.Close()
+	_, err = client.Get(ctx, "key")
+	assert.Error(t, err)
+}
+
+func TestGetClientBeforeStart(t *testing.T) {
+	extension, err := NewFactory().CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), createDefaultConfig())
+	require.NoError(t, err)
+
+	_, err = extension.(storage.Extension).GetClient(context.Background(), component.KindReceiver, component.MustNewID("filelog"), "")
+	assert.EqualError(t, err, "the redis storage extension is not started")
+}
+
+func newTestExtension(t *testing.T, modify func(cfg *Config)) storage.Extension {
+	cfg := createDefaultConfig().(*Config)
+	modify(cfg)
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	extension, err := NewFactory().CreateExtension(context.Background(), extensiontest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, extension.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, extension.Shutdown(context.Background()))
+	})
+
+	se, ok := extension.(storage.Extension)
+	require.True(t, ok)
+	return se
+}
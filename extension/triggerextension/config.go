@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package triggerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+)
+
+var errMissingEndpoint = errors.New("endpoint required")
+
+// Config has the configuration of the trigger extension.
+type Config struct {
+	confighttp.ServerConfig `mapstructure:",squash"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the extension configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Endpoint == "" {
+		return errMissingEndpoint
+	}
+	return nil
+}
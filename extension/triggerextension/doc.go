@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package triggerextension serves an HTTP endpoint running the one-off actions
+// registered by the components of a collector, such as on-demand scrapes.
+package triggerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension"
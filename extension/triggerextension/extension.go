@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package triggerextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/zap"
+)
+
+// triggersPath is the path listing the registered triggers, a trigger is run by a POST request
+// to the path followed by its name.
+const triggersPath = "/triggers"
+
+// Func is the function run when a trigger is called.
+type Func func(ctx context.Context) error
+
+// Trigger is the interface of the trigger extension used by the components to register their
+// triggers.
+type Trigger interface {
+	extension.Extension
+
+	// Register registers fn as the function run when the trigger name is called, until the
+	// returned function is called. The names of the triggers must be unique.
+	Register(name string, fn Func) (unregister func(), err error)
+}
+
+type trigger struct {
+	fn Func
+	// running is locked while the trigger runs, a trigger is never run concurrently.
+	running sync.Mutex
+}
+
+type triggerExtension struct {
+	config   *Config
+	settings component.TelemetrySettings
+	server   *http.Server
+	stopCh   chan struct{}
+
+	mu       sync.Mutex
+	triggers map[string]*trigger
+}
+
+var _ Trigger = (*triggerExtension)(nil)
+
+func newTriggerExtension(config *Config, settings component.TelemetrySettings) *triggerExtension {
+	return &triggerExtension{
+		config:   config,
+		settings: settings,
+		triggers: map[string]*trigger{},
+	}
+}
+
+func (te *triggerExtension) Start(ctx context.Context, host component.Host) error {
+	ln, err := te.config.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", te.config.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(triggersPath, te.handleList)
+	mux.HandleFunc(triggersPath+"/", te.handleRun)
+	te.server, err = te.config.ToServer(ctx, host, te.settings, mux)
+	if err != nil {
+		return err
+	}
+
+	te.stopCh = make(chan struct{})
+	go func() {
+		defer close(te.stopCh)
+
+		// The listener ownership goes to the server.
+		if errHTTP := te.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			te.settings.ReportStatus(component.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+	return nil
+}
+
+func (te *triggerExtension) Shutdown(ctx context.Context) error {
+	if te.server == nil {
+		return nil
+	}
+	err := te.server.Shutdown(ctx)
+	if te.stopCh != nil {
+		<-te.stopCh
+	}
+	return err
+}
+
+func (te *triggerExtension) Register(name string, fn Func) (func(), error) {
+	if name == "" {
+		return nil, fmt.Errorf("invalid trigger name %q", name)
+	}
+
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	if _, ok := te.triggers[name]; ok {
+		return nil, fmt.Errorf("trigger %q already registered", name)
+	}
+	t := &trigger{fn: fn}
+	te.triggers[name] = t
+
+	return func() {
+		te.mu.Lock()
+		defer te.mu.Unlock()
+		if te.triggers[name] == t {
+			delete(te.triggers, name)
+		}
+	}, nil
+}
+
+// handleList responds with the names of the registered triggers.
+func (te *triggerExtension) handleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	te.mu.Lock()
+	names := make([]string, 0, len(te.triggers))
+	for name := range te.triggers {
+		names = append(names, name)
+	}
+	te.mu.Unlock()
+	sort.Strings(names)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(names)
+}
+
+// handleRun runs a trigger and responds once it completed.
+func (te *triggerExtension) handleRun(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(r.URL.Path, triggersPath+"/")
+	te.mu.Lock()
+	t, ok := te.triggers[name]
+	te.mu.Unlock()
+	if !ok {
+		http.Error(w, fmt.Sprintf("trigger %q not found", name), http.StatusNotFound)
+		return
+	}
+
+	if !t.running.TryLock() {
+		http.Error(w, fmt.Sprintf("trigger %q already running", name), http.StatusConflict)
+		return
+	}
+	defer t.running.Unlock()
+
+	te.settings.Logger.Info("Running trigger", zap.String("trigger", name))
+	if err := t.fn(r.Context()); err != nil {
+		te.settings.Logger.Warn("Trigger failed", zap.String("trigger", name), zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
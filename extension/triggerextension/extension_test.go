@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package triggerextension
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
+)
+
+func newTestExtension(t *testing.T) (*triggerExtension, string) {
+	endpoint := testutil.GetAvailableLocalAddress(t)
+	te := newTriggerExtension(&Config{
+		ServerConfig: confighttp.ServerConfig{Endpoint: endpoint},
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, te.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, te.Shutdown(context.Background())) })
+	return te, "http://" + endpoint + triggersPath
+}
+
+func post(t *testing.T, url string) (int, string) {
+	resp, err := http.Post(url, "", nil) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestRunTrigger(t *testing.T) {
+	te, url := newTestExtension(t)
+
+	calls := 0
+	unregister, err := te.Register("receiver/test", func(context.Context) error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+
+	status, _ := post(t, url+"/receiver/test")
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, 1, calls)
+
+	unregister()
+	status, body := post(t, url+"/receiver/test")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Contains(t, body, `trigger "receiver/test" not found`)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRunTriggerError(t *testing.T) {
+	te, url := newTestExtension(t)
+
+	_, err := te.Register("failing", func(context.Context) error {
+		return errors.New("scrape failed")
+	})
+	require.NoError(t, err)
+
+	status, body := post(t, url+"/failing")
+	assert.Equal(t, http.StatusInternalServerError, status)
+	assert.Contains(t, body, "scrape failed")
+}
+
+func TestRunTriggerAlreadyRunning(t *testing.T) {
+	te, url := newTestExtension(t)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	_, err := te.Register("slow", func(context.Context) error {
+		close(started)
+		<-release
+		return nil
+	})
+	require.NoError(t, err)
+
+	done := make(chan int)
+	go func() {
+		resp, errPost := http.Post(url+"/slow", "", nil) //nolint:gosec
+		if errPost != nil {
+			done <- 0
+			return
+		}
+		resp.Body.Close()
+		done <- resp.StatusCode
+	}()
+	<-started
+
+	status, body := post(t, url+"/slow")
+	assert.Equal(t, http.StatusConflict, status)
+	assert.Contains(t, body, `trigger "slow" already running`)
+
+	close(release)
+	assert.Equal(t, http.StatusNoContent, <-done)
+}
+
+func TestListTriggers(t *testing.T) {
+	te, url := newTestExtension(t)
+
+	for _, name := range []string{"receiver/b", "receiver/a"} {
+		_, err := te.Register(name, func(context.Context) error { return nil })
+		require.NoError(t, err)
+	}
+
+	resp, err := http.Get(url) //nolint:gosec
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var names []string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&names))
+	assert.Equal(t, []string{"receiver/a", "receiver/b"}, names)
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	te, url := newTestExtension(t)
+	_, err := te.Register("test", func(context.Context) error { return nil })
+	require.NoError(t, err)
+
+	status, _ := post(t, url)
+	assert.Equal(t, http.StatusMethodNotAllowed, status)
+
+	resp, err := http.Get(url + "/test") //nolint:gosec
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestRegister(t *testing.T) {
+	te := newTriggerExtension(createDefaultConfig().(*Config), componenttest.NewNopTelemetrySettings())
+
+	_, err := te.Register("", func(context.Context) error { return nil })
+	assert.EqualError(t, err, `invalid trigger name ""`)
+
+	unregister, err := te.Register("test", func(context.Context) error { return nil })
+	require.NoError(t, err)
+	_, err = te.Register("test", func(context.Context) error { return nil })
+	assert.EqualError(t, err, `trigger "test" already registered`)
+
+	unregister()
+	unregisterAgain, err := te.Register("test", func(context.Context) error { return nil })
+	require.NoError(t, err)
+
+	// Calling the first unregister function again doesn't remove the new trigger.
+	unregister()
+	assert.Contains(t, te.triggers, "test")
+	unregisterAgain()
+	assert.NotContains(t, te.triggers, "test")
+}
@@ -551,8 +551,10 @@ require (
 	github.com/mwitkow/go-conntrack v0.0.0-20190716064945-2f068394615f // indirect
 	github.com/nginxinc/nginx-prometheus-exporter v0.11.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/ackextension v0.102.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/sumologicextension v0.102.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/awsutil v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/containerinsight v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/cwlogs v0.102.0 // indirect
@@ -561,6 +563,7 @@ require (
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/metrics v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/proxy v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray v0.102.0 // indirect
+	github.com/open-telemetry/opentelemetry-collector-contrib/internal/chrony v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/collectd v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/common v0.102.0 // indirect
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal v0.102.0 // indirect
@@ -798,6 +801,8 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/p
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/xray => ./internal/aws/xray
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/chrony => ./internal/chrony
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/common => ./internal/common
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal => ./internal/coreinternal
@@ -1228,3 +1233,7 @@ replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/acke
 replace github.com/open-telemetry/opentelemetry-collector-contrib/receiver/splunkenterprisereceiver => ./receiver/splunkenterprisereceiver
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/pkg/sampling => ./pkg/sampling
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension => ./extension/k8sinformerextension
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension => ./extension/triggerextension
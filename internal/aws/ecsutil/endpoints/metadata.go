@@ -14,8 +14,9 @@ const (
 	TaskMetadataEndpointV3EnvVar = "ECS_CONTAINER_METADATA_URI"
 	TaskMetadataEndpointV4EnvVar = "ECS_CONTAINER_METADATA_URI_V4"
 
-	TaskMetadataPath      = "/task"
-	ContainerMetadataPath = ""
+	TaskMetadataPath         = "/task"
+	TaskMetadataWithTagsPath = "/taskWithTags"
+	ContainerMetadataPath    = ""
 )
 
 // ErrNoTaskMetadataEndpointDetected is a reserved error type to distinguish between incompatible environments
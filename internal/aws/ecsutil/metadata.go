@@ -17,6 +17,7 @@ type TaskMetadata struct {
 	Revision         string              `json:"Revision,omitempty"`
 	ServiceName      string              `json:"ServiceName,omitempty"`
 	TaskARN          string              `json:"TaskARN,omitempty"`
+	TaskTags         map[string]string   `json:"TaskTags,omitempty"`
 }
 
 // ContainerMetadata defines container metadata for a container
@@ -0,0 +1,18 @@
+module github.com/open-telemetry/opentelemetry-collector-contrib/internal/chrony
+
+go 1.21.0
+
+require (
+	github.com/facebook/time v0.0.0-20240510113249-fa89cc575891
+	github.com/stretchr/testify v1.9.0
+	github.com/tilinna/clock v1.1.0
+	go.uber.org/goleak v1.3.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/sirupsen/logrus v1.8.1 // indirect
+	golang.org/x/sys v0.20.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
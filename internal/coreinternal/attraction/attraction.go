@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/client"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -17,7 +18,7 @@ import (
 // Settings specifies the processor settings.
 type Settings struct {
 	// Actions specifies the list of attributes to act on.
-	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, CONVERT}.
+	// The set of actions are {INSERT, UPDATE, UPSERT, DELETE, HASH, EXTRACT, EXTRACTJSONPATH, CONVERT, LIMIT}.
 	// This is a required field.
 	Actions []ActionKeyValue `mapstructure:"actions"`
 }
@@ -42,6 +43,14 @@ type ActionKeyValue struct {
 	// no extraction will occur.
 	RegexPattern string `mapstructure:"pattern"`
 
+	// A JSONPath must be specified for the action EXTRACTJSONPATH.
+	// It selects the values set to the attribute specified by `key` in the
+	// JSON document of the attribute specified by `from_attribute`, or of the
+	// log body if none is specified.
+	// Note: Only a subset of JSONPath is supported: members, array elements
+	// and wildcards, e.g. `$.user.roles[*].name`.
+	JSONPath string `mapstructure:"jsonpath"`
+
 	// FromAttribute specifies the attribute to use to populate
 	// the value. If the attribute doesn't exist, no action is performed.
 	FromAttribute string `mapstructure:"from_attribute"`
@@ -57,6 +66,15 @@ type ActionKeyValue struct {
 	// If the value cannot be converted, the original value will be left as-is
 	ConvertedType string `mapstructure:"converted_type"`
 
+	// MaxValues specifies the maximum number of unique values of the attribute
+	// for the action LIMIT.
+	MaxValues int `mapstructure:"max_values"`
+
+	// ValueTTL specifies how long a value of the attribute is kept for the
+	// action LIMIT after it was last seen, its slot being then freed for
+	// another value. Defaults to one hour.
+	ValueTTL time.Duration `mapstructure:"value_ttl"`
+
 	// Action specifies the type of action to perform.
 	// The set of values are {INSERT, UPDATE, UPSERT, DELETE, HASH}.
 	// Both lower case and upper case are supported.
@@ -80,7 +98,15 @@ type ActionKeyValue struct {
 	// EXTRACT - Extracts values using a regular expression rule from the input
 	//           'key' to target keys specified in the 'rule'. If a target key
 	//           already exists, it will be overridden.
+	// EXTRACTJSONPATH - Extracts the values selected by JSONPath from the
+	//           JSON document of FromAttribute or of the log body, a map or
+	//           slice value or a string holding serialized JSON, to Key.
+	//           If Key already exists, it will be overridden.
 	// CONVERT  - converts the type of an existing attribute, if convertable
+	// LIMIT   - Limits the number of unique values of an existing attribute
+	//           to MaxValues. The values seen once the limit is reached are
+	//           replaced with Value, `overflow` by default. The values not
+	//           seen for ValueTTL are evicted, freeing their slot.
 	// This is a required field.
 	Action Action `mapstructure:"action"`
 }
@@ -133,10 +159,27 @@ const (
 	// exists, it will be overridden.
 	EXTRACT Action = "extract"
 
+	// EXTRACTJSONPATH extracts the values selected by a JSONPath from the JSON
+	// document of an attribute or of the log body to the attribute 'key'. If the
+	// path contains a wildcard, the values are extracted as a slice.
+	EXTRACTJSONPATH Action = "extract_jsonpath"
+
 	// CONVERT converts the type of an existing attribute, if convertable
 	CONVERT Action = "convert"
+
+	// LIMIT limits the number of unique values of an existing attribute. The
+	// values seen once the limit is reached are replaced with the overflow value.
+	LIMIT Action = "limit"
 )
 
+// defaultOverflowValue is the value the action LIMIT replaces the values with
+// once the limit is reached, unless another one is configured.
+const defaultOverflowValue = "overflow"
+
+// defaultValueTTL is how long the action LIMIT keeps a value after it was last
+// seen, unless another TTL is configured.
+const defaultValueTTL = time.Hour
+
 type attributeAction struct {
 	Key           string
 	FromAttribute string
@@ -146,6 +189,8 @@ type attributeAction struct {
 	Regex *regexp.Regexp
 	// Attribute names extracted from the regexp's subexpressions.
 	AttrNames []string
+	// Parsed JSONPath for the action EXTRACTJSONPATH.
+	JSONPath jsonPath
 	// Number of non empty strings in above array
 
 	// TODO https://go.opentelemetry.io/collector/issues/296
@@ -154,17 +199,40 @@ type attributeAction struct {
 	// and could impact performance.
 	Action         Action
 	AttributeValue *pcommon.Value
+	// Unique values of the attribute for the action LIMIT.
+	limiter *valueLimiter
 }
 
 // AttrProc is an attribute processor.
 type AttrProc struct {
-	actions []attributeAction
+	actions    []attributeAction
+	onOverflow func(ctx context.Context, key string)
+	onEviction func(ctx context.Context, key string, evicted int)
+}
+
+// Option is an option of the AttrProc.
+type Option func(*AttrProc)
+
+// WithOverflowFunc sets the function called each time the action LIMIT
+// replaces a value of the attribute key with the overflow value.
+func WithOverflowFunc(f func(ctx context.Context, key string)) Option {
+	return func(ap *AttrProc) {
+		ap.onOverflow = f
+	}
+}
+
+// WithEvictionFunc sets the function called each time the action LIMIT evicts
+// values of the attribute key not seen for the TTL.
+func WithEvictionFunc(f func(ctx context.Context, key string, evicted int)) Option {
+	return func(ap *AttrProc) {
+		ap.onEviction = f
+	}
 }
 
 // NewAttrProc validates that the input configuration has all of the required fields for the processor
 // and returns a AttrProc to be used to process attributes.
 // An error is returned if there are any invalid inputs.
-func NewAttrProc(settings *Settings) (*AttrProc, error) {
+func NewAttrProc(settings *Settings, opts ...Option) (*AttrProc, error) {
 	attributeActions := make([]attributeAction, 0, len(settings.Actions))
 	for i, a := range settings.Actions {
 		// Convert `action` to lowercase for comparison.
@@ -190,6 +258,17 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 
 		valueSourceCount := a.valueSourceCount()
 
+		if a.MaxValues != 0 && a.Action != LIMIT {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"max_values\" field. This must not be specified for %d-th action", a.Action, i)
+		}
+		if a.ValueTTL != 0 && a.Action != LIMIT {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"value_ttl\" field. This must not be specified for %d-th action", a.Action, i)
+		}
+
+		if a.JSONPath != "" && a.Action != EXTRACTJSONPATH {
+			return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use the \"jsonpath\" field. This must not be specified for %d-th action", a.Action, i)
+		}
+
 		switch a.Action {
 		case INSERT, UPDATE, UPSERT:
 			if valueSourceCount == 0 {
@@ -258,6 +337,19 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 			}
 			action.Regex = re
 			action.AttrNames = attrNames
+		case EXTRACTJSONPATH:
+			if a.Value != nil || a.FromContext != "" || a.RegexPattern != "" || a.ConvertedType != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" only uses the \"jsonpath\" and \"from_attribute\" fields. The others must not be specified for %d-th action", a.Action, i)
+			}
+			if a.JSONPath == "" {
+				return nil, fmt.Errorf("error creating AttrProc due to missing required field \"jsonpath\" for action \"%s\" at the %d-th action", a.Action, i)
+			}
+			path, err := parseJSONPath(a.JSONPath)
+			if err != nil {
+				return nil, fmt.Errorf("error creating AttrProc. Field \"jsonpath\" is invalid at the %d-th action: %w", i, err)
+			}
+			action.JSONPath = path
+			action.FromAttribute = a.FromAttribute
 		case CONVERT:
 			if valueSourceCount > 0 || a.RegexPattern != "" {
 				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" does not use value sources or \"pattern\" field. These must not be specified for %d-th action", a.Action, i)
@@ -272,17 +364,53 @@ func NewAttrProc(settings *Settings) (*AttrProc, error) {
 				return nil, fmt.Errorf("error creating AttrProc due to invalid value \"%s\" in field \"converted_type\" for action \"%s\" at the %d-th action", a.ConvertedType, a.Action, i)
 			}
 			action.ConvertedType = a.ConvertedType
+		case LIMIT:
+			if a.FromAttribute != "" || a.FromContext != "" || a.RegexPattern != "" || a.ConvertedType != "" {
+				return nil, fmt.Errorf("error creating AttrProc. Action \"%s\" only uses the \"value\", \"max_values\" and \"value_ttl\" fields. The others must not be specified for %d-th action", a.Action, i)
+			}
+			if a.MaxValues <= 0 {
+				return nil, fmt.Errorf("error creating AttrProc due to missing or invalid field \"max_values\" for action \"%s\" at the %d-th action, it must be positive", a.Action, i)
+			}
+			if a.ValueTTL < 0 {
+				return nil, fmt.Errorf("error creating AttrProc due to invalid field \"value_ttl\" for action \"%s\" at the %d-th action, it must not be negative", a.Action, i)
+			}
+			ttl := a.ValueTTL
+			if ttl == 0 {
+				ttl = defaultValueTTL
+			}
+			val := pcommon.NewValueStr(defaultOverflowValue)
+			if a.Value != nil {
+				if err := val.FromRaw(a.Value); err != nil {
+					return nil, err
+				}
+			}
+			action.AttributeValue = &val
+			action.limiter = newValueLimiter(a.MaxValues, ttl)
 		default:
 			return nil, fmt.Errorf("error creating AttrProc due to unsupported action %q at the %d-th actions", a.Action, i)
 		}
 
 		attributeActions = append(attributeActions, action)
 	}
-	return &AttrProc{actions: attributeActions}, nil
+	ap := &AttrProc{actions: attributeActions}
+	for _, opt := range opts {
+		opt(ap)
+	}
+	return ap, nil
 }
 
 // Process applies the AttrProc to an attribute map.
 func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcommon.Map) {
+	ap.process(ctx, logger, attrs, nil)
+}
+
+// ProcessLog applies the AttrProc to the attribute map of a log record, the
+// EXTRACTJSONPATH actions without FromAttribute extract values from its body.
+func (ap *AttrProc) ProcessLog(ctx context.Context, logger *zap.Logger, body pcommon.Value, attrs pcommon.Map) {
+	ap.process(ctx, logger, attrs, &body)
+}
+
+func (ap *AttrProc) process(ctx context.Context, logger *zap.Logger, attrs pcommon.Map, body *pcommon.Value) {
 	for _, action := range ap.actions {
 		// TODO https://go.opentelemetry.io/collector/issues/296
 		// Do benchmark testing between having action be of type string vs integer.
@@ -333,8 +461,26 @@ func (ap *AttrProc) Process(ctx context.Context, logger *zap.Logger, attrs pcomm
 			}
 		case EXTRACT:
 			extractAttributes(action, attrs)
+		case EXTRACTJSONPATH:
+			extractJSONPathAttribute(action, body, attrs)
 		case CONVERT:
 			convertAttribute(logger, action, attrs)
+		case LIMIT:
+			value, found := attrs.Get(action.Key)
+			if !found {
+				continue
+			}
+			allowed, evicted := action.limiter.allow(value)
+			if evicted > 0 && ap.onEviction != nil {
+				ap.onEviction(ctx, action.Key, evicted)
+			}
+			if allowed {
+				continue
+			}
+			action.AttributeValue.CopyTo(value)
+			if ap.onOverflow != nil {
+				ap.onOverflow(ctx, action.Key)
+			}
 		}
 	}
 }
@@ -428,6 +574,50 @@ func extractAttributes(action attributeAction, attrs pcommon.Map) {
 	}
 }
 
+func extractJSONPathAttribute(action attributeAction, body *pcommon.Value, attrs pcommon.Map) {
+	var source pcommon.Value
+	switch {
+	case action.FromAttribute != "":
+		value, found := attrs.Get(action.FromAttribute)
+		if !found {
+			return
+		}
+		source = value
+	case body != nil:
+		source = *body
+	default:
+		return
+	}
+
+	// String values are parsed on the fly, if they hold a JSON object or array.
+	if source.Type() == pcommon.ValueTypeStr {
+		parsed, ok := parseJSONValue(source.Str())
+		if !ok {
+			return
+		}
+		source = parsed
+	}
+
+	values := action.JSONPath.find(source)
+	if len(values) == 0 {
+		return
+	}
+
+	// The extracted value is built before setting the attribute, which may be
+	// the source of the values.
+	extracted := pcommon.NewValueEmpty()
+	if action.JSONPath.wildcard {
+		s := extracted.SetEmptySlice()
+		s.EnsureCapacity(len(values))
+		for _, v := range values {
+			v.CopyTo(s.AppendEmpty())
+		}
+	} else {
+		values[0].CopyTo(extracted)
+	}
+	extracted.CopyTo(attrs.PutEmpty(action.Key))
+}
+
 func getMatchingKeys(regexp *regexp.Regexp, attrs pcommon.Map) []string {
 	var keys []string
 
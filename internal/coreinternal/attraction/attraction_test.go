@@ -12,6 +12,7 @@ import (
 	"math"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -691,6 +692,212 @@ func TestAttributes_HashValue(t *testing.T) {
 	}
 }
 
+func TestAttributes_Limit(t *testing.T) {
+	var overflows []string
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "user.id", Action: LIMIT, MaxValues: 2},
+			{Key: "http.status_code", Action: LIMIT, MaxValues: 1, Value: "other"},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg, WithOverflowFunc(func(_ context.Context, key string) {
+		overflows = append(overflows, key)
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, ap)
+
+	testCases := []testCase{
+		{
+			name:               "first value",
+			inputAttributes:    map[string]any{"user.id": "a", "http.status_code": 200},
+			expectedAttributes: map[string]any{"user.id": "a", "http.status_code": int64(200)},
+		},
+		{
+			name:               "second value",
+			inputAttributes:    map[string]any{"user.id": "b", "http.status_code": 200},
+			expectedAttributes: map[string]any{"user.id": "b", "http.status_code": int64(200)},
+		},
+		{
+			name:               "values over the limit",
+			inputAttributes:    map[string]any{"user.id": "c", "http.status_code": "200"},
+			expectedAttributes: map[string]any{"user.id": "overflow", "http.status_code": "other"},
+		},
+		{
+			name:               "values seen before the limit",
+			inputAttributes:    map[string]any{"user.id": "a", "http.status_code": 200},
+			expectedAttributes: map[string]any{"user.id": "a", "http.status_code": int64(200)},
+		},
+		{
+			name:               "missing attributes",
+			inputAttributes:    map[string]any{"service.name": "svc"},
+			expectedAttributes: map[string]any{"service.name": "svc"},
+		},
+	}
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+	assert.Equal(t, []string{"user.id", "http.status_code"}, overflows)
+}
+
+func TestAttributes_LimitValueTTL(t *testing.T) {
+	evictions := map[string]int{}
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "user.id", Action: LIMIT, MaxValues: 2, ValueTTL: time.Minute},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg, WithEvictionFunc(func(_ context.Context, key string, evicted int) {
+		evictions[key] += evicted
+	}))
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	ap.actions[0].limiter.now = func() time.Time { return now }
+
+	runIndividualTestCase(t, testCase{
+		name:               "first value",
+		inputAttributes:    map[string]any{"user.id": "a"},
+		expectedAttributes: map[string]any{"user.id": "a"},
+	}, ap)
+	now = now.Add(30 * time.Second)
+	runIndividualTestCase(t, testCase{
+		name:               "second value",
+		inputAttributes:    map[string]any{"user.id": "b"},
+		expectedAttributes: map[string]any{"user.id": "b"},
+	}, ap)
+	runIndividualTestCase(t, testCase{
+		name:               "value over the limit",
+		inputAttributes:    map[string]any{"user.id": "c"},
+		expectedAttributes: map[string]any{"user.id": "overflow"},
+	}, ap)
+	assert.Empty(t, evictions)
+
+	// The first value expired, the second one was seen within the TTL.
+	now = now.Add(45 * time.Second)
+	runIndividualTestCase(t, testCase{
+		name:               "value replacing the expired one",
+		inputAttributes:    map[string]any{"user.id": "c"},
+		expectedAttributes: map[string]any{"user.id": "c"},
+	}, ap)
+	runIndividualTestCase(t, testCase{
+		name:               "expired value over the limit",
+		inputAttributes:    map[string]any{"user.id": "a"},
+		expectedAttributes: map[string]any{"user.id": "overflow"},
+	}, ap)
+	runIndividualTestCase(t, testCase{
+		name:               "value kept",
+		inputAttributes:    map[string]any{"user.id": "b"},
+		expectedAttributes: map[string]any{"user.id": "b"},
+	}, ap)
+	assert.Equal(t, map[string]int{"user.id": 1}, evictions)
+}
+
+func TestAttributes_ExtractJSONPath(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:               "missing source attribute",
+			inputAttributes:    map[string]any{"boo": "ghosts are scary"},
+			expectedAttributes: map[string]any{"boo": "ghosts are scary"},
+		},
+		{
+			name:               "source attribute is not JSON",
+			inputAttributes:    map[string]any{"payload": "user=alice"},
+			expectedAttributes: map[string]any{"payload": "user=alice"},
+		},
+		{
+			name:               "source attribute is invalid JSON",
+			inputAttributes:    map[string]any{"payload": `{"user": {"id": `},
+			expectedAttributes: map[string]any{"payload": `{"user": {"id": `},
+		},
+		{
+			name:            "serialized JSON",
+			inputAttributes: map[string]any{"payload": `{"user": {"id": 42, "name": null, "roles": [{"name": "admin"}, {"name": "dev"}]}, "tags": ["a", "b", "c"]}`},
+			expectedAttributes: map[string]any{
+				"payload":     `{"user": {"id": 42, "name": null, "roles": [{"name": "admin"}, {"name": "dev"}]}, "tags": ["a", "b", "c"]}`,
+				"user.id":     int64(42),
+				"user.roles":  []any{"admin", "dev"},
+				"user.fields": []any{int64(42), []any{map[string]any{"name": "admin"}, map[string]any{"name": "dev"}}},
+				"last_tag":    "c",
+			},
+		},
+		{
+			name: "map value overriding existing attributes",
+			inputAttributes: map[string]any{
+				"payload": map[string]any{"user": map[string]any{"id": 1.5}},
+				"user.id": "unknown",
+			},
+			expectedAttributes: map[string]any{
+				"payload":     map[string]any{"user": map[string]any{"id": 1.5}},
+				"user.id":     1.5,
+				"user.fields": []any{1.5},
+			},
+		},
+		{
+			name:               "source attribute replaced by the extracted value",
+			inputAttributes:    map[string]any{"request": map[string]any{"headers": map[string]any{"host": "example.com"}}},
+			expectedAttributes: map[string]any{"request": map[string]any{"host": "example.com"}},
+		},
+	}
+
+	cfg := &Settings{
+		Actions: []ActionKeyValue{
+			{Key: "user.id", FromAttribute: "payload", JSONPath: "$.user.id", Action: EXTRACTJSONPATH},
+			{Key: "user.roles", FromAttribute: "payload", JSONPath: "$.user.roles[*]['name']", Action: EXTRACTJSONPATH},
+			{Key: "user.fields", FromAttribute: "payload", JSONPath: "$.user.*", Action: EXTRACTJSONPATH},
+			{Key: "last_tag", FromAttribute: "payload", JSONPath: "$.tags[-1]", Action: EXTRACTJSONPATH},
+			{Key: "request", FromAttribute: "request", JSONPath: "$.headers", Action: EXTRACTJSONPATH},
+		},
+	}
+
+	ap, err := NewAttrProc(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, ap)
+
+	for _, tt := range testCases {
+		runIndividualTestCase(t, tt, ap)
+	}
+}
+
+func TestAttributes_ExtractJSONPathFromLogBody(t *testing.T) {
+	ap, err := NewAttrProc(&Settings{
+		Actions: []ActionKeyValue{
+			{Key: "user.id", JSONPath: "$.user.id", Action: EXTRACTJSONPATH},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, body := range []any{`{"user": {"id": "alice"}}`, map[string]any{"user": map[string]any{"id": "alice"}}} {
+		value := pcommon.NewValueEmpty()
+		require.NoError(t, value.FromRaw(body))
+
+		attrs := pcommon.NewMap()
+		ap.ProcessLog(context.TODO(), nil, value, attrs)
+		assert.Equal(t, map[string]any{"user.id": "alice"}, attrs.AsRaw())
+
+		// there is no body outside of log records
+		attrs = pcommon.NewMap()
+		ap.Process(context.TODO(), nil, attrs)
+		assert.Empty(t, attrs.AsRaw())
+	}
+}
+
+func TestAttributes_ExtractJSONPathInvalidPath(t *testing.T) {
+	for path, errorString := range map[string]string{
+		"user.id":    `path "user.id" must start with $`,
+		"$.user.":    `path "$.user." has an empty member name`,
+		"$.user[0":   `path "$.user[0" has an unterminated bracket`,
+		"$.user[id]": `path "$.user[id]": "id" is neither a quoted member name, an array index nor a wildcard`,
+		"$user":      `path "$user" has an unexpected character 'u'`,
+	} {
+		_, err := NewAttrProc(&Settings{
+			Actions: []ActionKeyValue{{Key: "aa", JSONPath: path, Action: EXTRACTJSONPATH}},
+		})
+		assert.EqualError(t, err, "error creating AttrProc. Field \"jsonpath\" is invalid at the 0-th action: "+errorString)
+	}
+}
+
 func TestAttributes_FromAttributeNoChange(t *testing.T) {
 	tc := testCase{
 		name: "FromAttributeNoChange",
@@ -890,6 +1097,62 @@ func TestInvalidConfig(t *testing.T) {
 			},
 			errorString: "error creating AttrProc. Field \"pattern\" contains at least one unnamed matcher group at the 0-th actions",
 		},
+		{
+			name: "missing max values for limit",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Action: LIMIT},
+			},
+			errorString: "error creating AttrProc due to missing or invalid field \"max_values\" for action \"limit\" at the 0-th action, it must be positive",
+		},
+		{
+			name: "set from attribute for limit",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", FromAttribute: "bb", MaxValues: 10, Action: LIMIT},
+			},
+			errorString: "error creating AttrProc. Action \"limit\" only uses the \"value\", \"max_values\" and \"value_ttl\" fields. The others must not be specified for 0-th action",
+		},
+		{
+			name: "missing jsonpath for extract_jsonpath",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Action: EXTRACTJSONPATH},
+			},
+			errorString: "error creating AttrProc due to missing required field \"jsonpath\" for action \"extract_jsonpath\" at the 0-th action",
+		},
+		{
+			name: "set value for extract_jsonpath",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", JSONPath: "$.user", Value: "bb", Action: EXTRACTJSONPATH},
+			},
+			errorString: "error creating AttrProc. Action \"extract_jsonpath\" only uses the \"jsonpath\" and \"from_attribute\" fields. The others must not be specified for 0-th action",
+		},
+		{
+			name: "jsonpath shouldn't be specified",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", JSONPath: "$.user", Action: DELETE},
+			},
+			errorString: "error creating AttrProc. Action \"delete\" does not use the \"jsonpath\" field. This must not be specified for 0-th action",
+		},
+		{
+			name: "max values shouldn't be specified",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "bb", MaxValues: 10, Action: UPSERT},
+			},
+			errorString: "error creating AttrProc. Action \"upsert\" does not use the \"max_values\" field. This must not be specified for 0-th action",
+		},
+		{
+			name: "value ttl shouldn't be specified",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", Value: "bb", ValueTTL: time.Minute, Action: UPSERT},
+			},
+			errorString: "error creating AttrProc. Action \"upsert\" does not use the \"value_ttl\" field. This must not be specified for 0-th action",
+		},
+		{
+			name: "negative value ttl for limit",
+			actionLists: []ActionKeyValue{
+				{Key: "aa", MaxValues: 10, ValueTTL: -time.Minute, Action: LIMIT},
+			},
+			errorString: "error creating AttrProc due to invalid field \"value_ttl\" for action \"limit\" at the 0-th action, it must not be negative",
+		},
 	}
 
 	for _, tc := range testcase {
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// jsonPathSegment selects either the member of a map by key, the element of a slice by
+// index, or all the members or elements of a map or a slice.
+type jsonPathSegment struct {
+	key      string
+	index    int
+	array    bool
+	wildcard bool
+}
+
+// jsonPath is the subset of JSONPath made of the root `$`, followed by members `.name` or
+// `['name']`, array elements `[0]`, negative indexes counting from the end, and wildcards
+// `.*` or `[*]`.
+type jsonPath struct {
+	segments []jsonPathSegment
+	// wildcard is set if the path can select any number of values.
+	wildcard bool
+}
+
+func parseJSONPath(s string) (jsonPath, error) {
+	if !strings.HasPrefix(s, "$") {
+		return jsonPath{}, fmt.Errorf("path %q must start with $", s)
+	}
+	rest := s[1:]
+
+	var p jsonPath
+	for rest != "" {
+		var seg jsonPathSegment
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return jsonPath{}, fmt.Errorf("path %q has an empty member name", s)
+			}
+			seg = jsonPathSegment{key: rest[:end], wildcard: rest[:end] == "*"}
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return jsonPath{}, fmt.Errorf("path %q has an unterminated bracket", s)
+			}
+			var err error
+			if seg, err = parseJSONPathBracket(rest[1:end]); err != nil {
+				return jsonPath{}, fmt.Errorf("path %q: %w", s, err)
+			}
+			rest = rest[end+1:]
+		default:
+			return jsonPath{}, fmt.Errorf("path %q has an unexpected character %q", s, rest[0])
+		}
+		p.segments = append(p.segments, seg)
+		p.wildcard = p.wildcard || seg.wildcard
+	}
+	return p, nil
+}
+
+func parseJSONPathBracket(s string) (jsonPathSegment, error) {
+	if s == "*" {
+		return jsonPathSegment{wildcard: true}, nil
+	}
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return jsonPathSegment{key: s[1 : len(s)-1]}, nil
+	}
+	index, err := strconv.Atoi(s)
+	if err != nil {
+		return jsonPathSegment{}, fmt.Errorf("%q is neither a quoted member name, an array index nor a wildcard", s)
+	}
+	return jsonPathSegment{index: index, array: true}, nil
+}
+
+// find returns the non null values selected by the path in v.
+func (p jsonPath) find(v pcommon.Value) []pcommon.Value {
+	values := []pcommon.Value{v}
+	for _, seg := range p.segments {
+		var next []pcommon.Value
+		for _, value := range values {
+			next = seg.appendSelected(next, value)
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		values = next
+	}
+
+	selected := values[:0]
+	for _, value := range values {
+		if value.Type() != pcommon.ValueTypeEmpty {
+			selected = append(selected, value)
+		}
+	}
+	return selected
+}
+
+func (seg jsonPathSegment) appendSelected(dest []pcommon.Value, v pcommon.Value) []pcommon.Value {
+	switch v.Type() {
+	case pcommon.ValueTypeMap:
+		switch {
+		case seg.wildcard:
+			v.Map().Range(func(_ string, member pcommon.Value) bool {
+				dest = append(dest, member)
+				return true
+			})
+		case !seg.array:
+			if member, ok := v.Map().Get(seg.key); ok {
+				dest = append(dest, member)
+			}
+		}
+	case pcommon.ValueTypeSlice:
+		elements := v.Slice()
+		switch {
+		case seg.wildcard:
+			for i := 0; i < elements.Len(); i++ {
+				dest = append(dest, elements.At(i))
+			}
+		case seg.array:
+			index := seg.index
+			if index < 0 {
+				index += elements.Len()
+			}
+			if index >= 0 && index < elements.Len() {
+				dest = append(dest, elements.At(index))
+			}
+		}
+	}
+	return dest
+}
+
+// parseJSONValue decodes the JSON object or array serialized in s, integral numbers are
+// decoded as integers and the members of objects are sorted by key.
+func parseJSONValue(s string) (pcommon.Value, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || (s[0] != '{' && s[0] != '[') {
+		return pcommon.Value{}, false
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var raw any
+	if err := dec.Decode(&raw); err != nil {
+		return pcommon.Value{}, false
+	}
+	v := pcommon.NewValueEmpty()
+	setJSONValue(v, raw)
+	return v, true
+}
+
+func setJSONValue(dest pcommon.Value, raw any) {
+	switch t := raw.(type) {
+	case map[string]any:
+		// the members are sorted so that wildcards select them in a stable order
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		m := dest.SetEmptyMap()
+		m.EnsureCapacity(len(t))
+		for _, k := range keys {
+			setJSONValue(m.PutEmpty(k), t[k])
+		}
+	case []any:
+		s := dest.SetEmptySlice()
+		s.EnsureCapacity(len(t))
+		for _, element := range t {
+			setJSONValue(s.AppendEmpty(), element)
+		}
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			dest.SetInt(i)
+		} else if f, err := t.Float64(); err == nil {
+			dest.SetDouble(f)
+		} else {
+			dest.SetStr(t.String())
+		}
+	case string:
+		dest.SetStr(t)
+	case bool:
+		dest.SetBool(t)
+	}
+}
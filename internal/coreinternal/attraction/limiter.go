@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attraction // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// valueLimiter keeps track of the unique values of an attribute. Only the hashes
+// of the values are kept, so that the memory used doesn't depend on their size.
+// The values not seen for the TTL are evicted, which frees their slot for new
+// values.
+type valueLimiter struct {
+	maxValues int
+	ttl       time.Duration
+	now       func() time.Time
+
+	mu sync.Mutex
+	// seen holds the last time each value was seen.
+	seen map[uint64]time.Time
+	// nextEviction is the earliest time a value can expire, before which looking
+	// for expired values is pointless.
+	nextEviction time.Time
+}
+
+func newValueLimiter(maxValues int, ttl time.Duration) *valueLimiter {
+	return &valueLimiter{
+		maxValues: maxValues,
+		ttl:       ttl,
+		now:       time.Now,
+		seen:      make(map[uint64]time.Time, maxValues),
+	}
+}
+
+// allow returns whether the value was already seen, or is a new value that
+// can be kept as the limit isn't reached. It also returns the number of values
+// evicted to make room for it.
+func (l *valueLimiter) allow(value pcommon.Value) (bool, int) {
+	h := fnv.New64a()
+	// The type is part of the hash, so that e.g. 1 and "1" are different values.
+	_, _ = h.Write([]byte{byte(value.Type())})
+	_, _ = h.Write([]byte(value.AsString()))
+	sum := h.Sum64()
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.seen[sum]; ok {
+		l.seen[sum] = now
+		return true, 0
+	}
+	evicted := 0
+	if len(l.seen) >= l.maxValues {
+		evicted = l.evictExpired(now)
+		if len(l.seen) >= l.maxValues {
+			return false, evicted
+		}
+	}
+	l.seen[sum] = now
+	return true, evicted
+}
+
+// evictExpired removes the values not seen for the TTL, and returns their number.
+func (l *valueLimiter) evictExpired(now time.Time) int {
+	if now.Before(l.nextEviction) {
+		return 0
+	}
+	expiry := now.Add(-l.ttl)
+	oldest := now
+	evicted := 0
+	for sum, lastSeen := range l.seen {
+		if !lastSeen.After(expiry) {
+			delete(l.seen, sum)
+			evicted++
+			continue
+		}
+		if lastSeen.Before(oldest) {
+			oldest = lastSeen
+		}
+	}
+	l.nextEviction = oldest.Add(l.ttl)
+	return evicted
+}
@@ -11,6 +11,7 @@ require (
 	github.com/testcontainers/testcontainers-go v0.31.0
 	go.opentelemetry.io/collector v0.102.0
 	go.opentelemetry.io/collector/component v0.102.0
+	go.opentelemetry.io/collector/config/configretry v0.102.0
 	go.opentelemetry.io/collector/consumer v0.102.0
 	go.opentelemetry.io/collector/pdata v1.9.0
 	go.opentelemetry.io/collector/receiver v0.102.0
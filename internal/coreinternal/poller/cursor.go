@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Storage is the subset of the client of a storage extension the cursors are persisted with.
+type Storage interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte) error
+}
+
+// Cursor tracks the position reached by the incremental polls of an API, such as a page token or the time
+// of the last record received, so that the next poll resumes from there. When it has a storage, the
+// position is persisted and restored after a restart of the collector.
+type Cursor struct {
+	storage Storage
+	key     string
+
+	mu    sync.Mutex
+	value string
+}
+
+// NewCursor creates a Cursor, restoring the position persisted under key when storage is not nil, and
+// starting from initial otherwise.
+func NewCursor(ctx context.Context, storage Storage, key, initial string) (*Cursor, error) {
+	c := &Cursor{storage: storage, key: key, value: initial}
+	if storage == nil {
+		return c, nil
+	}
+	value, err := storage.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the cursor %q: %w", key, err)
+	}
+	if value != nil {
+		c.value = string(value)
+	}
+	return c, nil
+}
+
+// Value returns the current position.
+func (c *Cursor) Value() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.value
+}
+
+// Advance moves the cursor to value, and persists it when the cursor has a storage. The cursor is moved
+// even if it fails to be persisted.
+func (c *Cursor) Advance(ctx context.Context, value string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.set(ctx, value)
+}
+
+// Time returns the current position as a time, the zero time when the position isn't a time.
+func (c *Cursor) Time() time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, c.Value())
+	return t
+}
+
+// AdvanceTime moves the cursor to t when it is after the current position, so that the records received
+// out of order don't move the cursor backwards.
+func (c *Cursor) AdvanceTime(ctx context.Context, t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if current, err := time.Parse(time.RFC3339Nano, c.value); err == nil && !t.After(current) {
+		return nil
+	}
+	return c.set(ctx, t.UTC().Format(time.RFC3339Nano))
+}
+
+func (c *Cursor) set(ctx context.Context, value string) error {
+	c.value = value
+	if c.storage == nil {
+		return nil
+	}
+	if err := c.storage.Set(ctx, c.key, []byte(value)); err != nil {
+		return fmt.Errorf("failed to persist the cursor %q: %w", c.key, err)
+	}
+	return nil
+}
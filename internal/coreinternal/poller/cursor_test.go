@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mapStorage map[string][]byte
+
+func (s mapStorage) Get(_ context.Context, key string) ([]byte, error) {
+	return s[key], nil
+}
+
+func (s mapStorage) Set(_ context.Context, key string, value []byte) error {
+	s[key] = value
+	return nil
+}
+
+type errStorage struct{}
+
+func (errStorage) Get(context.Context, string) ([]byte, error) {
+	return nil, errors.New("storage error")
+}
+
+func (errStorage) Set(context.Context, string, []byte) error {
+	return errors.New("storage error")
+}
+
+func TestCursor(t *testing.T) {
+	ctx := context.Background()
+	storage := mapStorage{}
+
+	c, err := NewCursor(ctx, storage, "events", "page-0")
+	require.NoError(t, err)
+	assert.Equal(t, "page-0", c.Value())
+
+	require.NoError(t, c.Advance(ctx, "page-1"))
+	assert.Equal(t, "page-1", c.Value())
+
+	// the position is restored from the storage
+	c, err = NewCursor(ctx, storage, "events", "page-0")
+	require.NoError(t, err)
+	assert.Equal(t, "page-1", c.Value())
+}
+
+func TestCursorWithoutStorage(t *testing.T) {
+	c, err := NewCursor(context.Background(), nil, "events", "")
+	require.NoError(t, err)
+	require.NoError(t, c.Advance(context.Background(), "page-1"))
+	assert.Equal(t, "page-1", c.Value())
+}
+
+func TestCursorStorageError(t *testing.T) {
+	_, err := NewCursor(context.Background(), errStorage{}, "events", "")
+	assert.EqualError(t, err, `failed to load the cursor "events": storage error`)
+
+	c, err := NewCursor(context.Background(), nil, "events", "")
+	require.NoError(t, err)
+	c.storage = errStorage{}
+	assert.EqualError(t, c.Advance(context.Background(), "page-1"), `failed to persist the cursor "events": storage error`)
+	assert.Equal(t, "page-1", c.Value())
+}
+
+func TestCursorTime(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewCursor(ctx, mapStorage{}, "events", "")
+	require.NoError(t, err)
+	assert.True(t, c.Time().IsZero())
+
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, c.AdvanceTime(ctx, start))
+	assert.Equal(t, start, c.Time())
+
+	// the cursor doesn't move backwards
+	require.NoError(t, c.AdvanceTime(ctx, start.Add(-time.Minute)))
+	assert.Equal(t, start, c.Time())
+
+	require.NoError(t, c.AdvanceTime(ctx, start.Add(time.Minute)))
+	assert.Equal(t, start.Add(time.Minute), c.Time())
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package poller provides the building blocks shared by receivers that poll
+// the API of a SaaS or cloud provider: retrying rate limited requests with
+// the delay of their Retry-After header, refreshing expiring credentials,
+// paginating list calls, tracking incremental cursors across restarts, and
+// capping the number of concurrent calls.
+package poller // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Limiter caps the number of calls to an API running at the same time, across the goroutines sharing it.
+type Limiter struct {
+	sem chan struct{}
+}
+
+// NewLimiter creates a Limiter letting at most maxConcurrency calls run at the same time, zero or a
+// negative value meaning no limit.
+func NewLimiter(maxConcurrency int) *Limiter {
+	l := &Limiter{}
+	if maxConcurrency > 0 {
+		l.sem = make(chan struct{}, maxConcurrency)
+	}
+	return l
+}
+
+// Acquire waits for a free slot, or until ctx is done. The slot must be released with Release.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if l.sem == nil {
+		return ctx.Err()
+	}
+	select {
+	case l.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	// The slot and ctx.Done may have been ready at the same time.
+	if err := ctx.Err(); err != nil {
+		l.Release()
+		return err
+	}
+	return nil
+}
+
+// Release frees a slot acquired with Acquire.
+func (l *Limiter) Release() {
+	if l.sem != nil {
+		<-l.sem
+	}
+}
+
+// ForEach calls fn for the n items, each in its own goroutine holding a slot of the limiter, and waits for
+// all of them. It returns the errors of the calls joined, and the error of ctx when items were skipped
+// because it was done before they got a slot.
+func (l *Limiter) ForEach(ctx context.Context, n int, fn func(ctx context.Context, index int) error) error {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    error
+		skipErr error
+	)
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(index int) {
+			defer wg.Done()
+			if err := l.Acquire(ctx); err != nil {
+				mu.Lock()
+				skipErr = err
+				mu.Unlock()
+				return
+			}
+			err := fn(ctx, index)
+			l.Release()
+			if err != nil {
+				mu.Lock()
+				errs = errors.Join(errs, err)
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errors.Join(errs, skipErr)
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterForEach(t *testing.T) {
+	var running, peak, calls atomic.Int32
+	l := NewLimiter(2)
+	err := l.ForEach(context.Background(), 10, func(context.Context, int) error {
+		calls.Add(1)
+		cur := running.Add(1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), calls.Load())
+	assert.Equal(t, int32(2), peak.Load())
+}
+
+func TestLimiterForEachErrors(t *testing.T) {
+	l := NewLimiter(0)
+	err := l.ForEach(context.Background(), 3, func(_ context.Context, i int) error {
+		if i == 1 {
+			return errors.New("call error")
+		}
+		return nil
+	})
+	assert.EqualError(t, err, "call error")
+}
+
+func TestLimiterForEachCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var calls atomic.Int32
+	err := NewLimiter(1).ForEach(ctx, 3, func(context.Context, int) error {
+		calls.Add(1)
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, calls.Load())
+}
+
+func TestLimiterAcquire(t *testing.T) {
+	l := NewLimiter(1)
+	require.NoError(t, l.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Acquire(ctx), context.DeadlineExceeded)
+
+	l.Release()
+	assert.NoError(t, l.Acquire(context.Background()))
+	l.Release()
+}
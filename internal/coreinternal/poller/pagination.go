@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrMaxPages is returned by Paginate when there are still pages after the maximum number of pages.
+var ErrMaxPages = errors.New("maximum number of pages reached")
+
+// PageFunc fetches the page identified by page, a page number or a page token, and returns the
+// identifier of the next page and whether there is one.
+type PageFunc[T any] func(ctx context.Context, page T) (next T, hasNext bool, err error)
+
+// Paginate fetches the pages from first until the last one, at most maxPages of them when it is positive.
+// It stops at the first error, and once ctx is done.
+func Paginate[T any](ctx context.Context, first T, maxPages int, fetch PageFunc[T]) error {
+	page := first
+	for pages := 1; ; pages++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		next, hasNext, err := fetch(ctx, page)
+		if err != nil || !hasNext {
+			return err
+		}
+		if maxPages > 0 && pages >= maxPages {
+			return ErrMaxPages
+		}
+		page = next
+	}
+}
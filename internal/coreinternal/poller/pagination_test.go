@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	var pages []int
+	err := Paginate(context.Background(), 1, 0, func(_ context.Context, page int) (int, bool, error) {
+		pages = append(pages, page)
+		return page + 1, page < 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, pages)
+}
+
+func TestPaginateTokens(t *testing.T) {
+	var tokens []string
+	err := Paginate(context.Background(), "", 0, func(_ context.Context, token string) (string, bool, error) {
+		tokens = append(tokens, token)
+		next := strconv.Itoa(len(tokens))
+		return next, len(tokens) < 2, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "1"}, tokens)
+}
+
+func TestPaginateMaxPages(t *testing.T) {
+	fetched := 0
+	err := Paginate(context.Background(), 1, 2, func(_ context.Context, page int) (int, bool, error) {
+		fetched++
+		return page + 1, true, nil
+	})
+	assert.ErrorIs(t, err, ErrMaxPages)
+	assert.Equal(t, 2, fetched)
+}
+
+func TestPaginateError(t *testing.T) {
+	fetched := 0
+	err := Paginate(context.Background(), 1, 0, func(_ context.Context, page int) (int, bool, error) {
+		fetched++
+		return page + 1, true, errors.New("fetch error")
+	})
+	assert.EqualError(t, err, "fetch error")
+	assert.Equal(t, 1, fetched)
+}
+
+func TestPaginateCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fetched := 0
+	err := Paginate(ctx, 1, 0, func(_ context.Context, page int) (int, bool, error) {
+		fetched++
+		cancel()
+		return page + 1, true, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, fetched)
+}
@@ -0,0 +1,240 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.uber.org/zap"
+)
+
+var errShutdown = errors.New("request cancelled due to shutdown")
+
+// RetryAfterError is returned by the calls rejected by an API which requested a delay before the next
+// attempt, for instance with the Retry-After header of a rate limited HTTP response.
+type RetryAfterError struct {
+	Err   error
+	Delay time.Duration
+}
+
+// NewRetryAfterError wraps err with the delay requested by the API.
+func NewRetryAfterError(err error, delay time.Duration) error {
+	return &RetryAfterError{Err: err, Delay: delay}
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("%v (retry after %v)", e.Err, e.Delay)
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+type permanentError struct {
+	err error
+}
+
+// Permanent wraps an error to signal Retry that the call must not be retried.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// IsPermanent checks whether err was wrapped with Permanent.
+func IsPermanent(err error) bool {
+	return errors.As(err, &permanentError{})
+}
+
+// Retry calls fn until it succeeds, it returns a permanent error, or the backoff configured by cfg gives up.
+// When the error wraps a RetryAfterError, the next attempt is delayed by at least the requested delay, and
+// the last error is returned right away when that delay exceeds the maximum elapsed time.
+func Retry(ctx context.Context, cfg configretry.BackOffConfig, fn func(context.Context) error) error {
+	err := fn(ctx)
+	if err == nil || !cfg.Enabled || IsPermanent(err) {
+		return err
+	}
+
+	b := newBackOff(cfg)
+	for {
+		var retryAfter *RetryAfterError
+		requested := time.Duration(-1)
+		if errors.As(err, &retryAfter) {
+			requested = retryAfter.Delay
+		}
+		delay, ok := nextDelay(b, cfg, requested)
+		if !ok {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context is cancelled or timed out: %w", err)
+		case <-time.After(delay):
+		}
+
+		if err = fn(ctx); err == nil || IsPermanent(err) {
+			return err
+		}
+	}
+}
+
+// RetryTransport is an http.RoundTripper retrying the requests rejected with 429 Too Many Requests or
+// 503 Service Unavailable. It waits for the delay of the Retry-After header of the response when it is
+// longer than the backoff interval.
+type RetryTransport struct {
+	next   http.RoundTripper
+	cfg    configretry.BackOffConfig
+	logger *zap.Logger
+
+	shutdownOnce sync.Once
+	shutdown     chan struct{}
+}
+
+// NewRetryTransport creates a RetryTransport sending the requests with next.
+func NewRetryTransport(next http.RoundTripper, cfg configretry.BackOffConfig, logger *zap.Logger) *RetryTransport {
+	return &RetryTransport{
+		next:     next,
+		cfg:      cfg,
+		logger:   logger,
+		shutdown: make(chan struct{}),
+	}
+}
+
+// RoundTrip sends the request, and sends it again while the response is retryable. The last response
+// is returned when the backoff gives up.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case <-t.shutdown:
+		return nil, errShutdown
+	default:
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || !t.cfg.Enabled || !isRetryableStatus(resp.StatusCode) {
+		return resp, err
+	}
+	// the body of the request can only be sent again when it can be rewound
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	b := newBackOff(t.cfg)
+	for attempts := 1; ; attempts++ {
+		requested := time.Duration(-1)
+		if retryAfter, ok := ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			requested = retryAfter
+		}
+		delay, ok := nextDelay(b, t.cfg, requested)
+		if !ok {
+			return resp, nil
+		}
+		t.logger.Warn("Server busy, retrying request",
+			zap.Int("status", resp.StatusCode),
+			zap.Int("attempts", attempts),
+			zap.Duration("delay", delay))
+		select {
+		case <-req.Context().Done():
+			return resp, fmt.Errorf("request was cancelled or timed out")
+		case <-t.shutdown:
+			return resp, errShutdown
+		case <-time.After(delay):
+		}
+
+		// The rejected response is discarded, its body must be closed to reuse the connection.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		retry := req
+		if req.GetBody != nil {
+			retry = req.Clone(req.Context())
+			if retry.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		resp, err = t.next.RoundTrip(retry)
+		if err != nil || !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+	}
+}
+
+// Shutdown rejects the new requests and interrupts the requests waiting for their next attempt.
+func (t *RetryTransport) Shutdown() error {
+	t.shutdownOnce.Do(func() {
+		close(t.shutdown)
+	})
+	return nil
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable
+}
+
+// ParseRetryAfter returns the delay of a Retry-After header, either in seconds or as an HTTP date.
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if delay := t.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func newBackOff(cfg configretry.BackOffConfig) *backoff.ExponentialBackOff {
+	// Do not use NewExponentialBackOff since it calls Reset and the code here must
+	// call Reset after changing the InitialInterval (this saves an unnecessary call to Now).
+	b := &backoff.ExponentialBackOff{
+		InitialInterval:     cfg.InitialInterval,
+		RandomizationFactor: cfg.RandomizationFactor,
+		Multiplier:          cfg.Multiplier,
+		MaxInterval:         cfg.MaxInterval,
+		MaxElapsedTime:      cfg.MaxElapsedTime,
+		Stop:                backoff.Stop,
+		Clock:               backoff.SystemClock,
+	}
+	b.Reset()
+	return b
+}
+
+// nextDelay returns the delay before the next attempt, which is the delay requested by the API when it is
+// not negative and longer than the backoff interval. It returns false when the backoff gives up, or when
+// the requested delay exceeds the maximum elapsed time, since waiting for it would be pointless.
+func nextDelay(b *backoff.ExponentialBackOff, cfg configretry.BackOffConfig, requested time.Duration) (time.Duration, bool) {
+	delay := b.NextBackOff()
+	if delay == backoff.Stop {
+		return 0, false
+	}
+	if requested > delay {
+		if cfg.MaxElapsedTime > 0 && requested > cfg.MaxElapsedTime {
+			return 0, false
+		}
+		delay = requested
+	}
+	return delay, true
+}
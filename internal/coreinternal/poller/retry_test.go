@@ -0,0 +1,228 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.uber.org/zap"
+)
+
+func testBackOffConfig() configretry.BackOffConfig {
+	cfg := configretry.NewDefaultBackOffConfig()
+	cfg.InitialInterval = time.Millisecond
+	cfg.MaxInterval = 10 * time.Millisecond
+	return cfg
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{value: "", ok: false},
+		{value: "invalid", ok: false},
+		{value: "-1", ok: false},
+		{value: "30", expected: 30 * time.Second, ok: true},
+		{value: now.Add(time.Minute).Format(http.TimeFormat), expected: time.Minute, ok: true},
+		{value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			delay, ok := ParseRetryAfter(tc.value, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, delay)
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errTransient := errors.New("transient")
+	testCases := []struct {
+		name             string
+		cfg              func() configretry.BackOffConfig
+		errs             []error
+		expectedErr      error
+		expectedAttempts int
+	}{
+		{
+			name:             "succeeds after retries",
+			cfg:              testBackOffConfig,
+			errs:             []error{errTransient, errTransient, nil},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "permanent error",
+			cfg:              testBackOffConfig,
+			errs:             []error{errTransient, Permanent(errTransient)},
+			expectedErr:      errTransient,
+			expectedAttempts: 2,
+		},
+		{
+			name: "disabled",
+			cfg: func() configretry.BackOffConfig {
+				cfg := testBackOffConfig()
+				cfg.Enabled = false
+				return cfg
+			},
+			errs:             []error{errTransient, nil},
+			expectedErr:      errTransient,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "retry after exceeds max elapsed time",
+			cfg:              testBackOffConfig,
+			errs:             []error{NewRetryAfterError(errTransient, time.Hour), nil},
+			expectedErr:      errTransient,
+			expectedAttempts: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			err := Retry(context.Background(), tc.cfg(), func(context.Context) error {
+				err := tc.errs[attempts]
+				attempts++
+				return err
+			})
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			}
+			assert.Equal(t, tc.expectedAttempts, attempts)
+		})
+	}
+}
+
+func TestRetryWaitsRetryAfter(t *testing.T) {
+	start := time.Now()
+	attempts := 0
+	err := Retry(context.Background(), testBackOffConfig(), func(context.Context) error {
+		attempts++
+		if attempts == 1 {
+			return NewRetryAfterError(errors.New("rate limited"), 100*time.Millisecond)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	// The delay requested by the API is waited rather than the shorter backoff interval.
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestRetryCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := Retry(ctx, testBackOffConfig(), func(context.Context) error {
+		return errors.New("transient")
+	})
+	assert.ErrorContains(t, err, "context is cancelled or timed out")
+}
+
+func TestRetryTransport(t *testing.T) {
+	var requests atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		switch requests.Add(1) {
+		case 1:
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	transport := &http.Transport{}
+	defer transport.CloseIdleConnections()
+	rt := NewRetryTransport(transport, testBackOffConfig(), zap.NewNop())
+
+	start := time.Now()
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), requests.Load())
+	// The body is sent again with each attempt.
+	assert.Equal(t, []string{"payload", "payload", "payload"}, bodies)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryTransportRetryAfterExceedsMaxElapsedTime(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport := &http.Transport{}
+	defer transport.CloseIdleConnections()
+	rt := NewRetryTransport(transport, configretry.NewDefaultBackOffConfig(), zap.NewNop())
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// Waiting longer than the maximum elapsed time would be pointless, the rate limited response is returned.
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestRetryTransportShutdown(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport := &http.Transport{}
+	defer transport.CloseIdleConnections()
+	rt := NewRetryTransport(transport, configretry.NewDefaultBackOffConfig(), zap.NewNop())
+
+	done := make(chan error)
+	go func() {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			done <- err
+			return
+		}
+		resp, err := rt.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		done <- err
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, rt.Shutdown())
+	assert.ErrorIs(t, <-done, errShutdown)
+
+	_, err := rt.RoundTrip(httptest.NewRequest(http.MethodGet, server.URL, nil))
+	assert.ErrorIs(t, err, errShutdown)
+}
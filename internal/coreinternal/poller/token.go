@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TokenFunc is the callback fetching a new token from an API. It returns the token and its expiry, the zero
+// time meaning the token is valid until the API rejects it.
+type TokenFunc func(ctx context.Context) (token string, expiry time.Time, err error)
+
+// TokenSource caches the token of an API, and refreshes it when it is about to expire or once it has been
+// invalidated because the API rejected it. It is safe for concurrent use, the concurrent calls waiting for
+// the same refresh.
+type TokenSource struct {
+	refresh TokenFunc
+	// margin is how long before its expiry the token is refreshed, so that it doesn't expire in flight.
+	margin time.Duration
+	now    func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+	valid  bool
+}
+
+// NewTokenSource creates a TokenSource refreshing the token with refresh, margin before its expiry.
+func NewTokenSource(refresh TokenFunc, margin time.Duration) *TokenSource {
+	return &TokenSource{
+		refresh: refresh,
+		margin:  margin,
+		now:     time.Now,
+	}
+}
+
+// Token returns the cached token, refreshing it first when needed.
+func (s *TokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.valid && (s.expiry.IsZero() || s.now().Add(s.margin).Before(s.expiry)) {
+		return s.token, nil
+	}
+	token, expiry, err := s.refresh(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token, s.expiry, s.valid = token, expiry, true
+	return token, nil
+}
+
+// Invalidate discards the cached token if it is still the given one, so that the next call to Token
+// refreshes it. Comparing the tokens avoids refreshing again a token another caller already refreshed.
+func (s *TokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == token {
+		s.valid = false
+	}
+}
+
+// AuthTransport is an http.RoundTripper authenticating the requests with the token of a TokenSource. The
+// requests rejected with 401 Unauthorized are sent again once with a refreshed token.
+type AuthTransport struct {
+	next   http.RoundTripper
+	source *TokenSource
+	// authorize sets the token on the request, as a bearer token by default.
+	authorize func(req *http.Request, token string)
+}
+
+// NewAuthTransport creates an AuthTransport sending the requests with next. When authorize is nil, the
+// token is sent as a bearer token in the Authorization header.
+func NewAuthTransport(next http.RoundTripper, source *TokenSource, authorize func(req *http.Request, token string)) *AuthTransport {
+	if authorize == nil {
+		authorize = func(req *http.Request, token string) {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+	return &AuthTransport{
+		next:      next,
+		source:    source,
+		authorize: authorize,
+	}
+}
+
+// RoundTrip sends the request with the current token, and with a refreshed one when it is rejected.
+func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.send(req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	// the body of the request can only be sent again when it can be rewound
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	t.source.Invalidate(token)
+	if token, err = t.source.Token(req.Context()); err != nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+	return t.send(req, token)
+}
+
+// send clones the request before setting the token, since a RoundTripper must not modify the request.
+func (t *AuthTransport) send(req *http.Request, token string) (*http.Response, error) {
+	authorized := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		authorized.Body = body
+	}
+	t.authorize(authorized, token)
+	return t.next.RoundTrip(authorized)
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package poller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenSource(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	refreshes := 0
+	source := NewTokenSource(func(context.Context) (string, time.Time, error) {
+		refreshes++
+		return fmt.Sprintf("token-%d", refreshes), now.Add(time.Hour), nil
+	}, time.Minute)
+	source.now = func() time.Time { return now }
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	// the token is cached until it is about to expire
+	now = now.Add(58 * time.Minute)
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	now = now.Add(time.Minute)
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	// invalidating a token already refreshed is a no-op
+	source.Invalidate("token-1")
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	source.Invalidate("token-2")
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token)
+}
+
+func TestTokenSourceError(t *testing.T) {
+	source := NewTokenSource(func(context.Context) (string, time.Time, error) {
+		return "", time.Time{}, fmt.Errorf("invalid credentials")
+	}, 0)
+	_, err := source.Token(context.Background())
+	assert.EqualError(t, err, "invalid credentials")
+}
+
+func TestAuthTransport(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	refreshes := 0
+	source := NewTokenSource(func(context.Context) (string, time.Time, error) {
+		refreshes++
+		return fmt.Sprintf("token-%d", refreshes), time.Time{}, nil
+	}, 0)
+	transport := &http.Transport{}
+	defer transport.CloseIdleConnections()
+	rt := NewAuthTransport(transport, source, nil)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-2"}, authorizations)
+	// the original request isn't modified
+	assert.Empty(t, req.Header.Get("Authorization"))
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package probe provides the scheduling shared by receivers that actively
+// check a list of targets on every scrape: it delays the first round with a
+// random jitter, bounds how many probes run at once and applies a per-probe
+// timeout.
+package probe // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/probe"
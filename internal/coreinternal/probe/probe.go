@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package probe // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/probe"
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Config configures how the probes of a receiver are scheduled.
+type Config struct {
+	// MaxConcurrency caps the number of probes running at the same time.
+	// Zero or unset means no limit.
+	MaxConcurrency int `mapstructure:"max_concurrency"`
+	// Jitter is the upper bound of the random delay applied before the first
+	// round, so that collectors started at the same time don't hit the targets
+	// at the same instant. Zero disables it.
+	Jitter time.Duration `mapstructure:"jitter"`
+	// ProbeTimeout bounds the duration of every probe: the context passed to the
+	// probe is cancelled once it expires. Zero means no limit besides the one
+	// of the round.
+	ProbeTimeout time.Duration `mapstructure:"probe_timeout"`
+}
+
+// Validate checks the probe scheduling configuration.
+func (cfg *Config) Validate() error {
+	var err error
+	if cfg.MaxConcurrency < 0 {
+		err = errors.Join(err, errors.New(`"max_concurrency" must not be negative`))
+	}
+	if cfg.Jitter < 0 {
+		err = errors.Join(err, errors.New(`"jitter" must not be negative`))
+	}
+	if cfg.ProbeTimeout < 0 {
+		err = errors.Join(err, errors.New(`"probe_timeout" must not be negative`))
+	}
+	return err
+}
+
+// StartDelay returns a random delay between zero and Jitter, to add to the
+// initial delay of the scraper controller before its ticker starts.
+func (cfg *Config) StartDelay() time.Duration {
+	if cfg.Jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(cfg.Jitter)))
+}
+
+// Func runs the probe of the target at the given index.
+type Func func(ctx context.Context, index int)
+
+// Runner schedules the probes of a round.
+type Runner struct {
+	cfg Config
+}
+
+// NewRunner creates a Runner for the given configuration.
+func NewRunner(cfg Config) *Runner {
+	return &Runner{cfg: cfg}
+}
+
+// Run probes the n targets and waits for all of them to complete. Probes still
+// waiting for a free slot are not started once ctx is done.
+func (r *Runner) Run(ctx context.Context, n int, probe Func) {
+	var sem chan struct{}
+	if r.cfg.MaxConcurrency > 0 {
+		sem = make(chan struct{}, r.cfg.MaxConcurrency)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func(index int) {
+			defer wg.Done()
+
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					return
+				}
+				// The slot and ctx.Done may have been ready at the same time.
+				if ctx.Err() != nil {
+					return
+				}
+			}
+
+			probeCtx := ctx
+			if r.cfg.ProbeTimeout > 0 {
+				var cancel context.CancelFunc
+				probeCtx, cancel = context.WithTimeout(ctx, r.cfg.ProbeTimeout)
+				defer cancel()
+			}
+			probe(probeCtx, index)
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package probe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, (&Config{}).Validate())
+	assert.NoError(t, (&Config{MaxConcurrency: 2, Jitter: time.Second, ProbeTimeout: time.Second}).Validate())
+	assert.EqualError(t, (&Config{MaxConcurrency: -1, Jitter: -time.Second, ProbeTimeout: -time.Second}).Validate(),
+		"\"max_concurrency\" must not be negative\n\"jitter\" must not be negative\n\"probe_timeout\" must not be negative")
+}
+
+func TestStartDelay(t *testing.T) {
+	assert.Zero(t, (&Config{}).StartDelay())
+	for i := 0; i < 10; i++ {
+		delay := (&Config{Jitter: time.Second}).StartDelay()
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.Less(t, delay, time.Second)
+	}
+}
+
+func TestRunAllProbes(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[int]bool{}
+	r := NewRunner(Config{})
+	r.Run(context.Background(), 5, func(_ context.Context, i int) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen[i] = true
+	})
+	assert.Equal(t, map[int]bool{0: true, 1: true, 2: true, 3: true, 4: true}, seen)
+}
+
+func TestRunMaxConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	r := NewRunner(Config{MaxConcurrency: 2})
+	r.Run(context.Background(), 10, func(_ context.Context, _ int) {
+		cur := running.Add(1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+	})
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+	assert.Positive(t, peak.Load())
+}
+
+func TestRunCancelledWaitingForSlot(t *testing.T) {
+	r := NewRunner(Config{MaxConcurrency: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int32
+	r.Run(ctx, 3, func(context.Context, int) {
+		calls.Add(1)
+		cancel()
+	})
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRunProbeTimeout(t *testing.T) {
+	r := NewRunner(Config{ProbeTimeout: 10 * time.Millisecond})
+	var timedOut atomic.Int32
+	r.Run(context.Background(), 2, func(ctx context.Context, _ int) {
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, 10*time.Millisecond)
+		<-ctx.Done()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			timedOut.Add(1)
+		}
+	})
+	assert.Equal(t, int32(2), timedOut.Load())
+
+	// Without a timeout, the probes get the context of the round.
+	NewRunner(Config{}).Run(context.Background(), 1, func(ctx context.Context, _ int) {
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+}
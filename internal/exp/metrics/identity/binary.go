@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package identity // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Stream{}
+	_ encoding.BinaryUnmarshaler = (*Stream)(nil)
+)
+
+var errInvalidStream = errors.New("invalid binary stream identity")
+
+// MarshalBinary encodes the stream identity, so that it can be persisted and restored
+// by UnmarshalBinary. The attributes are only encoded as their hashes.
+func (i Stream) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 4*16+len(i.scope.name)+len(i.scope.version)+len(i.name)+len(i.unit)+16)
+	buf = append(buf, i.scope.resource.attrs[:]...)
+	buf = appendString(buf, i.scope.name)
+	buf = appendString(buf, i.scope.version)
+	buf = append(buf, i.scope.attrs[:]...)
+	buf = appendString(buf, i.name)
+	buf = appendString(buf, i.unit)
+	var mono byte
+	if i.monotonic {
+		mono = 1
+	}
+	buf = append(buf, byte(i.ty), mono, byte(i.temporality))
+	buf = append(buf, i.attrs[:]...)
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a stream identity encoded by MarshalBinary.
+func (i *Stream) UnmarshalBinary(data []byte) error {
+	var id Stream
+	var ok bool
+	if data, ok = readHash(data, &id.scope.resource.attrs); !ok {
+		return errInvalidStream
+	}
+	if id.scope.name, data, ok = readString(data); !ok {
+		return errInvalidStream
+	}
+	if id.scope.version, data, ok = readString(data); !ok {
+		return errInvalidStream
+	}
+	if data, ok = readHash(data, &id.scope.attrs); !ok {
+		return errInvalidStream
+	}
+	if id.name, data, ok = readString(data); !ok {
+		return errInvalidStream
+	}
+	if id.unit, data, ok = readString(data); !ok {
+		return errInvalidStream
+	}
+	if len(data) < 3 {
+		return errInvalidStream
+	}
+	id.ty = pmetric.MetricType(data[0])
+	id.monotonic = data[1] == 1
+	id.temporality = pmetric.AggregationTemporality(data[2])
+	data = data[3:]
+	if data, ok = readHash(data, &id.attrs); !ok || len(data) != 0 {
+		return errInvalidStream
+	}
+	*i = id
+	return nil
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func readString(data []byte) (string, []byte, bool) {
+	n, size := binary.Uvarint(data)
+	if size <= 0 || uint64(len(data)-size) < n {
+		return "", nil, false
+	}
+	data = data[size:]
+	return string(data[:n]), data[n:], true
+}
+
+func readHash(data []byte, hash *[16]byte) ([]byte, bool) {
+	if len(data) < len(hash) {
+		return nil, false
+	}
+	copy(hash[:], data)
+	return data[len(hash):], true
+}
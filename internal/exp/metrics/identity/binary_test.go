@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestStreamBinary(t *testing.T) {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "checkout")
+	scope := pcommon.NewInstrumentationScope()
+	scope.SetName("otelcol/test")
+	scope.SetVersion("1.0.0")
+	m := pmetric.NewMetric()
+	m.SetName("requests")
+	m.SetUnit("{request}")
+	sum := m.SetEmptySum()
+	sum.SetIsMonotonic(true)
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.Attributes().PutStr("http.route", "/cart")
+
+	id := OfStream(OfResourceMetric(res, scope, m), dp)
+	data, err := id.MarshalBinary()
+	require.NoError(t, err)
+
+	var got Stream
+	require.NoError(t, got.UnmarshalBinary(data))
+	require.Equal(t, id, got)
+	require.Equal(t, id.Hash().Sum64(), got.Hash().Sum64())
+
+	// truncated or trailing data is rejected
+	for _, invalid := range [][]byte{nil, data[:len(data)-1], append(data, 0)} {
+		require.ErrorIs(t, got.UnmarshalBinary(invalid), errInvalidStream)
+	}
+}
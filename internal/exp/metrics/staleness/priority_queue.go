@@ -21,6 +21,8 @@ type PriorityQueue interface {
 	Pop() (identity.Stream, time.Time)
 	// Len will return the number of entries in the queue
 	Len() int
+	// Priority will return the priority of the entry, if it is in the queue
+	Priority(id identity.Stream) (time.Time, bool)
 }
 
 // heapQueue implements heap.Interface.
@@ -109,3 +111,11 @@ func (pq *heapPriorityQueue) Pop() (identity.Stream, time.Time) {
 func (pq *heapPriorityQueue) Len() int {
 	return pq.inner.Len()
 }
+
+func (pq *heapPriorityQueue) Priority(id identity.Stream) (time.Time, bool) {
+	item, ok := pq.itemLookup[id]
+	if !ok {
+		return time.Time{}, false
+	}
+	return item.prio, true
+}
@@ -51,6 +51,18 @@ func (s *Staleness[T]) Store(id identity.Stream, v T) error {
 	return s.items.Store(id, v)
 }
 
+// StoreAt stores the given key value pair in the map, with the given staleness value. It is used to
+// restore entries that were last seen before, so that they expire as if they were never removed
+func (s *Staleness[T]) StoreAt(id identity.Stream, v T, ts time.Time) error {
+	s.pq.Update(id, ts)
+	return s.items.Store(id, v)
+}
+
+// LastSeen returns the staleness value of the entry at key, the last time it was stored
+func (s *Staleness[T]) LastSeen(id identity.Stream) (time.Time, bool) {
+	return s.pq.Priority(id)
+}
+
 func (s *Staleness[T]) Delete(id identity.Stream) {
 	s.items.Delete(id)
 }
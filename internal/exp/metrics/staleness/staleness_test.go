@@ -130,3 +130,32 @@ func TestEvict(t *testing.T) {
 	require.False(t, ok)
 	require.Equal(t, 1, stale.Len())
 }
+
+func TestStoreAt(t *testing.T) {
+	now := 100
+	NowFunc = func() time.Time {
+		return time.Unix(int64(now), 0)
+	}
+
+	stale := NewStaleness(1*time.Minute, make(streams.HashMap[int]))
+	idA := generateStreamID(t, map[string]any{"aaa": "123"})
+	idB := generateStreamID(t, map[string]any{"bbb": "456"})
+
+	// idA is restored as last seen before idB was stored
+	require.NoError(t, stale.Store(idB, 1))
+	require.NoError(t, stale.StoreAt(idA, 0, time.Unix(50, 0)))
+
+	ts, ok := stale.LastSeen(idA)
+	require.True(t, ok)
+	require.Equal(t, time.Unix(50, 0), ts)
+	ts, ok = stale.LastSeen(idB)
+	require.True(t, ok)
+	require.Equal(t, time.Unix(100, 0), ts)
+
+	now = 111
+	gone, ok := stale.Evict()
+	require.True(t, ok)
+	require.Equal(t, idA, gone)
+	_, ok = stale.LastSeen(idA)
+	require.False(t, ok)
+}
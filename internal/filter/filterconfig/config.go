@@ -259,6 +259,37 @@ type MetricMatchProperties struct {
 	// ResourceAttributes defines a list of possible resource attributes to match metrics against.
 	// A match occurs if any resource attribute matches all expressions in this given list.
 	ResourceAttributes []Attribute `mapstructure:"resource_attributes"`
+
+	// Libraries specifies the list of instrumentation scopes to match metrics against.
+	// A match occurs if the metric's instrumentation scope matches at least one item in this list.
+	// When combined with MetricNames, both must match.
+	Libraries []InstrumentationLibrary `mapstructure:"libraries"`
+
+	// DataPointValue specifies the range of values to match the datapoints of sum and gauge metrics against.
+	// When set, the match is evaluated for each datapoint: a datapoint matches if its value is within
+	// the range and its metric matches MetricNames, Libraries and Expressions, if specified.
+	DataPointValue *DataPointValueMatchProperties `mapstructure:"datapoint_value"`
+}
+
+// DataPointValueMatchProperties defines an inclusive range of datapoint values to match against.
+// Histogram, exponential histogram and summary datapoints never match.
+type DataPointValueMatchProperties struct {
+	// Min is the lowest value that may be matched. If not set, the range has no lower bound.
+	Min *float64 `mapstructure:"min"`
+
+	// Max is the highest value that may be matched. If not set, the range has no upper bound.
+	Max *float64 `mapstructure:"max"`
+}
+
+// Validate checks that at least one bound is set and that the range is not empty.
+func (dp *DataPointValueMatchProperties) Validate() error {
+	if dp.Min == nil && dp.Max == nil {
+		return errors.New(`at least one of "min" or "max" must be specified for "datapoint_value"`)
+	}
+	if dp.Min != nil && dp.Max != nil && *dp.Min > *dp.Max {
+		return fmt.Errorf(`"datapoint_value" min (%v) must not be greater than max (%v)`, *dp.Min, *dp.Max)
+	}
+	return nil
 }
 
 func CreateMetricMatchPropertiesFromDefault(properties *MatchProperties) *MetricMatchProperties {
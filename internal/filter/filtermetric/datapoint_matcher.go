@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filtermetric // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filtermetric"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+)
+
+// valueMatcher matches number datapoints whose value is within an inclusive range.
+type valueMatcher struct {
+	min *float64
+	max *float64
+}
+
+func (m valueMatcher) Eval(_ context.Context, tCtx ottldatapoint.TransformContext) (bool, error) {
+	dp, ok := tCtx.GetDataPoint().(pmetric.NumberDataPoint)
+	if !ok {
+		return false, nil
+	}
+	var value float64
+	switch dp.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		value = float64(dp.IntValue())
+	case pmetric.NumberDataPointValueTypeDouble:
+		value = dp.DoubleValue()
+	default:
+		return false, nil
+	}
+	return (m.min == nil || value >= *m.min) && (m.max == nil || value <= *m.max), nil
+}
+
+// dataPointMetricMatcher evaluates a metric matcher against the metric a datapoint belongs to.
+type dataPointMetricMatcher struct {
+	matcher expr.BoolExpr[ottlmetric.TransformContext]
+}
+
+func (m dataPointMetricMatcher) Eval(ctx context.Context, tCtx ottldatapoint.TransformContext) (bool, error) {
+	return m.matcher.Eval(ctx, ottlmetric.NewTransformContext(tCtx.GetMetric(), tCtx.GetMetrics(), tCtx.GetInstrumentationScope(), tCtx.GetResource()))
+}
+
+// newDataPointExpr constructs a datapoint matcher for match properties with a datapoint value range.
+func newDataPointExpr(mp *filterconfig.MetricMatchProperties) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
+	if mp == nil || mp.DataPointValue == nil {
+		return nil, nil
+	}
+	if err := mp.DataPointValue.Validate(); err != nil {
+		return nil, err
+	}
+
+	matchers := []expr.BoolExpr[ottldatapoint.TransformContext]{
+		valueMatcher{min: mp.DataPointValue.Min, max: mp.DataPointValue.Max},
+	}
+	metricExpr, err := newMetricExpr(mp)
+	if err != nil {
+		return nil, err
+	}
+	if metricExpr != nil {
+		matchers = append(matchers, dataPointMetricMatcher{matcher: metricExpr})
+	}
+	return expr.And(matchers...), nil
+}
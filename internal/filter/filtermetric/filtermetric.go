@@ -9,6 +9,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 )
 
@@ -44,21 +45,60 @@ func NewSkipExpr(include *filterconfig.MetricMatchProperties, exclude *filtercon
 	return expr.Or(matchers...), nil
 }
 
-// NewMatcher constructs a metric Matcher. If an 'expr' match type is specified,
-// returns an expr matcher, otherwise a name matcher.
+// NewSkipDataPointExpr creates a BoolExpr that on evaluation returns true if a datapoint should NOT be processed or kept.
+// Only match properties with a datapoint value range are evaluated per datapoint, the others are
+// handled by the BoolExpr returned by NewSkipExpr. Returns nil if no datapoint value range is configured.
+func NewSkipDataPointExpr(include *filterconfig.MetricMatchProperties, exclude *filterconfig.MetricMatchProperties) (expr.BoolExpr[ottldatapoint.TransformContext], error) {
+	var matchers []expr.BoolExpr[ottldatapoint.TransformContext]
+	inclExpr, err := newDataPointExpr(include)
+	if err != nil {
+		return nil, err
+	}
+	if inclExpr != nil {
+		matchers = append(matchers, expr.Not(inclExpr))
+	}
+	exclExpr, err := newDataPointExpr(exclude)
+	if err != nil {
+		return nil, err
+	}
+	if exclExpr != nil {
+		matchers = append(matchers, exclExpr)
+	}
+	return expr.Or(matchers...), nil
+}
+
+// newExpr constructs a metric matcher for match properties evaluated per metric.
 func newExpr(mp *filterconfig.MetricMatchProperties) (expr.BoolExpr[ottlmetric.TransformContext], error) {
-	if mp == nil {
+	if mp == nil || mp.DataPointValue != nil {
 		return nil, nil
 	}
+	return newMetricExpr(mp)
+}
 
+// newMetricExpr constructs a metric matcher. If an 'expr' match type is specified,
+// returns an expr matcher, otherwise a matcher on the metric name and instrumentation scope.
+func newMetricExpr(mp *filterconfig.MetricMatchProperties) (expr.BoolExpr[ottlmetric.TransformContext], error) {
 	if mp.MatchType == filterconfig.MetricExpr {
 		if len(mp.Expressions) == 0 {
 			return nil, nil
 		}
 		return newExprMatcher(mp.Expressions)
 	}
-	if len(mp.MetricNames) == 0 {
-		return nil, nil
+
+	var matchers []expr.BoolExpr[ottlmetric.TransformContext]
+	if len(mp.MetricNames) > 0 {
+		nm, err := newNameMatcher(mp)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, nm)
+	}
+	if len(mp.Libraries) > 0 {
+		sm, err := newScopeMatcher(mp)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, sm)
 	}
-	return newNameMatcher(mp)
+	return expr.And(matchers...), nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 )
 
@@ -155,6 +156,47 @@ func Test_NewSkipExpr_With_Bridge(t *testing.T) {
 			},
 		},
 
+		// Libraries
+		{
+			name: "static library include",
+			include: &filterconfig.MetricMatchProperties{
+				MatchType: filterconfig.MetricStrict,
+				Libraries: []filterconfig.InstrumentationLibrary{{Name: "scopeA"}},
+			},
+		},
+		{
+			name: "static library with version exclude",
+			exclude: &filterconfig.MetricMatchProperties{
+				MatchType: filterconfig.MetricStrict,
+				Libraries: []filterconfig.InstrumentationLibrary{{Name: "scopeB"}, {Name: "scopeA", Version: ptr("1.0.0")}},
+			},
+		},
+		{
+			name: "regex library with mismatching version exclude",
+			exclude: &filterconfig.MetricMatchProperties{
+				MatchType: filterconfig.MetricRegexp,
+				Libraries: []filterconfig.InstrumentationLibrary{{Name: "scope.*", Version: ptr("2.*")}},
+			},
+		},
+		{
+			name: "metric name and library include",
+			include: &filterconfig.MetricMatchProperties{
+				MatchType:   filterconfig.MetricStrict,
+				MetricNames: []string{"metricA"},
+				Libraries:   []filterconfig.InstrumentationLibrary{{Name: "scopeB"}},
+			},
+		},
+
+		// Datapoint value
+		{
+			name: "datapoint value errors",
+			exclude: &filterconfig.MetricMatchProperties{
+				MatchType:      filterconfig.MetricStrict,
+				DataPointValue: &filterconfig.DataPointValueMatchProperties{Max: ptr(0.0)},
+			},
+			err: fmt.Errorf("datapoint_value configuration cannot be converted to OTTL - see https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor#configuration for OTTL configuration"),
+		},
+
 		// Expression
 		{
 			name: "expression errors",
@@ -187,13 +229,18 @@ func Test_NewSkipExpr_With_Bridge(t *testing.T) {
 			resource := pcommon.NewResource()
 
 			scope := pcommon.NewInstrumentationScope()
+			scope.SetName("scopeA")
+			scope.SetVersion("1.0.0")
 
 			tCtx := ottlmetric.NewTransformContext(metric, pmetric.NewMetricSlice(), scope, resource)
 
 			boolExpr, err := NewSkipExpr(tt.include, tt.exclude)
 			require.NoError(t, err)
-			expectedResult, err := boolExpr.Eval(context.Background(), tCtx)
-			assert.NoError(t, err)
+			expectedResult := false
+			if boolExpr != nil {
+				expectedResult, err = boolExpr.Eval(context.Background(), tCtx)
+				assert.NoError(t, err)
+			}
 
 			ottlBoolExpr, err := filterottl.NewMetricSkipExprBridge(tt.include, tt.exclude)
 
@@ -209,3 +256,140 @@ func Test_NewSkipExpr_With_Bridge(t *testing.T) {
 		})
 	}
 }
+
+func TestScopeMatcher(t *testing.T) {
+	tests := []struct {
+		name        string
+		libraries   []filterconfig.InstrumentationLibrary
+		matchType   filterconfig.MetricMatchType
+		shouldMatch bool
+	}{
+		{
+			name:        "strict name",
+			libraries:   []filterconfig.InstrumentationLibrary{{Name: "other"}, {Name: "scopeA"}},
+			matchType:   filterconfig.MetricStrict,
+			shouldMatch: true,
+		},
+		{
+			name:        "strict name mismatch",
+			libraries:   []filterconfig.InstrumentationLibrary{{Name: "scope"}},
+			matchType:   filterconfig.MetricStrict,
+			shouldMatch: false,
+		},
+		{
+			name:        "strict version mismatch",
+			libraries:   []filterconfig.InstrumentationLibrary{{Name: "scopeA", Version: ptr("")}},
+			matchType:   filterconfig.MetricStrict,
+			shouldMatch: false,
+		},
+		{
+			name:        "regexp name and version",
+			libraries:   []filterconfig.InstrumentationLibrary{{Name: "scope.*", Version: ptr(`1\..*`)}},
+			matchType:   filterconfig.MetricRegexp,
+			shouldMatch: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := newExpr(&filterconfig.MetricMatchProperties{MatchType: tt.matchType, Libraries: tt.libraries})
+			require.NoError(t, err)
+			require.NotNil(t, matcher)
+
+			scope := pcommon.NewInstrumentationScope()
+			scope.SetName("scopeA")
+			scope.SetVersion("1.2.0")
+			matches, err := matcher.Eval(context.Background(), ottlmetric.NewTransformContext(createMetric("metricA"), pmetric.NewMetricSlice(), scope, pcommon.NewResource()))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.shouldMatch, matches)
+		})
+	}
+}
+
+func TestNewSkipDataPointExpr(t *testing.T) {
+	zeroValue := &filterconfig.DataPointValueMatchProperties{Min: ptr(0.0), Max: ptr(0.0)}
+	tests := []struct {
+		name     string
+		include  *filterconfig.MetricMatchProperties
+		exclude  *filterconfig.MetricMatchProperties
+		expected []bool
+	}{
+		{
+			name: "exclude zero values",
+			exclude: &filterconfig.MetricMatchProperties{
+				MatchType:      filterconfig.MetricStrict,
+				DataPointValue: zeroValue,
+			},
+			expected: []bool{true, true, false, false, false},
+		},
+		{
+			name: "exclude zero values of another metric",
+			exclude: &filterconfig.MetricMatchProperties{
+				MatchType:      filterconfig.MetricStrict,
+				MetricNames:    []string{"metricB"},
+				DataPointValue: zeroValue,
+			},
+			expected: []bool{false, false, false, false, false},
+		},
+		{
+			name: "include values above a threshold",
+			include: &filterconfig.MetricMatchProperties{
+				MatchType:      filterconfig.MetricStrict,
+				MetricNames:    []string{"metricA"},
+				DataPointValue: &filterconfig.DataPointValueMatchProperties{Min: ptr(1.5)},
+			},
+			expected: []bool{true, true, true, false, true},
+		},
+		{
+			name: "exclude values below a threshold",
+			exclude: &filterconfig.MetricMatchProperties{
+				MatchType:      filterconfig.MetricRegexp,
+				Libraries:      []filterconfig.InstrumentationLibrary{{Name: "scope.*"}},
+				DataPointValue: &filterconfig.DataPointValueMatchProperties{Max: ptr(1.5)},
+			},
+			expected: []bool{true, true, true, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metric := createMetric("metricA")
+			dps := metric.SetEmptyGauge().DataPoints()
+			dps.AppendEmpty().SetIntValue(0)
+			dps.AppendEmpty().SetDoubleValue(0)
+			dps.AppendEmpty().SetDoubleValue(1.2)
+			dps.AppendEmpty().SetIntValue(2)
+			dps.AppendEmpty()
+			scope := pcommon.NewInstrumentationScope()
+			scope.SetName("scopeA")
+
+			skipExpr, err := NewSkipDataPointExpr(tt.include, tt.exclude)
+			require.NoError(t, err)
+			require.NotNil(t, skipExpr)
+			for i, expected := range tt.expected {
+				tCtx := ottldatapoint.NewTransformContext(dps.At(i), metric, pmetric.NewMetricSlice(), scope, pcommon.NewResource())
+				skip, err := skipExpr.Eval(context.Background(), tCtx)
+				assert.NoError(t, err)
+				assert.Equal(t, expected, skip, "datapoint %d", i)
+			}
+		})
+	}
+}
+
+func TestNewSkipDataPointExprWithoutValueRange(t *testing.T) {
+	skipExpr, err := NewSkipDataPointExpr(createConfig(strictFilters, filterset.Strict), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, skipExpr)
+}
+
+func TestNewSkipDataPointExprInvalidRange(t *testing.T) {
+	_, err := NewSkipDataPointExpr(nil, &filterconfig.MetricMatchProperties{
+		MatchType:      filterconfig.MetricStrict,
+		DataPointValue: &filterconfig.DataPointValueMatchProperties{Min: ptr(1.0), Max: ptr(0.0)},
+	})
+	assert.EqualError(t, err, `"datapoint_value" min (1) must not be greater than max (0)`)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filtermetric // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filtermetric"
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+)
+
+type scopeFilter struct {
+	name    filterset.FilterSet
+	version filterset.FilterSet
+}
+
+// scopeMatcher matches metrics by the name and version of their instrumentation scope.
+type scopeMatcher struct {
+	scopes []scopeFilter
+}
+
+func newScopeMatcher(mp *filterconfig.MetricMatchProperties) (*scopeMatcher, error) {
+	fsCfg := &filterset.Config{
+		MatchType:    filterset.MatchType(mp.MatchType),
+		RegexpConfig: mp.RegexpConfig,
+	}
+	m := &scopeMatcher{}
+	for _, library := range mp.Libraries {
+		name, err := filterset.CreateFilterSet([]string{library.Name}, fsCfg)
+		if err != nil {
+			return nil, fmt.Errorf("error creating library name filters: %w", err)
+		}
+		sf := scopeFilter{name: name}
+		if library.Version != nil {
+			sf.version, err = filterset.CreateFilterSet([]string{*library.Version}, fsCfg)
+			if err != nil {
+				return nil, fmt.Errorf("error creating library version filters: %w", err)
+			}
+		}
+		m.scopes = append(m.scopes, sf)
+	}
+	return m, nil
+}
+
+// Eval matches a metric if its instrumentation scope matches at least one of the configured libraries.
+func (m *scopeMatcher) Eval(_ context.Context, tCtx ottlmetric.TransformContext) (bool, error) {
+	scope := tCtx.GetInstrumentationScope()
+	for _, sf := range m.scopes {
+		if sf.name.Matches(scope.Name()) && (sf.version == nil || sf.version.Matches(scope.Version())) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
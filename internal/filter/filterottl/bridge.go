@@ -384,21 +384,46 @@ func createMetricStatement(mp filterconfig.MetricMatchProperties) (*string, erro
 		return nil, fmt.Errorf("expressions configuration cannot be converted to OTTL - see https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor#configuration for OTTL configuration")
 	}
 
-	if len(mp.MetricNames) == 0 {
+	if mp.DataPointValue != nil {
+		return nil, fmt.Errorf("datapoint_value configuration cannot be converted to OTTL - see https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/filterprocessor#configuration for OTTL configuration")
+	}
+
+	if len(mp.MetricNames) == 0 && len(mp.Libraries) == 0 {
 		return nil, nil
 	}
 
 	metricNameStatement := nameStaticStatement
+	scopeNameStatement := scopeNameStaticStatement
+	scopeVersionStatement := scopeVersionStaticStatement
 	if mp.MatchType == filterconfig.MetricRegexp {
 		metricNameStatement = nameRegexStatement
+		scopeNameStatement = scopeNameRegexStatement
+		scopeVersionStatement = scopeVersionRegexStatement
 	}
-	metricNameConditions := createBasicConditions(metricNameStatement, mp.MetricNames)
-	var format string
-	if len(metricNameConditions) > 1 {
-		format = "(%v)"
-	} else {
-		format = "%v"
+
+	var conditions []string
+	if len(mp.MetricNames) > 0 {
+		conditions = append(conditions, joinConditions(createBasicConditions(metricNameStatement, mp.MetricNames), " or "))
 	}
-	statement := fmt.Sprintf(format, strings.Join(metricNameConditions, " or "))
+	if len(mp.Libraries) > 0 {
+		scopeConditions := make([]string, 0, len(mp.Libraries))
+		for _, library := range mp.Libraries {
+			scopeCondition := []string{fmt.Sprintf(scopeNameStatement, library.Name)}
+			if library.Version != nil {
+				scopeCondition = append(scopeCondition, fmt.Sprintf(scopeVersionStatement, *library.Version))
+			}
+			scopeConditions = append(scopeConditions, joinConditions(scopeCondition, " and "))
+		}
+		conditions = append(conditions, joinConditions(scopeConditions, " or "))
+	}
+	statement := strings.Join(conditions, " and ")
 	return &statement, nil
 }
+
+// joinConditions joins conditions with the given operator, wrapping them in parentheses if there is more than one.
+func joinConditions(conditions []string, operator string) string {
+	if len(conditions) > 1 {
+		return fmt.Sprintf("(%v)", strings.Join(conditions, operator))
+	}
+	return strings.Join(conditions, operator)
+}
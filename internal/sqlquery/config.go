@@ -4,8 +4,12 @@
 package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -34,26 +38,60 @@ func (c Config) Validate() error {
 		if err := query.Validate(); err != nil {
 			return err
 		}
+		if query.Procedure != "" {
+			if _, err := procedureStatement(c.Driver, query.Procedure, len(query.Parameters)); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
 
 type Query struct {
-	SQL                string      `mapstructure:"sql"`
+	SQL string `mapstructure:"sql"`
+	// Procedure is the name of a stored procedure called instead of running SQL.
+	Procedure string `mapstructure:"procedure"`
+	// Parameters are passed, in order, as the arguments of the statement.
+	Parameters         []string    `mapstructure:"parameters"`
 	Metrics            []MetricCfg `mapstructure:"metrics"`
 	Logs               []LogsCfg   `mapstructure:"logs"`
 	TrackingColumn     string      `mapstructure:"tracking_column"`
 	TrackingStartValue string      `mapstructure:"tracking_start_value"`
+	// TrackingColumns is a composite tracking key, e.g. a timestamp and an id to break ties,
+	// compared column by column according to the type of each column.
+	TrackingColumns []TrackingColumn `mapstructure:"tracking_columns"`
+	// DiffKeyColumns enables the diffing of the result set: the rows are keyed by these columns and
+	// only the rows which are new or changed since the previous collection create logs.
+	DiffKeyColumns []string `mapstructure:"diff_key_columns"`
+	// ResultSets maps each result set returned by the statement, in order, to its own logs and metrics.
+	ResultSets []ResultSetCfg `mapstructure:"result_sets"`
+}
+
+// ResultSetCfg defines the logs and metrics created from the rows of a result set.
+// A result set without logs and metrics is skipped.
+type ResultSetCfg struct {
+	Metrics []MetricCfg `mapstructure:"metrics"`
+	Logs    []LogsCfg   `mapstructure:"logs"`
 }
 
 func (q Query) Validate() error {
 	var errs []error
-	if q.SQL == "" {
+	switch {
+	case q.SQL == "" && q.Procedure == "":
 		errs = append(errs, errors.New("'query.sql' cannot be empty"))
+	case q.SQL != "" && q.Procedure != "":
+		errs = append(errs, errors.New("'query.sql' and 'query.procedure' cannot be used together"))
 	}
-	if len(q.Logs) == 0 && len(q.Metrics) == 0 {
+	if !q.HasLogs() && !q.HasMetrics() {
 		errs = append(errs, errors.New("at least one of 'query.logs' and 'query.metrics' must not be empty"))
 	}
+	if len(q.ResultSets) > 0 && (len(q.Logs) > 0 || len(q.Metrics) > 0) {
+		errs = append(errs, errors.New("'query.result_sets' cannot be used together with 'query.logs' and 'query.metrics'"))
+	}
+	tracking := q.TrackingColumn != "" || q.TrackingStartValue != "" || len(q.TrackingColumns) > 0
+	if tracking && (len(q.ResultSets) > 0 || q.Procedure != "" || len(q.Parameters) > 0) {
+		errs = append(errs, errors.New("tracking is not supported together with 'query.procedure', 'query.parameters' and 'query.result_sets'"))
+	}
 	for _, logs := range q.Logs {
 		if err := logs.Validate(); err != nil {
 			errs = append(errs, err)
@@ -64,9 +102,222 @@ func (q Query) Validate() error {
 			errs = append(errs, err)
 		}
 	}
+	for i, resultSet := range q.ResultSets {
+		for _, logs := range resultSet.Logs {
+			if err := logs.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("result set %d: %w", i, err))
+			}
+		}
+		for _, metric := range resultSet.Metrics {
+			if err := metric.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("result set %d: %w", i, err))
+			}
+		}
+	}
+	if len(q.TrackingColumns) > 0 && (q.TrackingColumn != "" || q.TrackingStartValue != "") {
+		errs = append(errs, errors.New("'tracking_columns' cannot be used together with 'tracking_column' and 'tracking_start_value'"))
+	}
+	if len(q.DiffKeyColumns) > 0 {
+		if tracking || len(q.ResultSets) > 0 {
+			errs = append(errs, errors.New("'diff_key_columns' cannot be used together with tracking and 'query.result_sets'"))
+		}
+		if len(q.Logs) == 0 {
+			errs = append(errs, errors.New("'diff_key_columns' requires 'query.logs'"))
+		}
+	}
+	diffKeyColumns := map[string]bool{}
+	for _, column := range q.DiffKeyColumns {
+		if column == "" {
+			errs = append(errs, errors.New("diff key column cannot be empty"))
+		}
+		if diffKeyColumns[column] {
+			errs = append(errs, fmt.Errorf("duplicate diff key column '%s'", column))
+		}
+		diffKeyColumns[column] = true
+	}
+	trackingColumnNames := map[string]bool{}
+	for _, column := range q.TrackingColumns {
+		if trackingColumnNames[column.Name] {
+			errs = append(errs, fmt.Errorf("duplicate tracking column '%s'", column.Name))
+		}
+		trackingColumnNames[column.Name] = true
+		if err := column.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
 	return errors.Join(errs...)
 }
 
+// HasLogs returns true if logs are created from any of the result sets of the query.
+func (q Query) HasLogs() bool {
+	if len(q.Logs) > 0 {
+		return true
+	}
+	for _, resultSet := range q.ResultSets {
+		if len(resultSet.Logs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMetrics returns true if metrics are created from any of the result sets of the query.
+func (q Query) HasMetrics() bool {
+	if len(q.Metrics) > 0 {
+		return true
+	}
+	for _, resultSet := range q.ResultSets {
+		if len(resultSet.Metrics) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Statement returns the statement run by the query: the configured SQL, or the call of the stored procedure
+// in the dialect of the driver.
+func (q Query) Statement(driver string) string {
+	if q.Procedure == "" {
+		return q.SQL
+	}
+	statement, _ := procedureStatement(driver, q.Procedure, len(q.Parameters))
+	return statement
+}
+
+// Args returns the parameters of the query as arguments of the statement.
+func (q Query) Args() []any {
+	args := make([]any, len(q.Parameters))
+	for i, parameter := range q.Parameters {
+		args[i] = parameter
+	}
+	return args
+}
+
+func procedureStatement(driver string, procedure string, numParams int) (string, error) {
+	placeholders := make([]string, numParams)
+	switch driver {
+	case "sqlserver":
+		for i := range placeholders {
+			placeholders[i] = fmt.Sprintf("@p%d", i+1)
+		}
+		return strings.TrimSpace("EXEC " + procedure + " " + strings.Join(placeholders, ", ")), nil
+	case "oracle":
+		for i := range placeholders {
+			placeholders[i] = fmt.Sprintf(":%d", i+1)
+		}
+		return "BEGIN " + procedure + "(" + strings.Join(placeholders, ", ") + "); END;", nil
+	case "mysql":
+		for i := range placeholders {
+			placeholders[i] = "?"
+		}
+		return "CALL " + procedure + "(" + strings.Join(placeholders, ", ") + ")", nil
+	}
+	return "", fmt.Errorf("'query.procedure' is not supported by driver '%s'", driver)
+}
+
+// TrackingColumn is a column of a composite tracking key.
+type TrackingColumn struct {
+	Name       string             `mapstructure:"name"`
+	Type       TrackingColumnType `mapstructure:"type"`
+	StartValue string             `mapstructure:"start_value"`
+}
+
+func (c TrackingColumn) Validate() error {
+	if c.Name == "" {
+		return errors.New("tracking column 'name' cannot be empty")
+	}
+	if err := c.Type.Validate(); err != nil {
+		return fmt.Errorf("tracking column '%s': %w", c.Name, err)
+	}
+	if c.StartValue != "" {
+		if _, err := c.Type.Parse(c.StartValue); err != nil {
+			return fmt.Errorf("tracking column '%s' has an invalid 'start_value': %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
+type TrackingColumnType string
+
+const (
+	TrackingColumnTypeUnspecified TrackingColumnType = ""
+	TrackingColumnTypeString      TrackingColumnType = "string"
+	TrackingColumnTypeInt         TrackingColumnType = "int"
+	TrackingColumnTypeDouble      TrackingColumnType = "double"
+	TrackingColumnTypeTimestamp   TrackingColumnType = "timestamp"
+)
+
+func (t TrackingColumnType) Validate() error {
+	switch t {
+	case TrackingColumnTypeUnspecified, TrackingColumnTypeString, TrackingColumnTypeInt, TrackingColumnTypeDouble, TrackingColumnTypeTimestamp:
+		return nil
+	}
+	return fmt.Errorf("unsupported type: '%s'", t)
+}
+
+// Parse converts a value of the column, as returned in a StringMap, to the Go type used as a query
+// argument and for comparisons. Empty values parse to the zero value of the type.
+func (t TrackingColumnType) Parse(value string) (any, error) {
+	switch t {
+	case TrackingColumnTypeInt:
+		if value == "" {
+			return int64(0), nil
+		}
+		return strconv.ParseInt(value, 10, 64)
+	case TrackingColumnTypeDouble:
+		if value == "" {
+			return float64(0), nil
+		}
+		return strconv.ParseFloat(value, 64)
+	case TrackingColumnTypeTimestamp:
+		if value == "" {
+			return time.Time{}, nil
+		}
+		return parseTimestamp(value)
+	}
+	return value, nil
+}
+
+// timestampLayouts are the layouts of the timestamps returned by the database drivers: RFC3339 for
+// the drivers scanning to time.Time, and the layouts of the drivers returning timestamps as text,
+// e.g. MySQL without parseTime. Fractional seconds are accepted with all of them.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	time.DateTime,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05-07",
+}
+
+// parseTimestamp parses a timestamp in one of the timestampLayouts. Timestamps without a time zone
+// are in UTC.
+func parseTimestamp(value string) (time.Time, error) {
+	t, err := time.Parse(timestampLayouts[0], value)
+	if err == nil {
+		return t, nil
+	}
+	for _, layout := range timestampLayouts[1:] {
+		if t, layoutErr := time.Parse(layout, value); layoutErr == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b,
+// both being values returned by Parse.
+func (t TrackingColumnType) Compare(a, b any) int {
+	switch t {
+	case TrackingColumnTypeInt:
+		return cmp.Compare(a.(int64), b.(int64))
+	case TrackingColumnTypeDouble:
+		return cmp.Compare(a.(float64), b.(float64))
+	case TrackingColumnTypeTimestamp:
+		return a.(time.Time).Compare(b.(time.Time))
+	}
+	return cmp.Compare(a.(string), b.(string))
+}
+
 type LogsCfg struct {
 	BodyColumn string `mapstructure:"body_column"`
 }
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlquery // import "github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Statement(t *testing.T) {
+	tests := []struct {
+		driver   string
+		params   []string
+		expected string
+	}{
+		{driver: "sqlserver", expected: "EXEC dbo.stats"},
+		{driver: "sqlserver", params: []string{"a", "b"}, expected: "EXEC dbo.stats @p1, @p2"},
+		{driver: "oracle", params: []string{"a", "b"}, expected: "BEGIN dbo.stats(:1, :2); END;"},
+		{driver: "mysql", params: []string{"a"}, expected: "CALL dbo.stats(?)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			query := Query{Procedure: "dbo.stats", Parameters: tt.params}
+			assert.Equal(t, tt.expected, query.Statement(tt.driver))
+		})
+	}
+	assert.Equal(t, "select 1", Query{SQL: "select 1"}.Statement("sqlserver"))
+}
+
+func TestConfig_Validate_Procedure(t *testing.T) {
+	metrics := []MetricCfg{{MetricName: "my.metric", ValueColumn: "value", ValueType: MetricValueTypeInt, DataType: MetricTypeGauge}}
+	tests := []struct {
+		name  string
+		query Query
+		err   string
+	}{
+		{
+			name:  "valid",
+			query: Query{Procedure: "dbo.stats", ResultSets: []ResultSetCfg{{}, {Metrics: metrics}}},
+		},
+		{
+			name:  "sql and procedure",
+			query: Query{SQL: "select 1", Procedure: "dbo.stats", Metrics: metrics},
+			err:   "'query.sql' and 'query.procedure' cannot be used together",
+		},
+		{
+			name:  "result sets and metrics",
+			query: Query{Procedure: "dbo.stats", Metrics: metrics, ResultSets: []ResultSetCfg{{Metrics: metrics}}},
+			err:   "'query.result_sets' cannot be used together with 'query.logs' and 'query.metrics'",
+		},
+		{
+			name:  "empty result sets",
+			query: Query{Procedure: "dbo.stats", ResultSets: []ResultSetCfg{{}}},
+			err:   "at least one of 'query.logs' and 'query.metrics' must not be empty",
+		},
+		{
+			name:  "tracking",
+			query: Query{Procedure: "dbo.stats", TrackingColumn: "id", Logs: []LogsCfg{{BodyColumn: "body"}}},
+			err:   "tracking is not supported together with 'query.procedure', 'query.parameters' and 'query.result_sets'",
+		},
+		{
+			name:  "invalid result set",
+			query: Query{Procedure: "dbo.stats", ResultSets: []ResultSetCfg{{Logs: []LogsCfg{{}}}}},
+			err:   "result set 0: 'body_column' must not be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Driver: "sqlserver", DataSource: "sqlserver://localhost", Queries: []Query{tt.query}}
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+
+	cfg := Config{Driver: "postgres", DataSource: "postgres://localhost", Queries: []Query{{Procedure: "stats", Metrics: metrics}}}
+	assert.EqualError(t, cfg.Validate(), "'query.procedure' is not supported by driver 'postgres'")
+}
+
+func TestConfig_Validate_DiffKeyColumns(t *testing.T) {
+	logs := []LogsCfg{{BodyColumn: "body"}}
+	tests := []struct {
+		name  string
+		query Query
+		err   string
+	}{
+		{
+			name:  "valid",
+			query: Query{SQL: "select * from audit", DiffKeyColumns: []string{"id", "region"}, Logs: logs},
+		},
+		{
+			name:  "tracking",
+			query: Query{SQL: "select * from audit", DiffKeyColumns: []string{"id"}, TrackingColumn: "id", Logs: logs},
+			err:   "'diff_key_columns' cannot be used together with tracking and 'query.result_sets'",
+		},
+		{
+			name: "metrics only",
+			query: Query{SQL: "select * from audit", DiffKeyColumns: []string{"id"},
+				Metrics: []MetricCfg{{MetricName: "my.metric", ValueColumn: "value"}}},
+			err: "'diff_key_columns' requires 'query.logs'",
+		},
+		{
+			name:  "duplicate column",
+			query: Query{SQL: "select * from audit", DiffKeyColumns: []string{"id", "id"}, Logs: logs},
+			err:   "duplicate diff key column 'id'",
+		},
+		{
+			name:  "empty column",
+			query: Query{SQL: "select * from audit", DiffKeyColumns: []string{""}, Logs: logs},
+			err:   "diff key column cannot be empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Driver: "postgres", DataSource: "postgres://localhost", Queries: []Query{tt.query}}
+			err := cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestTrackingColumnType_ParseTimestamp(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{value: "", expected: time.Time{}},
+		{value: "2024-01-02T03:04:05Z", expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{value: "2024-01-02T03:04:05.25Z", expected: time.Date(2024, 1, 2, 3, 4, 5, 250000000, time.UTC)},
+		{value: "2024-01-02T05:04:05+02:00", expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{value: "2024-01-02 03:04:05", expected: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{value: "2024-01-02 03:04:05.123456", expected: time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)},
+		{value: "2024-01-02T03:04:05.5", expected: time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC)},
+		{value: "2024-01-02 05:04:05.5+02", expected: time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, err := TrackingColumnTypeTimestamp.Parse(tt.value)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(value.(time.Time)), "expected %s, got %s", tt.expected, value)
+		})
+	}
+
+	_, err := TrackingColumnTypeTimestamp.Parse("yesterday")
+	assert.ErrorContains(t, err, `cannot parse "yesterday"`)
+}
@@ -22,6 +22,8 @@ type StringMap map[string]string
 
 type DbClient interface {
 	QueryRows(ctx context.Context, args ...any) ([]StringMap, error)
+	// QueryResultSets returns the rows of every result set returned by the statement, e.g. a stored procedure.
+	QueryResultSets(ctx context.Context, args ...any) ([][]StringMap, error)
 }
 
 type DbSQLClient struct {
@@ -46,17 +48,47 @@ func (cl DbSQLClient) QueryRows(ctx context.Context, args ...any) ([]StringMap,
 	if err != nil {
 		return nil, err
 	}
+	out, warnings, err := scanResultSet(sqlRows)
+	if err != nil {
+		return nil, err
+	}
+	return out, errors.Join(warnings...)
+}
+
+func (cl DbSQLClient) QueryResultSets(ctx context.Context, args ...any) ([][]StringMap, error) {
+	cl.Logger.Debug("Running query", cl.prepareQueryFields(cl.SQL, args)...)
+	sqlRows, err := cl.Db.QueryContext(ctx, cl.SQL, args...)
+	if err != nil {
+		return nil, err
+	}
+	var out [][]StringMap
+	var warnings []error
+	for {
+		resultSet, resultSetWarnings, err := scanResultSet(sqlRows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resultSet)
+		warnings = append(warnings, resultSetWarnings...)
+		if !sqlRows.NextResultSet() {
+			break
+		}
+	}
+	return out, errors.Join(warnings...)
+}
+
+func scanResultSet(sqlRows rows) ([]StringMap, []error, error) {
 	var out []StringMap
 	colTypes, err := sqlRows.ColumnTypes()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	scanner := newRowScanner(colTypes)
 	var warnings []error
 	for sqlRows.Next() {
 		err = scanner.scan(sqlRows)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		sm, scanErr := scanner.toStringMap()
 		if scanErr != nil {
@@ -64,7 +96,7 @@ func (cl DbSQLClient) QueryRows(ctx context.Context, args ...any) ([]StringMap,
 		}
 		out = append(out, sm)
 	}
-	return out, errors.Join(warnings...)
+	return out, warnings, nil
 }
 
 func (cl DbSQLClient) prepareQueryFields(sql string, args []any) []zap.Field {
@@ -81,9 +113,14 @@ type FakeDBClient struct {
 	RequestCounter int
 	StringMaps     [][]StringMap
 	Err            error
+	// ResultSets are returned by QueryResultSets, one element per call.
+	ResultSets [][][]StringMap
+	// Args records the arguments passed to each QueryRows and QueryResultSets call.
+	Args [][]any
 }
 
-func (c *FakeDBClient) QueryRows(context.Context, ...any) ([]StringMap, error) {
+func (c *FakeDBClient) QueryRows(_ context.Context, args ...any) ([]StringMap, error) {
+	c.Args = append(c.Args, args)
 	if c.Err != nil {
 		return nil, c.Err
 	}
@@ -91,3 +128,13 @@ func (c *FakeDBClient) QueryRows(context.Context, ...any) ([]StringMap, error) {
 	c.RequestCounter++
 	return c.StringMaps[idx], nil
 }
+
+func (c *FakeDBClient) QueryResultSets(_ context.Context, args ...any) ([][]StringMap, error) {
+	c.Args = append(c.Args, args)
+	if c.Err != nil {
+		return nil, c.Err
+	}
+	idx := c.RequestCounter
+	c.RequestCounter++
+	return c.ResultSets[idx], nil
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,23 @@ func TestDBSQLClient_SingleRow(t *testing.T) {
 	}, rows[0])
 }
 
+func TestDBSQLClient_Timestamps(t *testing.T) {
+	cl := DbSQLClient{
+		Db: fakeDB{rowVals: [][]any{{
+			time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			time.Date(2024, 1, 2, 3, 4, 5, 250000000, time.UTC),
+		}}},
+		Logger: zap.NewNop(),
+		SQL:    "",
+	}
+	rows, err := cl.QueryRows(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, map[string]string{
+		"col_0": "2024-01-02T03:04:05Z",
+		"col_1": "2024-01-02T03:04:05.25Z",
+	}, rows[0])
+}
+
 func TestDBSQLClient_MultiRow(t *testing.T) {
 	cl := DbSQLClient{
 		Db: fakeDB{rowVals: [][]any{
@@ -108,40 +126,78 @@ func TestDBSQLClient_Nulls_MultiRow(t *testing.T) {
 	}, rows[1])
 }
 
+func TestDBSQLClient_ResultSets(t *testing.T) {
+	cl := DbSQLClient{
+		Db: fakeDB{resultSets: [][][]any{
+			{{42, "hello"}, {43, "goodbye"}},
+			{},
+			{{44, nil}},
+		}},
+		Logger: zap.NewNop(),
+		SQL:    "",
+	}
+	resultSets, err := cl.QueryResultSets(context.Background())
+	assert.True(t, errors.Is(err, ErrNullValueWarning))
+	assert.Equal(t, [][]StringMap{
+		{{"col_0": "42", "col_1": "hello"}, {"col_0": "43", "col_1": "goodbye"}},
+		nil,
+		{{"col_0": "44"}},
+	}, resultSets)
+}
+
 type fakeDB struct {
 	rowVals [][]any
+	// resultSets, when set, replaces rowVals with multiple result sets.
+	resultSets [][][]any
 }
 
 func (db fakeDB) QueryContext(context.Context, string, ...any) (rows, error) {
-	return &fakeRows{vals: db.rowVals}, nil
+	if db.resultSets != nil {
+		return &fakeRows{sets: db.resultSets}, nil
+	}
+	return &fakeRows{sets: [][][]any{db.rowVals}}, nil
 }
 
 type fakeRows struct {
-	vals [][]any
+	sets [][][]any
+	set  int
 	row  int
 }
 
 func (r *fakeRows) ColumnTypes() ([]colType, error) {
 	var out []colType
-	for i := 0; i < len(r.vals[0]); i++ {
+	vals := r.sets[r.set]
+	if len(vals) == 0 {
+		return out, nil
+	}
+	for i := 0; i < len(vals[0]); i++ {
 		out = append(out, fakeCol{fmt.Sprintf("col_%d", i)})
 	}
 	return out, nil
 }
 
 func (r *fakeRows) Next() bool {
-	return r.row < len(r.vals)
+	return r.row < len(r.sets[r.set])
 }
 
 func (r *fakeRows) Scan(dest ...any) error {
 	for i := range dest {
 		ptr := dest[i].(*any)
-		*ptr = r.vals[r.row][i]
+		*ptr = r.sets[r.set][r.row][i]
 	}
 	r.row++
 	return nil
 }
 
+func (r *fakeRows) NextResultSet() bool {
+	if r.set+1 >= len(r.sets) {
+		return false
+	}
+	r.set++
+	r.row = 0
+	return true
+}
+
 type fakeCol struct {
 	name string
 }
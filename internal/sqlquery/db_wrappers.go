@@ -18,6 +18,7 @@ type rows interface {
 	ColumnTypes() ([]colType, error)
 	Next() bool
 	Scan(dest ...any) error
+	NextResultSet() bool
 }
 
 type colType interface {
@@ -57,6 +58,10 @@ func (r rowsWrapper) Scan(dest ...any) error {
 	return r.rows.Scan(dest...)
 }
 
+func (r rowsWrapper) NextResultSet() bool {
+	return r.rows.NextResultSet()
+}
+
 type colWrapper struct {
 	ct *sql.ColumnType
 }
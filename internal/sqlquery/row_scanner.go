@@ -32,7 +32,9 @@ func newRowScanner(colTypes []colType) *rowScanner {
 			}
 			format := "%v"
 			if t, isTime := v.(time.Time); isTime {
-				return t.Format(time.RFC3339), nil
+				// The fractional seconds are kept, so that timestamp tracking columns don't return the
+				// last row of the previous query again. Whole seconds are formatted as with RFC3339.
+				return t.Format(time.RFC3339Nano), nil
 			}
 			if reflect.TypeOf(v).Kind() == reflect.Slice {
 				// The Postgres driver returns a []uint8 (ascii string) for decimal and numeric types,
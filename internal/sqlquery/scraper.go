@@ -69,7 +69,15 @@ func (s *Scraper) Start(context.Context, component.Host) error {
 
 func (s *Scraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
 	out := pmetric.NewMetrics()
-	rows, err := s.Client.QueryRows(ctx)
+	var resultSets [][]StringMap
+	var err error
+	if len(s.Query.ResultSets) > 0 {
+		resultSets, err = s.Client.QueryResultSets(ctx, s.Query.Args()...)
+	} else {
+		var rows []StringMap
+		rows, err = s.Client.QueryRows(ctx, s.Query.Args()...)
+		resultSets = [][]StringMap{rows}
+	}
 	if err != nil {
 		if errors.Is(err, ErrNullValueWarning) {
 			s.Logger.Warn("problems encountered getting metric rows", zap.Error(err))
@@ -84,12 +92,19 @@ func (s *Scraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
 	sm := sms.AppendEmpty()
 	ms := sm.Metrics()
 	var errs []error
-	for _, metricCfg := range s.Query.Metrics {
-		for i, row := range rows {
-			if err = rowToMetric(row, metricCfg, ms.AppendEmpty(), s.StartTime, ts, s.ScrapeCfg); err != nil {
-				err = fmt.Errorf("row %d: %w", i, err)
-				errs = append(errs, err)
-			}
+	if len(s.Query.ResultSets) == 0 {
+		errs = s.appendMetrics(ms, s.Query.Metrics, resultSets[0], ts)
+	}
+	for i, resultSet := range s.Query.ResultSets {
+		if len(resultSet.Metrics) == 0 {
+			continue
+		}
+		if i >= len(resultSets) {
+			errs = append(errs, fmt.Errorf("result set %d was not returned by the query", i))
+			continue
+		}
+		for _, err = range s.appendMetrics(ms, resultSet.Metrics, resultSets[i], ts) {
+			errs = append(errs, fmt.Errorf("result set %d: %w", i, err))
 		}
 	}
 	if errs != nil {
@@ -98,6 +113,19 @@ func (s *Scraper) Scrape(ctx context.Context) (pmetric.Metrics, error) {
 	return out, nil
 }
 
+func (s *Scraper) appendMetrics(ms pmetric.MetricSlice, metricCfgs []MetricCfg, rows []StringMap, ts pcommon.Timestamp) []error {
+	var errs []error
+	for _, metricCfg := range metricCfgs {
+		for i, row := range rows {
+			if err := rowToMetric(row, metricCfg, ms.AppendEmpty(), s.StartTime, ts, s.ScrapeCfg); err != nil {
+				err = fmt.Errorf("row %d: %w", i, err)
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
 func (s *Scraper) Shutdown(_ context.Context) error {
 	if s.Db != nil {
 		return s.Db.Close()
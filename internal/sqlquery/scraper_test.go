@@ -480,3 +480,38 @@ func TestScraper_StartAndTS_ErrorOnParse(t *testing.T) {
 	_, err := scrpr.Scrape(context.Background())
 	assert.Error(t, err)
 }
+
+func TestScraper_ResultSets(t *testing.T) {
+	client := &FakeDBClient{
+		ResultSets: [][][]StringMap{{
+			{{"count": "42"}},
+			{{"ignored": "1"}},
+			{{"size": "1.5", "db": "a"}, {"size": "2.5", "db": "b"}},
+		}},
+	}
+	scrpr := Scraper{
+		Client: client,
+		Query: Query{
+			Procedure:  "dbo.monitoring",
+			Parameters: []string{"prod"},
+			ResultSets: []ResultSetCfg{
+				{Metrics: []MetricCfg{{MetricName: "my.count", ValueColumn: "count", ValueType: MetricValueTypeInt, DataType: MetricTypeGauge}}},
+				{},
+				{Metrics: []MetricCfg{{MetricName: "my.size", ValueColumn: "size", AttributeColumns: []string{"db"}, ValueType: MetricValueTypeDouble, DataType: MetricTypeGauge}}},
+				{Metrics: []MetricCfg{{MetricName: "my.missing", ValueColumn: "missing", ValueType: MetricValueTypeInt, DataType: MetricTypeGauge}}},
+			},
+		},
+	}
+	metrics, err := scrpr.Scrape(context.Background())
+	assert.EqualError(t, err, "result set 3 was not returned by the query")
+	assert.Equal(t, [][]any{{"prod"}}, client.Args)
+
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+	assert.Equal(t, "my.count", ms.At(0).Name())
+	assert.EqualValues(t, 42, ms.At(0).Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, "my.size", ms.At(1).Name())
+	assert.EqualValues(t, 1.5, ms.At(1).Gauge().DataPoints().At(0).DoubleValue())
+	db, _ := ms.At(2).Gauge().DataPoints().At(0).Attributes().Get("db")
+	assert.Equal(t, "b", db.Str())
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
@@ -1966,6 +1967,77 @@ func Test_parseCondition(t *testing.T) {
 	}
 }
 
+// This test doesn't validate parser results, simply checks whether the parse succeeds or not.
+func Test_parseValueExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    bool
+	}{
+		{`nil`, false},
+		{`1`, false},
+		{`1 + 2 * 3`, false},
+		{`"foo"`, false},
+		{`true`, false},
+		{`0x0102`, false},
+		{`["foo", 1]`, false},
+		{`name`, false},
+		{`foo.attributes["tenant"]`, false},
+		{`Concat([name, "suffix"], "/")`, false},
+		{`Int(attributes["count"]) * 2`, false},
+		{`name == "fido"`, true},
+		{`set(name, "fido")`, true},
+		{`name where name == "fido"`, true},
+		{`"foo`, true},
+		{`(`, true},
+		{``, true},
+	}
+	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
+	for _, tt := range tests {
+		name := pat.ReplaceAllString(tt.expression, "_")
+		t.Run(name, func(t *testing.T) {
+			ast, err := parseValueExpression(tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseValueExpression(%s) error = %v, wantErr %v", tt.expression, err, tt.wantErr)
+				t.Errorf("AST: %+v", ast)
+				return
+			}
+		})
+	}
+}
+
+func Test_ParseValueExpression_Eval(t *testing.T) {
+	p, _ := NewParser(
+		CreateFactoryMap[any](),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+		WithEnumParser[any](testParseEnum),
+	)
+
+	tests := []struct {
+		expression string
+		tCtx       any
+		expected   any
+	}{
+		{`"foo"`, nil, "foo"},
+		{`1 + 2 * 3`, nil, int64(7)},
+		{`name`, "fido", "fido"},
+		{`nil`, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expression, err := p.ParseValueExpression(tt.expression)
+			require.NoError(t, err)
+
+			result, err := expression.Eval(context.Background(), tt.tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	_, err := p.ParseValueExpression(`unknown`)
+	assert.Error(t, err)
+}
+
 func Test_Statement_Execute(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -85,6 +85,7 @@ type Config struct {
 	FlushPeriod        time.Duration   `mapstructure:"force_flush_period,omitempty"`
 	Header             *HeaderConfig   `mapstructure:"header,omitempty"`
 	DeleteAfterRead    bool            `mapstructure:"delete_after_read,omitempty"`
+	DrainTimeout       time.Duration   `mapstructure:"drain_timeout,omitempty"`
 }
 
 type HeaderConfig struct {
@@ -200,6 +201,7 @@ func (c Config) Build(set component.TelemetrySettings, emit emit.Callback, opts
 		pollInterval:  c.PollInterval,
 		maxBatchFiles: c.MaxConcurrentFiles / 2,
 		maxBatches:    c.MaxBatches,
+		drainTimeout:  c.DrainTimeout,
 		tracker:       t,
 		openFiles:     openFiles,
 		readingFiles:  readingFiles,
@@ -227,6 +229,10 @@ func (c Config) validate() error {
 		return errors.New("'max_batches' must not be negative")
 	}
 
+	if c.DrainTimeout < 0 {
+		return errors.New("'drain_timeout' must not be negative")
+	}
+
 	enc, err := decode.LookupEncoding(c.Encoding)
 	if err != nil {
 		return err
@@ -402,6 +402,14 @@ func TestUnmarshal(t *testing.T) {
 					return newMockOperatorConfig(cfg)
 				}(),
 			},
+			{
+				Name: "drain_timeout_5s",
+				Expect: func() *mockOperatorConfig {
+					cfg := NewConfig()
+					cfg.DrainTimeout = 5 * time.Second
+					return newMockOperatorConfig(cfg)
+				}(),
+			},
 			{
 				Name: "header_config",
 				Expect: func() *mockOperatorConfig {
@@ -564,6 +572,24 @@ func TestBuild(t *testing.T) {
 				require.Equal(t, 6, m.maxBatches)
 			},
 		},
+		{
+			"InvalidDrainTimeout",
+			func(cfg *Config) {
+				cfg.DrainTimeout = -time.Second
+			},
+			require.Error,
+			nil,
+		},
+		{
+			"ValidDrainTimeout",
+			func(cfg *Config) {
+				cfg.DrainTimeout = 5 * time.Second
+			},
+			require.NoError,
+			func(t *testing.T, m *Manager) {
+				require.Equal(t, 5*time.Second, m.drainTimeout)
+			},
+		},
 		{
 			"HeaderConfigNoFlag",
 			func(cfg *Config) {
@@ -38,6 +38,7 @@ type Manager struct {
 	persister     operator.Persister
 	maxBatches    int
 	maxBatchFiles int
+	drainTimeout  time.Duration
 
 	openFiles    metric.Int64UpDownCounter
 	readingFiles metric.Int64UpDownCounter
@@ -77,6 +78,9 @@ func (m *Manager) Stop() error {
 		m.cancel = nil
 	}
 	m.wg.Wait()
+	if m.drainTimeout > 0 {
+		m.drain()
+	}
 	m.openFiles.Add(context.TODO(), int64(0-m.tracker.ClosePreviousFiles()))
 	if m.persister != nil {
 		if err := checkpoint.Save(context.Background(), m.persister, m.tracker.GetMetadata()); err != nil {
@@ -86,6 +90,29 @@ func (m *Manager) Stop() error {
 	return nil
 }
 
+// drain reads the open files up to their current end, including their last partial log, so that the
+// data buffered until the flush period elapses isn't left behind. Reading stops when the drain timeout
+// elapses, and the offsets are saved afterwards either way.
+func (m *Manager) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.drainTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, r := range m.tracker.PreviousPollFiles() {
+		wg.Add(1)
+		go func(r *reader.Reader) {
+			defer wg.Done()
+			m.readingFiles.Add(ctx, 1)
+			r.Drain(ctx)
+			m.readingFiles.Add(ctx, -1)
+		}(r)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		m.set.Logger.Warn("Drain timeout elapsed before all files were read to the end", zap.Duration("drain_timeout", m.drainTimeout))
+	}
+}
+
 // startPoller kicks off a goroutine that will poll the filesystem periodically,
 // checking if there are new files or new logs in the watched files
 func (m *Manager) startPoller(ctx context.Context) {
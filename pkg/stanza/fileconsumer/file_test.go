@@ -1468,3 +1468,97 @@ func TestNoTracking(t *testing.T) {
 		})
 	}
 }
+
+func TestDrainOnStop(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FlushPeriod = time.Hour
+	cfg.DrainTimeout = 10 * time.Second
+	persister := testutil.NewUnscopedMockPersister()
+	operator, sink := testManager(t, cfg)
+	operator.persister = persister
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\ntestlog2")
+	operator.poll(context.Background())
+	sink.ExpectToken(t, []byte("testlog1"))
+	// The partial log is buffered until the flush period elapses.
+	sink.ExpectNoCallsUntil(t, 100*time.Millisecond)
+
+	filetest.WriteString(t, temp, "\ntestlog3\ntestlog4")
+	require.NoError(t, operator.Stop())
+	sink.ExpectTokens(t, []byte("testlog2"), []byte("testlog3"), []byte("testlog4"))
+
+	// The offsets are saved once the files are drained.
+	operator, sink = testManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	sink.ExpectNoCallsUntil(t, 500*time.Millisecond)
+}
+
+func TestNoDrainOnStop(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.FlushPeriod = time.Hour
+	persister := testutil.NewUnscopedMockPersister()
+	operator, sink := testManager(t, cfg)
+	operator.persister = persister
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\ntestlog2")
+	operator.poll(context.Background())
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	filetest.WriteString(t, temp, "\ntestlog3\ntestlog4")
+	require.NoError(t, operator.Stop())
+	sink.ExpectNoCalls(t)
+
+	// The logs written after the last poll are read after the restart, except the partial one.
+	operator, sink = testManager(t, cfg)
+	require.NoError(t, operator.Start(persister))
+	defer func() {
+		require.NoError(t, operator.Stop())
+	}()
+	sink.ExpectTokens(t, []byte("testlog2"), []byte("testlog3"))
+	sink.ExpectNoCallsUntil(t, 500*time.Millisecond)
+}
+
+func TestDrainTimeout(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	cfg := NewConfig().includeDir(tempDir)
+	cfg.StartAt = "beginning"
+	cfg.DrainTimeout = 100 * time.Millisecond
+	// The sink doesn't accept any more logs than the first one, so the drain can't complete.
+	operator, sink := testManager(t, cfg)
+	emitted := 0
+	callback := sink.Callback
+	operator.readerFactory.EmitFunc = func(ctx context.Context, token []byte, attrs map[string]any) error {
+		if emitted > 0 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		emitted++
+		return callback(ctx, token, attrs)
+	}
+
+	temp := filetest.OpenTemp(t, tempDir)
+	filetest.WriteString(t, temp, "testlog1\n")
+	operator.poll(context.Background())
+	sink.ExpectToken(t, []byte("testlog1"))
+
+	filetest.WriteString(t, temp, "testlog2\ntestlog3\n")
+	start := time.Now()
+	require.NoError(t, operator.Stop())
+	assert.Less(t, time.Since(start), 5*time.Second)
+	sink.ExpectNoCalls(t)
+}
@@ -97,7 +97,11 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 	}
 
 	flushFunc := m.FlushState.Func(f.SplitFunc, f.FlushTimeout)
-	r.lineSplitFunc = trim.WithFunc(trim.ToLength(flushFunc, f.MaxLogSize), f.TrimFunc)
+	r.lineSplitFunc = f.lineSplitFunc(flushFunc)
+	r.drainSplitFunc = r.lineSplitFunc
+	if m.FlushState != nil && f.FlushTimeout > 0 {
+		r.drainSplitFunc = f.lineSplitFunc(flushAtEOF(f.SplitFunc))
+	}
 	r.emitFunc = f.EmitFunc
 	if f.HeaderConfig == nil || m.HeaderFinalized {
 		r.splitFunc = r.lineSplitFunc
@@ -121,3 +125,18 @@ func (f *Factory) NewReaderFromMetadata(file *os.File, m *Metadata) (r *Reader,
 	}
 	return r, nil
 }
+
+func (f *Factory) lineSplitFunc(splitFunc bufio.SplitFunc) bufio.SplitFunc {
+	return trim.WithFunc(trim.ToLength(splitFunc, f.MaxLogSize), f.TrimFunc)
+}
+
+// flushAtEOF wraps a bufio.SplitFunc to return the remaining data as a token at the end of the file.
+func flushAtEOF(splitFunc bufio.SplitFunc) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		advance, token, err := splitFunc(data, atEOF)
+		if err == nil && token == nil && atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return advance, token, err
+	}
+}
@@ -38,6 +38,7 @@ type Reader struct {
 	initialBufferSize      int
 	maxLogSize             int
 	lineSplitFunc          bufio.SplitFunc
+	drainSplitFunc         bufio.SplitFunc
 	splitFunc              bufio.SplitFunc
 	decoder                *decode.Decoder
 	headerReader           *header.Reader
@@ -121,6 +122,16 @@ func (r *Reader) ReadToEnd(ctx context.Context) {
 	}
 }
 
+// Drain will read until the end of the file, including the last partial log, which is emitted
+// without waiting for the flush period to elapse. The reader must not be read again afterwards.
+func (r *Reader) Drain(ctx context.Context) {
+	r.lineSplitFunc = r.drainSplitFunc
+	if r.headerReader == nil {
+		r.splitFunc = r.lineSplitFunc
+	}
+	r.ReadToEnd(ctx)
+}
+
 // Delete will close and delete the file
 func (r *Reader) delete() {
 	r.close()
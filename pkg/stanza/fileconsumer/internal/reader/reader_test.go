@@ -212,3 +212,41 @@ func TestFlushPeriodEOF(t *testing.T) {
 	r.ReadToEnd(context.Background())
 	sink.ExpectTokens(t, content[0:aContentLength], []byte{'b'})
 }
+
+func TestDrain(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		flushPeriod time.Duration
+		expected    [][]byte
+	}{
+		{
+			name:        "flush_period",
+			flushPeriod: time.Hour,
+			expected:    [][]byte{[]byte("testlog1"), []byte("testlog2")},
+		},
+		{
+			// Partial logs are never flushed when the flush period is disabled.
+			name:     "no_flush_period",
+			expected: [][]byte{[]byte("testlog1")},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			temp := filetest.OpenTemp(t, t.TempDir())
+			filetest.WriteString(t, temp, "testlog1\n  testlog2  ")
+
+			f, sink := testFactory(t, withFlushPeriod(tc.flushPeriod))
+			fp, err := f.NewFingerprint(temp)
+			require.NoError(t, err)
+			r, err := f.NewReader(temp, fp)
+			require.NoError(t, err)
+			defer r.Close()
+
+			r.Drain(context.Background())
+			sink.ExpectTokens(t, tc.expected...)
+			sink.ExpectNoCalls(t)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package finder // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/fileconsumer/matcher/internal/finder"
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+)
+
+// mtimeGranularity is the coarsest modification time resolution of the supported file systems (FAT uses 2 seconds).
+// A directory modified less than mtimeGranularity before it was listed may be modified again without its
+// modification time changing, so its listing cannot be trusted to be up to date.
+const mtimeGranularity = 2 * time.Second
+
+// dirListing is the cached listing of a directory.
+type dirListing struct {
+	modTime  time.Time
+	listedAt time.Time
+	entries  []fs.DirEntry
+	gen      uint64
+}
+
+// upToDate returns true if the directory wasn't modified since it was listed.
+func (l *dirListing) upToDate(modTime time.Time) bool {
+	return l.modTime.Equal(modTime) && l.listedAt.Sub(l.modTime) >= mtimeGranularity
+}
+
+// dependency is the state of a path read while evaluating a pattern.
+type dependency struct {
+	// readDir is true if the directory was listed, false if the path was only stat'ed.
+	readDir   bool
+	exists    bool
+	isDir     bool
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func newDependency(info fs.FileInfo, readDir bool, checkedAt time.Time) dependency {
+	dep := dependency{readDir: readDir, checkedAt: checkedAt}
+	if info != nil {
+		dep.exists = true
+		dep.isDir = info.IsDir()
+		dep.modTime = info.ModTime()
+	}
+	return dep
+}
+
+// unchanged returns true if the path is in the same state as when the pattern was evaluated.
+// The listing of a directory is only considered unchanged if its modification time is the same
+// and old enough to be trusted.
+func (d dependency) unchanged(info fs.FileInfo) bool {
+	if info == nil {
+		return !d.exists
+	}
+	if !d.exists || d.isDir != info.IsDir() {
+		return false
+	}
+	if !d.readDir {
+		return true
+	}
+	return d.modTime.Equal(info.ModTime()) && d.checkedAt.Sub(d.modTime) >= mtimeGranularity
+}
+
+// patternResult is the result of the last evaluation of a pattern, with the paths it depends on.
+type patternResult struct {
+	matches []string
+	deps    map[string]dependency
+}
+
+// cachingFS is a file system rooted at base which serves directory listings from the cache of the finder
+// while the directories are unchanged, and records the paths read while evaluating a pattern.
+type cachingFS struct {
+	finder *Finder
+	base   string
+	fsys   fs.FS
+	deps   map[string]dependency
+}
+
+func newCachingFS(f *Finder, base string) *cachingFS {
+	return &cachingFS{
+		finder: f,
+		base:   base,
+		fsys:   os.DirFS(base),
+		deps:   map[string]dependency{},
+	}
+}
+
+func (c *cachingFS) Open(name string) (fs.File, error) {
+	return c.fsys.Open(name)
+}
+
+func (c *cachingFS) Stat(name string) (fs.FileInfo, error) {
+	now := time.Now()
+	info, err := fs.Stat(c.fsys, name)
+	if err == nil || errors.Is(err, fs.ErrNotExist) {
+		c.deps[path.Join(c.base, name)] = newDependency(info, false, now)
+	}
+	return info, err
+}
+
+func (c *cachingFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	key := path.Join(c.base, name)
+	now := time.Now()
+
+	// The modification time is read before the listing, so that a concurrent modification
+	// invalidates the listing on the next evaluation.
+	info, err := fs.Stat(c.fsys, name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			c.deps[key] = newDependency(nil, true, now)
+		}
+		return nil, err
+	}
+
+	listing, ok := c.finder.dirs[key]
+	if !ok || !listing.upToDate(info.ModTime()) {
+		entries, err := fs.ReadDir(c.fsys, name)
+		if err != nil {
+			delete(c.finder.dirs, key)
+			return nil, err
+		}
+		listing = &dirListing{modTime: info.ModTime(), listedAt: now, entries: entries}
+		c.finder.dirs[key] = listing
+	}
+	listing.gen = c.finder.gen
+	c.deps[key] = newDependency(info, true, listing.listedAt)
+	return listing.entries, nil
+}
+
+// cachedMatches returns the matches of the last evaluation of the pattern if none of the paths
+// it depends on changed since.
+func (f *Finder) cachedMatches(pattern string) ([]string, bool) {
+	result, ok := f.patterns[pattern]
+	if !ok {
+		return nil, false
+	}
+	for p, dep := range result.deps {
+		info, err := os.Stat(p)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, false
+		}
+		if !dep.unchanged(info) {
+			return nil, false
+		}
+	}
+	// Keep the listings of the directories the pattern depends on.
+	for p, dep := range result.deps {
+		if listing, ok := f.dirs[p]; ok && dep.readDir {
+			listing.gen = f.gen
+		}
+	}
+	return result.matches, true
+}
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package finder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFiles(t testing.TB, files ...string) {
+	for _, f := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(f), 0700))
+		require.NoError(t, os.WriteFile(f, []byte(filepath.Base(f)), 0600))
+	}
+}
+
+// ageDirs sets the modification time of the directories far enough in the past for their listings to be cached.
+func ageDirs(t testing.TB, dirs ...string) time.Time {
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	for _, d := range dirs {
+		require.NoError(t, os.Chtimes(d, old, old))
+	}
+	return old
+}
+
+func TestFinderMatchesDoublestar(t *testing.T) {
+	tempDir := t.TempDir()
+	createFiles(t,
+		filepath.Join(tempDir, "a.log"),
+		filepath.Join(tempDir, "b.txt"),
+		filepath.Join(tempDir, "x", "a.log"),
+		filepath.Join(tempDir, "x", "y", "b.log"),
+		filepath.Join(tempDir, "x-y", "c.log"),
+		filepath.Join(tempDir, "z", "d.log"),
+	)
+
+	for _, pattern := range []string{
+		"*",
+		"*.log",
+		"a.log",
+		"missing.log",
+		filepath.Join("missing", "*.log"),
+		filepath.Join("**", "*.log"),
+		filepath.Join("x", "**", "*"),
+		filepath.Join("*", "*.log"),
+		filepath.Join("{x,z}", "*.log"),
+		filepath.Join("x*", "**", "{a,c}.log"),
+	} {
+		t.Run(pattern, func(t *testing.T) {
+			include := filepath.Join(tempDir, pattern)
+			expected, err := doublestar.FilepathGlob(include, doublestar.WithFilesOnly())
+			require.NoError(t, err)
+
+			f := New([]string{include}, nil)
+			for i := 0; i < 2; i++ {
+				matches, err := f.FindFiles()
+				assert.NoError(t, err)
+				assert.ElementsMatch(t, expected, matches)
+			}
+		})
+	}
+}
+
+func TestFinderDetectsChanges(t *testing.T) {
+	tempDir := t.TempDir()
+	createFiles(t, filepath.Join(tempDir, "a", "1.log"), filepath.Join(tempDir, "b", "1.log"))
+	ageDirs(t, tempDir, filepath.Join(tempDir, "a"), filepath.Join(tempDir, "b"))
+
+	f := New([]string{filepath.Join(tempDir, "**", "*.log")}, nil)
+	matches, err := f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(tempDir, "a", "1.log"), filepath.Join(tempDir, "b", "1.log")}, matches)
+
+	// New file in a listed directory
+	createFiles(t, filepath.Join(tempDir, "b", "2.log"))
+	matches, err = f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(tempDir, "a", "1.log"), filepath.Join(tempDir, "b", "1.log"), filepath.Join(tempDir, "b", "2.log")}, matches)
+
+	// New directory
+	createFiles(t, filepath.Join(tempDir, "c", "1.log"))
+	matches, err = f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(tempDir, "a", "1.log"),
+		filepath.Join(tempDir, "b", "1.log"),
+		filepath.Join(tempDir, "b", "2.log"),
+		filepath.Join(tempDir, "c", "1.log"),
+	}, matches)
+
+	// Removed file and directory
+	require.NoError(t, os.Remove(filepath.Join(tempDir, "b", "1.log")))
+	require.NoError(t, os.RemoveAll(filepath.Join(tempDir, "a")))
+	matches, err = f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(tempDir, "b", "2.log"), filepath.Join(tempDir, "c", "1.log")}, matches)
+	assert.NotContains(t, f.dirs, filepath.ToSlash(filepath.Join(tempDir, "a")))
+}
+
+func TestFinderReusesUnmodifiedListings(t *testing.T) {
+	tempDir := t.TempDir()
+	createFiles(t, filepath.Join(tempDir, "a", "1.log"))
+	ageDirs(t, tempDir, filepath.Join(tempDir, "a"))
+
+	f := New([]string{filepath.Join(tempDir, "*", "*.log")}, nil)
+	matches, err := f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(tempDir, "a", "1.log")}, matches)
+
+	// A file created without changing the modification time of its directory is not listed,
+	// which shows that the listing was reused.
+	createFiles(t, filepath.Join(tempDir, "a", "2.log"))
+	ageDirs(t, filepath.Join(tempDir, "a"))
+	matches, err = f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(tempDir, "a", "1.log")}, matches)
+
+	// Recently modified directories are listed again, even if their modification time didn't change,
+	// since a coarse modification time may not reflect a later modification.
+	recent := time.Now().Truncate(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(tempDir, "a"), recent, recent))
+	_, err = f.FindFiles()
+	require.NoError(t, err)
+	createFiles(t, filepath.Join(tempDir, "a", "3.log"))
+	require.NoError(t, os.Chtimes(filepath.Join(tempDir, "a"), recent, recent))
+	matches, err = f.FindFiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(tempDir, "a", "1.log"),
+		filepath.Join(tempDir, "a", "2.log"),
+		filepath.Join(tempDir, "a", "3.log"),
+	}, matches)
+}
+
+func BenchmarkFindFiles(b *testing.B) {
+	tempDir := b.TempDir()
+	var dirs []string
+	for i := 0; i < 50; i++ {
+		dir := filepath.Join(tempDir, fmt.Sprintf("service%d", i), "logs")
+		dirs = append(dirs, filepath.Dir(dir), dir)
+		for j := 0; j < 1000; j++ {
+			createFiles(b, filepath.Join(dir, fmt.Sprintf("%d.log", j)))
+		}
+	}
+	dirs = append(dirs, tempDir)
+	ageDirs(b, dirs...)
+	include := []string{filepath.Join(tempDir, "**", "*.log")}
+
+	b.Run("Uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := New(include, nil).FindFiles()
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("Incremental", func(b *testing.B) {
+		f := New(include, nil)
+		_, err := f.FindFiles()
+		require.NoError(b, err)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, err = f.FindFiles()
+			require.NoError(b, err)
+		}
+	})
+}
@@ -6,6 +6,9 @@ package finder // import "github.com/open-telemetry/opentelemetry-collector-cont
 import (
 	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
+	"sync"
 
 	"github.com/bmatcuk/doublestar/v4"
 )
@@ -22,33 +25,100 @@ func Validate(globs []string) error {
 
 // FindFiles gets a list of paths given an array of glob patterns to include and exclude
 func FindFiles(includes []string, excludes []string) ([]string, error) {
+	return New(includes, excludes).FindFiles()
+}
+
+// Finder finds the files matching glob patterns to include and exclude. It caches directory listings
+// between calls, so that only the directories modified since the previous call are listed again, and
+// the patterns depending on unmodified directories only are not evaluated again.
+type Finder struct {
+	includes []string
+	excludes []string
+
+	mu       sync.Mutex
+	gen      uint64
+	dirs     map[string]*dirListing
+	patterns map[string]*patternResult
+}
+
+func New(includes []string, excludes []string) *Finder {
+	return &Finder{
+		includes: includes,
+		excludes: excludes,
+		dirs:     map[string]*dirListing{},
+		patterns: map[string]*patternResult{},
+	}
+}
+
+// FindFiles gets the list of paths matching the patterns to include and not the patterns to exclude
+func (f *Finder) FindFiles() ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.gen++
+
 	var errs error
-	all := make([]string, 0, len(includes))
-	for _, include := range includes {
-		matches, err := doublestar.FilepathGlob(include, doublestar.WithFilesOnly(), doublestar.WithFailOnIOErrors())
+	all := make([]string, 0, len(f.includes))
+	seen := make(map[string]struct{})
+	for _, include := range f.includes {
+		matches, err := f.glob(include)
 		if err != nil {
 			errs = errors.Join(errs, fmt.Errorf("find files with '%s' pattern: %w", include, err))
-			// the same pattern could cause an IO error due to one file or directory,
-			// but also could still find files without `doublestar.WithFailOnIOErrors()`.
-			matches, _ = doublestar.FilepathGlob(include, doublestar.WithFilesOnly())
 		}
 	INCLUDE:
 		for _, match := range matches {
-			for _, exclude := range excludes {
+			for _, exclude := range f.excludes {
 				if itMatches, _ := doublestar.PathMatch(exclude, match); itMatches {
 					continue INCLUDE
 				}
 			}
 
-			for _, existing := range all {
-				if existing == match {
-					continue INCLUDE
-				}
+			if _, ok := seen[match]; ok {
+				continue
 			}
-
+			seen[match] = struct{}{}
 			all = append(all, match)
 		}
 	}
 
+	// Drop the listings of the directories which are not matched by the patterns anymore.
+	for p, listing := range f.dirs {
+		if listing.gen != f.gen {
+			delete(f.dirs, p)
+		}
+	}
 	return all, errs
 }
+
+// glob evaluates the pattern like doublestar.FilepathGlob, but reads the file system through the cache
+// of the finder. If the pattern causes an IO error, the matches found in spite of it are returned with the error.
+func (f *Finder) glob(pattern string) ([]string, error) {
+	if matches, ok := f.cachedMatches(pattern); ok {
+		return matches, nil
+	}
+	delete(f.patterns, pattern)
+
+	base, rest := doublestar.SplitPattern(filepath.ToSlash(filepath.Clean(pattern)))
+	if rest == "" || rest == "." || rest == ".." {
+		// Patterns without meta characters match a single path, there is nothing to cache.
+		matches, err := doublestar.FilepathGlob(pattern, doublestar.WithFilesOnly(), doublestar.WithFailOnIOErrors())
+		if err != nil {
+			matches, _ = doublestar.FilepathGlob(pattern, doublestar.WithFilesOnly())
+		}
+		return matches, err
+	}
+
+	fsys := newCachingFS(f, base)
+	matches, err := doublestar.Glob(fsys, rest, doublestar.WithFilesOnly(), doublestar.WithFailOnIOErrors())
+	if err != nil {
+		// the same pattern could cause an IO error due to one file or directory,
+		// but also could still find files without `doublestar.WithFailOnIOErrors()`.
+		matches, _ = doublestar.Glob(fsys, rest, doublestar.WithFilesOnly())
+	}
+	for i := range matches {
+		matches[i] = filepath.FromSlash(path.Join(base, matches[i]))
+	}
+	if err == nil {
+		f.patterns[pattern] = &patternResult{matches: matches, deps: fsys.deps}
+	}
+	return matches, err
+}
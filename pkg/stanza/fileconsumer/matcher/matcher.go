@@ -72,8 +72,7 @@ func New(c Criteria) (*Matcher, error) {
 	}
 
 	m := &Matcher{
-		include: c.Include,
-		exclude: c.Exclude,
+		finder: finder.New(c.Include, c.Exclude),
 	}
 
 	if c.ExcludeOlderThan != 0 {
@@ -154,8 +153,7 @@ func orderingCriteriaNeedsRegex(sorts []Sort) bool {
 }
 
 type Matcher struct {
-	include    []string
-	exclude    []string
+	finder     *finder.Finder
 	regex      *regexp.Regexp
 	filterOpts []filter.Option
 }
@@ -163,7 +161,7 @@ type Matcher struct {
 // MatchFiles gets a list of paths given an array of glob patterns to include and exclude
 func (m Matcher) MatchFiles() ([]string, error) {
 	var errs error
-	files, err := finder.FindFiles(m.include, m.exclude)
+	files, err := m.finder.FindFiles()
 	if err != nil {
 		errs = errors.Join(errs, err)
 	}
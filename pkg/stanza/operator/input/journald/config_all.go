@@ -37,6 +37,8 @@ type Config struct {
 	Grep        string        `mapstructure:"grep,omitempty"`
 	Dmesg       bool          `mapstructure:"dmesg,omitempty"`
 	All         bool          `mapstructure:"all,omitempty"`
+	Namespace   string        `mapstructure:"namespace,omitempty"`
+	Merge       bool          `mapstructure:"merge,omitempty"`
 }
 
 type MatchConfig map[string]string
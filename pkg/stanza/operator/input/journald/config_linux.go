@@ -7,9 +7,11 @@ package journald // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"regexp"
+	"slices"
 	"sort"
 	"time"
 
@@ -40,10 +42,11 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 	return &Input{
 		InputOperator: inputOperator,
 		newCmd: func(ctx context.Context, cursor []byte) cmd {
+			cmdArgs := slices.Clone(args)
 			if cursor != nil {
-				args = append(args, "--after-cursor", string(cursor))
+				cmdArgs = append(cmdArgs, "--after-cursor", string(cursor))
 			}
-			return exec.CommandContext(ctx, "journalctl", args...) // #nosec - ...
+			return exec.CommandContext(ctx, "journalctl", cmdArgs...) // #nosec - ...
 			// journalctl is an executable that is required for this operator to function
 		},
 		json: jsoniter.ConfigFastest,
@@ -88,6 +91,10 @@ func (c Config) buildArgs() ([]string, error) {
 		args = append(args, "--dmesg")
 	}
 
+	if c.Namespace != "" && (c.Directory != nil || len(c.Files) > 0) {
+		return nil, errors.New("'namespace' cannot be used with 'directory' or 'files'")
+	}
+
 	switch {
 	case c.Directory != nil:
 		args = append(args, "--directory", *c.Directory)
@@ -95,6 +102,12 @@ func (c Config) buildArgs() ([]string, error) {
 		for _, file := range c.Files {
 			args = append(args, "--file", file)
 		}
+	case c.Namespace != "":
+		args = append(args, "--namespace", c.Namespace)
+	}
+
+	if c.Merge {
+		args = append(args, "--merge")
 	}
 
 	if len(c.Matches) > 0 {
@@ -49,6 +49,13 @@ type failedCommand struct {
 	output string
 }
 
+func (f *failedCommand) Error() string {
+	if f.err == "" {
+		return "journalctl command exited"
+	}
+	return fmt.Sprintf("journalctl command failed (%v): %v", f.err, f.output)
+}
+
 var lastReadCursorKey = "lastReadCursor"
 
 // Start will start generating log entries.
@@ -64,6 +71,19 @@ func (operator *Input) Start(persister operator.Persister) error {
 
 	operator.persister = persister
 
+	err = operator.startJournalctl(ctx, cursor)
+	var failed *failedCommand
+	if cursor != nil && errors.As(err, &failed) && strings.Contains(failed.output, "cursor") {
+		// The saved cursor can't be found anymore, e.g. the journal was rotated or vacuumed.
+		operator.Logger().Warn("Failed to resume reading the journal from the saved cursor, starting from start_at",
+			zap.String("cursor", string(cursor)), zap.String("output", failed.output))
+		err = operator.startJournalctl(ctx, nil)
+	}
+	return err
+}
+
+// startJournalctl starts journalctl after the cursor, if any, and the goroutines reading its output.
+func (operator *Input) startJournalctl(ctx context.Context, cursor []byte) error {
 	// Start journalctl
 	journal := operator.newCmd(ctx, cursor)
 	stdout, err := journal.StdoutPipe()
@@ -150,20 +170,19 @@ func (operator *Input) Start(persister operator.Persister) error {
 				operator.Logger().Warn("Failed to parse journal entry", zap.Error(err))
 				continue
 			}
+			// Save the cursor once the entry is written, so that it is read again after a restart
+			// if the collector stops in between.
+			operator.Write(ctx, entry)
 			if err := operator.persister.Set(ctx, lastReadCursorKey, []byte(cursor)); err != nil {
 				operator.Logger().Warn("Failed to set offset", zap.Error(err))
 			}
-			operator.Write(ctx, entry)
 		}
 	}()
 
 	// Wait waitDuration for eventual error
 	select {
-	case err := <-failedChan:
-		if err.err == "" {
-			return fmt.Errorf("journalctl command exited")
-		}
-		return fmt.Errorf("journalctl command failed (%v): %v", err.err, err.output)
+	case f := <-failedChan:
+		return &f
 	case <-time.After(waitDuration):
 		return nil
 	}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"io"
 	"os/exec"
+	"strings"
 	"testing"
 	"time"
 
@@ -212,6 +213,23 @@ func TestBuildConfig(t *testing.T) {
 			},
 			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "info", "--all"},
 		},
+		{
+			Name: "namespace",
+			Config: func(cfg *Config) {
+				cfg.Namespace = "+audit"
+				cfg.Merge = true
+			},
+			Expected: []string{"--utc", "--output=json", "--follow", "--priority", "info", "--namespace", "+audit", "--merge"},
+		},
+		{
+			Name: "namespace with directory",
+			Config: func(cfg *Config) {
+				dir := "/run/log/journal"
+				cfg.Directory = &dir
+				cfg.Namespace = "audit"
+			},
+			ExpectedError: "'namespace' cannot be used with 'directory' or 'files'",
+		},
 	}
 
 	for _, tt := range testCases {
@@ -267,3 +285,60 @@ func TestInputJournaldError(t *testing.T) {
 		require.FailNow(t, "Timed out waiting for entry to be read")
 	}
 }
+
+func TestInputJournaldCursor(t *testing.T) {
+	cfg := NewConfigWithID("my_journald_input")
+	cfg.OutputIDs = []string{"output"}
+
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	// The arguments of a command don't leak into the next ones.
+	for i := 0; i < 2; i++ {
+		c := op.(*Input).newCmd(context.Background(), []byte("s=1"))
+		assert.Equal(t, []string{"journalctl", "--utc", "--output=json", "--follow", "--priority", "info", "--after-cursor", "s=1"}, c.(*exec.Cmd).Args)
+	}
+
+	mockOutput := testutil.NewMockOperator("output")
+	received := make(chan *entry.Entry)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		received <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+	require.NoError(t, op.SetOutputs([]operator.Operator{mockOutput}))
+
+	var cursors []string
+	op.(*Input).newCmd = func(_ context.Context, cursor []byte) cmd {
+		cursors = append(cursors, string(cursor))
+		if cursor != nil {
+			return &fakeJournaldCmd{
+				exitError: &exec.ExitError{},
+				stdErr:    "Failed to seek to cursor: Invalid argument\n",
+			}
+		}
+		return &fakeJournaldCmd{}
+	}
+
+	persister := testutil.NewUnscopedMockPersister()
+	require.NoError(t, persister.Set(context.Background(), lastReadCursorKey, []byte("s=vacuumed")))
+
+	// The receiver falls back to start_at when the saved cursor is invalid.
+	err = op.Start(persister)
+	assert.EqualError(t, err, "journalctl command exited")
+	defer func() {
+		require.NoError(t, op.Stop())
+	}()
+	assert.Equal(t, []string{"s=vacuumed", ""}, cursors)
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-received:
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for entry to be read")
+		}
+	}
+	require.Eventually(t, func() bool {
+		cursor, err := persister.Get(context.Background(), lastReadCursorKey)
+		return err == nil && strings.HasPrefix(string(cursor), "s=b1e713b587ae4001a9ca482c4b12c005")
+	}, time.Second, 10*time.Millisecond)
+}
@@ -6,6 +6,7 @@ package syslog // import "github.com/open-telemetry/opentelemetry-collector-cont
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
 
@@ -63,6 +64,8 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		tcpInputCfg.BaseConfig = *c.TCP
 		if syslogParserCfg.EnableOctetCounting {
 			tcpInputCfg.SplitFuncBuilder = OctetSplitFuncBuilder
+		} else if strings.EqualFold(syslogParserCfg.Protocol, syslog.Auto) {
+			tcpInputCfg.SplitFuncBuilder = AutoSplitFuncBuilder
 		}
 
 		tcpInput, err := tcpInputCfg.Build(set)
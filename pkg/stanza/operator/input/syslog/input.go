@@ -15,6 +15,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/tcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/udp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/syslog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/split"
 )
 
 // Input is an operator that listens for log entries over tcp.
@@ -51,6 +52,23 @@ func OctetSplitFuncBuilder(_ encoding.Encoding) (bufio.SplitFunc, error) {
 	return newOctetFrameSplitFunc(true), nil
 }
 
+// AutoSplitFuncBuilder detects the framing of every message: octet-counted messages (RFC6587) are split
+// by their count, the other messages by line.
+func AutoSplitFuncBuilder(enc encoding.Encoding) (bufio.SplitFunc, error) {
+	lineSplitFunc, err := split.NewlineSplitFunc(enc, true)
+	if err != nil {
+		return nil, err
+	}
+	octetSplitFunc := newOctetFrameSplitFunc(true)
+	frameRegex := regexp.MustCompile(`^[1-9]\d*\s<`)
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if frameRegex.Match(data) {
+			return octetSplitFunc(data, atEOF)
+		}
+		return lineSplitFunc(data, atEOF)
+	}, nil
+}
+
 func newOctetFrameSplitFunc(flushAtEOF bool) bufio.SplitFunc {
 	frameRegex := regexp.MustCompile(`^[1-9]\d*\s`)
 	return func(data []byte, atEOF bool) (int, []byte, error) {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"golang.org/x/text/encoding/unicode"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -327,3 +328,86 @@ func TestOctetFramingSplitFunc(t *testing.T) {
 		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
 	}
 }
+
+func TestAutoFramingSplitFunc(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input []byte
+		steps []splittest.Step
+	}{
+		{
+			name:  "Lines",
+			input: []byte("<34>Jan 12 06:30:00 host app: first\n<34>Jan 12 06:30:01 host app: second\n"),
+			steps: []splittest.Step{
+				splittest.ExpectAdvanceToken(36, `<34>Jan 12 06:30:00 host app: first`),
+				splittest.ExpectAdvanceToken(37, `<34>Jan 12 06:30:01 host app: second`),
+			},
+		},
+		{
+			name:  "OctetCounted",
+			input: []byte("18 <1>1 - - - - - a\nb18 <1>1 - - - - - c\nd"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("18 <1>1 - - - - - a\nb"),
+				splittest.ExpectToken("18 <1>1 - - - - - c\nd"),
+			},
+		},
+		{
+			name:  "Mixed",
+			input: []byte("18 <1>1 - - - - - a\nb<34>Jan 12 06:30:00 host app: line\n12 my message\n"),
+			steps: []splittest.Step{
+				splittest.ExpectToken("18 <1>1 - - - - - a\nb"),
+				splittest.ExpectAdvanceToken(35, `<34>Jan 12 06:30:00 host app: line`),
+				splittest.ExpectAdvanceToken(14, `12 my message`),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		splitFunc, err := AutoSplitFuncBuilder(unicode.UTF8)
+		require.NoError(t, err)
+		t.Run(tc.name, splittest.New(splitFunc, tc.input, tc.steps...))
+	}
+}
+
+func TestInputAutoProtocol(t *testing.T) {
+	syslogCfg := syslog.NewConfigWithID("test_syslog_parser")
+	syslogCfg.Protocol = syslog.Auto
+	cfg := NewConfigWithTCP(&syslogCfg.BaseConfig)
+	cfg.TCP.ListenAddress = ":14202"
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	fake := testutil.NewFakeOutput(t)
+	p, err := pipeline.NewDirectedPipeline([]operator.Operator{op, fake})
+	require.NoError(t, err)
+	require.NoError(t, p.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, p.Stop())
+	}()
+
+	conn, err := net.Dial("tcp", cfg.TCP.ListenAddress)
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("<34>Jan 12 06:30:00 1.2.3.4 apache_server: test message\n" +
+		"65 <86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 - - - hi" +
+		"not syslog\n"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	expected := []struct {
+		appname any
+		body    string
+	}{
+		{appname: "apache_server", body: "<34>Jan 12 06:30:00 1.2.3.4 apache_server: test message"},
+		{appname: "SecureAuth0", body: "65 <86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 - - - hi"},
+		{appname: nil, body: "not syslog"},
+	}
+	for _, exp := range expected {
+		select {
+		case e := <-fake.Received:
+			require.Equal(t, exp.body, e.Body)
+			require.Equal(t, exp.appname, e.Attributes["appname"])
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for entry to be processed")
+		}
+	}
+}
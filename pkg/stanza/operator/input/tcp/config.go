@@ -66,6 +66,7 @@ type BaseConfig struct {
 	ListenAddress    string                  `mapstructure:"listen_address,omitempty"`
 	TLS              *configtls.ServerConfig `mapstructure:"tls,omitempty"`
 	AddAttributes    bool                    `mapstructure:"add_attributes,omitempty"`
+	ProxyProtocol    bool                    `mapstructure:"proxy_protocol,omitempty"`
 	OneLogPerPacket  bool                    `mapstructure:"one_log_per_packet,omitempty"`
 	Encoding         string                  `mapstructure:"encoding,omitempty"`
 	SplitConfig      split.Config            `mapstructure:"multiline,omitempty"`
@@ -130,6 +131,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		address:         c.ListenAddress,
 		MaxLogSize:      int(c.MaxLogSize),
 		addAttributes:   c.AddAttributes,
+		proxyProtocol:   c.ProxyProtocol,
 		OneLogPerPacket: c.OneLogPerPacket,
 		encoding:        enc,
 		splitFunc:       splitFunc,
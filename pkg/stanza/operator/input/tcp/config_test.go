@@ -30,6 +30,7 @@ func TestUnmarshal(t *testing.T) {
 					cfg.MaxLogSize = 1000000
 					cfg.ListenAddress = "10.0.0.1:9000"
 					cfg.AddAttributes = true
+					cfg.ProxyProtocol = true
 					cfg.Encoding = "utf-8"
 					cfg.SplitConfig.LineStartPattern = "ABC"
 					cfg.TLS = &configtls.ServerConfig{
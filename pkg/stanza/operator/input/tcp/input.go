@@ -31,6 +31,7 @@ type Input struct {
 	address         string
 	MaxLogSize      int
 	addAttributes   bool
+	proxyProtocol   bool
 	OneLogPerPacket bool
 
 	listener net.Listener
@@ -57,7 +58,8 @@ func (i *Input) Start(_ operator.Persister) error {
 }
 
 func (i *Input) configureListener() error {
-	if i.tls == nil {
+	// The PROXY protocol header precedes the TLS handshake, TLS is set up once it has been read.
+	if i.tls == nil || i.proxyProtocol {
 		listener, err := net.Listen("tcp", i.address)
 		if err != nil {
 			return fmt.Errorf("failed to configure tcp listener: %w", err)
@@ -129,6 +131,18 @@ func (i *Input) goHandleMessages(ctx context.Context, conn net.Conn, cancel cont
 		defer i.wg.Done()
 		defer cancel()
 
+		if i.proxyProtocol {
+			pc, err := newProxyConn(conn)
+			if err != nil {
+				i.Logger().Error("Failed to accept connection", zap.String("address", conn.RemoteAddr().String()), zap.Error(err))
+				return
+			}
+			conn = pc
+			if i.tls != nil {
+				conn = tls.Server(conn, i.tls)
+			}
+		}
+
 		dec := decode.New(i.encoding)
 		if i.OneLogPerPacket {
 			var buf bytes.Buffer
@@ -186,6 +200,13 @@ func (i *Input) handleMessage(ctx context.Context, conn net.Conn, dec *decode.De
 			entry.AddAttribute("net.host.port", strconv.FormatInt(int64(addr.Port), 10))
 			entry.AddAttribute("net.host.name", i.resolver.GetHostFromIP(ip))
 		}
+
+		if tlsConn, ok := conn.(*tls.Conn); ok {
+			if certs := tlsConn.ConnectionState().PeerCertificates; len(certs) > 0 {
+				entry.AddAttribute("tls.client.subject", certs[0].Subject.String())
+				entry.AddAttribute("tls.client.issuer", certs[0].Issuer.String())
+			}
+		}
 	}
 
 	i.Write(ctx, entry)
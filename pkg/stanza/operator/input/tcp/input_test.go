@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -373,6 +374,103 @@ func TestTLSTCPInput(t *testing.T) {
 	t.Run("CarriageReturn", tlsInputTest([]byte("message\r\n"), []string{"message"}))
 }
 
+func TestProxyProtocolTLSTCPInput(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "test.crt"), filepath.Join(dir, "test.key")
+	require.NoError(t, os.WriteFile(certFile, []byte(testTLSCertificate+"\n"), 0600))
+	require.NoError(t, os.WriteFile(keyFile, []byte(testTLSPrivateKey+"\n"), 0600))
+
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.AddAttributes = true
+	cfg.ProxyProtocol = true
+	cfg.TLS = &configtls.ServerConfig{
+		Config: configtls.Config{
+			CertFile: certFile,
+			KeyFile:  keyFile,
+		},
+		ClientCAFile: certFile,
+	}
+
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	entryChan := make(chan *entry.Entry, 1)
+	mockOutput.On("Process", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		entryChan <- args.Get(1).(*entry.Entry)
+	}).Return(nil)
+
+	err = tcpInput.Start(testutil.NewUnscopedMockPersister())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	conn, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"))
+	require.NoError(t, err)
+
+	cert, err := tls.X509KeyPair([]byte(testTLSCertificate), []byte(testTLSPrivateKey))
+	require.NoError(t, err)
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true, Certificates: []tls.Certificate{cert}})
+	_, err = tlsConn.Write([]byte("message\n"))
+	require.NoError(t, err)
+
+	select {
+	case entry := <-entryChan:
+		require.Equal(t, "message", entry.Body)
+		require.Equal(t, "192.0.2.1", entry.Attributes["net.peer.ip"])
+		require.Equal(t, "56324", entry.Attributes["net.peer.port"])
+		require.Equal(t, "198.51.100.1", entry.Attributes["net.host.ip"])
+		require.Equal(t, "443", entry.Attributes["net.host.port"])
+		require.Equal(t, "CN=Stanza,OU=Stanza,O=observiQ,L=Grand Rapids,ST=Michigan,C=US", entry.Attributes["tls.client.subject"])
+		require.Equal(t, "CN=Stanza,OU=Stanza,O=observiQ,L=Grand Rapids,ST=Michigan,C=US", entry.Attributes["tls.client.issuer"])
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for message to be written")
+	}
+}
+
+func TestProxyProtocolMissingHeader(t *testing.T) {
+	cfg := NewConfigWithID("test_id")
+	cfg.ListenAddress = ":0"
+	cfg.ProxyProtocol = true
+
+	set := componenttest.NewNopTelemetrySettings()
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	mockOutput := testutil.Operator{}
+	tcpInput := op.(*Input)
+	tcpInput.InputOperator.OutputOperators = []operator.Operator{&mockOutput}
+
+	err = tcpInput.Start(testutil.NewUnscopedMockPersister())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tcpInput.Stop(), "expected to stop tcp input operator without error")
+	}()
+
+	conn, err := net.Dial("tcp", tcpInput.listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("message\n"))
+	require.NoError(t, err)
+
+	// The connection is closed without processing any message.
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(t, err)
+	mockOutput.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
+
 func TestFailToBind(t *testing.T) {
 	ip := "localhost"
 	port := 0
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tcp // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/tcp"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// proxyHeaderTimeout is the time allowed to a client to send the PROXY protocol header.
+	proxyHeaderTimeout = 5 * time.Second
+
+	// proxyV1MaxLength is the maximum length of a v1 header, including the CRLF.
+	proxyV1MaxLength = 107
+
+	proxyV2CommandLocal = 0x0
+	proxyV2CommandProxy = 0x1
+	proxyV2FamilyTCP4   = 0x11
+	proxyV2FamilyTCP6   = 0x21
+)
+
+var (
+	proxyV1Prefix    = []byte("PROXY ")
+	proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+	errMissingProxyHeader = errors.New("connection doesn't start with a PROXY protocol header")
+)
+
+// proxyConn is a connection which started with a PROXY protocol header. Its addresses are the
+// addresses of the original connection to the proxy, when the header provides them.
+type proxyConn struct {
+	net.Conn
+	reader     *bufio.Reader
+	remoteAddr net.Addr
+	localAddr  net.Addr
+}
+
+// newProxyConn reads the PROXY protocol v1 or v2 header at the start of conn.
+func newProxyConn(conn net.Conn) (*proxyConn, error) {
+	pc := &proxyConn{
+		Conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(proxyHeaderTimeout)); err != nil {
+		return nil, err
+	}
+	if err := pc.readHeader(); err != nil {
+		return nil, fmt.Errorf("failed to read the PROXY protocol header: %w", err)
+	}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	return pc, nil
+}
+
+func (pc *proxyConn) Read(b []byte) (int, error) {
+	return pc.reader.Read(b)
+}
+
+func (pc *proxyConn) RemoteAddr() net.Addr {
+	if pc.remoteAddr != nil {
+		return pc.remoteAddr
+	}
+	return pc.Conn.RemoteAddr()
+}
+
+func (pc *proxyConn) LocalAddr() net.Addr {
+	if pc.localAddr != nil {
+		return pc.localAddr
+	}
+	return pc.Conn.LocalAddr()
+}
+
+func (pc *proxyConn) readHeader() error {
+	first, err := pc.reader.Peek(1)
+	if err != nil {
+		return err
+	}
+	switch first[0] {
+	case proxyV1Prefix[0]:
+		return pc.readV1Header()
+	case proxyV2Signature[0]:
+		return pc.readV2Header()
+	default:
+		return errMissingProxyHeader
+	}
+}
+
+// readV1Header reads a human-readable header, as "PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n".
+func (pc *proxyConn) readV1Header() error {
+	var line []byte
+	for {
+		b, err := pc.reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		line = append(line, b)
+		if b == '\n' {
+			break
+		}
+		if len(line) == proxyV1MaxLength {
+			return errors.New("v1 header too long")
+		}
+	}
+	if !bytes.HasPrefix(line, proxyV1Prefix) || !bytes.HasSuffix(line, []byte("\r\n")) {
+		return errMissingProxyHeader
+	}
+
+	fields := strings.Split(string(line[len(proxyV1Prefix):len(line)-2]), " ")
+	switch fields[0] {
+	case "UNKNOWN":
+		return nil
+	case "TCP4", "TCP6":
+	default:
+		return fmt.Errorf("unsupported v1 protocol %q", fields[0])
+	}
+	if len(fields) != 5 {
+		return errors.New("malformed v1 header")
+	}
+
+	src, err := parseProxyV1Addr(fields[1], fields[3])
+	if err != nil {
+		return err
+	}
+	dst, err := parseProxyV1Addr(fields[2], fields[4])
+	if err != nil {
+		return err
+	}
+	pc.remoteAddr, pc.localAddr = src, dst
+	return nil
+}
+
+func parseProxyV1Addr(rawIP, rawPort string) (*net.TCPAddr, error) {
+	ip := net.ParseIP(rawIP)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid v1 address %q", rawIP)
+	}
+	port, err := strconv.ParseUint(rawPort, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid v1 port %q", rawPort)
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readV2Header reads a binary header, made of the signature, the version and command, the
+// address family, the length of the addresses and the addresses.
+func (pc *proxyConn) readV2Header() error {
+	header := make([]byte, len(proxyV2Signature)+4)
+	if _, err := io.ReadFull(pc.reader, header); err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(header, proxyV2Signature) {
+		return errMissingProxyHeader
+	}
+	versionCommand, family := header[12], header[13]
+	if versionCommand>>4 != 2 {
+		return fmt.Errorf("unsupported version %d", versionCommand>>4)
+	}
+	addresses := make([]byte, binary.BigEndian.Uint16(header[14:]))
+	if _, err := io.ReadFull(pc.reader, addresses); err != nil {
+		return err
+	}
+
+	switch versionCommand & 0xF {
+	case proxyV2CommandLocal:
+		// The connection was established by the proxy itself, e.g. for health checks.
+		return nil
+	case proxyV2CommandProxy:
+	default:
+		return fmt.Errorf("unsupported v2 command %d", versionCommand&0xF)
+	}
+
+	var ipLength int
+	switch family {
+	case proxyV2FamilyTCP4:
+		ipLength = net.IPv4len
+	case proxyV2FamilyTCP6:
+		ipLength = net.IPv6len
+	default:
+		// Other families are not relevant to a TCP listener, the addresses are ignored.
+		return nil
+	}
+	if len(addresses) < 2*ipLength+4 {
+		return errors.New("v2 addresses too short")
+	}
+	pc.remoteAddr = &net.TCPAddr{
+		IP:   net.IP(addresses[:ipLength]),
+		Port: int(binary.BigEndian.Uint16(addresses[2*ipLength:])),
+	}
+	pc.localAddr = &net.TCPAddr{
+		IP:   net.IP(addresses[ipLength : 2*ipLength]),
+		Port: int(binary.BigEndian.Uint16(addresses[2*ipLength+2:])),
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tcp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func proxyV2Header(command byte, family byte, addresses []byte) []byte {
+	header := append([]byte{}, proxyV2Signature...)
+	header = append(header, 0x20|command, family)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(addresses)))
+	return append(header, addresses...)
+}
+
+func TestNewProxyConn(t *testing.T) {
+	tcp4Addresses := []byte{192, 0, 2, 1, 198, 51, 100, 1, 0xDC, 0x04, 0x01, 0xBB}
+	tcp6Addresses := append(append(net.ParseIP("2001:db8::1").To16(), net.ParseIP("2001:db8::2").To16()...), 0xDC, 0x04, 0x01, 0xBB)
+
+	tests := []struct {
+		name        string
+		header      []byte
+		remoteAddr  string
+		localAddr   string
+		expectedErr string
+	}{
+		{
+			name:       "v1 TCP4",
+			header:     []byte("PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n"),
+			remoteAddr: "192.0.2.1:56324",
+			localAddr:  "198.51.100.1:443",
+		},
+		{
+			name:       "v1 TCP6",
+			header:     []byte("PROXY TCP6 2001:db8::1 2001:db8::2 56324 443\r\n"),
+			remoteAddr: "[2001:db8::1]:56324",
+			localAddr:  "[2001:db8::2]:443",
+		},
+		{
+			name:   "v1 UNKNOWN",
+			header: []byte("PROXY UNKNOWN\r\n"),
+		},
+		{
+			name:        "v1 malformed",
+			header:      []byte("PROXY TCP4 192.0.2.1 56324\r\n"),
+			expectedErr: "malformed v1 header",
+		},
+		{
+			name:        "v1 invalid address",
+			header:      []byte("PROXY TCP4 192.0.2 198.51.100.1 56324 443\r\n"),
+			expectedErr: `invalid v1 address "192.0.2"`,
+		},
+		{
+			name:        "v1 too long",
+			header:      append([]byte("PROXY UNKNOWN "), bytes.Repeat([]byte("x"), proxyV1MaxLength)...),
+			expectedErr: "v1 header too long",
+		},
+		{
+			name:       "v2 TCP4",
+			header:     proxyV2Header(proxyV2CommandProxy, proxyV2FamilyTCP4, tcp4Addresses),
+			remoteAddr: "192.0.2.1:56324",
+			localAddr:  "198.51.100.1:443",
+		},
+		{
+			name:       "v2 TCP6",
+			header:     proxyV2Header(proxyV2CommandProxy, proxyV2FamilyTCP6, tcp6Addresses),
+			remoteAddr: "[2001:db8::1]:56324",
+			localAddr:  "[2001:db8::2]:443",
+		},
+		{
+			name:   "v2 LOCAL",
+			header: proxyV2Header(proxyV2CommandLocal, 0x00, nil),
+		},
+		{
+			name:        "v2 truncated addresses",
+			header:      proxyV2Header(proxyV2CommandProxy, proxyV2FamilyTCP4, tcp4Addresses[:8]),
+			expectedErr: "v2 addresses too short",
+		},
+		{
+			name:        "missing header",
+			header:      []byte("message\n"),
+			expectedErr: errMissingProxyHeader.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := net.Pipe()
+			defer server.Close()
+			go func() {
+				defer client.Close()
+				_, _ = client.Write(append(tt.header, []byte("message\n")...))
+			}()
+
+			pc, err := newProxyConn(server)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.remoteAddr != "" {
+				assert.Equal(t, tt.remoteAddr, pc.RemoteAddr().String())
+				assert.Equal(t, tt.localAddr, pc.LocalAddr().String())
+			} else {
+				assert.Equal(t, server.RemoteAddr(), pc.RemoteAddr())
+				assert.Equal(t, server.LocalAddr(), pc.LocalAddr())
+			}
+
+			data, err := io.ReadAll(pc)
+			require.NoError(t, err)
+			assert.Equal(t, "message\n", string(data))
+		})
+	}
+}
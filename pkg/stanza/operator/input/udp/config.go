@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/decode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -23,9 +24,12 @@ const (
 	// Maximum UDP packet size
 	MaxUDPSize = 64 * 1024
 
+	defaultSockets        = 1
 	defaultReaders        = 1
 	defaultProcessors     = 1
 	defaultMaxQueueLength = 100
+
+	droppedPacketsMetric = "udp_input/dropped_packets"
 )
 
 func init() {
@@ -66,6 +70,7 @@ type AsyncConfig struct {
 // BaseConfig is the details configuration of a udp input operator.
 type BaseConfig struct {
 	ListenAddress   string       `mapstructure:"listen_address,omitempty"`
+	Sockets         int          `mapstructure:"sockets,omitempty"`
 	OneLogPerPacket bool         `mapstructure:"one_log_per_packet,omitempty"`
 	AddAttributes   bool         `mapstructure:"add_attributes,omitempty"`
 	Encoding        string       `mapstructure:"encoding,omitempty"`
@@ -90,6 +95,14 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		return nil, fmt.Errorf("failed to resolve listen_address: %w", err)
 	}
 
+	sockets := c.Sockets
+	if sockets <= 0 {
+		sockets = defaultSockets
+	}
+	if sockets > 1 && !reusePortSupported {
+		return nil, fmt.Errorf("'sockets' greater than 1 is only supported on linux")
+	}
+
 	enc, err := decode.LookupEncoding(c.Encoding)
 	if err != nil {
 		return nil, err
@@ -119,9 +132,20 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		}
 	}
 
+	droppedPackets, err := set.MeterProvider.Meter("otelcol/udp_input").Int64Counter(
+		droppedPacketsMetric,
+		metric.WithDescription("Number of packets dropped by the kernel because the receive buffer of a socket was full"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	udpInput := &Input{
 		InputOperator:   inputOperator,
 		address:         address,
+		sockets:         sockets,
+		droppedPackets:  droppedPackets,
 		buffer:          make([]byte, MaxUDPSize),
 		addAttributes:   c.AddAttributes,
 		encoding:        enc,
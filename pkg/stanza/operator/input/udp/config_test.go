@@ -26,6 +26,7 @@ func TestUnmarshal(t *testing.T) {
 				Expect: func() *Config {
 					cfg := NewConfig()
 					cfg.ListenAddress = "10.0.0.1:9000"
+					cfg.Sockets = 4
 					cfg.AddAttributes = true
 					cfg.Encoding = "utf-8"
 					cfg.SplitConfig.LineStartPattern = "ABC"
@@ -11,7 +11,9 @@ import (
 	"net"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 	"golang.org/x/text/encoding"
 
@@ -25,14 +27,16 @@ type Input struct {
 	buffer []byte
 	helper.InputOperator
 	address         *net.UDPAddr
+	sockets         int
 	addAttributes   bool
 	OneLogPerPacket bool
 	AsyncConfig     *AsyncConfig
 
-	connection net.PacketConn
-	cancel     context.CancelFunc
-	wg         sync.WaitGroup
-	wgReader   sync.WaitGroup
+	connection  net.PacketConn
+	connections []*socket
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	wgReader    sync.WaitGroup
 
 	encoding  encoding.Encoding
 	splitFunc bufio.SplitFunc
@@ -41,6 +45,15 @@ type Input struct {
 	messageQueue   chan messageAndAddress
 	readBufferPool sync.Pool
 	stopOnce       sync.Once
+
+	droppedPackets metric.Int64Counter
+}
+
+// socket is one of the sockets bound to the listen address.
+type socket struct {
+	conn *net.UDPConn
+	// drops is the last kernel drop counter reported for the socket.
+	drops atomic.Uint32
 }
 
 type messageAndAddress struct {
@@ -54,11 +67,27 @@ func (i *Input) Start(_ operator.Persister) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	i.cancel = cancel
 
-	conn, err := net.ListenUDP("udp", i.address)
+	reusePort := i.sockets > 1
+	conn, err := listen(i.address, reusePort)
 	if err != nil {
+		cancel()
 		return fmt.Errorf("failed to open connection: %w", err)
 	}
 	i.connection = conn
+	i.connections = []*socket{{conn: conn}}
+
+	// Bind the other sockets to the address of the first one, so that they share its port
+	// when the listen address leaves it to the kernel.
+	address := conn.LocalAddr().(*net.UDPAddr)
+	for n := 1; n < i.sockets; n++ {
+		conn, err = listen(address, reusePort)
+		if err != nil {
+			cancel()
+			i.closeConnections()
+			return fmt.Errorf("failed to open connection: %w", err)
+		}
+		i.connections = append(i.connections, &socket{conn: conn})
+	}
 
 	i.goHandleMessages(ctx)
 	return nil
@@ -67,14 +96,18 @@ func (i *Input) Start(_ operator.Persister) error {
 // goHandleMessages will handle messages from a udp connection.
 func (i *Input) goHandleMessages(ctx context.Context) {
 	if i.AsyncConfig == nil {
-		i.wg.Add(1)
-		go i.readAndProcessMessages(ctx)
+		for _, sock := range i.connections {
+			i.wg.Add(1)
+			go i.readAndProcessMessages(ctx, sock)
+		}
 		return
 	}
 
-	for n := 0; n < i.AsyncConfig.Readers; n++ {
-		i.wgReader.Add(1)
-		go i.readMessagesAsync(ctx)
+	for _, sock := range i.connections {
+		for n := 0; n < i.AsyncConfig.Readers; n++ {
+			i.wgReader.Add(1)
+			go i.readMessagesAsync(ctx, sock)
+		}
 	}
 
 	for n := 0; n < i.AsyncConfig.Processors; n++ {
@@ -83,14 +116,15 @@ func (i *Input) goHandleMessages(ctx context.Context) {
 	}
 }
 
-func (i *Input) readAndProcessMessages(ctx context.Context) {
+func (i *Input) readAndProcessMessages(ctx context.Context, sock *socket) {
 	defer i.wg.Done()
 
 	dec := decode.New(i.encoding)
 	readBuffer := make([]byte, MaxUDPSize)
+	oobBuffer := make([]byte, oobSize)
 	scannerBuffer := make([]byte, 0, MaxUDPSize)
 	for {
-		message, remoteAddr, bufferLength, err := i.readMessage(readBuffer)
+		message, remoteAddr, bufferLength, err := i.readMessage(ctx, sock, readBuffer, oobBuffer)
 		message = i.removeTrailingCharactersAndNULsFromBuffer(message, bufferLength)
 
 		if err != nil {
@@ -127,12 +161,13 @@ func (i *Input) processMessage(ctx context.Context, message []byte, remoteAddr n
 	}
 }
 
-func (i *Input) readMessagesAsync(ctx context.Context) {
+func (i *Input) readMessagesAsync(ctx context.Context, sock *socket) {
 	defer i.wgReader.Done()
 
+	oobBuffer := make([]byte, oobSize)
 	for {
 		readBuffer := i.readBufferPool.Get().(*[]byte) // Can't reuse the same buffer since same references would be written multiple times to the messageQueue (and cause data override of previous entries)
-		message, remoteAddr, bufferLength, err := i.readMessage(*readBuffer)
+		message, remoteAddr, bufferLength, err := i.readMessage(ctx, sock, *readBuffer, oobBuffer)
 		if err != nil {
 			i.readBufferPool.Put(readBuffer)
 			select {
@@ -224,15 +259,38 @@ func (i *Input) handleMessage(ctx context.Context, remoteAddr net.Addr, dec *dec
 }
 
 // readMessage will read log messages from the connection.
-func (i *Input) readMessage(buffer []byte) ([]byte, net.Addr, int, error) {
-	n, addr, err := i.connection.ReadFrom(buffer)
+func (i *Input) readMessage(ctx context.Context, sock *socket, buffer []byte, oob []byte) ([]byte, net.Addr, int, error) {
+	n, oobn, _, addr, err := sock.conn.ReadMsgUDP(buffer, oob)
 	if err != nil {
 		return nil, nil, 0, err
 	}
+	if oobn > 0 {
+		i.recordDrops(ctx, sock, oob[:oobn])
+	}
 
 	return buffer, addr, n, nil
 }
 
+// recordDrops adds the packets dropped by the kernel since the last reported counter of the socket.
+func (i *Input) recordDrops(ctx context.Context, sock *socket, oob []byte) {
+	drops, ok := parseDrops(oob)
+	if !ok {
+		return
+	}
+	for {
+		last := sock.drops.Load()
+		// The counter may wrap around, and readers sharing a socket may report it out of order.
+		delta := drops - last
+		if int32(delta) <= 0 {
+			return
+		}
+		if sock.drops.CompareAndSwap(last, drops) {
+			i.droppedPackets.Add(ctx, int64(delta))
+			return
+		}
+	}
+}
+
 // This will remove trailing characters and NULs from the buffer
 func (i *Input) removeTrailingCharactersAndNULsFromBuffer(buffer []byte, n int) []byte {
 	// Remove trailing characters and NULs
@@ -249,11 +307,7 @@ func (i *Input) Stop() error {
 			return
 		}
 		i.cancel()
-		if i.connection != nil {
-			if err := i.connection.Close(); err != nil {
-				i.Logger().Error("failed to close UDP connection", zap.Error(err))
-			}
-		}
+		i.closeConnections()
 		if i.AsyncConfig != nil {
 			i.wgReader.Wait() // only when all async readers are finished, so there's no risk of sending to a closed channel, do we close messageQueue (which allows the async processors to finish)
 			close(i.messageQueue)
@@ -266,3 +320,12 @@ func (i *Input) Stop() error {
 	})
 	return nil
 }
+
+func (i *Input) closeConnections() {
+	for _, sock := range i.connections {
+		if err := sock.conn.Close(); err != nil {
+			i.Logger().Error("failed to close UDP connection", zap.Error(err))
+		}
+	}
+	i.connections = nil
+}
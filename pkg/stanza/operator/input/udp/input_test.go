@@ -154,6 +154,63 @@ func TestInput(t *testing.T) {
 	t.Run("SimpleAsync", udpInputTest([]byte("message1"), []string{"message1"}, cfg))
 }
 
+func TestInputSockets(t *testing.T) {
+	if !reusePortSupported {
+		t.Skip("SO_REUSEPORT is only supported on linux")
+	}
+
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = "127.0.0.1:0"
+	cfg.Sockets = 4
+
+	t.Run("Simple", udpInputTest([]byte("message1"), []string{"message1"}, cfg))
+
+	cfg.AsyncConfig = &AsyncConfig{
+		Readers:        2,
+		Processors:     2,
+		MaxQueueLength: 100,
+	}
+	t.Run("SimpleAsync", udpInputTest([]byte("message1"), []string{"message1"}, cfg))
+}
+
+func TestInputSocketsSharePort(t *testing.T) {
+	if !reusePortSupported {
+		t.Skip("SO_REUSEPORT is only supported on linux")
+	}
+
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = "127.0.0.1:0"
+	cfg.Sockets = 3
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	udpInput := op.(*Input)
+	udpInput.InputOperator.OutputOperators = []operator.Operator{testutil.NewFakeOutput(t)}
+
+	require.NoError(t, udpInput.Start(testutil.NewUnscopedMockPersister()))
+	defer func() {
+		require.NoError(t, udpInput.Stop())
+	}()
+
+	require.Len(t, udpInput.connections, 3)
+	for _, sock := range udpInput.connections {
+		require.Equal(t, udpInput.connection.LocalAddr().String(), sock.conn.LocalAddr().String())
+	}
+}
+
+func TestInputSocketsUnsupported(t *testing.T) {
+	if reusePortSupported {
+		t.Skip("SO_REUSEPORT is supported on this platform")
+	}
+
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = "127.0.0.1:0"
+	cfg.Sockets = 2
+
+	_, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.ErrorContains(t, err, "only supported on linux")
+}
+
 func TestInputAttributes(t *testing.T) {
 	t.Run("Simple", udpInputAttributesTest([]byte("message1"), []string{"message1"}))
 	t.Run("TrailingNewlines", udpInputAttributesTest([]byte("message1\n"), []string{"message1"}))
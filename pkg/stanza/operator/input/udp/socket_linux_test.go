@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package udp
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"golang.org/x/sys/unix"
+)
+
+func dropsControlMessage(drops uint32) []byte {
+	oob := make([]byte, oobSize)
+	h := (*unix.Cmsghdr)(unsafe.Pointer(&oob[0]))
+	h.Level = unix.SOL_SOCKET
+	h.Type = unix.SO_RXQ_OVFL
+	h.SetLen(unix.CmsgLen(4))
+	binary.NativeEndian.PutUint32(oob[unix.CmsgLen(0):], drops)
+	return oob
+}
+
+func TestParseDrops(t *testing.T) {
+	drops, ok := parseDrops(dropsControlMessage(42))
+	require.True(t, ok)
+	require.Equal(t, uint32(42), drops)
+
+	_, ok = parseDrops(nil)
+	require.False(t, ok)
+}
+
+func TestRecordDrops(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	set := componenttest.NewNopTelemetrySettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	cfg := NewConfigWithID("test_input")
+	cfg.ListenAddress = "127.0.0.1:0"
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+	udpInput := op.(*Input)
+
+	sock := &socket{}
+	udpInput.recordDrops(context.Background(), sock, dropsControlMessage(5))
+	udpInput.recordDrops(context.Background(), sock, dropsControlMessage(5))
+	// A counter reported out of order by another reader of the socket is ignored.
+	udpInput.recordDrops(context.Background(), sock, dropsControlMessage(3))
+	udpInput.recordDrops(context.Background(), sock, dropsControlMessage(12))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	require.Equal(t, droppedPacketsMetric, m.Name)
+	dps := m.Data.(metricdata.Sum[int64]).DataPoints
+	require.Len(t, dps, 1)
+	require.Equal(t, int64(12), dps[0].Value)
+}
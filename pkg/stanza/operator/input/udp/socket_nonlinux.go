@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package udp // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/udp"
+
+import (
+	"net"
+)
+
+const reusePortSupported = false
+
+var oobSize = 0
+
+// listen opens a udp socket. Sharing the address between several sockets is only supported on linux.
+func listen(address *net.UDPAddr, _ bool) (*net.UDPConn, error) {
+	return net.ListenUDP("udp", address)
+}
+
+// parseDrops always reports no counter since SO_RXQ_OVFL is only supported on linux.
+func parseDrops(_ []byte) (uint32, bool) {
+	return 0, false
+}
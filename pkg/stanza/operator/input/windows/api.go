@@ -24,6 +24,7 @@ var (
 	updateBookmarkProc        SyscallProc = api.NewProc("EvtUpdateBookmark")
 	openPublisherMetadataProc SyscallProc = api.NewProc("EvtOpenPublisherMetadata")
 	formatMessageProc         SyscallProc = api.NewProc("EvtFormatMessage")
+	openSessionProc           SyscallProc = api.NewProc("EvtOpenSession")
 )
 
 // SyscallProc is a syscall procedure.
@@ -58,6 +59,25 @@ const (
 	EvtFormatMessageXML uint32 = 9
 )
 
+const (
+	// EvtRPCLogin is the login class of a remote session opened with the EVT_RPC_LOGIN structure.
+	EvtRPCLogin uint32 = 1
+)
+
+const (
+	// EvtRPCLoginAuthDefault is a flag that uses the default authentication method of the remote session.
+	EvtRPCLoginAuthDefault uint32 = 0
+)
+
+// EvtRPCLoginInfo contains the information used to connect to a remote computer (https://learn.microsoft.com/en-us/windows/win32/api/winevt/ns-winevt-evt_rpc_login)
+type EvtRPCLoginInfo struct {
+	Server   *uint16
+	User     *uint16
+	Domain   *uint16
+	Password *uint16
+	Flags    uint32
+}
+
 const (
 	// EvtRenderEventXML is a flag to render an event as an XML string
 	EvtRenderEventXML uint32 = 1
@@ -147,3 +167,13 @@ func evtFormatMessage(publisherMetadata uintptr, event uintptr, messageID uint32
 
 	return bufferUsed, nil
 }
+
+// evtOpenSession is the direct syscall implementation of EvtOpenSession (https://learn.microsoft.com/en-us/windows/win32/api/winevt/nf-winevt-evtopensession)
+func evtOpenSession(loginClass uint32, login *EvtRPCLoginInfo, timeout uint32, flags uint32) (uintptr, error) {
+	handle, _, err := openSessionProc.Call(uintptr(loginClass), uintptr(unsafe.Pointer(login)), uintptr(timeout), uintptr(flags))
+	if !errors.Is(err, ErrorSuccess) {
+		return 0, err
+	}
+
+	return handle, nil
+}
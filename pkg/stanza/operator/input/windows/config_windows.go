@@ -36,6 +36,14 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		return nil, fmt.Errorf("the `start_at` field must be set to `beginning` or `end`")
 	}
 
+	if c.Remote.Server == "" && (c.Remote.Username != "" || c.Remote.Password != "" || c.Remote.Domain != "") {
+		return nil, fmt.Errorf("the `remote.server` field is required when remote credentials are set")
+	}
+
+	if c.Remote.Username == "" && c.Remote.Password != "" {
+		return nil, fmt.Errorf("the `remote.username` field is required when `remote.password` is set")
+	}
+
 	return &Input{
 		InputOperator:    inputOperator,
 		buffer:           NewBuffer(),
@@ -45,5 +53,6 @@ func (c *Config) Build(set component.TelemetrySettings) (operator.Operator, erro
 		pollInterval:     c.PollInterval,
 		raw:              c.Raw,
 		excludeProviders: c.ExcludeProviders,
+		remote:           c.Remote,
 	}, nil
 }
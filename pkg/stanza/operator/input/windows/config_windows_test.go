@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestBuildRemote(t *testing.T) {
+	tests := []struct {
+		name        string
+		remote      RemoteConfig
+		expectedErr string
+	}{
+		{
+			name: "local",
+		},
+		{
+			name:   "current credentials",
+			remote: RemoteConfig{Server: "server"},
+		},
+		{
+			name:   "explicit credentials",
+			remote: RemoteConfig{Server: "server", Username: "user", Password: "password", Domain: "domain"},
+		},
+		{
+			name:        "credentials without server",
+			remote:      RemoteConfig{Username: "user", Password: "password"},
+			expectedErr: "the `remote.server` field is required",
+		},
+		{
+			name:        "password without username",
+			remote:      RemoteConfig{Server: "server", Password: "password"},
+			expectedErr: "the `remote.username` field is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewConfig()
+			cfg.Channel = "application"
+			cfg.Remote = tt.remote
+			op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.remote, op.(*Input).remote)
+		})
+	}
+}
@@ -28,6 +28,8 @@ type Input struct {
 	startAt          string
 	raw              bool
 	excludeProviders []string
+	remote           RemoteConfig
+	session          Session
 	pollInterval     time.Duration
 	persister        operator.Persister
 	publisherCache   publisherCache
@@ -55,12 +57,19 @@ func (i *Input) Start(persister operator.Persister) error {
 		}
 	}
 
-	i.subscription = NewSubscription()
+	i.session = NewSession()
+	if i.remote.Server != "" {
+		if err := i.session.Open(i.remote); err != nil {
+			return fmt.Errorf("failed to open remote session: %w", err)
+		}
+	}
+
+	i.subscription = NewRemoteSubscription(i.session)
 	if err := i.subscription.Open(i.channel, i.startAt, i.bookmark); err != nil {
 		return fmt.Errorf("failed to open subscription: %w", err)
 	}
 
-	i.publisherCache = newPublisherCache()
+	i.publisherCache = newRemotePublisherCache(i.session)
 
 	i.wg.Add(1)
 	go i.readOnInterval(ctx)
@@ -84,6 +93,10 @@ func (i *Input) Stop() error {
 		return fmt.Errorf("failed to close publishers: %w", err)
 	}
 
+	if err := i.session.Close(); err != nil {
+		return fmt.Errorf("failed to close remote session: %w", err)
+	}
+
 	return nil
 }
 
@@ -12,7 +12,8 @@ import (
 
 // Publisher is a windows event metadata publisher.
 type Publisher struct {
-	handle uintptr
+	handle  uintptr
+	session uintptr
 }
 
 // Open will open the publisher handle using the supplied provider.
@@ -26,7 +27,7 @@ func (p *Publisher) Open(provider string) error {
 		return fmt.Errorf("failed to convert the provider name %q to utf16: %w", provider, err)
 	}
 
-	handle, err := evtOpenPublisherMetadata(0, utf16, nil, 0, 0)
+	handle, err := evtOpenPublisherMetadata(p.session, utf16, nil, 0, 0)
 	if err != nil {
 		return fmt.Errorf("failed to open the metadata for the %q provider: %w", provider, err)
 	}
@@ -10,12 +10,19 @@ import (
 )
 
 type publisherCache struct {
-	cache map[string]Publisher
+	cache   map[string]Publisher
+	session uintptr
 }
 
 func newPublisherCache() publisherCache {
+	return newRemotePublisherCache(NewSession())
+}
+
+// newRemotePublisherCache creates a cache of the publishers of the session's server.
+func newRemotePublisherCache(session Session) publisherCache {
 	return publisherCache{
-		cache: make(map[string]Publisher),
+		cache:   make(map[string]Publisher),
+		session: session.handle,
 	}
 }
 
@@ -26,6 +33,7 @@ func (c *publisherCache) get(provider string) (publisher Publisher, openPublishe
 	}
 
 	publisher = NewPublisher()
+	publisher.session = c.session
 	err := publisher.Open(provider)
 
 	// Always store the publisher even if there was an error opening it.
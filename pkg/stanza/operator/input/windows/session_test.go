@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package windows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionOpenPreexisting(t *testing.T) {
+	session := Session{handle: 5}
+	err := session.Open(RemoteConfig{Server: "server"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "session handle is already open")
+}
+
+func TestSessionOpenInvalidUTF8(t *testing.T) {
+	session := NewSession()
+	err := session.Open(RemoteConfig{Server: "\u0000"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to convert server to utf16")
+	require.Zero(t, session.handle)
+}
+
+func TestSessionOpenSyscallFailure(t *testing.T) {
+	session := NewSession()
+	defer mockWithDeferredRestore(&openSessionProc, SimpleMockProc(0, 0, ErrorNotSupported))()
+	err := session.Open(RemoteConfig{Server: "server"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to open session on server: The request is not supported.")
+	require.Zero(t, session.handle)
+}
+
+func TestSessionOpenSuccess(t *testing.T) {
+	session := NewSession()
+	defer mockWithDeferredRestore(&openSessionProc, SimpleMockProc(5, 0, ErrorSuccess))()
+	err := session.Open(RemoteConfig{Server: "server", Username: "user", Password: "password", Domain: "domain"})
+	require.NoError(t, err)
+	require.Equal(t, uintptr(5), session.handle)
+}
+
+func TestSessionCloseWhenAlreadyClosed(t *testing.T) {
+	session := NewSession()
+	require.NoError(t, session.Close())
+}
+
+func TestSessionCloseSyscallFailure(t *testing.T) {
+	session := Session{handle: 5}
+	defer mockWithDeferredRestore(&closeProc, SimpleMockProc(0, 0, ErrorNotSupported))()
+	err := session.Close()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to close session handle")
+	require.Equal(t, uintptr(5), session.handle)
+}
+
+func TestSessionCloseSuccess(t *testing.T) {
+	session := Session{handle: 5}
+	defer mockWithDeferredRestore(&closeProc, SimpleMockProc(1, 0, ErrorSuccess))()
+	require.NoError(t, session.Close())
+	require.Zero(t, session.handle)
+}
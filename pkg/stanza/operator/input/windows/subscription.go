@@ -15,7 +15,8 @@ import (
 
 // Subscription is a subscription to a windows eventlog channel.
 type Subscription struct {
-	handle uintptr
+	handle  uintptr
+	session uintptr
 }
 
 // Open will open the subscription handle.
@@ -38,7 +39,7 @@ func (s *Subscription) Open(channel string, startAt string, bookmark Bookmark) e
 	}
 
 	flags := s.createFlags(startAt, bookmark)
-	subscriptionHandle, err := evtSubscribe(0, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
+	subscriptionHandle, err := evtSubscribe(s.session, signalEvent, channelPtr, nil, bookmark.handle, 0, 0, flags)
 	if err != nil {
 		return fmt.Errorf("failed to subscribe to %s channel: %w", channel, err)
 	}
@@ -111,3 +112,11 @@ func NewSubscription() Subscription {
 		handle: 0,
 	}
 }
+
+// NewRemoteSubscription will create a new subscription to a channel of the session's server.
+func NewRemoteSubscription(session Session) Subscription {
+	return Subscription{
+		handle:  0,
+		session: session.handle,
+	}
+}
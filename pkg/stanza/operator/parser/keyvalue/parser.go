@@ -5,13 +5,20 @@ package keyvalue // import "github.com/open-telemetry/opentelemetry-collector-co
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+
+	"go.uber.org/multierr"
 
-	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/parseutils"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
 
+const quoteChars = `"'`
+
+var errUnclosedQuote = errors.New("never reached the end of a quoted value")
+
 // Parser is an operator that parses key value pairs.
 type Parser struct {
 	helper.ParserOperator
@@ -39,10 +46,105 @@ func (p *Parser) parser(input string, delimiter string, pairDelimiter string) (m
 		return nil, fmt.Errorf("parse from field %s is empty", p.ParseFrom.String())
 	}
 
-	pairs, err := parseutils.SplitString(input, pairDelimiter)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse pairs from input: %w", err)
+	if !strings.ContainsAny(input, quoteChars) {
+		return parseUnquoted(input, delimiter, pairDelimiter)
+	}
+	return parseQuoted(input, delimiter, pairDelimiter)
+}
+
+// parseUnquoted is the fast path for input without any quotes, where every pair delimiter
+// separates a pair and the first delimiter of each pair separates its key from its value.
+func parseUnquoted(input string, delimiter string, pairDelimiter string) (map[string]any, error) {
+	parsed := make(map[string]any)
+	var err error
+	for _, pair := range strings.Split(input, pairDelimiter) {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, delimiter)
+		if !ok {
+			err = multierr.Append(err, missingDelimiterError(pair))
+			continue
+		}
+		parsed[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return parsed, err
+}
+
+// parseQuoted scans the input once, honoring quotes at the start of a key or a value. Delimiters
+// and pair delimiters inside quotes are kept, and the quote character or a backslash can be escaped
+// with a backslash. A quote anywhere else, e.g. in O'Brien, is a literal character.
+func parseQuoted(input string, delimiter string, pairDelimiter string) (map[string]any, error) {
+	parsed := make(map[string]any)
+	var err error
+
+	var key, value strings.Builder
+	current := &key
+	inValue := false
+	pairStart := 0
+
+	endPair := func(end int) {
+		if inValue {
+			parsed[strings.TrimSpace(key.String())] = strings.TrimSpace(value.String())
+		} else if pair := input[pairStart:end]; strings.TrimSpace(pair) != "" {
+			err = multierr.Append(err, missingDelimiterError(pair))
+		}
+		key.Reset()
+		value.Reset()
+		current = &key
+		inValue = false
+	}
+
+	for i := 0; i < len(input); {
+		if strings.HasPrefix(input[i:], pairDelimiter) {
+			endPair(i)
+			i += len(pairDelimiter)
+			pairStart = i
+			continue
+		}
+		if !inValue && strings.HasPrefix(input[i:], delimiter) {
+			current = &value
+			inValue = true
+			i += len(delimiter)
+			continue
+		}
+
+		c := input[i]
+		if strings.IndexByte(quoteChars, c) >= 0 && strings.TrimSpace(current.String()) == "" {
+			end, ok := readQuoted(input, i, current)
+			if !ok {
+				return nil, errUnclosedQuote
+			}
+			i = end
+			continue
+		}
+
+		current.WriteByte(c)
+		i++
+	}
+	endPair(len(input))
+
+	return parsed, err
+}
+
+// readQuoted writes the content of the quoted string starting at input[start] to dest and returns
+// the index following the closing quote.
+func readQuoted(input string, start int, dest *strings.Builder) (int, bool) {
+	quote := input[start]
+	for i := start + 1; i < len(input); i++ {
+		switch c := input[i]; {
+		case c == '\\' && i+1 < len(input) && (input[i+1] == quote || input[i+1] == '\\'):
+			i++
+			dest.WriteByte(input[i])
+		case c == quote:
+			return i + 1, true
+		default:
+			dest.WriteByte(c)
+		}
 	}
+	return 0, false
+}
 
-	return parseutils.ParseKeyValuePairs(pairs, delimiter)
+func missingDelimiterError(pair string) error {
+	return fmt.Errorf("cannot split %q into 2 items, got 1 item(s)", pair)
 }
@@ -17,7 +17,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
 )
 
-func newTestParser(t *testing.T) *Parser {
+func newTestParser(t testing.TB) *Parser {
 	config := NewConfigWithID("test")
 	set := componenttest.NewNopTelemetrySettings()
 	op, err := config.Build(set)
@@ -680,6 +680,95 @@ key=value`,
 			false,
 			false,
 		},
+		{
+			"escaped quotes in quoted value",
+			func(_ *Config) {},
+			&entry.Entry{
+				Body: `msg="he said \"hi\"" who='O\'Brien' path="C:\temp\\new"`,
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"msg":  `he said "hi"`,
+					"who":  `O'Brien`,
+					"path": `C:\temp\new`,
+				},
+				Body: `msg="he said \"hi\"" who='O\'Brien' path="C:\temp\\new"`,
+			},
+			false,
+			false,
+		},
+		{
+			"quote inside unquoted value",
+			func(_ *Config) {},
+			&entry.Entry{
+				Body: `user=O'Brien action=login note=5"`,
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"user":   "O'Brien",
+					"action": "login",
+					"note":   `5"`,
+				},
+				Body: `user=O'Brien action=login note=5"`,
+			},
+			false,
+			false,
+		},
+		{
+			"quoted key contains delimiter",
+			func(_ *Config) {},
+			&entry.Entry{
+				Body: `"a=b"=c d=e`,
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"a=b": "c",
+					"d":   "e",
+				},
+				Body: `"a=b"=c d=e`,
+			},
+			false,
+			false,
+		},
+		{
+			"quoted value contains delimiters and pair delimiters",
+			func(kv *Config) {
+				kv.Delimiter = ":"
+				kv.PairDelimiter = ";"
+			},
+			&entry.Entry{
+				Body: `rule:"id:942100; SQL injection";uri:/login?a=b;action:'block; log'`,
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"rule":   "id:942100; SQL injection",
+					"uri":    "/login?a=b",
+					"action": "block; log",
+				},
+				Body: `rule:"id:942100; SQL injection";uri:/login?a=b;action:'block; log'`,
+			},
+			false,
+			false,
+		},
+		{
+			"waf log",
+			func(_ *Config) {},
+			&entry.Entry{
+				Body: `action=blocked src="10.1.2.3" uri="/search?q=a b" msg="Matched \"union select\" in ARGS:q" severity=critical`,
+			},
+			&entry.Entry{
+				Attributes: map[string]any{
+					"action":   "blocked",
+					"src":      "10.1.2.3",
+					"uri":      "/search?q=a b",
+					"msg":      `Matched "union select" in ARGS:q`,
+					"severity": "critical",
+				},
+				Body: `action=blocked src="10.1.2.3" uri="/search?q=a b" msg="Matched \"union select\" in ARGS:q" severity=critical`,
+			},
+			false,
+			false,
+		},
 		{
 			"unclosed quotes",
 			func(_ *Config) {},
@@ -725,3 +814,23 @@ key=value`,
 		})
 	}
 }
+
+func BenchmarkParse(b *testing.B) {
+	benchmarks := map[string]string{
+		"unquoted": "name=stanza age=1 dst=172.217.0.10 protocol=udp sport=57112 dport=443 translated_src_ip=96.63.176.3 translated_port=57112",
+		"quoted":   `name=stanza age=1 job="software engineering" location="grand rapids michigan" msg="Matched \"union select\"" dport=443`,
+	}
+	for name, input := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			parser := newTestParser(b)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, err := parser.parse(input)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -21,9 +22,13 @@ const (
 
 	RFC3164 = "rfc3164"
 	RFC5424 = "rfc5424"
+	// Auto detects the protocol and the octet counting framing of every message.
+	Auto = "auto"
 
 	NULTrailer = "NUL"
 	LFTrailer  = "LF"
+
+	messagesMetric = "syslog/messages"
 )
 
 func init() {
@@ -55,6 +60,7 @@ type BaseConfig struct {
 	EnableOctetCounting          bool    `mapstructure:"enable_octet_counting,omitempty"`
 	AllowSkipPriHeader           bool    `mapstructure:"allow_skip_pri_header,omitempty"`
 	NonTransparentFramingTrailer *string `mapstructure:"non_transparent_framing_trailer,omitempty"`
+	Profile                      string  `mapstructure:"profile,omitempty"`
 }
 
 // Build will build a JSON parser operator.
@@ -77,6 +83,8 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 	switch {
 	case proto == "":
 		return nil, fmt.Errorf("missing field 'protocol'")
+	case proto == Auto && (c.NonTransparentFramingTrailer != nil || c.EnableOctetCounting):
+		return nil, errors.New("octet_counting and non_transparent_framing cannot be enabled with protocol auto, the framing is detected automatically")
 	case proto != RFC5424 && (c.NonTransparentFramingTrailer != nil || c.EnableOctetCounting):
 		return nil, errors.New("octet_counting and non_transparent_framing are only compatible with protocol rfc5424")
 	case proto == RFC5424 && (c.NonTransparentFramingTrailer != nil && c.EnableOctetCounting):
@@ -85,7 +93,7 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		if *c.NonTransparentFramingTrailer != NULTrailer && *c.NonTransparentFramingTrailer != LFTrailer {
 			return nil, fmt.Errorf("invalid non_transparent_framing_trailer '%s'. Must be either 'LF' or 'NUL'", *c.NonTransparentFramingTrailer)
 		}
-	case proto != RFC5424 && proto != RFC3164:
+	case proto != RFC5424 && proto != RFC3164 && proto != Auto:
 		return nil, fmt.Errorf("unsupported protocol version: %s", proto)
 	}
 
@@ -98,12 +106,30 @@ func (c Config) Build(set component.TelemetrySettings) (operator.Operator, error
 		return nil, fmt.Errorf("failed to load location %s: %w", c.Location, err)
 	}
 
+	var prof *profile
+	if c.Profile != "" {
+		if prof, err = loadProfile(c.Profile); err != nil {
+			return nil, err
+		}
+	}
+
+	messages, err := set.MeterProvider.Meter("otelcol/syslog").Int64Counter(
+		messagesMetric,
+		metric.WithDescription("Number of messages parsed with protocol auto, by detected format"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Parser{
 		ParserOperator:               parserOperator,
+		messages:                     messages,
 		protocol:                     proto,
 		location:                     location,
 		enableOctetCounting:          c.EnableOctetCounting,
 		allowSkipPriHeader:           c.AllowSkipPriHeader,
 		nonTransparentFramingTrailer: c.NonTransparentFramingTrailer,
+		profile:                      prof,
 	}, nil
 }
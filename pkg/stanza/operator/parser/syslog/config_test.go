@@ -40,6 +40,23 @@ func TestUnmarshal(t *testing.T) {
 					return cfg
 				}(),
 			},
+			{
+				Name: "auto",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Protocol = Auto
+					return cfg
+				}(),
+			},
+			{
+				Name: "profile",
+				Expect: func() *Config {
+					cfg := NewConfig()
+					cfg.Protocol = RFC3164
+					cfg.Profile = "cisco_asa"
+					return cfg
+				}(),
+			},
 			{
 				Name: "location",
 				Expect: func() *Config {
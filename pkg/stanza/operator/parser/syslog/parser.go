@@ -15,12 +15,28 @@ import (
 	"github.com/leodido/go-syslog/v4/octetcounting"
 	"github.com/leodido/go-syslog/v4/rfc3164"
 	"github.com/leodido/go-syslog/v4/rfc5424"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/helper"
 )
 
-var priRegex = regexp.MustCompile(`\<\d{1,3}\>`)
+var (
+	priRegex = regexp.MustCompile(`\<\d{1,3}\>`)
+	// octetCountRegex matches the count of an octet-counted message, followed by its PRI.
+	octetCountRegex = regexp.MustCompile(`^[1-9]\d*\s<`)
+	// rfc5424Regex matches the start of an RFC5424 message, its VERSION follows the PRI of the message.
+	rfc5424Regex = regexp.MustCompile(`^(<\d{1,3}>)?[1-9]\d{0,2} `)
+)
+
+// Formats detected with protocol auto, a message is sent raw if it cannot be parsed.
+const (
+	formatRFC3164 = RFC3164
+	formatRFC5424 = RFC5424
+	formatRaw     = "raw"
+)
 
 // parseFunc a parseFunc determines how the raw input is to be parsed into a syslog message
 type parseFunc func(input []byte) (sl.Message, error)
@@ -33,10 +49,15 @@ type Parser struct {
 	enableOctetCounting          bool
 	allowSkipPriHeader           bool
 	nonTransparentFramingTrailer *string
+	messages                     metric.Int64Counter
+	profile                      *profile
 }
 
 // Process will parse an entry field as syslog.
 func (p *Parser) Process(ctx context.Context, entry *entry.Entry) error {
+	if p.protocol == Auto {
+		return p.processAuto(ctx, entry)
+	}
 
 	// if pri header is missing and this is an expected behavior then facility and severity values should be skipped.
 	if !p.enableOctetCounting && p.allowSkipPriHeader {
@@ -86,12 +107,7 @@ func (p *Parser) parse(value any) (any, error) {
 func (p *Parser) buildParseFunc() (parseFunc, error) {
 	switch p.protocol {
 	case RFC3164:
-		return func(input []byte) (sl.Message, error) {
-			if p.allowSkipPriHeader && !priRegex.Match(input) {
-				input = append([]byte("<0>"), input...)
-			}
-			return rfc3164.NewMachine(rfc3164.WithLocaleTimezone(p.location)).Parse(input)
-		}, nil
+		return p.parseRFC3164Func(), nil
 	case RFC5424:
 		switch {
 		// Octet Counting Parsing RFC6587
@@ -104,12 +120,7 @@ func (p *Parser) buildParseFunc() (parseFunc, error) {
 			return newNonTransparentFramingParseFunc(nontransparent.NUL), nil
 		// Raw RFC5424 parsing
 		default:
-			return func(input []byte) (sl.Message, error) {
-				if p.allowSkipPriHeader && !priRegex.Match(input) {
-					input = append([]byte("<0>"), input...)
-				}
-				return rfc5424.NewMachine().Parse(input)
-			}, nil
+			return p.parseRFC5424Func(), nil
 		}
 
 	default:
@@ -117,6 +128,100 @@ func (p *Parser) buildParseFunc() (parseFunc, error) {
 	}
 }
 
+func (p *Parser) parseRFC3164Func() parseFunc {
+	return func(input []byte) (sl.Message, error) {
+		if p.allowSkipPriHeader && !priRegex.Match(input) {
+			input = append([]byte("<0>"), input...)
+		}
+		return rfc3164.NewMachine(rfc3164.WithLocaleTimezone(p.location)).Parse(input)
+	}
+}
+
+func (p *Parser) parseRFC5424Func() parseFunc {
+	return func(input []byte) (sl.Message, error) {
+		if p.allowSkipPriHeader && !priRegex.Match(input) {
+			input = append([]byte("<0>"), input...)
+		}
+		return rfc5424.NewMachine().Parse(input)
+	}
+}
+
+// processAuto parses an entry with the protocol detected from the message. The entry is sent as is
+// if the message cannot be parsed with the detected protocol.
+func (p *Parser) processAuto(ctx context.Context, entry *entry.Entry) error {
+	skip, err := p.Skip(ctx, entry)
+	if err != nil {
+		return p.HandleEntryError(ctx, entry, err)
+	}
+	if skip {
+		p.Write(ctx, entry)
+		return nil
+	}
+
+	value, ok := entry.Get(p.ParseFrom)
+	if !ok {
+		return p.ProcessWith(ctx, entry, p.parse)
+	}
+	input, err := toBytes(value)
+	if err != nil {
+		return p.ProcessWith(ctx, entry, p.parse)
+	}
+
+	parsed, format, err := p.parseAuto(input)
+	p.messages.Add(ctx, 1, metric.WithAttributes(attribute.String("format", format)))
+	if err != nil {
+		p.Logger().Debug("Failed to parse syslog message, sending it raw", zap.Error(err))
+		p.Write(ctx, entry)
+		return nil
+	}
+
+	if err = p.ParseWith(ctx, entry, func(any) (any, error) { return parsed, nil }); err != nil {
+		return err
+	}
+	cb := postprocess
+	if p.shouldSkipPriorityValues(input) {
+		cb = postprocessWithoutPriHeader
+	}
+	if err = cb(entry); err != nil {
+		return err
+	}
+	p.Write(ctx, entry)
+	return nil
+}
+
+// parseAuto detects the framing and the protocol of a message, and parses it. The returned format is
+// the detected protocol, or raw if the message cannot be parsed.
+func (p *Parser) parseAuto(input []byte) (map[string]any, string, error) {
+	if loc := octetCountRegex.FindIndex(input); loc != nil {
+		input = input[loc[1]-1:]
+	}
+	input = bytes.TrimRight(input, "\r\n\x00")
+
+	format, pFunc := formatRFC3164, p.parseRFC3164Func()
+	if rfc5424Regex.Match(input) {
+		format, pFunc = formatRFC5424, p.parseRFC5424Func()
+	}
+	slog, err := pFunc(input)
+	if err != nil {
+		return nil, formatRaw, err
+	}
+
+	var parsed map[string]any
+	skipPriHeaderValues := p.shouldSkipPriorityValues(input)
+	switch message := slog.(type) {
+	case *rfc3164.SyslogMessage:
+		parsed, err = p.parseRFC3164(message, skipPriHeaderValues)
+	case *rfc5424.SyslogMessage:
+		parsed, err = p.parseRFC5424(message, skipPriHeaderValues)
+	default:
+		err = fmt.Errorf("parsed value was not rfc3164 or rfc5424 compliant")
+	}
+	if err != nil {
+		return nil, formatRaw, err
+	}
+	return parsed, format, nil
+}
+
 func (p *Parser) shouldSkipPriorityValues(value []byte) bool {
 	if !p.enableOctetCounting && p.allowSkipPriHeader {
 		// check if entry starts with '<'.
@@ -145,7 +250,7 @@ func (p *Parser) parseRFC3164(syslogMessage *rfc3164.SyslogMessage, skipPriHeade
 		value["facility"] = syslogMessage.Facility
 	}
 
-	return p.toSafeMap(value)
+	return p.toProfiledMap(value)
 }
 
 // parseRFC5424 will parse an RFC5424 syslog message.
@@ -167,7 +272,26 @@ func (p *Parser) parseRFC5424(syslogMessage *rfc5424.SyslogMessage, skipPriHeade
 		value["facility"] = syslogMessage.Facility
 	}
 
-	return p.toSafeMap(value)
+	return p.toProfiledMap(value)
+}
+
+// toProfiledMap dereferences the pointers on the supplied map, and adds the attributes
+// parsed from the message by the profile.
+func (p *Parser) toProfiledMap(message map[string]any) (map[string]any, error) {
+	parsed, err := p.toSafeMap(message)
+	if err != nil || p.profile == nil {
+		return parsed, err
+	}
+
+	text, _ := parsed["message"].(string)
+	if p.profile.apply(text, parsed) {
+		return parsed, nil
+	}
+	// Appliances often send the vendor tag of the message where the syslog tag is expected.
+	if appname, ok := parsed["appname"].(string); ok {
+		p.profile.apply(appname+": "+text, parsed)
+	}
+	return parsed, nil
 }
 
 // toSafeMap will dereference any pointers on the supplied map.
@@ -8,8 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
@@ -82,7 +86,7 @@ func TestSyslogParseRFC5424_SDNameTooLong(t *testing.T) {
 }
 
 func TestSyslogProtocolConfig(t *testing.T) {
-	for _, proto := range []string{"RFC5424", "rfc5424", "RFC3164", "rfc3164"} {
+	for _, proto := range []string{"RFC5424", "rfc5424", "RFC3164", "rfc3164", "AUTO", "auto"} {
 		cfg := basicConfig()
 		cfg.Protocol = proto
 		set := componenttest.NewNopTelemetrySettings()
@@ -98,3 +102,124 @@ func TestSyslogProtocolConfig(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+func TestSyslogParseAuto(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		format   string
+		severity entry.Severity
+		appname  string
+	}{
+		{
+			name:     "rfc3164",
+			body:     "<34>Jan 12 06:30:00 1.2.3.4 apache_server: test message",
+			format:   formatRFC3164,
+			severity: entry.Error2,
+			appname:  "apache_server",
+		},
+		{
+			name:     "rfc5424",
+			body:     "<86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 - Found the user for retrieving user's profile",
+			format:   formatRFC5424,
+			severity: entry.Info,
+			appname:  "SecureAuth0",
+		},
+		{
+			name:     "rfc5424 octet counting",
+			body:     "91 <86>1 2015-08-05T21:58:59.693Z 192.168.2.132 SecureAuth0 23108 ID52020 - Found the user",
+			format:   formatRFC5424,
+			severity: entry.Info,
+			appname:  "SecureAuth0",
+		},
+		{
+			name:     "rfc3164 octet counting with trailer",
+			body:     "56 <34>Jan 12 06:30:00 1.2.3.4 apache_server: test message\n",
+			format:   formatRFC3164,
+			severity: entry.Error2,
+			appname:  "apache_server",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := basicConfig()
+			cfg.Protocol = Auto
+			reader := sdkmetric.NewManualReader()
+			set := componenttest.NewNopTelemetrySettings()
+			set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+			op, err := cfg.Build(set)
+			require.NoError(t, err)
+
+			fake := testutil.NewFakeOutput(t)
+			require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+			newEntry := entry.New()
+			newEntry.Body = tc.body
+			require.NoError(t, op.Process(context.Background(), newEntry))
+
+			select {
+			case e := <-fake.Received:
+				assert.Equal(t, tc.severity, e.Severity)
+				assert.Equal(t, tc.appname, e.Attributes["appname"])
+				assert.Equal(t, tc.body, e.Body)
+			case <-time.After(time.Second):
+				require.FailNow(t, "Timed out waiting for entry to be processed")
+			}
+			assertMessagesMetric(t, reader, map[string]int64{tc.format: 1})
+		})
+	}
+}
+
+func TestSyslogParseAutoRawFallback(t *testing.T) {
+	cfg := basicConfig()
+	cfg.Protocol = Auto
+	reader := sdkmetric.NewManualReader()
+	set := componenttest.NewNopTelemetrySettings()
+	set.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	op, err := cfg.Build(set)
+	require.NoError(t, err)
+
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	for _, body := range []string{"not a syslog message", "<86>1 2015-08-05T21:58:59 invalid timestamp"} {
+		newEntry := entry.New()
+		newEntry.Body = body
+		require.NoError(t, op.Process(context.Background(), newEntry))
+
+		select {
+		case e := <-fake.Received:
+			assert.Equal(t, body, e.Body)
+			assert.Empty(t, e.Attributes)
+			assert.Equal(t, entry.Default, e.Severity)
+		case <-time.After(time.Second):
+			require.FailNow(t, "Timed out waiting for entry to be processed")
+		}
+	}
+	assertMessagesMetric(t, reader, map[string]int64{formatRaw: 2})
+}
+
+func TestSyslogParseAutoInvalidFraming(t *testing.T) {
+	cfg := basicConfig()
+	cfg.Protocol = Auto
+	cfg.EnableOctetCounting = true
+	_, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.ErrorContains(t, err, "cannot be enabled with protocol auto")
+}
+
+func assertMessagesMetric(t *testing.T, reader *sdkmetric.ManualReader, expected map[string]int64) {
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	m := rm.ScopeMetrics[0].Metrics[0]
+	require.Equal(t, messagesMetric, m.Name)
+
+	actual := map[string]int64{}
+	for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+		format, _ := dp.Attributes.Value(attribute.Key("format"))
+		actual[format.AsString()] = dp.Value
+	}
+	assert.Equal(t, expected, actual)
+}
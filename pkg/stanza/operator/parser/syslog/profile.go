@@ -0,0 +1,292 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/parser/syslog"
+
+import (
+	"embed"
+	"encoding/csv"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Formats of the vendor messages a profile can parse.
+const (
+	profileFormatRegex = "regex"
+	profileFormatCSV   = "csv"
+	profileFormatKV    = "kv"
+)
+
+//go:embed profiles/*.yaml
+var profileFiles embed.FS
+
+// profileGrammar is the definition of a profile, as read from its grammar file.
+type profileGrammar struct {
+	// Format is how the message is split into fields: with the named groups of the
+	// pattern of each rule, by column index, or by key of key=value pairs.
+	Format string `yaml:"format"`
+	// Attributes are set on every message matched by at least one rule.
+	Attributes map[string]string `yaml:"attributes"`
+	Rules      []ruleGrammar     `yaml:"rules"`
+}
+
+type ruleGrammar struct {
+	// Pattern matches the message, it is required with the regex format.
+	Pattern string `yaml:"pattern"`
+	// Require restricts the rule to the messages that have all the given fields.
+	Require []string `yaml:"require"`
+	// When restricts the rule to the messages whose fields have the given values.
+	When map[string]string `yaml:"when"`
+	// Attributes are set on every message matched by the rule.
+	Attributes map[string]string       `yaml:"attributes"`
+	Fields     map[string]fieldGrammar `yaml:"fields"`
+}
+
+// fieldGrammar maps a field of the message to an attribute. It can be written as the
+// name of the field, or as a map with the name of the field, the type of the value and
+// a translation of the values of the field.
+type fieldGrammar struct {
+	From   string            `yaml:"from"`
+	Type   string            `yaml:"type"`
+	Values map[string]string `yaml:"values"`
+}
+
+func (f *fieldGrammar) UnmarshalYAML(unmarshal func(any) error) error {
+	var from string
+	if err := unmarshal(&from); err == nil {
+		f.From = from
+		return nil
+	}
+	type plain fieldGrammar
+	return unmarshal((*plain)(f))
+}
+
+// profile parses the vendor specific format of the syslog messages of an appliance.
+type profile struct {
+	format     string
+	attributes map[string]string
+	rules      []profileRule
+}
+
+type profileRule struct {
+	pattern    *regexp.Regexp
+	require    []string
+	when       map[string]string
+	attributes map[string]string
+	fields     map[string]fieldGrammar
+}
+
+// profileNames lists the built-in profiles.
+func profileNames() []string {
+	entries, _ := profileFiles.ReadDir("profiles")
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, strings.TrimSuffix(e.Name(), path.Ext(e.Name())))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadProfile compiles the built-in profile with the given name.
+func loadProfile(name string) (*profile, error) {
+	data, err := profileFiles.ReadFile(path.Join("profiles", name+".yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported profile '%s', must be one of %v", name, profileNames())
+	}
+	return newProfile(data)
+}
+
+func newProfile(data []byte) (*profile, error) {
+	var grammar profileGrammar
+	if err := yaml.UnmarshalStrict(data, &grammar); err != nil {
+		return nil, fmt.Errorf("invalid profile grammar: %w", err)
+	}
+
+	switch grammar.Format {
+	case profileFormatRegex, profileFormatCSV, profileFormatKV:
+	default:
+		return nil, fmt.Errorf("invalid profile format '%s'", grammar.Format)
+	}
+
+	p := &profile{
+		format:     grammar.Format,
+		attributes: grammar.Attributes,
+		rules:      make([]profileRule, 0, len(grammar.Rules)),
+	}
+	for i, r := range grammar.Rules {
+		rule := profileRule{require: r.Require, when: r.When, attributes: r.Attributes, fields: r.Fields}
+		switch {
+		case grammar.Format == profileFormatRegex && r.Pattern == "":
+			return nil, fmt.Errorf("rule %d: missing pattern", i)
+		case grammar.Format != profileFormatRegex && r.Pattern != "":
+			return nil, fmt.Errorf("rule %d: pattern is only supported with the regex format", i)
+		case grammar.Format != profileFormatRegex && len(r.Require) == 0 && len(r.When) == 0:
+			return nil, fmt.Errorf("rule %d: one of require or when is needed with the %s format", i, grammar.Format)
+		case r.Pattern != "":
+			pattern, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+			rule.pattern = pattern
+		}
+		for attr, field := range r.Fields {
+			if field.From == "" {
+				return nil, fmt.Errorf("rule %d: missing field of attribute '%s'", i, attr)
+			}
+			if field.Type != "" && field.Type != "int" && field.Type != "string" && field.Type != "lower" {
+				return nil, fmt.Errorf("rule %d: invalid type '%s' of attribute '%s'", i, field.Type, attr)
+			}
+		}
+		p.rules = append(p.rules, rule)
+	}
+	return p, nil
+}
+
+// apply adds the attributes of the rules matching the message to dest. The attributes of
+// the profile are only added if at least one rule matches, apply reports whether one did.
+func (p *profile) apply(message string, dest map[string]any) bool {
+	var values map[string]string
+	switch p.format {
+	case profileFormatCSV:
+		values = splitColumns(message)
+	case profileFormatKV:
+		values = splitPairs(message)
+	}
+
+	matched := false
+	for _, rule := range p.rules {
+		fields := values
+		if rule.pattern != nil {
+			if fields = matchGroups(rule.pattern, message); fields == nil {
+				continue
+			}
+		}
+		if !rule.matches(fields) {
+			continue
+		}
+		matched = true
+		for k, v := range rule.attributes {
+			dest[k] = v
+		}
+		for attr, field := range rule.fields {
+			if value, ok := fields[field.From]; ok && value != "" {
+				dest[attr] = field.convert(value)
+			}
+		}
+	}
+
+	if matched {
+		for k, v := range p.attributes {
+			dest[k] = v
+		}
+	}
+	return matched
+}
+
+func (r profileRule) matches(fields map[string]string) bool {
+	if fields == nil {
+		return false
+	}
+	for _, field := range r.require {
+		if _, ok := fields[field]; !ok {
+			return false
+		}
+	}
+	for field, expected := range r.when {
+		if fields[field] != expected {
+			return false
+		}
+	}
+	return true
+}
+
+func (f fieldGrammar) convert(value string) any {
+	if translated, ok := f.Values[value]; ok {
+		value = translated
+	}
+	switch f.Type {
+	case "int":
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	case "lower":
+		return strings.ToLower(value)
+	}
+	return value
+}
+
+func matchGroups(pattern *regexp.Regexp, message string) map[string]string {
+	matches := pattern.FindStringSubmatch(message)
+	if matches == nil {
+		return nil
+	}
+	groups := make(map[string]string, len(matches))
+	for i, name := range pattern.SubexpNames() {
+		if name != "" {
+			groups[name] = matches[i]
+		}
+	}
+	return groups
+}
+
+// splitColumns splits a comma separated message into fields named by column index.
+func splitColumns(message string) map[string]string {
+	r := csv.NewReader(strings.NewReader(message))
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+	columns, err := r.Read()
+	if err != nil {
+		return nil
+	}
+	fields := make(map[string]string, len(columns))
+	for i, column := range columns {
+		fields[strconv.Itoa(i)] = column
+	}
+	return fields
+}
+
+// splitPairs splits a message of space separated key=value pairs, whose values may be quoted.
+func splitPairs(message string) map[string]string {
+	fields := map[string]string{}
+	for i := 0; i < len(message); {
+		for i < len(message) && message[i] == ' ' {
+			i++
+		}
+		eq := strings.IndexByte(message[i:], '=')
+		if eq <= 0 {
+			break
+		}
+		key := message[i : i+eq]
+		if strings.ContainsRune(key, ' ') {
+			// Skip the text preceding the first pair.
+			i += strings.LastIndexByte(key, ' ') + 1
+			continue
+		}
+		i += eq + 1
+
+		var value string
+		if i < len(message) && message[i] == '"' {
+			end := strings.IndexByte(message[i+1:], '"')
+			if end < 0 {
+				value, i = message[i+1:], len(message)
+			} else {
+				value, i = message[i+1:i+1+end], i+end+2
+			}
+		} else {
+			end := strings.IndexByte(message[i:], ' ')
+			if end < 0 {
+				value, i = message[i:], len(message)
+			} else {
+				value, i = message[i:i+end], i+end
+			}
+		}
+		fields[key] = value
+	}
+	return fields
+}
@@ -0,0 +1,279 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package syslog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/entry"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/testutil"
+)
+
+func TestBuiltInProfiles(t *testing.T) {
+	require.Equal(t, []string{"cisco_asa", "fortinet", "paloalto"}, profileNames())
+	for _, name := range profileNames() {
+		_, err := loadProfile(name)
+		require.NoError(t, err, name)
+	}
+
+	_, err := loadProfile("unknown")
+	require.ErrorContains(t, err, "unsupported profile 'unknown', must be one of [cisco_asa fortinet paloalto]")
+}
+
+func TestProfileApply(t *testing.T) {
+	tests := []struct {
+		profile  string
+		message  string
+		expected map[string]any
+	}{
+		{
+			profile: "cisco_asa",
+			message: "%ASA-6-302013: Built inbound TCP connection 123456 for outside:203.0.113.5/54321 (203.0.113.5/54321) to inside:10.0.0.5/443 (10.0.0.5/443)",
+			expected: map[string]any{
+				"device.manufacturer":             "Cisco",
+				"cisco.asa.product":               "ASA",
+				"cisco.asa.level":                 int64(6),
+				"cisco.asa.message_id":            "302013",
+				"cisco.asa.action":                "built",
+				"network.io.direction":            "receive",
+				"network.transport":               "tcp",
+				"cisco.asa.connection_id":         "123456",
+				"cisco.asa.source_interface":      "outside",
+				"source.address":                  "203.0.113.5",
+				"source.port":                     int64(54321),
+				"cisco.asa.destination_interface": "inside",
+				"destination.address":             "10.0.0.5",
+				"destination.port":                int64(443),
+			},
+		},
+		{
+			profile: "cisco_asa",
+			message: "%ASA-6-302014: Teardown TCP connection 123456 for outside:203.0.113.5/54321 to inside:10.0.0.5/443 duration 0:00:30 bytes 1234 TCP FINs",
+			expected: map[string]any{
+				"device.manufacturer":             "Cisco",
+				"cisco.asa.product":               "ASA",
+				"cisco.asa.level":                 int64(6),
+				"cisco.asa.message_id":            "302014",
+				"cisco.asa.action":                "teardown",
+				"network.transport":               "tcp",
+				"cisco.asa.connection_id":         "123456",
+				"cisco.asa.source_interface":      "outside",
+				"source.address":                  "203.0.113.5",
+				"source.port":                     int64(54321),
+				"cisco.asa.destination_interface": "inside",
+				"destination.address":             "10.0.0.5",
+				"destination.port":                int64(443),
+				"cisco.asa.duration":              "0:00:30",
+				"cisco.asa.bytes":                 int64(1234),
+			},
+		},
+		{
+			profile: "cisco_asa",
+			message: `%ASA-4-106023: Deny tcp src outside:203.0.113.5/54321 dst inside:10.0.0.5/22 by access-group "outside_in" [0x0, 0x0]`,
+			expected: map[string]any{
+				"device.manufacturer":             "Cisco",
+				"cisco.asa.product":               "ASA",
+				"cisco.asa.level":                 int64(4),
+				"cisco.asa.message_id":            "106023",
+				"cisco.asa.action":                "deny",
+				"network.transport":               "tcp",
+				"cisco.asa.source_interface":      "outside",
+				"source.address":                  "203.0.113.5",
+				"source.port":                     int64(54321),
+				"cisco.asa.destination_interface": "inside",
+				"destination.address":             "10.0.0.5",
+				"destination.port":                int64(22),
+				"cisco.asa.access_group":          "outside_in",
+			},
+		},
+		{
+			profile: "cisco_asa",
+			message: "%ASA-5-111008: User 'admin' executed the 'write memory' command.",
+			expected: map[string]any{
+				"device.manufacturer":  "Cisco",
+				"cisco.asa.product":    "ASA",
+				"cisco.asa.level":      int64(5),
+				"cisco.asa.message_id": "111008",
+			},
+		},
+		{
+			profile:  "cisco_asa",
+			message:  "not a cisco message",
+			expected: map[string]any{},
+		},
+		{
+			profile: "paloalto",
+			message: "1,2024/01/15 10:00:00,012345678901,TRAFFIC,end,2561,2024/01/15 10:00:00,10.0.0.5,203.0.113.5,192.0.2.1,203.0.113.5,allow-web,alice,,ssl,vsys1,trust,untrust,ethernet1/2,ethernet1/1,default,,4242,1,54321,443,12345,443,0x400064,tcp,allow,6000,1000,5000,20",
+			expected: map[string]any{
+				"device.manufacturer":         "Palo Alto Networks",
+				"paloalto.serial_number":      "012345678901",
+				"paloalto.type":               "TRAFFIC",
+				"paloalto.subtype":            "end",
+				"source.address":              "10.0.0.5",
+				"destination.address":         "203.0.113.5",
+				"paloalto.rule":               "allow-web",
+				"user.name":                   "alice",
+				"paloalto.application":        "ssl",
+				"paloalto.virtual_system":     "vsys1",
+				"paloalto.source_zone":        "trust",
+				"paloalto.destination_zone":   "untrust",
+				"paloalto.inbound_interface":  "ethernet1/2",
+				"paloalto.outbound_interface": "ethernet1/1",
+				"paloalto.session_id":         int64(4242),
+				"source.port":                 int64(54321),
+				"destination.port":            int64(443),
+				"network.transport":           "tcp",
+				"paloalto.action":             "allow",
+				"paloalto.bytes":              int64(6000),
+				"paloalto.bytes_sent":         int64(1000),
+				"paloalto.bytes_received":     int64(5000),
+				"paloalto.packets":            int64(20),
+			},
+		},
+		{
+			profile: "paloalto",
+			message: `1,2024/01/15 10:00:00,012345678901,THREAT,url,2561,2024/01/15 10:00:00,10.0.0.5,203.0.113.5,,,block-urls,,,web-browsing,vsys1,trust,untrust,ethernet1/2,ethernet1/1,default,,4243,1,54322,80,0,0,0x0,tcp,block-url,"example.com/bad",(9999),malware,informational,client-to-server`,
+			expected: map[string]any{
+				"device.manufacturer":         "Palo Alto Networks",
+				"paloalto.serial_number":      "012345678901",
+				"paloalto.type":               "THREAT",
+				"paloalto.subtype":            "url",
+				"source.address":              "10.0.0.5",
+				"destination.address":         "203.0.113.5",
+				"paloalto.rule":               "block-urls",
+				"paloalto.application":        "web-browsing",
+				"paloalto.virtual_system":     "vsys1",
+				"paloalto.source_zone":        "trust",
+				"paloalto.destination_zone":   "untrust",
+				"paloalto.inbound_interface":  "ethernet1/2",
+				"paloalto.outbound_interface": "ethernet1/1",
+				"paloalto.session_id":         int64(4243),
+				"source.port":                 int64(54322),
+				"destination.port":            int64(80),
+				"network.transport":           "tcp",
+				"paloalto.action":             "block-url",
+				"paloalto.url":                "example.com/bad",
+				"paloalto.threat_id":          "(9999)",
+				"paloalto.category":           "malware",
+				"paloalto.severity":           "informational",
+				"paloalto.direction":          "client-to-server",
+			},
+		},
+		{
+			profile:  "paloalto",
+			message:  "1,2024/01/15 10:00:00,012345678901,SYSTEM,general",
+			expected: map[string]any{},
+		},
+		{
+			profile: "fortinet",
+			message: `date=2024-01-15 time=10:00:00 devname="FGT60E" devid="FGT60E0000000000" logid="0000000013" type="traffic" subtype="forward" level="notice" vd="root" srcip=10.0.0.5 srcport=54321 srcintf="internal" dstip=203.0.113.5 dstport=443 dstintf="wan1" policyid=1 proto=6 action="accept" service="HTTPS" sentbyte=1234 rcvdbyte=5678`,
+			expected: map[string]any{
+				"device.manufacturer":            "Fortinet",
+				"fortinet.log_id":                "0000000013",
+				"fortinet.type":                  "traffic",
+				"fortinet.subtype":               "forward",
+				"fortinet.level":                 "notice",
+				"fortinet.device_name":           "FGT60E",
+				"fortinet.device_id":             "FGT60E0000000000",
+				"fortinet.virtual_domain":        "root",
+				"fortinet.action":                "accept",
+				"fortinet.policy_id":             int64(1),
+				"fortinet.service":               "HTTPS",
+				"fortinet.source_interface":      "internal",
+				"fortinet.destination_interface": "wan1",
+				"source.address":                 "10.0.0.5",
+				"source.port":                    int64(54321),
+				"destination.address":            "203.0.113.5",
+				"destination.port":               int64(443),
+				"network.transport":              "tcp",
+				"fortinet.sent_bytes":            int64(1234),
+				"fortinet.received_bytes":        int64(5678),
+			},
+		},
+		{
+			profile: "fortinet",
+			message: `devid="FGT60E0000000000" logid="0211008192" type="utm" subtype="virus" action="blocked" virus="EICAR_TEST_FILE" msg="File is infected."`,
+			expected: map[string]any{
+				"device.manufacturer": "Fortinet",
+				"fortinet.log_id":     "0211008192",
+				"fortinet.type":       "utm",
+				"fortinet.subtype":    "virus",
+				"fortinet.device_id":  "FGT60E0000000000",
+				"fortinet.action":     "blocked",
+				"fortinet.virus":      "EICAR_TEST_FILE",
+				"fortinet.message":    "File is infected.",
+			},
+		},
+		{
+			profile:  "fortinet",
+			message:  "a message with key=value pairs",
+			expected: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			p, err := loadProfile(tt.profile)
+			require.NoError(t, err)
+			dest := map[string]any{}
+			assert.Equal(t, len(tt.expected) > 0, p.apply(tt.message, dest))
+			assert.Equal(t, tt.expected, dest)
+		})
+	}
+}
+
+func TestInvalidProfileGrammar(t *testing.T) {
+	tests := []struct {
+		name     string
+		grammar  string
+		expected string
+	}{
+		{name: "format", grammar: "format: xml", expected: "invalid profile format 'xml'"},
+		{name: "unknown key", grammar: "format: kv\nrule: []", expected: "invalid profile grammar"},
+		{name: "missing pattern", grammar: "format: regex\nrules: [{fields: {a: b}}]", expected: "rule 0: missing pattern"},
+		{name: "pattern with csv", grammar: "format: csv\nrules: [{pattern: a, when: {'0': a}}]", expected: "rule 0: pattern is only supported with the regex format"},
+		{name: "unconditional kv", grammar: "format: kv\nrules: [{fields: {a: b}}]", expected: "rule 0: one of require or when is needed with the kv format"},
+		{name: "invalid pattern", grammar: "format: regex\nrules: [{pattern: '('}]", expected: "rule 0: error parsing regexp"},
+		{name: "invalid type", grammar: "format: regex\nrules: [{pattern: a, fields: {a: {from: b, type: float}}}]", expected: "rule 0: invalid type 'float' of attribute 'a'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newProfile([]byte(tt.grammar))
+			require.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
+func TestSyslogParseProfile(t *testing.T) {
+	cfg := basicConfig()
+	cfg.Protocol = RFC3164
+	cfg.Profile = "cisco_asa"
+
+	op, err := cfg.Build(componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	fake := testutil.NewFakeOutput(t)
+	require.NoError(t, op.SetOutputs([]operator.Operator{fake}))
+
+	e := entry.New()
+	e.Body = `<164>Jan 15 10:00:00 asa01 %ASA-4-106023: Deny udp src outside:203.0.113.5/5353 dst inside:10.0.0.5/53 by access-group "outside_in" [0x0, 0x0]`
+	require.NoError(t, op.Process(context.Background(), e))
+
+	select {
+	case e := <-fake.Received:
+		assert.Equal(t, "Cisco", e.Attributes["device.manufacturer"])
+		assert.Equal(t, "106023", e.Attributes["cisco.asa.message_id"])
+		assert.Equal(t, "udp", e.Attributes["network.transport"])
+		assert.Equal(t, "203.0.113.5", e.Attributes["source.address"])
+		assert.Equal(t, int64(53), e.Attributes["destination.port"])
+		assert.Equal(t, "asa01", e.Attributes["hostname"])
+	case <-time.After(time.Second):
+		require.FailNow(t, "Timed out waiting for entry to be processed")
+	}
+}
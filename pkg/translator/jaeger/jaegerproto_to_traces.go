@@ -24,7 +24,8 @@ import (
 var blankJaegerProtoSpan = new(model.Span)
 
 // ProtoToTraces converts multiple Jaeger proto batches to internal traces
-func ProtoToTraces(batches []*model.Batch) (ptrace.Traces, error) {
+func ProtoToTraces(batches []*model.Batch, opts ...Option) (ptrace.Traces, error) {
+	o := newOptions(opts)
 	traceData := ptrace.NewTraces()
 	if len(batches) == 0 {
 		return traceData, nil
@@ -39,7 +40,7 @@ func ProtoToTraces(batches []*model.Batch) (ptrace.Traces, error) {
 			continue
 		}
 
-		protoBatchToResourceSpans(*batch, rss.AppendEmpty())
+		protoBatchToResourceSpans(*batch, rss.AppendEmpty(), o)
 	}
 
 	return traceData, nil
@@ -114,19 +115,19 @@ func checksum(process *model.Process) uint64 {
 	return binary.BigEndian.Uint64(out)
 }
 
-func protoBatchToResourceSpans(batch model.Batch, dest ptrace.ResourceSpans) {
+func protoBatchToResourceSpans(batch model.Batch, dest ptrace.ResourceSpans, opts options) {
 	jSpans := batch.GetSpans()
 
-	jProcessToInternalResource(batch.GetProcess(), dest.Resource())
+	jProcessToInternalResource(batch.GetProcess(), dest.Resource(), opts)
 
 	if len(jSpans) == 0 {
 		return
 	}
 
-	jSpansToInternal(jSpans, dest.ScopeSpans())
+	jSpansToInternal(jSpans, dest.ScopeSpans(), opts)
 }
 
-func jProcessToInternalResource(process *model.Process, dest pcommon.Resource) {
+func jProcessToInternalResource(process *model.Process, dest pcommon.Resource, opts options) {
 	if process == nil || process.ServiceName == tracetranslator.ResourceNoServiceName {
 		return
 	}
@@ -144,7 +145,7 @@ func jProcessToInternalResource(process *model.Process, dest pcommon.Resource) {
 	} else {
 		attrs.EnsureCapacity(len(tags))
 	}
-	jTagsToInternalAttributes(tags, attrs)
+	jTagsToInternalAttributes(tags, attrs, opts)
 
 	// Handle special keys translations.
 	translateHostnameAttr(attrs)
@@ -175,7 +176,7 @@ type scope struct {
 	name, version string
 }
 
-func jSpansToInternal(spans []*model.Span, dest ptrace.ScopeSpansSlice) {
+func jSpansToInternal(spans []*model.Span, dest ptrace.ScopeSpansSlice, opts options) {
 	spansByLibrary := make(map[scope]ptrace.SpanSlice)
 
 	for _, span := range spans {
@@ -191,11 +192,11 @@ func jSpansToInternal(spans []*model.Span, dest ptrace.ScopeSpansSlice) {
 			sps = ss.Spans()
 			spansByLibrary[il] = sps
 		}
-		jSpanToInternal(span, sps.AppendEmpty())
+		jSpanToInternal(span, sps.AppendEmpty(), opts)
 	}
 }
 
-func jSpanToInternal(span *model.Span, dest ptrace.Span) {
+func jSpanToInternal(span *model.Span, dest ptrace.Span, opts options) {
 	dest.SetTraceID(idutils.UInt64ToTraceID(span.TraceID.High, span.TraceID.Low))
 	dest.SetSpanID(idutils.UInt64ToSpanID(uint64(span.SpanID)))
 	dest.SetName(span.OperationName)
@@ -209,7 +210,7 @@ func jSpanToInternal(span *model.Span, dest ptrace.Span) {
 
 	attrs := dest.Attributes()
 	attrs.EnsureCapacity(len(span.Tags))
-	jTagsToInternalAttributes(span.Tags, attrs)
+	jTagsToInternalAttributes(span.Tags, attrs, opts)
 	if spanKindAttr, ok := attrs.Get(tracetranslator.TagSpanKind); ok {
 		dest.SetKind(jSpanKindToInternal(spanKindAttr.Str()))
 		attrs.Remove(tracetranslator.TagSpanKind)
@@ -217,21 +218,22 @@ func jSpanToInternal(span *model.Span, dest ptrace.Span) {
 	setInternalSpanStatus(attrs, dest)
 
 	dest.TraceState().FromRaw(getTraceStateFromAttrs(attrs))
+	opts.setSamplingTraceState(attrs, dest)
 
 	// drop the attributes slice if all of them were replaced during translation
 	if attrs.Len() == 0 {
 		attrs.Clear()
 	}
 
-	jLogsToSpanEvents(span.Logs, dest.Events())
+	jLogsToSpanEvents(span.Logs, dest.Events(), opts)
 	jReferencesToSpanLinks(span.References, parentSpanID, dest.Links())
 }
 
-func jTagsToInternalAttributes(tags []model.KeyValue, dest pcommon.Map) {
+func jTagsToInternalAttributes(tags []model.KeyValue, dest pcommon.Map, opts options) {
 	for _, tag := range tags {
 		switch tag.GetVType() {
 		case model.ValueType_STRING:
-			dest.PutStr(tag.Key, tag.GetVStr())
+			opts.putStr(dest, tag.Key, tag.GetVStr())
 		case model.ValueType_BOOL:
 			dest.PutBool(tag.Key, tag.GetVBool())
 		case model.ValueType_INT64:
@@ -380,7 +382,7 @@ func jSpanKindToInternal(spanKind string) ptrace.SpanKind {
 	return ptrace.SpanKindUnspecified
 }
 
-func jLogsToSpanEvents(logs []model.Log, dest ptrace.SpanEventSlice) {
+func jLogsToSpanEvents(logs []model.Log, dest ptrace.SpanEventSlice, opts options) {
 	if len(logs) == 0 {
 		return
 	}
@@ -402,7 +404,7 @@ func jLogsToSpanEvents(logs []model.Log, dest ptrace.SpanEventSlice) {
 
 		attrs := event.Attributes()
 		attrs.EnsureCapacity(len(log.Fields))
-		jTagsToInternalAttributes(log.Fields, attrs)
+		jTagsToInternalAttributes(log.Fields, attrs, opts)
 		if name, ok := attrs.Get(eventNameAttr); ok {
 			event.SetName(name.Str())
 			attrs.Remove(eventNameAttr)
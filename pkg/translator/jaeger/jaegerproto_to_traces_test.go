@@ -171,7 +171,7 @@ func TestJTagsToInternalAttributes(t *testing.T) {
 	expected.PutEmptyBytes("binary-val").FromRaw([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0x7D, 0x98})
 
 	got := pcommon.NewMap()
-	jTagsToInternalAttributes(tags, got)
+	jTagsToInternalAttributes(tags, got, options{})
 
 	require.EqualValues(t, expected, got)
 }
@@ -19,7 +19,8 @@ import (
 var blankJaegerThriftSpan = new(jaeger.Span)
 
 // ThriftToTraces transforms a Thrift trace batch into ptrace.Traces.
-func ThriftToTraces(batches *jaeger.Batch) (ptrace.Traces, error) {
+func ThriftToTraces(batches *jaeger.Batch, opts ...Option) (ptrace.Traces, error) {
+	o := newOptions(opts)
 	traceData := ptrace.NewTraces()
 	jProcess := batches.GetProcess()
 	jSpans := batches.GetSpans()
@@ -29,18 +30,18 @@ func ThriftToTraces(batches *jaeger.Batch) (ptrace.Traces, error) {
 	}
 
 	rs := traceData.ResourceSpans().AppendEmpty()
-	jThriftProcessToInternalResource(jProcess, rs.Resource())
+	jThriftProcessToInternalResource(jProcess, rs.Resource(), o)
 
 	if len(jSpans) == 0 {
 		return traceData, nil
 	}
 
-	jThriftSpansToInternal(jSpans, rs.ScopeSpans().AppendEmpty().Spans())
+	jThriftSpansToInternal(jSpans, rs.ScopeSpans().AppendEmpty().Spans(), o)
 
 	return traceData, nil
 }
 
-func jThriftProcessToInternalResource(process *jaeger.Process, dest pcommon.Resource) {
+func jThriftProcessToInternalResource(process *jaeger.Process, dest pcommon.Resource, opts options) {
 	if process == nil {
 		return
 	}
@@ -58,14 +59,14 @@ func jThriftProcessToInternalResource(process *jaeger.Process, dest pcommon.Reso
 	} else {
 		attrs.EnsureCapacity(len(tags))
 	}
-	jThriftTagsToInternalAttributes(tags, attrs)
+	jThriftTagsToInternalAttributes(tags, attrs, opts)
 
 	// Handle special keys translations.
 	translateHostnameAttr(attrs)
 	translateJaegerVersionAttr(attrs)
 }
 
-func jThriftSpansToInternal(spans []*jaeger.Span, dest ptrace.SpanSlice) {
+func jThriftSpansToInternal(spans []*jaeger.Span, dest ptrace.SpanSlice, opts options) {
 	if len(spans) == 0 {
 		return
 	}
@@ -75,7 +76,7 @@ func jThriftSpansToInternal(spans []*jaeger.Span, dest ptrace.SpanSlice) {
 		if span == nil || reflect.DeepEqual(span, blankJaegerThriftSpan) {
 			continue
 		}
-		jThriftSpanToInternal(span, dest.AppendEmpty())
+		jThriftSpanToInternal(span, dest.AppendEmpty(), opts)
 	}
 }
 
@@ -106,7 +107,7 @@ func jThriftSpanParentID(span *jaeger.Span) int64 {
 	return 0
 }
 
-func jThriftSpanToInternal(span *jaeger.Span, dest ptrace.Span) {
+func jThriftSpanToInternal(span *jaeger.Span, dest ptrace.Span, opts options) {
 	dest.SetTraceID(idutils.UInt64ToTraceID(uint64(span.TraceIdHigh), uint64(span.TraceIdLow)))
 	dest.SetSpanID(idutils.UInt64ToSpanID(uint64(span.SpanId)))
 	dest.SetName(span.OperationName)
@@ -120,28 +121,29 @@ func jThriftSpanToInternal(span *jaeger.Span, dest ptrace.Span) {
 
 	attrs := dest.Attributes()
 	attrs.EnsureCapacity(len(span.Tags))
-	jThriftTagsToInternalAttributes(span.Tags, attrs)
+	jThriftTagsToInternalAttributes(span.Tags, attrs, opts)
 	if spanKindAttr, ok := attrs.Get(tracetranslator.TagSpanKind); ok {
 		dest.SetKind(jSpanKindToInternal(spanKindAttr.Str()))
 		attrs.Remove(tracetranslator.TagSpanKind)
 	}
 	setInternalSpanStatus(attrs, dest)
+	opts.setSamplingTraceState(attrs, dest)
 
 	// drop the attributes slice if all of them were replaced during translation
 	if attrs.Len() == 0 {
 		attrs.Clear()
 	}
 
-	jThriftLogsToSpanEvents(span.Logs, dest.Events())
+	jThriftLogsToSpanEvents(span.Logs, dest.Events(), opts)
 	jThriftReferencesToSpanLinks(span.References, parentSpanID, dest.Links())
 }
 
 // jThriftTagsToInternalAttributes sets internal span links based on jaeger span references skipping excludeParentID
-func jThriftTagsToInternalAttributes(tags []*jaeger.Tag, dest pcommon.Map) {
+func jThriftTagsToInternalAttributes(tags []*jaeger.Tag, dest pcommon.Map, opts options) {
 	for _, tag := range tags {
 		switch tag.GetVType() {
 		case jaeger.TagType_STRING:
-			dest.PutStr(tag.Key, tag.GetVStr())
+			opts.putStr(dest, tag.Key, tag.GetVStr())
 		case jaeger.TagType_BOOL:
 			dest.PutBool(tag.Key, tag.GetVBool())
 		case jaeger.TagType_LONG:
@@ -156,7 +158,7 @@ func jThriftTagsToInternalAttributes(tags []*jaeger.Tag, dest pcommon.Map) {
 	}
 }
 
-func jThriftLogsToSpanEvents(logs []*jaeger.Log, dest ptrace.SpanEventSlice) {
+func jThriftLogsToSpanEvents(logs []*jaeger.Log, dest ptrace.SpanEventSlice, opts options) {
 	if len(logs) == 0 {
 		return
 	}
@@ -173,7 +175,7 @@ func jThriftLogsToSpanEvents(logs []*jaeger.Log, dest ptrace.SpanEventSlice) {
 
 		attrs := event.Attributes()
 		attrs.EnsureCapacity(len(log.Fields))
-		jThriftTagsToInternalAttributes(log.Fields, attrs)
+		jThriftTagsToInternalAttributes(log.Fields, attrs, opts)
 		if name, ok := attrs.Get(eventNameAttr); ok {
 			event.SetName(name.Str())
 			attrs.Remove(eventNameAttr)
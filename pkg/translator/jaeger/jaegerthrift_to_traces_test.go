@@ -59,7 +59,7 @@ func TestJThriftTagsToInternalAttributes(t *testing.T) {
 	expected.PutEmptyBytes("binary-val").FromRaw([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0x7D, 0x98})
 
 	got := pcommon.NewMap()
-	jThriftTagsToInternalAttributes(tags, got)
+	jThriftTagsToInternalAttributes(tags, got, options{})
 
 	require.EqualValues(t, expected, got)
 }
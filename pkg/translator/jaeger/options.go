@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jaeger // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/jaeger"
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// Sampler tags set by the Jaeger clients on the root span of a sampled trace.
+const (
+	samplerTypeTag           = "sampler.type"
+	samplerParamTag          = "sampler.param"
+	samplerTypeProbabilistic = "probabilistic"
+)
+
+// Option configures the conversion of Jaeger batches to ptrace.Traces.
+type Option func(*options)
+
+type options struct {
+	jsonTagsAsMaps       bool
+	samplingToTraceState bool
+}
+
+// WithJSONTagsAsMaps converts the string tags and log fields holding a JSON object or
+// array into map or slice attributes, instead of keeping the serialized string.
+func WithJSONTagsAsMaps() Option {
+	return func(o *options) {
+		o.jsonTagsAsMaps = true
+	}
+}
+
+// WithSamplingToTraceState records the probability of the spans sampled by a
+// probabilistic Jaeger sampler, including the per-operation probabilities of the
+// adaptive sampling strategies, as the OpenTelemetry sampling threshold ("ot=th:")
+// in the span trace state. The sampler tags are kept as attributes.
+func WithSamplingToTraceState() Option {
+	return func(o *options) {
+		o.samplingToTraceState = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// putStr puts a string tag into dest, parsing it as a map or slice when enabled.
+func (o options) putStr(dest pcommon.Map, key string, value string) {
+	if o.jsonTagsAsMaps {
+		trimmed := strings.TrimSpace(value)
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			var parsed any
+			if err := json.Unmarshal([]byte(trimmed), &parsed); err == nil {
+				switch v := parsed.(type) {
+				case map[string]any:
+					if dest.PutEmptyMap(key).FromRaw(v) == nil {
+						return
+					}
+				case []any:
+					if dest.PutEmptySlice(key).FromRaw(v) == nil {
+						return
+					}
+				}
+			}
+		}
+	}
+	dest.PutStr(key, value)
+}
+
+// setSamplingTraceState adds the sampling threshold of a probabilistic sampler to the
+// trace state of the span, unless it already carries an OpenTelemetry entry.
+func (o options) setSamplingTraceState(attrs pcommon.Map, dest ptrace.Span) {
+	if !o.samplingToTraceState {
+		return
+	}
+	samplerType, ok := attrs.Get(samplerTypeTag)
+	if !ok || samplerType.AsString() != samplerTypeProbabilistic {
+		return
+	}
+	param, ok := attrs.Get(samplerParamTag)
+	if !ok {
+		return
+	}
+	threshold, ok := samplingThreshold(param)
+	if !ok {
+		return
+	}
+
+	traceState := dest.TraceState().AsRaw()
+	for _, member := range strings.Split(traceState, ",") {
+		if strings.HasPrefix(strings.TrimSpace(member), "ot=") {
+			return
+		}
+	}
+	if traceState == "" {
+		dest.TraceState().FromRaw("ot=th:" + threshold)
+		return
+	}
+	dest.TraceState().FromRaw("ot=th:" + threshold + "," + traceState)
+}
+
+// samplingThreshold encodes a sampling probability as the rejection threshold defined
+// by the OpenTelemetry probability sampling specification.
+func samplingThreshold(param pcommon.Value) (string, bool) {
+	var probability float64
+	switch param.Type() {
+	case pcommon.ValueTypeDouble:
+		probability = param.Double()
+	case pcommon.ValueTypeInt:
+		probability = float64(param.Int())
+	case pcommon.ValueTypeStr:
+		var err error
+		if probability, err = strconv.ParseFloat(param.Str(), 64); err != nil {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	if math.IsNaN(probability) || probability <= 0 || probability > 1 {
+		return "", false
+	}
+
+	// Scale the probability before the subtraction, the scaling by a power of two is exact.
+	const maxThreshold = 1 << 56
+	adjusted := uint64(math.Round(probability * maxThreshold))
+	if adjusted == 0 {
+		return "", false
+	}
+	threshold := maxThreshold - adjusted
+	encoded := strings.TrimRight(fmt.Sprintf("%014x", threshold), "0")
+	if encoded == "" {
+		encoded = "0"
+	}
+	return encoded, true
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jaeger
+
+import (
+	"testing"
+
+	"github.com/jaegertracing/jaeger/model"
+	"github.com/jaegertracing/jaeger/thrift-gen/jaeger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestJSONTagsAsMaps(t *testing.T) {
+	tags := []model.KeyValue{
+		{Key: "object", VType: model.ValueType_STRING, VStr: `{"a": "b", "c": [1, true]}`},
+		{Key: "array", VType: model.ValueType_STRING, VStr: ` ["x", {"y": 2}]`},
+		{Key: "invalid", VType: model.ValueType_STRING, VStr: `{"a": `},
+		{Key: "scalar", VType: model.ValueType_STRING, VStr: `42`},
+		{Key: "binary", VType: model.ValueType_BINARY, VBinary: []byte{0x01, 0x02}},
+	}
+
+	withoutOption := pcommon.NewMap()
+	jTagsToInternalAttributes(tags, withoutOption, options{})
+	assert.Equal(t, map[string]any{
+		"object":  `{"a": "b", "c": [1, true]}`,
+		"array":   ` ["x", {"y": 2}]`,
+		"invalid": `{"a": `,
+		"scalar":  `42`,
+		"binary":  []byte{0x01, 0x02},
+	}, withoutOption.AsRaw())
+
+	withOption := pcommon.NewMap()
+	jTagsToInternalAttributes(tags, withOption, newOptions([]Option{WithJSONTagsAsMaps()}))
+	assert.Equal(t, map[string]any{
+		"object":  map[string]any{"a": "b", "c": []any{float64(1), true}},
+		"array":   []any{"x", map[string]any{"y": float64(2)}},
+		"invalid": `{"a": `,
+		"scalar":  `42`,
+		"binary":  []byte{0x01, 0x02},
+	}, withOption.AsRaw())
+}
+
+func TestSamplingThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		param     pcommon.Value
+		threshold string
+		ok        bool
+	}{
+		{name: "always", param: pcommon.NewValueDouble(1), threshold: "0", ok: true},
+		{name: "half", param: pcommon.NewValueDouble(0.5), threshold: "8", ok: true},
+		{name: "quarter", param: pcommon.NewValueDouble(0.25), threshold: "c", ok: true},
+		{name: "tenth", param: pcommon.NewValueDouble(0.1), threshold: "e6666666666666", ok: true},
+		{name: "string", param: pcommon.NewValueStr("0.5"), threshold: "8", ok: true},
+		{name: "int", param: pcommon.NewValueInt(1), threshold: "0", ok: true},
+		{name: "zero", param: pcommon.NewValueDouble(0)},
+		{name: "above one", param: pcommon.NewValueDouble(2)},
+		{name: "invalid string", param: pcommon.NewValueStr("often")},
+		{name: "bool", param: pcommon.NewValueBool(true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			threshold, ok := samplingThreshold(tt.param)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.threshold, threshold)
+		})
+	}
+}
+
+func TestProtoSamplingToTraceState(t *testing.T) {
+	span := func(samplerType string, traceState string) *model.Span {
+		s := &model.Span{
+			TraceID:       model.NewTraceID(0, 1),
+			SpanID:        model.NewSpanID(2),
+			OperationName: "op",
+			Tags: []model.KeyValue{
+				model.String(samplerTypeTag, samplerType),
+				model.Float64(samplerParamTag, 0.25),
+			},
+		}
+		if traceState != "" {
+			s.Tags = append(s.Tags, model.String("w3c.tracestate", traceState))
+		}
+		return s
+	}
+	batch := &model.Batch{
+		Process: model.NewProcess("svc", nil),
+		Spans: []*model.Span{
+			span(samplerTypeProbabilistic, ""),
+			span(samplerTypeProbabilistic, "vendor=value"),
+			span(samplerTypeProbabilistic, "ot=th:8"),
+			span("lowerbound", ""),
+		},
+	}
+
+	td, err := ProtoToTraces([]*model.Batch{batch})
+	require.NoError(t, err)
+	assert.Equal(t, "", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).TraceState().AsRaw())
+
+	td, err = ProtoToTraces([]*model.Batch{batch}, WithSamplingToTraceState())
+	require.NoError(t, err)
+	spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 4, spans.Len())
+	assert.Equal(t, "ot=th:c", spans.At(0).TraceState().AsRaw())
+	assert.Equal(t, "ot=th:c,vendor=value", spans.At(1).TraceState().AsRaw())
+	assert.Equal(t, "ot=th:8", spans.At(2).TraceState().AsRaw())
+	assert.Equal(t, "", spans.At(3).TraceState().AsRaw())
+
+	samplerType, ok := spans.At(0).Attributes().Get(samplerTypeTag)
+	require.True(t, ok)
+	assert.Equal(t, samplerTypeProbabilistic, samplerType.Str())
+}
+
+func TestThriftOptions(t *testing.T) {
+	samplerType := samplerTypeProbabilistic
+	samplerParam := 0.5
+	payload := `{"k": "v"}`
+	batch := &jaeger.Batch{
+		Process: &jaeger.Process{ServiceName: "svc"},
+		Spans: []*jaeger.Span{{
+			TraceIdLow:    1,
+			SpanId:        2,
+			OperationName: "op",
+			Tags: []*jaeger.Tag{
+				{Key: samplerTypeTag, VType: jaeger.TagType_STRING, VStr: &samplerType},
+				{Key: samplerParamTag, VType: jaeger.TagType_DOUBLE, VDouble: &samplerParam},
+				{Key: "payload", VType: jaeger.TagType_STRING, VStr: &payload},
+			},
+		}},
+	}
+
+	td, err := ThriftToTraces(batch, WithSamplingToTraceState(), WithJSONTagsAsMaps())
+	require.NoError(t, err)
+	span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "ot=th:8", span.TraceState().AsRaw())
+	p, ok := span.Attributes().Get("payload")
+	require.True(t, ok)
+	assert.Equal(t, map[string]any{"k": "v"}, p.Map().AsRaw())
+}
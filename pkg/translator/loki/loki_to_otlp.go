@@ -4,7 +4,9 @@
 package loki // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/loki"
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,26 +34,13 @@ func PushRequestToLogs(pushRequest *push.PushRequest, keepTimestamp bool) (plog.
 		if len(stream.Entries) == 0 {
 			continue
 		}
-		// Get stream labels
-		// Stream contains labels in string format: `{label1="value1", label2="value2"}`
-		// Here we parse such a string into labels.Labels
-		ls, err := promql_parser.ParseMetric(stream.Labels)
+		filtered, err := parseStreamLabels(stream.Labels)
 		if err != nil {
 			lastErr = err
 			errNumber++
 			continue
 		}
 
-		// Convert to model.LabelSet
-		filtered := model.LabelSet{}
-		for _, label := range ls {
-			// Labels started from __ are considered internal and should be ignored
-			if strings.HasPrefix(label.Name, "__") {
-				continue
-			}
-			filtered[model.LabelName(label.Name)] = model.LabelValue(label.Value)
-		}
-
 		for i := range stream.Entries {
 			lr := logSlice.AppendEmpty()
 			ConvertEntryToLogRecord(&stream.Entries[i], &lr, filtered, keepTimestamp)
@@ -78,4 +67,149 @@ func ConvertEntryToLogRecord(entry *push.Entry, lr *plog.LogRecord, labelSet mod
 	for key, value := range labelSet {
 		lr.Attributes().PutStr(string(key), string(value))
 	}
+	for _, metadata := range entry.StructuredMetadata {
+		lr.Attributes().PutStr(metadata.Name, metadata.Value)
+	}
+}
+
+// PushRequestToOTLPLogs converts loki push request to logs pipeline data, reverting the mapping Loki applies
+// when ingesting OTLP logs: the labels of the streams are resource attributes, the structured metadata of the
+// entries are log attributes, except for the fields of the log records and their scope stored as structured metadata.
+func PushRequestToOTLPLogs(pushRequest *push.PushRequest, keepTimestamp bool) (plog.Logs, error) {
+	logs := plog.NewLogs()
+
+	var lastErr error
+	var errNumber int64
+	for _, stream := range pushRequest.Streams {
+		// Return early if stream does not contain any entries
+		if len(stream.Entries) == 0 {
+			continue
+		}
+		filtered, err := parseStreamLabels(stream.Labels)
+		if err != nil {
+			lastErr = err
+			errNumber++
+			continue
+		}
+
+		rls := logs.ResourceLogs().AppendEmpty()
+		for key, value := range filtered {
+			rls.Resource().Attributes().PutStr(string(key), string(value))
+		}
+
+		scopeLogs := map[scopeKey]plog.LogRecordSlice{}
+		for i := range stream.Entries {
+			entry := &stream.Entries[i]
+			scope := entryScope(entry)
+			logSlice, ok := scopeLogs[scope]
+			if !ok {
+				sl := rls.ScopeLogs().AppendEmpty()
+				sl.Scope().SetName(scope.name)
+				sl.Scope().SetVersion(scope.version)
+				logSlice = sl.LogRecords()
+				scopeLogs[scope] = logSlice
+			}
+			lr := logSlice.AppendEmpty()
+			convertEntryToOTLPLogRecord(entry, &lr, keepTimestamp)
+		}
+	}
+
+	if lastErr != nil {
+		lastErr = fmt.Errorf("%d entries failed to process, the last error: %w", errNumber, lastErr)
+	}
+
+	return logs, lastErr
+}
+
+// Structured metadata keys used by Loki to store the fields of OTLP log records and of their scope.
+const (
+	metadataTraceID           = "trace_id"
+	metadataSpanID            = "span_id"
+	metadataSeverityText      = "severity_text"
+	metadataSeverityNumber    = "severity_number"
+	metadataFlags             = "flags"
+	metadataObservedTimestamp = "observed_timestamp"
+	metadataScopeName         = "scope_name"
+	metadataScopeVersion      = "scope_version"
+)
+
+type scopeKey struct {
+	name    string
+	version string
+}
+
+func entryScope(entry *push.Entry) scopeKey {
+	var scope scopeKey
+	for _, metadata := range entry.StructuredMetadata {
+		switch metadata.Name {
+		case metadataScopeName:
+			scope.name = metadata.Value
+		case metadataScopeVersion:
+			scope.version = metadata.Value
+		}
+	}
+	return scope
+}
+
+func convertEntryToOTLPLogRecord(entry *push.Entry, lr *plog.LogRecord, keepTimestamp bool) {
+	ConvertEntryToLogRecord(&push.Entry{Timestamp: entry.Timestamp, Line: entry.Line}, lr, nil, keepTimestamp)
+
+	for _, metadata := range entry.StructuredMetadata {
+		// Values which cannot be restored to their field are kept as attributes, so that nothing is lost.
+		switch metadata.Name {
+		case metadataScopeName, metadataScopeVersion:
+			continue
+		case metadataTraceID:
+			var traceID pcommon.TraceID
+			if n, err := hex.Decode(traceID[:], []byte(metadata.Value)); err == nil && n == len(traceID) {
+				lr.SetTraceID(traceID)
+				continue
+			}
+		case metadataSpanID:
+			var spanID pcommon.SpanID
+			if n, err := hex.Decode(spanID[:], []byte(metadata.Value)); err == nil && n == len(spanID) {
+				lr.SetSpanID(spanID)
+				continue
+			}
+		case metadataSeverityText:
+			lr.SetSeverityText(metadata.Value)
+			continue
+		case metadataSeverityNumber:
+			if severity, err := strconv.ParseInt(metadata.Value, 10, 32); err == nil {
+				lr.SetSeverityNumber(plog.SeverityNumber(severity))
+				continue
+			}
+		case metadataFlags:
+			if flags, err := strconv.ParseUint(metadata.Value, 10, 32); err == nil {
+				lr.SetFlags(plog.LogRecordFlags(flags))
+				continue
+			}
+		case metadataObservedTimestamp:
+			if ts, err := strconv.ParseInt(metadata.Value, 10, 64); err == nil {
+				lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, ts)))
+				continue
+			}
+		}
+		lr.Attributes().PutStr(metadata.Name, metadata.Value)
+	}
+}
+
+// parseStreamLabels parses the labels of a stream, in the `{label1="value1", label2="value2"}` format,
+// ignoring the internal ones.
+func parseStreamLabels(labels string) (model.LabelSet, error) {
+	ls, err := promql_parser.ParseMetric(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to model.LabelSet
+	filtered := model.LabelSet{}
+	for _, label := range ls {
+		// Labels started from __ are considered internal and should be ignored
+		if strings.HasPrefix(label.Name, "__") {
+			continue
+		}
+		filtered[model.LabelName(label.Name)] = model.LabelValue(label.Value)
+	}
+	return filtered, nil
 }
@@ -77,6 +77,38 @@ func TestPushRequestToLogs(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "Should add structured metadata to attributes",
+			pushRequest: &push.PushRequest{
+				Streams: []push.Stream{
+					{
+						Labels: "{foo=\"bar\"}",
+						Entries: []push.Entry{
+							{
+								Timestamp: time.Unix(0, 1676888496000000000),
+								Line:      "logline 1",
+								StructuredMetadata: push.LabelsAdapter{
+									{Name: "trace_id", Value: "0102030405060708090a0b0c0d0e0f10"},
+									{Name: "user", Value: "alice"},
+								},
+							},
+						},
+					},
+				},
+			},
+			keepTimestamp: true,
+			expected: generateLogs([]Log{
+				{
+					Timestamp: 1676888496000000000,
+					Body:      pcommon.NewValueStr("logline 1"),
+					Attributes: map[string]any{
+						"foo":      "bar",
+						"trace_id": "0102030405060708090a0b0c0d0e0f10",
+						"user":     "alice",
+					},
+				},
+			}),
+		},
 	}
 
 	for _, tt := range testCases {
@@ -89,6 +121,85 @@ func TestPushRequestToLogs(t *testing.T) {
 	}
 }
 
+func TestPushRequestToOTLPLogs(t *testing.T) {
+	pushRequest := &push.PushRequest{
+		Streams: []push.Stream{
+			{
+				Labels:  "{service_name=\"empty\"}",
+				Entries: []push.Entry{},
+			},
+			{
+				Labels: "{__internal=\"ignored\", service_name=\"checkout\", k8s_namespace_name=\"shop\"}",
+				Entries: []push.Entry{
+					{
+						Timestamp: time.Unix(0, 1676888496000000000),
+						Line:      "order placed",
+						StructuredMetadata: push.LabelsAdapter{
+							{Name: "trace_id", Value: "0102030405060708090a0b0c0d0e0f10"},
+							{Name: "span_id", Value: "0102030405060708"},
+							{Name: "severity_text", Value: "WARN"},
+							{Name: "severity_number", Value: "13"},
+							{Name: "flags", Value: "1"},
+							{Name: "observed_timestamp", Value: "1676888497000000000"},
+							{Name: "scope_name", Value: "checkout"},
+							{Name: "scope_version", Value: "1.0.0"},
+							{Name: "order_id", Value: "42"},
+						},
+					},
+					{
+						Timestamp: time.Unix(0, 1676888498000000000),
+						Line:      "no scope",
+						StructuredMetadata: push.LabelsAdapter{
+							{Name: "span_id", Value: "not-a-span-id"},
+						},
+					},
+				},
+			},
+			{
+				Labels: "{service_name=\"payment\"}",
+				Entries: []push.Entry{
+					{Timestamp: time.Unix(0, 1676888499000000000), Line: "payment accepted"},
+				},
+			},
+		},
+	}
+
+	expected := plog.NewLogs()
+	rl := expected.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service_name", "checkout")
+	rl.Resource().Attributes().PutStr("k8s_namespace_name", "shop")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout")
+	sl.Scope().SetVersion("1.0.0")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1676888496000000000))
+	lr.SetObservedTimestamp(pcommon.Timestamp(1676888497000000000))
+	lr.Body().SetStr("order placed")
+	lr.SetTraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+	lr.SetSpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	lr.SetSeverityText("WARN")
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.SetFlags(plog.DefaultLogRecordFlags.WithIsSampled(true))
+	lr.Attributes().PutStr("order_id", "42")
+	lr = rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1676888498000000000))
+	lr.Body().SetStr("no scope")
+	lr.Attributes().PutStr("span_id", "not-a-span-id")
+	rl = expected.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service_name", "payment")
+	lr = rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1676888499000000000))
+	lr.Body().SetStr("payment accepted")
+
+	logs, err := PushRequestToOTLPLogs(pushRequest, true)
+	require.NoError(t, err)
+	require.NoError(t, plogtest.CompareLogs(expected, logs, plogtest.IgnoreObservedTimestamp()))
+	assert.Equal(t, pcommon.Timestamp(1676888497000000000), logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).ObservedTimestamp())
+
+	_, err = PushRequestToOTLPLogs(&push.PushRequest{Streams: []push.Stream{{Labels: "{", Entries: []push.Entry{{Line: "invalid labels"}}}}}, true)
+	assert.ErrorContains(t, err, "1 entries failed to process")
+}
+
 type Log struct {
 	Timestamp  int64
 	Body       pcommon.Value
@@ -19,10 +19,51 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/tracetranslator"
 )
 
+// EventTranslation defines how a kind of OpenCensus time event is translated.
+type EventTranslation string
+
+const (
+	// EventTranslationEvent translates the time events to span events. This is the default.
+	EventTranslationEvent EventTranslation = "event"
+	// EventTranslationRPCEvent translates message events to span events named "message", with the
+	// attributes of the OpenTelemetry semantic conventions for RPC messages.
+	EventTranslationRPCEvent EventTranslation = "rpc_event"
+	// EventTranslationDrop drops the time events, they are only added to the dropped events count of the span.
+	EventTranslationDrop EventTranslation = "drop"
+)
+
+type tracesOptions struct {
+	annotations   EventTranslation
+	messageEvents EventTranslation
+}
+
+// TracesOption customizes the translation done by OCToTraces.
+type TracesOption func(*tracesOptions)
+
+// WithAnnotations sets how the annotations are translated, EventTranslationEvent or EventTranslationDrop.
+func WithAnnotations(translation EventTranslation) TracesOption {
+	return func(opts *tracesOptions) {
+		opts.annotations = translation
+	}
+}
+
+// WithMessageEvents sets how the message events are translated, EventTranslationEvent,
+// EventTranslationRPCEvent or EventTranslationDrop.
+func WithMessageEvents(translation EventTranslation) TracesOption {
+	return func(opts *tracesOptions) {
+		opts.messageEvents = translation
+	}
+}
+
 // OCToTraces may be used only by OpenCensus receiver and exporter implementations.
 // Deprecated: use ptrace.Traces instead.
 // TODO: move this function to OpenCensus package.
-func OCToTraces(node *occommon.Node, resource *ocresource.Resource, spans []*octrace.Span) ptrace.Traces {
+func OCToTraces(node *occommon.Node, resource *ocresource.Resource, spans []*octrace.Span, options ...TracesOption) ptrace.Traces {
+	opts := tracesOptions{annotations: EventTranslationEvent, messageEvents: EventTranslationEvent}
+	for _, option := range options {
+		option(&opts)
+	}
+
 	traceData := ptrace.NewTraces()
 	if node == nil && resource == nil && len(spans) == 0 {
 		return traceData
@@ -93,24 +134,24 @@ func OCToTraces(node *occommon.Node, resource *ocresource.Resource, spans []*oct
 			// Add the span to the "combinedSpans". combinedSpans length is equal
 			// to combinedSpanCount. The loop above that calculates combinedSpanCount
 			// has exact same conditions as we have here in this loop.
-			ocSpanToInternal(ocSpan, combinedSpans.AppendEmpty())
+			ocSpanToInternal(ocSpan, combinedSpans.AppendEmpty(), opts)
 		} else {
 			// This span has a different Resource and must be placed in a different
 			// ResourceSpans instance. Create a separate ResourceSpans item just for this span.
-			ocSpanToResourceSpans(ocSpan, node, traceData.ResourceSpans().AppendEmpty())
+			ocSpanToResourceSpans(ocSpan, node, traceData.ResourceSpans().AppendEmpty(), opts)
 		}
 	}
 
 	return traceData
 }
 
-func ocSpanToResourceSpans(ocSpan *octrace.Span, node *occommon.Node, dest ptrace.ResourceSpans) {
+func ocSpanToResourceSpans(ocSpan *octrace.Span, node *occommon.Node, dest ptrace.ResourceSpans, opts tracesOptions) {
 	ocNodeResourceToInternal(node, ocSpan.Resource, dest.Resource())
 	ilss := dest.ScopeSpans()
-	ocSpanToInternal(ocSpan, ilss.AppendEmpty().Spans().AppendEmpty())
+	ocSpanToInternal(ocSpan, ilss.AppendEmpty().Spans().AppendEmpty(), opts)
 }
 
-func ocSpanToInternal(src *octrace.Span, dest ptrace.Span) {
+func ocSpanToInternal(src *octrace.Span, dest ptrace.Span, opts tracesOptions) {
 	// Note that ocSpanKindToInternal must be called before initAttributeMapFromOC
 	// since it may modify src.Attributes (remove the attribute which represents the
 	// span kind).
@@ -129,7 +170,7 @@ func ocSpanToInternal(src *octrace.Span, dest ptrace.Span) {
 
 	initAttributeMapFromOC(src.Attributes, dest.Attributes())
 	dest.SetDroppedAttributesCount(ocAttrsToDroppedAttributes(src.Attributes))
-	ocEventsToInternal(src.TimeEvents, dest)
+	ocEventsToInternal(src.TimeEvents, dest, opts)
 	ocLinksToInternal(src.Links, dest)
 	ocSameProcessAsParentSpanToInternal(src.SameProcessAsParentSpan, dest)
 }
@@ -265,12 +306,13 @@ func ocSpanKindToInternal(ocKind octrace.Span_SpanKind, ocAttrs *octrace.Span_At
 	}
 }
 
-func ocEventsToInternal(ocEvents *octrace.Span_TimeEvents, dest ptrace.Span) {
+func ocEventsToInternal(ocEvents *octrace.Span_TimeEvents, dest ptrace.Span, opts tracesOptions) {
 	if ocEvents == nil {
 		return
 	}
 
-	dest.SetDroppedEventsCount(uint32(ocEvents.DroppedMessageEventsCount + ocEvents.DroppedAnnotationsCount))
+	droppedEventsCount := uint32(ocEvents.DroppedMessageEventsCount + ocEvents.DroppedAnnotationsCount)
+	defer func() { dest.SetDroppedEventsCount(droppedEventsCount) }()
 
 	if len(ocEvents.TimeEvent) == 0 {
 		return
@@ -285,6 +327,19 @@ func ocEventsToInternal(ocEvents *octrace.Span_TimeEvents, dest ptrace.Span) {
 			continue
 		}
 
+		switch ocEvent.Value.(type) {
+		case *octrace.Span_TimeEvent_Annotation_:
+			if opts.annotations == EventTranslationDrop {
+				droppedEventsCount++
+				continue
+			}
+		case *octrace.Span_TimeEvent_MessageEvent_:
+			if opts.messageEvents == EventTranslationDrop {
+				droppedEventsCount++
+				continue
+			}
+		}
+
 		event := events.AppendEmpty()
 		event.SetTimestamp(pcommon.NewTimestampFromTime(ocEvent.Time.AsTime()))
 
@@ -298,7 +353,11 @@ func ocEventsToInternal(ocEvents *octrace.Span_TimeEvents, dest ptrace.Span) {
 
 		case *octrace.Span_TimeEvent_MessageEvent_:
 			event.SetName("message")
-			ocMessageEventToInternalAttrs(teValue.MessageEvent, event.Attributes())
+			if opts.messageEvents == EventTranslationRPCEvent {
+				ocMessageEventToRPCAttrs(teValue.MessageEvent, event.Attributes())
+			} else {
+				ocMessageEventToInternalAttrs(teValue.MessageEvent, event.Attributes())
+			}
 			// No dropped attributes for this case.
 			event.SetDroppedAttributesCount(0)
 
@@ -347,6 +406,18 @@ func ocMessageEventToInternalAttrs(msgEvent *octrace.Span_TimeEvent_MessageEvent
 	dest.PutInt(conventions.AttributeMessagingMessagePayloadCompressedSizeBytes, int64(msgEvent.CompressedSize))
 }
 
+// ocMessageEventToRPCAttrs sets the attributes of the OpenTelemetry semantic conventions for RPC messages.
+func ocMessageEventToRPCAttrs(msgEvent *octrace.Span_TimeEvent_MessageEvent, dest pcommon.Map) {
+	if msgEvent == nil {
+		return
+	}
+
+	dest.PutStr("message.type", msgEvent.Type.String())
+	dest.PutInt("message.id", int64(msgEvent.Id))
+	dest.PutInt("message.uncompressed_size", int64(msgEvent.UncompressedSize))
+	dest.PutInt("message.compressed_size", int64(msgEvent.CompressedSize))
+}
+
 func ocSameProcessAsParentSpanToInternal(spaps *wrapperspb.BoolValue, dest ptrace.Span) {
 	if spaps == nil {
 		return
@@ -373,6 +373,102 @@ func TestOcToInternal(t *testing.T) {
 	}
 }
 
+func TestOcEventsToInternalWithOptions(t *testing.T) {
+	eventTime := timestamppb.New(testdata.TestSpanEventTime)
+	ocEvents := &octrace.Span_TimeEvents{
+		TimeEvent: []*octrace.Span_TimeEvent{
+			{
+				Time: eventTime,
+				Value: &octrace.Span_TimeEvent_Annotation_{
+					Annotation: &octrace.Span_TimeEvent_Annotation{
+						Description: &octrace.TruncatableString{Value: "cache miss"},
+					},
+				},
+			},
+			{
+				Time: eventTime,
+				Value: &octrace.Span_TimeEvent_MessageEvent_{
+					MessageEvent: &octrace.Span_TimeEvent_MessageEvent{
+						Type:             octrace.Span_TimeEvent_MessageEvent_SENT,
+						Id:               7,
+						UncompressedSize: 100,
+						CompressedSize:   40,
+					},
+				},
+			},
+		},
+		DroppedAnnotationsCount: 1,
+	}
+
+	tests := []struct {
+		name               string
+		options            []TracesOption
+		expectedNames      []string
+		expectedAttributes []map[string]any
+		expectedDropped    uint32
+	}{
+		{
+			name:          "default",
+			expectedNames: []string{"cache miss", "message"},
+			expectedAttributes: []map[string]any{
+				{},
+				{
+					"message.type":                                    "SENT",
+					"messaging.message_id":                            int64(7),
+					"messaging.message_payload_size_bytes":            int64(100),
+					"messaging.message_payload_compressed_size_bytes": int64(40),
+				},
+			},
+			expectedDropped: 1,
+		},
+		{
+			name:          "rpc message events",
+			options:       []TracesOption{WithMessageEvents(EventTranslationRPCEvent)},
+			expectedNames: []string{"cache miss", "message"},
+			expectedAttributes: []map[string]any{
+				{},
+				{
+					"message.type":              "SENT",
+					"message.id":                int64(7),
+					"message.uncompressed_size": int64(100),
+					"message.compressed_size":   int64(40),
+				},
+			},
+			expectedDropped: 1,
+		},
+		{
+			name:               "dropped annotations",
+			options:            []TracesOption{WithAnnotations(EventTranslationDrop)},
+			expectedNames:      []string{"message"},
+			expectedAttributes: nil,
+			expectedDropped:    2,
+		},
+		{
+			name:               "dropped time events",
+			options:            []TracesOption{WithAnnotations(EventTranslationDrop), WithMessageEvents(EventTranslationDrop)},
+			expectedNames:      nil,
+			expectedAttributes: nil,
+			expectedDropped:    3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := OCToTraces(nil, nil, []*octrace.Span{{TimeEvents: ocEvents}}, tt.options...)
+			span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+			assert.Equal(t, tt.expectedDropped, span.DroppedEventsCount())
+
+			var names []string
+			for i := 0; i < span.Events().Len(); i++ {
+				names = append(names, span.Events().At(i).Name())
+				if tt.expectedAttributes != nil {
+					assert.Equal(t, tt.expectedAttributes[i], span.Events().At(i).Attributes().AsRaw())
+				}
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
 func TestOcSameProcessAsParentSpanToInternal(t *testing.T) {
 	span := ptrace.NewSpan()
 	ocSameProcessAsParentSpanToInternal(nil, span)
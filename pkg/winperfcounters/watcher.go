@@ -132,6 +132,12 @@ func ExpandWildCardPath(counterPath string) ([]string, error) {
 	return win_perf_counters.ExpandWildCardPath(counterPath)
 }
 
+// IsInstanceNotFound reports whether the error was returned because an instance of the counter no longer exists.
+func IsInstanceNotFound(err error) bool {
+	var pdhErr *win_perf_counters.PdhError
+	return errors.As(err, &pdhErr) && pdhErr.ErrorCode == win_perf_counters.PDH_CSTATUS_NO_INSTANCE
+}
+
 func removeTotalIfMultipleValues(vals []CounterValue) []CounterValue {
 	if len(vals) == 0 {
 		return vals
@@ -6,10 +6,14 @@
 package winperfcounters // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters/internal/third_party/telegraf/win_perf_counters"
 )
 
 func TestCounterPath(t *testing.T) {
@@ -156,3 +160,11 @@ func TestPerfCounter_ScrapeData(t *testing.T) {
 		})
 	}
 }
+
+func TestIsInstanceNotFound(t *testing.T) {
+	notFound := win_perf_counters.NewPdhError(win_perf_counters.PDH_CSTATUS_NO_INSTANCE)
+	assert.True(t, IsInstanceNotFound(notFound))
+	assert.True(t, IsInstanceNotFound(fmt.Errorf("failed to collect data: %w", notFound)))
+	assert.False(t, IsInstanceNotFound(win_perf_counters.NewPdhError(win_perf_counters.PDH_CSTATUS_NO_COUNTER)))
+	assert.False(t, IsInstanceNotFound(errors.New("instance not found")))
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adaptivelogsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivelogsamplerprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// severityToNumber maps the names of the severities, e.g. WARN or ERROR2, to their number.
+var severityToNumber = func() map[string]plog.SeverityNumber {
+	m := make(map[string]plog.SeverityNumber)
+	for n := plog.SeverityNumberTrace; n <= plog.SeverityNumberFatal4; n++ {
+		m[strings.ToUpper(n.String())] = n
+	}
+	return m
+}()
+
+// logSeverity is the name of a severity, case insensitive.
+type logSeverity string
+
+func (l logSeverity) validate() error {
+	if _, ok := severityToNumber[strings.ToUpper(string(l))]; !ok {
+		return fmt.Errorf("%q is not a valid severity", string(l))
+	}
+	return nil
+}
+
+func (l logSeverity) severityNumber() plog.SeverityNumber {
+	return severityToNumber[strings.ToUpper(string(l))]
+}
+
+// ErrorContextConfig configures the log records kept around the errors of a resource.
+type ErrorContextConfig struct {
+	// Severity is the minimum severity of the log records considered as errors.
+	Severity logSeverity `mapstructure:"severity"`
+
+	// Before is the duration before an error during which the log records of the same resource
+	// are buffered instead of being dropped, to be sent with the error.
+	Before time.Duration `mapstructure:"before"`
+
+	// After is the duration after an error during which all the log records of the same resource are kept.
+	After time.Duration `mapstructure:"after"`
+
+	// MaxBufferedRecords is the maximum number of log records buffered per resource,
+	// the oldest ones are dropped beyond it.
+	MaxBufferedRecords int `mapstructure:"max_buffered_records"`
+
+	// MaxResources is the maximum number of resources which context is tracked, the least recently
+	// active ones are forgotten beyond it.
+	MaxResources int `mapstructure:"max_resources"`
+}
+
+// Config defines configuration for the adaptive log sampler processor.
+type Config struct {
+	// SeverityThreshold is the minimum severity of the log records that are always kept.
+	SeverityThreshold logSeverity `mapstructure:"severity_threshold"`
+
+	// RatePerSecond is the number of log records below the severity threshold kept per second and per service,
+	// outside of the context of the errors. 0 drops all of them.
+	RatePerSecond float64 `mapstructure:"rate_per_second"`
+
+	// ServiceAttribute is the resource attribute identifying the service of the log records.
+	ServiceAttribute string `mapstructure:"service_attribute"`
+
+	// ErrorContext configures the log records kept around the errors.
+	ErrorContext ErrorContextConfig `mapstructure:"error_context"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if err := cfg.SeverityThreshold.validate(); err != nil {
+		return fmt.Errorf("severity_threshold: %w", err)
+	}
+	if cfg.RatePerSecond < 0 {
+		return errors.New("rate_per_second must not be negative")
+	}
+	if cfg.ServiceAttribute == "" {
+		return errors.New("service_attribute must be set")
+	}
+	if err := cfg.ErrorContext.Severity.validate(); err != nil {
+		return fmt.Errorf("error_context: severity: %w", err)
+	}
+	if cfg.ErrorContext.Before < 0 || cfg.ErrorContext.After < 0 {
+		return errors.New("error_context: before and after must not be negative")
+	}
+	if cfg.ErrorContext.Before > 0 && cfg.ErrorContext.MaxBufferedRecords < 1 {
+		return errors.New("error_context: max_buffered_records must be at least 1")
+	}
+	if cfg.ErrorContext.MaxResources < 1 {
+		return errors.New("error_context: max_resources must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adaptivelogsamplerprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivelogsamplerprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    func(*Config)
+		expectedErr string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: func(cfg *Config) {
+				cfg.SeverityThreshold = "info"
+				cfg.RatePerSecond = 0.5
+				cfg.ServiceAttribute = "k8s.deployment.name"
+				cfg.ErrorContext = ErrorContextConfig{
+					Severity:           "FATAL",
+					Before:             30 * time.Second,
+					After:              time.Minute,
+					MaxBufferedRecords: 100,
+					MaxResources:       500,
+				}
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_severity_threshold"),
+			expectedErr: `severity_threshold: "NOTICE" is not a valid severity`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_rate"),
+			expectedErr: "rate_per_second must not be negative",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_service_attribute"),
+			expectedErr: "service_attribute must be set",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_error_severity"),
+			expectedErr: `error_context: severity: "CRITICAL" is not a valid severity`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_duration"),
+			expectedErr: "error_context: before and after must not be negative",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_max_buffered_records"),
+			expectedErr: "error_context: max_buffered_records must be at least 1",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_max_resources"),
+			expectedErr: "error_context: max_resources must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+
+			expected := createDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
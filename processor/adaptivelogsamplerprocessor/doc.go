@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package adaptivelogsamplerprocessor implements a processor keeping the severe log records,
+// rate limiting the other ones per service, and keeping the context of the errors.
+package adaptivelogsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivelogsamplerprocessor"
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adaptivelogsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivelogsamplerprocessor"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivelogsamplerprocessor/internal/metadata"
+)
+
+var processorCapabilities = consumer.Capabilities{MutatesData: true}
+
+// NewFactory returns a new factory for the adaptive log sampler processor.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithLogs(createLogsProcessor, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		SeverityThreshold: "WARN",
+		RatePerSecond:     10,
+		ServiceAttribute:  conventions.AttributeServiceName,
+		ErrorContext: ErrorContextConfig{
+			Severity:           "ERROR",
+			Before:             10 * time.Second,
+			After:              10 * time.Second,
+			MaxBufferedRecords: 1000,
+			MaxResources:       10000,
+		},
+	}
+}
+
+func createLogsProcessor(
+	ctx context.Context,
+	set processor.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs) (processor.Logs, error) {
+	s := newLogSampler(cfg.(*Config))
+	return processorhelper.NewLogsProcessor(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		s.processLogs,
+		processorhelper.WithCapabilities(processorCapabilities))
+}
@@ -0,0 +1,298 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adaptivelogsamplerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/adaptivelogsamplerprocessor"
+
+import (
+	"container/list"
+	"context"
+	"hash/fnv"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// tokenBucket limits the rate of the log records of a service.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accumulated since the last call, up to burst.
+func (b *tokenBucket) refill(now time.Time, rate, burst float64) {
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+}
+
+// bufferedScope is the scope of buffered log records, shared by the records of the same scope logs.
+type bufferedScope struct {
+	scope     pcommon.InstrumentationScope
+	schemaURL string
+}
+
+// bufferedRecord is a log record dropped before an error, sent if an error of its resource follows.
+type bufferedRecord struct {
+	at     time.Time
+	scope  *bufferedScope
+	record plog.LogRecord
+}
+
+// resourceState holds the context of the errors of a resource.
+type resourceState struct {
+	key       [16]byte
+	resource  pcommon.Resource
+	schemaURL string
+	buffered  []bufferedRecord
+	// keepUntil is the end of the context after the last error.
+	keepUntil time.Time
+	// lastActive is the last time a log record of the resource was buffered or was an error.
+	lastActive time.Time
+	element    *list.Element
+}
+
+// numShards is the number of shards of the state of the sampler. The services are spread over the
+// shards, so that the logs of different services are mostly processed concurrently.
+const numShards = 16
+
+// shard holds the state of the services assigned to it and of their resources.
+type shard struct {
+	mu        sync.Mutex
+	services  map[string]*tokenBucket
+	resources map[[16]byte]*resourceState
+	// recent orders the resources from the most to the least recently active.
+	recent *list.List
+	// nextServicesExpiry is the next time the services are looked at to be forgotten.
+	nextServicesExpiry time.Time
+}
+
+type logSampler struct {
+	cfg           *Config
+	threshold     plog.SeverityNumber
+	errorSeverity plog.SeverityNumber
+	burst         float64
+	// idleTimeout is the duration after which the state of a resource which isn't active anymore
+	// is back to the initial one.
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	shards [numShards]shard
+	// numResources is the number of resources tracked by all the shards.
+	numResources atomic.Int64
+}
+
+func newLogSampler(cfg *Config) *logSampler {
+	s := &logSampler{
+		cfg:           cfg,
+		threshold:     cfg.SeverityThreshold.severityNumber(),
+		errorSeverity: cfg.ErrorContext.Severity.severityNumber(),
+		// a service can send up to a second of log records at once
+		burst:       math.Max(1, cfg.RatePerSecond),
+		idleTimeout: max(cfg.ErrorContext.Before, cfg.ErrorContext.After),
+		now:         time.Now,
+	}
+	for i := range s.shards {
+		s.shards[i].services = make(map[string]*tokenBucket)
+		s.shards[i].resources = make(map[[16]byte]*resourceState)
+		s.shards[i].recent = list.New()
+	}
+	return s
+}
+
+// processLogs keeps the errors and the log records at or above the severity threshold, the log records
+// of the resources with a recent error, and the other log records within the rate of their service.
+// The log records dropped shortly before an error are sent with it, in resource logs of their own.
+func (s *logSampler) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	now := s.now()
+	s.expire(now)
+	errorContext := plog.NewLogs()
+	ld.ResourceLogs().RemoveIf(func(rl plog.ResourceLogs) bool {
+		var service string
+		if v, ok := rl.Resource().Attributes().Get(s.cfg.ServiceAttribute); ok {
+			service = v.AsString()
+		}
+		sh := s.shardOf(service)
+		sh.mu.Lock()
+		defer sh.mu.Unlock()
+
+		key := pdatautil.MapHash(rl.Resource().Attributes())
+		state := sh.resources[key]
+		if state != nil {
+			state.dropExpired(now, s.cfg.ErrorContext.Before)
+		}
+
+		rl.ScopeLogs().RemoveIf(func(sl plog.ScopeLogs) bool {
+			var scope *bufferedScope
+			sl.LogRecords().RemoveIf(func(lr plog.LogRecord) bool {
+				severity := lr.SeverityNumber()
+				switch {
+				case severity >= s.errorSeverity:
+					if state == nil {
+						state = s.track(sh, key, rl, now)
+					}
+					if state != nil {
+						sh.touch(state, now)
+						state.keepUntil = now.Add(s.cfg.ErrorContext.After)
+						state.flush(errorContext)
+					}
+					return false
+				case severity >= s.threshold:
+					return false
+				case state != nil && now.Before(state.keepUntil):
+					return false
+				case sh.allow(service, now, s.cfg.RatePerSecond, s.burst):
+					return false
+				}
+
+				if s.cfg.ErrorContext.Before > 0 {
+					if state == nil {
+						state = s.track(sh, key, rl, now)
+					}
+					if state == nil {
+						return true
+					}
+					if scope == nil {
+						scope = &bufferedScope{scope: pcommon.NewInstrumentationScope(), schemaURL: sl.SchemaUrl()}
+						sl.Scope().CopyTo(scope.scope)
+					}
+					sh.touch(state, now)
+					state.buffer(bufferedRecord{at: now, scope: scope, record: lr}, s.cfg.ErrorContext.MaxBufferedRecords)
+				}
+				return true
+			})
+			return sl.LogRecords().Len() == 0
+		})
+		return rl.ScopeLogs().Len() == 0
+	})
+	errorContext.ResourceLogs().MoveAndAppendTo(ld.ResourceLogs())
+	return ld, nil
+}
+
+// shardOf returns the shard of a service.
+func (s *logSampler) shardOf(service string) *shard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(service))
+	return &s.shards[h.Sum32()%numShards]
+}
+
+// allow consumes a token of the service if there is one left.
+func (sh *shard) allow(service string, now time.Time, rate, burst float64) bool {
+	if rate == 0 {
+		return false
+	}
+	bucket, ok := sh.services[service]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, last: now}
+		sh.services[service] = bucket
+	}
+	bucket.refill(now, rate, burst)
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// track starts tracking the state of a resource. Once max_resources are tracked, the least recently
+// active resource of the shard is forgotten to make room for it, and the resource isn't tracked if
+// the shard has none.
+func (s *logSampler) track(sh *shard, key [16]byte, rl plog.ResourceLogs, now time.Time) *resourceState {
+	if s.numResources.Add(1) > int64(s.cfg.ErrorContext.MaxResources) {
+		e := sh.recent.Back()
+		if e == nil {
+			s.numResources.Add(-1)
+			return nil
+		}
+		s.forget(sh, e.Value.(*resourceState))
+	}
+	state := &resourceState{key: key, resource: pcommon.NewResource(), schemaURL: rl.SchemaUrl(), lastActive: now}
+	rl.Resource().CopyTo(state.resource)
+	state.element = sh.recent.PushFront(state)
+	sh.resources[state.key] = state
+	return state
+}
+
+func (s *logSampler) forget(sh *shard, state *resourceState) {
+	sh.recent.Remove(state.element)
+	delete(sh.resources, state.key)
+	s.numResources.Add(-1)
+}
+
+// touch marks the resource as active.
+func (sh *shard) touch(state *resourceState, now time.Time) {
+	state.lastActive = now
+	sh.recent.MoveToFront(state.element)
+}
+
+// expire forgets the resources inactive for longer than the context of the errors, and the services
+// which rate is back under the limit, as their state is back to the initial one, so that it doesn't
+// grow unbounded.
+func (s *logSampler) expire(now time.Time) {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		for e := sh.recent.Back(); e != nil; e = sh.recent.Back() {
+			state := e.Value.(*resourceState)
+			if now.Sub(state.lastActive) <= s.idleTimeout {
+				break
+			}
+			s.forget(sh, state)
+		}
+		// the services are only looked at once per second, as it requires going through all of them
+		if !now.Before(sh.nextServicesExpiry) {
+			sh.nextServicesExpiry = now.Add(time.Second)
+			for service, bucket := range sh.services {
+				bucket.refill(now, s.cfg.RatePerSecond, s.burst)
+				if bucket.tokens >= s.burst {
+					delete(sh.services, service)
+				}
+			}
+		}
+		sh.mu.Unlock()
+	}
+}
+
+// dropExpired drops the log records buffered for longer than the context before the errors.
+func (state *resourceState) dropExpired(now time.Time, before time.Duration) {
+	i := 0
+	for i < len(state.buffered) && now.Sub(state.buffered[i].at) > before {
+		i++
+	}
+	state.buffered = state.buffered[i:]
+}
+
+// buffer copies the log record to the buffer, dropping the oldest one if it is full.
+func (state *resourceState) buffer(br bufferedRecord, maxRecords int) {
+	record := plog.NewLogRecord()
+	br.record.CopyTo(record)
+	br.record = record
+	if len(state.buffered) >= maxRecords {
+		state.buffered = state.buffered[1:]
+	}
+	state.buffered = append(state.buffered, br)
+}
+
+// flush moves the buffered log records to the logs, grouping the consecutive records of the same scope.
+func (state *resourceState) flush(ld plog.Logs) {
+	if len(state.buffered) == 0 {
+		return
+	}
+	rl := ld.ResourceLogs().AppendEmpty()
+	state.resource.CopyTo(rl.Resource())
+	rl.SetSchemaUrl(state.schemaURL)
+	var sl plog.ScopeLogs
+	for i, br := range state.buffered {
+		if i == 0 || br.scope != state.buffered[i-1].scope {
+			sl = rl.ScopeLogs().AppendEmpty()
+			br.scope.scope.CopyTo(sl.Scope())
+			sl.SetSchemaUrl(br.scope.schemaURL)
+		}
+		br.record.MoveTo(sl.LogRecords().AppendEmpty())
+	}
+	state.buffered = nil
+}
@@ -0,0 +1,269 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package adaptivelogsamplerprocessor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+type testRecord struct {
+	service  string
+	body     string
+	severity plog.SeverityNumber
+}
+
+func newTestLogs(records ...testRecord) plog.Logs {
+	ld := plog.NewLogs()
+	for _, r := range records {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", r.service)
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName("test")
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Body().SetStr(r.body)
+		lr.SetSeverityNumber(r.severity)
+	}
+	return ld
+}
+
+// bodies returns the bodies of the log records by service, in their order.
+func bodies(ld plog.Logs) map[string][]string {
+	result := map[string][]string{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		service, _ := rl.Resource().Attributes().Get("service.name")
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				result[service.Str()] = append(result[service.Str()], records.At(k).Body().Str())
+			}
+		}
+	}
+	return result
+}
+
+type testSampler struct {
+	*logSampler
+	now time.Time
+}
+
+func newTestSampler(cfg *Config) *testSampler {
+	s := &testSampler{logSampler: newLogSampler(cfg), now: time.Unix(1700000000, 0)}
+	s.logSampler.now = func() time.Time { return s.now }
+	return s
+}
+
+// tracked returns the number of services and resources tracked by the sampler.
+func (s *testSampler) tracked() (services, resources int) {
+	for i := range s.shards {
+		services += len(s.shards[i].services)
+		resources += len(s.shards[i].resources)
+	}
+	return services, resources
+}
+
+func (s *testSampler) process(t *testing.T, records ...testRecord) map[string][]string {
+	ld, err := s.processLogs(context.Background(), newTestLogs(records...))
+	require.NoError(t, err)
+	return bodies(ld)
+}
+
+func TestSeverityThreshold(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 0
+	cfg.ErrorContext.Before = 0
+	s := newTestSampler(cfg)
+
+	assert.Equal(t, map[string][]string{"api": {"warn", "error"}}, s.process(t,
+		testRecord{"api", "debug", plog.SeverityNumberDebug},
+		testRecord{"api", "info", plog.SeverityNumberInfo},
+		testRecord{"api", "warn", plog.SeverityNumberWarn},
+		testRecord{"api", "error", plog.SeverityNumberError},
+	))
+}
+
+func TestRatePerService(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 2
+	cfg.ErrorContext.Before = 0
+	s := newTestSampler(cfg)
+
+	assert.Equal(t, map[string][]string{"api": {"1", "2"}, "web": {"1"}}, s.process(t,
+		testRecord{"api", "1", plog.SeverityNumberInfo},
+		testRecord{"api", "2", plog.SeverityNumberInfo},
+		testRecord{"api", "3", plog.SeverityNumberInfo},
+		testRecord{"web", "1", plog.SeverityNumberInfo},
+	))
+
+	s.now = s.now.Add(500 * time.Millisecond)
+	assert.Equal(t, map[string][]string{"api": {"4"}}, s.process(t,
+		testRecord{"api", "4", plog.SeverityNumberInfo},
+		testRecord{"api", "5", plog.SeverityNumberInfo},
+	))
+
+	// the buckets of the services which rate is back under the limit are forgotten
+	s.now = s.now.Add(time.Minute)
+	s.process(t)
+	services, _ := s.tracked()
+	assert.Zero(t, services)
+}
+
+func TestErrorContext(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 0
+	cfg.ErrorContext.Before = 10 * time.Second
+	cfg.ErrorContext.After = 5 * time.Second
+	cfg.ErrorContext.MaxBufferedRecords = 2
+	s := newTestSampler(cfg)
+
+	assert.Empty(t, s.process(t,
+		testRecord{"api", "expired", plog.SeverityNumberInfo},
+	))
+	s.now = s.now.Add(11 * time.Second)
+	assert.Empty(t, s.process(t,
+		testRecord{"api", "dropped", plog.SeverityNumberDebug},
+		testRecord{"api", "before 1", plog.SeverityNumberInfo},
+		testRecord{"web", "other resource", plog.SeverityNumberInfo},
+	))
+
+	s.now = s.now.Add(time.Second)
+	// the records buffered before the error are sent after the records of the batch, the oldest
+	// one was dropped as the buffer is full
+	assert.Equal(t, map[string][]string{"api": {"error", "before 1", "before 2"}}, s.process(t,
+		testRecord{"api", "before 2", plog.SeverityNumberInfo},
+		testRecord{"api", "error", plog.SeverityNumberError},
+	))
+
+	s.now = s.now.Add(4 * time.Second)
+	assert.Equal(t, map[string][]string{"api": {"after"}}, s.process(t,
+		testRecord{"api", "after", plog.SeverityNumberDebug},
+		testRecord{"web", "other resource", plog.SeverityNumberInfo},
+	))
+
+	s.now = s.now.Add(2 * time.Second)
+	assert.Empty(t, s.process(t,
+		testRecord{"api", "too late", plog.SeverityNumberDebug},
+	))
+
+	// the state of the resources is forgotten once their context is over
+	s.now = s.now.Add(time.Minute)
+	s.process(t)
+	_, resources := s.tracked()
+	assert.Zero(t, resources)
+	assert.Zero(t, s.numResources.Load())
+}
+
+func TestMaxResources(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 0
+	cfg.ErrorContext.MaxResources = 2
+	s := newTestSampler(cfg)
+
+	// the resources of a service are in the same shard
+	hostLogs := func(host, body string, severity plog.SeverityNumber) plog.Logs {
+		ld := newTestLogs(testRecord{"api", body, severity})
+		ld.ResourceLogs().At(0).Resource().Attributes().PutStr("host.name", host)
+		return ld
+	}
+	for _, host := range []string{"a", "b", "c"} {
+		ld, err := s.processLogs(context.Background(), hostLogs(host, "before "+host, plog.SeverityNumberInfo))
+		require.NoError(t, err)
+		assert.Empty(t, bodies(ld))
+		s.now = s.now.Add(time.Second)
+	}
+	_, resources := s.tracked()
+	assert.Equal(t, 2, resources)
+	assert.EqualValues(t, 2, s.numResources.Load())
+
+	// the least recently active resource was forgotten
+	ld, err := s.processLogs(context.Background(), hostLogs("a", "error a", plog.SeverityNumberError))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"api": {"error a"}}, bodies(ld))
+	ld, err = s.processLogs(context.Background(), hostLogs("c", "error c", plog.SeverityNumberError))
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"api": {"error c", "before c"}}, bodies(ld))
+	assert.EqualValues(t, 2, s.numResources.Load())
+}
+
+func TestFlushGroupsScopes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 0
+	s := newTestSampler(cfg)
+
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.SetSchemaUrl("https://opentelemetry.io/schemas/1.9.0")
+	rl.Resource().Attributes().PutStr("service.name", "api")
+	for _, name := range []string{"http", "db"} {
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName(name)
+		sl.LogRecords().AppendEmpty().Body().SetStr(name + " 1")
+		sl.LogRecords().AppendEmpty().Body().SetStr(name + " 2")
+	}
+	ld, err := s.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	assert.Equal(t, 0, ld.ResourceLogs().Len())
+
+	ld, err = s.processLogs(context.Background(), newTestLogs(testRecord{"api", "error", plog.SeverityNumberError}))
+	require.NoError(t, err)
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+	flushed := ld.ResourceLogs().At(1)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", flushed.SchemaUrl())
+	assert.Equal(t, map[string]any{"service.name": "api"}, flushed.Resource().Attributes().AsRaw())
+	require.Equal(t, 2, flushed.ScopeLogs().Len())
+	assert.Equal(t, "http", flushed.ScopeLogs().At(0).Scope().Name())
+	assert.Equal(t, 2, flushed.ScopeLogs().At(0).LogRecords().Len())
+	assert.Equal(t, "db", flushed.ScopeLogs().At(1).Scope().Name())
+	assert.Equal(t, 2, flushed.ScopeLogs().At(1).LogRecords().Len())
+}
+
+func TestProcessLogsConcurrently(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 0
+	s := newLogSampler(cfg)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(service string) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				ld, err := s.processLogs(context.Background(), newTestLogs(
+					testRecord{service, "info", plog.SeverityNumberInfo},
+					testRecord{service, "error", plog.SeverityNumberError},
+				))
+				assert.NoError(t, err)
+				// the info record is buffered, then kept in the context of the previous error
+				assert.ElementsMatch(t, []string{"error", "info"}, bodies(ld)[service])
+			}
+		}(fmt.Sprintf("service-%d", i))
+	}
+	wg.Wait()
+	assert.EqualValues(t, 8, s.numResources.Load())
+}
+
+func TestProcessLogs(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.RatePerSecond = 1
+	sink := new(consumertest.LogsSink)
+	lp, err := NewFactory().CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, lp.ConsumeLogs(context.Background(), newTestLogs(
+		testRecord{"api", "kept", plog.SeverityNumberInfo},
+		testRecord{"api", "dropped", plog.SeverityNumberInfo},
+	)))
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, map[string][]string{"api": {"kept"}}, bodies(sink.AllLogs()[0]))
+}
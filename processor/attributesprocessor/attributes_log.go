@@ -53,7 +53,7 @@ func (a *logAttributesProcessor) processLogs(ctx context.Context, ld plog.Logs)
 					}
 				}
 
-				a.attrProc.Process(ctx, a.logger, lr.Attributes())
+				a.attrProc.ProcessLog(ctx, a.logger, lr.Body(), lr.Attributes())
 			}
 		}
 	}
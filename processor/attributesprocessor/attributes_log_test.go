@@ -415,6 +415,34 @@ func TestLogAttributes_Convert(t *testing.T) {
 	}
 }
 
+func TestLogAttributes_ExtractJSONPath(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "user.id", Action: attraction.EXTRACTJSONPATH, JSONPath: "$.user.id"},
+		{Key: "user.roles", Action: attraction.EXTRACTJSONPATH, JSONPath: "$.user.roles[*]"},
+	}
+
+	tp, err := factory.CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, tp)
+
+	for name, body := range map[string]any{
+		"string body": `{"user": {"id": 42, "roles": ["admin", "dev"]}}`,
+		"map body":    map[string]any{"user": map[string]any{"id": 42, "roles": []any{"admin", "dev"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ld := generateLogData(name, map[string]any{})
+			lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+			require.NoError(t, lr.Body().FromRaw(body))
+
+			assert.NoError(t, tp.ConsumeLogs(context.Background(), ld))
+			assert.Equal(t, map[string]any{"user.id": int64(42), "user.roles": []any{"admin", "dev"}}, lr.Attributes().AsRaw())
+		})
+	}
+}
+
 func BenchmarkAttributes_FilterLogsByName(b *testing.B) {
 	testCases := []logTestCase{
 		{
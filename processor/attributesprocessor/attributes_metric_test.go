@@ -6,6 +6,7 @@ package attributesprocessor
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,8 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
@@ -405,6 +408,117 @@ func TestMetricAttributes_Convert(t *testing.T) {
 	}
 }
 
+func TestMetricAttributes_Limit(t *testing.T) {
+	testCases := []metricTestCase{
+		{
+			name:               "first value",
+			inputAttributes:    map[string]any{"user.id": "a"},
+			expectedAttributes: map[string]any{"user.id": "a"},
+		},
+		{
+			name:               "value over the limit",
+			inputAttributes:    map[string]any{"user.id": "b"},
+			expectedAttributes: map[string]any{"user.id": "overflow"},
+		},
+		{
+			name:               "value seen before the limit",
+			inputAttributes:    map[string]any{"user.id": "a"},
+			expectedAttributes: map[string]any{"user.id": "a"},
+		},
+		{
+			name:               "another value over the limit",
+			inputAttributes:    map[string]any{"user.id": "c"},
+			expectedAttributes: map[string]any{"user.id": "overflow"},
+		},
+	}
+
+	tel := setupTestTelemetry()
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "user.id", Action: attraction.LIMIT, MaxValues: 1},
+	}
+
+	tp, err := factory.CreateMetricsProcessor(context.Background(), tel.NewCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, tp)
+
+	for _, tt := range testCases {
+		runIndividualMetricTestCase(t, tt, tp)
+	}
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_attributes_values.overflowed",
+			Description: "Number of attribute values replaced with the overflow value by the limit action",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value: 2,
+						Attributes: attribute.NewSet(
+							attribute.String("attributes", "attributes"),
+							attribute.String("key", "user.id"),
+						),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
+func TestMetricAttributes_LimitValueTTL(t *testing.T) {
+	tel := setupTestTelemetry()
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.Actions = []attraction.ActionKeyValue{
+		{Key: "user.id", Action: attraction.LIMIT, MaxValues: 1, ValueTTL: time.Millisecond},
+	}
+
+	tp, err := factory.CreateMetricsProcessor(context.Background(), tel.NewCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NotNil(t, tp)
+
+	runIndividualMetricTestCase(t, metricTestCase{
+		name:               "first value",
+		inputAttributes:    map[string]any{"user.id": "a"},
+		expectedAttributes: map[string]any{"user.id": "a"},
+	}, tp)
+	time.Sleep(5 * time.Millisecond)
+	runIndividualMetricTestCase(t, metricTestCase{
+		name:               "value replacing the expired one",
+		inputAttributes:    map[string]any{"user.id": "b"},
+		expectedAttributes: map[string]any{"user.id": "b"},
+	}, tp)
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_attributes_values.evicted",
+			Description: "Number of attribute values evicted by the limit action as they were not seen for the value TTL",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Value: 1,
+						Attributes: attribute.NewSet(
+							attribute.String("attributes", "attributes"),
+							attribute.String("key", "user.id"),
+						),
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
 func BenchmarkAttributes_FilterMetricsByName(b *testing.B) {
 	testCases := []metricTestCase{
 		{
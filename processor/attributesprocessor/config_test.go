@@ -6,6 +6,7 @@ package attributesprocessor
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -197,6 +198,17 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "limit"),
+			expected: &Config{
+				Settings: attraction.Settings{
+					Actions: []attraction.ActionKeyValue{
+						{Key: "user.id", Action: attraction.LIMIT, MaxValues: 1000},
+						{Key: "http.route", Action: attraction.LIMIT, MaxValues: 100, Value: "other", ValueTTL: 10 * time.Minute},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
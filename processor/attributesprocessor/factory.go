@@ -10,6 +10,8 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/attraction"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterconfig"
@@ -31,6 +33,24 @@ func NewFactory() processor.Factory {
 		processor.WithMetrics(createMetricsProcessor, metadata.MetricsStability))
 }
 
+// newAttrProc returns the attribute processor of the configured actions, counting the
+// values replaced with the overflow value and the values evicted by the limit actions.
+func newAttrProc(set processor.CreateSettings, cfg *Config) (*attraction.AttrProc, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	processorAttr := attribute.String(metadata.Type.String(), set.ID.String())
+	return attraction.NewAttrProc(&cfg.Settings,
+		attraction.WithOverflowFunc(func(ctx context.Context, key string) {
+			telemetryBuilder.ProcessorAttributesValuesOverflowed.Add(ctx, 1, metric.WithAttributes(processorAttr, attribute.String("key", key)))
+		}),
+		attraction.WithEvictionFunc(func(ctx context.Context, key string, evicted int) {
+			telemetryBuilder.ProcessorAttributesValuesEvicted.Add(ctx, int64(evicted), metric.WithAttributes(processorAttr, attribute.String("key", key)))
+		}),
+	)
+}
+
 // Note: This isn't a valid configuration because the processor would do no work.
 func createDefaultConfig() component.Config {
 	return &Config{}
@@ -43,7 +63,7 @@ func createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
-	attrProc, err := attraction.NewAttrProc(&oCfg.Settings)
+	attrProc, err := newAttrProc(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +87,7 @@ func createLogsProcessor(
 	nextConsumer consumer.Logs,
 ) (processor.Logs, error) {
 	oCfg := cfg.(*Config)
-	attrProc, err := attraction.NewAttrProc(&oCfg.Settings)
+	attrProc, err := newAttrProc(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +114,7 @@ func createMetricsProcessor(
 ) (processor.Metrics, error) {
 
 	oCfg := cfg.(*Config)
-	attrProc, err := attraction.NewAttrProc(&oCfg.Settings)
+	attrProc, err := newAttrProc(set, oCfg)
 	if err != nil {
 		return nil, err
 	}
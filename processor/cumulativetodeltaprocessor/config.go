@@ -25,6 +25,14 @@ type Config struct {
 	//   - drop: don't send the first point, but store it for subsequent delta calculations
 	InitialValue tracking.InitialValue `mapstructure:"initial_value"`
 
+	// CounterReset determines how to handle a datapoint lower than the previous datapoint of its metric,
+	// which started counting again from zero. Valid values:
+	//
+	//   - auto: (default) drop the point of sums, and send the point of histograms
+	//   - keep: send the point as the delta since the reset
+	//   - drop: don't send the point, but store it for subsequent delta calculations
+	CounterReset tracking.CounterReset `mapstructure:"counter_reset"`
+
 	// Include specifies a filter on the metrics that should be converted.
 	// Exclude specifies a filter on the metrics that should not be converted.
 	// If neither `include` nor `exclude` are set, all metrics will be converted.
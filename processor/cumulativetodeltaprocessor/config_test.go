@@ -108,6 +108,18 @@ func TestLoadConfig(t *testing.T) {
 				InitialValue: tracking.InitialValueDrop,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "counter_reset_keep"),
+			expected: &Config{
+				CounterReset: tracking.CounterResetKeep,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "counter_reset_drop"),
+			expected: &Config{
+				CounterReset: tracking.CounterResetDrop,
+			},
+		},
 	}
 
 	for _, tt := range tests {
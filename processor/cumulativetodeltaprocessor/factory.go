@@ -40,7 +40,10 @@ func createMetricsProcessor(
 		return nil, fmt.Errorf("configuration parsing error")
 	}
 
-	metricsProcessor := newCumulativeToDeltaProcessor(processorConfig, set.Logger)
+	metricsProcessor, err := newCumulativeToDeltaProcessor(processorConfig, set)
+	if err != nil {
+		return nil, err
+	}
 
 	return processorhelper.NewMetricsProcessor(
 		ctx,
@@ -53,6 +53,52 @@ func (i *InitialValue) UnmarshalText(text []byte) error {
 	return nil
 }
 
+type CounterReset int
+
+const (
+	CounterResetAuto CounterReset = iota
+	CounterResetKeep
+	CounterResetDrop
+)
+
+func (r *CounterReset) String() string {
+	switch *r {
+	case CounterResetAuto:
+		return "auto"
+	case CounterResetKeep:
+		return "keep"
+	case CounterResetDrop:
+		return "drop"
+	}
+	return "unknown"
+}
+
+func (r *CounterReset) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "auto":
+		*r = CounterResetAuto
+	case "keep":
+		*r = CounterResetKeep
+	case "drop":
+		*r = CounterResetDrop
+	default:
+		return fmt.Errorf("unknown counter_reset: %s", text)
+	}
+	return nil
+}
+
+// Event is what a point represents for the state of its metric identity.
+type Event int
+
+const (
+	// EventUpdate is a point following a previous observation.
+	EventUpdate Event = iota
+	// EventInitial is the first observed point.
+	EventInitial
+	// EventReset is a point lower than the previous observation.
+	EventReset
+)
+
 var identityBufferPool = sync.Pool{
 	New: func() any {
 		return bytes.NewBuffer(make([]byte, initialBytes))
@@ -65,18 +111,23 @@ type State struct {
 }
 
 type DeltaValue struct {
+	Event          Event
 	StartTimestamp pcommon.Timestamp
 	FloatValue     float64
 	IntValue       int64
 	HistogramValue *HistogramPoint
 }
 
-func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, initalValue InitialValue) *MetricTracker {
+// NewMetricTracker returns a tracker of the states of the metric identities. onStaleRemoved, if not nil,
+// is called with the number of states removed after exceeding the max staleness.
+func NewMetricTracker(ctx context.Context, logger *zap.Logger, maxStaleness time.Duration, initalValue InitialValue, counterReset CounterReset, onStaleRemoved func(context.Context, int)) *MetricTracker {
 	t := &MetricTracker{
-		logger:       logger,
-		maxStaleness: maxStaleness,
-		initialValue: initalValue,
-		startTime:    pcommon.NewTimestampFromTime(time.Now()),
+		logger:         logger,
+		maxStaleness:   maxStaleness,
+		initialValue:   initalValue,
+		counterReset:   counterReset,
+		startTime:      pcommon.NewTimestampFromTime(time.Now()),
+		onStaleRemoved: onStaleRemoved,
 	}
 	if maxStaleness > 0 {
 		go t.sweeper(ctx, t.removeStale)
@@ -89,7 +140,10 @@ type MetricTracker struct {
 	maxStaleness time.Duration
 	states       sync.Map
 	initialValue InitialValue
+	counterReset CounterReset
 	startTime    pcommon.Timestamp
+
+	onStaleRemoved func(ctx context.Context, removed int)
 }
 
 func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
@@ -116,6 +170,7 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 		PrevPoint: metricPoint,
 	})
 	if !ok {
+		out.Event = EventInitial
 		switch metricID.MetricType {
 		case pmetric.MetricTypeHistogram:
 			val := metricPoint.HistogramValue.Clone()
@@ -168,6 +223,10 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 			for index, prevBucket := range prevValue.Buckets {
 				delta.Buckets[index] -= prevBucket
 			}
+		} else if valid {
+			// the observed value is sent as the delta since the reset, unless dropped
+			out.Event = EventReset
+			valid = t.counterReset != CounterResetDrop
 		}
 
 		out.HistogramValue = &delta
@@ -179,7 +238,9 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 			// Detect reset (non-monotonic sums are not converted)
 			if value < prevValue {
-				valid = false
+				out.Event = EventReset
+				delta = value
+				valid = t.counterReset == CounterResetKeep
 			}
 
 			out.FloatValue = delta
@@ -190,7 +251,9 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 
 			// Detect reset (non-monotonic sums are not converted)
 			if value < prevValue {
-				valid = false
+				out.Event = EventReset
+				delta = value
+				valid = t.counterReset == CounterResetKeep
 			}
 
 			out.IntValue = delta
@@ -202,7 +265,9 @@ func (t *MetricTracker) Convert(in MetricPoint) (out DeltaValue, valid bool) {
 	return
 }
 
-func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
+// removeStale removes the states last observed before staleBefore, and returns their number.
+func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) int {
+	var removed int
 	t.states.Range(func(key, value any) bool {
 		s := value.(*State)
 
@@ -225,18 +290,22 @@ func (t *MetricTracker) removeStale(staleBefore pcommon.Timestamp) {
 		if lastObserved < staleBefore {
 			t.logger.Debug("removing stale state key", zap.String("key", key.(string)))
 			t.states.Delete(key)
+			removed++
 		}
 		return true
 	})
+	return removed
 }
 
-func (t *MetricTracker) sweeper(ctx context.Context, remove func(pcommon.Timestamp)) {
+func (t *MetricTracker) sweeper(ctx context.Context, remove func(pcommon.Timestamp) int) {
 	ticker := time.NewTicker(t.maxStaleness)
 	for {
 		select {
 		case currentTime := <-ticker.C:
 			staleBefore := pcommon.NewTimestampFromTime(currentTime.Add(-t.maxStaleness))
-			remove(staleBefore)
+			if removed := remove(staleBefore); removed > 0 && t.onStaleRemoved != nil {
+				t.onStaleRemoved(ctx, removed)
+			}
 		case <-ctx.Done():
 			ticker.Stop()
 			return
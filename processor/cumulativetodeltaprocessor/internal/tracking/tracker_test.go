@@ -194,7 +194,7 @@ func TestMetricTracker_Convert(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.initValue.String(), func(t *testing.T) {
-			m := NewMetricTracker(context.Background(), zap.NewNop(), 0, tt.initValue)
+			m := NewMetricTracker(context.Background(), zap.NewNop(), 0, tt.initValue, CounterResetAuto, nil)
 
 			miSum := miSum
 			miSum.StartTimestamp = tt.metricStartTime
@@ -231,7 +231,7 @@ func TestMetricTracker_Convert(t *testing.T) {
 	}
 
 	t.Run("Invalid metric identity", func(t *testing.T) {
-		m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueAuto)
+		m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueAuto, CounterResetAuto, nil)
 		invalidID := miIntSum
 		invalidID.MetricType = pmetric.MetricTypeGauge
 		_, valid := m.Convert(MetricPoint{
@@ -248,6 +248,75 @@ func TestMetricTracker_Convert(t *testing.T) {
 	})
 }
 
+func TestMetricTracker_CounterReset(t *testing.T) {
+	miSum := MetricIdentity{
+		Resource:               pcommon.NewResource(),
+		InstrumentationLibrary: pcommon.NewInstrumentationScope(),
+		MetricType:             pmetric.MetricTypeSum,
+		MetricIsMonotonic:      true,
+		MetricValueType:        pmetric.NumberDataPointValueTypeInt,
+		Attributes:             pcommon.NewMap(),
+	}
+	miHistogram := miSum
+	miHistogram.MetricType = pmetric.MetricTypeHistogram
+
+	start := time.Now()
+	sumPoint := func(offset time.Duration, value int64) MetricPoint {
+		return MetricPoint{Identity: miSum, Value: ValuePoint{
+			ObservedTimestamp: pcommon.NewTimestampFromTime(start.Add(offset)),
+			IntValue:          value,
+		}}
+	}
+	histogramPoint := func(offset time.Duration, count uint64) MetricPoint {
+		return MetricPoint{Identity: miHistogram, Value: ValuePoint{
+			ObservedTimestamp: pcommon.NewTimestampFromTime(start.Add(offset)),
+			HistogramValue:    &HistogramPoint{Count: count, Sum: float64(count), Buckets: []uint64{count}},
+		}}
+	}
+
+	tests := []struct {
+		counterReset   CounterReset
+		sumValid       bool
+		histogramValid bool
+	}{
+		{counterReset: CounterResetAuto, sumValid: false, histogramValid: true},
+		{counterReset: CounterResetKeep, sumValid: true, histogramValid: true},
+		{counterReset: CounterResetDrop, sumValid: false, histogramValid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.counterReset.String(), func(t *testing.T) {
+			m := NewMetricTracker(context.Background(), zap.NewNop(), 0, InitialValueKeep, tt.counterReset, nil)
+
+			out, valid := m.Convert(sumPoint(0, 100))
+			assert.True(t, valid)
+			assert.Equal(t, EventInitial, out.Event)
+			out, valid = m.Convert(sumPoint(time.Minute, 150))
+			assert.True(t, valid)
+			assert.Equal(t, EventUpdate, out.Event)
+			out, valid = m.Convert(sumPoint(2*time.Minute, 20))
+			assert.Equal(t, tt.sumValid, valid)
+			assert.Equal(t, EventReset, out.Event)
+			if valid {
+				assert.Equal(t, int64(20), out.IntValue)
+				assert.Equal(t, pcommon.NewTimestampFromTime(start.Add(time.Minute)), out.StartTimestamp)
+			}
+			// the point after the reset is the reference of the next delta
+			out, valid = m.Convert(sumPoint(3*time.Minute, 50))
+			assert.True(t, valid)
+			assert.Equal(t, int64(30), out.IntValue)
+
+			_, valid = m.Convert(histogramPoint(0, 10))
+			assert.True(t, valid)
+			out, valid = m.Convert(histogramPoint(time.Minute, 4))
+			assert.Equal(t, tt.histogramValid, valid)
+			assert.Equal(t, EventReset, out.Event)
+			if valid {
+				assert.Equal(t, HistogramPoint{Count: 4, Sum: 4, Buckets: []uint64{4}}, *out.HistogramValue)
+			}
+		})
+	}
+}
+
 func Test_metricTracker_removeStale(t *testing.T) {
 	currentTime := pcommon.Timestamp(100)
 	freshPoint := ValuePoint{
@@ -295,7 +364,7 @@ func Test_metricTracker_removeStale(t *testing.T) {
 			for k, v := range tt.fields.States {
 				tr.states.Store(k, v)
 			}
-			tr.removeStale(currentTime)
+			assert.Equal(t, len(tt.fields.States)-len(tt.wantOut), tr.removeStale(currentTime))
 
 			gotOut := make(map[string]*State)
 			tr.states.Range(func(key, value any) bool {
@@ -312,8 +381,9 @@ func Test_metricTracker_sweeper(t *testing.T) {
 	sweepEvent := make(chan pcommon.Timestamp)
 	closed := &atomic.Bool{}
 
-	onSweep := func(staleBefore pcommon.Timestamp) {
+	onSweep := func(staleBefore pcommon.Timestamp) int {
 		sweepEvent <- staleBefore
+		return 0
 	}
 
 	tr := &MetricTracker{
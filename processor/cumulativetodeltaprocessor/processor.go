@@ -8,26 +8,45 @@ import (
 	"math"
 
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
 )
 
+var (
+	// actionKeep and actionDrop tell whether the initial points and the points following a reset were sent.
+	actionKeep = metric.WithAttributes(attribute.String("action", "keep"))
+	actionDrop = metric.WithAttributes(attribute.String("action", "drop"))
+)
+
 type cumulativeToDeltaProcessor struct {
-	includeFS       filterset.FilterSet
-	excludeFS       filterset.FilterSet
-	logger          *zap.Logger
-	deltaCalculator *tracking.MetricTracker
-	cancelFunc      context.CancelFunc
+	includeFS        filterset.FilterSet
+	excludeFS        filterset.FilterSet
+	logger           *zap.Logger
+	deltaCalculator  *tracking.MetricTracker
+	cancelFunc       context.CancelFunc
+	telemetryBuilder *metadata.TelemetryBuilder
 }
 
-func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) *cumulativeToDeltaProcessor {
+func newCumulativeToDeltaProcessor(config *Config, set processor.CreateSettings) (*cumulativeToDeltaProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancel := context.WithCancel(context.Background())
+	onStaleRemoved := func(ctx context.Context, removed int) {
+		telemetryBuilder.ProcessorCumulativetodeltaStaleSeries.Add(ctx, int64(removed))
+	}
 	p := &cumulativeToDeltaProcessor{
-		logger:          logger,
-		deltaCalculator: tracking.NewMetricTracker(ctx, logger, config.MaxStaleness, config.InitialValue),
-		cancelFunc:      cancel,
+		logger:           set.Logger,
+		deltaCalculator:  tracking.NewMetricTracker(ctx, set.Logger, config.MaxStaleness, config.InitialValue, config.CounterReset, onStaleRemoved),
+		cancelFunc:       cancel,
+		telemetryBuilder: telemetryBuilder,
 	}
 	if len(config.Include.Metrics) > 0 {
 		p.includeFS, _ = filterset.CreateFilterSet(config.Include.Metrics, &config.Include.Config)
@@ -35,11 +54,11 @@ func newCumulativeToDeltaProcessor(config *Config, logger *zap.Logger) *cumulati
 	if len(config.Exclude.Metrics) > 0 {
 		p.excludeFS, _ = filterset.CreateFilterSet(config.Exclude.Metrics, &config.Exclude.Config)
 	}
-	return p
+	return p, nil
 }
 
 // processMetrics implements the ProcessMetricsFunc type.
-func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (ctdp *cumulativeToDeltaProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
 	md.ResourceMetrics().RemoveIf(func(rm pmetric.ResourceMetrics) bool {
 		rm.ScopeMetrics().RemoveIf(func(ilm pmetric.ScopeMetrics) bool {
 			ilm.Metrics().RemoveIf(func(m pmetric.Metric) bool {
@@ -66,7 +85,7 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 						MetricUnit:             m.Unit(),
 						MetricIsMonotonic:      ms.IsMonotonic(),
 					}
-					ctdp.convertDataPoints(ms.DataPoints(), baseIdentity)
+					ctdp.convertDataPoints(ctx, ms.DataPoints(), baseIdentity)
 					ms.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
 					return ms.DataPoints().Len() == 0
 				case pmetric.MetricTypeHistogram:
@@ -89,7 +108,7 @@ func (ctdp *cumulativeToDeltaProcessor) processMetrics(_ context.Context, md pme
 						MetricValueType:        pmetric.NumberDataPointValueTypeInt,
 					}
 
-					ctdp.convertHistogramDataPoints(ms.DataPoints(), baseIdentity)
+					ctdp.convertHistogramDataPoints(ctx, ms.DataPoints(), baseIdentity)
 
 					ms.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
 					return ms.DataPoints().Len() == 0
@@ -116,7 +135,22 @@ func (ctdp *cumulativeToDeltaProcessor) shouldConvertMetric(metricName string) b
 		(ctdp.excludeFS == nil || !ctdp.excludeFS.Matches(metricName))
 }
 
-func (ctdp *cumulativeToDeltaProcessor) convertDataPoints(in any, baseIdentity tracking.MetricIdentity) {
+// recordEvent counts the initial points and the points following a reset, sent or not.
+func (ctdp *cumulativeToDeltaProcessor) recordEvent(ctx context.Context, delta tracking.DeltaValue, valid bool) {
+	action := actionDrop
+	if valid {
+		action = actionKeep
+	}
+	switch delta.Event {
+	case tracking.EventInitial:
+		ctdp.telemetryBuilder.ProcessorCumulativetodeltaInitialPoints.Add(ctx, 1, action)
+	case tracking.EventReset:
+		ctdp.telemetryBuilder.ProcessorCumulativetodeltaCounterResets.Add(ctx, 1, action)
+	case tracking.EventUpdate:
+	}
+}
+
+func (ctdp *cumulativeToDeltaProcessor) convertDataPoints(ctx context.Context, in any, baseIdentity tracking.MetricIdentity) {
 	if dps, ok := in.(pmetric.NumberDataPointSlice); ok {
 		dps.RemoveIf(func(dp pmetric.NumberDataPoint) bool {
 			id := baseIdentity
@@ -145,6 +179,7 @@ func (ctdp *cumulativeToDeltaProcessor) convertDataPoints(in any, baseIdentity t
 				Value:    point,
 			}
 			delta, valid := ctdp.deltaCalculator.Convert(trackingPoint)
+			ctdp.recordEvent(ctx, delta, valid)
 			if !valid {
 				return true
 			}
@@ -159,7 +194,7 @@ func (ctdp *cumulativeToDeltaProcessor) convertDataPoints(in any, baseIdentity t
 	}
 }
 
-func (ctdp *cumulativeToDeltaProcessor) convertHistogramDataPoints(in any, baseIdentity tracking.MetricIdentity) {
+func (ctdp *cumulativeToDeltaProcessor) convertHistogramDataPoints(ctx context.Context, in any, baseIdentity tracking.MetricIdentity) {
 	if dps, ok := in.(pmetric.HistogramDataPointSlice); ok {
 		dps.RemoveIf(func(dp pmetric.HistogramDataPoint) bool {
 			id := baseIdentity
@@ -185,6 +220,7 @@ func (ctdp *cumulativeToDeltaProcessor) convertHistogramDataPoints(in any, baseI
 				Value:    point,
 			}
 			delta, valid := ctdp.deltaCalculator.Convert(trackingPoint)
+			ctdp.recordEvent(ctx, delta, valid)
 
 			if valid {
 				dp.SetStartTimestamp(delta.StartTimestamp)
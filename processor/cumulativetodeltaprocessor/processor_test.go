@@ -11,15 +11,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
-	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processortest"
-	"go.uber.org/zap"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/cumulativetodeltaprocessor/internal/tracking"
 )
 
 var (
@@ -47,11 +47,12 @@ type testHistogramMetric struct {
 }
 
 type cumulativeToDeltaTest struct {
-	name       string
-	include    MatchMetrics
-	exclude    MatchMetrics
-	inMetrics  pmetric.Metrics
-	outMetrics pmetric.Metrics
+	name         string
+	include      MatchMetrics
+	exclude      MatchMetrics
+	counterReset tracking.CounterReset
+	inMetrics    pmetric.Metrics
+	outMetrics   pmetric.Metrics
 }
 
 func TestCumulativeToDeltaProcessor(t *testing.T) {
@@ -436,6 +437,40 @@ func TestCumulativeToDeltaProcessor(t *testing.T) {
 				isMonotonic:  []bool{true},
 			}),
 		},
+		{
+			name:         "cumulative_to_delta_restart_kept",
+			counterReset: tracking.CounterResetKeep,
+			inMetrics: generateTestSumMetrics(testSumMetric{
+				metricNames:  []string{"metric_1"},
+				metricValues: [][]float64{{100, 105, 120, 100, 110}},
+				isCumulative: []bool{true},
+				isMonotonic:  []bool{true},
+			}),
+			outMetrics: generateTestSumMetrics(testSumMetric{
+				metricNames:  []string{"metric_1"},
+				metricValues: [][]float64{{5, 15, 100, 10}},
+				isCumulative: []bool{false},
+				isMonotonic:  []bool{true},
+			}),
+		},
+		{
+			name:         "cumulative_to_delta_histogram_restart_dropped",
+			counterReset: tracking.CounterResetDrop,
+			inMetrics: generateTestHistogramMetrics(testHistogramMetric{
+				metricNames:   []string{"metric_1"},
+				metricCounts:  [][]uint64{{100, 120, 20, 50}},
+				metricSums:    [][]float64{{100, 120, 20, 50}},
+				metricBuckets: [][][]uint64{{{50, 50}, {60, 60}, {10, 10}, {25, 25}}},
+				isCumulative:  []bool{true},
+			}),
+			outMetrics: generateTestHistogramMetrics(testHistogramMetric{
+				metricNames:   []string{"metric_1"},
+				metricCounts:  [][]uint64{{20, 30}},
+				metricSums:    [][]float64{{20, 30}},
+				metricBuckets: [][][]uint64{{{10, 10}, {15, 15}}},
+				isCumulative:  []bool{false},
+			}),
+		},
 	}
 
 	for _, test := range testCases {
@@ -443,8 +478,9 @@ func TestCumulativeToDeltaProcessor(t *testing.T) {
 			// next stores the results of the filter metric processor
 			next := new(consumertest.MetricsSink)
 			cfg := &Config{
-				Include: test.include,
-				Exclude: test.exclude,
+				Include:      test.include,
+				Exclude:      test.exclude,
+				CounterReset: test.counterReset,
 			}
 			factory := NewFactory()
 			mgp, err := factory.CreateMetricsProcessor(
@@ -534,6 +570,52 @@ func TestCumulativeToDeltaProcessor(t *testing.T) {
 	}
 }
 
+func TestCumulativeToDeltaProcessorTelemetry(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := createDefaultConfig().(*Config)
+	cfg.CounterReset = tracking.CounterResetKeep
+	next := new(consumertest.MetricsSink)
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), tel.NewCreateSettings(), cfg, next)
+	require.NoError(t, err)
+
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), generateTestSumMetrics(testSumMetric{
+		metricNames:  []string{"metric_1"},
+		metricValues: [][]float64{{100, 105, 20, 30}},
+		isCumulative: []bool{true},
+		isMonotonic:  []bool{true},
+	})))
+	require.NoError(t, mgp.Shutdown(context.Background()))
+
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_cumulativetodelta_counter_resets",
+			Description: "Number of points lower than the previous point of their series, by whether they were sent (keep) or dropped (drop)",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Value: 1, Attributes: attribute.NewSet(attribute.String("action", "keep"))},
+				},
+			},
+		},
+		{
+			Name:        "processor_cumulativetodelta_initial_points",
+			Description: "Number of points observed first for their series, by whether they were sent (keep) or dropped (drop)",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					// the start time of the points is not set, the first one is dropped
+					{Value: 1, Attributes: attribute.NewSet(attribute.String("action", "drop"))},
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
 func generateTestSumMetrics(tm testSumMetric) pmetric.Metrics {
 	md := pmetric.NewMetrics()
 	now := time.Now()
@@ -615,14 +697,8 @@ func generateTestHistogramMetrics(tm testHistogramMetric) pmetric.Metrics {
 
 func BenchmarkConsumeMetrics(b *testing.B) {
 	c := consumertest.NewNop()
-	params := processor.CreateSettings{
-		TelemetrySettings: component.TelemetrySettings{
-			Logger: zap.NewNop(),
-		},
-		BuildInfo: component.BuildInfo{},
-	}
 	cfg := createDefaultConfig().(*Config)
-	p, err := createMetricsProcessor(context.Background(), params, cfg, c)
+	p, err := createMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, c)
 	if err != nil {
 		b.Fatal(err)
 	}
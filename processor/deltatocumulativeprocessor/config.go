@@ -15,6 +15,12 @@ var _ component.ConfigValidator = (*Config)(nil)
 type Config struct {
 	MaxStale   time.Duration `mapstructure:"max_stale"`
 	MaxStreams int           `mapstructure:"max_streams"`
+
+	// Storage is the ID of the storage extension used to persist the accumulated streams across
+	// restarts. The streams are only kept in memory if it is not set.
+	Storage *component.ID `mapstructure:"storage"`
+	// SnapshotInterval is how often the accumulated streams are persisted to the storage.
+	SnapshotInterval time.Duration `mapstructure:"snapshot_interval"`
 }
 
 func (c *Config) Validate() error {
@@ -24,6 +30,9 @@ func (c *Config) Validate() error {
 	if c.MaxStreams < 0 {
 		return fmt.Errorf("max_streams must be a positive number (got %d)", c.MaxStreams)
 	}
+	if c.Storage != nil && c.SnapshotInterval <= 0 {
+		return fmt.Errorf("snapshot_interval must be a positive duration (got %s)", c.SnapshotInterval)
+	}
 	return nil
 }
 
@@ -34,5 +43,7 @@ func createDefaultConfig() component.Config {
 		// disable. TODO: find good default
 		// https://github.com/open-telemetry/opentelemetry-collector-contrib/issues/31603
 		MaxStreams: 0,
+
+		SnapshotInterval: time.Minute,
 	}
 }
@@ -22,6 +22,7 @@ func TestLoadConfig(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
 
+	storageID := component.MustNewIDWithName("file_storage", "deltatocumulative")
 	tests := []struct {
 		id       component.ID
 		expected component.Config
@@ -31,6 +32,8 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				MaxStale:   1 * time.Minute,
 				MaxStreams: 10,
+
+				SnapshotInterval: time.Minute,
 			},
 		},
 		{
@@ -38,6 +41,8 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				MaxStale:   2 * time.Minute,
 				MaxStreams: 0,
+
+				SnapshotInterval: time.Minute,
 			},
 		},
 		{
@@ -45,6 +50,18 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				MaxStale:   5 * time.Minute,
 				MaxStreams: 20,
+
+				SnapshotInterval: time.Minute,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "set-valid-storage"),
+			expected: &Config{
+				MaxStale:   5 * time.Minute,
+				MaxStreams: 0,
+
+				Storage:          &storageID,
+				SnapshotInterval: 30 * time.Second,
 			},
 		},
 	}
@@ -6,11 +6,13 @@ package deltatocumulativeprocessor // import "github.com/open-telemetry/opentele
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/otel/metric"
@@ -29,6 +31,8 @@ var _ processor.Metrics = (*Processor)(nil)
 
 type Processor struct {
 	next consumer.Metrics
+	cfg  Config
+	id   component.ID
 
 	log    *zap.Logger
 	ctx    context.Context
@@ -37,10 +41,12 @@ type Processor struct {
 	sums Pipeline[data.Number]
 	expo Pipeline[data.ExpHistogram]
 
+	storage storage.Client
+
 	mtx sync.Mutex
 }
 
-func newProcessor(cfg *Config, log *zap.Logger, meter metric.Meter, next consumer.Metrics) *Processor {
+func newProcessor(cfg *Config, id component.ID, log *zap.Logger, meter metric.Meter, next consumer.Metrics) *Processor {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	tel := telemetry.New(meter)
@@ -50,6 +56,8 @@ func newProcessor(cfg *Config, log *zap.Logger, meter metric.Meter, next consume
 		ctx:    ctx,
 		cancel: cancel,
 		next:   next,
+		cfg:    *cfg,
+		id:     id,
 
 		sums: pipeline[data.Number](cfg, &tel),
 		expo: pipeline[data.ExpHistogram](cfg, &tel),
@@ -91,15 +99,35 @@ func pipeline[D data.Point[D]](cfg *Config, tel *telemetry.Telemetry) Pipeline[D
 	return pipe
 }
 
-func (p *Processor) Start(_ context.Context, _ component.Host) error {
+func (p *Processor) Start(ctx context.Context, host component.Host) error {
+	client, err := getStorageClient(ctx, host, p.cfg.Storage, p.id)
+	if err != nil {
+		return fmt.Errorf("failed to get the storage client: %w", err)
+	}
+	p.storage = client
+
 	sums, sok := p.sums.stale.Try()
 	expo, eok := p.expo.stale.Try()
 	if !(sok && eok) {
 		return nil
 	}
 
+	if p.cfg.Storage != nil {
+		p.restore(ctx, sums, expo)
+	}
+
 	go func() {
 		tick := time.NewTicker(time.Minute)
+		defer tick.Stop()
+
+		// snapshots are only taken when a storage extension is configured
+		var snapshot <-chan time.Time
+		if p.cfg.Storage != nil {
+			snap := time.NewTicker(p.cfg.SnapshotInterval)
+			defer snap.Stop()
+			snapshot = snap.C
+		}
+
 		for {
 			select {
 			case <-p.ctx.Done():
@@ -109,15 +137,73 @@ func (p *Processor) Start(_ context.Context, _ component.Host) error {
 				sums.ExpireOldEntries()
 				expo.ExpireOldEntries()
 				p.mtx.Unlock()
+			case <-snapshot:
+				p.mtx.Lock()
+				err := p.snapshot(p.ctx, sums, expo)
+				p.mtx.Unlock()
+				if err != nil {
+					p.log.Warn("failed to snapshot the accumulated streams", zap.Error(err))
+				}
 			}
 		}
 	}()
 	return nil
 }
 
-func (p *Processor) Shutdown(_ context.Context) error {
+func (p *Processor) Shutdown(ctx context.Context) error {
 	p.cancel()
-	return nil
+	if p.storage == nil {
+		return nil
+	}
+
+	var errs error
+	sums, sok := p.sums.stale.Try()
+	expo, eok := p.expo.stale.Try()
+	if p.cfg.Storage != nil && sok && eok {
+		p.mtx.Lock()
+		errs = p.snapshot(ctx, sums, expo)
+		p.mtx.Unlock()
+	}
+	return errors.Join(errs, p.storage.Close(ctx))
+}
+
+// restore loads the streams of the last snapshot. The processor starts without them if the
+// snapshot can't be loaded.
+func (p *Processor) restore(ctx context.Context, sums *staleness.Staleness[data.Number], expo *staleness.Staleness[data.ExpHistogram]) {
+	buf, err := p.storage.Get(ctx, stateKey)
+	if err != nil {
+		p.log.Warn("failed to load the accumulated streams, starting without them", zap.Error(err))
+		return
+	}
+	if buf == nil {
+		return
+	}
+
+	st, err := unmarshalState(buf)
+	if err != nil {
+		p.log.Warn("failed to decode the accumulated streams, starting without them", zap.Error(err))
+		return
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	err = errors.Join(
+		restoreStreams(sums, st.sums, p.cfg.MaxStreams),
+		restoreStreams(expo, st.expo, p.cfg.MaxStreams),
+	)
+	if err != nil {
+		p.log.Warn("failed to restore some accumulated streams", zap.Error(err))
+	}
+	p.log.Debug("restored the accumulated streams", zap.Int("sums", sums.Len()), zap.Int("exponential_histograms", expo.Len()))
+}
+
+// snapshot persists the streams and their staleness. It must be called with the lock held.
+func (p *Processor) snapshot(ctx context.Context, sums *staleness.Staleness[data.Number], expo *staleness.Staleness[data.ExpHistogram]) error {
+	buf, err := marshalState(sums, expo)
+	if err != nil {
+		return err
+	}
+	return p.storage.Set(ctx, stateKey, buf)
 }
 
 func (p *Processor) Capabilities() consumer.Capabilities {
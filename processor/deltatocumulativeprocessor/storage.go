@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/staleness"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data"
+)
+
+const (
+	// stateKey is the storage key of the snapshot of the accumulated streams
+	stateKey = "state"
+	// stateVersion is the version of the snapshot encoding, snapshots of other versions are ignored
+	stateVersion = 1
+)
+
+var errInvalidState = errors.New("invalid state snapshot")
+
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindProcessor, componentID, "")
+}
+
+// stored is a stream restored from a snapshot, along with the last time it received a sample
+type stored[D data.Point[D]] struct {
+	id       identity.Stream
+	lastSeen time.Time
+	dp       D
+}
+
+// state is the decoded snapshot of the streams of both pipelines
+type state struct {
+	sums []stored[data.Number]
+	expo []stored[data.ExpHistogram]
+}
+
+// marshalState encodes the streams as:
+//
+//	version | sum streams | exponential histogram streams | datapoints
+//
+// where the streams are a count followed by the identity and last seen time of each stream, and the
+// datapoints are a protobuf encoded pmetric.Metrics holding a sum and an exponential histogram,
+// whose datapoints are in the same order as the streams.
+func marshalState(sums *staleness.Staleness[data.Number], expo *staleness.Staleness[data.ExpHistogram]) ([]byte, error) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sumDps := ms.AppendEmpty().SetEmptySum().DataPoints()
+	expoDps := ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints()
+
+	buf := []byte{stateVersion}
+	buf, err := appendStreams(buf, sums, func(dp data.Number) {
+		dp.NumberDataPoint.CopyTo(sumDps.AppendEmpty())
+	})
+	if err != nil {
+		return nil, err
+	}
+	buf, err = appendStreams(buf, expo, func(dp data.ExpHistogram) {
+		dp.DataPoint.CopyTo(expoDps.AppendEmpty())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var marshaler pmetric.ProtoMarshaler
+	dps, err := marshaler.MarshalMetrics(md)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, dps...), nil
+}
+
+func appendStreams[D data.Point[D]](buf []byte, stale *staleness.Staleness[D], appendDp func(D)) ([]byte, error) {
+	buf = binary.AppendUvarint(buf, uint64(stale.Len()))
+
+	var err error
+	stale.Items()(func(id identity.Stream, dp D) bool {
+		var ident []byte
+		if ident, err = id.MarshalBinary(); err != nil {
+			return false
+		}
+		lastSeen, _ := stale.LastSeen(id)
+		buf = binary.AppendUvarint(buf, uint64(len(ident)))
+		buf = append(buf, ident...)
+		buf = binary.AppendVarint(buf, lastSeen.UnixNano())
+		appendDp(dp)
+		return true
+	})
+	return buf, err
+}
+
+func unmarshalState(buf []byte) (state, error) {
+	if len(buf) == 0 || buf[0] != stateVersion {
+		return state{}, fmt.Errorf("%w: unsupported version", errInvalidState)
+	}
+	buf = buf[1:]
+
+	sums, buf, err := readStreams[data.Number](buf)
+	if err != nil {
+		return state{}, err
+	}
+	expo, buf, err := readStreams[data.ExpHistogram](buf)
+	if err != nil {
+		return state{}, err
+	}
+
+	var unmarshaler pmetric.ProtoUnmarshaler
+	md, err := unmarshaler.UnmarshalMetrics(buf)
+	if err != nil {
+		return state{}, fmt.Errorf("%w: %w", errInvalidState, err)
+	}
+	if md.ResourceMetrics().Len() != 1 || md.ResourceMetrics().At(0).ScopeMetrics().Len() != 1 {
+		return state{}, fmt.Errorf("%w: missing datapoints", errInvalidState)
+	}
+	ms := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	if ms.Len() != 2 || ms.At(0).Type() != pmetric.MetricTypeSum || ms.At(1).Type() != pmetric.MetricTypeExponentialHistogram {
+		return state{}, fmt.Errorf("%w: missing datapoints", errInvalidState)
+	}
+	sumDps, expoDps := ms.At(0).Sum().DataPoints(), ms.At(1).ExponentialHistogram().DataPoints()
+	if sumDps.Len() != len(sums) || expoDps.Len() != len(expo) {
+		return state{}, fmt.Errorf("%w: datapoints don't match the streams", errInvalidState)
+	}
+
+	for i := range sums {
+		sums[i].dp = data.Number{NumberDataPoint: sumDps.At(i)}
+	}
+	for i := range expo {
+		expo[i].dp = data.ExpHistogram{DataPoint: expoDps.At(i)}
+	}
+	return state{sums: sums, expo: expo}, nil
+}
+
+func readStreams[D data.Point[D]](buf []byte) ([]stored[D], []byte, error) {
+	n, size := binary.Uvarint(buf)
+	if size <= 0 || n > uint64(len(buf)) {
+		return nil, nil, fmt.Errorf("%w: invalid streams count", errInvalidState)
+	}
+	buf = buf[size:]
+
+	streams := make([]stored[D], n)
+	for i := range streams {
+		l, size := binary.Uvarint(buf)
+		if size <= 0 || uint64(len(buf)-size) < l {
+			return nil, nil, fmt.Errorf("%w: invalid stream identity", errInvalidState)
+		}
+		buf = buf[size:]
+		if err := streams[i].id.UnmarshalBinary(buf[:l]); err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", errInvalidState, err)
+		}
+		buf = buf[l:]
+
+		lastSeen, size := binary.Varint(buf)
+		if size <= 0 {
+			return nil, nil, fmt.Errorf("%w: invalid last seen time", errInvalidState)
+		}
+		buf = buf[size:]
+		streams[i].lastSeen = time.Unix(0, lastSeen)
+	}
+	return streams, buf, nil
+}
+
+// restoreStreams stores the restored streams that didn't become stale since they were last seen,
+// up to the limit of streams.
+func restoreStreams[D data.Point[D]](stale *staleness.Staleness[D], streams []stored[D], maxStreams int) error {
+	now := staleness.NowFunc()
+	var errs error
+	for _, s := range streams {
+		if now.Sub(s.lastSeen) >= stale.Max {
+			continue
+		}
+		if maxStreams > 0 && stale.Len() >= maxStreams {
+			break
+		}
+		errs = errors.Join(errs, stale.StoreAt(s.id, s.dp, s.lastSeen))
+	}
+	return errs
+}
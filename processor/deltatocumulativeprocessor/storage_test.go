@@ -0,0 +1,184 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package deltatocumulativeprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/staleness"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/streams"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/deltatocumulativeprocessor/internal/data"
+)
+
+func TestStateRoundTrip(t *testing.T) {
+	sums := staleness.NewStaleness(time.Minute, make(streams.HashMap[data.Number]))
+	expo := staleness.NewStaleness(time.Minute, make(streams.HashMap[data.ExpHistogram]))
+
+	sumMetrics := deltaMetrics("requests", 1, 2)
+	sumIDs := storeSums(t, sums, sumMetrics)
+	expoMetrics := pmetric.NewMetrics()
+	m := expoMetrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("latency")
+	dp := m.SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	dp.SetScale(2)
+	dp.SetCount(3)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2})
+	expoID := identity.OfStream(identity.OfResourceMetric(pcommon.NewResource(), pcommon.NewInstrumentationScope(), m), dp)
+	require.NoError(t, expo.StoreAt(expoID, data.ExpHistogram{DataPoint: dp}, time.Unix(100, 0)))
+
+	buf, err := marshalState(sums, expo)
+	require.NoError(t, err)
+	st, err := unmarshalState(buf)
+	require.NoError(t, err)
+
+	require.Len(t, st.sums, 2)
+	for _, s := range st.sums {
+		want, ok := sums.Load(s.id)
+		require.True(t, ok)
+		assert.Equal(t, want.IntValue(), s.dp.IntValue())
+		lastSeen, _ := sums.LastSeen(s.id)
+		assert.True(t, lastSeen.Equal(s.lastSeen))
+	}
+	assert.ElementsMatch(t, sumIDs, []identity.Stream{st.sums[0].id, st.sums[1].id})
+
+	require.Len(t, st.expo, 1)
+	assert.Equal(t, expoID, st.expo[0].id)
+	assert.Equal(t, time.Unix(100, 0), st.expo[0].lastSeen)
+	assert.Equal(t, []uint64{1, 2}, st.expo[0].dp.Positive().BucketCounts().AsRaw())
+
+	// truncated and unknown snapshots are rejected
+	for _, invalid := range [][]byte{nil, {2}, buf[:len(buf)/2]} {
+		_, err = unmarshalState(invalid)
+		assert.ErrorIs(t, err, errInvalidState)
+	}
+}
+
+func TestRestoreStreams(t *testing.T) {
+	now := time.Unix(1000, 0)
+	staleness.NowFunc = func() time.Time { return now }
+	t.Cleanup(func() { staleness.NowFunc = time.Now })
+
+	src := staleness.NewStaleness(time.Minute, make(streams.HashMap[data.Number]))
+	ids := storeSums(t, src, deltaMetrics("requests", 1, 2, 3))
+	buf, err := marshalState(src, staleness.NewStaleness(time.Minute, make(streams.HashMap[data.ExpHistogram])))
+	require.NoError(t, err)
+	st, err := unmarshalState(buf)
+	require.NoError(t, err)
+
+	// the streams are restored with their staleness
+	now = now.Add(30 * time.Second)
+	dst := staleness.NewStaleness(time.Minute, make(streams.HashMap[data.Number]))
+	require.NoError(t, restoreStreams(dst, st.sums, 0))
+	require.Equal(t, 3, dst.Len())
+	for _, id := range ids {
+		lastSeen, ok := dst.LastSeen(id)
+		require.True(t, ok)
+		assert.Equal(t, time.Unix(1000, 0), lastSeen)
+	}
+
+	// up to the limit of streams
+	dst = staleness.NewStaleness(time.Minute, make(streams.HashMap[data.Number]))
+	require.NoError(t, restoreStreams(dst, st.sums, 2))
+	assert.Equal(t, 2, dst.Len())
+
+	// stale streams are not restored
+	now = now.Add(time.Minute)
+	dst = staleness.NewStaleness(time.Minute, make(streams.HashMap[data.Number]))
+	require.NoError(t, restoreStreams(dst, st.sums, 0))
+	assert.Zero(t, dst.Len())
+}
+
+func TestPersistence(t *testing.T) {
+	storageID := storagetest.NewStorageID("deltatocumulative")
+	ext := storagetest.NewFileBackedStorageExtension("deltatocumulative", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	// the first processor accumulates the samples and persists them on shutdown
+	sink := new(consumertest.MetricsSink)
+	proc := newProcessor(cfg, component.MustNewID("deltatocumulative"), zap.NewNop(), noop.NewMeterProvider().Meter("test"), sink)
+	require.NoError(t, proc.Start(context.Background(), host))
+	require.NoError(t, proc.ConsumeMetrics(context.Background(), deltaSample(10, 1)))
+	require.NoError(t, proc.ConsumeMetrics(context.Background(), deltaSample(20, 2)))
+	require.NoError(t, proc.Shutdown(context.Background()))
+	assert.Equal(t, int64(3), lastValue(sink))
+
+	// the restarted processor carries on from the restored value
+	sink = new(consumertest.MetricsSink)
+	proc = newProcessor(cfg, component.MustNewID("deltatocumulative"), zap.NewNop(), noop.NewMeterProvider().Meter("test"), sink)
+	require.NoError(t, proc.Start(context.Background(), host))
+	require.NoError(t, proc.ConsumeMetrics(context.Background(), deltaSample(30, 4)))
+	require.NoError(t, proc.Shutdown(context.Background()))
+	assert.Equal(t, int64(7), lastValue(sink))
+}
+
+func TestPersistenceMissingExtension(t *testing.T) {
+	storageID := storagetest.NewStorageID("missing")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Storage = &storageID
+
+	proc := newProcessor(cfg, component.MustNewID("deltatocumulative"), zap.NewNop(), noop.NewMeterProvider().Meter("test"), consumertest.NewNop())
+	assert.ErrorContains(t, proc.Start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage/missing' not found")
+	assert.NoError(t, proc.Shutdown(context.Background()))
+}
+
+// deltaMetrics returns a delta sum with a datapoint of each value, each in its own stream.
+func deltaMetrics(name string, values ...int64) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	m := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName(name)
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	for i, v := range values {
+		dp := sum.DataPoints().AppendEmpty()
+		dp.Attributes().PutInt("stream", int64(i))
+		dp.SetIntValue(v)
+	}
+	return md
+}
+
+// deltaSample returns a delta sum with a single datapoint in the stream of deltaMetrics, from ts-10s to ts.
+func deltaSample(ts int64, value int64) pmetric.Metrics {
+	md := deltaMetrics("requests", value)
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(time.Unix(ts-10, 0)))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(ts, 0)))
+	return md
+}
+
+func storeSums(t *testing.T, stale *staleness.Staleness[data.Number], md pmetric.Metrics) []identity.Stream {
+	rm := md.ResourceMetrics().At(0)
+	sm := rm.ScopeMetrics().At(0)
+	m := sm.Metrics().At(0)
+	var ids []identity.Stream
+	for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+		dp := m.Sum().DataPoints().At(i)
+		id := identity.OfStream(identity.OfResourceMetric(rm.Resource(), sm.Scope(), m), dp)
+		require.NoError(t, stale.Store(id, data.Number{NumberDataPoint: dp}))
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func lastValue(sink *consumertest.MetricsSink) int64 {
+	all := sink.AllMetrics()
+	sum := all[len(all)-1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum()
+	return sum.DataPoints().At(0).IntValue()
+}
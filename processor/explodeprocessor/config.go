@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explodeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/explodeprocessor"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines configuration for the explode processor.
+type Config struct {
+	// Field is the array to expand, the body or a key of the body or of the attributes, e.g.
+	// body.records or attributes["aws.records"]. The log records without an array there are left untouched.
+	Field string `mapstructure:"field"`
+
+	// Target is where each element of the array is set in its log record. The element replaces
+	// the array if empty, otherwise the array is removed from the log record.
+	Target string `mapstructure:"target"`
+
+	// IndexAttribute is set to the index of the element in the array on each log record.
+	// Nothing is set if empty.
+	IndexAttribute string `mapstructure:"index_attribute"`
+
+	// MaxDepth is the number of levels of nested arrays flattened, 1 expands only the elements of the array.
+	MaxDepth int `mapstructure:"max_depth"`
+
+	// MaxRecords is the maximum number of log records a log record is expanded into,
+	// the elements beyond it are dropped.
+	MaxRecords int `mapstructure:"max_records"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Field == "" {
+		return errors.New("field must be set")
+	}
+	if _, err := parseField(cfg.Field); err != nil {
+		return fmt.Errorf("invalid field %q: %w", cfg.Field, err)
+	}
+	if cfg.Target != "" {
+		if _, err := parseField(cfg.Target); err != nil {
+			return fmt.Errorf("invalid target %q: %w", cfg.Target, err)
+		}
+	}
+	if cfg.MaxDepth < 1 {
+		return errors.New("max_depth must be at least 1")
+	}
+	if cfg.MaxRecords < 1 {
+		return errors.New("max_records must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explodeprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/explodeprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    func(*Config)
+		expectedErr string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, ""),
+			expected: func(cfg *Config) {
+				cfg.Field = "body"
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "records"),
+			expected: func(cfg *Config) {
+				cfg.Field = `attributes["aws.records"]`
+				cfg.Target = "body"
+				cfg.IndexAttribute = "explode.index"
+				cfg.MaxDepth = 2
+				cfg.MaxRecords = 100
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_field"),
+			expectedErr: "field must be set",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_field"),
+			expectedErr: `invalid field "resource.records": must start with body or attributes`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_target"),
+			expectedErr: `invalid target "attributes": a key of the attributes is required`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_max_depth"),
+			expectedErr: "max_depth must be at least 1",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_max_records"),
+			expectedErr: "max_records must be at least 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+
+			expected := createDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package explodeprocessor implements a processor expanding the log records
+// holding an array into one log record per element of the array.
+package explodeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/explodeprocessor"
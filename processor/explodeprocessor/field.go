@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explodeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/explodeprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+const (
+	bodyField       = "body"
+	attributesField = "attributes"
+)
+
+// field is a value of a log record: the body, or a key of the body or of the attributes,
+// followed by the keys of the nested maps.
+type field struct {
+	attributes bool
+	keys       []string
+}
+
+// parseField parses a field written as the root, body or attributes, followed by keys
+// separated by dots. The keys holding dots are written in brackets, e.g. attributes["aws.records"].
+func parseField(s string) (field, error) {
+	var f field
+	switch {
+	case strings.HasPrefix(s, bodyField):
+		s = s[len(bodyField):]
+	case strings.HasPrefix(s, attributesField):
+		f.attributes = true
+		s = s[len(attributesField):]
+	default:
+		return f, errors.New("must start with body or attributes")
+	}
+
+	for s != "" {
+		var key string
+		switch {
+		case strings.HasPrefix(s, `["`):
+			end := strings.Index(s, `"]`)
+			if end < 0 {
+				return f, errors.New("missing closing bracket")
+			}
+			key, s = s[2:end], s[end+2:]
+		case s[0] == '.':
+			s = s[1:]
+			end := strings.IndexAny(s, ".[")
+			if end < 0 {
+				end = len(s)
+			}
+			key, s = s[:end], s[end:]
+		default:
+			return f, fmt.Errorf("unexpected %q", s)
+		}
+		if key == "" {
+			return f, errors.New("empty key")
+		}
+		f.keys = append(f.keys, key)
+	}
+
+	if f.attributes && len(f.keys) == 0 {
+		return f, errors.New("a key of the attributes is required")
+	}
+	return f, nil
+}
+
+// get returns the value of the field in the record, and false if it doesn't exist.
+func (f field) get(record plog.LogRecord) (pcommon.Value, bool) {
+	var value pcommon.Value
+	keys := f.keys
+	if f.attributes {
+		var ok bool
+		if value, ok = record.Attributes().Get(keys[0]); !ok {
+			return value, false
+		}
+		keys = keys[1:]
+	} else {
+		value = record.Body()
+	}
+	for _, key := range keys {
+		if value.Type() != pcommon.ValueTypeMap {
+			return value, false
+		}
+		var ok bool
+		if value, ok = value.Map().Get(key); !ok {
+			return value, false
+		}
+	}
+	return value, true
+}
+
+// set returns the value of the field in the record to overwrite, creating the field
+// and replacing the values that aren't maps along its path.
+func (f field) set(record plog.LogRecord) pcommon.Value {
+	if !f.attributes && len(f.keys) == 0 {
+		return record.Body()
+	}
+	var m pcommon.Map
+	if f.attributes {
+		m = record.Attributes()
+	} else {
+		if record.Body().Type() != pcommon.ValueTypeMap {
+			record.Body().SetEmptyMap()
+		}
+		m = record.Body().Map()
+	}
+	for _, key := range f.keys[:len(f.keys)-1] {
+		value, ok := m.Get(key)
+		if !ok {
+			m = m.PutEmptyMap(key)
+			continue
+		}
+		if value.Type() != pcommon.ValueTypeMap {
+			value.SetEmptyMap()
+		}
+		m = value.Map()
+	}
+	last := f.keys[len(f.keys)-1]
+	if value, ok := m.Get(last); ok {
+		return value
+	}
+	return m.PutEmpty(last)
+}
+
+// remove removes the field from the record. The body is emptied if the field is the body.
+func (f field) remove(record plog.LogRecord) {
+	if !f.attributes && len(f.keys) == 0 {
+		pcommon.NewValueEmpty().CopyTo(record.Body())
+		return
+	}
+	parent := field{attributes: f.attributes, keys: f.keys[:len(f.keys)-1]}
+	last := f.keys[len(f.keys)-1]
+	if parent.attributes && len(parent.keys) == 0 {
+		record.Attributes().Remove(last)
+		return
+	}
+	if value, ok := parent.get(record); ok && value.Type() == pcommon.ValueTypeMap {
+		value.Map().Remove(last)
+	}
+}
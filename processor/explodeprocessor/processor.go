@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explodeprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/explodeprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+type explodeProcessor struct {
+	cfg    *Config
+	logger *zap.Logger
+	field  field
+	target *field
+}
+
+func newExplodeProcessor(cfg *Config, logger *zap.Logger) (*explodeProcessor, error) {
+	f, err := parseField(cfg.Field)
+	if err != nil {
+		return nil, err
+	}
+	p := &explodeProcessor{
+		cfg:    cfg,
+		logger: logger,
+		field:  f,
+	}
+	if cfg.Target != "" {
+		target, err := parseField(cfg.Target)
+		if err != nil {
+			return nil, err
+		}
+		p.target = &target
+	}
+	return p, nil
+}
+
+func (p *explodeProcessor) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	dropped := 0
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			dropped += p.explodeRecords(rl.ScopeLogs().At(j).LogRecords())
+		}
+	}
+	if dropped > 0 {
+		p.logger.Warn("Dropped the elements of arrays beyond max_records",
+			zap.Int("max_records", p.cfg.MaxRecords),
+			zap.Int("dropped", dropped))
+	}
+	return ld, nil
+}
+
+// explodeRecords replaces the log records holding an array with one log record per element,
+// and returns the number of elements dropped because of the max_records limit.
+func (p *explodeProcessor) explodeRecords(records plog.LogRecordSlice) int {
+	exploded := plog.NewLogRecordSlice()
+	exploded.EnsureCapacity(records.Len())
+	dropped := 0
+	for i := 0; i < records.Len(); i++ {
+		record := records.At(i)
+		value, ok := p.field.get(record)
+		if !ok || value.Type() != pcommon.ValueTypeSlice {
+			record.MoveTo(exploded.AppendEmpty())
+			continue
+		}
+
+		// The elements are moved out of the record first, so that they aren't copied with it.
+		elements := pcommon.NewSlice()
+		value.Slice().MoveAndAppendTo(elements)
+		flattened, n := p.flatten(elements, 1, nil)
+		dropped += n
+		if len(flattened) == 0 {
+			elements.MoveAndAppendTo(value.Slice())
+			record.MoveTo(exploded.AppendEmpty())
+			continue
+		}
+
+		if p.target != nil {
+			p.field.remove(record)
+		}
+		for index, element := range flattened {
+			dest := exploded.AppendEmpty()
+			record.CopyTo(dest)
+			if p.target != nil {
+				element.CopyTo(p.target.set(dest))
+			} else {
+				destValue, _ := p.field.get(dest)
+				element.CopyTo(destValue)
+			}
+			if p.cfg.IndexAttribute != "" {
+				dest.Attributes().PutInt(p.cfg.IndexAttribute, int64(index))
+			}
+		}
+	}
+	records.RemoveIf(func(plog.LogRecord) bool { return true })
+	exploded.MoveAndAppendTo(records)
+	return dropped
+}
+
+// flatten appends the elements of the array to dest, and the elements of the nested arrays up to
+// max_depth. It returns the number of elements dropped because of the max_records limit.
+func (p *explodeProcessor) flatten(elements pcommon.Slice, depth int, dest []pcommon.Value) ([]pcommon.Value, int) {
+	dropped := 0
+	for i := 0; i < elements.Len(); i++ {
+		element := elements.At(i)
+		if element.Type() == pcommon.ValueTypeSlice && depth < p.cfg.MaxDepth {
+			var n int
+			dest, n = p.flatten(element.Slice(), depth+1, dest)
+			dropped += n
+			continue
+		}
+		if len(dest) >= p.cfg.MaxRecords {
+			dropped++
+			continue
+		}
+		dest = append(dest, element)
+	}
+	return dest, dropped
+}
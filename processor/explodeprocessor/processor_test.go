@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package explodeprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+)
+
+func TestParseField(t *testing.T) {
+	tests := []struct {
+		field       string
+		expected    field
+		expectedErr string
+	}{
+		{field: "body", expected: field{}},
+		{field: "body.records", expected: field{keys: []string{"records"}}},
+		{field: `body.detail["aws.records"].items`, expected: field{keys: []string{"detail", "aws.records", "items"}}},
+		{field: `attributes["aws.records"]`, expected: field{attributes: true, keys: []string{"aws.records"}}},
+		{field: "attributes.records", expected: field{attributes: true, keys: []string{"records"}}},
+		{field: "attributes", expectedErr: "a key of the attributes is required"},
+		{field: "resource.records", expectedErr: "must start with body or attributes"},
+		{field: "body.", expectedErr: "empty key"},
+		{field: `body["records`, expectedErr: "missing closing bracket"},
+		{field: "bodyrecords", expectedErr: `unexpected "records"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			f, err := parseField(tt.field)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, f)
+		})
+	}
+}
+
+func TestProcessLogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      func(*Config)
+		body     map[string]any
+		expected []map[string]any
+	}{
+		{
+			name: "in place",
+			cfg:  func(*Config) {},
+			body: map[string]any{"source": "audit", "records": []any{"a", map[string]any{"b": int64(1)}}},
+			expected: []map[string]any{
+				{"body": map[string]any{"source": "audit", "records": "a"}, "attributes": map[string]any{"host": "h"}},
+				{"body": map[string]any{"source": "audit", "records": map[string]any{"b": int64(1)}}, "attributes": map[string]any{"host": "h"}},
+			},
+		},
+		{
+			name: "target",
+			cfg: func(cfg *Config) {
+				cfg.Target = "body"
+				cfg.IndexAttribute = "explode.index"
+			},
+			body: map[string]any{"source": "audit", "records": []any{map[string]any{"user": "x"}, map[string]any{"user": "y"}}},
+			expected: []map[string]any{
+				{"body": map[string]any{"user": "x"}, "attributes": map[string]any{"host": "h", "explode.index": int64(0)}},
+				{"body": map[string]any{"user": "y"}, "attributes": map[string]any{"host": "h", "explode.index": int64(1)}},
+			},
+		},
+		{
+			name: "nested target",
+			cfg: func(cfg *Config) {
+				cfg.Target = `attributes["audit.record"]`
+			},
+			body: map[string]any{"source": "audit", "records": []any{"a", "b"}},
+			expected: []map[string]any{
+				{"body": map[string]any{"source": "audit"}, "attributes": map[string]any{"host": "h", "audit.record": "a"}},
+				{"body": map[string]any{"source": "audit"}, "attributes": map[string]any{"host": "h", "audit.record": "b"}},
+			},
+		},
+		{
+			name: "max depth",
+			cfg: func(cfg *Config) {
+				cfg.MaxDepth = 2
+			},
+			body: map[string]any{"records": []any{[]any{"a", []any{"b"}}, "c"}},
+			expected: []map[string]any{
+				{"body": map[string]any{"records": "a"}, "attributes": map[string]any{"host": "h"}},
+				{"body": map[string]any{"records": []any{"b"}}, "attributes": map[string]any{"host": "h"}},
+				{"body": map[string]any{"records": "c"}, "attributes": map[string]any{"host": "h"}},
+			},
+		},
+		{
+			name: "max records",
+			cfg: func(cfg *Config) {
+				cfg.MaxRecords = 2
+			},
+			body: map[string]any{"records": []any{"a", "b", "c"}},
+			expected: []map[string]any{
+				{"body": map[string]any{"records": "a"}, "attributes": map[string]any{"host": "h"}},
+				{"body": map[string]any{"records": "b"}, "attributes": map[string]any{"host": "h"}},
+			},
+		},
+		{
+			name: "empty array",
+			cfg:  func(*Config) {},
+			body: map[string]any{"records": []any{}},
+			expected: []map[string]any{
+				{"body": map[string]any{"records": []any{}}, "attributes": map[string]any{"host": "h"}},
+			},
+		},
+		{
+			name: "not an array",
+			cfg:  func(*Config) {},
+			body: map[string]any{"records": "a"},
+			expected: []map[string]any{
+				{"body": map[string]any{"records": "a"}, "attributes": map[string]any{"host": "h"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Field = "body.records"
+			tt.cfg(cfg)
+			require.NoError(t, cfg.Validate())
+			p, err := newExplodeProcessor(cfg, zap.NewNop())
+			require.NoError(t, err)
+
+			ld := plog.NewLogs()
+			records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+			record := records.AppendEmpty()
+			record.SetTimestamp(pcommon.Timestamp(10))
+			record.SetSeverityText("INFO")
+			record.Attributes().PutStr("host", "h")
+			require.NoError(t, record.Body().SetEmptyMap().FromRaw(tt.body))
+
+			ld, err = p.processLogs(context.Background(), ld)
+			require.NoError(t, err)
+			records = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+			require.Equal(t, len(tt.expected), records.Len())
+			for i, expected := range tt.expected {
+				record := records.At(i)
+				assert.Equal(t, expected["body"], record.Body().AsRaw())
+				assert.Equal(t, expected["attributes"], record.Attributes().AsRaw())
+				assert.Equal(t, pcommon.Timestamp(10), record.Timestamp())
+				assert.Equal(t, "INFO", record.SeverityText())
+			}
+		})
+	}
+}
+
+func TestProcessLogsKeepsOrder(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Field = "body"
+	p, err := newExplodeProcessor(cfg, zap.NewNop())
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	records.AppendEmpty().Body().SetStr("first")
+	require.NoError(t, records.AppendEmpty().Body().SetEmptySlice().FromRaw([]any{"a", "b"}))
+	records.AppendEmpty().Body().SetStr("last")
+
+	ld, err = p.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	records = ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	var bodies []any
+	for i := 0; i < records.Len(); i++ {
+		bodies = append(bodies, records.At(i).Body().AsRaw())
+	}
+	assert.Equal(t, []any{"first", "a", "b", "last"}, bodies)
+}
@@ -303,6 +303,14 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	if cfg.Metrics.Include != nil && cfg.Metrics.Include.DataPointValue != nil {
+		errors = multierr.Append(errors, cfg.Metrics.Include.DataPointValue.Validate())
+	}
+
+	if cfg.Metrics.Exclude != nil && cfg.Metrics.Exclude.DataPointValue != nil {
+		errors = multierr.Append(errors, cfg.Metrics.Exclude.DataPointValue.Validate())
+	}
+
 	if cfg.Logs.LogConditions != nil && cfg.Logs.Include != nil {
 		errors = multierr.Append(errors, cfg.Logs.Include.validate())
 	}
@@ -77,6 +77,21 @@ func TestLoadingConfigStrict(t *testing.T) {
 					},
 				},
 			},
+		}, {
+			id: component.MustNewIDWithName("filter", "scopeandvalue"),
+			expected: &Config{
+				ErrorMode: ottl.PropagateError,
+				Metrics: MetricFilters{
+					Exclude: &filterconfig.MetricMatchProperties{
+						MatchType: filterconfig.MetricStrict,
+						Libraries: []filterconfig.InstrumentationLibrary{{Name: "noisy", Version: ptr("1.0.0")}},
+						DataPointValue: &filterconfig.DataPointValueMatchProperties{
+							Min: ptr(0.0),
+							Max: ptr(0.0),
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -96,6 +111,21 @@ func TestLoadingConfigStrict(t *testing.T) {
 }
 
 // TestLoadingConfigStrictLogs tests loading testdata/config_logs_strict.yaml
+func TestMetricDataPointValueValidate(t *testing.T) {
+	cfg := &Config{
+		Metrics: MetricFilters{
+			Exclude: &filterconfig.MetricMatchProperties{
+				MatchType:      filterconfig.MetricStrict,
+				DataPointValue: &filterconfig.DataPointValueMatchProperties{},
+			},
+		},
+	}
+	assert.EqualError(t, cfg.Validate(), `at least one of "min" or "max" must be specified for "datapoint_value"`)
+
+	cfg.Metrics.Exclude.DataPointValue = &filterconfig.DataPointValueMatchProperties{Min: ptr(1.0), Max: ptr(0.0)}
+	assert.EqualError(t, cfg.Validate(), `"datapoint_value" min (1) must not be greater than max (0)`)
+}
+
 func TestLoadingConfigStrictLogs(t *testing.T) {
 
 	testDataLogPropertiesInclude := &LogMatchProperties{
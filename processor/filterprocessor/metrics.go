@@ -73,6 +73,11 @@ func newFilterMetricProcessor(set processor.CreateSettings, cfg *Config) (*filte
 		return nil, err
 	}
 
+	fsp.skipDataPointExpr, err = filtermetric.NewSkipDataPointExpr(cfg.Metrics.Include, cfg.Metrics.Exclude)
+	if err != nil {
+		return nil, err
+	}
+
 	includeMatchType := ""
 	var includeExpressions []string
 	var includeMetricNames []string
@@ -815,6 +815,94 @@ func TestFilterMetricProcessorWithOTTL(t *testing.T) {
 	}
 }
 
+func TestFilterMetricProcessorWithScopeAndDataPointValue(t *testing.T) {
+	tests := []struct {
+		name             string
+		filters          MetricFilters
+		filterEverything bool
+		want             func(md pmetric.Metrics)
+	}{
+		{
+			name: "drop metrics by scope",
+			filters: MetricFilters{
+				Exclude: &filterconfig.MetricMatchProperties{
+					MatchType: filterconfig.MetricStrict,
+					Libraries: []filterconfig.InstrumentationLibrary{{Name: "scope"}},
+				},
+			},
+			filterEverything: true,
+		},
+		{
+			name: "keep metrics with another scope version",
+			filters: MetricFilters{
+				Exclude: &filterconfig.MetricMatchProperties{
+					MatchType: filterconfig.MetricRegexp,
+					Libraries: []filterconfig.InstrumentationLibrary{{Name: "sco.*", Version: ptr("1.0")}},
+				},
+			},
+			want: func(pmetric.Metrics) {},
+		},
+		{
+			name: "drop data points with low values",
+			filters: MetricFilters{
+				Exclude: &filterconfig.MetricMatchProperties{
+					MatchType:      filterconfig.MetricStrict,
+					DataPointValue: &filterconfig.DataPointValueMatchProperties{Max: ptr(1.0)},
+				},
+			},
+			want: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				metrics.At(0).Sum().DataPoints().RemoveIf(func(point pmetric.NumberDataPoint) bool {
+					return point.DoubleValue() == 1.0
+				})
+				metrics.At(4).Gauge().DataPoints().RemoveIf(func(point pmetric.NumberDataPoint) bool {
+					return point.DoubleValue() == 1.0
+				})
+			},
+		},
+		{
+			name: "keep data points of a metric above a threshold",
+			filters: MetricFilters{
+				Include: &filterconfig.MetricMatchProperties{
+					MatchType:      filterconfig.MetricStrict,
+					MetricNames:    []string{"operationE"},
+					DataPointValue: &filterconfig.DataPointValueMatchProperties{Min: ptr(1.5)},
+				},
+			},
+			want: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				metrics.RemoveIf(func(metric pmetric.Metric) bool {
+					return metric.Name() != "operationE"
+				})
+				metrics.At(0).Gauge().DataPoints().RemoveIf(func(point pmetric.NumberDataPoint) bool {
+					return point.DoubleValue() < 1.5
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor, err := newFilterMetricProcessor(processortest.NewNopCreateSettings(), &Config{Metrics: tt.filters})
+			require.NoError(t, err)
+
+			got, err := processor.processMetrics(context.Background(), constructMetrics())
+
+			if tt.filterEverything {
+				assert.Equal(t, processorhelper.ErrSkipProcessingData, err)
+			} else {
+				assert.NoError(t, err)
+				exTd := constructMetrics()
+				tt.want(exTd)
+				assert.Equal(t, exTd, got)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func constructMetrics() pmetric.Metrics {
 	td := pmetric.NewMetrics()
 	rm0 := td.ResourceMetrics().AppendEmpty()
@@ -3,9 +3,52 @@
 
 package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor"
 
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+)
+
+// ContextID is the context of the attributes holding the IP address, which are enriched with the
+// geographical location.
+type ContextID string
+
+const (
+	// resourceContext sets the location attributes in the resource attributes
+	resourceContext ContextID = "resource"
+	// recordContext sets the location attributes in the attributes of the spans, log records and metric datapoints
+	recordContext ContextID = "record"
+)
+
 // Config holds the configuration for the GeoIP processor.
-type Config struct{}
+type Config struct {
+	// Providers configures the databases providing the location of the IP addresses, e.g. `maxmind` and
+	// `maxmind/asn`. The attributes of every provider are added to the telemetry.
+	Providers map[component.ID]maxmindprovider.Config `mapstructure:"providers"`
+
+	// Context is the context of the attributes holding the IP address: `resource` (default) or `record`.
+	Context ContextID `mapstructure:"context"`
+
+	// Attributes are the attributes holding the IP address, the first one holding a valid IP address is used.
+	// Defaults to `source.address` and `client.address`.
+	Attributes []attribute.Key `mapstructure:"attributes"`
+}
 
 func (cfg *Config) Validate() error {
+	for id := range cfg.Providers {
+		if id.Type().String() != maxmindprovider.TypeStr {
+			return fmt.Errorf("providers: unsupported provider %q", id.Type())
+		}
+	}
+	if cfg.Context != resourceContext && cfg.Context != recordContext {
+		return fmt.Errorf("unknown context %q, available values: %q, %q", cfg.Context, resourceContext, recordContext)
+	}
+	if len(cfg.Attributes) == 0 {
+		return errors.New("the attributes holding the IP address must not be empty")
+	}
 	return nil
 }
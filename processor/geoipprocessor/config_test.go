@@ -11,8 +11,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -24,8 +26,50 @@ func TestLoadConfig(t *testing.T) {
 		errorMessage string
 	}{
 		{
-			id:       component.NewID(metadata.Type),
-			expected: &Config{},
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Context:    resourceContext,
+				Attributes: defaultAttributes,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "maxmind"),
+			expected: &Config{
+				Providers: map[component.ID]maxmindprovider.Config{
+					component.MustNewID("maxmind"): {
+						DatabasePath: "/tmp/GeoLite2-City.mmdb",
+					},
+					component.MustNewIDWithName("maxmind", "asn"): {
+						DatabasePath: "/tmp/GeoLite2-ASN.mmdb",
+					},
+					component.MustNewIDWithName("maxmind", "custom"): {
+						DatabasePath: "/tmp/custom.mmdb",
+						Fields:       map[string]string{"network.connection.type": "connection_type"},
+					},
+				},
+				Context:    recordContext,
+				Attributes: []attribute.Key{"client.address"},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_provider"),
+			errorMessage: `providers: unsupported provider "ipinfo"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "missing_database_path"),
+			errorMessage: "a database_path must be provided",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_field"),
+			errorMessage: `fields: "region" has an invalid path "subdivisions..iso_code"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_context"),
+			errorMessage: `unknown context "span", available values: "resource", "record"`,
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "empty_attributes"),
+			errorMessage: "the attributes holding the IP address must not be empty",
 		},
 	}
 
@@ -5,6 +5,9 @@ package geoipprocessor // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -14,14 +17,17 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
 var (
 	processorCapabilities = consumer.Capabilities{MutatesData: true}
-	// defaultResourceAttributes holds a list of default resource attribute keys.
-	// These keys are used to identify an IP address attribute associated with the resource.
-	defaultResourceAttributes = []attribute.Key{
+	// defaultAttributes holds a list of default attribute keys.
+	// These keys are used to identify an IP address attribute associated with the resource or the record.
+	defaultAttributes = []attribute.Key{
 		semconv.SourceAddressKey, // This key represents the standard source address attribute as defined in the OpenTelemetry semantic conventions.
+		semconv.ClientAddressKey, // This key represents the standard client address attribute, e.g. set on the server spans.
 	}
 )
 
@@ -33,17 +39,65 @@ func NewFactory() processor.Factory {
 
 // createDefaultConfig returns a default configuration for the processor.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		Context:    resourceContext,
+		Attributes: defaultAttributes,
+	}
+}
+
+// createProviders opens the configured providers, sorted by their ID so that their attributes are
+// added in a deterministic order.
+func createProviders(cfg *Config) ([]provider.GeoIPProvider, error) {
+	ids := make([]component.ID, 0, len(cfg.Providers))
+	for id := range cfg.Providers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	providers := make([]provider.GeoIPProvider, 0, len(ids))
+	for _, id := range ids {
+		providerCfg := cfg.Providers[id]
+		p, err := maxmindprovider.NewProvider(&providerCfg)
+		if err != nil {
+			for _, opened := range providers {
+				err = errors.Join(err, opened.Close(context.Background()))
+			}
+			return nil, fmt.Errorf("failed to create the provider %v: %w", id, err)
+		}
+		providers = append(providers, p)
+	}
+	return providers, nil
+}
+
+func createGeoIPProcessor(cfg component.Config) (*geoIPProcessor, error) {
+	geoCfg := cfg.(*Config)
+	providers, err := createProviders(geoCfg)
+	if err != nil {
+		return nil, err
+	}
+	return newGeoIPProcessor(geoCfg, providers), nil
 }
 
 func createMetricsProcessor(ctx context.Context, set processor.CreateSettings, cfg component.Config, nextConsumer consumer.Metrics) (processor.Metrics, error) {
-	return processorhelper.NewMetricsProcessor(ctx, set, cfg, nextConsumer, newGeoIPProcessor(defaultResourceAttributes).processMetrics, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor, err := createGeoIPProcessor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewMetricsProcessor(ctx, set, cfg, nextConsumer, geoProcessor.processMetrics, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
 
 func createTracesProcessor(ctx context.Context, set processor.CreateSettings, cfg component.Config, nextConsumer consumer.Traces) (processor.Traces, error) {
-	return processorhelper.NewTracesProcessor(ctx, set, cfg, nextConsumer, newGeoIPProcessor(defaultResourceAttributes).processTraces, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor, err := createGeoIPProcessor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewTracesProcessor(ctx, set, cfg, nextConsumer, geoProcessor.processTraces, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
 
 func createLogsProcessor(ctx context.Context, set processor.CreateSettings, cfg component.Config, nextConsumer consumer.Logs) (processor.Logs, error) {
-	return processorhelper.NewLogsProcessor(ctx, set, cfg, nextConsumer, newGeoIPProcessor(defaultResourceAttributes).processLogs, processorhelper.WithCapabilities(processorCapabilities))
+	geoProcessor, err := createGeoIPProcessor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return processorhelper.NewLogsProcessor(ctx, set, cfg, nextConsumer, geoProcessor.processLogs, processorhelper.WithCapabilities(processorCapabilities), processorhelper.WithShutdown(geoProcessor.shutdown))
 }
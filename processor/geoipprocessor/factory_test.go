@@ -5,12 +5,16 @@ package geoipprocessor
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
 )
 
 func TestCreateDefaultConfig(t *testing.T) {
@@ -50,3 +54,22 @@ func TestCreateProcessor(t *testing.T) {
 	assert.NotNil(t, lp)
 	assert.NoError(t, err)
 }
+
+func TestCreateProcessorMissingDatabase(t *testing.T) {
+	factory := NewFactory()
+
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Providers = map[component.ID]maxmindprovider.Config{
+		component.MustNewID("maxmind"): {DatabasePath: filepath.Join(t.TempDir(), "missing.mmdb")},
+	}
+	params := processortest.NewNopCreateSettings()
+
+	_, err := factory.CreateTracesProcessor(context.Background(), params, cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, "failed to create the provider maxmind")
+
+	_, err = factory.CreateMetricsProcessor(context.Background(), params, cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, "failed to create the provider maxmind")
+
+	_, err = factory.CreateLogsProcessor(context.Background(), params, cfg, consumertest.NewNop())
+	assert.ErrorContains(t, err, "failed to create the provider maxmind")
+}
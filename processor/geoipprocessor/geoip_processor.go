@@ -17,25 +17,28 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
 )
 
-var errIPNotFound = errors.New("no IP address found in the resource attributes")
+var errIPNotFound = errors.New("no IP address found in the attributes")
 
-// newGeoIPProcessor creates a new instance of geoIPProcessor with the specified fields.
+// geoIPProcessor adds the geographical location of the IP address found in the configured attributes.
 type geoIPProcessor struct {
-	providers          []provider.GeoIPProvider
-	resourceAttributes []attribute.Key
+	providers  []provider.GeoIPProvider
+	context    ContextID
+	attributes []attribute.Key
 }
 
-func newGeoIPProcessor(resourceAttributes []attribute.Key) *geoIPProcessor {
+func newGeoIPProcessor(cfg *Config, providers []provider.GeoIPProvider) *geoIPProcessor {
 	return &geoIPProcessor{
-		resourceAttributes: resourceAttributes,
+		providers:  providers,
+		context:    cfg.Context,
+		attributes: cfg.Attributes,
 	}
 }
 
-// ipFromResourceAttributes extracts an IP address from the given resource's attributes based on the specified fields.
+// ipFromAttributes extracts an IP address from the given attributes based on the specified fields.
 // It returns the first IP address if found, or an error if no valid IP address is found.
-func ipFromResourceAttributes(attributes []attribute.Key, resource pcommon.Resource) (net.IP, error) {
+func ipFromAttributes(attributes []attribute.Key, attrs pcommon.Map) (net.IP, error) {
 	for _, attr := range attributes {
-		if ipField, found := resource.Attributes().Get(string(attr)); found {
+		if ipField, found := attrs.Get(string(attr)); found {
 			ipAttribute := net.ParseIP(ipField.AsString())
 			// The attribute might contain a domain name. Skip any net.ParseIP error until we have a fine-grained error propagation strategy.
 			// TODO: propagate an error once error_mode configuration option is available (e.g. transformprocessor)
@@ -51,21 +54,22 @@ func ipFromResourceAttributes(attributes []attribute.Key, resource pcommon.Resou
 // geoLocation fetches geolocation information for the given IP address using the configured providers.
 // It returns a set of attributes containing the geolocation data, or an error if the location could not be determined.
 func (g *geoIPProcessor) geoLocation(ctx context.Context, ip net.IP) (attribute.Set, error) {
-	allAttributes := attribute.EmptySet()
+	// attribute.EmptySet returns a shared set, which must not be modified
+	var allAttributes attribute.Set
 	for _, provider := range g.providers {
 		geoAttributes, err := provider.Location(ctx, ip)
 		if err != nil {
 			return attribute.Set{}, err
 		}
-		*allAttributes = attribute.NewSet(append(allAttributes.ToSlice(), geoAttributes.ToSlice()...)...)
+		allAttributes = attribute.NewSet(append(allAttributes.ToSlice(), geoAttributes.ToSlice()...)...)
 	}
 
-	return *allAttributes, nil
+	return allAttributes, nil
 }
 
-// processResource processes a single resource by adding geolocation attributes based on the found IP address.
-func (g *geoIPProcessor) processResource(ctx context.Context, resource pcommon.Resource) error {
-	ipAddr, err := ipFromResourceAttributes(g.resourceAttributes, resource)
+// processAttributes adds the geolocation attributes based on the IP address found in the attributes.
+func (g *geoIPProcessor) processAttributes(ctx context.Context, attrs pcommon.Map) error {
+	ipAddr, err := ipFromAttributes(g.attributes, attrs)
 	if err != nil {
 		// TODO: log IP error not found
 		if errors.Is(err, errIPNotFound) {
@@ -80,29 +84,97 @@ func (g *geoIPProcessor) processResource(ctx context.Context, resource pcommon.R
 	}
 
 	for _, geoAttr := range attributes.ToSlice() {
-		resource.Attributes().PutStr(string(geoAttr.Key), geoAttr.Value.AsString())
+		putAttribute(attrs, geoAttr)
 	}
 
 	return nil
 }
 
+// putAttribute sets the attribute keeping the type of its value, the slices are set as strings.
+func putAttribute(attrs pcommon.Map, kv attribute.KeyValue) {
+	switch kv.Value.Type() {
+	case attribute.BOOL:
+		attrs.PutBool(string(kv.Key), kv.Value.AsBool())
+	case attribute.INT64:
+		attrs.PutInt(string(kv.Key), kv.Value.AsInt64())
+	case attribute.FLOAT64:
+		attrs.PutDouble(string(kv.Key), kv.Value.AsFloat64())
+	default:
+		attrs.PutStr(string(kv.Key), kv.Value.Emit())
+	}
+}
+
 func (g *geoIPProcessor) processMetrics(ctx context.Context, ms pmetric.Metrics) (pmetric.Metrics, error) {
 	rm := ms.ResourceMetrics()
 	for i := 0; i < rm.Len(); i++ {
-		err := g.processResource(ctx, rm.At(i).Resource())
-		if err != nil {
-			return ms, err
+		if g.context == resourceContext {
+			if err := g.processAttributes(ctx, rm.At(i).Resource().Attributes()); err != nil {
+				return ms, err
+			}
+			continue
+		}
+		sm := rm.At(i).ScopeMetrics()
+		for j := 0; j < sm.Len(); j++ {
+			metrics := sm.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				if err := g.processDataPoints(ctx, metrics.At(k)); err != nil {
+					return ms, err
+				}
+			}
 		}
 	}
 	return ms, nil
 }
 
+func (g *geoIPProcessor) processDataPoints(ctx context.Context, metric pmetric.Metric) error {
+	var attrs []pcommon.Map
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			attrs = append(attrs, metric.Summary().DataPoints().At(i).Attributes())
+		}
+	}
+	for _, a := range attrs {
+		if err := g.processAttributes(ctx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *geoIPProcessor) processTraces(ctx context.Context, ts ptrace.Traces) (ptrace.Traces, error) {
 	rt := ts.ResourceSpans()
 	for i := 0; i < rt.Len(); i++ {
-		err := g.processResource(ctx, rt.At(i).Resource())
-		if err != nil {
-			return ts, err
+		if g.context == resourceContext {
+			if err := g.processAttributes(ctx, rt.At(i).Resource().Attributes()); err != nil {
+				return ts, err
+			}
+			continue
+		}
+		ss := rt.At(i).ScopeSpans()
+		for j := 0; j < ss.Len(); j++ {
+			spans := ss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if err := g.processAttributes(ctx, spans.At(k).Attributes()); err != nil {
+					return ts, err
+				}
+			}
 		}
 	}
 	return ts, nil
@@ -111,10 +183,30 @@ func (g *geoIPProcessor) processTraces(ctx context.Context, ts ptrace.Traces) (p
 func (g *geoIPProcessor) processLogs(ctx context.Context, ls plog.Logs) (plog.Logs, error) {
 	rl := ls.ResourceLogs()
 	for i := 0; i < rl.Len(); i++ {
-		err := g.processResource(ctx, rl.At(i).Resource())
-		if err != nil {
-			return ls, err
+		if g.context == resourceContext {
+			if err := g.processAttributes(ctx, rl.At(i).Resource().Attributes()); err != nil {
+				return ls, err
+			}
+			continue
+		}
+		sl := rl.At(i).ScopeLogs()
+		for j := 0; j < sl.Len(); j++ {
+			logs := sl.At(j).LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				if err := g.processAttributes(ctx, logs.At(k).Attributes()); err != nil {
+					return ls, err
+				}
+			}
 		}
 	}
 	return ls, nil
 }
+
+// shutdown closes the providers.
+func (g *geoIPProcessor) shutdown(ctx context.Context) error {
+	var errs error
+	for _, provider := range g.providers {
+		errs = errors.Join(errs, provider.Close(ctx))
+	}
+	return errs
+}
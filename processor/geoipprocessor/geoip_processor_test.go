@@ -8,6 +8,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -38,6 +39,10 @@ func (pm *ProviderMock) Location(ctx context.Context, ip net.IP) (attribute.Set,
 	return pm.LocationF(ctx, ip)
 }
 
+func (pm *ProviderMock) Close(context.Context) error {
+	return nil
+}
+
 type generateResourceFunc func(res pcommon.Resource)
 
 func generateTraces(resourceFunc ...generateResourceFunc) ptrace.Traces {
@@ -96,7 +101,7 @@ func TestProcessPdata(t *testing.T) {
 	}{
 		{
 			name:               "default source.ip attribute, not found",
-			resourceAttributes: defaultResourceAttributes,
+			resourceAttributes: defaultAttributes,
 			initResourceAttributes: []generateResourceFunc{
 				withAttributes([]attribute.KeyValue{
 					attribute.String("ip", "1.2.3.4"),
@@ -113,7 +118,7 @@ func TestProcessPdata(t *testing.T) {
 		},
 		{
 			name:               "default source.ip attribute",
-			resourceAttributes: defaultResourceAttributes,
+			resourceAttributes: defaultAttributes,
 			initResourceAttributes: []generateResourceFunc{
 				withAttributes([]attribute.KeyValue{
 					attribute.String("ip", "1.2.3.4"),
@@ -173,7 +178,7 @@ func TestProcessPdata(t *testing.T) {
 		},
 		{
 			name:               "do not add resource attributes with an invalid ip",
-			resourceAttributes: defaultResourceAttributes,
+			resourceAttributes: defaultAttributes,
 			initResourceAttributes: []generateResourceFunc{
 				withAttributes([]attribute.KeyValue{
 					attribute.String(string(semconv.SourceAddressKey), "%"),
@@ -194,8 +199,7 @@ func TestProcessPdata(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// prepare processor
 			baseProviderMock.LocationF = tt.geoLocationMock
-			processor := newGeoIPProcessor(tt.resourceAttributes)
-			processor.providers = []provider.GeoIPProvider{&baseProviderMock}
+			processor := newGeoIPProcessor(&Config{Context: resourceContext, Attributes: tt.resourceAttributes}, []provider.GeoIPProvider{&baseProviderMock})
 
 			// assert metrics
 			actualMetrics, err := processor.processMetrics(context.Background(), generateMetrics(tt.initResourceAttributes...))
@@ -214,3 +218,63 @@ func TestProcessPdata(t *testing.T) {
 		})
 	}
 }
+
+// TestProcessRecords asserts that the processor adds the geo location data into the attributes of the spans,
+// log records and datapoints holding an ip with the record context, keeping the types of the values
+func TestProcessRecords(t *testing.T) {
+	t.Parallel()
+
+	asnMock := &ProviderMock{
+		LocationF: func(_ context.Context, ip net.IP) (attribute.Set, error) {
+			if ip.Equal(net.IP{1, 2, 3, 4}) {
+				return attribute.NewSet(attribute.Int("as.number", 13335), attribute.String("as.organization.name", "Cloudflare")), nil
+			}
+			return attribute.Set{}, nil
+		},
+	}
+	cityMock := &ProviderMock{
+		LocationF: func(context.Context, net.IP) (attribute.Set, error) {
+			return attribute.NewSet(attribute.String("geo.city_name", "barcelona"), attribute.Float64("geo.location.lat", 41.38)), nil
+		},
+	}
+	processor := newGeoIPProcessor(&Config{Context: recordContext, Attributes: defaultAttributes}, []provider.GeoIPProvider{asnMock, cityMock})
+
+	assertEnriched := func(t *testing.T, attrs pcommon.Map) {
+		expected := pcommon.NewMap()
+		expected.PutStr(string(semconv.ClientAddressKey), "1.2.3.4")
+		expected.PutInt("as.number", 13335)
+		expected.PutStr("as.organization.name", "Cloudflare")
+		expected.PutStr("geo.city_name", "barcelona")
+		expected.PutDouble("geo.location.lat", 41.38)
+		assert.Equal(t, expected.AsRaw(), attrs.AsRaw())
+	}
+
+	traces := ptrace.NewTraces()
+	rs := traces.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr(string(semconv.SourceAddressKey), "1.2.3.4")
+	spans := rs.ScopeSpans().AppendEmpty().Spans()
+	spans.AppendEmpty().Attributes().PutStr(string(semconv.ClientAddressKey), "1.2.3.4")
+	spans.AppendEmpty().Attributes().PutStr("http.method", "GET")
+	actualTraces, err := processor.processTraces(context.Background(), traces)
+	require.NoError(t, err)
+	assertEnriched(t, actualTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes())
+	assert.Equal(t, map[string]any{"http.method": "GET"}, actualTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().AsRaw())
+	// the resource is left untouched with the record context
+	assert.Equal(t, 1, actualTraces.ResourceSpans().At(0).Resource().Attributes().Len())
+
+	logs := plog.NewLogs()
+	logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr(string(semconv.ClientAddressKey), "1.2.3.4")
+	actualLogs, err := processor.processLogs(context.Background(), logs)
+	require.NoError(t, err)
+	assertEnriched(t, actualLogs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes())
+
+	metrics := pmetric.NewMetrics()
+	ms := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr(string(semconv.ClientAddressKey), "1.2.3.4")
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr(string(semconv.ClientAddressKey), "1.2.3.4")
+	actualMetrics, err := processor.processMetrics(context.Background(), metrics)
+	require.NoError(t, err)
+	actualMs := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assertEnriched(t, actualMs.At(0).Sum().DataPoints().At(0).Attributes())
+	assertEnriched(t, actualMs.At(1).Histogram().DataPoints().At(0).Attributes())
+}
@@ -14,4 +14,6 @@ import (
 type GeoIPProvider interface {
 	// Location returns a set of attributes representing the geographical location for the given IP address. It requires a context for managing request lifetime.
 	Location(context.Context, net.IP) (attribute.Set, error)
+	// Close releases the resources held by the provider, such as open databases.
+	Close(context.Context) error
 }
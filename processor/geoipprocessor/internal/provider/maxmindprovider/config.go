@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package maxmindprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Config defines configuration for the MaxMind GeoIP provider.
+type Config struct {
+	// DatabasePath is the path to the MaxMind database file (.mmdb).
+	DatabasePath string `mapstructure:"database_path"`
+
+	// Fields maps attribute names to the dot separated path of a field in the database records,
+	// e.g. `connection_type` or `subdivisions.0.iso_code`. It is required for the databases other
+	// than the City, Country, ASN and ISP ones, and replaces their default attributes otherwise.
+	Fields map[string]string `mapstructure:"fields"`
+}
+
+// Validate checks if the provider configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.DatabasePath == "" {
+		return errors.New("a database_path must be provided")
+	}
+	for name, field := range cfg.Fields {
+		if name == "" {
+			return errors.New("fields: the attribute name must not be empty")
+		}
+		for _, segment := range strings.Split(field, ".") {
+			if segment == "" {
+				return fmt.Errorf("fields: %q has an invalid path %q", name, field)
+			}
+		}
+	}
+	return nil
+}
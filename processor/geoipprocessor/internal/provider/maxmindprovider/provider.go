@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package maxmindprovider implements a GeoIP provider reading MaxMind databases (.mmdb), such as
+// the GeoIP2/GeoLite2 City, Country and ASN databases, or custom databases.
+package maxmindprovider // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider/maxmindprovider"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/oschwald/maxminddb-golang"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/geoipprocessor/internal/provider"
+)
+
+// TypeStr is the type of the provider.
+const TypeStr = "maxmind"
+
+var (
+	// locationFields are the attributes set from the City and Country databases.
+	locationFields = map[string]string{
+		"geo.city_name":        "city.names.en",
+		"geo.postal_code":      "postal.code",
+		"geo.country_name":     "country.names.en",
+		"geo.country_iso_code": "country.iso_code",
+		"geo.continent_name":   "continent.names.en",
+		"geo.continent_code":   "continent.code",
+		"geo.region_name":      "subdivisions.0.names.en",
+		"geo.region_iso_code":  "subdivisions.0.iso_code",
+		"geo.timezone":         "location.time_zone",
+		"geo.location.lat":     "location.latitude",
+		"geo.location.lon":     "location.longitude",
+	}
+	// asnFields are the attributes set from the ASN and ISP databases.
+	asnFields = map[string]string{
+		"as.number":            "autonomous_system_number",
+		"as.organization.name": "autonomous_system_organization",
+	}
+)
+
+type field struct {
+	name string
+	path []string
+}
+
+type maxMindProvider struct {
+	reader *maxminddb.Reader
+	fields []field
+}
+
+var _ provider.GeoIPProvider = (*maxMindProvider)(nil)
+
+// NewProvider opens the configured database and returns a provider mapping its records to attributes.
+func NewProvider(cfg *Config) (provider.GeoIPProvider, error) {
+	reader, err := maxminddb.Open(cfg.DatabasePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the MaxMind database %q: %w", cfg.DatabasePath, err)
+	}
+
+	fields := cfg.Fields
+	if len(fields) == 0 {
+		databaseType := reader.Metadata.DatabaseType
+		switch {
+		case strings.Contains(databaseType, "City"), strings.Contains(databaseType, "Country"):
+			fields = locationFields
+		case strings.Contains(databaseType, "ASN"), strings.Contains(databaseType, "ISP"):
+			fields = asnFields
+		default:
+			_ = reader.Close()
+			return nil, fmt.Errorf("unsupported MaxMind database type %q, the fields to map must be configured", databaseType)
+		}
+	}
+
+	p := &maxMindProvider{reader: reader}
+	for name, path := range fields {
+		p.fields = append(p.fields, field{name: name, path: strings.Split(path, ".")})
+	}
+	sort.Slice(p.fields, func(i, j int) bool { return p.fields[i].name < p.fields[j].name })
+	return p, nil
+}
+
+// Location returns the attributes of the fields found in the record of the IP address, or an empty set
+// if the database holds no record for it.
+func (p *maxMindProvider) Location(_ context.Context, ip net.IP) (attribute.Set, error) {
+	var record any
+	_, ok, err := p.reader.LookupNetwork(ip, &record)
+	if err != nil {
+		return attribute.Set{}, err
+	}
+	if !ok {
+		return *attribute.EmptySet(), nil
+	}
+
+	attributes := make([]attribute.KeyValue, 0, len(p.fields))
+	for _, f := range p.fields {
+		v, found := lookup(record, f.path)
+		if !found {
+			continue
+		}
+		if kv, ok := toAttribute(f.name, v); ok {
+			attributes = append(attributes, kv)
+		}
+	}
+	return attribute.NewSet(attributes...), nil
+}
+
+func (p *maxMindProvider) Close(context.Context) error {
+	return p.reader.Close()
+}
+
+// lookup returns the value at the path in the decoded record, the numeric segments index the arrays.
+func lookup(record any, path []string) (any, bool) {
+	v := record
+	for _, segment := range path {
+		switch node := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = node[segment]; !ok {
+				return nil, false
+			}
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			v = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return v, v != nil
+}
+
+// toAttribute converts the scalar values of a record, maps and arrays are not converted.
+func toAttribute(name string, v any) (attribute.KeyValue, bool) {
+	switch value := v.(type) {
+	case string:
+		return attribute.String(name, value), true
+	case bool:
+		return attribute.Bool(name, value), true
+	case int:
+		return attribute.Int(name, value), true
+	case uint64:
+		if value > math.MaxInt64 {
+			return attribute.String(name, strconv.FormatUint(value, 10)), true
+		}
+		return attribute.Int64(name, int64(value)), true
+	case *big.Int:
+		return attribute.String(name, value.String()), true
+	case float32:
+		return attribute.Float64(name, float64(value)), true
+	case float64:
+		return attribute.Float64(name, value), true
+	}
+	return attribute.KeyValue{}, false
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package maxmindprovider
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maxmind/mmdbwriter"
+	"github.com/maxmind/mmdbwriter/mmdbtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// writeDatabase writes a database of the given type holding the record for 1.2.3.0/24.
+func writeDatabase(t *testing.T, databaseType string, record mmdbtype.Map) string {
+	tree, err := mmdbwriter.New(mmdbwriter.Options{DatabaseType: databaseType, RecordSize: 24})
+	require.NoError(t, err)
+	_, network, err := net.ParseCIDR("1.2.3.0/24")
+	require.NoError(t, err)
+	require.NoError(t, tree.Insert(network, record))
+
+	path := filepath.Join(t.TempDir(), databaseType+".mmdb")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = tree.WriteTo(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	return path
+}
+
+func TestLocation(t *testing.T) {
+	city := mmdbtype.Map{
+		"city": mmdbtype.Map{"names": mmdbtype.Map{"en": mmdbtype.String("Barcelona")}},
+		"continent": mmdbtype.Map{
+			"code":  mmdbtype.String("EU"),
+			"names": mmdbtype.Map{"en": mmdbtype.String("Europe")},
+		},
+		"country": mmdbtype.Map{
+			"iso_code": mmdbtype.String("ES"),
+			"names":    mmdbtype.Map{"en": mmdbtype.String("Spain")},
+		},
+		"location": mmdbtype.Map{
+			"latitude":  mmdbtype.Float64(41.3891),
+			"longitude": mmdbtype.Float64(2.1611),
+			"time_zone": mmdbtype.String("Europe/Madrid"),
+		},
+		"subdivisions": mmdbtype.Slice{
+			mmdbtype.Map{
+				"iso_code": mmdbtype.String("CT"),
+				"names":    mmdbtype.Map{"en": mmdbtype.String("Catalonia")},
+			},
+		},
+	}
+	asn := mmdbtype.Map{
+		"autonomous_system_number":       mmdbtype.Uint32(13335),
+		"autonomous_system_organization": mmdbtype.String("CLOUDFLARENET"),
+	}
+	custom := mmdbtype.Map{
+		"connection_type": mmdbtype.String("Corporate"),
+		"anonymous":       mmdbtype.Bool(true),
+		"risk":            mmdbtype.Map{"score": mmdbtype.Float32(0.5), "rank": mmdbtype.Int32(-2)},
+		"ranges":          mmdbtype.Slice{mmdbtype.Uint64(7)},
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		fields   map[string]string
+		expected attribute.Set
+	}{
+		{
+			name: "city database",
+			path: writeDatabase(t, "GeoLite2-City", city),
+			expected: attribute.NewSet(
+				attribute.String("geo.city_name", "Barcelona"),
+				attribute.String("geo.continent_code", "EU"),
+				attribute.String("geo.continent_name", "Europe"),
+				attribute.String("geo.country_iso_code", "ES"),
+				attribute.String("geo.country_name", "Spain"),
+				attribute.Float64("geo.location.lat", 41.3891),
+				attribute.Float64("geo.location.lon", 2.1611),
+				attribute.String("geo.region_iso_code", "CT"),
+				attribute.String("geo.region_name", "Catalonia"),
+				attribute.String("geo.timezone", "Europe/Madrid"),
+			),
+		},
+		{
+			name: "asn database",
+			path: writeDatabase(t, "GeoLite2-ASN", asn),
+			expected: attribute.NewSet(
+				attribute.Int("as.number", 13335),
+				attribute.String("as.organization.name", "CLOUDFLARENET"),
+			),
+		},
+		{
+			name: "custom database",
+			path: writeDatabase(t, "Custom-Connection-Type", custom),
+			fields: map[string]string{
+				"network.connection.type": "connection_type",
+				"anonymous":               "anonymous",
+				"risk.score":              "risk.score",
+				"risk.rank":               "risk.rank",
+				"range":                   "ranges.0",
+				"risk":                    "risk",
+				"missing":                 "ranges.1",
+			},
+			expected: attribute.NewSet(
+				attribute.String("network.connection.type", "Corporate"),
+				attribute.Bool("anonymous", true),
+				attribute.Float64("risk.score", 0.5),
+				attribute.Int("risk.rank", -2),
+				attribute.Int("range", 7),
+			),
+		},
+		{
+			name:     "custom fields replace the default ones",
+			path:     writeDatabase(t, "GeoLite2-City", city),
+			fields:   map[string]string{"geo.city_name": "city.names.en"},
+			expected: attribute.NewSet(attribute.String("geo.city_name", "Barcelona")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewProvider(&Config{DatabasePath: tt.path, Fields: tt.fields})
+			require.NoError(t, err)
+			defer func() { assert.NoError(t, p.Close(context.Background())) }()
+
+			actual, err := p.Location(context.Background(), net.ParseIP("1.2.3.4"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.ToSlice(), actual.ToSlice())
+
+			// the addresses without a record have no attributes
+			actual, err = p.Location(context.Background(), net.ParseIP("5.6.7.8"))
+			require.NoError(t, err)
+			assert.Zero(t, actual.Len())
+		})
+	}
+}
+
+func TestNewProviderErrors(t *testing.T) {
+	_, err := NewProvider(&Config{DatabasePath: filepath.Join(t.TempDir(), "missing.mmdb")})
+	assert.ErrorContains(t, err, "could not open the MaxMind database")
+
+	path := writeDatabase(t, "Custom-Connection-Type", mmdbtype.Map{"connection_type": mmdbtype.String("Corporate")})
+	_, err = NewProvider(&Config{DatabasePath: path})
+	assert.EqualError(t, err, `unsupported MaxMind database type "Custom-Connection-Type", the fields to map must be configured`)
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// resourceKey identifies the resources which are merged in compaction-only mode.
+type resourceKey struct {
+	attributes             [16]byte
+	droppedAttributesCount uint32
+	schemaURL              string
+}
+
+func newResourceKey(resource pcommon.Resource, schemaURL string) resourceKey {
+	return resourceKey{
+		attributes:             pdatautil.MapHash(resource.Attributes()),
+		droppedAttributesCount: resource.DroppedAttributesCount(),
+		schemaURL:              schemaURL,
+	}
+}
+
+// scopeKey identifies the scopes which are merged, within the same resource, in compaction-only mode.
+type scopeKey struct {
+	resource               resourceKey
+	name                   string
+	version                string
+	attributes             [16]byte
+	droppedAttributesCount uint32
+	schemaURL              string
+}
+
+func newScopeKey(resource resourceKey, scope pcommon.InstrumentationScope, schemaURL string) scopeKey {
+	return scopeKey{
+		resource:               resource,
+		name:                   scope.Name(),
+		version:                scope.Version(),
+		attributes:             pdatautil.MapHash(scope.Attributes()),
+		droppedAttributesCount: scope.DroppedAttributesCount(),
+		schemaURL:              schemaURL,
+	}
+}
+
+// recordCompaction records the number of resources and scopes received and emitted by the compaction.
+func (gap *groupByAttrsProcessor) recordCompaction(ctx context.Context, resourcesIn, resourcesOut, scopesIn, scopesOut int) {
+	gap.telemetryBuilder.ProcessorGroupbyattrsCompactionInputResources.Add(ctx, int64(resourcesIn))
+	gap.telemetryBuilder.ProcessorGroupbyattrsCompactionOutputResources.Add(ctx, int64(resourcesOut))
+	gap.telemetryBuilder.ProcessorGroupbyattrsCompactionInputScopes.Add(ctx, int64(scopesIn))
+	gap.telemetryBuilder.ProcessorGroupbyattrsCompactionOutputScopes.Add(ctx, int64(scopesOut))
+}
+
+// compactTraces merges the identical resources and scopes of the traces, the spans are moved without being copied.
+func (gap *groupByAttrsProcessor) compactTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	compacted := ptrace.NewTraces()
+	resources := map[resourceKey]ptrace.ResourceSpans{}
+	scopes := map[scopeKey]ptrace.ScopeSpans{}
+	scopesIn := 0
+
+	rss := td.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		rs := rss.At(i)
+		rKey := newResourceKey(rs.Resource(), rs.SchemaUrl())
+		compactedRs, ok := resources[rKey]
+		if !ok {
+			compactedRs = compacted.ResourceSpans().AppendEmpty()
+			rs.Resource().MoveTo(compactedRs.Resource())
+			compactedRs.SetSchemaUrl(rs.SchemaUrl())
+			resources[rKey] = compactedRs
+		}
+
+		ilss := rs.ScopeSpans()
+		scopesIn += ilss.Len()
+		for j := 0; j < ilss.Len(); j++ {
+			ils := ilss.At(j)
+			sKey := newScopeKey(rKey, ils.Scope(), ils.SchemaUrl())
+			if compactedIls, ok := scopes[sKey]; ok {
+				ils.Spans().MoveAndAppendTo(compactedIls.Spans())
+				continue
+			}
+			compactedIls := compactedRs.ScopeSpans().AppendEmpty()
+			ils.MoveTo(compactedIls)
+			scopes[sKey] = compactedIls
+		}
+	}
+
+	gap.recordCompaction(ctx, rss.Len(), len(resources), scopesIn, len(scopes))
+	return compacted, nil
+}
+
+// compactLogs merges the identical resources and scopes of the logs, the log records are moved without being copied.
+func (gap *groupByAttrsProcessor) compactLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	compacted := plog.NewLogs()
+	resources := map[resourceKey]plog.ResourceLogs{}
+	scopes := map[scopeKey]plog.ScopeLogs{}
+	scopesIn := 0
+
+	rls := ld.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		rl := rls.At(i)
+		rKey := newResourceKey(rl.Resource(), rl.SchemaUrl())
+		compactedRl, ok := resources[rKey]
+		if !ok {
+			compactedRl = compacted.ResourceLogs().AppendEmpty()
+			rl.Resource().MoveTo(compactedRl.Resource())
+			compactedRl.SetSchemaUrl(rl.SchemaUrl())
+			resources[rKey] = compactedRl
+		}
+
+		ills := rl.ScopeLogs()
+		scopesIn += ills.Len()
+		for j := 0; j < ills.Len(); j++ {
+			sl := ills.At(j)
+			sKey := newScopeKey(rKey, sl.Scope(), sl.SchemaUrl())
+			if compactedSl, ok := scopes[sKey]; ok {
+				sl.LogRecords().MoveAndAppendTo(compactedSl.LogRecords())
+				continue
+			}
+			compactedSl := compactedRl.ScopeLogs().AppendEmpty()
+			sl.MoveTo(compactedSl)
+			scopes[sKey] = compactedSl
+		}
+	}
+
+	gap.recordCompaction(ctx, rls.Len(), len(resources), scopesIn, len(scopes))
+	return compacted, nil
+}
+
+// compactMetrics merges the identical resources and scopes of the metrics, the metrics are moved without being
+// copied. Unlike the grouping, the data points of metrics with the same name are not merged.
+func (gap *groupByAttrsProcessor) compactMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	compacted := pmetric.NewMetrics()
+	resources := map[resourceKey]pmetric.ResourceMetrics{}
+	scopes := map[scopeKey]pmetric.ScopeMetrics{}
+	scopesIn := 0
+
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		rKey := newResourceKey(rm.Resource(), rm.SchemaUrl())
+		compactedRm, ok := resources[rKey]
+		if !ok {
+			compactedRm = compacted.ResourceMetrics().AppendEmpty()
+			rm.Resource().MoveTo(compactedRm.Resource())
+			compactedRm.SetSchemaUrl(rm.SchemaUrl())
+			resources[rKey] = compactedRm
+		}
+
+		ilms := rm.ScopeMetrics()
+		scopesIn += ilms.Len()
+		for j := 0; j < ilms.Len(); j++ {
+			ilm := ilms.At(j)
+			sKey := newScopeKey(rKey, ilm.Scope(), ilm.SchemaUrl())
+			if compactedIlm, ok := scopes[sKey]; ok {
+				ilm.Metrics().MoveAndAppendTo(compactedIlm.Metrics())
+				continue
+			}
+			compactedIlm := compactedRm.ScopeMetrics().AppendEmpty()
+			ilm.MoveTo(compactedIlm)
+			scopes[sKey] = compactedIlm
+		}
+	}
+
+	gap.recordCompaction(ctx, rms.Len(), len(resources), scopesIn, len(scopes))
+	return compacted, nil
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbyattrsprocessor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestCompactionOnly(t *testing.T) {
+	spans := someSpans(attrMap, 10, 10)
+	logs := someLogs(attrMap, 10, 10)
+	metrics := someGaugeMetrics(attrMap, 10, 10)
+
+	gap, err := createGroupByAttrsProcessor(processortest.NewNopCreateSettings(), []string{})
+	require.NoError(t, err)
+
+	processedSpans, err := gap.compactTraces(context.Background(), spans)
+	assert.NoError(t, err)
+	processedLogs, err := gap.compactLogs(context.Background(), logs)
+	assert.NoError(t, err)
+	processedMetrics, err := gap.compactMetrics(context.Background(), metrics)
+	assert.NoError(t, err)
+
+	require.Equal(t, 1, processedSpans.ResourceSpans().Len())
+	require.Equal(t, 1, processedLogs.ResourceLogs().Len())
+	require.Equal(t, 1, processedMetrics.ResourceMetrics().Len())
+
+	rss := processedSpans.ResourceSpans().At(0)
+	rls := processedLogs.ResourceLogs().At(0)
+	rlm := processedMetrics.ResourceMetrics().At(0)
+
+	require.Equal(t, 10, rss.ScopeSpans().Len())
+	require.Equal(t, 10, rls.ScopeLogs().Len())
+	require.Equal(t, 10, rlm.ScopeMetrics().Len())
+
+	for i := 0; i < 10; i++ {
+		ils := rss.ScopeSpans().At(i)
+		sl := rls.ScopeLogs().At(i)
+		ilm := rlm.ScopeMetrics().At(i)
+
+		assert.Equal(t, fmt.Sprint("ils-", i), ils.Scope().Name())
+		assert.Equal(t, 10, ils.Spans().Len())
+		assert.Equal(t, 10, sl.LogRecords().Len())
+		assert.Equal(t, 10, ilm.Metrics().Len())
+
+		// The attributes of the records are left untouched.
+		assert.Equal(t, attrMap.AsRaw(), ils.Spans().At(0).Attributes().AsRaw())
+		assert.Equal(t, attrMap.AsRaw(), sl.LogRecords().At(0).Attributes().AsRaw())
+	}
+}
+
+func TestCompactionOnlyKeepsDistinctResourcesAndScopes(t *testing.T) {
+	traces := ptrace.NewTraces()
+	appendSpan := func(host, schemaURL, scopeVersion string, scopeAttr string) {
+		rs := traces.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("host.name", host)
+		rs.SetSchemaUrl(schemaURL)
+		ils := rs.ScopeSpans().AppendEmpty()
+		ils.Scope().SetName("library")
+		ils.Scope().SetVersion(scopeVersion)
+		if scopeAttr != "" {
+			ils.Scope().Attributes().PutStr("attr", scopeAttr)
+		}
+		ils.Spans().AppendEmpty()
+	}
+	appendSpan("host-a", "", "1.0", "")
+	appendSpan("host-a", "", "1.0", "")
+	appendSpan("host-a", "", "2.0", "")
+	appendSpan("host-a", "", "1.0", "value")
+	appendSpan("host-b", "", "1.0", "")
+	appendSpan("host-a", "https://opentelemetry.io/schemas/1.4.0", "1.0", "")
+
+	gap, err := createGroupByAttrsProcessor(processortest.NewNopCreateSettings(), []string{})
+	require.NoError(t, err)
+	processed, err := gap.compactTraces(context.Background(), traces)
+	require.NoError(t, err)
+
+	rss := processed.ResourceSpans()
+	require.Equal(t, 3, rss.Len())
+	assert.Equal(t, "", rss.At(0).SchemaUrl())
+	require.Equal(t, 3, rss.At(0).ScopeSpans().Len())
+	assert.Equal(t, 2, rss.At(0).ScopeSpans().At(0).Spans().Len())
+	assert.Equal(t, 1, rss.At(0).ScopeSpans().At(1).Spans().Len())
+	assert.Equal(t, 1, rss.At(0).ScopeSpans().At(2).Spans().Len())
+	host, _ := rss.At(1).Resource().Attributes().Get("host.name")
+	assert.Equal(t, "host-b", host.Str())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.4.0", rss.At(2).SchemaUrl())
+	assert.Equal(t, 6, processed.SpanCount())
+}
+
+func TestCompactionOnlyTelemetry(t *testing.T) {
+	tel := setupTelemetry()
+	gap, err := createGroupByAttrsProcessor(tel.NewProcessorCreateSettings(), []string{})
+	require.NoError(t, err)
+
+	_, err = gap.compactTraces(context.Background(), someSpans(attrMap, 10, 10))
+	require.NoError(t, err)
+	_, err = gap.compactLogs(context.Background(), someLogs(attrMap, 2, 5))
+	require.NoError(t, err)
+
+	var md metricdata.ResourceMetrics
+	require.NoError(t, tel.reader.Collect(context.Background(), &md))
+	for name, expected := range map[string]int64{
+		"processor_groupbyattrs_compaction_input_resources":  110,
+		"processor_groupbyattrs_compaction_output_resources": 2,
+		"processor_groupbyattrs_compaction_input_scopes":     110,
+		"processor_groupbyattrs_compaction_output_scopes":    12,
+	} {
+		sum, ok := tel.getMetric(name, md).Data.(metricdata.Sum[int64])
+		require.True(t, ok, name)
+		require.Len(t, sum.DataPoints, 1, name)
+		assert.Equal(t, expected, sum.DataPoints[0].Value, name)
+	}
+}
+
+func TestConfigValidateCompactionOnly(t *testing.T) {
+	assert.NoError(t, (&Config{CompactionOnly: true}).Validate())
+	assert.EqualError(t, (&Config{CompactionOnly: true, GroupByKeys: []string{"foo"}}).Validate(),
+		"'keys' cannot be used together with 'compaction_only'")
+}
+
+func BenchmarkCompactionOnly(bb *testing.B) {
+	runs := []struct {
+		ilCount   int
+		spanCount int
+	}{
+		{
+			ilCount:   1,
+			spanCount: 100,
+		},
+		{
+			ilCount:   10,
+			spanCount: 10,
+		},
+		{
+			ilCount:   100,
+			spanCount: 1,
+		},
+	}
+
+	gap, err := createGroupByAttrsProcessor(processortest.NewNopCreateSettings(), []string{})
+	require.NoError(bb, err)
+	modes := []struct {
+		name    string
+		process func(context.Context, ptrace.Traces) (ptrace.Traces, error)
+	}{
+		{name: "compaction_only", process: gap.compactTraces},
+		{name: "empty_keys", process: gap.processTraces},
+	}
+	for _, run := range runs {
+		for _, mode := range modes {
+			bb.Run(fmt.Sprintf("mode=%s, instrumentation_library_count=%d, spans_per_library_count=%d", mode.name, run.ilCount, run.spanCount), func(b *testing.B) {
+				spans := someSpans(attrMap, run.ilCount, run.spanCount)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					// The compaction moves the records of its input, which is copied for every run.
+					b.StopTimer()
+					input := ptrace.NewTraces()
+					spans.CopyTo(input)
+					b.StartTimer()
+					if _, err := mode.process(context.Background(), input); err != nil {
+						return
+					}
+				}
+			})
+		}
+	}
+}
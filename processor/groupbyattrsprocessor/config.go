@@ -3,10 +3,26 @@
 
 package groupbyattrsprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbyattrsprocessor"
 
+import (
+	"errors"
+)
+
 // Config is the configuration for the processor.
 type Config struct {
 
 	// GroupByKeys describes the attribute names that are going to be used for grouping.
 	// Empty value is allowed, since processor in such case can compact data
 	GroupByKeys []string `mapstructure:"keys"`
+
+	// CompactionOnly only merges the identical Resources and Scopes, moving their records without looking at
+	// their attributes. It is cheaper than the compaction done with empty keys, and cannot be used with keys.
+	CompactionOnly bool `mapstructure:"compaction_only"`
+}
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.CompactionOnly && len(cfg.GroupByKeys) > 0 {
+		return errors.New("'keys' cannot be used together with 'compaction_only'")
+	}
+	return nil
 }
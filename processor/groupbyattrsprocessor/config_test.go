@@ -34,6 +34,13 @@ func TestLoadingConfig(t *testing.T) {
 				GroupByKeys: []string{},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "compaction_only"),
+			expected: &Config{
+				GroupByKeys:    []string{},
+				CompactionOnly: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
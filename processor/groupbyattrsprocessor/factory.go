@@ -71,13 +71,17 @@ func createTracesProcessor(
 	if err != nil {
 		return nil, err
 	}
+	process := gap.processTraces
+	if oCfg.CompactionOnly {
+		process = gap.compactTraces
+	}
 
 	return processorhelper.NewTracesProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		gap.processTraces,
+		process,
 		processorhelper.WithCapabilities(consumerCapabilities))
 }
 
@@ -93,13 +97,17 @@ func createLogsProcessor(
 	if err != nil {
 		return nil, err
 	}
+	process := gap.processLogs
+	if oCfg.CompactionOnly {
+		process = gap.compactLogs
+	}
 
 	return processorhelper.NewLogsProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		gap.processLogs,
+		process,
 		processorhelper.WithCapabilities(consumerCapabilities))
 }
 
@@ -115,12 +123,16 @@ func createMetricsProcessor(
 	if err != nil {
 		return nil, err
 	}
+	process := gap.processMetrics
+	if oCfg.CompactionOnly {
+		process = gap.compactMetrics
+	}
 
 	return processorhelper.NewMetricsProcessor(
 		ctx,
 		set,
 		cfg,
 		nextConsumer,
-		gap.processMetrics,
+		process,
 		processorhelper.WithCapabilities(consumerCapabilities))
 }
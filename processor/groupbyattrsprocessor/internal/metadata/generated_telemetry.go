@@ -24,16 +24,20 @@ func Tracer(settings component.TelemetrySettings) trace.Tracer {
 // TelemetryBuilder provides an interface for components to report telemetry
 // as defined in metadata and user config.
 type TelemetryBuilder struct {
-	ProcessorGroupbyattrsLogGroups            metric.Int64Histogram
-	ProcessorGroupbyattrsMetricGroups         metric.Int64Histogram
-	ProcessorGroupbyattrsNumGroupedLogs       metric.Int64Counter
-	ProcessorGroupbyattrsNumGroupedMetrics    metric.Int64Counter
-	ProcessorGroupbyattrsNumGroupedSpans      metric.Int64Counter
-	ProcessorGroupbyattrsNumNonGroupedLogs    metric.Int64Counter
-	ProcessorGroupbyattrsNumNonGroupedMetrics metric.Int64Counter
-	ProcessorGroupbyattrsNumNonGroupedSpans   metric.Int64Counter
-	ProcessorGroupbyattrsSpanGroups           metric.Int64Histogram
-	level                                     configtelemetry.Level
+	ProcessorGroupbyattrsCompactionInputResources  metric.Int64Counter
+	ProcessorGroupbyattrsCompactionInputScopes     metric.Int64Counter
+	ProcessorGroupbyattrsCompactionOutputResources metric.Int64Counter
+	ProcessorGroupbyattrsCompactionOutputScopes    metric.Int64Counter
+	ProcessorGroupbyattrsLogGroups                 metric.Int64Histogram
+	ProcessorGroupbyattrsMetricGroups              metric.Int64Histogram
+	ProcessorGroupbyattrsNumGroupedLogs            metric.Int64Counter
+	ProcessorGroupbyattrsNumGroupedMetrics         metric.Int64Counter
+	ProcessorGroupbyattrsNumGroupedSpans           metric.Int64Counter
+	ProcessorGroupbyattrsNumNonGroupedLogs         metric.Int64Counter
+	ProcessorGroupbyattrsNumNonGroupedMetrics      metric.Int64Counter
+	ProcessorGroupbyattrsNumNonGroupedSpans        metric.Int64Counter
+	ProcessorGroupbyattrsSpanGroups                metric.Int64Histogram
+	level                                          configtelemetry.Level
 }
 
 // telemetryBuilderOption applies changes to default builder.
@@ -62,6 +66,30 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...teleme
 	} else {
 		meter = noop.Meter{}
 	}
+	builder.ProcessorGroupbyattrsCompactionInputResources, err = meter.Int64Counter(
+		"processor_groupbyattrs_compaction_input_resources",
+		metric.WithDescription("Number of resources received in compaction-only mode"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ProcessorGroupbyattrsCompactionInputScopes, err = meter.Int64Counter(
+		"processor_groupbyattrs_compaction_input_scopes",
+		metric.WithDescription("Number of scopes received in compaction-only mode"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ProcessorGroupbyattrsCompactionOutputResources, err = meter.Int64Counter(
+		"processor_groupbyattrs_compaction_output_resources",
+		metric.WithDescription("Number of resources emitted in compaction-only mode"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ProcessorGroupbyattrsCompactionOutputScopes, err = meter.Int64Counter(
+		"processor_groupbyattrs_compaction_output_scopes",
+		metric.WithDescription("Number of scopes emitted in compaction-only mode"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ProcessorGroupbyattrsLogGroups, err = meter.Int64Histogram(
 		"processor_groupbyattrs_log_groups",
 		metric.WithDescription("Distribution of groups extracted for logs"),
@@ -4,7 +4,10 @@
 package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
 
 import (
+	"errors"
 	"time"
+
+	"go.opentelemetry.io/collector/component"
 )
 
 // Config is the configuration for the processor.
@@ -28,9 +31,38 @@ type Config struct {
 	// Not yet implemented, and an error will be returned when this option is used.
 	DiscardOrphans bool `mapstructure:"discard_orphans"`
 
-	// StoreOnDisk tells the processor to keep only the trace ID in memory, serializing the trace spans to disk.
+	// StoreOnDisk tells the processor to serialize the spans of the traces exceeding the memory watermark
+	// to the storage extension, keeping only their trace ID in memory.
 	// Useful when the duration to wait for traces to complete is high.
 	// Default: false.
-	// Not yet implemented, and an error will be returned when this option is used.
 	StoreOnDisk bool `mapstructure:"store_on_disk"`
+
+	// Storage is the ID of the storage extension holding the traces, required when StoreOnDisk is set.
+	Storage *component.ID `mapstructure:"storage"`
+
+	// MemoryWatermark is the number of traces kept in memory before the new ones are stored on disk,
+	// when StoreOnDisk is set. Zero stores all traces on disk.
+	// Default: 10_000.
+	MemoryWatermark int `mapstructure:"memory_watermark"`
+
+	// DiskWatermarkMiB is the size of the traces stored on disk above which the spans of the new traces
+	// are dropped, when StoreOnDisk is set. Zero means no limit.
+	// Default: 0.
+	DiskWatermarkMiB int `mapstructure:"disk_watermark_mib"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.MemoryWatermark < 0 {
+		return errors.New("memory_watermark must not be negative")
+	}
+	if cfg.DiskWatermarkMiB < 0 {
+		return errors.New("disk_watermark_mib must not be negative")
+	}
+	if cfg.StoreOnDisk && cfg.Storage == nil {
+		return errors.New("storage must be set when store_on_disk is enabled")
+	}
+	return nil
 }
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	storageID := component.MustNewID("file_storage")
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr string
+	}{
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: &Config{
+				NumTraces:       1000,
+				NumWorkers:      defaultNumWorkers,
+				WaitDuration:    10 * time.Second,
+				MemoryWatermark: defaultMemoryWatermark,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "disk"),
+			expected: &Config{
+				NumTraces:        1_000_000,
+				NumWorkers:       defaultNumWorkers,
+				WaitDuration:     5 * time.Minute,
+				StoreOnDisk:      true,
+				Storage:          &storageID,
+				MemoryWatermark:  1000,
+				DiskWatermarkMiB: 512,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "disk_without_storage"),
+			expectedErr: "storage must be set when store_on_disk is enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cfg := createDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
@@ -17,15 +17,15 @@ import (
 )
 
 const (
-	defaultWaitDuration   = time.Second
-	defaultNumTraces      = 1_000_000
-	defaultNumWorkers     = 1
-	defaultDiscardOrphans = false
-	defaultStoreOnDisk    = false
+	defaultWaitDuration    = time.Second
+	defaultNumTraces       = 1_000_000
+	defaultNumWorkers      = 1
+	defaultDiscardOrphans  = false
+	defaultStoreOnDisk     = false
+	defaultMemoryWatermark = 10_000
 )
 
 var (
-	errDiskStorageNotSupported    = fmt.Errorf("option 'disk storage' not supported in this release")
 	errDiscardOrphansNotSupported = fmt.Errorf("option 'discard orphans' not supported in this release")
 )
 
@@ -47,9 +47,11 @@ func createDefaultConfig() component.Config {
 		NumWorkers:   defaultNumWorkers,
 		WaitDuration: defaultWaitDuration,
 
+		StoreOnDisk:     defaultStoreOnDisk,
+		MemoryWatermark: defaultMemoryWatermark,
+
 		// not supported for now
 		DiscardOrphans: defaultDiscardOrphans,
-		StoreOnDisk:    defaultStoreOnDisk,
 	}
 }
 
@@ -62,16 +64,14 @@ func createTracesProcessor(
 
 	oCfg := cfg.(*Config)
 
-	var st storage
-	if oCfg.StoreOnDisk {
-		return nil, errDiskStorageNotSupported
-	}
 	if oCfg.DiscardOrphans {
 		return nil, errDiscardOrphansNotSupported
 	}
 
-	// the only supported storage for now
-	st = newMemoryStorage()
+	var st storage = newMemoryStorage()
+	if oCfg.StoreOnDisk {
+		st = newDiskStorage(params.ID, *oCfg.Storage, oCfg.MemoryWatermark, int64(oCfg.DiskWatermarkMiB)<<20)
+	}
 
 	return newGroupByTraceProcessor(params.Logger, st, nextConsumer, *oCfg), nil
 }
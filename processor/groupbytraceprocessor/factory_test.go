@@ -21,6 +21,7 @@ func TestDefaultConfiguration(t *testing.T) {
 	assert.Equal(t, defaultWaitDuration, c.WaitDuration)
 	assert.Equal(t, defaultDiscardOrphans, c.DiscardOrphans)
 	assert.Equal(t, defaultStoreOnDisk, c.StoreOnDisk)
+	assert.Equal(t, defaultMemoryWatermark, c.MemoryWatermark)
 }
 
 func TestCreateTestProcessor(t *testing.T) {
@@ -52,12 +53,6 @@ func TestCreateTestProcessorWithNotImplementedOptions(t *testing.T) {
 			},
 			errDiscardOrphansNotSupported,
 		},
-		{
-			&Config{
-				StoreOnDisk: true,
-			},
-			errDiskStorageNotSupported,
-		},
 	} {
 		p, err := f.CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), tt.config, next)
 
@@ -16,6 +16,8 @@ var (
 	mNumTracesConf      = stats.Int64("conf_num_traces", "Maximum number of traces to hold in the internal storage", stats.UnitDimensionless)
 	mNumEventsInQueue   = stats.Int64("num_events_in_queue", "Number of events currently in the queue", stats.UnitDimensionless)
 	mNumTracesInMemory  = stats.Int64("num_traces_in_memory", "Number of traces currently in the in-memory storage", stats.UnitDimensionless)
+	mNumTracesOnDisk    = stats.Int64("num_traces_on_disk", "Number of traces currently stored on disk", stats.UnitDimensionless)
+	mDiskUsage          = stats.Int64("disk_usage", "Size of the traces currently stored on disk", stats.UnitBytes)
 	mTracesEvicted      = stats.Int64("traces_evicted", "Traces evicted from the internal buffer", stats.UnitDimensionless)
 	mReleasedSpans      = stats.Int64("spans_released", "Spans released to the next consumer", stats.UnitDimensionless)
 	mReleasedTraces     = stats.Int64("traces_released", "Traces released to the next consumer", stats.UnitDimensionless)
@@ -44,6 +46,18 @@ func metricViews() []*view.View {
 			Description: mNumTracesInMemory.Description(),
 			Aggregation: view.LastValue(),
 		},
+		{
+			Name:        processorhelper.BuildCustomMetricName(metadata.Type.String(), mNumTracesOnDisk.Name()),
+			Measure:     mNumTracesOnDisk,
+			Description: mNumTracesOnDisk.Description(),
+			Aggregation: view.LastValue(),
+		},
+		{
+			Name:        processorhelper.BuildCustomMetricName(metadata.Type.String(), mDiskUsage.Name()),
+			Measure:     mDiskUsage,
+			Description: mDiskUsage.Description(),
+			Aggregation: view.LastValue(),
+		},
 		{
 			Name:        processorhelper.BuildCustomMetricName(metadata.Type.String(), mTracesEvicted.Name()),
 			Measure:     mTracesEvicted,
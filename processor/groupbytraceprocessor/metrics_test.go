@@ -14,6 +14,8 @@ func TestProcessorMetrics(t *testing.T) {
 		"processor_groupbytrace_conf_num_traces",
 		"processor_groupbytrace_num_events_in_queue",
 		"processor_groupbytrace_num_traces_in_memory",
+		"processor_groupbytrace_num_traces_on_disk",
+		"processor_groupbytrace_disk_usage",
 		"processor_groupbytrace_traces_evicted",
 		"processor_groupbytrace_spans_released",
 		"processor_groupbytrace_traces_released",
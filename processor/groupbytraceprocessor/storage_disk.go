@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/groupbytraceprocessor"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"go.opencensus.io/stats"
+	"go.opentelemetry.io/collector/component"
+	extstorage "go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+var errDiskWatermarkReached = errors.New("the disk watermark has been reached, the spans of the trace are dropped")
+
+// diskTrace locates the spans of a trace in the storage extension, each batch of spans
+// received for the trace is stored as a chunk under its own key.
+type diskTrace struct {
+	chunks int
+	size   int64
+}
+
+// diskStorage keeps the traces in memory up to the memory watermark, and stores the spans
+// of the following traces in a storage extension, so that long wait durations don't require
+// to hold all the spans in memory.
+type diskStorage struct {
+	sync.Mutex
+	memory          *memoryStorage
+	componentID     component.ID
+	storageID       component.ID
+	client          extstorage.Client
+	traces          map[pcommon.TraceID]*diskTrace
+	size            int64
+	memoryWatermark int
+	diskWatermark   int64
+	marshaler       ptrace.ProtoMarshaler
+	unmarshaler     ptrace.ProtoUnmarshaler
+}
+
+var _ storage = (*diskStorage)(nil)
+
+// newDiskStorage returns a storage keeping up to memoryWatermark traces in memory, and up to
+// diskWatermark bytes of spans in the storage extension, zero meaning no limit.
+func newDiskStorage(componentID, storageID component.ID, memoryWatermark int, diskWatermark int64) *diskStorage {
+	return &diskStorage{
+		memory:          newMemoryStorage(),
+		componentID:     componentID,
+		storageID:       storageID,
+		traces:          make(map[pcommon.TraceID]*diskTrace),
+		memoryWatermark: memoryWatermark,
+		diskWatermark:   diskWatermark,
+	}
+}
+
+func (st *diskStorage) createOrAppend(traceID pcommon.TraceID, td ptrace.Traces) error {
+	st.Lock()
+	defer st.Unlock()
+
+	trace, onDisk := st.traces[traceID]
+	if !onDisk {
+		if st.memory.contains(traceID) || st.memory.count() < st.memoryWatermark {
+			return st.memory.createOrAppend(traceID, td)
+		}
+		trace = &diskTrace{}
+	}
+
+	chunk, err := st.marshaler.MarshalTraces(td)
+	if err != nil {
+		return err
+	}
+	if st.diskWatermark > 0 && st.size+int64(len(chunk)) > st.diskWatermark {
+		return errDiskWatermarkReached
+	}
+	if err = st.client.Set(context.Background(), chunkKey(traceID, trace.chunks), chunk); err != nil {
+		return err
+	}
+
+	trace.chunks++
+	trace.size += int64(len(chunk))
+	st.traces[traceID] = trace
+	st.size += int64(len(chunk))
+	st.recordMetrics()
+	return nil
+}
+
+func (st *diskStorage) get(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	trace, ok := st.traces[traceID]
+	if !ok {
+		return st.memory.get(traceID)
+	}
+	return st.read(traceID, trace)
+}
+
+func (st *diskStorage) delete(traceID pcommon.TraceID) ([]ptrace.ResourceSpans, error) {
+	st.Lock()
+	defer st.Unlock()
+
+	trace, ok := st.traces[traceID]
+	if !ok {
+		return st.memory.delete(traceID)
+	}
+
+	rss, err := st.read(traceID, trace)
+	if err != nil {
+		return nil, err
+	}
+	if err = st.remove(traceID, trace); err != nil {
+		return nil, err
+	}
+	st.recordMetrics()
+	return rss, nil
+}
+
+func (st *diskStorage) start(ctx context.Context, host component.Host) error {
+	client, err := getStorageClient(ctx, host, st.storageID, st.componentID)
+	if err != nil {
+		return err
+	}
+	st.client = client
+	st.recordMetrics()
+	return st.memory.start(ctx, host)
+}
+
+// shutdown removes the traces that haven't been released from the storage extension,
+// as they can't be retrieved after a restart.
+func (st *diskStorage) shutdown() error {
+	st.Lock()
+	defer st.Unlock()
+
+	errs := st.memory.shutdown()
+	if st.client == nil {
+		return errs
+	}
+	for traceID, trace := range st.traces {
+		errs = errors.Join(errs, st.remove(traceID, trace))
+	}
+	return errors.Join(errs, st.client.Close(context.Background()))
+}
+
+// read returns the spans of a trace stored on disk, the storage's lock must be held.
+func (st *diskStorage) read(traceID pcommon.TraceID, trace *diskTrace) ([]ptrace.ResourceSpans, error) {
+	var rss []ptrace.ResourceSpans
+	for i := 0; i < trace.chunks; i++ {
+		chunk, err := st.client.Get(context.Background(), chunkKey(traceID, i))
+		if err != nil {
+			return nil, err
+		}
+		if chunk == nil {
+			return nil, fmt.Errorf("chunk %d of the trace %q is missing from the storage", i, traceID)
+		}
+		td, err := st.unmarshaler.UnmarshalTraces(chunk)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < td.ResourceSpans().Len(); j++ {
+			rss = append(rss, td.ResourceSpans().At(j))
+		}
+	}
+	return rss, nil
+}
+
+// remove deletes the chunks of a trace from the storage extension, the storage's lock must be held.
+func (st *diskStorage) remove(traceID pcommon.TraceID, trace *diskTrace) error {
+	ops := make([]extstorage.Operation, 0, trace.chunks)
+	for i := 0; i < trace.chunks; i++ {
+		ops = append(ops, extstorage.DeleteOperation(chunkKey(traceID, i)))
+	}
+	if err := st.client.Batch(context.Background(), ops...); err != nil {
+		return err
+	}
+	delete(st.traces, traceID)
+	st.size -= trace.size
+	return nil
+}
+
+func (st *diskStorage) recordMetrics() {
+	stats.Record(context.Background(),
+		mNumTracesOnDisk.M(int64(len(st.traces))),
+		mDiskUsage.M(st.size),
+	)
+}
+
+func chunkKey(traceID pcommon.TraceID, chunk int) string {
+	return traceID.String() + "/" + strconv.Itoa(chunk)
+}
+
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, componentID component.ID) (extstorage.Client, error) {
+	extension, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(extstorage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindProcessor, componentID, "")
+}
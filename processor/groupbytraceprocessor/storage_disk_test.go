@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package groupbytraceprocessor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
+)
+
+func newTestDiskStorage(t *testing.T, memoryWatermark int, diskWatermark int64) *diskStorage {
+	storageID := storagetest.NewStorageID("groupbytrace")
+	host := storagetest.NewStorageHost().WithExtension(storageID, storagetest.NewInMemoryStorageExtension("groupbytrace"))
+
+	st := newDiskStorage(component.MustNewID("groupbytrace"), storageID, memoryWatermark, diskWatermark)
+	require.NoError(t, st.start(context.Background(), host))
+	t.Cleanup(func() {
+		assert.NoError(t, st.shutdown())
+	})
+	return st
+}
+
+func newTestTrace(traceID pcommon.TraceID, name string) ptrace.Traces {
+	trace := ptrace.NewTraces()
+	span := trace.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetTraceID(traceID)
+	span.SetName(name)
+	return trace
+}
+
+func TestDiskStorageWatermarks(t *testing.T) {
+	// prepare
+	st := newTestDiskStorage(t, 1, 0)
+
+	inMemory := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	onDisk := pcommon.TraceID([16]byte{2, 3, 4, 5})
+
+	// test
+	require.NoError(t, st.createOrAppend(inMemory, newTestTrace(inMemory, "first")))
+	require.NoError(t, st.createOrAppend(onDisk, newTestTrace(onDisk, "first")))
+	require.NoError(t, st.createOrAppend(inMemory, newTestTrace(inMemory, "second")))
+	require.NoError(t, st.createOrAppend(onDisk, newTestTrace(onDisk, "second")))
+
+	// verify
+	assert.Equal(t, 1, st.memory.count())
+	require.Contains(t, st.traces, onDisk)
+	assert.Equal(t, 2, st.traces[onDisk].chunks)
+	assert.Equal(t, st.traces[onDisk].size, st.size)
+
+	for _, traceID := range []pcommon.TraceID{inMemory, onDisk} {
+		retrieved, err := st.get(traceID)
+		require.NoError(t, err)
+		require.Len(t, retrieved, 2)
+		assert.Equal(t, "first", retrieved[0].ScopeSpans().At(0).Spans().At(0).Name())
+		assert.Equal(t, "second", retrieved[1].ScopeSpans().At(0).Spans().At(0).Name())
+	}
+}
+
+func TestDiskStorageDeleteTrace(t *testing.T) {
+	// prepare
+	st := newTestDiskStorage(t, 0, 0)
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, st.createOrAppend(traceID, newTestTrace(traceID, "span")))
+	require.Contains(t, st.traces, traceID)
+
+	// test
+	deleted, err := st.delete(traceID)
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "span", deleted[0].ScopeSpans().At(0).Spans().At(0).Name())
+	assert.Empty(t, st.traces)
+	assert.Zero(t, st.size)
+
+	retrieved, err := st.get(traceID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+
+	chunk, err := st.client.Get(context.Background(), chunkKey(traceID, 0))
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+}
+
+func TestDiskStorageDiskWatermark(t *testing.T) {
+	// prepare
+	trace := newTestTrace(pcommon.TraceID([16]byte{1}), "span")
+	chunk, err := (&ptrace.ProtoMarshaler{}).MarshalTraces(trace)
+	require.NoError(t, err)
+
+	// only one chunk fits on disk
+	st := newTestDiskStorage(t, 0, int64(len(chunk)))
+
+	first := pcommon.TraceID([16]byte{1})
+	second := pcommon.TraceID([16]byte{2})
+
+	// test
+	require.NoError(t, st.createOrAppend(first, newTestTrace(first, "span")))
+	err = st.createOrAppend(second, newTestTrace(second, "span"))
+
+	// verify
+	assert.ErrorIs(t, err, errDiskWatermarkReached)
+	assert.NotContains(t, st.traces, second)
+
+	// the space is available again once the first trace is deleted
+	_, err = st.delete(first)
+	require.NoError(t, err)
+	assert.NoError(t, st.createOrAppend(second, newTestTrace(second, "span")))
+}
+
+func TestDiskStorageShutdownRemovesTraces(t *testing.T) {
+	// prepare
+	storageID := storagetest.NewStorageID("groupbytrace")
+	ext := storagetest.NewFileBackedStorageExtension("groupbytrace", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+	componentID := component.MustNewID("groupbytrace")
+
+	st := newDiskStorage(componentID, storageID, 0, 0)
+	require.NoError(t, st.start(context.Background(), host))
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+	require.NoError(t, st.createOrAppend(traceID, newTestTrace(traceID, "span")))
+
+	// test
+	require.NoError(t, st.shutdown())
+
+	// verify
+	client, err := ext.GetClient(context.Background(), component.KindProcessor, componentID, "")
+	require.NoError(t, err)
+	defer client.Close(context.Background())
+	chunk, err := client.Get(context.Background(), chunkKey(traceID, 0))
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+}
+
+func TestDiskStorageMissingExtension(t *testing.T) {
+	st := newDiskStorage(component.MustNewID("groupbytrace"), storagetest.NewStorageID("missing"), 0, 0)
+	assert.ErrorContains(t, st.start(context.Background(), componenttest.NewNopHost()), "storage extension 'test_storage/missing' not found")
+	assert.NoError(t, st.shutdown())
+}
+
+func TestDiskStorageProcessor(t *testing.T) {
+	// prepare
+	storageID := storagetest.NewStorageID("groupbytrace")
+	host := storagetest.NewStorageHost().WithInMemoryStorageExtension("groupbytrace")
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.WaitDuration = time.Millisecond
+	cfg.StoreOnDisk = true
+	cfg.Storage = &storageID
+	cfg.MemoryWatermark = 0
+
+	received := make(chan ptrace.Traces, 1)
+	next := &mockProcessor{
+		onTraces: func(_ context.Context, td ptrace.Traces) error {
+			received <- td
+			return nil
+		},
+	}
+
+	p, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, next)
+	require.NoError(t, err)
+	require.NoError(t, p.Start(context.Background(), host))
+	defer func() {
+		assert.NoError(t, p.Shutdown(context.Background()))
+	}()
+
+	traceID := pcommon.TraceID([16]byte{1, 2, 3, 4})
+
+	// test
+	require.NoError(t, p.ConsumeTraces(context.Background(), newTestTrace(traceID, "span")))
+
+	// verify
+	var td ptrace.Traces
+	select {
+	case td = <-received:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the trace was not released")
+	}
+	assert.Equal(t, 1, td.SpanCount())
+	assert.Equal(t, "span", td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+}
@@ -5,13 +5,21 @@ package intervalprocessor // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 var (
 	ErrInvalidIntervalValue = errors.New("invalid interval value")
+	ErrMissingRuleMatcher   = errors.New("either metric_names or conditions must be set")
+	ErrPassthroughInterval  = errors.New("interval must not be set on passthrough rules")
 )
 
 var _ component.Config = (*Config)(nil)
@@ -20,6 +28,27 @@ var _ component.Config = (*Config)(nil)
 type Config struct {
 	// Interval is the time
 	Interval time.Duration `mapstructure:"interval"`
+
+	// Rules assigns the metrics to aggregation intervals, the first matching rule applies.
+	// If rules are set, the metrics not matching any rule are passed through untouched.
+	// Otherwise, all the metrics are aggregated over Interval.
+	Rules []Rule `mapstructure:"rules"`
+}
+
+// Rule defines the aggregation interval of the metrics it matches.
+type Rule struct {
+	// MetricNames is a list of regular expressions, a metric matches if its name matches any of them.
+	MetricNames []string `mapstructure:"metric_names"`
+
+	// Conditions is a list of OTTL conditions in the metric context, a metric matches if any of them is true.
+	Conditions []string `mapstructure:"conditions"`
+
+	// Interval is the interval over which the matching metrics are aggregated. Defaults to the
+	// interval of the processor.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// Passthrough passes the matching metrics through untouched, instead of aggregating them.
+	Passthrough bool `mapstructure:"passthrough"`
 }
 
 // Validate checks whether the input configuration has all of the required fields for the processor.
@@ -29,5 +58,35 @@ func (config *Config) Validate() error {
 		return ErrInvalidIntervalValue
 	}
 
+	for i, rule := range config.Rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+
 	return nil
 }
+
+func (rule *Rule) validate() error {
+	if len(rule.MetricNames) == 0 && len(rule.Conditions) == 0 {
+		return ErrMissingRuleMatcher
+	}
+	if rule.Interval < 0 {
+		return ErrInvalidIntervalValue
+	}
+	if rule.Passthrough && rule.Interval != 0 {
+		return ErrPassthroughInterval
+	}
+
+	var errs error
+	for _, name := range rule.MetricNames {
+		if _, err := regexp.Compile(name); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid metric name pattern %q: %w", name, err))
+		}
+	}
+	if len(rule.Conditions) > 0 {
+		_, err := filterottl.NewBoolExprForMetric(rule.Conditions, filterottl.StandardMetricFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
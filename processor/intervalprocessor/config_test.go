@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intervalprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       []Rule
+		expectedErr string
+	}{
+		{
+			name:  "valid rules",
+			rules: []Rule{{MetricNames: []string{`^http\.`}, Interval: time.Second}, {Conditions: []string{`name == "a"`}, Passthrough: true}},
+		},
+		{
+			name:        "missing matcher",
+			rules:       []Rule{{Interval: time.Second}},
+			expectedErr: "rule 0: either metric_names or conditions must be set",
+		},
+		{
+			name:        "negative interval",
+			rules:       []Rule{{MetricNames: []string{"a"}, Interval: -time.Second}},
+			expectedErr: "rule 0: invalid interval value",
+		},
+		{
+			name:        "passthrough with interval",
+			rules:       []Rule{{MetricNames: []string{"a"}}, {MetricNames: []string{"b"}, Interval: time.Second, Passthrough: true}},
+			expectedErr: "rule 1: interval must not be set on passthrough rules",
+		},
+		{
+			name:        "invalid metric name pattern",
+			rules:       []Rule{{MetricNames: []string{"["}}},
+			expectedErr: "rule 0: invalid metric name pattern \"[\": error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name:        "invalid condition",
+			rules:       []Rule{{Conditions: []string{"name =="}}},
+			expectedErr: "rule 0: unable to parse OTTL condition",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Interval: time.Minute, Rules: tt.rules}
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+
+	assert.ErrorIs(t, (&Config{}).Validate(), ErrInvalidIntervalValue)
+}
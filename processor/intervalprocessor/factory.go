@@ -35,5 +35,5 @@ func createMetricsProcessor(_ context.Context, set processor.CreateSettings, cfg
 		return nil, fmt.Errorf("configuration parsing error")
 	}
 
-	return newProcessor(processorConfig, set.Logger, nextConsumer), nil
+	return newProcessor(processorConfig, set.TelemetrySettings, nextConsumer)
 }
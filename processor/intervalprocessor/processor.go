@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sync"
 	"time"
 
@@ -17,6 +18,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/exp/metrics/identity"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/intervalprocessor/internal/metrics"
 )
 
@@ -29,6 +34,17 @@ type Processor struct {
 
 	stateLock sync.Mutex
 
+	// partitions holds the metrics aggregated over each interval.
+	partitions []*partition
+	rules      []rule
+
+	nextConsumer consumer.Metrics
+}
+
+// partition holds the state of the metrics aggregated over the same interval.
+type partition struct {
+	exportInterval time.Duration
+
 	md                 pmetric.Metrics
 	rmLookup           map[identity.Resource]pmetric.ResourceMetrics
 	smLookup           map[identity.Scope]pmetric.ScopeMetrics
@@ -36,22 +52,78 @@ type Processor struct {
 	numberLookup       map[identity.Stream]pmetric.NumberDataPoint
 	histogramLookup    map[identity.Stream]pmetric.HistogramDataPoint
 	expHistogramLookup map[identity.Stream]pmetric.ExponentialHistogramDataPoint
+}
 
-	exportInterval time.Duration
-
-	nextConsumer consumer.Metrics
+// rule is a compiled Rule, whose matching metrics are aggregated in its partition,
+// or passed through if it has none.
+type rule struct {
+	names      []*regexp.Regexp
+	conditions expr.BoolExpr[ottlmetric.TransformContext]
+	partition  *partition
 }
 
-func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics) *Processor {
+func newProcessor(config *Config, set component.TelemetrySettings, nextConsumer consumer.Metrics) (*Processor, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &Processor{
+	p := &Processor{
 		ctx:    ctx,
 		cancel: cancel,
-		logger: log,
+		logger: set.Logger,
 
 		stateLock: sync.Mutex{},
 
+		nextConsumer: nextConsumer,
+	}
+
+	// the rules aggregating over the same interval share a partition
+	partitions := map[time.Duration]*partition{}
+	partitionOf := func(interval time.Duration) *partition {
+		if interval == 0 {
+			interval = config.Interval
+		}
+		part, ok := partitions[interval]
+		if !ok {
+			part = newPartition(interval)
+			partitions[interval] = part
+			p.partitions = append(p.partitions, part)
+		}
+		return part
+	}
+
+	if len(config.Rules) == 0 {
+		partitionOf(config.Interval)
+	}
+	for _, r := range config.Rules {
+		var compiled rule
+		for _, name := range r.MetricNames {
+			re, err := regexp.Compile(name)
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			compiled.names = append(compiled.names, re)
+		}
+		if len(r.Conditions) > 0 {
+			conditions, err := filterottl.NewBoolExprForMetric(r.Conditions, filterottl.StandardMetricFuncs(), ottl.IgnoreError, set)
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			compiled.conditions = conditions
+		}
+		if !r.Passthrough {
+			compiled.partition = partitionOf(r.Interval)
+		}
+		p.rules = append(p.rules, compiled)
+	}
+
+	return p, nil
+}
+
+func newPartition(interval time.Duration) *partition {
+	return &partition{
+		exportInterval: interval,
+
 		md:                 pmetric.NewMetrics(),
 		rmLookup:           map[identity.Resource]pmetric.ResourceMetrics{},
 		smLookup:           map[identity.Scope]pmetric.ScopeMetrics{},
@@ -59,26 +131,24 @@ func newProcessor(config *Config, log *zap.Logger, nextConsumer consumer.Metrics
 		numberLookup:       map[identity.Stream]pmetric.NumberDataPoint{},
 		histogramLookup:    map[identity.Stream]pmetric.HistogramDataPoint{},
 		expHistogramLookup: map[identity.Stream]pmetric.ExponentialHistogramDataPoint{},
-
-		exportInterval: config.Interval,
-
-		nextConsumer: nextConsumer,
 	}
 }
 
 func (p *Processor) Start(_ context.Context, _ component.Host) error {
-	exportTicker := time.NewTicker(p.exportInterval)
-	go func() {
-		for {
-			select {
-			case <-p.ctx.Done():
-				exportTicker.Stop()
-				return
-			case <-exportTicker.C:
-				p.exportMetrics()
+	for _, part := range p.partitions {
+		exportTicker := time.NewTicker(part.exportInterval)
+		go func(part *partition) {
+			for {
+				select {
+				case <-p.ctx.Done():
+					exportTicker.Stop()
+					return
+				case <-exportTicker.C:
+					p.exportPartition(part)
+				}
 			}
-		}
-	}()
+		}(part)
+	}
 
 	return nil
 }
@@ -115,40 +185,39 @@ func (p *Processor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) erro
 					if sum.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
 						return false
 					}
-
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					cloneSum := mClone.Sum()
-
-					aggregateDataPoints(sum.DataPoints(), cloneSum.DataPoints(), metricID, p.numberLookup)
-					return true
 				case pmetric.MetricTypeHistogram:
-					histogram := m.Histogram()
-
-					if histogram.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+					if m.Histogram().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
 						return false
 					}
-
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					cloneHistogram := mClone.Histogram()
-
-					aggregateDataPoints(histogram.DataPoints(), cloneHistogram.DataPoints(), metricID, p.histogramLookup)
-					return true
 				case pmetric.MetricTypeExponentialHistogram:
-					expHistogram := m.ExponentialHistogram()
-
-					if expHistogram.AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
+					if m.ExponentialHistogram().AggregationTemporality() != pmetric.AggregationTemporalityCumulative {
 						return false
 					}
-
-					mClone, metricID := p.getOrCloneMetric(rm, sm, m)
-					cloneExpHistogram := mClone.ExponentialHistogram()
-
-					aggregateDataPoints(expHistogram.DataPoints(), cloneExpHistogram.DataPoints(), metricID, p.expHistogramLookup)
-					return true
 				default:
 					errs = errors.Join(fmt.Errorf("invalid MetricType %d", m.Type()))
 					return false
 				}
+
+				part, err := p.partitionOf(ctx, rm, sm, m)
+				if err != nil {
+					errs = errors.Join(errs, err)
+					return false
+				}
+				if part == nil {
+					// passed through, either by a passthrough rule or by default
+					return false
+				}
+
+				mClone, metricID := part.getOrCloneMetric(rm, sm, m)
+				switch m.Type() {
+				case pmetric.MetricTypeSum:
+					aggregateDataPoints(m.Sum().DataPoints(), mClone.Sum().DataPoints(), metricID, part.numberLookup)
+				case pmetric.MetricTypeHistogram:
+					aggregateDataPoints(m.Histogram().DataPoints(), mClone.Histogram().DataPoints(), metricID, part.histogramLookup)
+				case pmetric.MetricTypeExponentialHistogram:
+					aggregateDataPoints(m.ExponentialHistogram().DataPoints(), mClone.ExponentialHistogram().DataPoints(), metricID, part.expHistogramLookup)
+				}
+				return true
 			})
 			return sm.Metrics().Len() == 0
 		})
@@ -162,6 +231,36 @@ func (p *Processor) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) erro
 	return errs
 }
 
+// partitionOf returns the partition aggregating the metric, or nil if it is passed through.
+func (p *Processor) partitionOf(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (*partition, error) {
+	if len(p.rules) == 0 {
+		return p.partitions[0], nil
+	}
+
+	for _, r := range p.rules {
+		match, err := r.matches(ctx, rm, sm, m)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			return r.partition, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r rule) matches(ctx context.Context, rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (bool, error) {
+	for _, name := range r.names {
+		if name.MatchString(m.Name()) {
+			return true, nil
+		}
+	}
+	if r.conditions == nil {
+		return false, nil
+	}
+	return r.conditions.Eval(ctx, ottlmetric.NewTransformContext(m, sm.Metrics(), sm.Scope(), rm.Resource()))
+}
+
 func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP]](dataPoints DPS, mCloneDataPoints DPS, metricID identity.Metric, dpLookup map[identity.Stream]DP) {
 	for i := 0; i < dataPoints.Len(); i++ {
 		dp := dataPoints.At(i)
@@ -185,23 +284,30 @@ func aggregateDataPoints[DPS metrics.DataPointSlice[DP], DP metrics.DataPoint[DP
 	}
 }
 
+// exportMetrics exports the metrics aggregated in all the partitions.
 func (p *Processor) exportMetrics() {
+	for _, part := range p.partitions {
+		p.exportPartition(part)
+	}
+}
+
+func (p *Processor) exportPartition(part *partition) {
 	md := func() pmetric.Metrics {
 		p.stateLock.Lock()
 		defer p.stateLock.Unlock()
 
 		// ConsumeMetrics() has prepared our own pmetric.Metrics instance ready for us to use
 		// Take it and clear replace it with a new empty one
-		out := p.md
-		p.md = pmetric.NewMetrics()
+		out := part.md
+		part.md = pmetric.NewMetrics()
 
 		// Clear all the lookup references
-		clear(p.rmLookup)
-		clear(p.smLookup)
-		clear(p.mLookup)
-		clear(p.numberLookup)
-		clear(p.histogramLookup)
-		clear(p.expHistogramLookup)
+		clear(part.rmLookup)
+		clear(part.smLookup)
+		clear(part.mLookup)
+		clear(part.numberLookup)
+		clear(part.histogramLookup)
+		clear(part.expHistogramLookup)
 
 		return out
 	}()
@@ -211,7 +317,7 @@ func (p *Processor) exportMetrics() {
 	}
 }
 
-func (p *Processor) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (pmetric.Metric, identity.Metric) {
+func (p *partition) getOrCloneMetric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, m pmetric.Metric) (pmetric.Metric, identity.Metric) {
 	// Find the ResourceMetrics
 	resID := identity.OfResource(rm.Resource())
 	rmClone, ok := p.rmLookup[resID]
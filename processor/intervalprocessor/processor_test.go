@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -69,12 +70,14 @@ func TestAggregation(t *testing.T) {
 			processor.exportMetrics()
 
 			// All the lookup tables should now be empty
-			require.Empty(t, processor.rmLookup)
-			require.Empty(t, processor.smLookup)
-			require.Empty(t, processor.mLookup)
-			require.Empty(t, processor.numberLookup)
-			require.Empty(t, processor.histogramLookup)
-			require.Empty(t, processor.expHistogramLookup)
+			require.Len(t, processor.partitions, 1)
+			part := processor.partitions[0]
+			require.Empty(t, part.rmLookup)
+			require.Empty(t, part.smLookup)
+			require.Empty(t, part.mLookup)
+			require.Empty(t, part.numberLookup)
+			require.Empty(t, part.histogramLookup)
+			require.Empty(t, part.expHistogramLookup)
 
 			// Exporting again should return nothing
 			processor.exportMetrics()
@@ -102,3 +105,61 @@ func TestAggregation(t *testing.T) {
 		})
 	}
 }
+
+func TestRules(t *testing.T) {
+	config := &Config{
+		Interval: time.Minute,
+		Rules: []Rule{
+			{MetricNames: []string{`^fast\.`}, Interval: time.Second},
+			{Conditions: []string{`name == "slow.requests"`}},
+			{MetricNames: []string{`^debug\.`}, Passthrough: true},
+			{MetricNames: []string{`\.errors$`}, Interval: time.Second},
+		},
+	}
+	require.NoError(t, config.Validate())
+
+	next := &consumertest.MetricsSink{}
+	mgp, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), config, next)
+	require.NoError(t, err)
+	processor := mgp.(*Processor)
+
+	// the rules aggregating over the same interval share a partition
+	require.Len(t, processor.partitions, 2)
+	assert.Equal(t, time.Second, processor.partitions[0].exportInterval)
+	assert.Equal(t, time.Minute, processor.partitions[1].exportInterval)
+
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	for _, name := range []string{"fast.requests", "slow.requests", "debug.requests", "other.requests", "slow.errors"} {
+		m := ms.AppendEmpty()
+		m.SetName(name)
+		sum := m.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		sum.DataPoints().AppendEmpty().SetIntValue(1)
+	}
+	require.NoError(t, mgp.ConsumeMetrics(context.Background(), md))
+
+	// the metrics not matching any rule, or matching a passthrough rule, are passed through
+	processor.exportPartition(processor.partitions[0])
+	processor.exportPartition(processor.partitions[1])
+	allMetrics := next.AllMetrics()
+	require.Len(t, allMetrics, 3)
+	assert.Equal(t, []string{"debug.requests", "other.requests"}, metricNames(allMetrics[0]))
+	assert.Equal(t, []string{"fast.requests", "slow.errors"}, metricNames(allMetrics[1]))
+	assert.Equal(t, []string{"slow.requests"}, metricNames(allMetrics[2]))
+}
+
+func metricNames(md pmetric.Metrics) []string {
+	var names []string
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				names = append(names, ms.At(k).Name())
+			}
+		}
+	}
+	return names
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 
+	"go.opentelemetry.io/collector/component"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -38,6 +39,11 @@ type Config struct {
 	// Exclude section allows to define names of pod that should be
 	// ignored while tagging.
 	Exclude ExcludeConfig `mapstructure:"exclude"`
+
+	// InformerExtension is the ID of a k8s_informer extension whose shared informers are used
+	// instead of opening list/watch connections of the processor's own. The API settings of the
+	// processor are ignored when it is set.
+	InformerExtension *component.ID `mapstructure:"informer_extension"`
 }
 
 func (cfg *Config) Validate() error {
@@ -81,6 +87,12 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for _, condition := range cfg.Extract.NodeConditions {
+		if condition == "" {
+			return fmt.Errorf("node_conditions cannot contain an empty condition type")
+		}
+	}
+
 	for _, field := range cfg.Extract.Metadata {
 		switch field {
 		case conventions.AttributeK8SNamespaceName, conventions.AttributeK8SPodName, conventions.AttributeK8SPodUID,
@@ -94,7 +106,7 @@ func (cfg *Config) Validate() error {
 			conventions.AttributeK8SNodeName, conventions.AttributeK8SNodeUID,
 			conventions.AttributeK8SContainerName, conventions.AttributeContainerID,
 			conventions.AttributeContainerImageName, conventions.AttributeContainerImageTag,
-			clusterUID:
+			clusterUID, metadataProjectDisplayName, metadataProjectRequester, metadataPodSCC, metadataRouteHost:
 		default:
 			return fmt.Errorf("\"%s\" is not a supported metadata field", field)
 		}
@@ -135,6 +147,8 @@ type ExtractConfig struct {
 	//   k8s.container.name, container.image.name,
 	//   container.image.tag, container.id
 	//   k8s.cluster.uid
+	//   openshift.project.display_name, openshift.project.requester,
+	//   openshift.pod.scc, openshift.route.host
 	//
 	// Specifying anything other than these values will result in an error.
 	// By default, the following fields are extracted and added to spans, metrics and logs as resource attributes:
@@ -160,6 +174,11 @@ type ExtractConfig struct {
 	// It is a list of FieldExtractConfig type. See FieldExtractConfig
 	// documentation for more details.
 	Labels []FieldExtractConfig `mapstructure:"labels"`
+
+	// NodeConditions allows extracting the status of node conditions, e.g. Ready or MemoryPressure,
+	// and record them as resource attributes named k8s.node.condition.<condition type in snake case>.
+	// The value of the attribute is the status of the condition: true, false or unknown.
+	NodeConditions []string `mapstructure:"node_conditions"`
 }
 
 // FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace)
@@ -186,12 +205,15 @@ type FieldExtractConfig struct {
 	//       key_regex: kubernetes.io/(.*)
 	//
 	// this will add the `component` and `version` tags to the spans or metrics.
+	// If the expanded tag name is empty, the default tag name is used.
 	TagName string `mapstructure:"tag_name"`
 
 	// Key represents the annotation (or label) name. This must exactly match an annotation (or label) name.
 	Key string `mapstructure:"key"`
 	// KeyRegex is a regular expression used to extract a Key that matches the regex.
 	// Out of Key or KeyRegex, only one option is expected to be configured at a time.
+	// Regex, when set, is applied to the value of every matching key, and keys whose
+	// extracted value is empty are skipped.
 	KeyRegex string `mapstructure:"key_regex"`
 
 	// Regex is an optional field used to extract a sub-string from a complex field value.
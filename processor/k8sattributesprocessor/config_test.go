@@ -20,6 +20,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	informerID := component.MustNewID("k8s_informer")
+
 	tests := []struct {
 		id       component.ID
 		expected component.Config
@@ -49,6 +51,7 @@ func TestLoadConfig(t *testing.T) {
 						{TagName: "l1", Key: "label1", From: "pod"},
 						{TagName: "l2", Key: "label2", Regex: "field=(?P<value>.+)", From: kube.MetadataFromPod},
 					},
+					NodeConditions: []string{"Ready", "MemoryPressure"},
 				},
 				Filter: FilterConfig{
 					Namespace:      "ns2",
@@ -127,6 +130,17 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "informer_extension"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Exclude:   ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}},
+				Extract: ExtractConfig{
+					Metadata: enabledAttributes(),
+				},
+				InformerExtension: &informerID,
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "too_many_sources"),
 		},
@@ -172,6 +186,9 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_filter_field_op"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_node_conditions"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,6 +5,8 @@ go 1.21.0
 require (
 	github.com/google/go-cmp v0.6.0
 	github.com/google/uuid v1.6.0
+	github.com/iancoleman/strcase v0.3.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension v0.102.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig v0.102.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8stest v0.102.0
 	github.com/stretchr/testify v1.9.0
@@ -124,6 +126,8 @@ require (
 	sigs.k8s.io/yaml v1.3.0 // indirect
 )
 
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension => ../../extension/k8sinformerextension
+
 replace github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig => ./../../internal/k8sconfig
 
 // openshift removed all tags from their repo, use the pseudoversion from the release-3.9 branch HEAD
@@ -6,16 +6,19 @@ package kube // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/iancoleman/strcase"
 	"go.opentelemetry.io/collector/featuregate"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/zap"
 	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -44,11 +47,19 @@ type WatchClient struct {
 	namespaceInformer  cache.SharedInformer
 	nodeInformer       cache.SharedInformer
 	replicasetInformer cache.SharedInformer
+	serviceInformer    cache.SharedInformer
+	routeInformer      cache.SharedInformer
 	replicasetRegex    *regexp.Regexp
 	cronJobRegex       *regexp.Regexp
 	deleteQueue        []deleteRequest
 	stopCh             chan struct{}
 
+	// sharedInformers is set when the informers are shared with other components, in which case
+	// the client neither transforms, runs nor stops them, and removes its handlers on Stop.
+	sharedInformers bool
+	registrationsMu sync.Mutex
+	registrations   map[cache.SharedInformer]cache.ResourceEventHandlerRegistration
+
 	// A map containing Pod related data, used to associate them with resources.
 	// Key can be either an IP address or Pod UID
 	Pods         map[PodIdentifier]*Pod
@@ -68,6 +79,11 @@ type WatchClient struct {
 	// A map containing ReplicaSets related data, used to associate them with resources.
 	// Key is replicaset uid
 	ReplicaSets map[string]*ReplicaSet
+
+	// Maps containing the Services and OpenShift Routes used to find the routes backed by a pod.
+	// Key is namespace/name
+	Services map[string]*Service
+	Routes   map[string]*Route
 }
 
 // Extract replicaset name from the pod name. Pod name is created using
@@ -80,22 +96,7 @@ var cronJobRegex = regexp.MustCompile(`^(.*)-[0-9]+$`)
 
 // New initializes a new k8s Client.
 func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newClientSet APIClientsetProvider, newInformer InformerProvider, newNamespaceInformer InformerProviderNamespace, newReplicaSetInformer InformerProviderReplicaSet) (Client, error) {
-	c := &WatchClient{
-		logger:          logger,
-		Rules:           rules,
-		Filters:         filters,
-		Associations:    associations,
-		Exclude:         exclude,
-		replicasetRegex: rRegex,
-		cronJobRegex:    cronJobRegex,
-		stopCh:          make(chan struct{}),
-	}
-	go c.deleteLoop(time.Second*30, defaultPodDeleteGracePeriod)
-
-	c.Pods = map[PodIdentifier]*Pod{}
-	c.Namespaces = map[string]*Namespace{}
-	c.Nodes = map[string]*Node{}
-	c.ReplicaSets = map[string]*ReplicaSet{}
+	c := newWatchClient(logger, rules, filters, associations, exclude)
 	if newClientSet == nil {
 		newClientSet = k8sconfig.MakeClient
 	}
@@ -171,63 +172,190 @@ func New(logger *zap.Logger, apiCfg k8sconfig.APIConfig, rules ExtractionRules,
 		}
 	}
 
-	if c.extractNodeLabelsAnnotations() || c.extractNodeUID() {
+	if c.extractNodeLabelsAnnotations() || c.extractNodeUID() || len(c.Rules.NodeConditions) > 0 {
 		c.nodeInformer = k8sconfig.NewNodeSharedInformer(c.kc, c.Filters.Node, 5*time.Minute)
 	}
 
+	if rules.RouteHost {
+		c.serviceInformer = newServiceSharedInformer(c.kc, c.Filters.Namespace)
+		c.routeInformer, err = newRouteSharedInformer(apiCfg, c.Filters.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return c, err
 }
 
+// NewWithSharedInformers initializes a new k8s Client watching the cluster through informers
+// shared with other components.
+func NewWithSharedInformers(logger *zap.Logger, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes, newInformer SharedInformerProvider) (Client, error) {
+	c := newWatchClient(logger, rules, filters, associations, exclude)
+	c.sharedInformers = true
+
+	labelSelector, fieldSelector, err := selectorsFromFilters(c.Filters)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(
+		"k8s filtering",
+		zap.String("labelSelector", labelSelector.String()),
+		zap.String("fieldSelector", fieldSelector.String()),
+	)
+
+	if c.informer, err = newInformer(podsGVR, c.Filters.Namespace, labelSelector, fieldSelector); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.extractNamespaceLabelsAnnotations():
+		c.namespaceInformer, err = newInformer(namespacesGVR, "", labels.Everything(), fields.Everything())
+	case rules.ClusterUID:
+		c.namespaceInformer, err = newInformer(namespacesGVR, "", labels.Everything(), fields.OneTermEqualSelector("metadata.name", kubeSystemNamespace))
+	default:
+		c.namespaceInformer = NewNoOpInformer(nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rules.DeploymentName || rules.DeploymentUID {
+		if c.replicasetInformer, err = newInformer(replicaSetsGVR, c.Filters.Namespace, labels.Everything(), fields.Everything()); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.extractNodeLabelsAnnotations() || c.extractNodeUID() || len(c.Rules.NodeConditions) > 0 {
+		nodeSelector := fields.Everything()
+		if c.Filters.Node != "" {
+			nodeSelector = fields.OneTermEqualSelector("metadata.name", c.Filters.Node)
+		}
+		if c.nodeInformer, err = newInformer(nodesGVR, "", labels.Everything(), nodeSelector); err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.RouteHost {
+		if c.serviceInformer, err = newInformer(servicesGVR, c.Filters.Namespace, labels.Everything(), fields.Everything()); err != nil {
+			return nil, err
+		}
+		if c.routeInformer, err = newInformer(routeGVR, c.Filters.Namespace, labels.Everything(), fields.Everything()); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func newWatchClient(logger *zap.Logger, rules ExtractionRules, filters Filters, associations []Association, exclude Excludes) *WatchClient {
+	c := &WatchClient{
+		logger:          logger,
+		Rules:           rules,
+		Filters:         filters,
+		Associations:    associations,
+		Exclude:         exclude,
+		replicasetRegex: rRegex,
+		cronJobRegex:    cronJobRegex,
+		stopCh:          make(chan struct{}),
+		registrations:   map[cache.SharedInformer]cache.ResourceEventHandlerRegistration{},
+	}
+	go c.deleteLoop(time.Second*30, defaultPodDeleteGracePeriod)
+
+	c.Pods = map[PodIdentifier]*Pod{}
+	c.Namespaces = map[string]*Namespace{}
+	c.Nodes = map[string]*Node{}
+	c.ReplicaSets = map[string]*ReplicaSet{}
+	c.Services = map[string]*Service{}
+	c.Routes = map[string]*Route{}
+	return c
+}
+
 // Start registers pod event handlers and starts watching the kubernetes cluster for pod changes.
 func (c *WatchClient) Start() {
-	_, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	c.watch(c.informer, "pod", cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handlePodAdd,
 		UpdateFunc: c.handlePodUpdate,
 		DeleteFunc: c.handlePodDelete,
 	})
-	if err != nil {
-		c.logger.Error("error adding event handler to pod informer", zap.Error(err))
-	}
-	go c.informer.Run(c.stopCh)
 
-	_, err = c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	c.watch(c.namespaceInformer, "namespace", cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleNamespaceAdd,
 		UpdateFunc: c.handleNamespaceUpdate,
 		DeleteFunc: c.handleNamespaceDelete,
 	})
-	if err != nil {
-		c.logger.Error("error adding event handler to namespace informer", zap.Error(err))
-	}
-	go c.namespaceInformer.Run(c.stopCh)
 
 	if c.Rules.DeploymentName || c.Rules.DeploymentUID {
-		_, err = c.replicasetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		c.watch(c.replicasetInformer, "replicaset", cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleReplicaSetAdd,
 			UpdateFunc: c.handleReplicaSetUpdate,
 			DeleteFunc: c.handleReplicaSetDelete,
 		})
-		if err != nil {
-			c.logger.Error("error adding event handler to replicaset informer", zap.Error(err))
-		}
-		go c.replicasetInformer.Run(c.stopCh)
 	}
 
 	if c.nodeInformer != nil {
-		_, err = c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		c.watch(c.nodeInformer, "node", cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleNodeAdd,
 			UpdateFunc: c.handleNodeUpdate,
 			DeleteFunc: c.handleNodeDelete,
 		})
-		if err != nil {
-			c.logger.Error("error adding event handler to node informer", zap.Error(err))
+	}
+
+	if c.routeInformer != nil {
+		c.watch(c.serviceInformer, "service", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleServiceAdd,
+			UpdateFunc: c.handleServiceUpdate,
+			DeleteFunc: c.handleServiceDelete,
+		})
+
+		c.watch(c.routeInformer, "route", cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleRouteAdd,
+			UpdateFunc: c.handleRouteUpdate,
+			DeleteFunc: c.handleRouteDelete,
+		})
+	}
+}
+
+// watch adds the handlers to the informer and runs it. Shared informers are run by their owner,
+// the handlers are removed from them on Stop instead.
+func (c *WatchClient) watch(informer cache.SharedInformer, resource string, handlers cache.ResourceEventHandlerFuncs) {
+	if !c.sharedInformers {
+		if _, err := informer.AddEventHandler(handlers); err != nil {
+			c.logger.Error("error adding event handler to "+resource+" informer", zap.Error(err))
 		}
-		go c.nodeInformer.Run(c.stopCh)
+		go informer.Run(c.stopCh)
+		return
+	}
+
+	c.registrationsMu.Lock()
+	defer c.registrationsMu.Unlock()
+	// The handlers aren't added once stopped, as they wouldn't be removed.
+	select {
+	case <-c.stopCh:
+		return
+	default:
+	}
+	registration, err := informer.AddEventHandler(handlers)
+	if err != nil {
+		c.logger.Error("error adding event handler to "+resource+" informer", zap.Error(err))
+		return
+	}
+	if registration != nil {
+		c.registrations[informer] = registration
 	}
 }
 
 // Stop signals the the k8s watcher/informer to stop watching for new events.
 func (c *WatchClient) Stop() {
+	c.registrationsMu.Lock()
+	defer c.registrationsMu.Unlock()
+
 	close(c.stopCh)
+	for informer, registration := range c.registrations {
+		if err := informer.RemoveEventHandler(registration); err != nil {
+			c.logger.Error("error removing event handler from shared informer", zap.Error(err))
+		}
+	}
+	c.registrations = nil
 }
 
 func (c *WatchClient) handlePodAdd(obj any) {
@@ -512,6 +640,18 @@ func (c *WatchClient) extractPodAttributes(pod *api_v1.Pod) map[string]string {
 		}
 	}
 
+	if c.Rules.PodSCC {
+		if scc, ok := pod.Annotations[podSCCAnnotation]; ok {
+			tags[tagPodSCC] = scc
+		}
+	}
+
+	if c.Rules.RouteHost {
+		if hosts := c.routeHosts(pod); hosts != "" {
+			tags[tagRouteHost] = hosts
+		}
+	}
+
 	for _, r := range c.Rules.Labels {
 		r.extractFromPodMetadata(pod.Labels, tags, "k8s.pod.labels.%s")
 	}
@@ -601,12 +741,15 @@ func removeUnnecessaryPodData(pod *api_v1.Pod, rules ExtractionRules) *api_v1.Po
 		}
 	}
 
-	if len(rules.Labels) > 0 {
+	// labels are needed to match the selectors of the services backing routes
+	if len(rules.Labels) > 0 || rules.RouteHost {
 		transformedPod.Labels = pod.Labels
 	}
 
 	if len(rules.Annotations) > 0 {
 		transformedPod.Annotations = pod.Annotations
+	} else if scc, ok := pod.Annotations[podSCCAnnotation]; ok && rules.PodSCC {
+		transformedPod.Annotations = map[string]string{podSCCAnnotation: scc}
 	}
 
 	if rules.IncludesOwnerMetadata() {
@@ -678,6 +821,18 @@ func (c *WatchClient) extractNamespaceAttributes(namespace *api_v1.Namespace) ma
 		r.extractFromNamespaceMetadata(namespace.Annotations, tags, "k8s.namespace.annotations.%s")
 	}
 
+	if c.Rules.ProjectDisplayName {
+		if displayName, ok := namespace.Annotations[projectDisplayNameAnnotation]; ok {
+			tags[tagProjectDisplayName] = displayName
+		}
+	}
+
+	if c.Rules.ProjectRequester {
+		if requester, ok := namespace.Annotations[projectRequesterAnnotation]; ok {
+			tags[tagProjectRequester] = requester
+		}
+	}
+
 	return tags
 }
 
@@ -692,9 +847,24 @@ func (c *WatchClient) extractNodeAttributes(node *api_v1.Node) map[string]string
 		r.extractFromNodeMetadata(node.Annotations, tags, "k8s.node.annotations.%s")
 	}
 
+	for _, conditionType := range c.Rules.NodeConditions {
+		for _, condition := range node.Status.Conditions {
+			if string(condition.Type) == conditionType {
+				tags[nodeConditionAttribute(conditionType)] = strings.ToLower(string(condition.Status))
+				break
+			}
+		}
+	}
+
 	return tags
 }
 
+// nodeConditionAttribute returns the name of the attribute holding the status of a node condition,
+// e.g. k8s.node.condition.memory_pressure for MemoryPressure.
+func nodeConditionAttribute(conditionType string) string {
+	return "k8s.node.condition." + strcase.ToSnake(conditionType)
+}
+
 func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 	newPod := &Pod{
 		Name:        pod.Name,
@@ -899,6 +1069,11 @@ func (c *WatchClient) addOrUpdateNamespace(namespace *api_v1.Namespace) {
 }
 
 func (c *WatchClient) extractNamespaceLabelsAnnotations() bool {
+	// the OpenShift project metadata is stored in namespace annotations
+	if c.Rules.ProjectDisplayName || c.Rules.ProjectRequester {
+		return true
+	}
+
 	for _, r := range c.Rules.Labels {
 		if r.From == MetadataFromNamespace {
 			return true
@@ -1032,6 +1207,159 @@ func (c *WatchClient) getReplicaSet(uid string) (*ReplicaSet, bool) {
 	return nil, false
 }
 
+func (c *WatchClient) handleServiceAdd(obj any) {
+	if service, ok := obj.(*api_v1.Service); ok {
+		c.addOrUpdateService(service)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Service", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleServiceUpdate(_, newService any) {
+	if service, ok := newService.(*api_v1.Service); ok {
+		c.addOrUpdateService(service)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Service", zap.Any("received", newService))
+	}
+}
+
+func (c *WatchClient) handleServiceDelete(obj any) {
+	if service, ok := ignoreDeletedFinalStateUnknown(obj).(*api_v1.Service); ok {
+		c.m.Lock()
+		delete(c.Services, service.Namespace+"/"+service.Name)
+		c.m.Unlock()
+		c.refreshRouteHosts(service.Namespace)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Service", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) addOrUpdateService(service *api_v1.Service) {
+	newService := &Service{
+		Name:      service.Name,
+		Namespace: service.Namespace,
+		Selector:  service.Spec.Selector,
+	}
+
+	c.m.Lock()
+	c.Services[service.Namespace+"/"+service.Name] = newService
+	c.m.Unlock()
+	c.refreshRouteHosts(service.Namespace)
+}
+
+func (c *WatchClient) handleRouteAdd(obj any) {
+	if route, ok := obj.(*unstructured.Unstructured); ok {
+		c.addOrUpdateRoute(route)
+	} else {
+		c.logger.Error("object received was not of type unstructured.Unstructured", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleRouteUpdate(_, newRoute any) {
+	if route, ok := newRoute.(*unstructured.Unstructured); ok {
+		c.addOrUpdateRoute(route)
+	} else {
+		c.logger.Error("object received was not of type unstructured.Unstructured", zap.Any("received", newRoute))
+	}
+}
+
+func (c *WatchClient) handleRouteDelete(obj any) {
+	if route, ok := ignoreDeletedFinalStateUnknown(obj).(*unstructured.Unstructured); ok {
+		c.m.Lock()
+		delete(c.Routes, route.GetNamespace()+"/"+route.GetName())
+		c.m.Unlock()
+		c.refreshRouteHosts(route.GetNamespace())
+	} else {
+		c.logger.Error("object received was not of type unstructured.Unstructured", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) addOrUpdateRoute(route *unstructured.Unstructured) {
+	newRoute := routeFromAPI(route)
+
+	c.m.Lock()
+	c.Routes[newRoute.Namespace+"/"+newRoute.Name] = newRoute
+	c.m.Unlock()
+	c.refreshRouteHosts(newRoute.Namespace)
+}
+
+// routeFromAPI extracts the host and the backend services of an OpenShift route.
+func routeFromAPI(route *unstructured.Unstructured) *Route {
+	newRoute := &Route{
+		Name:      route.GetName(),
+		Namespace: route.GetNamespace(),
+	}
+
+	newRoute.Host, _, _ = unstructured.NestedString(route.Object, "spec", "host")
+	if newRoute.Host == "" {
+		// the host generated by the router when none is requested is only reported in the status
+		ingresses, _, _ := unstructured.NestedSlice(route.Object, "status", "ingress")
+		for _, ingress := range ingresses {
+			if ingressMap, ok := ingress.(map[string]any); ok {
+				if host, _ := ingressMap["host"].(string); host != "" {
+					newRoute.Host = host
+					break
+				}
+			}
+		}
+	}
+
+	var backends []any
+	if to, ok, _ := unstructured.NestedMap(route.Object, "spec", "to"); ok {
+		backends = append(backends, to)
+	}
+	alternateBackends, _, _ := unstructured.NestedSlice(route.Object, "spec", "alternateBackends")
+	backends = append(backends, alternateBackends...)
+	for _, backend := range backends {
+		backendMap, ok := backend.(map[string]any)
+		if !ok {
+			continue
+		}
+		kind, _ := backendMap["kind"].(string)
+		name, _ := backendMap["name"].(string)
+		if (kind == "" || kind == "Service") && name != "" {
+			newRoute.Services = append(newRoute.Services, name)
+		}
+	}
+	return newRoute
+}
+
+// routeHosts returns the comma separated, sorted, hosts of the routes sending traffic to the services selecting the pod.
+func (c *WatchClient) routeHosts(pod *api_v1.Pod) string {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	hosts := map[string]struct{}{}
+	for _, route := range c.Routes {
+		if route.Namespace != pod.Namespace || route.Host == "" {
+			continue
+		}
+		for _, serviceName := range route.Services {
+			service, ok := c.Services[pod.Namespace+"/"+serviceName]
+			if ok && len(service.Selector) > 0 && labels.SelectorFromSet(service.Selector).Matches(labels.Set(pod.Labels)) {
+				hosts[route.Host] = struct{}{}
+				break
+			}
+		}
+	}
+
+	sortedHosts := make([]string, 0, len(hosts))
+	for host := range hosts {
+		sortedHosts = append(sortedHosts, host)
+	}
+	sort.Strings(sortedHosts)
+	return strings.Join(sortedHosts, ",")
+}
+
+// refreshRouteHosts extracts again the attributes of the pods of a namespace after one of its services or routes changed.
+func (c *WatchClient) refreshRouteHosts(namespace string) {
+	for _, obj := range c.informer.GetStore().List() {
+		if pod, ok := obj.(*api_v1.Pod); ok && pod.Namespace == namespace {
+			c.addOrUpdatePod(pod)
+		}
+	}
+}
+
 // ignoreDeletedFinalStateUnknown returns the object wrapped in
 // DeletedFinalStateUnknown. Useful in OnDelete resource event handlers that do
 // not need the additional context.
@@ -4,6 +4,7 @@
 package kube
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
@@ -17,6 +18,10 @@ import (
 	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -189,6 +194,76 @@ func TestClientStartStop(t *testing.T) {
 	assert.True(t, fctr.HasStopped())
 }
 
+// sharedInformer records the handlers added by the client, and fails the test if run.
+type sharedInformer struct {
+	*FakeInformer
+	t        *testing.T
+	handlers map[cache.ResourceEventHandlerRegistration]bool
+}
+
+type sharedRegistration struct{ id int }
+
+func (r *sharedRegistration) HasSynced() bool { return true }
+
+func (f *sharedInformer) AddEventHandler(_ cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	registration := &sharedRegistration{id: len(f.handlers)}
+	f.handlers[registration] = true
+	return registration, nil
+}
+
+func (f *sharedInformer) RemoveEventHandler(registration cache.ResourceEventHandlerRegistration) error {
+	delete(f.handlers, registration)
+	return nil
+}
+
+func (f *sharedInformer) Run(<-chan struct{}) {
+	f.t.Error("shared informers must not be run by the client")
+}
+
+func TestClientWithSharedInformers(t *testing.T) {
+	requested := map[string]string{}
+	informers := map[string]*sharedInformer{}
+	provider := func(gvr schema.GroupVersionResource, namespace string, labelSelector labels.Selector, fieldSelector fields.Selector) (cache.SharedInformer, error) {
+		requested[gvr.Resource] = fmt.Sprintf("namespace=%q labels=%q fields=%q", namespace, labelSelector, fieldSelector)
+		informer := &sharedInformer{FakeInformer: &FakeInformer{FakeController: &FakeController{}}, t: t, handlers: map[cache.ResourceEventHandlerRegistration]bool{}}
+		informers[gvr.Resource] = informer
+		return informer, nil
+	}
+
+	c, err := NewWithSharedInformers(zap.NewNop(),
+		ExtractionRules{ClusterUID: true, DeploymentName: true, NodeUID: true, RouteHost: true},
+		Filters{Namespace: "default", Node: "node-1", Labels: []FieldFilter{{Key: "app", Value: "web", Op: selection.Equals}}},
+		[]Association{}, Excludes{}, provider)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"pods":        `namespace="default" labels="app=web" fields="spec.nodeName=node-1"`,
+		"namespaces":  `namespace="" labels="" fields="metadata.name=kube-system"`,
+		"replicasets": `namespace="default" labels="" fields=""`,
+		"nodes":       `namespace="" labels="" fields="metadata.name=node-1"`,
+		"services":    `namespace="default" labels="" fields=""`,
+		"routes":      `namespace="default" labels="" fields=""`,
+	}, requested)
+
+	c.Start()
+	for resource, informer := range informers {
+		assert.Len(t, informer.handlers, 1, resource)
+	}
+
+	// The handlers are removed from the shared informers on Stop.
+	c.Stop()
+	for resource, informer := range informers {
+		assert.Empty(t, informer.handlers, resource)
+	}
+}
+
+func TestClientWithSharedInformersError(t *testing.T) {
+	_, err := NewWithSharedInformers(zap.NewNop(), ExtractionRules{}, Filters{}, []Association{}, Excludes{},
+		func(schema.GroupVersionResource, string, labels.Selector, fields.Selector) (cache.SharedInformer, error) {
+			return nil, errors.New("k8s informer extension is not started")
+		})
+	assert.EqualError(t, err, "k8s informer extension is not started")
+}
+
 func TestConstructorErrors(t *testing.T) {
 	er := ExtractionRules{}
 	ff := Filters{}
@@ -949,6 +1024,37 @@ func TestExtractionRules(t *testing.T) {
 				"prefix-annotation1": "av1",
 			},
 		},
+		{
+			name: "captured-groups-with-value-regex",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{{
+					Name:                 "k8s.label.$1",
+					KeyRegex:             regexp.MustCompile(`^(?:label(\d+))$`),
+					HasKeyRegexReference: true,
+					Regex:                regexp.MustCompile(`k5=(?P<value>[^\s]+)`),
+					From:                 MetadataFromPod,
+				},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.label.2": "v5",
+			},
+		},
+		{
+			name: "captured-groups-empty-name",
+			rules: ExtractionRules{
+				Annotations: []FieldExtractionRule{{
+					Name:                 "${missing}",
+					KeyRegex:             regexp.MustCompile(`^(?:annotation(\d+))$`),
+					HasKeyRegexReference: true,
+					From:                 MetadataFromPod,
+				},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.pod.annotations.annotation1": "av1",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1140,7 +1246,9 @@ func TestNamespaceExtractionRules(t *testing.T) {
 				"label1": "lv1",
 			},
 			Annotations: map[string]string{
-				"annotation1": "av1",
+				"annotation1":               "av1",
+				"openshift.io/display-name": "Auth Service",
+				"openshift.io/requester":    "jane",
 			},
 		},
 	}
@@ -1153,6 +1261,16 @@ func TestNamespaceExtractionRules(t *testing.T) {
 		name:       "no-rules",
 		rules:      ExtractionRules{},
 		attributes: nil,
+	}, {
+		name: "openshift-project",
+		rules: ExtractionRules{
+			ProjectDisplayName: true,
+			ProjectRequester:   true,
+		},
+		attributes: map[string]string{
+			"openshift.project.display_name": "Auth Service",
+			"openshift.project.requester":    "jane",
+		},
 	}, {
 		name: "labels",
 		rules: ExtractionRules{
@@ -1218,6 +1336,110 @@ func TestNamespaceExtractionRules(t *testing.T) {
 	}
 }
 
+func TestOpenShiftPodExtraction(t *testing.T) {
+	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
+	c.Rules = ExtractionRules{PodSCC: true, RouteHost: true}
+
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "frontend-abc12",
+			Namespace: "shop",
+			UID:       "33333333-bbbb-cccc-dddd-eeeeeeeeeeee",
+			Labels:    map[string]string{"app": "frontend", "tier": "web"},
+			Annotations: map[string]string{
+				"openshift.io/scc": "restricted-v2",
+				"other":            "value",
+			},
+		},
+		Status: api_v1.PodStatus{
+			PodIP: "1.1.1.1",
+		},
+	}
+
+	transformedPod := removeUnnecessaryPodData(pod, c.Rules)
+	assert.Equal(t, pod.Labels, transformedPod.Labels)
+	assert.Equal(t, map[string]string{"openshift.io/scc": "restricted-v2"}, transformedPod.Annotations)
+
+	c.handleServiceAdd(&api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "frontend", Namespace: "shop"},
+		Spec:       api_v1.ServiceSpec{Selector: map[string]string{"app": "frontend"}},
+	})
+	c.handleServiceAdd(&api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "frontend-canary", Namespace: "shop"},
+		Spec:       api_v1.ServiceSpec{Selector: map[string]string{"app": "frontend", "track": "canary"}},
+	})
+	c.handleServiceAdd(&api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "frontend", Namespace: "other"},
+		Spec:       api_v1.ServiceSpec{Selector: map[string]string{"app": "frontend"}},
+	})
+	newRoute := func(namespace, name string, spec map[string]any, status map[string]any) *unstructured.Unstructured {
+		route := &unstructured.Unstructured{Object: map[string]any{"spec": spec, "status": status}}
+		route.SetNamespace(namespace)
+		route.SetName(name)
+		return route
+	}
+	c.handleRouteAdd(newRoute("shop", "www", map[string]any{
+		"host": "www.shop.example.com",
+		"to":   map[string]any{"kind": "Service", "name": "frontend"},
+	}, nil))
+	c.handleRouteAdd(newRoute("shop", "generated", map[string]any{
+		"to":                map[string]any{"kind": "Service", "name": "frontend-canary"},
+		"alternateBackends": []any{map[string]any{"kind": "Service", "name": "frontend"}},
+	}, map[string]any{
+		"ingress": []any{map[string]any{"host": "generated-shop.apps.example.com"}},
+	}))
+	c.handleRouteAdd(newRoute("shop", "canary", map[string]any{
+		"host": "canary.shop.example.com",
+		"to":   map[string]any{"kind": "Service", "name": "frontend-canary"},
+	}, nil))
+	c.handleRouteAdd(newRoute("other", "www", map[string]any{
+		"host": "www.other.example.com",
+		"to":   map[string]any{"kind": "Service", "name": "frontend"},
+	}, nil))
+
+	c.handlePodAdd(transformedPod)
+	p, ok := c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{
+		"openshift.pod.scc":    "restricted-v2",
+		"openshift.route.host": "generated-shop.apps.example.com,www.shop.example.com",
+	}, p.Attributes)
+
+	c.handleRouteDelete(newRoute("shop", "generated", nil, nil))
+	c.handleServiceDelete(&api_v1.Service{ObjectMeta: meta_v1.ObjectMeta{Name: "frontend", Namespace: "shop"}})
+	c.handlePodUpdate(&api_v1.Pod{}, transformedPod)
+	p, ok = c.GetPod(newPodIdentifier("connection", "k8s.pod.ip", "1.1.1.1"))
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"openshift.pod.scc": "restricted-v2"}, p.Attributes)
+}
+
+func TestRouteFromAPI(t *testing.T) {
+	route := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"to": map[string]any{"kind": "Service", "name": "a"},
+			"alternateBackends": []any{
+				map[string]any{"kind": "Service", "name": "b"},
+				map[string]any{"kind": "Unknown", "name": "c"},
+			},
+		},
+		"status": map[string]any{
+			"ingress": []any{
+				map[string]any{"routerName": "default"},
+				map[string]any{"host": "a.apps.example.com"},
+			},
+		},
+	}}
+	route.SetNamespace("ns")
+	route.SetName("a")
+
+	assert.Equal(t, &Route{
+		Name:      "a",
+		Namespace: "ns",
+		Host:      "a.apps.example.com",
+		Services:  []string{"a", "b"},
+	}, routeFromAPI(route))
+}
+
 func TestNodeExtractionRules(t *testing.T) {
 	c, _ := newTestClientWithRulesAndFilters(t, Filters{})
 
@@ -1233,6 +1455,13 @@ func TestNodeExtractionRules(t *testing.T) {
 				"annotation1": "av1",
 			},
 		},
+		Status: api_v1.NodeStatus{
+			Conditions: []api_v1.NodeCondition{
+				{Type: api_v1.NodeReady, Status: api_v1.ConditionTrue},
+				{Type: api_v1.NodeMemoryPressure, Status: api_v1.ConditionFalse},
+				{Type: "KernelDeadlock", Status: api_v1.ConditionUnknown},
+			},
+		},
 	}
 
 	testCases := []struct {
@@ -1243,6 +1472,16 @@ func TestNodeExtractionRules(t *testing.T) {
 		name:       "no-rules",
 		rules:      ExtractionRules{},
 		attributes: nil,
+	}, {
+		name: "conditions",
+		rules: ExtractionRules{
+			NodeConditions: []string{"Ready", "MemoryPressure", "KernelDeadlock", "DiskPressure"},
+		},
+		attributes: map[string]string{
+			"k8s.node.condition.ready":           "true",
+			"k8s.node.condition.memory_pressure": "false",
+			"k8s.node.condition.kernel_deadlock": "unknown",
+		},
 	}, {
 		name: "labels",
 		rules: ExtractionRules{
@@ -9,12 +9,16 @@ import (
 	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
 const kubeSystemNamespace = "kube-system"
@@ -28,6 +32,15 @@ type InformerProvider func(
 	fieldSelector fields.Selector,
 ) cache.SharedInformer
 
+// SharedInformerProvider defines a function type that returns an informer shared with other
+// components, e.g. by the k8s_informer extension.
+type SharedInformerProvider func(
+	gvr schema.GroupVersionResource,
+	namespace string,
+	labelSelector labels.Selector,
+	fieldSelector fields.Selector,
+) (cache.SharedInformer, error)
+
 // InformerProviderNamespace defines a function type that returns a new SharedInformer. It is used to
 // allow passing custom shared informers to the watch client for fetching namespace objects.
 type InformerProviderNamespace func(
@@ -155,3 +168,57 @@ func replicasetWatchFuncWithSelectors(client kubernetes.Interface, namespace str
 		return client.AppsV1().ReplicaSets(namespace).Watch(context.Background(), opts)
 	}
 }
+
+func newServiceSharedInformer(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.CoreV1().Services(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.CoreV1().Services(namespace).Watch(context.Background(), opts)
+			},
+		},
+		&api_v1.Service{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+var (
+	podsGVR        = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	namespacesGVR  = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	nodesGVR       = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	servicesGVR    = schema.GroupVersionResource{Version: "v1", Resource: "services"}
+	replicaSetsGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+)
+
+// routeGVR identifies the OpenShift routes, which aren't part of the kubernetes API and are watched
+// with a dynamic client.
+var routeGVR = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+func newRouteSharedInformer(
+	apiCfg k8sconfig.APIConfig,
+	namespace string,
+) (cache.SharedInformer, error) {
+	client, err := k8sconfig.MakeDynamicClient(apiCfg)
+	if err != nil {
+		return nil, err
+	}
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return client.Resource(routeGVR).Namespace(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return client.Resource(routeGVR).Namespace(namespace).Watch(context.Background(), opts)
+			},
+		},
+		&unstructured.Unstructured{},
+		watchSyncPeriod,
+	)
+	return informer, nil
+}
@@ -17,12 +17,20 @@ import (
 )
 
 const (
-	podNodeField            = "spec.nodeName"
-	ignoreAnnotation string = "opentelemetry.io/k8s-processor/ignore"
-	tagNodeName             = "k8s.node.name"
-	tagStartTime            = "k8s.pod.start_time"
-	tagHostName             = "k8s.pod.hostname"
-	tagClusterUID           = "k8s.cluster.uid"
+	podNodeField                 = "spec.nodeName"
+	ignoreAnnotation      string = "opentelemetry.io/k8s-processor/ignore"
+	tagNodeName                  = "k8s.node.name"
+	tagStartTime                 = "k8s.pod.start_time"
+	tagHostName                  = "k8s.pod.hostname"
+	tagClusterUID                = "k8s.cluster.uid"
+	tagProjectDisplayName        = "openshift.project.display_name"
+	tagProjectRequester          = "openshift.project.requester"
+	tagPodSCC                    = "openshift.pod.scc"
+	tagRouteHost                 = "openshift.route.host"
+	// OpenShift sets these annotations on the namespaces of projects and on the pods it admits.
+	projectDisplayNameAnnotation = "openshift.io/display-name"
+	projectRequesterAnnotation   = "openshift.io/requester"
+	podSCCAnnotation             = "openshift.io/scc"
 	// MetadataFromPod is used to specify to extract metadata/labels/annotations from pod
 	MetadataFromPod = "pod"
 	// MetadataFromNamespace is used to specify to extract metadata/labels/annotations from namespace
@@ -217,9 +225,16 @@ type ExtractionRules struct {
 	ContainerImageName bool
 	ContainerImageTag  bool
 	ClusterUID         bool
+	ProjectDisplayName bool
+	ProjectRequester   bool
+	PodSCC             bool
+	RouteHost          bool
 
 	Annotations []FieldExtractionRule
 	Labels      []FieldExtractionRule
+
+	// NodeConditions lists the node condition types whose status is extracted from the node.
+	NodeConditions []string
 }
 
 // IncludesOwnerMetadata determines whether the ExtractionRules include metadata about Pod Owners
@@ -288,16 +303,22 @@ func (r *FieldExtractionRule) extractFromNodeMetadata(metadata map[string]string
 func (r *FieldExtractionRule) extractFromMetadata(metadata map[string]string, tags map[string]string, formatter string) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
-			if r.KeyRegex.MatchString(k) && v != "" {
-				var name string
-				if r.HasKeyRegexReference {
-					var result []byte
-					name = string(r.KeyRegex.ExpandString(result, r.Name, k, r.KeyRegex.FindStringSubmatchIndex(k)))
-				} else {
-					name = fmt.Sprintf(formatter, k)
-				}
-				tags[name] = v
+			if !r.KeyRegex.MatchString(k) {
+				continue
+			}
+			value := r.extractField(v)
+			if value == "" {
+				continue
+			}
+			var name string
+			if r.HasKeyRegexReference {
+				var result []byte
+				name = string(r.KeyRegex.ExpandString(result, r.Name, k, r.KeyRegex.FindStringSubmatchIndex(k)))
 			}
+			if name == "" {
+				name = fmt.Sprintf(formatter, k)
+			}
+			tags[name] = value
 		}
 	} else if v, ok := metadata[r.Key]; ok {
 		tags[r.Name] = r.extractField(v)
@@ -357,3 +378,19 @@ type ReplicaSet struct {
 	UID        string
 	Deployment Deployment
 }
+
+// Service represents a kubernetes service, only its selector is needed to match the pods backing routes.
+type Service struct {
+	Name      string
+	Namespace string
+	Selector  map[string]string
+}
+
+// Route represents an OpenShift route.
+type Route struct {
+	Name      string
+	Namespace string
+	Host      string
+	// Services lists the names of the services the route sends traffic to, including alternate backends.
+	Services []string
+}
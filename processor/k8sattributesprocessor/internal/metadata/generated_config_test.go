@@ -24,57 +24,65 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				ContainerID:        ResourceAttributeConfig{Enabled: true},
-				ContainerImageName: ResourceAttributeConfig{Enabled: true},
-				ContainerImageTag:  ResourceAttributeConfig{Enabled: true},
-				K8sClusterUID:      ResourceAttributeConfig{Enabled: true},
-				K8sContainerName:   ResourceAttributeConfig{Enabled: true},
-				K8sCronjobName:     ResourceAttributeConfig{Enabled: true},
-				K8sDaemonsetName:   ResourceAttributeConfig{Enabled: true},
-				K8sDaemonsetUID:    ResourceAttributeConfig{Enabled: true},
-				K8sDeploymentName:  ResourceAttributeConfig{Enabled: true},
-				K8sDeploymentUID:   ResourceAttributeConfig{Enabled: true},
-				K8sJobName:         ResourceAttributeConfig{Enabled: true},
-				K8sJobUID:          ResourceAttributeConfig{Enabled: true},
-				K8sNamespaceName:   ResourceAttributeConfig{Enabled: true},
-				K8sNodeName:        ResourceAttributeConfig{Enabled: true},
-				K8sNodeUID:         ResourceAttributeConfig{Enabled: true},
-				K8sPodHostname:     ResourceAttributeConfig{Enabled: true},
-				K8sPodName:         ResourceAttributeConfig{Enabled: true},
-				K8sPodStartTime:    ResourceAttributeConfig{Enabled: true},
-				K8sPodUID:          ResourceAttributeConfig{Enabled: true},
-				K8sReplicasetName:  ResourceAttributeConfig{Enabled: true},
-				K8sReplicasetUID:   ResourceAttributeConfig{Enabled: true},
-				K8sStatefulsetName: ResourceAttributeConfig{Enabled: true},
-				K8sStatefulsetUID:  ResourceAttributeConfig{Enabled: true},
+				ContainerID:                 ResourceAttributeConfig{Enabled: true},
+				ContainerImageName:          ResourceAttributeConfig{Enabled: true},
+				ContainerImageTag:           ResourceAttributeConfig{Enabled: true},
+				K8sClusterUID:               ResourceAttributeConfig{Enabled: true},
+				K8sContainerName:            ResourceAttributeConfig{Enabled: true},
+				K8sCronjobName:              ResourceAttributeConfig{Enabled: true},
+				K8sDaemonsetName:            ResourceAttributeConfig{Enabled: true},
+				K8sDaemonsetUID:             ResourceAttributeConfig{Enabled: true},
+				K8sDeploymentName:           ResourceAttributeConfig{Enabled: true},
+				K8sDeploymentUID:            ResourceAttributeConfig{Enabled: true},
+				K8sJobName:                  ResourceAttributeConfig{Enabled: true},
+				K8sJobUID:                   ResourceAttributeConfig{Enabled: true},
+				K8sNamespaceName:            ResourceAttributeConfig{Enabled: true},
+				K8sNodeName:                 ResourceAttributeConfig{Enabled: true},
+				K8sNodeUID:                  ResourceAttributeConfig{Enabled: true},
+				K8sPodHostname:              ResourceAttributeConfig{Enabled: true},
+				K8sPodName:                  ResourceAttributeConfig{Enabled: true},
+				K8sPodStartTime:             ResourceAttributeConfig{Enabled: true},
+				K8sPodUID:                   ResourceAttributeConfig{Enabled: true},
+				K8sReplicasetName:           ResourceAttributeConfig{Enabled: true},
+				K8sReplicasetUID:            ResourceAttributeConfig{Enabled: true},
+				K8sStatefulsetName:          ResourceAttributeConfig{Enabled: true},
+				K8sStatefulsetUID:           ResourceAttributeConfig{Enabled: true},
+				OpenshiftPodScc:             ResourceAttributeConfig{Enabled: true},
+				OpenshiftProjectDisplayName: ResourceAttributeConfig{Enabled: true},
+				OpenshiftProjectRequester:   ResourceAttributeConfig{Enabled: true},
+				OpenshiftRouteHost:          ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				ContainerID:        ResourceAttributeConfig{Enabled: false},
-				ContainerImageName: ResourceAttributeConfig{Enabled: false},
-				ContainerImageTag:  ResourceAttributeConfig{Enabled: false},
-				K8sClusterUID:      ResourceAttributeConfig{Enabled: false},
-				K8sContainerName:   ResourceAttributeConfig{Enabled: false},
-				K8sCronjobName:     ResourceAttributeConfig{Enabled: false},
-				K8sDaemonsetName:   ResourceAttributeConfig{Enabled: false},
-				K8sDaemonsetUID:    ResourceAttributeConfig{Enabled: false},
-				K8sDeploymentName:  ResourceAttributeConfig{Enabled: false},
-				K8sDeploymentUID:   ResourceAttributeConfig{Enabled: false},
-				K8sJobName:         ResourceAttributeConfig{Enabled: false},
-				K8sJobUID:          ResourceAttributeConfig{Enabled: false},
-				K8sNamespaceName:   ResourceAttributeConfig{Enabled: false},
-				K8sNodeName:        ResourceAttributeConfig{Enabled: false},
-				K8sNodeUID:         ResourceAttributeConfig{Enabled: false},
-				K8sPodHostname:     ResourceAttributeConfig{Enabled: false},
-				K8sPodName:         ResourceAttributeConfig{Enabled: false},
-				K8sPodStartTime:    ResourceAttributeConfig{Enabled: false},
-				K8sPodUID:          ResourceAttributeConfig{Enabled: false},
-				K8sReplicasetName:  ResourceAttributeConfig{Enabled: false},
-				K8sReplicasetUID:   ResourceAttributeConfig{Enabled: false},
-				K8sStatefulsetName: ResourceAttributeConfig{Enabled: false},
-				K8sStatefulsetUID:  ResourceAttributeConfig{Enabled: false},
+				ContainerID:                 ResourceAttributeConfig{Enabled: false},
+				ContainerImageName:          ResourceAttributeConfig{Enabled: false},
+				ContainerImageTag:           ResourceAttributeConfig{Enabled: false},
+				K8sClusterUID:               ResourceAttributeConfig{Enabled: false},
+				K8sContainerName:            ResourceAttributeConfig{Enabled: false},
+				K8sCronjobName:              ResourceAttributeConfig{Enabled: false},
+				K8sDaemonsetName:            ResourceAttributeConfig{Enabled: false},
+				K8sDaemonsetUID:             ResourceAttributeConfig{Enabled: false},
+				K8sDeploymentName:           ResourceAttributeConfig{Enabled: false},
+				K8sDeploymentUID:            ResourceAttributeConfig{Enabled: false},
+				K8sJobName:                  ResourceAttributeConfig{Enabled: false},
+				K8sJobUID:                   ResourceAttributeConfig{Enabled: false},
+				K8sNamespaceName:            ResourceAttributeConfig{Enabled: false},
+				K8sNodeName:                 ResourceAttributeConfig{Enabled: false},
+				K8sNodeUID:                  ResourceAttributeConfig{Enabled: false},
+				K8sPodHostname:              ResourceAttributeConfig{Enabled: false},
+				K8sPodName:                  ResourceAttributeConfig{Enabled: false},
+				K8sPodStartTime:             ResourceAttributeConfig{Enabled: false},
+				K8sPodUID:                   ResourceAttributeConfig{Enabled: false},
+				K8sReplicasetName:           ResourceAttributeConfig{Enabled: false},
+				K8sReplicasetUID:            ResourceAttributeConfig{Enabled: false},
+				K8sStatefulsetName:          ResourceAttributeConfig{Enabled: false},
+				K8sStatefulsetUID:           ResourceAttributeConfig{Enabled: false},
+				OpenshiftPodScc:             ResourceAttributeConfig{Enabled: false},
+				OpenshiftProjectDisplayName: ResourceAttributeConfig{Enabled: false},
+				OpenshiftProjectRequester:   ResourceAttributeConfig{Enabled: false},
+				OpenshiftRouteHost:          ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
@@ -182,6 +182,34 @@ func (rb *ResourceBuilder) SetK8sStatefulsetUID(val string) {
 	}
 }
 
+// SetOpenshiftPodScc sets provided value as "openshift.pod.scc" attribute.
+func (rb *ResourceBuilder) SetOpenshiftPodScc(val string) {
+	if rb.config.OpenshiftPodScc.Enabled {
+		rb.res.Attributes().PutStr("openshift.pod.scc", val)
+	}
+}
+
+// SetOpenshiftProjectDisplayName sets provided value as "openshift.project.display_name" attribute.
+func (rb *ResourceBuilder) SetOpenshiftProjectDisplayName(val string) {
+	if rb.config.OpenshiftProjectDisplayName.Enabled {
+		rb.res.Attributes().PutStr("openshift.project.display_name", val)
+	}
+}
+
+// SetOpenshiftProjectRequester sets provided value as "openshift.project.requester" attribute.
+func (rb *ResourceBuilder) SetOpenshiftProjectRequester(val string) {
+	if rb.config.OpenshiftProjectRequester.Enabled {
+		rb.res.Attributes().PutStr("openshift.project.requester", val)
+	}
+}
+
+// SetOpenshiftRouteHost sets provided value as "openshift.route.host" attribute.
+func (rb *ResourceBuilder) SetOpenshiftRouteHost(val string) {
+	if rb.config.OpenshiftRouteHost.Enabled {
+		rb.res.Attributes().PutStr("openshift.route.host", val)
+	}
+}
+
 // Emit returns the built resource and resets the internal builder state.
 func (rb *ResourceBuilder) Emit() pcommon.Resource {
 	r := rb.res
@@ -36,6 +36,10 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetOpenshiftPodScc("openshift.pod.scc-val")
+			rb.SetOpenshiftProjectDisplayName("openshift.project.display_name-val")
+			rb.SetOpenshiftProjectRequester("openshift.project.requester-val")
+			rb.SetOpenshiftRouteHost("openshift.route.host-val")
 
 			res := rb.Emit()
 			assert.Equal(t, 0, rb.Emit().Attributes().Len()) // Second call should return empty Resource
@@ -44,7 +48,7 @@ func TestResourceBuilder(t *testing.T) {
 			case "default":
 				assert.Equal(t, 8, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 23, res.Attributes().Len())
+				assert.Equal(t, 27, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -167,6 +171,26 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.statefulset.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("openshift.pod.scc")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "openshift.pod.scc-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("openshift.project.display_name")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "openshift.project.display_name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("openshift.project.requester")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "openshift.project.requester-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("openshift.route.host")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "openshift.route.host-val", val.Str())
+			}
 		})
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"regexp"
 
+	"go.opentelemetry.io/collector/component"
 	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"k8s.io/apimachinery/pkg/selection"
 
@@ -25,6 +26,11 @@ const (
 	specPodHostName      = "k8s.pod.hostname"
 	// TODO: use k8s.cluster.uid from semconv when available, and replace clusterUID with conventions.AttributeClusterUid
 	clusterUID = "k8s.cluster.uid"
+
+	metadataProjectDisplayName = "openshift.project.display_name"
+	metadataProjectRequester   = "openshift.project.requester"
+	metadataPodSCC             = "openshift.pod.scc"
+	metadataRouteHost          = "openshift.route.host"
 )
 
 // option represents a configuration option that can be passes.
@@ -121,6 +127,18 @@ func enabledAttributes() (attributes []string) {
 	if defaultConfig.K8sStatefulsetUID.Enabled {
 		attributes = append(attributes, conventions.AttributeK8SStatefulSetUID)
 	}
+	if defaultConfig.OpenshiftProjectDisplayName.Enabled {
+		attributes = append(attributes, metadataProjectDisplayName)
+	}
+	if defaultConfig.OpenshiftProjectRequester.Enabled {
+		attributes = append(attributes, metadataProjectRequester)
+	}
+	if defaultConfig.OpenshiftPodScc.Enabled {
+		attributes = append(attributes, metadataPodSCC)
+	}
+	if defaultConfig.OpenshiftRouteHost.Enabled {
+		attributes = append(attributes, metadataRouteHost)
+	}
 	return
 }
 
@@ -176,6 +194,14 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.ContainerImageTag = true
 			case clusterUID:
 				p.rules.ClusterUID = true
+			case metadataProjectDisplayName:
+				p.rules.ProjectDisplayName = true
+			case metadataProjectRequester:
+				p.rules.ProjectRequester = true
+			case metadataPodSCC:
+				p.rules.PodSCC = true
+			case metadataRouteHost:
+				p.rules.RouteHost = true
 			}
 		}
 		return nil
@@ -206,6 +232,14 @@ func withExtractAnnotations(annotations ...FieldExtractConfig) option {
 	}
 }
 
+// withExtractNodeConditions allows specifying node conditions to extract from the node the pod runs on.
+func withExtractNodeConditions(conditions ...string) option {
+	return func(p *kubernetesprocessor) error {
+		p.rules.NodeConditions = conditions
+		return nil
+	}
+}
+
 func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.FieldExtractionRule, error) {
 	var rules []kube.FieldExtractionRule
 	for _, a := range fields {
@@ -238,7 +272,8 @@ func extractFieldRules(fieldType string, fields ...FieldExtractConfig) ([]kube.F
 				return rules, err
 			}
 
-			if keyRegex.NumSubexp() > 0 {
+			// Without a tag_name there is nothing to expand, the default name is used instead.
+			if keyRegex.NumSubexp() > 0 && name != "" {
 				hasKeyRegexReference = true
 			}
 		}
@@ -366,3 +401,11 @@ func withExcludes(podExclude ExcludeConfig) option {
 		return nil
 	}
 }
+
+// withInformerExtension allows specifying the k8s_informer extension providing the informers.
+func withInformerExtension(informerExtension *component.ID) option {
+	return func(p *kubernetesprocessor) error {
+		p.informerExtension = informerExtension
+		return nil
+	}
+}
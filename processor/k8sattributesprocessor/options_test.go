@@ -372,6 +372,20 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.StartTime)
 	assert.False(t, p.rules.DeploymentName)
 	assert.False(t, p.rules.Node)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata(metadataProjectDisplayName, metadataProjectRequester, metadataPodSCC, metadataRouteHost)(p))
+	assert.True(t, p.rules.ProjectDisplayName)
+	assert.True(t, p.rules.ProjectRequester)
+	assert.True(t, p.rules.PodSCC)
+	assert.True(t, p.rules.RouteHost)
+	assert.False(t, p.rules.Namespace)
+}
+
+func TestWithExtractNodeConditions(t *testing.T) {
+	p := &kubernetesprocessor{}
+	assert.NoError(t, withExtractNodeConditions("Ready", "DiskPressure")(p))
+	assert.Equal(t, []string{"Ready", "DiskPressure"}, p.rules.NodeConditions)
 }
 
 func TestWithFilterLabels(t *testing.T) {
@@ -647,6 +661,21 @@ func Test_extractFieldRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "keyregex-capture-group-without-tag-name",
+			args: args{"labels", []FieldExtractConfig{
+				{
+					KeyRegex: "example.com/team-(.*)",
+					From:     kube.MetadataFromNamespace,
+				},
+			}},
+			want: []kube.FieldExtractionRule{
+				{
+					KeyRegex: regexp.MustCompile("^(?:example.com/team-(.*))$"),
+					From:     kube.MetadataFromNamespace,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
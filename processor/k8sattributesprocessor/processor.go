@@ -15,7 +15,12 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 )
@@ -36,6 +41,7 @@ type kubernetesprocessor struct {
 	filters           kube.Filters
 	podAssociations   []kube.Association
 	podIgnore         kube.Excludes
+	informerExtension *component.ID
 }
 
 func (kp *kubernetesprocessor) initKubeClient(logger *zap.Logger, kubeClient kube.ClientProvider) error {
@@ -52,7 +58,33 @@ func (kp *kubernetesprocessor) initKubeClient(logger *zap.Logger, kubeClient kub
 	return nil
 }
 
-func (kp *kubernetesprocessor) Start(_ context.Context, _ component.Host) error {
+// initSharedKubeClient creates a client watching the cluster through the informers of the
+// k8s_informer extension.
+func (kp *kubernetesprocessor) initSharedKubeClient(host component.Host, informerExtension component.ID) error {
+	ext, ok := host.GetExtensions()[informerExtension]
+	if !ok {
+		return fmt.Errorf("informer extension %q not found", informerExtension)
+	}
+	provider, ok := ext.(k8sinformerextension.Provider)
+	if !ok {
+		return fmt.Errorf("extension %q is not a k8s informer extension", informerExtension)
+	}
+	kc, err := kube.NewWithSharedInformers(kp.logger, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore,
+		func(gvr schema.GroupVersionResource, namespace string, labelSelector labels.Selector, fieldSelector fields.Selector) (cache.SharedInformer, error) {
+			return provider.Informer(gvr, k8sinformerextension.InformerOptions{
+				Namespace:     namespace,
+				LabelSelector: labelSelector.String(),
+				FieldSelector: fieldSelector.String(),
+			})
+		})
+	if err != nil {
+		return err
+	}
+	kp.kc = kc
+	return nil
+}
+
+func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) error {
 	allOptions := append(createProcessorOpts(kp.cfg), kp.options...)
 
 	for _, opt := range allOptions {
@@ -63,6 +95,12 @@ func (kp *kubernetesprocessor) Start(_ context.Context, _ component.Host) error
 	}
 
 	// This might have been set by an option already
+	if kp.kc == nil && !kp.passthroughMode && kp.informerExtension != nil {
+		if err := kp.initSharedKubeClient(host, *kp.informerExtension); err != nil {
+			kp.telemetrySettings.ReportStatus(component.NewFatalErrorEvent(err))
+			return nil
+		}
+	}
 	if kp.kc == nil {
 		err := kp.initKubeClient(kp.logger, kubeClientProvider)
 		if err != nil {
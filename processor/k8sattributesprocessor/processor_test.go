@@ -25,7 +25,12 @@ import (
 	"go.opentelemetry.io/collector/processor/processortest"
 	conventions "go.opentelemetry.io/collector/semconv/v1.8.0"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8sinformerextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 )
@@ -1423,6 +1428,70 @@ func TestPassthroughStart(t *testing.T) {
 	assert.NoError(t, p.Shutdown(context.Background()))
 }
 
+type fakeInformerProvider struct {
+	component.StartFunc
+	component.ShutdownFunc
+	options map[string]k8sinformerextension.InformerOptions
+}
+
+func (p *fakeInformerProvider) Informer(gvr schema.GroupVersionResource, opts k8sinformerextension.InformerOptions) (cache.SharedIndexInformer, error) {
+	p.options[gvr.Resource] = opts
+	return cache.NewSharedIndexInformer(framework.NewFakeControllerSource(), &unstructured.Unstructured{}, 0, cache.Indexers{}), nil
+}
+
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func TestSharedInformersStart(t *testing.T) {
+	informerID := component.MustNewID("k8s_informer")
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.InformerExtension = &informerID
+	cfg.Filter.Namespace = "ns1"
+
+	var kp *kubernetesprocessor
+	p, err := createTracesProcessorWithOptions(
+		context.Background(),
+		processortest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+		withExtractKubernetesProcessorInto(&kp),
+	)
+	require.NoError(t, err)
+
+	provider := &fakeInformerProvider{options: map[string]k8sinformerextension.InformerOptions{}}
+	host := extensionsHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{informerID: provider}}
+	require.NoError(t, p.Start(context.Background(), host))
+
+	assert.IsType(t, &kube.WatchClient{}, kp.kc)
+	assert.Equal(t, k8sinformerextension.InformerOptions{Namespace: "ns1"}, provider.options["pods"])
+	assert.Equal(t, k8sinformerextension.InformerOptions{Namespace: "ns1"}, provider.options["replicasets"])
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestSharedInformersMissingExtension(t *testing.T) {
+	informerID := component.MustNewID("k8s_informer")
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.InformerExtension = &informerID
+
+	var reported error
+	set := processortest.NewNopCreateSettings()
+	set.ReportStatus = func(event *component.StatusEvent) {
+		reported = event.Err()
+	}
+	p, err := createTracesProcessorWithOptions(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, p.Start(context.Background(), componenttest.NewNopHost()))
+	assert.EqualError(t, reported, `informer extension "k8s_informer" not found`)
+	assert.NoError(t, p.Shutdown(context.Background()))
+}
+
 func TestRealClient(t *testing.T) {
 	newMultiTest(
 		t,
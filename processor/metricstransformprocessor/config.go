@@ -34,6 +34,9 @@ const (
 	// scaleFieldName is the mapstructure field name for Scale field
 	scaleFieldName = "experimental_scale"
 
+	// percentilesFieldName is the mapstructure field name for Percentiles field
+	percentilesFieldName = "percentiles"
+
 	// submatchCaseFieldName is the mapstructure field name for submatchCase field
 	submatchCaseFieldName = "submatch_case"
 )
@@ -128,6 +131,10 @@ type Operation struct {
 
 	// LabelValue identifies the exact label value to operate on
 	LabelValue string `mapstructure:"label_value"`
+
+	// Percentiles is a list of percentiles, between 0 (exclusive) and 100 (inclusive), to compute from histograms.
+	// Defaults to [50, 90, 99].
+	Percentiles []float64 `mapstructure:"percentiles"`
 }
 
 // ValueAction renames label values.
@@ -202,9 +209,15 @@ const (
 	// Metric has to match the FilterConfig with all its data points if used with Update ConfigAction,
 	// otherwise the operation will be ignored.
 	aggregateLabelValues operationAction = "aggregate_label_values"
+
+	// histogramPercentiles converts histograms to gauges holding the percentiles in Operation.Percentiles,
+	// labeled by Operation.NewLabel.
+	// Metric has to match the FilterConfig with all its data points if used with Update ConfigAction,
+	// otherwise the operation will be ignored.
+	histogramPercentiles operationAction = "histogram_percentiles"
 )
 
-var operationActions = []operationAction{addLabel, updateLabel, deleteLabelValue, toggleScalarDataType, scaleValue, aggregateLabels, aggregateLabelValues}
+var operationActions = []operationAction{addLabel, updateLabel, deleteLabelValue, toggleScalarDataType, scaleValue, aggregateLabels, aggregateLabelValues, histogramPercentiles}
 
 func (oa operationAction) isValid() bool {
 	for _, operationAction := range operationActions {
@@ -113,6 +113,11 @@ func validateConfiguration(config *Config) error {
 			if op.Action == scaleValue && op.Scale == 0 {
 				return fmt.Errorf("operation %v: missing required field %q while %q is %v", i+1, scaleFieldName, actionFieldName, scaleValue)
 			}
+			for _, p := range op.Percentiles {
+				if p <= 0 || p > 100 {
+					return fmt.Errorf("operation %v: %q must be greater than 0 and at most 100, got %v", i+1, percentilesFieldName, p)
+				}
+			}
 
 			if op.AggregationType != "" && !op.AggregationType.isValid() {
 				return fmt.Errorf("operation %v: %q must be in %q", i+1, aggregationTypeFieldName, aggregationTypes)
@@ -154,6 +159,9 @@ func buildHelperConfig(config *Config, version string) ([]internalTransform, err
 			if len(op.ValueActions) > 0 {
 				mtpOp.valueActionsMapping = createLabelValueMapping(op.ValueActions, version)
 			}
+			if op.Action == histogramPercentiles && len(op.Percentiles) == 0 {
+				mtpOp.configOperation.Percentiles = defaultPercentiles
+			}
 			if op.Action == aggregateLabels {
 				mtpOp.labelSetMap = sliceToSet(op.LabelSet)
 			} else if op.Action == aggregateLabelValues {
@@ -80,6 +80,11 @@ func TestCreateProcessors(t *testing.T) {
 			succeed:      false,
 			errorMessage: fmt.Sprintf("operation %v: missing required field %q while %q is %v", 1, scaleFieldName, actionFieldName, scaleValue),
 		},
+		{
+			configName:   "config_invalid_percentiles.yaml",
+			succeed:      false,
+			errorMessage: fmt.Sprintf("operation %v: %q must be greater than 0 and at most 100, got %v", 1, percentilesFieldName, 150),
+		},
 		{
 			configName:   "config_invalid_regexp.yaml",
 			succeed:      false,
@@ -116,6 +116,28 @@ func (b builder) addHistogramDatapointWithMinMaxAndExemplars(start, ts pcommon.T
 	return b
 }
 
+// addExponentialHistogramDatapoint adds a data point of scale 0, whose buckets at index i cover (2^i, 2^(i+1)].
+func (b builder) addExponentialHistogramDatapoint(start, ts pcommon.Timestamp, min, max float64, negative, positive []uint64,
+	attrValues ...string) builder {
+	if b.metric.Type() != pmetric.MetricTypeExponentialHistogram {
+		panic(b.metric.Type().String())
+	}
+	dp := b.metric.ExponentialHistogram().DataPoints().AppendEmpty()
+	b.setAttrs(dp.Attributes(), attrValues)
+	var count uint64
+	for _, c := range append(append([]uint64{}, negative...), positive...) {
+		count += c
+	}
+	dp.SetCount(count)
+	dp.SetMin(min)
+	dp.SetMax(max)
+	dp.Negative().BucketCounts().FromRaw(negative)
+	dp.Positive().BucketCounts().FromRaw(positive)
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	return b
+}
+
 // setUnit sets the unit of this metric
 func (b builder) setUnit(unit string) builder {
 	b.metric.SetUnit(unit)
@@ -559,6 +559,10 @@ func transformMetric(metric pmetric.Metric, transform internalTransform) bool {
 			if canChangeMetric {
 				deleteLabelValueOp(metric, op)
 			}
+		case histogramPercentiles:
+			if canChangeMetric {
+				histogramPercentilesOp(metric, op)
+			}
 		}
 	}
 
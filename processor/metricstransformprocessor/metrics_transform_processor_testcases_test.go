@@ -1715,6 +1715,66 @@ var (
 					addIntDatapoint(1, 2, 4, "label1value2", "label2value").build(),
 			},
 		},
+		// Histogram percentiles
+		{
+			name: "histogram_percentiles_update",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+					Action:              Update,
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:      histogramPercentiles,
+								Percentiles: []float64{50, 90, 99},
+							},
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeHistogram, "metric1", "label1").
+					addHistogramDatapoint(1, 2, 10, 200, []float64{10, 20, 40}, []uint64{2, 4, 2, 2}, "value1").
+					addHistogramDatapoint(1, 2, 0, 0, []float64{10, 20, 40}, []uint64{0, 0, 0, 0}, "value2").build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeGauge, "metric1", "label1", "percentile").
+					addDoubleDatapoint(1, 2, 17.5, "value1", "p50").
+					addDoubleDatapoint(1, 2, 40, "value1", "p90").
+					addDoubleDatapoint(1, 2, 40, "value1", "p99").build(),
+			},
+		},
+		{
+			name: "histogram_percentiles_insert_exponential",
+			transforms: []internalTransform{
+				{
+					MetricIncludeFilter: internalFilterStrict{include: "metric1"},
+					Action:              Insert,
+					NewName:             "metric1.percentiles",
+					Operations: []internalOperation{
+						{
+							configOperation: Operation{
+								Action:      histogramPercentiles,
+								NewLabel:    "quantile",
+								Percentiles: []float64{25, 50, 100},
+							},
+						},
+					},
+				},
+			},
+			in: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeExponentialHistogram, "metric1").
+					addExponentialHistogramDatapoint(1, 2, -1.5, 3.5, []uint64{2}, []uint64{1, 4}).build(),
+			},
+			out: []pmetric.Metric{
+				metricBuilder(pmetric.MetricTypeExponentialHistogram, "metric1").
+					addExponentialHistogramDatapoint(1, 2, -1.5, 3.5, []uint64{2}, []uint64{1, 4}).build(),
+				metricBuilder(pmetric.MetricTypeGauge, "metric1.percentiles", "quantile").
+					addDoubleDatapoint(1, 2, -1.0625, "p25").
+					addDoubleDatapoint(1, 2, 2.1875, "p50").
+					addDoubleDatapoint(1, 2, 3.5, "p100").build(),
+			},
+		},
 		{
 			name: "delete_all_metric_datapoints",
 			transforms: []internalTransform{
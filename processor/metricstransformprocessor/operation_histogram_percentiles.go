@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricstransformprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/metricstransformprocessor"
+
+import (
+	"math"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// defaultPercentileLabel is the label holding the percentile of the gauge data points if Operation.NewLabel is empty.
+const defaultPercentileLabel = "percentile"
+
+// defaultPercentiles are the percentiles computed if Operation.Percentiles is empty.
+var defaultPercentiles = []float64{50, 90, 99}
+
+// bucket is a histogram bucket holding count observations between lower and upper.
+type bucket struct {
+	lower, upper float64
+	count        uint64
+}
+
+// histogramPercentilesOp converts explicit bucket and exponential histograms to gauges holding the approximate
+// percentiles of each data point, one gauge data point per percentile labeled with it, e.g. percentile=p99.
+// The percentiles are linearly interpolated within the bucket they fall in, and bounded by the min and max of the
+// data point if present. Histogram data points without any observation are dropped.
+func histogramPercentilesOp(metric pmetric.Metric, op internalOperation) {
+	gauge := pmetric.NewNumberDataPointSlice()
+	switch metric.Type() {
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			buckets := clampBuckets(explicitBuckets(dp), dp.HasMin(), dp.Min(), dp.HasMax(), dp.Max())
+			appendPercentiles(gauge, op, buckets, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			buckets := clampBuckets(exponentialBuckets(dp), dp.HasMin(), dp.Min(), dp.HasMax(), dp.Max())
+			appendPercentiles(gauge, op, buckets, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp())
+		}
+	default:
+		return
+	}
+	gauge.MoveAndAppendTo(metric.SetEmptyGauge().DataPoints())
+}
+
+func appendPercentiles(dest pmetric.NumberDataPointSlice, op internalOperation, buckets []bucket, attrs pcommon.Map,
+	start, ts pcommon.Timestamp) {
+	var total uint64
+	for _, b := range buckets {
+		total += b.count
+	}
+	if total == 0 {
+		return
+	}
+
+	label := op.configOperation.NewLabel
+	if label == "" {
+		label = defaultPercentileLabel
+	}
+	for _, p := range op.configOperation.Percentiles {
+		dp := dest.AppendEmpty()
+		attrs.CopyTo(dp.Attributes())
+		dp.Attributes().PutStr(label, "p"+strconv.FormatFloat(p, 'f', -1, 64))
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(ts)
+		dp.SetDoubleValue(percentile(buckets, total, p))
+	}
+}
+
+// percentile returns the value below which p percent of the total observations of the buckets fall.
+func percentile(buckets []bucket, total uint64, p float64) float64 {
+	rank := p / 100 * float64(total)
+	var cumulative uint64
+	var last bucket
+	for _, b := range buckets {
+		if b.count == 0 {
+			continue
+		}
+		if float64(cumulative+b.count) >= rank {
+			return b.lower + (b.upper-b.lower)*(rank-float64(cumulative))/float64(b.count)
+		}
+		cumulative += b.count
+		last = b
+	}
+	return last.upper
+}
+
+// explicitBuckets returns the buckets of an explicit bucket histogram data point. As in Prometheus, the lower
+// bound of the first bucket is 0 unless its upper bound is negative, and the upper bound of the overflow bucket is
+// its lower bound, unless the data point has a min or a max.
+func explicitBuckets(dp pmetric.HistogramDataPoint) []bucket {
+	bounds, counts := dp.ExplicitBounds(), dp.BucketCounts()
+	if counts.Len() != bounds.Len()+1 {
+		return nil
+	}
+
+	buckets := make([]bucket, counts.Len())
+	for i := range buckets {
+		b := bucket{count: counts.At(i)}
+		switch {
+		case i > 0:
+			b.lower = bounds.At(i - 1)
+		case dp.HasMin():
+			b.lower = dp.Min()
+		case bounds.Len() > 0:
+			b.lower = math.Min(0, bounds.At(0))
+		}
+		switch {
+		case i < bounds.Len():
+			b.upper = bounds.At(i)
+		case dp.HasMax():
+			b.upper = dp.Max()
+		default:
+			b.upper = b.lower
+		}
+		buckets[i] = b
+	}
+	return buckets
+}
+
+// exponentialBuckets returns the buckets of an exponential histogram data point in increasing order: the
+// negative buckets, the zero bucket and the positive buckets.
+func exponentialBuckets(dp pmetric.ExponentialHistogramDataPoint) []bucket {
+	// the bucket at index i covers (base^i, base^(i+1)], with base = 2^(2^-scale)
+	factor := math.Exp2(-float64(dp.Scale()))
+	bound := func(index int) float64 {
+		return math.Exp2(float64(index) * factor)
+	}
+
+	negative, positive := dp.Negative(), dp.Positive()
+	buckets := make([]bucket, 0, negative.BucketCounts().Len()+positive.BucketCounts().Len()+1)
+	for i := negative.BucketCounts().Len() - 1; i >= 0; i-- {
+		index := int(negative.Offset()) + i
+		buckets = append(buckets, bucket{lower: -bound(index + 1), upper: -bound(index), count: negative.BucketCounts().At(i)})
+	}
+	buckets = append(buckets, bucket{lower: -dp.ZeroThreshold(), upper: dp.ZeroThreshold(), count: dp.ZeroCount()})
+	for i := 0; i < positive.BucketCounts().Len(); i++ {
+		index := int(positive.Offset()) + i
+		buckets = append(buckets, bucket{lower: bound(index), upper: bound(index + 1), count: positive.BucketCounts().At(i)})
+	}
+	return buckets
+}
+
+// clampBuckets bounds the buckets by the min and the max observed values, if known.
+func clampBuckets(buckets []bucket, hasMin bool, minValue float64, hasMax bool, maxValue float64) []bucket {
+	for i := range buckets {
+		if hasMin {
+			buckets[i].lower = math.Max(buckets[i].lower, minValue)
+			buckets[i].upper = math.Max(buckets[i].upper, minValue)
+		}
+		if hasMax {
+			buckets[i].lower = math.Min(buckets[i].lower, maxValue)
+			buckets[i].upper = math.Min(buckets[i].upper, maxValue)
+		}
+	}
+	return buckets
+}
@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+)
+
+// Action is what is done with the values detected as personally identifiable information.
+type Action string
+
+const (
+	// Mask replaces the detected values with asterisks.
+	Mask Action = "mask"
+	// Hash replaces the detected values with the hex-encoded SHA-256 hash of the salt and the value,
+	// so that the same values can still be correlated.
+	Hash Action = "hash"
+	// Remove removes the attributes and the nested values containing a detected value,
+	// and empties the log bodies. The detected values of span names are masked.
+	Remove Action = "remove"
+)
+
+func (a Action) validate() error {
+	switch a {
+	case Mask, Hash, Remove:
+		return nil
+	}
+	return fmt.Errorf("unknown action %q, must be one of %q, %q or %q", a, Mask, Hash, Remove)
+}
+
+// CustomDetector detects the values matching any of its patterns.
+type CustomDetector struct {
+	// Name identifies the detector in the detector_actions and in the detection metrics.
+	Name string `mapstructure:"name"`
+
+	// Patterns are the regular expressions matching the values to detect.
+	Patterns []string `mapstructure:"patterns"`
+
+	// Action overrides the action of the processor for this detector.
+	Action Action `mapstructure:"action"`
+}
+
+// Config defines configuration for the PII detection processor.
+type Config struct {
+	// Detectors are the built-in detectors enabled, all of them if empty.
+	Detectors []string `mapstructure:"detectors"`
+
+	// CustomDetectors are applied after the built-in detectors.
+	CustomDetectors []CustomDetector `mapstructure:"custom_detectors"`
+
+	// Action is applied to the values detected, mask by default.
+	Action Action `mapstructure:"action"`
+
+	// DetectorActions overrides the action for the detectors of the given names.
+	DetectorActions map[string]Action `mapstructure:"detector_actions"`
+
+	// HashSalt is prepended to the detected values before they are hashed.
+	HashSalt configopaque.String `mapstructure:"hash_salt"`
+
+	// IgnoredKeys are the keys of the attributes and of the nested maps which values are left as is.
+	IgnoredKeys []string `mapstructure:"ignored_keys"`
+
+	// SpanNames enables the detection in the span names.
+	SpanNames bool `mapstructure:"span_names"`
+
+	// LogBodies enables the detection in the log bodies, including the values of structured bodies.
+	LogBodies bool `mapstructure:"log_bodies"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	for _, name := range cfg.Detectors {
+		if _, ok := builtinDetectors[name]; !ok {
+			return fmt.Errorf("unknown detector %q", name)
+		}
+	}
+
+	names := make(map[string]bool, len(cfg.CustomDetectors))
+	for _, custom := range cfg.CustomDetectors {
+		if custom.Name == "" {
+			return errors.New("custom detectors must have a name")
+		}
+		if _, ok := builtinDetectors[custom.Name]; ok || names[custom.Name] {
+			return fmt.Errorf("duplicate detector %q", custom.Name)
+		}
+		names[custom.Name] = true
+		if len(custom.Patterns) == 0 {
+			return fmt.Errorf("custom detector %q must have at least one pattern", custom.Name)
+		}
+		for _, pattern := range custom.Patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid pattern of the custom detector %q: %w", custom.Name, err)
+			}
+		}
+		if custom.Action != "" {
+			if err := custom.Action.validate(); err != nil {
+				return fmt.Errorf("custom detector %q: %w", custom.Name, err)
+			}
+		}
+	}
+
+	if err := cfg.Action.validate(); err != nil {
+		return err
+	}
+	for name, action := range cfg.DetectorActions {
+		if _, ok := builtinDetectors[name]; !ok && !names[name] {
+			return fmt.Errorf("detector_actions: unknown detector %q", name)
+		}
+		if err := action.validate(); err != nil {
+			return fmt.Errorf("detector_actions: detector %q: %w", name, err)
+		}
+	}
+	return nil
+}
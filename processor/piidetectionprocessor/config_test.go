@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    func(*Config)
+		expectedErr string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "custom"),
+			expected: func(cfg *Config) {
+				cfg.Detectors = []string{"email", "credit_card"}
+				cfg.CustomDetectors = []CustomDetector{
+					{Name: "employee_id", Patterns: []string{`\bEMP-\d{6}\b`}, Action: Remove},
+				}
+				cfg.Action = Hash
+				cfg.DetectorActions = map[string]Action{"credit_card": Mask}
+				cfg.HashSalt = "s3cr3t"
+				cfg.IgnoredKeys = []string{"user.id"}
+				cfg.SpanNames = false
+				cfg.LogBodies = false
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknown_detector"),
+			expectedErr: `unknown detector "passport"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unnamed_custom_detector"),
+			expectedErr: "custom detectors must have a name",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "duplicate_custom_detector"),
+			expectedErr: `duplicate detector "email"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_patterns"),
+			expectedErr: `custom detector "employee_id" must have at least one pattern`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_pattern"),
+			expectedErr: "invalid pattern of the custom detector \"employee_id\": error parsing regexp: missing closing ): `EMP-(\\d+`",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_action"),
+			expectedErr: `unknown action "drop", must be one of "mask", "hash" or "remove"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_detector_action"),
+			expectedErr: `detector_actions: detector "email": unknown action "drop", must be one of "mask", "hash" or "remove"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "unknown_detector_action"),
+			expectedErr: `detector_actions: unknown detector "passport"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+
+			expected := createDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
+
+import (
+	"math/big"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// detector finds a kind of personally identifiable information in string values.
+type detector struct {
+	name     string
+	patterns []*regexp.Regexp
+	// validate filters out the matches of the patterns that aren't valid, e.g. card numbers
+	// failing the Luhn check, it is optional.
+	validate func(match string) bool
+	action   Action
+}
+
+// builtinDetector is a detector shipped with the processor.
+type builtinDetector struct {
+	pattern  string
+	validate func(match string) bool
+}
+
+// builtinDetectors are the detectors shipped with the processor. They are applied in the order
+// of builtinDetectorNames, the secrets first, as they may contain values matching the other detectors.
+var builtinDetectors = map[string]builtinDetector{
+	"private_key": {
+		pattern: `-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+	},
+	"jwt": {
+		pattern: `\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`,
+	},
+	"aws_access_key": {
+		pattern: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	},
+	"github_token": {
+		pattern: `\bgh[pousr]_[A-Za-z0-9]{36}\b`,
+	},
+	"email": {
+		pattern: `\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`,
+	},
+	"credit_card": {
+		pattern:  `\b\d(?:[ -]?\d){12,18}\b`,
+		validate: validCardNumber,
+	},
+	"us_ssn": {
+		pattern:  `\b\d{3}-\d{2}-\d{4}\b`,
+		validate: validSSN,
+	},
+	"uk_nino": {
+		pattern: `\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?\d{2} ?\d{2} ?\d{2} ?[A-D]\b`,
+	},
+	"iban": {
+		pattern:  `\b[A-Z]{2}\d{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,4})?\b`,
+		validate: validIBAN,
+	},
+	"ipv4": {
+		pattern:  `\b(?:\d{1,3}\.){3}\d{1,3}\b`,
+		validate: validIP,
+	},
+	"ipv6": {
+		// the address must not be surrounded by characters of identifiers or addresses,
+		// so that e.g. the C++ names aren't matched
+		pattern:  `(?i)(?:^|[^0-9a-z_:.])((?:[0-9a-f]{0,4}:){2,7}[0-9a-f]{0,4})(?:$|[^0-9a-z_:.])`,
+		validate: validIPv6,
+	},
+}
+
+var builtinDetectorNames = []string{
+	"private_key",
+	"jwt",
+	"aws_access_key",
+	"github_token",
+	"email",
+	"credit_card",
+	"us_ssn",
+	"uk_nino",
+	"iban",
+	"ipv4",
+	"ipv6",
+}
+
+// newDetectors returns the enabled built-in detectors followed by the custom ones,
+// with the action configured for each of them.
+func newDetectors(cfg *Config) []*detector {
+	enabled := cfg.Detectors
+	if len(enabled) == 0 {
+		enabled = builtinDetectorNames
+	}
+	actionOf := func(name string) Action {
+		if action, ok := cfg.DetectorActions[name]; ok {
+			return action
+		}
+		return cfg.Action
+	}
+
+	detectors := make([]*detector, 0, len(enabled)+len(cfg.CustomDetectors))
+	for _, name := range builtinDetectorNames {
+		if !contains(enabled, name) {
+			continue
+		}
+		builtin := builtinDetectors[name]
+		detectors = append(detectors, &detector{
+			name:     name,
+			patterns: []*regexp.Regexp{regexp.MustCompile(builtin.pattern)},
+			validate: builtin.validate,
+			action:   actionOf(name),
+		})
+	}
+	for _, custom := range cfg.CustomDetectors {
+		d := &detector{name: custom.Name, action: actionOf(custom.Name)}
+		if custom.Action != "" {
+			d.action = custom.Action
+		}
+		for _, pattern := range custom.Patterns {
+			// the patterns are validated with the configuration
+			d.patterns = append(d.patterns, regexp.MustCompile(pattern))
+		}
+		detectors = append(detectors, d)
+	}
+	return detectors
+}
+
+// matches returns the start and end offsets of the valid matches of the pattern in s.
+// The first capturing group of the pattern delimits the match when it is present.
+func (d *detector) matches(re *regexp.Regexp, s string) [][2]int {
+	var matches [][2]int
+	for _, loc := range re.FindAllStringSubmatchIndex(s, -1) {
+		start, end := loc[0], loc[1]
+		if len(loc) > 2 && loc[2] >= 0 {
+			start, end = loc[2], loc[3]
+		}
+		if d.validate == nil || d.validate(s[start:end]) {
+			matches = append(matches, [2]int{start, end})
+		}
+	}
+	return matches
+}
+
+// replace replaces the valid matches in s with the result of fn, and returns the number of matches.
+func (d *detector) replace(s string, fn func(match string) string) (string, int) {
+	var count int
+	for _, re := range d.patterns {
+		matches := d.matches(re, s)
+		if len(matches) == 0 {
+			continue
+		}
+		var b strings.Builder
+		last := 0
+		for _, m := range matches {
+			b.WriteString(s[last:m[0]])
+			b.WriteString(fn(s[m[0]:m[1]]))
+			last = m[1]
+		}
+		b.WriteString(s[last:])
+		s = b.String()
+		count += len(matches)
+	}
+	return s, count
+}
+
+// count returns the number of valid matches in s.
+func (d *detector) count(s string) int {
+	var count int
+	for _, re := range d.patterns {
+		count += len(d.matches(re, s))
+	}
+	return count
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// digits returns the digits of s, skipping the separators.
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// validCardNumber checks the number passes the Luhn check.
+func validCardNumber(match string) bool {
+	number := digits(match)
+	if len(number) < 13 || len(number) > 19 {
+		return false
+	}
+	var sum int
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// validSSN rejects the area, group and serial numbers that are never assigned.
+func validSSN(match string) bool {
+	area, group, serial := match[0:3], match[4:6], match[7:11]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// validIBAN checks the number passes the ISO 7064 mod 97 check.
+func validIBAN(match string) bool {
+	iban := strings.ReplaceAll(match, " ", "")
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			numeric.WriteString(big.NewInt(int64(r - 'A' + 10)).String())
+		} else {
+			numeric.WriteRune(r)
+		}
+	}
+	n, ok := new(big.Int).SetString(numeric.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func validIP(match string) bool {
+	return net.ParseIP(match) != nil
+}
+
+// validIPv6 rejects the unspecified address, as "::" is common in text, e.g. in scoped names.
+func validIPv6(match string) bool {
+	return strings.Count(match, ":") >= 2 && strings.Trim(match, ":") != "" && net.ParseIP(match) != nil
+}
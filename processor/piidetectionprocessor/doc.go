@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package piidetectionprocessor implements a processor detecting personally identifiable
+// information in the telemetry, and masking, hashing or removing it.
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/piidetectionprocessor/internal/metadata"
+)
+
+const (
+	maskedValue = "****"
+	// detectorAttr is the attribute of the detection metrics holding the name of the detector.
+	detectorAttr = "detector"
+)
+
+type piiDetectionProcessor struct {
+	cfg         *Config
+	detectors   []*detector
+	ignoredKeys map[string]bool
+
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    attribute.KeyValue
+}
+
+// detections counts the values detected in a batch by detector.
+type detections map[string]int64
+
+func newPIIDetectionProcessor(cfg *Config, set processor.CreateSettings) (*piiDetectionProcessor, error) {
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+	ignoredKeys := make(map[string]bool, len(cfg.IgnoredKeys))
+	for _, key := range cfg.IgnoredKeys {
+		ignoredKeys[key] = true
+	}
+	return &piiDetectionProcessor{
+		cfg:              cfg,
+		detectors:        newDetectors(cfg),
+		ignoredKeys:      ignoredKeys,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    attribute.String(metadata.Type.String(), set.ID.String()),
+	}, nil
+}
+
+func (p *piiDetectionProcessor) processTraces(ctx context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	found := make(detections)
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		p.processMap(rs.Resource().Attributes(), found)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				p.processMap(span.Attributes(), found)
+				if p.cfg.SpanNames {
+					// the span names can't be removed, the values are masked instead
+					name, _ := p.processString(span.Name(), false, found)
+					span.SetName(name)
+				}
+				for l := 0; l < span.Events().Len(); l++ {
+					p.processMap(span.Events().At(l).Attributes(), found)
+				}
+			}
+		}
+	}
+	p.record(ctx, found)
+	return td, nil
+}
+
+func (p *piiDetectionProcessor) processMetrics(ctx context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	found := make(detections)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		p.processMap(rm.Resource().Attributes(), found)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				p.processMetric(metrics.At(k), found)
+			}
+		}
+	}
+	p.record(ctx, found)
+	return md, nil
+}
+
+func (p *piiDetectionProcessor) processMetric(m pmetric.Metric, found detections) {
+	//exhaustive:enforce
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			p.processMap(m.Gauge().DataPoints().At(i).Attributes(), found)
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+			p.processMap(m.Sum().DataPoints().At(i).Attributes(), found)
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < m.Histogram().DataPoints().Len(); i++ {
+			p.processMap(m.Histogram().DataPoints().At(i).Attributes(), found)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < m.ExponentialHistogram().DataPoints().Len(); i++ {
+			p.processMap(m.ExponentialHistogram().DataPoints().At(i).Attributes(), found)
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < m.Summary().DataPoints().Len(); i++ {
+			p.processMap(m.Summary().DataPoints().At(i).Attributes(), found)
+		}
+	case pmetric.MetricTypeEmpty:
+	}
+}
+
+func (p *piiDetectionProcessor) processLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	found := make(detections)
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		p.processMap(rl.Resource().Attributes(), found)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				lr := records.At(k)
+				p.processMap(lr.Attributes(), found)
+				if p.cfg.LogBodies && p.processValue(lr.Body(), found) {
+					pcommon.NewValueEmpty().CopyTo(lr.Body())
+				}
+			}
+		}
+	}
+	p.record(ctx, found)
+	return ld, nil
+}
+
+// processMap applies the actions to the values of the map, except the ignored keys,
+// and removes the entries holding a value detected by a detector which action is remove.
+func (p *piiDetectionProcessor) processMap(m pcommon.Map, found detections) {
+	m.RemoveIf(func(key string, value pcommon.Value) bool {
+		return !p.ignoredKeys[key] && p.processValue(value, found)
+	})
+}
+
+// processValue applies the actions to a string, or to the nested values of a map or a slice.
+// It returns true if the value must be removed.
+func (p *piiDetectionProcessor) processValue(value pcommon.Value, found detections) bool {
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		s, remove := p.processString(value.Str(), true, found)
+		if remove {
+			return true
+		}
+		if s != value.Str() {
+			value.SetStr(s)
+		}
+	case pcommon.ValueTypeMap:
+		p.processMap(value.Map(), found)
+	case pcommon.ValueTypeSlice:
+		value.Slice().RemoveIf(func(elem pcommon.Value) bool {
+			return p.processValue(elem, found)
+		})
+	}
+	return false
+}
+
+// processString masks or hashes the values detected in s. When removable is true, it returns
+// true if a detector which action is remove matched, otherwise those values are masked.
+func (p *piiDetectionProcessor) processString(s string, removable bool, found detections) (string, bool) {
+	remove := false
+	for _, d := range p.detectors {
+		var n int
+		switch {
+		case d.action == Remove && removable:
+			if n = d.count(s); n > 0 {
+				remove = true
+			}
+		case d.action == Hash:
+			s, n = d.replace(s, p.hash)
+		default:
+			s, n = d.replace(s, mask)
+		}
+		if n > 0 {
+			found[d.name] += int64(n)
+		}
+	}
+	return s, remove
+}
+
+func (p *piiDetectionProcessor) hash(value string) string {
+	sum := sha256.Sum256([]byte(string(p.cfg.HashSalt) + value))
+	return hex.EncodeToString(sum[:])
+}
+
+func mask(string) string {
+	return maskedValue
+}
+
+// record adds the detections of a batch to the detection metrics.
+func (p *piiDetectionProcessor) record(ctx context.Context, found detections) {
+	for name, count := range found {
+		p.telemetryBuilder.ProcessorPiidetectionDetections.Add(ctx, count,
+			metric.WithAttributes(p.processorAttr, attribute.String(detectorAttr, name)))
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package piidetectionprocessor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestProcessTraces(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.DetectorActions = map[string]Action{"email": Remove}
+	cfg.IgnoredKeys = []string{"client.address"}
+
+	sink := new(consumertest.TracesSink)
+	tp, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("host.ip", "10.1.2.3")
+	span := rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetName("GET /users/jane@example.com")
+	span.Attributes().PutStr("client.address", "10.1.2.4")
+	span.Attributes().PutStr("user.email", "jane@example.com")
+	span.Attributes().PutStr("payment", "card 4111111111111111 accepted")
+	span.Events().AppendEmpty().Attributes().PutStr("exception.message", "user 123-45-6789 not found")
+
+	require.NoError(t, tp.ConsumeTraces(context.Background(), td))
+	out := sink.AllTraces()[0].ResourceSpans().At(0)
+	assert.Equal(t, map[string]any{"host.ip": "****"}, out.Resource().Attributes().AsRaw())
+	span = out.ScopeSpans().At(0).Spans().At(0)
+	// the span names can't be removed, the emails are masked instead
+	assert.Equal(t, "GET /users/****", span.Name())
+	assert.Equal(t, map[string]any{
+		"client.address": "10.1.2.4",
+		"payment":        "card **** accepted",
+	}, span.Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"exception.message": "user **** not found"}, span.Events().At(0).Attributes().AsRaw())
+}
+
+func TestProcessMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Action = Hash
+	cfg.HashSalt = "salt"
+
+	sink := new(consumertest.MetricsSink)
+	mp, err := NewFactory().CreateMetricsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("user", "jane@example.com")
+	metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("peer", "10.1.2.3")
+	metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr("route", "/orders")
+
+	require.NoError(t, mp.ConsumeMetrics(context.Background(), md))
+	out := sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, map[string]any{"user": sha256Hex("saltjane@example.com")}, out.At(0).Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"peer": sha256Hex("salt10.1.2.3")}, out.At(1).Sum().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{"route": "/orders"}, out.At(2).Histogram().DataPoints().At(0).Attributes().AsRaw())
+}
+
+func TestProcessLogs(t *testing.T) {
+	tel := setupTestTelemetry()
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomDetectors = []CustomDetector{
+		{Name: "employee_id", Patterns: []string{`\bEMP-\d{6}\b`}, Action: Remove},
+	}
+	cfg.IgnoredKeys = []string{"trace.ip"}
+
+	sink := new(consumertest.LogsSink)
+	lp, err := NewFactory().CreateLogsProcessor(context.Background(), tel.NewCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	structured := records.AppendEmpty()
+	structured.Attributes().PutStr("owner", "EMP-123456")
+	require.NoError(t, structured.Body().SetEmptyMap().FromRaw(map[string]any{
+		"message": "login of jane@example.com",
+		"request": map[string]any{
+			"trace.ip": "10.1.2.3",
+			"client":   "10.1.2.4",
+			"tags":     []any{"EMP-654321", "admin"},
+		},
+		"count": 3,
+	}))
+	records.AppendEmpty().Body().SetStr("badge EMP-123456 of jane@example.com")
+	records.AppendEmpty().Body().SetStr("nothing to see")
+
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	out := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, map[string]any{}, out.At(0).Attributes().AsRaw())
+	assert.Equal(t, map[string]any{
+		"message": "login of ****",
+		"request": map[string]any{
+			"trace.ip": "10.1.2.3",
+			"client":   "****",
+			"tags":     []any{"admin"},
+		},
+		"count": int64(3),
+	}, out.At(0).Body().AsRaw())
+	assert.Nil(t, out.At(1).Body().AsRaw())
+	assert.Equal(t, "nothing to see", out.At(2).Body().Str())
+
+	dataPoint := func(detector string, value int64) metricdata.DataPoint[int64] {
+		return metricdata.DataPoint[int64]{
+			Value:      value,
+			Attributes: attribute.NewSet(attribute.String("piidetection", "piidetection"), attribute.String("detector", detector)),
+		}
+	}
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_piidetection_detections",
+			Description: "Number of values detected as personally identifiable information, by detector",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					dataPoint("email", 2),
+					dataPoint("ipv4", 1),
+					dataPoint("employee_id", 3),
+				},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
+func TestProcessLogsDisabledBodies(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.LogBodies = false
+
+	sink := new(consumertest.LogsSink)
+	lp, err := NewFactory().CreateLogsProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.Body().SetStr("login of jane@example.com")
+	lr.Attributes().PutStr("user", "jane@example.com")
+
+	require.NoError(t, lp.ConsumeLogs(context.Background(), ld))
+	out := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "login of jane@example.com", out.Body().Str())
+	assert.Equal(t, map[string]any{"user": "****"}, out.Attributes().AsRaw())
+}
@@ -3,6 +3,14 @@
 
 package redactionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor"
 
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+var _ component.ConfigValidator = (*Config)(nil)
+
 type Config struct {
 
 	// AllowAllKeys is a flag to allow all span attribute keys. Setting this
@@ -30,4 +38,18 @@ type Config struct {
 	// information, while it is valuable when integrating and testing a new
 	// configuration. Possible values are `debug`, `info`, and `silent`.
 	Summary string `mapstructure:"summary"`
+
+	// MaxDepth is the number of levels of nested maps and slices inspected in
+	// attribute values and log bodies. The keys and values at every level are
+	// processed like the attributes. Maps and slices nested deeper are removed,
+	// as they can't be inspected.
+	MaxDepth int `mapstructure:"max_depth"`
+}
+
+// Validate checks the maximum depth of nested values is valid
+func (c *Config) Validate() error {
+	if c.MaxDepth < 0 {
+		return errors.New("max_depth must not be negative")
+	}
+	return nil
 }
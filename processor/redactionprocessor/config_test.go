@@ -30,6 +30,7 @@ func TestLoadConfig(t *testing.T) {
 				IgnoredKeys:   []string{"safe_attribute"},
 				BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?", "(5[1-5][0-9]{14})"},
 				Summary:       debug,
+				MaxDepth:      5,
 			},
 		},
 		{
@@ -11,5 +11,6 @@ var (
 )
 
 const (
+	LogsStability   = component.StabilityLevelDevelopment
 	TracesStability = component.StabilityLevelBeta
 )
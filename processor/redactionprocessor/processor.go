@@ -11,8 +11,14 @@ import (
 	"strings"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/redactionprocessor/internal/metadata"
 )
 
 const attrValuesSeparator = ","
@@ -28,10 +34,13 @@ type redaction struct {
 	config *Config
 	// Logger
 	logger *zap.Logger
+	// Telemetry of the redacted keys and masked values
+	telemetryBuilder *metadata.TelemetryBuilder
+	processorAttr    attribute.KeyValue
 }
 
 // newRedaction creates a new instance of the redaction processor
-func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*redaction, error) {
+func newRedaction(ctx context.Context, config *Config, set processor.CreateSettings) (*redaction, error) {
 	allowList := makeAllowList(config)
 	ignoreList := makeIgnoreList(config)
 	blockRegexList, err := makeBlockRegexList(ctx, config)
@@ -39,13 +48,19 @@ func newRedaction(ctx context.Context, config *Config, logger *zap.Logger) (*red
 		// TODO: Placeholder for an error metric in the next PR
 		return nil, fmt.Errorf("failed to process block list: %w", err)
 	}
+	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
 
 	return &redaction{
-		allowList:      allowList,
-		ignoreList:     ignoreList,
-		blockRegexList: blockRegexList,
-		config:         config,
-		logger:         logger,
+		allowList:        allowList,
+		ignoreList:       ignoreList,
+		blockRegexList:   blockRegexList,
+		config:           config,
+		logger:           set.Logger,
+		telemetryBuilder: telemetryBuilder,
+		processorAttr:    attribute.String(metadata.Type.String(), set.ID.String()),
 	}, nil
 }
 
@@ -59,6 +74,22 @@ func (s *redaction) processTraces(ctx context.Context, batch ptrace.Traces) (ptr
 	return batch, nil
 }
 
+// processLogs implements ProcessLogsFunc. It processes the attributes and the
+// bodies of the incoming logs and returns the logs to be sent to the next component
+func (s *redaction) processLogs(ctx context.Context, logs plog.Logs) (plog.Logs, error) {
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		rl := logs.ResourceLogs().At(i)
+		s.processAttrs(ctx, rl.Resource().Attributes())
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				s.processLogRecord(ctx, sl.LogRecords().At(k))
+			}
+		}
+	}
+	return logs, nil
+}
+
 // processResourceSpan processes the RS and all of its spans and then returns the last
 // view metric context. The context can be used for tests
 func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceSpans) {
@@ -79,12 +110,39 @@ func (s *redaction) processResourceSpan(ctx context.Context, rs ptrace.ResourceS
 	}
 }
 
-// processAttrs redacts the attributes of a resource span or a span
-func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
-	// TODO: Use the context for recording metrics
+// redactionResult holds the keys redacted, masked and ignored while processing
+// the attributes of a resource, span or log record. The keys of nested maps are
+// prefixed with the keys of their parents, separated by dots.
+type redactionResult struct {
+	redacted []string
+	masked   []string
+	ignored  []string
+}
+
+// processAttrs redacts the attributes of a resource, a span or a log record
+func (s *redaction) processAttrs(ctx context.Context, attributes pcommon.Map) {
+	var result redactionResult
+	s.redactMap(attributes, "", 0, &result)
+	s.summarize(ctx, attributes, &result)
+}
+
+// processLogRecord redacts the attributes and the body of a log record. The body
+// is processed as the value of an attribute named body, and the summary of both
+// is added to the attributes.
+func (s *redaction) processLogRecord(ctx context.Context, lr plog.LogRecord) {
+	var result redactionResult
+	s.redactMap(lr.Attributes(), "", 0, &result)
+	if s.redactValue(lr.Body(), "body", 0, &result) {
+		pcommon.NewValueEmpty().CopyTo(lr.Body())
+		result.redacted = append(result.redacted, "body")
+	}
+	s.summarize(ctx, lr.Attributes(), &result)
+}
+
+// redactMap redacts the keys of a map, recursing into the nested maps and slices
+// at the given depth.
+func (s *redaction) redactMap(m pcommon.Map, prefix string, depth int, result *redactionResult) {
 	var toDelete []string
-	var toBlock []string
-	var ignoring []string
 
 	// Identify attributes to redact and mask in the following sequence
 	// 1. Make a list of attribute keys to redact
@@ -94,10 +152,11 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 	// This sequence satisfies these performance constraints:
 	// - Only range through all attributes once
 	// - Don't mask any values if the whole attribute is slated for deletion
-	attributes.Range(func(k string, value pcommon.Value) bool {
+	m.Range(func(k string, value pcommon.Value) bool {
+		key := prefix + k
 		// don't delete or redact the attribute if it should be ignored
 		if _, ignored := s.ignoreList[k]; ignored {
-			ignoring = append(ignoring, k)
+			result.ignored = append(result.ignored, key)
 			// Skip to the next attribute
 			return true
 		}
@@ -106,38 +165,94 @@ func (s *redaction) processAttrs(_ context.Context, attributes pcommon.Map) {
 		if !s.config.AllowAllKeys {
 			if _, allowed := s.allowList[k]; !allowed {
 				toDelete = append(toDelete, k)
+				result.redacted = append(result.redacted, key)
 				// Skip to the next attribute
 				return true
 			}
 		}
 
 		// Mask any blocked values for the other attributes
-		strVal := value.Str()
-		var matched bool
-		for _, compiledRE := range s.blockRegexList {
-			match := compiledRE.MatchString(strVal)
-			if match {
-				if !matched {
-					matched = true
-					toBlock = append(toBlock, k)
-				}
-
-				maskedValue := compiledRE.ReplaceAllString(strVal, "****")
-				value.SetStr(maskedValue)
-				strVal = maskedValue
-			}
+		if s.redactValue(value, key, depth, result) {
+			toDelete = append(toDelete, k)
+			result.redacted = append(result.redacted, key)
 		}
 		return true
 	})
 
 	// Delete the attributes on the redaction list
 	for _, k := range toDelete {
-		attributes.Remove(k)
+		m.Remove(k)
+	}
+}
+
+// redactValue masks the blocked values of a string, and redacts the nested maps
+// and slices. It returns true if the value is nested deeper than the maximum depth,
+// and must be removed as it can't be inspected.
+func (s *redaction) redactValue(value pcommon.Value, key string, depth int, result *redactionResult) bool {
+	switch value.Type() {
+	case pcommon.ValueTypeStr:
+		if s.maskValue(value) {
+			result.masked = append(result.masked, key)
+		}
+	case pcommon.ValueTypeMap:
+		if depth >= s.config.MaxDepth {
+			return true
+		}
+		s.redactMap(value.Map(), key+".", depth+1, result)
+	case pcommon.ValueTypeSlice:
+		if depth >= s.config.MaxDepth {
+			return true
+		}
+		var masked bool
+		value.Slice().RemoveIf(func(v pcommon.Value) bool {
+			if v.Type() == pcommon.ValueTypeStr {
+				// The masked elements of a slice are summarized once, by the key of the slice
+				masked = s.maskValue(v) || masked
+				return false
+			}
+			if s.redactValue(v, key, depth+1, result) {
+				result.redacted = append(result.redacted, key)
+				return true
+			}
+			return false
+		})
+		if masked {
+			result.masked = append(result.masked, key)
+		}
 	}
-	// Add diagnostic information to the span
-	s.addMetaAttrs(toDelete, attributes, redactedKeys, redactedKeyCount)
-	s.addMetaAttrs(toBlock, attributes, maskedValues, maskedValueCount)
-	s.addMetaAttrs(ignoring, attributes, "", ignoredKeyCount)
+	return false
+}
+
+// maskValue masks the parts of a string value matching the blocked values, and
+// returns whether any part was masked
+func (s *redaction) maskValue(value pcommon.Value) bool {
+	strVal := value.Str()
+	var matched bool
+	for _, compiledRE := range s.blockRegexList {
+		if compiledRE.MatchString(strVal) {
+			matched = true
+			strVal = compiledRE.ReplaceAllString(strVal, "****")
+		}
+	}
+	if matched {
+		value.SetStr(strVal)
+	}
+	return matched
+}
+
+// summarize adds diagnostic information to the attributes, and records the
+// number of redacted keys and masked values
+func (s *redaction) summarize(ctx context.Context, attributes pcommon.Map, result *redactionResult) {
+	if len(result.redacted) > 0 {
+		s.telemetryBuilder.ProcessorRedactionKeysRedacted.Add(ctx, int64(len(result.redacted)), metric.WithAttributes(s.processorAttr))
+	}
+	if len(result.masked) > 0 {
+		s.telemetryBuilder.ProcessorRedactionValuesMasked.Add(ctx, int64(len(result.masked)), metric.WithAttributes(s.processorAttr))
+	}
+
+	s.addMetaAttrs(result.redacted, attributes, redactedKeys, redactedKeyCount)
+	s.addMetaAttrs(result.masked, attributes, maskedValues, maskedValueCount)
+	s.addMetaAttrs(result.ignored, attributes, "", ignoredKeyCount)
 }
 
 // addMetaAttrs adds diagnostic information about redacted or masked attribute keys
@@ -11,9 +11,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
-	"go.uber.org/zap/zaptest"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 // TestRedactUnknownAttributes validates that the processor deletes span
@@ -336,7 +340,7 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
 		Summary:       "debug",
 	}
-	processor, err := newRedaction(context.TODO(), config, zaptest.NewLogger(t))
+	processor, err := newRedaction(context.TODO(), config, processortest.NewNopCreateSettings())
 	require.NoError(t, err)
 
 	attrs := pcommon.NewMap()
@@ -367,6 +371,153 @@ func TestProcessAttrsAppliedTwice(t *testing.T) {
 	assert.Equal(t, int64(2), val.Int())
 }
 
+// TestRedactNestedValues validates that the processor applies the allowed
+// keys and blocked values to the keys and values of nested maps and slices
+func TestRedactNestedValues(t *testing.T) {
+	config := &Config{
+		AllowedKeys:   []string{"user", "name", "cards", "number", "tags"},
+		IgnoredKeys:   []string{"safe"},
+		BlockedValues: []string{"4[0-9]{12}(?:[0-9]{3})?"},
+		Summary:       "debug",
+		MaxDepth:      defaultMaxDepth,
+	}
+	processor, err := newRedaction(context.Background(), config, processortest.NewNopCreateSettings())
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	require.NoError(t, attrs.FromRaw(map[string]any{
+		"user": map[string]any{
+			"name":       "placeholder",
+			"birth_date": "1970-01-01",
+			"safe":       map[string]any{"anything": "4111111111111111"},
+			"cards": []any{
+				map[string]any{"number": "4111111111111111", "cvv": "123"},
+			},
+			"tags": []any{"4111111111111111", "vip", "4111111111111112"},
+		},
+	}))
+	processor.processAttrs(context.Background(), attrs)
+
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{
+			"name":  "placeholder",
+			"safe":  map[string]any{"anything": "4111111111111111"},
+			"cards": []any{map[string]any{"number": "****"}},
+			"tags":  []any{"****", "vip", "****"},
+		},
+		redactedKeys:     "user.birth_date,user.cards.cvv",
+		redactedKeyCount: int64(2),
+		maskedValues:     "user.cards.number,user.tags",
+		maskedValueCount: int64(2),
+		ignoredKeyCount:  int64(1),
+	}, attrs.AsRaw())
+}
+
+// TestRedactMaxDepth validates that the processor removes the maps and slices
+// nested deeper than the maximum depth
+func TestRedactMaxDepth(t *testing.T) {
+	config := &Config{
+		AllowAllKeys: true,
+		Summary:      "debug",
+		MaxDepth:     1,
+	}
+	processor, err := newRedaction(context.Background(), config, processortest.NewNopCreateSettings())
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	require.NoError(t, attrs.FromRaw(map[string]any{
+		"flat":   "value",
+		"nested": map[string]any{"name": "value", "deeper": map[string]any{"name": "value"}},
+		"list":   []any{"value", []any{"value"}},
+	}))
+	processor.processAttrs(context.Background(), attrs)
+
+	assert.Equal(t, map[string]any{
+		"flat":           "value",
+		"nested":         map[string]any{"name": "value"},
+		"list":           []any{"value"},
+		redactedKeys:     "list,nested.deeper",
+		redactedKeyCount: int64(2),
+	}, attrs.AsRaw())
+}
+
+// TestRedactLogs validates that the processor redacts the attributes and the
+// structured bodies of log records, and summarizes both in the attributes
+func TestRedactLogs(t *testing.T) {
+	tel := setupTestTelemetry()
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.AllowedKeys = []string{"id", "message", "user"}
+	cfg.BlockedValues = []string{"4[0-9]{12}(?:[0-9]{3})?"}
+	cfg.Summary = "debug"
+
+	sink := new(consumertest.LogsSink)
+	lp, err := factory.CreateLogsProcessor(context.Background(), tel.NewCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("host", "redacted")
+	records := rl.ScopeLogs().AppendEmpty().LogRecords()
+	structured := records.AppendEmpty()
+	structured.Attributes().PutInt("id", 5)
+	require.NoError(t, structured.Body().SetEmptyMap().FromRaw(map[string]any{
+		"message": "paid with 4111111111111111",
+		"user":    map[string]any{"id": "u-1", "password": "hunter2"},
+	}))
+	records.AppendEmpty().Body().SetStr("paid with 4111111111111111")
+
+	require.NoError(t, lp.ConsumeLogs(context.Background(), logs))
+	out := sink.AllLogs()[0].ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{
+		redactedKeys:     "host",
+		redactedKeyCount: int64(1),
+	}, out.Resource().Attributes().AsRaw())
+
+	structured = out.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, map[string]any{
+		"message": "paid with ****",
+		"user":    map[string]any{"id": "u-1"},
+	}, structured.Body().AsRaw())
+	assert.Equal(t, map[string]any{
+		"id":             int64(5),
+		redactedKeys:     "body.user.password",
+		redactedKeyCount: int64(1),
+		maskedValues:     "body.message",
+		maskedValueCount: int64(1),
+	}, structured.Attributes().AsRaw())
+
+	plain := out.ScopeLogs().At(0).LogRecords().At(1)
+	assert.Equal(t, "paid with ****", plain.Body().Str())
+	masked, _ := plain.Attributes().Get(maskedValues)
+	assert.Equal(t, "body", masked.Str())
+
+	processorAttr := attribute.NewSet(attribute.String("redaction", "redaction"))
+	tel.assertMetrics(t, []metricdata.Metrics{
+		{
+			Name:        "processor_redaction_keys.redacted",
+			Description: "Number of attribute, nested map and log body keys removed because they are not allowed",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 2, Attributes: processorAttr}},
+			},
+		},
+		{
+			Name:        "processor_redaction_values.masked",
+			Description: "Number of attribute, nested and log body values masked because they match a blocked value",
+			Unit:        "1",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 2, Attributes: processorAttr}},
+			},
+		},
+	})
+	require.NoError(t, tel.Shutdown(context.Background()))
+}
+
 // runTest transforms the test input data and passes it through the processor
 func runTest(
 	t *testing.T,
@@ -406,7 +557,7 @@ func runTest(
 
 	// test
 	ctx := context.Background()
-	processor, err := newRedaction(ctx, config, zaptest.NewLogger(t))
+	processor, err := newRedaction(ctx, config, processortest.NewNopCreateSettings())
 	assert.NoError(t, err)
 	outBatch, err := processor.processTraces(ctx, inBatch)
 
@@ -440,7 +591,7 @@ func BenchmarkRedactSummaryDebug(b *testing.B) {
 		"credit_card": pcommon.NewValueStr("would be nice"),
 	}
 	ctx := context.Background()
-	processor, _ := newRedaction(ctx, config, zaptest.NewLogger(b))
+	processor, _ := newRedaction(ctx, config, processortest.NewNopCreateSettings())
 
 	for i := 0; i < b.N; i++ {
 		runBenchmark(allowed, redacted, masked, ignored, processor)
@@ -471,7 +622,7 @@ func BenchmarkMaskSummaryDebug(b *testing.B) {
 		"also_safe":      pcommon.NewValueStr("suspicious 4111111111111113"),
 	}
 	ctx := context.Background()
-	processor, _ := newRedaction(ctx, config, zaptest.NewLogger(b))
+	processor, _ := newRedaction(ctx, config, processortest.NewNopCreateSettings())
 
 	for i := 0; i < b.N; i++ {
 		runBenchmark(allowed, nil, masked, ignored, processor)
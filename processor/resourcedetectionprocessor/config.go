@@ -4,6 +4,9 @@
 package resourcedetectionprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor"
 
 import (
+	"fmt"
+	"strings"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
@@ -18,6 +21,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/k8snode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/system"
@@ -29,6 +33,9 @@ type Config struct {
 	// Detectors is an ordered list of named detectors that should be
 	// run to attempt to detect resource information.
 	Detectors []string `mapstructure:"detectors"`
+	// DetectorSettings holds the timeout and failure policy of the run of
+	// the detectors, by detector name.
+	DetectorSettings map[string]internal.DetectorSettings `mapstructure:"detector_settings"`
 	// Override indicates whether any existing resource attributes
 	// should be overridden or preserved. Defaults to true.
 	Override bool `mapstructure:"override"`
@@ -86,6 +93,29 @@ type DetectorConfig struct {
 
 	// K8SNode contains user-specified configurations for the K8SNode detector
 	K8SNodeConfig k8snode.Config `mapstructure:"k8snode"`
+
+	// HTTPMetadataConfig contains user-specified configurations for the HTTP metadata detector
+	HTTPMetadataConfig httpmetadata.Config `mapstructure:"http_metadata"`
+}
+
+// Validate checks the detector settings apply to configured detectors.
+func (cfg *Config) Validate() error {
+	for name, settings := range cfg.DetectorSettings {
+		found := false
+		for _, detector := range cfg.Detectors {
+			if strings.TrimSpace(detector) == strings.TrimSpace(name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("detector_settings of %q which is not one of the detectors", name)
+		}
+		if settings.Timeout < 0 {
+			return fmt.Errorf("detector_settings of %q has a negative timeout", name)
+		}
+	}
+	return nil
 }
 
 func detectorCreateDefaultConfig() DetectorConfig {
@@ -104,6 +134,7 @@ func detectorCreateDefaultConfig() DetectorConfig {
 		SystemConfig:           system.CreateDefaultConfig(),
 		OpenShiftConfig:        openshift.CreateDefaultConfig(),
 		K8SNodeConfig:          k8snode.CreateDefaultConfig(),
+		HTTPMetadataConfig:     httpmetadata.CreateDefaultConfig(),
 	}
 }
 
@@ -137,6 +168,8 @@ func (d *DetectorConfig) GetConfigFromType(detectorType internal.DetectorType) i
 		return d.OpenShiftConfig
 	case k8snode.TypeStr:
 		return d.K8SNodeConfig
+	case httpmetadata.TypeStr:
+		return d.HTTPMetadataConfig
 	default:
 		return nil
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/ec2"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/aws/lambda"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/system"
@@ -61,6 +63,21 @@ func TestLoadConfig(t *testing.T) {
 	resourceAttributesConfig.EC2Config = ec2ResourceAttributesConfig
 	resourceAttributesConfig.SystemConfig = systemResourceAttributesConfig
 
+	httpMetadataConfig := detectorCreateDefaultConfig()
+	httpMetadataConfig.HTTPMetadataConfig = httpmetadata.Config{
+		Endpoint: "http://169.254.169.254/openstack/latest/meta_data.json",
+		Headers:  map[string]configopaque.String{"Metadata-Flavor": "private"},
+		Attributes: map[string]string{
+			"host.id":                 "$.uuid",
+			"cloud.availability_zone": "$.availability_zone",
+		},
+		Cache: httpmetadata.CacheConfig{
+			Path:   "/var/lib/otelcol/meta_data.json",
+			MaxAge: 24 * time.Hour,
+		},
+		OnFailure: httpmetadata.OnFailureIgnore,
+	}
+
 	tests := []struct {
 		id           component.ID
 		expected     component.Config
@@ -130,6 +147,32 @@ func TestLoadConfig(t *testing.T) {
 				DetectorConfig: resourceAttributesConfig,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "detectorsettings"),
+			expected: &Config{
+				Detectors: []string{"env", "ec2", "gcp"},
+				DetectorSettings: map[string]internal.DetectorSettings{
+					"ec2": {Timeout: 500 * time.Millisecond, FailOnError: true},
+					"gcp": {Timeout: time.Second},
+				},
+				ClientConfig:   cfg,
+				Override:       false,
+				DetectorConfig: detectorCreateDefaultConfig(),
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "http_metadata"),
+			expected: &Config{
+				Detectors:      []string{"http_metadata"},
+				ClientConfig:   cfg,
+				Override:       false,
+				DetectorConfig: httpMetadataConfig,
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "invalid_detector_settings"),
+			errorMessage: `detector_settings of "ec2" which is not one of the detectors`,
+		},
 		{
 			id:           component.NewIDWithName(metadata.Type, "invalid"),
 			errorMessage: "hostname_sources contains invalid value: \"invalid_source\"",
@@ -28,6 +28,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/env"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/gcp"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/heroku"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/k8snode"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/openshift"
@@ -63,6 +64,7 @@ func NewFactory() processor.Factory {
 		system.TypeStr:           system.NewDetector,
 		openshift.TypeStr:        openshift.NewDetector,
 		k8snode.TypeStr:          k8snode.NewDetector,
+		httpmetadata.TypeStr:     httpmetadata.NewDetector,
 	})
 
 	f := &factory{
@@ -172,7 +174,7 @@ func (f *factory) getResourceDetectionProcessor(
 	if oCfg.Attributes != nil {
 		params.Logger.Warn("You are using deprecated `attributes` option that will be removed soon; use `resource_attributes` instead, details on configuration: https://github.com/open-telemetry/opentelemetry-collector-contrib/tree/main/processor/resourcedetectionprocessor#migration-from-attributes-to-resource_attributes")
 	}
-	provider, err := f.getResourceProvider(params, oCfg.ClientConfig.Timeout, oCfg.Detectors, oCfg.DetectorConfig, oCfg.Attributes)
+	provider, err := f.getResourceProvider(params, oCfg.ClientConfig.Timeout, oCfg.Detectors, oCfg.DetectorSettings, oCfg.DetectorConfig, oCfg.Attributes)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +191,7 @@ func (f *factory) getResourceProvider(
 	params processor.CreateSettings,
 	timeout time.Duration,
 	configuredDetectors []string,
+	configuredDetectorSettings map[string]internal.DetectorSettings,
 	detectorConfigs DetectorConfig,
 	attributes []string,
 ) (*internal.ResourceProvider, error) {
@@ -204,7 +207,12 @@ func (f *factory) getResourceProvider(
 		detectorTypes = append(detectorTypes, internal.DetectorType(strings.TrimSpace(key)))
 	}
 
-	provider, err := f.resourceProviderFactory.CreateResourceProvider(params, timeout, attributes, &detectorConfigs, detectorTypes...)
+	detectorSettings := make(map[internal.DetectorType]internal.DetectorSettings, len(configuredDetectorSettings))
+	for key, settings := range configuredDetectorSettings {
+		detectorSettings[internal.DetectorType(strings.TrimSpace(key))] = settings
+	}
+
+	provider, err := f.resourceProviderFactory.CreateResourceProvider(params, timeout, attributes, detectorSettings, &detectorConfigs, detectorTypes...)
 	if err != nil {
 		return nil, err
 	}
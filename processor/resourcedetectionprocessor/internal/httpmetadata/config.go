@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// OnFailure is what the detector does when the metadata document can't be fetched,
+// nor loaded from the cache.
+type OnFailure string
+
+const (
+	// OnFailureError fails the detector, see the detector_settings of the processor.
+	OnFailureError OnFailure = "error"
+	// OnFailureIgnore logs the failure and detects no attributes.
+	OnFailureIgnore OnFailure = "ignore"
+)
+
+// Config contains the endpoint serving the metadata document, and how its fields are
+// mapped to resource attributes.
+type Config struct {
+	// Endpoint is the URL of the metadata document, which must be a JSON document.
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Headers are added to the request fetching the metadata document.
+	Headers map[string]configopaque.String `mapstructure:"headers"`
+
+	// TLSSettings contains the TLS configuration of the connection to the endpoint.
+	TLSSettings configtls.ClientConfig `mapstructure:"tls"`
+
+	// Attributes maps the resource attributes to the JSONPath of their value in the
+	// metadata document, e.g. `host.id: $.instance.uuid`.
+	Attributes map[string]string `mapstructure:"attributes"`
+
+	// Cache holds the settings of the cache of the metadata document.
+	Cache CacheConfig `mapstructure:"cache"`
+
+	// OnFailure is either "error" or "ignore", defaults to "error".
+	OnFailure OnFailure `mapstructure:"on_failure"`
+}
+
+// CacheConfig contains the settings of the file caching the last metadata document
+// fetched, which is used when the endpoint can't be reached.
+type CacheConfig struct {
+	// Path of the cache file, the metadata document isn't cached when empty.
+	Path string `mapstructure:"path"`
+
+	// MaxAge is the age after which the cached document isn't used anymore,
+	// it is used regardless of its age when zero.
+	MaxAge time.Duration `mapstructure:"max_age"`
+}
+
+func CreateDefaultConfig() Config {
+	return Config{
+		OnFailure: OnFailureError,
+	}
+}
+
+// validate is called when the detector is created, as the configuration of the
+// detectors which aren't enabled is not required.
+func (c Config) validate() error {
+	if c.Endpoint == "" {
+		return errors.New("endpoint must be specified")
+	}
+	if u, err := url.Parse(c.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("endpoint %q must be an http or https URL", c.Endpoint)
+	}
+	if len(c.Attributes) == 0 {
+		return errors.New("attributes must map at least one resource attribute")
+	}
+	for attr, path := range c.Attributes {
+		if _, err := parsePath(path); err != nil {
+			return fmt.Errorf("invalid path of attribute %q: %w", attr, err)
+		}
+	}
+	if c.Cache.MaxAge < 0 {
+		return errors.New("cache max_age must not be negative")
+	}
+	switch c.OnFailure {
+	case OnFailureError, OnFailureIgnore:
+	default:
+		return fmt.Errorf("on_failure must be either %q or %q, got %q", OnFailureError, OnFailureIgnore, c.OnFailure)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package httpmetadata provides a detector that fetches a JSON metadata document from
+// an HTTP endpoint, and maps its fields to resource attributes. It supports the metadata
+// services of private clouds, which have no dedicated detector.
+package httpmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal"
+)
+
+const (
+	// TypeStr is type of detector.
+	TypeStr = "http_metadata"
+
+	// maxDocumentSize bounds the size of the metadata document read from the endpoint.
+	maxDocumentSize = 1 << 20
+)
+
+var _ internal.Detector = (*detector)(nil)
+
+type attribute struct {
+	name string
+	path path
+}
+
+type detector struct {
+	logger     *zap.Logger
+	client     *http.Client
+	cfg        Config
+	attributes []attribute
+	now        func() time.Time
+}
+
+// NewDetector returns a detector which maps the fields of the metadata document served
+// by the configured endpoint to resource attributes.
+func NewDetector(set processor.CreateSettings, dcfg internal.DetectorConfig) (internal.Detector, error) {
+	cfg := dcfg.(Config)
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	tlsCfg, err := cfg.TLSSettings.LoadTLSConfig(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+
+	// the attributes are sorted so that the detection is deterministic
+	attributes := make([]attribute, 0, len(cfg.Attributes))
+	for name, p := range cfg.Attributes {
+		parsed, _ := parsePath(p)
+		attributes = append(attributes, attribute{name: name, path: parsed})
+	}
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].name < attributes[j].name })
+
+	return &detector{
+		logger:     set.Logger,
+		client:     &http.Client{Transport: transport},
+		cfg:        cfg,
+		attributes: attributes,
+		now:        time.Now,
+	}, nil
+}
+
+func (d *detector) Detect(ctx context.Context) (resource pcommon.Resource, schemaURL string, err error) {
+	res := pcommon.NewResource()
+
+	doc, err := d.fetch(ctx)
+	if err != nil {
+		cached, errCache := d.loadCache()
+		switch {
+		case errCache == nil:
+			d.logger.Warn("failed to fetch the metadata document, using the cached document", zap.String("endpoint", d.cfg.Endpoint), zap.Error(err))
+			doc = cached
+		case d.cfg.OnFailure == OnFailureIgnore:
+			d.logger.Warn("failed to fetch the metadata document, ignoring it", zap.String("endpoint", d.cfg.Endpoint), zap.Error(errors.Join(err, errCache)))
+			return res, "", nil
+		default:
+			return res, "", fmt.Errorf("failed to fetch the metadata document: %w", errors.Join(err, errCache))
+		}
+	} else if errCache := d.storeCache(doc); errCache != nil {
+		d.logger.Warn("failed to cache the metadata document", zap.String("path", d.cfg.Cache.Path), zap.Error(errCache))
+	}
+
+	var parsed any
+	if err = json.Unmarshal(doc, &parsed); err != nil {
+		return res, "", fmt.Errorf("failed to decode the metadata document: %w", err)
+	}
+
+	attrs := res.Attributes()
+	for _, attr := range d.attributes {
+		v, err := attr.path.lookup(parsed)
+		if err != nil {
+			d.logger.Debug("attribute not found in the metadata document", zap.String("attribute", attr.name), zap.String("path", d.cfg.Attributes[attr.name]))
+			continue
+		}
+		if err = putValue(attrs, attr.name, v); err != nil {
+			return pcommon.NewResource(), "", fmt.Errorf("failed to set the attribute %q: %w", attr.name, err)
+		}
+	}
+	return res, "", nil
+}
+
+func (d *detector) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.cfg.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range d.cfg.Headers {
+		req.Header.Set(k, string(v))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	doc, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(doc) > maxDocumentSize {
+		return nil, fmt.Errorf("the metadata document is larger than %d bytes", maxDocumentSize)
+	}
+	if !json.Valid(doc) {
+		return nil, errors.New("the metadata document is not valid JSON")
+	}
+	return doc, nil
+}
+
+func (d *detector) loadCache() ([]byte, error) {
+	if d.cfg.Cache.Path == "" {
+		return nil, errors.New("no cached metadata document")
+	}
+	info, err := os.Stat(d.cfg.Cache.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cached metadata document: %w", err)
+	}
+	if age := d.now().Sub(info.ModTime()); d.cfg.Cache.MaxAge > 0 && age > d.cfg.Cache.MaxAge {
+		return nil, fmt.Errorf("the cached metadata document is %s old, older than the cache max_age", age.Round(time.Second))
+	}
+	doc, err := os.ReadFile(d.cfg.Cache.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cached metadata document: %w", err)
+	}
+	if !json.Valid(doc) {
+		return nil, errors.New("the cached metadata document is not valid JSON")
+	}
+	return doc, nil
+}
+
+// storeCache replaces the cached document atomically, so that a partially written
+// document is never loaded.
+func (d *detector) storeCache(doc []byte) error {
+	if d.cfg.Cache.Path == "" {
+		return nil
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(d.cfg.Cache.Path), filepath.Base(d.cfg.Cache.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(doc); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), d.cfg.Cache.Path)
+}
+
+// putValue sets the attribute to the JSON value, the integral numbers are set as integers.
+func putValue(attrs pcommon.Map, name string, v any) error {
+	if f, ok := v.(float64); ok && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		attrs.PutInt(name, int64(f))
+		return nil
+	}
+	return attrs.PutEmpty(name).FromRaw(v)
+}
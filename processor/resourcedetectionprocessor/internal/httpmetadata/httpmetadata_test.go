@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmetadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/processor/processortest"
+)
+
+const document = `{"uuid": "6a2c", "name": "web-1", "cpus": 4, "load": 0.5, "meta": {"tags": ["web"]}}`
+
+func newTestServer(t *testing.T, status *int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "private" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(*status)
+		_, _ = w.Write([]byte(document))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestConfig(endpoint string) Config {
+	cfg := CreateDefaultConfig()
+	cfg.Endpoint = endpoint
+	cfg.Headers = map[string]configopaque.String{"Metadata-Flavor": "private"}
+	cfg.Attributes = map[string]string{
+		"host.id":   "$.uuid",
+		"host.name": "$.name",
+		"host.cpus": "$.cpus",
+		"host.load": "$.load",
+		"host.tags": "$.meta.tags",
+		"host.type": "$.type",
+	}
+	return cfg
+}
+
+func newTestDetector(t *testing.T, cfg Config) *detector {
+	d, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+	require.NoError(t, err)
+	return d.(*detector)
+}
+
+func TestDetect(t *testing.T) {
+	status := http.StatusOK
+	srv := newTestServer(t, &status)
+	d := newTestDetector(t, newTestConfig(srv.URL))
+
+	res, schemaURL, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, schemaURL)
+	assert.Equal(t, map[string]any{
+		"host.id":   "6a2c",
+		"host.name": "web-1",
+		"host.cpus": int64(4),
+		"host.load": 0.5,
+		"host.tags": []any{"web"},
+	}, res.Attributes().AsRaw())
+}
+
+func TestDetectCache(t *testing.T) {
+	status := http.StatusOK
+	srv := newTestServer(t, &status)
+	cfg := newTestConfig(srv.URL)
+	cfg.Cache.Path = filepath.Join(t.TempDir(), "metadata.json")
+	cfg.Cache.MaxAge = time.Hour
+	d := newTestDetector(t, cfg)
+
+	_, _, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	cached, err := os.ReadFile(cfg.Cache.Path)
+	require.NoError(t, err)
+	assert.JSONEq(t, document, string(cached))
+
+	// the cached document is used while the endpoint fails
+	status = http.StatusServiceUnavailable
+	res, _, err := d.Detect(context.Background())
+	require.NoError(t, err)
+	id, _ := res.Attributes().Get("host.id")
+	assert.Equal(t, "6a2c", id.Str())
+
+	// until it is too old
+	d.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, _, err = d.Detect(context.Background())
+	assert.ErrorContains(t, err, "unexpected status 503 Service Unavailable")
+	assert.ErrorContains(t, err, "older than the cache max_age")
+}
+
+func TestDetectFailure(t *testing.T) {
+	status := http.StatusNotFound
+	srv := newTestServer(t, &status)
+
+	res, _, err := newTestDetector(t, newTestConfig(srv.URL)).Detect(context.Background())
+	assert.ErrorContains(t, err, "failed to fetch the metadata document: unexpected status 404 Not Found")
+	assert.Zero(t, res.Attributes().Len())
+
+	cfg := newTestConfig(srv.URL)
+	cfg.OnFailure = OnFailureIgnore
+	res, _, err = newTestDetector(t, cfg).Detect(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, res.Attributes().Len())
+}
+
+func TestNewDetectorInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{name: "no endpoint", modify: func(c *Config) { c.Endpoint = "" }, err: "endpoint must be specified"},
+		{name: "invalid endpoint", modify: func(c *Config) { c.Endpoint = "ftp://metadata" }, err: `endpoint "ftp://metadata" must be an http or https URL`},
+		{name: "no attributes", modify: func(c *Config) { c.Attributes = nil }, err: "attributes must map at least one resource attribute"},
+		{name: "invalid path", modify: func(c *Config) { c.Attributes = map[string]string{"host.id": "uuid"} }, err: `invalid path of attribute "host.id": path "uuid" must start with $`},
+		{name: "negative max age", modify: func(c *Config) { c.Cache.MaxAge = -time.Second }, err: "cache max_age must not be negative"},
+		{name: "invalid on_failure", modify: func(c *Config) { c.OnFailure = "retry" }, err: `on_failure must be either "error" or "ignore", got "retry"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newTestConfig("http://localhost")
+			tt.modify(&cfg)
+			_, err := NewDetector(processortest.NewNopCreateSettings(), cfg)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
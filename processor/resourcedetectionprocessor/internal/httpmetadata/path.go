@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmetadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/resourcedetectionprocessor/internal/httpmetadata"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// segment is either the key of an object member, or the index of an array element.
+type segment struct {
+	key   string
+	index int
+	array bool
+}
+
+// path is the subset of JSONPath selecting a single value: the root `$`, followed by
+// members `.name` or `['name']`, and array elements `[0]`.
+type path []segment
+
+func parsePath(s string) (path, error) {
+	if !strings.HasPrefix(s, "$") {
+		return nil, fmt.Errorf("path %q must start with $", s)
+	}
+	rest := s[1:]
+
+	var p path
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("path %q has an empty member name", s)
+			}
+			p = append(p, segment{key: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("path %q has an unterminated bracket", s)
+			}
+			seg, err := parseBracket(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("path %q: %w", s, err)
+			}
+			p = append(p, seg)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("path %q has an unexpected character %q", s, rest[0])
+		}
+	}
+	return p, nil
+}
+
+func parseBracket(s string) (segment, error) {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return segment{key: s[1 : len(s)-1]}, nil
+	}
+	index, err := strconv.Atoi(s)
+	if err != nil || index < 0 {
+		return segment{}, fmt.Errorf("%q is neither a quoted member name nor an array index", s)
+	}
+	return segment{index: index, array: true}, nil
+}
+
+var errNotFound = errors.New("no value at path")
+
+// lookup returns the value at the path in the document decoded by encoding/json.
+func (p path) lookup(doc any) (any, error) {
+	v := doc
+	for _, seg := range p {
+		switch node := v.(type) {
+		case map[string]any:
+			if seg.array {
+				return nil, errNotFound
+			}
+			var ok bool
+			if v, ok = node[seg.key]; !ok {
+				return nil, errNotFound
+			}
+		case []any:
+			if !seg.array || seg.index >= len(node) {
+				return nil, errNotFound
+			}
+			v = node[seg.index]
+		default:
+			return nil, errNotFound
+		}
+	}
+	if v == nil {
+		return nil, errNotFound
+	}
+	return v, nil
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmetadata
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathLookup(t *testing.T) {
+	var doc any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"uuid": "6a2c",
+		"meta": {"cluster.name": "prod", "tags": ["web", "eu"]},
+		"zones": [{"name": "az-1"}],
+		"empty": null
+	}`), &doc))
+
+	tests := []struct {
+		path     string
+		expected any
+		err      error
+	}{
+		{path: "$.uuid", expected: "6a2c"},
+		{path: "$['uuid']", expected: "6a2c"},
+		{path: `$.meta["cluster.name"]`, expected: "prod"},
+		{path: "$.meta.tags[1]", expected: "eu"},
+		{path: "$.zones[0].name", expected: "az-1"},
+		{path: "$.meta", expected: map[string]any{"cluster.name": "prod", "tags": []any{"web", "eu"}}},
+		{path: "$.missing", err: errNotFound},
+		{path: "$.meta.tags[2]", err: errNotFound},
+		{path: "$.uuid.name", err: errNotFound},
+		{path: "$.zones.name", err: errNotFound},
+		{path: "$.empty", err: errNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := parsePath(tt.path)
+			require.NoError(t, err)
+			v, err := p.lookup(doc)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.expected, v)
+		})
+	}
+}
+
+func TestParsePathErrors(t *testing.T) {
+	for _, p := range []string{"uuid", "$..uuid", "$.", "$[0", "$[-1]", "$[name]", "$uuid"} {
+		_, err := parsePath(p)
+		assert.Error(t, err, p)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -31,6 +32,16 @@ type ResourceDetectorConfig interface {
 
 type DetectorFactory func(processor.CreateSettings, DetectorConfig) (Detector, error)
 
+// DetectorSettings holds the settings of the run of a detector.
+type DetectorSettings struct {
+	// Timeout bounds the run of the detector, on top of the timeout of the whole detection.
+	// The timeout of the whole detection applies alone when zero.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// FailOnError fails the detection, and the start of the processor, when the detector
+	// fails or times out. By default the failure is logged and the detector is ignored.
+	FailOnError bool `mapstructure:"fail_on_error"`
+}
+
 type ResourceProviderFactory struct {
 	// detectors holds all possible detector types.
 	detectors map[DetectorType]DetectorFactory
@@ -44,6 +55,7 @@ func (f *ResourceProviderFactory) CreateResourceProvider(
 	params processor.CreateSettings,
 	timeout time.Duration,
 	attributes []string,
+	detectorSettings map[DetectorType]DetectorSettings,
 	detectorConfigs ResourceDetectorConfig,
 	detectorTypes ...DetectorType) (*ResourceProvider, error) {
 	detectors, err := f.getDetectors(params, detectorConfigs, detectorTypes)
@@ -59,6 +71,11 @@ func (f *ResourceProviderFactory) CreateResourceProvider(
 	}
 
 	provider := NewResourceProvider(params.Logger, timeout, attributesToKeep, detectors...)
+	provider.detectorTypes = detectorTypes
+	provider.detectorSettings = make([]DetectorSettings, len(detectorTypes))
+	for i, detectorType := range detectorTypes {
+		provider.detectorSettings[i] = detectorSettings[detectorType]
+	}
 	return provider, nil
 }
 
@@ -82,9 +99,13 @@ func (f *ResourceProviderFactory) getDetectors(params processor.CreateSettings,
 }
 
 type ResourceProvider struct {
-	logger           *zap.Logger
-	timeout          time.Duration
-	detectors        []Detector
+	logger    *zap.Logger
+	timeout   time.Duration
+	detectors []Detector
+	// detectorTypes and detectorSettings are the types and settings of the detectors, when
+	// created from their types.
+	detectorTypes    []DetectorType
+	detectorSettings []DetectorSettings
 	detectedResource *resourceResult
 	once             sync.Once
 	attributesToKeep map[string]struct{}
@@ -124,14 +145,31 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 
 	p.logger.Info("began detecting resource information")
 
-	for _, detector := range p.detectors {
-		r, schemaURL, err := detector.Detect(ctx)
-		if err != nil {
-			p.logger.Warn("failed to detect resource", zap.Error(err))
-		} else {
-			mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, schemaURL)
-			MergeResource(res, r, false)
+	// The detectors run concurrently, their results are merged in the order of the detectors
+	// so that the first detector setting an attribute wins.
+	results := make([]resourceResult, len(p.detectors))
+	var wg sync.WaitGroup
+	for i, detector := range p.detectors {
+		wg.Add(1)
+		go func(i int, detector Detector) {
+			defer wg.Done()
+			results[i] = p.runDetector(ctx, detector, p.settings(i))
+		}(i, detector)
+	}
+	wg.Wait()
+
+	for i, result := range results {
+		if result.err != nil {
+			if p.settings(i).FailOnError {
+				p.detectedResource.resource = pcommon.NewResource()
+				p.detectedResource.err = fmt.Errorf("failed to detect resource with detector %s: %w", p.detectorName(i), result.err)
+				return
+			}
+			p.logger.Warn("failed to detect resource", zap.String("detector", p.detectorName(i)), zap.Error(result.err))
+			continue
 		}
+		mergedSchemaURL = MergeSchemaURL(mergedSchemaURL, result.schemaURL)
+		MergeResource(res, result.resource, false)
 	}
 
 	droppedAttributes := filterAttributes(res.Attributes(), p.attributesToKeep)
@@ -145,6 +183,44 @@ func (p *ResourceProvider) detectResource(ctx context.Context) {
 	p.detectedResource.schemaURL = mergedSchemaURL
 }
 
+// runDetector runs a detector, giving up on it once its own timeout expires.
+func (p *ResourceProvider) runDetector(ctx context.Context, detector Detector, settings DetectorSettings) resourceResult {
+	if settings.Timeout <= 0 {
+		r, schemaURL, err := detector.Detect(ctx)
+		return resourceResult{resource: r, schemaURL: schemaURL, err: err}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, settings.Timeout)
+	defer cancel()
+
+	// Detectors not honoring the cancellation of the context are left running.
+	done := make(chan resourceResult, 1)
+	go func() {
+		r, schemaURL, err := detector.Detect(ctx)
+		done <- resourceResult{resource: r, schemaURL: schemaURL, err: err}
+	}()
+	select {
+	case result := <-done:
+		return result
+	case <-ctx.Done():
+		return resourceResult{err: ctx.Err()}
+	}
+}
+
+func (p *ResourceProvider) settings(i int) DetectorSettings {
+	if i < len(p.detectorSettings) {
+		return p.detectorSettings[i]
+	}
+	return DetectorSettings{}
+}
+
+func (p *ResourceProvider) detectorName(i int) string {
+	if i < len(p.detectorTypes) {
+		return fmt.Sprintf("%q", p.detectorTypes[i])
+	}
+	return strconv.Itoa(i)
+}
+
 func MergeSchemaURL(currentSchemaURL string, newSchemaURL string) string {
 	if currentSchemaURL == "" {
 		return newSchemaURL
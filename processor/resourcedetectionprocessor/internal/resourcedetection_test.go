@@ -101,7 +101,7 @@ func TestDetect(t *testing.T) {
 			}
 
 			f := NewProviderFactory(mockDetectors)
-			p, err := f.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, tt.attributes, &mockDetectorConfig{}, mockDetectorTypes...)
+			p, err := f.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, tt.attributes, nil, &mockDetectorConfig{}, mockDetectorTypes...)
 			require.NoError(t, err)
 
 			got, _, err := p.Get(context.Background(), http.DefaultClient)
@@ -115,7 +115,7 @@ func TestDetect(t *testing.T) {
 func TestDetectResource_InvalidDetectorType(t *testing.T) {
 	mockDetectorKey := DetectorType("mock")
 	p := NewProviderFactory(map[DetectorType]DetectorFactory{})
-	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, &mockDetectorConfig{}, mockDetectorKey)
+	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, nil, &mockDetectorConfig{}, mockDetectorKey)
 	require.EqualError(t, err, fmt.Sprintf("invalid detector key: %v", mockDetectorKey))
 }
 
@@ -126,7 +126,7 @@ func TestDetectResource_DetectoryFactoryError(t *testing.T) {
 			return nil, errors.New("creation failed")
 		},
 	})
-	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, &mockDetectorConfig{}, mockDetectorKey)
+	_, err := p.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, nil, &mockDetectorConfig{}, mockDetectorKey)
 	require.EqualError(t, err, fmt.Sprintf("failed creating detector type %q: %v", mockDetectorKey, "creation failed"))
 }
 
@@ -144,6 +144,119 @@ func TestDetectResource_Error(t *testing.T) {
 	require.NoError(t, err)
 }
 
+type blockingDetector struct{}
+
+func (blockingDetector) Detect(ctx context.Context) (pcommon.Resource, string, error) {
+	<-ctx.Done()
+	return pcommon.NewResource(), "", ctx.Err()
+}
+
+func TestDetectResource_DetectorSettings(t *testing.T) {
+	tests := []struct {
+		name             string
+		detectorSettings map[DetectorType]DetectorSettings
+		expectedResource map[string]any
+		expectedErr      string
+	}{
+		{
+			name: "Slow detector times out",
+			detectorSettings: map[DetectorType]DetectorSettings{
+				"slow": {Timeout: 10 * time.Millisecond},
+			},
+			expectedResource: map[string]any{"a": "1"},
+		},
+		{
+			name: "Slow detector fails the detection",
+			detectorSettings: map[DetectorType]DetectorSettings{
+				"slow": {Timeout: 10 * time.Millisecond, FailOnError: true},
+			},
+			expectedErr: `failed to detect resource with detector "slow": context deadline exceeded`,
+		},
+		{
+			name: "Failing detector fails the detection",
+			detectorSettings: map[DetectorType]DetectorSettings{
+				"slow":    {Timeout: 10 * time.Millisecond},
+				"failing": {FailOnError: true},
+			},
+			expectedErr: `failed to detect resource with detector "failing": err1`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := &MockDetector{}
+			res := pcommon.NewResource()
+			res.Attributes().PutStr("a", "1")
+			md.On("Detect").Return(res, nil)
+
+			failing := &MockDetector{}
+			failing.On("Detect").Return(pcommon.NewResource(), errors.New("err1"))
+
+			f := NewProviderFactory(map[DetectorType]DetectorFactory{
+				"slow": func(processor.CreateSettings, DetectorConfig) (Detector, error) {
+					return blockingDetector{}, nil
+				},
+				"mock": func(processor.CreateSettings, DetectorConfig) (Detector, error) {
+					return md, nil
+				},
+				"failing": func(processor.CreateSettings, DetectorConfig) (Detector, error) {
+					return failing, nil
+				},
+			})
+			p, err := f.CreateResourceProvider(processortest.NewNopCreateSettings(), time.Second, nil, tt.detectorSettings, &mockDetectorConfig{}, "slow", "mock", "failing")
+			require.NoError(t, err)
+
+			// The global timeout of the detection is far longer than the timeout of the slow detector.
+			client := &http.Client{Timeout: time.Minute}
+			start := time.Now()
+			got, _, err := p.Get(context.Background(), client)
+			assert.Less(t, time.Since(start), 30*time.Second)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResource, got.Attributes().AsRaw())
+		})
+	}
+}
+
+// TestDetectResource_Concurrent validates that the detectors run concurrently, every detector
+// below waits for all of them to be running.
+func TestDetectResource_Concurrent(t *testing.T) {
+	const detectors = 3
+
+	var running sync.WaitGroup
+	running.Add(detectors)
+	mds := make([]Detector, 0, detectors)
+	for i := 0; i < detectors; i++ {
+		md := NewMockParallelDetector()
+		res := pcommon.NewResource()
+		res.Attributes().PutStr("a", fmt.Sprint(i))
+		md.On("Detect").Return(res, nil)
+		go func() {
+			running.Wait()
+			md.ch <- struct{}{}
+		}()
+		mds = append(mds, &runningDetector{Detector: md, running: &running})
+	}
+
+	p := NewResourceProvider(zap.NewNop(), time.Second, nil, mds...)
+	detected, _, err := p.Get(context.Background(), http.DefaultClient)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "0"}, detected.Attributes().AsRaw())
+}
+
+type runningDetector struct {
+	Detector
+	running *sync.WaitGroup
+}
+
+func (d *runningDetector) Detect(ctx context.Context) (pcommon.Resource, string, error) {
+	d.running.Done()
+	return d.Detector.Detect(ctx)
+}
+
 func TestMergeResource(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
@@ -31,6 +31,16 @@ type Config struct {
 	// translated to, allowing older and newer formats
 	// to conform to the target schema identifier.
 	Targets []string `mapstructure:"targets"`
+
+	// CacheDirectory is a directory storing the fetched schema files,
+	// which are read from it instead of being fetched again,
+	// including after a restart of the collector. (Optional field)
+	CacheDirectory string `mapstructure:"cache_directory"`
+
+	// SchemaFiles maps schema URLs to local schema files that are
+	// read instead of fetching them, in order to pin the translations
+	// or to run without access to the schema servers. (Optional field)
+	SchemaFiles map[string]string `mapstructure:"schema_files"`
 }
 
 func (c *Config) Validate() error {
@@ -40,6 +50,12 @@ func (c *Config) Validate() error {
 			return err
 		}
 	}
+	for schemaURL := range c.SchemaFiles {
+		_, _, err := translation.GetFamilyAndVersion(schemaURL)
+		if err != nil {
+			return err
+		}
+	}
 	// Not strictly needed since it would just pass on
 	// any data that doesn't match targets, however defining
 	// this processor with no targets is wasteful.
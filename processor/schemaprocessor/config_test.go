@@ -39,6 +39,10 @@ func TestLoadConfig(t *testing.T) {
 			"https://opentelemetry.io/schemas/1.4.2",
 			"https://example.com/otel/schemas/1.2.0",
 		},
+		CacheDirectory: "/var/lib/otelcol/schemas",
+		SchemaFiles: map[string]string{
+			"https://example.com/otel/schemas/1.2.0": "/etc/otelcol/schemas/example-1.2.0.yaml",
+		},
 	}, cfg)
 }
 
@@ -85,4 +89,10 @@ func TestConfigurationValidation(t *testing.T) {
 
 		assert.ErrorIs(t, component.ValidateConfig(cfg), tc.expectError, tc.scenario)
 	}
+
+	cfg := &Config{
+		Targets:     []string{"https://opentelemetry.io/schemas/1.9.0"},
+		SchemaFiles: map[string]string{"https://opentelemetry.io/schemas/latest": "schema.yaml"},
+	}
+	assert.ErrorIs(t, component.ValidateConfig(cfg), translation.ErrInvalidVersion, "Invalid pinned schema url")
 }
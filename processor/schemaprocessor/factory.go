@@ -59,6 +59,7 @@ func (f factory) createLogsProcessor(
 		transformer.processLogs,
 		processorhelper.WithCapabilities(processorCapabilities),
 		processorhelper.WithStart(transformer.start),
+		processorhelper.WithShutdown(transformer.shutdown),
 	)
 }
 
@@ -80,6 +81,7 @@ func (f factory) createMetricsProcessor(
 		transformer.processMetrics,
 		processorhelper.WithCapabilities(processorCapabilities),
 		processorhelper.WithStart(transformer.start),
+		processorhelper.WithShutdown(transformer.shutdown),
 	)
 }
 
@@ -101,5 +103,6 @@ func (f factory) createTracesProcessor(
 		transformer.processTraces,
 		processorhelper.WithCapabilities(processorCapabilities),
 		processorhelper.WithStart(transformer.start),
+		processorhelper.WithShutdown(transformer.shutdown),
 	)
 }
@@ -34,20 +34,22 @@ func NewConditionalAttributeSet[Match ValueMatch](mappings ast.AttributeMap, mat
 }
 
 func (ca *ConditionalAttributeSet) Apply(attrs pcommon.Map, values ...string) (errs error) {
-	if ca.check(values...) {
+	if ca.Matches(values...) {
 		errs = ca.attrs.Apply(attrs)
 	}
 	return errs
 }
 
 func (ca *ConditionalAttributeSet) Rollback(attrs pcommon.Map, values ...string) (errs error) {
-	if ca.check(values...) {
+	if ca.Matches(values...) {
 		errs = ca.attrs.Rollback(attrs)
 	}
 	return errs
 }
 
-func (ca *ConditionalAttributeSet) check(values ...string) bool {
+// Matches reports if the set applies to the values, which is always
+// the case when the set was created without any matches.
+func (ca *ConditionalAttributeSet) Matches(values ...string) bool {
 	if len(*ca.on) == 0 {
 		return true
 	}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// retryInterval is the minimum duration between two attempts to retrieve a schema file
+// that could not be retrieved, so that an unavailable server isn't requested for each signal.
+const retryInterval = time.Minute
+
+type target struct {
+	schemaURL string
+	version   *Version
+}
+
+// retrieval is the retrieval of a schema file in progress.
+type retrieval struct {
+	done chan struct{}
+	err  error
+	// waited is whether a caller waits for the retrieval, in which case its error is returned
+	// to it instead of being logged.
+	waited bool
+}
+
+// Manager retrieves the schema files and caches the translations
+// of the schema URLs to the target version of their family.
+// The schema files are retrieved in the background, without blocking the signals.
+type Manager struct {
+	log      *zap.Logger
+	provider Provider
+	targets  map[string]target
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+
+	mu           sync.Mutex
+	revisions    map[string][]*RevisionV1
+	translations map[string]*Translation
+	failures     map[string]time.Time
+	retrievals   map[string]*retrieval
+	now          func() time.Time
+}
+
+// NewManager returns a manager translating the signals of the families of the target schema URLs.
+func NewManager(targets []string, provider Provider, log *zap.Logger) (*Manager, error) {
+	m := &Manager{
+		log:          log,
+		provider:     provider,
+		targets:      make(map[string]target, len(targets)),
+		revisions:    make(map[string][]*RevisionV1),
+		translations: make(map[string]*Translation),
+		failures:     make(map[string]time.Time),
+		retrievals:   make(map[string]*retrieval),
+		now:          time.Now,
+	}
+	for _, schemaURL := range targets {
+		family, version, err := GetFamilyAndVersion(schemaURL)
+		if err != nil {
+			return nil, err
+		}
+		m.targets[family] = target{schemaURL: schemaURL, version: version}
+	}
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	return m, nil
+}
+
+// Prefetch retrieves the schema file of the schema URL, so that the signals are translated from the start.
+func (m *Manager) Prefetch(ctx context.Context, schemaURL string) error {
+	m.mu.Lock()
+	if _, ok := m.revisions[schemaURL]; ok {
+		m.mu.Unlock()
+		return nil
+	}
+	r := m.retrieveLocked(schemaURL)
+	r.waited = true
+	m.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return r.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RequestTranslation returns the translation of the signals of the schema URL, or nil if they
+// don't need to be translated: when the schema URL is not part of a target family, or is already
+// the target version. The schema file holding the revisions between both versions, the one of the
+// newest version, is retrieved in the background on the first request, nil being returned until
+// it was retrieved, or while it can't be retrieved.
+func (m *Manager) RequestTranslation(schemaURL string) *Translation {
+	if schemaURL == "" {
+		return nil
+	}
+	family, version, err := GetFamilyAndVersion(schemaURL)
+	if err != nil {
+		return nil
+	}
+	target, ok := m.targets[family]
+	if !ok || version.Equal(target.version) {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if t, ok := m.translations[schemaURL]; ok {
+		return t
+	}
+
+	schemaFile := target.schemaURL
+	if version.GreaterThan(target.version) {
+		schemaFile = schemaURL
+	}
+	revisions, ok := m.revisions[schemaFile]
+	if !ok {
+		if failed, ok := m.failures[schemaFile]; !ok || m.now().Sub(failed) >= retryInterval {
+			m.retrieveLocked(schemaFile)
+		}
+		return nil
+	}
+
+	t := NewTranslation(target.schemaURL, version, target.version, revisions)
+	m.translations[schemaURL] = t
+	return t
+}
+
+// Close cancels the retrievals in progress and waits for them to end.
+func (m *Manager) Close() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+// retrieveLocked starts retrieving the schema file in the background, unless it is already being
+// retrieved, and returns the retrieval. The manager's lock must be held.
+func (m *Manager) retrieveLocked(schemaURL string) *retrieval {
+	if r, ok := m.retrievals[schemaURL]; ok {
+		return r
+	}
+	r := &retrieval{done: make(chan struct{})}
+	m.retrievals[schemaURL] = r
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		revisions, err := m.retrieve(m.ctx, schemaURL)
+
+		m.mu.Lock()
+		delete(m.retrievals, schemaURL)
+		if err != nil {
+			m.failures[schemaURL] = m.now()
+		} else {
+			m.revisions[schemaURL] = revisions
+			delete(m.failures, schemaURL)
+		}
+		waited := r.waited
+		m.mu.Unlock()
+
+		if err != nil && !waited {
+			m.log.Warn("Unable to retrieve the schema file, the signals are left untranslated", zap.String("schema-url", schemaURL), zap.Error(err))
+		}
+		r.err = err
+		close(r.done)
+	}()
+	return r
+}
+
+// retrieve retrieves and parses the schema file.
+func (m *Manager) retrieve(ctx context.Context, schemaURL string) ([]*RevisionV1, error) {
+	m.log.Debug("Retrieving the schema file", zap.String("schema-url", schemaURL))
+	content, err := m.provider.Retrieve(ctx, schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the schema file %q: %w", schemaURL, err)
+	}
+	revisions, err := ParseRevisions(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the schema file %q: %w", schemaURL, err)
+	}
+	return revisions, nil
+}
@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// countingProvider serves the example schema for any schema URL, and counts the requests of each one.
+type countingProvider struct {
+	mu       sync.Mutex
+	requests map[string]int
+	err      error
+}
+
+func (p *countingProvider) Retrieve(_ context.Context, schemaURL string) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests[schemaURL]++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return []byte(exampleSchema), nil
+}
+
+// blockingProvider serves the example schema once it is released.
+type blockingProvider struct {
+	release chan struct{}
+}
+
+func (p *blockingProvider) Retrieve(ctx context.Context, _ string) ([]byte, error) {
+	select {
+	case <-p.release:
+		return []byte(exampleSchema), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestManagerRequestTranslation(t *testing.T) {
+	t.Parallel()
+
+	provider := &countingProvider{requests: make(map[string]int)}
+	m, err := NewManager([]string{"https://example.com/schemas/1.1.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating the manager")
+	t.Cleanup(m.Close)
+
+	for _, schemaURL := range []string{
+		"",
+		"not a schema url",
+		"https://other.example.com/schemas/1.0.0",
+		"https://example.com/schemas/1.1.0",
+	} {
+		assert.Nil(t, m.RequestTranslation(schemaURL), "Must not translate %q", schemaURL)
+	}
+	m.wg.Wait()
+	assert.Empty(t, provider.requests, "Must not retrieve any schema file")
+
+	// older versions are translated with the target schema file, once it was retrieved
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.0.0"))
+	m.wg.Wait()
+	tr := m.RequestTranslation("https://example.com/schemas/1.0.0")
+	require.NotNil(t, tr)
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tr.TargetSchemaURL())
+	assert.Len(t, tr.revisions, 1)
+
+	// newer versions are translated with their own schema file
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.2.0"))
+	m.wg.Wait()
+	tr = m.RequestTranslation("https://example.com/schemas/1.2.0")
+	require.NotNil(t, tr)
+	assert.Equal(t, "https://example.com/schemas/1.1.0", tr.TargetSchemaURL())
+	assert.Len(t, tr.revisions, 1)
+
+	// the translations are cached
+	assert.Same(t, tr, m.RequestTranslation("https://example.com/schemas/1.2.0"))
+	assert.Equal(t, map[string]int{
+		"https://example.com/schemas/1.1.0": 1,
+		"https://example.com/schemas/1.2.0": 1,
+	}, provider.requests)
+}
+
+func TestManagerRequestTranslationDoesNotBlock(t *testing.T) {
+	t.Parallel()
+
+	provider := &blockingProvider{release: make(chan struct{})}
+	m, err := NewManager([]string{"https://example.com/schemas/1.1.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating the manager")
+	t.Cleanup(m.Close)
+
+	// the signals are passed through while the schema file is retrieved, which is retrieved once
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.0.0"))
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.0.1"))
+	m.mu.Lock()
+	assert.Len(t, m.retrievals, 1)
+	m.mu.Unlock()
+
+	// a prefetch waits for the retrieval in progress
+	prefetched := make(chan error)
+	go func() {
+		prefetched <- m.Prefetch(context.Background(), "https://example.com/schemas/1.1.0")
+	}()
+	close(provider.release)
+	require.NoError(t, <-prefetched)
+	assert.NotNil(t, m.RequestTranslation("https://example.com/schemas/1.0.0"))
+}
+
+func TestManagerClose(t *testing.T) {
+	t.Parallel()
+
+	provider := &blockingProvider{release: make(chan struct{})}
+	m, err := NewManager([]string{"https://example.com/schemas/1.1.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating the manager")
+
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.0.0"))
+	// the retrieval in progress is cancelled
+	m.Close()
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.0.0"))
+}
+
+func TestManagerRetrieveFailure(t *testing.T) {
+	t.Parallel()
+
+	provider := &countingProvider{requests: make(map[string]int), err: errors.New("unavailable")}
+	m, err := NewManager([]string{"https://example.com/schemas/1.2.0"}, provider, zaptest.NewLogger(t))
+	require.NoError(t, err, "Must not error when creating the manager")
+	t.Cleanup(m.Close)
+	now := time.Unix(1000, 0)
+	m.mu.Lock()
+	m.now = func() time.Time { return now }
+	m.mu.Unlock()
+
+	assert.ErrorContains(t, m.Prefetch(context.Background(), "https://example.com/schemas/1.2.0"), "unavailable")
+
+	// the schema file isn't retrieved again until the retry interval elapsed
+	provider.mu.Lock()
+	provider.err = nil
+	provider.mu.Unlock()
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.1.0"))
+	m.wg.Wait()
+	assert.Equal(t, 1, provider.requests["https://example.com/schemas/1.2.0"])
+
+	now = now.Add(retryInterval)
+	assert.Nil(t, m.RequestTranslation("https://example.com/schemas/1.1.0"))
+	m.wg.Wait()
+	assert.NotNil(t, m.RequestTranslation("https://example.com/schemas/1.1.0"))
+	assert.Equal(t, 2, provider.requests["https://example.com/schemas/1.2.0"])
+}
+
+func TestNewManagerInvalidTarget(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewManager([]string{"https://example.com/schemas/latest"}, &countingProvider{}, zaptest.NewLogger(t))
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// maxSchemaSize bounds the size of the schema files read from a remote server.
+const maxSchemaSize = 10 << 20
+
+// Provider retrieves the content of schema files.
+type Provider interface {
+	Retrieve(ctx context.Context, schemaURL string) ([]byte, error)
+}
+
+type httpProvider struct {
+	client *http.Client
+}
+
+// NewHTTPProvider returns a provider fetching the schema files from their URL.
+func NewHTTPProvider(client *http.Client) Provider {
+	return &httpProvider{client: client}
+}
+
+func (p *httpProvider) Retrieve(ctx context.Context, schemaURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, schemaURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxSchemaSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxSchemaSize {
+		return nil, fmt.Errorf("the schema file is larger than %d bytes", maxSchemaSize)
+	}
+	return content, nil
+}
+
+type fileProvider struct {
+	files map[string]string
+	next  Provider
+}
+
+// NewFileProvider returns a provider reading the schema files pinned to local files,
+// and retrieving the other ones from next.
+func NewFileProvider(files map[string]string, next Provider) Provider {
+	return &fileProvider{files: files, next: next}
+}
+
+func (p *fileProvider) Retrieve(ctx context.Context, schemaURL string) ([]byte, error) {
+	if path, ok := p.files[schemaURL]; ok {
+		return os.ReadFile(path)
+	}
+	return p.next.Retrieve(ctx, schemaURL)
+}
+
+type cacheProvider struct {
+	dir  string
+	next Provider
+}
+
+// NewCacheProvider returns a provider storing the schema files retrieved from next in the directory,
+// so that they are read from it when they are requested again, including after a restart.
+func NewCacheProvider(dir string, next Provider) Provider {
+	return &cacheProvider{dir: dir, next: next}
+}
+
+func (p *cacheProvider) Retrieve(ctx context.Context, schemaURL string) ([]byte, error) {
+	path := filepath.Join(p.dir, url.QueryEscape(schemaURL)+".yaml")
+	content, err := os.ReadFile(path)
+	if err == nil {
+		return content, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	if content, err = p.next.Retrieve(ctx, schemaURL); err != nil {
+		return nil, err
+	}
+	if err = storeFile(path, content); err != nil {
+		return nil, fmt.Errorf("failed to cache the schema file: %w", err)
+	}
+	return content, nil
+}
+
+// storeFile replaces the file atomically, so that a partially written file is never read.
+func storeFile(path string, content []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(content); err != nil {
+		return errors.Join(err, tmp.Close())
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPProvider(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/schemas/1.2.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(exampleSchema))
+	}))
+	t.Cleanup(server.Close)
+
+	p := NewHTTPProvider(server.Client())
+	content, err := p.Retrieve(context.Background(), server.URL+"/schemas/1.2.0")
+	require.NoError(t, err)
+	assert.Equal(t, exampleSchema, string(content))
+
+	_, err = p.Retrieve(context.Background(), server.URL+"/schemas/1.3.0")
+	assert.EqualError(t, err, "unexpected status 404 Not Found")
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "schema.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(exampleSchema), 0o600))
+	next := &countingProvider{requests: make(map[string]int)}
+
+	p := NewFileProvider(map[string]string{"https://example.com/schemas/1.2.0": path}, next)
+	content, err := p.Retrieve(context.Background(), "https://example.com/schemas/1.2.0")
+	require.NoError(t, err)
+	assert.Equal(t, exampleSchema, string(content))
+	assert.Empty(t, next.requests, "Must read the pinned schema file")
+
+	_, err = p.Retrieve(context.Background(), "https://example.com/schemas/1.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"https://example.com/schemas/1.1.0": 1}, next.requests)
+}
+
+func TestCacheProvider(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	next := &countingProvider{requests: make(map[string]int)}
+
+	for i := 0; i < 2; i++ {
+		// a new provider reads the schema files cached by the previous one
+		p := NewCacheProvider(dir, next)
+		content, err := p.Retrieve(context.Background(), "https://example.com/schemas/1.2.0")
+		require.NoError(t, err)
+		assert.Equal(t, exampleSchema, string(content))
+	}
+	assert.Equal(t, map[string]int{"https://example.com/schemas/1.2.0": 1}, next.requests)
+
+	_, err := NewCacheProvider(filepath.Join(dir, "missing"), next).Retrieve(context.Background(), "https://example.com/schemas/1.1.0")
+	assert.ErrorContains(t, err, "failed to cache the schema file")
+}
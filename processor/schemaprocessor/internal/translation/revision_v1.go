@@ -4,7 +4,12 @@
 package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 
 import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/otel/schema/v1.0/ast"
+	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
 )
@@ -21,6 +26,7 @@ type RevisionV1 struct {
 	eventAttrsOnName *migrate.ConditionalAttributeSetSlice
 	metricsAttrs     *migrate.ConditionalAttributeSetSlice
 	metricNames      *migrate.SignalNameChangeSlice
+	logs             *migrate.AttributeChangeSetSlice
 }
 
 // NewRevision processes the VersionDef and assigns the version to this revision
@@ -39,9 +45,123 @@ func NewRevision(ver *Version, def ast.VersionDef) *RevisionV1 {
 		eventAttrsOnName: newSpanEventConditionalNames(def.SpanEvents),
 		metricsAttrs:     newMetricConditionalSlice(def.Metrics),
 		metricNames:      newMetricNameSignalSlice(def.Metrics),
+		logs:             newLogsAttributeChangeSetSlice(def.Logs),
 	}
 }
 
+// Version returns the version of the revision.
+func (r *RevisionV1) Version() *Version {
+	return r.ver
+}
+
+// The changes of a revision are applied to update a signal from the previous version to the
+// revision's version, and rolled back in the reverse order to downgrade it.
+
+func (r *RevisionV1) applyResourceChanges(ss migrate.StateSelector, attrs pcommon.Map) error {
+	if ss == migrate.StateSelectorApply {
+		return multierr.Combine(r.all.Apply(attrs), r.resource.Apply(attrs))
+	}
+	return multierr.Combine(r.resource.Rollback(attrs), r.all.Rollback(attrs))
+}
+
+func (r *RevisionV1) applySpanChanges(ss migrate.StateSelector, span ptrace.Span) (errs error) {
+	if ss == migrate.StateSelectorApply {
+		errs = multierr.Combine(r.all.Apply(span.Attributes()), r.spans.Apply(span.Attributes(), span.Name()))
+	} else {
+		errs = multierr.Combine(r.spans.Rollback(span.Attributes(), span.Name()), r.all.Rollback(span.Attributes()))
+	}
+	for i := 0; i < span.Events().Len(); i++ {
+		errs = multierr.Append(errs, r.applySpanEventChanges(ss, span.Name(), span.Events().At(i)))
+	}
+	return errs
+}
+
+// applySpanEventChanges matches the attribute changes against the name of the event in the previous version,
+// so the event is renamed after its attributes are updated, and before they are rolled back.
+func (r *RevisionV1) applySpanEventChanges(ss migrate.StateSelector, spanName string, event ptrace.SpanEvent) error {
+	if ss == migrate.StateSelectorApply {
+		errs := multierr.Combine(r.all.Apply(event.Attributes()), r.applySpanEventAttributes(ss, spanName, event))
+		r.eventNames.Apply(event)
+		return errs
+	}
+	r.eventNames.Rollback(event)
+	return multierr.Combine(r.applySpanEventAttributes(ss, spanName, event), r.all.Rollback(event.Attributes()))
+}
+
+// applySpanEventAttributes applies the event attribute changes matching both the span and the event name,
+// eventAttrsOnSpan and eventAttrsOnName hold the same changes, restricted to the spans and events respectively.
+func (r *RevisionV1) applySpanEventAttributes(ss migrate.StateSelector, spanName string, event ptrace.SpanEvent) (errs error) {
+	n := len(*r.eventAttrsOnSpan)
+	for i := 0; i < n; i++ {
+		j := i
+		if ss == migrate.StateSelectorRollback {
+			j = n - 1 - i
+		}
+		onSpan, onName := (*r.eventAttrsOnSpan)[j], (*r.eventAttrsOnName)[j]
+		if !onName.Matches(event.Name()) {
+			continue
+		}
+		switch ss {
+		case migrate.StateSelectorApply:
+			errs = multierr.Append(errs, onSpan.Apply(event.Attributes(), spanName))
+		case migrate.StateSelectorRollback:
+			errs = multierr.Append(errs, onSpan.Rollback(event.Attributes(), spanName))
+		}
+	}
+	return errs
+}
+
+// applyMetricChanges matches the attribute changes against the name of the metric in the previous version,
+// so the metric is renamed after its datapoints are updated, and before they are rolled back.
+func (r *RevisionV1) applyMetricChanges(ss migrate.StateSelector, metric pmetric.Metric) error {
+	if ss == migrate.StateSelectorRollback {
+		r.metricNames.Rollback(metric)
+	}
+	errs := rangeDataPointAttributes(metric, func(attrs pcommon.Map) error {
+		if ss == migrate.StateSelectorApply {
+			return multierr.Combine(r.all.Apply(attrs), r.metricsAttrs.Apply(attrs, metric.Name()))
+		}
+		return multierr.Combine(r.metricsAttrs.Rollback(attrs, metric.Name()), r.all.Rollback(attrs))
+	})
+	if ss == migrate.StateSelectorApply {
+		r.metricNames.Apply(metric)
+	}
+	return errs
+}
+
+func (r *RevisionV1) applyLogRecordChanges(ss migrate.StateSelector, record plog.LogRecord) error {
+	if ss == migrate.StateSelectorApply {
+		return multierr.Combine(r.all.Apply(record.Attributes()), r.logs.Apply(record.Attributes()))
+	}
+	return multierr.Combine(r.logs.Rollback(record.Attributes()), r.all.Rollback(record.Attributes()))
+}
+
+func rangeDataPointAttributes(metric pmetric.Metric, fn func(attrs pcommon.Map) error) (errs error) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < metric.Gauge().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Gauge().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < metric.Sum().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Sum().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < metric.Histogram().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Histogram().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < metric.ExponentialHistogram().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.ExponentialHistogram().DataPoints().At(i).Attributes()))
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < metric.Summary().DataPoints().Len(); i++ {
+			errs = multierr.Append(errs, fn(metric.Summary().DataPoints().At(i).Attributes()))
+		}
+	}
+	return errs
+}
+
 func newAttributeChangeSetSliceFromChanges(attrs ast.Attributes) *migrate.AttributeChangeSetSlice {
 	values := make([]*migrate.AttributeChangeSet, 0, 10)
 	for _, at := range attrs.Changes {
@@ -52,6 +172,16 @@ func newAttributeChangeSetSliceFromChanges(attrs ast.Attributes) *migrate.Attrib
 	return migrate.NewAttributeChangeSetSlice(values...)
 }
 
+func newLogsAttributeChangeSetSlice(logs ast.Logs) *migrate.AttributeChangeSetSlice {
+	values := make([]*migrate.AttributeChangeSet, 0, 10)
+	for _, ch := range logs.Changes {
+		if renamed := ch.RenameAttributes; renamed != nil {
+			values = append(values, migrate.NewAttributeChangeSet(renamed.AttributeMap))
+		}
+	}
+	return migrate.NewAttributeChangeSetSlice(values...)
+}
+
 func newSpanConditionalAttributeSlice(spans ast.Spans) *migrate.ConditionalAttributeSetSlice {
 	values := make([]*migrate.ConditionalAttributeSet, 0, 10)
 	for _, ch := range spans.Changes {
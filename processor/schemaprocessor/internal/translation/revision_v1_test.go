@@ -35,6 +35,7 @@ func TestNewRevision(t *testing.T) {
 				eventAttrsOnName: migrate.NewConditionalAttributeSetSlice(),
 				metricsAttrs:     migrate.NewConditionalAttributeSetSlice(),
 				metricNames:      migrate.NewSignalNameChangeSlice(),
+				logs:             migrate.NewAttributeChangeSetSlice(),
 			},
 		},
 		{
@@ -200,6 +201,11 @@ func TestNewRevision(t *testing.T) {
 						"service.computed.uptime": "service.uptime",
 					}),
 				),
+				logs: migrate.NewAttributeChangeSetSlice(
+					migrate.NewAttributeChangeSet(map[string]string{
+						"ERROR": "error",
+					}),
+				),
 			},
 		},
 	} {
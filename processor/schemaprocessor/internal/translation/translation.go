@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
+
+import (
+	"io"
+	"sort"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	ast10 "go.opentelemetry.io/otel/schema/v1.0/ast"
+	schema11 "go.opentelemetry.io/otel/schema/v1.1"
+	ast11 "go.opentelemetry.io/otel/schema/v1.1/ast"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/migrate"
+)
+
+// Translation applies the changes of the revisions between the version
+// of a signal and the target version of its schema family.
+type Translation struct {
+	targetSchemaURL string
+	selector        migrate.StateSelector
+	revisions       []*RevisionV1
+}
+
+// ParseRevisions reads the revisions of a schema file of the
+// format 1.0.0 or 1.1.0, sorted by their version.
+// The metric splits of the format 1.1.0 are not supported and are ignored.
+func ParseRevisions(content io.Reader) ([]*RevisionV1, error) {
+	schema, err := schema11.Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*RevisionV1, 0, len(schema.Versions))
+	for v, def := range schema.Versions {
+		ver, err := NewVersion(string(v))
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, NewRevision(ver, newVersionDef(def)))
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].ver.LessThan(revisions[j].ver)
+	})
+	return revisions, nil
+}
+
+func newVersionDef(def ast11.VersionDef) ast10.VersionDef {
+	metrics := ast10.Metrics{Changes: make([]ast10.MetricsChange, 0, len(def.Metrics.Changes))}
+	for _, ch := range def.Metrics.Changes {
+		metrics.Changes = append(metrics.Changes, ast10.MetricsChange{
+			RenameMetrics:    ch.RenameMetrics,
+			RenameAttributes: ch.RenameAttributes,
+		})
+	}
+	return ast10.VersionDef{
+		All:        def.All,
+		Resources:  def.Resources,
+		Spans:      def.Spans,
+		SpanEvents: def.SpanEvents,
+		Logs:       def.Logs,
+		Metrics:    metrics,
+	}
+}
+
+// NewTranslation selects the revisions, sorted by their version, that translate
+// signals of the from version to the target version.
+// Moving to a newer version applies the revisions after the from version up to the target version,
+// while moving to an older version rolls back the revisions after the target version up to the from version.
+func NewTranslation(targetSchemaURL string, from, target *Version, revisions []*RevisionV1) *Translation {
+	t := &Translation{
+		targetSchemaURL: targetSchemaURL,
+		selector:        migrate.StateSelectorApply,
+	}
+	lower, upper := from, target
+	if from.GreaterThan(target) {
+		t.selector = migrate.StateSelectorRollback
+		lower, upper = target, from
+	}
+	for _, rev := range revisions {
+		if rev.ver.GreaterThan(lower) && !rev.ver.GreaterThan(upper) {
+			t.revisions = append(t.revisions, rev)
+		}
+	}
+	if t.selector == migrate.StateSelectorRollback {
+		for i, j := 0, len(t.revisions)-1; i < j; i, j = i+1, j-1 {
+			t.revisions[i], t.revisions[j] = t.revisions[j], t.revisions[i]
+		}
+	}
+	return t
+}
+
+// TargetSchemaURL is the schema URL of the translated signals.
+func (t *Translation) TargetSchemaURL() string {
+	return t.targetSchemaURL
+}
+
+func (t *Translation) ApplyResourceChanges(resource pcommon.Resource) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyResourceChanges(t.selector, resource.Attributes()))
+	}
+	return errs
+}
+
+func (t *Translation) ApplySpanChanges(span ptrace.Span) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applySpanChanges(t.selector, span))
+	}
+	return errs
+}
+
+func (t *Translation) ApplyMetricChanges(metric pmetric.Metric) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyMetricChanges(t.selector, metric))
+	}
+	return errs
+}
+
+func (t *Translation) ApplyLogRecordChanges(record plog.LogRecord) (errs error) {
+	for _, rev := range t.revisions {
+		errs = multierr.Append(errs, rev.applyLogRecordChanges(t.selector, record))
+	}
+	return errs
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package translation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const exampleSchema = `
+file_format: 1.1.0
+schema_url: https://example.com/schemas/1.2.0
+versions:
+  1.2.0:
+    all:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              http.method: http.request.method
+    spans:
+      changes:
+        - rename_attributes:
+            apply_to_spans: [query]
+            attribute_map:
+              db.statement: db.query.text
+    span_events:
+      changes:
+        - rename_events:
+            name_map:
+              exception.thrown: exception
+        - rename_attributes:
+            apply_to_events: [exception.thrown]
+            attribute_map:
+              exception.kind: exception.type
+    metrics:
+      changes:
+        - rename_attributes:
+            apply_to_metrics: [process.runtime.uptime]
+            attribute_map:
+              runtime: process.runtime.name
+        - rename_metrics:
+            process.runtime.uptime: process.uptime
+        - split:
+            apply_to_metric: system.paging.faults
+            by_attribute: type
+            metrics_from_attributes:
+              system.paging.major_faults: major
+    logs:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              log.level: log.severity
+  1.1.0:
+    resources:
+      changes:
+        - rename_attributes:
+            attribute_map:
+              telemetry.auto.version: telemetry.distro.version
+  1.0.0:
+`
+
+func TestParseRevisions(t *testing.T) {
+	t.Parallel()
+
+	revisions, err := ParseRevisions(strings.NewReader(exampleSchema))
+	require.NoError(t, err, "Must not error when parsing the schema")
+	require.Len(t, revisions, 3)
+	for i, ver := range []*Version{{1, 0, 0}, {1, 1, 0}, {1, 2, 0}} {
+		assert.Equal(t, ver, revisions[i].Version(), "Must be sorted by version")
+	}
+
+	_, err = ParseRevisions(strings.NewReader("file_format: 2.0.0\nschema_url: https://example.com/schemas/1.2.0\n"))
+	assert.Error(t, err, "Must error with an unsupported file format")
+}
+
+func TestTranslation(t *testing.T) {
+	t.Parallel()
+
+	revisions, err := ParseRevisions(strings.NewReader(exampleSchema))
+	require.NoError(t, err, "Must not error when parsing the schema")
+
+	newResource := func(key string) pcommon.Resource {
+		res := pcommon.NewResource()
+		res.Attributes().PutStr(key, "1.0.0")
+		return res
+	}
+	newSpan := func(name, event, attr string) ptrace.Span {
+		span := ptrace.NewSpan()
+		span.SetName(name)
+		span.Attributes().PutStr(attr, "SELECT 1")
+		ev := span.Events().AppendEmpty()
+		ev.SetName(event)
+		ev.Attributes().PutStr("exception.kind", "panic")
+		return span
+	}
+
+	t.Run("upgrade", func(t *testing.T) {
+		tr := NewTranslation("https://example.com/schemas/1.2.0", &Version{1, 0, 0}, &Version{1, 2, 0}, revisions)
+		assert.Equal(t, "https://example.com/schemas/1.2.0", tr.TargetSchemaURL())
+
+		res := newResource("telemetry.auto.version")
+		assert.NoError(t, tr.ApplyResourceChanges(res))
+		assert.Equal(t, map[string]any{"telemetry.distro.version": "1.0.0"}, res.Attributes().AsRaw())
+
+		span := newSpan("query", "exception.thrown", "db.statement")
+		span.Attributes().PutStr("http.method", "GET")
+		assert.NoError(t, tr.ApplySpanChanges(span))
+		assert.Equal(t, map[string]any{"db.query.text": "SELECT 1", "http.request.method": "GET"}, span.Attributes().AsRaw())
+		assert.Equal(t, "exception", span.Events().At(0).Name())
+		assert.Equal(t, map[string]any{"exception.type": "panic"}, span.Events().At(0).Attributes().AsRaw())
+
+		// the span attributes are only renamed on the matching spans
+		other := newSpan("insert", "exception.thrown", "db.statement")
+		assert.NoError(t, tr.ApplySpanChanges(other))
+		assert.Equal(t, map[string]any{"db.statement": "SELECT 1"}, other.Attributes().AsRaw())
+
+		metric := pmetric.NewMetric()
+		metric.SetName("process.runtime.uptime")
+		metric.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("runtime", "go")
+		assert.NoError(t, tr.ApplyMetricChanges(metric))
+		assert.Equal(t, "process.uptime", metric.Name())
+		assert.Equal(t, map[string]any{"process.runtime.name": "go"}, metric.Sum().DataPoints().At(0).Attributes().AsRaw())
+
+		record := plog.NewLogRecord()
+		record.Attributes().PutStr("log.level", "info")
+		assert.NoError(t, tr.ApplyLogRecordChanges(record))
+		assert.Equal(t, map[string]any{"log.severity": "info"}, record.Attributes().AsRaw())
+	})
+
+	t.Run("partial upgrade", func(t *testing.T) {
+		tr := NewTranslation("https://example.com/schemas/1.2.0", &Version{1, 1, 0}, &Version{1, 2, 0}, revisions)
+
+		// the resource changes of 1.1.0 are already applied
+		res := newResource("telemetry.auto.version")
+		assert.NoError(t, tr.ApplyResourceChanges(res))
+		assert.Equal(t, map[string]any{"telemetry.auto.version": "1.0.0"}, res.Attributes().AsRaw())
+	})
+
+	t.Run("downgrade", func(t *testing.T) {
+		tr := NewTranslation("https://example.com/schemas/1.0.0", &Version{1, 2, 0}, &Version{1, 0, 0}, revisions)
+
+		res := newResource("telemetry.distro.version")
+		assert.NoError(t, tr.ApplyResourceChanges(res))
+		assert.Equal(t, map[string]any{"telemetry.auto.version": "1.0.0"}, res.Attributes().AsRaw())
+
+		span := newSpan("query", "exception", "db.query.text")
+		span.Events().At(0).Attributes().Clear()
+		span.Events().At(0).Attributes().PutStr("exception.type", "panic")
+		assert.NoError(t, tr.ApplySpanChanges(span))
+		assert.Equal(t, map[string]any{"db.statement": "SELECT 1"}, span.Attributes().AsRaw())
+		assert.Equal(t, "exception.thrown", span.Events().At(0).Name())
+		assert.Equal(t, map[string]any{"exception.kind": "panic"}, span.Events().At(0).Attributes().AsRaw())
+
+		metric := pmetric.NewMetric()
+		metric.SetName("process.uptime")
+		metric.SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr("process.runtime.name", "go")
+		assert.NoError(t, tr.ApplyMetricChanges(metric))
+		assert.Equal(t, "process.runtime.uptime", metric.Name())
+		assert.Equal(t, map[string]any{"runtime": "go"}, metric.Gauge().DataPoints().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		tr := NewTranslation("https://example.com/schemas/1.2.0", &Version{1, 0, 0}, &Version{1, 2, 0}, revisions)
+
+		record := plog.NewLogRecord()
+		record.Attributes().PutStr("log.level", "info")
+		record.Attributes().PutStr("log.severity", "warn")
+		assert.Error(t, tr.ApplyLogRecordChanges(record), "Must report the conflicting attributes")
+		assert.Equal(t, map[string]any{"log.severity": "info"}, record.Attributes().AsRaw())
+	})
+}
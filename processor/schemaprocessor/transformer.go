@@ -12,12 +12,18 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/schemaprocessor/internal/translation"
 )
 
 type transformer struct {
+	cfg     *Config
 	targets []string
 	log     *zap.Logger
+	set     component.TelemetrySettings
+	manager *translation.Manager
 }
 
 func newTransformer(
@@ -30,28 +36,145 @@ func newTransformer(
 		return nil, errors.New("invalid configuration provided")
 	}
 	return &transformer{
+		cfg:     cfg,
 		log:     set.Logger,
+		set:     set.TelemetrySettings,
 		targets: cfg.Targets,
 	}, nil
 }
 
-func (t transformer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+// logConflicts reports the attributes that could not be renamed because the new name was already used,
+// the signals are sent on nonetheless.
+func (t *transformer) logConflicts(schemaURL string, errs error) {
+	if errs != nil {
+		t.log.Debug("Conflicts found while translating the signals", zap.String("schema-url", schemaURL), zap.Error(errs))
+	}
+}
+
+func (t *transformer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		resourceURL := rl.SchemaUrl()
+		if tr := t.manager.RequestTranslation(resourceURL); tr != nil {
+			t.logConflicts(resourceURL, tr.ApplyResourceChanges(rl.Resource()))
+			rl.SetSchemaUrl(tr.TargetSchemaURL())
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			// the scope schema URL takes precedence over the resource one
+			schemaURL := sl.SchemaUrl()
+			if schemaURL == "" {
+				schemaURL = resourceURL
+			}
+			tr := t.manager.RequestTranslation(schemaURL)
+			if tr == nil {
+				continue
+			}
+			var errs error
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				errs = multierr.Append(errs, tr.ApplyLogRecordChanges(sl.LogRecords().At(k)))
+			}
+			t.logConflicts(schemaURL, errs)
+			if sl.SchemaUrl() != "" {
+				sl.SetSchemaUrl(tr.TargetSchemaURL())
+			}
+		}
+	}
 	return ld, nil
 }
 
-func (t transformer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+func (t *transformer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		resourceURL := rm.SchemaUrl()
+		if tr := t.manager.RequestTranslation(resourceURL); tr != nil {
+			t.logConflicts(resourceURL, tr.ApplyResourceChanges(rm.Resource()))
+			rm.SetSchemaUrl(tr.TargetSchemaURL())
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			schemaURL := sm.SchemaUrl()
+			if schemaURL == "" {
+				schemaURL = resourceURL
+			}
+			tr := t.manager.RequestTranslation(schemaURL)
+			if tr == nil {
+				continue
+			}
+			var errs error
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				errs = multierr.Append(errs, tr.ApplyMetricChanges(sm.Metrics().At(k)))
+			}
+			t.logConflicts(schemaURL, errs)
+			if sm.SchemaUrl() != "" {
+				sm.SetSchemaUrl(tr.TargetSchemaURL())
+			}
+		}
+	}
 	return md, nil
 }
 
-func (t transformer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+func (t *transformer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		resourceURL := rs.SchemaUrl()
+		if tr := t.manager.RequestTranslation(resourceURL); tr != nil {
+			t.logConflicts(resourceURL, tr.ApplyResourceChanges(rs.Resource()))
+			rs.SetSchemaUrl(tr.TargetSchemaURL())
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			schemaURL := ss.SchemaUrl()
+			if schemaURL == "" {
+				schemaURL = resourceURL
+			}
+			tr := t.manager.RequestTranslation(schemaURL)
+			if tr == nil {
+				continue
+			}
+			var errs error
+			for k := 0; k < ss.Spans().Len(); k++ {
+				errs = multierr.Append(errs, tr.ApplySpanChanges(ss.Spans().At(k)))
+			}
+			t.logConflicts(schemaURL, errs)
+			if ss.SchemaUrl() != "" {
+				ss.SetSchemaUrl(tr.TargetSchemaURL())
+			}
+		}
+	}
 	return td, nil
 }
 
-// start will load the remote file definition if it isn't already cached
-// and resolve the schema translation file
-func (t *transformer) start(_ context.Context, _ component.Host) error {
-	for _, target := range t.targets {
-		t.log.Info("Fetching remote schema url", zap.String("schema-url", target))
+// start creates the translation manager, and retrieves the schema files of the targets
+// and the prefetched schema URLs.
+func (t *transformer) start(ctx context.Context, host component.Host) error {
+	client, err := t.cfg.ClientConfig.ToClient(ctx, host, t.set)
+	if err != nil {
+		return err
+	}
+	var provider translation.Provider = translation.NewHTTPProvider(client)
+	if t.cfg.CacheDirectory != "" {
+		provider = translation.NewCacheProvider(t.cfg.CacheDirectory, provider)
+	}
+	if len(t.cfg.SchemaFiles) > 0 {
+		provider = translation.NewFileProvider(t.cfg.SchemaFiles, provider)
+	}
+	if t.manager, err = translation.NewManager(t.targets, provider, t.log); err != nil {
+		return err
+	}
+
+	for _, schemaURL := range append(append([]string(nil), t.targets...), t.cfg.Prefetch...) {
+		t.log.Info("Fetching remote schema url", zap.String("schema-url", schemaURL))
+		if err := t.manager.Prefetch(ctx, schemaURL); err != nil {
+			t.log.Warn("Unable to prefetch the schema url, it will be fetched again when required", zap.String("schema-url", schemaURL), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (t *transformer) shutdown(context.Context) error {
+	if t.manager != nil {
+		t.manager.Close()
 	}
 	return nil
 }
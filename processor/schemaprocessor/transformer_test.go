@@ -6,33 +6,45 @@ package schemaprocessor
 import (
 	"context"
 	_ "embed"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processortest"
 	"go.uber.org/zap/zaptest"
 )
 
 func newTestTransformer(t *testing.T) *transformer {
-	trans, err := newTransformer(context.Background(), newDefaultConfiguration(), processor.CreateSettings{
-		TelemetrySettings: component.TelemetrySettings{
-			Logger: zaptest.NewLogger(t),
-		},
+	return newTestTransformerWithConfig(t, newDefaultConfiguration().(*Config))
+}
+
+func newTestTransformerWithConfig(t *testing.T, cfg *Config) *transformer {
+	trans, err := newTransformer(context.Background(), cfg, processor.CreateSettings{
+		TelemetrySettings: componenttest.NewNopTelemetrySettings(),
 	})
 	require.NoError(t, err, "Must not error when creating default transformer")
+	trans.log = zaptest.NewLogger(t)
+	require.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()), "Must not error when starting the transformer")
 	return trans
 }
 
 func TestTransformerStart(t *testing.T) {
 	t.Parallel()
 
-	trans := newTestTransformer(t)
-	assert.NoError(t, trans.start(context.Background(), nil))
+	trans, err := newTransformer(context.Background(), newDefaultConfiguration(), processor.CreateSettings{
+		TelemetrySettings: componenttest.NewNopTelemetrySettings(),
+	})
+	require.NoError(t, err, "Must not error when creating default transformer")
+	assert.NoError(t, trans.start(context.Background(), componenttest.NewNopHost()))
 }
 
 func TestTransformerProcessing(t *testing.T) {
@@ -86,3 +98,107 @@ func TestTransformerProcessing(t *testing.T) {
 		assert.Equal(t, in, out, "Must return the same data (subject to change)")
 	})
 }
+
+func TestTransformerTranslation(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	fs := http.FileServer(http.Dir("testdata"))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fs.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	var (
+		oldSchemaURL    = server.URL + "/schemas/1.0.0"
+		targetSchemaURL = server.URL + "/schemas/1.2.0"
+		otherSchemaURL  = "https://other.example.com/schemas/1.0.0"
+	)
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{targetSchemaURL}
+	trans := newTestTransformerWithConfig(t, cfg)
+	assert.Equal(t, int32(1), requests.Load(), "Must fetch the target schema file on start")
+
+	t.Run("traces", func(t *testing.T) {
+		in := ptrace.NewTraces()
+		rs := in.ResourceSpans().AppendEmpty()
+		rs.SetSchemaUrl(oldSchemaURL)
+		rs.Resource().Attributes().PutStr("telemetry.auto.version", "1.0.0")
+		rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes().PutStr("http.method", "GET")
+		// the scope schema url takes precedence over the resource one
+		other := rs.ScopeSpans().AppendEmpty()
+		other.SetSchemaUrl(otherSchemaURL)
+		other.Spans().AppendEmpty().Attributes().PutStr("http.method", "GET")
+
+		out, err := trans.processTraces(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing traces")
+		rs = out.ResourceSpans().At(0)
+		assert.Equal(t, targetSchemaURL, rs.SchemaUrl())
+		assert.Equal(t, map[string]any{"telemetry.distro.version": "1.0.0"}, rs.Resource().Attributes().AsRaw())
+		assert.Equal(t, "", rs.ScopeSpans().At(0).SchemaUrl())
+		assert.Equal(t, map[string]any{"http.request.method": "GET"}, rs.ScopeSpans().At(0).Spans().At(0).Attributes().AsRaw())
+		assert.Equal(t, otherSchemaURL, rs.ScopeSpans().At(1).SchemaUrl())
+		assert.Equal(t, map[string]any{"http.method": "GET"}, rs.ScopeSpans().At(1).Spans().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		in := pmetric.NewMetrics()
+		rm := in.ResourceMetrics().AppendEmpty()
+		sm := rm.ScopeMetrics().AppendEmpty()
+		sm.SetSchemaUrl(oldSchemaURL)
+		m := sm.Metrics().AppendEmpty()
+		m.SetName("process.runtime.uptime")
+		m.SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr("http.method", "GET")
+
+		out, err := trans.processMetrics(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing metrics")
+		sm = out.ResourceMetrics().At(0).ScopeMetrics().At(0)
+		assert.Equal(t, targetSchemaURL, sm.SchemaUrl())
+		assert.Equal(t, "process.uptime", sm.Metrics().At(0).Name())
+		assert.Equal(t, map[string]any{"http.request.method": "GET"}, sm.Metrics().At(0).Sum().DataPoints().At(0).Attributes().AsRaw())
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		in := plog.NewLogs()
+		rl := in.ResourceLogs().AppendEmpty()
+		rl.SetSchemaUrl(server.URL + "/schemas/1.1.0")
+		rl.Resource().Attributes().PutStr("telemetry.distro.version", "1.0.0")
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr("http.method", "GET")
+
+		out, err := trans.processLogs(context.Background(), in)
+		require.NoError(t, err, "Must not error when processing logs")
+		rl = out.ResourceLogs().At(0)
+		assert.Equal(t, targetSchemaURL, rl.SchemaUrl())
+		assert.Equal(t, map[string]any{"telemetry.distro.version": "1.0.0"}, rl.Resource().Attributes().AsRaw())
+		assert.Equal(t, map[string]any{"http.request.method": "GET"}, rl.ScopeLogs().At(0).LogRecords().At(0).Attributes().AsRaw())
+	})
+
+	assert.Equal(t, int32(1), requests.Load(), "Must not fetch the target schema file again")
+}
+
+func TestProcessorTranslation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	t.Cleanup(server.Close)
+
+	cfg := newDefaultConfiguration().(*Config)
+	cfg.Targets = []string{server.URL + "/schemas/1.2.0"}
+	sink := new(consumertest.TracesSink)
+	tp, err := NewFactory().CreateTracesProcessor(context.Background(), processortest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, tp.Start(context.Background(), componenttest.NewNopHost()))
+
+	in := ptrace.NewTraces()
+	rs := in.ResourceSpans().AppendEmpty()
+	rs.SetSchemaUrl(server.URL + "/schemas/1.0.0")
+	rs.ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes().PutStr("http.method", "GET")
+	require.NoError(t, tp.ConsumeTraces(context.Background(), in))
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	require.Len(t, sink.AllTraces(), 1)
+	rs = sink.AllTraces()[0].ResourceSpans().At(0)
+	assert.Equal(t, server.URL+"/schemas/1.2.0", rs.SchemaUrl())
+	assert.Equal(t, map[string]any{"http.request.method": "GET"}, rs.ScopeSpans().At(0).Spans().At(0).Attributes().AsRaw())
+}
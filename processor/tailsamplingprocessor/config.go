@@ -234,4 +234,15 @@ type Config struct {
 	// PolicyCfgs sets the tail-based sampling policy which makes a sampling decision
 	// for a given trace when requested.
 	PolicyCfgs []PolicyCfg `mapstructure:"policies"`
+	// DecisionAudit configures the log records emitted for the sampling decisions.
+	DecisionAudit DecisionAuditCfg `mapstructure:"decision_audit"`
+}
+
+// DecisionAuditCfg holds the configurable settings of the decision audit log, a log record
+// per decided trace listing the policies that matched it and the final decision.
+type DecisionAuditCfg struct {
+	// Enabled turns on the decision audit log.
+	Enabled bool `mapstructure:"enabled"`
+	// SamplingPercentage is the percentage of decided traces with an audit log record.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
 }
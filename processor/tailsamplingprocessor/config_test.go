@@ -36,6 +36,10 @@ func TestLoadConfig(t *testing.T) {
 			DecisionWait:            10 * time.Second,
 			NumTraces:               100,
 			ExpectedNewTracesPerSec: 10,
+			DecisionAudit: DecisionAuditCfg{
+				Enabled:            true,
+				SamplingPercentage: 10,
+			},
 			PolicyCfgs: []PolicyCfg{
 				{
 					sharedPolicyCfg: sharedPolicyCfg{
@@ -28,6 +28,9 @@ func createDefaultConfig() component.Config {
 	return &Config{
 		DecisionWait: 30 * time.Second,
 		NumTraces:    50000,
+		DecisionAudit: DecisionAuditCfg{
+			SamplingPercentage: 100,
+		},
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/rand"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -51,6 +52,9 @@ type tailSamplingSpanProcessor struct {
 	decisionBatcher idbatcher.Batcher
 	deleteChan      chan pcommon.TraceID
 	numTracesOnMap  *atomic.Uint64
+	decisionAudit   DecisionAuditCfg
+	// auditRand returns a number in [0, 100) compared to the decision audit sampling percentage.
+	auditRand func() float64
 }
 
 // spanAndScope a structure for holding information about span and its instrumentation scope.
@@ -95,6 +99,10 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		policies[i] = p
 	}
 
+	if cfg.DecisionAudit.Enabled && (cfg.DecisionAudit.SamplingPercentage <= 0 || cfg.DecisionAudit.SamplingPercentage > 100) {
+		return nil, fmt.Errorf("decision_audit sampling_percentage must be greater than 0 and at most 100, got %v", cfg.DecisionAudit.SamplingPercentage)
+	}
+
 	// this will start a goroutine in the background, so we run it only if everything went
 	// well in creating the policies
 	numDecisionBatches := math.Max(1, cfg.DecisionWait.Seconds())
@@ -113,6 +121,8 @@ func newTracesProcessor(ctx context.Context, settings component.TelemetrySetting
 		tickerFrequency: time.Second,
 		numTracesOnMap:  &atomic.Uint64{},
 		T:               telemetry,
+		decisionAudit:   cfg.DecisionAudit,
+		auditRand:       func() float64 { return rand.Float64() * 100 },
 	}
 
 	tsp.policyTicker = &timeutils.PolicyTicker{OnTickFunc: tsp.samplingPolicyOnTick}
@@ -195,6 +205,9 @@ func (tsp *tailSamplingSpanProcessor) samplingPolicyOnTick() {
 		trace.DecisionTime = time.Now()
 
 		decision, policy := tsp.makeDecision(id, trace, &metrics)
+		if tsp.decisionAudit.Enabled && tsp.auditRand() < tsp.decisionAudit.SamplingPercentage {
+			tsp.auditDecision(id, trace, decision)
+		}
 		tsp.RecordFinalDecision(tsp.ctx,
 			int64(time.Since(startTime)/time.Microsecond),
 			metrics.idNotFoundOnMapCount,
@@ -296,6 +309,25 @@ func (tsp *tailSamplingSpanProcessor) makeDecision(id pcommon.TraceID, trace *sa
 	return finalDecision, matchingPolicy
 }
 
+// auditDecision logs the policies that sampled the trace along with the final decision, so that
+// operators can check how the policies behave on real traffic.
+func (tsp *tailSamplingSpanProcessor) auditDecision(id pcommon.TraceID, trace *sampling.TraceData, decision sampling.Decision) {
+	var matchedPolicies []string
+	for i, p := range tsp.policies {
+		if trace.Decisions[i] == sampling.Sampled {
+			matchedPolicies = append(matchedPolicies, p.name)
+		}
+	}
+	tsp.logger.Info("Sampling decision",
+		zap.Stringer("trace_id", id),
+		zap.Bool("sampled", decision == sampling.Sampled),
+		zap.Strings("matched_policies", matchedPolicies),
+		zap.Int64("span_count", trace.SpanCount.Load()),
+		zap.Duration("evaluation_latency", time.Since(trace.DecisionTime)),
+		zap.Duration("decision_latency", trace.DecisionTime.Sub(trace.ArrivalTime)),
+	)
+}
+
 // ConsumeTraces is required by the processor.Traces interface.
 func (tsp *tailSamplingSpanProcessor) ConsumeTraces(_ context.Context, td ptrace.Traces) error {
 	resourceSpans := td.ResourceSpans()
@@ -891,6 +891,76 @@ func TestDuplicatePolicyName(t *testing.T) {
 	assert.Equal(t, err, errors.New(`duplicate policy name "always_sample"`))
 }
 
+func TestDecisionAuditLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		auditRand  float64
+		expectLogs int
+	}{
+		{name: "audited", auditRand: 10, expectLogs: 1},
+		{name: "not audited", auditRand: 60, expectLogs: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const maxSize = 100
+			zc, logs := observer.New(zap.InfoLevel)
+			mpe1 := &mockPolicyEvaluator{NextDecision: sampling.Sampled}
+			mpe2 := &mockPolicyEvaluator{NextDecision: sampling.NotSampled}
+			tsp := &tailSamplingSpanProcessor{
+				T:               telemetry.New(),
+				ctx:             context.Background(),
+				nextConsumer:    new(consumertest.TracesSink),
+				maxNumTraces:    maxSize,
+				logger:          zap.New(zc),
+				decisionBatcher: newSyncIDBatcher(1),
+				policies: []*policy{
+					{name: "policy-1", evaluator: mpe1, ctx: context.TODO()},
+					{name: "policy-2", evaluator: mpe2, ctx: context.TODO()},
+				},
+				deleteChan:      make(chan pcommon.TraceID, maxSize),
+				policyTicker:    &manualTTicker{},
+				tickerFrequency: 100 * time.Millisecond,
+				numTracesOnMap:  &atomic.Uint64{},
+				decisionAudit:   DecisionAuditCfg{Enabled: true, SamplingPercentage: 50},
+				auditRand:       func() float64 { return tt.auditRand },
+			}
+			require.NoError(t, tsp.Start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, tsp.Shutdown(context.Background()))
+			}()
+
+			traceIDs, batches := generateIDsAndBatches(1)
+			require.NoError(t, tsp.ConsumeTraces(context.Background(), batches[0]))
+			for i := 0; i < 2 && mpe1.EvaluationCount == 0; i++ {
+				tsp.samplingPolicyOnTick()
+			}
+			require.Equal(t, 1, mpe1.EvaluationCount)
+
+			entries := logs.FilterMessage("Sampling decision").All()
+			require.Len(t, entries, tt.expectLogs)
+			if tt.expectLogs == 0 {
+				return
+			}
+			fields := entries[0].ContextMap()
+			assert.Equal(t, traceIDs[0].String(), fields["trace_id"])
+			assert.Equal(t, true, fields["sampled"])
+			assert.Equal(t, []any{"policy-1"}, fields["matched_policies"])
+			assert.Equal(t, int64(1), fields["span_count"])
+			assert.Contains(t, fields, "evaluation_latency")
+			assert.Contains(t, fields, "decision_latency")
+		})
+	}
+}
+
+func TestInvalidDecisionAuditSamplingPercentage(t *testing.T) {
+	_, err := newTracesProcessor(context.Background(), componenttest.NewNopTelemetrySettings(), new(consumertest.TracesSink), Config{
+		DecisionWait:  500 * time.Millisecond,
+		NumTraces:     uint64(50000),
+		DecisionAudit: DecisionAuditCfg{Enabled: true, SamplingPercentage: 150},
+	})
+	assert.EqualError(t, err, "decision_audit sampling_percentage must be greater than 0 and at most 100, got 150")
+}
+
 func collectSpanIDs(trace ptrace.Traces) []pcommon.SpanID {
 	var spanIDs []pcommon.SpanID
 
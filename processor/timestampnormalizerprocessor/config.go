@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizerprocessor"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/chrony"
+)
+
+const (
+	sourceStatic    = "static"
+	sourceChrony    = "chrony"
+	sourceAttribute = "attribute"
+)
+
+// Config defines configuration for the timestamp normalizer processor.
+type Config struct {
+	// Source is where the clock offset is read from:
+	//   static: the configured Offset.
+	//   chrony: the offset of the clock of the collector host measured by chronyd.
+	//   attribute: the OffsetAttribute resource attribute of the telemetry.
+	Source string `mapstructure:"source"`
+
+	// Offset is how far the clock of the hosts emitting the telemetry is ahead of the reference time,
+	// it is negative if the clock is behind. Only used by the static source.
+	Offset time.Duration `mapstructure:"offset"`
+
+	// Chrony defines how chronyd is queried. Only used by the chrony source.
+	Chrony ChronyConfig `mapstructure:"chrony"`
+
+	// OffsetAttribute is the resource attribute holding the offset of the clock of the host emitting the
+	// telemetry, either as a number of seconds or as a duration string. Only used by the attribute source.
+	OffsetAttribute string `mapstructure:"offset_attribute"`
+
+	// MinOffset is the smallest offset that is corrected, smaller offsets are considered as noise.
+	MinOffset time.Duration `mapstructure:"min_offset"`
+
+	// CorrectionAttribute is set to the correction applied in seconds on the corrected spans and log records.
+	// Nothing is tagged if empty.
+	CorrectionAttribute string `mapstructure:"correction_attribute"`
+}
+
+// ChronyConfig defines how chronyd is queried.
+type ChronyConfig struct {
+	// Endpoint is the published address or unix socket of chronyd, in the same format as
+	// the endpoint of the chrony receiver:
+	//   unix:///path/to/chronyd/unix.sock
+	//   udp://localhost:323
+	Endpoint string `mapstructure:"endpoint"`
+	// Timeout is the timeout of a query to chronyd.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// RefreshInterval is the interval at which the offset is refreshed.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the processor configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.MinOffset < 0 {
+		return errors.New("min_offset must not be negative")
+	}
+
+	switch cfg.Source {
+	case sourceStatic:
+		return nil
+	case sourceChrony:
+		if cfg.Chrony.Timeout <= 0 {
+			return errors.New("chrony::timeout must be positive")
+		}
+		if cfg.Chrony.RefreshInterval <= 0 {
+			return errors.New("chrony::refresh_interval must be positive")
+		}
+		_, _, err := chrony.SplitNetworkEndpoint(cfg.Chrony.Endpoint)
+		return err
+	case sourceAttribute:
+		if cfg.OffsetAttribute == "" {
+			return errors.New("offset_attribute must be set when the source is attribute")
+		}
+		return nil
+	default:
+		return fmt.Errorf("source must be one of %q, %q or %q, got %q", sourceStatic, sourceChrony, sourceAttribute, cfg.Source)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizerprocessor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizerprocessor/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id          component.ID
+		expected    func(*Config)
+		expectedErr string
+	}{
+		{
+			id:       component.NewIDWithName(metadata.Type, ""),
+			expected: func(*Config) {},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "static"),
+			expected: func(cfg *Config) {
+				cfg.Offset = -1500 * time.Millisecond
+				cfg.MinOffset = 10 * time.Millisecond
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "chrony"),
+			expected: func(cfg *Config) {
+				cfg.Source = sourceChrony
+				cfg.Chrony = ChronyConfig{
+					Endpoint:        "udp://localhost:323",
+					Timeout:         time.Second,
+					RefreshInterval: 30 * time.Second,
+				}
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "attribute"),
+			expected: func(cfg *Config) {
+				cfg.Source = sourceAttribute
+				cfg.OffsetAttribute = "host.clock.offset"
+				cfg.CorrectionAttribute = ""
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_source"),
+			expectedErr: `source must be one of "static", "chrony" or "attribute", got "ntp"`,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_offset_attribute"),
+			expectedErr: "offset_attribute must be set when the source is attribute",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_chrony_endpoint"),
+			expectedErr: "missing '://' to separate networks",
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative_min_offset"),
+			expectedErr: "min_offset must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
+
+			expected := createDefaultConfig().(*Config)
+			tt.expected(expected)
+			assert.NoError(t, component.ValidateConfig(cfg))
+			assert.Equal(t, expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package timestampnormalizerprocessor implements a processor correcting the
+// timestamps of the telemetry emitted by hosts with a skewed clock.
+package timestampnormalizerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizerprocessor"
@@ -0,0 +1,259 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizerprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/timestampnormalizerprocessor"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/chrony"
+)
+
+type timestampNormalizer struct {
+	cfg    *Config
+	logger *zap.Logger
+	chrony chrony.Client
+
+	// offset is the offset of the static and chrony sources, in nanoseconds.
+	offset atomic.Int64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newTimestampNormalizer(cfg *Config, logger *zap.Logger) (*timestampNormalizer, error) {
+	tn := &timestampNormalizer{
+		cfg:    cfg,
+		logger: logger,
+	}
+	switch cfg.Source {
+	case sourceStatic:
+		tn.offset.Store(int64(cfg.Offset))
+	case sourceChrony:
+		client, err := chrony.New(cfg.Chrony.Endpoint, cfg.Chrony.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		tn.chrony = client
+	}
+	return tn, nil
+}
+
+// start queries chronyd for the offset and refreshes it periodically. The processor keeps
+// the previous offset, or doesn't correct anything before the first success, if chronyd can't be queried.
+func (tn *timestampNormalizer) start(ctx context.Context, _ component.Host) error {
+	if tn.chrony == nil {
+		return nil
+	}
+	tn.refreshOffset(ctx)
+
+	var refreshCtx context.Context
+	refreshCtx, tn.cancel = context.WithCancel(context.Background())
+	tn.wg.Add(1)
+	go func() {
+		defer tn.wg.Done()
+		ticker := time.NewTicker(tn.cfg.Chrony.RefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-refreshCtx.Done():
+				return
+			case <-ticker.C:
+				tn.refreshOffset(refreshCtx)
+			}
+		}
+	}()
+	return nil
+}
+
+func (tn *timestampNormalizer) shutdown(context.Context) error {
+	if tn.cancel != nil {
+		tn.cancel()
+	}
+	tn.wg.Wait()
+	return nil
+}
+
+func (tn *timestampNormalizer) refreshOffset(ctx context.Context) {
+	tracking, err := tn.chrony.GetTrackingData(ctx)
+	if err != nil {
+		tn.logger.Warn("Failed to get the clock offset from chronyd", zap.Error(err))
+		return
+	}
+	// The current correction of chronyd is positive when the system clock is behind.
+	offset := time.Duration(-tracking.CurrentCorrection * float64(time.Second))
+	tn.offset.Store(int64(offset))
+	tn.logger.Debug("Refreshed the clock offset from chronyd", zap.Duration("offset", offset))
+}
+
+// resourceCorrection returns the duration to add to the timestamps of the telemetry of a resource,
+// and false if there is nothing to correct.
+func (tn *timestampNormalizer) resourceCorrection(resource pcommon.Resource) (time.Duration, bool) {
+	offset := time.Duration(tn.offset.Load())
+	if tn.cfg.Source == sourceAttribute {
+		val, ok := resource.Attributes().Get(tn.cfg.OffsetAttribute)
+		if !ok {
+			return 0, false
+		}
+		if offset, ok = parseOffset(val); !ok {
+			return 0, false
+		}
+	}
+	if offset == 0 || (offset < tn.cfg.MinOffset && -offset < tn.cfg.MinOffset) {
+		return 0, false
+	}
+	return -offset, true
+}
+
+// parseOffset parses an offset attribute, either a number of seconds or a duration string.
+func parseOffset(val pcommon.Value) (time.Duration, bool) {
+	switch val.Type() {
+	case pcommon.ValueTypeDouble:
+		return time.Duration(val.Double() * float64(time.Second)), true
+	case pcommon.ValueTypeInt:
+		return time.Duration(val.Int()) * time.Second, true
+	case pcommon.ValueTypeStr:
+		offset, err := time.ParseDuration(val.Str())
+		return offset, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// shift adds the correction to a timestamp, unset timestamps are left untouched.
+func shift(ts pcommon.Timestamp, correction time.Duration) pcommon.Timestamp {
+	if ts == 0 {
+		return ts
+	}
+	return pcommon.NewTimestampFromTime(ts.AsTime().Add(correction))
+}
+
+func (tn *timestampNormalizer) tag(attrs pcommon.Map, correction time.Duration) {
+	if tn.cfg.CorrectionAttribute != "" {
+		attrs.PutDouble(tn.cfg.CorrectionAttribute, correction.Seconds())
+	}
+}
+
+func (tn *timestampNormalizer) processTraces(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		correction, ok := tn.resourceCorrection(rs.Resource())
+		if !ok {
+			continue
+		}
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			spans := rs.ScopeSpans().At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				span.SetStartTimestamp(shift(span.StartTimestamp(), correction))
+				span.SetEndTimestamp(shift(span.EndTimestamp(), correction))
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					event.SetTimestamp(shift(event.Timestamp(), correction))
+				}
+				tn.tag(span.Attributes(), correction)
+			}
+		}
+	}
+	return td, nil
+}
+
+func (tn *timestampNormalizer) processLogs(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		correction, ok := tn.resourceCorrection(rl.Resource())
+		if !ok {
+			continue
+		}
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			records := rl.ScopeLogs().At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				record := records.At(k)
+				record.SetTimestamp(shift(record.Timestamp(), correction))
+				tn.tag(record.Attributes(), correction)
+			}
+		}
+	}
+	return ld, nil
+}
+
+// processMetrics corrects the timestamps of the data points. The data points aren't tagged with the
+// correction, as a changing value would change their series, on the data points as on their resource.
+func (tn *timestampNormalizer) processMetrics(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		correction, ok := tn.resourceCorrection(rm.Resource())
+		if !ok {
+			continue
+		}
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			metrics := rm.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				shiftMetric(metrics.At(k), correction)
+			}
+		}
+	}
+	return md, nil
+}
+
+type dataPoint interface {
+	StartTimestamp() pcommon.Timestamp
+	SetStartTimestamp(pcommon.Timestamp)
+	Timestamp() pcommon.Timestamp
+	SetTimestamp(pcommon.Timestamp)
+}
+
+func shiftDataPoint(dp dataPoint, correction time.Duration) {
+	dp.SetStartTimestamp(shift(dp.StartTimestamp(), correction))
+	dp.SetTimestamp(shift(dp.Timestamp(), correction))
+}
+
+func shiftExemplars(exemplars pmetric.ExemplarSlice, correction time.Duration) {
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		exemplar.SetTimestamp(shift(exemplar.Timestamp(), correction))
+	}
+}
+
+func shiftNumberDataPoints(dps pmetric.NumberDataPointSlice, correction time.Duration) {
+	for i := 0; i < dps.Len(); i++ {
+		shiftDataPoint(dps.At(i), correction)
+		shiftExemplars(dps.At(i).Exemplars(), correction)
+	}
+}
+
+func shiftMetric(metric pmetric.Metric, correction time.Duration) {
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		shiftNumberDataPoints(metric.Gauge().DataPoints(), correction)
+	case pmetric.MetricTypeSum:
+		shiftNumberDataPoints(metric.Sum().DataPoints(), correction)
+	case pmetric.MetricTypeHistogram:
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			shiftDataPoint(dps.At(i), correction)
+			shiftExemplars(dps.At(i).Exemplars(), correction)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			shiftDataPoint(dps.At(i), correction)
+			shiftExemplars(dps.At(i).Exemplars(), correction)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			shiftDataPoint(dps.At(i), correction)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package timestampnormalizerprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/chrony"
+)
+
+var (
+	baseTime = time.Date(2024, 6, 12, 10, 0, 0, 0, time.UTC)
+	baseTs   = pcommon.NewTimestampFromTime(baseTime)
+)
+
+func newTestNormalizer(t *testing.T, fns ...func(*Config)) *timestampNormalizer {
+	cfg := createDefaultConfig().(*Config)
+	for _, fn := range fns {
+		fn(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+	tn, err := newTimestampNormalizer(cfg, zap.NewNop())
+	require.NoError(t, err)
+	return tn
+}
+
+func TestProcessTracesStatic(t *testing.T) {
+	tn := newTestNormalizer(t, func(cfg *Config) { cfg.Offset = 2 * time.Second })
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(baseTs)
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(baseTime.Add(time.Second)))
+	span.Events().AppendEmpty().SetTimestamp(pcommon.NewTimestampFromTime(baseTime.Add(500 * time.Millisecond)))
+
+	td, err := tn.processTraces(context.Background(), td)
+	require.NoError(t, err)
+	span = td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, baseTime.Add(-2*time.Second), span.StartTimestamp().AsTime())
+	assert.Equal(t, baseTime.Add(-time.Second), span.EndTimestamp().AsTime())
+	assert.Equal(t, baseTime.Add(-1500*time.Millisecond), span.Events().At(0).Timestamp().AsTime())
+	correction, ok := span.Attributes().Get("timestamp_normalizer.correction")
+	require.True(t, ok)
+	assert.Equal(t, -2.0, correction.Double())
+}
+
+func TestProcessLogsAttribute(t *testing.T) {
+	tn := newTestNormalizer(t, func(cfg *Config) {
+		cfg.Source = sourceAttribute
+		cfg.OffsetAttribute = "host.clock.offset"
+		cfg.MinOffset = 100 * time.Millisecond
+	})
+
+	ld := plog.NewLogs()
+	for _, offset := range []any{-1.5, "250ms", "10ms", "invalid", nil} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if offset != nil {
+			require.NoError(t, rl.Resource().Attributes().FromRaw(map[string]any{"host.clock.offset": offset}))
+		}
+		records := rl.ScopeLogs().AppendEmpty().LogRecords()
+		records.AppendEmpty().SetTimestamp(baseTs)
+		records.AppendEmpty()
+	}
+
+	ld, err := tn.processLogs(context.Background(), ld)
+	require.NoError(t, err)
+	for i, expected := range []time.Duration{1500 * time.Millisecond, -250 * time.Millisecond, 0, 0, 0} {
+		records := ld.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords()
+		assert.Equal(t, baseTime.Add(expected), records.At(0).Timestamp().AsTime())
+		assert.Zero(t, records.At(1).Timestamp(), "unset timestamps are not corrected")
+
+		correction, ok := records.At(0).Attributes().Get("timestamp_normalizer.correction")
+		if expected == 0 {
+			assert.False(t, ok)
+			continue
+		}
+		require.True(t, ok)
+		assert.Equal(t, expected.Seconds(), correction.Double())
+	}
+}
+
+func TestProcessMetrics(t *testing.T) {
+	tn := newTestNormalizer(t, func(cfg *Config) {
+		cfg.Offset = -time.Minute
+	})
+
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.SetStartTimestamp(baseTs)
+	sum.SetTimestamp(baseTs)
+	sum.Exemplars().AppendEmpty().SetTimestamp(baseTs)
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.SetTimestamp(baseTs)
+	summary := metrics.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	summary.SetTimestamp(baseTs)
+
+	md, err := tn.processMetrics(context.Background(), md)
+	require.NoError(t, err)
+	expected := baseTime.Add(time.Minute)
+	assert.Equal(t, expected, sum.StartTimestamp().AsTime())
+	assert.Equal(t, expected, sum.Timestamp().AsTime())
+	assert.Equal(t, expected, sum.Exemplars().At(0).Timestamp().AsTime())
+	assert.Zero(t, histogram.StartTimestamp())
+	assert.Equal(t, expected, histogram.Timestamp().AsTime())
+	assert.Equal(t, expected, summary.Timestamp().AsTime())
+	assert.Zero(t, md.ResourceMetrics().At(0).Resource().Attributes().Len())
+}
+
+type fakeChronyClient struct {
+	tracking *chrony.Tracking
+	err      error
+}
+
+func (c *fakeChronyClient) GetTrackingData(context.Context) (*chrony.Tracking, error) {
+	return c.tracking, c.err
+}
+
+func TestChronyOffset(t *testing.T) {
+	tn := newTestNormalizer(t)
+	tn.cfg.Source = sourceChrony
+	tn.offset.Store(0)
+	client := &fakeChronyClient{err: errors.New("connection refused")}
+	tn.chrony = client
+
+	require.NoError(t, tn.start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, tn.shutdown(context.Background())) }()
+	_, ok := tn.resourceCorrection(pcommon.NewResource())
+	assert.False(t, ok, "nothing is corrected before chronyd is reached")
+
+	// A positive current correction means that the clock is behind.
+	client.tracking, client.err = &chrony.Tracking{CurrentCorrection: 0.25}, nil
+	tn.refreshOffset(context.Background())
+	correction, ok := tn.resourceCorrection(pcommon.NewResource())
+	require.True(t, ok)
+	assert.Equal(t, 250*time.Millisecond, correction)
+
+	// The previous offset is kept if chronyd can't be reached.
+	client.err = errors.New("timeout")
+	tn.refreshOffset(context.Background())
+	correction, ok = tn.resourceCorrection(pcommon.NewResource())
+	require.True(t, ok)
+	assert.Equal(t, 250*time.Millisecond, correction)
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+// Default keywords for each severity, in order of confidence: when several keywords match at the
+// same position of the body, the first one wins.
+var (
+	defaultFatalPatterns = []string{`(?i)\bfatal\b`, `(?i)\bcritical\b`, `(?i)\bemergency\b`, `(?i)\bpanic\b`, `(?i)\bcrit\b`, `(?i)\bemerg\b`}
+	defaultErrorPatterns = []string{`(?i)\berror\b`, `(?i)\bsevere\b`, `(?i)\berr\b`}
+	defaultWarnPatterns  = []string{`(?i)\bwarning\b`, `(?i)\bwarn\b`}
+	defaultInfoPatterns  = []string{`(?i)\binfo\b`, `(?i)\bnotice\b`}
+	defaultDebugPatterns = []string{`(?i)\bdebug\b`, `(?i)\bdbg\b`}
+	defaultTracePatterns = []string{`(?i)\btrace\b`}
+)
+
+type severityFromBodyArguments struct {
+	Fatal ottl.Optional[[]string]
+	Error ottl.Optional[[]string]
+	Warn  ottl.Optional[[]string]
+	Info  ottl.Optional[[]string]
+	Debug ottl.Optional[[]string]
+	Trace ottl.Optional[[]string]
+}
+
+type severityPattern struct {
+	severity plog.SeverityNumber
+	regex    *regexp.Regexp
+}
+
+func newSeverityFromBodyFactory() ottl.Factory[ottllog.TransformContext] {
+	return ottl.NewFactory("SeverityFromBody", &severityFromBodyArguments{}, createSeverityFromBodyFunction)
+}
+
+func createSeverityFromBodyFunction(_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[ottllog.TransformContext], error) {
+	args, ok := oArgs.(*severityFromBodyArguments)
+
+	if !ok {
+		return nil, fmt.Errorf("SeverityFromBodyFactory args must be of type *severityFromBodyArguments")
+	}
+
+	return severityFromBody(args)
+}
+
+func severityFromBody(args *severityFromBodyArguments) (ottl.ExprFunc[ottllog.TransformContext], error) {
+	// Tables are ordered from the most to the least severe, so that ties between severities resolve
+	// to the most severe one.
+	tables := []struct {
+		severity plog.SeverityNumber
+		patterns ottl.Optional[[]string]
+		defaults []string
+	}{
+		{severity: plog.SeverityNumberFatal, patterns: args.Fatal, defaults: defaultFatalPatterns},
+		{severity: plog.SeverityNumberError, patterns: args.Error, defaults: defaultErrorPatterns},
+		{severity: plog.SeverityNumberWarn, patterns: args.Warn, defaults: defaultWarnPatterns},
+		{severity: plog.SeverityNumberInfo, patterns: args.Info, defaults: defaultInfoPatterns},
+		{severity: plog.SeverityNumberDebug, patterns: args.Debug, defaults: defaultDebugPatterns},
+		{severity: plog.SeverityNumberTrace, patterns: args.Trace, defaults: defaultTracePatterns},
+	}
+
+	var patterns []severityPattern
+	for _, table := range tables {
+		exprs := table.defaults
+		if !table.patterns.IsEmpty() {
+			exprs = table.patterns.Get()
+		}
+		for _, expr := range exprs {
+			regex, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("the pattern supplied to SeverityFromBody is not a valid regexp pattern: %w", err)
+			}
+			patterns = append(patterns, severityPattern{severity: table.severity, regex: regex})
+		}
+	}
+
+	return func(_ context.Context, tCtx ottllog.TransformContext) (any, error) {
+		body := tCtx.GetLogRecord().Body().AsString()
+
+		// The severity is usually logged before the message, which may itself contain keywords of
+		// other severities: the match found first in the body wins.
+		severity, position := plog.SeverityNumberUnspecified, len(body)
+		for _, p := range patterns {
+			loc := p.regex.FindStringIndex(body)
+			if loc != nil && loc[0] < position {
+				severity, position = p.severity, loc[0]
+			}
+		}
+		return int64(severity), nil
+	}, nil
+}
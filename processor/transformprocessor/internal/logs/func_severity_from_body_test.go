@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+)
+
+func Test_severityFromBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body func(pcommon.Value)
+		args severityFromBodyArguments
+		want plog.SeverityNumber
+	}{
+		{
+			name: "info",
+			body: func(v pcommon.Value) {
+				v.SetStr("[2023-09-22 07:38:22,570] INFO [Something]: some interesting log")
+			},
+			want: plog.SeverityNumberInfo,
+		},
+		{
+			name: "lowercase",
+			body: func(v pcommon.Value) {
+				v.SetStr("2023-09-22T07:38:22Z warning: disk almost full")
+			},
+			want: plog.SeverityNumberWarn,
+		},
+		{
+			name: "abbreviation",
+			body: func(v pcommon.Value) {
+				v.SetStr("E0922 07:38:22 ERR connection refused")
+			},
+			want: plog.SeverityNumberError,
+		},
+		{
+			name: "first keyword wins",
+			body: func(v pcommon.Value) {
+				v.SetStr("INFO request failed with error: timeout")
+			},
+			want: plog.SeverityNumberInfo,
+		},
+		{
+			name: "keywords within words are ignored",
+			body: func(v pcommon.Value) {
+				v.SetStr("errors=0 information retrieved")
+			},
+			want: plog.SeverityNumberUnspecified,
+		},
+		{
+			name: "map body",
+			body: func(v pcommon.Value) {
+				v.SetEmptyMap().PutStr("level", "debug")
+			},
+			want: plog.SeverityNumberDebug,
+		},
+		{
+			name: "no match",
+			body: func(v pcommon.Value) {
+				v.SetStr("some interesting log")
+			},
+			want: plog.SeverityNumberUnspecified,
+		},
+		{
+			name: "custom table",
+			body: func(v pcommon.Value) {
+				v.SetStr("I0922 07:38:22.570 some interesting log")
+			},
+			args: severityFromBodyArguments{
+				Info: ottl.NewTestingOptional[[]string]([]string{`^I\d{4} `}),
+			},
+			want: plog.SeverityNumberInfo,
+		},
+		{
+			name: "custom table replaces defaults",
+			body: func(v pcommon.Value) {
+				v.SetStr("WARN disk almost full")
+			},
+			args: severityFromBodyArguments{
+				Warn: ottl.NewTestingOptional[[]string]([]string{`^W\d{4} `}),
+			},
+			want: plog.SeverityNumberUnspecified,
+		},
+		{
+			name: "confidence ordering",
+			body: func(v pcommon.Value) {
+				v.SetStr("level=ERROR message=something failed")
+			},
+			args: severityFromBodyArguments{
+				Error: ottl.NewTestingOptional[[]string]([]string{`ERROR`}),
+				Warn:  ottl.NewTestingOptional[[]string]([]string{`ERR`}),
+			},
+			want: plog.SeverityNumberError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := plog.NewLogRecord()
+			tt.body(log.Body())
+
+			exprFunc, err := severityFromBody(&tt.args)
+			require.NoError(t, err)
+
+			result, err := exprFunc(context.Background(), ottllog.NewTransformContext(log, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+			require.NoError(t, err)
+			assert.Equal(t, int64(tt.want), result)
+		})
+	}
+}
+
+func Test_severityFromBody_invalidPattern(t *testing.T) {
+	_, err := severityFromBody(&severityFromBodyArguments{
+		Error: ottl.NewTestingOptional[[]string]([]string{`(`}),
+	})
+	assert.ErrorContains(t, err, "not a valid regexp pattern")
+}
@@ -15,6 +15,7 @@ import (
 
 func Test_LogFunctions(t *testing.T) {
 	expected := ottlfuncs.StandardFuncs[ottllog.TransformContext]()
+	expected["SeverityFromBody"] = newSeverityFromBodyFactory()
 	actual := LogFunctions()
 	require.Equal(t, len(expected), len(actual))
 	for k := range actual {
@@ -204,6 +204,12 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "pass")
 			},
 		},
+		{
+			statement: `set(severity_number, SeverityFromBody(info=["^operationA$"])) where body == "operationA"`,
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).SetSeverityNumber(plog.SeverityNumberInfo)
+			},
+		},
 		{
 			statement: `delete_key(attributes, "http.url") where body == "operationA"`,
 			want: func(td plog.Logs) {
@@ -4,6 +4,9 @@
 package awsecscontainermetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver"
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
 	"time"
 )
 
@@ -12,4 +15,57 @@ type Config struct {
 
 	// CollectionInterval is the interval at which metrics should be collected
 	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+
+	// TaskTags configures the tags of the task added as resource attributes
+	TaskTags TaskTagsConfig `mapstructure:"task_tags"`
+
+	// Sidecars configures the scraping of the Prometheus endpoints declared by the containers of the task
+	Sidecars SidecarsConfig `mapstructure:"sidecars"`
+}
+
+// TaskTagsConfig defines the propagation of the tags of the task to the resource attributes.
+type TaskTagsConfig struct {
+	// Enabled reads the task metadata with the tags of the task.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Include is the list of regular expressions matching the keys of the tags added, all the tags
+	// are added if empty.
+	Include []string `mapstructure:"include"`
+
+	// Prefix is prepended to the key of a tag to build the name of its attribute.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// SidecarsConfig defines the discovery of the Prometheus endpoints declared with docker labels.
+type SidecarsConfig struct {
+	// Enabled scrapes the endpoints of the containers declaring a Prometheus port.
+	Enabled bool `mapstructure:"enabled"`
+
+	// PortLabel is the docker label holding the port of the endpoint of a container.
+	PortLabel string `mapstructure:"port_label"`
+
+	// PathLabel is the docker label holding the path of the endpoint of a container,
+	// the path is /metrics if the label is missing.
+	PathLabel string `mapstructure:"path_label"`
+
+	// Timeout is the timeout of the scrape of every endpoint.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	for _, include := range cfg.TaskTags.Include {
+		if _, err := regexp.Compile(include); err != nil {
+			return fmt.Errorf("invalid task_tags::include expression %q: %w", include, err)
+		}
+	}
+	if cfg.Sidecars.Enabled {
+		if cfg.Sidecars.PortLabel == "" {
+			return errors.New("sidecars::port_label cannot be empty")
+		}
+		if cfg.Sidecars.Timeout <= 0 {
+			return errors.New("sidecars::timeout must be positive")
+		}
+	}
+	return nil
 }
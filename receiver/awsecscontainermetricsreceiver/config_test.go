@@ -34,6 +34,31 @@ func TestLoadConfig(t *testing.T) {
 			id: component.NewIDWithName(metadata.Type, "collection_interval_settings"),
 			expected: &Config{
 				CollectionInterval: 10 * time.Second,
+				TaskTags: TaskTagsConfig{
+					Prefix: defaultTaskTagsPrefix,
+				},
+				Sidecars: SidecarsConfig{
+					PortLabel: defaultSidecarPortLabel,
+					PathLabel: defaultSidecarPathLabel,
+					Timeout:   defaultSidecarTimeout,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "task_tags_and_sidecars"),
+			expected: &Config{
+				CollectionInterval: defaultCollectionInterval,
+				TaskTags: TaskTagsConfig{
+					Enabled: true,
+					Include: []string{"^team$", "^aws:ecs:"},
+					Prefix:  "ecs.tag.",
+				},
+				Sidecars: SidecarsConfig{
+					Enabled:   true,
+					PortLabel: "PROMETHEUS_PORT",
+					PathLabel: defaultSidecarPathLabel,
+					Timeout:   2 * time.Second,
+				},
 			},
 		},
 	}
@@ -52,3 +77,51 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(*Config)
+		wantError string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name: "invalid include expression",
+			modify: func(cfg *Config) {
+				cfg.TaskTags.Include = []string{"("}
+			},
+			wantError: "invalid task_tags::include expression \"(\": error parsing regexp: missing closing ): `(`",
+		},
+		{
+			name: "empty port label",
+			modify: func(cfg *Config) {
+				cfg.Sidecars.Enabled = true
+				cfg.Sidecars.PortLabel = ""
+			},
+			wantError: "sidecars::port_label cannot be empty",
+		},
+		{
+			name: "invalid timeout",
+			modify: func(cfg *Config) {
+				cfg.Sidecars.Enabled = true
+				cfg.Sidecars.Timeout = 0
+			},
+			wantError: "sidecars::timeout must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			tt.modify(cfg)
+			err := component.ValidateConfig(cfg)
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantError)
+			}
+		})
+	}
+}
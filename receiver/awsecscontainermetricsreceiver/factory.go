@@ -22,6 +22,11 @@ import (
 const (
 	// Default collection interval. Every 20s the receiver will collect metrics from Amazon ECS Task Metadata Endpoint
 	defaultCollectionInterval = 20 * time.Second
+
+	defaultTaskTagsPrefix   = "aws.ecs.task.tag."
+	defaultSidecarPortLabel = "ECS_PROMETHEUS_EXPORTER_PORT"
+	defaultSidecarPathLabel = "ECS_PROMETHEUS_METRICS_PATH"
+	defaultSidecarTimeout   = 5 * time.Second
 )
 
 // NewFactory creates a factory for AWS ECS Container Metrics receiver.
@@ -36,6 +41,14 @@ func NewFactory() receiver.Factory {
 func createDefaultConfig() component.Config {
 	return &Config{
 		CollectionInterval: defaultCollectionInterval,
+		TaskTags: TaskTagsConfig{
+			Prefix: defaultTaskTagsPrefix,
+		},
+		Sidecars: SidecarsConfig{
+			PortLabel: defaultSidecarPortLabel,
+			PathLabel: defaultSidecarPathLabel,
+			Timeout:   defaultSidecarTimeout,
+		},
 	}
 }
 
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsecscontainermetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+)
+
+const defaultSidecarMetricsPath = "/metrics"
+
+// SidecarEndpoint is a Prometheus endpoint declared by a container of the task with docker labels
+type SidecarEndpoint struct {
+	Container ecsutil.ContainerMetadata
+	URL       string
+}
+
+// SidecarEndpoints returns the endpoints declared by the containers of the task. The host of an endpoint
+// is the first IPv4 address of its container, the path is /metrics if the path label is missing.
+func SidecarEndpoints(metadata ecsutil.TaskMetadata, portLabel, pathLabel string, logger *zap.Logger) []SidecarEndpoint {
+	var endpoints []SidecarEndpoint
+	for _, cm := range metadata.Containers {
+		port, ok := cm.Labels[portLabel]
+		if !ok {
+			continue
+		}
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			logger.Warn("Invalid Prometheus port declared by container: "+cm.ContainerName, zap.String("port", port))
+			continue
+		}
+
+		host := ""
+		for _, network := range cm.Networks {
+			if len(network.IPv4Addresses) > 0 {
+				host = network.IPv4Addresses[0]
+				break
+			}
+		}
+		if host == "" {
+			logger.Debug("No IPv4 address found for container: " + cm.ContainerName)
+			continue
+		}
+
+		path := cm.Labels[pathLabel]
+		if path == "" {
+			path = defaultSidecarMetricsPath
+		} else if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		endpoints = append(endpoints, SidecarEndpoint{
+			Container: cm,
+			URL:       "http://" + host + ":" + port + path,
+		})
+	}
+	return endpoints
+}
+
+// SidecarMetricsData converts the metric families scraped from the endpoint of a sidecar to metrics with
+// the resource of its container.
+func SidecarMetricsData(endpoint SidecarEndpoint, metadata ecsutil.TaskMetadata, families map[string]*dto.MetricFamily, logger *zap.Logger) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.SetSchemaUrl(conventions.SchemaURL)
+
+	resource := containerResource(endpoint.Container, logger)
+	taskResource(metadata).Attributes().Range(func(k string, av pcommon.Value) bool {
+		av.CopyTo(resource.Attributes().PutEmpty(k))
+		return true
+	})
+	resource.MoveTo(rm.Resource())
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+	timestamp := pcommon.NewTimestampFromTime(time.Now())
+	for _, name := range names {
+		appendPrometheusMetric(metrics, families[name], timestamp)
+	}
+	return md
+}
+
+func appendPrometheusMetric(metrics pmetric.MetricSlice, family *dto.MetricFamily, timestamp pcommon.Timestamp) {
+	if len(family.GetMetric()) == 0 {
+		return
+	}
+	metric := metrics.AppendEmpty()
+	metric.SetName(family.GetName())
+	metric.SetDescription(family.GetHelp())
+
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		sum := metric.SetEmptySum()
+		sum.SetIsMonotonic(true)
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		for _, m := range family.GetMetric() {
+			dp := sum.DataPoints().AppendEmpty()
+			setPrometheusDataPoint(dp, m, timestamp)
+			dp.SetDoubleValue(m.GetCounter().GetValue())
+		}
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.SetEmptyHistogram()
+		histogram.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		for _, m := range family.GetMetric() {
+			dp := histogram.DataPoints().AppendEmpty()
+			setPrometheusDataPoint(dp, m, timestamp)
+			h := m.GetHistogram()
+			dp.SetCount(h.GetSampleCount())
+			dp.SetSum(h.GetSampleSum())
+			// Prometheus buckets are cumulative and may end with the +Inf bucket.
+			var previous uint64
+			for _, bucket := range h.GetBucket() {
+				if math.IsInf(bucket.GetUpperBound(), 1) {
+					continue
+				}
+				dp.ExplicitBounds().Append(bucket.GetUpperBound())
+				dp.BucketCounts().Append(bucket.GetCumulativeCount() - previous)
+				previous = bucket.GetCumulativeCount()
+			}
+			dp.BucketCounts().Append(h.GetSampleCount() - previous)
+		}
+	case dto.MetricType_SUMMARY:
+		summary := metric.SetEmptySummary()
+		for _, m := range family.GetMetric() {
+			dp := summary.DataPoints().AppendEmpty()
+			setPrometheusDataPoint(dp, m, timestamp)
+			s := m.GetSummary()
+			dp.SetCount(s.GetSampleCount())
+			dp.SetSum(s.GetSampleSum())
+			for _, quantile := range s.GetQuantile() {
+				qv := dp.QuantileValues().AppendEmpty()
+				qv.SetQuantile(quantile.GetQuantile())
+				qv.SetValue(quantile.GetValue())
+			}
+		}
+	default:
+		gauge := metric.SetEmptyGauge()
+		for _, m := range family.GetMetric() {
+			dp := gauge.DataPoints().AppendEmpty()
+			setPrometheusDataPoint(dp, m, timestamp)
+			if family.GetType() == dto.MetricType_UNTYPED {
+				dp.SetDoubleValue(m.GetUntyped().GetValue())
+			} else {
+				dp.SetDoubleValue(m.GetGauge().GetValue())
+			}
+		}
+	}
+}
+
+type prometheusDataPoint interface {
+	Attributes() pcommon.Map
+	SetTimestamp(pcommon.Timestamp)
+}
+
+func setPrometheusDataPoint(dp prometheusDataPoint, m *dto.Metric, timestamp pcommon.Timestamp) {
+	for _, label := range m.GetLabel() {
+		dp.Attributes().PutStr(label.GetName(), label.GetValue())
+	}
+	if m.TimestampMs != nil {
+		timestamp = pcommon.NewTimestampFromTime(time.UnixMilli(m.GetTimestampMs()))
+	}
+	dp.SetTimestamp(timestamp)
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package awsecscontainermetrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+)
+
+func TestSidecarEndpoints(t *testing.T) {
+	metadata := ecsutil.TaskMetadata{
+		Containers: []ecsutil.ContainerMetadata{
+			{
+				ContainerName: "app",
+				Networks:      []ecsutil.Network{{IPv4Addresses: []string{"10.0.0.1"}}},
+			},
+			{
+				ContainerName: "exporter",
+				Labels:        map[string]string{"PORT": "9100"},
+				Networks:      []ecsutil.Network{{IPv4Addresses: []string{"10.0.0.1"}}},
+			},
+			{
+				ContainerName: "envoy",
+				Labels:        map[string]string{"PORT": "9901", "PATH": "stats/prometheus"},
+				Networks:      []ecsutil.Network{{}, {IPv4Addresses: []string{"10.0.0.2"}}},
+			},
+			{
+				ContainerName: "invalid-port",
+				Labels:        map[string]string{"PORT": "http"},
+				Networks:      []ecsutil.Network{{IPv4Addresses: []string{"10.0.0.1"}}},
+			},
+			{
+				ContainerName: "no-address",
+				Labels:        map[string]string{"PORT": "9100"},
+			},
+		},
+	}
+
+	endpoints := SidecarEndpoints(metadata, "PORT", "PATH", zap.NewNop())
+	require.Len(t, endpoints, 2)
+	assert.Equal(t, "exporter", endpoints[0].Container.ContainerName)
+	assert.Equal(t, "http://10.0.0.1:9100/metrics", endpoints[0].URL)
+	assert.Equal(t, "envoy", endpoints[1].Container.ContainerName)
+	assert.Equal(t, "http://10.0.0.2:9901/stats/prometheus", endpoints[1].URL)
+}
+
+func TestSidecarMetricsData(t *testing.T) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(`# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{le="0.1"} 5
+request_duration_seconds_bucket{le="0.5"} 8
+request_duration_seconds_bucket{le="+Inf"} 10
+request_duration_seconds_sum 3.5
+request_duration_seconds_count 10
+# TYPE gc_duration_seconds summary
+gc_duration_seconds{quantile="0.5"} 0.01
+gc_duration_seconds{quantile="0.99"} 0.2
+gc_duration_seconds_sum 1.5
+gc_duration_seconds_count 42
+build_info{version="1.2.3"} 1 1700000000000
+`))
+	require.NoError(t, err)
+
+	endpoint := SidecarEndpoint{Container: ecsutil.ContainerMetadata{ContainerName: "exporter", DockerID: "abc"}}
+	metadata := ecsutil.TaskMetadata{Cluster: "cluster", TaskARN: "arn:aws:ecs:us-west-2:123456789012:task/cluster/1234"}
+	md := SidecarMetricsData(endpoint, metadata, families, zap.NewNop())
+
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	attrs := md.ResourceMetrics().At(0).Resource().Attributes()
+	containerName, _ := attrs.Get("container.name")
+	assert.Equal(t, "exporter", containerName.Str())
+	taskID, _ := attrs.Get(attributeECSTaskID)
+	assert.Equal(t, "1234", taskID.Str())
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, metrics.Len())
+
+	buildInfo := metrics.At(0)
+	assert.Equal(t, "build_info", buildInfo.Name())
+	require.Equal(t, pmetric.MetricTypeGauge, buildInfo.Type())
+	dp := buildInfo.Gauge().DataPoints().At(0)
+	assert.Equal(t, 1.0, dp.DoubleValue())
+	assert.Equal(t, int64(1700000000000), dp.Timestamp().AsTime().UnixMilli())
+	version, _ := dp.Attributes().Get("version")
+	assert.Equal(t, "1.2.3", version.Str())
+
+	gc := metrics.At(1)
+	require.Equal(t, pmetric.MetricTypeSummary, gc.Type())
+	summary := gc.Summary().DataPoints().At(0)
+	assert.Equal(t, uint64(42), summary.Count())
+	assert.Equal(t, 1.5, summary.Sum())
+	require.Equal(t, 2, summary.QuantileValues().Len())
+	assert.Equal(t, 0.99, summary.QuantileValues().At(1).Quantile())
+	assert.Equal(t, 0.2, summary.QuantileValues().At(1).Value())
+
+	duration := metrics.At(2)
+	require.Equal(t, pmetric.MetricTypeHistogram, duration.Type())
+	histogram := duration.Histogram().DataPoints().At(0)
+	assert.Equal(t, uint64(10), histogram.Count())
+	assert.Equal(t, 3.5, histogram.Sum())
+	assert.Equal(t, []float64{0.1, 0.5}, histogram.ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{5, 3, 2}, histogram.BucketCounts().AsRaw())
+}
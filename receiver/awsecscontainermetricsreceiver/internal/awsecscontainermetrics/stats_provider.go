@@ -4,23 +4,26 @@
 package awsecscontainermetrics // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil/endpoints"
 )
 
 // StatsProvider wraps a RestClient, returning an unmarshaled metadata and docker stats
 type StatsProvider struct {
 	rc               ecsutil.RestClient
 	metadataProvider ecsutil.MetadataProvider
+	taskTags         bool
 }
 
-// NewStatsProvider returns a new stats provider
-func NewStatsProvider(rc ecsutil.RestClient, logger *zap.Logger) *StatsProvider {
-	return &StatsProvider{rc: rc, metadataProvider: ecsutil.NewTaskMetadataProvider(rc, logger)}
+// NewStatsProvider returns a new stats provider, the task metadata holds the tags of the task if taskTags is true
+func NewStatsProvider(rc ecsutil.RestClient, logger *zap.Logger, taskTags bool) *StatsProvider {
+	return &StatsProvider{rc: rc, metadataProvider: ecsutil.NewTaskMetadataProvider(rc, logger), taskTags: taskTags}
 }
 
 // GetStats calls the ecs task metadata endpoint and unmarshals the data
@@ -28,7 +31,7 @@ func (p *StatsProvider) GetStats() (map[string]*ContainerStats, ecsutil.TaskMeta
 	stats := make(map[string]*ContainerStats)
 	var metadata ecsutil.TaskMetadata
 
-	taskMetadata, err := p.metadataProvider.FetchTaskMetadata()
+	taskMetadata, err := p.fetchTaskMetadata()
 	if err != nil {
 		return stats, metadata, fmt.Errorf("cannot read data from task metadata endpoint: %w", err)
 	}
@@ -49,3 +52,20 @@ func (p *StatsProvider) GetStats() (map[string]*ContainerStats, ecsutil.TaskMeta
 
 	return stats, metadata, nil
 }
+
+// fetchTaskMetadata reads the task metadata, with the tags of the task if they are enabled. The tags are
+// missing if the ECS container agent isn't allowed to call ecs:ListTagsForResource.
+func (p *StatsProvider) fetchTaskMetadata() (*ecsutil.TaskMetadata, error) {
+	if !p.taskTags {
+		return p.metadataProvider.FetchTaskMetadata()
+	}
+	resp, err := p.rc.GetResponse(endpoints.TaskMetadataWithTagsPath)
+	if err != nil {
+		return nil, err
+	}
+	taskMetadata := &ecsutil.TaskMetadata{}
+	if err = json.NewDecoder(bytes.NewReader(resp)).Decode(taskMetadata); err != nil {
+		return nil, fmt.Errorf("encountered unexpected error reading response from ECS Task Metadata Endpoint: %w", err)
+	}
+	return taskMetadata, nil
+}
@@ -65,7 +65,7 @@ func TestGetStats(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			provider := NewStatsProvider(tt.client, zap.NewNop())
+			provider := NewStatsProvider(tt.client, zap.NewNop(), false)
 			stats, metadata, err := provider.GetStats()
 			if tt.wantError == "" {
 				require.NoError(t, err)
@@ -77,3 +77,20 @@ func TestGetStats(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStatsWithTaskTags(t *testing.T) {
+	provider := NewStatsProvider(&testRestClient{}, zap.NewNop(), true)
+	_, metadata, err := provider.GetStats()
+	require.NoError(t, err)
+	assert.Equal(t, "test200", metadata.Cluster)
+	assert.Equal(t, map[string]string{
+		"team":                "checkout",
+		"environment":         "production",
+		"aws:ecs:serviceName": "checkout-service",
+	}, metadata.TaskTags)
+
+	provider = NewStatsProvider(&testRestClient{}, zap.NewNop(), false)
+	_, metadata, err = provider.GetStats()
+	require.NoError(t, err)
+	assert.Empty(t, metadata.TaskTags)
+}
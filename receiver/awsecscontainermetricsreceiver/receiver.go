@@ -5,10 +5,16 @@ package awsecscontainermetricsreceiver // import "github.com/open-telemetry/open
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"regexp"
 	"time"
 
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
@@ -26,6 +32,8 @@ type awsEcsContainerMetricsReceiver struct {
 	cancel       context.CancelFunc
 	restClient   ecsutil.RestClient
 	provider     *awsecscontainermetrics.StatsProvider
+	taskTags     []*regexp.Regexp
+	httpClient   *http.Client
 }
 
 // New creates the aws ecs container metrics receiver with the given parameters.
@@ -39,6 +47,14 @@ func newAWSECSContainermetrics(
 		nextConsumer: nextConsumer,
 		config:       config,
 		restClient:   rest,
+		httpClient:   &http.Client{},
+	}
+	for _, include := range config.TaskTags.Include {
+		re, err := regexp.Compile(include)
+		if err != nil {
+			return nil, fmt.Errorf("invalid task tags include expression %q: %w", include, err)
+		}
+		r.taskTags = append(r.taskTags, re)
 	}
 	return r, nil
 }
@@ -72,7 +88,7 @@ func (aecmr *awsEcsContainerMetricsReceiver) Shutdown(context.Context) error {
 
 // collectDataFromEndpoint collects container stats from Amazon ECS Task Metadata Endpoint
 func (aecmr *awsEcsContainerMetricsReceiver) collectDataFromEndpoint(ctx context.Context) error {
-	aecmr.provider = awsecscontainermetrics.NewStatsProvider(aecmr.restClient, aecmr.logger)
+	aecmr.provider = awsecscontainermetrics.NewStatsProvider(aecmr.restClient, aecmr.logger, aecmr.config.TaskTags.Enabled)
 	stats, metadata, err := aecmr.provider.GetStats()
 
 	if err != nil {
@@ -82,7 +98,11 @@ func (aecmr *awsEcsContainerMetricsReceiver) collectDataFromEndpoint(ctx context
 
 	// TODO: report self metrics using obsreport
 	mds := awsecscontainermetrics.MetricsData(stats, metadata, aecmr.logger)
+	if aecmr.config.Sidecars.Enabled {
+		mds = append(mds, aecmr.scrapeSidecars(ctx, metadata)...)
+	}
 	for _, md := range mds {
+		aecmr.addTaskTags(md, metadata.TaskTags)
 		err = aecmr.nextConsumer.ConsumeMetrics(ctx, md)
 		if err != nil {
 			return err
@@ -91,3 +111,68 @@ func (aecmr *awsEcsContainerMetricsReceiver) collectDataFromEndpoint(ctx context
 
 	return nil
 }
+
+// addTaskTags adds the tags of the task matching the include expressions to the resources of the metrics.
+func (aecmr *awsEcsContainerMetricsReceiver) addTaskTags(md pmetric.Metrics, tags map[string]string) {
+	for key, value := range tags {
+		if !aecmr.includeTaskTag(key) {
+			continue
+		}
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			md.ResourceMetrics().At(i).Resource().Attributes().PutStr(aecmr.config.TaskTags.Prefix+key, value)
+		}
+	}
+}
+
+func (aecmr *awsEcsContainerMetricsReceiver) includeTaskTag(key string) bool {
+	if len(aecmr.taskTags) == 0 {
+		return true
+	}
+	for _, re := range aecmr.taskTags {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrapeSidecars scrapes the Prometheus endpoints declared by the containers of the task. The metrics of
+// the endpoints that can't be scraped are skipped.
+func (aecmr *awsEcsContainerMetricsReceiver) scrapeSidecars(ctx context.Context, metadata ecsutil.TaskMetadata) []pmetric.Metrics {
+	var mds []pmetric.Metrics
+	cfg := aecmr.config.Sidecars
+	for _, endpoint := range awsecscontainermetrics.SidecarEndpoints(metadata, cfg.PortLabel, cfg.PathLabel, aecmr.logger) {
+		families, err := aecmr.scrapeSidecar(ctx, endpoint.URL)
+		if err != nil {
+			aecmr.logger.Warn("Failed to scrape sidecar",
+				zap.String("container", endpoint.Container.ContainerName),
+				zap.String("endpoint", endpoint.URL),
+				zap.Error(err))
+			continue
+		}
+		mds = append(mds, awsecscontainermetrics.SidecarMetricsData(endpoint, metadata, families, aecmr.logger))
+	}
+	return mds
+}
+
+func (aecmr *awsEcsContainerMetricsReceiver) scrapeSidecar(ctx context.Context, endpoint string) (map[string]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(ctx, aecmr.config.Sidecars.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+	resp, err := aecmr.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(resp.Body)
+}
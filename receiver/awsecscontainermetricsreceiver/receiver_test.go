@@ -5,17 +5,25 @@ package awsecscontainermetricsreceiver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil/ecsutiltest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/aws/ecsutil/endpoints"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/awsecscontainermetricsreceiver/internal/awsecscontainermetrics"
 )
 
@@ -117,3 +125,123 @@ func TestCollectDataFromEndpointWithEndpointError(t *testing.T) {
 	err = r.collectDataFromEndpoint(ctx)
 	require.Error(t, err)
 }
+
+func TestCollectDataFromEndpointWithTaskTags(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TaskTags.Enabled = true
+	cfg.TaskTags.Include = []string{"^team$", "^aws:ecs:"}
+	sink := new(consumertest.MetricsSink)
+	metricsReceiver, err := newAWSECSContainermetrics(zap.NewNop(), cfg, sink, &fakeRestClient{})
+	require.NoError(t, err)
+
+	r := metricsReceiver.(*awsEcsContainerMetricsReceiver)
+	require.NoError(t, r.collectDataFromEndpoint(context.Background()))
+
+	require.NotEmpty(t, sink.AllMetrics())
+	for _, md := range sink.AllMetrics() {
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			attrs := md.ResourceMetrics().At(i).Resource().Attributes()
+			team, ok := attrs.Get("aws.ecs.task.tag.team")
+			require.True(t, ok)
+			assert.Equal(t, "checkout", team.Str())
+			service, ok := attrs.Get("aws.ecs.task.tag.aws:ecs:serviceName")
+			require.True(t, ok)
+			assert.Equal(t, "checkout-service", service.Str())
+			_, ok = attrs.Get("aws.ecs.task.tag.environment")
+			assert.False(t, ok)
+		}
+	}
+}
+
+// sidecarRestClient returns the task metadata with a container declaring a Prometheus endpoint.
+type sidecarRestClient struct {
+	*testing.T
+	endpoint *url.URL
+}
+
+func (f sidecarRestClient) GetResponse(path string) ([]byte, error) {
+	if path != endpoints.TaskMetadataPath {
+		return fakeRestClient{f.T}.GetResponse(path)
+	}
+	var metadata ecsutil.TaskMetadata
+	require.NoError(f.T, json.Unmarshal(ecsutiltest.TaskMetadataTestResponse, &metadata))
+	metadata.Containers[0].Labels[defaultSidecarPortLabel] = f.endpoint.Port()
+	metadata.Containers[0].Labels[defaultSidecarPathLabel] = "/custom/metrics"
+	metadata.Containers[0].Networks = []ecsutil.Network{{NetworkMode: "awsvpc", IPv4Addresses: []string{f.endpoint.Hostname()}}}
+	metadata.Containers[1].Labels[defaultSidecarPortLabel] = "not-a-port"
+	return json.Marshal(metadata)
+}
+
+func TestCollectDataFromEndpointWithSidecars(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/custom/metrics" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`# HELP http_requests_total The total number of requests.
+# TYPE http_requests_total counter
+http_requests_total{code="200"} 1027
+http_requests_total{code="500"} 3
+# TYPE queue_depth gauge
+queue_depth 12
+`))
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Sidecars.Enabled = true
+	sink := new(consumertest.MetricsSink)
+	metricsReceiver, err := newAWSECSContainermetrics(zap.NewNop(), cfg, sink, &sidecarRestClient{T: t, endpoint: endpoint})
+	require.NoError(t, err)
+
+	r := metricsReceiver.(*awsEcsContainerMetricsReceiver)
+	require.NoError(t, r.collectDataFromEndpoint(context.Background()))
+
+	var sidecar pmetric.ResourceMetrics
+	for _, md := range sink.AllMetrics() {
+		rm := md.ResourceMetrics().At(0)
+		if metrics := rm.ScopeMetrics().At(0).Metrics(); metrics.Len() > 0 && metrics.At(0).Name() == "http_requests_total" {
+			sidecar = rm
+		}
+	}
+	require.NotEqual(t, pmetric.ResourceMetrics{}, sidecar, "no metrics scraped from the sidecar")
+
+	name, ok := sidecar.Resource().Attributes().Get("container.name")
+	require.True(t, ok)
+	assert.Equal(t, "nginx100", name.Str())
+	cluster, ok := sidecar.Resource().Attributes().Get("aws.ecs.cluster.name")
+	require.True(t, ok)
+	assert.Equal(t, "test200", cluster.Str())
+
+	metrics := sidecar.ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	assert.Equal(t, pmetric.MetricTypeSum, metrics.At(0).Type())
+	assert.Equal(t, 2, metrics.At(0).Sum().DataPoints().Len())
+	assert.Equal(t, "queue_depth", metrics.At(1).Name())
+	assert.Equal(t, 12.0, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestScrapeSidecarError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Sidecars.Enabled = true
+	metricsReceiver, err := newAWSECSContainermetrics(zap.NewNop(), cfg, consumertest.NewNop(), &fakeRestClient{})
+	require.NoError(t, err)
+
+	r := metricsReceiver.(*awsEcsContainerMetricsReceiver)
+	_, err = r.scrapeSidecar(context.Background(), server.URL)
+	assert.EqualError(t, err, "unexpected status: 500 Internal Server Error")
+}
+
+func TestNewReceiverInvalidTaskTagsInclude(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.TaskTags.Include = []string{"("}
+	_, err := newAWSECSContainermetrics(zap.NewNop(), cfg, consumertest.NewNop(), &fakeRestClient{})
+	assert.ErrorContains(t, err, "invalid task tags include expression")
+}
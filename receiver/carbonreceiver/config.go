@@ -5,6 +5,8 @@ package carbonreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -13,6 +15,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
 )
 
+// Protocols of the received data.
+const (
+	protocolPlaintext = "plaintext"
+	protocolPickle    = "pickle"
+)
+
 var _ component.ConfigValidator = (*Config)(nil)
 
 // Config defines configuration for the Carbon receiver.
@@ -23,6 +31,11 @@ type Config struct {
 	// if transport being used is UDP.
 	TCPIdleTimeout time.Duration `mapstructure:"tcp_idle_timeout"`
 
+	// Protocol is the Graphite protocol of the received data, either
+	// "plaintext" (the default) or "pickle". The pickle protocol requires the
+	// "tcp" transport.
+	Protocol string `mapstructure:"protocol"`
+
 	// Parser specifies a parser and the respective configuration to be used
 	// by the receiver.
 	Parser *protocol.Config `mapstructure:"parser"`
@@ -32,5 +45,14 @@ func (cfg *Config) Validate() error {
 	if cfg.TCPIdleTimeout < 0 {
 		return errors.New("'tcp_idle_timeout' must be non-negative")
 	}
+	switch cfg.Protocol {
+	case "", protocolPlaintext:
+	case protocolPickle:
+		if transport := strings.ToLower(string(cfg.Transport)); transport != "" && transport != "tcp" {
+			return fmt.Errorf("the %q protocol requires the \"tcp\" transport", protocolPickle)
+		}
+	default:
+		return fmt.Errorf("unsupported protocol %q, must be %q or %q", cfg.Protocol, protocolPlaintext, protocolPickle)
+	}
 	return nil
 }
@@ -76,6 +76,27 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pickle"),
+			expected: &Config{
+				AddrConfig: confignet.AddrConfig{
+					Endpoint:  "localhost:2004",
+					Transport: confignet.TransportTypeTCP,
+				},
+				TCPIdleTimeout: 30 * time.Second,
+				Protocol:       "pickle",
+				Parser: &protocol.Config{
+					Type: "template",
+					Config: &protocol.TemplateParserConfig{
+						Templates: []string{
+							"servers.* .host.name.name* source=servers",
+							"region.host.name",
+						},
+						Separator: "_",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -106,4 +127,12 @@ func TestConfigValidate(t *testing.T) {
 		},
 	}
 	assert.Error(t, cfg.Validate())
+
+	cfg = createDefaultConfig().(*Config)
+	cfg.Protocol = "pickle"
+	assert.NoError(t, cfg.Validate())
+	cfg.Transport = confignet.TransportTypeUDP
+	assert.EqualError(t, cfg.Validate(), `the "pickle" protocol requires the "tcp" transport`)
+	cfg.Protocol = "json"
+	assert.EqualError(t, cfg.Validate(), `unsupported protocol "json", must be "plaintext" or "pickle"`)
 }
@@ -0,0 +1,500 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/internal/transport"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// maxPickleSize is the maximum size of a message of the pickle protocol, the
+// same limit as the one of Carbon.
+const maxPickleSize = 1 << 20
+
+// Opcodes of the pickle format used to serialize the metrics, see
+// https://github.com/python/cpython/blob/main/Lib/pickletools.py. The opcodes
+// that build arbitrary objects aren't supported.
+const (
+	opMark            = '('
+	opStop            = '.'
+	opPop             = '0'
+	opPopMark         = '1'
+	opDup             = '2'
+	opFloat           = 'F'
+	opInt             = 'I'
+	opBinInt          = 'J'
+	opBinInt1         = 'K'
+	opLong            = 'L'
+	opBinInt2         = 'M'
+	opNone            = 'N'
+	opString          = 'S'
+	opBinString       = 'T'
+	opShortBinString  = 'U'
+	opUnicode         = 'V'
+	opBinUnicode      = 'X'
+	opAppend          = 'a'
+	opAppends         = 'e'
+	opGet             = 'g'
+	opBinGet          = 'h'
+	opLongBinGet      = 'j'
+	opList            = 'l'
+	opEmptyList       = ']'
+	opPut             = 'p'
+	opBinPut          = 'q'
+	opLongBinPut      = 'r'
+	opTuple           = 't'
+	opEmptyTuple      = ')'
+	opBinFloat        = 'G'
+	opBinBytes        = 'B'
+	opShortBinBytes   = 'C'
+	opProto           = 0x80
+	opTuple1          = 0x85
+	opTuple2          = 0x86
+	opTuple3          = 0x87
+	opNewTrue         = 0x88
+	opNewFalse        = 0x89
+	opLong1           = 0x8a
+	opShortBinUnicode = 0x8c
+	opMemoize         = 0x94
+	opFrame           = 0x95
+)
+
+var errPickleTruncated = errors.New("truncated pickle")
+
+// mark is pushed on the stack by the MARK opcode.
+type mark struct{}
+
+// list is a mutable list, the tuples are decoded as []any.
+type list struct {
+	items []any
+}
+
+type unpickler struct {
+	r     *bytes.Reader
+	stack []any
+	memo  map[int]any
+}
+
+// pickleLines decodes a message of the Carbon pickle protocol, a list of
+// (path, (timestamp, value)) tuples, into lines of the plaintext protocol.
+func pickleLines(data []byte) ([]string, error) {
+	v, err := unpickle(data)
+	if err != nil {
+		return nil, err
+	}
+	metrics, ok := sequence(v)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pickled value of type %T, expected a list", v)
+	}
+
+	lines := make([]string, 0, len(metrics))
+	for _, item := range metrics {
+		metric, ok := sequence(item)
+		if !ok || len(metric) != 2 {
+			return nil, fmt.Errorf("unexpected pickled metric %v, expected a (path, (timestamp, value)) tuple", item)
+		}
+		path, ok := metric[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected pickled metric path %v", metric[0])
+		}
+		datapoint, ok := sequence(metric[1])
+		if !ok || len(datapoint) != 2 {
+			return nil, fmt.Errorf("unexpected pickled datapoint %v of metric %q", metric[1], path)
+		}
+		timestamp, err := formatNumber(datapoint[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp of metric %q: %w", path, err)
+		}
+		value, err := formatNumber(datapoint[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of metric %q: %w", path, err)
+		}
+		lines = append(lines, path+" "+value+" "+timestamp)
+	}
+	return lines, nil
+}
+
+// sequence returns the items of a list or tuple.
+func sequence(v any) ([]any, bool) {
+	switch s := v.(type) {
+	case *list:
+		return s.items, true
+	case []any:
+		return s, true
+	}
+	return nil, false
+}
+
+func formatNumber(v any) (string, error) {
+	switch n := v.(type) {
+	case int64:
+		return strconv.FormatInt(n, 10), nil
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case string:
+		// Some clients send the numbers as strings.
+		return n, nil
+	}
+	return "", fmt.Errorf("unexpected pickled number %v", v)
+}
+
+func unpickle(data []byte) (any, error) {
+	u := &unpickler{r: bytes.NewReader(data), memo: map[int]any{}}
+	for {
+		op, err := u.r.ReadByte()
+		if err != nil {
+			return nil, errPickleTruncated
+		}
+		if op == opStop {
+			if len(u.stack) != 1 {
+				return nil, errors.New("invalid pickle: unexpected stack at stop")
+			}
+			return u.stack[0], nil
+		}
+		if err := u.exec(op); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (u *unpickler) exec(op byte) error {
+	switch op {
+	case opProto:
+		_, err := u.read(1)
+		return err
+	case opFrame:
+		_, err := u.read(8)
+		return err
+	case opMark:
+		u.push(mark{})
+	case opPop:
+		_, err := u.pop()
+		return err
+	case opPopMark:
+		_, err := u.popMark()
+		return err
+	case opDup:
+		v, err := u.top()
+		if err != nil {
+			return err
+		}
+		u.push(v)
+	case opNone:
+		u.push(nil)
+	case opNewTrue:
+		u.push(true)
+	case opNewFalse:
+		u.push(false)
+	case opInt:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		// Protocol 0 encodes the booleans as "I01" and "I00".
+		switch line {
+		case "01":
+			u.push(true)
+			return nil
+		case "00":
+			u.push(false)
+			return nil
+		}
+		n, err := strconv.ParseInt(line, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pickled int: %w", err)
+		}
+		u.push(n)
+	case opLong:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(strings.TrimSuffix(line, "L"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pickled long: %w", err)
+		}
+		u.push(n)
+	case opBinInt:
+		b, err := u.read(4)
+		if err != nil {
+			return err
+		}
+		u.push(int64(int32(binary.LittleEndian.Uint32(b))))
+	case opBinInt1:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		u.push(int64(b[0]))
+	case opBinInt2:
+		b, err := u.read(2)
+		if err != nil {
+			return err
+		}
+		u.push(int64(binary.LittleEndian.Uint16(b)))
+	case opLong1:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		b, err = u.read(int(b[0]))
+		if err != nil {
+			return err
+		}
+		n, err := decodeLong(b)
+		if err != nil {
+			return err
+		}
+		u.push(n)
+	case opFloat:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		f, err := strconv.ParseFloat(line, 64)
+		if err != nil {
+			return fmt.Errorf("invalid pickled float: %w", err)
+		}
+		u.push(f)
+	case opBinFloat:
+		b, err := u.read(8)
+		if err != nil {
+			return err
+		}
+		u.push(math.Float64frombits(binary.BigEndian.Uint64(b)))
+	case opString:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		s, err := strconv.Unquote(line)
+		if err != nil {
+			// Python quotes the strings with single quotes.
+			if len(line) < 2 || line[0] != '\'' || line[len(line)-1] != '\'' {
+				return fmt.Errorf("invalid pickled string %q", line)
+			}
+			s = line[1 : len(line)-1]
+		}
+		u.push(s)
+	case opUnicode:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		u.push(line)
+	case opBinString, opBinUnicode, opBinBytes:
+		b, err := u.read(4)
+		if err != nil {
+			return err
+		}
+		if b, err = u.read(int(binary.LittleEndian.Uint32(b))); err != nil {
+			return err
+		}
+		u.push(string(b))
+	case opShortBinString, opShortBinUnicode, opShortBinBytes:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		if b, err = u.read(int(b[0])); err != nil {
+			return err
+		}
+		u.push(string(b))
+	case opEmptyList:
+		u.push(&list{})
+	case opEmptyTuple:
+		u.push([]any{})
+	case opList:
+		items, err := u.popMark()
+		if err != nil {
+			return err
+		}
+		u.push(&list{items: items})
+	case opTuple:
+		items, err := u.popMark()
+		if err != nil {
+			return err
+		}
+		u.push(items)
+	case opTuple1, opTuple2, opTuple3:
+		n := int(op-opTuple1) + 1
+		if len(u.stack) < n {
+			return errors.New("invalid pickle: stack underflow")
+		}
+		items := append([]any{}, u.stack[len(u.stack)-n:]...)
+		u.stack = u.stack[:len(u.stack)-n]
+		u.push(items)
+	case opAppend:
+		v, err := u.pop()
+		if err != nil {
+			return err
+		}
+		return u.appendToList(v)
+	case opAppends:
+		items, err := u.popMark()
+		if err != nil {
+			return err
+		}
+		return u.appendToList(items...)
+	case opPut:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		idx, err := strconv.Atoi(line)
+		if err != nil {
+			return fmt.Errorf("invalid pickled memo index: %w", err)
+		}
+		return u.put(idx)
+	case opBinPut:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		return u.put(int(b[0]))
+	case opLongBinPut:
+		b, err := u.read(4)
+		if err != nil {
+			return err
+		}
+		return u.put(int(binary.LittleEndian.Uint32(b)))
+	case opMemoize:
+		return u.put(len(u.memo))
+	case opGet:
+		line, err := u.readLine()
+		if err != nil {
+			return err
+		}
+		idx, err := strconv.Atoi(line)
+		if err != nil {
+			return fmt.Errorf("invalid pickled memo index: %w", err)
+		}
+		return u.get(idx)
+	case opBinGet:
+		b, err := u.read(1)
+		if err != nil {
+			return err
+		}
+		return u.get(int(b[0]))
+	case opLongBinGet:
+		b, err := u.read(4)
+		if err != nil {
+			return err
+		}
+		return u.get(int(binary.LittleEndian.Uint32(b)))
+	default:
+		return fmt.Errorf("unsupported pickle opcode 0x%02x", op)
+	}
+	return nil
+}
+
+func (u *unpickler) read(n int) ([]byte, error) {
+	if n < 0 || n > u.r.Len() {
+		return nil, errPickleTruncated
+	}
+	b := make([]byte, n)
+	_, _ = u.r.Read(b)
+	return b, nil
+}
+
+func (u *unpickler) readLine() (string, error) {
+	var sb strings.Builder
+	for {
+		c, err := u.r.ReadByte()
+		if err != nil {
+			return "", errPickleTruncated
+		}
+		if c == '\n' {
+			return sb.String(), nil
+		}
+		sb.WriteByte(c)
+	}
+}
+
+func (u *unpickler) push(v any) {
+	u.stack = append(u.stack, v)
+}
+
+func (u *unpickler) top() (any, error) {
+	if len(u.stack) == 0 {
+		return nil, errors.New("invalid pickle: stack underflow")
+	}
+	return u.stack[len(u.stack)-1], nil
+}
+
+func (u *unpickler) pop() (any, error) {
+	v, err := u.top()
+	if err != nil {
+		return nil, err
+	}
+	u.stack = u.stack[:len(u.stack)-1]
+	return v, nil
+}
+
+// popMark pops the items pushed since the last mark, and the mark.
+func (u *unpickler) popMark() ([]any, error) {
+	for i := len(u.stack) - 1; i >= 0; i-- {
+		if _, ok := u.stack[i].(mark); ok {
+			items := append([]any{}, u.stack[i+1:]...)
+			u.stack = u.stack[:i]
+			return items, nil
+		}
+	}
+	return nil, errors.New("invalid pickle: mark not found")
+}
+
+func (u *unpickler) appendToList(items ...any) error {
+	if len(u.stack) == 0 {
+		return errors.New("invalid pickle: stack underflow")
+	}
+	l, ok := u.stack[len(u.stack)-1].(*list)
+	if !ok {
+		return errors.New("invalid pickle: append to a value that isn't a list")
+	}
+	l.items = append(l.items, items...)
+	return nil
+}
+
+func (u *unpickler) put(idx int) error {
+	v, err := u.top()
+	if err != nil {
+		return err
+	}
+	u.memo[idx] = v
+	return nil
+}
+
+func (u *unpickler) get(idx int) error {
+	v, ok := u.memo[idx]
+	if !ok {
+		return fmt.Errorf("invalid pickle: memo index %d not found", idx)
+	}
+	u.push(v)
+	return nil
+}
+
+// decodeLong decodes a little-endian two's complement integer.
+func decodeLong(b []byte) (int64, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	be := make([]byte, len(b))
+	for i, c := range b {
+		be[len(b)-1-i] = c
+	}
+	n := new(big.Int).SetBytes(be)
+	if b[len(b)-1]&0x80 != 0 {
+		n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("pickled long %v overflows int64", n)
+	}
+	return n.Int64(), nil
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickleLines(t *testing.T) {
+	// The pickles are generated with pickle.dumps of the Python standard library.
+	want := []string{
+		"a.b.c 1.5 1582230020",
+		"a.b.d;k=v 42 1582230020.25",
+		"big 1099511627776 1582230020",
+	}
+	tests := []struct {
+		name    string
+		data    string
+		want    []string
+		wantErr string
+	}{
+		{name: "protocol 0", data: "(lp0\n(Va.b.c\np1\n(I1582230020\nF1.5\ntp2\ntp3\na(Va.b.d;k=v\np4\n(F1582230020.25\nI42\ntp5\ntp6\na(Vbig\np7\n(I1582230020\nL1099511627776L\ntp8\ntp9\na.", want: want},
+		{name: "protocol 2", data: "\x80\x02]q\x00(X\x05\x00\x00\x00a.b.cq\x01J\x04\xeaN^G?\xf8\x00\x00\x00\x00\x00\x00\x86q\x02\x86q\x03X\t\x00\x00\x00a.b.d;k=vq\x04GA\xd7\x93\xba\x81\x10\x00\x00K*\x86q\x05\x86q\x06X\x03\x00\x00\x00bigq\x07J\x04\xeaN^\x8a\x06\x00\x00\x00\x00\x00\x01\x86q\x08\x86q\te.", want: want},
+		{name: "protocol 4", data: "\x80\x04\x95Q\x00\x00\x00\x00\x00\x00\x00]\x94(\x8c\x05a.b.c\x94J\x04\xeaN^G?\xf8\x00\x00\x00\x00\x00\x00\x86\x94\x86\x94\x8c\ta.b.d;k=v\x94GA\xd7\x93\xba\x81\x10\x00\x00K*\x86\x94\x86\x94\x8c\x03big\x94J\x04\xeaN^\x8a\x06\x00\x00\x00\x00\x00\x01\x86\x94\x86\x94e.", want: want},
+		{name: "memo", data: "(lp0\n(Va\np1\n(I1\nI2\ntp2\ntp3\nag3\na.", want: []string{"a 2 1", "a 2 1"}},
+		{name: "truncated", data: "\x80\x02]q\x00(X\x05\x00\x00", wantErr: "truncated pickle"},
+		{name: "not a list", data: "\x80\x02K\x01.", wantErr: "unexpected pickled value of type int64, expected a list"},
+		{name: "invalid datapoint", data: "\x80\x02]q\x00X\x01\x00\x00\x00aK\x01\x86a.", wantErr: "unexpected pickled datapoint 1 of metric \"a\""},
+		{name: "global", data: "\x80\x02cos\nsystem\n.", wantErr: "unsupported pickle opcode 0x63"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines, err := pickleLines([]byte(tt.data))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, lines)
+		})
+	}
+}
@@ -5,6 +5,8 @@ package transport
 
 import (
 	"context"
+	"encoding/binary"
+	"net"
 	"runtime"
 	"sync"
 	"testing"
@@ -99,6 +101,53 @@ func Test_Server_ListenAndServe(t *testing.T) {
 	}
 }
 
+func Test_TCPPickleServer_ListenAndServe(t *testing.T) {
+	addr := testutil.GetAvailableLocalNetworkAddress(t, "tcp")
+
+	svr, err := NewTCPPickleServer(addr, 1*time.Second)
+	require.NoError(t, err)
+
+	mc := new(consumertest.MetricsSink)
+	p, err := (&protocol.PlaintextConfig{}).BuildParser()
+	require.NoError(t, err)
+	mr := &mockReporter{}
+	mr.wgMetricsProcessed.Add(1)
+
+	wgListenAndServe := sync.WaitGroup{}
+	wgListenAndServe.Add(1)
+	go func() {
+		defer wgListenAndServe.Done()
+		assert.Error(t, svr.ListenAndServe(p, mc, mr))
+	}()
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	// pickle.dumps([("test.metric", (1582230020, 1)), ("test.other;k=v", (1582230020, 2.5))], protocol=2)
+	payload := []byte("\x80\x02]q\x00(X\x0b\x00\x00\x00test.metricq\x01J\x04\xeaN^K\x01\x86q\x02\x86q\x03" +
+		"X\x0e\x00\x00\x00test.other;k=vq\x04J\x04\xeaN^G@\x04\x00\x00\x00\x00\x00\x00\x86q\x05\x86q\x06e.")
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(payload)))
+	_, err = conn.Write(append(header, payload...))
+	require.NoError(t, err)
+
+	mr.wgMetricsProcessed.Wait()
+	require.NoError(t, conn.Close())
+	require.NoError(t, svr.Close())
+	wgListenAndServe.Wait()
+
+	mdd := mc.AllMetrics()
+	require.Len(t, mdd, 1)
+	metrics := mdd[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	assert.Equal(t, "test.metric", metrics.At(0).Name())
+	assert.Equal(t, int64(1), metrics.At(0).Gauge().DataPoints().At(0).IntValue())
+	assert.Equal(t, "test.other", metrics.At(1).Name())
+	assert.Equal(t, 2.5, metrics.At(1).Gauge().DataPoints().At(0).DoubleValue())
+	v, ok := metrics.At(1).Gauge().DataPoints().At(0).Attributes().Get("k")
+	require.True(t, ok)
+	assert.Equal(t, "v", v.Str())
+}
+
 // mockReporter provides a Reporter that provides some useful functionalities for
 // tests (eg.: wait for certain number of messages).
 type mockReporter struct {
@@ -6,7 +6,9 @@ package transport // import "github.com/open-telemetry/opentelemetry-collector-c
 import (
 	"bufio"
 	"context"
+	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"strings"
@@ -24,6 +26,8 @@ type tcpServer struct {
 	wg          sync.WaitGroup
 	idleTimeout time.Duration
 	reporter    Reporter
+	// pickle selects the pickle protocol instead of the plaintext one.
+	pickle bool
 }
 
 var _ Server = (*tcpServer)(nil)
@@ -45,6 +49,26 @@ func NewTCPServer(
 	return &t, nil
 }
 
+// NewTCPPickleServer creates a transport.Server using TCP as its transport,
+// receiving the metrics with the Carbon pickle protocol, see
+// https://graphite.readthedocs.io/en/latest/feeding-carbon.html#the-pickle-protocol.
+func NewTCPPickleServer(
+	addr string,
+	idleTimeout time.Duration,
+) (Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	t := tcpServer{
+		ln:          ln,
+		idleTimeout: idleTimeout,
+		pickle:      true,
+	}
+	return &t, nil
+}
+
 func (t *tcpServer) ListenAndServe(
 	parser protocol.Parser,
 	nextConsumer consumer.Metrics,
@@ -67,7 +91,11 @@ func (t *tcpServer) ListenAndServe(
 			connMapMtx.Unlock()
 			t.wg.Add(1)
 			go func(c net.Conn) {
-				t.handleConnection(parser, nextConsumer, c)
+				if t.pickle {
+					t.handlePickleConnection(parser, nextConsumer, c)
+				} else {
+					t.handleConnection(parser, nextConsumer, c)
+				}
 				connMapMtx.Lock()
 				delete(acceptedConnMap, c)
 				connMapMtx.Unlock()
@@ -196,3 +224,72 @@ func (t *tcpServer) handleConnection(
 		}
 	}
 }
+
+// handlePickleConnection reads the messages of the pickle protocol: the length
+// of the message as a 4 bytes big-endian integer, followed by the pickled list
+// of metrics.
+func (t *tcpServer) handlePickleConnection(
+	p protocol.Parser,
+	nextConsumer consumer.Metrics,
+	conn net.Conn,
+) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	header := make([]byte, 4)
+	for {
+		if err := conn.SetDeadline(time.Now().Add(t.idleTimeout)); err != nil {
+			t.reporter.OnDebugf(
+				"TCP Transport (%s) - conn.SetDeadLine error: %v",
+				t.ln.Addr(),
+				err)
+			return
+		}
+
+		if _, err := io.ReadFull(reader, header); err != nil {
+			t.reporter.OnDebugf("TCP Transport (%s) - error: %v", t.ln.Addr(), err)
+			return
+		}
+		size := binary.BigEndian.Uint32(header)
+		ctx := t.reporter.OnDataReceived(context.Background())
+		if size > maxPickleSize {
+			// The rest of the connection can't be trusted to be in sync.
+			t.reporter.OnTranslationError(ctx, fmt.Errorf("pickle message of %d bytes exceeds the maximum of %d bytes", size, maxPickleSize))
+			t.reporter.OnMetricsProcessed(ctx, 0, nil)
+			return
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			t.reporter.OnMetricsProcessed(ctx, 0, err)
+			return
+		}
+
+		lines, err := pickleLines(data)
+		if err != nil {
+			t.reporter.OnTranslationError(ctx, err)
+			t.reporter.OnMetricsProcessed(ctx, 0, nil)
+			continue
+		}
+
+		metrics := pmetric.NewMetrics()
+		metricSlice := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+		for _, line := range lines {
+			metric, err := p.Parse(line)
+			if err != nil {
+				t.reporter.OnTranslationError(ctx, err)
+				continue
+			}
+			metric.MoveTo(metricSlice.AppendEmpty())
+		}
+		if metricSlice.Len() == 0 {
+			t.reporter.OnMetricsProcessed(ctx, 0, nil)
+			continue
+		}
+		err = nextConsumer.ConsumeMetrics(ctx, metrics)
+		t.reporter.OnMetricsProcessed(ctx, metricSlice.Len(), err)
+		if err != nil {
+			// See handleConnection, closing the connection is the only way to
+			// report the error back to the client.
+			return
+		}
+	}
+}
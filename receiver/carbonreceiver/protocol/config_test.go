@@ -49,6 +49,14 @@ func TestLoadParserConfig(t *testing.T) {
 				Config: &RegexParserConfig{},
 			},
 		},
+		{
+			name:   "default_template",
+			cfgMap: map[string]any{"type": "template"},
+			want: Config{
+				Type:   "template",
+				Config: &TemplateParserConfig{Separator: "."},
+			},
+		},
 		{
 			name:   "plaintext",
 			cfgMap: map[string]any{"type": "plaintext"},
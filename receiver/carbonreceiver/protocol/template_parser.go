@@ -0,0 +1,207 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/carbonreceiver/protocol"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	templateNamePart     = "name"
+	templateNameRestPart = "name*"
+)
+
+// TemplateParserConfig has the configuration for a parser that breaks down the
+// dotted "metric path" of a Carbon metric into a metric name and attributes,
+// according to Graphite templates like the ones used by carbon-relay users to
+// describe their naming hierarchy.
+//
+// A template has the following format:
+//
+//	[filter] template [key=value[,key=value]...]
+//
+// The template is a dotted list of parts, each one mapped to the node of the
+// metric path at the same position: "name" nodes are joined with the separator
+// to form the metric name, "name*" joins all the remaining nodes, empty parts
+// drop the node, and any other part is the key of the attribute set to the
+// node. The optional filter selects the metric paths the template applies to,
+// a "*" node of the filter matches any node. The optional key=value pairs are
+// added as attributes to the metrics.
+//
+// Examples:
+//
+// 1. Template: "servers.* .host.name.name*"
+//
+//	Metric path: "servers.host01.cpu.load.shortterm"
+//	Resulting metric:
+//	name: cpu.load.shortterm
+//	attributes: {"host": "host01"}
+//
+// 2. Template: "region.host.name region=us-east"
+//
+//	Metric path: "eu.host02.requests"
+//	Resulting metric:
+//	name: requests
+//	attributes: {"region": "eu", "host": "host02"}
+type TemplateParserConfig struct {
+	// Templates are applied to the metric paths matching their filter. The
+	// first template that matches is applied, if none matches the metric is
+	// processed by the "plaintext" parser.
+	Templates []string `mapstructure:"templates"`
+
+	// Separator is used when joining the nodes of the metric path forming the
+	// metric name, the default is ".".
+	Separator string `mapstructure:"separator"`
+}
+
+var _ (ParserConfig) = (*TemplateParserConfig)(nil)
+
+// BuildParser builds the respective parser of the configuration instance.
+func (tpc *TemplateParserConfig) BuildParser() (Parser, error) {
+	if tpc == nil {
+		return nil, errors.New("nil receiver on TemplateParserConfig.BuildParser")
+	}
+	if len(tpc.Templates) == 0 {
+		return nil, errors.New("no template was specified")
+	}
+
+	tpp := &templatePathParser{separator: tpc.Separator}
+	for i, t := range tpc.Templates {
+		tmpl, err := compileTemplate(t)
+		if err != nil {
+			return nil, fmt.Errorf("error on %d-th template: %w", i, err)
+		}
+		tpp.templates = append(tpp.templates, tmpl)
+	}
+	return NewParser(tpp)
+}
+
+type template struct {
+	filter     []string
+	parts      []string
+	attributes map[string]string
+}
+
+func compileTemplate(s string) (*template, error) {
+	fields := strings.Fields(s)
+	var tmpl template
+	switch {
+	case len(fields) == 1:
+		tmpl.parts = strings.Split(fields[0], ".")
+	case len(fields) == 2 && strings.Contains(fields[1], "="):
+		tmpl.parts = strings.Split(fields[0], ".")
+		tmpl.attributes = map[string]string{}
+	case len(fields) == 2:
+		tmpl.filter = strings.Split(fields[0], ".")
+		tmpl.parts = strings.Split(fields[1], ".")
+	case len(fields) == 3:
+		tmpl.filter = strings.Split(fields[0], ".")
+		tmpl.parts = strings.Split(fields[1], ".")
+		tmpl.attributes = map[string]string{}
+	default:
+		return nil, fmt.Errorf("invalid template %q", s)
+	}
+
+	if tmpl.attributes != nil {
+		for _, pair := range strings.Split(fields[len(fields)-1], ",") {
+			k, v, ok := strings.Cut(pair, "=")
+			if !ok || k == "" {
+				return nil, fmt.Errorf("invalid attribute %q of template %q", pair, s)
+			}
+			tmpl.attributes[k] = v
+		}
+	}
+
+	hasName := false
+	for i, part := range tmpl.parts {
+		switch {
+		case part == templateNamePart:
+			hasName = true
+		case part == templateNameRestPart:
+			if i != len(tmpl.parts)-1 {
+				return nil, fmt.Errorf("%q must be the last part of template %q", templateNameRestPart, s)
+			}
+			hasName = true
+		case strings.Contains(part, "*"):
+			return nil, fmt.Errorf("invalid part %q of template %q", part, s)
+		}
+	}
+	if !hasName {
+		return nil, fmt.Errorf("template %q has no %q part", s, templateNamePart)
+	}
+	return &tmpl, nil
+}
+
+func (t *template) matches(nodes []string) bool {
+	if len(nodes) < len(t.filter) {
+		return false
+	}
+	for i, f := range t.filter {
+		if f != "*" && f != nodes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+type templatePathParser struct {
+	templates []*template
+	separator string
+
+	// plaintextPathParser handles the tags of the path, and the metrics that
+	// no template matches.
+	plaintextPathParser PlaintextPathParser
+}
+
+// ParsePath converts the <metric_path> of a Carbon line (see PathParserHelper
+// a full description of the line format) according to the TemplateParserConfig
+// settings. The tags of the path, if any, take precedence over the attributes
+// extracted by the template.
+func (tpp *templatePathParser) ParsePath(path string, parsedPath *ParsedPath) error {
+	if err := tpp.plaintextPathParser.ParsePath(path, parsedPath); err != nil {
+		return err
+	}
+
+	nodes := strings.Split(parsedPath.MetricName, ".")
+	for _, t := range tpp.templates {
+		if !t.matches(nodes) {
+			continue
+		}
+
+		var name []string
+		for i, part := range t.parts {
+			if i >= len(nodes) {
+				break
+			}
+			switch part {
+			case "":
+			case templateNamePart:
+				name = append(name, nodes[i])
+			case templateNameRestPart:
+				name = append(name, nodes[i:]...)
+			default:
+				if _, ok := parsedPath.Attributes.Get(part); !ok {
+					parsedPath.Attributes.PutStr(part, nodes[i])
+				}
+			}
+		}
+		for k, v := range t.attributes {
+			if _, ok := parsedPath.Attributes.Get(k); !ok {
+				parsedPath.Attributes.PutStr(k, v)
+			}
+		}
+
+		if len(name) > 0 {
+			parsedPath.MetricName = strings.Join(name, tpp.separator)
+		}
+		return nil
+	}
+	return nil
+}
+
+func templateDefaultConfig() ParserConfig {
+	return &TemplateParserConfig{Separator: "."}
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateParserConfigBuildParser(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ParserConfig
+		wantErr string
+	}{
+		{
+			name:    "nil_method_receiver",
+			config:  (*TemplateParserConfig)(nil),
+			wantErr: "nil receiver on TemplateParserConfig.BuildParser",
+		},
+		{
+			name:    "no_templates",
+			config:  &TemplateParserConfig{},
+			wantErr: "no template was specified",
+		},
+		{
+			name:    "too_many_fields",
+			config:  &TemplateParserConfig{Templates: []string{"a.* name.host k=v extra"}},
+			wantErr: `error on 0-th template: invalid template "a.* name.host k=v extra"`,
+		},
+		{
+			name:    "invalid_attribute",
+			config:  &TemplateParserConfig{Templates: []string{"name.host =v"}},
+			wantErr: `error on 0-th template: invalid attribute "=v" of template "name.host =v"`,
+		},
+		{
+			name:    "no_name",
+			config:  &TemplateParserConfig{Templates: []string{"region.host"}},
+			wantErr: `error on 0-th template: template "region.host" has no "name" part`,
+		},
+		{
+			name:    "name_rest_not_last",
+			config:  &TemplateParserConfig{Templates: []string{"name*.host"}},
+			wantErr: `error on 0-th template: "name*" must be the last part of template "name*.host"`,
+		},
+		{
+			name:    "invalid_part",
+			config:  &TemplateParserConfig{Templates: []string{"name.host*"}},
+			wantErr: `error on 0-th template: invalid part "host*" of template "name.host*"`,
+		},
+		{
+			name: "valid_templates",
+			config: &TemplateParserConfig{Templates: []string{
+				"servers.* .host.name.name* source=servers",
+				"region.host.name k=v,k2=v2",
+				"name*",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.BuildParser()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			require.NotNil(t, got)
+		})
+	}
+}
+
+func Test_templateParser_Parse(t *testing.T) {
+	cfg := templateDefaultConfig().(*TemplateParserConfig)
+	cfg.Templates = []string{
+		"servers.* .host.name.name* source=servers",
+		"*.*.requests region.host.name",
+		"apps.*.*.* .app.env.name k=v",
+	}
+	p, err := cfg.BuildParser()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		line           string
+		wantName       string
+		wantAttributes map[string]any
+	}{
+		{
+			name:     "name_rest",
+			line:     "servers.host01.cpu.load.shortterm 0.5 1582230020",
+			wantName: "cpu.load.shortterm",
+			wantAttributes: map[string]any{
+				"host":   "host01",
+				"source": "servers",
+			},
+		},
+		{
+			name:     "filter_wildcards",
+			line:     "eu.host02.requests 10 1582230020",
+			wantName: "requests",
+			wantAttributes: map[string]any{
+				"region": "eu",
+				"host":   "host02",
+			},
+		},
+		{
+			name:     "tags_take_precedence",
+			line:     "apps.web.prod.latency;env=staging;k=tag 12 1582230020",
+			wantName: "latency",
+			wantAttributes: map[string]any{
+				"app": "web",
+				"env": "staging",
+				"k":   "tag",
+			},
+		},
+		{
+			name:     "shorter_path",
+			line:     "servers.host03 1 1582230020",
+			wantName: "servers.host03",
+			wantAttributes: map[string]any{
+				"host":   "host03",
+				"source": "servers",
+			},
+		},
+		{
+			name:           "no_template_match",
+			line:           "other.metric 1 1582230020",
+			wantName:       "other.metric",
+			wantAttributes: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := p.Parse(tt.line)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, m.Name())
+			assert.Equal(t, tt.wantAttributes, m.Gauge().DataPoints().At(0).Attributes().AsRaw())
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dockerstatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/dockerstatsreceiver"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dtypes "github.com/docker/docker/api/types"
+	devents "github.com/docker/docker/api/types/events"
+	dfilters "github.com/docker/docker/api/types/filters"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/docker"
+)
+
+// containerEvents are the container lifecycle events emitted as log records.
+var containerEvents = []string{"start", "stop", "die", "oom", "health_status"}
+
+const (
+	eventDomain = "docker"
+
+	attributeEventDomain           = "event.domain"
+	attributeEventName             = "event.name"
+	attributeContainerExitCode     = "container.exit_code"
+	attributeContainerHealthStatus = "container.health_status"
+)
+
+// logsReceiver subscribes to the events API of the Docker daemon and emits the lifecycle
+// events of containers as log records.
+type logsReceiver struct {
+	config   *Config
+	settings receiver.CreateSettings
+	consumer consumer.Logs
+	client   *docker.Client
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func newLogsReceiver(set receiver.CreateSettings, config *Config, consumer consumer.Logs) *logsReceiver {
+	return &logsReceiver{
+		config:   config,
+		settings: set,
+		consumer: consumer,
+	}
+}
+
+func (r *logsReceiver) Start(_ context.Context, _ component.Host) error {
+	dConfig, err := docker.NewConfig(r.config.Endpoint, r.config.Timeout, r.config.ExcludedImages, r.config.DockerAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	r.client, err = docker.NewDockerClient(dConfig, r.settings.Logger)
+	if err != nil {
+		return err
+	}
+
+	cctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.eventLoop(cctx)
+	}()
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+func (r *logsReceiver) eventLoop(ctx context.Context) {
+	filters := dfilters.NewArgs(dfilters.Arg("type", "container"))
+	for _, event := range containerEvents {
+		filters.Add("event", event)
+	}
+	lastTime := time.Now()
+
+EVENT_LOOP:
+	for {
+		options := dtypes.EventsOptions{
+			Filters: filters,
+			Since:   lastTime.Format(time.RFC3339Nano),
+		}
+		eventCh, errCh := r.client.Events(ctx, options)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-eventCh:
+				// Events are consumed one at a time: lifecycle events are rare enough not to be worth batching.
+				if err := r.consumer.ConsumeLogs(ctx, r.eventToLogs(event)); err != nil {
+					r.settings.Logger.Error("Error consuming docker container event", zap.Error(err))
+				}
+
+				if event.TimeNano > lastTime.UnixNano() {
+					lastTime = time.Unix(0, event.TimeNano)
+				}
+
+			case err := <-errCh:
+				// We are only interested when the context hasn't been canceled since requests made
+				// with a closed context are guaranteed to fail.
+				if ctx.Err() == nil {
+					r.settings.Logger.Error("Error watching docker container events", zap.Error(err))
+					select {
+					case <-time.After(3 * time.Second):
+						continue EVENT_LOOP
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func (r *logsReceiver) eventToLogs(event devents.Message) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+
+	resource := rl.Resource().Attributes()
+	resource.PutStr(conventions.AttributeContainerRuntime, "docker")
+	resource.PutStr(conventions.AttributeContainerID, event.Actor.ID)
+	if name, ok := event.Actor.Attributes["name"]; ok {
+		resource.PutStr(conventions.AttributeContainerName, name)
+	}
+	if image, ok := event.Actor.Attributes["image"]; ok {
+		resource.PutStr(conventions.AttributeContainerImageName, image)
+	}
+	// Container labels are reported as attributes of the event actor.
+	for k, label := range r.config.ContainerLabelsToMetricLabels {
+		if v, ok := event.Actor.Attributes[k]; ok {
+			resource.PutStr(label, v)
+		}
+	}
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/dockerstatsreceiver")
+	lr := sl.LogRecords().AppendEmpty()
+
+	timestamp := time.Unix(event.Time, 0)
+	if event.TimeNano != 0 {
+		timestamp = time.Unix(0, event.TimeNano)
+	}
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.Body().SetStr(string(event.Action))
+
+	// Health status events have the new status appended to their action, e.g. "health_status: healthy".
+	action, status, _ := strings.Cut(string(event.Action), ":")
+	status = strings.TrimSpace(status)
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeEventDomain, eventDomain)
+	attrs.PutStr(attributeEventName, "container."+action)
+
+	severity := plog.SeverityNumberInfo
+	switch action {
+	case "die":
+		if exitCode, err := strconv.ParseInt(event.Actor.Attributes["exitCode"], 10, 64); err == nil {
+			attrs.PutInt(attributeContainerExitCode, exitCode)
+			if exitCode != 0 {
+				severity = plog.SeverityNumberWarn
+			}
+		}
+	case "oom":
+		severity = plog.SeverityNumberError
+	case "health_status":
+		attrs.PutStr(attributeContainerHealthStatus, status)
+		if status == "unhealthy" {
+			severity = plog.SeverityNumberWarn
+		}
+	}
+	lr.SetSeverityNumber(severity)
+	lr.SetSeverityText(severity.String())
+
+	return logs
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !windows
+
+package dockerstatsreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const containerEventsStream = `{"status":"start","id":"a359c0fc87c5","from":"nginx","Type":"container","Action":"start","Actor":{"ID":"a359c0fc87c5","Attributes":{"image":"nginx","name":"web","my.container.label":"my-value"}},"scope":"local","time":1700000000,"timeNano":1700000000000000001}
+{"status":"health_status: unhealthy","id":"a359c0fc87c5","from":"nginx","Type":"container","Action":"health_status: unhealthy","Actor":{"ID":"a359c0fc87c5","Attributes":{"image":"nginx","name":"web"}},"scope":"local","time":1700000001,"timeNano":1700000001000000000}
+{"status":"oom","id":"a359c0fc87c5","from":"nginx","Type":"container","Action":"oom","Actor":{"ID":"a359c0fc87c5","Attributes":{"image":"nginx","name":"web"}},"scope":"local","time":1700000002,"timeNano":1700000002000000000}
+{"status":"die","id":"a359c0fc87c5","from":"nginx","Type":"container","Action":"die","Actor":{"ID":"a359c0fc87c5","Attributes":{"exitCode":"137","image":"nginx","name":"web"}},"scope":"local","time":1700000003,"timeNano":1700000003000000000}
+`
+
+func TestLogsReceiverContainerEvents(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v1.25/events" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte(containerEventsStream))
+		rw.(http.Flusher).Flush()
+		// Keep the stream open like the Docker daemon does.
+		<-req.Context().Done()
+	}))
+	defer mockServer.Close()
+
+	cfg := newTestConfigBuilder().withEndpoint(mockServer.URL).config
+	cfg.ContainerLabelsToMetricLabels = map[string]string{"my.container.label": "my-label"}
+
+	sink := new(consumertest.LogsSink)
+	recv := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, recv.Shutdown(context.Background()))
+	}()
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 4
+	}, 10*time.Second, 10*time.Millisecond)
+
+	logs := sink.AllLogs()
+	testCases := []struct {
+		body       string
+		eventName  string
+		severity   plog.SeverityNumber
+		attributes map[string]any
+	}{
+		{
+			body:      "start",
+			eventName: "container.start",
+			severity:  plog.SeverityNumberInfo,
+		},
+		{
+			body:       "health_status: unhealthy",
+			eventName:  "container.health_status",
+			severity:   plog.SeverityNumberWarn,
+			attributes: map[string]any{"container.health_status": "unhealthy"},
+		},
+		{
+			body:      "oom",
+			eventName: "container.oom",
+			severity:  plog.SeverityNumberError,
+		},
+		{
+			body:       "die",
+			eventName:  "container.die",
+			severity:   plog.SeverityNumberWarn,
+			attributes: map[string]any{"container.exit_code": int64(137)},
+		},
+	}
+	for i, tc := range testCases {
+		rl := logs[i].ResourceLogs().At(0)
+		expectedResource := map[string]any{
+			"container.runtime":    "docker",
+			"container.id":         "a359c0fc87c5",
+			"container.name":       "web",
+			"container.image.name": "nginx",
+		}
+		if i == 0 {
+			expectedResource["my-label"] = "my-value"
+		}
+		assert.Equal(t, expectedResource, rl.Resource().Attributes().AsRaw())
+
+		lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+		assert.Equal(t, tc.body, lr.Body().Str())
+		assert.Equal(t, tc.severity, lr.SeverityNumber())
+		expectedAttributes := map[string]any{
+			"event.domain": "docker",
+			"event.name":   tc.eventName,
+		}
+		for k, v := range tc.attributes {
+			expectedAttributes[k] = v
+		}
+		assert.Equal(t, expectedAttributes, lr.Attributes().AsRaw())
+	}
+	assert.Equal(t, time.Unix(0, 1700000000000000001).UTC(), logs[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Timestamp().AsTime())
+}
@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ebpfflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver"
+
+import (
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/metadata"
+)
+
+type Config struct {
+	// CollectionInterval is the interval at which the traced connections are aggregated into flows.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// MaxConnections is the maximum number of connections traced at once, the connections opened once
+	// it is reached aren't traced until others are closed.
+	MaxConnections int `mapstructure:"max_connections"`
+	// ProcPath is the path of the proc filesystem of the host, read to find the listening ports and
+	// the containers of the processes.
+	ProcPath string `mapstructure:"proc_path"`
+
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.CollectionInterval <= 0 {
+		errs = errors.Join(errs, errors.New("collection_interval must be positive"))
+	}
+	if cfg.MaxConnections <= 0 {
+		errs = errors.Join(errs, errors.New("max_connections must be positive"))
+	}
+	if cfg.ProcPath == "" {
+		errs = errors.Join(errs, errors.New("proc_path must be specified"))
+	}
+	return errs
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package ebpfflowreceiver traces the TCP connections of the host with eBPF and aggregates
+// them into network flow metrics and logs.
+package ebpfflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver"
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ebpfflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/metadata"
+)
+
+const (
+	defaultCollectionInterval = 10 * time.Second
+	defaultMaxConnections     = 65536
+	defaultProcPath           = "/proc"
+)
+
+// receivers shares a single tracer between the metrics and logs pipelines of a receiver.
+var receivers = sharedcomponent.NewSharedComponents()
+
+// NewFactory creates a factory for the eBPF flow receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		CollectionInterval:   defaultCollectionInterval,
+		MaxConnections:       defaultMaxConnections,
+		ProcPath:             defaultProcPath,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	r, err := getFlowReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*flowReceiver).nextMetrics = nextConsumer
+	return r, nil
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	r, err := getFlowReceiver(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().(*flowReceiver).nextLogs = nextConsumer
+	return r, nil
+}
+
+func getFlowReceiver(cfg *Config, set receiver.CreateSettings) (*sharedcomponent.SharedComponent, error) {
+	var err error
+	r := receivers.GetOrAdd(cfg, func() component.Component {
+		var flows *flowReceiver
+		flows, err = newFlowReceiver(cfg, set)
+		return flows
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,256 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package tracer // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/tracer"
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/btf"
+)
+
+const (
+	afInet  = 2
+	afInet6 = 10
+
+	socketsMapName = "sockets"
+	exitLabel      = "exit"
+	updateLabel    = "update"
+	ipv6Label      = "ipv6"
+	lookupLabel    = "lookup"
+	countersLabel  = "counters"
+	timesLabel     = "times"
+	startLabel     = "start_set"
+)
+
+// The layout of the values of the sockets map, each field aligned on its size.
+const (
+	valuePID           = 0
+	valueFamily        = 4
+	valueLocalPort     = 6
+	valueRemotePort    = 8
+	valueClosed        = 12
+	valueLocalAddr     = 16
+	valueRemoteAddr    = 32
+	valueBytesSent     = 48
+	valueBytesReceived = 56
+	valueSegsOut       = 64
+	valueSegsIn        = 68
+	valueSRTT          = 72
+	valueStart         = 80
+	valueLastSeen      = 88
+	valueComm          = 96
+	valueSize          = 112
+
+	commSize = 16
+	keySize  = 8
+)
+
+// offsets holds the offsets of the fields of the kernel socket structures read by the programs, resolved
+// from the BTF of the running kernel so that the programs don't depend on the kernel version.
+type offsets struct {
+	family, localPort, remotePort int16
+	localAddr, remoteAddr         int16
+	localAddr6, remoteAddr6       int16
+	bytesSent, bytesReceived      int16
+	segsOut, segsIn, srtt         int16
+}
+
+func loadOffsets(spec *btf.Spec) (offsets, error) {
+	var sock, tcpSock *btf.Struct
+	if err := spec.TypeByName("sock", &sock); err != nil {
+		return offsets{}, fmt.Errorf("failed to find struct sock: %w", err)
+	}
+	if err := spec.TypeByName("tcp_sock", &tcpSock); err != nil {
+		return offsets{}, fmt.Errorf("failed to find struct tcp_sock: %w", err)
+	}
+
+	var o offsets
+	var errs error
+	resolve := func(dst *int16, typ btf.Type, path ...string) {
+		off, err := memberOffset(typ, path...)
+		errs = errors.Join(errs, err)
+		*dst = off
+	}
+	resolve(&o.family, sock, "__sk_common", "skc_family")
+	resolve(&o.localPort, sock, "__sk_common", "skc_num")
+	resolve(&o.remotePort, sock, "__sk_common", "skc_dport")
+	resolve(&o.localAddr, sock, "__sk_common", "skc_rcv_saddr")
+	resolve(&o.remoteAddr, sock, "__sk_common", "skc_daddr")
+	resolve(&o.localAddr6, sock, "__sk_common", "skc_v6_rcv_saddr")
+	resolve(&o.remoteAddr6, sock, "__sk_common", "skc_v6_daddr")
+	resolve(&o.bytesSent, tcpSock, "bytes_acked")
+	resolve(&o.bytesReceived, tcpSock, "bytes_received")
+	resolve(&o.segsOut, tcpSock, "segs_out")
+	resolve(&o.segsIn, tcpSock, "segs_in")
+	resolve(&o.srtt, tcpSock, "srtt_us")
+	return o, errs
+}
+
+// memberOffset returns the offset in bytes of the member at path, looking up each name through the
+// anonymous structs and unions.
+func memberOffset(typ btf.Type, path ...string) (int16, error) {
+	var offset btf.Bits
+	for _, name := range path {
+		members, err := compositeMembers(typ)
+		if err != nil {
+			return 0, err
+		}
+		member, off, ok := findMember(members, name)
+		if !ok {
+			return 0, fmt.Errorf("member %q not found in %s", name, typ.TypeName())
+		}
+		offset += off
+		typ = member.Type
+	}
+	if offset%8 != 0 {
+		return 0, fmt.Errorf("member %v is a bitfield", path)
+	}
+	return int16(offset / 8), nil
+}
+
+func findMember(members []btf.Member, name string) (btf.Member, btf.Bits, bool) {
+	for _, member := range members {
+		if member.Name == name {
+			return member, member.Offset, true
+		}
+		if member.Name != "" {
+			continue
+		}
+		nested, err := compositeMembers(member.Type)
+		if err != nil {
+			continue
+		}
+		if found, off, ok := findMember(nested, name); ok {
+			return found, member.Offset + off, true
+		}
+	}
+	return btf.Member{}, 0, false
+}
+
+func compositeMembers(typ btf.Type) ([]btf.Member, error) {
+	switch t := btf.UnderlyingType(typ).(type) {
+	case *btf.Struct:
+		return t.Members, nil
+	case *btf.Union:
+		return t.Members, nil
+	default:
+		return nil, fmt.Errorf("%s is not a struct or a union", typ.TypeName())
+	}
+}
+
+// socketProgram returns the instructions of a program attached to the entry of a kernel function whose
+// first argument is a TCP socket. It copies the addresses, the ports and the counters of the socket into
+// its entry of the sockets map, keyed by the address of the socket. The program attached to tcp_close
+// marks the entry as closed.
+func socketProgram(o offsets, closed bool) asm.Instructions {
+	var closedValue int64
+	if closed {
+		closedValue = 1
+	}
+
+	insns := asm.Instructions{
+		// r6 = sk, the first argument of the function
+		asm.LoadMem(asm.R6, asm.R1, 0, asm.DWord),
+		asm.LoadMem(asm.R7, asm.R6, o.family, asm.Half),
+		asm.JEq.Imm(asm.R7, afInet, lookupLabel),
+		asm.JNE.Imm(asm.R7, afInet6, exitLabel),
+
+		// the address of the socket is the key
+		asm.StoreMem(asm.RFP, -keySize, asm.R6, asm.DWord).WithSymbol(lookupLabel),
+		asm.LoadMapPtr(asm.R1, 0).WithReference(socketsMapName),
+		asm.Mov.Reg(asm.R2, asm.RFP),
+		asm.Add.Imm(asm.R2, -keySize),
+		asm.FnMapLookupElem.Call(),
+		asm.JNE.Imm(asm.R0, 0, updateLabel),
+	}
+
+	// the socket isn't tracked yet, its entry is created from a zeroed value
+	for off := int16(0); off < valueSize; off += 8 {
+		insns = append(insns, asm.StoreImm(asm.RFP, -keySize-valueSize+off, 0, asm.DWord))
+	}
+	insns = append(insns,
+		asm.LoadMapPtr(asm.R1, 0).WithReference(socketsMapName),
+		asm.Mov.Reg(asm.R2, asm.RFP),
+		asm.Add.Imm(asm.R2, -keySize),
+		asm.Mov.Reg(asm.R3, asm.RFP),
+		asm.Add.Imm(asm.R3, -keySize-valueSize),
+		asm.Mov.Imm(asm.R4, 1), // BPF_NOEXIST
+		asm.FnMapUpdateElem.Call(),
+		asm.LoadMapPtr(asm.R1, 0).WithReference(socketsMapName),
+		asm.Mov.Reg(asm.R2, asm.RFP),
+		asm.Add.Imm(asm.R2, -keySize),
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, exitLabel),
+
+		// r8 = the value of the socket
+		asm.Mov.Reg(asm.R8, asm.R0).WithSymbol(updateLabel),
+		asm.FnGetCurrentPidTgid.Call(),
+		asm.RSh.Imm(asm.R0, 32),
+		asm.StoreMem(asm.R8, valuePID, asm.R0, asm.Word),
+		asm.StoreMem(asm.R8, valueFamily, asm.R7, asm.Half),
+		asm.LoadMem(asm.R1, asm.R6, o.localPort, asm.Half),
+		asm.StoreMem(asm.R8, valueLocalPort, asm.R1, asm.Half),
+		asm.LoadMem(asm.R1, asm.R6, o.remotePort, asm.Half),
+		asm.StoreMem(asm.R8, valueRemotePort, asm.R1, asm.Half),
+		asm.StoreImm(asm.R8, valueClosed, closedValue, asm.Word),
+		asm.JEq.Imm(asm.R7, afInet6, ipv6Label),
+
+		asm.LoadMem(asm.R1, asm.R6, o.localAddr, asm.Word),
+		asm.StoreMem(asm.R8, valueLocalAddr, asm.R1, asm.Word),
+		asm.LoadMem(asm.R1, asm.R6, o.remoteAddr, asm.Word),
+		asm.StoreMem(asm.R8, valueRemoteAddr, asm.R1, asm.Word),
+		asm.Ja.Label(countersLabel),
+	)
+
+	// the IPv6 addresses are copied a word at a time
+	for i := int16(0); i < 16; i += 4 {
+		local := asm.LoadMem(asm.R1, asm.R6, o.localAddr6+i, asm.Word)
+		if i == 0 {
+			local = local.WithSymbol(ipv6Label)
+		}
+		insns = append(insns,
+			local,
+			asm.StoreMem(asm.R8, valueLocalAddr+i, asm.R1, asm.Word),
+			asm.LoadMem(asm.R1, asm.R6, o.remoteAddr6+i, asm.Word),
+			asm.StoreMem(asm.R8, valueRemoteAddr+i, asm.R1, asm.Word),
+		)
+	}
+
+	insns = append(insns,
+		// r9 = the tcp_sock of the socket, the counters are only read from TCP sockets
+		asm.Mov.Reg(asm.R1, asm.R6).WithSymbol(countersLabel),
+		asm.FnSkcToTcpSock.Call(),
+		asm.JEq.Imm(asm.R0, 0, timesLabel),
+		asm.Mov.Reg(asm.R9, asm.R0),
+		asm.LoadMem(asm.R1, asm.R9, o.bytesSent, asm.DWord),
+		asm.StoreMem(asm.R8, valueBytesSent, asm.R1, asm.DWord),
+		asm.LoadMem(asm.R1, asm.R9, o.bytesReceived, asm.DWord),
+		asm.StoreMem(asm.R8, valueBytesReceived, asm.R1, asm.DWord),
+		asm.LoadMem(asm.R1, asm.R9, o.segsOut, asm.Word),
+		asm.StoreMem(asm.R8, valueSegsOut, asm.R1, asm.Word),
+		asm.LoadMem(asm.R1, asm.R9, o.segsIn, asm.Word),
+		asm.StoreMem(asm.R8, valueSegsIn, asm.R1, asm.Word),
+		asm.LoadMem(asm.R1, asm.R9, o.srtt, asm.Word),
+		asm.StoreMem(asm.R8, valueSRTT, asm.R1, asm.Word),
+
+		asm.FnKtimeGetNs.Call().WithSymbol(timesLabel),
+		asm.StoreMem(asm.R8, valueLastSeen, asm.R0, asm.DWord),
+		asm.LoadMem(asm.R1, asm.R8, valueStart, asm.DWord),
+		asm.JNE.Imm(asm.R1, 0, startLabel),
+		asm.StoreMem(asm.R8, valueStart, asm.R0, asm.DWord),
+
+		asm.Mov.Reg(asm.R1, asm.R8).WithSymbol(startLabel),
+		asm.Add.Imm(asm.R1, valueComm),
+		asm.Mov.Imm(asm.R2, commSize),
+		asm.FnGetCurrentComm.Call(),
+
+		asm.Mov.Imm(asm.R0, 0).WithSymbol(exitLabel),
+		asm.Return(),
+	)
+	return insns
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracer traces the TCP sockets of the host with eBPF programs attached to the
+// kernel functions sending, receiving and closing them.
+package tracer // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/tracer"
+
+import (
+	"net/netip"
+	"time"
+)
+
+// Socket holds the cumulative counters of a TCP socket, as last updated by the eBPF programs.
+type Socket struct {
+	// ID identifies the socket while it is open, it is the address of the kernel socket.
+	ID uint64
+	// PID is the process which last sent or received data on the socket.
+	PID uint32
+	// Command is the name of the executable of the process, truncated to 15 characters.
+	Command    string
+	LocalAddr  netip.Addr
+	LocalPort  uint16
+	RemoteAddr netip.Addr
+	RemotePort uint16
+	// BytesSent counts the bytes sent and acknowledged by the peer.
+	BytesSent        uint64
+	BytesReceived    uint64
+	SegmentsSent     uint32
+	SegmentsReceived uint32
+	// SmoothedRTT is the smoothed round-trip time estimated by the TCP stack.
+	SmoothedRTT time.Duration
+	// Start is the time the socket was first seen by the eBPF programs.
+	Start time.Time
+	// LastSeen is the time of the last update of the counters.
+	LastSeen time.Time
+	// Closed reports whether the socket was closed. The closed sockets are reported once.
+	Closed bool
+}
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package tracer // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/tracer"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/btf"
+	"github.com/cilium/ebpf/link"
+	"github.com/cilium/ebpf/rlimit"
+	"golang.org/x/sys/unix"
+)
+
+// tracedFunctions are the kernel functions the programs are attached to, and whether the socket is closed
+// when they are called.
+var tracedFunctions = []struct {
+	name   string
+	closed bool
+}{
+	{name: "tcp_sendmsg"},
+	{name: "tcp_cleanup_rbuf"},
+	{name: "tcp_close", closed: true},
+}
+
+// Tracer traces the TCP sockets with programs attached to the entry of the kernel functions sending,
+// receiving and closing them.
+type Tracer struct {
+	sockets *ebpf.Map
+	progs   []*ebpf.Program
+	links   []link.Link
+}
+
+// New loads the programs and attaches them to the kernel. The sockets map holds at most maxSockets
+// sockets, the sockets opened once it is full aren't traced.
+func New(maxSockets int) (*Tracer, error) {
+	if err := rlimit.RemoveMemlock(); err != nil {
+		return nil, fmt.Errorf("failed to remove the memlock limit: %w", err)
+	}
+	spec, err := btf.LoadKernelSpec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the BTF of the kernel, BTF is required: %w", err)
+	}
+	o, err := loadOffsets(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Tracer{}
+	t.sockets, err = ebpf.NewMap(&ebpf.MapSpec{
+		Name:       socketsMapName,
+		Type:       ebpf.Hash,
+		KeySize:    keySize,
+		ValueSize:  valueSize,
+		MaxEntries: uint32(maxSockets),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the sockets map: %w", err)
+	}
+
+	for _, fn := range tracedFunctions {
+		insns := socketProgram(o, fn.closed)
+		if err = insns.AssociateMap(socketsMapName, t.sockets); err != nil {
+			return nil, errors.Join(err, t.Close())
+		}
+		prog, err := ebpf.NewProgramWithOptions(&ebpf.ProgramSpec{
+			Name:         "otel_" + fn.name,
+			Type:         ebpf.Tracing,
+			AttachType:   ebpf.AttachTraceFEntry,
+			AttachTo:     fn.name,
+			Instructions: insns,
+			License:      "GPL",
+		}, ebpf.ProgramOptions{KernelTypes: spec})
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to load the program tracing %s: %w", fn.name, err), t.Close())
+		}
+		t.progs = append(t.progs, prog)
+
+		l, err := link.AttachTracing(link.TracingOptions{Program: prog, AttachType: ebpf.AttachTraceFEntry})
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to attach the program tracing %s: %w", fn.name, err), t.Close())
+		}
+		t.links = append(t.links, l)
+	}
+	return t, nil
+}
+
+// Collect returns the sockets traced since they were opened, and removes the closed ones from the map.
+func (t *Tracer) Collect() ([]Socket, error) {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_MONOTONIC, &ts); err != nil {
+		return nil, fmt.Errorf("failed to read the monotonic clock: %w", err)
+	}
+	// the programs record the times with the monotonic clock
+	bootTime := time.Now().Add(-time.Duration(ts.Nano()))
+
+	var (
+		sockets []Socket
+		closed  []uint64
+		key     uint64
+		value   = make([]byte, valueSize)
+	)
+	iter := t.sockets.Iterate()
+	for iter.Next(&key, &value) {
+		s := decodeSocket(key, value, bootTime)
+		sockets = append(sockets, s)
+		if s.Closed {
+			closed = append(closed, s.ID)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the sockets map: %w", err)
+	}
+
+	for _, id := range closed {
+		if err := t.sockets.Delete(id); err != nil && !errors.Is(err, ebpf.ErrKeyNotExist) {
+			return sockets, fmt.Errorf("failed to remove a closed socket: %w", err)
+		}
+	}
+	return sockets, nil
+}
+
+// Close detaches the programs and releases the map.
+func (t *Tracer) Close() error {
+	var errs error
+	for _, l := range t.links {
+		errs = errors.Join(errs, l.Close())
+	}
+	for _, prog := range t.progs {
+		errs = errors.Join(errs, prog.Close())
+	}
+	if t.sockets != nil {
+		errs = errors.Join(errs, t.sockets.Close())
+	}
+	return errs
+}
+
+func decodeSocket(key uint64, value []byte, bootTime time.Time) Socket {
+	s := Socket{
+		ID:               key,
+		PID:              binary.NativeEndian.Uint32(value[valuePID:]),
+		Command:          string(bytes.TrimRight(value[valueComm:valueComm+commSize], "\x00")),
+		LocalPort:        binary.NativeEndian.Uint16(value[valueLocalPort:]),
+		RemotePort:       binary.BigEndian.Uint16(value[valueRemotePort:]),
+		BytesSent:        binary.NativeEndian.Uint64(value[valueBytesSent:]),
+		BytesReceived:    binary.NativeEndian.Uint64(value[valueBytesReceived:]),
+		SegmentsSent:     binary.NativeEndian.Uint32(value[valueSegsOut:]),
+		SegmentsReceived: binary.NativeEndian.Uint32(value[valueSegsIn:]),
+		// srtt_us holds the smoothed round-trip time in microseconds, shifted left by 3
+		SmoothedRTT: time.Duration(binary.NativeEndian.Uint32(value[valueSRTT:])>>3) * time.Microsecond,
+		Start:       bootTime.Add(time.Duration(binary.NativeEndian.Uint64(value[valueStart:]))),
+		LastSeen:    bootTime.Add(time.Duration(binary.NativeEndian.Uint64(value[valueLastSeen:]))),
+		Closed:      binary.NativeEndian.Uint32(value[valueClosed:]) != 0,
+	}
+	// the ports and addresses are copied as they are stored by the kernel, the addresses in network order
+	if binary.NativeEndian.Uint16(value[valueFamily:]) == afInet6 {
+		s.LocalAddr = netip.AddrFrom16([16]byte(value[valueLocalAddr : valueLocalAddr+16])).Unmap()
+		s.RemoteAddr = netip.AddrFrom16([16]byte(value[valueRemoteAddr : valueRemoteAddr+16])).Unmap()
+	} else {
+		s.LocalAddr = netip.AddrFrom4([4]byte(value[valueLocalAddr : valueLocalAddr+4]))
+		s.RemoteAddr = netip.AddrFrom4([4]byte(value[valueRemoteAddr : valueRemoteAddr+4]))
+	}
+	return s
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package tracer
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTracer(t *testing.T) *Tracer {
+	if os.Geteuid() != 0 {
+		t.Skip("loading eBPF programs requires root privileges")
+	}
+	tr, err := New(1024)
+	if errors.Is(err, ebpf.ErrNotSupported) || errors.Is(err, os.ErrPermission) {
+		t.Skipf("eBPF tracing is not supported: %v", err)
+	}
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, tr.Close()) })
+	return tr
+}
+
+func TestTracer(t *testing.T) {
+	tr := newTestTracer(t)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	received := make(chan int64)
+	go func() {
+		conn, errAccept := ln.Accept()
+		if errAccept != nil {
+			close(received)
+			return
+		}
+		n, _ := io.Copy(io.Discard, conn)
+		conn.Close()
+		received <- n
+	}()
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	payload := make([]byte, 64*1024)
+	_, err = conn.Write(payload)
+	require.NoError(t, err)
+	localPort := uint16(conn.LocalAddr().(*net.TCPAddr).Port)
+	serverPort := uint16(ln.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, conn.Close())
+	require.Equal(t, int64(len(payload)), <-received)
+
+	var client, server *Socket
+	require.Eventually(t, func() bool {
+		sockets, errCollect := tr.Collect()
+		require.NoError(t, errCollect)
+		for i := range sockets {
+			s := sockets[i]
+			switch {
+			case s.LocalPort == localPort && s.RemotePort == serverPort:
+				client = &s
+			case s.LocalPort == serverPort && s.RemotePort == localPort:
+				server = &s
+			}
+		}
+		return client != nil && client.Closed && server != nil && server.Closed
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.Equal(t, uint32(os.Getpid()), client.PID)
+	assert.Equal(t, "127.0.0.1", client.LocalAddr.String())
+	assert.Equal(t, "127.0.0.1", client.RemoteAddr.String())
+	assert.Equal(t, uint64(len(payload)), client.BytesSent)
+	assert.Equal(t, uint64(len(payload)), server.BytesReceived)
+	assert.NotZero(t, client.SegmentsSent)
+	assert.False(t, client.Start.After(client.LastSeen))
+	assert.WithinDuration(t, time.Now(), client.LastSeen, 5*time.Second)
+
+	// the closed sockets are reported once
+	sockets, err := tr.Collect()
+	require.NoError(t, err)
+	for _, s := range sockets {
+		assert.NotEqual(t, client.ID, s.ID)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package tracer // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/tracer"
+
+import (
+	"errors"
+)
+
+var errUnsupported = errors.New("eBPF tracing is only supported on Linux")
+
+// Tracer isn't supported on this platform.
+type Tracer struct{}
+
+// New returns an error, eBPF tracing is only supported on Linux.
+func New(int) (*Tracer, error) {
+	return nil, errUnsupported
+}
+
+// Collect returns an error, eBPF tracing is only supported on Linux.
+func (*Tracer) Collect() ([]Socket, error) {
+	return nil, errUnsupported
+}
+
+// Close does nothing.
+func (*Tracer) Close() error {
+	return nil
+}
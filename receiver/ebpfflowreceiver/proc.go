@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ebpfflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver"
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tcpListen is the state of the listening sockets in /proc/net/tcp.
+const tcpListen = "0A"
+
+// containerIDPattern matches the IDs of the containers in the cgroup paths, such as
+// /docker/<id> or /kubepods.slice/.../cri-containerd-<id>.scope.
+var containerIDPattern = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// listeningPorts returns the ports of the TCP sockets listening in the network namespace of the proc
+// filesystem.
+func listeningPorts(procPath string) (map[uint16]bool, error) {
+	ports := map[uint16]bool{}
+	var errs error
+	for _, name := range []string{"tcp", "tcp6"} {
+		if err := readListeningPorts(filepath.Join(procPath, "net", name), ports); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return ports, errs
+}
+
+func readListeningPorts(path string, ports map[uint16]bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// the first line is a header
+	scanner.Scan()
+	for scanner.Scan() {
+		// sl local_address rem_address st ...
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || fields[3] != tcpListen {
+			continue
+		}
+		i := strings.LastIndexByte(fields[1], ':')
+		if i < 0 {
+			return fmt.Errorf("invalid local address %q in %s", fields[1], path)
+		}
+		port, err := strconv.ParseUint(fields[1][i+1:], 16, 16)
+		if err != nil {
+			return fmt.Errorf("invalid local address %q in %s: %w", fields[1], path, err)
+		}
+		ports[uint16(port)] = true
+	}
+	return scanner.Err()
+}
+
+// containerID returns the ID of the container of the process, or an empty string when the process
+// doesn't run in a container.
+func containerID(procPath string, pid uint32) (string, error) {
+	data, err := os.ReadFile(filepath.Join(procPath, strconv.FormatUint(uint64(pid), 10), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	// the container is the innermost cgroup holding an ID
+	ids := containerIDPattern.FindAllString(string(data), -1)
+	if len(ids) == 0 {
+		return "", nil
+	}
+	return ids[len(ids)-1], nil
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ebpfflowreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testProcPath = filepath.Join("testdata", "proc")
+
+func TestListeningPorts(t *testing.T) {
+	ports, err := listeningPorts(testProcPath)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint16]bool{8080: true, 443: true}, ports)
+
+	ports, err = listeningPorts(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, ports)
+}
+
+func TestListeningPortsInvalid(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "net"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "net", "tcp"), []byte("header\n0: 00000000:XYZ 00000000:0000 0A\n"), 0o600))
+	_, err := listeningPorts(dir)
+	assert.ErrorContains(t, err, `invalid local address "00000000:XYZ"`)
+}
+
+func TestContainerID(t *testing.T) {
+	id, err := containerID(testProcPath, 1234)
+	require.NoError(t, err)
+	assert.Equal(t, "4f2a8c1b9d3e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a", id)
+
+	id, err = containerID(testProcPath, 5678)
+	require.NoError(t, err)
+	assert.Empty(t, id)
+
+	_, err = containerID(testProcPath, 1)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,341 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ebpfflowreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/tracer"
+)
+
+const (
+	transport = "ebpf"
+	scopeName = "otelcol/ebpfflowreceiver"
+
+	attributeEventName          = "event.name"
+	attributeSourceAddress      = "source.address"
+	attributeSourcePort         = "source.port"
+	attributeDestinationAddress = "destination.address"
+	attributeDestinationPort    = "destination.port"
+	attributeRole               = "network.flow.role"
+	attributeTransmittedBytes   = "network.flow.transmitted_bytes"
+	attributeReceivedBytes      = "network.flow.received_bytes"
+	attributeTransmittedPackets = "network.flow.transmitted_packets"
+	attributeReceivedPackets    = "network.flow.received_packets"
+	attributeRTT                = "network.flow.rtt"
+	attributeDuration           = "network.flow.duration"
+
+	flowEventName = "network.flow"
+)
+
+// socketTracer collects the counters of the traced TCP sockets.
+type socketTracer interface {
+	Collect() ([]tracer.Socket, error)
+	Close() error
+}
+
+type flowReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+	mb       *metadata.MetricsBuilder
+
+	nextMetrics consumer.Metrics
+	nextLogs    consumer.Logs
+
+	// newTracer loads the eBPF programs, it is replaced in the tests.
+	newTracer func(maxSockets int) (socketTracer, error)
+	tracer    socketTracer
+	// previous holds the counters of the open sockets at the previous collection, to compute the
+	// traffic of the flows since then.
+	previous map[uint64]tracer.Socket
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newFlowReceiver(cfg *Config, settings receiver.CreateSettings) (*flowReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &flowReceiver{
+		cfg:      cfg,
+		settings: settings,
+		obsrecv:  obsrecv,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		newTracer: func(maxSockets int) (socketTracer, error) {
+			return tracer.New(maxSockets)
+		},
+		previous: map[uint64]tracer.Socket{},
+	}, nil
+}
+
+func (r *flowReceiver) Start(_ context.Context, _ component.Host) error {
+	t, err := r.newTracer(r.cfg.MaxConnections)
+	if err != nil {
+		return fmt.Errorf("failed to load the eBPF programs: %w", err)
+	}
+	r.tracer = t
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.run(ctx)
+	return nil
+}
+
+func (r *flowReceiver) Shutdown(context.Context) error {
+	if r.tracer == nil {
+		return nil
+	}
+	r.cancel()
+	r.wg.Wait()
+	return r.tracer.Close()
+}
+
+func (r *flowReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+	ticker := time.NewTicker(r.cfg.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.collect(ctx)
+		}
+	}
+}
+
+// flowKey identifies the connections of a process with the same client and server.
+type flowKey struct {
+	process         processKey
+	role            metadata.AttributeRole
+	source          netip.Addr
+	destination     netip.Addr
+	destinationPort uint16
+}
+
+type processKey struct {
+	pid     uint32
+	command string
+}
+
+// flow holds the traffic of the connections of a flow since the previous collection.
+type flow struct {
+	transmittedBytes   uint64
+	receivedBytes      uint64
+	transmittedPackets uint64
+	receivedPackets    uint64
+	connections        int64
+	rttSum             time.Duration
+	rttCount           int64
+}
+
+// idle reports whether the connections of the flow transferred nothing since the previous collection.
+func (f *flow) idle() bool {
+	return f.connections == 0 && f.transmittedPackets == 0 && f.receivedPackets == 0
+}
+
+// collect aggregates the traffic of the sockets since the previous collection into flows, and emits
+// them as metrics. The sockets closed since then are emitted as flow logs.
+func (r *flowReceiver) collect(ctx context.Context) {
+	sockets, err := r.tracer.Collect()
+	if err != nil {
+		r.settings.Logger.Error("Failed to collect the traced sockets", zap.Error(err))
+		return
+	}
+	listening, err := listeningPorts(r.cfg.ProcPath)
+	if err != nil {
+		r.settings.Logger.Debug("Failed to read the listening ports", zap.Error(err))
+	}
+
+	now := time.Now()
+	flows := map[flowKey]*flow{}
+	logs := plog.NewLogs()
+	logRecords := map[processKey]plog.LogRecordSlice{}
+	open := make(map[uint64]bool, len(sockets))
+	for _, s := range sockets {
+		prev, seen := r.previous[s.ID]
+		// the address of a closed socket can be reused by a new one
+		if seen && !prev.Start.Equal(s.Start) {
+			seen = false
+			prev = tracer.Socket{}
+		}
+		if s.Closed {
+			delete(r.previous, s.ID)
+		} else {
+			r.previous[s.ID] = s
+			open[s.ID] = true
+		}
+		// the listening sockets have no peer
+		if s.RemotePort == 0 {
+			continue
+		}
+
+		key := newFlowKey(s, listening)
+		f, ok := flows[key]
+		if !ok {
+			f = &flow{}
+			flows[key] = f
+		}
+		f.transmittedBytes += delta(s.BytesSent, prev.BytesSent)
+		f.receivedBytes += delta(s.BytesReceived, prev.BytesReceived)
+		f.transmittedPackets += delta(uint64(s.SegmentsSent), uint64(prev.SegmentsSent))
+		f.receivedPackets += delta(uint64(s.SegmentsReceived), uint64(prev.SegmentsReceived))
+		if !seen {
+			f.connections++
+		}
+		if s.SmoothedRTT > 0 {
+			f.rttSum += s.SmoothedRTT
+			f.rttCount++
+		}
+
+		if s.Closed && r.nextLogs != nil {
+			records, ok := logRecords[key.process]
+			if !ok {
+				rl := logs.ResourceLogs().AppendEmpty()
+				r.newResource(key.process).MoveTo(rl.Resource())
+				sl := rl.ScopeLogs().AppendEmpty()
+				sl.Scope().SetName(scopeName)
+				records = sl.LogRecords()
+				logRecords[key.process] = records
+			}
+			appendFlowLog(records, s, key, now)
+		}
+	}
+	// the sockets which disappeared without being reported closed aren't tracked anymore
+	for id := range r.previous {
+		if !open[id] {
+			delete(r.previous, id)
+		}
+	}
+
+	if r.nextMetrics != nil {
+		r.emitMetrics(ctx, flows, now)
+	}
+	if logs.LogRecordCount() > 0 {
+		obsCtx := r.obsrecv.StartLogsOp(ctx)
+		err = r.nextLogs.ConsumeLogs(obsCtx, logs)
+		r.obsrecv.EndLogsOp(obsCtx, metadata.Type.String(), logs.LogRecordCount(), err)
+	}
+}
+
+func (r *flowReceiver) emitMetrics(ctx context.Context, flows map[flowKey]*flow, now time.Time) {
+	ts := pcommon.NewTimestampFromTime(now)
+	byProcess := map[processKey][]flowKey{}
+	for key := range flows {
+		byProcess[key.process] = append(byProcess[key.process], key)
+	}
+	for process, keys := range byProcess {
+		for _, key := range keys {
+			f := flows[key]
+			if f.idle() {
+				continue
+			}
+			source, destination, port := key.source.String(), key.destination.String(), int64(key.destinationPort)
+			r.mb.RecordNetworkFlowIoDataPoint(ts, int64(f.transmittedBytes), source, destination, port, key.role, metadata.AttributeDirectionTransmit)
+			r.mb.RecordNetworkFlowIoDataPoint(ts, int64(f.receivedBytes), source, destination, port, key.role, metadata.AttributeDirectionReceive)
+			r.mb.RecordNetworkFlowPacketsDataPoint(ts, int64(f.transmittedPackets), source, destination, port, key.role, metadata.AttributeDirectionTransmit)
+			r.mb.RecordNetworkFlowPacketsDataPoint(ts, int64(f.receivedPackets), source, destination, port, key.role, metadata.AttributeDirectionReceive)
+			r.mb.RecordNetworkFlowConnectionsDataPoint(ts, f.connections, source, destination, port, key.role)
+			if f.rttCount > 0 {
+				r.mb.RecordNetworkFlowRttDataPoint(ts, (f.rttSum / time.Duration(f.rttCount)).Seconds(), source, destination, port, key.role)
+			}
+		}
+		r.mb.EmitForResource(metadata.WithResource(r.newResource(process)))
+	}
+
+	metrics := r.mb.Emit()
+	if metrics.DataPointCount() == 0 {
+		return
+	}
+	obsCtx := r.obsrecv.StartMetricsOp(ctx)
+	err := r.nextMetrics.ConsumeMetrics(obsCtx, metrics)
+	r.obsrecv.EndMetricsOp(obsCtx, metadata.Type.String(), metrics.DataPointCount(), err)
+}
+
+func (r *flowReceiver) newResource(process processKey) pcommon.Resource {
+	rb := r.mb.NewResourceBuilder()
+	rb.SetProcessPid(int64(process.pid))
+	rb.SetProcessExecutableName(process.command)
+	id, err := containerID(r.cfg.ProcPath, process.pid)
+	switch {
+	case err != nil && !errors.Is(err, os.ErrNotExist):
+		r.settings.Logger.Debug("Failed to read the cgroups of the process", zap.Uint32("pid", process.pid), zap.Error(err))
+	case id != "":
+		rb.SetContainerID(id)
+	}
+	return rb.Emit()
+}
+
+// newFlowKey orients the socket from the client to the server of the connection. The process is the
+// server when the local port of the socket is a listening port.
+func newFlowKey(s tracer.Socket, listening map[uint16]bool) flowKey {
+	key := flowKey{process: processKey{pid: s.PID, command: s.Command}}
+	if listening[s.LocalPort] {
+		key.role = metadata.AttributeRoleServer
+		key.source = s.RemoteAddr
+		key.destination = s.LocalAddr
+		key.destinationPort = s.LocalPort
+	} else {
+		key.role = metadata.AttributeRoleClient
+		key.source = s.LocalAddr
+		key.destination = s.RemoteAddr
+		key.destinationPort = s.RemotePort
+	}
+	return key
+}
+
+// appendFlowLog appends the log of a closed connection, with its traffic since it was first traced.
+func appendFlowLog(records plog.LogRecordSlice, s tracer.Socket, key flowKey, now time.Time) {
+	lr := records.AppendEmpty()
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(s.LastSeen))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeEventName, flowEventName)
+	attrs.PutStr(attributeSourceAddress, key.source.String())
+	if key.role == metadata.AttributeRoleClient {
+		attrs.PutInt(attributeSourcePort, int64(s.LocalPort))
+	} else {
+		attrs.PutInt(attributeSourcePort, int64(s.RemotePort))
+	}
+	attrs.PutStr(attributeDestinationAddress, key.destination.String())
+	attrs.PutInt(attributeDestinationPort, int64(key.destinationPort))
+	attrs.PutStr(attributeRole, key.role.String())
+	attrs.PutInt(attributeTransmittedBytes, int64(s.BytesSent))
+	attrs.PutInt(attributeReceivedBytes, int64(s.BytesReceived))
+	attrs.PutInt(attributeTransmittedPackets, int64(s.SegmentsSent))
+	attrs.PutInt(attributeReceivedPackets, int64(s.SegmentsReceived))
+	attrs.PutDouble(attributeRTT, s.SmoothedRTT.Seconds())
+	attrs.PutDouble(attributeDuration, s.LastSeen.Sub(s.Start).Seconds())
+}
+
+// delta returns the increase of a counter, or its value when it was reset.
+func delta(cur, prev uint64) uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
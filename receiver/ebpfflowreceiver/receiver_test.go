@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ebpfflowreceiver
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/ebpfflowreceiver/internal/tracer"
+)
+
+const testContainerID = "4f2a8c1b9d3e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a"
+
+// fakeTracer returns the batches of sockets in order, then no sockets.
+type fakeTracer struct {
+	mu      sync.Mutex
+	batches [][]tracer.Socket
+	err     error
+	closed  bool
+}
+
+func (t *fakeTracer) Collect() ([]tracer.Socket, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err != nil {
+		return nil, t.err
+	}
+	if len(t.batches) == 0 {
+		return nil, nil
+	}
+	batch := t.batches[0]
+	t.batches = t.batches[1:]
+	return batch, nil
+}
+
+func (t *fakeTracer) Close() error {
+	t.closed = true
+	return nil
+}
+
+func newTestReceiver(t *testing.T, ft *fakeTracer) (*flowReceiver, *consumertest.MetricsSink, *consumertest.LogsSink) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProcPath = testProcPath
+	cfg.CollectionInterval = 10 * time.Millisecond
+	r, err := newFlowReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	r.newTracer = func(int) (socketTracer, error) { return ft, nil }
+	metrics, logs := new(consumertest.MetricsSink), new(consumertest.LogsSink)
+	r.nextMetrics = metrics
+	r.nextLogs = logs
+	return r, metrics, logs
+}
+
+var (
+	localhost = netip.MustParseAddr("127.0.0.1")
+	start     = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// serverSocket and clientSocket are the two ends of a connection to the port 8080, listening in
+// testdata/proc.
+func serverSocket() tracer.Socket {
+	return tracer.Socket{
+		ID: 1, PID: 1234, Command: "server",
+		LocalAddr: localhost, LocalPort: 8080, RemoteAddr: localhost, RemotePort: 50000,
+		BytesSent: 100, BytesReceived: 200, SegmentsSent: 2, SegmentsReceived: 3,
+		SmoothedRTT: time.Millisecond, Start: start, LastSeen: start.Add(time.Second),
+	}
+}
+
+func clientSocket() tracer.Socket {
+	return tracer.Socket{
+		ID: 2, PID: 5678, Command: "curl",
+		LocalAddr: localhost, LocalPort: 50000, RemoteAddr: localhost, RemotePort: 8080,
+		BytesSent: 200, BytesReceived: 100, SegmentsSent: 3, SegmentsReceived: 2,
+		SmoothedRTT: 3 * time.Millisecond, Start: start, LastSeen: start.Add(time.Second),
+	}
+}
+
+func TestCollect(t *testing.T) {
+	server, client := serverSocket(), clientSocket()
+	listener := tracer.Socket{ID: 3, PID: 1234, Command: "server", LocalAddr: localhost, LocalPort: 8080, Start: start}
+	closedServer := server
+	closedServer.BytesSent, closedServer.BytesReceived = 150, 300
+	closedServer.SegmentsSent, closedServer.SegmentsReceived = 3, 5
+	closedServer.LastSeen = start.Add(2 * time.Second)
+	closedServer.Closed = true
+	reused := serverSocket()
+	reused.Start = start.Add(time.Minute)
+
+	ft := &fakeTracer{batches: [][]tracer.Socket{
+		{server, client, listener},
+		{closedServer, client, listener},
+		{reused},
+	}}
+	r, metrics, logs := newTestReceiver(t, ft)
+	r.tracer = ft
+
+	r.collect(context.Background())
+	require.Len(t, metrics.AllMetrics(), 1)
+	md := metrics.AllMetrics()[0]
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	serverMetrics := findResource(t, md, 1234)
+	assertResource(t, serverMetrics.Resource(), "server", testContainerID)
+	assertSum(t, serverMetrics, "network.flow.io", "transmit", 100)
+	assertSum(t, serverMetrics, "network.flow.io", "receive", 200)
+	assertSum(t, serverMetrics, "network.flow.packets", "transmit", 2)
+	assertSum(t, serverMetrics, "network.flow.packets", "receive", 3)
+	assertSum(t, serverMetrics, "network.flow.connections", "", 1)
+	rtt := findMetric(t, serverMetrics, "network.flow.rtt").Gauge().DataPoints().At(0)
+	assert.Equal(t, 0.001, rtt.DoubleValue())
+	assertAttributes(t, rtt.Attributes(), "server")
+
+	clientMetrics := findResource(t, md, 5678)
+	assertResource(t, clientMetrics.Resource(), "curl", "")
+	assertSum(t, clientMetrics, "network.flow.io", "transmit", 200)
+	assertSum(t, clientMetrics, "network.flow.io", "receive", 100)
+	assertAttributes(t, findMetric(t, clientMetrics, "network.flow.rtt").Gauge().DataPoints().At(0).Attributes(), "client")
+	assert.Zero(t, logs.LogRecordCount())
+
+	// the client socket is idle, and the server socket is closed
+	r.collect(context.Background())
+	require.Len(t, metrics.AllMetrics(), 2)
+	md = metrics.AllMetrics()[1]
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	serverMetrics = findResource(t, md, 1234)
+	assertSum(t, serverMetrics, "network.flow.io", "transmit", 50)
+	assertSum(t, serverMetrics, "network.flow.io", "receive", 100)
+	assertSum(t, serverMetrics, "network.flow.packets", "transmit", 1)
+	assertSum(t, serverMetrics, "network.flow.packets", "receive", 2)
+	assertSum(t, serverMetrics, "network.flow.connections", "", 0)
+
+	require.Equal(t, 1, logs.LogRecordCount())
+	rl := logs.AllLogs()[0].ResourceLogs().At(0)
+	assertResource(t, rl.Resource(), "server", testContainerID)
+	lr := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.NewTimestampFromTime(closedServer.LastSeen), lr.Timestamp())
+	assert.Equal(t, map[string]any{
+		"event.name":                       "network.flow",
+		"source.address":                   "127.0.0.1",
+		"source.port":                      int64(50000),
+		"destination.address":              "127.0.0.1",
+		"destination.port":                 int64(8080),
+		"network.flow.role":                "server",
+		"network.flow.transmitted_bytes":   int64(150),
+		"network.flow.received_bytes":      int64(300),
+		"network.flow.transmitted_packets": int64(3),
+		"network.flow.received_packets":    int64(5),
+		"network.flow.rtt":                 0.001,
+		"network.flow.duration":            2.0,
+	}, lr.Attributes().AsRaw())
+
+	// the address of the closed socket is reused by a new connection
+	r.collect(context.Background())
+	require.Len(t, metrics.AllMetrics(), 3)
+	serverMetrics = findResource(t, metrics.AllMetrics()[2], 1234)
+	assertSum(t, serverMetrics, "network.flow.io", "transmit", 100)
+	assertSum(t, serverMetrics, "network.flow.connections", "", 1)
+	assert.Len(t, r.previous, 1)
+}
+
+func TestCollectWithoutLogs(t *testing.T) {
+	server := serverSocket()
+	server.Closed = true
+	ft := &fakeTracer{batches: [][]tracer.Socket{{server}}}
+	r, metrics, _ := newTestReceiver(t, ft)
+	r.nextLogs = nil
+	r.tracer = ft
+
+	r.collect(context.Background())
+	assert.Len(t, metrics.AllMetrics(), 1)
+	assert.Empty(t, r.previous)
+}
+
+func TestCollectError(t *testing.T) {
+	ft := &fakeTracer{err: errors.New("map error")}
+	r, metrics, logs := newTestReceiver(t, ft)
+	r.tracer = ft
+
+	r.collect(context.Background())
+	assert.Empty(t, metrics.AllMetrics())
+	assert.Empty(t, logs.AllLogs())
+}
+
+func TestReceiverLifecycle(t *testing.T) {
+	ft := &fakeTracer{batches: [][]tracer.Socket{{serverSocket(), clientSocket()}}}
+	r, metrics, _ := newTestReceiver(t, ft)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool {
+		return len(metrics.AllMetrics()) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.True(t, ft.closed)
+}
+
+func TestReceiverStartError(t *testing.T) {
+	r, _, _ := newTestReceiver(t, &fakeTracer{})
+	r.newTracer = func(int) (socketTracer, error) { return nil, errors.New("permission denied") }
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), "failed to load the eBPF programs: permission denied")
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
+func findResource(t *testing.T, md pmetric.Metrics, pid int64) pmetric.ResourceMetrics {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		if v, ok := rm.Resource().Attributes().Get("process.pid"); ok && v.Int() == pid {
+			return rm
+		}
+	}
+	require.Failf(t, "resource not found", "pid %d", pid)
+	return pmetric.ResourceMetrics{}
+}
+
+func findMetric(t *testing.T, rm pmetric.ResourceMetrics, name string) pmetric.Metric {
+	metrics := rm.ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i)
+		}
+	}
+	require.Failf(t, "metric not found", name)
+	return pmetric.Metric{}
+}
+
+// assertSum checks the value of the data point of a sum with the given direction, or of its single
+// data point when direction is empty.
+func assertSum(t *testing.T, rm pmetric.ResourceMetrics, name string, direction string, expected int64) {
+	sum := findMetric(t, rm, name).Sum()
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, sum.AggregationTemporality())
+	for i := 0; i < sum.DataPoints().Len(); i++ {
+		dp := sum.DataPoints().At(i)
+		if v, ok := dp.Attributes().Get("network.io.direction"); direction == "" || (ok && v.Str() == direction) {
+			assert.Equal(t, expected, dp.IntValue(), "%s %s", name, direction)
+			return
+		}
+	}
+	assert.Failf(t, "data point not found", "%s %s", name, direction)
+}
+
+func assertAttributes(t *testing.T, attrs pcommon.Map, role string) {
+	assert.Equal(t, map[string]any{
+		"source.address":      "127.0.0.1",
+		"destination.address": "127.0.0.1",
+		"destination.port":    int64(8080),
+		"network.flow.role":   role,
+	}, attrs.AsRaw())
+}
+
+func assertResource(t *testing.T, res pcommon.Resource, command string, containerID string) {
+	attrs := res.Attributes()
+	name, _ := attrs.Get("process.executable.name")
+	assert.Equal(t, command, name.Str())
+	id, ok := attrs.Get("container.id")
+	if containerID == "" {
+		assert.False(t, ok)
+		return
+	}
+	assert.Equal(t, containerID, id.Str())
+}
@@ -34,6 +34,7 @@ type elasticsearchClient interface {
 	IndexStats(ctx context.Context, indices []string) (*model.IndexStats, error)
 	ClusterMetadata(ctx context.Context) (*model.ClusterMetadataResponse, error)
 	ClusterStats(ctx context.Context, nodes []string) (*model.ClusterStats, error)
+	ILMExplain(ctx context.Context) (*model.ILMExplain, error)
 }
 
 // defaultElasticsearchClient is the main implementation of elasticsearchClient.
@@ -217,6 +218,19 @@ func (c defaultElasticsearchClient) ClusterStats(ctx context.Context, nodes []st
 	return &clusterStats, err
 }
 
+// ILMExplain retrieves the lifecycle state of all the indices managed by ILM.
+func (c defaultElasticsearchClient) ILMExplain(ctx context.Context) (*model.ILMExplain, error) {
+	body, err := c.doRequest(ctx, "*/_ilm/explain?only_managed=true")
+	if err != nil {
+		return nil, err
+	}
+
+	ilmExplain := model.ILMExplain{}
+	err = json.Unmarshal(body, &ilmExplain)
+
+	return &ilmExplain, err
+}
+
 func (c defaultElasticsearchClient) doRequest(ctx context.Context, path string) ([]byte, error) {
 	endpoint, err := c.endpoint.Parse(path)
 	if err != nil {
@@ -587,6 +587,30 @@ func TestClusterStatsBadAuthentication(t *testing.T) {
 	require.ErrorIs(t, err, errUnauthorized)
 }
 
+func TestILMExplain(t *testing.T) {
+	ilmJSON := readSamplePayload(t, "ilm_explain.json")
+
+	actualILMExplain := model.ILMExplain{}
+	require.NoError(t, json.Unmarshal(ilmJSON, &actualILMExplain))
+
+	elasticsearchMock := newMockServer(t)
+	defer elasticsearchMock.Close()
+
+	client, err := newElasticsearchClient(context.Background(), componenttest.NewNopTelemetrySettings(), Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: elasticsearchMock.URL,
+		},
+	}, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	ilmExplain, err := client.ILMExplain(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, &actualILMExplain, ilmExplain)
+	require.Equal(t, "ERROR", ilmExplain.Indices["logs-000003"].Step)
+	require.Equal(t, "check-rollover-ready", ilmExplain.Indices["logs-000003"].FailedStep)
+}
+
 type mockServer struct {
 	auth     func(username, password string) bool
 	metadata []byte
@@ -618,6 +642,7 @@ func newMockServer(t *testing.T, opts ...mockServerOption) *httptest.Server {
 			"/_all/_stats":       readSamplePayload(t, "indices.json"),
 			"/_cluster/health":   readSamplePayload(t, "health.json"),
 			"/_cluster/stats":    readSamplePayload(t, "cluster.json"),
+			"/*/_ilm/explain":    readSamplePayload(t, "ilm_explain.json"),
 		},
 	}
 	for _, opt := range opts {
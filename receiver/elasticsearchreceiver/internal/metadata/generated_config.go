@@ -33,6 +33,9 @@ type MetricsConfig struct {
 	ElasticsearchBreakerTripped                               MetricConfig `mapstructure:"elasticsearch.breaker.tripped"`
 	ElasticsearchClusterDataNodes                             MetricConfig `mapstructure:"elasticsearch.cluster.data_nodes"`
 	ElasticsearchClusterHealth                                MetricConfig `mapstructure:"elasticsearch.cluster.health"`
+	ElasticsearchClusterIlmIndices                            MetricConfig `mapstructure:"elasticsearch.cluster.ilm.indices"`
+	ElasticsearchClusterIlmIndicesFailed                      MetricConfig `mapstructure:"elasticsearch.cluster.ilm.indices.failed"`
+	ElasticsearchClusterIlmPhaseTransitions                   MetricConfig `mapstructure:"elasticsearch.cluster.ilm.phase.transitions"`
 	ElasticsearchClusterInFlightFetch                         MetricConfig `mapstructure:"elasticsearch.cluster.in_flight_fetch"`
 	ElasticsearchClusterIndicesCacheEvictions                 MetricConfig `mapstructure:"elasticsearch.cluster.indices.cache.evictions"`
 	ElasticsearchClusterNodes                                 MetricConfig `mapstructure:"elasticsearch.cluster.nodes"`
@@ -86,6 +89,10 @@ type MetricsConfig struct {
 	ElasticsearchNodePipelineIngestDocumentsCurrent           MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.documents.current"`
 	ElasticsearchNodePipelineIngestDocumentsPreprocessed      MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.documents.preprocessed"`
 	ElasticsearchNodePipelineIngestOperationsFailed           MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.operations.failed"`
+	ElasticsearchNodePipelineIngestProcessorDocuments         MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.processor.documents"`
+	ElasticsearchNodePipelineIngestProcessorOperationsFailed  MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.processor.operations.failed"`
+	ElasticsearchNodePipelineIngestProcessorTime              MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.processor.time"`
+	ElasticsearchNodePipelineIngestTime                       MetricConfig `mapstructure:"elasticsearch.node.pipeline.ingest.time"`
 	ElasticsearchNodeScriptCacheEvictions                     MetricConfig `mapstructure:"elasticsearch.node.script.cache_evictions"`
 	ElasticsearchNodeScriptCompilationLimitTriggered          MetricConfig `mapstructure:"elasticsearch.node.script.compilation_limit_triggered"`
 	ElasticsearchNodeScriptCompilations                       MetricConfig `mapstructure:"elasticsearch.node.script.compilations"`
@@ -138,6 +145,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		ElasticsearchClusterHealth: MetricConfig{
 			Enabled: true,
 		},
+		ElasticsearchClusterIlmIndices: MetricConfig{
+			Enabled: false,
+		},
+		ElasticsearchClusterIlmIndicesFailed: MetricConfig{
+			Enabled: false,
+		},
+		ElasticsearchClusterIlmPhaseTransitions: MetricConfig{
+			Enabled: false,
+		},
 		ElasticsearchClusterInFlightFetch: MetricConfig{
 			Enabled: true,
 		},
@@ -297,6 +313,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		ElasticsearchNodePipelineIngestOperationsFailed: MetricConfig{
 			Enabled: true,
 		},
+		ElasticsearchNodePipelineIngestProcessorDocuments: MetricConfig{
+			Enabled: false,
+		},
+		ElasticsearchNodePipelineIngestProcessorOperationsFailed: MetricConfig{
+			Enabled: false,
+		},
+		ElasticsearchNodePipelineIngestProcessorTime: MetricConfig{
+			Enabled: false,
+		},
+		ElasticsearchNodePipelineIngestTime: MetricConfig{
+			Enabled: false,
+		},
 		ElasticsearchNodeScriptCacheEvictions: MetricConfig{
 			Enabled: true,
 		},
@@ -30,6 +30,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ElasticsearchBreakerTripped:                               MetricConfig{Enabled: true},
 					ElasticsearchClusterDataNodes:                             MetricConfig{Enabled: true},
 					ElasticsearchClusterHealth:                                MetricConfig{Enabled: true},
+					ElasticsearchClusterIlmIndices:                            MetricConfig{Enabled: true},
+					ElasticsearchClusterIlmIndicesFailed:                      MetricConfig{Enabled: true},
+					ElasticsearchClusterIlmPhaseTransitions:                   MetricConfig{Enabled: true},
 					ElasticsearchClusterInFlightFetch:                         MetricConfig{Enabled: true},
 					ElasticsearchClusterIndicesCacheEvictions:                 MetricConfig{Enabled: true},
 					ElasticsearchClusterNodes:                                 MetricConfig{Enabled: true},
@@ -83,6 +86,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ElasticsearchNodePipelineIngestDocumentsCurrent:           MetricConfig{Enabled: true},
 					ElasticsearchNodePipelineIngestDocumentsPreprocessed:      MetricConfig{Enabled: true},
 					ElasticsearchNodePipelineIngestOperationsFailed:           MetricConfig{Enabled: true},
+					ElasticsearchNodePipelineIngestProcessorDocuments:         MetricConfig{Enabled: true},
+					ElasticsearchNodePipelineIngestProcessorOperationsFailed:  MetricConfig{Enabled: true},
+					ElasticsearchNodePipelineIngestProcessorTime:              MetricConfig{Enabled: true},
+					ElasticsearchNodePipelineIngestTime:                       MetricConfig{Enabled: true},
 					ElasticsearchNodeScriptCacheEvictions:                     MetricConfig{Enabled: true},
 					ElasticsearchNodeScriptCompilationLimitTriggered:          MetricConfig{Enabled: true},
 					ElasticsearchNodeScriptCompilations:                       MetricConfig{Enabled: true},
@@ -134,6 +141,9 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ElasticsearchBreakerTripped:                               MetricConfig{Enabled: false},
 					ElasticsearchClusterDataNodes:                             MetricConfig{Enabled: false},
 					ElasticsearchClusterHealth:                                MetricConfig{Enabled: false},
+					ElasticsearchClusterIlmIndices:                            MetricConfig{Enabled: false},
+					ElasticsearchClusterIlmIndicesFailed:                      MetricConfig{Enabled: false},
+					ElasticsearchClusterIlmPhaseTransitions:                   MetricConfig{Enabled: false},
 					ElasticsearchClusterInFlightFetch:                         MetricConfig{Enabled: false},
 					ElasticsearchClusterIndicesCacheEvictions:                 MetricConfig{Enabled: false},
 					ElasticsearchClusterNodes:                                 MetricConfig{Enabled: false},
@@ -187,6 +197,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ElasticsearchNodePipelineIngestDocumentsCurrent:           MetricConfig{Enabled: false},
 					ElasticsearchNodePipelineIngestDocumentsPreprocessed:      MetricConfig{Enabled: false},
 					ElasticsearchNodePipelineIngestOperationsFailed:           MetricConfig{Enabled: false},
+					ElasticsearchNodePipelineIngestProcessorDocuments:         MetricConfig{Enabled: false},
+					ElasticsearchNodePipelineIngestProcessorOperationsFailed:  MetricConfig{Enabled: false},
+					ElasticsearchNodePipelineIngestProcessorTime:              MetricConfig{Enabled: false},
+					ElasticsearchNodePipelineIngestTime:                       MetricConfig{Enabled: false},
 					ElasticsearchNodeScriptCacheEvictions:                     MetricConfig{Enabled: false},
 					ElasticsearchNodeScriptCompilationLimitTriggered:          MetricConfig{Enabled: false},
 					ElasticsearchNodeScriptCompilations:                       MetricConfig{Enabled: false},
@@ -799,6 +799,168 @@ func newMetricElasticsearchClusterHealth(cfg MetricConfig) metricElasticsearchCl
 	return m
 }
 
+type metricElasticsearchClusterIlmIndices struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.cluster.ilm.indices metric with initial data.
+func (m *metricElasticsearchClusterIlmIndices) init() {
+	m.data.SetName("elasticsearch.cluster.ilm.indices")
+	m.data.SetDescription("The number of indices managed by the lifecycle policy in the phase.")
+	m.data.SetUnit("{indices}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchClusterIlmIndices) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ilmPolicyAttributeValue string, ilmPhaseAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("policy", ilmPolicyAttributeValue)
+	dp.Attributes().PutStr("phase", ilmPhaseAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchClusterIlmIndices) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchClusterIlmIndices) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchClusterIlmIndices(cfg MetricConfig) metricElasticsearchClusterIlmIndices {
+	m := metricElasticsearchClusterIlmIndices{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchClusterIlmIndicesFailed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.cluster.ilm.indices.failed metric with initial data.
+func (m *metricElasticsearchClusterIlmIndicesFailed) init() {
+	m.data.SetName("elasticsearch.cluster.ilm.indices.failed")
+	m.data.SetDescription("The number of indices managed by the lifecycle policy which failed a step of the phase.")
+	m.data.SetUnit("{indices}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchClusterIlmIndicesFailed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ilmPolicyAttributeValue string, ilmPhaseAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("policy", ilmPolicyAttributeValue)
+	dp.Attributes().PutStr("phase", ilmPhaseAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchClusterIlmIndicesFailed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchClusterIlmIndicesFailed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchClusterIlmIndicesFailed(cfg MetricConfig) metricElasticsearchClusterIlmIndicesFailed {
+	m := metricElasticsearchClusterIlmIndicesFailed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchClusterIlmPhaseTransitions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.cluster.ilm.phase.transitions metric with initial data.
+func (m *metricElasticsearchClusterIlmPhaseTransitions) init() {
+	m.data.SetName("elasticsearch.cluster.ilm.phase.transitions")
+	m.data.SetDescription("The number of indices observed by the receiver moving into the phase of the lifecycle policy.")
+	m.data.SetUnit("{transitions}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchClusterIlmPhaseTransitions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ilmPolicyAttributeValue string, ilmPhaseAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("policy", ilmPolicyAttributeValue)
+	dp.Attributes().PutStr("phase", ilmPhaseAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchClusterIlmPhaseTransitions) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchClusterIlmPhaseTransitions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchClusterIlmPhaseTransitions(cfg MetricConfig) metricElasticsearchClusterIlmPhaseTransitions {
+	m := metricElasticsearchClusterIlmPhaseTransitions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricElasticsearchClusterInFlightFetch struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3573,6 +3735,224 @@ func newMetricElasticsearchNodePipelineIngestOperationsFailed(cfg MetricConfig)
 	return m
 }
 
+type metricElasticsearchNodePipelineIngestProcessorDocuments struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.pipeline.ingest.processor.documents metric with initial data.
+func (m *metricElasticsearchNodePipelineIngestProcessorDocuments) init() {
+	m.data.SetName("elasticsearch.node.pipeline.ingest.processor.documents")
+	m.data.SetDescription("Number of documents processed by the ingest processor.")
+	m.data.SetUnit("{documents}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchNodePipelineIngestProcessorDocuments) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string, ingestProcessorNameAttributeValue string, ingestProcessorTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", ingestPipelineNameAttributeValue)
+	dp.Attributes().PutStr("processor.name", ingestProcessorNameAttributeValue)
+	dp.Attributes().PutStr("processor.type", ingestProcessorTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodePipelineIngestProcessorDocuments) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodePipelineIngestProcessorDocuments) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodePipelineIngestProcessorDocuments(cfg MetricConfig) metricElasticsearchNodePipelineIngestProcessorDocuments {
+	m := metricElasticsearchNodePipelineIngestProcessorDocuments{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchNodePipelineIngestProcessorOperationsFailed struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.pipeline.ingest.processor.operations.failed metric with initial data.
+func (m *metricElasticsearchNodePipelineIngestProcessorOperationsFailed) init() {
+	m.data.SetName("elasticsearch.node.pipeline.ingest.processor.operations.failed")
+	m.data.SetDescription("Total number of failed operations for the ingest processor.")
+	m.data.SetUnit("{operation}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchNodePipelineIngestProcessorOperationsFailed) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string, ingestProcessorNameAttributeValue string, ingestProcessorTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", ingestPipelineNameAttributeValue)
+	dp.Attributes().PutStr("processor.name", ingestProcessorNameAttributeValue)
+	dp.Attributes().PutStr("processor.type", ingestProcessorTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodePipelineIngestProcessorOperationsFailed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodePipelineIngestProcessorOperationsFailed) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodePipelineIngestProcessorOperationsFailed(cfg MetricConfig) metricElasticsearchNodePipelineIngestProcessorOperationsFailed {
+	m := metricElasticsearchNodePipelineIngestProcessorOperationsFailed{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchNodePipelineIngestProcessorTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.pipeline.ingest.processor.time metric with initial data.
+func (m *metricElasticsearchNodePipelineIngestProcessorTime) init() {
+	m.data.SetName("elasticsearch.node.pipeline.ingest.processor.time")
+	m.data.SetDescription("Total time spent processing documents in the ingest processor.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchNodePipelineIngestProcessorTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string, ingestProcessorNameAttributeValue string, ingestProcessorTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", ingestPipelineNameAttributeValue)
+	dp.Attributes().PutStr("processor.name", ingestProcessorNameAttributeValue)
+	dp.Attributes().PutStr("processor.type", ingestProcessorTypeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodePipelineIngestProcessorTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodePipelineIngestProcessorTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodePipelineIngestProcessorTime(cfg MetricConfig) metricElasticsearchNodePipelineIngestProcessorTime {
+	m := metricElasticsearchNodePipelineIngestProcessorTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricElasticsearchNodePipelineIngestTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills elasticsearch.node.pipeline.ingest.time metric with initial data.
+func (m *metricElasticsearchNodePipelineIngestTime) init() {
+	m.data.SetName("elasticsearch.node.pipeline.ingest.time")
+	m.data.SetDescription("Total time spent processing documents in the ingest pipeline.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricElasticsearchNodePipelineIngestTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", ingestPipelineNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricElasticsearchNodePipelineIngestTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricElasticsearchNodePipelineIngestTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricElasticsearchNodePipelineIngestTime(cfg MetricConfig) metricElasticsearchNodePipelineIngestTime {
+	m := metricElasticsearchNodePipelineIngestTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricElasticsearchNodeScriptCacheEvictions struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -5259,6 +5639,9 @@ type MetricsBuilder struct {
 	metricElasticsearchBreakerTripped                               metricElasticsearchBreakerTripped
 	metricElasticsearchClusterDataNodes                             metricElasticsearchClusterDataNodes
 	metricElasticsearchClusterHealth                                metricElasticsearchClusterHealth
+	metricElasticsearchClusterIlmIndices                            metricElasticsearchClusterIlmIndices
+	metricElasticsearchClusterIlmIndicesFailed                      metricElasticsearchClusterIlmIndicesFailed
+	metricElasticsearchClusterIlmPhaseTransitions                   metricElasticsearchClusterIlmPhaseTransitions
 	metricElasticsearchClusterInFlightFetch                         metricElasticsearchClusterInFlightFetch
 	metricElasticsearchClusterIndicesCacheEvictions                 metricElasticsearchClusterIndicesCacheEvictions
 	metricElasticsearchClusterNodes                                 metricElasticsearchClusterNodes
@@ -5312,6 +5695,10 @@ type MetricsBuilder struct {
 	metricElasticsearchNodePipelineIngestDocumentsCurrent           metricElasticsearchNodePipelineIngestDocumentsCurrent
 	metricElasticsearchNodePipelineIngestDocumentsPreprocessed      metricElasticsearchNodePipelineIngestDocumentsPreprocessed
 	metricElasticsearchNodePipelineIngestOperationsFailed           metricElasticsearchNodePipelineIngestOperationsFailed
+	metricElasticsearchNodePipelineIngestProcessorDocuments         metricElasticsearchNodePipelineIngestProcessorDocuments
+	metricElasticsearchNodePipelineIngestProcessorOperationsFailed  metricElasticsearchNodePipelineIngestProcessorOperationsFailed
+	metricElasticsearchNodePipelineIngestProcessorTime              metricElasticsearchNodePipelineIngestProcessorTime
+	metricElasticsearchNodePipelineIngestTime                       metricElasticsearchNodePipelineIngestTime
 	metricElasticsearchNodeScriptCacheEvictions                     metricElasticsearchNodeScriptCacheEvictions
 	metricElasticsearchNodeScriptCompilationLimitTriggered          metricElasticsearchNodeScriptCompilationLimitTriggered
 	metricElasticsearchNodeScriptCompilations                       metricElasticsearchNodeScriptCompilations
@@ -5368,6 +5755,9 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricElasticsearchBreakerTripped:                               newMetricElasticsearchBreakerTripped(mbc.Metrics.ElasticsearchBreakerTripped),
 		metricElasticsearchClusterDataNodes:                             newMetricElasticsearchClusterDataNodes(mbc.Metrics.ElasticsearchClusterDataNodes),
 		metricElasticsearchClusterHealth:                                newMetricElasticsearchClusterHealth(mbc.Metrics.ElasticsearchClusterHealth),
+		metricElasticsearchClusterIlmIndices:                            newMetricElasticsearchClusterIlmIndices(mbc.Metrics.ElasticsearchClusterIlmIndices),
+		metricElasticsearchClusterIlmIndicesFailed:                      newMetricElasticsearchClusterIlmIndicesFailed(mbc.Metrics.ElasticsearchClusterIlmIndicesFailed),
+		metricElasticsearchClusterIlmPhaseTransitions:                   newMetricElasticsearchClusterIlmPhaseTransitions(mbc.Metrics.ElasticsearchClusterIlmPhaseTransitions),
 		metricElasticsearchClusterInFlightFetch:                         newMetricElasticsearchClusterInFlightFetch(mbc.Metrics.ElasticsearchClusterInFlightFetch),
 		metricElasticsearchClusterIndicesCacheEvictions:                 newMetricElasticsearchClusterIndicesCacheEvictions(mbc.Metrics.ElasticsearchClusterIndicesCacheEvictions),
 		metricElasticsearchClusterNodes:                                 newMetricElasticsearchClusterNodes(mbc.Metrics.ElasticsearchClusterNodes),
@@ -5421,6 +5811,10 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricElasticsearchNodePipelineIngestDocumentsCurrent:           newMetricElasticsearchNodePipelineIngestDocumentsCurrent(mbc.Metrics.ElasticsearchNodePipelineIngestDocumentsCurrent),
 		metricElasticsearchNodePipelineIngestDocumentsPreprocessed:      newMetricElasticsearchNodePipelineIngestDocumentsPreprocessed(mbc.Metrics.ElasticsearchNodePipelineIngestDocumentsPreprocessed),
 		metricElasticsearchNodePipelineIngestOperationsFailed:           newMetricElasticsearchNodePipelineIngestOperationsFailed(mbc.Metrics.ElasticsearchNodePipelineIngestOperationsFailed),
+		metricElasticsearchNodePipelineIngestProcessorDocuments:         newMetricElasticsearchNodePipelineIngestProcessorDocuments(mbc.Metrics.ElasticsearchNodePipelineIngestProcessorDocuments),
+		metricElasticsearchNodePipelineIngestProcessorOperationsFailed:  newMetricElasticsearchNodePipelineIngestProcessorOperationsFailed(mbc.Metrics.ElasticsearchNodePipelineIngestProcessorOperationsFailed),
+		metricElasticsearchNodePipelineIngestProcessorTime:              newMetricElasticsearchNodePipelineIngestProcessorTime(mbc.Metrics.ElasticsearchNodePipelineIngestProcessorTime),
+		metricElasticsearchNodePipelineIngestTime:                       newMetricElasticsearchNodePipelineIngestTime(mbc.Metrics.ElasticsearchNodePipelineIngestTime),
 		metricElasticsearchNodeScriptCacheEvictions:                     newMetricElasticsearchNodeScriptCacheEvictions(mbc.Metrics.ElasticsearchNodeScriptCacheEvictions),
 		metricElasticsearchNodeScriptCompilationLimitTriggered:          newMetricElasticsearchNodeScriptCompilationLimitTriggered(mbc.Metrics.ElasticsearchNodeScriptCompilationLimitTriggered),
 		metricElasticsearchNodeScriptCompilations:                       newMetricElasticsearchNodeScriptCompilations(mbc.Metrics.ElasticsearchNodeScriptCompilations),
@@ -5547,6 +5941,9 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricElasticsearchBreakerTripped.emit(ils.Metrics())
 	mb.metricElasticsearchClusterDataNodes.emit(ils.Metrics())
 	mb.metricElasticsearchClusterHealth.emit(ils.Metrics())
+	mb.metricElasticsearchClusterIlmIndices.emit(ils.Metrics())
+	mb.metricElasticsearchClusterIlmIndicesFailed.emit(ils.Metrics())
+	mb.metricElasticsearchClusterIlmPhaseTransitions.emit(ils.Metrics())
 	mb.metricElasticsearchClusterInFlightFetch.emit(ils.Metrics())
 	mb.metricElasticsearchClusterIndicesCacheEvictions.emit(ils.Metrics())
 	mb.metricElasticsearchClusterNodes.emit(ils.Metrics())
@@ -5600,6 +5997,10 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricElasticsearchNodePipelineIngestDocumentsCurrent.emit(ils.Metrics())
 	mb.metricElasticsearchNodePipelineIngestDocumentsPreprocessed.emit(ils.Metrics())
 	mb.metricElasticsearchNodePipelineIngestOperationsFailed.emit(ils.Metrics())
+	mb.metricElasticsearchNodePipelineIngestProcessorDocuments.emit(ils.Metrics())
+	mb.metricElasticsearchNodePipelineIngestProcessorOperationsFailed.emit(ils.Metrics())
+	mb.metricElasticsearchNodePipelineIngestProcessorTime.emit(ils.Metrics())
+	mb.metricElasticsearchNodePipelineIngestTime.emit(ils.Metrics())
 	mb.metricElasticsearchNodeScriptCacheEvictions.emit(ils.Metrics())
 	mb.metricElasticsearchNodeScriptCompilationLimitTriggered.emit(ils.Metrics())
 	mb.metricElasticsearchNodeScriptCompilations.emit(ils.Metrics())
@@ -5689,6 +6090,21 @@ func (mb *MetricsBuilder) RecordElasticsearchClusterHealthDataPoint(ts pcommon.T
 	mb.metricElasticsearchClusterHealth.recordDataPoint(mb.startTime, ts, val, healthStatusAttributeValue.String())
 }
 
+// RecordElasticsearchClusterIlmIndicesDataPoint adds a data point to elasticsearch.cluster.ilm.indices metric.
+func (mb *MetricsBuilder) RecordElasticsearchClusterIlmIndicesDataPoint(ts pcommon.Timestamp, val int64, ilmPolicyAttributeValue string, ilmPhaseAttributeValue string) {
+	mb.metricElasticsearchClusterIlmIndices.recordDataPoint(mb.startTime, ts, val, ilmPolicyAttributeValue, ilmPhaseAttributeValue)
+}
+
+// RecordElasticsearchClusterIlmIndicesFailedDataPoint adds a data point to elasticsearch.cluster.ilm.indices.failed metric.
+func (mb *MetricsBuilder) RecordElasticsearchClusterIlmIndicesFailedDataPoint(ts pcommon.Timestamp, val int64, ilmPolicyAttributeValue string, ilmPhaseAttributeValue string) {
+	mb.metricElasticsearchClusterIlmIndicesFailed.recordDataPoint(mb.startTime, ts, val, ilmPolicyAttributeValue, ilmPhaseAttributeValue)
+}
+
+// RecordElasticsearchClusterIlmPhaseTransitionsDataPoint adds a data point to elasticsearch.cluster.ilm.phase.transitions metric.
+func (mb *MetricsBuilder) RecordElasticsearchClusterIlmPhaseTransitionsDataPoint(ts pcommon.Timestamp, val int64, ilmPolicyAttributeValue string, ilmPhaseAttributeValue string) {
+	mb.metricElasticsearchClusterIlmPhaseTransitions.recordDataPoint(mb.startTime, ts, val, ilmPolicyAttributeValue, ilmPhaseAttributeValue)
+}
+
 // RecordElasticsearchClusterInFlightFetchDataPoint adds a data point to elasticsearch.cluster.in_flight_fetch metric.
 func (mb *MetricsBuilder) RecordElasticsearchClusterInFlightFetchDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricElasticsearchClusterInFlightFetch.recordDataPoint(mb.startTime, ts, val)
@@ -5954,6 +6370,26 @@ func (mb *MetricsBuilder) RecordElasticsearchNodePipelineIngestOperationsFailedD
 	mb.metricElasticsearchNodePipelineIngestOperationsFailed.recordDataPoint(mb.startTime, ts, val, ingestPipelineNameAttributeValue)
 }
 
+// RecordElasticsearchNodePipelineIngestProcessorDocumentsDataPoint adds a data point to elasticsearch.node.pipeline.ingest.processor.documents metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodePipelineIngestProcessorDocumentsDataPoint(ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string, ingestProcessorNameAttributeValue string, ingestProcessorTypeAttributeValue string) {
+	mb.metricElasticsearchNodePipelineIngestProcessorDocuments.recordDataPoint(mb.startTime, ts, val, ingestPipelineNameAttributeValue, ingestProcessorNameAttributeValue, ingestProcessorTypeAttributeValue)
+}
+
+// RecordElasticsearchNodePipelineIngestProcessorOperationsFailedDataPoint adds a data point to elasticsearch.node.pipeline.ingest.processor.operations.failed metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodePipelineIngestProcessorOperationsFailedDataPoint(ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string, ingestProcessorNameAttributeValue string, ingestProcessorTypeAttributeValue string) {
+	mb.metricElasticsearchNodePipelineIngestProcessorOperationsFailed.recordDataPoint(mb.startTime, ts, val, ingestPipelineNameAttributeValue, ingestProcessorNameAttributeValue, ingestProcessorTypeAttributeValue)
+}
+
+// RecordElasticsearchNodePipelineIngestProcessorTimeDataPoint adds a data point to elasticsearch.node.pipeline.ingest.processor.time metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodePipelineIngestProcessorTimeDataPoint(ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string, ingestProcessorNameAttributeValue string, ingestProcessorTypeAttributeValue string) {
+	mb.metricElasticsearchNodePipelineIngestProcessorTime.recordDataPoint(mb.startTime, ts, val, ingestPipelineNameAttributeValue, ingestProcessorNameAttributeValue, ingestProcessorTypeAttributeValue)
+}
+
+// RecordElasticsearchNodePipelineIngestTimeDataPoint adds a data point to elasticsearch.node.pipeline.ingest.time metric.
+func (mb *MetricsBuilder) RecordElasticsearchNodePipelineIngestTimeDataPoint(ts pcommon.Timestamp, val int64, ingestPipelineNameAttributeValue string) {
+	mb.metricElasticsearchNodePipelineIngestTime.recordDataPoint(mb.startTime, ts, val, ingestPipelineNameAttributeValue)
+}
+
 // RecordElasticsearchNodeScriptCacheEvictionsDataPoint adds a data point to elasticsearch.node.script.cache_evictions metric.
 func (mb *MetricsBuilder) RecordElasticsearchNodeScriptCacheEvictionsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricElasticsearchNodeScriptCacheEvictions.recordDataPoint(mb.startTime, ts, val)
@@ -88,6 +88,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordElasticsearchClusterHealthDataPoint(ts, 1, AttributeHealthStatusGreen)
 
+			allMetricsCount++
+			mb.RecordElasticsearchClusterIlmIndicesDataPoint(ts, 1, "ilm_policy-val", "ilm_phase-val")
+
+			allMetricsCount++
+			mb.RecordElasticsearchClusterIlmIndicesFailedDataPoint(ts, 1, "ilm_policy-val", "ilm_phase-val")
+
+			allMetricsCount++
+			mb.RecordElasticsearchClusterIlmPhaseTransitionsDataPoint(ts, 1, "ilm_policy-val", "ilm_phase-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordElasticsearchClusterInFlightFetchDataPoint(ts, 1)
@@ -284,6 +293,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordElasticsearchNodePipelineIngestOperationsFailedDataPoint(ts, 1, "ingest_pipeline_name-val")
 
+			allMetricsCount++
+			mb.RecordElasticsearchNodePipelineIngestProcessorDocumentsDataPoint(ts, 1, "ingest_pipeline_name-val", "ingest_processor_name-val", "ingest_processor_type-val")
+
+			allMetricsCount++
+			mb.RecordElasticsearchNodePipelineIngestProcessorOperationsFailedDataPoint(ts, 1, "ingest_pipeline_name-val", "ingest_processor_name-val", "ingest_processor_type-val")
+
+			allMetricsCount++
+			mb.RecordElasticsearchNodePipelineIngestProcessorTimeDataPoint(ts, 1, "ingest_pipeline_name-val", "ingest_processor_name-val", "ingest_processor_type-val")
+
+			allMetricsCount++
+			mb.RecordElasticsearchNodePipelineIngestTimeDataPoint(ts, 1, "ingest_pipeline_name-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordElasticsearchNodeScriptCacheEvictionsDataPoint(ts, 1)
@@ -518,6 +539,66 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("status")
 					assert.True(t, ok)
 					assert.EqualValues(t, "green", attrVal.Str())
+				case "elasticsearch.cluster.ilm.indices":
+					assert.False(t, validatedMetrics["elasticsearch.cluster.ilm.indices"], "Found a duplicate in the metrics slice: elasticsearch.cluster.ilm.indices")
+					validatedMetrics["elasticsearch.cluster.ilm.indices"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of indices managed by the lifecycle policy in the phase.", ms.At(i).Description())
+					assert.Equal(t, "{indices}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("policy")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ilm_policy-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("phase")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ilm_phase-val", attrVal.Str())
+				case "elasticsearch.cluster.ilm.indices.failed":
+					assert.False(t, validatedMetrics["elasticsearch.cluster.ilm.indices.failed"], "Found a duplicate in the metrics slice: elasticsearch.cluster.ilm.indices.failed")
+					validatedMetrics["elasticsearch.cluster.ilm.indices.failed"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of indices managed by the lifecycle policy which failed a step of the phase.", ms.At(i).Description())
+					assert.Equal(t, "{indices}", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("policy")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ilm_policy-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("phase")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ilm_phase-val", attrVal.Str())
+				case "elasticsearch.cluster.ilm.phase.transitions":
+					assert.False(t, validatedMetrics["elasticsearch.cluster.ilm.phase.transitions"], "Found a duplicate in the metrics slice: elasticsearch.cluster.ilm.phase.transitions")
+					validatedMetrics["elasticsearch.cluster.ilm.phase.transitions"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of indices observed by the receiver moving into the phase of the lifecycle policy.", ms.At(i).Description())
+					assert.Equal(t, "{transitions}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("policy")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ilm_policy-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("phase")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ilm_phase-val", attrVal.Str())
 				case "elasticsearch.cluster.in_flight_fetch":
 					assert.False(t, validatedMetrics["elasticsearch.cluster.in_flight_fetch"], "Found a duplicate in the metrics slice: elasticsearch.cluster.in_flight_fetch")
 					validatedMetrics["elasticsearch.cluster.in_flight_fetch"] = true
@@ -1379,6 +1460,92 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("name")
 					assert.True(t, ok)
 					assert.EqualValues(t, "ingest_pipeline_name-val", attrVal.Str())
+				case "elasticsearch.node.pipeline.ingest.processor.documents":
+					assert.False(t, validatedMetrics["elasticsearch.node.pipeline.ingest.processor.documents"], "Found a duplicate in the metrics slice: elasticsearch.node.pipeline.ingest.processor.documents")
+					validatedMetrics["elasticsearch.node.pipeline.ingest.processor.documents"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of documents processed by the ingest processor.", ms.At(i).Description())
+					assert.Equal(t, "{documents}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_pipeline_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("processor.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_processor_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("processor.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_processor_type-val", attrVal.Str())
+				case "elasticsearch.node.pipeline.ingest.processor.operations.failed":
+					assert.False(t, validatedMetrics["elasticsearch.node.pipeline.ingest.processor.operations.failed"], "Found a duplicate in the metrics slice: elasticsearch.node.pipeline.ingest.processor.operations.failed")
+					validatedMetrics["elasticsearch.node.pipeline.ingest.processor.operations.failed"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total number of failed operations for the ingest processor.", ms.At(i).Description())
+					assert.Equal(t, "{operation}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_pipeline_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("processor.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_processor_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("processor.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_processor_type-val", attrVal.Str())
+				case "elasticsearch.node.pipeline.ingest.processor.time":
+					assert.False(t, validatedMetrics["elasticsearch.node.pipeline.ingest.processor.time"], "Found a duplicate in the metrics slice: elasticsearch.node.pipeline.ingest.processor.time")
+					validatedMetrics["elasticsearch.node.pipeline.ingest.processor.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total time spent processing documents in the ingest processor.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_pipeline_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("processor.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_processor_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("processor.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_processor_type-val", attrVal.Str())
+				case "elasticsearch.node.pipeline.ingest.time":
+					assert.False(t, validatedMetrics["elasticsearch.node.pipeline.ingest.time"], "Found a duplicate in the metrics slice: elasticsearch.node.pipeline.ingest.time")
+					validatedMetrics["elasticsearch.node.pipeline.ingest.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total time spent processing documents in the ingest pipeline.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "ingest_pipeline_name-val", attrVal.Str())
 				case "elasticsearch.node.script.cache_evictions":
 					assert.False(t, validatedMetrics["elasticsearch.node.script.cache_evictions"], "Found a duplicate in the metrics slice: elasticsearch.node.script.cache_evictions")
 					validatedMetrics["elasticsearch.node.script.cache_evictions"] = true
@@ -84,6 +84,29 @@ func (_m *MockElasticsearchClient) ClusterStats(ctx context.Context, nodes []str
 	return r0, r1
 }
 
+// ILMExplain provides a mock function with given fields: ctx
+func (_m *MockElasticsearchClient) ILMExplain(ctx context.Context) (*model.ILMExplain, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *model.ILMExplain
+	if rf, ok := ret.Get(0).(func(context.Context) *model.ILMExplain); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ILMExplain)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IndexStats provides a mock function with given fields: ctx, indices
 func (_m *MockElasticsearchClient) IndexStats(ctx context.Context, indices []string) (*model.IndexStats, error) {
 	ret := _m.Called(ctx, indices)
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/elasticsearchreceiver/internal/model"
+
+// ILMExplain represents a response from elasticsearch's /<index>/_ilm/explain endpoint.
+// The struct is not exhaustive; It does not provide all values returned by elasticsearch,
+// only the ones relevant to the metrics retrieved by the scraper.
+type ILMExplain struct {
+	Indices map[string]ILMIndexExplain `json:"indices"`
+}
+
+type ILMIndexExplain struct {
+	Index      string `json:"index"`
+	Managed    bool   `json:"managed"`
+	Policy     string `json:"policy"`
+	Phase      string `json:"phase"`
+	Action     string `json:"action"`
+	Step       string `json:"step"`
+	FailedStep string `json:"failed_step"`
+}
@@ -49,6 +49,14 @@ type IngestTotalStats struct {
 
 type IngestPipelineTotalStats struct {
 	IngestTotalStats
+	// Processors holds the stats of every processor of the pipeline, in order. Each entry has a single key,
+	// the type of the processor followed by its tag if it has one, e.g. "set:my_tag".
+	Processors []map[string]IngestProcessorStats `json:"processors"`
+}
+
+type IngestProcessorStats struct {
+	Type  string           `json:"type"`
+	Stats IngestTotalStats `json:"stats"`
 }
 
 type Discovery struct {
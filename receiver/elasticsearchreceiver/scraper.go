@@ -40,6 +40,16 @@ type elasticsearchScraper struct {
 	mb          *metadata.MetricsBuilder
 	version     *version.Version
 	clusterName string
+
+	// ilmPhases holds the lifecycle phase of every managed index at the previous scrape.
+	ilmPhases map[string]ilmPhase
+	// ilmTransitions counts the indices observed moving into every phase since the start of the receiver.
+	ilmTransitions map[ilmPhase]int64
+}
+
+type ilmPhase struct {
+	policy string
+	phase  string
 }
 
 func newElasticSearchScraper(
@@ -50,6 +60,9 @@ func newElasticSearchScraper(
 		settings: settings.TelemetrySettings,
 		cfg:      cfg,
 		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+
+		ilmPhases:      map[string]ilmPhase{},
+		ilmTransitions: map[ilmPhase]int64{},
 	}
 }
 
@@ -294,6 +307,8 @@ func (r *elasticsearchScraper) scrapeNodeMetrics(ctx context.Context, now pcommo
 			r.mb.RecordElasticsearchNodePipelineIngestDocumentsPreprocessedDataPoint(now, ipInfo.Count, ipName)
 			r.mb.RecordElasticsearchNodePipelineIngestOperationsFailedDataPoint(now, ipInfo.Failed, ipName)
 			r.mb.RecordElasticsearchNodePipelineIngestDocumentsCurrentDataPoint(now, ipInfo.Current, ipName)
+			r.mb.RecordElasticsearchNodePipelineIngestTimeDataPoint(now, ipInfo.TimeInMillis, ipName)
+			r.scrapeIngestProcessorMetrics(now, ipName, ipInfo.Processors)
 		}
 
 		r.mb.RecordElasticsearchNodeScriptCacheEvictionsDataPoint(now, info.Script.CacheEvictions)
@@ -325,6 +340,37 @@ func (r *elasticsearchScraper) scrapeNodeMetrics(ctx context.Context, now pcommo
 	}
 }
 
+// scrapeIngestProcessorMetrics records the metrics of the processors of an ingest pipeline. The stats of
+// processors with the same name and type, i.e. processors of the same type without a tag, are summed.
+func (r *elasticsearchScraper) scrapeIngestProcessorMetrics(now pcommon.Timestamp, pipeline string, processors []map[string]model.IngestProcessorStats) {
+	type processorKey struct {
+		name          string
+		processorType string
+	}
+	var keys []processorKey
+	stats := map[processorKey]model.IngestTotalStats{}
+	for _, processor := range processors {
+		for name, processorStats := range processor {
+			key := processorKey{name: name, processorType: processorStats.Type}
+			total, ok := stats[key]
+			if !ok {
+				keys = append(keys, key)
+			}
+			total.Count += processorStats.Stats.Count
+			total.TimeInMillis += processorStats.Stats.TimeInMillis
+			total.Failed += processorStats.Stats.Failed
+			stats[key] = total
+		}
+	}
+
+	for _, key := range keys {
+		total := stats[key]
+		r.mb.RecordElasticsearchNodePipelineIngestProcessorDocumentsDataPoint(now, total.Count, pipeline, key.name, key.processorType)
+		r.mb.RecordElasticsearchNodePipelineIngestProcessorOperationsFailedDataPoint(now, total.Failed, pipeline, key.name, key.processorType)
+		r.mb.RecordElasticsearchNodePipelineIngestProcessorTimeDataPoint(now, total.TimeInMillis, pipeline, key.name, key.processorType)
+	}
+}
+
 func (r *elasticsearchScraper) scrapeClusterMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	if r.cfg.SkipClusterMetrics {
 		return
@@ -332,6 +378,7 @@ func (r *elasticsearchScraper) scrapeClusterMetrics(ctx context.Context, now pco
 
 	r.scrapeClusterHealthMetrics(ctx, now, errs)
 	r.scrapeClusterStatsMetrics(ctx, now, errs)
+	r.scrapeILMMetrics(ctx, now, errs)
 
 	rb := r.mb.NewResourceBuilder()
 	rb.SetElasticsearchClusterName(r.clusterName)
@@ -398,6 +445,53 @@ func (r *elasticsearchScraper) scrapeClusterHealthMetrics(ctx context.Context, n
 	}
 }
 
+// scrapeILMMetrics records the number of managed indices per lifecycle policy and phase. The phase transitions are
+// counted by comparing the phase of every index with the one at the previous scrape, as Elasticsearch doesn't count them.
+func (r *elasticsearchScraper) scrapeILMMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
+	if !r.cfg.Metrics.ElasticsearchClusterIlmIndices.Enabled &&
+		!r.cfg.Metrics.ElasticsearchClusterIlmIndicesFailed.Enabled &&
+		!r.cfg.Metrics.ElasticsearchClusterIlmPhaseTransitions.Enabled {
+		return
+	}
+
+	ilmExplain, err := r.client.ILMExplain(ctx)
+	if err != nil {
+		errs.AddPartial(3, err)
+		return
+	}
+
+	indices := map[ilmPhase]int64{}
+	failedIndices := map[ilmPhase]int64{}
+	phases := make(map[string]ilmPhase, len(ilmExplain.Indices))
+	for name, index := range ilmExplain.Indices {
+		if !index.Managed {
+			continue
+		}
+		phase := ilmPhase{policy: index.Policy, phase: index.Phase}
+		indices[phase]++
+		if index.Step == "ERROR" {
+			failedIndices[phase]++
+		}
+
+		if _, ok := r.ilmTransitions[phase]; !ok {
+			r.ilmTransitions[phase] = 0
+		}
+		if previous, ok := r.ilmPhases[name]; ok && previous != phase {
+			r.ilmTransitions[phase]++
+		}
+		phases[name] = phase
+	}
+	r.ilmPhases = phases
+
+	for phase, count := range indices {
+		r.mb.RecordElasticsearchClusterIlmIndicesDataPoint(now, count, phase.policy, phase.phase)
+		r.mb.RecordElasticsearchClusterIlmIndicesFailedDataPoint(now, failedIndices[phase], phase.policy, phase.phase)
+	}
+	for phase, count := range r.ilmTransitions {
+		r.mb.RecordElasticsearchClusterIlmPhaseTransitionsDataPoint(now, count, phase.policy, phase.phase)
+	}
+}
+
 func (r *elasticsearchScraper) scrapeIndicesMetrics(ctx context.Context, now pcommon.Timestamp, errs *scrapererror.ScrapeErrors) {
 	if len(r.cfg.Indices) == 0 {
 		return
@@ -60,6 +60,15 @@ func TestScraper(t *testing.T) {
 	config.Metrics.ElasticsearchProcessCPUTime.Enabled = true
 	config.Metrics.ElasticsearchProcessMemoryVirtual.Enabled = true
 
+	config.Metrics.ElasticsearchNodePipelineIngestTime.Enabled = true
+	config.Metrics.ElasticsearchNodePipelineIngestProcessorDocuments.Enabled = true
+	config.Metrics.ElasticsearchNodePipelineIngestProcessorOperationsFailed.Enabled = true
+	config.Metrics.ElasticsearchNodePipelineIngestProcessorTime.Enabled = true
+
+	config.Metrics.ElasticsearchClusterIlmIndices.Enabled = true
+	config.Metrics.ElasticsearchClusterIlmIndicesFailed.Enabled = true
+	config.Metrics.ElasticsearchClusterIlmPhaseTransitions.Enabled = true
+
 	sc := newElasticSearchScraper(receivertest.NewNopCreateSettings(), config)
 
 	err := sc.start(context.Background(), componenttest.NewNopHost())
@@ -72,6 +81,7 @@ func TestScraper(t *testing.T) {
 	mockClient.On("Nodes", mock.Anything, []string{"_all"}).Return(nodes(t), nil)
 	mockClient.On("NodeStats", mock.Anything, []string{"_all"}).Return(nodeStatsLinux(t), nil)
 	mockClient.On("IndexStats", mock.Anything, []string{"_all"}).Return(indexStats(t), nil)
+	mockClient.On("ILMExplain", mock.Anything).Return(ilmExplain(t), nil)
 
 	sc.client = &mockClient
 
@@ -85,6 +95,52 @@ func TestScraper(t *testing.T) {
 		pmetrictest.IgnoreMetricDataPointsOrder(), pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
 }
 
+func TestScraperILMPhaseTransitions(t *testing.T) {
+	t.Parallel()
+
+	config := createDefaultConfig().(*Config)
+	config.Nodes = nil
+	config.Indices = nil
+	config.Metrics.ElasticsearchClusterIlmPhaseTransitions.Enabled = true
+
+	sc := newElasticSearchScraper(receivertest.NewNopCreateSettings(), config)
+	require.NoError(t, sc.start(context.Background(), componenttest.NewNopHost()))
+
+	moved := ilmExplain(t)
+	index := moved.Indices["logs-000002"]
+	index.Phase = "warm"
+	moved.Indices["logs-000002"] = index
+
+	mockClient := mocks.MockElasticsearchClient{}
+	mockClient.On("ClusterMetadata", mock.Anything).Return(clusterMetadata(t), nil)
+	mockClient.On("ClusterHealth", mock.Anything).Return(clusterHealth(t), nil)
+	mockClient.On("ILMExplain", mock.Anything).Return(ilmExplain(t), nil).Once()
+	mockClient.On("ILMExplain", mock.Anything).Return(moved, nil).Once()
+	sc.client = &mockClient
+
+	transitions := func() map[string]int64 {
+		md, err := sc.scrape(context.Background())
+		require.NoError(t, err)
+		counts := map[string]int64{}
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			if metrics.At(i).Name() != "elasticsearch.cluster.ilm.phase.transitions" {
+				continue
+			}
+			dps := metrics.At(i).Sum().DataPoints()
+			for j := 0; j < dps.Len(); j++ {
+				policy, _ := dps.At(j).Attributes().Get("policy")
+				phase, _ := dps.At(j).Attributes().Get("phase")
+				counts[policy.Str()+"/"+phase.Str()] = dps.At(j).IntValue()
+			}
+		}
+		return counts
+	}
+
+	require.Equal(t, map[string]int64{"logs/hot": 0, "logs/warm": 0, "metrics/delete": 0}, transitions())
+	require.Equal(t, map[string]int64{"logs/hot": 0, "logs/warm": 1, "metrics/delete": 0}, transitions())
+}
+
 func TestScraperNoIOStats(t *testing.T) {
 	t.Parallel()
 
@@ -440,6 +496,12 @@ func indexStats(t *testing.T) *model.IndexStats {
 	return &indexStats
 }
 
+func ilmExplain(t *testing.T) *model.ILMExplain {
+	ilmExplain := model.ILMExplain{}
+	require.NoError(t, json.Unmarshal(readSamplePayload(t, "ilm_explain.json"), &ilmExplain))
+	return &ilmExplain
+}
+
 func clusterMetadata(t *testing.T) *model.ClusterMetadataResponse {
 	metadataResponse := model.ClusterMetadataResponse{}
 	require.NoError(t, json.Unmarshal(readSamplePayload(t, "metadata.json"), &metadataResponse))
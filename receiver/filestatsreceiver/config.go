@@ -5,21 +5,50 @@ package filestatsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filestatsreceiver/internal/metadata"
 )
 
+const (
+	checksumAlgorithmMD5    = "md5"
+	checksumAlgorithmSHA256 = "sha256"
+	checksumAlgorithmSHA512 = "sha512"
+)
+
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 	Include                        string `mapstructure:"include"`
+	// Checksum configures how the content checksum is computed when the `file.checksum` resource attribute is enabled.
+	Checksum ChecksumConfig `mapstructure:"checksum"`
+	// HeadSize is the number of bytes at the beginning of the file reported by the `file.content.head` resource attribute.
+	HeadSize int `mapstructure:"head_size"`
+}
+
+type ChecksumConfig struct {
+	// Algorithm is the hash function used to compute the checksum, one of `md5`, `sha256` or `sha512`.
+	Algorithm string `mapstructure:"algorithm"`
+	// MaxSize is the size in bytes above which files are not hashed and their lines are not counted. 0 means no limit.
+	MaxSize int64 `mapstructure:"max_size"`
 }
 
 func (c Config) Validate() error {
 	if c.Include == "" {
 		return errors.New("include must not be empty")
 	}
+	switch c.Checksum.Algorithm {
+	case checksumAlgorithmMD5, checksumAlgorithmSHA256, checksumAlgorithmSHA512:
+	default:
+		return fmt.Errorf("checksum algorithm must be one of %q, %q or %q, got %q", checksumAlgorithmMD5, checksumAlgorithmSHA256, checksumAlgorithmSHA512, c.Checksum.Algorithm)
+	}
+	if c.Checksum.MaxSize < 0 {
+		return errors.New("checksum max_size must not be negative")
+	}
+	if c.HeadSize < 0 {
+		return errors.New("head_size must not be negative")
+	}
 	return nil
 }
@@ -22,6 +22,7 @@ func Test_Config_Validate(t *testing.T) {
 			cfg: &Config{
 				Include:          "/var/log/*.log",
 				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Checksum:         ChecksumConfig{Algorithm: "sha256"},
 			},
 			wantErr: nil,
 		},
@@ -33,6 +34,34 @@ func Test_Config_Validate(t *testing.T) {
 			},
 			wantErr: errors.New("include must not be empty"),
 		},
+		{
+			name: "invalid checksum algorithm",
+			cfg: &Config{
+				Include:          "/var/log/*.log",
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Checksum:         ChecksumConfig{Algorithm: "crc32"},
+			},
+			wantErr: errors.New(`checksum algorithm must be one of "md5", "sha256" or "sha512", got "crc32"`),
+		},
+		{
+			name: "negative checksum max size",
+			cfg: &Config{
+				Include:          "/var/log/*.log",
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Checksum:         ChecksumConfig{Algorithm: "sha512", MaxSize: -1},
+			},
+			wantErr: errors.New("checksum max_size must not be negative"),
+		},
+		{
+			name: "negative head size",
+			cfg: &Config{
+				Include:          "/var/log/*.log",
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Checksum:         ChecksumConfig{Algorithm: "md5"},
+				HeadSize:         -1,
+			},
+			wantErr: errors.New("head_size must not be negative"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -19,13 +19,19 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		newDefaultConfig,
-		receiver.WithMetrics(newReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(newReceiver, metadata.MetricsStability),
+		receiver.WithLogs(newLogsReceiver, metadata.LogsStability))
 }
 
 func newDefaultConfig() component.Config {
 	return &Config{
 		ControllerConfig:     scraperhelper.NewDefaultControllerConfig(),
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		Checksum: ChecksumConfig{
+			Algorithm: checksumAlgorithmSHA256,
+			MaxSize:   100 * 1024 * 1024,
+		},
+		HeadSize: 256,
 	}
 }
 
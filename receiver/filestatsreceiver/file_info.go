@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filestatsreceiver"
+
+import (
+	"bytes"
+	"crypto/md5" // #nosec G501 -- md5 is used for change detection, not for security
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filestatsreceiver/internal/metadata"
+)
+
+// fileState is a snapshot of the properties of a matched file, used to set the resource attributes
+// and to detect changes between two collections.
+type fileState struct {
+	path          string
+	name          string
+	size          int64
+	modTime       time.Time
+	mode          os.FileMode
+	ownerID       string
+	groupID       string
+	symlinkTarget string
+	checksum      string
+	// lineCount is the number of lines of the file, or -1 if they were not counted.
+	lineCount int64
+	head      string
+}
+
+// changedFields returns the names of the properties that differ between two states of the same file.
+func (s fileState) changedFields(previous fileState) []string {
+	var fields []string
+	if s.size != previous.size {
+		fields = append(fields, "size")
+	}
+	if !s.modTime.Equal(previous.modTime) {
+		fields = append(fields, "mtime")
+	}
+	if s.mode != previous.mode {
+		fields = append(fields, "mode")
+	}
+	if s.ownerID != previous.ownerID {
+		fields = append(fields, "owner")
+	}
+	if s.groupID != previous.groupID {
+		fields = append(fields, "group")
+	}
+	if s.symlinkTarget != previous.symlinkTarget {
+		fields = append(fields, "symlink_target")
+	}
+	if s.checksum != previous.checksum {
+		fields = append(fields, "checksum")
+	}
+	return fields
+}
+
+// fileInspector collects the state of the matched files. Owner and group names are looked up once per ID.
+type fileInspector struct {
+	checksum           ChecksumConfig
+	headSize           int
+	countLines         bool
+	resourceAttributes metadata.ResourceAttributesConfig
+	userNames          map[string]string
+	groupNames         map[string]string
+}
+
+func newFileInspector(cfg *Config) *fileInspector {
+	return &fileInspector{
+		checksum:           cfg.Checksum,
+		headSize:           cfg.HeadSize,
+		countLines:         cfg.Metrics.FileLineCount.Enabled,
+		resourceAttributes: cfg.MetricsBuilderConfig.ResourceAttributes,
+		userNames:          map[string]string{},
+		groupNames:         map[string]string{},
+	}
+}
+
+func (i *fileInspector) inspect(path string) (os.FileInfo, fileState, error) {
+	fileinfo, err := os.Stat(path)
+	if err != nil {
+		return nil, fileState{}, err
+	}
+	state := fileState{
+		path:      path,
+		name:      fileinfo.Name(),
+		size:      fileinfo.Size(),
+		modTime:   fileinfo.ModTime(),
+		mode:      fileinfo.Mode(),
+		lineCount: -1,
+	}
+	state.ownerID, state.groupID, _ = fileOwner(fileinfo)
+
+	linkinfo, err := os.Lstat(path)
+	if err != nil {
+		return nil, fileState{}, err
+	}
+	if linkinfo.Mode()&os.ModeSymlink != 0 {
+		if state.symlinkTarget, err = os.Readlink(path); err != nil {
+			return nil, fileState{}, err
+		}
+	}
+
+	if !fileinfo.Mode().IsRegular() {
+		return fileinfo, state, nil
+	}
+	checksum := i.resourceAttributes.FileChecksum.Enabled
+	if (checksum || i.countLines) && (i.checksum.MaxSize == 0 || fileinfo.Size() <= i.checksum.MaxSize) {
+		if state.checksum, state.lineCount, err = i.readContent(path, checksum, i.countLines); err != nil {
+			return nil, fileState{}, err
+		}
+	}
+	if i.resourceAttributes.FileContentHead.Enabled && i.headSize > 0 {
+		if state.head, err = i.readHead(path); err != nil {
+			return nil, fileState{}, err
+		}
+	}
+	return fileinfo, state, nil
+}
+
+// readContent reads the content of the file once to compute its checksum and count its lines, as requested.
+func (i *fileInspector) readContent(path string, checksum bool, countLines bool) (string, int64, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", -1, err
+	}
+	defer f.Close()
+
+	var writers []io.Writer
+	var h hash.Hash
+	if checksum {
+		h = i.newHash()
+		writers = append(writers, h)
+	}
+	var lines *lineCounter
+	if countLines {
+		lines = &lineCounter{}
+		writers = append(writers, lines)
+	}
+	if _, err = io.Copy(io.MultiWriter(writers...), f); err != nil {
+		return "", -1, fmt.Errorf("failed to read the content of %s: %w", path, err)
+	}
+
+	var sum string
+	if h != nil {
+		sum = hex.EncodeToString(h.Sum(nil))
+	}
+	lineCount := int64(-1)
+	if lines != nil {
+		lineCount = lines.count()
+	}
+	return sum, lineCount, nil
+}
+
+func (i *fileInspector) newHash() hash.Hash {
+	switch i.checksum.Algorithm {
+	case checksumAlgorithmMD5:
+		return md5.New() // #nosec G401
+	case checksumAlgorithmSHA512:
+		return sha512.New()
+	default:
+		return sha256.New()
+	}
+}
+
+// readHead returns the first bytes of the file, with the invalid UTF-8 sequences replaced.
+func (i *fileInspector) readHead(path string) (string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	head := make([]byte, i.headSize)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("failed to read the head of %s: %w", path, err)
+	}
+	return strings.ToValidUTF8(string(head[:n]), "\uFFFD"), nil
+}
+
+// lineCounter counts the lines written to it. The last line is counted even if it doesn't end with a newline.
+type lineCounter struct {
+	newlines int64
+	last     byte
+	written  bool
+}
+
+func (c *lineCounter) Write(p []byte) (int, error) {
+	if len(p) > 0 {
+		c.newlines += int64(bytes.Count(p, []byte{'\n'}))
+		c.last = p[len(p)-1]
+		c.written = true
+	}
+	return len(p), nil
+}
+
+func (c *lineCounter) count() int64 {
+	if !c.written || c.last == '\n' {
+		return c.newlines
+	}
+	return c.newlines + 1
+}
+
+func (i *fileInspector) setResourceAttributes(rb *metadata.ResourceBuilder, state fileState) {
+	rb.SetFileName(state.name)
+	rb.SetFilePath(state.path)
+	rb.SetFileMode(fmt.Sprintf("%04o", state.mode.Perm()))
+	if state.ownerID != "" {
+		rb.SetFileOwnerID(state.ownerID)
+		if i.resourceAttributes.FileOwnerName.Enabled {
+			if name := i.userName(state.ownerID); name != "" {
+				rb.SetFileOwnerName(name)
+			}
+		}
+	}
+	if state.groupID != "" {
+		rb.SetFileGroupID(state.groupID)
+		if i.resourceAttributes.FileGroupName.Enabled {
+			if name := i.groupName(state.groupID); name != "" {
+				rb.SetFileGroupName(name)
+			}
+		}
+	}
+	if state.symlinkTarget != "" {
+		rb.SetFileSymbolicLinkTargetPath(state.symlinkTarget)
+	}
+	if state.checksum != "" {
+		rb.SetFileChecksum(state.checksum)
+	}
+	if state.head != "" {
+		rb.SetFileContentHead(state.head)
+	}
+}
+
+// userName returns the name of the user, or an empty string if it can't be looked up.
+func (i *fileInspector) userName(uid string) string {
+	if name, ok := i.userNames[uid]; ok {
+		return name
+	}
+	var name string
+	if u, err := user.LookupId(uid); err == nil {
+		name = u.Username
+	}
+	i.userNames[uid] = name
+	return name
+}
+
+// groupName returns the name of the group, or an empty string if it can't be looked up.
+func (i *fileInspector) groupName(gid string) string {
+	if name, ok := i.groupNames[gid]; ok {
+		return name
+	}
+	var name string
+	if g, err := user.LookupGroupId(gid); err == nil {
+		name = g.Name
+	}
+	i.groupNames[gid] = name
+	return name
+}
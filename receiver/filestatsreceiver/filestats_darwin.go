@@ -7,6 +7,7 @@ package filestatsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"os"
+	"strconv"
 	"syscall"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -22,3 +23,11 @@ func collectStats(now pcommon.Timestamp, fileinfo os.FileInfo, metricsBuilder *m
 	metricsBuilder.RecordFileAtimeDataPoint(now, atime)
 	metricsBuilder.RecordFileCtimeDataPoint(now, ctime, fileinfo.Mode().Perm().String())
 }
+
+func fileOwner(fileinfo os.FileInfo) (uid string, gid string, ok bool) {
+	stat, ok := fileinfo.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", "", false
+	}
+	return strconv.FormatUint(uint64(stat.Uid), 10), strconv.FormatUint(uint64(stat.Gid), 10), true
+}
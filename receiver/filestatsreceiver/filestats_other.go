@@ -17,3 +17,7 @@ import (
 func collectStats(now pcommon.Timestamp, fileinfo os.FileInfo, metricsBuilder *metadata.MetricsBuilder, logger *zap.Logger) {
 	logger.Warn("Cannot collect access and creation time for this arch")
 }
+
+func fileOwner(os.FileInfo) (uid string, gid string, ok bool) {
+	return "", "", false
+}
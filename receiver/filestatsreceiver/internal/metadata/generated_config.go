@@ -28,11 +28,12 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for filestats metrics.
 type MetricsConfig struct {
-	FileAtime MetricConfig `mapstructure:"file.atime"`
-	FileCount MetricConfig `mapstructure:"file.count"`
-	FileCtime MetricConfig `mapstructure:"file.ctime"`
-	FileMtime MetricConfig `mapstructure:"file.mtime"`
-	FileSize  MetricConfig `mapstructure:"file.size"`
+	FileAtime     MetricConfig `mapstructure:"file.atime"`
+	FileCount     MetricConfig `mapstructure:"file.count"`
+	FileCtime     MetricConfig `mapstructure:"file.ctime"`
+	FileLineCount MetricConfig `mapstructure:"file.line_count"`
+	FileMtime     MetricConfig `mapstructure:"file.mtime"`
+	FileSize      MetricConfig `mapstructure:"file.size"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -46,6 +47,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		FileCtime: MetricConfig{
 			Enabled: false,
 		},
+		FileLineCount: MetricConfig{
+			Enabled: false,
+		},
 		FileMtime: MetricConfig{
 			Enabled: true,
 		},
@@ -83,18 +87,50 @@ func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesConfig provides config for filestats resource attributes.
 type ResourceAttributesConfig struct {
-	FileName ResourceAttributeConfig `mapstructure:"file.name"`
-	FilePath ResourceAttributeConfig `mapstructure:"file.path"`
+	FileChecksum               ResourceAttributeConfig `mapstructure:"file.checksum"`
+	FileContentHead            ResourceAttributeConfig `mapstructure:"file.content.head"`
+	FileGroupID                ResourceAttributeConfig `mapstructure:"file.group.id"`
+	FileGroupName              ResourceAttributeConfig `mapstructure:"file.group.name"`
+	FileMode                   ResourceAttributeConfig `mapstructure:"file.mode"`
+	FileName                   ResourceAttributeConfig `mapstructure:"file.name"`
+	FileOwnerID                ResourceAttributeConfig `mapstructure:"file.owner.id"`
+	FileOwnerName              ResourceAttributeConfig `mapstructure:"file.owner.name"`
+	FilePath                   ResourceAttributeConfig `mapstructure:"file.path"`
+	FileSymbolicLinkTargetPath ResourceAttributeConfig `mapstructure:"file.symbolic_link.target_path"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		FileChecksum: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		FileContentHead: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		FileGroupID: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		FileGroupName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		FileMode: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		FileName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		FileOwnerID: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		FileOwnerName: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		FilePath: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		FileSymbolicLinkTargetPath: ResourceAttributeConfig{
+			Enabled: false,
+		},
 	}
 }
 
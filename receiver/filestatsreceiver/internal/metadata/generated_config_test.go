@@ -25,15 +25,24 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					FileAtime: MetricConfig{Enabled: true},
-					FileCount: MetricConfig{Enabled: true},
-					FileCtime: MetricConfig{Enabled: true},
-					FileMtime: MetricConfig{Enabled: true},
-					FileSize:  MetricConfig{Enabled: true},
+					FileAtime:     MetricConfig{Enabled: true},
+					FileCount:     MetricConfig{Enabled: true},
+					FileCtime:     MetricConfig{Enabled: true},
+					FileLineCount: MetricConfig{Enabled: true},
+					FileMtime:     MetricConfig{Enabled: true},
+					FileSize:      MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					FileName: ResourceAttributeConfig{Enabled: true},
-					FilePath: ResourceAttributeConfig{Enabled: true},
+					FileChecksum:               ResourceAttributeConfig{Enabled: true},
+					FileContentHead:            ResourceAttributeConfig{Enabled: true},
+					FileGroupID:                ResourceAttributeConfig{Enabled: true},
+					FileGroupName:              ResourceAttributeConfig{Enabled: true},
+					FileMode:                   ResourceAttributeConfig{Enabled: true},
+					FileName:                   ResourceAttributeConfig{Enabled: true},
+					FileOwnerID:                ResourceAttributeConfig{Enabled: true},
+					FileOwnerName:              ResourceAttributeConfig{Enabled: true},
+					FilePath:                   ResourceAttributeConfig{Enabled: true},
+					FileSymbolicLinkTargetPath: ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -41,15 +50,24 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					FileAtime: MetricConfig{Enabled: false},
-					FileCount: MetricConfig{Enabled: false},
-					FileCtime: MetricConfig{Enabled: false},
-					FileMtime: MetricConfig{Enabled: false},
-					FileSize:  MetricConfig{Enabled: false},
+					FileAtime:     MetricConfig{Enabled: false},
+					FileCount:     MetricConfig{Enabled: false},
+					FileCtime:     MetricConfig{Enabled: false},
+					FileLineCount: MetricConfig{Enabled: false},
+					FileMtime:     MetricConfig{Enabled: false},
+					FileSize:      MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					FileName: ResourceAttributeConfig{Enabled: false},
-					FilePath: ResourceAttributeConfig{Enabled: false},
+					FileChecksum:               ResourceAttributeConfig{Enabled: false},
+					FileContentHead:            ResourceAttributeConfig{Enabled: false},
+					FileGroupID:                ResourceAttributeConfig{Enabled: false},
+					FileGroupName:              ResourceAttributeConfig{Enabled: false},
+					FileMode:                   ResourceAttributeConfig{Enabled: false},
+					FileName:                   ResourceAttributeConfig{Enabled: false},
+					FileOwnerID:                ResourceAttributeConfig{Enabled: false},
+					FileOwnerName:              ResourceAttributeConfig{Enabled: false},
+					FilePath:                   ResourceAttributeConfig{Enabled: false},
+					FileSymbolicLinkTargetPath: ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -86,15 +104,31 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				FileName: ResourceAttributeConfig{Enabled: true},
-				FilePath: ResourceAttributeConfig{Enabled: true},
+				FileChecksum:               ResourceAttributeConfig{Enabled: true},
+				FileContentHead:            ResourceAttributeConfig{Enabled: true},
+				FileGroupID:                ResourceAttributeConfig{Enabled: true},
+				FileGroupName:              ResourceAttributeConfig{Enabled: true},
+				FileMode:                   ResourceAttributeConfig{Enabled: true},
+				FileName:                   ResourceAttributeConfig{Enabled: true},
+				FileOwnerID:                ResourceAttributeConfig{Enabled: true},
+				FileOwnerName:              ResourceAttributeConfig{Enabled: true},
+				FilePath:                   ResourceAttributeConfig{Enabled: true},
+				FileSymbolicLinkTargetPath: ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				FileName: ResourceAttributeConfig{Enabled: false},
-				FilePath: ResourceAttributeConfig{Enabled: false},
+				FileChecksum:               ResourceAttributeConfig{Enabled: false},
+				FileContentHead:            ResourceAttributeConfig{Enabled: false},
+				FileGroupID:                ResourceAttributeConfig{Enabled: false},
+				FileGroupName:              ResourceAttributeConfig{Enabled: false},
+				FileMode:                   ResourceAttributeConfig{Enabled: false},
+				FileName:                   ResourceAttributeConfig{Enabled: false},
+				FileOwnerID:                ResourceAttributeConfig{Enabled: false},
+				FileOwnerName:              ResourceAttributeConfig{Enabled: false},
+				FilePath:                   ResourceAttributeConfig{Enabled: false},
+				FileSymbolicLinkTargetPath: ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
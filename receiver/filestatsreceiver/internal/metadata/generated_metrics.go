@@ -165,6 +165,55 @@ func newMetricFileCtime(cfg MetricConfig) metricFileCtime {
 	return m
 }
 
+type metricFileLineCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills file.line_count metric with initial data.
+func (m *metricFileLineCount) init() {
+	m.data.SetName("file.line_count")
+	m.data.SetDescription("The number of lines of the file. Not collected for files larger than `checksum.max_size`.")
+	m.data.SetUnit("{line}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricFileLineCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricFileLineCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricFileLineCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricFileLineCount(cfg MetricConfig) metricFileLineCount {
+	m := metricFileLineCount{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricFileMtime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -278,6 +327,7 @@ type MetricsBuilder struct {
 	metricFileAtime                metricFileAtime
 	metricFileCount                metricFileCount
 	metricFileCtime                metricFileCtime
+	metricFileLineCount            metricFileLineCount
 	metricFileMtime                metricFileMtime
 	metricFileSize                 metricFileSize
 }
@@ -301,23 +351,72 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricFileAtime:                newMetricFileAtime(mbc.Metrics.FileAtime),
 		metricFileCount:                newMetricFileCount(mbc.Metrics.FileCount),
 		metricFileCtime:                newMetricFileCtime(mbc.Metrics.FileCtime),
+		metricFileLineCount:            newMetricFileLineCount(mbc.Metrics.FileLineCount),
 		metricFileMtime:                newMetricFileMtime(mbc.Metrics.FileMtime),
 		metricFileSize:                 newMetricFileSize(mbc.Metrics.FileSize),
 		resourceAttributeIncludeFilter: make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter: make(map[string]filter.Filter),
 	}
+	if mbc.ResourceAttributes.FileChecksum.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.checksum"] = filter.CreateFilter(mbc.ResourceAttributes.FileChecksum.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileChecksum.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.checksum"] = filter.CreateFilter(mbc.ResourceAttributes.FileChecksum.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.FileContentHead.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.content.head"] = filter.CreateFilter(mbc.ResourceAttributes.FileContentHead.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileContentHead.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.content.head"] = filter.CreateFilter(mbc.ResourceAttributes.FileContentHead.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.FileGroupID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.group.id"] = filter.CreateFilter(mbc.ResourceAttributes.FileGroupID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileGroupID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.group.id"] = filter.CreateFilter(mbc.ResourceAttributes.FileGroupID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.FileGroupName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.group.name"] = filter.CreateFilter(mbc.ResourceAttributes.FileGroupName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileGroupName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.group.name"] = filter.CreateFilter(mbc.ResourceAttributes.FileGroupName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.FileMode.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.mode"] = filter.CreateFilter(mbc.ResourceAttributes.FileMode.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileMode.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.mode"] = filter.CreateFilter(mbc.ResourceAttributes.FileMode.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.FileName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["file.name"] = filter.CreateFilter(mbc.ResourceAttributes.FileName.MetricsInclude)
 	}
 	if mbc.ResourceAttributes.FileName.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["file.name"] = filter.CreateFilter(mbc.ResourceAttributes.FileName.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.FileOwnerID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.owner.id"] = filter.CreateFilter(mbc.ResourceAttributes.FileOwnerID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileOwnerID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.owner.id"] = filter.CreateFilter(mbc.ResourceAttributes.FileOwnerID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.FileOwnerName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.owner.name"] = filter.CreateFilter(mbc.ResourceAttributes.FileOwnerName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileOwnerName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.owner.name"] = filter.CreateFilter(mbc.ResourceAttributes.FileOwnerName.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.FilePath.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["file.path"] = filter.CreateFilter(mbc.ResourceAttributes.FilePath.MetricsInclude)
 	}
 	if mbc.ResourceAttributes.FilePath.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["file.path"] = filter.CreateFilter(mbc.ResourceAttributes.FilePath.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.FileSymbolicLinkTargetPath.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["file.symbolic_link.target_path"] = filter.CreateFilter(mbc.ResourceAttributes.FileSymbolicLinkTargetPath.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.FileSymbolicLinkTargetPath.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["file.symbolic_link.target_path"] = filter.CreateFilter(mbc.ResourceAttributes.FileSymbolicLinkTargetPath.MetricsExclude)
+	}
 
 	for _, op := range options {
 		op(mb)
@@ -382,6 +481,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricFileAtime.emit(ils.Metrics())
 	mb.metricFileCount.emit(ils.Metrics())
 	mb.metricFileCtime.emit(ils.Metrics())
+	mb.metricFileLineCount.emit(ils.Metrics())
 	mb.metricFileMtime.emit(ils.Metrics())
 	mb.metricFileSize.emit(ils.Metrics())
 
@@ -430,6 +530,11 @@ func (mb *MetricsBuilder) RecordFileCtimeDataPoint(ts pcommon.Timestamp, val int
 	mb.metricFileCtime.recordDataPoint(mb.startTime, ts, val, filePermissionsAttributeValue)
 }
 
+// RecordFileLineCountDataPoint adds a data point to file.line_count metric.
+func (mb *MetricsBuilder) RecordFileLineCountDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricFileLineCount.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordFileMtimeDataPoint adds a data point to file.mtime metric.
 func (mb *MetricsBuilder) RecordFileMtimeDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricFileMtime.recordDataPoint(mb.startTime, ts, val)
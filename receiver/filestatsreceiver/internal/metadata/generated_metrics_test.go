@@ -77,6 +77,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordFileCtimeDataPoint(ts, 1, "file.permissions-val")
 
+			allMetricsCount++
+			mb.RecordFileLineCountDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordFileMtimeDataPoint(ts, 1)
@@ -86,8 +89,16 @@ func TestMetricsBuilder(t *testing.T) {
 			mb.RecordFileSizeDataPoint(ts, 1)
 
 			rb := mb.NewResourceBuilder()
+			rb.SetFileChecksum("file.checksum-val")
+			rb.SetFileContentHead("file.content.head-val")
+			rb.SetFileGroupID("file.group.id-val")
+			rb.SetFileGroupName("file.group.name-val")
+			rb.SetFileMode("file.mode-val")
 			rb.SetFileName("file.name-val")
+			rb.SetFileOwnerID("file.owner.id-val")
+			rb.SetFileOwnerName("file.owner.name-val")
 			rb.SetFilePath("file.path-val")
+			rb.SetFileSymbolicLinkTargetPath("file.symbolic_link.target_path-val")
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 
@@ -153,6 +164,18 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("file.permissions")
 					assert.True(t, ok)
 					assert.EqualValues(t, "file.permissions-val", attrVal.Str())
+				case "file.line_count":
+					assert.False(t, validatedMetrics["file.line_count"], "Found a duplicate in the metrics slice: file.line_count")
+					validatedMetrics["file.line_count"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of lines of the file. Not collected for files larger than `checksum.max_size`.", ms.At(i).Description())
+					assert.Equal(t, "{line}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "file.mtime":
 					assert.False(t, validatedMetrics["file.mtime"], "Found a duplicate in the metrics slice: file.mtime")
 					validatedMetrics["file.mtime"] = true
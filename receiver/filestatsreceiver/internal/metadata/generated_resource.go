@@ -21,6 +21,41 @@ func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
 	}
 }
 
+// SetFileChecksum sets provided value as "file.checksum" attribute.
+func (rb *ResourceBuilder) SetFileChecksum(val string) {
+	if rb.config.FileChecksum.Enabled {
+		rb.res.Attributes().PutStr("file.checksum", val)
+	}
+}
+
+// SetFileContentHead sets provided value as "file.content.head" attribute.
+func (rb *ResourceBuilder) SetFileContentHead(val string) {
+	if rb.config.FileContentHead.Enabled {
+		rb.res.Attributes().PutStr("file.content.head", val)
+	}
+}
+
+// SetFileGroupID sets provided value as "file.group.id" attribute.
+func (rb *ResourceBuilder) SetFileGroupID(val string) {
+	if rb.config.FileGroupID.Enabled {
+		rb.res.Attributes().PutStr("file.group.id", val)
+	}
+}
+
+// SetFileGroupName sets provided value as "file.group.name" attribute.
+func (rb *ResourceBuilder) SetFileGroupName(val string) {
+	if rb.config.FileGroupName.Enabled {
+		rb.res.Attributes().PutStr("file.group.name", val)
+	}
+}
+
+// SetFileMode sets provided value as "file.mode" attribute.
+func (rb *ResourceBuilder) SetFileMode(val string) {
+	if rb.config.FileMode.Enabled {
+		rb.res.Attributes().PutStr("file.mode", val)
+	}
+}
+
 // SetFileName sets provided value as "file.name" attribute.
 func (rb *ResourceBuilder) SetFileName(val string) {
 	if rb.config.FileName.Enabled {
@@ -28,6 +63,20 @@ func (rb *ResourceBuilder) SetFileName(val string) {
 	}
 }
 
+// SetFileOwnerID sets provided value as "file.owner.id" attribute.
+func (rb *ResourceBuilder) SetFileOwnerID(val string) {
+	if rb.config.FileOwnerID.Enabled {
+		rb.res.Attributes().PutStr("file.owner.id", val)
+	}
+}
+
+// SetFileOwnerName sets provided value as "file.owner.name" attribute.
+func (rb *ResourceBuilder) SetFileOwnerName(val string) {
+	if rb.config.FileOwnerName.Enabled {
+		rb.res.Attributes().PutStr("file.owner.name", val)
+	}
+}
+
 // SetFilePath sets provided value as "file.path" attribute.
 func (rb *ResourceBuilder) SetFilePath(val string) {
 	if rb.config.FilePath.Enabled {
@@ -35,6 +84,13 @@ func (rb *ResourceBuilder) SetFilePath(val string) {
 	}
 }
 
+// SetFileSymbolicLinkTargetPath sets provided value as "file.symbolic_link.target_path" attribute.
+func (rb *ResourceBuilder) SetFileSymbolicLinkTargetPath(val string) {
+	if rb.config.FileSymbolicLinkTargetPath.Enabled {
+		rb.res.Attributes().PutStr("file.symbolic_link.target_path", val)
+	}
+}
+
 // Emit returns the built resource and resets the internal builder state.
 func (rb *ResourceBuilder) Emit() pcommon.Resource {
 	r := rb.res
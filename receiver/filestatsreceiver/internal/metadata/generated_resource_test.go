@@ -13,8 +13,16 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(test, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, test)
 			rb := NewResourceBuilder(cfg)
+			rb.SetFileChecksum("file.checksum-val")
+			rb.SetFileContentHead("file.content.head-val")
+			rb.SetFileGroupID("file.group.id-val")
+			rb.SetFileGroupName("file.group.name-val")
+			rb.SetFileMode("file.mode-val")
 			rb.SetFileName("file.name-val")
+			rb.SetFileOwnerID("file.owner.id-val")
+			rb.SetFileOwnerName("file.owner.name-val")
 			rb.SetFilePath("file.path-val")
+			rb.SetFileSymbolicLinkTargetPath("file.symbolic_link.target_path-val")
 
 			res := rb.Emit()
 			assert.Equal(t, 0, rb.Emit().Attributes().Len()) // Second call should return empty Resource
@@ -23,7 +31,7 @@ func TestResourceBuilder(t *testing.T) {
 			case "default":
 				assert.Equal(t, 1, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 2, res.Attributes().Len())
+				assert.Equal(t, 10, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -31,16 +39,56 @@ func TestResourceBuilder(t *testing.T) {
 				assert.Failf(t, "unexpected test case: %s", test)
 			}
 
-			val, ok := res.Attributes().Get("file.name")
+			val, ok := res.Attributes().Get("file.checksum")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.checksum-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("file.content.head")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.content.head-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("file.group.id")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.group.id-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("file.group.name")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.group.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("file.mode")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.mode-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("file.name")
 			assert.True(t, ok)
 			if ok {
 				assert.EqualValues(t, "file.name-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("file.owner.id")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.owner.id-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("file.owner.name")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.owner.name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("file.path")
 			assert.Equal(t, test == "all_set", ok)
 			if ok {
 				assert.EqualValues(t, "file.path-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("file.symbolic_link.target_path")
+			assert.Equal(t, test == "all_set", ok)
+			if ok {
+				assert.EqualValues(t, "file.symbolic_link.target_path-val", val.Str())
+			}
 		})
 	}
 }
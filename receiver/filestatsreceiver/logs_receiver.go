@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestatsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filestatsreceiver"
+
+import (
+	"context"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/filestatsreceiver/internal/metadata"
+)
+
+const (
+	changeTypeCreated  = "created"
+	changeTypeModified = "modified"
+	changeTypeDeleted  = "deleted"
+
+	changeTypeAttribute   = "file.change.type"
+	changeFieldsAttribute = "file.change.fields"
+)
+
+// changeEventsReceiver emits a log record for every matched file created, modified or deleted
+// between two collections.
+type changeEventsReceiver struct {
+	cfg       *Config
+	settings  receiver.CreateSettings
+	consumer  consumer.Logs
+	inspector *fileInspector
+
+	// previous holds the state of the files matched by the last collection, nil before the first one.
+	previous map[string]fileState
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newLogsReceiver(
+	_ context.Context,
+	settings receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	fileStatsConfig := cfg.(*Config)
+	return &changeEventsReceiver{
+		cfg:       fileStatsConfig,
+		settings:  settings,
+		consumer:  consumer,
+		inspector: newFileInspector(fileStatsConfig),
+	}, nil
+}
+
+func (r *changeEventsReceiver) Start(context.Context, component.Host) error {
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go r.run(ctx)
+	return nil
+}
+
+func (r *changeEventsReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(r.cfg.InitialDelay):
+	}
+
+	ticker := time.NewTicker(r.cfg.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		if logs := r.collect(); logs.LogRecordCount() > 0 {
+			if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+				r.settings.Logger.Error("Failed to consume file change events", zap.Error(err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *changeEventsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return nil
+}
+
+// collect compares the state of the matched files with the one of the previous collection.
+// The first collection only records the initial state.
+func (r *changeEventsReceiver) collect() plog.Logs {
+	logs := plog.NewLogs()
+
+	matches, err := doublestar.FilepathGlob(r.cfg.Include)
+	if err != nil {
+		r.settings.Logger.Error("Failed to match files", zap.Error(err))
+		return logs
+	}
+
+	current := make(map[string]fileState, len(matches))
+	for _, match := range matches {
+		_, state, err := r.inspector.inspect(match)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				r.settings.Logger.Warn("Failed to inspect file", zap.String("path", match), zap.Error(err))
+			}
+			continue
+		}
+		current[match] = state
+	}
+
+	previous := r.previous
+	r.previous = current
+	if previous == nil {
+		return logs
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, match := range matches {
+		state, ok := current[match]
+		if !ok {
+			continue
+		}
+		previousState, existed := previous[match]
+		if !existed {
+			r.appendEvent(logs, now, state, changeTypeCreated, nil)
+		} else if fields := state.changedFields(previousState); len(fields) > 0 {
+			r.appendEvent(logs, now, state, changeTypeModified, fields)
+		}
+	}
+
+	var deleted []string
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			deleted = append(deleted, path)
+		}
+	}
+	sort.Strings(deleted)
+	for _, path := range deleted {
+		r.appendEvent(logs, now, previous[path], changeTypeDeleted, nil)
+	}
+	return logs
+}
+
+func (r *changeEventsReceiver) appendEvent(logs plog.Logs, now pcommon.Timestamp, state fileState, changeType string, fields []string) {
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	rb := metadata.NewResourceBuilder(r.cfg.ResourceAttributes)
+	r.inspector.setResourceAttributes(rb, state)
+	rb.Emit().MoveTo(resourceLogs.Resource())
+
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName("otelcol/filestatsreceiver")
+	scopeLogs.Scope().SetVersion(r.settings.BuildInfo.Version)
+
+	record := scopeLogs.LogRecords().AppendEmpty()
+	record.SetTimestamp(now)
+	record.SetObservedTimestamp(now)
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	record.Body().SetStr("File " + changeType + ": " + state.path)
+	record.Attributes().PutStr(changeTypeAttribute, changeType)
+	if len(fields) > 0 {
+		changed := record.Attributes().PutEmptySlice(changeFieldsAttribute)
+		for _, field := range fields {
+			changed.AppendEmpty().SetStr(field)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filestatsreceiver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+type changeEvent struct {
+	path       string
+	changeType string
+	fields     []any
+}
+
+func changeEvents(logs plog.Logs) []changeEvent {
+	var events []changeEvent
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		resourceLogs := logs.ResourceLogs().At(i)
+		path, _ := resourceLogs.Resource().Attributes().Get("file.path")
+		record := resourceLogs.ScopeLogs().At(0).LogRecords().At(0)
+		changeType, _ := record.Attributes().Get(changeTypeAttribute)
+		event := changeEvent{path: path.Str(), changeType: changeType.Str()}
+		if fields, ok := record.Attributes().Get(changeFieldsAttribute); ok {
+			event.fields = fields.Slice().AsRaw()
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func Test_ChangeEvents(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Include = filepath.Join(tmpDir, "*.conf")
+	cfg.ResourceAttributes.FilePath.Enabled = true
+	cfg.ResourceAttributes.FileChecksum.Enabled = true
+
+	modified := filepath.Join(tmpDir, "modified.conf")
+	unchanged := filepath.Join(tmpDir, "unchanged.conf")
+	deleted := filepath.Join(tmpDir, "deleted.conf")
+	created := filepath.Join(tmpDir, "created.conf")
+	for _, path := range []string{modified, unchanged, deleted} {
+		require.NoError(t, os.WriteFile(path, []byte("foo"), 0600))
+	}
+
+	r, err := newLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	events := r.(*changeEventsReceiver)
+
+	// The first collection records the initial state without emitting events.
+	assert.Equal(t, 0, events.collect().LogRecordCount())
+
+	// Same size and modification time, only the checksum tells the content changed.
+	info, err := os.Stat(modified)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(modified, []byte("bar"), 0600))
+	require.NoError(t, os.Chtimes(modified, info.ModTime(), info.ModTime()))
+	require.NoError(t, os.Remove(deleted))
+	require.NoError(t, os.WriteFile(created, []byte("foo"), 0600))
+
+	logs := events.collect()
+	assert.ElementsMatch(t, []changeEvent{
+		{path: created, changeType: changeTypeCreated},
+		{path: modified, changeType: changeTypeModified, fields: []any{"checksum"}},
+		{path: deleted, changeType: changeTypeDeleted},
+	}, changeEvents(logs))
+	record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberInfo, record.SeverityNumber())
+	assert.NotZero(t, record.Timestamp())
+
+	assert.Equal(t, 0, events.collect().LogRecordCount())
+}
+
+func Test_ChangeEventsReceiver(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Include = filepath.Join(tmpDir, "*.conf")
+	cfg.InitialDelay = 0
+	cfg.CollectionInterval = 10 * time.Millisecond
+
+	sink := &consumertest.LogsSink{}
+	r, err := NewFactory().CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	// Let the receiver record the initial, empty, state before creating the file.
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "my.conf"), []byte("foo"), 0600))
+
+	require.Eventually(t, func() bool { return sink.LogRecordCount() > 0 }, 5*time.Second, 10*time.Millisecond)
+	event := sink.AllLogs()[0].ResourceLogs().At(0)
+	name, _ := event.Resource().Attributes().Get("file.name")
+	assert.Equal(t, "my.conf", name.Str())
+	changeType, _ := event.ScopeLogs().At(0).LogRecords().At(0).Attributes().Get(changeTypeAttribute)
+	assert.Equal(t, changeTypeCreated, changeType.Str())
+}
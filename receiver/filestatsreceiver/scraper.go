@@ -5,7 +5,6 @@ package filestatsreceiver // import "github.com/open-telemetry/opentelemetry-col
 
 import (
 	"context"
-	"os"
 	"time"
 
 	"github.com/bmatcuk/doublestar/v4"
@@ -20,9 +19,10 @@ import (
 )
 
 type scraper struct {
-	include string
-	logger  *zap.Logger
-	mb      *metadata.MetricsBuilder
+	include   string
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	inspector *fileInspector
 }
 
 func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
@@ -36,18 +36,20 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 
 	for _, match := range matches {
-		fileinfo, err := os.Stat(match)
+		fileinfo, state, err := s.inspector.inspect(match)
 		if err != nil {
 			scrapeErrors = append(scrapeErrors, err)
 			continue
 		}
 		s.mb.RecordFileSizeDataPoint(now, fileinfo.Size())
 		s.mb.RecordFileMtimeDataPoint(now, fileinfo.ModTime().Unix())
+		if state.lineCount >= 0 {
+			s.mb.RecordFileLineCountDataPoint(now, state.lineCount)
+		}
 		collectStats(now, fileinfo, s.mb, s.logger)
 
 		rb := s.mb.NewResourceBuilder()
-		rb.SetFileName(fileinfo.Name())
-		rb.SetFilePath(match)
+		s.inspector.setResourceAttributes(rb, state)
 		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 	}
 
@@ -62,8 +64,9 @@ func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
 
 func newScraper(cfg *Config, settings receiver.CreateSettings) *scraper {
 	return &scraper{
-		include: cfg.Include,
-		logger:  settings.TelemetrySettings.Logger,
-		mb:      metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		include:   cfg.Include,
+		logger:    settings.TelemetrySettings.Logger,
+		mb:        metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		inspector: newFileInspector(cfg),
 	}
 }
@@ -7,6 +7,9 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/bmatcuk/doublestar/v4"
@@ -94,3 +97,120 @@ func Test_Scrape_All(t *testing.T) {
 	require.Equal(t, "file.count", fileCount.Name())
 	require.Equal(t, int64(1), fileCount.Gauge().DataPoints().At(0).IntValue())
 }
+
+func Test_Scrape_ResourceAttributes(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Include = filepath.Join(tmpDir, "*.log")
+	cfg.ResourceAttributes.FileMode.Enabled = true
+	cfg.ResourceAttributes.FileOwnerID.Enabled = true
+	cfg.ResourceAttributes.FileSymbolicLinkTargetPath.Enabled = true
+	cfg.ResourceAttributes.FileChecksum.Enabled = true
+
+	target := filepath.Join(tmpDir, "target.txt")
+	require.NoError(t, os.WriteFile(target, []byte("something"), 0600))
+	if runtime.GOOS != "windows" {
+		require.NoError(t, os.Symlink(target, filepath.Join(tmpDir, "link.log")))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "my.log"), []byte("something"), 0600))
+
+	s := newScraper(cfg, receivertest.NewNopCreateSettings())
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	byName := map[string]map[string]any{}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		attrs := metrics.ResourceMetrics().At(i).Resource().Attributes().AsRaw()
+		if name, ok := attrs["file.name"]; ok {
+			byName[name.(string)] = attrs
+		}
+	}
+
+	// sha256 of "something"
+	const checksum = "3fc9b689459d738f8c88a3a48aa9e33542016b7a4052e001aaa536fca74813cb"
+	attrs := byName["my.log"]
+	require.Equal(t, checksum, attrs["file.checksum"])
+	require.NotContains(t, attrs, "file.symbolic_link.target_path")
+	if runtime.GOOS == "windows" {
+		return
+	}
+	require.Equal(t, "0600", attrs["file.mode"])
+	require.Equal(t, strconv.Itoa(os.Getuid()), attrs["file.owner.id"])
+
+	attrs = byName["link.log"]
+	require.Equal(t, target, attrs["file.symbolic_link.target_path"])
+	require.Equal(t, checksum, attrs["file.checksum"])
+}
+
+func Test_Scrape_ChecksumMaxSize(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Include = filepath.Join(tmpDir, "*.log")
+	cfg.ResourceAttributes.FileChecksum.Enabled = true
+	cfg.Checksum.Algorithm = checksumAlgorithmSHA512
+	cfg.Checksum.MaxSize = 4
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "small.log"), []byte("abc"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "large.log"), []byte("something"), 0600))
+
+	s := newScraper(cfg, receivertest.NewNopCreateSettings())
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	checksums := map[string]any{}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		attrs := metrics.ResourceMetrics().At(i).Resource().Attributes().AsRaw()
+		if name, ok := attrs["file.name"]; ok {
+			checksums[name.(string)] = attrs["file.checksum"]
+		}
+	}
+	require.Equal(t, map[string]any{
+		"small.log": "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		"large.log": nil,
+	}, checksums)
+}
+
+func Test_Scrape_Content(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := newDefaultConfig().(*Config)
+	cfg.Include = filepath.Join(tmpDir, "*.conf")
+	cfg.Metrics.FileLineCount.Enabled = true
+	cfg.ResourceAttributes.FileChecksum.Enabled = true
+	cfg.ResourceAttributes.FileContentHead.Enabled = true
+	cfg.Checksum.Algorithm = checksumAlgorithmMD5
+	cfg.Checksum.MaxSize = 32
+	cfg.HeadSize = 8
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "app.conf"), []byte("a = 1\nb = 2\nc = 3"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "empty.conf"), nil, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "large.conf"), []byte(strings.Repeat("line\n", 10)), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "binary.conf"), []byte{'a', 0xff, 'b', '\n'}, 0600))
+
+	s := newScraper(cfg, receivertest.NewNopCreateSettings())
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	attributes := map[string]map[string]any{}
+	lineCounts := map[string]int64{}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		rm := metrics.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("file.name")
+		if !ok {
+			continue
+		}
+		attributes[name.Str()] = rm.Resource().Attributes().AsRaw()
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() == "file.line_count" {
+				lineCounts[name.Str()] = ms.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+	}
+
+	// Files larger than checksum.max_size are neither hashed nor counted, but their head is reported.
+	require.Equal(t, map[string]int64{"app.conf": 3, "empty.conf": 0, "binary.conf": 1}, lineCounts)
+	require.Equal(t, "d0b2af8fd85cde02ce64a389ec3c4875", attributes["app.conf"]["file.checksum"])
+	require.Equal(t, "a = 1\nb ", attributes["app.conf"]["file.content.head"])
+	require.NotContains(t, attributes["empty.conf"], "file.content.head")
+	require.NotContains(t, attributes["large.conf"], "file.checksum")
+	require.Equal(t, "line\nlin", attributes["large.conf"]["file.content.head"])
+	require.Equal(t, "a�b\n", attributes["binary.conf"]["file.content.head"])
+}
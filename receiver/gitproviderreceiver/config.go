@@ -6,8 +6,11 @@ package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-c
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
@@ -17,6 +20,7 @@ import (
 
 const (
 	scrapersKey = "scrapers"
+	webHookKey  = "webhook"
 )
 
 // Config that is exposed to this github receiver through the OTEL config.yaml
@@ -24,6 +28,21 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	Scrapers                       map[string]internal.Config `mapstructure:"scrapers"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	// WebHook, when set, receives the GitHub webhook events of the workflow runs, workflow jobs and
+	// deployments, converted into traces and metrics.
+	WebHook *WebHookConfig `mapstructure:"webhook"`
+}
+
+// WebHookConfig configures the endpoint receiving the GitHub webhook events.
+type WebHookConfig struct {
+	confighttp.ServerConfig `mapstructure:",squash"`
+	// Path is the path of the endpoint the events are sent to.
+	Path string `mapstructure:"path"`
+	// HealthPath is the path of the health check endpoint.
+	HealthPath string `mapstructure:"health_path"`
+	// Secret is the secret of the webhook, used to validate the signature of the events. The signature
+	// isn't validated when it is empty.
+	Secret configopaque.String `mapstructure:"secret"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -31,18 +50,43 @@ var _ confmap.Unmarshaler = (*Config)(nil)
 
 // Validate the configuration passed through the OTEL config.yaml
 func (cfg *Config) Validate() error {
-	if len(cfg.Scrapers) == 0 {
-		return errors.New("must specify at least one scraper")
+	if len(cfg.Scrapers) == 0 && cfg.WebHook == nil {
+		return errors.New("must specify at least one scraper or the webhook")
+	}
+	if cfg.WebHook != nil {
+		return cfg.WebHook.validate()
 	}
 	return nil
 }
 
+func (cfg *WebHookConfig) validate() error {
+	var errs error
+	if cfg.Endpoint == "" {
+		errs = errors.Join(errs, errors.New("webhook endpoint must be specified"))
+	}
+	if !strings.HasPrefix(cfg.Path, "/") {
+		errs = errors.Join(errs, fmt.Errorf("webhook path %q must start with '/'", cfg.Path))
+	}
+	if !strings.HasPrefix(cfg.HealthPath, "/") {
+		errs = errors.Join(errs, fmt.Errorf("webhook health_path %q must start with '/'", cfg.HealthPath))
+	}
+	if cfg.Path == cfg.HealthPath {
+		errs = errors.Join(errs, errors.New("webhook path and health_path must be different"))
+	}
+	return errs
+}
+
 // Unmarshal a config.Parser into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
 		return nil
 	}
 
+	// the webhook settings that aren't configured keep their default value
+	if componentParser.IsSet(webHookKey) && cfg.WebHook == nil {
+		cfg.WebHook = createDefaultWebHookConfig()
+	}
+
 	// load the non-dynamic config normally
 	err := componentParser.Unmarshal(cfg, confmap.WithIgnoreUnused())
 	if err != nil {
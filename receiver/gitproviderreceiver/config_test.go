@@ -31,7 +31,7 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, cfg)
 
-	assert.Equal(t, len(cfg.Receivers), 2)
+	assert.Equal(t, len(cfg.Receivers), 3)
 
 	r0 := cfg.Receivers[component.NewID(metadata.Type)]
 	defaultConfigGitHubScraper := factory.CreateDefaultConfig()
@@ -53,6 +53,15 @@ func TestLoadConfig(t *testing.T) {
 	}
 
 	assert.Equal(t, expectedConfig, r1)
+
+	r2 := cfg.Receivers[component.NewIDWithName(metadata.Type, "webhook")].(*Config)
+	expectedConfig = factory.CreateDefaultConfig().(*Config)
+	expectedConfig.Scrapers = map[string]internal.Config{}
+	expectedConfig.WebHook = createDefaultWebHookConfig()
+	expectedConfig.WebHook.Endpoint = "localhost:8888"
+	expectedConfig.WebHook.Secret = "mysecret"
+
+	assert.Equal(t, expectedConfig, r2)
 }
 
 func TestLoadInvalidConfig_NoScrapers(t *testing.T) {
@@ -63,7 +72,18 @@ func TestLoadInvalidConfig_NoScrapers(t *testing.T) {
 	factories.Receivers[metadata.Type] = factory
 	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-noscrapers.yaml"), factories)
 
-	require.Contains(t, err.Error(), "must specify at least one scraper")
+	require.Contains(t, err.Error(), "must specify at least one scraper or the webhook")
+}
+
+func TestLoadInvalidConfig_WebHook(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-invalidwebhook.yaml"), factories)
+
+	require.Contains(t, err.Error(), "webhook path and health_path must be different")
 }
 
 func TestLoadInvalidConfig_InvalidScraperKey(t *testing.T) {
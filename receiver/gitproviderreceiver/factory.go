@@ -9,10 +9,12 @@ import (
 	"fmt"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver/internal"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver/internal/scraper/githubscraper"
@@ -20,12 +22,23 @@ import (
 
 // This file implements a factory for the git provider receiver
 
+const (
+	defaultWebHookEndpoint   = "localhost:8080"
+	defaultWebHookPath       = "/events"
+	defaultWebHookHealthPath = "/health"
+)
+
 var (
 	scraperFactories = map[string]internal.ScraperFactory{
 		githubscraper.TypeStr: &githubscraper.Factory{},
 	}
 
 	errConfigNotValid = errors.New("configuration is not valid for the git provider receiver")
+	errNoWebHook      = errors.New("the webhook must be configured to receive traces")
+
+	// webHookReceivers shares the webhook endpoint between the traces and metrics receivers created
+	// from the same configuration.
+	webHookReceivers = sharedcomponent.NewSharedComponents()
 )
 
 // NewFactory creates a factory for the git provider receiver
@@ -34,6 +47,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
 	)
 }
 
@@ -59,6 +73,16 @@ func createDefaultConfig() component.Config {
 	}
 }
 
+func createDefaultWebHookConfig() *WebHookConfig {
+	return &WebHookConfig{
+		ServerConfig: confighttp.ServerConfig{
+			Endpoint: defaultWebHookEndpoint,
+		},
+		Path:       defaultWebHookPath,
+		HealthPath: defaultWebHookHealthPath,
+	}
+}
+
 // Create the metrics receiver according to the OTEL conventions taking in the
 // context, receiver params, configuration from the component, and consumer (process or exporter)
 func createMetricsReceiver(
@@ -79,12 +103,58 @@ func createMetricsReceiver(
 		return nil, err
 	}
 
-	return scraperhelper.NewScraperControllerReceiver(
+	scrapers, err := scraperhelper.NewScraperControllerReceiver(
 		&conf.ControllerConfig,
 		params,
 		consumer,
 		addScraperOpts...,
 	)
+	if err != nil || conf.WebHook == nil {
+		return scrapers, err
+	}
+
+	webHook, err := getWebHookReceiver(conf, params)
+	if err != nil {
+		return nil, err
+	}
+	webHook.Unwrap().(*webHookReceiver).nextMetrics = consumer
+	return &metricsReceiver{scrapers: scrapers, webHook: webHook}, nil
+}
+
+// Create the traces receiver, converting the webhook events into traces.
+func createTracesReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Traces,
+) (receiver.Traces, error) {
+	conf, ok := cfg.(*Config)
+	if !ok {
+		return nil, errConfigNotValid
+	}
+	if conf.WebHook == nil {
+		return nil, errNoWebHook
+	}
+
+	webHook, err := getWebHookReceiver(conf, params)
+	if err != nil {
+		return nil, err
+	}
+	webHook.Unwrap().(*webHookReceiver).nextTraces = consumer
+	return webHook, nil
+}
+
+func getWebHookReceiver(cfg *Config, params receiver.CreateSettings) (*sharedcomponent.SharedComponent, error) {
+	var err error
+	r := webHookReceivers.GetOrAdd(cfg, func() component.Component {
+		var webHook *webHookReceiver
+		webHook, err = newWebHookReceiver(cfg.WebHook, params)
+		return webHook
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
 }
 
 func createAddScraperOpts(
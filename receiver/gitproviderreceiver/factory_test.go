@@ -33,7 +33,7 @@ func TestCreateReceiver(t *testing.T) {
 	cfg := factory.CreateDefaultConfig()
 
 	tReceiver, err := factory.CreateTracesReceiver(context.Background(), creationSet, cfg, consumertest.NewNop())
-	assert.Equal(t, err, component.ErrDataTypeIsNotSupported)
+	assert.Equal(t, err, errNoWebHook)
 	assert.Nil(t, tReceiver)
 
 	mReceiver, err := factory.CreateMetricsReceiver(context.Background(), creationSet, cfg, consumertest.NewNop())
@@ -45,6 +45,23 @@ func TestCreateReceiver(t *testing.T) {
 	assert.Nil(t, tLogs)
 }
 
+func TestCreateReceiver_WebHook(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.WebHook = createDefaultWebHookConfig()
+
+	tReceiver, err := factory.CreateTracesReceiver(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.NotNil(t, tReceiver)
+
+	mReceiver, err := factory.CreateMetricsReceiver(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.IsType(t, &metricsReceiver{}, mReceiver)
+
+	// the traces and metrics receivers share the webhook
+	assert.Same(t, tReceiver, mReceiver.(*metricsReceiver).webHook)
+}
+
 func TestCreateReceiver_ScraperKeyConfigError(t *testing.T) {
 	const errorKey string = "error"
 
@@ -12,4 +12,5 @@ var (
 
 const (
 	MetricsStability = component.StabilityLevelDevelopment
+	TracesStability  = component.StabilityLevelDevelopment
 )
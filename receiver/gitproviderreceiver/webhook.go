@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/google/go-github/v62/github"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const (
+	webHookTransport = "http"
+	webHookFormat    = "github"
+
+	// scopeName is the instrumentation scope of the webhook traces and metrics, the same as the scrapers'.
+	scopeName = "otelcol/gitproviderreceiver"
+)
+
+// webHookReceiver receives the GitHub webhook events of the workflow runs, workflow jobs and deployments,
+// and converts them into traces and metrics.
+type webHookReceiver struct {
+	cfg      *WebHookConfig
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+
+	nextTraces  consumer.Traces
+	nextMetrics consumer.Metrics
+
+	server     *http.Server
+	shutdownWG sync.WaitGroup
+}
+
+func newWebHookReceiver(cfg *WebHookConfig, settings receiver.CreateSettings) (*webHookReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		Transport:              webHookTransport,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &webHookReceiver{
+		cfg:      cfg,
+		settings: settings,
+		obsrecv:  obsrecv,
+	}, nil
+}
+
+func (r *webHookReceiver) Start(ctx context.Context, host component.Host) error {
+	ln, err := r.cfg.ToListener(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to bind to address %s: %w", r.cfg.Endpoint, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(r.cfg.Path, r.handleEvent)
+	mux.HandleFunc(r.cfg.HealthPath, r.handleHealthCheck)
+	r.server, err = r.cfg.ToServer(ctx, host, r.settings.TelemetrySettings, mux)
+	if err != nil {
+		return err
+	}
+
+	r.shutdownWG.Add(1)
+	go func() {
+		defer r.shutdownWG.Done()
+		if errHTTP := r.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			r.settings.TelemetrySettings.ReportStatus(component.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+	return nil
+}
+
+func (r *webHookReceiver) Shutdown(ctx context.Context) error {
+	if r.server == nil {
+		return nil
+	}
+	err := r.server.Shutdown(ctx)
+	r.shutdownWG.Wait()
+	return err
+}
+
+func (r *webHookReceiver) handleHealthCheck(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *webHookReceiver) handleEvent(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := github.ValidatePayload(req, []byte(r.cfg.Secret))
+	if err != nil {
+		r.settings.Logger.Debug("Invalid webhook event", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	event, err := github.ParseWebHook(github.WebHookType(req), payload)
+	if err != nil {
+		r.settings.Logger.Debug("Failed to parse the webhook event", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = r.consumeEvent(req.Context(), event); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// consumeEvent passes the traces and metrics of the completed workflow runs, workflow jobs and
+// deployments to the next consumers. The other events are ignored.
+func (r *webHookReceiver) consumeEvent(ctx context.Context, event any) error {
+	traces, metrics := ptrace.NewTraces(), pmetric.NewMetrics()
+	switch e := event.(type) {
+	case *github.WorkflowRunEvent:
+		if e.GetAction() != "completed" {
+			return nil
+		}
+		traces = workflowRunToTraces(e)
+		metrics = workflowRunToMetrics(e)
+	case *github.WorkflowJobEvent:
+		if e.GetAction() != "completed" {
+			return nil
+		}
+		traces = workflowJobToTraces(e)
+	case *github.DeploymentStatusEvent:
+		if !isDeploymentCompleted(e.GetDeploymentStatus().GetState()) {
+			return nil
+		}
+		traces = deploymentToTraces(e)
+		metrics = deploymentToMetrics(e)
+	default:
+		return nil
+	}
+
+	var errs error
+	if r.nextTraces != nil && traces.SpanCount() > 0 {
+		obsCtx := r.obsrecv.StartTracesOp(ctx)
+		err := r.nextTraces.ConsumeTraces(obsCtx, traces)
+		r.obsrecv.EndTracesOp(obsCtx, webHookFormat, traces.SpanCount(), err)
+		errs = errors.Join(errs, err)
+	}
+	if r.nextMetrics != nil && metrics.DataPointCount() > 0 {
+		obsCtx := r.obsrecv.StartMetricsOp(ctx)
+		err := r.nextMetrics.ConsumeMetrics(obsCtx, metrics)
+		r.obsrecv.EndMetricsOp(obsCtx, webHookFormat, metrics.DataPointCount(), err)
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+// metricsReceiver runs the scrapers and the webhook of a metrics pipeline.
+type metricsReceiver struct {
+	scrapers receiver.Metrics
+	webHook  component.Component
+}
+
+func (r *metricsReceiver) Start(ctx context.Context, host component.Host) error {
+	if err := r.scrapers.Start(ctx, host); err != nil {
+		return err
+	}
+	return r.webHook.Start(ctx, host)
+}
+
+func (r *metricsReceiver) Shutdown(ctx context.Context) error {
+	return errors.Join(r.scrapers.Shutdown(ctx), r.webHook.Shutdown(ctx))
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// The webhook metrics are recorded once per event, the counts are therefore delta sums of one data point.
+const (
+	metricWorkflowRunCount    = "git.repository.workflow_run.count"
+	metricWorkflowRunDuration = "git.repository.workflow_run.duration"
+	metricWorkflowRunLeadTime = "git.repository.workflow_run.lead_time"
+	metricDeploymentCount     = "git.repository.deployment.count"
+	metricDeploymentDuration  = "git.repository.deployment.duration"
+)
+
+// workflowRunToMetrics records the completed workflow run, its duration and, for the successful runs, the
+// lead time from the head commit to the completion of the run.
+func workflowRunToMetrics(e *github.WorkflowRunEvent) pmetric.Metrics {
+	run := e.GetWorkflowRun()
+	completedAt := run.GetUpdatedAt().Time
+	metrics, ms := newScopeMetrics(e.GetRepo())
+	putWorkflowRunAttributes := func(attrs pcommon.Map) {
+		attrs.PutStr(attributePipelineName, run.GetName())
+		attrs.PutStr(attributeBranchName, run.GetHeadBranch())
+		attrs.PutStr(attributePipelineRunConclusion, run.GetConclusion())
+	}
+
+	putWorkflowRunAttributes(appendCount(ms, metricWorkflowRunCount, "The number of completed workflow runs.", "{run}", completedAt))
+	putWorkflowRunAttributes(appendDuration(ms, metricWorkflowRunDuration, "The duration of a workflow run.",
+		completedAt.Sub(runStartedAt(run)), completedAt))
+
+	if run.GetConclusion() == workflowConclusionSuccess && run.GetHeadCommit().Timestamp != nil {
+		putWorkflowRunAttributes(appendDuration(ms, metricWorkflowRunLeadTime,
+			"The time from the head commit of a successful workflow run to its completion.",
+			completedAt.Sub(run.GetHeadCommit().GetTimestamp().Time), completedAt))
+	}
+	return metrics
+}
+
+// deploymentToMetrics records the completed deployment and its duration.
+func deploymentToMetrics(e *github.DeploymentStatusEvent) pmetric.Metrics {
+	deployment := e.GetDeployment()
+	status := e.GetDeploymentStatus()
+	completedAt := status.GetCreatedAt().Time
+	metrics, ms := newScopeMetrics(e.GetRepo())
+	putDeploymentAttributes := func(attrs pcommon.Map) {
+		attrs.PutStr(attributeDeploymentEnvironment, deployment.GetEnvironment())
+		attrs.PutStr(attributeDeploymentState, status.GetState())
+	}
+
+	putDeploymentAttributes(appendCount(ms, metricDeploymentCount, "The number of completed deployments.", "{deployment}", completedAt))
+	putDeploymentAttributes(appendDuration(ms, metricDeploymentDuration, "The duration of a deployment.",
+		completedAt.Sub(deployment.GetCreatedAt().Time), completedAt))
+	return metrics
+}
+
+func newScopeMetrics(repo *github.Repository) (pmetric.Metrics, pmetric.MetricSlice) {
+	metrics := pmetric.NewMetrics()
+	rm := metrics.ResourceMetrics().AppendEmpty()
+	putResourceAttributes(rm.Resource(), repo)
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	return metrics, sm.Metrics()
+}
+
+// appendCount appends a delta sum of one, and returns the attributes of its data point.
+func appendCount(ms pmetric.MetricSlice, name, description, unit string, ts time.Time) pcommon.Map {
+	m := ms.AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit(unit)
+	sum := m.SetEmptySum()
+	sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	sum.SetIsMonotonic(true)
+	dp := sum.DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	dp.SetIntValue(1)
+	return dp.Attributes()
+}
+
+// appendDuration appends a gauge of the duration in seconds, and returns the attributes of its data point.
+func appendDuration(ms pmetric.MetricSlice, name, description string, d time.Duration, ts time.Time) pcommon.Map {
+	m := ms.AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit("s")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	dp.SetDoubleValue(d.Seconds())
+	return dp.Attributes()
+}
@@ -0,0 +1,279 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviderreceiver
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+const testSecret = "secret"
+
+var (
+	testRepo = &github.Repository{
+		Name:  github.String("repo"),
+		Owner: &github.User{Login: github.String("org")},
+	}
+	testTime = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func newTestWebHookReceiver(t *testing.T) (*webHookReceiver, *consumertest.TracesSink, *consumertest.MetricsSink) {
+	cfg := createDefaultWebHookConfig()
+	cfg.Secret = testSecret
+	r, err := newWebHookReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	r.nextTraces = new(consumertest.TracesSink)
+	r.nextMetrics = new(consumertest.MetricsSink)
+	return r, r.nextTraces.(*consumertest.TracesSink), r.nextMetrics.(*consumertest.MetricsSink)
+}
+
+func newEventRequest(t *testing.T, eventType string, event any, secret string) *http.Request {
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, defaultWebHookPath, bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(github.EventTypeHeader, eventType)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	req.Header.Set(github.SHA256SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func timestamp(d time.Duration) *github.Timestamp {
+	return &github.Timestamp{Time: testTime.Add(d)}
+}
+
+func testWorkflowRunEvent(action, conclusion string) *github.WorkflowRunEvent {
+	return &github.WorkflowRunEvent{
+		Action: github.String(action),
+		Repo:   testRepo,
+		WorkflowRun: &github.WorkflowRun{
+			ID:           github.Int64(100),
+			Name:         github.String("build"),
+			RunAttempt:   github.Int(1),
+			HeadBranch:   github.String("main"),
+			HeadSHA:      github.String("abc"),
+			Conclusion:   github.String(conclusion),
+			CreatedAt:    timestamp(0),
+			RunStartedAt: timestamp(0),
+			UpdatedAt:    timestamp(5 * time.Minute),
+			HeadCommit:   &github.HeadCommit{Timestamp: timestamp(-10 * time.Minute)},
+		},
+	}
+}
+
+func TestWebHookHandleEvent(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            func(t *testing.T) *http.Request
+		expectedStatus int
+		expectedSpans  int
+		expectedPoints int
+	}{
+		{
+			name: "completed workflow run",
+			req: func(t *testing.T) *http.Request {
+				return newEventRequest(t, "workflow_run", testWorkflowRunEvent("completed", "success"), testSecret)
+			},
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+			expectedPoints: 3,
+		},
+		{
+			name: "failed workflow run",
+			req: func(t *testing.T) *http.Request {
+				return newEventRequest(t, "workflow_run", testWorkflowRunEvent("completed", "failure"), testSecret)
+			},
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+			expectedPoints: 2,
+		},
+		{
+			name: "workflow run in progress",
+			req: func(t *testing.T) *http.Request {
+				return newEventRequest(t, "workflow_run", testWorkflowRunEvent("in_progress", ""), testSecret)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "ping",
+			req: func(t *testing.T) *http.Request {
+				return newEventRequest(t, "ping", &github.PingEvent{Zen: github.String("zen")}, testSecret)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "invalid signature",
+			req: func(t *testing.T) *http.Request {
+				return newEventRequest(t, "workflow_run", testWorkflowRunEvent("completed", "success"), "other")
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unsupported method",
+			req: func(*testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, defaultWebHookPath, nil)
+			},
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, traces, metrics := newTestWebHookReceiver(t)
+			w := httptest.NewRecorder()
+			r.handleEvent(w, tt.req(t))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedSpans, traces.SpanCount())
+			assert.Equal(t, tt.expectedPoints, metrics.DataPointCount())
+		})
+	}
+}
+
+func TestWebHookHandleEvent_ConsumerError(t *testing.T) {
+	r, _, _ := newTestWebHookReceiver(t)
+	r.nextTraces = consumertest.NewErr(errors.New("consumer error"))
+
+	w := httptest.NewRecorder()
+	r.handleEvent(w, newEventRequest(t, "workflow_run", testWorkflowRunEvent("completed", "success"), testSecret))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestWorkflowRunToTraces(t *testing.T) {
+	traces := workflowRunToTraces(testWorkflowRunEvent("completed", "failure"))
+	require.Equal(t, 1, traces.SpanCount())
+
+	rs := traces.ResourceSpans().At(0)
+	assertAttribute(t, rs.Resource().Attributes(), attributeGitVendorName, "github")
+	assertAttribute(t, rs.Resource().Attributes(), attributeOrganizationName, "org")
+	assertAttribute(t, rs.Resource().Attributes(), attributeRepositoryName, "repo")
+
+	span := rs.ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "build", span.Name())
+	assert.Equal(t, traceID("run", 100, 1), span.TraceID())
+	assert.True(t, span.ParentSpanID().IsEmpty())
+	assert.Equal(t, 5*time.Minute, span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime()))
+	assert.Equal(t, ptrace.StatusCodeError, span.Status().Code())
+	assertAttribute(t, span.Attributes(), attributePipelineRunConclusion, "failure")
+	assertAttribute(t, span.Attributes(), attributeBranchName, "main")
+}
+
+func TestWorkflowJobToTraces(t *testing.T) {
+	traces := workflowJobToTraces(&github.WorkflowJobEvent{
+		Action: github.String("completed"),
+		Repo:   testRepo,
+		WorkflowJob: &github.WorkflowJob{
+			ID:          github.Int64(200),
+			RunID:       github.Int64(100),
+			RunAttempt:  github.Int64(1),
+			Name:        github.String("test"),
+			Conclusion:  github.String("success"),
+			RunnerName:  github.String("runner-1"),
+			CreatedAt:   timestamp(0),
+			StartedAt:   timestamp(time.Minute),
+			CompletedAt: timestamp(4 * time.Minute),
+			Steps: []*github.TaskStep{
+				{Name: github.String("checkout"), Number: github.Int64(1), Conclusion: github.String("success"), StartedAt: timestamp(time.Minute), CompletedAt: timestamp(2 * time.Minute)},
+				{Name: github.String("test"), Number: github.Int64(2), Conclusion: github.String("success"), StartedAt: timestamp(2 * time.Minute), CompletedAt: timestamp(4 * time.Minute)},
+				{Name: github.String("skipped"), Number: github.Int64(3), Conclusion: github.String("skipped")},
+			},
+		},
+	})
+
+	spans := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	require.Equal(t, 4, spans.Len())
+
+	job := spans.At(0)
+	assert.Equal(t, "test", job.Name())
+	assert.Equal(t, traceID("run", 100, 1), job.TraceID())
+	assert.Equal(t, spanID("run", 100, 1), job.ParentSpanID())
+	assert.Equal(t, ptrace.StatusCodeOk, job.Status().Code())
+	assertAttribute(t, job.Attributes(), attributeWorkerName, "runner-1")
+
+	queue := spans.At(1)
+	assert.Equal(t, "queued", queue.Name())
+	assert.Equal(t, job.SpanID(), queue.ParentSpanID())
+	assert.Equal(t, time.Minute, queue.EndTimestamp().AsTime().Sub(queue.StartTimestamp().AsTime()))
+
+	for i, name := range []string{"checkout", "test"} {
+		step := spans.At(i + 2)
+		assert.Equal(t, name, step.Name())
+		assert.Equal(t, job.TraceID(), step.TraceID())
+		assert.Equal(t, job.SpanID(), step.ParentSpanID())
+	}
+}
+
+func TestDeploymentToTracesAndMetrics(t *testing.T) {
+	event := &github.DeploymentStatusEvent{
+		Repo: testRepo,
+		Deployment: &github.Deployment{
+			ID:          github.Int64(300),
+			Environment: github.String("production"),
+			CreatedAt:   timestamp(0),
+		},
+		DeploymentStatus: &github.DeploymentStatus{
+			ID:          github.Int64(301),
+			State:       github.String("failure"),
+			Description: github.String("rollout failed"),
+			CreatedAt:   timestamp(2 * time.Minute),
+		},
+	}
+
+	traces := deploymentToTraces(event)
+	require.Equal(t, 1, traces.SpanCount())
+	span := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	assert.Equal(t, "deploy production", span.Name())
+	assert.Equal(t, ptrace.StatusCodeError, span.Status().Code())
+	assert.Equal(t, "rollout failed", span.Status().Message())
+
+	metrics := deploymentToMetrics(event)
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+
+	count := ms.At(0)
+	assert.Equal(t, metricDeploymentCount, count.Name())
+	assert.Equal(t, pmetric.AggregationTemporalityDelta, count.Sum().AggregationTemporality())
+	assert.Equal(t, int64(1), count.Sum().DataPoints().At(0).IntValue())
+	assertAttribute(t, count.Sum().DataPoints().At(0).Attributes(), attributeDeploymentState, "failure")
+	assertAttribute(t, count.Sum().DataPoints().At(0).Attributes(), attributeDeploymentEnvironment, "production")
+
+	duration := ms.At(1)
+	assert.Equal(t, metricDeploymentDuration, duration.Name())
+	assert.Equal(t, 120.0, duration.Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestWorkflowRunToMetrics(t *testing.T) {
+	metrics := workflowRunToMetrics(testWorkflowRunEvent("completed", "success"))
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 3, ms.Len())
+
+	assert.Equal(t, metricWorkflowRunCount, ms.At(0).Name())
+	assert.Equal(t, metricWorkflowRunDuration, ms.At(1).Name())
+	assert.Equal(t, 300.0, ms.At(1).Gauge().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, metricWorkflowRunLeadTime, ms.At(2).Name())
+	assert.Equal(t, 900.0, ms.At(2).Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func assertAttribute(t *testing.T, attrs pcommon.Map, key, expected string) {
+	v, ok := attrs.Get(key)
+	require.True(t, ok, "missing attribute %q", key)
+	assert.Equal(t, expected, v.Str())
+}
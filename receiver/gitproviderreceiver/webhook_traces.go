@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviderreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/gitproviderreceiver"
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const (
+	attributeGitVendorName    = "git.vendor.name"
+	attributeOrganizationName = "organization.name"
+	attributeRepositoryName   = "repository.name"
+	attributeBranchName       = "branch.name"
+	attributeRevision         = "vcs.repository.ref.revision"
+
+	attributePipelineName          = "cicd.pipeline.name"
+	attributePipelineRunID         = "cicd.pipeline.run.id"
+	attributePipelineRunAttempt    = "cicd.pipeline.run.attempt"
+	attributePipelineRunURL        = "cicd.pipeline.run.url.full"
+	attributePipelineRunConclusion = "cicd.pipeline.run.conclusion"
+	attributeTaskName              = "cicd.pipeline.task.name"
+	attributeTaskRunID             = "cicd.pipeline.task.run.id"
+	attributeTaskRunURL            = "cicd.pipeline.task.run.url.full"
+	attributeTaskRunConclusion     = "cicd.pipeline.task.run.conclusion"
+	attributeTaskStepNumber        = "cicd.pipeline.task.step.number"
+	attributeWorkerName            = "cicd.worker.name"
+
+	attributeDeploymentEnvironment = "deployment.environment"
+	attributeDeploymentID          = "deployment.id"
+	attributeDeploymentState       = "deployment.state"
+
+	gitVendorGitHub = "github"
+
+	deploymentStateSuccess = "success"
+	deploymentStateFailure = "failure"
+	deploymentStateError   = "error"
+
+	workflowConclusionSuccess        = "success"
+	workflowConclusionFailure        = "failure"
+	workflowConclusionTimedOut       = "timed_out"
+	workflowConclusionStartupFailure = "startup_failure"
+)
+
+// traceID derives a trace ID from the kind and the IDs of a GitHub object. The spans of a workflow run and
+// of its jobs, which are received in separate events, share the trace ID derived from the run ID and attempt.
+func traceID(kind string, ids ...int64) pcommon.TraceID {
+	var id pcommon.TraceID
+	copy(id[:], hashIDs(kind, ids))
+	return id
+}
+
+// spanID derives a span ID from the kind and the IDs of a GitHub object, so that the span of a job can
+// reference the span of its run as its parent.
+func spanID(kind string, ids ...int64) pcommon.SpanID {
+	var id pcommon.SpanID
+	copy(id[:], hashIDs(kind, ids))
+	return id
+}
+
+func hashIDs(kind string, ids []int64) []byte {
+	key := kind
+	for _, id := range ids {
+		key += fmt.Sprintf("-%d", id)
+	}
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
+
+func isDeploymentCompleted(state string) bool {
+	return state == deploymentStateSuccess || state == deploymentStateFailure || state == deploymentStateError
+}
+
+// workflowRunToTraces converts a completed workflow run into its root span, from the start of the run to
+// its completion.
+func workflowRunToTraces(e *github.WorkflowRunEvent) ptrace.Traces {
+	run := e.GetWorkflowRun()
+	traces := ptrace.NewTraces()
+	spans := newScopeSpans(traces, e.GetRepo())
+
+	span := spans.AppendEmpty()
+	span.SetTraceID(traceID("run", run.GetID(), int64(run.GetRunAttempt())))
+	span.SetSpanID(spanID("run", run.GetID(), int64(run.GetRunAttempt())))
+	span.SetName(run.GetName())
+	span.SetKind(ptrace.SpanKindServer)
+	setSpanTimes(span, runStartedAt(run), run.GetUpdatedAt().Time)
+	setSpanStatus(span, run.GetConclusion())
+
+	attrs := span.Attributes()
+	attrs.PutStr(attributePipelineName, run.GetName())
+	attrs.PutInt(attributePipelineRunID, run.GetID())
+	attrs.PutInt(attributePipelineRunAttempt, int64(run.GetRunAttempt()))
+	attrs.PutStr(attributePipelineRunURL, run.GetHTMLURL())
+	attrs.PutStr(attributePipelineRunConclusion, run.GetConclusion())
+	attrs.PutStr(attributeBranchName, run.GetHeadBranch())
+	attrs.PutStr(attributeRevision, run.GetHeadSHA())
+	return traces
+}
+
+// workflowJobToTraces converts a completed workflow job into a span, child of the span of its run, with a
+// child span for the time the job was queued and a child span for each of its steps.
+func workflowJobToTraces(e *github.WorkflowJobEvent) ptrace.Traces {
+	job := e.GetWorkflowJob()
+	traces := ptrace.NewTraces()
+	spans := newScopeSpans(traces, e.GetRepo())
+
+	runTraceID := traceID("run", job.GetRunID(), job.GetRunAttempt())
+	jobSpanID := spanID("job", job.GetID())
+
+	span := spans.AppendEmpty()
+	span.SetTraceID(runTraceID)
+	span.SetSpanID(jobSpanID)
+	span.SetParentSpanID(spanID("run", job.GetRunID(), job.GetRunAttempt()))
+	span.SetName(job.GetName())
+	span.SetKind(ptrace.SpanKindInternal)
+	setSpanTimes(span, job.GetCreatedAt().Time, job.GetCompletedAt().Time)
+	setSpanStatus(span, job.GetConclusion())
+
+	attrs := span.Attributes()
+	attrs.PutStr(attributePipelineName, job.GetWorkflowName())
+	attrs.PutInt(attributePipelineRunID, job.GetRunID())
+	attrs.PutInt(attributePipelineRunAttempt, job.GetRunAttempt())
+	attrs.PutStr(attributeTaskName, job.GetName())
+	attrs.PutInt(attributeTaskRunID, job.GetID())
+	attrs.PutStr(attributeTaskRunURL, job.GetHTMLURL())
+	attrs.PutStr(attributeTaskRunConclusion, job.GetConclusion())
+	attrs.PutStr(attributeWorkerName, job.GetRunnerName())
+	attrs.PutStr(attributeBranchName, job.GetHeadBranch())
+	attrs.PutStr(attributeRevision, job.GetHeadSHA())
+
+	// the job is queued until a runner picks it up
+	if job.StartedAt != nil && job.CreatedAt != nil {
+		queue := spans.AppendEmpty()
+		queue.SetTraceID(runTraceID)
+		queue.SetSpanID(spanID("queue", job.GetID()))
+		queue.SetParentSpanID(jobSpanID)
+		queue.SetName("queued")
+		queue.SetKind(ptrace.SpanKindInternal)
+		setSpanTimes(queue, job.GetCreatedAt().Time, job.GetStartedAt().Time)
+		queue.Attributes().PutStr(attributeWorkerName, job.GetRunnerName())
+	}
+
+	for _, step := range job.Steps {
+		if step.StartedAt == nil || step.CompletedAt == nil {
+			// the step was skipped
+			continue
+		}
+		stepSpan := spans.AppendEmpty()
+		stepSpan.SetTraceID(runTraceID)
+		stepSpan.SetSpanID(spanID("step", job.GetID(), step.GetNumber()))
+		stepSpan.SetParentSpanID(jobSpanID)
+		stepSpan.SetName(step.GetName())
+		stepSpan.SetKind(ptrace.SpanKindInternal)
+		setSpanTimes(stepSpan, step.GetStartedAt().Time, step.GetCompletedAt().Time)
+		setSpanStatus(stepSpan, step.GetConclusion())
+		stepSpan.Attributes().PutInt(attributeTaskStepNumber, step.GetNumber())
+		stepSpan.Attributes().PutStr(attributeTaskRunConclusion, step.GetConclusion())
+	}
+	return traces
+}
+
+// deploymentToTraces converts a completed deployment into a span, from the creation of the deployment to
+// its final status.
+func deploymentToTraces(e *github.DeploymentStatusEvent) ptrace.Traces {
+	deployment := e.GetDeployment()
+	status := e.GetDeploymentStatus()
+	traces := ptrace.NewTraces()
+	spans := newScopeSpans(traces, e.GetRepo())
+
+	span := spans.AppendEmpty()
+	span.SetTraceID(traceID("deployment", deployment.GetID()))
+	span.SetSpanID(spanID("deployment", deployment.GetID(), status.GetID()))
+	span.SetName("deploy " + deployment.GetEnvironment())
+	span.SetKind(ptrace.SpanKindInternal)
+	setSpanTimes(span, deployment.GetCreatedAt().Time, status.GetCreatedAt().Time)
+	if status.GetState() == deploymentStateSuccess {
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	} else {
+		span.Status().SetCode(ptrace.StatusCodeError)
+		span.Status().SetMessage(status.GetDescription())
+	}
+
+	attrs := span.Attributes()
+	attrs.PutInt(attributeDeploymentID, deployment.GetID())
+	attrs.PutStr(attributeDeploymentEnvironment, deployment.GetEnvironment())
+	attrs.PutStr(attributeDeploymentState, status.GetState())
+	attrs.PutStr(attributeBranchName, deployment.GetRef())
+	attrs.PutStr(attributeRevision, deployment.GetSHA())
+	return traces
+}
+
+func newScopeSpans(traces ptrace.Traces, repo *github.Repository) ptrace.SpanSlice {
+	rs := traces.ResourceSpans().AppendEmpty()
+	putResourceAttributes(rs.Resource(), repo)
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName(scopeName)
+	return ss.Spans()
+}
+
+func putResourceAttributes(resource pcommon.Resource, repo *github.Repository) {
+	attrs := resource.Attributes()
+	attrs.PutStr(attributeGitVendorName, gitVendorGitHub)
+	attrs.PutStr(attributeOrganizationName, repo.GetOwner().GetLogin())
+	attrs.PutStr(attributeRepositoryName, repo.GetName())
+}
+
+// runStartedAt returns the time the attempt of the run started, which is the creation time of the run for
+// its first attempt.
+func runStartedAt(run *github.WorkflowRun) time.Time {
+	if run.RunStartedAt != nil {
+		return run.GetRunStartedAt().Time
+	}
+	return run.GetCreatedAt().Time
+}
+
+func setSpanTimes(span ptrace.Span, start, end time.Time) {
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+}
+
+func setSpanStatus(span ptrace.Span, conclusion string) {
+	switch conclusion {
+	case workflowConclusionSuccess:
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	case workflowConclusionFailure, workflowConclusionTimedOut, workflowConclusionStartupFailure:
+		span.Status().SetCode(ptrace.StatusCodeError)
+		span.Status().SetMessage(conclusion)
+	}
+}
@@ -28,32 +28,37 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for haproxy metrics.
 type MetricsConfig struct {
-	HaproxyBytesInput           MetricConfig `mapstructure:"haproxy.bytes.input"`
-	HaproxyBytesOutput          MetricConfig `mapstructure:"haproxy.bytes.output"`
-	HaproxyClientsCanceled      MetricConfig `mapstructure:"haproxy.clients.canceled"`
-	HaproxyCompressionBypass    MetricConfig `mapstructure:"haproxy.compression.bypass"`
-	HaproxyCompressionCount     MetricConfig `mapstructure:"haproxy.compression.count"`
-	HaproxyCompressionInput     MetricConfig `mapstructure:"haproxy.compression.input"`
-	HaproxyCompressionOutput    MetricConfig `mapstructure:"haproxy.compression.output"`
-	HaproxyConnectionsErrors    MetricConfig `mapstructure:"haproxy.connections.errors"`
-	HaproxyConnectionsRate      MetricConfig `mapstructure:"haproxy.connections.rate"`
-	HaproxyConnectionsRetries   MetricConfig `mapstructure:"haproxy.connections.retries"`
-	HaproxyConnectionsTotal     MetricConfig `mapstructure:"haproxy.connections.total"`
-	HaproxyDowntime             MetricConfig `mapstructure:"haproxy.downtime"`
-	HaproxyFailedChecks         MetricConfig `mapstructure:"haproxy.failed_checks"`
-	HaproxyRequestsDenied       MetricConfig `mapstructure:"haproxy.requests.denied"`
-	HaproxyRequestsErrors       MetricConfig `mapstructure:"haproxy.requests.errors"`
-	HaproxyRequestsQueued       MetricConfig `mapstructure:"haproxy.requests.queued"`
-	HaproxyRequestsRate         MetricConfig `mapstructure:"haproxy.requests.rate"`
-	HaproxyRequestsRedispatched MetricConfig `mapstructure:"haproxy.requests.redispatched"`
-	HaproxyRequestsTotal        MetricConfig `mapstructure:"haproxy.requests.total"`
-	HaproxyResponsesDenied      MetricConfig `mapstructure:"haproxy.responses.denied"`
-	HaproxyResponsesErrors      MetricConfig `mapstructure:"haproxy.responses.errors"`
-	HaproxyServerSelectedTotal  MetricConfig `mapstructure:"haproxy.server_selected.total"`
-	HaproxySessionsAverage      MetricConfig `mapstructure:"haproxy.sessions.average"`
-	HaproxySessionsCount        MetricConfig `mapstructure:"haproxy.sessions.count"`
-	HaproxySessionsRate         MetricConfig `mapstructure:"haproxy.sessions.rate"`
-	HaproxySessionsTotal        MetricConfig `mapstructure:"haproxy.sessions.total"`
+	HaproxyBytesInput             MetricConfig `mapstructure:"haproxy.bytes.input"`
+	HaproxyBytesOutput            MetricConfig `mapstructure:"haproxy.bytes.output"`
+	HaproxyClientsCanceled        MetricConfig `mapstructure:"haproxy.clients.canceled"`
+	HaproxyCompressionBypass      MetricConfig `mapstructure:"haproxy.compression.bypass"`
+	HaproxyCompressionCount       MetricConfig `mapstructure:"haproxy.compression.count"`
+	HaproxyCompressionInput       MetricConfig `mapstructure:"haproxy.compression.input"`
+	HaproxyCompressionOutput      MetricConfig `mapstructure:"haproxy.compression.output"`
+	HaproxyConnectionsAverageTime MetricConfig `mapstructure:"haproxy.connections.average_time"`
+	HaproxyConnectionsErrors      MetricConfig `mapstructure:"haproxy.connections.errors"`
+	HaproxyConnectionsRate        MetricConfig `mapstructure:"haproxy.connections.rate"`
+	HaproxyConnectionsRetries     MetricConfig `mapstructure:"haproxy.connections.retries"`
+	HaproxyConnectionsTotal       MetricConfig `mapstructure:"haproxy.connections.total"`
+	HaproxyDowntime               MetricConfig `mapstructure:"haproxy.downtime"`
+	HaproxyFailedChecks           MetricConfig `mapstructure:"haproxy.failed_checks"`
+	HaproxyQueueAverageTime       MetricConfig `mapstructure:"haproxy.queue.average_time"`
+	HaproxyRequestsDenied         MetricConfig `mapstructure:"haproxy.requests.denied"`
+	HaproxyRequestsErrors         MetricConfig `mapstructure:"haproxy.requests.errors"`
+	HaproxyRequestsQueued         MetricConfig `mapstructure:"haproxy.requests.queued"`
+	HaproxyRequestsRate           MetricConfig `mapstructure:"haproxy.requests.rate"`
+	HaproxyRequestsRedispatched   MetricConfig `mapstructure:"haproxy.requests.redispatched"`
+	HaproxyRequestsTotal          MetricConfig `mapstructure:"haproxy.requests.total"`
+	HaproxyResponsesAverageTime   MetricConfig `mapstructure:"haproxy.responses.average_time"`
+	HaproxyResponsesDenied        MetricConfig `mapstructure:"haproxy.responses.denied"`
+	HaproxyResponsesErrors        MetricConfig `mapstructure:"haproxy.responses.errors"`
+	HaproxyServerCheckDuration    MetricConfig `mapstructure:"haproxy.server.check.duration"`
+	HaproxyServerStatus           MetricConfig `mapstructure:"haproxy.server.status"`
+	HaproxyServerSelectedTotal    MetricConfig `mapstructure:"haproxy.server_selected.total"`
+	HaproxySessionsAverage        MetricConfig `mapstructure:"haproxy.sessions.average"`
+	HaproxySessionsCount          MetricConfig `mapstructure:"haproxy.sessions.count"`
+	HaproxySessionsRate           MetricConfig `mapstructure:"haproxy.sessions.rate"`
+	HaproxySessionsTotal          MetricConfig `mapstructure:"haproxy.sessions.total"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -79,6 +84,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		HaproxyCompressionOutput: MetricConfig{
 			Enabled: false,
 		},
+		HaproxyConnectionsAverageTime: MetricConfig{
+			Enabled: false,
+		},
 		HaproxyConnectionsErrors: MetricConfig{
 			Enabled: true,
 		},
@@ -97,6 +105,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		HaproxyFailedChecks: MetricConfig{
 			Enabled: false,
 		},
+		HaproxyQueueAverageTime: MetricConfig{
+			Enabled: false,
+		},
 		HaproxyRequestsDenied: MetricConfig{
 			Enabled: true,
 		},
@@ -115,12 +126,21 @@ func DefaultMetricsConfig() MetricsConfig {
 		HaproxyRequestsTotal: MetricConfig{
 			Enabled: true,
 		},
+		HaproxyResponsesAverageTime: MetricConfig{
+			Enabled: false,
+		},
 		HaproxyResponsesDenied: MetricConfig{
 			Enabled: true,
 		},
 		HaproxyResponsesErrors: MetricConfig{
 			Enabled: true,
 		},
+		HaproxyServerCheckDuration: MetricConfig{
+			Enabled: false,
+		},
+		HaproxyServerStatus: MetricConfig{
+			Enabled: false,
+		},
 		HaproxyServerSelectedTotal: MetricConfig{
 			Enabled: true,
 		},
@@ -25,32 +25,37 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					HaproxyBytesInput:           MetricConfig{Enabled: true},
-					HaproxyBytesOutput:          MetricConfig{Enabled: true},
-					HaproxyClientsCanceled:      MetricConfig{Enabled: true},
-					HaproxyCompressionBypass:    MetricConfig{Enabled: true},
-					HaproxyCompressionCount:     MetricConfig{Enabled: true},
-					HaproxyCompressionInput:     MetricConfig{Enabled: true},
-					HaproxyCompressionOutput:    MetricConfig{Enabled: true},
-					HaproxyConnectionsErrors:    MetricConfig{Enabled: true},
-					HaproxyConnectionsRate:      MetricConfig{Enabled: true},
-					HaproxyConnectionsRetries:   MetricConfig{Enabled: true},
-					HaproxyConnectionsTotal:     MetricConfig{Enabled: true},
-					HaproxyDowntime:             MetricConfig{Enabled: true},
-					HaproxyFailedChecks:         MetricConfig{Enabled: true},
-					HaproxyRequestsDenied:       MetricConfig{Enabled: true},
-					HaproxyRequestsErrors:       MetricConfig{Enabled: true},
-					HaproxyRequestsQueued:       MetricConfig{Enabled: true},
-					HaproxyRequestsRate:         MetricConfig{Enabled: true},
-					HaproxyRequestsRedispatched: MetricConfig{Enabled: true},
-					HaproxyRequestsTotal:        MetricConfig{Enabled: true},
-					HaproxyResponsesDenied:      MetricConfig{Enabled: true},
-					HaproxyResponsesErrors:      MetricConfig{Enabled: true},
-					HaproxyServerSelectedTotal:  MetricConfig{Enabled: true},
-					HaproxySessionsAverage:      MetricConfig{Enabled: true},
-					HaproxySessionsCount:        MetricConfig{Enabled: true},
-					HaproxySessionsRate:         MetricConfig{Enabled: true},
-					HaproxySessionsTotal:        MetricConfig{Enabled: true},
+					HaproxyBytesInput:             MetricConfig{Enabled: true},
+					HaproxyBytesOutput:            MetricConfig{Enabled: true},
+					HaproxyClientsCanceled:        MetricConfig{Enabled: true},
+					HaproxyCompressionBypass:      MetricConfig{Enabled: true},
+					HaproxyCompressionCount:       MetricConfig{Enabled: true},
+					HaproxyCompressionInput:       MetricConfig{Enabled: true},
+					HaproxyCompressionOutput:      MetricConfig{Enabled: true},
+					HaproxyConnectionsAverageTime: MetricConfig{Enabled: true},
+					HaproxyConnectionsErrors:      MetricConfig{Enabled: true},
+					HaproxyConnectionsRate:        MetricConfig{Enabled: true},
+					HaproxyConnectionsRetries:     MetricConfig{Enabled: true},
+					HaproxyConnectionsTotal:       MetricConfig{Enabled: true},
+					HaproxyDowntime:               MetricConfig{Enabled: true},
+					HaproxyFailedChecks:           MetricConfig{Enabled: true},
+					HaproxyQueueAverageTime:       MetricConfig{Enabled: true},
+					HaproxyRequestsDenied:         MetricConfig{Enabled: true},
+					HaproxyRequestsErrors:         MetricConfig{Enabled: true},
+					HaproxyRequestsQueued:         MetricConfig{Enabled: true},
+					HaproxyRequestsRate:           MetricConfig{Enabled: true},
+					HaproxyRequestsRedispatched:   MetricConfig{Enabled: true},
+					HaproxyRequestsTotal:          MetricConfig{Enabled: true},
+					HaproxyResponsesAverageTime:   MetricConfig{Enabled: true},
+					HaproxyResponsesDenied:        MetricConfig{Enabled: true},
+					HaproxyResponsesErrors:        MetricConfig{Enabled: true},
+					HaproxyServerCheckDuration:    MetricConfig{Enabled: true},
+					HaproxyServerStatus:           MetricConfig{Enabled: true},
+					HaproxyServerSelectedTotal:    MetricConfig{Enabled: true},
+					HaproxySessionsAverage:        MetricConfig{Enabled: true},
+					HaproxySessionsCount:          MetricConfig{Enabled: true},
+					HaproxySessionsRate:           MetricConfig{Enabled: true},
+					HaproxySessionsTotal:          MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					HaproxyAddr:        ResourceAttributeConfig{Enabled: true},
@@ -63,32 +68,37 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					HaproxyBytesInput:           MetricConfig{Enabled: false},
-					HaproxyBytesOutput:          MetricConfig{Enabled: false},
-					HaproxyClientsCanceled:      MetricConfig{Enabled: false},
-					HaproxyCompressionBypass:    MetricConfig{Enabled: false},
-					HaproxyCompressionCount:     MetricConfig{Enabled: false},
-					HaproxyCompressionInput:     MetricConfig{Enabled: false},
-					HaproxyCompressionOutput:    MetricConfig{Enabled: false},
-					HaproxyConnectionsErrors:    MetricConfig{Enabled: false},
-					HaproxyConnectionsRate:      MetricConfig{Enabled: false},
-					HaproxyConnectionsRetries:   MetricConfig{Enabled: false},
-					HaproxyConnectionsTotal:     MetricConfig{Enabled: false},
-					HaproxyDowntime:             MetricConfig{Enabled: false},
-					HaproxyFailedChecks:         MetricConfig{Enabled: false},
-					HaproxyRequestsDenied:       MetricConfig{Enabled: false},
-					HaproxyRequestsErrors:       MetricConfig{Enabled: false},
-					HaproxyRequestsQueued:       MetricConfig{Enabled: false},
-					HaproxyRequestsRate:         MetricConfig{Enabled: false},
-					HaproxyRequestsRedispatched: MetricConfig{Enabled: false},
-					HaproxyRequestsTotal:        MetricConfig{Enabled: false},
-					HaproxyResponsesDenied:      MetricConfig{Enabled: false},
-					HaproxyResponsesErrors:      MetricConfig{Enabled: false},
-					HaproxyServerSelectedTotal:  MetricConfig{Enabled: false},
-					HaproxySessionsAverage:      MetricConfig{Enabled: false},
-					HaproxySessionsCount:        MetricConfig{Enabled: false},
-					HaproxySessionsRate:         MetricConfig{Enabled: false},
-					HaproxySessionsTotal:        MetricConfig{Enabled: false},
+					HaproxyBytesInput:             MetricConfig{Enabled: false},
+					HaproxyBytesOutput:            MetricConfig{Enabled: false},
+					HaproxyClientsCanceled:        MetricConfig{Enabled: false},
+					HaproxyCompressionBypass:      MetricConfig{Enabled: false},
+					HaproxyCompressionCount:       MetricConfig{Enabled: false},
+					HaproxyCompressionInput:       MetricConfig{Enabled: false},
+					HaproxyCompressionOutput:      MetricConfig{Enabled: false},
+					HaproxyConnectionsAverageTime: MetricConfig{Enabled: false},
+					HaproxyConnectionsErrors:      MetricConfig{Enabled: false},
+					HaproxyConnectionsRate:        MetricConfig{Enabled: false},
+					HaproxyConnectionsRetries:     MetricConfig{Enabled: false},
+					HaproxyConnectionsTotal:       MetricConfig{Enabled: false},
+					HaproxyDowntime:               MetricConfig{Enabled: false},
+					HaproxyFailedChecks:           MetricConfig{Enabled: false},
+					HaproxyQueueAverageTime:       MetricConfig{Enabled: false},
+					HaproxyRequestsDenied:         MetricConfig{Enabled: false},
+					HaproxyRequestsErrors:         MetricConfig{Enabled: false},
+					HaproxyRequestsQueued:         MetricConfig{Enabled: false},
+					HaproxyRequestsRate:           MetricConfig{Enabled: false},
+					HaproxyRequestsRedispatched:   MetricConfig{Enabled: false},
+					HaproxyRequestsTotal:          MetricConfig{Enabled: false},
+					HaproxyResponsesAverageTime:   MetricConfig{Enabled: false},
+					HaproxyResponsesDenied:        MetricConfig{Enabled: false},
+					HaproxyResponsesErrors:        MetricConfig{Enabled: false},
+					HaproxyServerCheckDuration:    MetricConfig{Enabled: false},
+					HaproxyServerStatus:           MetricConfig{Enabled: false},
+					HaproxyServerSelectedTotal:    MetricConfig{Enabled: false},
+					HaproxySessionsAverage:        MetricConfig{Enabled: false},
+					HaproxySessionsCount:          MetricConfig{Enabled: false},
+					HaproxySessionsRate:           MetricConfig{Enabled: false},
+					HaproxySessionsTotal:          MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					HaproxyAddr:        ResourceAttributeConfig{Enabled: false},
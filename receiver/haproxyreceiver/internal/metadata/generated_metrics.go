@@ -14,6 +14,48 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeServerStatus specifies the a value server_status attribute.
+type AttributeServerStatus int
+
+const (
+	_ AttributeServerStatus = iota
+	AttributeServerStatusUp
+	AttributeServerStatusDown
+	AttributeServerStatusNolb
+	AttributeServerStatusMaint
+	AttributeServerStatusDrain
+	AttributeServerStatusNoCheck
+)
+
+// String returns the string representation of the AttributeServerStatus.
+func (av AttributeServerStatus) String() string {
+	switch av {
+	case AttributeServerStatusUp:
+		return "up"
+	case AttributeServerStatusDown:
+		return "down"
+	case AttributeServerStatusNolb:
+		return "nolb"
+	case AttributeServerStatusMaint:
+		return "maint"
+	case AttributeServerStatusDrain:
+		return "drain"
+	case AttributeServerStatusNoCheck:
+		return "no_check"
+	}
+	return ""
+}
+
+// MapAttributeServerStatus is a helper map of string to AttributeServerStatus attribute value.
+var MapAttributeServerStatus = map[string]AttributeServerStatus{
+	"up":       AttributeServerStatusUp,
+	"down":     AttributeServerStatusDown,
+	"nolb":     AttributeServerStatusNolb,
+	"maint":    AttributeServerStatusMaint,
+	"drain":    AttributeServerStatusDrain,
+	"no_check": AttributeServerStatusNoCheck,
+}
+
 // AttributeStatusCode specifies the a value status_code attribute.
 type AttributeStatusCode int
 
@@ -413,6 +455,55 @@ func newMetricHaproxyCompressionOutput(cfg MetricConfig) metricHaproxyCompressio
 	return m
 }
 
+type metricHaproxyConnectionsAverageTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.connections.average_time metric with initial data.
+func (m *metricHaproxyConnectionsAverageTime) init() {
+	m.data.SetName("haproxy.connections.average_time")
+	m.data.SetDescription("Average connect time in ms over the last 1024 requests. Corresponds to HAProxy's `ctime` metric.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyConnectionsAverageTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyConnectionsAverageTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyConnectionsAverageTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyConnectionsAverageTime(cfg MetricConfig) metricHaproxyConnectionsAverageTime {
+	m := metricHaproxyConnectionsAverageTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHaproxyConnectionsErrors struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -717,6 +808,55 @@ func newMetricHaproxyFailedChecks(cfg MetricConfig) metricHaproxyFailedChecks {
 	return m
 }
 
+type metricHaproxyQueueAverageTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.queue.average_time metric with initial data.
+func (m *metricHaproxyQueueAverageTime) init() {
+	m.data.SetName("haproxy.queue.average_time")
+	m.data.SetDescription("Average queue time in ms over the last 1024 requests. Corresponds to HAProxy's `qtime` metric.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyQueueAverageTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyQueueAverageTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyQueueAverageTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyQueueAverageTime(cfg MetricConfig) metricHaproxyQueueAverageTime {
+	m := metricHaproxyQueueAverageTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHaproxyRequestsDenied struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1023,6 +1163,55 @@ func newMetricHaproxyRequestsTotal(cfg MetricConfig) metricHaproxyRequestsTotal
 	return m
 }
 
+type metricHaproxyResponsesAverageTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.responses.average_time metric with initial data.
+func (m *metricHaproxyResponsesAverageTime) init() {
+	m.data.SetName("haproxy.responses.average_time")
+	m.data.SetDescription("Average response time in ms over the last 1024 requests. Corresponds to HAProxy's `rtime` metric.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyResponsesAverageTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyResponsesAverageTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyResponsesAverageTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyResponsesAverageTime(cfg MetricConfig) metricHaproxyResponsesAverageTime {
+	m := metricHaproxyResponsesAverageTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHaproxyResponsesDenied struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1125,6 +1314,106 @@ func newMetricHaproxyResponsesErrors(cfg MetricConfig) metricHaproxyResponsesErr
 	return m
 }
 
+type metricHaproxyServerCheckDuration struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.server.check.duration metric with initial data.
+func (m *metricHaproxyServerCheckDuration) init() {
+	m.data.SetName("haproxy.server.check.duration")
+	m.data.SetDescription("Time in ms taken to finish the last health check of the server. Corresponds to HAProxy's `check_duration` metric.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricHaproxyServerCheckDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyServerCheckDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyServerCheckDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyServerCheckDuration(cfg MetricConfig) metricHaproxyServerCheckDuration {
+	m := metricHaproxyServerCheckDuration{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricHaproxyServerStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills haproxy.server.status metric with initial data.
+func (m *metricHaproxyServerStatus) init() {
+	m.data.SetName("haproxy.server.status")
+	m.data.SetDescription("Status of the server or backend, 1 for its current status and 0 for the others. Corresponds to HAProxy's `status` metric.")
+	m.data.SetUnit("{status}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHaproxyServerStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serverStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("status", serverStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHaproxyServerStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHaproxyServerStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHaproxyServerStatus(cfg MetricConfig) metricHaproxyServerStatus {
+	m := metricHaproxyServerStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricHaproxyServerSelectedTotal struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1377,39 +1666,44 @@ func newMetricHaproxySessionsTotal(cfg MetricConfig) metricHaproxySessionsTotal
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                            MetricsBuilderConfig // config of the metrics builder.
-	startTime                         pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                   int                  // maximum observed number of metrics per resource.
-	metricsBuffer                     pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                         component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter    map[string]filter.Filter
-	resourceAttributeExcludeFilter    map[string]filter.Filter
-	metricHaproxyBytesInput           metricHaproxyBytesInput
-	metricHaproxyBytesOutput          metricHaproxyBytesOutput
-	metricHaproxyClientsCanceled      metricHaproxyClientsCanceled
-	metricHaproxyCompressionBypass    metricHaproxyCompressionBypass
-	metricHaproxyCompressionCount     metricHaproxyCompressionCount
-	metricHaproxyCompressionInput     metricHaproxyCompressionInput
-	metricHaproxyCompressionOutput    metricHaproxyCompressionOutput
-	metricHaproxyConnectionsErrors    metricHaproxyConnectionsErrors
-	metricHaproxyConnectionsRate      metricHaproxyConnectionsRate
-	metricHaproxyConnectionsRetries   metricHaproxyConnectionsRetries
-	metricHaproxyConnectionsTotal     metricHaproxyConnectionsTotal
-	metricHaproxyDowntime             metricHaproxyDowntime
-	metricHaproxyFailedChecks         metricHaproxyFailedChecks
-	metricHaproxyRequestsDenied       metricHaproxyRequestsDenied
-	metricHaproxyRequestsErrors       metricHaproxyRequestsErrors
-	metricHaproxyRequestsQueued       metricHaproxyRequestsQueued
-	metricHaproxyRequestsRate         metricHaproxyRequestsRate
-	metricHaproxyRequestsRedispatched metricHaproxyRequestsRedispatched
-	metricHaproxyRequestsTotal        metricHaproxyRequestsTotal
-	metricHaproxyResponsesDenied      metricHaproxyResponsesDenied
-	metricHaproxyResponsesErrors      metricHaproxyResponsesErrors
-	metricHaproxyServerSelectedTotal  metricHaproxyServerSelectedTotal
-	metricHaproxySessionsAverage      metricHaproxySessionsAverage
-	metricHaproxySessionsCount        metricHaproxySessionsCount
-	metricHaproxySessionsRate         metricHaproxySessionsRate
-	metricHaproxySessionsTotal        metricHaproxySessionsTotal
+	config                              MetricsBuilderConfig // config of the metrics builder.
+	startTime                           pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                     int                  // maximum observed number of metrics per resource.
+	metricsBuffer                       pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                           component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter      map[string]filter.Filter
+	resourceAttributeExcludeFilter      map[string]filter.Filter
+	metricHaproxyBytesInput             metricHaproxyBytesInput
+	metricHaproxyBytesOutput            metricHaproxyBytesOutput
+	metricHaproxyClientsCanceled        metricHaproxyClientsCanceled
+	metricHaproxyCompressionBypass      metricHaproxyCompressionBypass
+	metricHaproxyCompressionCount       metricHaproxyCompressionCount
+	metricHaproxyCompressionInput       metricHaproxyCompressionInput
+	metricHaproxyCompressionOutput      metricHaproxyCompressionOutput
+	metricHaproxyConnectionsAverageTime metricHaproxyConnectionsAverageTime
+	metricHaproxyConnectionsErrors      metricHaproxyConnectionsErrors
+	metricHaproxyConnectionsRate        metricHaproxyConnectionsRate
+	metricHaproxyConnectionsRetries     metricHaproxyConnectionsRetries
+	metricHaproxyConnectionsTotal       metricHaproxyConnectionsTotal
+	metricHaproxyDowntime               metricHaproxyDowntime
+	metricHaproxyFailedChecks           metricHaproxyFailedChecks
+	metricHaproxyQueueAverageTime       metricHaproxyQueueAverageTime
+	metricHaproxyRequestsDenied         metricHaproxyRequestsDenied
+	metricHaproxyRequestsErrors         metricHaproxyRequestsErrors
+	metricHaproxyRequestsQueued         metricHaproxyRequestsQueued
+	metricHaproxyRequestsRate           metricHaproxyRequestsRate
+	metricHaproxyRequestsRedispatched   metricHaproxyRequestsRedispatched
+	metricHaproxyRequestsTotal          metricHaproxyRequestsTotal
+	metricHaproxyResponsesAverageTime   metricHaproxyResponsesAverageTime
+	metricHaproxyResponsesDenied        metricHaproxyResponsesDenied
+	metricHaproxyResponsesErrors        metricHaproxyResponsesErrors
+	metricHaproxyServerCheckDuration    metricHaproxyServerCheckDuration
+	metricHaproxyServerStatus           metricHaproxyServerStatus
+	metricHaproxyServerSelectedTotal    metricHaproxyServerSelectedTotal
+	metricHaproxySessionsAverage        metricHaproxySessionsAverage
+	metricHaproxySessionsCount          metricHaproxySessionsCount
+	metricHaproxySessionsRate           metricHaproxySessionsRate
+	metricHaproxySessionsTotal          metricHaproxySessionsTotal
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1424,38 +1718,43 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                            mbc,
-		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                     pmetric.NewMetrics(),
-		buildInfo:                         settings.BuildInfo,
-		metricHaproxyBytesInput:           newMetricHaproxyBytesInput(mbc.Metrics.HaproxyBytesInput),
-		metricHaproxyBytesOutput:          newMetricHaproxyBytesOutput(mbc.Metrics.HaproxyBytesOutput),
-		metricHaproxyClientsCanceled:      newMetricHaproxyClientsCanceled(mbc.Metrics.HaproxyClientsCanceled),
-		metricHaproxyCompressionBypass:    newMetricHaproxyCompressionBypass(mbc.Metrics.HaproxyCompressionBypass),
-		metricHaproxyCompressionCount:     newMetricHaproxyCompressionCount(mbc.Metrics.HaproxyCompressionCount),
-		metricHaproxyCompressionInput:     newMetricHaproxyCompressionInput(mbc.Metrics.HaproxyCompressionInput),
-		metricHaproxyCompressionOutput:    newMetricHaproxyCompressionOutput(mbc.Metrics.HaproxyCompressionOutput),
-		metricHaproxyConnectionsErrors:    newMetricHaproxyConnectionsErrors(mbc.Metrics.HaproxyConnectionsErrors),
-		metricHaproxyConnectionsRate:      newMetricHaproxyConnectionsRate(mbc.Metrics.HaproxyConnectionsRate),
-		metricHaproxyConnectionsRetries:   newMetricHaproxyConnectionsRetries(mbc.Metrics.HaproxyConnectionsRetries),
-		metricHaproxyConnectionsTotal:     newMetricHaproxyConnectionsTotal(mbc.Metrics.HaproxyConnectionsTotal),
-		metricHaproxyDowntime:             newMetricHaproxyDowntime(mbc.Metrics.HaproxyDowntime),
-		metricHaproxyFailedChecks:         newMetricHaproxyFailedChecks(mbc.Metrics.HaproxyFailedChecks),
-		metricHaproxyRequestsDenied:       newMetricHaproxyRequestsDenied(mbc.Metrics.HaproxyRequestsDenied),
-		metricHaproxyRequestsErrors:       newMetricHaproxyRequestsErrors(mbc.Metrics.HaproxyRequestsErrors),
-		metricHaproxyRequestsQueued:       newMetricHaproxyRequestsQueued(mbc.Metrics.HaproxyRequestsQueued),
-		metricHaproxyRequestsRate:         newMetricHaproxyRequestsRate(mbc.Metrics.HaproxyRequestsRate),
-		metricHaproxyRequestsRedispatched: newMetricHaproxyRequestsRedispatched(mbc.Metrics.HaproxyRequestsRedispatched),
-		metricHaproxyRequestsTotal:        newMetricHaproxyRequestsTotal(mbc.Metrics.HaproxyRequestsTotal),
-		metricHaproxyResponsesDenied:      newMetricHaproxyResponsesDenied(mbc.Metrics.HaproxyResponsesDenied),
-		metricHaproxyResponsesErrors:      newMetricHaproxyResponsesErrors(mbc.Metrics.HaproxyResponsesErrors),
-		metricHaproxyServerSelectedTotal:  newMetricHaproxyServerSelectedTotal(mbc.Metrics.HaproxyServerSelectedTotal),
-		metricHaproxySessionsAverage:      newMetricHaproxySessionsAverage(mbc.Metrics.HaproxySessionsAverage),
-		metricHaproxySessionsCount:        newMetricHaproxySessionsCount(mbc.Metrics.HaproxySessionsCount),
-		metricHaproxySessionsRate:         newMetricHaproxySessionsRate(mbc.Metrics.HaproxySessionsRate),
-		metricHaproxySessionsTotal:        newMetricHaproxySessionsTotal(mbc.Metrics.HaproxySessionsTotal),
-		resourceAttributeIncludeFilter:    make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:    make(map[string]filter.Filter),
+		config:                              mbc,
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           settings.BuildInfo,
+		metricHaproxyBytesInput:             newMetricHaproxyBytesInput(mbc.Metrics.HaproxyBytesInput),
+		metricHaproxyBytesOutput:            newMetricHaproxyBytesOutput(mbc.Metrics.HaproxyBytesOutput),
+		metricHaproxyClientsCanceled:        newMetricHaproxyClientsCanceled(mbc.Metrics.HaproxyClientsCanceled),
+		metricHaproxyCompressionBypass:      newMetricHaproxyCompressionBypass(mbc.Metrics.HaproxyCompressionBypass),
+		metricHaproxyCompressionCount:       newMetricHaproxyCompressionCount(mbc.Metrics.HaproxyCompressionCount),
+		metricHaproxyCompressionInput:       newMetricHaproxyCompressionInput(mbc.Metrics.HaproxyCompressionInput),
+		metricHaproxyCompressionOutput:      newMetricHaproxyCompressionOutput(mbc.Metrics.HaproxyCompressionOutput),
+		metricHaproxyConnectionsAverageTime: newMetricHaproxyConnectionsAverageTime(mbc.Metrics.HaproxyConnectionsAverageTime),
+		metricHaproxyConnectionsErrors:      newMetricHaproxyConnectionsErrors(mbc.Metrics.HaproxyConnectionsErrors),
+		metricHaproxyConnectionsRate:        newMetricHaproxyConnectionsRate(mbc.Metrics.HaproxyConnectionsRate),
+		metricHaproxyConnectionsRetries:     newMetricHaproxyConnectionsRetries(mbc.Metrics.HaproxyConnectionsRetries),
+		metricHaproxyConnectionsTotal:       newMetricHaproxyConnectionsTotal(mbc.Metrics.HaproxyConnectionsTotal),
+		metricHaproxyDowntime:               newMetricHaproxyDowntime(mbc.Metrics.HaproxyDowntime),
+		metricHaproxyFailedChecks:           newMetricHaproxyFailedChecks(mbc.Metrics.HaproxyFailedChecks),
+		metricHaproxyQueueAverageTime:       newMetricHaproxyQueueAverageTime(mbc.Metrics.HaproxyQueueAverageTime),
+		metricHaproxyRequestsDenied:         newMetricHaproxyRequestsDenied(mbc.Metrics.HaproxyRequestsDenied),
+		metricHaproxyRequestsErrors:         newMetricHaproxyRequestsErrors(mbc.Metrics.HaproxyRequestsErrors),
+		metricHaproxyRequestsQueued:         newMetricHaproxyRequestsQueued(mbc.Metrics.HaproxyRequestsQueued),
+		metricHaproxyRequestsRate:           newMetricHaproxyRequestsRate(mbc.Metrics.HaproxyRequestsRate),
+		metricHaproxyRequestsRedispatched:   newMetricHaproxyRequestsRedispatched(mbc.Metrics.HaproxyRequestsRedispatched),
+		metricHaproxyRequestsTotal:          newMetricHaproxyRequestsTotal(mbc.Metrics.HaproxyRequestsTotal),
+		metricHaproxyResponsesAverageTime:   newMetricHaproxyResponsesAverageTime(mbc.Metrics.HaproxyResponsesAverageTime),
+		metricHaproxyResponsesDenied:        newMetricHaproxyResponsesDenied(mbc.Metrics.HaproxyResponsesDenied),
+		metricHaproxyResponsesErrors:        newMetricHaproxyResponsesErrors(mbc.Metrics.HaproxyResponsesErrors),
+		metricHaproxyServerCheckDuration:    newMetricHaproxyServerCheckDuration(mbc.Metrics.HaproxyServerCheckDuration),
+		metricHaproxyServerStatus:           newMetricHaproxyServerStatus(mbc.Metrics.HaproxyServerStatus),
+		metricHaproxyServerSelectedTotal:    newMetricHaproxyServerSelectedTotal(mbc.Metrics.HaproxyServerSelectedTotal),
+		metricHaproxySessionsAverage:        newMetricHaproxySessionsAverage(mbc.Metrics.HaproxySessionsAverage),
+		metricHaproxySessionsCount:          newMetricHaproxySessionsCount(mbc.Metrics.HaproxySessionsCount),
+		metricHaproxySessionsRate:           newMetricHaproxySessionsRate(mbc.Metrics.HaproxySessionsRate),
+		metricHaproxySessionsTotal:          newMetricHaproxySessionsTotal(mbc.Metrics.HaproxySessionsTotal),
+		resourceAttributeIncludeFilter:      make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:      make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.HaproxyAddr.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["haproxy.addr"] = filter.CreateFilter(mbc.ResourceAttributes.HaproxyAddr.MetricsInclude)
@@ -1543,20 +1842,25 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricHaproxyCompressionCount.emit(ils.Metrics())
 	mb.metricHaproxyCompressionInput.emit(ils.Metrics())
 	mb.metricHaproxyCompressionOutput.emit(ils.Metrics())
+	mb.metricHaproxyConnectionsAverageTime.emit(ils.Metrics())
 	mb.metricHaproxyConnectionsErrors.emit(ils.Metrics())
 	mb.metricHaproxyConnectionsRate.emit(ils.Metrics())
 	mb.metricHaproxyConnectionsRetries.emit(ils.Metrics())
 	mb.metricHaproxyConnectionsTotal.emit(ils.Metrics())
 	mb.metricHaproxyDowntime.emit(ils.Metrics())
 	mb.metricHaproxyFailedChecks.emit(ils.Metrics())
+	mb.metricHaproxyQueueAverageTime.emit(ils.Metrics())
 	mb.metricHaproxyRequestsDenied.emit(ils.Metrics())
 	mb.metricHaproxyRequestsErrors.emit(ils.Metrics())
 	mb.metricHaproxyRequestsQueued.emit(ils.Metrics())
 	mb.metricHaproxyRequestsRate.emit(ils.Metrics())
 	mb.metricHaproxyRequestsRedispatched.emit(ils.Metrics())
 	mb.metricHaproxyRequestsTotal.emit(ils.Metrics())
+	mb.metricHaproxyResponsesAverageTime.emit(ils.Metrics())
 	mb.metricHaproxyResponsesDenied.emit(ils.Metrics())
 	mb.metricHaproxyResponsesErrors.emit(ils.Metrics())
+	mb.metricHaproxyServerCheckDuration.emit(ils.Metrics())
+	mb.metricHaproxyServerStatus.emit(ils.Metrics())
 	mb.metricHaproxyServerSelectedTotal.emit(ils.Metrics())
 	mb.metricHaproxySessionsAverage.emit(ils.Metrics())
 	mb.metricHaproxySessionsCount.emit(ils.Metrics())
@@ -1663,6 +1967,16 @@ func (mb *MetricsBuilder) RecordHaproxyCompressionOutputDataPoint(ts pcommon.Tim
 	return nil
 }
 
+// RecordHaproxyConnectionsAverageTimeDataPoint adds a data point to haproxy.connections.average_time metric.
+func (mb *MetricsBuilder) RecordHaproxyConnectionsAverageTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseFloat(inputVal, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse float64 for HaproxyConnectionsAverageTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricHaproxyConnectionsAverageTime.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordHaproxyConnectionsErrorsDataPoint adds a data point to haproxy.connections.errors metric.
 func (mb *MetricsBuilder) RecordHaproxyConnectionsErrorsDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1723,6 +2037,16 @@ func (mb *MetricsBuilder) RecordHaproxyFailedChecksDataPoint(ts pcommon.Timestam
 	return nil
 }
 
+// RecordHaproxyQueueAverageTimeDataPoint adds a data point to haproxy.queue.average_time metric.
+func (mb *MetricsBuilder) RecordHaproxyQueueAverageTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseFloat(inputVal, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse float64 for HaproxyQueueAverageTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricHaproxyQueueAverageTime.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordHaproxyRequestsDeniedDataPoint adds a data point to haproxy.requests.denied metric.
 func (mb *MetricsBuilder) RecordHaproxyRequestsDeniedDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1783,6 +2107,16 @@ func (mb *MetricsBuilder) RecordHaproxyRequestsTotalDataPoint(ts pcommon.Timesta
 	return nil
 }
 
+// RecordHaproxyResponsesAverageTimeDataPoint adds a data point to haproxy.responses.average_time metric.
+func (mb *MetricsBuilder) RecordHaproxyResponsesAverageTimeDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseFloat(inputVal, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse float64 for HaproxyResponsesAverageTime, value was %s: %w", inputVal, err)
+	}
+	mb.metricHaproxyResponsesAverageTime.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
 // RecordHaproxyResponsesDeniedDataPoint adds a data point to haproxy.responses.denied metric.
 func (mb *MetricsBuilder) RecordHaproxyResponsesDeniedDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1798,6 +2132,21 @@ func (mb *MetricsBuilder) RecordHaproxyResponsesErrorsDataPoint(ts pcommon.Times
 	mb.metricHaproxyResponsesErrors.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordHaproxyServerCheckDurationDataPoint adds a data point to haproxy.server.check.duration metric.
+func (mb *MetricsBuilder) RecordHaproxyServerCheckDurationDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for HaproxyServerCheckDuration, value was %s: %w", inputVal, err)
+	}
+	mb.metricHaproxyServerCheckDuration.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordHaproxyServerStatusDataPoint adds a data point to haproxy.server.status metric.
+func (mb *MetricsBuilder) RecordHaproxyServerStatusDataPoint(ts pcommon.Timestamp, val int64, serverStatusAttributeValue AttributeServerStatus) {
+	mb.metricHaproxyServerStatus.recordDataPoint(mb.startTime, ts, val, serverStatusAttributeValue.String())
+}
+
 // RecordHaproxyServerSelectedTotalDataPoint adds a data point to haproxy.server_selected.total metric.
 func (mb *MetricsBuilder) RecordHaproxyServerSelectedTotalDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -91,6 +91,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHaproxyCompressionOutputDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordHaproxyConnectionsAverageTimeDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordHaproxyConnectionsErrorsDataPoint(ts, "1")
@@ -112,6 +115,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHaproxyFailedChecksDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordHaproxyQueueAverageTimeDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordHaproxyRequestsDeniedDataPoint(ts, "1")
@@ -136,6 +142,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHaproxyRequestsTotalDataPoint(ts, "1", AttributeStatusCode1xx)
 
+			allMetricsCount++
+			mb.RecordHaproxyResponsesAverageTimeDataPoint(ts, "1")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordHaproxyResponsesDeniedDataPoint(ts, "1")
@@ -144,6 +153,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordHaproxyResponsesErrorsDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordHaproxyServerCheckDurationDataPoint(ts, "1")
+
+			allMetricsCount++
+			mb.RecordHaproxyServerStatusDataPoint(ts, 1, AttributeServerStatusUp)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordHaproxyServerSelectedTotalDataPoint(ts, "1")
@@ -287,6 +302,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "haproxy.connections.average_time":
+					assert.False(t, validatedMetrics["haproxy.connections.average_time"], "Found a duplicate in the metrics slice: haproxy.connections.average_time")
+					validatedMetrics["haproxy.connections.average_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average connect time in ms over the last 1024 requests. Corresponds to HAProxy's `ctime` metric.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "haproxy.connections.errors":
 					assert.False(t, validatedMetrics["haproxy.connections.errors"], "Found a duplicate in the metrics slice: haproxy.connections.errors")
 					validatedMetrics["haproxy.connections.errors"] = true
@@ -369,6 +396,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "haproxy.queue.average_time":
+					assert.False(t, validatedMetrics["haproxy.queue.average_time"], "Found a duplicate in the metrics slice: haproxy.queue.average_time")
+					validatedMetrics["haproxy.queue.average_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average queue time in ms over the last 1024 requests. Corresponds to HAProxy's `qtime` metric.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "haproxy.requests.denied":
 					assert.False(t, validatedMetrics["haproxy.requests.denied"], "Found a duplicate in the metrics slice: haproxy.requests.denied")
 					validatedMetrics["haproxy.requests.denied"] = true
@@ -454,6 +493,18 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("status_code")
 					assert.True(t, ok)
 					assert.EqualValues(t, "1xx", attrVal.Str())
+				case "haproxy.responses.average_time":
+					assert.False(t, validatedMetrics["haproxy.responses.average_time"], "Found a duplicate in the metrics slice: haproxy.responses.average_time")
+					validatedMetrics["haproxy.responses.average_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Average response time in ms over the last 1024 requests. Corresponds to HAProxy's `rtime` metric.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "haproxy.responses.denied":
 					assert.False(t, validatedMetrics["haproxy.responses.denied"], "Found a duplicate in the metrics slice: haproxy.responses.denied")
 					validatedMetrics["haproxy.responses.denied"] = true
@@ -482,6 +533,33 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "haproxy.server.check.duration":
+					assert.False(t, validatedMetrics["haproxy.server.check.duration"], "Found a duplicate in the metrics slice: haproxy.server.check.duration")
+					validatedMetrics["haproxy.server.check.duration"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Time in ms taken to finish the last health check of the server. Corresponds to HAProxy's `check_duration` metric.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "haproxy.server.status":
+					assert.False(t, validatedMetrics["haproxy.server.status"], "Found a duplicate in the metrics slice: haproxy.server.status")
+					validatedMetrics["haproxy.server.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Status of the server or backend, 1 for its current status and 0 for the others. Corresponds to HAProxy's `status` metric.", ms.At(i).Description())
+					assert.Equal(t, "{status}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "up", attrVal.Str())
 				case "haproxy.server_selected.total":
 					assert.False(t, validatedMetrics["haproxy.server_selected.total"], "Found a duplicate in the metrics slice: haproxy.server_selected.total")
 					validatedMetrics["haproxy.server_selected.total"] = true
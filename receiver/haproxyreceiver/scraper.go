@@ -31,6 +31,12 @@ var (
 	showStatsCommand = []byte("show stat\n")
 )
 
+// Values of the `type` field of the statistics.
+const (
+	typeBackend = "1"
+	typeServer  = "2"
+)
+
 type scraper struct {
 	cfg               *Config
 	httpClient        *http.Client
@@ -40,44 +46,13 @@ type scraper struct {
 }
 
 func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-
-	var records []map[string]string
-	if u, notURLerr := url.Parse(s.cfg.Endpoint); notURLerr == nil && strings.HasPrefix(u.Scheme, "http") {
-
-		resp, err := s.httpClient.Get(s.cfg.Endpoint + ";csv")
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		defer resp.Body.Close()
-		buf, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		records, err = s.readStats(buf)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-	} else {
-		var d net.Dialer
-		c, err := d.DialContext(ctx, "unix", s.cfg.Endpoint)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		defer func(c net.Conn) {
-			_ = c.Close()
-		}(c)
-		_, err = c.Write(showStatsCommand)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		buf, err := io.ReadAll(c)
-		if err != nil {
-			return pmetric.NewMetrics(), err
-		}
-		records, err = s.readStats(buf)
-		if err != nil {
-			return pmetric.NewMetrics(), fmt.Errorf("error reading stats: %w", err)
-		}
+	buf, err := s.fetchStats(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+	records, err := s.readStats(buf)
+	if err != nil {
+		return pmetric.NewMetrics(), fmt.Errorf("error reading stats: %w", err)
 	}
 
 	var scrapeErrors []error
@@ -247,6 +222,30 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 				scrapeErrors = append(scrapeErrors, err)
 			}
 		}
+		if record["qtime"] != "" {
+			if err := s.mb.RecordHaproxyQueueAverageTimeDataPoint(now, record["qtime"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+		if record["ctime"] != "" {
+			if err := s.mb.RecordHaproxyConnectionsAverageTimeDataPoint(now, record["ctime"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+		if record["rtime"] != "" {
+			if err := s.mb.RecordHaproxyResponsesAverageTimeDataPoint(now, record["rtime"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+		if record["check_duration"] != "" {
+			if err := s.mb.RecordHaproxyServerCheckDurationDataPoint(now, record["check_duration"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+		// Frontends and listeners report whether they are open rather than a status.
+		if record["type"] == typeBackend || record["type"] == typeServer {
+			s.recordServerStatus(now, record)
+		}
 		rb := s.mb.NewResourceBuilder()
 		rb.SetHaproxyProxyName(record["pxname"])
 		rb.SetHaproxyServiceName(record["svname"])
@@ -260,6 +259,84 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	return s.mb.Emit(), nil
 }
 
+// fetchStats returns the CSV statistics from the stats page, for HTTP endpoints, or from the runtime API.
+func (s *scraper) fetchStats(ctx context.Context) ([]byte, error) {
+	if u, notURLerr := url.Parse(s.cfg.Endpoint); notURLerr == nil && strings.HasPrefix(u.Scheme, "http") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.Endpoint+";csv", nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		return io.ReadAll(resp.Body)
+	}
+
+	network, address := runtimeAPIAddress(s.cfg.Endpoint)
+	var d net.Dialer
+	c, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+	defer func(c net.Conn) {
+		_ = c.Close()
+	}(c)
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = c.SetDeadline(deadline)
+	}
+	_, err = c.Write(showStatsCommand)
+	if err != nil {
+		return nil, err
+	}
+	// The runtime API closes the connection once the command output was written.
+	return io.ReadAll(c)
+}
+
+// runtimeAPIAddress returns the network and address of the runtime API: the endpoint is either a unix socket
+// path, optionally prefixed by `unix://` or `file://`, or a `tcp://host:port` address.
+func runtimeAPIAddress(endpoint string) (string, string) {
+	if address, ok := strings.CutPrefix(endpoint, "tcp://"); ok {
+		return "tcp", address
+	}
+	for _, prefix := range []string{"unix://", "file://"} {
+		if path, ok := strings.CutPrefix(endpoint, prefix); ok {
+			return "unix", path
+		}
+	}
+	return "unix", endpoint
+}
+
+// recordServerStatus records 1 for the status of the server or backend and 0 for the other statuses.
+func (s *scraper) recordServerStatus(now pcommon.Timestamp, record map[string]string) {
+	status, ok := parseServerStatus(record["status"])
+	if !ok {
+		s.logger.Debug("unknown server status", zap.String("proxy", record["pxname"]), zap.String("server", record["svname"]), zap.String("status", record["status"]))
+		return
+	}
+	for _, value := range metadata.MapAttributeServerStatus {
+		var val int64
+		if value == status {
+			val = 1
+		}
+		s.mb.RecordHaproxyServerStatusDataPoint(now, val, value)
+	}
+}
+
+// parseServerStatus maps the status of a server or backend to the status attribute. Transitional statuses
+// such as `UP 1/3`, reported while health checks are failing, map to the current status and the reason of
+// a maintenance, such as in `MAINT (via)`, is ignored.
+func parseServerStatus(status string) (metadata.AttributeServerStatus, bool) {
+	if status == "no check" {
+		return metadata.AttributeServerStatusNoCheck, true
+	}
+	status, _, _ = strings.Cut(status, " ")
+	status, _, _ = strings.Cut(status, "(")
+	value, ok := metadata.MapAttributeServerStatus[strings.ToLower(status)]
+	return value, ok
+}
+
 func (s *scraper) readStats(buf []byte) ([]map[string]string, error) {
 	reader := csv.NewReader(bytes.NewReader(bytes.TrimSpace(buf)))
 	headers, err := reader.Read()
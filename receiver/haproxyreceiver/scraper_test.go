@@ -16,6 +16,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/haproxyreceiver/internal/metadata"
 )
 
 func Test_scraper_readStats(t *testing.T) {
@@ -144,3 +145,87 @@ func Test_scraper_readStatsWithNoValues(t *testing.T) {
 
 	require.Equal(t, 0, m.MetricCount())
 }
+
+func Test_scraper_readStatsFromTCPRuntimeAPI(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	go func() {
+		c, err2 := l.Accept()
+		require.NoError(t, err2)
+
+		buf := make([]byte, 512)
+		nr, err2 := c.Read(buf)
+		require.NoError(t, err2)
+		require.Equal(t, "show stat\n", string(buf[0:nr]))
+
+		stats, err2 := os.ReadFile(filepath.Join("testdata", "stats.txt"))
+		require.NoError(t, err2)
+		_, err2 = c.Write(stats)
+		require.NoError(t, err2)
+		require.NoError(t, c.Close())
+	}()
+
+	haProxyCfg := newDefaultConfig().(*Config)
+	haProxyCfg.Endpoint = "tcp://" + l.Addr().String()
+	haProxyCfg.Metrics.HaproxyQueueAverageTime.Enabled = true
+	haProxyCfg.Metrics.HaproxyConnectionsAverageTime.Enabled = true
+	haProxyCfg.Metrics.HaproxyResponsesAverageTime.Enabled = true
+	haProxyCfg.Metrics.HaproxyServerStatus.Enabled = true
+	haProxyCfg.Metrics.HaproxyServerCheckDuration.Enabled = true
+	s := newScraper(haProxyCfg, receivertest.NewNopCreateSettings())
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, m)
+
+	expectedFile := filepath.Join("testdata", "scraper", "expected_latency.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, m, pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(), pmetrictest.IgnoreResourceAttributeValue("haproxy.addr"),
+		pmetrictest.IgnoreResourceMetricsOrder(), pmetrictest.IgnoreMetricDataPointsOrder()))
+}
+
+func Test_runtimeAPIAddress(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		network  string
+		address  string
+	}{
+		{endpoint: "/var/run/haproxy.sock", network: "unix", address: "/var/run/haproxy.sock"},
+		{endpoint: "unix:///var/run/haproxy.sock", network: "unix", address: "/var/run/haproxy.sock"},
+		{endpoint: "file:///var/run/haproxy.ipc", network: "unix", address: "/var/run/haproxy.ipc"},
+		{endpoint: "tcp://127.0.0.1:9999", network: "tcp", address: "127.0.0.1:9999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			network, address := runtimeAPIAddress(tt.endpoint)
+			require.Equal(t, tt.network, network)
+			require.Equal(t, tt.address, address)
+		})
+	}
+}
+
+func Test_parseServerStatus(t *testing.T) {
+	tests := []struct {
+		status   string
+		expected metadata.AttributeServerStatus
+		ok       bool
+	}{
+		{status: "UP", expected: metadata.AttributeServerStatusUp, ok: true},
+		{status: "UP 1/3", expected: metadata.AttributeServerStatusUp, ok: true},
+		{status: "DOWN 1/2", expected: metadata.AttributeServerStatusDown, ok: true},
+		{status: "MAINT(via)", expected: metadata.AttributeServerStatusMaint, ok: true},
+		{status: "DRAIN (agent)", expected: metadata.AttributeServerStatusDrain, ok: true},
+		{status: "no check", expected: metadata.AttributeServerStatusNoCheck, ok: true},
+		{status: "OPEN", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			status, ok := parseServerStatus(tt.status)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, status)
+		})
+	}
+}
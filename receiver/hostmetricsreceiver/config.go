@@ -25,6 +25,14 @@ type Config struct {
 	Scrapers                       map[string]internal.Config `mapstructure:"-"`
 	// RootPath is the host's root directory (linux only).
 	RootPath string `mapstructure:"root_path"`
+	// OnDemand enables extended scrapes run on demand through a trigger extension.
+	OnDemand *OnDemandConfig `mapstructure:"on_demand"`
+}
+
+// OnDemandConfig defines the configuration of the on-demand scrapes.
+type OnDemandConfig struct {
+	// Extension is the ID of the trigger extension the on-demand scrape is registered with.
+	Extension component.ID `mapstructure:"extension"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -37,6 +45,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errors.New("must specify at least one scraper when using hostmetrics receiver"))
 	}
 	err = multierr.Append(err, validateRootPath(cfg.RootPath))
+	if cfg.OnDemand != nil && cfg.OnDemand.Extension.Type().String() == "" {
+		err = multierr.Append(err, errors.New("on_demand extension must be specified"))
+	}
 	return err
 }
 
@@ -25,8 +25,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -60,6 +62,9 @@ func TestLoadConfig(t *testing.T) {
 			CollectionInterval: 30 * time.Second,
 			InitialDelay:       time.Second,
 		},
+		OnDemand: &OnDemandConfig{
+			Extension: component.MustNewID("trigger"),
+		},
 		Scrapers: map[string]internal.Config{
 			cpuscraper.TypeStr: func() internal.Config {
 				cfg := (&cpuscraper.Factory{}).CreateDefaultConfig()
@@ -106,6 +111,11 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			}(),
+			pressurescraper.TypeStr: func() internal.Config {
+				cfg := (&pressurescraper.Factory{}).CreateDefaultConfig()
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			}(),
 			processscraper.TypeStr: (func() internal.Config {
 				cfg := (&processscraper.Factory{}).CreateDefaultConfig()
 				cfg.(*processscraper.Config).Include = processscraper.MatchConfig{
@@ -115,6 +125,12 @@ func TestLoadConfig(t *testing.T) {
 				cfg.SetEnvMap(common.EnvMap{})
 				return cfg
 			})(),
+			systemdscraper.TypeStr: (func() internal.Config {
+				cfg := (&systemdscraper.Factory{}).CreateDefaultConfig()
+				cfg.(*systemdscraper.Config).Units = []string{"*.service", "*.timer"}
+				cfg.SetEnvMap(common.EnvMap{})
+				return cfg
+			})(),
 		},
 	}
 
@@ -142,3 +158,14 @@ func TestLoadInvalidConfig_InvalidScraperKey(t *testing.T) {
 
 	require.Contains(t, err.Error(), "error reading configuration for \"hostmetrics\": invalid scraper key: invalidscraperkey")
 }
+
+func TestLoadInvalidConfig_MissingOnDemandExtension(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-missing-on-demand-extension.yaml"), factories)
+
+	require.Contains(t, err.Error(), "on_demand extension must be specified")
+}
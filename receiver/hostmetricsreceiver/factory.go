@@ -24,8 +24,10 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
 )
 
 // This file implements Factory for HostMetrics receiver.
@@ -38,8 +40,10 @@ var (
 		memoryscraper.TypeStr:     &memoryscraper.Factory{},
 		networkscraper.TypeStr:    &networkscraper.Factory{},
 		pagingscraper.TypeStr:     &pagingscraper.Factory{},
+		pressurescraper.TypeStr:   &pressurescraper.Factory{},
 		processesscraper.TypeStr:  &processesscraper.Factory{},
 		processscraper.TypeStr:    &processscraper.Factory{},
+		systemdscraper.TypeStr:    &systemdscraper.Factory{},
 	}
 )
 
@@ -81,12 +85,16 @@ func createMetricsReceiver(
 	host.EnableBootTimeCache(true)
 	process.EnableBootTimeCache(true)
 
-	return scraperhelper.NewScraperControllerReceiver(
+	rcvr, err := scraperhelper.NewScraperControllerReceiver(
 		&oCfg.ControllerConfig,
 		set,
 		consumer,
 		addScraperOptions...,
 	)
+	if err != nil || oCfg.OnDemand == nil {
+		return rcvr, err
+	}
+	return newOnDemandReceiver(rcvr, oCfg, set, scraperFactories, consumer), nil
 }
 
 func createAddScraperOptions(
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+const metricsLen = 2
+
+// resources are the files read from /proc/pressure.
+var resources = []metadata.AttributeResource{
+	metadata.AttributeResourceCpu,
+	metadata.AttributeResourceIo,
+	metadata.AttributeResourceMemory,
+}
+
+// pressureStat is a single line of a /proc/pressure file, e.g.
+// "some avg10=0.00 avg60=0.00 avg300=0.00 total=0".
+type pressureStat struct {
+	stallType metadata.AttributeStallType
+	avg10     float64
+	avg60     float64
+	avg300    float64
+	// total stall time in microseconds
+	total uint64
+}
+
+// scraper for Pressure Stall Information Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+
+	// for mocking
+	bootTime func(context.Context) (uint64, error)
+}
+
+// newPressureScraper creates a Pressure Stall Information scraper
+func newPressureScraper(settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{
+		settings: settings,
+		config:   cfg,
+		bootTime: host.BootTimeWithContext,
+	}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	ctx = context.WithValue(ctx, common.EnvKey, s.config.EnvMap)
+	bootTime, err := s.bootTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings, metadata.WithStartTime(pcommon.Timestamp(bootTime*1e9)))
+	return nil
+}
+
+func (s *scraper) scrape(_ context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var errs scrapererror.ScrapeErrors
+	for _, resource := range resources {
+		stats, err := readPressureFile(filepath.Join(s.procPath(), "pressure", resource.String()))
+		if err != nil {
+			// PSI is not available on kernels before 4.20 or when disabled with psi=0.
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			errs.AddPartial(metricsLen, fmt.Errorf("error reading %s pressure: %w", resource, err))
+			continue
+		}
+
+		for _, stat := range stats {
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, stat.avg10/100, resource, stat.stallType, metadata.AttributeWindow10s)
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, stat.avg60/100, resource, stat.stallType, metadata.AttributeWindow60s)
+			s.mb.RecordSystemPressureStallRatioDataPoint(now, stat.avg300/100, resource, stat.stallType, metadata.AttributeWindow300s)
+			s.mb.RecordSystemPressureStallTimeDataPoint(now, float64(stat.total)/1e6, resource, stat.stallType)
+		}
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+// procPath returns the proc filesystem root, honoring the receiver root_path and HOST_PROC.
+func (s *scraper) procPath() string {
+	if v, ok := s.config.EnvMap[common.HostProcEnvKey]; ok && v != "" {
+		return v
+	}
+	if v := os.Getenv(string(common.HostProcEnvKey)); v != "" {
+		return v
+	}
+	return "/proc"
+}
+
+func readPressureFile(path string) ([]pressureStat, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var stats []pressureStat
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		stat, err := parsePressureLine(line)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+	}
+	return stats, scanner.Err()
+}
+
+func parsePressureLine(line string) (pressureStat, error) {
+	fields := strings.Fields(line)
+	stallType, ok := metadata.MapAttributeStallType[fields[0]]
+	if !ok {
+		return pressureStat{}, fmt.Errorf("unexpected stall type in line %q", line)
+	}
+
+	stat := pressureStat{stallType: stallType}
+	for _, field := range fields[1:] {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			return pressureStat{}, fmt.Errorf("malformed field %q in line %q", field, line)
+		}
+		var err error
+		switch key {
+		case "avg10":
+			stat.avg10, err = strconv.ParseFloat(value, 64)
+		case "avg60":
+			stat.avg60, err = strconv.ParseFloat(value, 64)
+		case "avg300":
+			stat.avg300, err = strconv.ParseFloat(value, 64)
+		case "total":
+			stat.total, err = strconv.ParseUint(value, 10, 64)
+		}
+		if err != nil {
+			return pressureStat{}, fmt.Errorf("malformed field %q in line %q: %w", field, line, err)
+		}
+	}
+	return stat, nil
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pressurescraper
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/shirou/gopsutil/v3/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper/internal/metadata"
+)
+
+func newTestScraper(t *testing.T, procPath string) *scraper {
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	cfg.SetEnvMap(common.EnvMap{common.HostProcEnvKey: procPath})
+	s := newPressureScraper(receivertest.NewNopCreateSettings(), cfg)
+	s.bootTime = func(context.Context) (uint64, error) { return 100, nil }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	s := newTestScraper(t, filepath.Join("testdata", "proc"))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+
+	ratio := metrics.At(0)
+	assert.Equal(t, "system.pressure.stall.ratio", ratio.Name())
+	// 2 files (io is missing) * 2 stall types * 3 windows
+	require.Equal(t, 12, ratio.Gauge().DataPoints().Len())
+	assertDataPoint(t, ratio.Gauge().DataPoints().At(0), 0.015, "cpu", "some", "10s")
+	assertDataPoint(t, ratio.Gauge().DataPoints().At(1), 0.02, "cpu", "some", "60s")
+	assertDataPoint(t, ratio.Gauge().DataPoints().At(2), 0.0025, "cpu", "some", "300s")
+	assertDataPoint(t, ratio.Gauge().DataPoints().At(9), 0.04, "memory", "full", "10s")
+
+	stallTime := metrics.At(1)
+	assert.Equal(t, "system.pressure.stall.time", stallTime.Name())
+	assert.True(t, stallTime.Sum().IsMonotonic())
+	require.Equal(t, 4, stallTime.Sum().DataPoints().Len())
+	assertDataPoint(t, stallTime.Sum().DataPoints().At(0), 3.5, "cpu", "some", "")
+	assertDataPoint(t, stallTime.Sum().DataPoints().At(3), 0.00008, "memory", "full", "")
+	assert.Equal(t, int64(100*1e9), int64(stallTime.Sum().DataPoints().At(0).StartTimestamp()))
+}
+
+func TestScrapeUnavailable(t *testing.T) {
+	s := newTestScraper(t, filepath.Join("testdata", "does-not-exist"))
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, md.MetricCount())
+}
+
+func TestScrapeMalformed(t *testing.T) {
+	s := newTestScraper(t, filepath.Join("testdata", "malformed"))
+
+	_, err := s.scrape(context.Background())
+	require.ErrorContains(t, err, `error reading cpu pressure: malformed field "avg10=abc"`)
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, metricsLen, partialErr.Failed)
+}
+
+func TestParsePressureLine(t *testing.T) {
+	stat, err := parsePressureLine("full avg10=0.12 avg60=3.40 avg300=56.78 total=910")
+	require.NoError(t, err)
+	assert.Equal(t, pressureStat{stallType: metadata.AttributeStallTypeFull, avg10: 0.12, avg60: 3.4, avg300: 56.78, total: 910}, stat)
+
+	_, err = parsePressureLine("partial avg10=0.00")
+	assert.EqualError(t, err, `unexpected stall type in line "partial avg10=0.00"`)
+
+	_, err = parsePressureLine("some avg10")
+	assert.EqualError(t, err, `malformed field "avg10" in line "some avg10"`)
+}
+
+func assertDataPoint(t *testing.T, dp pmetric.NumberDataPoint, value float64, resource, stallType, window string) {
+	assert.InDelta(t, value, dp.DoubleValue(), 1e-9)
+	attr, _ := dp.Attributes().Get("resource")
+	assert.Equal(t, resource, attr.Str())
+	attr, _ = dp.Attributes().Get("type")
+	assert.Equal(t, stallType, attr.Str())
+	attr, ok := dp.Attributes().Get("window")
+	if window == "" {
+		assert.False(t, ok)
+	} else {
+		assert.Equal(t, window, attr.Str())
+	}
+}
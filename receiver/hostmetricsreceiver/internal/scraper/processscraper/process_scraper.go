@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"runtime"
 	"time"
 
@@ -27,18 +28,19 @@ import (
 )
 
 const (
-	cpuMetricsLen               = 1
-	memoryMetricsLen            = 2
-	memoryUtilizationMetricsLen = 1
-	diskMetricsLen              = 1
-	pagingMetricsLen            = 1
-	threadMetricsLen            = 1
-	contextSwitchMetricsLen     = 1
-	fileDescriptorMetricsLen    = 1
-	handleMetricsLen            = 1
-	signalMetricsLen            = 1
-
-	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + signalMetricsLen
+	cpuMetricsLen                 = 1
+	memoryMetricsLen              = 2
+	memoryUtilizationMetricsLen   = 1
+	diskMetricsLen                = 1
+	pagingMetricsLen              = 1
+	threadMetricsLen              = 1
+	contextSwitchMetricsLen       = 1
+	fileDescriptorMetricsLen      = 1
+	fileDescriptorLimitMetricsLen = 1
+	handleMetricsLen              = 1
+	signalMetricsLen              = 1
+
+	metricsLen = cpuMetricsLen + memoryMetricsLen + diskMetricsLen + memoryUtilizationMetricsLen + pagingMetricsLen + threadMetricsLen + contextSwitchMetricsLen + fileDescriptorMetricsLen + fileDescriptorLimitMetricsLen + signalMetricsLen
 )
 
 // scraper for Process Metrics
@@ -172,8 +174,13 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			errs.AddPartial(handleMetricsLen, fmt.Errorf("error reading handle count for process %q (pid %v): %w", md.executable.name, md.pid, err))
 		}
 
-		if err = s.scrapeAndAppendSignalsPendingMetric(ctx, now, md.handle); err != nil {
-			errs.AddPartial(signalMetricsLen, fmt.Errorf("error reading pending signals for process %q (pid %v): %w", md.executable.name, md.pid, err))
+		if err = s.scrapeAndAppendRlimitMetrics(ctx, now, md.handle); err != nil {
+			if s.config.MetricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled {
+				errs.AddPartial(fileDescriptorLimitMetricsLen, fmt.Errorf("error reading open file descriptor limit for process %q (pid %v): %w", md.executable.name, md.pid, err))
+			}
+			if s.config.MetricsBuilderConfig.Metrics.ProcessSignalsPending.Enabled {
+				errs.AddPartial(signalMetricsLen, fmt.Errorf("error reading pending signals for process %q (pid %v): %w", md.executable.name, md.pid, err))
+			}
 		}
 
 		s.mb.EmitForResource(metadata.WithResource(md.buildResource(s.mb.NewResourceBuilder())),
@@ -447,20 +454,32 @@ func (s *scraper) scrapeAndAppendHandlesMetric(_ context.Context, now pcommon.Ti
 	return nil
 }
 
-func (s *scraper) scrapeAndAppendSignalsPendingMetric(ctx context.Context, now pcommon.Timestamp, handle processHandle) error {
-	if !s.config.MetricsBuilderConfig.Metrics.ProcessSignalsPending.Enabled {
+// scrapeAndAppendRlimitMetrics records the metrics read from the resource limits of a process,
+// which are read once for all of them.
+func (s *scraper) scrapeAndAppendRlimitMetrics(ctx context.Context, now pcommon.Timestamp, handle processHandle) error {
+	fdLimitEnabled := s.config.MetricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled
+	signalsPendingEnabled := s.config.MetricsBuilderConfig.Metrics.ProcessSignalsPending.Enabled
+	if !fdLimitEnabled && !signalsPendingEnabled {
 		return nil
 	}
 
-	rlimitStats, err := handle.RlimitUsageWithContext(ctx, true)
+	// the usage of the resources is only needed for the pending signals
+	rlimitStats, err := handle.RlimitUsageWithContext(ctx, signalsPendingEnabled)
 	if err != nil {
 		return err
 	}
 
 	for _, rlimitStat := range rlimitStats {
-		if rlimitStat.Resource == process.RLIMIT_SIGPENDING {
-			s.mb.RecordProcessSignalsPendingDataPoint(now, int64(rlimitStat.Used))
-			break
+		switch rlimitStat.Resource {
+		case process.RLIMIT_NOFILE:
+			// an unlimited soft limit is reported as math.MaxUint64, which cannot be represented
+			if fdLimitEnabled && rlimitStat.Soft <= math.MaxInt64 {
+				s.mb.RecordProcessOpenFileDescriptorsLimitDataPoint(now, int64(rlimitStat.Soft))
+			}
+		case process.RLIMIT_SIGPENDING:
+			if signalsPendingEnabled {
+				s.mb.RecordProcessSignalsPendingDataPoint(now, int64(rlimitStat.Used))
+			}
 		}
 	}
 
@@ -42,6 +42,7 @@ func enableLinuxOnlyMetrics(ms *metadata.MetricsConfig) {
 	ms.ProcessPagingFaults.Enabled = true
 	ms.ProcessContextSwitches.Enabled = true
 	ms.ProcessOpenFileDescriptors.Enabled = true
+	ms.ProcessOpenFileDescriptorsLimit.Enabled = true
 	ms.ProcessSignalsPending.Enabled = true
 }
 
@@ -155,6 +156,11 @@ func TestScrape(t *testing.T) {
 			} else {
 				assertMetricMissing(t, md.ResourceMetrics(), "process.open_file_descriptors")
 			}
+			if metricsBuilderConfig.Metrics.ProcessOpenFileDescriptorsLimit.Enabled {
+				assertOpenFileDescriptorsLimitMetricValid(t, md.ResourceMetrics(), expectedStartTime)
+			} else {
+				assertMetricMissing(t, md.ResourceMetrics(), "process.open_file_descriptors.limit")
+			}
 			assertSameTimeStampForAllMetricsWithinResource(t, md.ResourceMetrics())
 		})
 	}
@@ -306,6 +312,15 @@ func assertOpenFileDescriptorMetricValid(t *testing.T, resourceMetrics pmetric.R
 	}
 }
 
+func assertOpenFileDescriptorsLimitMetricValid(t *testing.T, resourceMetrics pmetric.ResourceMetricsSlice, startTime pcommon.Timestamp) {
+	openFileDescriptorsLimitMetric := getMetric(t, "process.open_file_descriptors.limit", resourceMetrics)
+	assert.Equal(t, "process.open_file_descriptors.limit", openFileDescriptorsLimitMetric.Name())
+
+	if startTime != 0 {
+		internal.AssertSumMetricStartTimeEquals(t, openFileDescriptorsLimitMetric, startTime)
+	}
+}
+
 func assertSameTimeStampForAllMetricsWithinResource(t *testing.T, resourceMetrics pmetric.ResourceMetricsSlice) {
 	for i := 0; i < resourceMetrics.Len(); i++ {
 		ilms := resourceMetrics.At(i).ScopeMetrics()
@@ -673,6 +688,7 @@ func enableOptionalMetrics(ms *metadata.MetricsConfig) {
 	ms.ProcessPagingFaults.Enabled = true
 	ms.ProcessContextSwitches.Enabled = true
 	ms.ProcessOpenFileDescriptors.Enabled = true
+	ms.ProcessOpenFileDescriptorsLimit.Enabled = true
 	ms.ProcessSignalsPending.Enabled = true
 }
 
@@ -797,10 +813,11 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 			expectedError: `error reading open file descriptor count for process "test" (pid 1): err10`,
 		},
 		{
-			name:          "Signals Pending Error",
-			osFilter:      []string{"darwin"},
-			rlimitError:   errors.New("err-rlimit"),
-			expectedError: `error reading pending signals for process "test" (pid 1): err-rlimit`,
+			name:        "Rlimit Error",
+			osFilter:    []string{"darwin"},
+			rlimitError: errors.New("err-rlimit"),
+			expectedError: `error reading open file descriptor limit for process "test" (pid 1): err-rlimit; ` +
+				`error reading pending signals for process "test" (pid 1): err-rlimit`,
 		},
 		{
 			name:                "Multiple Errors",
@@ -828,6 +845,7 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 				`error reading thread info for process "test" (pid 1): err8; ` +
 				`error reading context switch counts for process "test" (pid 1): err9; ` +
 				`error reading open file descriptor count for process "test" (pid 1): err10; ` +
+				`error reading open file descriptor limit for process "test" (pid 1): err-rlimit; ` +
 				`error reading pending signals for process "test" (pid 1): err-rlimit`,
 		},
 	}
@@ -890,6 +908,10 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 					Resource: process.RLIMIT_SIGPENDING,
 					Used:     0,
 				},
+				{
+					Resource: process.RLIMIT_NOFILE,
+					Soft:     1024,
+				},
 			}, test.rlimitError)
 
 			scraper.getProcessHandles = func(context.Context) (processHandles, error) {
@@ -908,6 +930,14 @@ func TestScrapeMetrics_ProcessErrors(t *testing.T) {
 			assert.Equal(t, expectedResourceMetricsLen, md.ResourceMetrics().Len())
 			assert.Equal(t, expectedMetricsLen, md.MetricCount())
 
+			if test.rlimitError == nil && runtime.GOOS != "darwin" && expectedResourceMetricsLen > 0 {
+				fdLimitMetric := getMetric(t, "process.open_file_descriptors.limit", md.ResourceMetrics())
+				assert.Equal(t, int64(1024), fdLimitMetric.Sum().DataPoints().At(0).IntValue())
+			}
+			if runtime.GOOS != "darwin" && expectedResourceMetricsLen > 0 {
+				handleMock.AssertNumberOfCalls(t, "RlimitUsageWithContext", 1)
+			}
+
 			assert.EqualError(t, err, test.expectedError)
 			isPartial := scrapererror.IsPartialScrapeError(err)
 			assert.True(t, isPartial)
@@ -950,7 +980,7 @@ func getExpectedLengthOfReturnedMetrics(nameError, exeError, timeError, memError
 		expectedLen += pagingMetricsLen
 	}
 	if rlimitError == nil && runtime.GOOS != "darwin" {
-		expectedLen += signalMetricsLen
+		expectedLen += signalMetricsLen + fileDescriptorLimitMetricsLen
 	}
 	if threadError == nil && runtime.GOOS != "darwin" {
 		expectedLen += threadMetricsLen
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemdscraper // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+const (
+	// serviceMetricsLen is the number of metrics read from the properties of a service unit.
+	serviceMetricsLen = 4
+	metricsLen        = 1 + serviceMetricsLen
+
+	// systemd reports accounting values as the maximum uint64 when accounting is disabled.
+	notSet = uint64(math.MaxUint64)
+)
+
+var activeStates = []metadata.AttributeState{
+	metadata.AttributeStateActive,
+	metadata.AttributeStateActivating,
+	metadata.AttributeStateDeactivating,
+	metadata.AttributeStateFailed,
+	metadata.AttributeStateInactive,
+	metadata.AttributeStateReloading,
+}
+
+// systemdConn is the subset of the systemd D-Bus API used by the scraper, to support testing.
+type systemdConn interface {
+	ListUnitsByPatternsContext(ctx context.Context, states []string, patterns []string) ([]dbus.UnitStatus, error)
+	GetUnitTypePropertiesContext(ctx context.Context, unit string, unitType string) (map[string]any, error)
+	Close()
+}
+
+// scraper for systemd Metrics
+type scraper struct {
+	settings receiver.CreateSettings
+	config   *Config
+	mb       *metadata.MetricsBuilder
+	conn     systemdConn
+
+	// for mocking
+	newConn func(context.Context) (systemdConn, error)
+}
+
+// newSystemdScraper creates a systemd scraper
+func newSystemdScraper(settings receiver.CreateSettings, cfg *Config) *scraper {
+	return &scraper{
+		settings: settings,
+		config:   cfg,
+		newConn: func(ctx context.Context) (systemdConn, error) {
+			return dbus.NewSystemConnectionContext(ctx)
+		},
+	}
+}
+
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	conn, err := s.newConn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to systemd: %w", err)
+	}
+	s.conn = conn
+	s.mb = metadata.NewMetricsBuilder(s.config.MetricsBuilderConfig, s.settings)
+	return nil
+}
+
+func (s *scraper) shutdown(context.Context) error {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	return nil
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	units, err := s.conn.ListUnitsByPatternsContext(ctx, nil, s.config.Units)
+	if err != nil {
+		return pmetric.NewMetrics(), scrapererror.NewPartialScrapeError(err, metricsLen)
+	}
+
+	var errs scrapererror.ScrapeErrors
+	for _, unit := range units {
+		s.recordUnitStateDataPoints(now, unit.ActiveState)
+
+		if unitType(unit.Name) == "service" && s.serviceMetricsEnabled() {
+			if err := s.recordServiceDataPoints(ctx, now, unit.Name); err != nil {
+				errs.AddPartial(serviceMetricsLen, fmt.Errorf("error reading properties for unit %q: %w", unit.Name, err))
+			}
+		}
+
+		rb := s.mb.NewResourceBuilder()
+		rb.SetSystemdUnitName(unit.Name)
+		rb.SetSystemdUnitType(unitType(unit.Name))
+		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+
+	return s.mb.Emit(), errs.Combine()
+}
+
+func (s *scraper) recordUnitStateDataPoints(now pcommon.Timestamp, activeState string) {
+	for _, state := range activeStates {
+		var value int64
+		if state.String() == activeState {
+			value = 1
+		}
+		s.mb.RecordSystemdUnitStateDataPoint(now, value, state)
+	}
+}
+
+func (s *scraper) serviceMetricsEnabled() bool {
+	m := s.config.MetricsBuilderConfig.Metrics
+	return m.SystemdUnitRestarts.Enabled || m.SystemdUnitCPUTime.Enabled || m.SystemdUnitMemoryUsage.Enabled || m.SystemdUnitTasks.Enabled
+}
+
+func (s *scraper) recordServiceDataPoints(ctx context.Context, now pcommon.Timestamp, name string) error {
+	props, err := s.conn.GetUnitTypePropertiesContext(ctx, name, "Service")
+	if err != nil {
+		return err
+	}
+
+	if v, ok := uint32Property(props, "NRestarts"); ok {
+		s.mb.RecordSystemdUnitRestartsDataPoint(now, int64(v))
+	}
+	if v, ok := uint64Property(props, "CPUUsageNSec"); ok {
+		s.mb.RecordSystemdUnitCPUTimeDataPoint(now, float64(v)/float64(time.Second))
+	}
+	if v, ok := uint64Property(props, "MemoryCurrent"); ok {
+		s.mb.RecordSystemdUnitMemoryUsageDataPoint(now, int64(v))
+	}
+	if v, ok := uint64Property(props, "TasksCurrent"); ok {
+		s.mb.RecordSystemdUnitTasksDataPoint(now, int64(v))
+	}
+	return nil
+}
+
+func uint32Property(props map[string]any, key string) (uint32, bool) {
+	v, ok := props[key].(uint32)
+	return v, ok
+}
+
+func uint64Property(props map[string]any, key string) (uint64, bool) {
+	v, ok := props[key].(uint64)
+	if !ok || v == notSet {
+		return 0, false
+	}
+	return v, true
+}
+
+// unitType returns the type of a unit from its name, e.g. "service" for "sshd.service".
+func unitType(name string) string {
+	return strings.TrimPrefix(filepath.Ext(name), ".")
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package systemdscraper
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper/internal/metadata"
+)
+
+type fakeConn struct {
+	units     []dbus.UnitStatus
+	listErr   error
+	props     map[string]map[string]any
+	propsErr  error
+	patterns  []string
+	closed    bool
+	propCalls int
+}
+
+func (c *fakeConn) ListUnitsByPatternsContext(_ context.Context, _ []string, patterns []string) ([]dbus.UnitStatus, error) {
+	c.patterns = patterns
+	return c.units, c.listErr
+}
+
+func (c *fakeConn) GetUnitTypePropertiesContext(_ context.Context, unit string, unitType string) (map[string]any, error) {
+	c.propCalls++
+	if unitType != "Service" {
+		return nil, errors.New("unexpected unit type " + unitType)
+	}
+	return c.props[unit], c.propsErr
+}
+
+func (c *fakeConn) Close() {
+	c.closed = true
+}
+
+func newTestScraper(t *testing.T, conn *fakeConn, mutate func(*metadata.MetricsConfig)) *scraper {
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	if mutate != nil {
+		mutate(&cfg.MetricsBuilderConfig.Metrics)
+	}
+	s := newSystemdScraper(receivertest.NewNopCreateSettings(), cfg)
+	s.newConn = func(context.Context) (systemdConn, error) { return conn, nil }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { assert.NoError(t, s.shutdown(context.Background())) })
+	return s
+}
+
+func enableAllMetrics(ms *metadata.MetricsConfig) {
+	ms.SystemdUnitCPUTime.Enabled = true
+	ms.SystemdUnitMemoryUsage.Enabled = true
+	ms.SystemdUnitTasks.Enabled = true
+}
+
+func TestScrape(t *testing.T) {
+	conn := &fakeConn{
+		units: []dbus.UnitStatus{
+			{Name: "sshd.service", ActiveState: "active"},
+			{Name: "broken.service", ActiveState: "failed"},
+			{Name: "docker.socket", ActiveState: "active"},
+		},
+		props: map[string]map[string]any{
+			"sshd.service": {
+				"NRestarts":     uint32(2),
+				"CPUUsageNSec":  uint64(1500000000),
+				"MemoryCurrent": uint64(4096),
+				"TasksCurrent":  uint64(3),
+			},
+			"broken.service": {
+				"NRestarts":     uint32(5),
+				"CPUUsageNSec":  uint64(math.MaxUint64),
+				"MemoryCurrent": uint64(math.MaxUint64),
+				"TasksCurrent":  uint64(math.MaxUint64),
+			},
+		},
+	}
+	s := newTestScraper(t, conn, enableAllMetrics)
+
+	md, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"*.service"}, conn.patterns)
+	assert.Equal(t, 2, conn.propCalls)
+	require.Equal(t, 3, md.ResourceMetrics().Len())
+
+	sshd := metricsByName(md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics())
+	unitName, _ := md.ResourceMetrics().At(0).Resource().Attributes().Get("systemd.unit.name")
+	assert.Equal(t, "sshd.service", unitName.Str())
+	assert.Len(t, sshd, 5)
+	assertStateValue(t, sshd["systemd.unit.state"], "active")
+	assert.Equal(t, int64(2), sshd["systemd.unit.restarts"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, 1.5, sshd["systemd.unit.cpu.time"].Sum().DataPoints().At(0).DoubleValue())
+	assert.Equal(t, int64(4096), sshd["systemd.unit.memory.usage"].Sum().DataPoints().At(0).IntValue())
+	assert.Equal(t, int64(3), sshd["systemd.unit.tasks"].Sum().DataPoints().At(0).IntValue())
+
+	broken := metricsByName(md.ResourceMetrics().At(1).ScopeMetrics().At(0).Metrics())
+	assert.Len(t, broken, 2, "accounting values that are not set must be skipped")
+	assertStateValue(t, broken["systemd.unit.state"], "failed")
+
+	socket := metricsByName(md.ResourceMetrics().At(2).ScopeMetrics().At(0).Metrics())
+	assert.Len(t, socket, 1)
+	assertStateValue(t, socket["systemd.unit.state"], "active")
+}
+
+func TestScrapeSkipsPropertiesWhenDisabled(t *testing.T) {
+	conn := &fakeConn{units: []dbus.UnitStatus{{Name: "sshd.service", ActiveState: "active"}}}
+	s := newTestScraper(t, conn, func(ms *metadata.MetricsConfig) {
+		ms.SystemdUnitRestarts.Enabled = false
+	})
+
+	_, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, conn.propCalls)
+}
+
+func TestScrapeErrors(t *testing.T) {
+	conn := &fakeConn{listErr: errors.New("bus unavailable")}
+	s := newTestScraper(t, conn, nil)
+
+	_, err := s.scrape(context.Background())
+	require.EqualError(t, err, "bus unavailable")
+	var partialErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, metricsLen, partialErr.Failed)
+
+	conn = &fakeConn{
+		units:    []dbus.UnitStatus{{Name: "sshd.service", ActiveState: "active"}},
+		propsErr: errors.New("access denied"),
+	}
+	s = newTestScraper(t, conn, nil)
+
+	md, err := s.scrape(context.Background())
+	require.EqualError(t, err, `error reading properties for unit "sshd.service": access denied`)
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, serviceMetricsLen, partialErr.Failed)
+	assert.Equal(t, 1, md.MetricCount())
+}
+
+func TestShutdownClosesConnection(t *testing.T) {
+	conn := &fakeConn{}
+	s := newSystemdScraper(receivertest.NewNopCreateSettings(), (&Factory{}).CreateDefaultConfig().(*Config))
+	s.newConn = func(context.Context) (systemdConn, error) { return conn, nil }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, s.shutdown(context.Background()))
+	assert.True(t, conn.closed)
+}
+
+func TestStartError(t *testing.T) {
+	s := newSystemdScraper(receivertest.NewNopCreateSettings(), (&Factory{}).CreateDefaultConfig().(*Config))
+	s.newConn = func(context.Context) (systemdConn, error) { return nil, errors.New("no bus") }
+	assert.EqualError(t, s.start(context.Background(), componenttest.NewNopHost()), "failed to connect to systemd: no bus")
+	assert.NoError(t, s.shutdown(context.Background()))
+}
+
+func metricsByName(metrics pmetric.MetricSlice) map[string]pmetric.Metric {
+	byName := make(map[string]pmetric.Metric, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		byName[metrics.At(i).Name()] = metrics.At(i)
+	}
+	return byName
+}
+
+func assertStateValue(t *testing.T, metric pmetric.Metric, expectedState string) {
+	dps := metric.Sum().DataPoints()
+	require.Equal(t, len(activeStates), dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		state, _ := dps.At(i).Attributes().Get("state")
+		expected := int64(0)
+		if state.Str() == expectedState {
+			expected = 1
+		}
+		assert.Equal(t, expected, dps.At(i).IntValue(), state.Str())
+	}
+}
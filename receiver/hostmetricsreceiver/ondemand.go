@@ -0,0 +1,231 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/cpuscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/diskscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/filesystemscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/loadscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/networkscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pagingscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/pressurescraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processesscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/systemdscraper"
+)
+
+// onDemandAttribute is the resource attribute set on the metrics of the on-demand scrapes.
+const onDemandAttribute = "hostmetrics.scrape.on_demand"
+
+// onDemandReceiver is a receiver registering an extended scrape with a trigger extension, next
+// to the periodic scrapes of the wrapped receiver.
+type onDemandReceiver struct {
+	receiver.Metrics
+
+	cfg       *Config
+	set       receiver.CreateSettings
+	factories map[string]internal.ScraperFactory
+	consumer  consumer.Metrics
+
+	host       component.Host
+	unregister func()
+}
+
+func newOnDemandReceiver(
+	rcvr receiver.Metrics,
+	cfg *Config,
+	set receiver.CreateSettings,
+	factories map[string]internal.ScraperFactory,
+	consumer consumer.Metrics,
+) *onDemandReceiver {
+	return &onDemandReceiver{
+		Metrics:   rcvr,
+		cfg:       cfg,
+		set:       set,
+		factories: factories,
+		consumer:  consumer,
+	}
+}
+
+func (r *onDemandReceiver) Start(ctx context.Context, host component.Host) error {
+	if err := r.Metrics.Start(ctx, host); err != nil {
+		return err
+	}
+
+	ext, ok := host.GetExtensions()[r.cfg.OnDemand.Extension]
+	if !ok {
+		return fmt.Errorf("on_demand extension %q not found", r.cfg.OnDemand.Extension)
+	}
+	trigger, ok := ext.(triggerextension.Trigger)
+	if !ok {
+		return fmt.Errorf("on_demand extension %q is not a trigger extension", r.cfg.OnDemand.Extension)
+	}
+
+	r.host = host
+	unregister, err := trigger.Register("receiver/"+r.set.ID.String(), r.scrape)
+	if err != nil {
+		return err
+	}
+	r.unregister = unregister
+	return nil
+}
+
+func (r *onDemandReceiver) Shutdown(ctx context.Context) error {
+	if r.unregister != nil {
+		r.unregister()
+	}
+	return r.Metrics.Shutdown(ctx)
+}
+
+// scrape runs a one-off scrape of every metric of the configured scrapers and of the processes
+// of the host, and sends the metrics tagged with the on-demand attribute to the next consumer.
+func (r *onDemandReceiver) scrape(ctx context.Context) error {
+	scrapers, err := r.createScrapers(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	md := pmetric.NewMetrics()
+	for _, scraper := range scrapers {
+		if err = scraper.Start(ctx, r.host); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to start scraper %q: %w", scraper.ID(), err))
+			continue
+		}
+		metrics, errScrape := scraper.Scrape(ctx)
+		if errScrape != nil {
+			if !scrapererror.IsPartialScrapeError(errScrape) {
+				errs = multierr.Append(errs, fmt.Errorf("failed to scrape %q: %w", scraper.ID(), errScrape))
+			} else {
+				r.set.Logger.Warn("Partial on-demand scrape", zap.String("scraper", scraper.ID().String()), zap.Error(errScrape))
+			}
+		}
+		metrics.ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+		errs = multierr.Append(errs, scraper.Shutdown(ctx))
+	}
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		md.ResourceMetrics().At(i).Resource().Attributes().PutBool(onDemandAttribute, true)
+	}
+	if md.ResourceMetrics().Len() > 0 {
+		errs = multierr.Append(errs, r.consumer.ConsumeMetrics(ctx, md))
+	}
+	return errs
+}
+
+// createScrapers creates the scrapers of the on-demand scrape: the configured scrapers and the
+// process scraper, with all their metrics and resource attributes enabled.
+func (r *onDemandReceiver) createScrapers(ctx context.Context) ([]scraperhelper.Scraper, error) {
+	configs := make(map[string]internal.Config, len(r.cfg.Scrapers)+1)
+	for key, cfg := range r.cfg.Scrapers {
+		configs[key] = cfg
+	}
+	if _, ok := configs[processscraper.TypeStr]; !ok {
+		cfg := r.factories[processscraper.TypeStr].CreateDefaultConfig()
+		cfg.SetRootPath(r.cfg.RootPath)
+		cfg.SetEnvMap(setGoPsutilEnvVars(r.cfg.RootPath, &osEnv{}))
+		configs[processscraper.TypeStr] = cfg
+	}
+
+	scrapers := make([]scraperhelper.Scraper, 0, len(configs))
+	for key, cfg := range configs {
+		cfg, err := enableAllMetrics(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable the metrics of scraper %q: %w", key, err)
+		}
+		scraper, ok, err := createHostMetricsScraper(ctx, r.set, key, cfg, r.factories)
+		if err != nil {
+			if _, configured := r.cfg.Scrapers[key]; !configured {
+				r.set.Logger.Debug("Skipping scraper from the on-demand scrape", zap.String("scraper", key), zap.Error(err))
+				continue
+			}
+			return nil, fmt.Errorf("failed to create scraper for key %q: %w", key, err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("host metrics scraper factory not found for key: %q", key)
+		}
+		scrapers = append(scrapers, scraper)
+	}
+	return scrapers, nil
+}
+
+// enableAllMetrics returns a copy of the config of a scraper with all the metrics and resource
+// attributes of its metrics builder config enabled.
+func enableAllMetrics(cfg internal.Config) (internal.Config, error) {
+	switch c := cfg.(type) {
+	case *cpuscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *diskscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *filesystemscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *loadscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *memoryscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *networkscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *pagingscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *pressurescraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *processesscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *processscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	case *systemdscraper.Config:
+		cp := *c
+		return &cp, enableAll(&cp.MetricsBuilderConfig)
+	}
+	return cfg, nil
+}
+
+// enableAll enables all the metrics and resource attributes of a metrics builder config, by
+// unmarshaling it with the enabled setting of all of them set.
+func enableAll(mbc any) error {
+	conf := confmap.New()
+	if err := conf.Marshal(mbc); err != nil {
+		return err
+	}
+	enabled := map[string]any{}
+	for _, section := range []string{"metrics", "resource_attributes"} {
+		names, ok := conf.Get(section).(map[string]any)
+		if !ok {
+			continue
+		}
+		settings := make(map[string]any, len(names))
+		for name := range names {
+			settings[name] = map[string]any{"enabled": true}
+		}
+		enabled[section] = settings
+	}
+	return confmap.NewFromStringMap(enabled).Unmarshal(mbc)
+}
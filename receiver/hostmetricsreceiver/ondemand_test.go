@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hostmetricsreceiver
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/triggerextension"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/memoryscraper"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/hostmetricsreceiver/internal/scraper/processscraper"
+)
+
+type extensionsHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h *extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+type fakeTrigger struct {
+	component.StartFunc
+	component.ShutdownFunc
+	triggers map[string]triggerextension.Func
+}
+
+func (t *fakeTrigger) Register(name string, fn triggerextension.Func) (func(), error) {
+	t.triggers[name] = fn
+	return func() { delete(t.triggers, name) }, nil
+}
+
+type nopExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+var triggerID = component.MustNewID("trigger")
+
+func newOnDemandTestConfig() *Config {
+	return &Config{
+		ControllerConfig: scraperhelper.ControllerConfig{
+			CollectionInterval: time.Hour,
+		},
+		Scrapers: map[string]internal.Config{
+			memoryscraper.TypeStr: scraperFactories[memoryscraper.TypeStr].CreateDefaultConfig(),
+		},
+		OnDemand: &OnDemandConfig{Extension: triggerID},
+	}
+}
+
+func TestOnDemandScrape(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	trigger := &fakeTrigger{triggers: map[string]triggerextension.Func{}}
+	host := &extensionsHost{
+		Host:       componenttest.NewNopHost(),
+		extensions: map[component.ID]component.Component{triggerID: trigger},
+	}
+
+	set := receivertest.NewNopCreateSettings()
+	set.ID = component.NewID(metadata.Type)
+	cfg := newOnDemandTestConfig()
+	rcvr, err := NewFactory().CreateMetricsReceiver(context.Background(), set, cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, rcvr.Start(context.Background(), host))
+
+	fn, ok := trigger.triggers["receiver/hostmetrics"]
+	require.True(t, ok)
+	require.NoError(t, fn(context.Background()))
+
+	// The metrics of the on-demand scrape are sent next to the ones of the initial periodic scrape.
+	metrics := map[string]struct{}{}
+	for _, md := range sink.AllMetrics() {
+		rms := md.ResourceMetrics()
+		for i := 0; i < rms.Len(); i++ {
+			if onDemand, ok := rms.At(i).Resource().Attributes().Get(onDemandAttribute); ok {
+				assert.True(t, onDemand.Bool())
+				appendMapInto(metrics, getResourceMetricNames(rms.At(i)))
+			}
+		}
+	}
+	// system.memory.utilization is disabled by default.
+	assert.Contains(t, metrics, "system.memory.utilization")
+	if runtime.GOOS == "linux" || runtime.GOOS == "windows" || runtime.GOOS == "darwin" {
+		assert.Contains(t, metrics, "process.memory.usage")
+	}
+
+	// The config of the periodic scrapes is left untouched.
+	assert.False(t, cfg.Scrapers[memoryscraper.TypeStr].(*memoryscraper.Config).Metrics.SystemMemoryUtilization.Enabled)
+
+	require.NoError(t, rcvr.Shutdown(context.Background()))
+	assert.Empty(t, trigger.triggers)
+}
+
+func TestOnDemandScrapeInvalidExtension(t *testing.T) {
+	tests := []struct {
+		name        string
+		extensions  map[component.ID]component.Component
+		expectedErr string
+	}{
+		{
+			name:        "missing",
+			expectedErr: `on_demand extension "trigger" not found`,
+		},
+		{
+			name:        "not_a_trigger",
+			extensions:  map[component.ID]component.Component{triggerID: &nopExtension{}},
+			expectedErr: `on_demand extension "trigger" is not a trigger extension`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rcvr, err := NewFactory().CreateMetricsReceiver(context.Background(), creationSet, newOnDemandTestConfig(), consumertest.NewNop())
+			require.NoError(t, err)
+			host := &extensionsHost{Host: componenttest.NewNopHost(), extensions: tt.extensions}
+			assert.EqualError(t, rcvr.Start(context.Background(), host), tt.expectedErr)
+			require.NoError(t, rcvr.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestEnableAllMetrics(t *testing.T) {
+	orig := scraperFactories[processscraper.TypeStr].CreateDefaultConfig().(*processscraper.Config)
+	orig.Include = processscraper.MatchConfig{Names: []string{"otelcol"}}
+
+	cfg, err := enableAllMetrics(orig)
+	require.NoError(t, err)
+	enabled := cfg.(*processscraper.Config)
+	assert.True(t, enabled.Metrics.ProcessContextSwitches.Enabled)
+	assert.True(t, enabled.ResourceAttributes.ProcessCgroup.Enabled)
+	assert.Equal(t, orig.Include, enabled.Include)
+
+	assert.False(t, orig.Metrics.ProcessContextSwitches.Enabled)
+	assert.False(t, orig.ResourceAttributes.ProcessCgroup.Enabled)
+}
+
+func getResourceMetricNames(rm pmetric.ResourceMetrics) map[string]struct{} {
+	names := map[string]struct{}{}
+	sms := rm.ScopeMetrics()
+	for i := 0; i < sms.Len(); i++ {
+		appendMapInto(names, getReturnedMetricNames(sms.At(i).Metrics()))
+	}
+	return names
+}
@@ -0,0 +1,365 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.uber.org/multierr"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// maxBodySize is the maximum size of a response body read for the assertions and extractions.
+const maxBodySize = 10 << 20
+
+// check is the sequence of requests made to check a target: the steps, then the request to the endpoint.
+type check struct {
+	steps   []*checkRequest
+	request *checkRequest
+}
+
+type checkRequest struct {
+	// endpoint is the configured endpoint, recorded as the http.url attribute whatever the
+	// values of its template, which can be secrets.
+	endpoint   string
+	method     string
+	url        *template.Template
+	headers    map[string]*template.Template
+	body       *template.Template
+	hasBody    bool
+	extract    map[string]*selector
+	assertions []*assertion
+}
+
+// selector selects a value of a response, with one of its fields.
+type selector struct {
+	jsonPath  *jsonpath.JSONPath
+	header    string
+	bodyRegex *regexp.Regexp
+}
+
+type assertion struct {
+	name            string
+	selector        *selector
+	equals          string
+	regex           *regexp.Regexp
+	tlsExpiresAfter time.Duration
+}
+
+// response is a response read for the assertions and extractions.
+type response struct {
+	resp *http.Response
+	body []byte
+	// document is the body decoded as JSON, decoded on first use.
+	document    any
+	documentErr error
+	decoded     bool
+}
+
+func compileCheck(cfg *targetConfig) (*check, error) {
+	var errs error
+	c := &check{}
+	for i, step := range cfg.Steps {
+		req, err := compileRequest(step.Endpoint, step.Method, step.Headers, step.Body, step.Extract, step.Assertions)
+		for _, stepErr := range multierr.Errors(err) {
+			errs = multierr.Append(errs, fmt.Errorf("step %d: %w", i, stepErr))
+		}
+		c.steps = append(c.steps, req)
+	}
+	req, err := compileRequest(cfg.Endpoint, cfg.Method, cfg.Headers, cfg.Body, nil, cfg.Assertions)
+	errs = multierr.Append(errs, err)
+	c.request = req
+	return c, errs
+}
+
+func compileRequest(endpoint string, method string, headers map[string]configopaque.String, body string,
+	extract map[string]extractConfig, assertions []assertionConfig) (*checkRequest, error) {
+	var errs error
+	req := &checkRequest{
+		endpoint: endpoint,
+		method:   method,
+		hasBody:  body != "",
+		headers:  make(map[string]*template.Template, len(headers)),
+		extract:  make(map[string]*selector, len(extract)),
+	}
+
+	var err error
+	if req.url, err = compileTemplate(endpoint); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid endpoint: %w", err))
+	}
+	for name, value := range headers {
+		if req.headers[name], err = compileTemplate(string(value)); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid header %q: %w", name, err))
+		}
+	}
+	if req.body, err = compileTemplate(body); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("invalid body: %w", err))
+	}
+	for name, cfg := range extract {
+		if req.extract[name], err = compileSelector(cfg.JSONPath, cfg.Header, cfg.BodyRegex); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid extraction of %q: %w", name, err))
+		}
+	}
+	for i, cfg := range assertions {
+		a, err := compileAssertion(cfg)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid assertion %d: %w", i, err))
+			continue
+		}
+		req.assertions = append(req.assertions, a)
+	}
+	return req, errs
+}
+
+func compileTemplate(text string) (*template.Template, error) {
+	return template.New("").Option("missingkey=error").Parse(text)
+}
+
+// compileJSONPath parses a JSONPath expression. Both the `$.a.b` and the braced `{.a.b}` forms are accepted.
+func compileJSONPath(path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
+
+func compileSelector(jsonPath string, header string, bodyRegex string) (*selector, error) {
+	s := &selector{header: header}
+	var err error
+	switch {
+	case jsonPath != "":
+		if s.jsonPath, err = compileJSONPath(jsonPath); err != nil {
+			return nil, fmt.Errorf("invalid json_path: %w", err)
+		}
+	case bodyRegex != "":
+		if s.bodyRegex, err = regexp.Compile(bodyRegex); err != nil {
+			return nil, fmt.Errorf("invalid body_regex: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func compileAssertion(cfg assertionConfig) (*assertion, error) {
+	a := &assertion{
+		name:            cfg.Name,
+		equals:          cfg.Equals,
+		tlsExpiresAfter: cfg.TLSExpiresAfter,
+	}
+	if a.name == "" {
+		switch {
+		case cfg.JSONPath != "":
+			a.name = "json_path " + cfg.JSONPath
+		case cfg.Header != "":
+			a.name = "header " + cfg.Header
+		case cfg.BodyRegex != "":
+			a.name = "body_regex " + cfg.BodyRegex
+		default:
+			a.name = "tls_expires_after " + cfg.TLSExpiresAfter.String()
+		}
+	}
+	if cfg.TLSExpiresAfter == 0 {
+		var err error
+		if a.selector, err = compileSelector(cfg.JSONPath, cfg.Header, cfg.BodyRegex); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Regex != "" {
+		var err error
+		if a.regex, err = regexp.Compile(cfg.Regex); err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+	}
+	return a, nil
+}
+
+// newRequest creates the request with the values extracted by the previous steps.
+func (r *checkRequest) newRequest(ctx context.Context, values map[string]string) (*http.Request, error) {
+	url, err := execute(r.url, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the endpoint: %w", err)
+	}
+	var body io.Reader = http.NoBody
+	if r.hasBody {
+		rendered, err := execute(r.body, values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the body: %w", err)
+		}
+		body = strings.NewReader(rendered)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	for name, tmpl := range r.headers {
+		value, err := execute(tmpl, values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the header %q: %w", name, err)
+		}
+		// The Host header sets the host of the request, like the headers of the client do.
+		if name == "Host" {
+			req.Host = value
+		}
+		req.Header.Set(name, value)
+	}
+	return req, nil
+}
+
+func execute(tmpl *template.Template, values map[string]string) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, values); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// needsBody reports whether the body of the response is needed for the assertions or extractions.
+func (r *checkRequest) needsBody() bool {
+	for _, s := range r.extract {
+		if s.header == "" {
+			return true
+		}
+	}
+	for _, a := range r.assertions {
+		if a.selector != nil && a.selector.header == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// readResponse reads the body of the response if needed, and closes it.
+func (r *checkRequest) readResponse(resp *http.Response) (*response, error) {
+	defer resp.Body.Close()
+	res := &response{resp: resp}
+	if !r.needsBody() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxBodySize))
+		return res, nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response body: %w", err)
+	}
+	res.body = body
+	return res, nil
+}
+
+// extractValues adds the values extracted from the response to values.
+func (r *checkRequest) extractValues(res *response, values map[string]string) error {
+	for name, s := range r.extract {
+		value, ok, err := s.find(res)
+		if err != nil {
+			return fmt.Errorf("failed to extract %q: %w", name, err)
+		}
+		if !ok {
+			return fmt.Errorf("failed to extract %q: value not found in the response", name)
+		}
+		values[name] = value
+	}
+	return nil
+}
+
+// find returns the selected value of the response, and false if it doesn't exist.
+func (s *selector) find(res *response) (string, bool, error) {
+	switch {
+	case s.jsonPath != nil:
+		document, err := res.json()
+		if err != nil {
+			return "", false, err
+		}
+		results, err := s.jsonPath.FindResults(document)
+		if err != nil {
+			return "", false, err
+		}
+		for _, result := range results {
+			for _, value := range result {
+				if value.IsValid() && value.CanInterface() {
+					return toString(value.Interface()), true, nil
+				}
+			}
+		}
+		return "", false, nil
+	case s.bodyRegex != nil:
+		match := s.bodyRegex.FindSubmatch(res.body)
+		if match == nil {
+			return "", false, nil
+		}
+		if len(match) > 1 {
+			return string(match[1]), true, nil
+		}
+		return string(match[0]), true, nil
+	default:
+		values := res.resp.Header.Values(s.header)
+		if len(values) == 0 {
+			return "", false, nil
+		}
+		return values[0], true, nil
+	}
+}
+
+// check reports whether the response passes the assertion.
+func (a *assertion) check(res *response, now time.Time) bool {
+	if a.selector == nil {
+		if res.resp.TLS == nil || len(res.resp.TLS.PeerCertificates) == 0 {
+			return false
+		}
+		return res.resp.TLS.PeerCertificates[0].NotAfter.Sub(now) >= a.tlsExpiresAfter
+	}
+	value, ok, err := a.selector.find(res)
+	if err != nil || !ok {
+		return false
+	}
+	switch {
+	case a.equals != "":
+		return value == a.equals
+	case a.regex != nil:
+		return a.regex.MatchString(value)
+	}
+	return true
+}
+
+func (r *response) json() (any, error) {
+	if !r.decoded {
+		r.decoded = true
+		// Numbers are kept as json.Number so that they are compared as written.
+		decoder := json.NewDecoder(bytes.NewReader(r.body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&r.document); err != nil {
+			r.documentErr = fmt.Errorf("could not decode the response body to JSON: %w", err)
+		}
+	}
+	return r.document, r.documentErr
+}
+
+func toString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
@@ -7,11 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/probe"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
 
@@ -25,12 +28,59 @@ var (
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	probe.Config                   `mapstructure:",squash"`
 	Targets                        []*targetConfig `mapstructure:"targets"`
 }
 
 type targetConfig struct {
 	confighttp.ClientConfig `mapstructure:",squash"`
 	Method                  string `mapstructure:"method"`
+	// Body is the body of the request.
+	Body string `mapstructure:"body"`
+	// Assertions are checked on the response.
+	Assertions []assertionConfig `mapstructure:"assertions"`
+	// Steps are requests made in order before the request to the endpoint, with the same client.
+	// The values extracted from their responses can be used in the endpoint, headers and body of
+	// the next requests as {{ .name }}.
+	Steps []stepConfig `mapstructure:"steps"`
+}
+
+// stepConfig is a request made before the request to the endpoint of a target.
+type stepConfig struct {
+	Endpoint string                         `mapstructure:"endpoint"`
+	Method   string                         `mapstructure:"method"`
+	Headers  map[string]configopaque.String `mapstructure:"headers"`
+	Body     string                         `mapstructure:"body"`
+	// Extract maps names to the values of the response made available to the next requests.
+	Extract map[string]extractConfig `mapstructure:"extract"`
+	// Assertions are checked on the response, the check stops at the first step failing one.
+	Assertions []assertionConfig `mapstructure:"assertions"`
+}
+
+// extractConfig selects a value of a response, exactly one of the fields must be set.
+type extractConfig struct {
+	// JSONPath selects the first value matched by the expression in the JSON body.
+	JSONPath string `mapstructure:"json_path"`
+	// Header selects the value of the header.
+	Header string `mapstructure:"header"`
+	// BodyRegex selects the first capture group of the regular expression in the body,
+	// or the whole match if it has no group.
+	BodyRegex string `mapstructure:"body_regex"`
+}
+
+// assertionConfig is a check of a response. Exactly one of JSONPath, Header, BodyRegex and
+// TLSExpiresAfter must be set. The selected value must exist, and match Equals or Regex if set.
+type assertionConfig struct {
+	// Name identifies the assertion in the httpcheck.assertion metric, it defaults to the
+	// kind of the assertion followed by its expression, e.g. "json_path $.status".
+	Name      string `mapstructure:"name"`
+	JSONPath  string `mapstructure:"json_path"`
+	Header    string `mapstructure:"header"`
+	BodyRegex string `mapstructure:"body_regex"`
+	Equals    string `mapstructure:"equals"`
+	Regex     string `mapstructure:"regex"`
+	// TLSExpiresAfter checks that the certificate of the server expires after at least this duration.
+	TLSExpiresAfter time.Duration `mapstructure:"tls_expires_after"`
 }
 
 // Validate validates the configuration by checking for missing or invalid fields
@@ -46,9 +96,76 @@ func (cfg *targetConfig) Validate() error {
 		}
 	}
 
+	for i, assertion := range cfg.Assertions {
+		for _, assertionErr := range multierr.Errors(assertion.Validate()) {
+			err = multierr.Append(err, fmt.Errorf("assertion %d: %w", i, assertionErr))
+		}
+	}
+	for i, step := range cfg.Steps {
+		for _, stepErr := range multierr.Errors(step.Validate()) {
+			err = multierr.Append(err, fmt.Errorf("step %d: %w", i, stepErr))
+		}
+	}
+	// Compiling the requests checks the templates, regular expressions and JSONPath expressions.
+	if _, compileErr := compileCheck(cfg); compileErr != nil {
+		err = multierr.Append(err, compileErr)
+	}
+
+	return err
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *stepConfig) Validate() error {
+	var err error
+
+	if cfg.Endpoint == "" {
+		err = multierr.Append(err, errMissingEndpoint)
+	}
+
+	for name, extract := range cfg.Extract {
+		if count(extract.JSONPath != "", extract.Header != "", extract.BodyRegex != "") != 1 {
+			err = multierr.Append(err, fmt.Errorf(`exactly one of "json_path", "header" and "body_regex" must be set to extract %q`, name))
+		}
+	}
+	for i, assertion := range cfg.Assertions {
+		for _, assertionErr := range multierr.Errors(assertion.Validate()) {
+			err = multierr.Append(err, fmt.Errorf("assertion %d: %w", i, assertionErr))
+		}
+	}
+
+	return err
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *assertionConfig) Validate() error {
+	var err error
+
+	if count(cfg.JSONPath != "", cfg.Header != "", cfg.BodyRegex != "", cfg.TLSExpiresAfter != 0) != 1 {
+		err = multierr.Append(err, errors.New(`exactly one of "json_path", "header", "body_regex" and "tls_expires_after" must be set in an assertion`))
+	}
+	if cfg.Equals != "" && cfg.Regex != "" {
+		err = multierr.Append(err, errors.New(`"equals" and "regex" cannot both be set in an assertion`))
+	}
+	if cfg.TLSExpiresAfter < 0 {
+		err = multierr.Append(err, errors.New(`"tls_expires_after" must not be negative`))
+	}
+	if cfg.TLSExpiresAfter != 0 && (cfg.Equals != "" || cfg.Regex != "") {
+		err = multierr.Append(err, errors.New(`"equals" and "regex" cannot be used with "tls_expires_after"`))
+	}
+
 	return err
 }
 
+func count(conditions ...bool) int {
+	n := 0
+	for _, c := range conditions {
+		if c {
+			n++
+		}
+	}
+	return n
+}
+
 // Validate validates the configuration by checking for missing or invalid fields
 func (cfg *Config) Validate() error {
 	var err error
@@ -61,5 +178,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, target.Validate())
 	}
 
+	if cfg.Jitter > 0 && cfg.CollectionInterval > 0 && cfg.Jitter >= cfg.CollectionInterval {
+		err = multierr.Append(err, fmt.Errorf(`"jitter" (%s) must be less than "collection_interval" (%s)`, cfg.Jitter, cfg.CollectionInterval))
+	}
+
 	return err
 }
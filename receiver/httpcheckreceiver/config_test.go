@@ -4,13 +4,17 @@
 package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/probe"
 )
 
 func TestValidate(t *testing.T) {
@@ -86,6 +90,74 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf("%w: %s", errInvalidEndpoint, `parse "www.opentelemetry.io/docs": invalid URI for request`),
 			),
 		},
+		{
+			desc: "jitter not less than collection interval",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: "https://opentelemetry.io",
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Config:           probe.Config{Jitter: time.Minute},
+			},
+			expectedErr: multierr.Combine(
+				errors.New(`"jitter" (1m0s) must be less than "collection_interval" (1m0s)`),
+			),
+		},
+		{
+			desc: "invalid assertions",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: "https://opentelemetry.io",
+						},
+						Assertions: []assertionConfig{
+							{JSONPath: "$.status", Header: "Content-Type"},
+							{Header: "Content-Type", Equals: "application/json", Regex: "json"},
+							{TLSExpiresAfter: time.Hour, Equals: "x"},
+							{BodyRegex: "("},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: multierr.Combine(
+				errors.New(`assertion 0: exactly one of "json_path", "header", "body_regex" and "tls_expires_after" must be set in an assertion`),
+				errors.New(`assertion 1: "equals" and "regex" cannot both be set in an assertion`),
+				errors.New(`assertion 2: "equals" and "regex" cannot be used with "tls_expires_after"`),
+				errors.New("invalid assertion 3: invalid body_regex: error parsing regexp: missing closing ): `(`"),
+			),
+		},
+		{
+			desc: "invalid steps",
+			cfg: &Config{
+				Targets: []*targetConfig{
+					{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: "https://opentelemetry.io/{{ .id }}",
+						},
+						Steps: []stepConfig{
+							{
+								Extract: map[string]extractConfig{"id": {}},
+							},
+							{
+								Endpoint: "https://opentelemetry.io/{{ .id",
+							},
+						},
+					},
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf("step 0: %w", errMissingEndpoint),
+				errors.New(`step 0: exactly one of "json_path", "header" and "body_regex" must be set to extract "id"`),
+				errors.New(`step 1: invalid endpoint: template: :1: unclosed action`),
+			),
+		},
 		{
 			desc: "valid config",
 			cfg: &Config{
@@ -100,6 +172,22 @@ func TestValidate(t *testing.T) {
 							Endpoint: "https://opentelemetry.io:80/docs",
 						},
 					},
+					{
+						ClientConfig: confighttp.ClientConfig{
+							Endpoint: "https://opentelemetry.io/api/orders",
+						},
+						Assertions: []assertionConfig{
+							{JSONPath: "$.status", Equals: "ok"},
+							{TLSExpiresAfter: 7 * 24 * time.Hour},
+						},
+						Steps: []stepConfig{
+							{
+								Endpoint: "https://opentelemetry.io/api/login",
+								Method:   "POST",
+								Extract:  map[string]extractConfig{"token": {JSONPath: "$.token"}},
+							},
+						},
+					},
 				},
 				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
 			},
@@ -49,5 +49,8 @@ func createMetricsReceiver(_ context.Context, params receiver.CreateSettings, rC
 		return nil, err
 	}
 
-	return scraperhelper.NewScraperControllerReceiver(&cfg.ControllerConfig, params, consumer, scraperhelper.AddScraper(scraper))
+	// The jitter is applied once, before the ticker of the controller starts, rather than on every scrape.
+	controllerConfig := cfg.ControllerConfig
+	controllerConfig.InitialDelay += cfg.StartDelay()
+	return scraperhelper.NewScraperControllerReceiver(&controllerConfig, params, consumer, scraperhelper.AddScraper(scraper))
 }
@@ -6,6 +6,7 @@ package httpcheckreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/probe"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/httpcheckreceiver/internal/metadata"
 )
 
@@ -27,15 +29,27 @@ var (
 
 type httpcheckScraper struct {
 	clients  []*http.Client
+	checks   []*check
 	cfg      *Config
 	settings component.TelemetrySettings
 	mb       *metadata.MetricsBuilder
+	runner   *probe.Runner
 }
 
 // start starts the scraper by creating a new HTTP Client on the scraper
 func (h *httpcheckScraper) start(ctx context.Context, host component.Host) (err error) {
 	for _, target := range h.cfg.Targets {
-		client, clentErr := target.ToClient(ctx, host, h.settings)
+		c, compileErr := compileCheck(target)
+		if compileErr != nil {
+			err = multierr.Append(err, compileErr)
+		}
+		h.checks = append(h.checks, c)
+
+		// The headers are set on each request instead, so that they can use the values
+		// extracted by the steps, and aren't sent to the endpoints of the steps.
+		clientCfg := target.ClientConfig
+		clientCfg.Headers = nil
+		client, clentErr := clientCfg.ToClient(ctx, host, h.settings)
 		if clentErr != nil {
 			err = multierr.Append(err, clentErr)
 		}
@@ -50,48 +64,106 @@ func (h *httpcheckScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		return pmetric.NewMetrics(), errClientNotInit
 	}
 
-	var wg sync.WaitGroup
-	wg.Add(len(h.clients))
 	var mux sync.Mutex
+	h.runner.Run(ctx, len(h.clients), func(ctx context.Context, targetIndex int) {
+		targetClient := h.clients[targetIndex]
+		targetCheck := h.checks[targetIndex]
+		endpoint := h.cfg.Targets[targetIndex].Endpoint
+		now := pcommon.NewTimestampFromTime(time.Now())
+
+		values := map[string]string{}
+		for i, step := range targetCheck.steps {
+			if err := h.runStep(ctx, targetClient, step, values, now, &mux); err != nil {
+				mux.Lock()
+				defer mux.Unlock()
+				h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), step.endpoint, fmt.Sprintf("step %d: %s", i, err))
+				h.recordStatus(now, endpoint, 0, targetCheck.request.method)
+				return
+			}
+		}
 
-	for idx, client := range h.clients {
-		go func(targetClient *http.Client, targetIndex int) {
-			defer wg.Done()
+		req, err := targetCheck.request.newRequest(ctx, values)
+		if err != nil {
+			h.settings.Logger.Error("failed to create request", zap.Error(err))
+			return
+		}
 
-			now := pcommon.NewTimestampFromTime(time.Now())
+		start := time.Now()
+		resp, err := targetClient.Do(req)
+		duration := time.Since(start)
+		var res *response
+		if err == nil {
+			res, err = targetCheck.request.readResponse(resp)
+		}
+		mux.Lock()
+		defer mux.Unlock()
+		h.mb.RecordHttpcheckDurationDataPoint(now, duration.Milliseconds(), endpoint)
 
-			req, err := http.NewRequestWithContext(ctx, h.cfg.Targets[targetIndex].Method, h.cfg.Targets[targetIndex].Endpoint, http.NoBody)
-			if err != nil {
-				h.settings.Logger.Error("failed to create request", zap.Error(err))
-				return
-			}
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		if err != nil {
+			h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), endpoint, err.Error())
+		} else {
+			h.recordAssertions(now, targetCheck.request, res)
+		}
+		h.recordStatus(now, endpoint, statusCode, req.Method)
+	})
 
-			start := time.Now()
-			resp, err := targetClient.Do(req)
-			mux.Lock()
-			h.mb.RecordHttpcheckDurationDataPoint(now, time.Since(start).Milliseconds(), h.cfg.Targets[targetIndex].Endpoint)
+	return h.mb.Emit(), nil
+}
 
-			statusCode := 0
-			if err != nil {
-				h.mb.RecordHttpcheckErrorDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, err.Error())
-			} else {
-				statusCode = resp.StatusCode
-			}
+// runStep makes the request of a step, records its assertions and adds the values extracted
+// from its response to values. It fails if the request fails or if an assertion fails.
+func (h *httpcheckScraper) runStep(ctx context.Context, client *http.Client, step *checkRequest, values map[string]string, now pcommon.Timestamp, mux *sync.Mutex) error {
+	req, err := step.newRequest(ctx, values)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	res, err := step.readResponse(resp)
+	if err != nil {
+		return err
+	}
 
-			for class, intVal := range httpResponseClasses {
-				if statusCode/100 == intVal {
-					h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), h.cfg.Targets[targetIndex].Endpoint, int64(statusCode), req.Method, class)
-				} else {
-					h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), h.cfg.Targets[targetIndex].Endpoint, int64(statusCode), req.Method, class)
-				}
-			}
-			mux.Unlock()
-		}(client, idx)
+	mux.Lock()
+	failed := h.recordAssertions(now, step, res)
+	mux.Unlock()
+	if failed > 0 {
+		return fmt.Errorf("%d of %d assertions failed", failed, len(step.assertions))
 	}
+	return step.extractValues(res, values)
+}
 
-	wg.Wait()
+// recordAssertions records the result of the assertions of the request, and returns the number of failed ones.
+func (h *httpcheckScraper) recordAssertions(now pcommon.Timestamp, req *checkRequest, res *response) int {
+	failed := 0
+	for _, a := range req.assertions {
+		passed := int64(1)
+		if !a.check(res, now.AsTime()) {
+			passed = 0
+			failed++
+		}
+		h.mb.RecordHttpcheckAssertionDataPoint(now, passed, req.endpoint, a.name)
+	}
+	return failed
+}
 
-	return h.mb.Emit(), nil
+func (h *httpcheckScraper) recordStatus(now pcommon.Timestamp, endpoint string, statusCode int, method string) {
+	if method == "" {
+		method = http.MethodGet
+	}
+	for class, intVal := range httpResponseClasses {
+		if statusCode/100 == intVal {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(1), endpoint, int64(statusCode), method, class)
+		} else {
+			h.mb.RecordHttpcheckStatusDataPoint(now, int64(0), endpoint, int64(statusCode), method, class)
+		}
+	}
 }
 
 func newScraper(conf *Config, settings receiver.CreateSettings) *httpcheckScraper {
@@ -99,5 +171,6 @@ func newScraper(conf *Config, settings receiver.CreateSettings) *httpcheckScrape
 		cfg:      conf,
 		settings: settings.TelemetrySettings,
 		mb:       metadata.NewMetricsBuilder(conf.MetricsBuilderConfig, settings),
+		runner:   probe.NewRunner(conf.Config),
 	}
 }
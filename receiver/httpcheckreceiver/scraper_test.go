@@ -9,10 +9,13 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
@@ -201,8 +204,8 @@ func TestScraperMultipleTargets(t *testing.T) {
 	cfg := createDefaultConfig().(*Config)
 	ms1 := newMockServer(t, 200)
 	defer ms1.Close()
-	ms2 := newMockServer(t, 404)
-	defer ms2.Close()
+	metrics := newMockServer(t, 404)
+	defer metrics.Close()
 
 	cfg.Targets = append(cfg.Targets, &targetConfig{
 		ClientConfig: confighttp.ClientConfig{
@@ -211,7 +214,7 @@ func TestScraperMultipleTargets(t *testing.T) {
 	})
 	cfg.Targets = append(cfg.Targets, &targetConfig{
 		ClientConfig: confighttp.ClientConfig{
-			Endpoint: ms2.URL,
+			Endpoint: metrics.URL,
 		},
 	})
 
@@ -233,3 +236,182 @@ func TestScraperMultipleTargets(t *testing.T) {
 		pmetrictest.IgnoreTimestamp(),
 	))
 }
+
+func TestScraperProbeTimeout(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.ProbeTimeout = 50 * time.Millisecond
+	cfg.Targets = append(cfg.Targets, &targetConfig{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: slow.URL,
+		},
+	})
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	var errorMessage string
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "httpcheck.error" {
+			attr, _ := metrics.At(i).Sum().DataPoints().At(0).Attributes().Get("error.message")
+			errorMessage = attr.Str()
+		}
+	}
+	assert.Contains(t, errorMessage, context.DeadlineExceeded.Error())
+}
+
+func newLoginServer(t *testing.T) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/login":
+			rw.Header().Set("X-Session", "s1")
+			_, err := rw.Write([]byte(`{"token": "t0k3n", "expires_in": 3600}`))
+			require.NoError(t, err)
+		case "/orders":
+			if req.Header.Get("Authorization") != "Bearer t0k3n" || req.URL.Query().Get("session") != "s1" {
+				rw.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			rw.Header().Set("Content-Type", "application/json")
+			_, err := rw.Write([]byte(`{"status": "ok", "orders": [{"id": 1}, {"id": 2}]}`))
+			require.NoError(t, err)
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// assertionValues returns the values of the httpcheck.assertion data points by assertion name.
+func assertionValues(t *testing.T, metrics pmetric.Metrics) map[string]int64 {
+	values := map[string]int64{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() != "httpcheck.assertion" {
+			continue
+		}
+		dps := ms.At(i).Sum().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			name, ok := dps.At(j).Attributes().Get("assertion.name")
+			require.True(t, ok)
+			values[name.Str()] = dps.At(j).IntValue()
+		}
+	}
+	return values
+}
+
+func statusCode(t *testing.T, metrics pmetric.Metrics) int64 {
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == "httpcheck.status" {
+			code, ok := ms.At(i).Sum().DataPoints().At(0).Attributes().Get("http.status_code")
+			require.True(t, ok)
+			return code.Int()
+		}
+	}
+	require.FailNow(t, "missing httpcheck.status metric")
+	return 0
+}
+
+func TestScraperAssertionsAndSteps(t *testing.T) {
+	ms := newLoginServer(t)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint:   ms.URL + "/orders?session={{ .session }}",
+			TLSSetting: configtls.ClientConfig{InsecureSkipVerify: true},
+			Headers: map[string]configopaque.String{
+				"Authorization": "Bearer {{ .token }}",
+			},
+		},
+		Assertions: []assertionConfig{
+			{JSONPath: "$.status", Equals: "ok"},
+			{Name: "second order", JSONPath: "$.orders[1].id", Regex: "^[0-9]+$"},
+			{JSONPath: "$.missing"},
+			{Header: "Content-Type", Regex: "json"},
+			{BodyRegex: `"status":\s*"(\w+)"`, Equals: "ok"},
+			{TLSExpiresAfter: 24 * time.Hour},
+			{Name: "expires in a century", TLSExpiresAfter: 100 * 365 * 24 * time.Hour},
+		},
+		Steps: []stepConfig{{
+			Endpoint: ms.URL + "/login",
+			Method:   http.MethodPost,
+			Body:     `{"user": "probe"}`,
+			Extract: map[string]extractConfig{
+				"token":   {JSONPath: "$.token"},
+				"session": {Header: "X-Session"},
+			},
+			Assertions: []assertionConfig{
+				{Name: "token expiry", JSONPath: "$.expires_in", Equals: "3600"},
+			},
+		}},
+	}}
+	require.NoError(t, cfg.Validate())
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(200), statusCode(t, actualMetrics))
+	assert.Equal(t, map[string]int64{
+		"token expiry":                   1,
+		"json_path $.status":             1,
+		"second order":                   1,
+		"json_path $.missing":            0,
+		"header Content-Type":            1,
+		`body_regex "status":\s*"(\w+)"`: 1,
+		"tls_expires_after 24h0m0s":      1,
+		"expires in a century":           0,
+	}, assertionValues(t, actualMetrics))
+}
+
+func TestScraperFailedStep(t *testing.T) {
+	ms := newLoginServer(t)
+	defer ms.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Targets = []*targetConfig{{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint:   ms.URL + "/orders",
+			TLSSetting: configtls.ClientConfig{InsecureSkipVerify: true},
+		},
+		Steps: []stepConfig{{
+			Endpoint: ms.URL + "/login",
+			Extract: map[string]extractConfig{
+				"token": {JSONPath: "$.access_token"},
+			},
+		}},
+	}}
+
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(0), statusCode(t, actualMetrics))
+	metrics := actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var errorMessage string
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == "httpcheck.error" {
+			dp := metrics.At(i).Sum().DataPoints().At(0)
+			url, _ := dp.Attributes().Get("http.url")
+			assert.Equal(t, ms.URL+"/login", url.Str())
+			message, _ := dp.Attributes().Get("error.message")
+			errorMessage = message.Str()
+		}
+	}
+	assert.Equal(t, `step 0: failed to extract "token": value not found in the response`, errorMessage)
+}
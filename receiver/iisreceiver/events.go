@@ -0,0 +1,218 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver"
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+)
+
+const (
+	// wasProvider is the provider of the events of the Windows Process Activation Service,
+	// which manages the application pools and their worker processes.
+	wasProvider = "Microsoft-Windows-WAS"
+	// wasChannel is the event log channel the WAS events are written to.
+	wasChannel = "System"
+
+	eventDomain = "iis"
+
+	eventNameRecycle             = "application_pool.recycle"
+	eventNameRapidFailProtection = "application_pool.rapid_fail_protection"
+	eventNameWorkerProcessFailed = "worker_process.failure"
+
+	attributeEventDomain     = "event.domain"
+	attributeEventName       = "event.name"
+	attributeEventID         = "iis.event.id"
+	attributeEventReason     = "iis.event.reason"
+	attributeSites           = "iis.sites"
+	attributeProcessPID      = "process.pid"
+	attributeProcessExitCode = "process.exit_code"
+
+	// defaultAppPool is the application pool of the applications that don't configure one.
+	defaultAppPool = "DefaultAppPool"
+)
+
+// wasEvent describes a WAS event collected by the receiver.
+type wasEvent struct {
+	name   string
+	reason string
+}
+
+// wasEvents are the collected WAS events, by event ID.
+var wasEvents = map[uint32]wasEvent{
+	5002: {name: eventNameRapidFailProtection, reason: "repeated_failures"},
+	5009: {name: eventNameWorkerProcessFailed, reason: "crash"},
+	5010: {name: eventNameWorkerProcessFailed, reason: "ping_failure"},
+	5011: {name: eventNameWorkerProcessFailed, reason: "communication_error"},
+	5013: {name: eventNameWorkerProcessFailed, reason: "shutdown_timeout"},
+	5074: {name: eventNameRecycle, reason: "time"},
+	5075: {name: eventNameRecycle, reason: "requests"},
+	5076: {name: eventNameRecycle, reason: "schedule"},
+	5077: {name: eventNameRecycle, reason: "memory"},
+	5078: {name: eventNameRecycle, reason: "isapi_unhealthy"},
+	5079: {name: eventNameRecycle, reason: "on_demand"},
+	5080: {name: eventNameRecycle, reason: "config_change"},
+	5117: {name: eventNameRecycle, reason: "private_memory"},
+}
+
+// eventsToLogs converts the collected WAS events to log records, grouped by application pool.
+// The sites served by each application pool are added to its log records, so that they can be
+// correlated with the site metrics.
+func eventsToLogs(events []windows.EventXML, sites map[string][]string, observed time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	appPoolLogs := map[string]plog.LogRecordSlice{}
+
+	for _, event := range events {
+		if event.Provider.Name != wasProvider {
+			continue
+		}
+		e, ok := wasEvents[event.EventID.ID]
+		if !ok {
+			continue
+		}
+
+		data := map[string]string{}
+		for _, d := range event.EventData.Data {
+			data[d.Name] = d.Value
+		}
+		appPool := data["AppPoolID"]
+
+		records, ok := appPoolLogs[appPool]
+		if !ok {
+			rl := logs.ResourceLogs().AppendEmpty()
+			if appPool != "" {
+				rl.Resource().Attributes().PutStr("iis.application_pool", appPool)
+			}
+			sl := rl.ScopeLogs().AppendEmpty()
+			sl.Scope().SetName("otelcol/iisreceiver")
+			records = sl.LogRecords()
+			appPoolLogs[appPool] = records
+		}
+
+		lr := records.AppendEmpty()
+		if timestamp, err := time.Parse(time.RFC3339Nano, event.TimeCreated.SystemTime); err == nil {
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+		}
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(observed))
+		severity := eventSeverity(event.Level)
+		lr.SetSeverityNumber(severity)
+		lr.SetSeverityText(severity.String())
+
+		attrs := lr.Attributes()
+		attrs.PutStr(attributeEventDomain, eventDomain)
+		attrs.PutStr(attributeEventName, e.name)
+		attrs.PutInt(attributeEventID, int64(event.EventID.ID))
+		attrs.PutStr(attributeEventReason, e.reason)
+		if pid, err := strconv.ParseInt(data["ProcessID"], 10, 64); err == nil {
+			attrs.PutInt(attributeProcessPID, pid)
+		}
+		if exitCode, ok := parseExitCode(data["ExitCode"]); ok {
+			attrs.PutInt(attributeProcessExitCode, exitCode)
+		}
+		if poolSites := sites[appPool]; len(poolSites) > 0 {
+			s := attrs.PutEmptySlice(attributeSites)
+			for _, site := range poolSites {
+				s.AppendEmpty().SetStr(site)
+			}
+		}
+
+		if event.Message != "" {
+			lr.Body().SetStr(event.Message)
+		} else {
+			lr.Body().SetStr(fmt.Sprintf("%s of application pool '%s': %s", e.name, appPool, e.reason))
+		}
+	}
+	return logs
+}
+
+// eventSeverity returns the severity of a log record from the level of the event.
+func eventSeverity(level string) plog.SeverityNumber {
+	switch level {
+	case "1":
+		return plog.SeverityNumberFatal
+	case "2":
+		return plog.SeverityNumberError
+	case "3":
+		return plog.SeverityNumberWarn
+	default:
+		return plog.SeverityNumberInfo
+	}
+}
+
+// parseExitCode parses the exit code of a worker process, which WAS reports in hexadecimal.
+func parseExitCode(value string) (int64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if code, err := strconv.ParseUint(value, 0, 32); err == nil {
+		// Exit codes are NTSTATUS values, e.g. 0xc0000005, reported as a signed 32 bits integer.
+		return int64(int32(code)), true
+	}
+	return 0, false
+}
+
+type applicationHostConfig struct {
+	Sites struct {
+		ApplicationDefaults applicationDefaults `xml:"applicationDefaults"`
+		Sites               []struct {
+			Name                string              `xml:"name,attr"`
+			ApplicationDefaults applicationDefaults `xml:"applicationDefaults"`
+			Applications        []struct {
+				ApplicationPool string `xml:"applicationPool,attr"`
+			} `xml:"application"`
+		} `xml:"site"`
+	} `xml:"system.applicationHost>sites"`
+}
+
+type applicationDefaults struct {
+	ApplicationPool string `xml:"applicationPool,attr"`
+}
+
+// parseApplicationPoolSites returns the sites served by each application pool from the
+// applicationHost.config configuration file of IIS.
+func parseApplicationPoolSites(r io.Reader) (map[string][]string, error) {
+	var config applicationHostConfig
+	if err := xml.NewDecoder(r).Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse the IIS configuration: %w", err)
+	}
+
+	poolSites := map[string]map[string]struct{}{}
+	for _, site := range config.Sites.Sites {
+		siteDefault := site.ApplicationDefaults.ApplicationPool
+		if siteDefault == "" {
+			siteDefault = config.Sites.ApplicationDefaults.ApplicationPool
+		}
+		if siteDefault == "" {
+			siteDefault = defaultAppPool
+		}
+		for _, app := range site.Applications {
+			pool := app.ApplicationPool
+			if pool == "" {
+				pool = siteDefault
+			}
+			if poolSites[pool] == nil {
+				poolSites[pool] = map[string]struct{}{}
+			}
+			poolSites[pool][site.Name] = struct{}{}
+		}
+	}
+
+	sites := make(map[string][]string, len(poolSites))
+	for pool, names := range poolSites {
+		for name := range names {
+			sites[pool] = append(sites[pool], name)
+		}
+		sort.Strings(sites[pool])
+	}
+	return sites, nil
+}
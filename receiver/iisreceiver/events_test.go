@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iisreceiver
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+)
+
+func wasEventXML(id uint32, level string, data map[string]string) windows.EventXML {
+	event := windows.EventXML{
+		EventID:     windows.EventID{ID: id},
+		Provider:    windows.Provider{Name: wasProvider},
+		Channel:     wasChannel,
+		Level:       level,
+		TimeCreated: windows.TimeCreated{SystemTime: "2024-06-01T12:00:00.0000000Z"},
+	}
+	for name, value := range data {
+		event.EventData.Data = append(event.EventData.Data, windows.Data{Name: name, Value: value})
+	}
+	return event
+}
+
+func TestEventsToLogs(t *testing.T) {
+	events := []windows.EventXML{
+		wasEventXML(5077, "4", map[string]string{"AppPoolID": "ShopAppPool", "ProcessID": "4242"}),
+		wasEventXML(5009, "3", map[string]string{"AppPoolID": "ApiAppPool", "ProcessID": "1337", "ExitCode": "0xc0000005"}),
+		wasEventXML(5002, "2", map[string]string{"AppPoolID": "ApiAppPool"}),
+		// Events that are not collected.
+		wasEventXML(5186, "4", map[string]string{"AppPoolID": "ShopAppPool", "ProcessID": "4242"}),
+		{EventID: windows.EventID{ID: 5009}, Provider: windows.Provider{Name: "Service Control Manager"}},
+	}
+	events[2].Message = "Application pool 'ApiAppPool' is being automatically disabled due to a series of failures in the process(es) serving that application pool."
+	sites := map[string][]string{"ApiAppPool": {"Default Web Site", "Shop"}}
+	observed := time.Date(2024, 6, 1, 12, 0, 1, 0, time.UTC)
+
+	logs := eventsToLogs(events, sites, observed)
+	require.Equal(t, 3, logs.LogRecordCount())
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+
+	shop := logs.ResourceLogs().At(0)
+	appPool, ok := shop.Resource().Attributes().Get("iis.application_pool")
+	require.True(t, ok)
+	assert.Equal(t, "ShopAppPool", appPool.Str())
+	assert.Equal(t, "otelcol/iisreceiver", shop.ScopeLogs().At(0).Scope().Name())
+
+	recycle := shop.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC), recycle.Timestamp().AsTime())
+	assert.Equal(t, observed, recycle.ObservedTimestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberInfo, recycle.SeverityNumber())
+	assert.Equal(t, "application_pool.recycle of application pool 'ShopAppPool': memory", recycle.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.domain":     "iis",
+		"event.name":       "application_pool.recycle",
+		"iis.event.id":     int64(5077),
+		"iis.event.reason": "memory",
+		"process.pid":      int64(4242),
+	}, recycle.Attributes().AsRaw())
+
+	api := logs.ResourceLogs().At(1)
+	appPool, ok = api.Resource().Attributes().Get("iis.application_pool")
+	require.True(t, ok)
+	assert.Equal(t, "ApiAppPool", appPool.Str())
+	require.Equal(t, 2, api.ScopeLogs().At(0).LogRecords().Len())
+
+	crash := api.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberWarn, crash.SeverityNumber())
+	assert.Equal(t, map[string]any{
+		"event.domain":      "iis",
+		"event.name":        "worker_process.failure",
+		"iis.event.id":      int64(5009),
+		"iis.event.reason":  "crash",
+		"iis.sites":         []any{"Default Web Site", "Shop"},
+		"process.pid":       int64(1337),
+		"process.exit_code": int64(-1073741819),
+	}, crash.Attributes().AsRaw())
+
+	rapidFail := api.ScopeLogs().At(0).LogRecords().At(1)
+	assert.Equal(t, plog.SeverityNumberError, rapidFail.SeverityNumber())
+	assert.Equal(t, "Error", rapidFail.SeverityText())
+	assert.Equal(t, events[2].Message, rapidFail.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.domain":     "iis",
+		"event.name":       "application_pool.rapid_fail_protection",
+		"iis.event.id":     int64(5002),
+		"iis.event.reason": "repeated_failures",
+		"iis.sites":        []any{"Default Web Site", "Shop"},
+	}, rapidFail.Attributes().AsRaw())
+}
+
+func TestParseApplicationPoolSites(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "config", "applicationHost.config"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	sites, err := parseApplicationPoolSites(f)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"DefaultAppPool": {"Default Web Site"},
+		"ApiAppPool":     {"Default Web Site", "Shop"},
+		"ShopAppPool":    {"Shop"},
+	}, sites)
+}
+
+func TestParseApplicationPoolSitesInvalid(t *testing.T) {
+	_, err := parseApplicationPoolSites(strings.NewReader("<configuration>"))
+	assert.ErrorContains(t, err, "failed to parse the IIS configuration")
+}
@@ -23,3 +23,13 @@ func createMetricsReceiver(
 ) (receiver.Metrics, error) {
 	return nil, errors.New("the windows perf counters receiver is only supported on Windows")
 }
+
+// createLogsReceiver creates a logs receiver based on provided config.
+func createLogsReceiver(
+	_ context.Context,
+	_ receiver.CreateSettings,
+	_ component.Config,
+	_ consumer.Logs,
+) (receiver.Logs, error) {
+	return nil, errors.New("the iis receiver is only supported on Windows")
+}
@@ -22,3 +22,12 @@ func TestCreateMetricsReceiver(t *testing.T) {
 	assert.EqualError(t, err, "the windows perf counters receiver is only supported on Windows")
 	assert.Nil(t, mReceiver)
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	lReceiver, err := factory.CreateLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+
+	assert.EqualError(t, err, "the iis receiver is only supported on Windows")
+	assert.Nil(t, lReceiver)
+}
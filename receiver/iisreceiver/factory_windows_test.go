@@ -27,4 +27,13 @@ func TestWindowsFactory(t *testing.T) {
 	)
 	require.NoError(t, err)
 	require.NotNil(t, r)
+
+	l, err := f.CreateLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		cfg,
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, l)
 }
@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build windows
+
+package iisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/iisreceiver"
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/stanza/operator/input/windows"
+)
+
+// maxReads is the maximum number of events read from the subscription at once.
+const maxReads = 100
+
+// logsReceiver reads the application pool recycles, rapid-fail protection trips and worker
+// process failures reported by WAS to the event log and emits them as log records.
+type logsReceiver struct {
+	config       *Config
+	settings     receiver.CreateSettings
+	consumer     consumer.Logs
+	subscription windows.Subscription
+	publisher    windows.Publisher
+	buffer       windows.Buffer
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+
+	// applicationHostConfigPath is the path of the IIS configuration, which lists the sites.
+	applicationHostConfigPath string
+}
+
+func newLogsReceiver(set receiver.CreateSettings, config *Config, consumer consumer.Logs) *logsReceiver {
+	return &logsReceiver{
+		config:                    config,
+		settings:                  set,
+		consumer:                  consumer,
+		subscription:              windows.NewSubscription(),
+		publisher:                 windows.NewPublisher(),
+		buffer:                    windows.NewBuffer(),
+		applicationHostConfigPath: filepath.Join(os.Getenv("windir"), "System32", "inetsrv", "config", "applicationHost.config"),
+	}
+}
+
+func (r *logsReceiver) Start(_ context.Context, _ component.Host) error {
+	// Only the events reported after the receiver started are collected.
+	if err := r.subscription.Open(wasChannel, "end", windows.NewBookmark()); err != nil {
+		return err
+	}
+	if err := r.publisher.Open(wasProvider); err != nil {
+		r.settings.Logger.Warn("Failed to open the WAS event source, the messages of the events won't be formatted", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.config.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.poll(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	return multierr.Combine(r.subscription.Close(), r.publisher.Close())
+}
+
+// poll reads the events reported since the previous poll.
+func (r *logsReceiver) poll(ctx context.Context) {
+	var events []windows.EventXML
+	for ctx.Err() == nil {
+		batch, err := r.subscription.Read(maxReads)
+		if err != nil {
+			r.settings.Logger.Error("Failed to read events from subscription", zap.Error(err))
+			break
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, event := range batch {
+			if eventXML, ok := r.render(event); ok {
+				events = append(events, eventXML)
+			}
+			event.Close()
+		}
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	logs := eventsToLogs(events, r.applicationPoolSites(), time.Now())
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.settings.Logger.Error("Error consuming IIS events", zap.Error(err))
+	}
+}
+
+// render renders the events of WAS, the events of the other providers of the channel are skipped.
+func (r *logsReceiver) render(event windows.Event) (windows.EventXML, bool) {
+	simpleEvent, err := event.RenderSimple(r.buffer)
+	if err != nil {
+		r.settings.Logger.Error("Failed to render simple event", zap.Error(err))
+		return windows.EventXML{}, false
+	}
+	if simpleEvent.Provider.Name != wasProvider {
+		return windows.EventXML{}, false
+	}
+	if _, ok := wasEvents[simpleEvent.EventID.ID]; !ok || !r.publisher.Valid() {
+		return simpleEvent, true
+	}
+
+	formattedEvent, err := event.RenderFormatted(r.buffer, r.publisher)
+	if err != nil {
+		r.settings.Logger.Debug("Failed to render formatted event", zap.Error(err))
+		return simpleEvent, true
+	}
+	return formattedEvent, true
+}
+
+// applicationPoolSites reads the sites served by each application pool, so that the configuration
+// changes made since the previous poll are taken into account.
+func (r *logsReceiver) applicationPoolSites() map[string][]string {
+	f, err := os.Open(r.applicationHostConfigPath)
+	if err != nil {
+		r.settings.Logger.Debug("Failed to open the IIS configuration, the sites of the application pools won't be reported", zap.Error(err))
+		return nil
+	}
+	defer f.Close()
+
+	sites, err := parseApplicationPoolSites(f)
+	if err != nil {
+		r.settings.Logger.Debug("The sites of the application pools won't be reported", zap.Error(err))
+		return nil
+	}
+	return sites
+}
@@ -27,6 +27,8 @@ type Config struct {
 	Endpoint string `mapstructure:"endpoint"`
 	// The target system for the metric gatherer whose built in groovy script to run.
 	TargetSystem string `mapstructure:"target_system"`
+	// The metrics reported from the attributes of custom MBeans, instead of the target system.
+	Metrics []MetricMapping `mapstructure:"metrics"`
 	// The duration in between groovy script invocations and metric exports (10 seconds by default).
 	// Will be converted to milliseconds.
 	CollectionInterval time.Duration `mapstructure:"collection_interval"`
@@ -223,7 +225,7 @@ func (c *Config) Validate() error {
 	if c.Endpoint == "" {
 		missingFields = append(missingFields, "`endpoint`")
 	}
-	if c.TargetSystem == "" {
+	if c.TargetSystem == "" && len(c.Metrics) == 0 {
 		missingFields = append(missingFields, "`target_system`")
 	}
 	if missingFields != nil {
@@ -257,6 +259,19 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if len(c.Metrics) > 0 {
+		// The JMX Metric Gatherer only runs the groovy script when no target system is set.
+		if c.TargetSystem != "" {
+			return errors.New("only one of `target_system` and `metrics` can be set")
+		}
+		for i, m := range c.Metrics {
+			if err := m.validate(); err != nil {
+				return fmt.Errorf("invalid `metrics` %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+
 	for _, system := range strings.Split(c.TargetSystem, ",") {
 		if _, ok := validTargetSystems[strings.ToLower(system)]; !ok {
 			return fmt.Errorf("`target_system` list may only be a subset of %s", listKeys(validTargetSystems))
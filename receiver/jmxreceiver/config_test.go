@@ -197,6 +197,83 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "metrics"),
+			expected: &Config{
+				JARPath:  "testdata/fake_jmx.jar",
+				Endpoint: "myendpoint:55555",
+				Metrics: []MetricMapping{
+					{
+						Name:        "cache.hits",
+						Description: "The number of cache hits",
+						Unit:        "{hits}",
+						ObjectName:  "com.example:type=Cache,name=*",
+						Attribute:   "Hits",
+						Type:        "counter",
+						ValueType:   "int",
+						Attributes:  map[string]string{"cache": "name"},
+					},
+					{
+						Name:       "queue.size",
+						ObjectName: "com.example:type=Queue",
+						Attribute:  "Size",
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "metricsandtargetsystem"),
+			expectedErr: "only one of `target_system` and `metrics` can be set",
+			expected: &Config{
+				JARPath:      "testdata/fake_jmx.jar",
+				Endpoint:     "myendpoint:55555",
+				TargetSystem: "jvm",
+				Metrics: []MetricMapping{
+					{
+						Name:       "queue.size",
+						ObjectName: "com.example:type=Queue",
+						Attribute:  "Size",
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidmetrictype"),
+			expectedErr: "invalid `metrics` 0: `type` must be one of 'counter', 'gauge', 'updowncounter'",
+			expected: &Config{
+				JARPath:  "testdata/fake_jmx.jar",
+				Endpoint: "myendpoint:55555",
+				Metrics: []MetricMapping{
+					{
+						Name:       "queue.size",
+						ObjectName: "com.example:type=Queue",
+						Attribute:  "Size",
+						Type:       "histogram",
+					},
+				},
+				CollectionInterval: 10 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "0.0.0.0:0",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 5 * time.Second,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -315,6 +392,35 @@ func TestClassPathParse(t *testing.T) {
 	}
 }
 
+func TestBuildGroovyScript(t *testing.T) {
+	cfg := &Config{
+		Metrics: []MetricMapping{
+			{
+				Name:        "cache.hits",
+				Description: "The number of cache hits",
+				Unit:        "{hits}",
+				ObjectName:  "com.example:type=Cache,name=*",
+				Attribute:   "Hits",
+				Type:        "Counter",
+				ValueType:   "int",
+				Attributes:  map[string]string{"cache": "name", "area": "area"},
+			},
+			{
+				Name:       "queue.size",
+				ObjectName: `com.example:type=Queue,name="it's"`,
+				Attribute:  "Size",
+			},
+		},
+	}
+
+	expected := `def beans0 = otel.mbeans('com.example:type=Cache,name=*')
+otel.instrument(beans0, 'cache.hits', 'The number of cache hits', '{hits}', ['area': { mbean -> mbean.name().getKeyProperty('area') }, 'cache': { mbean -> mbean.name().getKeyProperty('name') }], 'Hits', otel.&longCounterCallback)
+def beans1 = otel.mbeans('com.example:type=Queue,name="it\'s"')
+otel.instrument(beans1, 'queue.size', '', '', [:], 'Size', otel.&doubleValueCallback)
+`
+	require.Equal(t, expected, cfg.buildGroovyScript())
+}
+
 func TestWithInvalidConfig(t *testing.T) {
 	f := NewFactory()
 	assert.Equal(t, metadata.Type, f.Type())
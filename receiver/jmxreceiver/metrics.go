@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jmxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jmxreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MetricMapping maps an attribute of the MBeans matching an object name pattern to a metric, reported
+// by the JMX Metric Gatherer with a groovy script generated by the receiver.
+type MetricMapping struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// ObjectName is the object name, or object name pattern, of the MBeans, e.g. "com.example:type=Cache,name=*".
+	ObjectName string `mapstructure:"object_name"`
+	// Attribute of the MBeans holding the value of the metric.
+	Attribute string `mapstructure:"attribute"`
+	// Type of the metric, one of "gauge" (the default), "counter" or "updowncounter".
+	Type string `mapstructure:"type"`
+	// ValueType of the metric, one of "double" (the default) or "int".
+	ValueType string `mapstructure:"value_type"`
+	// Attributes of the metric, set to the value of a key property of the object name of the MBeans, by attribute name.
+	Attributes map[string]string `mapstructure:"attributes"`
+}
+
+// The callbacks of the JMX Metric Gatherer creating each type of instrument, by type and value type.
+var metricMappingInstruments = map[string]map[string]string{
+	"gauge":         {"double": "doubleValueCallback", "int": "longValueCallback"},
+	"counter":       {"double": "doubleCounterCallback", "int": "longCounterCallback"},
+	"updowncounter": {"double": "doubleUpDownCounterCallback", "int": "longUpDownCounterCallback"},
+}
+
+func (m MetricMapping) instrument() string {
+	metricType, valueType := "gauge", "double"
+	if m.Type != "" {
+		metricType = strings.ToLower(m.Type)
+	}
+	if m.ValueType != "" {
+		valueType = strings.ToLower(m.ValueType)
+	}
+	return metricMappingInstruments[metricType][valueType]
+}
+
+func (m MetricMapping) validate() error {
+	var missingFields []string
+	if m.Name == "" {
+		missingFields = append(missingFields, "`name`")
+	}
+	if m.ObjectName == "" {
+		missingFields = append(missingFields, "`object_name`")
+	}
+	if m.Attribute == "" {
+		missingFields = append(missingFields, "`attribute`")
+	}
+	if missingFields != nil {
+		return fmt.Errorf("missing required field(s): %v", strings.Join(missingFields, ", "))
+	}
+	if m.Type != "" {
+		if _, ok := metricMappingInstruments[strings.ToLower(m.Type)]; !ok {
+			return errors.New("`type` must be one of 'counter', 'gauge', 'updowncounter'")
+		}
+	}
+	if m.ValueType != "" && m.instrument() == "" {
+		return errors.New("`value_type` must be one of 'double', 'int'")
+	}
+	for name, key := range m.Attributes {
+		if name == "" || key == "" {
+			return errors.New("`attributes` must map attribute names to object name key properties")
+		}
+	}
+	return nil
+}
+
+// buildGroovyScript creates the groovy script run by the JMX Metric Gatherer to report the metrics
+// of the metric mappings.
+func (c *Config) buildGroovyScript() string {
+	var sb strings.Builder
+	for i, m := range c.Metrics {
+		beans := fmt.Sprintf("beans%d", i)
+		fmt.Fprintf(&sb, "def %s = otel.mbeans(%s)\n", beans, groovyString(m.ObjectName))
+
+		// Sorted for testing and reproducibility
+		names := make([]string, 0, len(m.Attributes))
+		for name := range m.Attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		attributes := make([]string, 0, len(names))
+		for _, name := range names {
+			attributes = append(attributes, fmt.Sprintf("%s: { mbean -> mbean.name().getKeyProperty(%s) }",
+				groovyString(name), groovyString(m.Attributes[name])))
+		}
+		attributesMap := "[:]"
+		if len(attributes) > 0 {
+			attributesMap = "[" + strings.Join(attributes, ", ") + "]"
+		}
+
+		fmt.Fprintf(&sb, "otel.instrument(%s, %s, %s, %s, %s, %s, otel.&%s)\n", beans, groovyString(m.Name),
+			groovyString(m.Description), groovyString(m.Unit), attributesMap, groovyString(m.Attribute), m.instrument())
+	}
+	return sb.String()
+}
+
+// groovyString quotes a string as a groovy single-quoted string, which is not interpolated.
+func groovyString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "'", `\'`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return "'" + s + "'"
+}
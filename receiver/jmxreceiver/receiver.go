@@ -36,6 +36,7 @@ type jmxMetricReceiver struct {
 	otlpReceiver receiver.Metrics
 	nextConsumer consumer.Metrics
 	configFile   string
+	scriptFile   string
 	cancel       context.CancelFunc
 }
 
@@ -63,6 +64,12 @@ func (jmx *jmxMetricReceiver) Start(ctx context.Context, host component.Host) er
 		return err
 	}
 
+	if len(jmx.config.Metrics) > 0 {
+		if jmx.scriptFile, err = writeTempFile("jmx-metrics-*.groovy", jmx.config.buildGroovyScript()); err != nil {
+			return fmt.Errorf("failed to write groovy script for jmxreceiver metrics: %w", err)
+		}
+	}
+
 	javaConfig, err := jmx.buildJMXMetricGathererConfig()
 	if err != nil {
 		return err
@@ -129,6 +136,11 @@ func (jmx *jmxMetricReceiver) Shutdown(ctx context.Context) error {
 	}
 
 	removeErr := os.Remove(jmx.configFile)
+	if jmx.scriptFile != "" {
+		if err := os.Remove(jmx.scriptFile); err != nil && removeErr == nil {
+			removeErr = err
+		}
+	}
 	if subprocessErr != nil {
 		return subprocessErr
 	}
@@ -190,7 +202,11 @@ func (jmx *jmxMetricReceiver) buildJMXMetricGathererConfig() (string, error) {
 
 	config["otel.jmx.service.url"] = jmx.config.Endpoint
 	config["otel.jmx.interval.milliseconds"] = strconv.FormatInt(jmx.config.CollectionInterval.Milliseconds(), 10)
-	config["otel.jmx.target.system"] = jmx.config.TargetSystem
+	if jmx.scriptFile != "" {
+		config["otel.jmx.groovy.script"] = jmx.scriptFile
+	} else {
+		config["otel.jmx.target.system"] = jmx.config.TargetSystem
+	}
 
 	endpoint := jmx.config.OTLPExporterConfig.Endpoint
 	if !strings.HasPrefix(endpoint, "http") {
@@ -273,3 +289,21 @@ func (jmx *jmxMetricReceiver) buildJMXMetricGathererConfig() (string, error) {
 
 	return strings.Join(content, "\n"), nil
 }
+
+// writeTempFile writes content to a new temporary file, and returns its name.
+func writeTempFile(pattern string, content string) (string, error) {
+	tmpFile, err := os.CreateTemp(os.TempDir(), pattern)
+	if err != nil {
+		return "", err
+	}
+	if _, err = tmpFile.WriteString(content); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+	if err = tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return "", err
+	}
+	return tmpFile.Name(), nil
+}
@@ -97,6 +97,29 @@ otel.metrics.exporter = otlp
 otel.resource.attributes = abc=123,one=two`,
 			"",
 		},
+		{
+			"runs the groovy script of the metrics",
+			&Config{
+				Endpoint: "myhost:12345",
+				Metrics: []MetricMapping{
+					{Name: "queue.size", ObjectName: "com.example:type=Queue", Attribute: "Size"},
+				},
+				CollectionInterval: 123 * time.Second,
+				OTLPExporterConfig: otlpExporterConfig{
+					Endpoint: "myotlpendpoint",
+					TimeoutSettings: exporterhelper.TimeoutSettings{
+						Timeout: 234 * time.Second,
+					},
+				},
+			},
+			`otel.exporter.otlp.endpoint = http://myotlpendpoint
+otel.exporter.otlp.timeout = 234000
+otel.jmx.groovy.script = /tmp/jmx-metrics.groovy
+otel.jmx.interval.milliseconds = 123000
+otel.jmx.service.url = service:jmx:rmi:///jndi/rmi://myhost:12345/jmxrmi
+otel.metrics.exporter = otlp`,
+			"",
+		},
 		{
 			"errors on portless endpoint",
 			&Config{
@@ -148,6 +171,9 @@ otel.resource.attributes = abc=123,one=two`,
 		t.Run(test.name, func(*testing.T) {
 			params := receivertest.NewNopCreateSettings()
 			receiver := newJMXMetricReceiver(params, test.config, consumertest.NewNop())
+			if len(test.config.Metrics) > 0 {
+				receiver.scriptFile = "/tmp/jmx-metrics.groovy"
+			}
 			jmxConfig, err := receiver.buildJMXMetricGathererConfig()
 			if test.expectedError == "" {
 				require.NoError(t, err)
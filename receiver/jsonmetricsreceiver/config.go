@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jsonmetricsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+)
+
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	confighttp.ClientConfig        `mapstructure:",squash"`
+
+	// Metrics defines the metrics extracted from the JSON document served by the endpoint.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// MetricConfig maps the values selected by a JSONPath expression to a metric.
+type MetricConfig struct {
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// Path is the JSONPath expression selecting the values of the metric, one data point per value.
+	// When ValuePath is set, Path selects the elements the values and attributes are read from.
+	Path string `mapstructure:"path"`
+	// ValuePath is the JSONPath expression, relative to each element selected by Path, of the value.
+	ValuePath   string            `mapstructure:"value_path"`
+	DataType    MetricType        `mapstructure:"data_type"`
+	ValueType   MetricValueType   `mapstructure:"value_type"`
+	Monotonic   bool              `mapstructure:"monotonic"`
+	Aggregation MetricAggregation `mapstructure:"aggregation"`
+	Attributes  []AttributeConfig `mapstructure:"attributes"`
+}
+
+// AttributeConfig defines an attribute of the data points of a metric, read from the
+// element selected by the metric path or set to a static value.
+type AttributeConfig struct {
+	Name string `mapstructure:"name"`
+	// Path is the JSONPath expression, relative to each element selected by the metric path, of the value.
+	Path string `mapstructure:"path"`
+	// Value is a static value of the attribute.
+	Value string `mapstructure:"value"`
+}
+
+type MetricType string
+
+const (
+	MetricTypeUnspecified MetricType = ""
+	MetricTypeGauge       MetricType = "gauge"
+	MetricTypeSum         MetricType = "sum"
+)
+
+func (t MetricType) Validate() error {
+	switch t {
+	case MetricTypeUnspecified, MetricTypeGauge, MetricTypeSum:
+		return nil
+	}
+	return fmt.Errorf("unsupported data_type: '%s'", t)
+}
+
+type MetricValueType string
+
+const (
+	MetricValueTypeUnspecified MetricValueType = ""
+	MetricValueTypeInt         MetricValueType = "int"
+	MetricValueTypeDouble      MetricValueType = "double"
+)
+
+func (t MetricValueType) Validate() error {
+	switch t {
+	case MetricValueTypeUnspecified, MetricValueTypeInt, MetricValueTypeDouble:
+		return nil
+	}
+	return fmt.Errorf("unsupported value_type: '%s'", t)
+}
+
+type MetricAggregation string
+
+const (
+	MetricAggregationUnspecified MetricAggregation = ""
+	MetricAggregationCumulative  MetricAggregation = "cumulative"
+	MetricAggregationDelta       MetricAggregation = "delta"
+)
+
+func (a MetricAggregation) Validate() error {
+	switch a {
+	case MetricAggregationUnspecified, MetricAggregationCumulative, MetricAggregationDelta:
+		return nil
+	}
+	return fmt.Errorf("unsupported aggregation: '%s'", a)
+}
+
+func (c *Config) Validate() error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint is not a valid URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be 'http' or 'https', but was '%s'", u.Scheme)
+	}
+	if u.Host == "" {
+		return errors.New("host not found in HTTP endpoint")
+	}
+	if len(c.Metrics) == 0 {
+		return errors.New("at least one metric must be defined")
+	}
+
+	var errs []error
+	names := make(map[string]bool, len(c.Metrics))
+	for _, metric := range c.Metrics {
+		if names[metric.Name] {
+			errs = append(errs, fmt.Errorf("duplicate metric name '%s'", metric.Name))
+		}
+		names[metric.Name] = true
+		if err := metric.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c MetricConfig) Validate() error {
+	var errs []error
+	if c.Name == "" {
+		errs = append(errs, errors.New("'name' cannot be empty"))
+	}
+	if c.Path == "" {
+		errs = append(errs, errors.New("'path' cannot be empty"))
+	} else if _, err := compilePath(c.Path); err != nil {
+		errs = append(errs, fmt.Errorf("invalid 'path': %w", err))
+	}
+	if c.ValuePath != "" {
+		if _, err := compilePath(c.ValuePath); err != nil {
+			errs = append(errs, fmt.Errorf("invalid 'value_path': %w", err))
+		}
+	}
+	if err := c.DataType.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.ValueType.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Aggregation.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.DataType != MetricTypeSum && (c.Aggregation != "" || c.Monotonic) {
+		errs = append(errs, errors.New("'aggregation' and 'monotonic' are only supported by the sum data_type"))
+	}
+	for _, attribute := range c.Attributes {
+		if attribute.Name == "" {
+			errs = append(errs, errors.New("attribute 'name' cannot be empty"))
+		}
+		if (attribute.Path == "") == (attribute.Value == "") {
+			errs = append(errs, fmt.Errorf("exactly one of 'path' and 'value' must be set for attribute '%s'", attribute.Name))
+		} else if attribute.Path != "" {
+			if _, err := compilePath(attribute.Path); err != nil {
+				errs = append(errs, fmt.Errorf("invalid 'path' of attribute '%s': %w", attribute.Name, err))
+			}
+		}
+	}
+	if errs != nil && c.Name != "" {
+		errs = append(errs, fmt.Errorf("invalid metric config with name '%s'", c.Name))
+	}
+	return errors.Join(errs...)
+}
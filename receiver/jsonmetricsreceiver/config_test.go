@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonmetricsreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jsonmetricsreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	expected := createDefaultConfig().(*Config)
+	expected.Endpoint = "http://localhost:8080/status.json"
+	expected.CollectionInterval = 30 * time.Second
+	expected.Metrics = []MetricConfig{
+		{
+			Name:      "appliance.uptime",
+			Path:      "$.system.uptime",
+			Unit:      "s",
+			DataType:  MetricTypeSum,
+			ValueType: MetricValueTypeInt,
+			Monotonic: true,
+		},
+		{
+			Name:        "appliance.disk.usage",
+			Description: "Space used on each disk.",
+			Path:        "$.disks[*]",
+			ValuePath:   ".used_bytes",
+			Unit:        "By",
+			Attributes: []AttributeConfig{
+				{Name: "disk", Path: ".name"},
+				{Name: "appliance.model", Value: "x200"},
+			},
+		},
+	}
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr []string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: expected,
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_metrics"),
+			expectedErr: []string{"at least one metric must be defined"},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_endpoint"),
+			expectedErr: []string{"scheme must be 'http' or 'https', but was 'localhost'"},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_metric"),
+			expectedErr: []string{
+				"invalid 'path'",
+				"unsupported data_type: 'histogram'",
+				"exactly one of 'path' and 'value' must be set for attribute 'host'",
+				"invalid metric config with name 'appliance.uptime'",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			err = component.ValidateConfig(cfg)
+			if len(tt.expectedErr) > 0 {
+				require.Error(t, err)
+				for _, expectedErr := range tt.expectedErr {
+					assert.ErrorContains(t, err, expectedErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
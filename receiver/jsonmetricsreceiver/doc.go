@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package jsonmetricsreceiver scrapes HTTP endpoints serving JSON documents and
+// converts the values selected by JSONPath expressions into metrics.
+package jsonmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jsonmetricsreceiver"
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jsonmetricsreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jsonmetricsreceiver/internal/metadata"
+)
+
+const (
+	defaultEndpoint = "http://localhost:8080/status"
+	defaultTimeout  = 10 * time.Second
+)
+
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	rCfg component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := rCfg.(*Config)
+
+	js, err := newJSONScraper(cfg, set)
+	if err != nil {
+		return nil, err
+	}
+	scraper, err := scraperhelper.NewScraper(
+		metadata.Type.String(),
+		js.scrape,
+		scraperhelper.WithStart(js.start),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ControllerConfig,
+		set,
+		consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: defaultEndpoint,
+			Timeout:  defaultTimeout,
+		},
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonmetricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/jsonmetricsreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const scopeName = "otelcol/jsonmetricsreceiver"
+
+type compiledAttribute struct {
+	name  string
+	path  *jsonpath.JSONPath
+	value string
+}
+
+type compiledMetric struct {
+	cfg        MetricConfig
+	path       *jsonpath.JSONPath
+	valuePath  *jsonpath.JSONPath
+	attributes []compiledAttribute
+}
+
+type jsonScraper struct {
+	cfg       *Config
+	set       receiver.CreateSettings
+	client    *http.Client
+	metrics   []compiledMetric
+	startTime pcommon.Timestamp
+}
+
+func newJSONScraper(cfg *Config, set receiver.CreateSettings) (*jsonScraper, error) {
+	metrics := make([]compiledMetric, 0, len(cfg.Metrics))
+	for _, metricCfg := range cfg.Metrics {
+		metric := compiledMetric{cfg: metricCfg}
+		var err error
+		if metric.path, err = compilePath(metricCfg.Path); err != nil {
+			return nil, fmt.Errorf("invalid path of metric %q: %w", metricCfg.Name, err)
+		}
+		if metricCfg.ValuePath != "" {
+			if metric.valuePath, err = compilePath(metricCfg.ValuePath); err != nil {
+				return nil, fmt.Errorf("invalid value_path of metric %q: %w", metricCfg.Name, err)
+			}
+		}
+		for _, attributeCfg := range metricCfg.Attributes {
+			attribute := compiledAttribute{name: attributeCfg.Name, value: attributeCfg.Value}
+			if attributeCfg.Path != "" {
+				if attribute.path, err = compilePath(attributeCfg.Path); err != nil {
+					return nil, fmt.Errorf("invalid path of attribute %q of metric %q: %w", attributeCfg.Name, metricCfg.Name, err)
+				}
+			}
+			metric.attributes = append(metric.attributes, attribute)
+		}
+		metrics = append(metrics, metric)
+	}
+	return &jsonScraper{cfg: cfg, set: set, metrics: metrics}, nil
+}
+
+// compilePath parses a JSONPath expression. Both the `$.a.b` and the braced `{.a.b}` forms are accepted.
+func compilePath(path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New("").AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
+
+func (s *jsonScraper) start(ctx context.Context, host component.Host) error {
+	client, err := s.cfg.ClientConfig.ToClient(ctx, host, s.set.TelemetrySettings)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	s.startTime = pcommon.NewTimestampFromTime(time.Now())
+	return nil
+}
+
+func (s *jsonScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	emptyMetrics := pmetric.NewMetrics()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.Endpoint, nil)
+	if err != nil {
+		return emptyMetrics, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return emptyMetrics, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return emptyMetrics, fmt.Errorf("expected 200 but received %d status code", resp.StatusCode)
+	}
+
+	// Numbers are kept as json.Number so that integers are not rounded to float64.
+	var document any
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	if err = decoder.Decode(&document); err != nil {
+		return emptyMetrics, fmt.Errorf("could not decode response body to JSON: %w", err)
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	md := pmetric.NewMetrics()
+	sm := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	sm.Scope().SetVersion(s.set.BuildInfo.Version)
+
+	var errs scrapererror.ScrapeErrors
+	for _, metric := range s.metrics {
+		failed, err := s.appendMetric(sm.Metrics(), metric, document, now)
+		if err != nil {
+			errs.AddPartial(failed, err)
+		}
+	}
+	return md, errs.Combine()
+}
+
+// appendMetric adds a metric with a data point per value selected in the document. It returns the
+// number of values which could not be converted to a data point.
+func (s *jsonScraper) appendMetric(metrics pmetric.MetricSlice, metric compiledMetric, document any, now pcommon.Timestamp) (int, error) {
+	elements, err := findValues(metric.path, document)
+	if err != nil {
+		return 1, fmt.Errorf("failed to evaluate the path of metric %q: %w", metric.cfg.Name, err)
+	}
+
+	m := pmetric.NewMetric()
+	m.SetName(metric.cfg.Name)
+	m.SetDescription(metric.cfg.Description)
+	m.SetUnit(metric.cfg.Unit)
+	var dps pmetric.NumberDataPointSlice
+	if metric.cfg.DataType == MetricTypeSum {
+		sum := m.SetEmptySum()
+		sum.SetIsMonotonic(metric.cfg.Monotonic)
+		if metric.cfg.Aggregation == MetricAggregationDelta {
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		} else {
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		}
+		dps = sum.DataPoints()
+	} else {
+		dps = m.SetEmptyGauge().DataPoints()
+	}
+
+	var failed int
+	var errs error
+	for _, element := range elements {
+		value := element
+		if metric.valuePath != nil {
+			values, err := findValues(metric.valuePath, element)
+			if err != nil {
+				failed++
+				errs = errors.Join(errs, fmt.Errorf("failed to evaluate the value_path of metric %q: %w", metric.cfg.Name, err))
+				continue
+			}
+			// Elements without the value are skipped, arrays and maps are often heterogeneous.
+			if len(values) == 0 {
+				continue
+			}
+			value = values[0]
+		}
+
+		dp := pmetric.NewNumberDataPoint()
+		if err := setValue(dp, value, metric.cfg.ValueType); err != nil {
+			failed++
+			errs = errors.Join(errs, fmt.Errorf("invalid value for metric %q: %w", metric.cfg.Name, err))
+			continue
+		}
+		dp.SetTimestamp(now)
+		if metric.cfg.DataType == MetricTypeSum && metric.cfg.Aggregation != MetricAggregationDelta {
+			dp.SetStartTimestamp(s.startTime)
+		}
+		for _, attribute := range metric.attributes {
+			if attribute.path == nil {
+				dp.Attributes().PutStr(attribute.name, attribute.value)
+				continue
+			}
+			if values, err := findValues(attribute.path, element); err == nil && len(values) > 0 {
+				dp.Attributes().PutStr(attribute.name, toString(values[0]))
+			}
+		}
+		dp.MoveTo(dps.AppendEmpty())
+	}
+
+	if dps.Len() > 0 {
+		m.MoveTo(metrics.AppendEmpty())
+	}
+	return failed, errs
+}
+
+// findValues returns all the values selected by the path in data.
+func findValues(path *jsonpath.JSONPath, data any) ([]any, error) {
+	results, err := path.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+	var values []any
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() {
+				values = append(values, value.Interface())
+			}
+		}
+	}
+	return values, nil
+}
+
+func setValue(dp pmetric.NumberDataPoint, value any, valueType MetricValueType) error {
+	var number json.Number
+	switch v := value.(type) {
+	case json.Number:
+		number = v
+	case string:
+		number = json.Number(strings.TrimSpace(v))
+	case bool:
+		number = "0"
+		if v {
+			number = "1"
+		}
+	default:
+		return fmt.Errorf("%v is not a number", value)
+	}
+
+	if valueType == MetricValueTypeInt {
+		i, err := number.Int64()
+		if err != nil {
+			// Accept integral values written with a fraction or an exponent, e.g. `1e3`.
+			f, ferr := number.Float64()
+			if ferr != nil || f != float64(int64(f)) {
+				return fmt.Errorf("%q is not an integer", number)
+			}
+			i = int64(f)
+		}
+		dp.SetIntValue(i)
+		return nil
+	}
+	f, err := number.Float64()
+	if err != nil {
+		return fmt.Errorf("%q is not a number", number)
+	}
+	dp.SetDoubleValue(f)
+	return nil
+}
+
+func toString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(b)
+}
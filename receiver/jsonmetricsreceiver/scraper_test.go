@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonmetricsreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+func newMockServer(t *testing.T, responseCode int, responseFile string) *httptest.Server {
+	body, err := os.ReadFile(filepath.Join("testdata", responseFile))
+	require.NoError(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/status.json", req.URL.Path)
+		rw.WriteHeader(responseCode)
+		_, err := rw.Write(body)
+		assert.NoError(t, err)
+	}))
+}
+
+func newTestScraper(t *testing.T, endpoint string, metrics []MetricConfig) *jsonScraper {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint + "/status.json"
+	cfg.Metrics = metrics
+	require.NoError(t, cfg.Validate())
+
+	scraper, err := newJSONScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScrape(t *testing.T) {
+	server := newMockServer(t, http.StatusOK, "response.json")
+	defer server.Close()
+
+	scraper := newTestScraper(t, server.URL, []MetricConfig{
+		{Name: "appliance.uptime", Path: "$.system.uptime", Unit: "s", DataType: MetricTypeSum, ValueType: MetricValueTypeInt, Monotonic: true},
+		{Name: "appliance.load", Path: "$.system.load"},
+		{Name: "appliance.healthy", Path: "$.system.healthy", ValueType: MetricValueTypeInt},
+		{
+			Name:      "appliance.disk.usage",
+			Path:      "$.disks[*]",
+			ValuePath: ".used_bytes",
+			Unit:      "By",
+			DataType:  MetricTypeSum,
+			ValueType: MetricValueTypeInt,
+			Attributes: []AttributeConfig{
+				{Name: "disk", Path: ".name"},
+				{Name: "appliance.model", Value: "x200"},
+			},
+		},
+		{
+			Name:       "appliance.disk.temperature",
+			Path:       "$.disks[*]",
+			ValuePath:  ".temperature.celsius",
+			Unit:       "Cel",
+			Attributes: []AttributeConfig{{Name: "disk", Path: ".name"}},
+		},
+		{Name: "appliance.missing", Path: "$.system.missing"},
+	})
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.ErrorContains(t, err, `invalid value for metric "appliance.disk.usage": "unknown" is not an integer`)
+
+	expectedFile := filepath.Join("testdata", "expected_metrics.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+}
+
+func TestScrapeErrors(t *testing.T) {
+	metrics := []MetricConfig{{Name: "appliance.uptime", Path: "$.system.uptime"}}
+
+	server := newMockServer(t, http.StatusInternalServerError, "response.json")
+	defer server.Close()
+	_, err := newTestScraper(t, server.URL, metrics).scrape(context.Background())
+	assert.EqualError(t, err, "expected 200 but received 500 status code")
+
+	invalidServer := newMockServer(t, http.StatusOK, "config.yaml")
+	defer invalidServer.Close()
+	_, err = newTestScraper(t, invalidServer.URL, metrics).scrape(context.Background())
+	assert.ErrorContains(t, err, "could not decode response body to JSON")
+}
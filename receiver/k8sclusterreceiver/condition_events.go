@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sclusterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	conditionEventDomain = "k8s"
+
+	attributeEventDomain             = "event.domain"
+	attributeEventName               = "event.name"
+	attributeConditionType           = "k8s.condition.type"
+	attributeConditionStatus         = "k8s.condition.status"
+	attributeConditionPreviousStatus = "k8s.condition.previous_status"
+	attributeConditionReason         = "k8s.condition.reason"
+	attributeConditionOriginalReason = "k8s.condition.original_reason"
+)
+
+// condition is the part of the node and pod conditions reported by the condition events.
+type condition struct {
+	conditionType      string
+	status             corev1.ConditionStatus
+	reason             string
+	message            string
+	lastTransitionTime metav1.Time
+}
+
+// normalizedReasons are the reasons reported for the transitions of the well known conditions,
+// by kind and condition type. They match the reasons of the events recorded by the kubelet and the
+// scheduler where there is one, e.g. NodeNotReady, so that the transitions are reported the same
+// way regardless of the component that updated the condition.
+var normalizedReasons = map[string]map[string]map[corev1.ConditionStatus]string{
+	"Node": {
+		string(corev1.NodeReady): {
+			corev1.ConditionTrue:    "NodeReady",
+			corev1.ConditionFalse:   "NodeNotReady",
+			corev1.ConditionUnknown: "NodeStatusUnknown",
+		},
+		string(corev1.NodeMemoryPressure): {
+			corev1.ConditionTrue:  "NodeHasInsufficientMemory",
+			corev1.ConditionFalse: "NodeHasSufficientMemory",
+		},
+		string(corev1.NodeDiskPressure): {
+			corev1.ConditionTrue:  "NodeHasDiskPressure",
+			corev1.ConditionFalse: "NodeHasNoDiskPressure",
+		},
+		string(corev1.NodePIDPressure): {
+			corev1.ConditionTrue:  "NodeHasInsufficientPID",
+			corev1.ConditionFalse: "NodeHasSufficientPID",
+		},
+		string(corev1.NodeNetworkUnavailable): {
+			corev1.ConditionTrue:  "NodeNetworkUnavailable",
+			corev1.ConditionFalse: "NodeNetworkAvailable",
+		},
+	},
+	"Pod": {
+		string(corev1.PodScheduled): {
+			corev1.ConditionTrue:  "PodScheduled",
+			corev1.ConditionFalse: "PodUnschedulable",
+		},
+		string(corev1.PodInitialized): {
+			corev1.ConditionTrue:  "PodInitialized",
+			corev1.ConditionFalse: "PodNotInitialized",
+		},
+		string(corev1.ContainersReady): {
+			corev1.ConditionTrue:  "PodContainersReady",
+			corev1.ConditionFalse: "PodContainersNotReady",
+		},
+		string(corev1.PodReady): {
+			corev1.ConditionTrue:  "PodReady",
+			corev1.ConditionFalse: "PodNotReady",
+		},
+	},
+}
+
+// healthyWhenTrue are the condition types whose True status is the healthy one. The other
+// conditions, e.g. MemoryPressure, report a problem when they are True. The Ready type is shared
+// by nodes and pods.
+var healthyWhenTrue = map[string]bool{
+	string(corev1.NodeReady):       true,
+	string(corev1.PodScheduled):    true,
+	string(corev1.PodInitialized):  true,
+	string(corev1.ContainersReady): true,
+	"PodReadyToStartContainers":    true,
+}
+
+// normalizeReason returns the reason reported for the transition of a condition to the given status.
+// The conditions that are not well known get a reason made of the kind, the condition type and
+// the status, e.g. NodeKernelDeadlockTrue.
+func normalizeReason(kind, conditionType string, status corev1.ConditionStatus) string {
+	if reason, ok := normalizedReasons[kind][conditionType][status]; ok {
+		return reason
+	}
+	return kind + conditionType + string(status)
+}
+
+// conditionTransitionsToLogs returns a log record for each condition of the updated node or pod
+// whose status changed. Conditions that appeared or disappeared aren't reported.
+func conditionTransitionsToLogs(oldObj, newObj any, now time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	rl := logs.ResourceLogs().AppendEmpty()
+	resource := rl.Resource().Attributes()
+
+	var kind, eventName string
+	var oldConditions, newConditions []condition
+	switch o := newObj.(type) {
+	case *corev1.Node:
+		old, ok := oldObj.(*corev1.Node)
+		if !ok {
+			return plog.NewLogs()
+		}
+		kind, eventName = "Node", "k8s.node.condition_transition"
+		oldConditions, newConditions = nodeConditions(old), nodeConditions(o)
+		resource.PutStr(conventions.AttributeK8SNodeName, o.Name)
+		resource.PutStr(conventions.AttributeK8SNodeUID, string(o.UID))
+	case *corev1.Pod:
+		old, ok := oldObj.(*corev1.Pod)
+		if !ok {
+			return plog.NewLogs()
+		}
+		kind, eventName = "Pod", "k8s.pod.condition_transition"
+		oldConditions, newConditions = podConditions(old), podConditions(o)
+		resource.PutStr(conventions.AttributeK8SNamespaceName, o.Namespace)
+		resource.PutStr(conventions.AttributeK8SPodName, o.Name)
+		resource.PutStr(conventions.AttributeK8SPodUID, string(o.UID))
+		if o.Spec.NodeName != "" {
+			resource.PutStr(conventions.AttributeK8SNodeName, o.Spec.NodeName)
+		}
+	default:
+		return plog.NewLogs()
+	}
+
+	previous := make(map[string]corev1.ConditionStatus, len(oldConditions))
+	for _, c := range oldConditions {
+		previous[c.conditionType] = c.status
+	}
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("otelcol/k8sclusterreceiver")
+	for _, c := range newConditions {
+		previousStatus, ok := previous[c.conditionType]
+		if !ok || previousStatus == c.status {
+			continue
+		}
+
+		reason := normalizeReason(kind, c.conditionType, c.status)
+		lr := sl.LogRecords().AppendEmpty()
+		timestamp := now
+		if !c.lastTransitionTime.IsZero() {
+			timestamp = c.lastTransitionTime.Time
+		}
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+
+		severity := plog.SeverityNumberInfo
+		if (c.status == corev1.ConditionTrue) != healthyWhenTrue[c.conditionType] {
+			severity = plog.SeverityNumberWarn
+		}
+		lr.SetSeverityNumber(severity)
+		lr.SetSeverityText(severity.String())
+
+		if c.message != "" {
+			lr.Body().SetStr(c.message)
+		} else {
+			lr.Body().SetStr(reason)
+		}
+
+		attrs := lr.Attributes()
+		attrs.PutStr(attributeEventDomain, conditionEventDomain)
+		attrs.PutStr(attributeEventName, eventName)
+		attrs.PutStr(attributeConditionType, c.conditionType)
+		attrs.PutStr(attributeConditionStatus, string(c.status))
+		attrs.PutStr(attributeConditionPreviousStatus, string(previousStatus))
+		attrs.PutStr(attributeConditionReason, reason)
+		if c.reason != "" {
+			attrs.PutStr(attributeConditionOriginalReason, c.reason)
+		}
+	}
+
+	if sl.LogRecords().Len() == 0 {
+		return plog.NewLogs()
+	}
+	return logs
+}
+
+func nodeConditions(node *corev1.Node) []condition {
+	conditions := make([]condition, 0, len(node.Status.Conditions))
+	for _, c := range node.Status.Conditions {
+		conditions = append(conditions, condition{
+			conditionType:      string(c.Type),
+			status:             c.Status,
+			reason:             c.Reason,
+			message:            c.Message,
+			lastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
+
+func podConditions(pod *corev1.Pod) []condition {
+	conditions := make([]condition, 0, len(pod.Status.Conditions))
+	for _, c := range pod.Status.Conditions {
+		conditions = append(conditions, condition{
+			conditionType:      string(c.Type),
+			status:             c.Status,
+			reason:             c.Reason,
+			message:            c.Message,
+			lastTransitionTime: c.LastTransitionTime,
+		})
+	}
+	return conditions
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sclusterreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func newConditionNode(conditions ...corev1.NodeCondition) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", UID: "node-1-uid"},
+		Status:     corev1.NodeStatus{Conditions: conditions},
+	}
+}
+
+func newConditionPod(conditions ...corev1.PodCondition) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "default", UID: "pod-1-uid"},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status:     corev1.PodStatus{Conditions: conditions},
+	}
+}
+
+func TestConditionTransitionsToLogsNode(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 30, 0, time.UTC)
+	transition := metav1.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	oldNode := newConditionNode(
+		corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue, Reason: "KubeletReady"},
+		corev1.NodeCondition{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse, Reason: "KubeletHasSufficientMemory"},
+		corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse},
+		corev1.NodeCondition{Type: "KernelDeadlock", Status: corev1.ConditionFalse},
+	)
+	newNode := newConditionNode(
+		corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Reason: "NodeStatusUnknown", Message: "Kubelet stopped posting node status.", LastTransitionTime: transition},
+		corev1.NodeCondition{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue, Reason: "KubeletHasInsufficientMemory", LastTransitionTime: transition},
+		corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionFalse},
+		corev1.NodeCondition{Type: "KernelDeadlock", Status: corev1.ConditionTrue},
+		// Conditions that appeared aren't transitions.
+		corev1.NodeCondition{Type: corev1.NodePIDPressure, Status: corev1.ConditionTrue},
+	)
+
+	logs := conditionTransitionsToLogs(oldNode, newNode, now)
+	require.Equal(t, 3, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{
+		"k8s.node.name": "node-1",
+		"k8s.node.uid":  "node-1-uid",
+	}, rl.Resource().Attributes().AsRaw())
+	assert.Equal(t, "otelcol/k8sclusterreceiver", rl.ScopeLogs().At(0).Scope().Name())
+	records := rl.ScopeLogs().At(0).LogRecords()
+
+	notReady := records.At(0)
+	assert.Equal(t, transition.Time, notReady.Timestamp().AsTime())
+	assert.Equal(t, now, notReady.ObservedTimestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberWarn, notReady.SeverityNumber())
+	assert.Equal(t, "Kubelet stopped posting node status.", notReady.Body().Str())
+	assert.Equal(t, map[string]any{
+		"event.domain":                  "k8s",
+		"event.name":                    "k8s.node.condition_transition",
+		"k8s.condition.type":            "Ready",
+		"k8s.condition.status":          "Unknown",
+		"k8s.condition.previous_status": "True",
+		"k8s.condition.reason":          "NodeStatusUnknown",
+		"k8s.condition.original_reason": "NodeStatusUnknown",
+	}, notReady.Attributes().AsRaw())
+
+	memoryPressure := records.At(1)
+	assert.Equal(t, plog.SeverityNumberWarn, memoryPressure.SeverityNumber())
+	assert.Equal(t, "NodeHasInsufficientMemory", memoryPressure.Body().Str())
+	reason, _ := memoryPressure.Attributes().Get("k8s.condition.reason")
+	assert.Equal(t, "NodeHasInsufficientMemory", reason.Str())
+	originalReason, _ := memoryPressure.Attributes().Get("k8s.condition.original_reason")
+	assert.Equal(t, "KubeletHasInsufficientMemory", originalReason.Str())
+
+	kernelDeadlock := records.At(2)
+	assert.Equal(t, now, kernelDeadlock.Timestamp().AsTime())
+	assert.Equal(t, plog.SeverityNumberWarn, kernelDeadlock.SeverityNumber())
+	reason, _ = kernelDeadlock.Attributes().Get("k8s.condition.reason")
+	assert.Equal(t, "NodeKernelDeadlockTrue", reason.Str())
+	_, ok := kernelDeadlock.Attributes().Get("k8s.condition.original_reason")
+	assert.False(t, ok)
+}
+
+func TestConditionTransitionsToLogsPod(t *testing.T) {
+	now := time.Now()
+	oldPod := newConditionPod(
+		corev1.PodCondition{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available: 3 Insufficient cpu."},
+		corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+	)
+	newPod := newConditionPod(
+		corev1.PodCondition{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+		corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+	)
+
+	logs := conditionTransitionsToLogs(oldPod, newPod, now)
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	rl := logs.ResourceLogs().At(0)
+	assert.Equal(t, map[string]any{
+		"k8s.namespace.name": "default",
+		"k8s.pod.name":       "pod-1",
+		"k8s.pod.uid":        "pod-1-uid",
+		"k8s.node.name":      "node-1",
+	}, rl.Resource().Attributes().AsRaw())
+
+	scheduled := rl.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberInfo, scheduled.SeverityNumber())
+	assert.Equal(t, map[string]any{
+		"event.domain":                  "k8s",
+		"event.name":                    "k8s.pod.condition_transition",
+		"k8s.condition.type":            "PodScheduled",
+		"k8s.condition.status":          "True",
+		"k8s.condition.previous_status": "False",
+		"k8s.condition.reason":          "PodScheduled",
+	}, scheduled.Attributes().AsRaw())
+
+	// The reverse transition is reported with the normalized reason of the unschedulable pods.
+	logs = conditionTransitionsToLogs(newPod, oldPod, now)
+	require.Equal(t, 1, logs.LogRecordCount())
+	unschedulable := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberWarn, unschedulable.SeverityNumber())
+	assert.Equal(t, "0/3 nodes are available: 3 Insufficient cpu.", unschedulable.Body().Str())
+	reason, _ := unschedulable.Attributes().Get("k8s.condition.reason")
+	assert.Equal(t, "PodUnschedulable", reason.Str())
+}
+
+func TestConditionTransitionsToLogsNoTransition(t *testing.T) {
+	pod := newConditionPod(corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue})
+	assert.Equal(t, 0, conditionTransitionsToLogs(pod, pod, time.Now()).ResourceLogs().Len())
+
+	node := newConditionNode(corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue})
+	assert.Equal(t, 0, conditionTransitionsToLogs(node, node, time.Now()).ResourceLogs().Len())
+
+	assert.Equal(t, 0, conditionTransitionsToLogs(&corev1.Service{}, &corev1.Service{}, time.Now()).ResourceLogs().Len())
+}
+
+func TestOnUpdateEmitsConditionEvents(t *testing.T) {
+	oldNode := newConditionNode(corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionTrue})
+	newNode := newConditionNode(corev1.NodeCondition{Type: corev1.NodeReady, Status: corev1.ConditionFalse})
+
+	for _, enabled := range []bool{false, true} {
+		logsConsumer := new(consumertest.LogsSink)
+		rw := newResourceWatcher(receivertest.NewNopCreateSettings(), &Config{ConditionEvents: enabled}, metadata.NewStore())
+		rw.entityLogConsumer = logsConsumer
+		rw.initialSyncDone.Store(true)
+
+		rw.onUpdate(oldNode, newNode)
+
+		var conditionEvents int
+		for _, logs := range logsConsumer.AllLogs() {
+			for i := 0; i < logs.ResourceLogs().Len(); i++ {
+				records := logs.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords()
+				for j := 0; j < records.Len(); j++ {
+					if name, ok := records.At(j).Attributes().Get("event.name"); ok && name.Str() == "k8s.node.condition_transition" {
+						conditionEvents++
+					}
+				}
+			}
+		}
+		if enabled {
+			assert.Equal(t, 1, conditionEvents)
+		} else {
+			assert.Equal(t, 0, conditionEvents)
+		}
+	}
+}
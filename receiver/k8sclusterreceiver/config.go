@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -37,6 +40,13 @@ type Config struct {
 	// metadata collection on changes).
 	MetadataCollectionInterval time.Duration `mapstructure:"metadata_collection_interval"`
 
+	// Whether the transitions of the node and pod conditions should be emitted as log records in logs
+	// pipelines, in addition to the entity events.
+	ConditionEvents bool `mapstructure:"condition_events"`
+
+	// CustomResources to watch, with the fields of their objects to report as metrics.
+	CustomResources []customresource.Config `mapstructure:"custom_resources"`
+
 	// MetricsBuilderConfig allows customizing scraped metrics/attributes representation.
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
 }
@@ -48,5 +58,16 @@ func (cfg *Config) Validate() error {
 	default:
 		return fmt.Errorf("\"%s\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", cfg.Distribution)
 	}
+
+	customResources := make(map[schema.GroupVersionKind]bool, len(cfg.CustomResources))
+	for _, cr := range cfg.CustomResources {
+		if err := cr.Validate(); err != nil {
+			return err
+		}
+		if customResources[cr.GroupVersionKind()] {
+			return fmt.Errorf("custom resource %q is specified more than once", cr.GroupVersionKind())
+		}
+		customResources[cr.GroupVersionKind()] = true
+	}
 	return nil
 }
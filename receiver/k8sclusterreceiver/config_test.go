@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -44,7 +45,23 @@ func TestLoadConfig(t *testing.T) {
 					AuthType: k8sconfig.AuthTypeServiceAccount,
 				},
 				MetadataCollectionInterval: 30 * time.Minute,
-				MetricsBuilderConfig:       metadata.DefaultMetricsBuilderConfig(),
+				ConditionEvents:            true,
+				CustomResources: []customresource.Config{
+					{
+						Group:   "cert-manager.io",
+						Version: "v1",
+						Kind:    "Certificate",
+						Metrics: []customresource.MetricConfig{
+							{
+								Name:        "certmanager.certificate.expiration",
+								Description: "Time at which the certificate expires",
+								Unit:        "s",
+								Path:        "{.status.notAfter}",
+							},
+						},
+					},
+				},
+				MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 			},
 		},
 		{
@@ -96,4 +113,20 @@ func TestInvalidConfig(t *testing.T) {
 	err = component.ValidateConfig(cfg)
 	assert.Error(t, err)
 	assert.Equal(t, "\"wrong\" is not a supported distribution. Must be one of: \"openshift\", \"kubernetes\"", err.Error())
+
+	// Duplicate custom resource
+	customResource := customresource.Config{
+		Version: "v1",
+		Kind:    "Widget",
+		Metrics: []customresource.MetricConfig{{Name: "widget.size", Path: "{.status.size}"}},
+	}
+	cfg = &Config{
+		APIConfig:          k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeNone},
+		Distribution:       distributionKubernetes,
+		CollectionInterval: 30 * time.Second,
+		CustomResources:    []customresource.Config{customResource, customResource},
+	}
+	err = component.ValidateConfig(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, "custom resource \"/v1, Kind=Widget\" is specified more than once", err.Error())
 }
@@ -0,0 +1,225 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.18.0"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// Config defines a custom resource to watch and the metrics to report for each of its objects.
+type Config struct {
+	// Group is the API group of the custom resource, e.g. "argoproj.io".
+	Group string `mapstructure:"group"`
+	// Version is the API version of the custom resource, e.g. "v1alpha1".
+	Version string `mapstructure:"version"`
+	// Kind is the kind of the custom resource, e.g. "Rollout".
+	Kind string `mapstructure:"kind"`
+	// Metrics is the list of metrics reported for every object of the custom resource.
+	Metrics []MetricConfig `mapstructure:"metrics"`
+}
+
+// MetricConfig maps a field of a custom resource object to a gauge metric.
+type MetricConfig struct {
+	// Name of the metric.
+	Name string `mapstructure:"name"`
+	// Description of the metric.
+	Description string `mapstructure:"description"`
+	// Unit of the metric.
+	Unit string `mapstructure:"unit"`
+	// Path is the JSONPath expression selecting the value of the metric, e.g. "{.status.replicas}".
+	// Numbers are reported as is, booleans as 1 or 0 and condition statuses ("True", "False", "Unknown")
+	// as 1, 0 or -1. RFC 3339 timestamps are reported as seconds since the epoch.
+	Path string `mapstructure:"path"`
+}
+
+// GroupVersionKind returns the GroupVersionKind of the custom resource.
+func (cfg Config) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: cfg.Group, Version: cfg.Version, Kind: cfg.Kind}
+}
+
+// Validate checks that the custom resource is fully qualified and that its metrics are valid.
+func (cfg Config) Validate() error {
+	if cfg.Version == "" || cfg.Kind == "" {
+		return errors.New("custom resource version and kind must be specified")
+	}
+	gvk := cfg.GroupVersionKind()
+	if len(cfg.Metrics) == 0 {
+		return fmt.Errorf("custom resource %q must specify at least one metric", gvk)
+	}
+	names := make(map[string]bool, len(cfg.Metrics))
+	for _, m := range cfg.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("custom resource %q: metric name must be specified", gvk)
+		}
+		if names[m.Name] {
+			return fmt.Errorf("custom resource %q: duplicate metric %q", gvk, m.Name)
+		}
+		names[m.Name] = true
+		if _, err := parsePath(m.Name, m.Path); err != nil {
+			return fmt.Errorf("custom resource %q: metric %q: %w", gvk, m.Name, err)
+		}
+	}
+	return nil
+}
+
+func parsePath(name, path string) (*jsonpath.JSONPath, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, errors.New("path must be specified")
+	}
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New(name).AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	return jp, nil
+}
+
+type metric struct {
+	MetricConfig
+	path *jsonpath.JSONPath
+}
+
+// Recorder reports the configured metrics of the objects of a custom resource.
+type Recorder struct {
+	gvk     schema.GroupVersionKind
+	metrics []metric
+	// attrPrefix is the prefix of the name and uid resource attributes, e.g. "k8s.rollout".
+	attrPrefix string
+}
+
+// NewRecorder compiles the metric paths of a custom resource configuration.
+func NewRecorder(cfg Config) (*Recorder, error) {
+	r := &Recorder{
+		gvk:        cfg.GroupVersionKind(),
+		attrPrefix: "k8s." + strcase.ToSnake(cfg.Kind),
+	}
+	for _, m := range cfg.Metrics {
+		jp, err := parsePath(m.Name, m.Path)
+		if err != nil {
+			return nil, fmt.Errorf("custom resource %q: metric %q: %w", r.gvk, m.Name, err)
+		}
+		r.metrics = append(r.metrics, metric{MetricConfig: m, path: jp})
+	}
+	return r, nil
+}
+
+// GroupVersionKind returns the GroupVersionKind of the custom resource the Recorder reports metrics for.
+func (r *Recorder) GroupVersionKind() schema.GroupVersionKind {
+	return r.gvk
+}
+
+// CustomMetrics returns the metrics of a custom resource object. The resource metrics are empty if none
+// of the configured paths resolve to a value.
+func (r *Recorder) CustomMetrics(set receiver.CreateSettings, rb *metadata.ResourceBuilder, obj *unstructured.Unstructured,
+	ts pcommon.Timestamp) pmetric.ResourceMetrics {
+	rm := pmetric.NewResourceMetrics()
+	sm := rm.ScopeMetrics().AppendEmpty()
+	for _, m := range r.metrics {
+		results, err := m.path.FindResults(obj.UnstructuredContent())
+		if err != nil {
+			set.Logger.Debug("failed to evaluate custom resource metric path", zap.String("metric", m.Name),
+				zap.String("object", obj.GetName()), zap.Error(err))
+			continue
+		}
+		if len(results) == 0 || len(results[0]) == 0 {
+			continue
+		}
+		value := results[0][0]
+		dp := pmetric.NewNumberDataPoint()
+		if !setValue(dp, value) {
+			set.Logger.Debug("custom resource metric value is not numeric", zap.String("metric", m.Name),
+				zap.String("object", obj.GetName()), zap.Any("value", value.Interface()))
+			continue
+		}
+		dp.SetTimestamp(ts)
+
+		gm := sm.Metrics().AppendEmpty()
+		gm.SetName(m.Name)
+		gm.SetDescription(m.Description)
+		gm.SetUnit(m.Unit)
+		dp.MoveTo(gm.SetEmptyGauge().DataPoints().AppendEmpty())
+	}
+
+	if sm.Metrics().Len() == 0 {
+		return pmetric.NewResourceMetrics()
+	}
+
+	rm.SetSchemaUrl(conventions.SchemaURL)
+	sm.Scope().SetName("otelcol/k8sclusterreceiver")
+	sm.Scope().SetVersion(set.BuildInfo.Version)
+
+	if obj.GetNamespace() != "" {
+		rb.SetK8sNamespaceName(obj.GetNamespace())
+	}
+	rb.Emit().MoveTo(rm.Resource())
+	rm.Resource().Attributes().PutStr(r.attrPrefix+".name", obj.GetName())
+	rm.Resource().Attributes().PutStr(r.attrPrefix+".uid", string(obj.GetUID()))
+	return rm
+}
+
+var conditionValues = map[string]int64{
+	"true":    1,
+	"false":   0,
+	"unknown": -1,
+}
+
+// setValue sets the value of the datapoint from a JSONPath result, returning false if it isn't numeric.
+func setValue(dp pmetric.NumberDataPoint, value reflect.Value) bool {
+	for value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return false
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		dp.SetIntValue(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		dp.SetIntValue(int64(value.Uint()))
+	case reflect.Float32, reflect.Float64:
+		dp.SetDoubleValue(value.Float())
+	case reflect.Bool:
+		if value.Bool() {
+			dp.SetIntValue(1)
+		} else {
+			dp.SetIntValue(0)
+		}
+	case reflect.String:
+		s := value.String()
+		if v, ok := conditionValues[strings.ToLower(s)]; ok {
+			dp.SetIntValue(v)
+		} else if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			dp.SetIntValue(i)
+		} else if f, err := strconv.ParseFloat(s, 64); err == nil {
+			dp.SetDoubleValue(f)
+		} else if t, err := time.Parse(time.RFC3339, s); err == nil {
+			dp.SetIntValue(t.Unix())
+		} else {
+			return false
+		}
+	default:
+		return false
+	}
+	return true
+}
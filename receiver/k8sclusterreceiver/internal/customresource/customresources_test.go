@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package customresource
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+func newRollout() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]any{
+			"name":      "checkout",
+			"namespace": "shop",
+			"uid":       "rollout-uid",
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"paused":   true,
+		},
+		"status": map[string]any{
+			"availableReplicas": int64(2),
+			"canaryWeight":      "12.5",
+			"phase":             "Progressing",
+			"conditions": []any{
+				map[string]any{"type": "Available", "status": "False"},
+				map[string]any{"type": "Healthy", "status": "True"},
+			},
+			"restartedAt": "2024-05-01T10:00:00Z",
+		},
+	}}
+}
+
+func newRolloutConfig() Config {
+	return Config{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "Rollout",
+		Metrics: []MetricConfig{
+			{Name: "argo.rollout.desired", Description: "Desired replicas", Unit: "{replica}", Path: "{.spec.replicas}"},
+			{Name: "argo.rollout.available", Unit: "{replica}", Path: ".status.availableReplicas"},
+			{Name: "argo.rollout.paused", Path: "{.spec.paused}"},
+			{Name: "argo.rollout.healthy", Path: `{.status.conditions[?(@.type=="Healthy")].status}`},
+			{Name: "argo.rollout.canary_weight", Unit: "%", Path: "{.status.canaryWeight}"},
+			{Name: "argo.rollout.restarted_at", Unit: "s", Path: "{.status.restartedAt}"},
+			{Name: "argo.rollout.phase", Path: "{.status.phase}"},
+			{Name: "argo.rollout.missing", Path: "{.status.missing}"},
+		},
+	}
+}
+
+func TestCustomMetrics(t *testing.T) {
+	r, err := NewRecorder(newRolloutConfig())
+	require.NoError(t, err)
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	rm := r.CustomMetrics(receivertest.NewNopCreateSettings(), mb.NewResourceBuilder(), newRollout(), ts)
+	m := pmetric.NewMetrics()
+	rm.MoveTo(m.ResourceMetrics().AppendEmpty())
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+	))
+}
+
+func TestCustomMetricsEmpty(t *testing.T) {
+	r, err := NewRecorder(Config{
+		Version: "v1",
+		Kind:    "Widget",
+		Metrics: []MetricConfig{{Name: "widget.size", Path: "{.status.size}"}},
+	})
+	require.NoError(t, err)
+
+	mb := metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings())
+	rm := r.CustomMetrics(receivertest.NewNopCreateSettings(), mb.NewResourceBuilder(), newRollout(), 0)
+	assert.Equal(t, 0, rm.ScopeMetrics().Len())
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		err  string
+	}{
+		{
+			name: "valid",
+			cfg:  newRolloutConfig(),
+		},
+		{
+			name: "missing kind",
+			cfg:  Config{Group: "argoproj.io", Version: "v1alpha1"},
+			err:  "custom resource version and kind must be specified",
+		},
+		{
+			name: "no metrics",
+			cfg:  Config{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"},
+			err:  `custom resource "argoproj.io/v1alpha1, Kind=Rollout" must specify at least one metric`,
+		},
+		{
+			name: "missing metric name",
+			cfg:  Config{Version: "v1", Kind: "Widget", Metrics: []MetricConfig{{Path: "{.status.size}"}}},
+			err:  `custom resource "/v1, Kind=Widget": metric name must be specified`,
+		},
+		{
+			name: "duplicate metric",
+			cfg: Config{Version: "v1", Kind: "Widget", Metrics: []MetricConfig{
+				{Name: "widget.size", Path: "{.status.size}"},
+				{Name: "widget.size", Path: "{.spec.size}"},
+			}},
+			err: `custom resource "/v1, Kind=Widget": duplicate metric "widget.size"`,
+		},
+		{
+			name: "missing path",
+			cfg:  Config{Version: "v1", Kind: "Widget", Metrics: []MetricConfig{{Name: "widget.size"}}},
+			err:  `custom resource "/v1, Kind=Widget": metric "widget.size": path must be specified`,
+		},
+		{
+			name: "invalid path",
+			cfg:  Config{Version: "v1", Kind: "Widget", Metrics: []MetricConfig{{Name: "widget.size", Path: "{.status[}"}}},
+			err:  `custom resource "/v1, Kind=Widget": metric "widget.size": invalid path "{.status[}": unterminated array`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{name: "int", value: int64(5), expected: int64(5)},
+		{name: "uint", value: uint32(5), expected: int64(5)},
+		{name: "float", value: 1.5, expected: 1.5},
+		{name: "bool", value: false, expected: int64(0)},
+		{name: "condition unknown", value: "Unknown", expected: int64(-1)},
+		{name: "int string", value: "42", expected: int64(42)},
+		{name: "float string", value: "0.25", expected: 0.25},
+		{name: "timestamp", value: "1970-01-01T00:01:00Z", expected: int64(60)},
+		{name: "text", value: "Healthy", expected: nil},
+		{name: "map", value: map[string]any{}, expected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dp := pmetric.NewNumberDataPoint()
+			ok := setValue(dp, reflect.ValueOf(tt.value))
+			switch expected := tt.expected.(type) {
+			case nil:
+				assert.False(t, ok)
+			case int64:
+				require.True(t, ok)
+				assert.Equal(t, expected, dp.IntValue())
+			case float64:
+				require.True(t, ok)
+				assert.Equal(t, expected, dp.DoubleValue())
+			}
+		})
+	}
+}
@@ -44,8 +44,11 @@ func Transform(node *corev1.Node) *corev1.Node {
 	}
 	for _, c := range node.Status.Conditions {
 		newNode.Status.Conditions = append(newNode.Status.Conditions, corev1.NodeCondition{
-			Type:   c.Type,
-			Status: c.Status,
+			Type:               c.Type,
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
 		})
 	}
 	return newNode
@@ -197,8 +197,12 @@ func TestTransform(t *testing.T) {
 		Status: corev1.NodeStatus{
 			Conditions: []corev1.NodeCondition{
 				{
-					Type:   corev1.NodeReady,
-					Status: corev1.ConditionTrue,
+					Type:               corev1.NodeReady,
+					Status:             corev1.ConditionTrue,
+					Reason:             "KubeletReady",
+					Message:            "kubelet is posting ready status",
+					LastHeartbeatTime:  metav1.Date(2024, 6, 1, 12, 5, 0, 0, time.UTC),
+					LastTransitionTime: metav1.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
 				},
 			},
 			Capacity: corev1.ResourceList{
@@ -244,8 +248,11 @@ func TestTransform(t *testing.T) {
 		Status: corev1.NodeStatus{
 			Conditions: []corev1.NodeCondition{
 				{
-					Type:   corev1.NodeReady,
-					Status: corev1.ConditionTrue,
+					Type:               corev1.NodeReady,
+					Status:             corev1.ConditionTrue,
+					Reason:             "KubeletReady",
+					Message:            "kubelet is posting ready status",
+					LastTransitionTime: metav1.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
 				},
 			},
 			Allocatable: corev1.ResourceList{
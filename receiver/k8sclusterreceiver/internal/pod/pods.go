@@ -59,6 +59,15 @@ func Transform(pod *corev1.Pod) *corev1.Pod {
 			LastTerminationState: cs.LastTerminationState,
 		})
 	}
+	for _, c := range pod.Status.Conditions {
+		newPod.Status.Conditions = append(newPod.Status.Conditions, corev1.PodCondition{
+			Type:               c.Type,
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime,
+		})
+	}
 	for _, c := range pod.Spec.Containers {
 		newPod.Spec.Containers = append(newPod.Spec.Containers, corev1.Container{
 			Name: c.Name,
@@ -418,6 +418,16 @@ func TestTransform(t *testing.T) {
 			HostIP:    "192.168.1.100",
 			PodIP:     "10.244.0.5",
 			StartTime: &v1.Time{Time: v1.Now().Add(-5 * time.Minute)},
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionFalse,
+					Reason:             "ContainersNotReady",
+					Message:            "containers with unready status: [my-container]",
+					LastProbeTime:      v1.Date(2024, 6, 1, 12, 5, 0, 0, time.UTC),
+					LastTransitionTime: v1.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+				},
+			},
 			ContainerStatuses: []corev1.ContainerStatus{
 				{
 					Name:         "invalid-container",
@@ -464,6 +474,15 @@ func TestTransform(t *testing.T) {
 		},
 		Status: corev1.PodStatus{
 			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionFalse,
+					Reason:             "ContainersNotReady",
+					Message:            "containers with unready status: [my-container]",
+					LastTransitionTime: v1.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+				},
+			},
 			ContainerStatuses: []corev1.ContainerStatus{
 				{
 					Name:         "my-container",
@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/collection"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 )
 
@@ -174,10 +175,18 @@ func newReceiver(_ context.Context, set receiver.CreateSettings, cfg component.C
 	if err != nil {
 		return nil, err
 	}
+	customResources := make([]*customresource.Recorder, 0, len(rCfg.CustomResources))
+	for _, cr := range rCfg.CustomResources {
+		recorder, err := customresource.NewRecorder(cr)
+		if err != nil {
+			return nil, err
+		}
+		customResources = append(customResources, recorder)
+	}
 	ms := metadata.NewStore()
 	return &kubernetesReceiver{
 		dataCollector: collection.NewDataCollector(set, ms, rCfg.MetricsBuilderConfig,
-			rCfg.NodeConditionTypesToReport, rCfg.AllocatableTypesToReport),
+			rCfg.NodeConditionTypesToReport, rCfg.AllocatableTypesToReport, customResources),
 		resourceWatcher: newResourceWatcher(set, rCfg, ms),
 		settings:        set,
 		config:          rCfg,
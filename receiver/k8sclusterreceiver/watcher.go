@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -22,7 +23,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -64,6 +69,7 @@ type resourceWatcher struct {
 
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
+	makeDynamicClient        func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
 }
 
@@ -79,6 +85,7 @@ func newResourceWatcher(set receiver.CreateSettings, cfg *Config, metadataStore
 		initialTimeout:           defaultInitialSyncTimeout,
 		config:                   cfg,
 		makeClient:               k8sconfig.MakeClient,
+		makeDynamicClient:        k8sconfig.MakeDynamicClient,
 		makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient,
 	}
 }
@@ -153,25 +160,73 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 	}
 	rw.informerFactories = append(rw.informerFactories, factory)
 
-	return nil
+	return rw.setupCustomResourceInformers()
 }
 
 func (rw *resourceWatcher) isKindSupported(gvk schema.GroupVersionKind) (bool, error) {
+	resource, err := rw.apiResourceForKind(gvk)
+	return resource != nil, err
+}
+
+// apiResourceForKind returns the API resource serving the given kind, or nil if the server doesn't support it.
+func (rw *resourceWatcher) apiResourceForKind(gvk schema.GroupVersionKind) (*metav1.APIResource, error) {
 	resources, err := rw.client.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
 		if apierrors.IsNotFound(err) { // if the discovery endpoint isn't present, assume group version is not supported
 			rw.logger.Debug("Group version is not supported", zap.String("group", gvk.GroupVersion().String()))
-			return false, nil
+			return nil, nil
 		}
-		return false, fmt.Errorf("failed to fetch group version details: %w", err)
+		return nil, fmt.Errorf("failed to fetch group version details: %w", err)
+	}
+
+	for i, r := range resources.APIResources {
+		// Subresources such as "deployments/status" share the kind of their parent.
+		if r.Kind == gvk.Kind && !strings.Contains(r.Name, "/") {
+			return &resources.APIResources[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// setupCustomResourceInformers sets up informers for the configured custom resources.
+func (rw *resourceWatcher) setupCustomResourceInformers() error {
+	if len(rw.config.CustomResources) == 0 {
+		return nil
 	}
 
-	for _, r := range resources.APIResources {
-		if r.Kind == gvk.Kind {
-			return true, nil
+	dynamicClient, err := rw.makeDynamicClient(rw.config.APIConfig)
+	if err != nil {
+		return fmt.Errorf("Failed to create Kubernetes dynamic client: %w", err)
+	}
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, rw.config.MetadataCollectionInterval)
+
+	for _, cr := range rw.config.CustomResources {
+		kind := cr.GroupVersionKind()
+		resource, err := rw.apiResourceForKind(kind)
+		if err != nil {
+			return err
+		}
+		if resource == nil {
+			rw.logger.Warn("Server doesn't support the custom resource", zap.String("group version kind", kind.String()))
+			continue
 		}
+		rw.setupInformer(kind, factory.ForResource(kind.GroupVersion().WithResource(resource.Name)).Informer())
+	}
+	rw.informerFactories = append(rw.informerFactories, dynamicSharedInformer{factory})
+	return nil
+}
+
+// dynamicSharedInformer adapts a dynamic informer factory to the sharedInformer interface.
+type dynamicSharedInformer struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+func (d dynamicSharedInformer) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	synced := true
+	for _, ok := range d.DynamicSharedInformerFactory.WaitForCacheSync(stopCh) {
+		synced = synced && ok
 	}
-	return false, nil
+	return map[reflect.Type]bool{reflect.TypeOf(&unstructured.Unstructured{}): synced}
 }
 
 func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, factory informers.SharedInformerFactory) {
@@ -261,6 +316,10 @@ func (rw *resourceWatcher) hasDestination() bool {
 func (rw *resourceWatcher) onUpdate(oldObj, newObj any) {
 	rw.waitForInitialInformerSync()
 
+	if rw.config.ConditionEvents && rw.entityLogConsumer != nil {
+		rw.emitConditionTransitions(oldObj, newObj)
+	}
+
 	// Sync metadata only if there's at least one destination for it to sent.
 	if !rw.hasDestination() {
 		return
@@ -354,6 +413,17 @@ func validateMetadataExporters(metadataExporters map[string]bool, exporters map[
 	return nil
 }
 
+// emitConditionTransitions sends the transitions of the conditions of an updated node or pod to the logs consumer.
+func (rw *resourceWatcher) emitConditionTransitions(oldObj, newObj any) {
+	logs := conditionTransitionsToLogs(oldObj, newObj, time.Now())
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err := rw.entityLogConsumer.ConsumeLogs(context.Background(), logs); err != nil {
+		rw.logger.Error("Error sending condition events to the consumer", zap.Error(err))
+	}
+}
+
 func (rw *resourceWatcher) syncMetadataUpdate(oldMetadata, newMetadata map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata) {
 	timestamp := pcommon.NewTimestampFromTime(time.Now())
 
@@ -4,6 +4,8 @@
 package k8sclusterreceiver
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -17,13 +19,19 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/customresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
@@ -203,6 +211,65 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 	}
 }
 
+func TestSetupCustomResourceInformers(t *testing.T) {
+	certificateGVK := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+	certificateGVR := certificateGVK.GroupVersion().WithResource("certificates")
+	rolloutGVK := schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+
+	client := newFakeClientWithAllResources()
+	client.Resources = append(client.Resources, &metav1.APIResourceList{
+		GroupVersion: certificateGVK.GroupVersion().String(),
+		APIResources: []metav1.APIResource{
+			{Name: "certificates/status", Kind: "Certificate"},
+			{Name: "certificates", Kind: "Certificate"},
+		},
+	})
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(certificateGVK)
+	certificate.SetNamespace("default")
+	certificate.SetName("example")
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{certificateGVR: "CertificateList"}, certificate)
+
+	metric := customresource.MetricConfig{Name: "certmanager.certificate.expiration", Path: "{.status.notAfter}"}
+	obs, logs := observer.New(zap.WarnLevel)
+	rw := &resourceWatcher{
+		client:        client,
+		logger:        zap.New(obs),
+		metadataStore: metadata.NewStore(),
+		config: &Config{
+			CustomResources: []customresource.Config{
+				{Group: certificateGVK.Group, Version: certificateGVK.Version, Kind: certificateGVK.Kind, Metrics: []customresource.MetricConfig{metric}},
+				{Group: rolloutGVK.Group, Version: rolloutGVK.Version, Kind: rolloutGVK.Kind, Metrics: []customresource.MetricConfig{metric}},
+			},
+		},
+		makeDynamicClient: func(k8sconfig.APIConfig) (dynamic.Interface, error) {
+			return dynamicClient, nil
+		},
+		initialSyncDone:     &atomic.Bool{},
+		initialSyncTimedOut: &atomic.Bool{},
+		initialTimeout:      10 * time.Second,
+	}
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	require.Len(t, rw.informerFactories, 2)
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "Server doesn't support the custom resource", logs.All()[0].Message)
+	assert.Nil(t, rw.metadataStore.Get(rolloutGVK))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rw.initialSyncDone.Store(true)
+	<-rw.startWatchingResources(ctx, rw.informerFactories[1]).Done()
+
+	store := rw.metadataStore.Get(certificateGVK)
+	require.NotNil(t, store)
+	objs := store.List()
+	require.Len(t, objs, 1)
+	assert.Equal(t, "example", objs[0].(*unstructured.Unstructured).GetName())
+}
+
 func TestSetupInformerForKind(t *testing.T) {
 	obs, logs := observer.New(zap.WarnLevel)
 	obsLogger := zap.New(obs)
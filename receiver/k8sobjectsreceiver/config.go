@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -51,6 +52,10 @@ type Config struct {
 
 	Objects []*K8sObjectsConfig `mapstructure:"objects"`
 
+	// StorageID is the ID of the storage extension used to persist the last resourceVersion
+	// seen by each watch, so that watches resume from it after a restart.
+	StorageID *component.ID `mapstructure:"storage"`
+
 	// For mocking purposes only.
 	makeDiscoveryClient func() (discovery.ServerResourcesInterface, error)
 	makeDynamicClient   func() (dynamic.Interface, error)
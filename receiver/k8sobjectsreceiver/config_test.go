@@ -22,6 +22,8 @@ import (
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
 
+	fileStorageID := component.MustNewID("file_storage")
+
 	tests := []struct {
 		id       component.ID
 		expected *Config
@@ -128,6 +130,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				StorageID:           &fileStorageID,
 				makeDiscoveryClient: getMockDiscoveryClient,
 			},
 		},
@@ -160,6 +163,7 @@ func TestLoadConfig(t *testing.T) {
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected.AuthType, cfg.AuthType)
 			assert.Equal(t, tt.expected.Objects, cfg.Objects)
+			assert.Equal(t, tt.expected.StorageID, cfg.StorageID)
 		})
 	}
 }
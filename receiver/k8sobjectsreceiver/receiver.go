@@ -12,11 +12,14 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.uber.org/zap"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
@@ -33,10 +36,17 @@ type k8sobjectsreceiver struct {
 	client          dynamic.Interface
 	consumer        consumer.Logs
 	obsrecv         *receiverhelper.ObsReport
+	storageClient   storage.Client
 	mu              sync.Mutex
 	cancel          context.CancelFunc
+	// watches tracks the running watches, which are stopped before the storage client is closed.
+	watches sync.WaitGroup
 }
 
+// resourceVersionPersistInterval is the interval at which the last resourceVersion seen by a
+// watch is persisted, rather than persisting it on every event.
+const resourceVersionPersistInterval = 5 * time.Second
+
 func newReceiver(params receiver.CreateSettings, config *Config, consumer consumer.Logs) (receiver.Logs, error) {
 	transport := "http"
 
@@ -65,12 +75,18 @@ func newReceiver(params receiver.CreateSettings, config *Config, consumer consum
 	}, nil
 }
 
-func (kr *k8sobjectsreceiver) Start(ctx context.Context, _ component.Host) error {
+func (kr *k8sobjectsreceiver) Start(ctx context.Context, host component.Host) error {
 	client, err := kr.config.getDynamicClient()
 	if err != nil {
 		return err
 	}
 	kr.client = client
+
+	storageClient, err := getStorageClient(ctx, host, kr.config.StorageID, kr.setting.ID)
+	if err != nil {
+		return fmt.Errorf("error connecting to storage: %w", err)
+	}
+	kr.storageClient = storageClient
 	kr.setting.Logger.Info("Object Receiver started")
 
 	cctx, cancel := context.WithCancel(ctx)
@@ -82,7 +98,7 @@ func (kr *k8sobjectsreceiver) Start(ctx context.Context, _ component.Host) error
 	return nil
 }
 
-func (kr *k8sobjectsreceiver) Shutdown(context.Context) error {
+func (kr *k8sobjectsreceiver) Shutdown(ctx context.Context) error {
 	kr.setting.Logger.Info("Object Receiver stopped")
 	if kr.cancel != nil {
 		kr.cancel()
@@ -93,6 +109,11 @@ func (kr *k8sobjectsreceiver) Shutdown(context.Context) error {
 		close(stopperChan)
 	}
 	kr.mu.Unlock()
+	kr.watches.Wait()
+
+	if kr.storageClient != nil {
+		return kr.storageClient.Close(ctx)
+	}
 	return nil
 }
 
@@ -112,10 +133,12 @@ func (kr *k8sobjectsreceiver) start(ctx context.Context, object *K8sObjectsConfi
 
 	case WatchMode:
 		if len(object.Namespaces) == 0 {
-			go kr.startWatch(ctx, object, resource)
+			kr.watches.Add(1)
+			go kr.startWatch(ctx, object, resource, resourceVersionKey(object, ""))
 		} else {
 			for _, ns := range object.Namespaces {
-				go kr.startWatch(ctx, object, resource.Namespace(ns))
+				kr.watches.Add(1)
+				go kr.startWatch(ctx, object, resource.Namespace(ns), resourceVersionKey(object, ns))
 			}
 		}
 	}
@@ -159,7 +182,8 @@ func (kr *k8sobjectsreceiver) startPull(ctx context.Context, config *K8sObjectsC
 
 }
 
-func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface) {
+func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface, storageKey string) {
+	defer kr.watches.Done()
 	stopperChan := make(chan struct{})
 	kr.mu.Lock()
 	kr.stopperChanList = append(kr.stopperChanList, stopperChan)
@@ -173,6 +197,9 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 
 	cancelCtx, cancel := context.WithCancel(ctx)
 	cfgCopy := *config
+	if cfgCopy.ResourceVersion == "" {
+		cfgCopy.ResourceVersion = kr.loadResourceVersion(ctx, storageKey)
+	}
 	wait.UntilWithContext(cancelCtx, func(newCtx context.Context) {
 		resourceVersion, err := getResourceVersion(newCtx, &cfgCopy, resource)
 		if err != nil {
@@ -181,7 +208,7 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 			return
 		}
 
-		done := kr.doWatch(newCtx, &cfgCopy, resourceVersion, watchFunc, stopperChan)
+		done := kr.doWatch(newCtx, &cfgCopy, resourceVersion, storageKey, watchFunc, stopperChan)
 		if done {
 			cancel()
 			return
@@ -193,7 +220,7 @@ func (kr *k8sobjectsreceiver) startWatch(ctx context.Context, config *K8sObjects
 }
 
 // doWatch returns true when watching is done, false when watching should be restarted.
-func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsConfig, resourceVersion string, watchFunc func(options metav1.ListOptions) (apiWatch.Interface, error), stopperChan chan struct{}) bool {
+func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsConfig, resourceVersion string, storageKey string, watchFunc func(options metav1.ListOptions) (apiWatch.Interface, error), stopperChan chan struct{}) bool {
 	watcher, err := watch.NewRetryWatcher(resourceVersion, &cache.ListWatch{WatchFunc: watchFunc})
 	if err != nil {
 		kr.setting.Logger.Error("error in watching object", zap.String("resource", config.gvr.String()), zap.Error(err))
@@ -201,6 +228,13 @@ func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsCon
 	}
 
 	defer watcher.Stop()
+
+	// The last resourceVersion seen is persisted periodically and when the watch ends.
+	checkpoint := &resourceVersionCheckpoint{storageKey: storageKey}
+	defer kr.persistResourceVersion(context.Background(), checkpoint)
+	persistTicker := time.NewTicker(resourceVersionPersistInterval)
+	defer persistTicker.Stop()
+
 	res := watcher.ResultChan()
 	for {
 		select {
@@ -222,6 +256,7 @@ func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsCon
 
 			if config.exclude[data.Type] {
 				kr.setting.Logger.Debug("dropping excluded data", zap.String("type", string(data.Type)))
+				checkpoint.observe(data.Object)
 				continue
 			}
 
@@ -233,6 +268,9 @@ func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsCon
 				err := kr.consumer.ConsumeLogs(obsCtx, logs)
 				kr.obsrecv.EndLogsOp(obsCtx, metadata.Type.String(), 1, err)
 			}
+			checkpoint.observe(data.Object)
+		case <-persistTicker.C:
+			kr.persistResourceVersion(ctx, checkpoint)
 		case <-stopperChan:
 			watcher.Stop()
 			return true
@@ -240,6 +278,48 @@ func (kr *k8sobjectsreceiver) doWatch(ctx context.Context, config *K8sObjectsCon
 	}
 }
 
+// loadResourceVersion returns the resourceVersion checkpointed for the given key, or an empty
+// string when there is none.
+func (kr *k8sobjectsreceiver) loadResourceVersion(ctx context.Context, storageKey string) string {
+	resourceVersion, err := kr.storageClient.Get(ctx, storageKey)
+	if err != nil {
+		kr.setting.Logger.Info("unable to load resourceVersion from storage client, continuing without a checkpoint", zap.String("key", storageKey), zap.Error(err))
+		return ""
+	}
+	return string(resourceVersion)
+}
+
+// resourceVersionCheckpoint is the last resourceVersion seen by a watch, and whether it was
+// persisted already.
+type resourceVersionCheckpoint struct {
+	storageKey      string
+	resourceVersion string
+	dirty           bool
+}
+
+// observe records the resourceVersion of a watched object.
+func (c *resourceVersionCheckpoint) observe(object runtime.Object) {
+	accessor, err := meta.Accessor(object)
+	if err != nil || accessor.GetResourceVersion() == "" {
+		return
+	}
+	c.resourceVersion = accessor.GetResourceVersion()
+	c.dirty = true
+}
+
+// persistResourceVersion stores the last resourceVersion seen by a watch, if it changed since
+// it was last stored, so that the watch can resume from it after a restart.
+func (kr *k8sobjectsreceiver) persistResourceVersion(ctx context.Context, checkpoint *resourceVersionCheckpoint) {
+	if !checkpoint.dirty {
+		return
+	}
+	if err := kr.storageClient.Set(ctx, checkpoint.storageKey, []byte(checkpoint.resourceVersion)); err != nil {
+		kr.setting.Logger.Warn("unable to store resourceVersion", zap.String("key", checkpoint.storageKey), zap.Error(err))
+		return
+	}
+	checkpoint.dirty = false
+}
+
 func getResourceVersion(ctx context.Context, config *K8sObjectsConfig, resource dynamic.ResourceInterface) (string, error) {
 	resourceVersion := config.ResourceVersion
 	if resourceVersion == "" || resourceVersion == "0" {
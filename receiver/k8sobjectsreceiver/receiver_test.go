@@ -10,10 +10,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	apiWatch "k8s.io/apimachinery/pkg/watch"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 )
 
 func TestNewReceiver(t *testing.T) {
@@ -205,3 +209,64 @@ func TestExludeDeletedTrue(t *testing.T) {
 
 	assert.NoError(t, r.Shutdown(ctx))
 }
+
+func TestWatchObjectResourceVersionCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	mockClient := newMockDynamicClient()
+
+	rCfg := createDefaultConfig().(*Config)
+	rCfg.makeDynamicClient = mockClient.getMockDynamicClient
+	rCfg.makeDiscoveryClient = getMockDiscoveryClient
+	storageID := storagetest.NewStorageID("resource_version")
+	rCfg.StorageID = &storageID
+
+	rCfg.Objects = []*K8sObjectsConfig{
+		{
+			Name:       "pods",
+			Mode:       WatchMode,
+			Namespaces: []string{"default"},
+		},
+	}
+	require.NoError(t, rCfg.Validate())
+
+	ext := storagetest.NewFileBackedStorageExtension("resource_version", t.TempDir())
+	host := storagetest.NewStorageHost().WithExtension(storageID, ext)
+
+	settings := receivertest.NewNopCreateSettings()
+	consumer := newMockLogConsumer()
+	r, err := newReceiver(settings, rCfg, consumer)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, r.Start(ctx, host))
+
+	time.Sleep(time.Millisecond * 100)
+	mockClient.createPods(
+		generatePod("pod1", "default", map[string]any{
+			"environment": "production",
+		}, "5"),
+	)
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 1, consumer.Count())
+	require.NoError(t, r.Shutdown(ctx))
+
+	// a restarted receiver resumes the watch from the checkpointed resourceVersion
+	client, err := ext.GetClient(ctx, component.KindReceiver, settings.ID, "")
+	require.NoError(t, err)
+	kr := r.(*k8sobjectsreceiver)
+	kr.storageClient = client
+	assert.Equal(t, "5", kr.loadResourceVersion(ctx, resourceVersionKey(rCfg.Objects[0], "default")))
+	assert.Empty(t, kr.loadResourceVersion(ctx, resourceVersionKey(rCfg.Objects[0], "")))
+	require.NoError(t, client.Close(ctx))
+}
+
+func TestResourceVersionKey(t *testing.T) {
+	gvr := &schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	cfg := &K8sObjectsConfig{gvr: gvr, FieldSelector: "status.phase=Running"}
+	other := &K8sObjectsConfig{gvr: gvr, LabelSelector: "app=nginx"}
+
+	assert.Equal(t, resourceVersionKey(cfg, "default"), resourceVersionKey(&K8sObjectsConfig{gvr: gvr, FieldSelector: "status.phase=Running"}, "default"))
+	assert.NotEqual(t, resourceVersionKey(cfg, "default"), resourceVersionKey(other, "default"))
+	assert.NotEqual(t, resourceVersionKey(cfg, "default"), resourceVersionKey(cfg, ""))
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8sobjectsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sobjectsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+)
+
+func getStorageClient(ctx context.Context, host component.Host, storageID *component.ID, componentID component.ID) (storage.Client, error) {
+	if storageID == nil {
+		return storage.NewNopClient(), nil
+	}
+
+	extension, ok := host.GetExtensions()[*storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension '%s' not found", storageID)
+	}
+
+	storageExtension, ok := extension.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("non-storage extension '%s' found", storageID)
+	}
+
+	return storageExtension.GetClient(ctx, component.KindReceiver, componentID, "")
+}
+
+// resourceVersionKey is the storage key of the last resourceVersion seen by the watch
+// of the given object in the given namespace, empty meaning all namespaces. The selectors
+// are part of the key, so that watches of the same object with different selectors don't
+// overwrite each other's resourceVersion.
+func resourceVersionKey(config *K8sObjectsConfig, namespace string) string {
+	selectors := fnv.New64a()
+	_, _ = selectors.Write([]byte(config.FieldSelector + "\x00" + config.LabelSelector))
+	return fmt.Sprintf("resourceVersion.%s.%s.%x", config.gvr.String(), namespace, selectors.Sum64())
+}
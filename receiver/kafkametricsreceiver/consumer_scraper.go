@@ -29,6 +29,9 @@ type consumerScraper struct {
 	saramaConfig *sarama.Config
 	config       Config
 	mb           *metadata.MetricsBuilder
+	// offsetHistories tracks the end offset progression of the partitions of matched topics,
+	// to estimate the lag time of consumer groups.
+	offsetHistories map[string]map[int32]*offsetHistory
 }
 
 func (s *consumerScraper) Name() string {
@@ -88,6 +91,8 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		}
 	}
 	var scrapeError error
+	lagTimeEnabled := s.config.MetricsBuilderConfig.Metrics.KafkaConsumerGroupLagTime.Enabled
+	scrapeTime := time.Now()
 	// partitionIds in matchedTopics
 	topicPartitions := map[string][]int32{}
 	// currentOffset for each partition in matchedTopics
@@ -108,6 +113,14 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 			}
 			topicPartitions[topic] = append(topicPartitions[topic], p)
 			topicPartitionOffset[topic][p] = offset
+			if lagTimeEnabled {
+				s.offsetHistory(topic, p).record(offset, scrapeTime)
+			}
+		}
+	}
+	for topic := range s.offsetHistories {
+		if _, ok := matchedTopics[topic]; !ok {
+			delete(s.offsetHistories, topic)
 		}
 	}
 	consumerGroups, listErr := s.clusterAdmin.DescribeConsumerGroups(matchedGrpIDs)
@@ -115,7 +128,7 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 		return pmetric.Metrics{}, listErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	now := pcommon.NewTimestampFromTime(scrapeTime)
 
 	for _, group := range consumerGroups {
 		s.mb.RecordKafkaConsumerGroupMembersDataPoint(now, int64(len(group.Members)), group.GroupId)
@@ -154,6 +167,12 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 						}
 					}
 					s.mb.RecordKafkaConsumerGroupLagDataPoint(now, consumerLag, group.GroupId, topic, int64(partition))
+
+					if history, ok := s.offsetHistories[topic][partition]; ok && block.Offset != -1 {
+						if lagTime, ok := history.lagTime(consumerOffset, scrapeTime); ok {
+							s.mb.RecordKafkaConsumerGroupLagTimeDataPoint(now, lagTime.Seconds(), group.GroupId, topic, int64(partition))
+						}
+					}
 				}
 				s.mb.RecordKafkaConsumerGroupOffsetSumDataPoint(now, offsetSum, group.GroupId, topic)
 				s.mb.RecordKafkaConsumerGroupLagSumDataPoint(now, lagSum, group.GroupId, topic)
@@ -164,6 +183,24 @@ func (s *consumerScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	return s.mb.Emit(), scrapeError
 }
 
+// offsetHistory returns the end offset history of the partition, creating it if needed.
+func (s *consumerScraper) offsetHistory(topic string, partition int32) *offsetHistory {
+	if s.offsetHistories == nil {
+		s.offsetHistories = map[string]map[int32]*offsetHistory{}
+	}
+	partitions, ok := s.offsetHistories[topic]
+	if !ok {
+		partitions = map[int32]*offsetHistory{}
+		s.offsetHistories[topic] = partitions
+	}
+	history, ok := partitions[partition]
+	if !ok {
+		history = &offsetHistory{}
+		partitions[partition] = history
+	}
+	return history
+}
+
 func createConsumerScraper(_ context.Context, cfg Config, saramaConfig *sarama.Config,
 	settings receiver.CreateSettings) (scraperhelper.Scraper, error) {
 	groupFilter, err := regexp.Compile(cfg.GroupMatch)
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -227,3 +228,49 @@ func TestConsumerScraper_scrape_handlesPartitionPartialError(t *testing.T) {
 	_, err := cs.scrape(context.Background())
 	assert.Error(t, err)
 }
+
+func TestConsumerScraper_scrape_lagTime(t *testing.T) {
+	filter := regexp.MustCompile(defaultGroupMatch)
+	client := newMockClient()
+	client.offset = 10
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.KafkaConsumerGroupLagTime.Enabled = true
+	cs := consumerScraper{
+		client:       client,
+		settings:     receivertest.NewNopCreateSettings(),
+		clusterAdmin: newMockClusterAdmin(),
+		topicFilter:  filter,
+		groupFilter:  filter,
+		config:       *cfg,
+	}
+	require.NoError(t, cs.start(context.Background(), componenttest.NewNopHost()))
+
+	lagTime := func(md pmetric.Metrics) (float64, bool) {
+		metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		for i := 0; i < metrics.Len(); i++ {
+			if metrics.At(i).Name() == "kafka.consumer_group.lag_time" {
+				return metrics.At(i).Gauge().DataPoints().At(0).DoubleValue(), true
+			}
+		}
+		return 0, false
+	}
+
+	// The lag time is unknown until the end offset progressed.
+	md, err := cs.scrape(context.Background())
+	require.NoError(t, err)
+	_, ok := lagTime(md)
+	assert.False(t, ok)
+
+	client.offset = 20
+	md, err = cs.scrape(context.Background())
+	require.NoError(t, err)
+	lag, ok := lagTime(md)
+	require.True(t, ok)
+	assert.Greater(t, lag, 0.0)
+
+	// Topics which are no longer matched are forgotten.
+	cs.topicFilter = regexp.MustCompile("^$")
+	_, err = cs.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, cs.offsetHistories)
+}
@@ -30,6 +30,7 @@ type MetricsConfig struct {
 	KafkaBrokers                 MetricConfig `mapstructure:"kafka.brokers"`
 	KafkaConsumerGroupLag        MetricConfig `mapstructure:"kafka.consumer_group.lag"`
 	KafkaConsumerGroupLagSum     MetricConfig `mapstructure:"kafka.consumer_group.lag_sum"`
+	KafkaConsumerGroupLagTime    MetricConfig `mapstructure:"kafka.consumer_group.lag_time"`
 	KafkaConsumerGroupMembers    MetricConfig `mapstructure:"kafka.consumer_group.members"`
 	KafkaConsumerGroupOffset     MetricConfig `mapstructure:"kafka.consumer_group.offset"`
 	KafkaConsumerGroupOffsetSum  MetricConfig `mapstructure:"kafka.consumer_group.offset_sum"`
@@ -51,6 +52,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		KafkaConsumerGroupLagSum: MetricConfig{
 			Enabled: true,
 		},
+		KafkaConsumerGroupLagTime: MetricConfig{
+			Enabled: false,
+		},
 		KafkaConsumerGroupMembers: MetricConfig{
 			Enabled: true,
 		},
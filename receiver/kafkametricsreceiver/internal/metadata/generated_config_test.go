@@ -28,6 +28,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaBrokers:                 MetricConfig{Enabled: true},
 					KafkaConsumerGroupLag:        MetricConfig{Enabled: true},
 					KafkaConsumerGroupLagSum:     MetricConfig{Enabled: true},
+					KafkaConsumerGroupLagTime:    MetricConfig{Enabled: true},
 					KafkaConsumerGroupMembers:    MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffset:     MetricConfig{Enabled: true},
 					KafkaConsumerGroupOffsetSum:  MetricConfig{Enabled: true},
@@ -46,6 +47,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					KafkaBrokers:                 MetricConfig{Enabled: false},
 					KafkaConsumerGroupLag:        MetricConfig{Enabled: false},
 					KafkaConsumerGroupLagSum:     MetricConfig{Enabled: false},
+					KafkaConsumerGroupLagTime:    MetricConfig{Enabled: false},
 					KafkaConsumerGroupMembers:    MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffset:     MetricConfig{Enabled: false},
 					KafkaConsumerGroupOffsetSum:  MetricConfig{Enabled: false},
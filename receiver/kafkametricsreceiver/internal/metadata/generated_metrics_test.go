@@ -71,6 +71,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordKafkaConsumerGroupLagSumDataPoint(ts, 1, "group-val", "topic-val")
 
+			allMetricsCount++
+			mb.RecordKafkaConsumerGroupLagTimeDataPoint(ts, 1, "group-val", "topic-val", 9)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordKafkaConsumerGroupMembersDataPoint(ts, 1, "group-val")
@@ -178,6 +181,27 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("topic")
 					assert.True(t, ok)
 					assert.EqualValues(t, "topic-val", attrVal.Str())
+				case "kafka.consumer_group.lag_time":
+					assert.False(t, validatedMetrics["kafka.consumer_group.lag_time"], "Found a duplicate in the metrics slice: kafka.consumer_group.lag_time")
+					validatedMetrics["kafka.consumer_group.lag_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current approximate time since the oldest message not consumed by the consumer group at partition of topic was produced", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("group")
+					assert.True(t, ok)
+					assert.EqualValues(t, "group-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("topic")
+					assert.True(t, ok)
+					assert.EqualValues(t, "topic-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("partition")
+					assert.True(t, ok)
+					assert.EqualValues(t, 9, attrVal.Int())
 				case "kafka.consumer_group.members":
 					assert.False(t, validatedMetrics["kafka.consumer_group.members"], "Found a duplicate in the metrics slice: kafka.consumer_group.members")
 					validatedMetrics["kafka.consumer_group.members"] = true
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/kafkametricsreceiver"
+
+import "time"
+
+// maxOffsetSamples is the number of end offset changes kept per partition, which bounds how far in the
+// past the lag time can be interpolated rather than extrapolated.
+const maxOffsetSamples = 120
+
+type offsetSample struct {
+	offset int64
+	time   time.Time
+}
+
+// offsetHistory records the progression of the end offset of a partition across scrapes, to estimate
+// when the messages at a given offset were produced without reading them.
+type offsetHistory struct {
+	// samples are sorted by offset and time, and hold the time at which each end offset was first observed.
+	samples []offsetSample
+}
+
+// record adds the end offset observed at the given time. Samples are only added when the end offset
+// progressed, so that the time of a sample is when its offset was first observed.
+func (h *offsetHistory) record(offset int64, t time.Time) {
+	if n := len(h.samples); n > 0 && h.samples[n-1].offset >= offset {
+		if h.samples[n-1].offset > offset {
+			// The partition was truncated or recreated, the history no longer applies.
+			h.samples = h.samples[:0]
+		} else {
+			return
+		}
+	}
+	if len(h.samples) == maxOffsetSamples {
+		h.samples = append(h.samples[:0], h.samples[1:]...)
+	}
+	h.samples = append(h.samples, offsetSample{offset: offset, time: t})
+}
+
+// lagTime estimates how long ago the message following the committed offset was produced, as of now.
+// It returns false if the history is too short to estimate it.
+func (h *offsetHistory) lagTime(committed int64, now time.Time) (time.Duration, bool) {
+	n := len(h.samples)
+	if n == 0 {
+		return 0, false
+	}
+	last := h.samples[n-1]
+	if committed >= last.offset {
+		// The consumer group caught up with the end of the partition.
+		return 0, true
+	}
+
+	// The message following the committed offset was produced when the end offset went past it.
+	for i := n - 1; i > 0; i-- {
+		prev, next := h.samples[i-1], h.samples[i]
+		if committed >= prev.offset {
+			return now.Sub(interpolate(prev, next, committed+1)), true
+		}
+	}
+
+	// The message was produced before the oldest sample, extrapolate from the average production rate.
+	if n < 2 {
+		return 0, false
+	}
+	return now.Sub(interpolate(h.samples[0], last, committed+1)), true
+}
+
+// interpolate returns the time at which the end offset reached offset, assuming a constant production
+// rate between the two samples.
+func interpolate(from, to offsetSample, offset int64) time.Time {
+	ratio := float64(offset-from.offset) / float64(to.offset-from.offset)
+	return from.time.Add(time.Duration(ratio * float64(to.time.Sub(from.time))))
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafkametricsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffsetHistoryLagTime(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	h := &offsetHistory{}
+
+	_, ok := h.lagTime(0, start)
+	assert.False(t, ok)
+
+	h.record(100, start)
+	// Caught up consumer groups have no lag.
+	lag, ok := h.lagTime(100, start)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), lag)
+	// The production rate is unknown until the end offset changed.
+	_, ok = h.lagTime(50, start)
+	assert.False(t, ok)
+
+	// Unchanged end offsets keep the time they were first observed.
+	h.record(100, start.Add(time.Minute))
+	h.record(200, start.Add(2*time.Minute))
+	h.record(200, start.Add(3*time.Minute))
+	h.record(300, start.Add(4*time.Minute))
+	now := start.Add(5 * time.Minute)
+
+	testCases := []struct {
+		name      string
+		committed int64
+		expected  time.Duration
+	}{
+		{
+			name:      "caught up",
+			committed: 300,
+			expected:  0,
+		},
+		{
+			name:      "interpolated",
+			committed: 149,
+			expected:  4 * time.Minute,
+		},
+		{
+			name:      "latest interval",
+			committed: 249,
+			expected:  2 * time.Minute,
+		},
+		{
+			name:      "extrapolated",
+			committed: 49,
+			expected:  6 * time.Minute,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lag, ok := h.lagTime(tc.committed, now)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, lag)
+		})
+	}
+}
+
+func TestOffsetHistoryReset(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	h := &offsetHistory{}
+	h.record(100, start)
+	h.record(200, start.Add(time.Minute))
+
+	// A lower end offset means the partition was recreated.
+	h.record(10, start.Add(2*time.Minute))
+	assert.Equal(t, []offsetSample{{offset: 10, time: start.Add(2 * time.Minute)}}, h.samples)
+}
+
+func TestOffsetHistoryMaxSamples(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	h := &offsetHistory{}
+	for i := 0; i < 2*maxOffsetSamples; i++ {
+		h.record(int64(i), start.Add(time.Duration(i)*time.Second))
+	}
+	assert.Len(t, h.samples, maxOffsetSamples)
+	assert.Equal(t, int64(maxOffsetSamples), h.samples[0].offset)
+}
@@ -23,6 +23,8 @@ const (
 	PodMetricGroup       = MetricGroup("pod")
 	NodeMetricGroup      = MetricGroup("node")
 	VolumeMetricGroup    = MetricGroup("volume")
+	// PersistentVolumeClaimMetricGroup reports the volume metrics once per claim rather than per pod.
+	PersistentVolumeClaimMetricGroup = MetricGroup("pvc")
 )
 
 // ValidMetricGroups map of valid metrics.
@@ -31,6 +33,8 @@ var ValidMetricGroups = map[MetricGroup]bool{
 	PodMetricGroup:       true,
 	NodeMetricGroup:      true,
 	VolumeMetricGroup:    true,
+
+	PersistentVolumeClaimMetricGroup: true,
 }
 
 type metricDataAccumulator struct {
@@ -40,6 +44,8 @@ type metricDataAccumulator struct {
 	metricGroupsToCollect map[MetricGroup]bool
 	time                  time.Time
 	mbs                   *metadata.MetricsBuilders
+	// seenClaims tracks the persistent volume claims already reported, as a claim can be mounted by several pods.
+	seenClaims map[stats.PVCReference]bool
 }
 
 func addUptimeMetric(mb *metadata.MetricsBuilder, uptimeMetric metadata.RecordIntDataPointFunc, startTime v1.Time, currentTime pcommon.Timestamp) {
@@ -141,3 +147,33 @@ func (a *metricDataAccumulator) volumeStats(sPod stats.PodStats, s stats.VolumeS
 
 	a.m = append(a.m, a.mbs.OtherMetricsBuilder.Emit(metadata.WithResource(res)))
 }
+
+func (a *metricDataAccumulator) persistentVolumeClaimStats(s stats.VolumeStats) {
+	if !a.metricGroupsToCollect[PersistentVolumeClaimMetricGroup] || s.PVCRef == nil {
+		return
+	}
+
+	if a.seenClaims[*s.PVCRef] {
+		return
+	}
+	if a.seenClaims == nil {
+		a.seenClaims = make(map[stats.PVCReference]bool)
+	}
+	a.seenClaims[*s.PVCRef] = true
+
+	rb := a.mbs.OtherMetricsBuilder.NewResourceBuilder()
+	res, err := getPersistentVolumeClaimResourceOptions(rb, *s.PVCRef, a.metadata)
+	if err != nil {
+		a.logger.Warn(
+			"Failed to gather additional persistent volume claim metadata. Skipping metric collection.",
+			zap.String("namespace", s.PVCRef.Namespace),
+			zap.String("claim", s.PVCRef.Name),
+			zap.Error(err))
+		return
+	}
+
+	currentTime := pcommon.NewTimestampFromTime(a.time)
+	addVolumeMetrics(a.mbs.OtherMetricsBuilder, metadata.K8sVolumeMetrics, s, currentTime)
+
+	a.m = append(a.m, a.mbs.OtherMetricsBuilder.Emit(metadata.WithResource(res)))
+}
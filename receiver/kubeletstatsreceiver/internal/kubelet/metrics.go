@@ -36,6 +36,7 @@ func MetricsData(
 		for _, volumeStats := range podStats.VolumeStats {
 			// propagate the pod resource down to the container
 			acc.volumeStats(podStats, volumeStats)
+			acc.persistentVolumeClaimStats(volumeStats)
 		}
 	}
 	return acc.m
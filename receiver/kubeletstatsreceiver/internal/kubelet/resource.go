@@ -33,6 +33,9 @@ func getVolumeResourceOptions(rb *metadata.ResourceBuilder, sPod stats.PodStats,
 	rb.SetK8sPodName(sPod.PodRef.Name)
 	rb.SetK8sNamespaceName(sPod.PodRef.Namespace)
 	rb.SetK8sVolumeName(vs.Name)
+	if vs.PVCRef != nil {
+		rb.SetK8sPersistentvolumeclaimName(vs.PVCRef.Name)
+	}
 
 	err := k8sMetadata.setExtraResources(rb, sPod.PodRef, MetadataLabelVolumeType, vs.Name)
 	if err != nil {
@@ -41,3 +44,19 @@ func getVolumeResourceOptions(rb *metadata.ResourceBuilder, sPod stats.PodStats,
 
 	return rb.Emit(), nil
 }
+
+func getPersistentVolumeClaimResourceOptions(rb *metadata.ResourceBuilder, pvcRef stats.PVCReference,
+	k8sMetadata Metadata) (pcommon.Resource, error) {
+	rb.SetK8sNamespaceName(pvcRef.Namespace)
+	rb.SetK8sPersistentvolumeclaimName(pvcRef.Name)
+	rb.SetK8sVolumeType(labelValuePersistentVolumeClaim)
+
+	if k8sMetadata.DetailedPVCResourceSetter != nil {
+		volCacheID := fmt.Sprintf("%s/%s", pvcRef.Namespace, pvcRef.Name)
+		if err := k8sMetadata.DetailedPVCResourceSetter(rb, volCacheID, pvcRef.Name, pvcRef.Namespace); err != nil {
+			return rb.Emit(), fmt.Errorf("failed to set labels from volume claim: %w", err)
+		}
+	}
+
+	return rb.Emit(), nil
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -187,3 +189,60 @@ func TestDetailedPVCLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestPersistentVolumeClaimStats(t *testing.T) {
+	mbs := &metadata.MetricsBuilders{
+		OtherMetricsBuilder: metadata.NewMetricsBuilder(metadata.DefaultMetricsBuilderConfig(), receivertest.NewNopCreateSettings()),
+	}
+	md := NewMetadata(nil, nil, NodeLimits{}, func(rb *metadata.ResourceBuilder, volCacheID, volumeClaim, namespace string) error {
+		require.Equal(t, "ns/claim", volCacheID)
+		require.Equal(t, "claim", volumeClaim)
+		require.Equal(t, "ns", namespace)
+		rb.SetK8sStorageclassName("standard")
+		return nil
+	})
+	acc := metricDataAccumulator{
+		metadata:              md,
+		logger:                zap.NewNop(),
+		metricGroupsToCollect: map[MetricGroup]bool{PersistentVolumeClaimMetricGroup: true},
+		mbs:                   mbs,
+	}
+
+	capacity := uint64(100)
+	volumeStats := stats.VolumeStats{
+		Name:    "volume0",
+		PVCRef:  &stats.PVCReference{Name: "claim", Namespace: "ns"},
+		FsStats: stats.FsStats{CapacityBytes: &capacity},
+	}
+	// the same claim mounted by two pods is reported once
+	acc.persistentVolumeClaimStats(volumeStats)
+	acc.persistentVolumeClaimStats(volumeStats)
+	acc.persistentVolumeClaimStats(stats.VolumeStats{Name: "volume1"})
+
+	require.Len(t, acc.m, 1)
+	rm := acc.m[0].ResourceMetrics().At(0)
+	require.Equal(t, map[string]any{
+		"k8s.namespace.name":             "ns",
+		"k8s.persistentvolumeclaim.name": "claim",
+		"k8s.storageclass.name":          "standard",
+		"k8s.volume.type":                "persistentVolumeClaim",
+	}, rm.Resource().Attributes().AsRaw())
+	require.Equal(t, "k8s.volume.capacity", rm.ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestVolumeResourceWithPVCRef(t *testing.T) {
+	rb := metadata.NewResourceBuilder(metadata.DefaultResourceAttributesConfig())
+	podStats := stats.PodStats{PodRef: stats.PodReference{UID: "uid", Name: "pod", Namespace: "ns"}}
+	res, err := getVolumeResourceOptions(rb, podStats, stats.VolumeStats{
+		Name:   "volume0",
+		PVCRef: &stats.PVCReference{Name: "claim", Namespace: "ns"},
+	}, Metadata{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"k8s.pod.uid":                    "uid",
+		"k8s.pod.name":                   "pod",
+		"k8s.namespace.name":             "ns",
+		"k8s.volume.name":                "volume0",
+		"k8s.persistentvolumeclaim.name": "claim",
+	}, res.Attributes().AsRaw())
+}
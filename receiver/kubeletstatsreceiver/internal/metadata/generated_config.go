@@ -303,6 +303,7 @@ type ResourceAttributesConfig struct {
 	K8sPersistentvolumeclaimName ResourceAttributeConfig `mapstructure:"k8s.persistentvolumeclaim.name"`
 	K8sPodName                   ResourceAttributeConfig `mapstructure:"k8s.pod.name"`
 	K8sPodUID                    ResourceAttributeConfig `mapstructure:"k8s.pod.uid"`
+	K8sStorageclassName          ResourceAttributeConfig `mapstructure:"k8s.storageclass.name"`
 	K8sVolumeName                ResourceAttributeConfig `mapstructure:"k8s.volume.name"`
 	K8sVolumeType                ResourceAttributeConfig `mapstructure:"k8s.volume.type"`
 	Partition                    ResourceAttributeConfig `mapstructure:"partition"`
@@ -346,6 +347,9 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sPodUID: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sStorageclassName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 		K8sVolumeName: ResourceAttributeConfig{
 			Enabled: true,
 		},
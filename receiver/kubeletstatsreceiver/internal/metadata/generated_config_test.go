@@ -96,6 +96,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPersistentvolumeclaimName: ResourceAttributeConfig{Enabled: true},
 					K8sPodName:                   ResourceAttributeConfig{Enabled: true},
 					K8sPodUID:                    ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassName:          ResourceAttributeConfig{Enabled: true},
 					K8sVolumeName:                ResourceAttributeConfig{Enabled: true},
 					K8sVolumeType:                ResourceAttributeConfig{Enabled: true},
 					Partition:                    ResourceAttributeConfig{Enabled: true},
@@ -177,6 +178,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sPersistentvolumeclaimName: ResourceAttributeConfig{Enabled: false},
 					K8sPodName:                   ResourceAttributeConfig{Enabled: false},
 					K8sPodUID:                    ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassName:          ResourceAttributeConfig{Enabled: false},
 					K8sVolumeName:                ResourceAttributeConfig{Enabled: false},
 					K8sVolumeType:                ResourceAttributeConfig{Enabled: false},
 					Partition:                    ResourceAttributeConfig{Enabled: false},
@@ -228,6 +230,7 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sPersistentvolumeclaimName: ResourceAttributeConfig{Enabled: true},
 				K8sPodName:                   ResourceAttributeConfig{Enabled: true},
 				K8sPodUID:                    ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassName:          ResourceAttributeConfig{Enabled: true},
 				K8sVolumeName:                ResourceAttributeConfig{Enabled: true},
 				K8sVolumeType:                ResourceAttributeConfig{Enabled: true},
 				Partition:                    ResourceAttributeConfig{Enabled: true},
@@ -248,6 +251,7 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sPersistentvolumeclaimName: ResourceAttributeConfig{Enabled: false},
 				K8sPodName:                   ResourceAttributeConfig{Enabled: false},
 				K8sPodUID:                    ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassName:          ResourceAttributeConfig{Enabled: false},
 				K8sVolumeName:                ResourceAttributeConfig{Enabled: false},
 				K8sVolumeType:                ResourceAttributeConfig{Enabled: false},
 				Partition:                    ResourceAttributeConfig{Enabled: false},
@@ -105,6 +105,13 @@ func (rb *ResourceBuilder) SetK8sPodUID(val string) {
 	}
 }
 
+// SetK8sStorageclassName sets provided value as "k8s.storageclass.name" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassName(val string) {
+	if rb.config.K8sStorageclassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.name", val)
+	}
+}
+
 // SetK8sVolumeName sets provided value as "k8s.volume.name" attribute.
 func (rb *ResourceBuilder) SetK8sVolumeName(val string) {
 	if rb.config.K8sVolumeName.Enabled {
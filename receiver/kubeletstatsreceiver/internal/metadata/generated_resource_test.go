@@ -25,6 +25,7 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
 			rb.SetK8sVolumeName("k8s.volume.name-val")
 			rb.SetK8sVolumeType("k8s.volume.type-val")
 			rb.SetPartition("partition-val")
@@ -34,9 +35,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch test {
 			case "default":
-				assert.Equal(t, 15, res.Attributes().Len())
+				assert.Equal(t, 16, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 15, res.Attributes().Len())
+				assert.Equal(t, 16, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -104,6 +105,11 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.EqualValues(t, "k8s.pod.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.storageclass.name")
+			assert.True(t, ok)
+			if ok {
+				assert.EqualValues(t, "k8s.storageclass.name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.volume.name")
 			assert.True(t, ok)
 			if ok {
@@ -46,6 +46,8 @@ var volumeClaim1 = getPVC("volume_claim_1", "kube-system", "storage-provisioner-
 var volumeClaim2 = getPVC("volume_claim_2", "kube-system", "kube-proxy")
 var volumeClaim3 = getPVC("volume_claim_3", "kube-system", "coredns-token-dzc5t")
 
+var storageClassName = "standard"
+
 func getPVC(claimName, namespace, volumeName string) *v1.PersistentVolumeClaim {
 	return &v1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
@@ -54,7 +56,8 @@ func getPVC(claimName, namespace, volumeName string) *v1.PersistentVolumeClaim {
 			UID:       types.UID(claimName),
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
-			VolumeName: volumeName,
+			VolumeName:       volumeName,
+			StorageClassName: &storageClassName,
 		},
 	}
 }
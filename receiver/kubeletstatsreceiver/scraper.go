@@ -40,6 +40,7 @@ type kubletScraper struct {
 	metricGroupsToCollect map[kubelet.MetricGroup]bool
 	k8sAPIClient          kubernetes.Interface
 	cachedVolumeSource    map[string]v1.PersistentVolumeSource
+	cachedStorageClass    map[string]string
 	mbs                   *metadata.MetricsBuilders
 	needsResources        bool
 	nodeInformer          cache.SharedInformer
@@ -65,6 +66,7 @@ func newKubletScraper(
 		metricGroupsToCollect: rOptions.metricGroupsToCollect,
 		k8sAPIClient:          rOptions.k8sAPIClient,
 		cachedVolumeSource:    make(map[string]v1.PersistentVolumeSource),
+		cachedStorageClass:    make(map[string]string),
 		mbs: &metadata.MetricsBuilders{
 			NodeMetricsBuilder:      metadata.NewMetricsBuilder(metricsConfig, set),
 			PodMetricsBuilder:       metadata.NewMetricsBuilder(metricsConfig, set),
@@ -152,8 +154,14 @@ func (r *kubletScraper) detailedPVCLabelsSetter() func(rb *metadata.ResourceBuil
 
 			// Cache collected source.
 			r.cachedVolumeSource[volCacheID] = pv.Spec.PersistentVolumeSource
+			if pvc.Spec.StorageClassName != nil {
+				r.cachedStorageClass[volCacheID] = *pvc.Spec.StorageClassName
+			}
 		}
 		kubelet.SetPersistentVolumeLabels(rb, r.cachedVolumeSource[volCacheID])
+		if storageClass, ok := r.cachedStorageClass[volCacheID]; ok {
+			rb.SetK8sStorageclassName(storageClass)
+		}
 		return nil
 	}
 }
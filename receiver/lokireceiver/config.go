@@ -5,6 +5,7 @@ package lokireceiver // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"errors"
+	"fmt"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -16,6 +17,10 @@ const (
 	// Protocol values.
 	protoGRPC = "protocols::grpc"
 	protoHTTP = "protocols::http"
+
+	// Mapping values.
+	mappingAttributes = "attributes"
+	mappingOTLP       = "otlp"
 )
 
 // Protocols is the configuration for the supported protocols.
@@ -29,6 +34,9 @@ type Config struct {
 	// Protocols is the configuration for the supported protocols, currently gRPC and HTTP (Proto and JSON).
 	Protocols     `mapstructure:"protocols"`
 	KeepTimestamp bool `mapstructure:"use_incoming_timestamp"`
+	// Mapping is the scheme used to map the labels of the streams and the structured metadata of their entries
+	// to logs: "attributes" maps both to log attributes, "otlp" reverts the mapping of OTLP logs ingested by Loki.
+	Mapping string `mapstructure:"mapping"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -39,6 +47,9 @@ func (cfg *Config) Validate() error {
 	if cfg.GRPC == nil && cfg.HTTP == nil {
 		return errors.New("must specify at least one protocol when using the Loki receiver")
 	}
+	if cfg.Mapping != mappingAttributes && cfg.Mapping != mappingOTLP {
+		return fmt.Errorf("mapping must be either %q or %q, got %q", mappingAttributes, mappingOTLP, cfg.Mapping)
+	}
 	return nil
 }
 
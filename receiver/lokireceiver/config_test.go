@@ -42,6 +42,7 @@ func TestLoadConfig(t *testing.T) {
 						Endpoint: "0.0.0.0:3500",
 					},
 				},
+				Mapping: mappingAttributes,
 			},
 		},
 		{
@@ -59,6 +60,18 @@ func TestLoadConfig(t *testing.T) {
 					},
 				},
 				KeepTimestamp: true,
+				Mapping:       mappingAttributes,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "otlp"),
+			expected: &Config{
+				Protocols: Protocols{
+					HTTP: &confighttp.ServerConfig{
+						Endpoint: "0.0.0.0:3500",
+					},
+				},
+				Mapping: mappingOTLP,
 			},
 		},
 	}
@@ -90,6 +103,10 @@ func TestInvalidConfig(t *testing.T) {
 			id:  component.NewIDWithName(metadata.Type, "empty"),
 			err: "must specify at least one protocol when using the Loki receiver",
 		},
+		{
+			id:  component.NewIDWithName(metadata.Type, "invalid_mapping"),
+			err: `mapping must be either "attributes" or "otlp", got "labels"`,
+		},
 	}
 
 	for _, tt := range tests {
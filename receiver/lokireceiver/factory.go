@@ -47,6 +47,7 @@ func createDefaultConfig() component.Config {
 				Endpoint: localhostgate.EndpointForPort(defaultHTTPPort),
 			},
 		},
+		Mapping: mappingAttributes,
 	}
 }
 
@@ -15,6 +15,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
 	"go.uber.org/zap"
@@ -153,7 +154,7 @@ func (r *lokiReceiver) startGRPCServer(ctx context.Context) error {
 }
 
 func (r *lokiReceiver) Push(ctx context.Context, pushRequest *push.PushRequest) (*push.PushResponse, error) {
-	logs, err := loki.PushRequestToLogs(pushRequest, r.conf.KeepTimestamp)
+	logs, err := r.pushRequestToLogs(pushRequest)
 	if err != nil {
 		r.settings.Logger.Warn(ErrAtLeastOneEntryFailedToProcess, zap.Error(err))
 		return &push.PushResponse{}, err
@@ -165,6 +166,13 @@ func (r *lokiReceiver) Push(ctx context.Context, pushRequest *push.PushRequest)
 	return &push.PushResponse{}, nil
 }
 
+func (r *lokiReceiver) pushRequestToLogs(pushRequest *push.PushRequest) (plog.Logs, error) {
+	if r.conf.Mapping == mappingOTLP {
+		return loki.PushRequestToOTLPLogs(pushRequest, r.conf.KeepTimestamp)
+	}
+	return loki.PushRequestToLogs(pushRequest, r.conf.KeepTimestamp)
+}
+
 func (r *lokiReceiver) Start(ctx context.Context, host component.Host) error {
 	return r.startProtocolsServers(ctx, host)
 }
@@ -208,7 +216,7 @@ func handleLogs(resp http.ResponseWriter, req *http.Request, r *lokiReceiver) {
 		return
 	}
 
-	logs, err := loki.PushRequestToLogs(pushRequest, r.conf.KeepTimestamp)
+	logs, err := r.pushRequestToLogs(pushRequest)
 	if err != nil {
 		r.settings.Logger.Warn(ErrAtLeastOneEntryFailedToProcess, zap.Error(err))
 		http.Error(resp, err.Error(), http.StatusBadRequest)
@@ -111,7 +111,7 @@ func startGRPCServer(t *testing.T) (*grpc.ClientConn, *consumertest.LogsSink) {
 	return conn, sink
 }
 
-func startHTTPServer(t *testing.T) (string, *consumertest.LogsSink) {
+func startHTTPServer(t *testing.T, mapping string) (string, *consumertest.LogsSink) {
 	addr := testutil.GetAvailableLocalAddress(t)
 	config := &Config{
 		Protocols: Protocols{
@@ -120,6 +120,7 @@ func startHTTPServer(t *testing.T) (string, *consumertest.LogsSink) {
 			},
 		},
 		KeepTimestamp: true,
+		Mapping:       mapping,
 	}
 	sink := new(consumertest.LogsSink)
 
@@ -173,7 +174,7 @@ func TestSendingProtobufPushRequestToHTTPEndpoint(t *testing.T) {
 	}
 
 	// Start http server
-	addr, sink := startHTTPServer(t)
+	addr, sink := startHTTPServer(t, mappingAttributes)
 
 	for i, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -269,6 +270,23 @@ func TestSendingPushRequestToHTTPEndpoint(t *testing.T) {
 			}),
 			err: nil,
 		},
+		{
+			name:            "Sending structured metadata contentType=application/json to http endpoint",
+			contentEncoding: "",
+			contentType:     jsonContentType,
+			body:            []byte(`{"streams": [{"stream": {"foo": "bar"},"values": [[ "1676888496000000000", "logline 1", {"user": "alice"} ]]}]}`),
+			expected: generateLogs([]Log{
+				{
+					Timestamp: 1676888496000000000,
+					Attributes: map[string]any{
+						"foo":  "bar",
+						"user": "alice",
+					},
+					Body: pcommon.NewValueStr("logline 1"),
+				},
+			}),
+			err: nil,
+		},
 		{
 			name:            "Sending contentEncoding=\"deflate\" contentType=application/json to http endpoint",
 			contentEncoding: "deflate",
@@ -295,7 +313,7 @@ func TestSendingPushRequestToHTTPEndpoint(t *testing.T) {
 	}
 
 	// Start http server
-	addr, sink := startHTTPServer(t)
+	addr, sink := startHTTPServer(t, mappingAttributes)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -311,6 +329,31 @@ func TestSendingPushRequestToHTTPEndpoint(t *testing.T) {
 	}
 }
 
+func TestSendingPushRequestWithOTLPMapping(t *testing.T) {
+	addr, sink := startHTTPServer(t, mappingOTLP)
+	_, port, _ := net.SplitHostPort(addr)
+	collectorAddr := fmt.Sprintf("http://localhost:%s/loki/api/v1/push", port)
+
+	body := []byte(`{"streams": [{"stream": {"service_name": "checkout"},"values": [[ "1676888496000000000", "order placed", {"scope_name": "checkout", "severity_text": "INFO", "severity_number": "9", "order_id": "42"} ]]}]}`)
+	require.NoError(t, sendToCollector(collectorAddr, jsonContentType, "", body))
+
+	expected := plog.NewLogs()
+	rl := expected.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service_name", "checkout")
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("checkout")
+	lr := sl.LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1676888496000000000))
+	lr.Body().SetStr("order placed")
+	lr.SetSeverityText("INFO")
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.Attributes().PutStr("order_id", "42")
+
+	gotLogs := sink.AllLogs()
+	require.Len(t, gotLogs, 1)
+	require.NoError(t, plogtest.CompareLogs(expected, gotLogs[0], plogtest.IgnoreObservedTimestamp()))
+}
+
 func TestSendingPushRequestToGRPCEndpoint(t *testing.T) {
 	// Start grpc server
 	conn, sink := startGRPCServer(t)
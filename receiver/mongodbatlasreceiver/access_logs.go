@@ -364,7 +364,7 @@ func accessLogsCheckpointKey(groupID string) string {
 }
 
 func (alr *accessLogsReceiver) checkpoint(ctx context.Context, groupID string) error {
-	marshalBytes, err := json.Marshal(alr.record)
+	marshalBytes, err := json.Marshal(alr.record[groupID])
 	if err != nil {
 		return fmt.Errorf("unable to write checkpoint: %w", err)
 	}
@@ -372,6 +372,11 @@ func (alr *accessLogsReceiver) checkpoint(ctx context.Context, groupID string) e
 }
 
 func (alr *accessLogsReceiver) loadCheckpoint(ctx context.Context, groupID string) {
+	if _, ok := alr.record[groupID]; ok {
+		// The checkpoint was already loaded, and is kept up to date in memory.
+		return
+	}
+
 	cBytes, err := alr.storageClient.Get(ctx, accessLogsCheckpointKey(groupID))
 	if err != nil {
 		alr.logger.Info("unable to load checkpoint from storage client, continuing without a previous checkpoint", zap.Error(err))
@@ -394,7 +399,9 @@ func (alr *accessLogsReceiver) loadCheckpoint(ctx context.Context, groupID strin
 		if _, ok := alr.record[groupID]; !ok {
 			alr.record[groupID] = []*accessLogStorageRecord{}
 		}
+		return
 	}
+	alr.record[groupID] = record
 }
 
 func (alr *accessLogsReceiver) getClusterCheckpoint(groupID, clusterName string) *accessLogStorageRecord {
@@ -414,6 +421,7 @@ func (alr *accessLogsReceiver) setClusterCheckpoint(groupID string, clusterCheck
 	groupCheckpoints, ok := alr.record[groupID]
 	if !ok {
 		alr.record[groupID] = []*accessLogStorageRecord{clusterCheckpoint}
+		return
 	}
 
 	var found bool
@@ -329,6 +329,61 @@ func TestCheckpointing(t *testing.T) {
 	require.Equal(t, expectedTime, clusterCheckpoint.NextPollStartTime)
 }
 
+func TestCheckpointRestore(t *testing.T) {
+	pc := &LogsProjectConfig{
+		ProjectConfig: ProjectConfig{
+			Name: testProjectName,
+		},
+		AccessLogs: &AccessLogsConfig{
+			PollInterval: 1 * time.Second,
+		},
+	}
+	config := &Config{
+		ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+		Granularity:      defaultGranularity,
+		BackOffConfig:    configretry.NewDefaultBackOffConfig(),
+		Logs: LogConfig{
+			Enabled:  true,
+			Projects: []*LogsProjectConfig{pc},
+		},
+	}
+	storageClient := newMemoryStorageClient()
+
+	rcvr := newAccessLogsReceiver(receivertest.NewNopCreateSettings(), config, &consumertest.LogsSink{})
+	rcvr.client = simpleAccessLogClient()
+	rcvr.storageClient = storageClient
+	require.NoError(t, rcvr.pollAccessLogs(context.Background(), pc))
+
+	// A new receiver resumes from the stored checkpoint.
+	restarted := newAccessLogsReceiver(receivertest.NewNopCreateSettings(), config, &consumertest.LogsSink{})
+	restarted.storageClient = storageClient
+	restarted.loadCheckpoint(context.Background(), testProjectID)
+	clusterCheckpoint := restarted.getClusterCheckpoint(testProjectID, testClusterName)
+	require.NotNil(t, clusterCheckpoint)
+	expectedTime, _ := time.Parse(time.RFC3339, "2023-04-26T02:38:56.544+00:00")
+	require.True(t, expectedTime.Equal(clusterCheckpoint.NextPollStartTime))
+	require.Len(t, restarted.record[testProjectID], 1)
+}
+
+// memoryStorageClient is a storage.Client keeping the values in memory.
+type memoryStorageClient struct {
+	storage.Client
+	values map[string][]byte
+}
+
+func newMemoryStorageClient() *memoryStorageClient {
+	return &memoryStorageClient{Client: storage.NewNopClient(), values: map[string][]byte{}}
+}
+
+func (m *memoryStorageClient) Get(_ context.Context, key string) ([]byte, error) {
+	return m.values[key], nil
+}
+
+func (m *memoryStorageClient) Set(_ context.Context, key string, value []byte) error {
+	m.values[key] = value
+	return nil
+}
+
 func testClientBase() *mockAccessLogsClient {
 	ac := &mockAccessLogsClient{}
 	ac.On("GetProject", mock.Anything, mock.Anything).Return(&mongodbatlas.Project{
@@ -55,6 +55,7 @@ const (
 
 type alertsClient interface {
 	GetProject(ctx context.Context, groupID string) (*mongodbatlas.Project, error)
+	Projects(ctx context.Context, orgID string) ([]*mongodbatlas.Project, error)
 	GetAlerts(ctx context.Context, groupID string, opts *internal.AlertPollOptions) ([]mongodbatlas.Alert, bool, error)
 }
 
@@ -69,6 +70,7 @@ type alertsReceiver struct {
 
 	// only relevant in `poll` mode
 	projects          []*ProjectConfig
+	organizations     []*OrgConfig
 	client            alertsClient
 	privateKey        string
 	publicKey         string
@@ -106,6 +108,7 @@ func newAlertsReceiver(params rcvr.CreateSettings, baseConfig *Config, consumer
 		consumer:          consumer,
 		mode:              cfg.Mode,
 		projects:          cfg.Projects,
+		organizations:     cfg.Organizations,
 		backoffConfig:     baseConfig.BackOffConfig,
 		publicKey:         baseConfig.PublicKey,
 		privateKey:        string(baseConfig.PrivateKey),
@@ -167,46 +170,81 @@ func (a *alertsReceiver) startPolling(ctx context.Context, storageClient storage
 }
 
 func (a *alertsReceiver) retrieveAndProcessAlerts(ctx context.Context) error {
+	polled := map[string]bool{}
 	for _, p := range a.projects {
 		project, err := a.client.GetProject(ctx, p.Name)
 		if err != nil {
 			a.telemetrySettings.Logger.Error("error retrieving project "+p.Name+":", zap.Error(err))
 			continue
 		}
+		polled[project.ID] = true
 		a.pollAndProcess(ctx, p, project)
 	}
+
+	// The alerts of the projects of the organizations which are not configured explicitly are all retrieved.
+	for _, o := range a.organizations {
+		projects, err := a.client.Projects(ctx, o.ID)
+		if err != nil {
+			a.telemetrySettings.Logger.Error("error retrieving projects of organization "+o.ID+":", zap.Error(err))
+			continue
+		}
+		for _, project := range projects {
+			if polled[project.ID] {
+				continue
+			}
+			polled[project.ID] = true
+			pc := &ProjectConfig{Name: project.Name}
+			pc.populateIncludesAndExcludes()
+			a.pollAndProcess(ctx, pc, project)
+		}
+	}
 	return a.writeCheckpoint(ctx)
 }
 
 func (a *alertsReceiver) pollAndProcess(ctx context.Context, pc *ProjectConfig, project *mongodbatlas.Project) {
+	lastRecorded := a.record.lastRecorded(project.ID)
+	// The checkpoint is only advanced once all the pages were processed, as the pages are not ordered by update time.
+	latest := lastRecorded
+	// Alerts may move across pages while paginating, so the alerts already processed in this poll are skipped.
+	seen := map[string]bool{}
+	complete := false
 	for pageNum := 1; pageNum <= int(a.maxPages); pageNum++ {
 		projectAlerts, hasNext, err := a.client.GetAlerts(ctx, project.ID, &internal.AlertPollOptions{
 			PageNum:  pageNum,
 			PageSize: int(a.pageSize),
 		})
 		if err != nil {
-			a.telemetrySettings.Logger.Error("unable to get alerts for project", zap.Error(err))
-			break
+			a.telemetrySettings.Logger.Error("unable to get alerts for project", zap.Error(err), zap.String("project", project.Name))
+			return
 		}
 
-		filteredAlerts := a.applyFilters(pc, projectAlerts)
+		filteredAlerts, latestInPage := a.applyFilters(pc, projectAlerts, lastRecorded, seen)
+		if latestInPage.After(latest) {
+			latest = latestInPage
+		}
 		now := pcommon.NewTimestampFromTime(time.Now())
 		logs, err := a.convertAlerts(now, filteredAlerts, project)
 		if err != nil {
 			a.telemetrySettings.Logger.Error("error processing alerts", zap.Error(err))
-			break
+			return
 		}
 
 		if logs.LogRecordCount() > 0 {
 			if err = a.consumer.ConsumeLogs(ctx, logs); err != nil {
 				a.telemetrySettings.Logger.Error("error consuming alerts", zap.Error(err))
-				break
+				return
 			}
 		}
 		if !hasNext {
+			complete = true
 			break
 		}
 	}
+	if !complete {
+		a.telemetrySettings.Logger.Warn("reached maximum number of pages of alerts, increase 'max_pages' or "+
+			"frequency of 'poll_interval' to ensure all alerts are retrieved", zap.Int64("maxPages", a.maxPages), zap.String("project", project.Name))
+	}
+	a.record.setLastRecorded(project.ID, latest)
 }
 
 func (a *alertsReceiver) startListening(ctx context.Context) error {
@@ -346,6 +384,7 @@ func (a *alertsReceiver) convertAlerts(now pcommon.Timestamp, alerts []mongodbat
 		resourceAttrs.PutStr("mongodbatlas.group.id", alert.GroupID)
 		resourceAttrs.PutStr("mongodbatlas.alert.config.id", alert.AlertConfigID)
 		resourceAttrs.PutStr("mongodbatlas.org.id", project.OrgID)
+		resourceAttrs.PutStr("mongodbatlas.project.id", project.ID)
 		resourceAttrs.PutStr("mongodbatlas.project.name", project.Name)
 		putStringToMapNotNil(resourceAttrs, "mongodbatlas.cluster.name", &alert.ClusterName)
 		putStringToMapNotNil(resourceAttrs, "mongodbatlas.replica_set.name", &alert.ReplicaSetName)
@@ -507,22 +546,41 @@ func payloadToLogs(now time.Time, payload []byte) (plog.Logs, error) {
 // can have custom marshaling
 type alertRecord struct {
 	sync.Mutex
+	// LastRecordedTime is the update time of the latest alert processed before the checkpoints were kept per project,
+	// it is only used for the projects without a checkpoint.
 	LastRecordedTime *time.Time `mapstructure:"last_recorded"`
+	// Projects are the update times of the latest alert processed, by project ID.
+	Projects map[string]time.Time `mapstructure:"projects"`
 }
 
-func (a *alertRecord) SetLastRecorded(lastUpdated *time.Time) {
+func (a *alertRecord) lastRecorded(projectID string) time.Time {
 	a.Lock()
-	a.LastRecordedTime = lastUpdated
-	a.Unlock()
+	defer a.Unlock()
+	if t, ok := a.Projects[projectID]; ok {
+		return t
+	}
+	if a.LastRecordedTime != nil {
+		return *a.LastRecordedTime
+	}
+	return pcommon.Timestamp(0).AsTime()
+}
+
+func (a *alertRecord) setLastRecorded(projectID string, lastUpdated time.Time) {
+	a.Lock()
+	defer a.Unlock()
+	if a.Projects == nil {
+		a.Projects = map[string]time.Time{}
+	}
+	a.Projects[projectID] = lastUpdated
 }
 
 func (a *alertsReceiver) syncPersistence(ctx context.Context) error {
+	a.record = &alertRecord{}
 	if a.storageClient == nil {
 		return nil
 	}
 	cBytes, err := a.storageClient.Get(ctx, alertCacheKey)
 	if err != nil || cBytes == nil {
-		a.record = &alertRecord{}
 		return nil
 	}
 
@@ -539,21 +597,19 @@ func (a *alertsReceiver) writeCheckpoint(ctx context.Context) error {
 		a.telemetrySettings.Logger.Error("unable to write checkpoint since no storage client was found")
 		return errors.New("missing non-nil storage client")
 	}
+	a.record.Lock()
 	marshalBytes, err := json.Marshal(&a.record)
+	a.record.Unlock()
 	if err != nil {
 		return fmt.Errorf("unable to write checkpoint: %w", err)
 	}
 	return a.storageClient.Set(ctx, alertCacheKey, marshalBytes)
 }
 
-func (a *alertsReceiver) applyFilters(pConf *ProjectConfig, alerts []mongodbatlas.Alert) []mongodbatlas.Alert {
+// applyFilters returns the alerts updated after lastRecordedTime which weren't seen yet and match the
+// cluster filters of the project, and the latest update time of these alerts.
+func (a *alertsReceiver) applyFilters(pConf *ProjectConfig, alerts []mongodbatlas.Alert, lastRecordedTime time.Time, seen map[string]bool) ([]mongodbatlas.Alert, time.Time) {
 	filtered := []mongodbatlas.Alert{}
-
-	var lastRecordedTime = pcommon.Timestamp(0).AsTime()
-	if a.record.LastRecordedTime != nil {
-		lastRecordedTime = *a.record.LastRecordedTime
-	}
-	// we need to maintain two timestamps in order to not conflict while iterating
 	var latestInPayload = pcommon.Timestamp(0).AsTime()
 
 	for _, alert := range alerts {
@@ -568,6 +624,12 @@ func (a *alertsReceiver) applyFilters(pConf *ProjectConfig, alerts []mongodbatla
 			continue
 		}
 
+		seenKey := alert.ID + "/" + alert.Updated
+		if seen[seenKey] {
+			continue
+		}
+		seen[seenKey] = true
+
 		if len(pConf.excludesByClusterName) > 0 {
 			if _, ok := pConf.excludesByClusterName[alert.ClusterName]; ok {
 				continue
@@ -586,11 +648,7 @@ func (a *alertsReceiver) applyFilters(pConf *ProjectConfig, alerts []mongodbatla
 		}
 	}
 
-	if latestInPayload.After(lastRecordedTime) {
-		a.record.SetLastRecorded(&latestInPayload)
-	}
-
-	return filtered
+	return filtered, latestInPayload
 }
 
 func timestampFromAlert(a model.Alert) pcommon.Timestamp {
@@ -6,6 +6,7 @@ package mongodbatlasreceiver
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -708,3 +709,114 @@ func (mac *mockAlertsClient) GetAlerts(ctx context.Context, pID string, opts *in
 	args := mac.Called(ctx, pID, opts)
 	return args.Get(0).([]mongodbatlas.Alert), args.Bool(1), args.Error(2)
 }
+
+func (mac *mockAlertsClient) Projects(ctx context.Context, orgID string) ([]*mongodbatlas.Project, error) {
+	args := mac.Called(ctx, orgID)
+	return args.Get(0).([]*mongodbatlas.Project), args.Error(1)
+}
+
+func pageNum(n int) any {
+	return mock.MatchedBy(func(opts *internal.AlertPollOptions) bool { return opts.PageNum == n })
+}
+
+func TestAlertsOrganizationPolling(t *testing.T) {
+	otherProjectID := "other-project-id"
+	ac := testClient()
+	ac.On("Projects", mock.Anything, testOrgID).Return([]*mongodbatlas.Project{
+		{ID: testProjectID, OrgID: testOrgID, Name: testProjectName},
+		{ID: otherProjectID, OrgID: testOrgID, Name: "other-project"},
+	}, nil)
+	otherAlert := testAlert()
+	otherAlert.ID = "other-alert-id"
+	otherAlert.GroupID = otherProjectID
+	ac.On("GetAlerts", mock.Anything, otherProjectID, mock.Anything).Return([]mongodbatlas.Alert{otherAlert}, false, nil)
+
+	logSink := &consumertest.LogsSink{}
+	alertsRcvr, err := newAlertsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Alerts: AlertConfig{
+			Enabled: true,
+			Mode:    alertModePoll,
+			Projects: []*ProjectConfig{
+				{
+					Name:            testProjectName,
+					ExcludeClusters: []string{testClusterName},
+				},
+			},
+			Organizations: []*OrgConfig{{ID: testOrgID}},
+			PageSize:      defaultAlertsPageSize,
+			MaxPages:      defaultAlertsMaxPages,
+			PollInterval:  time.Second,
+		},
+	}, logSink)
+	require.NoError(t, err)
+	alertsRcvr.client = ac
+	alertsRcvr.storageClient = storage.NewNopClient()
+	require.NoError(t, alertsRcvr.syncPersistence(context.Background()))
+
+	require.NoError(t, alertsRcvr.retrieveAndProcessAlerts(context.Background()))
+
+	// The explicitly configured project keeps its filters, and is only polled once.
+	ac.AssertNumberOfCalls(t, "GetAlerts", 2)
+	require.Equal(t, 1, logSink.LogRecordCount())
+	ra := logSink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().AsRaw()
+	require.Equal(t, otherProjectID, ra["mongodbatlas.project.id"])
+	require.Equal(t, "other-project", ra["mongodbatlas.project.name"])
+	require.Equal(t, testOrgID, ra["mongodbatlas.org.id"])
+}
+
+func TestAlertsPollingCheckpoints(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	newer, older := testAlert(), testAlert()
+	newer.ID, newer.Updated = "newer", now.Format(time.RFC3339)
+	older.ID, older.Updated = "older", now.Add(-time.Minute).Format(time.RFC3339)
+
+	otherProjectID := "other-project-id"
+	otherAlert := testAlert()
+	otherAlert.ID, otherAlert.Updated = "other", now.Add(-time.Hour).Format(time.RFC3339)
+
+	ac := &mockAlertsClient{}
+	ac.On("GetProject", mock.Anything, testProjectName).Return(&mongodbatlas.Project{ID: testProjectID, Name: testProjectName}, nil)
+	ac.On("GetProject", mock.Anything, "other-project").Return(&mongodbatlas.Project{ID: otherProjectID, Name: "other-project"}, nil)
+	// The newer alert moves to the second page while paginating, and the older one is on the second page.
+	ac.On("GetAlerts", mock.Anything, testProjectID, pageNum(1)).Return([]mongodbatlas.Alert{newer}, true, nil)
+	ac.On("GetAlerts", mock.Anything, testProjectID, pageNum(2)).Return([]mongodbatlas.Alert{newer, older}, false, nil)
+	ac.On("GetAlerts", mock.Anything, otherProjectID, mock.Anything).Return([]mongodbatlas.Alert{otherAlert}, false, nil)
+
+	logSink := &consumertest.LogsSink{}
+	alertsRcvr, err := newAlertsReceiver(receivertest.NewNopCreateSettings(), &Config{
+		Alerts: AlertConfig{
+			Enabled:      true,
+			Mode:         alertModePoll,
+			Projects:     []*ProjectConfig{{Name: testProjectName}, {Name: "other-project"}},
+			PageSize:     defaultAlertsPageSize,
+			MaxPages:     defaultAlertsMaxPages,
+			PollInterval: time.Second,
+		},
+	}, logSink)
+	require.NoError(t, err)
+	alertsRcvr.client = ac
+	alertsRcvr.storageClient = storage.NewNopClient()
+	require.NoError(t, alertsRcvr.syncPersistence(context.Background()))
+
+	require.NoError(t, alertsRcvr.retrieveAndProcessAlerts(context.Background()))
+	// The alerts of the second page and of the other project are not filtered by the newer alert of the first page.
+	require.Equal(t, 3, logSink.LogRecordCount())
+	require.Equal(t, now, alertsRcvr.record.lastRecorded(testProjectID))
+	require.Equal(t, now.Add(-time.Hour), alertsRcvr.record.lastRecorded(otherProjectID))
+
+	// Alerts are only emitted once.
+	require.NoError(t, alertsRcvr.retrieveAndProcessAlerts(context.Background()))
+	require.Equal(t, 3, logSink.LogRecordCount())
+}
+
+func TestAlertRecordLegacyCheckpoint(t *testing.T) {
+	lastRecorded := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	record := &alertRecord{}
+	require.NoError(t, json.Unmarshal([]byte(`{"LastRecordedTime":"2024-06-01T12:00:00Z"}`), record))
+
+	// Projects without a checkpoint of their own use the checkpoint shared by all projects.
+	require.Equal(t, lastRecorded, record.lastRecorded(testProjectID))
+	record.setLastRecorded(testProjectID, lastRecorded.Add(time.Hour))
+	require.Equal(t, lastRecorded.Add(time.Hour), record.lastRecorded(testProjectID))
+	require.Equal(t, lastRecorded, record.lastRecorded("other-project-id"))
+}
@@ -39,7 +39,7 @@ func (c *combinedLogsReceiver) Start(ctx context.Context, host component.Host) e
 	}
 
 	if c.logs != nil {
-		if err := c.logs.Start(ctx, host); err != nil {
+		if err := c.logs.Start(ctx, host, storageClient); err != nil {
 			errs = multierr.Append(errs, err)
 		}
 	}
@@ -44,10 +44,11 @@ type AlertConfig struct {
 	Mode     string                  `mapstructure:"mode"`
 
 	// these parameters are only relevant in retrieval mode
-	Projects     []*ProjectConfig `mapstructure:"projects"`
-	PollInterval time.Duration    `mapstructure:"poll_interval"`
-	PageSize     int64            `mapstructure:"page_size"`
-	MaxPages     int64            `mapstructure:"max_pages"`
+	Projects      []*ProjectConfig `mapstructure:"projects"`
+	Organizations []*OrgConfig     `mapstructure:"organizations"`
+	PollInterval  time.Duration    `mapstructure:"poll_interval"`
+	PageSize      int64            `mapstructure:"page_size"`
+	MaxPages      int64            `mapstructure:"max_pages"`
 }
 
 type LogConfig struct {
@@ -124,6 +125,7 @@ var (
 
 	// Logs Receiver Errors
 	errNoProjects    = errors.New("at least one 'project' must be specified")
+	errNoOrgID       = errors.New("an 'id' must be specified for each organization")
 	errNoEvents      = errors.New("at least one 'project' or 'organizations' event type must be specified")
 	errClusterConfig = errors.New("only one of 'include_clusters' or 'exclude_clusters' may be specified")
 
@@ -191,7 +193,7 @@ func (a *AlertConfig) validate() error {
 }
 
 func (a AlertConfig) validatePollConfig() error {
-	if len(a.Projects) == 0 {
+	if len(a.Projects) == 0 && len(a.Organizations) == 0 {
 		return errNoProjects
 	}
 
@@ -206,6 +208,11 @@ func (a AlertConfig) validatePollConfig() error {
 			errs = multierr.Append(errs, errClusterConfig)
 		}
 	}
+	for _, org := range a.Organizations {
+		if org.ID == "" {
+			errs = multierr.Append(errs, errNoOrgID)
+		}
+	}
 
 	return errs
 }
@@ -236,6 +236,31 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: errNoProjects.Error(),
 		},
+		{
+			name: "Valid Alerts Poll Organizations",
+			input: Config{
+				Alerts: AlertConfig{
+					Enabled:       true,
+					Mode:          alertModePoll,
+					Organizations: []*OrgConfig{{ID: "5b478b3afc4625789ce616a3"}},
+					PageSize:      defaultAlertsPageSize,
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+		},
+		{
+			name: "Invalid Alerts Poll Organization Without ID",
+			input: Config{
+				Alerts: AlertConfig{
+					Enabled:       true,
+					Mode:          alertModePoll,
+					Organizations: []*OrgConfig{{}},
+					PageSize:      defaultAlertsPageSize,
+				},
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+			},
+			expectedErr: errNoOrgID.Error(),
+		},
 		{
 			name: "Valid Alerts Config",
 			input: Config{
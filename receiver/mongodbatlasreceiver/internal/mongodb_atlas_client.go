@@ -9,122 +9,24 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"sync"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/mongodb-forks/digest"
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/poller"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/metadata"
 )
 
-type clientRoundTripper struct {
-	originalTransport http.RoundTripper
-	log               *zap.Logger
-	backoffConfig     configretry.BackOffConfig
-	stopped           bool
-	mutex             sync.Mutex
-	shutdownChan      chan struct{}
-}
-
-func newClientRoundTripper(
-	originalTransport http.RoundTripper,
-	log *zap.Logger,
-	backoffConfig configretry.BackOffConfig,
-) *clientRoundTripper {
-	return &clientRoundTripper{
-		originalTransport: originalTransport,
-		log:               log,
-		backoffConfig:     backoffConfig,
-		shutdownChan:      make(chan struct{}, 1),
-	}
-}
-
-func (rt *clientRoundTripper) isStopped() bool {
-	rt.mutex.Lock()
-	defer rt.mutex.Unlock()
-
-	return rt.stopped
-}
-
-func (rt *clientRoundTripper) stop() {
-	rt.mutex.Lock()
-	defer rt.mutex.Unlock()
-
-	rt.stopped = true
-}
-
-func (rt *clientRoundTripper) Shutdown() error {
-	if rt.isStopped() {
-		return nil
-	}
-
-	rt.stop()
-	rt.shutdownChan <- struct{}{}
-	close(rt.shutdownChan)
-	return nil
-}
-
-func (rt *clientRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
-	if rt.isStopped() {
-		return nil, fmt.Errorf("request cancelled due to shutdown")
-	}
-
-	resp, err := rt.originalTransport.RoundTrip(r)
-	if err != nil {
-		return nil, err // Can't do anything
-	}
-	if resp.StatusCode == 429 {
-		expBackoff := &backoff.ExponentialBackOff{
-			InitialInterval:     rt.backoffConfig.InitialInterval,
-			RandomizationFactor: backoff.DefaultRandomizationFactor,
-			Multiplier:          backoff.DefaultMultiplier,
-			MaxInterval:         rt.backoffConfig.MaxInterval,
-			MaxElapsedTime:      rt.backoffConfig.MaxElapsedTime,
-			Stop:                backoff.Stop,
-			Clock:               backoff.SystemClock,
-		}
-		expBackoff.Reset()
-		attempts := 0
-		for {
-			attempts++
-			delay := expBackoff.NextBackOff()
-			if delay == backoff.Stop {
-				return resp, err
-			}
-			rt.log.Warn("server busy, retrying request",
-				zap.Int("attempts", attempts),
-				zap.Duration("delay", delay))
-			select {
-			case <-r.Context().Done():
-				return resp, fmt.Errorf("request was cancelled or timed out")
-			case <-rt.shutdownChan:
-				return resp, fmt.Errorf("request is cancelled due to server shutdown")
-			case <-time.After(delay):
-			}
-
-			resp, err = rt.originalTransport.RoundTrip(r)
-			if err != nil {
-				return nil, err
-			}
-			if resp.StatusCode != 429 {
-				break
-			}
-		}
-	}
-	return resp, err
-}
-
 // MongoDBAtlasClient wraps the official MongoDB Atlas client to manage pagination
 // and mapping to OpenTelmetry metric and log structures.
 type MongoDBAtlasClient struct {
 	log          *zap.Logger
 	client       *mongodbatlas.Client
 	transport    *http.Transport
-	roundTripper *clientRoundTripper
+	roundTripper *poller.RetryTransport
 }
 
 // NewMongoDBAtlasClient creates a new MongoDB Atlas client wrapper
@@ -136,7 +38,7 @@ func NewMongoDBAtlasClient(
 ) *MongoDBAtlasClient {
 	defaultTransporter := &http.Transport{}
 	t := digest.NewTransportWithHTTPTransport(publicKey, privateKey, defaultTransporter)
-	roundTripper := newClientRoundTripper(t, log, backoffConfig)
+	roundTripper := poller.NewRetryTransport(t, backoffConfig, log)
 	tc := &http.Client{Transport: roundTripper}
 	client := mongodbatlas.NewClient(tc)
 	return &MongoDBAtlasClient{
@@ -175,20 +77,15 @@ func hasNext(links []*mongodbatlas.Link) bool {
 // Organizations returns a list of all organizations available with the supplied credentials
 func (s *MongoDBAtlasClient) Organizations(ctx context.Context) ([]*mongodbatlas.Organization, error) {
 	var allOrgs []*mongodbatlas.Organization
-	page := 1
-
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, page int) (int, bool, error) {
 		orgs, hasNext, err := s.getOrganizationsPage(ctx, page)
-		page++
-		if err != nil {
-			// TODO: Add error to a metric
-			// Stop, returning what we have (probably empty slice)
-			return allOrgs, fmt.Errorf("error retrieving organizations from MongoDB Atlas API: %w", err)
-		}
 		allOrgs = append(allOrgs, orgs...)
-		if !hasNext {
-			break
-		}
+		return page + 1, hasNext, err
+	})
+	if err != nil {
+		// TODO: Add error to a metric
+		// Stop, returning what we have (probably empty slice)
+		return allOrgs, fmt.Errorf("error retrieving organizations from MongoDB Atlas API: %w", err)
 	}
 	return allOrgs, nil
 }
@@ -226,18 +123,13 @@ func (s *MongoDBAtlasClient) Projects(
 	orgID string,
 ) ([]*mongodbatlas.Project, error) {
 	var allProjects []*mongodbatlas.Project
-	page := 1
-
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, page int) (int, bool, error) {
 		projects, hasNext, err := s.getProjectsPage(ctx, orgID, page)
-		page++
-		if err != nil {
-			return allProjects, fmt.Errorf("error retrieving list of projects from MongoDB Atlas API: %w", err)
-		}
 		allProjects = append(allProjects, projects...)
-		if !hasNext {
-			break
-		}
+		return page + 1, hasNext, err
+	})
+	if err != nil {
+		return allProjects, fmt.Errorf("error retrieving list of projects from MongoDB Atlas API: %w", err)
 	}
 	return allProjects, nil
 }
@@ -328,19 +220,12 @@ func (s *MongoDBAtlasClient) ProcessDatabases(
 	port int,
 ) ([]*mongodbatlas.ProcessDatabase, error) {
 	var allProcessDatabases []*mongodbatlas.ProcessDatabase
-	pageNum := 1
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, pageNum int) (int, bool, error) {
 		processes, hasMore, err := s.getProcessDatabasesPage(ctx, projectID, host, port, pageNum)
-		pageNum++
-		if err != nil {
-			return allProcessDatabases, err
-		}
 		allProcessDatabases = append(allProcessDatabases, processes...)
-		if !hasMore {
-			break
-		}
-	}
-	return allProcessDatabases, nil
+		return pageNum + 1, hasMore, err
+	})
+	return allProcessDatabases, err
 }
 
 // ProcessMetrics returns a set of metrics associated with the specified running process.
@@ -355,8 +240,7 @@ func (s *MongoDBAtlasClient) ProcessMetrics(
 	resolution string,
 ) error {
 	var allMeasurements []*mongodbatlas.Measurements
-	pageNum := 1
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, pageNum int) (int, bool, error) {
 		measurements, hasMore, err := s.getProcessMeasurementsPage(
 			ctx,
 			projectID,
@@ -367,15 +251,12 @@ func (s *MongoDBAtlasClient) ProcessMetrics(
 			end,
 			resolution,
 		)
-		if err != nil {
-			s.log.Debug("Error retrieving process metrics from MongoDB Atlas API", zap.Error(err))
-			break // Return partial results
-		}
-		pageNum++
 		allMeasurements = append(allMeasurements, measurements...)
-		if !hasMore {
-			break
-		}
+		return pageNum + 1, hasMore, err
+	})
+	if err != nil {
+		// Return partial results
+		s.log.Debug("Error retrieving process metrics from MongoDB Atlas API", zap.Error(err))
 	}
 	return processMeasurements(mb, allMeasurements)
 }
@@ -422,8 +303,7 @@ func (s *MongoDBAtlasClient) ProcessDatabaseMetrics(
 	resolution string,
 ) error {
 	var allMeasurements []*mongodbatlas.Measurements
-	pageNum := 1
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, pageNum int) (int, bool, error) {
 		measurements, hasMore, err := s.getProcessDatabaseMeasurementsPage(
 			ctx,
 			projectID,
@@ -435,14 +315,11 @@ func (s *MongoDBAtlasClient) ProcessDatabaseMetrics(
 			end,
 			resolution,
 		)
-		if err != nil {
-			return err
-		}
-		pageNum++
 		allMeasurements = append(allMeasurements, measurements...)
-		if !hasMore {
-			break
-		}
+		return pageNum + 1, hasMore, err
+	})
+	if err != nil {
+		return err
 	}
 	return processMeasurements(mb, allMeasurements)
 }
@@ -486,18 +363,14 @@ func (s *MongoDBAtlasClient) ProcessDisks(
 	port int,
 ) []*mongodbatlas.ProcessDisk {
 	var allDisks []*mongodbatlas.ProcessDisk
-	pageNum := 1
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, pageNum int) (int, bool, error) {
 		disks, hasMore, err := s.getProcessDisksPage(ctx, projectID, host, port, pageNum)
-		if err != nil {
-			s.log.Debug("Error retrieving disk metrics from MongoDB Atlas API", zap.Error(err))
-			break // Return partial results
-		}
-		pageNum++
 		allDisks = append(allDisks, disks...)
-		if !hasMore {
-			break
-		}
+		return pageNum + 1, hasMore, err
+	})
+	if err != nil {
+		// Return partial results
+		s.log.Debug("Error retrieving disk metrics from MongoDB Atlas API", zap.Error(err))
 	}
 	return allDisks
 }
@@ -536,8 +409,7 @@ func (s *MongoDBAtlasClient) ProcessDiskMetrics(
 	resolution string,
 ) error {
 	var allMeasurements []*mongodbatlas.Measurements
-	pageNum := 1
-	for {
+	err := poller.Paginate(ctx, 1, 0, func(ctx context.Context, pageNum int) (int, bool, error) {
 		measurements, hasMore, err := s.processDiskMeasurementsPage(
 			ctx,
 			projectID,
@@ -549,14 +421,11 @@ func (s *MongoDBAtlasClient) ProcessDiskMetrics(
 			end,
 			resolution,
 		)
-		if err != nil {
-			return err
-		}
-		pageNum++
 		allMeasurements = append(allMeasurements, measurements...)
-		if !hasMore {
-			break
-		}
+		return pageNum + 1, hasMore, err
+	})
+	if err != nil {
+		return err
 	}
 	return processMeasurements(mb, allMeasurements)
 }
@@ -4,8 +4,11 @@
 package mongodbatlasreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver"
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"strings"
@@ -15,6 +18,7 @@ import (
 	"go.mongodb.org/atlas/mongodbatlas"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
 	rcvr "go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
@@ -22,17 +26,32 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbatlasreceiver/internal/model"
 )
 
-const mongoDBMajorVersion4_2 = "4.2"
+const (
+	mongoDBMajorVersion4_2 = "4.2"
+	logsStorageKey         = "last_endtime_logs_%s"
+)
+
+type logsClient interface {
+	GetProject(ctx context.Context, groupID string) (*mongodbatlas.Project, error)
+	GetOrganization(ctx context.Context, orgID string) (*mongodbatlas.Organization, error)
+	GetClusters(ctx context.Context, groupID string) ([]mongodbatlas.Cluster, error)
+	GetLogs(ctx context.Context, groupID, hostname, logName string, start, end time.Time) (*bytes.Buffer, error)
+	Shutdown() error
+}
 
 type logsReceiver struct {
-	log         *zap.Logger
-	cfg         *Config
-	client      *internal.MongoDBAtlasClient
-	consumer    consumer.Logs
-	stopperChan chan struct{}
-	wg          sync.WaitGroup
-	start       time.Time
-	end         time.Time
+	log           *zap.Logger
+	cfg           *Config
+	client        logsClient
+	consumer      consumer.Logs
+	storageClient storage.Client
+	stopperChan   chan struct{}
+	wg            sync.WaitGroup
+	start         time.Time
+	end           time.Time
+
+	// record is the start time of the next download of each log file, by project ID and log file key.
+	record map[string]map[string]time.Time
 }
 
 type ProjectContext struct {
@@ -51,16 +70,19 @@ func newMongoDBAtlasLogsReceiver(settings rcvr.CreateSettings, cfg *Config, cons
 	}
 
 	return &logsReceiver{
-		log:         settings.Logger,
-		cfg:         cfg,
-		client:      client,
-		stopperChan: make(chan struct{}),
-		consumer:    consumer,
+		log:           settings.Logger,
+		cfg:           cfg,
+		client:        client,
+		stopperChan:   make(chan struct{}),
+		consumer:      consumer,
+		storageClient: storage.NewNopClient(),
+		record:        map[string]map[string]time.Time{},
 	}
 }
 
 // Log receiver logic
-func (s *logsReceiver) Start(ctx context.Context, _ component.Host) error {
+func (s *logsReceiver) Start(ctx context.Context, _ component.Host, storageClient storage.Client) error {
+	s.storageClient = storageClient
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -119,6 +141,7 @@ func (s *logsReceiver) collect(ctx context.Context) {
 			continue
 		}
 		pc := ProjectContext{Project: *project}
+		s.loadCheckpoint(ctx, project.ID)
 
 		org, err := s.client.GetOrganization(ctx, project.OrgID)
 		if err != nil {
@@ -135,6 +158,9 @@ func (s *logsReceiver) collect(ctx context.Context) {
 		}
 
 		s.collectClusterLogs(clusters, *projectCfg, pc)
+		if err = s.checkpoint(ctx, project.ID); err != nil {
+			s.log.Warn("Failed to write the logs checkpoint", zap.Error(err), zap.String("project", projectCfg.Name))
+		}
 	}
 }
 
@@ -214,9 +240,9 @@ func filterClusters(clusters []mongodbatlas.Cluster, projectCfg ProjectConfig) (
 	return filtered, nil
 }
 
-func (s *logsReceiver) getHostLogs(groupID, hostname, logName string, clusterMajorVersion string) ([]model.LogEntry, error) {
+func (s *logsReceiver) getHostLogs(groupID, hostname, logName string, clusterMajorVersion string, start time.Time) ([]model.LogEntry, error) {
 	// Get gzip bytes buffer from API
-	buf, err := s.client.GetLogs(context.Background(), groupID, hostname, logName, s.start, s.end)
+	buf, err := s.client.GetLogs(context.Background(), groupID, hostname, logName, start, s.end)
 	if err != nil {
 		return nil, err
 	}
@@ -224,9 +250,9 @@ func (s *logsReceiver) getHostLogs(groupID, hostname, logName string, clusterMaj
 	return decodeLogs(s.log, clusterMajorVersion, buf)
 }
 
-func (s *logsReceiver) getHostAuditLogs(groupID, hostname, logName string) ([]model.AuditLog, error) {
+func (s *logsReceiver) getHostAuditLogs(groupID, hostname, logName string, start time.Time) ([]model.AuditLog, error) {
 	// Get gzip bytes buffer from API
-	buf, err := s.client.GetLogs(context.Background(), groupID, hostname, logName, s.start, s.end)
+	buf, err := s.client.GetLogs(context.Background(), groupID, hostname, logName, start, s.end)
 	if err != nil {
 		return nil, err
 	}
@@ -235,14 +261,15 @@ func (s *logsReceiver) getHostAuditLogs(groupID, hostname, logName string) ([]mo
 }
 
 func (s *logsReceiver) collectLogs(pc ProjectContext, hostname, logName string, clusterInfo ClusterInfo) {
-	logs, err := s.getHostLogs(pc.Project.ID, hostname, logName, clusterInfo.MongoDBMajorVersion)
+	start := s.nextStartTime(pc.Project.ID, hostname, logName)
+	logs, err := s.getHostLogs(pc.Project.ID, hostname, logName, clusterInfo.MongoDBMajorVersion, start)
 	if err != nil && !errors.Is(err, io.EOF) {
-		s.log.Warn("Failed to retrieve host logs", zap.Error(err), zap.String("hostname", hostname), zap.String("log", logName), zap.Time("startTime", s.start), zap.Time("endTime", s.end))
+		s.log.Warn("Failed to retrieve host logs", zap.Error(err), zap.String("hostname", hostname), zap.String("log", logName), zap.Time("startTime", start), zap.Time("endTime", s.end))
 		return
 	}
 
 	if len(logs) == 0 {
-		s.log.Warn("Attempted to retrieve host logs but received 0 logs", zap.Error(err), zap.String("log", logName), zap.String("hostname", hostname), zap.Time("startTime", s.start), zap.Time("endTime", s.end))
+		s.log.Warn("Attempted to retrieve host logs but received 0 logs", zap.Error(err), zap.String("log", logName), zap.String("hostname", hostname), zap.Time("startTime", start), zap.Time("endTime", s.end))
 		return
 	}
 
@@ -255,23 +282,27 @@ func (s *logsReceiver) collectLogs(pc ProjectContext, hostname, logName string,
 	err = s.consumer.ConsumeLogs(context.Background(), plog)
 	if err != nil {
 		s.log.Error("Failed to consume logs", zap.Error(err))
+		return
 	}
+	s.setNextStartTime(pc.Project.ID, hostname, logName, s.end)
 }
 
 func (s *logsReceiver) collectAuditLogs(pc ProjectContext, hostname, logName string, clusterInfo ClusterInfo) {
+	start := s.nextStartTime(pc.Project.ID, hostname, logName)
 	logs, err := s.getHostAuditLogs(
 		pc.Project.ID,
 		hostname,
 		logName,
+		start,
 	)
 
 	if err != nil && !errors.Is(err, io.EOF) {
-		s.log.Warn("Failed to retrieve audit logs", zap.Error(err), zap.String("hostname", hostname), zap.String("log", logName), zap.Time("startTime", s.start), zap.Time("endTime", s.end))
+		s.log.Warn("Failed to retrieve audit logs", zap.Error(err), zap.String("hostname", hostname), zap.String("log", logName), zap.Time("startTime", start), zap.Time("endTime", s.end))
 		return
 	}
 
 	if len(logs) == 0 {
-		s.log.Warn("Attempted to retrieve audit logs but received 0 logs", zap.Error(err), zap.String("hostname", hostname), zap.String("log", logName), zap.Time("startTime", s.start), zap.Time("endTime", s.end))
+		s.log.Warn("Attempted to retrieve audit logs but received 0 logs", zap.Error(err), zap.String("hostname", hostname), zap.String("log", logName), zap.Time("startTime", start), zap.Time("endTime", s.end))
 		return
 	}
 
@@ -289,5 +320,67 @@ func (s *logsReceiver) collectAuditLogs(pc ProjectContext, hostname, logName str
 	err = s.consumer.ConsumeLogs(context.Background(), plog)
 	if err != nil {
 		s.log.Error("Failed to consume logs", zap.Error(err))
+		return
+	}
+	s.setNextStartTime(pc.Project.ID, hostname, logName, s.end)
+}
+
+// nextStartTime returns the start time of the next download of a log file. The log files that weren't
+// downloaded before start at the beginning of the current collection interval.
+func (s *logsReceiver) nextStartTime(groupID, hostname, logName string) time.Time {
+	if start, ok := s.record[groupID][logsCheckpointRecordKey(hostname, logName)]; ok && start.Before(s.end) {
+		return start
+	}
+	s.setNextStartTime(groupID, hostname, logName, s.start)
+	return s.start
+}
+
+// setNextStartTime records the start time of the next download of a log file, once its logs were consumed.
+// The log files that fail to be downloaded or consumed, or that are still empty as the logs may not be
+// available yet, are downloaded again from the same start time.
+func (s *logsReceiver) setNextStartTime(groupID, hostname, logName string, start time.Time) {
+	if s.record[groupID] == nil {
+		s.record[groupID] = map[string]time.Time{}
+	}
+	s.record[groupID][logsCheckpointRecordKey(hostname, logName)] = start
+}
+
+func logsCheckpointRecordKey(hostname, logName string) string {
+	return hostname + "/" + logName
+}
+
+func logsCheckpointKey(groupID string) string {
+	return fmt.Sprintf(logsStorageKey, groupID)
+}
+
+func (s *logsReceiver) checkpoint(ctx context.Context, groupID string) error {
+	marshalBytes, err := json.Marshal(s.record[groupID])
+	if err != nil {
+		return fmt.Errorf("unable to write checkpoint: %w", err)
+	}
+	return s.storageClient.Set(ctx, logsCheckpointKey(groupID), marshalBytes)
+}
+
+func (s *logsReceiver) loadCheckpoint(ctx context.Context, groupID string) {
+	if _, ok := s.record[groupID]; ok {
+		// The checkpoint was already loaded, and is kept up to date in memory.
+		return
+	}
+	s.record[groupID] = map[string]time.Time{}
+
+	cBytes, err := s.storageClient.Get(ctx, logsCheckpointKey(groupID))
+	if err != nil {
+		s.log.Info("unable to load checkpoint from storage client, continuing without a previous checkpoint", zap.Error(err))
+		return
+	}
+	if cBytes == nil {
+		return
+	}
+
+	var record map[string]time.Time
+	if err = json.Unmarshal(cBytes, &record); err != nil {
+		s.log.Error("unable to decode stored record for logs, continuing without a checkpoint", zap.Error(err))
+		return
 	}
+	s.record[groupID] = record
 }
@@ -4,8 +4,15 @@
 package mongodbatlasreceiver
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/atlas/mongodbatlas"
@@ -68,3 +75,94 @@ func TestNoLoggingEnabled(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, recv, "receiver creation failed")
 }
+
+// mockLogsClient serves the audit logs of a single host, failing the downloads while failGetLogs is set.
+type mockLogsClient struct {
+	auditLog    []byte
+	failGetLogs bool
+	starts      []time.Time
+}
+
+func (*mockLogsClient) GetProject(_ context.Context, name string) (*mongodbatlas.Project, error) {
+	return &mongodbatlas.Project{ID: testProjectID, Name: name, OrgID: testOrgID}, nil
+}
+
+func (*mockLogsClient) GetOrganization(_ context.Context, orgID string) (*mongodbatlas.Organization, error) {
+	return &mongodbatlas.Organization{ID: orgID, Name: "Org"}, nil
+}
+
+func (*mockLogsClient) GetClusters(_ context.Context, _ string) ([]mongodbatlas.Cluster, error) {
+	return []mongodbatlas.Cluster{{
+		Name:                testClusterName,
+		MongoDBMajorVersion: "5.0",
+		ConnectionStrings:   &mongodbatlas.ConnectionStrings{Standard: "mongodb://host-0.mongodb.net:27017"},
+		ProviderSettings:    &mongodbatlas.ProviderSettings{RegionName: "US_EAST_1", ProviderName: "AWS"},
+	}}, nil
+}
+
+func (m *mockLogsClient) GetLogs(_ context.Context, _, _, logName string, start, _ time.Time) (*bytes.Buffer, error) {
+	if logName != "mongodb-audit-log.gz" {
+		return nil, io.EOF
+	}
+	m.starts = append(m.starts, start)
+	if m.failGetLogs {
+		return nil, errors.New("service unavailable")
+	}
+	buf := &bytes.Buffer{}
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(m.auditLog); err != nil {
+		return nil, err
+	}
+	return buf, w.Close()
+}
+
+func (*mockLogsClient) Shutdown() error {
+	return nil
+}
+
+func TestAuditLogsCheckpoint(t *testing.T) {
+	auditLog, err := os.ReadFile(filepath.Join("testdata", "logs", "sample-payloads", "5.0_audit.log"))
+	require.NoError(t, err)
+
+	hostLogs := false
+	cfg := &Config{
+		Logs: LogConfig{
+			Enabled: true,
+			Projects: []*LogsProjectConfig{{
+				ProjectConfig:   ProjectConfig{Name: testProjectName},
+				EnableAuditLogs: true,
+				EnableHostLogs:  &hostLogs,
+			}},
+		},
+	}
+	storageClient := newMemoryStorageClient()
+	client := &mockLogsClient{auditLog: auditLog, failGetLogs: true}
+	sink := &consumertest.LogsSink{}
+
+	rcvr := newMongoDBAtlasLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	rcvr.client = client
+	rcvr.storageClient = storageClient
+	first := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	rcvr.start, rcvr.end = first.Add(-collectionInterval), first
+
+	// The failed download is retried from the same start time.
+	rcvr.collect(context.Background())
+	rcvr.start, rcvr.end = first, first.Add(collectionInterval)
+	client.failGetLogs = false
+	rcvr.collect(context.Background())
+	require.Equal(t, []time.Time{first.Add(-collectionInterval), first.Add(-collectionInterval)}, client.starts)
+	require.Equal(t, 1, len(sink.AllLogs()))
+
+	resourceAttrs := sink.AllLogs()[0].ResourceLogs().At(0).Resource().Attributes().AsRaw()
+	require.Equal(t, "Org", resourceAttrs["mongodb_atlas.org"])
+	require.Equal(t, testProjectID, resourceAttrs["mongodb_atlas.project.id"])
+	require.Equal(t, testClusterName, resourceAttrs["mongodb_atlas.cluster"])
+
+	// A new receiver resumes from the stored checkpoint.
+	restarted := newMongoDBAtlasLogsReceiver(receivertest.NewNopCreateSettings(), cfg, sink)
+	restarted.client = client
+	restarted.storageClient = storageClient
+	restarted.start, restarted.end = first.Add(time.Hour), first.Add(time.Hour+collectionInterval)
+	restarted.collect(context.Background())
+	require.Equal(t, first.Add(collectionInterval), client.starts[2])
+}
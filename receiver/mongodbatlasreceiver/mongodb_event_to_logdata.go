@@ -21,7 +21,7 @@ const (
 	totalAuditLogAttributes = 16
 
 	// Number of resource attributes to add to the plog.ResourceLogs.
-	totalResourceAttributes = 4
+	totalResourceAttributes = 7
 )
 
 // jsonTimestampLayout for the timestamp format in the plog.Logs structure
@@ -54,6 +54,7 @@ func mongodbAuditEventToLogData(logger *zap.Logger, logs []model.AuditLog, pc Pr
 	// Attributes related to the object causing the event.
 	resourceAttrs.PutStr("mongodb_atlas.org", pc.orgName)
 	resourceAttrs.PutStr("mongodb_atlas.project", pc.Project.Name)
+	resourceAttrs.PutStr("mongodb_atlas.project.id", pc.Project.ID)
 	resourceAttrs.PutStr("mongodb_atlas.cluster", clusterInfo.ClusterName)
 	resourceAttrs.PutStr("mongodb_atlas.region.name", clusterInfo.RegionName)
 	resourceAttrs.PutStr("mongodb_atlas.provider.name", clusterInfo.ProviderName)
@@ -156,6 +157,7 @@ func mongodbEventToLogData(logger *zap.Logger, logs []model.LogEntry, pc Project
 	// Attributes related to the object causing the event.
 	resourceAttrs.PutStr("mongodb_atlas.org", pc.orgName)
 	resourceAttrs.PutStr("mongodb_atlas.project", pc.Project.Name)
+	resourceAttrs.PutStr("mongodb_atlas.project.id", pc.Project.ID)
 	resourceAttrs.PutStr("mongodb_atlas.cluster", clusterInfo.ClusterName)
 	resourceAttrs.PutStr("mongodb_atlas.region.name", clusterInfo.RegionName)
 	resourceAttrs.PutStr("mongodb_atlas.provider.name", clusterInfo.ProviderName)
@@ -21,7 +21,7 @@ func TestMongoeventToLogData4_4(t *testing.T) {
 	mongoevent := getTestEvent4_4()
 	pc := ProjectContext{
 		orgName: "Org",
-		Project: mongodbatlas.Project{Name: "Project"},
+		Project: mongodbatlas.Project{Name: "Project", ID: "ProjectID"},
 	}
 	clusterInfo := ClusterInfo{
 		ClusterName:         "clusterName",
@@ -38,9 +38,10 @@ func TestMongoeventToLogData4_4(t *testing.T) {
 	attrs := lr.Attributes()
 
 	assert.Equal(t, 1, ld.ResourceLogs().Len())
-	assert.Equal(t, 6, resourceAttrs.Len())
+	assert.Equal(t, 7, resourceAttrs.Len())
 	assertString(t, resourceAttrs, "mongodb_atlas.org", "Org")
 	assertString(t, resourceAttrs, "mongodb_atlas.project", "Project")
+	assertString(t, resourceAttrs, "mongodb_atlas.project.id", "ProjectID")
 	assertString(t, resourceAttrs, "mongodb_atlas.cluster", "clusterName")
 	assertString(t, resourceAttrs, "mongodb_atlas.host.name", "hostname")
 	assertString(t, resourceAttrs, "mongodb_atlas.region.name", "regionName")
@@ -67,7 +68,7 @@ func TestMongoeventToLogData4_2(t *testing.T) {
 	mongoevent := getTestEvent4_2()
 	pc := ProjectContext{
 		orgName: "Org",
-		Project: mongodbatlas.Project{Name: "Project"},
+		Project: mongodbatlas.Project{Name: "Project", ID: "ProjectID"},
 	}
 
 	clusterInfo := ClusterInfo{
@@ -85,9 +86,10 @@ func TestMongoeventToLogData4_2(t *testing.T) {
 	attrs := lr.Attributes()
 
 	assert.Equal(t, 1, ld.ResourceLogs().Len())
-	assert.Equal(t, 6, resourceAttrs.Len())
+	assert.Equal(t, 7, resourceAttrs.Len())
 	assertString(t, resourceAttrs, "mongodb_atlas.org", "Org")
 	assertString(t, resourceAttrs, "mongodb_atlas.project", "Project")
+	assertString(t, resourceAttrs, "mongodb_atlas.project.id", "ProjectID")
 	assertString(t, resourceAttrs, "mongodb_atlas.cluster", "clusterName")
 	assertString(t, resourceAttrs, "mongodb_atlas.region.name", "regionName")
 	assertString(t, resourceAttrs, "mongodb_atlas.provider.name", "providerName")
@@ -113,7 +115,7 @@ func TestUnknownSeverity(t *testing.T) {
 	mongoevent.Severity = "Unknown"
 	pc := ProjectContext{
 		orgName: "Org",
-		Project: mongodbatlas.Project{Name: "Project"},
+		Project: mongodbatlas.Project{Name: "Project", ID: "ProjectID"},
 	}
 	clusterInfo := ClusterInfo{
 		ClusterName:         "clusterName",
@@ -134,7 +136,7 @@ func TestMongoEventToAuditLogData5_0(t *testing.T) {
 	mongoevent := getTestAuditEvent5_0()
 	pc := ProjectContext{
 		orgName: "Org",
-		Project: mongodbatlas.Project{Name: "Project"},
+		Project: mongodbatlas.Project{Name: "Project", ID: "ProjectID"},
 	}
 
 	clusterInfo := ClusterInfo{
@@ -153,9 +155,10 @@ func TestMongoEventToAuditLogData5_0(t *testing.T) {
 	attrs := lr.Attributes()
 
 	assert.Equal(t, ld.ResourceLogs().Len(), 1)
-	assert.Equal(t, resourceAttrs.Len(), 6)
+	assert.Equal(t, resourceAttrs.Len(), 7)
 	assertString(t, resourceAttrs, "mongodb_atlas.org", "Org")
 	assertString(t, resourceAttrs, "mongodb_atlas.project", "Project")
+	assertString(t, resourceAttrs, "mongodb_atlas.project.id", "ProjectID")
 	assertString(t, resourceAttrs, "mongodb_atlas.cluster", "clusterName")
 	assertString(t, resourceAttrs, "mongodb_atlas.host.name", "hostname")
 	assertString(t, resourceAttrs, "mongodb_atlas.region.name", "regionName")
@@ -200,7 +203,7 @@ func TestMongoEventToAuditLogData4_2(t *testing.T) {
 	mongoevent := getTestAuditEvent4_2()
 	pc := ProjectContext{
 		orgName: "Org",
-		Project: mongodbatlas.Project{Name: "Project"},
+		Project: mongodbatlas.Project{Name: "Project", ID: "ProjectID"},
 	}
 
 	clusterInfo := ClusterInfo{
@@ -219,9 +222,10 @@ func TestMongoEventToAuditLogData4_2(t *testing.T) {
 	attrs := lr.Attributes()
 
 	assert.Equal(t, ld.ResourceLogs().Len(), 1)
-	assert.Equal(t, resourceAttrs.Len(), 6)
+	assert.Equal(t, resourceAttrs.Len(), 7)
 	assertString(t, resourceAttrs, "mongodb_atlas.org", "Org")
 	assertString(t, resourceAttrs, "mongodb_atlas.project", "Project")
+	assertString(t, resourceAttrs, "mongodb_atlas.project.id", "ProjectID")
 	assertString(t, resourceAttrs, "mongodb_atlas.cluster", "clusterName")
 	assertString(t, resourceAttrs, "mongodb_atlas.host.name", "hostname")
 	assertString(t, resourceAttrs, "mongodb_atlas.region.name", "regionName")
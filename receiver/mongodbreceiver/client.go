@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"go.mongodb.org/mongo-driver/bson"
@@ -26,6 +27,9 @@ type client interface {
 	DBStats(ctx context.Context, DBName string) (bson.M, error)
 	TopStats(ctx context.Context) (bson.M, error)
 	IndexStats(ctx context.Context, DBName, collectionName string) ([]bson.M, error)
+	// The operations are returned as raw documents to preserve the order of the fields of their commands.
+	CurrentOp(ctx context.Context, threshold time.Duration, limit int) ([]bson.Raw, error)
+	ProfilerOperations(ctx context.Context, DBName string, since time.Time, threshold time.Duration, limit int) ([]bson.Raw, error)
 }
 
 // mongodbClient is a mongodb metric scraper client
@@ -104,6 +108,54 @@ func (c *mongodbClient) IndexStats(ctx context.Context, database, collectionName
 	return indexStats, nil
 }
 
+// CurrentOp returns the active operations running for at least threshold, the longest first
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/operator/aggregation/currentOp/
+func (c *mongodbClient) CurrentOp(ctx context.Context, threshold time.Duration, limit int) ([]bson.Raw, error) {
+	pipeline := mongo.Pipeline{
+		bson.D{primitive.E{Key: "$currentOp", Value: bson.M{"allUsers": true}}},
+		bson.D{primitive.E{Key: "$match", Value: bson.M{
+			"active":            true,
+			"op":                bson.M{"$ne": "none"},
+			"microsecs_running": bson.M{"$gte": threshold.Microseconds()},
+		}}},
+		bson.D{primitive.E{Key: "$sort", Value: bson.M{"microsecs_running": -1}}},
+		bson.D{primitive.E{Key: "$limit", Value: limit}},
+	}
+	cursor, err := c.Client.Database("admin").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var operations []bson.Raw
+	if err = cursor.All(ctx, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+// ProfilerOperations returns the operations of a database recorded by the profiler after since and taking
+// at least threshold, the oldest first
+// more information can be found here: https://www.mongodb.com/docs/manual/reference/database-profiler/
+func (c *mongodbClient) ProfilerOperations(ctx context.Context, database string, since time.Time, threshold time.Duration, limit int) ([]bson.Raw, error) {
+	filter := bson.M{
+		"ts":     bson.M{"$gt": primitive.NewDateTimeFromTime(since)},
+		"millis": bson.M{"$gte": threshold.Milliseconds()},
+	}
+	opts := options.Find().SetSort(bson.D{primitive.E{Key: "ts", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := c.Client.Database(database).Collection("system.profile").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var operations []bson.Raw
+	if err = cursor.All(ctx, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
 // GetVersion returns a result of the version of mongo the client is connected to so adjustments in collection protocol can
 // be determined
 func (c *mongodbClient) GetVersion(ctx context.Context) (*version.Version, error) {
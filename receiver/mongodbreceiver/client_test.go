@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-version"
 	"github.com/stretchr/testify/mock"
@@ -68,6 +69,16 @@ func (fc *fakeClient) IndexStats(ctx context.Context, dbName, collectionName str
 	return args.Get(0).([]bson.M), args.Error(1)
 }
 
+func (fc *fakeClient) CurrentOp(ctx context.Context, threshold time.Duration, limit int) ([]bson.Raw, error) {
+	args := fc.Called(ctx, threshold, limit)
+	return args.Get(0).([]bson.Raw), args.Error(1)
+}
+
+func (fc *fakeClient) ProfilerOperations(ctx context.Context, dbName string, since time.Time, threshold time.Duration, limit int) ([]bson.Raw, error) {
+	args := fc.Called(ctx, dbName, since, threshold, limit)
+	return args.Get(0).([]bson.Raw), args.Error(1)
+}
+
 func TestListDatabaseNames(t *testing.T) {
 	mont := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
 
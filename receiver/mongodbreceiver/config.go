@@ -30,6 +30,28 @@ type Config struct {
 	Password                      configopaque.String    `mapstructure:"password"`
 	ReplicaSet                    string                 `mapstructure:"replica_set,omitempty"`
 	Timeout                       time.Duration          `mapstructure:"timeout"`
+	// SlowOperations configures the collection of slow operations as logs.
+	SlowOperations SlowOperationsConfig `mapstructure:"slow_operations"`
+}
+
+const (
+	slowOperationsSourceCurrentOp = "currentop"
+	slowOperationsSourceProfiler  = "profiler"
+)
+
+// SlowOperationsConfig defines the collection of the operations running for longer than a threshold.
+type SlowOperationsConfig struct {
+	// Enabled enables the collection of slow operations by the logs receiver.
+	Enabled bool `mapstructure:"enabled"`
+	// Source is where the slow operations are read from: "currentop" reads the operations in progress,
+	// "profiler" reads the operations recorded by the database profiler in the system.profile collections.
+	Source string `mapstructure:"source"`
+	// Threshold is the minimum duration of the operations collected.
+	Threshold time.Duration `mapstructure:"threshold"`
+	// CollectionInterval is the interval at which the slow operations are collected.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// MaxOperations is the maximum number of operations collected per interval, and per database for the profiler.
+	MaxOperations int `mapstructure:"max_operations"`
 }
 
 func (c *Config) Validate() error {
@@ -50,6 +72,10 @@ func (c *Config) Validate() error {
 		err = multierr.Append(err, errors.New("password provided without user"))
 	}
 
+	if c.SlowOperations.Enabled {
+		err = multierr.Append(err, c.SlowOperations.validate())
+	}
+
 	if _, tlsErr := c.LoadTLSConfig(context.Background()); tlsErr != nil {
 		err = multierr.Append(err, fmt.Errorf("error loading tls configuration: %w", tlsErr))
 	}
@@ -57,6 +83,24 @@ func (c *Config) Validate() error {
 	return err
 }
 
+func (c *SlowOperationsConfig) validate() error {
+	var err error
+	if c.Source != slowOperationsSourceCurrentOp && c.Source != slowOperationsSourceProfiler {
+		err = multierr.Append(err, fmt.Errorf("slow_operations::source must be %q or %q, got %q",
+			slowOperationsSourceCurrentOp, slowOperationsSourceProfiler, c.Source))
+	}
+	if c.Threshold < 0 {
+		err = multierr.Append(err, errors.New("slow_operations::threshold must not be negative"))
+	}
+	if c.CollectionInterval <= 0 {
+		err = multierr.Append(err, errors.New("slow_operations::collection_interval must be positive"))
+	}
+	if c.MaxOperations <= 0 {
+		err = multierr.Append(err, errors.New("slow_operations::max_operations must be positive"))
+	}
+	return err
+}
+
 func (c *Config) ClientOptions() *options.ClientOptions {
 	clientOptions := options.Client()
 	connString := fmt.Sprintf("mongodb://%s", strings.Join(c.hostlist(), ","))
@@ -101,6 +101,56 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateSlowOperations(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		modify   func(*SlowOperationsConfig)
+		expected string
+	}{
+		{
+			desc:   "default currentop",
+			modify: func(*SlowOperationsConfig) {},
+		},
+		{
+			desc:   "profiler",
+			modify: func(c *SlowOperationsConfig) { c.Source = slowOperationsSourceProfiler },
+		},
+		{
+			desc:     "invalid source",
+			modify:   func(c *SlowOperationsConfig) { c.Source = "oplog" },
+			expected: `slow_operations::source must be "currentop" or "profiler", got "oplog"`,
+		},
+		{
+			desc:     "negative threshold",
+			modify:   func(c *SlowOperationsConfig) { c.Threshold = -time.Second },
+			expected: "slow_operations::threshold must not be negative",
+		},
+		{
+			desc:     "no collection interval",
+			modify:   func(c *SlowOperationsConfig) { c.CollectionInterval = 0 },
+			expected: "slow_operations::collection_interval must be positive",
+		},
+		{
+			desc:     "no max operations",
+			modify:   func(c *SlowOperationsConfig) { c.MaxOperations = 0 },
+			expected: "slow_operations::max_operations must be positive",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.SlowOperations.Enabled = true
+			tc.modify(&cfg.SlowOperations)
+			err := component.ValidateConfig(cfg)
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expected)
+			}
+		})
+	}
+}
+
 func TestBadTLSConfigs(t *testing.T) {
 	testCases := []struct {
 		desc        string
@@ -217,6 +267,9 @@ func TestLoadConfig(t *testing.T) {
 	expected.Username = "otel"
 	expected.Password = "${env:MONGO_PASSWORD}"
 	expected.CollectionInterval = time.Minute
+	expected.SlowOperations.Enabled = true
+	expected.SlowOperations.Source = slowOperationsSourceProfiler
+	expected.SlowOperations.Threshold = 250 * time.Millisecond
 
 	require.Equal(t, expected, cfg)
 }
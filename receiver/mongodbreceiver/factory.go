@@ -22,7 +22,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -37,6 +38,12 @@ func createDefaultConfig() component.Config {
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 		ClientConfig:         configtls.ClientConfig{},
+		SlowOperations: SlowOperationsConfig{
+			Source:             slowOperationsSourceCurrentOp,
+			Threshold:          100 * time.Millisecond,
+			CollectionInterval: 10 * time.Second,
+			MaxOperations:      100,
+		},
 	}
 }
 
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mongodbreceiver/internal/metadata"
+)
+
+// slowOperationsReceiver periodically emits the operations running for longer than the threshold as log records.
+type slowOperationsReceiver struct {
+	cfg           *Config
+	settings      receiver.CreateSettings
+	consumer      consumer.Logs
+	clientFactory func(context.Context, *Config, *zap.Logger) (client, error)
+	client        client
+
+	// profilerWatermarks holds the timestamp of the last profiled operation collected from every database,
+	// operations older than the start of the receiver are never collected.
+	profilerWatermarks map[string]time.Time
+	startTime          time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	return &slowOperationsReceiver{
+		cfg:                rConf.(*Config),
+		settings:           params,
+		consumer:           consumer,
+		clientFactory:      newClient,
+		profilerWatermarks: map[string]time.Time{},
+	}, nil
+}
+
+// Start begins the collection of slow operations. The receiver does nothing if the collection is disabled,
+// a message is logged at the INFO level in that case.
+func (r *slowOperationsReceiver) Start(ctx context.Context, _ component.Host) error {
+	if !r.cfg.SlowOperations.Enabled {
+		r.settings.Logger.Info("No slow operations will be collected from MongoDB: slow_operations is not enabled.")
+		return nil
+	}
+
+	c, err := r.clientFactory(ctx, r.cfg, r.settings.Logger)
+	if err != nil {
+		return fmt.Errorf("create mongo client: %w", err)
+	}
+	r.client = c
+	r.startTime = time.Now()
+
+	var runCtx context.Context
+	runCtx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go r.run(runCtx)
+	return nil
+}
+
+func (r *slowOperationsReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.SlowOperations.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		logs, err := r.collect(ctx)
+		if err != nil {
+			r.settings.Logger.Error("Failed to collect the MongoDB slow operations", zap.Error(err))
+		}
+		if logs.LogRecordCount() == 0 {
+			continue
+		}
+		if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			r.settings.Logger.Error("Failed to consume the MongoDB slow operations", zap.Error(err))
+		}
+	}
+}
+
+func (r *slowOperationsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.client != nil {
+		return r.client.Disconnect(ctx)
+	}
+	return nil
+}
+
+// collect returns a log record per slow operation, grouped by database.
+func (r *slowOperationsReceiver) collect(ctx context.Context) (plog.Logs, error) {
+	cfg := r.cfg.SlowOperations
+	lb := &slowOperationsLogsBuilder{
+		logs:      plog.NewLogs(),
+		records:   map[string]plog.LogRecordSlice{},
+		resources: r.cfg.ResourceAttributes,
+		version:   r.settings.BuildInfo.Version,
+	}
+
+	if cfg.Source == slowOperationsSourceCurrentOp {
+		operations, err := r.client.CurrentOp(ctx, cfg.Threshold, cfg.MaxOperations)
+		if err != nil {
+			return lb.logs, fmt.Errorf("failed to fetch current operations: %w", err)
+		}
+		now := pcommon.NewTimestampFromTime(time.Now())
+		for _, operation := range operations {
+			ns := lookupString(operation, "ns")
+			database, _, _ := strings.Cut(ns, ".")
+			record := lb.appendRecord(database)
+			record.SetTimestamp(now)
+			setSlowOperationFields(record, operation, slowOperationsSourceCurrentOp)
+			if micros, ok := operation.Lookup("microsecs_running").AsInt64OK(); ok {
+				record.Attributes().PutDouble("mongodb.operation.duration", time.Duration(micros*int64(time.Microsecond)).Seconds())
+			}
+			if opid, ok := operation.Lookup("opid").AsInt64OK(); ok {
+				record.Attributes().PutInt("mongodb.operation.id", opid)
+			}
+		}
+		return lb.logs, nil
+	}
+
+	dbNames, err := r.client.ListDatabaseNames(ctx, bson.D{})
+	if err != nil {
+		return lb.logs, fmt.Errorf("failed to fetch database names: %w", err)
+	}
+	var errs []error
+	for _, dbName := range dbNames {
+		since, ok := r.profilerWatermarks[dbName]
+		if !ok {
+			since = r.startTime
+		}
+		operations, err := r.client.ProfilerOperations(ctx, dbName, since, cfg.Threshold, cfg.MaxOperations)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to fetch profiled operations of database %s: %w", dbName, err))
+			continue
+		}
+		for _, operation := range operations {
+			record := lb.appendRecord(dbName)
+			if ts, ok := operation.Lookup("ts").TimeOK(); ok {
+				record.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+				if ts.After(r.profilerWatermarks[dbName]) {
+					r.profilerWatermarks[dbName] = ts
+				}
+			}
+			setSlowOperationFields(record, operation, slowOperationsSourceProfiler)
+			if millis, ok := operation.Lookup("millis").AsInt64OK(); ok {
+				record.Attributes().PutDouble("mongodb.operation.duration", time.Duration(millis*int64(time.Millisecond)).Seconds())
+			}
+		}
+	}
+	return lb.logs, errors.Join(errs...)
+}
+
+// slowOperationsLogsBuilder groups the log records of the slow operations by database.
+type slowOperationsLogsBuilder struct {
+	logs      plog.Logs
+	records   map[string]plog.LogRecordSlice
+	resources metadata.ResourceAttributesConfig
+	version   string
+}
+
+func (lb *slowOperationsLogsBuilder) appendRecord(database string) plog.LogRecord {
+	records, ok := lb.records[database]
+	if !ok {
+		resourceLogs := lb.logs.ResourceLogs().AppendEmpty()
+		rb := metadata.NewResourceBuilder(lb.resources)
+		if database != "" {
+			rb.SetDatabase(database)
+		}
+		rb.Emit().MoveTo(resourceLogs.Resource())
+
+		scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+		scopeLogs.Scope().SetName("otelcol/mongodbreceiver")
+		scopeLogs.Scope().SetVersion(lb.version)
+		records = scopeLogs.LogRecords()
+		lb.records[database] = records
+	}
+	return records.AppendEmpty()
+}
+
+// setSlowOperationFields sets the body and the attributes shared by the operations of both sources. The body
+// is the command of the operation as relaxed extended JSON, or the type of the operation if it has no command.
+func setSlowOperationFields(record plog.LogRecord, operation bson.Raw, source string) {
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+
+	op := lookupString(operation, "op")
+	record.Body().SetStr(op)
+	if command, ok := operation.Lookup("command").DocumentOK(); ok {
+		if body, err := bson.MarshalExtJSON(command, false, false); err == nil {
+			record.Body().SetStr(string(body))
+		}
+	}
+
+	attrs := record.Attributes()
+	attrs.PutStr("db.system", "mongodb")
+	attrs.PutStr("mongodb.operation.source", source)
+	attrs.PutStr("mongodb.operation.type", op)
+	for key, attribute := range map[string]string{
+		"ns":          "mongodb.operation.namespace",
+		"planSummary": "mongodb.operation.plan_summary",
+		"appName":     "mongodb.operation.app_name",
+		"client":      "mongodb.operation.client",
+	} {
+		if val := lookupString(operation, key); val != "" {
+			attrs.PutStr(attribute, val)
+		}
+	}
+	for key, attribute := range map[string]string{
+		"docsExamined": "mongodb.operation.docs_examined",
+		"keysExamined": "mongodb.operation.keys_examined",
+		"nreturned":    "mongodb.operation.docs_returned",
+	} {
+		if val, ok := operation.Lookup(key).AsInt64OK(); ok {
+			attrs.PutInt(attribute, val)
+		}
+	}
+}
+
+func lookupString(document bson.Raw, key string) string {
+	val, _ := document.Lookup(key).StringValueOK()
+	return val
+}
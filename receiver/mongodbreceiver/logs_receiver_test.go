@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mongodbreceiver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+func loadOperations(t *testing.T, fileName string) []bson.Raw {
+	data, err := os.ReadFile(filepath.Join("testdata", "logs", fileName))
+	require.NoError(t, err)
+	var doc struct {
+		Operations []bson.Raw `bson:"operations"`
+	}
+	require.NoError(t, bson.UnmarshalExtJSON(data, false, &doc))
+	return doc.Operations
+}
+
+func newTestSlowOperationsReceiver(t *testing.T, source string, fc *fakeClient) *slowOperationsReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SlowOperations.Enabled = true
+	cfg.SlowOperations.Source = source
+	require.NoError(t, cfg.Validate())
+
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	rcvr := r.(*slowOperationsReceiver)
+	rcvr.clientFactory = func(context.Context, *Config, *zap.Logger) (client, error) { return fc, nil }
+	return rcvr
+}
+
+func TestSlowOperationsCurrentOp(t *testing.T) {
+	fc := &fakeClient{}
+	fc.On("CurrentOp", mock.Anything, 100*time.Millisecond, 100).Return(loadOperations(t, "currentOp.json"), nil)
+	fc.On("Disconnect", mock.Anything).Return(nil)
+
+	rcvr := newTestSlowOperationsReceiver(t, slowOperationsSourceCurrentOp, fc)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, rcvr.Shutdown(context.Background())) }()
+
+	actualLogs, err := rcvr.collect(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "logs", "expectedCurrentOp.yaml")
+	expectedLogs, err := golden.ReadLogs(expectedFile)
+	require.NoError(t, err)
+	assert.NoError(t, plogtest.CompareLogs(expectedLogs, actualLogs,
+		plogtest.IgnoreTimestamp(),
+		plogtest.IgnoreObservedTimestamp()))
+}
+
+func TestSlowOperationsProfiler(t *testing.T) {
+	fc := &fakeClient{}
+	fc.On("ListDatabaseNames", mock.Anything, mock.Anything, mock.Anything).Return([]string{"shop", "inventory"}, nil)
+	fc.On("ProfilerOperations", mock.Anything, "shop", mock.Anything, 100*time.Millisecond, 100).Return(loadOperations(t, "profile.json"), nil)
+	fc.On("ProfilerOperations", mock.Anything, "inventory", mock.Anything, 100*time.Millisecond, 100).Return([]bson.Raw(nil), errors.New("not authorized"))
+	fc.On("Disconnect", mock.Anything).Return(nil)
+
+	rcvr := newTestSlowOperationsReceiver(t, slowOperationsSourceProfiler, fc)
+	require.NoError(t, rcvr.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, rcvr.Shutdown(context.Background())) }()
+
+	actualLogs, err := rcvr.collect(context.Background())
+	assert.EqualError(t, err, "failed to fetch profiled operations of database inventory: not authorized")
+
+	expectedFile := filepath.Join("testdata", "logs", "expectedProfiler.yaml")
+	expectedLogs, err := golden.ReadLogs(expectedFile)
+	require.NoError(t, err)
+	assert.NoError(t, plogtest.CompareLogs(expectedLogs, actualLogs, plogtest.IgnoreObservedTimestamp()))
+
+	// The next collection only reads the operations profiled after the last one collected.
+	assert.Equal(t, time.Date(2024, 6, 12, 10, 15, 9, 1e6, time.UTC), rcvr.profilerWatermarks["shop"].UTC())
+	_, ok := rcvr.profilerWatermarks["inventory"]
+	assert.False(t, ok)
+}
+
+func TestSlowOperationsDisabled(t *testing.T) {
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), createDefaultConfig(), consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Nil(t, r.(*slowOperationsReceiver).client)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
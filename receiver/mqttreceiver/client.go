@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"math"
+	"net/url"
+	"time"
+
+	"github.com/eclipse/paho.golang/autopaho"
+	"github.com/eclipse/paho.golang/paho"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.uber.org/zap"
+)
+
+// connectRetryDelay is the delay between two attempts to establish the initial connection to the broker.
+const connectRetryDelay = 10 * time.Second
+
+// message is an MQTT message received on a subscribed topic.
+type message struct {
+	topic   string
+	payload []byte
+}
+
+// subscriber maintains a connection to the broker and the subscriptions of the receiver.
+type subscriber interface {
+	// start connects to the broker in the background. The topics are subscribed to each time the
+	// connection is established, and handle is called for each received message. The message is
+	// acknowledged once handle returns.
+	start(handle func(message)) error
+	shutdown(ctx context.Context) error
+}
+
+func newSubscriber(cfg *Config, clientID string, tlsConfig *tls.Config, logger *zap.Logger) subscriber {
+	if cfg.ProtocolVersion == protocolVersion5 {
+		return &subscriberV5{cfg: cfg, clientID: clientID, tlsConfig: tlsConfig, logger: logger}
+	}
+	return &subscriberV3{cfg: cfg, clientID: clientID, tlsConfig: tlsConfig, logger: logger}
+}
+
+// subscriberV3 implements the subscriber with the MQTT 3.1.1 protocol.
+type subscriberV3 struct {
+	cfg       *Config
+	clientID  string
+	tlsConfig *tls.Config
+	logger    *zap.Logger
+	client    mqtt.Client
+	// done stops the initial connection attempts, connecting is closed once they stopped.
+	done       chan struct{}
+	connecting chan struct{}
+}
+
+func (s *subscriberV3) start(handle func(message)) error {
+	filters := make(map[string]byte, len(s.cfg.Topics))
+	for _, topic := range s.cfg.Topics {
+		filters[s.cfg.subscriptionFilter(topic)] = topic.QoS
+	}
+	onMessage := func(_ mqtt.Client, m mqtt.Message) {
+		handle(message{topic: m.Topic(), payload: m.Payload()})
+	}
+
+	opts := mqtt.NewClientOptions().
+		AddBroker(s.cfg.Broker).
+		SetClientID(s.clientID).
+		SetUsername(s.cfg.Username).
+		SetPassword(string(s.cfg.Password)).
+		SetProtocolVersion(4).
+		SetCleanSession(s.cfg.CleanSession).
+		SetKeepAlive(s.cfg.KeepAlive).
+		SetConnectTimeout(s.cfg.ConnectTimeout).
+		SetTLSConfig(s.tlsConfig).
+		SetAutoReconnect(true).
+		SetOnConnectHandler(func(c mqtt.Client) {
+			token := c.SubscribeMultiple(filters, onMessage)
+			go func() {
+				if token.Wait(); token.Error() != nil {
+					s.logger.Error("Failed to subscribe to the topics", zap.Error(token.Error()))
+					return
+				}
+				s.logger.Debug("Subscribed to the topics")
+			}()
+		}).
+		SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			s.logger.Warn("Lost the connection to the broker", zap.Error(err))
+		})
+
+	s.client = mqtt.NewClient(opts)
+	s.done = make(chan struct{})
+	s.connecting = make(chan struct{})
+	go s.connect()
+	return nil
+}
+
+// connect establishes the initial connection, retrying until it succeeds or the subscriber shuts down.
+// The client reconnects by itself once the initial connection is established.
+func (s *subscriberV3) connect() {
+	defer close(s.connecting)
+	for {
+		token := s.client.Connect()
+		select {
+		case <-token.Done():
+		case <-s.done:
+			return
+		}
+		if token.Error() == nil {
+			return
+		}
+		s.logger.Warn("Failed to connect to the broker", zap.Error(token.Error()))
+		select {
+		case <-time.After(connectRetryDelay):
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *subscriberV3) shutdown(ctx context.Context) error {
+	if s.client == nil {
+		return nil
+	}
+	close(s.done)
+	select {
+	case <-s.connecting:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	s.client.Disconnect(250)
+	return nil
+}
+
+// subscriberV5 implements the subscriber with the MQTT 5 protocol.
+type subscriberV5 struct {
+	cfg       *Config
+	clientID  string
+	tlsConfig *tls.Config
+	logger    *zap.Logger
+	cm        *autopaho.ConnectionManager
+}
+
+func (s *subscriberV5) start(handle func(message)) error {
+	broker, err := url.Parse(s.cfg.Broker)
+	if err != nil {
+		return err
+	}
+	subscriptions := make([]paho.SubscribeOptions, 0, len(s.cfg.Topics))
+	for _, topic := range s.cfg.Topics {
+		subscriptions = append(subscriptions, paho.SubscribeOptions{Topic: s.cfg.subscriptionFilter(topic), QoS: topic.QoS})
+	}
+	// Without a clean session, the session is kept by the broker after the connection is closed.
+	var sessionExpiryInterval uint32
+	if !s.cfg.CleanSession {
+		sessionExpiryInterval = math.MaxUint32
+	}
+
+	cfg := autopaho.ClientConfig{
+		ServerUrls:                    []*url.URL{broker},
+		TlsCfg:                        s.tlsConfig,
+		KeepAlive:                     uint16(s.cfg.KeepAlive / time.Second),
+		CleanStartOnInitialConnection: s.cfg.CleanSession,
+		SessionExpiryInterval:         sessionExpiryInterval,
+		ConnectTimeout:                s.cfg.ConnectTimeout,
+		ConnectUsername:               s.cfg.Username,
+		ConnectPassword:               []byte(s.cfg.Password),
+		OnConnectionUp: func(cm *autopaho.ConnectionManager, _ *paho.Connack) {
+			if _, err := cm.Subscribe(context.Background(), &paho.Subscribe{Subscriptions: subscriptions}); err != nil {
+				s.logger.Error("Failed to subscribe to the topics", zap.Error(err))
+				return
+			}
+			s.logger.Debug("Subscribed to the topics")
+		},
+		OnConnectError: func(err error) {
+			s.logger.Warn("Failed to connect to the broker", zap.Error(err))
+		},
+		ClientConfig: paho.ClientConfig{
+			ClientID: s.clientID,
+			OnPublishReceived: []func(paho.PublishReceived) (bool, error){
+				func(pr paho.PublishReceived) (bool, error) {
+					handle(message{topic: pr.Packet.Topic, payload: pr.Packet.Payload})
+					return true, nil
+				},
+			},
+			OnClientError: func(err error) {
+				s.logger.Warn("Lost the connection to the broker", zap.Error(err))
+			},
+		},
+	}
+
+	// The connection is maintained until the receiver shuts down, regardless of the start context.
+	s.cm, err = autopaho.NewConnection(context.Background(), cfg)
+	return err
+}
+
+func (s *subscriberV5) shutdown(ctx context.Context) error {
+	if s.cm != nil {
+		return s.cm.Disconnect(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+const (
+	protocolVersion311 = "3.1.1"
+	protocolVersion5   = "5"
+)
+
+type Config struct {
+	// Broker is the URL of the MQTT broker, e.g. tcp://localhost:1883, ssl://localhost:8883 or wss://localhost:8084/mqtt.
+	Broker string `mapstructure:"broker"`
+	// ProtocolVersion is the version of the MQTT protocol, either 3.1.1 or 5.
+	ProtocolVersion string `mapstructure:"protocol_version"`
+	// ClientID identifies the receiver to the broker. A random identifier is used when it is empty.
+	ClientID string              `mapstructure:"client_id"`
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	// TLSSetting is used when the scheme of the broker is ssl, tls, mqtts or wss.
+	TLSSetting configtls.ClientConfig `mapstructure:"tls"`
+	// Topics are the topic filters the receiver subscribes to.
+	Topics []TopicConfig `mapstructure:"topics"`
+	// SharedSubscriptionGroup, when set, subscribes to the topic filters as shared subscriptions of the
+	// group, so that the messages are load balanced between the receivers of the group.
+	SharedSubscriptionGroup string `mapstructure:"shared_subscription_group"`
+	// CleanSession discards the session state of the client when connecting, including the messages
+	// queued by the broker while the receiver was disconnected.
+	CleanSession   bool          `mapstructure:"clean_session"`
+	KeepAlive      time.Duration `mapstructure:"keep_alive"`
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	// Encoding is the encoding extension used to decode the payloads. When it is not set, the payloads
+	// of the logs are used as log bodies and the payloads of the metrics are decoded as OTLP protobuf.
+	Encoding *component.ID `mapstructure:"encoding"`
+}
+
+// TopicConfig is a topic filter subscribed to by the receiver.
+type TopicConfig struct {
+	// Filter is the topic filter, which can include the + and # wildcards.
+	Filter string `mapstructure:"filter"`
+	// QoS is the maximum quality of service of the messages received on the topic: 0, 1 or 2.
+	QoS byte `mapstructure:"qos"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.Broker == "" {
+		errs = errors.Join(errs, errors.New("broker must be specified"))
+	} else if u, err := url.Parse(cfg.Broker); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("invalid broker: %w", err))
+	} else if !isSupportedScheme(u.Scheme) {
+		errs = errors.Join(errs, fmt.Errorf("unsupported broker scheme %q", u.Scheme))
+	}
+
+	switch cfg.ProtocolVersion {
+	case protocolVersion311, protocolVersion5:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("protocol_version must be %q or %q, got %q", protocolVersion311, protocolVersion5, cfg.ProtocolVersion))
+	}
+
+	if len(cfg.Topics) == 0 {
+		errs = errors.Join(errs, errors.New("at least one topic must be specified"))
+	}
+	for _, topic := range cfg.Topics {
+		if err := validateTopicFilter(topic.Filter); err != nil {
+			errs = errors.Join(errs, err)
+		}
+		if topic.QoS > 2 {
+			errs = errors.Join(errs, fmt.Errorf("invalid qos %d for topic %q: must be 0, 1 or 2", topic.QoS, topic.Filter))
+		}
+	}
+
+	if strings.ContainsAny(cfg.SharedSubscriptionGroup, "/+#") {
+		errs = errors.Join(errs, fmt.Errorf("shared_subscription_group %q must not contain '/', '+' or '#'", cfg.SharedSubscriptionGroup))
+	}
+	if cfg.KeepAlive < 0 || cfg.KeepAlive > math.MaxUint16*time.Second {
+		errs = errors.Join(errs, fmt.Errorf("keep_alive must be between 0s and %s", math.MaxUint16*time.Second))
+	}
+	if cfg.ConnectTimeout <= 0 {
+		errs = errors.Join(errs, errors.New("connect_timeout must be positive"))
+	}
+	return errs
+}
+
+func isSupportedScheme(scheme string) bool {
+	switch scheme {
+	case "tcp", "mqtt", "ssl", "tls", "mqtts", "ws", "wss":
+		return true
+	}
+	return false
+}
+
+// isTLSScheme returns whether the connection to a broker with the given scheme uses TLS.
+func isTLSScheme(scheme string) bool {
+	switch scheme {
+	case "ssl", "tls", "mqtts", "wss":
+		return true
+	}
+	return false
+}
+
+// validateTopicFilter checks the wildcards of a topic filter occupy entire levels, and that the
+// multi-level wildcard is the last level.
+func validateTopicFilter(filter string) error {
+	if filter == "" {
+		return errors.New("topic filter must not be empty")
+	}
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		if strings.Contains(level, "#") && (level != "#" || i != len(levels)-1) {
+			return fmt.Errorf("invalid topic filter %q: '#' must be the last level", filter)
+		}
+		if strings.Contains(level, "+") && level != "+" {
+			return fmt.Errorf("invalid topic filter %q: '+' must occupy an entire level", filter)
+		}
+	}
+	return nil
+}
+
+// subscriptionFilter returns the filter subscribed to for a topic, prefixed with the shared subscription group if any.
+func (cfg *Config) subscriptionFilter(topic TopicConfig) string {
+	if cfg.SharedSubscriptionGroup == "" {
+		return topic.Filter
+	}
+	return "$share/" + cfg.SharedSubscriptionGroup + "/" + topic.Filter
+}
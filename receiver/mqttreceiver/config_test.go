@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	encoding := component.MustNewID("json_log_encoding")
+	expected := createDefaultConfig().(*Config)
+	expected.Broker = "ssl://broker.example.com:8883"
+	expected.ProtocolVersion = protocolVersion5
+	expected.ClientID = "otelcol-gateway"
+	expected.Username = "collector"
+	expected.Password = "secret"
+	expected.TLSSetting = configtls.ClientConfig{Config: configtls.Config{CAFile: "/etc/ssl/certs/broker-ca.pem"}}
+	expected.Topics = []TopicConfig{
+		{Filter: "factory/+/sensors/#", QoS: 1},
+		{Filter: "factory/alarms"},
+	}
+	expected.SharedSubscriptionGroup = "collectors"
+	expected.CleanSession = false
+	expected.KeepAlive = time.Minute
+	expected.Encoding = &encoding
+
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr []string
+	}{
+		{
+			id:       component.NewID(metadata.Type),
+			expected: expected,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_topics"),
+			expectedErr: []string{
+				`invalid topic filter "factory/sensors#": '#' must be the last level`,
+				`invalid topic filter "factory/sensor+/temperature": '+' must occupy an entire level`,
+				`invalid qos 3 for topic "factory/alarms": must be 0, 1 or 2`,
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "missing_topics"),
+			expectedErr: []string{"at least one topic must be specified"},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_broker"),
+			expectedErr: []string{
+				`unsupported broker scheme "http"`,
+				`protocol_version must be "3.1.1" or "5", got "4"`,
+				`shared_subscription_group "a/b" must not contain '/', '+' or '#'`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			err = component.ValidateConfig(cfg)
+			if len(tt.expectedErr) > 0 {
+				require.Error(t, err)
+				for _, expectedErr := range tt.expectedErr {
+					assert.ErrorContains(t, err, expectedErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestSubscriptionFilter(t *testing.T) {
+	cfg := &Config{}
+	assert.Equal(t, "factory/#", cfg.subscriptionFilter(TopicConfig{Filter: "factory/#"}))
+	cfg.SharedSubscriptionGroup = "collectors"
+	assert.Equal(t, "$share/collectors/factory/#", cfg.subscriptionFilter(TopicConfig{Filter: "factory/#"}))
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mqttreceiver"
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const (
+	transport = "mqtt"
+
+	attributeMQTTTopic = "mqtt.topic"
+
+	defaultLogsFormat    = "raw"
+	defaultMetricsFormat = "otlp_proto"
+)
+
+type mqttReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+
+	nextLogs    consumer.Logs
+	nextMetrics consumer.Metrics
+
+	logsUnmarshaler    plog.Unmarshaler
+	metricsUnmarshaler pmetric.Unmarshaler
+	// format is the encoding of the payloads, reported in the receiver telemetry.
+	format string
+
+	// newSubscriber creates the connection to the broker, it is replaced in tests.
+	newSubscriber func(cfg *Config, clientID string, tlsConfig *tls.Config, logger *zap.Logger) subscriber
+	subscriber    subscriber
+}
+
+func newMQTTReceiver(cfg *Config, settings receiver.CreateSettings) (*mqttReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mqttReceiver{
+		cfg:           cfg,
+		settings:      settings,
+		obsrecv:       obsrecv,
+		newSubscriber: newSubscriber,
+	}, nil
+}
+
+func (r *mqttReceiver) Start(ctx context.Context, host component.Host) error {
+	handle, err := r.loadEncoding(host)
+	if err != nil {
+		return err
+	}
+
+	var tlsConfig *tls.Config
+	if broker, err := url.Parse(r.cfg.Broker); err == nil && isTLSScheme(broker.Scheme) {
+		if tlsConfig, err = r.cfg.TLSSetting.LoadTLSConfig(ctx); err != nil {
+			return fmt.Errorf("failed to load the TLS configuration: %w", err)
+		}
+	}
+
+	clientID := r.cfg.ClientID
+	if clientID == "" {
+		if clientID, err = randomClientID(); err != nil {
+			return err
+		}
+	}
+
+	r.subscriber = r.newSubscriber(r.cfg, clientID, tlsConfig, r.settings.Logger)
+	return r.subscriber.start(handle)
+}
+
+func (r *mqttReceiver) Shutdown(ctx context.Context) error {
+	if r.subscriber == nil {
+		return nil
+	}
+	return r.subscriber.shutdown(ctx)
+}
+
+// loadEncoding sets the unmarshaler of the payloads and returns the handler of the messages of the
+// signal of the receiver.
+func (r *mqttReceiver) loadEncoding(host component.Host) (func(message), error) {
+	var ext component.Component
+	if r.cfg.Encoding != nil {
+		var ok bool
+		if ext, ok = host.GetExtensions()[*r.cfg.Encoding]; !ok {
+			return nil, fmt.Errorf("unknown encoding extension %q", r.cfg.Encoding)
+		}
+		r.format = r.cfg.Encoding.String()
+	}
+
+	if r.nextLogs != nil {
+		r.logsUnmarshaler = rawLogsUnmarshaler{}
+		if r.format == "" {
+			r.format = defaultLogsFormat
+		}
+		if ext != nil {
+			u, ok := ext.(plog.Unmarshaler)
+			if !ok {
+				return nil, fmt.Errorf("extension %q is not a logs unmarshaler", r.cfg.Encoding)
+			}
+			r.logsUnmarshaler = u
+		}
+		return r.handleLogs, nil
+	}
+
+	r.metricsUnmarshaler = &pmetric.ProtoUnmarshaler{}
+	if r.format == "" {
+		r.format = defaultMetricsFormat
+	}
+	if ext != nil {
+		u, ok := ext.(pmetric.Unmarshaler)
+		if !ok {
+			return nil, fmt.Errorf("extension %q is not a metrics unmarshaler", r.cfg.Encoding)
+		}
+		r.metricsUnmarshaler = u
+	}
+	return r.handleMetrics, nil
+}
+
+func (r *mqttReceiver) handleLogs(msg message) {
+	ctx := r.obsrecv.StartLogsOp(context.Background())
+	logs, err := r.logsUnmarshaler.UnmarshalLogs(msg.payload)
+	if err != nil {
+		r.settings.Logger.Error("Failed to decode the message", zap.String("topic", msg.topic), zap.Error(err))
+		r.obsrecv.EndLogsOp(ctx, r.format, 0, err)
+		return
+	}
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		logs.ResourceLogs().At(i).Resource().Attributes().PutStr(attributeMQTTTopic, msg.topic)
+	}
+
+	err = r.nextLogs.ConsumeLogs(ctx, logs)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume the logs", zap.String("topic", msg.topic), zap.Error(err))
+	}
+	r.obsrecv.EndLogsOp(ctx, r.format, logs.LogRecordCount(), err)
+}
+
+func (r *mqttReceiver) handleMetrics(msg message) {
+	ctx := r.obsrecv.StartMetricsOp(context.Background())
+	metrics, err := r.metricsUnmarshaler.UnmarshalMetrics(msg.payload)
+	if err != nil {
+		r.settings.Logger.Error("Failed to decode the message", zap.String("topic", msg.topic), zap.Error(err))
+		r.obsrecv.EndMetricsOp(ctx, r.format, 0, err)
+		return
+	}
+	for i := 0; i < metrics.ResourceMetrics().Len(); i++ {
+		metrics.ResourceMetrics().At(i).Resource().Attributes().PutStr(attributeMQTTTopic, msg.topic)
+	}
+
+	err = r.nextMetrics.ConsumeMetrics(ctx, metrics)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume the metrics", zap.String("topic", msg.topic), zap.Error(err))
+	}
+	r.obsrecv.EndMetricsOp(ctx, r.format, metrics.DataPointCount(), err)
+}
+
+// rawLogsUnmarshaler creates a log record whose body is the payload, as a string when it is valid
+// UTF-8 and as bytes otherwise.
+type rawLogsUnmarshaler struct{}
+
+func (rawLogsUnmarshaler) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	lr := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	if utf8.Valid(buf) {
+		lr.Body().SetStr(string(buf))
+	} else {
+		lr.Body().SetEmptyBytes().FromRaw(buf)
+	}
+	return logs, nil
+}
+
+func randomClientID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate a client ID: %w", err)
+	}
+	return "otelcol-" + hex.EncodeToString(b), nil
+}
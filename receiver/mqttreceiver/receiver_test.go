@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mqttreceiver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+)
+
+// fakeSubscriber records the handler of the messages instead of connecting to a broker.
+type fakeSubscriber struct {
+	clientID string
+	handle   func(message)
+	stopped  bool
+}
+
+func (s *fakeSubscriber) start(handle func(message)) error {
+	s.handle = handle
+	return nil
+}
+
+func (s *fakeSubscriber) shutdown(_ context.Context) error {
+	s.stopped = true
+	return nil
+}
+
+func newTestReceiver(t *testing.T, cfg *Config) (*mqttReceiver, *fakeSubscriber) {
+	r, err := newMQTTReceiver(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, err)
+	fake := &fakeSubscriber{}
+	r.newSubscriber = func(_ *Config, clientID string, _ *tls.Config, _ *zap.Logger) subscriber {
+		fake.clientID = clientID
+		return fake
+	}
+	return r, fake
+}
+
+// testHost is a host providing the given extensions.
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// csvLogsUnmarshaler is an encoding extension creating a log record per comma separated value.
+type csvLogsUnmarshaler struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func (csvLogsUnmarshaler) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	if len(buf) == 0 {
+		return plog.Logs{}, errors.New("empty payload")
+	}
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	start := 0
+	for i := 0; i <= len(buf); i++ {
+		if i == len(buf) || buf[i] == ',' {
+			records.AppendEmpty().Body().SetStr(string(buf[start:i]))
+			start = i + 1
+		}
+	}
+	return logs, nil
+}
+
+func TestLogsReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientID = "otelcol-test"
+	sink := new(consumertest.LogsSink)
+	r, fake := newTestReceiver(t, cfg)
+	r.nextLogs = sink
+
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, "otelcol-test", fake.clientID)
+
+	fake.handle(message{topic: "factory/line1/status", payload: []byte("running")})
+	fake.handle(message{topic: "factory/line2/status", payload: []byte{0xff, 0x00}})
+
+	require.Len(t, sink.AllLogs(), 2)
+	logs := sink.AllLogs()[0]
+	assert.Equal(t, map[string]any{"mqtt.topic": "factory/line1/status"}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "running", record.Body().Str())
+	assert.NotZero(t, record.ObservedTimestamp())
+
+	// Payloads that aren't valid UTF-8 are kept as bytes.
+	record = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.ValueTypeBytes, record.Body().Type())
+	assert.Equal(t, []byte{0xff, 0x00}, record.Body().Bytes().AsRaw())
+
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.True(t, fake.stopped)
+}
+
+func TestLogsReceiverEncoding(t *testing.T) {
+	encoding := component.MustNewID("csv")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encoding = &encoding
+	sink := new(consumertest.LogsSink)
+	r, fake := newTestReceiver(t, cfg)
+	r.nextLogs = sink
+
+	host := testHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{encoding: csvLogsUnmarshaler{}}}
+	require.NoError(t, r.Start(context.Background(), host))
+	assert.Contains(t, fake.clientID, "otelcol-")
+
+	fake.handle(message{topic: "factory/events", payload: []byte("started,stopped")})
+	// Messages that can't be decoded are dropped.
+	fake.handle(message{topic: "factory/events", payload: nil})
+
+	require.Len(t, sink.AllLogs(), 1)
+	assert.Equal(t, 2, sink.LogRecordCount())
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "started", records.At(0).Body().Str())
+	assert.Equal(t, "stopped", records.At(1).Body().Str())
+}
+
+func TestMetricsReceiver(t *testing.T) {
+	sink := new(consumertest.MetricsSink)
+	r, fake := newTestReceiver(t, createDefaultConfig().(*Config))
+	r.nextMetrics = sink
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	metrics := pmetric.NewMetrics()
+	m := metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("temperature")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(21.5)
+	payload, err := (&pmetric.ProtoMarshaler{}).MarshalMetrics(metrics)
+	require.NoError(t, err)
+
+	fake.handle(message{topic: "factory/line1/temperature", payload: payload})
+	fake.handle(message{topic: "factory/line1/temperature", payload: []byte("21.5")})
+
+	require.Len(t, sink.AllMetrics(), 1)
+	received := sink.AllMetrics()[0]
+	assert.Equal(t, map[string]any{"mqtt.topic": "factory/line1/temperature"}, received.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, 21.5, received.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints().At(0).DoubleValue())
+}
+
+func TestReceiverInvalidEncoding(t *testing.T) {
+	encoding := component.MustNewID("csv")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Encoding = &encoding
+
+	r, _ := newTestReceiver(t, cfg)
+	r.nextLogs = consumertest.NewNop()
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), `unknown encoding extension "csv"`)
+
+	r, _ = newTestReceiver(t, cfg)
+	r.nextMetrics = consumertest.NewNop()
+	host := testHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{encoding: csvLogsUnmarshaler{}}}
+	assert.EqualError(t, r.Start(context.Background(), host), `extension "csv" is not a metrics unmarshaler`)
+}
+
+func TestReceiverLifecycleWithoutBroker(t *testing.T) {
+	for _, version := range []string{protocolVersion311, protocolVersion5} {
+		t.Run(version, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Broker = "tcp://localhost:1"
+			cfg.ProtocolVersion = version
+			cfg.Topics = []TopicConfig{{Filter: "factory/#"}}
+
+			r, err := newMQTTReceiver(cfg, receivertest.NewNopCreateSettings())
+			require.NoError(t, err)
+			r.nextLogs = consumertest.NewNop()
+			// The receiver starts while the broker is unavailable, and keeps trying to connect.
+			require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+			require.NoError(t, r.Shutdown(context.Background()))
+		})
+	}
+}
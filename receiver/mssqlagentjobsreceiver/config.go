@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver/internal/metadata"
+)
+
+// Config defines configuration for the SQL Server Agent jobs receiver.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+
+	Server   string              `mapstructure:"server"`
+	Port     uint                `mapstructure:"port"`
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+
+	// DataSource is a full connection string which, when set, is used instead of
+	// server, port, username and password.
+	DataSource configopaque.String `mapstructure:"datasource"`
+}
+
+func (cfg *Config) Validate() error {
+	if cfg.DataSource != "" {
+		if cfg.Server != "" || cfg.Username != "" || cfg.Password != "" {
+			return errors.New("datasource cannot be combined with server, username or password")
+		}
+		return nil
+	}
+	if cfg.Server == "" || cfg.Username == "" || cfg.Password == "" {
+		return errors.New("server, username and password must all be specified when datasource is not set")
+	}
+	return nil
+}
+
+// dataSource returns the connection string used to connect to the SQL Server instance.
+func (cfg *Config) dataSource() string {
+	if cfg.DataSource != "" {
+		return string(cfg.DataSource)
+	}
+	u := &url.URL{
+		Scheme:   "sqlserver",
+		User:     url.UserPassword(cfg.Username, string(cfg.Password)),
+		Host:     cfg.Server,
+		RawQuery: url.Values{"database": []string{"msdb"}}.Encode(),
+	}
+	if cfg.Port != 0 {
+		u.Host = fmt.Sprintf("%s:%d", cfg.Server, cfg.Port)
+	}
+	return u.String()
+}
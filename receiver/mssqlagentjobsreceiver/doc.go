@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package mssqlagentjobsreceiver collects SQL Server Agent job run history as metrics and failure logs.
+package mssqlagentjobsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver"
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver/internal/metadata"
+)
+
+var errConfigNotMSSQLAgentJobs = errors.New("config was not a mssqlagentjobs receiver config")
+
+// NewFactory creates a factory for the SQL Server Agent jobs receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
+}
+
+func createDefaultConfig() component.Config {
+	cfg := scraperhelper.NewDefaultControllerConfig()
+	cfg.CollectionInterval = time.Minute
+	return &Config{
+		ControllerConfig:     cfg,
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+func dbProviderFunc(cfg *Config) sqlquery.DbProviderFunc {
+	return func() (*sql.DB, error) {
+		return sql.Open("sqlserver", cfg.dataSource())
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotMSSQLAgentJobs
+	}
+
+	ms := newJobsScraper(params, cfg, dbProviderFunc(cfg), sqlquery.NewDbClient)
+	scraper, err := scraperhelper.NewScraper(metadata.Type.String(), ms.scrape,
+		scraperhelper.WithStart(ms.start),
+		scraperhelper.WithShutdown(ms.shutdown))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ControllerConfig, params, consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := rConf.(*Config)
+	if !ok {
+		return nil, errConfigNotMSSQLAgentJobs
+	}
+
+	return newLogsReceiver(params, cfg, dbProviderFunc(cfg), sqlquery.NewDbClient, consumer)
+}
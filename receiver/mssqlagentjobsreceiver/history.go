@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver/internal/metadata"
+)
+
+// jobHistoryQuery returns the job outcome rows (step_id 0) of msdb.dbo.sysjobhistory written after the
+// given instance_id. run_date and run_time are in the server's local time, so the server's offset from
+// UTC is returned alongside them.
+const jobHistoryQuery = `SELECT
+	h.instance_id,
+	j.name AS job_name,
+	ISNULL(c.name, '') AS category_name,
+	j.enabled,
+	h.run_status,
+	h.run_date,
+	h.run_time,
+	h.run_duration,
+	ISNULL(h.message, '') AS message,
+	DATEDIFF(minute, GETUTCDATE(), GETDATE()) AS utc_offset
+FROM msdb.dbo.sysjobhistory h
+JOIN msdb.dbo.sysjobs j ON h.job_id = j.job_id
+LEFT JOIN msdb.dbo.syscategories c ON j.category_id = c.category_id
+WHERE h.step_id = 0 AND h.instance_id > @p1
+ORDER BY h.instance_id`
+
+// lastInstanceIDQuery returns the instance_id of the most recent row of msdb.dbo.sysjobhistory.
+const lastInstanceIDQuery = `SELECT ISNULL(MAX(instance_id), 0) AS instance_id FROM msdb.dbo.sysjobhistory`
+
+// jobsQuery returns the jobs currently defined in msdb.dbo.sysjobs.
+const jobsQuery = `SELECT
+	j.name AS job_name,
+	ISNULL(c.name, '') AS category_name,
+	j.enabled
+FROM msdb.dbo.sysjobs j
+LEFT JOIN msdb.dbo.syscategories c ON j.category_id = c.category_id`
+
+// job is a job currently defined in SQL Server Agent.
+type job struct {
+	name     string
+	category string
+	enabled  bool
+}
+
+// jobRun is a single job outcome read from the SQL Server Agent job history.
+type jobRun struct {
+	instanceID int64
+	jobName    string
+	category   string
+	enabled    bool
+	outcome    metadata.AttributeOutcome
+	startTime  time.Time
+	duration   time.Duration
+	message    string
+}
+
+// jobHistory reads the job history incrementally, remembering the last instance_id it returned.
+type jobHistory struct {
+	dbProviderFunc     sqlquery.DbProviderFunc
+	clientProviderFunc sqlquery.ClientProviderFunc
+	logger             *zap.Logger
+	db                 *sql.DB
+	client             sqlquery.DbClient
+	lastIDClient       sqlquery.DbClient
+	jobsClient         sqlquery.DbClient
+	lastInstanceID     int64
+}
+
+func newJobHistory(logger *zap.Logger, dbProviderFunc sqlquery.DbProviderFunc, clientProviderFunc sqlquery.ClientProviderFunc) *jobHistory {
+	return &jobHistory{
+		dbProviderFunc:     dbProviderFunc,
+		clientProviderFunc: clientProviderFunc,
+		logger:             logger,
+	}
+}
+
+func (h *jobHistory) start() error {
+	var err error
+	h.db, err = h.dbProviderFunc()
+	if err != nil {
+		return fmt.Errorf("failed to open Db connection: %w", err)
+	}
+	h.client = h.clientProviderFunc(sqlquery.DbWrapper{Db: h.db}, jobHistoryQuery, h.logger, sqlquery.TelemetryConfig{})
+	h.lastIDClient = h.clientProviderFunc(sqlquery.DbWrapper{Db: h.db}, lastInstanceIDQuery, h.logger, sqlquery.TelemetryConfig{})
+	h.jobsClient = h.clientProviderFunc(sqlquery.DbWrapper{Db: h.db}, jobsQuery, h.logger, sqlquery.TelemetryConfig{})
+	return nil
+}
+
+// skipToEnd moves past the job runs recorded so far, so that the next call to next only returns
+// the runs recorded after this call.
+func (h *jobHistory) skipToEnd(ctx context.Context) error {
+	rows, err := h.lastIDClient.QueryRows(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rows) != 1 {
+		return fmt.Errorf("expected 1 row from the last instance_id query, got %d", len(rows))
+	}
+	lastInstanceID, err := strconv.ParseInt(rows[0]["instance_id"], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse instance_id %q: %w", rows[0]["instance_id"], err)
+	}
+	h.lastInstanceID = lastInstanceID
+	return nil
+}
+
+// next returns the job runs recorded since the previous call. Rows that cannot be parsed are
+// skipped and reported in the returned error.
+func (h *jobHistory) next(ctx context.Context) ([]jobRun, error) {
+	rows, err := h.client.QueryRows(ctx, h.lastInstanceID)
+	if err != nil {
+		if !errors.Is(err, sqlquery.ErrNullValueWarning) {
+			return nil, err
+		}
+		h.logger.Warn("problems encountered getting job history rows", zap.Error(err))
+	}
+
+	var errs error
+	runs := make([]jobRun, 0, len(rows))
+	for _, row := range rows {
+		run, err := parseJobRun(row)
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		if run.instanceID > h.lastInstanceID {
+			h.lastInstanceID = run.instanceID
+		}
+		runs = append(runs, run)
+	}
+	return runs, errs
+}
+
+// jobs returns the jobs currently defined, keyed by name.
+func (h *jobHistory) jobs(ctx context.Context) (map[string]job, error) {
+	rows, err := h.jobsClient.QueryRows(ctx)
+	if err != nil {
+		if !errors.Is(err, sqlquery.ErrNullValueWarning) {
+			return nil, err
+		}
+		h.logger.Warn("problems encountered getting job rows", zap.Error(err))
+	}
+
+	jobs := make(map[string]job, len(rows))
+	for _, row := range rows {
+		jobs[row["job_name"]] = job{
+			name:     row["job_name"],
+			category: row["category_name"],
+			enabled:  row["enabled"] == "1",
+		}
+	}
+	return jobs, nil
+}
+
+func (h *jobHistory) shutdown() error {
+	if h.db != nil {
+		return h.db.Close()
+	}
+	return nil
+}
+
+// runStatuses maps sysjobhistory.run_status to the outcome attribute.
+var runStatuses = map[string]metadata.AttributeOutcome{
+	"0": metadata.AttributeOutcomeFailed,
+	"1": metadata.AttributeOutcomeSucceeded,
+	"2": metadata.AttributeOutcomeRetry,
+	"3": metadata.AttributeOutcomeCanceled,
+	"4": metadata.AttributeOutcomeInProgress,
+}
+
+func parseJobRun(row sqlquery.StringMap) (jobRun, error) {
+	run := jobRun{
+		jobName:  row["job_name"],
+		category: row["category_name"],
+		enabled:  row["enabled"] == "1",
+		message:  row["message"],
+	}
+
+	var err error
+	if run.instanceID, err = strconv.ParseInt(row["instance_id"], 10, 64); err != nil {
+		return run, fmt.Errorf("failed to parse instance_id %q: %w", row["instance_id"], err)
+	}
+
+	var ok bool
+	if run.outcome, ok = runStatuses[row["run_status"]]; !ok {
+		return run, fmt.Errorf("unknown run_status %q for job %q", row["run_status"], run.jobName)
+	}
+
+	if run.startTime, err = parseRunStart(row["run_date"], row["run_time"], row["utc_offset"]); err != nil {
+		return run, fmt.Errorf("failed to parse start time of job %q: %w", run.jobName, err)
+	}
+
+	if run.duration, err = parseHHMMSS(row["run_duration"]); err != nil {
+		return run, fmt.Errorf("failed to parse run_duration of job %q: %w", run.jobName, err)
+	}
+	return run, nil
+}
+
+// parseRunStart converts run_date (yyyymmdd) and run_time (hhmmss) in the server's local time into UTC.
+func parseRunStart(runDate, runTime, utcOffset string) (time.Time, error) {
+	date, err := strconv.Atoi(runDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+	clock, err := parseHHMMSS(runTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	offset, err := strconv.Atoi(utcOffset)
+	if err != nil {
+		return time.Time{}, err
+	}
+	start := time.Date(date/10000, time.Month(date/100%100), date%100, 0, 0, 0, 0, time.UTC)
+	return start.Add(clock - time.Duration(offset)*time.Minute), nil
+}
+
+// parseHHMMSS parses the integer encoding used by sysjobhistory for run_time and run_duration,
+// where 1h 2m 3s is stored as 10203.
+func parseHHMMSS(s string) (time.Duration, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 {
+		return 0, fmt.Errorf("negative value %d", v)
+	}
+	return time.Duration(v/10000)*time.Hour +
+		time.Duration(v/100%100)*time.Minute +
+		time.Duration(v%100)*time.Second, nil
+}
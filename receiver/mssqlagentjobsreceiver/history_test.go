@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver/internal/metadata"
+)
+
+func historyRow(instanceID, job, status, duration string) sqlquery.StringMap {
+	return sqlquery.StringMap{
+		"instance_id":   instanceID,
+		"job_name":      job,
+		"category_name": "Database Maintenance",
+		"enabled":       "1",
+		"run_status":    status,
+		"run_date":      "20240603",
+		"run_time":      "231505",
+		"run_duration":  duration,
+		"message":       "The job " + status,
+		"utc_offset":    "120",
+	}
+}
+
+func TestParseJobRun(t *testing.T) {
+	run, err := parseJobRun(historyRow("42", "backup", "0", "10203"))
+	require.NoError(t, err)
+	assert.Equal(t, jobRun{
+		instanceID: 42,
+		jobName:    "backup",
+		category:   "Database Maintenance",
+		enabled:    true,
+		outcome:    metadata.AttributeOutcomeFailed,
+		startTime:  time.Date(2024, time.June, 3, 21, 15, 5, 0, time.UTC),
+		duration:   time.Hour + 2*time.Minute + 3*time.Second,
+		message:    "The job 0",
+	}, run)
+}
+
+func TestParseJobRunErrors(t *testing.T) {
+	testCases := []struct {
+		desc string
+		row  sqlquery.StringMap
+		err  string
+	}{
+		{
+			desc: "bad instance id",
+			row:  historyRow("x", "backup", "1", "5"),
+			err:  `failed to parse instance_id "x": strconv.ParseInt: parsing "x": invalid syntax`,
+		},
+		{
+			desc: "unknown status",
+			row:  historyRow("1", "backup", "9", "5"),
+			err:  `unknown run_status "9" for job "backup"`,
+		},
+		{
+			desc: "bad duration",
+			row:  historyRow("1", "backup", "1", "-5"),
+			err:  `failed to parse run_duration of job "backup": negative value -5`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := parseJobRun(tc.row)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func jobRow(job string) sqlquery.StringMap {
+	return sqlquery.StringMap{
+		"job_name":      job,
+		"category_name": "Database Maintenance",
+		"enabled":       "1",
+	}
+}
+
+func TestJobHistoryNext(t *testing.T) {
+	client := &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{historyRow("1", "backup", "1", "5"), historyRow("x", "backup", "1", "5"), historyRow("3", "index", "0", "7")},
+		{},
+	}}
+	h := newJobHistory(zap.NewNop(), nil, nil)
+	h.client = client
+
+	runs, err := h.next(context.Background())
+	assert.Error(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, int64(3), h.lastInstanceID)
+
+	runs, err = h.next(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, runs)
+	assert.Equal(t, int64(3), h.lastInstanceID)
+
+	client.Err = errors.New("connection refused")
+	_, err = h.next(context.Background())
+	assert.EqualError(t, err, "connection refused")
+}
+
+func TestJobHistoryJobs(t *testing.T) {
+	client := &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{jobRow("backup"), {"job_name": "index", "category_name": "", "enabled": "0"}},
+	}}
+	h := newJobHistory(zap.NewNop(), nil, nil)
+	h.jobsClient = client
+
+	jobs, err := h.jobs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]job{
+		"backup": {name: "backup", category: "Database Maintenance", enabled: true},
+		"index":  {name: "index"},
+	}, jobs)
+
+	client.Err = errors.New("connection refused")
+	_, err = h.jobs(context.Background())
+	assert.EqualError(t, err, "connection refused")
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver/internal/metadata"
+)
+
+var receiverScopeName = "otelcol/" + metadata.Type.String() + "receiver"
+
+const (
+	jobNameAttribute     = "sqlserver.agent.job.name"
+	jobCategoryAttribute = "sqlserver.agent.job.category"
+	serverAddrAttribute  = "server.address"
+	outcomeAttribute     = "outcome"
+	durationAttribute    = "sqlserver.agent.job.run.duration"
+	instanceIDAttribute  = "sqlserver.agent.job.history.id"
+)
+
+// logsReceiver emits a log record for every failed job run written to the job history after
+// the receiver started.
+type logsReceiver struct {
+	config       *Config
+	settings     receiver.CreateSettings
+	history      *jobHistory
+	nextConsumer consumer.Logs
+	obsrecv      *receiverhelper.ObsReport
+
+	// primed is set once the history available at startup has been skipped.
+	primed                   bool
+	collectionIntervalTicker *time.Ticker
+	shutdownRequested        chan struct{}
+	done                     chan struct{}
+}
+
+func newLogsReceiver(
+	settings receiver.CreateSettings,
+	config *Config,
+	dbProviderFunc sqlquery.DbProviderFunc,
+	clientProviderFunc sqlquery.ClientProviderFunc,
+	nextConsumer consumer.Logs,
+) (*logsReceiver, error) {
+	obsr, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &logsReceiver{
+		config:            config,
+		settings:          settings,
+		history:           newJobHistory(settings.Logger, dbProviderFunc, clientProviderFunc),
+		nextConsumer:      nextConsumer,
+		obsrecv:           obsr,
+		shutdownRequested: make(chan struct{}),
+		done:              make(chan struct{}),
+	}, nil
+}
+
+func (r *logsReceiver) Start(_ context.Context, _ component.Host) error {
+	if err := r.history.start(); err != nil {
+		return err
+	}
+
+	r.collectionIntervalTicker = time.NewTicker(r.config.CollectionInterval)
+	go func() {
+		defer close(r.done)
+		r.collect(context.Background())
+		for {
+			select {
+			case <-r.collectionIntervalTicker.C:
+				r.collect(context.Background())
+			case <-r.shutdownRequested:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *logsReceiver) collect(ctx context.Context) {
+	if !r.primed {
+		// The first collection only moves the cursor past the history that existed at startup.
+		if err := r.history.skipToEnd(ctx); err != nil {
+			r.settings.Logger.Error("error reading the end of the job history", zap.Error(err))
+			return
+		}
+		r.primed = true
+		return
+	}
+
+	runs, err := r.history.next(ctx)
+	if err != nil {
+		r.settings.Logger.Error("error reading job history", zap.Error(err))
+	}
+
+	logs := r.toLogs(runs)
+	logRecordCount := logs.LogRecordCount()
+	if logRecordCount == 0 {
+		return
+	}
+	obsCtx := r.obsrecv.StartLogsOp(ctx)
+	err = r.nextConsumer.ConsumeLogs(ctx, logs)
+	r.obsrecv.EndLogsOp(obsCtx, metadata.Type.String(), logRecordCount, err)
+	if err != nil {
+		r.settings.Logger.Error("failed to send logs", zap.Error(err))
+	}
+}
+
+func (r *logsReceiver) toLogs(runs []jobRun) plog.Logs {
+	logs := plog.NewLogs()
+	var records plog.LogRecordSlice
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	for _, run := range runs {
+		if run.outcome != metadata.AttributeOutcomeFailed {
+			continue
+		}
+		if logs.ResourceLogs().Len() == 0 {
+			resourceLogs := logs.ResourceLogs().AppendEmpty()
+			if r.config.Server != "" {
+				resourceLogs.Resource().Attributes().PutStr(serverAddrAttribute, r.config.Server)
+			}
+			scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+			scopeLogs.Scope().SetName(receiverScopeName)
+			records = scopeLogs.LogRecords()
+		}
+
+		record := records.AppendEmpty()
+		record.SetTimestamp(pcommon.NewTimestampFromTime(run.startTime))
+		record.SetObservedTimestamp(observed)
+		record.SetSeverityNumber(plog.SeverityNumberError)
+		record.SetSeverityText(plog.SeverityNumberError.String())
+		record.Body().SetStr(run.message)
+		attrs := record.Attributes()
+		attrs.PutStr(jobNameAttribute, run.jobName)
+		attrs.PutStr(jobCategoryAttribute, run.category)
+		attrs.PutStr(outcomeAttribute, run.outcome.String())
+		attrs.PutDouble(durationAttribute, run.duration.Seconds())
+		attrs.PutInt(instanceIDAttribute, run.instanceID)
+	}
+	return logs
+}
+
+func (r *logsReceiver) Shutdown(_ context.Context) error {
+	if r.collectionIntervalTicker != nil {
+		r.collectionIntervalTicker.Stop()
+		close(r.shutdownRequested)
+		<-r.done
+	}
+	return r.history.shutdown()
+}
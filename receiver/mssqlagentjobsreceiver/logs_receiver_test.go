@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+)
+
+func newTestLogsReceiver(t *testing.T, lastIDClient, client *sqlquery.FakeDBClient, sink *consumertest.LogsSink) *logsReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Server = "sqlserver.example.com"
+	r, err := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, nil, nil, sink)
+	require.NoError(t, err)
+	r.history.client = client
+	r.history.lastIDClient = lastIDClient
+	return r
+}
+
+func TestLogsReceiverCollect(t *testing.T) {
+	lastIDClient := &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{{"instance_id": "1"}}}}
+	client := &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{historyRow("2", "backup", "1", "12"), historyRow("3", "index", "0", "10203")},
+		{},
+	}}
+	sink := &consumertest.LogsSink{}
+	r := newTestLogsReceiver(t, lastIDClient, client, sink)
+
+	// Failures that happened before the receiver started are not read.
+	r.collect(context.Background())
+	assert.Equal(t, 0, client.RequestCounter)
+	assert.Equal(t, 0, sink.LogRecordCount())
+
+	r.collect(context.Background())
+	r.collect(context.Background())
+	assert.Equal(t, []any{int64(1)}, client.Args[0])
+	assert.Equal(t, []any{int64(3)}, client.Args[1])
+	require.Len(t, sink.AllLogs(), 1)
+	logs := sink.AllLogs()[0]
+	require.Equal(t, 1, logs.LogRecordCount())
+
+	resourceLogs := logs.ResourceLogs().At(0)
+	addr, ok := resourceLogs.Resource().Attributes().Get(serverAddrAttribute)
+	require.True(t, ok)
+	assert.Equal(t, "sqlserver.example.com", addr.Str())
+	assert.Equal(t, receiverScopeName, resourceLogs.ScopeLogs().At(0).Scope().Name())
+
+	record := resourceLogs.ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, plog.SeverityNumberError, record.SeverityNumber())
+	assert.Equal(t, "The job 0", record.Body().Str())
+	assert.Equal(t, map[string]any{
+		jobNameAttribute:     "index",
+		jobCategoryAttribute: "Database Maintenance",
+		outcomeAttribute:     "failed",
+		durationAttribute:    3723.0,
+		instanceIDAttribute:  int64(3),
+	}, record.Attributes().AsRaw())
+}
+
+func TestLogsReceiverCollectError(t *testing.T) {
+	lastIDClient := &sqlquery.FakeDBClient{Err: errors.New("connection refused")}
+	client := &sqlquery.FakeDBClient{}
+	sink := &consumertest.LogsSink{}
+	r := newTestLogsReceiver(t, lastIDClient, client, sink)
+
+	r.collect(context.Background())
+	assert.False(t, r.primed)
+
+	lastIDClient.Err = nil
+	lastIDClient.StringMaps = [][]sqlquery.StringMap{{{"instance_id": "1"}}}
+	r.collect(context.Background())
+	assert.True(t, r.primed)
+	assert.Equal(t, int64(1), r.history.lastInstanceID)
+	assert.Equal(t, 0, client.RequestCounter)
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
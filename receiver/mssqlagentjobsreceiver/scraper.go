@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver"
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/mssqlagentjobsreceiver/internal/metadata"
+)
+
+// outcomes lists the outcome attribute values in the order their datapoints are recorded.
+var outcomes = []metadata.AttributeOutcome{
+	metadata.AttributeOutcomeFailed,
+	metadata.AttributeOutcomeSucceeded,
+	metadata.AttributeOutcomeRetry,
+	metadata.AttributeOutcomeCanceled,
+	metadata.AttributeOutcomeInProgress,
+}
+
+// jobState is the state accumulated for a job from the runs observed so far.
+type jobState struct {
+	category     string
+	enabled      bool
+	runs         map[metadata.AttributeOutcome]int64
+	lastOutcome  metadata.AttributeOutcome
+	lastDuration time.Duration
+}
+
+type jobsScraper struct {
+	cfg     *Config
+	history *jobHistory
+	mb      *metadata.MetricsBuilder
+	jobs    map[string]*jobState
+}
+
+func newJobsScraper(
+	params receiver.CreateSettings,
+	cfg *Config,
+	dbProviderFunc sqlquery.DbProviderFunc,
+	clientProviderFunc sqlquery.ClientProviderFunc,
+) *jobsScraper {
+	return &jobsScraper{
+		cfg:     cfg,
+		history: newJobHistory(params.Logger, dbProviderFunc, clientProviderFunc),
+		mb:      metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, params),
+		jobs:    make(map[string]*jobState),
+	}
+}
+
+func (s *jobsScraper) start(context.Context, component.Host) error {
+	return s.history.start()
+}
+
+func (s *jobsScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	runs, err := s.history.next(ctx)
+	if err != nil && len(runs) == 0 {
+		return pmetric.NewMetrics(), err
+	}
+	for _, run := range runs {
+		s.observe(run)
+	}
+
+	// The state of the deleted jobs is dropped, so that they are no longer reported.
+	jobs, jobsErr := s.history.jobs(ctx)
+	if jobsErr != nil {
+		return pmetric.NewMetrics(), multierr.Append(err, jobsErr)
+	}
+	for name, state := range s.jobs {
+		current, ok := jobs[name]
+		if !ok {
+			delete(s.jobs, name)
+			continue
+		}
+		state.category = current.category
+		state.enabled = current.enabled
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		job := s.jobs[name]
+		for _, outcome := range outcomes {
+			if count, ok := job.runs[outcome]; ok {
+				s.mb.RecordSqlserverAgentJobRunsDataPoint(now, count, outcome)
+			}
+		}
+		for _, outcome := range outcomes {
+			value := int64(0)
+			if outcome == job.lastOutcome {
+				value = 1
+			}
+			s.mb.RecordSqlserverAgentJobLastRunOutcomeDataPoint(now, value, outcome)
+		}
+		s.mb.RecordSqlserverAgentJobLastRunDurationDataPoint(now, job.lastDuration.Seconds())
+		enabled := int64(0)
+		if job.enabled {
+			enabled = 1
+		}
+		s.mb.RecordSqlserverAgentJobEnabledDataPoint(now, enabled)
+
+		rb := s.mb.NewResourceBuilder()
+		rb.SetSqlserverAgentJobName(name)
+		rb.SetSqlserverAgentJobCategory(job.category)
+		if s.cfg.Server != "" {
+			rb.SetServerAddress(s.cfg.Server)
+		}
+		s.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+
+	if err != nil {
+		return s.mb.Emit(), scrapererror.NewPartialScrapeError(err, len(multierr.Errors(err))*s.runMetricsCount())
+	}
+	return s.mb.Emit(), nil
+}
+
+// runMetricsCount returns the number of enabled metrics recorded from the runs of a job, which are
+// the metrics missing a run when a history row cannot be parsed.
+func (s *jobsScraper) runMetricsCount() int {
+	count := 0
+	for _, enabled := range []bool{
+		s.cfg.Metrics.SqlserverAgentJobRuns.Enabled,
+		s.cfg.Metrics.SqlserverAgentJobLastRunOutcome.Enabled,
+		s.cfg.Metrics.SqlserverAgentJobLastRunDuration.Enabled,
+	} {
+		if enabled {
+			count++
+		}
+	}
+	return count
+}
+
+// observe folds a job run into the state of its job. Runs are returned in history order, so the
+// last observed run is the most recent one.
+func (s *jobsScraper) observe(run jobRun) {
+	job, ok := s.jobs[run.jobName]
+	if !ok {
+		job = &jobState{runs: make(map[metadata.AttributeOutcome]int64)}
+		s.jobs[run.jobName] = job
+	}
+	job.category = run.category
+	job.enabled = run.enabled
+	job.runs[run.outcome]++
+	job.lastOutcome = run.outcome
+	job.lastDuration = run.duration
+}
+
+func (s *jobsScraper) shutdown(context.Context) error {
+	return s.history.shutdown()
+}
@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mssqlagentjobsreceiver
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+func newTestScraper(client, jobsClient *sqlquery.FakeDBClient) *jobsScraper {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Server = "sqlserver.example.com"
+	cfg.Metrics.SqlserverAgentJobEnabled.Enabled = true
+	cfg.ResourceAttributes.ServerAddress.Enabled = true
+	cfg.ResourceAttributes.SqlserverAgentJobCategory.Enabled = true
+
+	s := newJobsScraper(receivertest.NewNopCreateSettings(), cfg, nil, nil)
+	s.history.client = client
+	s.history.jobsClient = jobsClient
+	return s
+}
+
+func TestScrape(t *testing.T) {
+	s := newTestScraper(&sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{
+			historyRow("1", "backup", "1", "130"),
+			historyRow("2", "index", "1", "5"),
+			historyRow("3", "backup", "0", "12"),
+		},
+		{
+			historyRow("4", "backup", "1", "200"),
+		},
+	}}, &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{jobRow("backup"), jobRow("index")},
+		{jobRow("backup"), jobRow("index"), jobRow("cleanup")},
+	}})
+
+	_, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	actualMetrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "expected_metrics.yaml")
+	expectedMetrics, err := golden.ReadMetrics(expectedFile)
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder()))
+}
+
+func TestScrapePartialError(t *testing.T) {
+	s := newTestScraper(&sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{
+			historyRow("1", "backup", "1", "130"),
+			historyRow("2", "backup", "7", "5"),
+		},
+	}}, &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{jobRow("backup")},
+	}})
+
+	metrics, err := s.scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	var scraperErr scrapererror.PartialScrapeError
+	require.ErrorAs(t, err, &scraperErr)
+	// The skipped row is missing from the three run metrics enabled by default.
+	assert.Equal(t, 3, scraperErr.Failed)
+	assert.Equal(t, 1, metrics.ResourceMetrics().Len())
+}
+
+func TestScrapeDeletedJobs(t *testing.T) {
+	jobsClient := &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{jobRow("backup"), jobRow("index")},
+		{jobRow("backup")},
+	}}
+	s := newTestScraper(&sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{
+		{
+			historyRow("1", "backup", "1", "130"),
+			historyRow("2", "index", "1", "5"),
+		},
+		{},
+		{},
+	}}, jobsClient)
+
+	metrics, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, metrics.ResourceMetrics().Len())
+
+	// The index job was deleted.
+	metrics, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, metrics.ResourceMetrics().Len())
+	name, _ := metrics.ResourceMetrics().At(0).Resource().Attributes().Get("sqlserver.agent.job.name")
+	assert.Equal(t, "backup", name.Str())
+	assert.Len(t, s.jobs, 1)
+
+	jobsClient.Err = errors.New("connection refused")
+	_, err = s.scrape(context.Background())
+	assert.EqualError(t, err, "connection refused")
+	assert.Len(t, s.jobs, 1)
+}
@@ -25,6 +25,7 @@ type client interface {
 	getStatementEventsStats() ([]StatementEventStats, error)
 	getTableLockWaitEventStats() ([]tableLockWaitEventStats, error)
 	getReplicaStatusStats() ([]ReplicaStatusStats, error)
+	getReplicationChannelStats() ([]ReplicationChannelStats, error)
 	Close() error
 }
 
@@ -34,6 +35,7 @@ type mySQLClient struct {
 	statementEventsDigestTextLimit int
 	statementEventsLimit           int
 	statementEventsTimeLimit       time.Duration
+	tableIoWaitsLimit              int
 }
 
 type IoWaitsStats struct {
@@ -51,6 +53,8 @@ type IoWaitsStats struct {
 
 type TableIoWaitsStats struct {
 	IoWaitsStats
+	avgTimerRead  int64
+	avgTimerWrite int64
 }
 
 type IndexIoWaitsStats struct {
@@ -109,6 +113,13 @@ type tableLockWaitEventStats struct {
 	sumTimerWriteExternal         int64
 }
 
+type ReplicationChannelStats struct {
+	channelName string
+	// missingGtidSet is the set of the GTIDs received from the source but not executed yet.
+	missingGtidSet string
+	applierLag     float64
+}
+
 type ReplicaStatusStats struct {
 	replicaIOState            string
 	sourceHost                string
@@ -205,6 +216,7 @@ func newMySQLClient(conf *Config) (client, error) {
 		statementEventsDigestTextLimit: conf.StatementEvents.DigestTextLimit,
 		statementEventsLimit:           conf.StatementEvents.Limit,
 		statementEventsTimeLimit:       conf.StatementEvents.TimeLimit,
+		tableIoWaitsLimit:              conf.TableIoWaits.Limit,
 	}, nil
 }
 
@@ -271,10 +283,14 @@ func (c *mySQLClient) getTableStats() ([]TableStats, error) {
 func (c *mySQLClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 	query := "SELECT OBJECT_SCHEMA, OBJECT_NAME, " +
 		"COUNT_DELETE, COUNT_FETCH, COUNT_INSERT, COUNT_UPDATE," +
-		"SUM_TIMER_DELETE, SUM_TIMER_FETCH, SUM_TIMER_INSERT, SUM_TIMER_UPDATE " +
+		"SUM_TIMER_DELETE, SUM_TIMER_FETCH, SUM_TIMER_INSERT, SUM_TIMER_UPDATE, " +
+		"AVG_TIMER_READ, AVG_TIMER_WRITE " +
 		"FROM performance_schema.table_io_waits_summary_by_table " +
-		"WHERE OBJECT_SCHEMA NOT IN ('mysql', 'performance_schema');"
-	rows, err := c.client.Query(query)
+		"WHERE OBJECT_SCHEMA NOT IN ('mysql', 'performance_schema')"
+	if c.tableIoWaitsLimit > 0 {
+		query += fmt.Sprintf(" ORDER BY SUM_TIMER_WAIT DESC LIMIT %d", c.tableIoWaitsLimit)
+	}
+	rows, err := c.client.Query(query + ";")
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +300,8 @@ func (c *mySQLClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 		var s TableIoWaitsStats
 		err := rows.Scan(&s.schema, &s.name,
 			&s.countDelete, &s.countFetch, &s.countInsert, &s.countUpdate,
-			&s.timeDelete, &s.timeFetch, &s.timeInsert, &s.timeUpdate)
+			&s.timeDelete, &s.timeFetch, &s.timeInsert, &s.timeUpdate,
+			&s.avgTimerRead, &s.avgTimerWrite)
 		if err != nil {
 			return nil, err
 		}
@@ -399,11 +416,16 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 		return nil, err
 	}
 
-	if version < "8.0.22" {
+	if strings.Contains(version, "MariaDB") {
 		return nil, nil
 	}
 
+	// Before 8.0.22 the replica status is only available with the former terminology,
+	// its columns are renamed below.
 	query := "SHOW REPLICA STATUS"
+	if version < "8.0.22" {
+		query = "SHOW SLAVE STATUS"
+	}
 	rows, err := c.client.Query(query)
 
 	if err != nil {
@@ -421,7 +443,7 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 		var s ReplicaStatusStats
 		dest := []any{}
 		for _, col := range cols {
-			switch strings.ToLower(col) {
+			switch replicaStatusColumnReplacer.Replace(strings.ToLower(col)) {
 			case "replica_io_state":
 				dest = append(dest, &s.replicaIOState)
 			case "source_host":
@@ -557,6 +579,47 @@ func (c *mySQLClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 	return stats, nil
 }
 
+// replicaStatusColumnReplacer maps the columns of SHOW SLAVE STATUS to the ones of SHOW REPLICA STATUS.
+var replicaStatusColumnReplacer = strings.NewReplacer("master", "source", "slave", "replica")
+
+// getReplicationChannelStats queries performance_schema for the GTIDs not executed yet and the applier lag of
+// every replication channel.
+func (c *mySQLClient) getReplicationChannelStats() ([]ReplicationChannelStats, error) {
+	version, err := c.getVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	// the timestamps of the applied transactions were added to performance_schema in 8.0
+	if strings.Contains(version, "MariaDB") || version < "8.0" {
+		return nil, nil
+	}
+
+	query := "SELECT c.CHANNEL_NAME, " +
+		"IFNULL(GTID_SUBTRACT(c.RECEIVED_TRANSACTION_SET, @@GLOBAL.gtid_executed), ''), " +
+		"IFNULL(MAX(IF(w.APPLYING_TRANSACTION = '', 0, " +
+		"TIMESTAMPDIFF(MICROSECOND, w.APPLYING_TRANSACTION_ORIGINAL_COMMIT_TIMESTAMP, NOW(6)))), 0) / 1000000 " +
+		"FROM performance_schema.replication_connection_status c " +
+		"LEFT JOIN performance_schema.replication_applier_status_by_worker w ON w.CHANNEL_NAME = c.CHANNEL_NAME " +
+		"GROUP BY c.CHANNEL_NAME, c.RECEIVED_TRANSACTION_SET;"
+	rows, err := c.client.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []ReplicationChannelStats
+	for rows.Next() {
+		var s ReplicationChannelStats
+		if err := rows.Scan(&s.channelName, &s.missingGtidSet, &s.applierLag); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, nil
+}
+
 func query(c mySQLClient, query string) (map[string]string, error) {
 	rows, err := c.client.Query(query)
 	if err != nil {
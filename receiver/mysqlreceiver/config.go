@@ -31,6 +31,7 @@ type Config struct {
 	TLS                            configtls.ClientConfig        `mapstructure:"tls,omitempty"`
 	MetricsBuilderConfig           metadata.MetricsBuilderConfig `mapstructure:",squash"`
 	StatementEvents                StatementEventsConfig         `mapstructure:"statement_events"`
+	TableIoWaits                   TableIoWaitsConfig            `mapstructure:"table_io_waits"`
 }
 
 type StatementEventsConfig struct {
@@ -39,6 +40,12 @@ type StatementEventsConfig struct {
 	TimeLimit       time.Duration `mapstructure:"time_limit"`
 }
 
+type TableIoWaitsConfig struct {
+	// Limit is the maximum number of tables, with the highest total I/O wait time, reported by the
+	// mysql.table.io.wait.* metrics. 0 means no limit.
+	Limit int `mapstructure:"limit"`
+}
+
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
 		// Nothing to do if there is no config given.
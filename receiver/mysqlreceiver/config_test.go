@@ -61,3 +61,26 @@ func TestLoadConfigDefaultTLS(t *testing.T) {
 
 	require.Equal(t, expected, cfg)
 }
+
+func TestLoadConfigTableIoWaits(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "").String() + "/table_io_waits")
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.Endpoint = "localhost:3306"
+	expected.Username = "otel"
+	expected.Password = "${env:MYSQL_PASSWORD}"
+	expected.Database = "otel"
+	expected.TableIoWaits.Limit = 50
+	// This defaults to true when tls is omitted from the configmap.
+	expected.TLS.Insecure = true
+
+	require.Equal(t, expected, cfg)
+}
@@ -54,6 +54,8 @@ type MetricsConfig struct {
 	MysqlQueryClientCount        MetricConfig `mapstructure:"mysql.query.client.count"`
 	MysqlQueryCount              MetricConfig `mapstructure:"mysql.query.count"`
 	MysqlQuerySlowCount          MetricConfig `mapstructure:"mysql.query.slow.count"`
+	MysqlReplicaApplierLag       MetricConfig `mapstructure:"mysql.replica.applier.lag"`
+	MysqlReplicaGtidGap          MetricConfig `mapstructure:"mysql.replica.gtid.gap"`
 	MysqlReplicaSQLDelay         MetricConfig `mapstructure:"mysql.replica.sql_delay"`
 	MysqlReplicaTimeBehindSource MetricConfig `mapstructure:"mysql.replica.time_behind_source"`
 	MysqlRowLocks                MetricConfig `mapstructure:"mysql.row_locks"`
@@ -63,6 +65,7 @@ type MetricsConfig struct {
 	MysqlStatementEventWaitTime  MetricConfig `mapstructure:"mysql.statement_event.wait.time"`
 	MysqlTableAverageRowLength   MetricConfig `mapstructure:"mysql.table.average_row_length"`
 	MysqlTableIoWaitCount        MetricConfig `mapstructure:"mysql.table.io.wait.count"`
+	MysqlTableIoWaitLatency      MetricConfig `mapstructure:"mysql.table.io.wait.latency"`
 	MysqlTableIoWaitTime         MetricConfig `mapstructure:"mysql.table.io.wait.time"`
 	MysqlTableLockWaitReadCount  MetricConfig `mapstructure:"mysql.table.lock_wait.read.count"`
 	MysqlTableLockWaitReadTime   MetricConfig `mapstructure:"mysql.table.lock_wait.read.time"`
@@ -156,6 +159,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlQuerySlowCount: MetricConfig{
 			Enabled: false,
 		},
+		MysqlReplicaApplierLag: MetricConfig{
+			Enabled: false,
+		},
+		MysqlReplicaGtidGap: MetricConfig{
+			Enabled: false,
+		},
 		MysqlReplicaSQLDelay: MetricConfig{
 			Enabled: false,
 		},
@@ -183,6 +192,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		MysqlTableIoWaitCount: MetricConfig{
 			Enabled: true,
 		},
+		MysqlTableIoWaitLatency: MetricConfig{
+			Enabled: false,
+		},
 		MysqlTableIoWaitTime: MetricConfig{
 			Enabled: true,
 		},
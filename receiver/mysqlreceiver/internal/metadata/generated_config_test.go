@@ -51,6 +51,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlQueryClientCount:        MetricConfig{Enabled: true},
 					MysqlQueryCount:              MetricConfig{Enabled: true},
 					MysqlQuerySlowCount:          MetricConfig{Enabled: true},
+					MysqlReplicaApplierLag:       MetricConfig{Enabled: true},
+					MysqlReplicaGtidGap:          MetricConfig{Enabled: true},
 					MysqlReplicaSQLDelay:         MetricConfig{Enabled: true},
 					MysqlReplicaTimeBehindSource: MetricConfig{Enabled: true},
 					MysqlRowLocks:                MetricConfig{Enabled: true},
@@ -60,6 +62,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlStatementEventWaitTime:  MetricConfig{Enabled: true},
 					MysqlTableAverageRowLength:   MetricConfig{Enabled: true},
 					MysqlTableIoWaitCount:        MetricConfig{Enabled: true},
+					MysqlTableIoWaitLatency:      MetricConfig{Enabled: true},
 					MysqlTableIoWaitTime:         MetricConfig{Enabled: true},
 					MysqlTableLockWaitReadCount:  MetricConfig{Enabled: true},
 					MysqlTableLockWaitReadTime:   MetricConfig{Enabled: true},
@@ -107,6 +110,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlQueryClientCount:        MetricConfig{Enabled: false},
 					MysqlQueryCount:              MetricConfig{Enabled: false},
 					MysqlQuerySlowCount:          MetricConfig{Enabled: false},
+					MysqlReplicaApplierLag:       MetricConfig{Enabled: false},
+					MysqlReplicaGtidGap:          MetricConfig{Enabled: false},
 					MysqlReplicaSQLDelay:         MetricConfig{Enabled: false},
 					MysqlReplicaTimeBehindSource: MetricConfig{Enabled: false},
 					MysqlRowLocks:                MetricConfig{Enabled: false},
@@ -116,6 +121,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					MysqlStatementEventWaitTime:  MetricConfig{Enabled: false},
 					MysqlTableAverageRowLength:   MetricConfig{Enabled: false},
 					MysqlTableIoWaitCount:        MetricConfig{Enabled: false},
+					MysqlTableIoWaitLatency:      MetricConfig{Enabled: false},
 					MysqlTableIoWaitTime:         MetricConfig{Enabled: false},
 					MysqlTableLockWaitReadCount:  MetricConfig{Enabled: false},
 					MysqlTableLockWaitReadTime:   MetricConfig{Enabled: false},
@@ -882,6 +882,32 @@ var MapAttributeSorts = map[string]AttributeSorts{
 	"scan":         AttributeSortsScan,
 }
 
+// AttributeTableIoDirection specifies the a value table_io_direction attribute.
+type AttributeTableIoDirection int
+
+const (
+	_ AttributeTableIoDirection = iota
+	AttributeTableIoDirectionRead
+	AttributeTableIoDirectionWrite
+)
+
+// String returns the string representation of the AttributeTableIoDirection.
+func (av AttributeTableIoDirection) String() string {
+	switch av {
+	case AttributeTableIoDirectionRead:
+		return "read"
+	case AttributeTableIoDirectionWrite:
+		return "write"
+	}
+	return ""
+}
+
+// MapAttributeTableIoDirection is a helper map of string to AttributeTableIoDirection attribute value.
+var MapAttributeTableIoDirection = map[string]AttributeTableIoDirection{
+	"read":  AttributeTableIoDirectionRead,
+	"write": AttributeTableIoDirectionWrite,
+}
+
 // AttributeTableSizeType specifies the a value table_size_type attribute.
 type AttributeTableSizeType int
 
@@ -2382,6 +2408,108 @@ func newMetricMysqlQuerySlowCount(cfg MetricConfig) metricMysqlQuerySlowCount {
 	return m
 }
 
+type metricMysqlReplicaApplierLag struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.replica.applier.lag metric with initial data.
+func (m *metricMysqlReplicaApplierLag) init() {
+	m.data.SetName("mysql.replica.applier.lag")
+	m.data.SetDescription("The time elapsed since the original commit, on the source, of the oldest transaction being applied on a replication channel, 0 when the applier is idle.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlReplicaApplierLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, replicationChannelAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("channel", replicationChannelAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlReplicaApplierLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlReplicaApplierLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlReplicaApplierLag(cfg MetricConfig) metricMysqlReplicaApplierLag {
+	m := metricMysqlReplicaApplierLag{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricMysqlReplicaGtidGap struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.replica.gtid.gap metric with initial data.
+func (m *metricMysqlReplicaGtidGap) init() {
+	m.data.SetName("mysql.replica.gtid.gap")
+	m.data.SetDescription("The number of transactions received from the source on a replication channel but not yet executed by the replica.")
+	m.data.SetUnit("{transactions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlReplicaGtidGap) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicationChannelAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("channel", replicationChannelAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlReplicaGtidGap) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlReplicaGtidGap) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlReplicaGtidGap(cfg MetricConfig) metricMysqlReplicaGtidGap {
+	m := metricMysqlReplicaGtidGap{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlReplicaSQLDelay struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2863,6 +2991,59 @@ func newMetricMysqlTableIoWaitCount(cfg MetricConfig) metricMysqlTableIoWaitCoun
 	return m
 }
 
+type metricMysqlTableIoWaitLatency struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills mysql.table.io.wait.latency metric with initial data.
+func (m *metricMysqlTableIoWaitLatency) init() {
+	m.data.SetName("mysql.table.io.wait.latency")
+	m.data.SetDescription("The average latency of the read or write I/O wait events for a table.")
+	m.data.SetUnit("ns")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricMysqlTableIoWaitLatency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, tableNameAttributeValue string, schemaAttributeValue string, tableIoDirectionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("table", tableNameAttributeValue)
+	dp.Attributes().PutStr("schema", schemaAttributeValue)
+	dp.Attributes().PutStr("direction", tableIoDirectionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricMysqlTableIoWaitLatency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricMysqlTableIoWaitLatency) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricMysqlTableIoWaitLatency(cfg MetricConfig) metricMysqlTableIoWaitLatency {
+	m := metricMysqlTableIoWaitLatency{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMysqlTableIoWaitTime struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -3493,6 +3674,8 @@ type MetricsBuilder struct {
 	metricMysqlQueryClientCount        metricMysqlQueryClientCount
 	metricMysqlQueryCount              metricMysqlQueryCount
 	metricMysqlQuerySlowCount          metricMysqlQuerySlowCount
+	metricMysqlReplicaApplierLag       metricMysqlReplicaApplierLag
+	metricMysqlReplicaGtidGap          metricMysqlReplicaGtidGap
 	metricMysqlReplicaSQLDelay         metricMysqlReplicaSQLDelay
 	metricMysqlReplicaTimeBehindSource metricMysqlReplicaTimeBehindSource
 	metricMysqlRowLocks                metricMysqlRowLocks
@@ -3502,6 +3685,7 @@ type MetricsBuilder struct {
 	metricMysqlStatementEventWaitTime  metricMysqlStatementEventWaitTime
 	metricMysqlTableAverageRowLength   metricMysqlTableAverageRowLength
 	metricMysqlTableIoWaitCount        metricMysqlTableIoWaitCount
+	metricMysqlTableIoWaitLatency      metricMysqlTableIoWaitLatency
 	metricMysqlTableIoWaitTime         metricMysqlTableIoWaitTime
 	metricMysqlTableLockWaitReadCount  metricMysqlTableLockWaitReadCount
 	metricMysqlTableLockWaitReadTime   metricMysqlTableLockWaitReadTime
@@ -3557,6 +3741,8 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricMysqlQueryClientCount:        newMetricMysqlQueryClientCount(mbc.Metrics.MysqlQueryClientCount),
 		metricMysqlQueryCount:              newMetricMysqlQueryCount(mbc.Metrics.MysqlQueryCount),
 		metricMysqlQuerySlowCount:          newMetricMysqlQuerySlowCount(mbc.Metrics.MysqlQuerySlowCount),
+		metricMysqlReplicaApplierLag:       newMetricMysqlReplicaApplierLag(mbc.Metrics.MysqlReplicaApplierLag),
+		metricMysqlReplicaGtidGap:          newMetricMysqlReplicaGtidGap(mbc.Metrics.MysqlReplicaGtidGap),
 		metricMysqlReplicaSQLDelay:         newMetricMysqlReplicaSQLDelay(mbc.Metrics.MysqlReplicaSQLDelay),
 		metricMysqlReplicaTimeBehindSource: newMetricMysqlReplicaTimeBehindSource(mbc.Metrics.MysqlReplicaTimeBehindSource),
 		metricMysqlRowLocks:                newMetricMysqlRowLocks(mbc.Metrics.MysqlRowLocks),
@@ -3566,6 +3752,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricMysqlStatementEventWaitTime:  newMetricMysqlStatementEventWaitTime(mbc.Metrics.MysqlStatementEventWaitTime),
 		metricMysqlTableAverageRowLength:   newMetricMysqlTableAverageRowLength(mbc.Metrics.MysqlTableAverageRowLength),
 		metricMysqlTableIoWaitCount:        newMetricMysqlTableIoWaitCount(mbc.Metrics.MysqlTableIoWaitCount),
+		metricMysqlTableIoWaitLatency:      newMetricMysqlTableIoWaitLatency(mbc.Metrics.MysqlTableIoWaitLatency),
 		metricMysqlTableIoWaitTime:         newMetricMysqlTableIoWaitTime(mbc.Metrics.MysqlTableIoWaitTime),
 		metricMysqlTableLockWaitReadCount:  newMetricMysqlTableLockWaitReadCount(mbc.Metrics.MysqlTableLockWaitReadCount),
 		metricMysqlTableLockWaitReadTime:   newMetricMysqlTableLockWaitReadTime(mbc.Metrics.MysqlTableLockWaitReadTime),
@@ -3673,6 +3860,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlQueryClientCount.emit(ils.Metrics())
 	mb.metricMysqlQueryCount.emit(ils.Metrics())
 	mb.metricMysqlQuerySlowCount.emit(ils.Metrics())
+	mb.metricMysqlReplicaApplierLag.emit(ils.Metrics())
+	mb.metricMysqlReplicaGtidGap.emit(ils.Metrics())
 	mb.metricMysqlReplicaSQLDelay.emit(ils.Metrics())
 	mb.metricMysqlReplicaTimeBehindSource.emit(ils.Metrics())
 	mb.metricMysqlRowLocks.emit(ils.Metrics())
@@ -3682,6 +3871,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricMysqlStatementEventWaitTime.emit(ils.Metrics())
 	mb.metricMysqlTableAverageRowLength.emit(ils.Metrics())
 	mb.metricMysqlTableIoWaitCount.emit(ils.Metrics())
+	mb.metricMysqlTableIoWaitLatency.emit(ils.Metrics())
 	mb.metricMysqlTableIoWaitTime.emit(ils.Metrics())
 	mb.metricMysqlTableLockWaitReadCount.emit(ils.Metrics())
 	mb.metricMysqlTableLockWaitReadTime.emit(ils.Metrics())
@@ -3964,6 +4154,16 @@ func (mb *MetricsBuilder) RecordMysqlQuerySlowCountDataPoint(ts pcommon.Timestam
 	return nil
 }
 
+// RecordMysqlReplicaApplierLagDataPoint adds a data point to mysql.replica.applier.lag metric.
+func (mb *MetricsBuilder) RecordMysqlReplicaApplierLagDataPoint(ts pcommon.Timestamp, val float64, replicationChannelAttributeValue string) {
+	mb.metricMysqlReplicaApplierLag.recordDataPoint(mb.startTime, ts, val, replicationChannelAttributeValue)
+}
+
+// RecordMysqlReplicaGtidGapDataPoint adds a data point to mysql.replica.gtid.gap metric.
+func (mb *MetricsBuilder) RecordMysqlReplicaGtidGapDataPoint(ts pcommon.Timestamp, val int64, replicationChannelAttributeValue string) {
+	mb.metricMysqlReplicaGtidGap.recordDataPoint(mb.startTime, ts, val, replicationChannelAttributeValue)
+}
+
 // RecordMysqlReplicaSQLDelayDataPoint adds a data point to mysql.replica.sql_delay metric.
 func (mb *MetricsBuilder) RecordMysqlReplicaSQLDelayDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricMysqlReplicaSQLDelay.recordDataPoint(mb.startTime, ts, val)
@@ -4024,6 +4224,11 @@ func (mb *MetricsBuilder) RecordMysqlTableIoWaitCountDataPoint(ts pcommon.Timest
 	mb.metricMysqlTableIoWaitCount.recordDataPoint(mb.startTime, ts, val, ioWaitsOperationsAttributeValue.String(), tableNameAttributeValue, schemaAttributeValue)
 }
 
+// RecordMysqlTableIoWaitLatencyDataPoint adds a data point to mysql.table.io.wait.latency metric.
+func (mb *MetricsBuilder) RecordMysqlTableIoWaitLatencyDataPoint(ts pcommon.Timestamp, val int64, tableNameAttributeValue string, schemaAttributeValue string, tableIoDirectionAttributeValue AttributeTableIoDirection) {
+	mb.metricMysqlTableIoWaitLatency.recordDataPoint(mb.startTime, ts, val, tableNameAttributeValue, schemaAttributeValue, tableIoDirectionAttributeValue.String())
+}
+
 // RecordMysqlTableIoWaitTimeDataPoint adds a data point to mysql.table.io.wait.time metric.
 func (mb *MetricsBuilder) RecordMysqlTableIoWaitTimeDataPoint(ts pcommon.Timestamp, val int64, ioWaitsOperationsAttributeValue AttributeIoWaitsOperations, tableNameAttributeValue string, schemaAttributeValue string) {
 	mb.metricMysqlTableIoWaitTime.recordDataPoint(mb.startTime, ts, val, ioWaitsOperationsAttributeValue.String(), tableNameAttributeValue, schemaAttributeValue)
@@ -163,6 +163,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordMysqlQuerySlowCountDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordMysqlReplicaApplierLagDataPoint(ts, 1, "replication_channel-val")
+
+			allMetricsCount++
+			mb.RecordMysqlReplicaGtidGapDataPoint(ts, 1, "replication_channel-val")
+
 			allMetricsCount++
 			mb.RecordMysqlReplicaSQLDelayDataPoint(ts, 1)
 
@@ -194,6 +200,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordMysqlTableIoWaitCountDataPoint(ts, 1, AttributeIoWaitsOperationsDelete, "table_name-val", "schema-val")
 
+			allMetricsCount++
+			mb.RecordMysqlTableIoWaitLatencyDataPoint(ts, 1, "table_name-val", "schema-val", AttributeTableIoDirectionRead)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordMysqlTableIoWaitTimeDataPoint(ts, 1, AttributeIoWaitsOperationsDelete, "table_name-val", "schema-val")
@@ -697,6 +706,36 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "mysql.replica.applier.lag":
+					assert.False(t, validatedMetrics["mysql.replica.applier.lag"], "Found a duplicate in the metrics slice: mysql.replica.applier.lag")
+					validatedMetrics["mysql.replica.applier.lag"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The time elapsed since the original commit, on the source, of the oldest transaction being applied on a replication channel, 0 when the applier is idle.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("channel")
+					assert.True(t, ok)
+					assert.EqualValues(t, "replication_channel-val", attrVal.Str())
+				case "mysql.replica.gtid.gap":
+					assert.False(t, validatedMetrics["mysql.replica.gtid.gap"], "Found a duplicate in the metrics slice: mysql.replica.gtid.gap")
+					validatedMetrics["mysql.replica.gtid.gap"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The number of transactions received from the source on a replication channel but not yet executed by the replica.", ms.At(i).Description())
+					assert.Equal(t, "{transactions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("channel")
+					assert.True(t, ok)
+					assert.EqualValues(t, "replication_channel-val", attrVal.Str())
 				case "mysql.replica.sql_delay":
 					assert.False(t, validatedMetrics["mysql.replica.sql_delay"], "Found a duplicate in the metrics slice: mysql.replica.sql_delay")
 					validatedMetrics["mysql.replica.sql_delay"] = true
@@ -868,6 +907,27 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("schema")
 					assert.True(t, ok)
 					assert.EqualValues(t, "schema-val", attrVal.Str())
+				case "mysql.table.io.wait.latency":
+					assert.False(t, validatedMetrics["mysql.table.io.wait.latency"], "Found a duplicate in the metrics slice: mysql.table.io.wait.latency")
+					validatedMetrics["mysql.table.io.wait.latency"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average latency of the read or write I/O wait events for a table.", ms.At(i).Description())
+					assert.Equal(t, "ns", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("table")
+					assert.True(t, ok)
+					assert.EqualValues(t, "table_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("schema")
+					assert.True(t, ok)
+					assert.EqualValues(t, "schema-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "read", attrVal.Str())
 				case "mysql.table.io.wait.time":
 					assert.False(t, validatedMetrics["mysql.table.io.wait.time"], "Found a duplicate in the metrics slice: mysql.table.io.wait.time")
 					validatedMetrics["mysql.table.io.wait.time"] = true
@@ -6,7 +6,9 @@ package mysqlreceiver // import "github.com/open-telemetry/opentelemetry-collect
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -108,6 +110,7 @@ func (m *mySQLScraper) scrape(context.Context) (pmetric.Metrics, error) {
 
 	// colect replicas status metrics.
 	m.scrapeReplicaStatusStats(now)
+	m.scrapeReplicationChannelStats(now)
 
 	rb := m.mb.NewResourceBuilder()
 	rb.SetMysqlInstanceEndpoint(m.config.Endpoint)
@@ -462,6 +465,14 @@ func (m *mySQLScraper) scrapeTableIoWaitsStats(now pcommon.Timestamp, errs *scra
 		m.mb.RecordMysqlTableIoWaitTimeDataPoint(
 			now, s.timeUpdate/picosecondsInNanoseconds, metadata.AttributeIoWaitsOperationsUpdate, s.name, s.schema,
 		)
+
+		// latencies
+		m.mb.RecordMysqlTableIoWaitLatencyDataPoint(
+			now, s.avgTimerRead/picosecondsInNanoseconds, s.name, s.schema, metadata.AttributeTableIoDirectionRead,
+		)
+		m.mb.RecordMysqlTableIoWaitLatencyDataPoint(
+			now, s.avgTimerWrite/picosecondsInNanoseconds, s.name, s.schema, metadata.AttributeTableIoDirectionWrite,
+		)
 	}
 }
 
@@ -581,6 +592,63 @@ func (m *mySQLScraper) scrapeReplicaStatusStats(now pcommon.Timestamp) {
 	}
 }
 
+func (m *mySQLScraper) scrapeReplicationChannelStats(now pcommon.Timestamp) {
+	if !m.config.MetricsBuilderConfig.Metrics.MysqlReplicaApplierLag.Enabled &&
+		!m.config.MetricsBuilderConfig.Metrics.MysqlReplicaGtidGap.Enabled {
+		return
+	}
+
+	replicationChannelStats, err := m.sqlclient.getReplicationChannelStats()
+	if err != nil {
+		m.logger.Info("Failed to fetch replication channel stats", zap.Error(err))
+		return
+	}
+
+	for _, s := range replicationChannelStats {
+		m.mb.RecordMysqlReplicaApplierLagDataPoint(now, s.applierLag, s.channelName)
+
+		gap, err := countGtids(s.missingGtidSet)
+		if err != nil {
+			m.logger.Info("Failed to parse the missing GTID set", zap.String("channel", s.channelName), zap.Error(err))
+			continue
+		}
+		m.mb.RecordMysqlReplicaGtidGapDataPoint(now, gap, s.channelName)
+	}
+}
+
+// countGtids returns the number of transactions in a GTID set such as
+// 3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5:11,5A6B2C1D-71CA-11E1-9E33-C80AA9429562:23.
+// Tags of tagged GTIDs (uuid:tag:1-5) are ignored.
+func countGtids(gtidSet string) (int64, error) {
+	var count int64
+	for _, uuidSet := range strings.Split(gtidSet, ",") {
+		uuidSet = strings.TrimSpace(uuidSet)
+		if uuidSet == "" {
+			continue
+		}
+		parts := strings.Split(uuidSet, ":")
+		for _, interval := range parts[1:] {
+			start, end, isRange := strings.Cut(interval, "-")
+			first, err := strconv.ParseInt(start, 10, 64)
+			if err != nil {
+				if isRange {
+					return 0, fmt.Errorf("invalid GTID interval %q", interval)
+				}
+				// tag
+				continue
+			}
+			last := first
+			if isRange {
+				if last, err = strconv.ParseInt(end, 10, 64); err != nil || last < first {
+					return 0, fmt.Errorf("invalid GTID interval %q", interval)
+				}
+			}
+			count += last - first + 1
+		}
+	}
+	return count, nil
+}
+
 func addPartialIfError(errors *scrapererror.ScrapeErrors, err error) {
 	if err != nil {
 		errors.AddPartial(1, err)
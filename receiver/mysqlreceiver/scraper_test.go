@@ -10,6 +10,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -54,6 +55,9 @@ func TestScrape(t *testing.T) {
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaSQLDelay.Enabled = true
 		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaTimeBehindSource.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaApplierLag.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlReplicaGtidGap.Enabled = true
+		cfg.MetricsBuilderConfig.Metrics.MysqlTableIoWaitLatency.Enabled = true
 
 		cfg.MetricsBuilderConfig.Metrics.MysqlConnectionCount.Enabled = true
 
@@ -67,6 +71,7 @@ func TestScrape(t *testing.T) {
 			statementEventsFile:         "statement_events",
 			tableLockWaitEventStatsFile: "table_lock_wait_event_stats",
 			replicaStatusFile:           "replica_stats",
+			replicationChannelFile:      "replication_channel_stats",
 		}
 
 		scraper.renameCommands = true
@@ -105,6 +110,7 @@ func TestScrape(t *testing.T) {
 			statementEventsFile:         "statement_events_empty",
 			tableLockWaitEventStatsFile: "table_lock_wait_event_stats_empty",
 			replicaStatusFile:           "replica_stats_empty",
+			replicationChannelFile:      "replication_channel_stats_empty",
 		}
 
 		actualMetrics, scrapeErr := scraper.scrape(context.Background())
@@ -137,6 +143,7 @@ type mockClient struct {
 	statementEventsFile         string
 	tableLockWaitEventStatsFile string
 	replicaStatusFile           string
+	replicationChannelFile      string
 }
 
 func readFile(fname string) (map[string]string, error) {
@@ -219,6 +226,8 @@ func (c *mockClient) getTableIoWaitsStats() ([]TableIoWaitsStats, error) {
 		s.timeFetch, _ = parseInt(text[7])
 		s.timeInsert, _ = parseInt(text[8])
 		s.timeUpdate, _ = parseInt(text[9])
+		s.avgTimerRead, _ = parseInt(text[10])
+		s.avgTimerWrite, _ = parseInt(text[11])
 
 		stats = append(stats, s)
 	}
@@ -410,6 +419,55 @@ func (c *mockClient) getReplicaStatusStats() ([]ReplicaStatusStats, error) {
 	return stats, nil
 }
 
+func (c *mockClient) getReplicationChannelStats() ([]ReplicationChannelStats, error) {
+	var stats []ReplicationChannelStats
+	file, err := os.Open(filepath.Join("testdata", "scraper", c.replicationChannelFile+".txt"))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var s ReplicationChannelStats
+		text := strings.Split(scanner.Text(), "\t")
+
+		s.channelName = text[0]
+		s.missingGtidSet = text[1]
+		s.applierLag, _ = strconv.ParseFloat(text[2], 64)
+
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
 func (c *mockClient) Close() error {
 	return nil
 }
+
+func TestCountGtids(t *testing.T) {
+	tests := []struct {
+		name     string
+		gtidSet  string
+		expected int64
+		err      bool
+	}{
+		{name: "empty", gtidSet: "", expected: 0},
+		{name: "single transaction", gtidSet: "3E11FA47-71CA-11E1-9E33-C80AA9429562:23", expected: 1},
+		{name: "intervals", gtidSet: "3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5:11-18", expected: 13},
+		{name: "several sources", gtidSet: "3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5,\n2174B383-5441-11E8-B90A-C80AA9429562:1-3", expected: 8},
+		{name: "tagged", gtidSet: "3E11FA47-71CA-11E1-9E33-C80AA9429562:1-5:domain_1:1-2", expected: 7},
+		{name: "invalid interval", gtidSet: "3E11FA47-71CA-11E1-9E33-C80AA9429562:5-1", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := countGtids(tt.gtidSet)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, count)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+type Config struct {
+	// Endpoint is the URL of the NATS server, or a comma separated list of URLs of the servers of a cluster.
+	Endpoint string `mapstructure:"endpoint"`
+	// Subject is the subject the receiver subscribes to, which can include the * and > wildcards. In
+	// JetStream mode, it filters the messages of the stream delivered to the consumer.
+	Subject string `mapstructure:"subject"`
+	// QueueGroup, when set, subscribes to the subject as a member of the queue group, so that the messages
+	// are load balanced between the receivers of the group. It is not supported in JetStream mode.
+	QueueGroup string `mapstructure:"queue_group"`
+	// JetStream, when set, consumes the messages of a stream through a durable consumer instead of
+	// subscribing to the subject.
+	JetStream *JetStreamConfig `mapstructure:"jetstream"`
+	Auth      AuthConfig       `mapstructure:"auth"`
+	// TLSSetting, when set, secures the connection to the server.
+	TLSSetting *configtls.ClientConfig `mapstructure:"tls"`
+	// Encoding is the encoding extension used to decode the payloads. When it is not set, the payloads
+	// are used as log bodies.
+	Encoding *component.ID `mapstructure:"encoding"`
+}
+
+// JetStreamConfig describes the durable consumer the messages are received from.
+type JetStreamConfig struct {
+	// Stream is the name of the stream.
+	Stream string `mapstructure:"stream"`
+	// Consumer is the name of the durable consumer, which is created when it doesn't exist. The receivers
+	// sharing the same consumer share its messages.
+	Consumer string `mapstructure:"consumer"`
+	// AckWait is the time the server waits for a message to be acknowledged before delivering it again,
+	// 30s when it is zero.
+	AckWait time.Duration `mapstructure:"ack_wait"`
+	// MaxAckPending is the maximum number of messages delivered to the consumer and not acknowledged yet,
+	// 1000 when it is zero. The server stops delivering messages once it is reached, which slows down the
+	// stream when the pipeline can't keep up.
+	MaxAckPending int `mapstructure:"max_ack_pending"`
+}
+
+// AuthConfig holds the credentials of the receiver. At most one authentication method can be configured.
+type AuthConfig struct {
+	Username string              `mapstructure:"username"`
+	Password configopaque.String `mapstructure:"password"`
+	Token    configopaque.String `mapstructure:"token"`
+	// CredentialsFile is the path of a file holding the JWT and the NKey seed of the user.
+	CredentialsFile string `mapstructure:"credentials_file"`
+	// NKeySeedFile is the path of a file holding the NKey seed of the user.
+	NKeySeedFile string `mapstructure:"nkey_seed_file"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.Endpoint == "" {
+		errs = errors.Join(errs, errors.New("endpoint must be specified"))
+	}
+	if err := validateSubject(cfg.Subject); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if strings.ContainsAny(cfg.QueueGroup, " \t\r\n") {
+		errs = errors.Join(errs, fmt.Errorf("queue_group %q must not contain whitespace", cfg.QueueGroup))
+	}
+	if cfg.JetStream != nil {
+		if cfg.QueueGroup != "" {
+			errs = errors.Join(errs, errors.New("queue_group is not supported in JetStream mode, the receivers sharing a consumer share its messages"))
+		}
+		if err := cfg.JetStream.validate(); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if err := cfg.Auth.validate(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+func (cfg *JetStreamConfig) validate() error {
+	var errs error
+	if cfg.Stream == "" {
+		errs = errors.Join(errs, errors.New("jetstream stream must be specified"))
+	}
+	if cfg.Consumer == "" {
+		errs = errors.Join(errs, errors.New("jetstream consumer must be specified"))
+	} else if strings.ContainsAny(cfg.Consumer, ".*> \t") {
+		errs = errors.Join(errs, fmt.Errorf("jetstream consumer %q must not contain '.', '*', '>' or whitespace", cfg.Consumer))
+	}
+	if cfg.AckWait < 0 {
+		errs = errors.Join(errs, errors.New("jetstream ack_wait must not be negative"))
+	}
+	if cfg.MaxAckPending < 0 {
+		errs = errors.Join(errs, errors.New("jetstream max_ack_pending must not be negative"))
+	}
+	return errs
+}
+
+func (cfg *JetStreamConfig) ackWait() time.Duration {
+	if cfg.AckWait == 0 {
+		return defaultAckWait
+	}
+	return cfg.AckWait
+}
+
+func (cfg *JetStreamConfig) maxAckPending() int {
+	if cfg.MaxAckPending == 0 {
+		return defaultMaxAckPending
+	}
+	return cfg.MaxAckPending
+}
+
+func (cfg *AuthConfig) validate() error {
+	methods := 0
+	if cfg.Username != "" || cfg.Password != "" {
+		if cfg.Username == "" {
+			return errors.New("auth username must be specified with the password")
+		}
+		methods++
+	}
+	if cfg.Token != "" {
+		methods++
+	}
+	if cfg.CredentialsFile != "" {
+		methods++
+	}
+	if cfg.NKeySeedFile != "" {
+		methods++
+	}
+	if methods > 1 {
+		return errors.New("only one of auth username, token, credentials_file or nkey_seed_file can be specified")
+	}
+	return nil
+}
+
+// validateSubject checks the tokens of a subject are not empty, that the wildcards occupy entire tokens,
+// and that the full wildcard is the last token.
+func validateSubject(subject string) error {
+	if subject == "" {
+		return errors.New("subject must be specified")
+	}
+	tokens := strings.Split(subject, ".")
+	for i, token := range tokens {
+		switch {
+		case token == "" || strings.ContainsAny(token, " \t"):
+			return fmt.Errorf("invalid subject %q: tokens must not be empty or contain whitespace", subject)
+		case strings.Contains(token, ">") && (token != ">" || i != len(tokens)-1):
+			return fmt.Errorf("invalid subject %q: '>' must be the last token", subject)
+		case strings.Contains(token, "*") && token != "*":
+			return fmt.Errorf("invalid subject %q: '*' must occupy an entire token", subject)
+		}
+	}
+	return nil
+}
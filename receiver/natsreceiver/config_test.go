@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver/internal/metadata"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Parallel()
+
+	encoding := component.MustNewID("json_log_encoding")
+	tests := []struct {
+		id          component.ID
+		expected    component.Config
+		expectedErr []string
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expected: &Config{
+				Endpoint:   "nats://nats-1.example.com:4222,nats://nats-2.example.com:4222",
+				Subject:    "logs.>",
+				QueueGroup: "collectors",
+				Auth:       AuthConfig{Token: "secret"},
+				TLSSetting: &configtls.ClientConfig{Config: configtls.Config{CAFile: "/etc/ssl/certs/nats-ca.pem"}},
+				Encoding:   &encoding,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "jetstream"),
+			expected: &Config{
+				Endpoint: defaultEndpoint,
+				Subject:  "logs.app.*",
+				JetStream: &JetStreamConfig{
+					Stream:   "LOGS",
+					Consumer: "otelcol",
+					AckWait:  time.Minute,
+				},
+				Auth: AuthConfig{CredentialsFile: "/etc/nats/otelcol.creds"},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_subject"),
+			expectedErr: []string{
+				`invalid subject "logs..app>": tokens must not be empty or contain whitespace`,
+				`queue_group "collectors 1" must not contain whitespace`,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "invalid_jetstream"),
+			expectedErr: []string{
+				"queue_group is not supported in JetStream mode",
+				"jetstream stream must be specified",
+				`jetstream consumer "otel.col" must not contain '.', '*', '>' or whitespace`,
+				"jetstream max_ack_pending must not be negative",
+			},
+		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_auth"),
+			expectedErr: []string{"only one of auth username, token, credentials_file or nkey_seed_file can be specified"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+			require.NoError(t, err)
+			cfg := NewFactory().CreateDefaultConfig()
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, component.UnmarshalConfig(sub, cfg))
+
+			err = component.ValidateConfig(cfg)
+			if len(tt.expectedErr) > 0 {
+				require.Error(t, err)
+				for _, expectedErr := range tt.expectedErr {
+					assert.ErrorContains(t, err, expectedErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestValidateSubject(t *testing.T) {
+	for _, subject := range []string{"logs", "logs.*.app", "logs.>", "*", ">"} {
+		assert.NoError(t, validateSubject(subject), subject)
+	}
+	assert.EqualError(t, validateSubject(""), "subject must be specified")
+	assert.EqualError(t, validateSubject("logs.>.app"), `invalid subject "logs.>.app": '>' must be the last token`)
+	assert.EqualError(t, validateSubject("logs.app*"), `invalid subject "logs.app*": '*' must occupy an entire token`)
+	assert.EqualError(t, validateSubject("logs."), `invalid subject "logs.": tokens must not be empty or contain whitespace`)
+}
+
+func TestJetStreamDefaults(t *testing.T) {
+	cfg := &JetStreamConfig{}
+	assert.Equal(t, defaultAckWait, cfg.ackWait())
+	assert.Equal(t, defaultMaxAckPending, cfg.maxAckPending())
+	cfg = &JetStreamConfig{AckWait: time.Second, MaxAckPending: 10}
+	assert.Equal(t, time.Second, cfg.ackWait())
+	assert.Equal(t, 10, cfg.maxAckPending())
+}
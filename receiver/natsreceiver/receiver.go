@@ -0,0 +1,296 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/natsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/zap"
+)
+
+const (
+	transport = "nats"
+
+	attributeNATSSubject = "nats.subject"
+
+	defaultFormat = "raw"
+
+	// consumerRetryDelay is the delay between two attempts to create the JetStream consumer.
+	consumerRetryDelay = 5 * time.Second
+	// consumerRequestTimeout is the timeout of the request creating the JetStream consumer.
+	consumerRequestTimeout = 5 * time.Second
+	// redeliveryDelay is the delay before a message the pipeline failed to consume is delivered again.
+	redeliveryDelay = time.Second
+)
+
+type natsReceiver struct {
+	cfg      *Config
+	settings receiver.CreateSettings
+	obsrecv  *receiverhelper.ObsReport
+
+	nextConsumer consumer.Logs
+	unmarshaler  plog.Unmarshaler
+	// format is the encoding of the payloads, reported in the receiver telemetry.
+	format string
+
+	conn *nats.Conn
+	// closed is closed once the connection is closed.
+	closed chan struct{}
+
+	// cancel stops the creation of the JetStream consumer.
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	consumeCtx jetstream.ConsumeContext
+}
+
+func newNATSReceiver(cfg *Config, settings receiver.CreateSettings, nextConsumer consumer.Logs) (*natsReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             settings.ID,
+		Transport:              transport,
+		ReceiverCreateSettings: settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &natsReceiver{
+		cfg:          cfg,
+		settings:     settings,
+		obsrecv:      obsrecv,
+		nextConsumer: nextConsumer,
+	}, nil
+}
+
+func (r *natsReceiver) Start(ctx context.Context, host component.Host) error {
+	if err := r.loadEncoding(host); err != nil {
+		return err
+	}
+	opts, err := r.options(ctx)
+	if err != nil {
+		return err
+	}
+
+	closed := make(chan struct{})
+	opts = append(opts, nats.ClosedHandler(func(*nats.Conn) { close(closed) }))
+	// The connection is retried in the background while the server is unavailable, and the
+	// subscriptions are restored each time it is re-established.
+	conn, err := nats.Connect(r.cfg.Endpoint, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %q: %w", r.cfg.Endpoint, err)
+	}
+	r.conn = conn
+	r.closed = closed
+
+	if r.cfg.JetStream == nil {
+		if _, err = conn.QueueSubscribe(r.cfg.Subject, r.cfg.QueueGroup, r.handleMsg); err != nil {
+			return fmt.Errorf("failed to subscribe to %q: %w", r.cfg.Subject, err)
+		}
+		return nil
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return fmt.Errorf("failed to create the JetStream context: %w", err)
+	}
+	consumeCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go r.consume(consumeCtx, js)
+	return nil
+}
+
+func (r *natsReceiver) Shutdown(ctx context.Context) error {
+	if r.conn == nil {
+		return nil
+	}
+	if r.cancel != nil {
+		r.cancel()
+		r.wg.Wait()
+		if r.consumeCtx != nil {
+			r.consumeCtx.Stop()
+		}
+	}
+
+	// Draining lets the messages already received be consumed before closing the connection. It fails
+	// only when the connection is closed, or closes it when the receiver isn't connected.
+	_ = r.conn.Drain()
+	select {
+	case <-r.closed:
+		return nil
+	case <-ctx.Done():
+		r.conn.Close()
+		return ctx.Err()
+	}
+}
+
+func (r *natsReceiver) options(ctx context.Context) ([]nats.Option, error) {
+	logger := r.settings.Logger
+	opts := []nats.Option{
+		nats.Name("otelcol-" + r.settings.ID.String()),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				logger.Warn("Disconnected from the NATS server", zap.Error(err))
+			}
+		}),
+		nats.ReconnectHandler(func(conn *nats.Conn) {
+			logger.Info("Connected to the NATS server", zap.String("url", conn.ConnectedUrl()))
+		}),
+		nats.ErrorHandler(func(_ *nats.Conn, _ *nats.Subscription, err error) {
+			logger.Error("NATS error", zap.Error(err))
+		}),
+	}
+
+	auth := r.cfg.Auth
+	switch {
+	case auth.Username != "":
+		opts = append(opts, nats.UserInfo(auth.Username, string(auth.Password)))
+	case auth.Token != "":
+		opts = append(opts, nats.Token(string(auth.Token)))
+	case auth.CredentialsFile != "":
+		opts = append(opts, nats.UserCredentials(auth.CredentialsFile))
+	case auth.NKeySeedFile != "":
+		opt, err := nats.NkeyOptionFromSeed(auth.NKeySeedFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the NKey seed: %w", err)
+		}
+		opts = append(opts, opt)
+	}
+
+	if r.cfg.TLSSetting != nil {
+		tlsConfig, err := r.cfg.TLSSetting.LoadTLSConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS configuration: %w", err)
+		}
+		if tlsConfig != nil {
+			opts = append(opts, nats.Secure(tlsConfig))
+		}
+	}
+	return opts, nil
+}
+
+// consume creates the durable consumer, retrying while the server is unavailable, and consumes its messages.
+func (r *natsReceiver) consume(ctx context.Context, js jetstream.JetStream) {
+	defer r.wg.Done()
+	cfg := r.cfg.JetStream
+	for {
+		requestCtx, cancel := context.WithTimeout(ctx, consumerRequestTimeout)
+		cons, err := js.CreateOrUpdateConsumer(requestCtx, cfg.Stream, jetstream.ConsumerConfig{
+			Durable:       cfg.Consumer,
+			FilterSubject: r.cfg.Subject,
+			AckPolicy:     jetstream.AckExplicitPolicy,
+			AckWait:       cfg.ackWait(),
+			MaxAckPending: cfg.maxAckPending(),
+		})
+		cancel()
+		if err == nil {
+			r.consumeCtx, err = cons.Consume(r.handleJetStreamMsg, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+				r.settings.Logger.Warn("Failed to consume from the JetStream consumer", zap.String("consumer", cfg.Consumer), zap.Error(err))
+			}))
+			if err == nil {
+				return
+			}
+		}
+		r.settings.Logger.Warn("Failed to create the JetStream consumer, retrying",
+			zap.String("stream", cfg.Stream), zap.String("consumer", cfg.Consumer), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(consumerRetryDelay):
+		}
+	}
+}
+
+func (r *natsReceiver) handleMsg(msg *nats.Msg) {
+	_ = r.consumeMessage(msg.Subject, msg.Data)
+}
+
+// handleJetStreamMsg acknowledges the messages once they are consumed. The messages the pipeline failed
+// to consume are delivered again unless the error is permanent, and the messages that can't be decoded
+// are terminated.
+func (r *natsReceiver) handleJetStreamMsg(msg jetstream.Msg) {
+	err := r.consumeMessage(msg.Subject(), msg.Data())
+	switch {
+	case err == nil:
+		err = msg.Ack()
+	case consumererror.IsPermanent(err):
+		err = msg.TermWithReason(err.Error())
+	default:
+		err = msg.NakWithDelay(redeliveryDelay)
+	}
+	if err != nil {
+		r.settings.Logger.Warn("Failed to acknowledge the message", zap.String("subject", msg.Subject()), zap.Error(err))
+	}
+}
+
+// consumeMessage decodes the payload of a message and passes the logs to the next consumer. The errors
+// decoding the payload are permanent.
+func (r *natsReceiver) consumeMessage(subject string, payload []byte) error {
+	ctx := r.obsrecv.StartLogsOp(context.Background())
+	logs, err := r.unmarshaler.UnmarshalLogs(payload)
+	if err != nil {
+		r.settings.Logger.Error("Failed to decode the message", zap.String("subject", subject), zap.Error(err))
+		r.obsrecv.EndLogsOp(ctx, r.format, 0, err)
+		return consumererror.NewPermanent(err)
+	}
+	for i := 0; i < logs.ResourceLogs().Len(); i++ {
+		logs.ResourceLogs().At(i).Resource().Attributes().PutStr(attributeNATSSubject, subject)
+	}
+
+	err = r.nextConsumer.ConsumeLogs(ctx, logs)
+	if err != nil {
+		r.settings.Logger.Error("Failed to consume the logs", zap.String("subject", subject), zap.Error(err))
+	}
+	r.obsrecv.EndLogsOp(ctx, r.format, logs.LogRecordCount(), err)
+	return err
+}
+
+func (r *natsReceiver) loadEncoding(host component.Host) error {
+	r.unmarshaler = rawLogsUnmarshaler{}
+	r.format = defaultFormat
+	if r.cfg.Encoding == nil {
+		return nil
+	}
+	ext, ok := host.GetExtensions()[*r.cfg.Encoding]
+	if !ok {
+		return fmt.Errorf("unknown encoding extension %q", r.cfg.Encoding)
+	}
+	unmarshaler, ok := ext.(plog.Unmarshaler)
+	if !ok {
+		return fmt.Errorf("extension %q is not a logs unmarshaler", r.cfg.Encoding)
+	}
+	r.unmarshaler = unmarshaler
+	r.format = r.cfg.Encoding.String()
+	return nil
+}
+
+// rawLogsUnmarshaler creates a log record whose body is the payload, as a string when it is valid
+// UTF-8 and as bytes otherwise.
+type rawLogsUnmarshaler struct{}
+
+func (rawLogsUnmarshaler) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	lr := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	if utf8.Valid(buf) {
+		lr.Body().SetStr(string(buf))
+	} else {
+		lr.Body().SetEmptyBytes().FromRaw(buf)
+	}
+	return logs, nil
+}
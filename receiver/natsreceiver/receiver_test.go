@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package natsreceiver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+// startServer starts an embedded NATS server listening on a random port.
+func startServer(t *testing.T) *server.Server {
+	ns, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		NoLog:     true,
+		NoSigs:    true,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	ns.Start()
+	require.True(t, ns.ReadyForConnections(10*time.Second))
+	t.Cleanup(func() {
+		ns.Shutdown()
+		ns.WaitForShutdown()
+	})
+	return ns
+}
+
+func connect(t *testing.T, ns *server.Server) *nats.Conn {
+	conn, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+	return conn
+}
+
+func startReceiver(t *testing.T, cfg *Config, next consumer.Logs, host component.Host) *natsReceiver {
+	r, err := newNATSReceiver(cfg, receivertest.NewNopCreateSettings(), next)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), host))
+	t.Cleanup(func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+	})
+	return r
+}
+
+// testHost is a host providing the given extensions.
+type testHost struct {
+	component.Host
+	extensions map[component.ID]component.Component
+}
+
+func (h testHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+// csvLogsUnmarshaler is an encoding extension creating a log record per comma separated value.
+type csvLogsUnmarshaler struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func (csvLogsUnmarshaler) UnmarshalLogs(buf []byte) (plog.Logs, error) {
+	if len(buf) == 0 {
+		return plog.Logs{}, errors.New("empty payload")
+	}
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	start := 0
+	for i := 0; i <= len(buf); i++ {
+		if i == len(buf) || buf[i] == ',' {
+			records.AppendEmpty().Body().SetStr(string(buf[start:i]))
+			start = i + 1
+		}
+	}
+	return logs, nil
+}
+
+func TestLogsReceiver(t *testing.T) {
+	ns := startServer(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ns.ClientURL()
+	cfg.Subject = "logs.>"
+	sink := new(consumertest.LogsSink)
+	r := startReceiver(t, cfg, sink, componenttest.NewNopHost())
+	require.NoError(t, r.conn.Flush())
+
+	conn := connect(t, ns)
+	require.NoError(t, conn.Publish("logs.app", []byte("started")))
+	require.NoError(t, conn.Publish("logs.db", []byte{0xff, 0x00}))
+	require.NoError(t, conn.Publish("metrics.app", []byte("ignored")))
+
+	require.Eventually(t, func() bool { return len(sink.AllLogs()) == 2 }, 10*time.Second, 10*time.Millisecond)
+	logs := sink.AllLogs()[0]
+	assert.Equal(t, map[string]any{"nats.subject": "logs.app"}, logs.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "started", record.Body().Str())
+	assert.NotZero(t, record.ObservedTimestamp())
+
+	// Payloads that aren't valid UTF-8 are kept as bytes.
+	record = sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, pcommon.ValueTypeBytes, record.Body().Type())
+	assert.Equal(t, []byte{0xff, 0x00}, record.Body().Bytes().AsRaw())
+}
+
+func TestLogsReceiverQueueGroup(t *testing.T) {
+	ns := startServer(t)
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ns.ClientURL()
+	cfg.Subject = "logs.>"
+	cfg.QueueGroup = "collectors"
+	sink := new(consumertest.LogsSink)
+	first := startReceiver(t, cfg, sink, componenttest.NewNopHost())
+	second := startReceiver(t, cfg, sink, componenttest.NewNopHost())
+	require.NoError(t, first.conn.Flush())
+	require.NoError(t, second.conn.Flush())
+
+	conn := connect(t, ns)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, conn.Publish("logs.app", []byte("started")))
+	}
+
+	// Each message is received by a single member of the queue group.
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 10 }, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, first.conn.Flush())
+	require.NoError(t, second.conn.Flush())
+	assert.Equal(t, 10, sink.LogRecordCount())
+}
+
+func TestLogsReceiverEncoding(t *testing.T) {
+	ns := startServer(t)
+	encoding := component.MustNewID("csv")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ns.ClientURL()
+	cfg.Subject = "logs.app"
+	cfg.Encoding = &encoding
+	sink := new(consumertest.LogsSink)
+	host := testHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{encoding: csvLogsUnmarshaler{}}}
+	r := startReceiver(t, cfg, sink, host)
+	require.NoError(t, r.conn.Flush())
+
+	conn := connect(t, ns)
+	// Messages that can't be decoded are dropped.
+	require.NoError(t, conn.Publish("logs.app", nil))
+	require.NoError(t, conn.Publish("logs.app", []byte("started,stopped")))
+
+	require.Eventually(t, func() bool { return len(sink.AllLogs()) == 1 }, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, sink.LogRecordCount())
+	records := sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "started", records.At(0).Body().Str())
+	assert.Equal(t, "stopped", records.At(1).Body().Str())
+}
+
+// flakyLogsSink fails to consume the first logs it receives.
+type flakyLogsSink struct {
+	consumertest.LogsSink
+	mu     sync.Mutex
+	failed bool
+}
+
+func (s *flakyLogsSink) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	s.mu.Lock()
+	failed := s.failed
+	s.failed = true
+	s.mu.Unlock()
+	if !failed {
+		return errors.New("pipeline unavailable")
+	}
+	return s.LogsSink.ConsumeLogs(ctx, ld)
+}
+
+func TestLogsReceiverJetStream(t *testing.T) {
+	ns := startServer(t)
+	conn := connect(t, ns)
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+	ctx := context.Background()
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "LOGS", Subjects: []string{"logs.>"}})
+	require.NoError(t, err)
+
+	// The messages published before the consumer is created are delivered.
+	_, err = js.Publish(ctx, "logs.app", []byte("started"))
+	require.NoError(t, err)
+	_, err = js.Publish(ctx, "logs.db", nil)
+	require.NoError(t, err)
+	_, err = js.Publish(ctx, "logs.app", []byte("stopped"))
+	require.NoError(t, err)
+
+	encoding := component.MustNewID("csv")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = ns.ClientURL()
+	cfg.Subject = "logs.>"
+	cfg.JetStream = &JetStreamConfig{Stream: "LOGS", Consumer: "otelcol"}
+	cfg.Encoding = &encoding
+	sink := new(flakyLogsSink)
+	host := testHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{encoding: csvLogsUnmarshaler{}}}
+	startReceiver(t, cfg, sink, host)
+
+	// The message the pipeline failed to consume is delivered again, and the message that can't be
+	// decoded is terminated.
+	require.Eventually(t, func() bool { return sink.LogRecordCount() == 2 }, 10*time.Second, 10*time.Millisecond)
+	bodies := []string{
+		sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str(),
+		sink.AllLogs()[1].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str(),
+	}
+	assert.ElementsMatch(t, []string{"started", "stopped"}, bodies)
+
+	cons, err := stream.Consumer(ctx, "otelcol")
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		info, err := cons.Info(ctx)
+		return err == nil && info.NumAckPending == 0 && info.NumPending == 0
+	}, 10*time.Second, 10*time.Millisecond)
+	info, err := cons.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "logs.>", info.Config.FilterSubject)
+	assert.Equal(t, jetstream.AckExplicitPolicy, info.Config.AckPolicy)
+	assert.Equal(t, defaultAckWait, info.Config.AckWait)
+	assert.Equal(t, defaultMaxAckPending, info.Config.MaxAckPending)
+}
+
+func TestReceiverInvalidEncoding(t *testing.T) {
+	encoding := component.MustNewID("csv")
+	cfg := createDefaultConfig().(*Config)
+	cfg.Subject = "logs.>"
+	cfg.Encoding = &encoding
+
+	r, err := newNATSReceiver(cfg, receivertest.NewNopCreateSettings(), consumertest.NewNop())
+	require.NoError(t, err)
+	assert.EqualError(t, r.Start(context.Background(), componenttest.NewNopHost()), `unknown encoding extension "csv"`)
+
+	r, err = newNATSReceiver(cfg, receivertest.NewNopCreateSettings(), consumertest.NewNop())
+	require.NoError(t, err)
+	host := testHost{Host: componenttest.NewNopHost(), extensions: map[component.ID]component.Component{encoding: nopExtension{}}}
+	assert.EqualError(t, r.Start(context.Background(), host), `extension "csv" is not a logs unmarshaler`)
+}
+
+type nopExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func TestReceiverLifecycleWithoutServer(t *testing.T) {
+	for name, jetStream := range map[string]*JetStreamConfig{
+		"core":      nil,
+		"jetstream": {Stream: "LOGS", Consumer: "otelcol"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = "nats://localhost:1"
+			cfg.Subject = "logs.>"
+			cfg.JetStream = jetStream
+
+			r, err := newNATSReceiver(cfg, receivertest.NewNopCreateSettings(), consumertest.NewNop())
+			require.NoError(t, err)
+			// The receiver starts while the server is unavailable, and keeps trying to connect.
+			require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+			require.NoError(t, r.Shutdown(context.Background()))
+		})
+	}
+}
@@ -4,14 +4,37 @@
 package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
 )
 
+const (
+	// statusModuleStubStatus is the ngx_http_stub_status_module status page.
+	statusModuleStubStatus = "stub_status"
+	// statusModulePlusAPI is the NGINX Plus REST API, provided by the ngx_http_api_module.
+	statusModulePlusAPI = "plus_api"
+	// statusModuleVTS is the JSON status page of the community nginx-module-vts module.
+	statusModuleVTS = "vts"
+)
+
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	confighttp.ClientConfig        `mapstructure:",squash"`
 	MetricsBuilderConfig           metadata.MetricsBuilderConfig `mapstructure:",squash"`
+
+	// StatusModule is the nginx module serving the endpoint: `stub_status`, `plus_api` or `vts`.
+	StatusModule string `mapstructure:"status_module"`
+}
+
+func (cfg *Config) Validate() error {
+	switch cfg.StatusModule {
+	case statusModuleStubStatus, statusModulePlusAPI, statusModuleVTS:
+		return nil
+	default:
+		return fmt.Errorf("invalid status_module %q, must be one of %q, %q or %q", cfg.StatusModule, statusModuleStubStatus, statusModulePlusAPI, statusModuleVTS)
+	}
 }
@@ -27,3 +27,14 @@ func TestLoadConfig(t *testing.T) {
 
 	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
 }
+
+func TestValidateConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.StatusModule = statusModuleVTS
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	cfg.StatusModule = "status"
+	require.EqualError(t, component.ValidateConfig(cfg), `invalid status_module "status", must be one of "stub_status", "plus_api" or "vts"`)
+}
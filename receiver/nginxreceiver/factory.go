@@ -35,6 +35,7 @@ func createDefaultConfig() component.Config {
 			Timeout:  10 * time.Second,
 		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		StatusModule:         statusModuleStubStatus,
 	}
 }
 
@@ -25,10 +25,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					NginxConnectionsAccepted: MetricConfig{Enabled: true},
-					NginxConnectionsCurrent:  MetricConfig{Enabled: true},
-					NginxConnectionsHandled:  MetricConfig{Enabled: true},
-					NginxRequests:            MetricConfig{Enabled: true},
+					NginxConnectionsAccepted:      MetricConfig{Enabled: true},
+					NginxConnectionsCurrent:       MetricConfig{Enabled: true},
+					NginxConnectionsHandled:       MetricConfig{Enabled: true},
+					NginxRequests:                 MetricConfig{Enabled: true},
+					NginxServerZoneIo:             MetricConfig{Enabled: true},
+					NginxServerZoneRequests:       MetricConfig{Enabled: true},
+					NginxServerZoneResponseTime:   MetricConfig{Enabled: true},
+					NginxServerZoneResponses:      MetricConfig{Enabled: true},
+					NginxUpstreamPeerFails:        MetricConfig{Enabled: true},
+					NginxUpstreamPeerIo:           MetricConfig{Enabled: true},
+					NginxUpstreamPeerRequests:     MetricConfig{Enabled: true},
+					NginxUpstreamPeerResponseTime: MetricConfig{Enabled: true},
+					NginxUpstreamPeerResponses:    MetricConfig{Enabled: true},
+					NginxUpstreamPeerState:        MetricConfig{Enabled: true},
 				},
 			},
 		},
@@ -36,10 +46,20 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					NginxConnectionsAccepted: MetricConfig{Enabled: false},
-					NginxConnectionsCurrent:  MetricConfig{Enabled: false},
-					NginxConnectionsHandled:  MetricConfig{Enabled: false},
-					NginxRequests:            MetricConfig{Enabled: false},
+					NginxConnectionsAccepted:      MetricConfig{Enabled: false},
+					NginxConnectionsCurrent:       MetricConfig{Enabled: false},
+					NginxConnectionsHandled:       MetricConfig{Enabled: false},
+					NginxRequests:                 MetricConfig{Enabled: false},
+					NginxServerZoneIo:             MetricConfig{Enabled: false},
+					NginxServerZoneRequests:       MetricConfig{Enabled: false},
+					NginxServerZoneResponseTime:   MetricConfig{Enabled: false},
+					NginxServerZoneResponses:      MetricConfig{Enabled: false},
+					NginxUpstreamPeerFails:        MetricConfig{Enabled: false},
+					NginxUpstreamPeerIo:           MetricConfig{Enabled: false},
+					NginxUpstreamPeerRequests:     MetricConfig{Enabled: false},
+					NginxUpstreamPeerResponseTime: MetricConfig{Enabled: false},
+					NginxUpstreamPeerResponses:    MetricConfig{Enabled: false},
+					NginxUpstreamPeerState:        MetricConfig{Enabled: false},
 				},
 			},
 		},
@@ -11,6 +11,74 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeDirection specifies the a value direction attribute.
+type AttributeDirection int
+
+const (
+	_ AttributeDirection = iota
+	AttributeDirectionReceived
+	AttributeDirectionSent
+)
+
+// String returns the string representation of the AttributeDirection.
+func (av AttributeDirection) String() string {
+	switch av {
+	case AttributeDirectionReceived:
+		return "received"
+	case AttributeDirectionSent:
+		return "sent"
+	}
+	return ""
+}
+
+// MapAttributeDirection is a helper map of string to AttributeDirection attribute value.
+var MapAttributeDirection = map[string]AttributeDirection{
+	"received": AttributeDirectionReceived,
+	"sent":     AttributeDirectionSent,
+}
+
+// AttributePeerState specifies the a value peer_state attribute.
+type AttributePeerState int
+
+const (
+	_ AttributePeerState = iota
+	AttributePeerStateUp
+	AttributePeerStateDown
+	AttributePeerStateUnavail
+	AttributePeerStateChecking
+	AttributePeerStateUnhealthy
+	AttributePeerStateDraining
+)
+
+// String returns the string representation of the AttributePeerState.
+func (av AttributePeerState) String() string {
+	switch av {
+	case AttributePeerStateUp:
+		return "up"
+	case AttributePeerStateDown:
+		return "down"
+	case AttributePeerStateUnavail:
+		return "unavail"
+	case AttributePeerStateChecking:
+		return "checking"
+	case AttributePeerStateUnhealthy:
+		return "unhealthy"
+	case AttributePeerStateDraining:
+		return "draining"
+	}
+	return ""
+}
+
+// MapAttributePeerState is a helper map of string to AttributePeerState attribute value.
+var MapAttributePeerState = map[string]AttributePeerState{
+	"up":        AttributePeerStateUp,
+	"down":      AttributePeerStateDown,
+	"unavail":   AttributePeerStateUnavail,
+	"checking":  AttributePeerStateChecking,
+	"unhealthy": AttributePeerStateUnhealthy,
+	"draining":  AttributePeerStateDraining,
+}
+
 // AttributeState specifies the a value state attribute.
 type AttributeState int
 
@@ -45,6 +113,44 @@ var MapAttributeState = map[string]AttributeState{
 	"waiting": AttributeStateWaiting,
 }
 
+// AttributeStatusRange specifies the a value status_range attribute.
+type AttributeStatusRange int
+
+const (
+	_ AttributeStatusRange = iota
+	AttributeStatusRange1xx
+	AttributeStatusRange2xx
+	AttributeStatusRange3xx
+	AttributeStatusRange4xx
+	AttributeStatusRange5xx
+)
+
+// String returns the string representation of the AttributeStatusRange.
+func (av AttributeStatusRange) String() string {
+	switch av {
+	case AttributeStatusRange1xx:
+		return "1xx"
+	case AttributeStatusRange2xx:
+		return "2xx"
+	case AttributeStatusRange3xx:
+		return "3xx"
+	case AttributeStatusRange4xx:
+		return "4xx"
+	case AttributeStatusRange5xx:
+		return "5xx"
+	}
+	return ""
+}
+
+// MapAttributeStatusRange is a helper map of string to AttributeStatusRange attribute value.
+var MapAttributeStatusRange = map[string]AttributeStatusRange{
+	"1xx": AttributeStatusRange1xx,
+	"2xx": AttributeStatusRange2xx,
+	"3xx": AttributeStatusRange3xx,
+	"4xx": AttributeStatusRange4xx,
+	"5xx": AttributeStatusRange5xx,
+}
+
 type metricNginxConnectionsAccepted struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -251,18 +357,563 @@ func newMetricNginxRequests(cfg MetricConfig) metricNginxRequests {
 	return m
 }
 
+type metricNginxServerZoneIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.io metric with initial data.
+func (m *metricNginxServerZoneIo) init() {
+	m.data.SetName("nginx.server_zone.io")
+	m.data.SetDescription("The total number of bytes received from or sent to clients by the server zone. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneNameAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.zone.name", zoneNameAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneIo(cfg MetricConfig) metricNginxServerZoneIo {
+	m := metricNginxServerZoneIo{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneRequests struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.requests metric with initial data.
+func (m *metricNginxServerZoneRequests) init() {
+	m.data.SetName("nginx.server_zone.requests")
+	m.data.SetDescription("The total number of client requests received by the server zone. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("requests")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.zone.name", zoneNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneRequests) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneRequests(cfg MetricConfig) metricNginxServerZoneRequests {
+	m := metricNginxServerZoneRequests{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneResponseTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.response_time metric with initial data.
+func (m *metricNginxServerZoneResponseTime) init() {
+	m.data.SetName("nginx.server_zone.response_time")
+	m.data.SetDescription("The average time to process the requests of the server zone. Only reported by the VTS module.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneResponseTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.zone.name", zoneNameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneResponseTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneResponseTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneResponseTime(cfg MetricConfig) metricNginxServerZoneResponseTime {
+	m := metricNginxServerZoneResponseTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxServerZoneResponses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.server_zone.responses metric with initial data.
+func (m *metricNginxServerZoneResponses) init() {
+	m.data.SetName("nginx.server_zone.responses")
+	m.data.SetDescription("The total number of responses sent to clients by the server zone, by status code range. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("responses")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxServerZoneResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, zoneNameAttributeValue string, statusRangeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.zone.name", zoneNameAttributeValue)
+	dp.Attributes().PutStr("http.response.status_code_range", statusRangeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxServerZoneResponses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxServerZoneResponses) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxServerZoneResponses(cfg MetricConfig) metricNginxServerZoneResponses {
+	m := metricNginxServerZoneResponses{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerFails struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.fails metric with initial data.
+func (m *metricNginxUpstreamPeerFails) init() {
+	m.data.SetName("nginx.upstream.peer.fails")
+	m.data.SetDescription("The total number of unsuccessful attempts to communicate with the upstream server. Only reported by the NGINX Plus API.")
+	m.data.SetUnit("{attempts}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerFails) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.upstream.name", upstreamNameAttributeValue)
+	dp.Attributes().PutStr("nginx.upstream.peer.address", peerAddressAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerFails) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerFails) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerFails(cfg MetricConfig) metricNginxUpstreamPeerFails {
+	m := metricNginxUpstreamPeerFails{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerIo struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.io metric with initial data.
+func (m *metricNginxUpstreamPeerIo) init() {
+	m.data.SetName("nginx.upstream.peer.io")
+	m.data.SetDescription("The total number of bytes received from or sent to the upstream server. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerIo) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string, directionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.upstream.name", upstreamNameAttributeValue)
+	dp.Attributes().PutStr("nginx.upstream.peer.address", peerAddressAttributeValue)
+	dp.Attributes().PutStr("direction", directionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerIo) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerIo) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerIo(cfg MetricConfig) metricNginxUpstreamPeerIo {
+	m := metricNginxUpstreamPeerIo{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerRequests struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.requests metric with initial data.
+func (m *metricNginxUpstreamPeerRequests) init() {
+	m.data.SetName("nginx.upstream.peer.requests")
+	m.data.SetDescription("The total number of client requests forwarded to the upstream server. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("requests")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerRequests) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.upstream.name", upstreamNameAttributeValue)
+	dp.Attributes().PutStr("nginx.upstream.peer.address", peerAddressAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerRequests) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerRequests) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerRequests(cfg MetricConfig) metricNginxUpstreamPeerRequests {
+	m := metricNginxUpstreamPeerRequests{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerResponseTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.response_time metric with initial data.
+func (m *metricNginxUpstreamPeerResponseTime) init() {
+	m.data.SetName("nginx.upstream.peer.response_time")
+	m.data.SetDescription("The average time to get the full response from the upstream server. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("ms")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerResponseTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.upstream.name", upstreamNameAttributeValue)
+	dp.Attributes().PutStr("nginx.upstream.peer.address", peerAddressAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerResponseTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerResponseTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerResponseTime(cfg MetricConfig) metricNginxUpstreamPeerResponseTime {
+	m := metricNginxUpstreamPeerResponseTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerResponses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.responses metric with initial data.
+func (m *metricNginxUpstreamPeerResponses) init() {
+	m.data.SetName("nginx.upstream.peer.responses")
+	m.data.SetDescription("The total number of responses obtained from the upstream server, by status code range. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("responses")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerResponses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string, statusRangeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.upstream.name", upstreamNameAttributeValue)
+	dp.Attributes().PutStr("nginx.upstream.peer.address", peerAddressAttributeValue)
+	dp.Attributes().PutStr("http.response.status_code_range", statusRangeAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerResponses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerResponses) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerResponses(cfg MetricConfig) metricNginxUpstreamPeerResponses {
+	m := metricNginxUpstreamPeerResponses{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricNginxUpstreamPeerState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills nginx.upstream.peer.state metric with initial data.
+func (m *metricNginxUpstreamPeerState) init() {
+	m.data.SetName("nginx.upstream.peer.state")
+	m.data.SetDescription("The current state of the upstream server, 1 for its current state and 0 for the others. Only reported by the NGINX Plus API and VTS module.")
+	m.data.SetUnit("{state}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricNginxUpstreamPeerState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string, peerStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("nginx.upstream.name", upstreamNameAttributeValue)
+	dp.Attributes().PutStr("nginx.upstream.peer.address", peerAddressAttributeValue)
+	dp.Attributes().PutStr("state", peerStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricNginxUpstreamPeerState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricNginxUpstreamPeerState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricNginxUpstreamPeerState(cfg MetricConfig) metricNginxUpstreamPeerState {
+	m := metricNginxUpstreamPeerState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                         MetricsBuilderConfig // config of the metrics builder.
-	startTime                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                int                  // maximum observed number of metrics per resource.
-	metricsBuffer                  pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                      component.BuildInfo  // contains version information.
-	metricNginxConnectionsAccepted metricNginxConnectionsAccepted
-	metricNginxConnectionsCurrent  metricNginxConnectionsCurrent
-	metricNginxConnectionsHandled  metricNginxConnectionsHandled
-	metricNginxRequests            metricNginxRequests
+	config                              MetricsBuilderConfig // config of the metrics builder.
+	startTime                           pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                     int                  // maximum observed number of metrics per resource.
+	metricsBuffer                       pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                           component.BuildInfo  // contains version information.
+	metricNginxConnectionsAccepted      metricNginxConnectionsAccepted
+	metricNginxConnectionsCurrent       metricNginxConnectionsCurrent
+	metricNginxConnectionsHandled       metricNginxConnectionsHandled
+	metricNginxRequests                 metricNginxRequests
+	metricNginxServerZoneIo             metricNginxServerZoneIo
+	metricNginxServerZoneRequests       metricNginxServerZoneRequests
+	metricNginxServerZoneResponseTime   metricNginxServerZoneResponseTime
+	metricNginxServerZoneResponses      metricNginxServerZoneResponses
+	metricNginxUpstreamPeerFails        metricNginxUpstreamPeerFails
+	metricNginxUpstreamPeerIo           metricNginxUpstreamPeerIo
+	metricNginxUpstreamPeerRequests     metricNginxUpstreamPeerRequests
+	metricNginxUpstreamPeerResponseTime metricNginxUpstreamPeerResponseTime
+	metricNginxUpstreamPeerResponses    metricNginxUpstreamPeerResponses
+	metricNginxUpstreamPeerState        metricNginxUpstreamPeerState
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -277,14 +928,24 @@ func WithStartTime(startTime pcommon.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                         mbc,
-		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                  pmetric.NewMetrics(),
-		buildInfo:                      settings.BuildInfo,
-		metricNginxConnectionsAccepted: newMetricNginxConnectionsAccepted(mbc.Metrics.NginxConnectionsAccepted),
-		metricNginxConnectionsCurrent:  newMetricNginxConnectionsCurrent(mbc.Metrics.NginxConnectionsCurrent),
-		metricNginxConnectionsHandled:  newMetricNginxConnectionsHandled(mbc.Metrics.NginxConnectionsHandled),
-		metricNginxRequests:            newMetricNginxRequests(mbc.Metrics.NginxRequests),
+		config:                              mbc,
+		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                       pmetric.NewMetrics(),
+		buildInfo:                           settings.BuildInfo,
+		metricNginxConnectionsAccepted:      newMetricNginxConnectionsAccepted(mbc.Metrics.NginxConnectionsAccepted),
+		metricNginxConnectionsCurrent:       newMetricNginxConnectionsCurrent(mbc.Metrics.NginxConnectionsCurrent),
+		metricNginxConnectionsHandled:       newMetricNginxConnectionsHandled(mbc.Metrics.NginxConnectionsHandled),
+		metricNginxRequests:                 newMetricNginxRequests(mbc.Metrics.NginxRequests),
+		metricNginxServerZoneIo:             newMetricNginxServerZoneIo(mbc.Metrics.NginxServerZoneIo),
+		metricNginxServerZoneRequests:       newMetricNginxServerZoneRequests(mbc.Metrics.NginxServerZoneRequests),
+		metricNginxServerZoneResponseTime:   newMetricNginxServerZoneResponseTime(mbc.Metrics.NginxServerZoneResponseTime),
+		metricNginxServerZoneResponses:      newMetricNginxServerZoneResponses(mbc.Metrics.NginxServerZoneResponses),
+		metricNginxUpstreamPeerFails:        newMetricNginxUpstreamPeerFails(mbc.Metrics.NginxUpstreamPeerFails),
+		metricNginxUpstreamPeerIo:           newMetricNginxUpstreamPeerIo(mbc.Metrics.NginxUpstreamPeerIo),
+		metricNginxUpstreamPeerRequests:     newMetricNginxUpstreamPeerRequests(mbc.Metrics.NginxUpstreamPeerRequests),
+		metricNginxUpstreamPeerResponseTime: newMetricNginxUpstreamPeerResponseTime(mbc.Metrics.NginxUpstreamPeerResponseTime),
+		metricNginxUpstreamPeerResponses:    newMetricNginxUpstreamPeerResponses(mbc.Metrics.NginxUpstreamPeerResponses),
+		metricNginxUpstreamPeerState:        newMetricNginxUpstreamPeerState(mbc.Metrics.NginxUpstreamPeerState),
 	}
 
 	for _, op := range options {
@@ -346,6 +1007,16 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricNginxConnectionsCurrent.emit(ils.Metrics())
 	mb.metricNginxConnectionsHandled.emit(ils.Metrics())
 	mb.metricNginxRequests.emit(ils.Metrics())
+	mb.metricNginxServerZoneIo.emit(ils.Metrics())
+	mb.metricNginxServerZoneRequests.emit(ils.Metrics())
+	mb.metricNginxServerZoneResponseTime.emit(ils.Metrics())
+	mb.metricNginxServerZoneResponses.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerFails.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerIo.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerRequests.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerResponseTime.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerResponses.emit(ils.Metrics())
+	mb.metricNginxUpstreamPeerState.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -387,6 +1058,56 @@ func (mb *MetricsBuilder) RecordNginxRequestsDataPoint(ts pcommon.Timestamp, val
 	mb.metricNginxRequests.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordNginxServerZoneIoDataPoint adds a data point to nginx.server_zone.io metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneIoDataPoint(ts pcommon.Timestamp, val int64, zoneNameAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNginxServerZoneIo.recordDataPoint(mb.startTime, ts, val, zoneNameAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNginxServerZoneRequestsDataPoint adds a data point to nginx.server_zone.requests metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneRequestsDataPoint(ts pcommon.Timestamp, val int64, zoneNameAttributeValue string) {
+	mb.metricNginxServerZoneRequests.recordDataPoint(mb.startTime, ts, val, zoneNameAttributeValue)
+}
+
+// RecordNginxServerZoneResponseTimeDataPoint adds a data point to nginx.server_zone.response_time metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneResponseTimeDataPoint(ts pcommon.Timestamp, val int64, zoneNameAttributeValue string) {
+	mb.metricNginxServerZoneResponseTime.recordDataPoint(mb.startTime, ts, val, zoneNameAttributeValue)
+}
+
+// RecordNginxServerZoneResponsesDataPoint adds a data point to nginx.server_zone.responses metric.
+func (mb *MetricsBuilder) RecordNginxServerZoneResponsesDataPoint(ts pcommon.Timestamp, val int64, zoneNameAttributeValue string, statusRangeAttributeValue AttributeStatusRange) {
+	mb.metricNginxServerZoneResponses.recordDataPoint(mb.startTime, ts, val, zoneNameAttributeValue, statusRangeAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerFailsDataPoint adds a data point to nginx.upstream.peer.fails metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerFailsDataPoint(ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string) {
+	mb.metricNginxUpstreamPeerFails.recordDataPoint(mb.startTime, ts, val, upstreamNameAttributeValue, peerAddressAttributeValue)
+}
+
+// RecordNginxUpstreamPeerIoDataPoint adds a data point to nginx.upstream.peer.io metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerIoDataPoint(ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string, directionAttributeValue AttributeDirection) {
+	mb.metricNginxUpstreamPeerIo.recordDataPoint(mb.startTime, ts, val, upstreamNameAttributeValue, peerAddressAttributeValue, directionAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerRequestsDataPoint adds a data point to nginx.upstream.peer.requests metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerRequestsDataPoint(ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string) {
+	mb.metricNginxUpstreamPeerRequests.recordDataPoint(mb.startTime, ts, val, upstreamNameAttributeValue, peerAddressAttributeValue)
+}
+
+// RecordNginxUpstreamPeerResponseTimeDataPoint adds a data point to nginx.upstream.peer.response_time metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerResponseTimeDataPoint(ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string) {
+	mb.metricNginxUpstreamPeerResponseTime.recordDataPoint(mb.startTime, ts, val, upstreamNameAttributeValue, peerAddressAttributeValue)
+}
+
+// RecordNginxUpstreamPeerResponsesDataPoint adds a data point to nginx.upstream.peer.responses metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerResponsesDataPoint(ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string, statusRangeAttributeValue AttributeStatusRange) {
+	mb.metricNginxUpstreamPeerResponses.recordDataPoint(mb.startTime, ts, val, upstreamNameAttributeValue, peerAddressAttributeValue, statusRangeAttributeValue.String())
+}
+
+// RecordNginxUpstreamPeerStateDataPoint adds a data point to nginx.upstream.peer.state metric.
+func (mb *MetricsBuilder) RecordNginxUpstreamPeerStateDataPoint(ts pcommon.Timestamp, val int64, upstreamNameAttributeValue string, peerAddressAttributeValue string, peerStateAttributeValue AttributePeerState) {
+	mb.metricNginxUpstreamPeerState.recordDataPoint(mb.startTime, ts, val, upstreamNameAttributeValue, peerAddressAttributeValue, peerStateAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
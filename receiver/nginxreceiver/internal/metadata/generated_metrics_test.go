@@ -75,6 +75,46 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordNginxRequestsDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneIoDataPoint(ts, 1, "zone_name-val", AttributeDirectionReceived)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneRequestsDataPoint(ts, 1, "zone_name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneResponseTimeDataPoint(ts, 1, "zone_name-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxServerZoneResponsesDataPoint(ts, 1, "zone_name-val", AttributeStatusRange1xx)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerFailsDataPoint(ts, 1, "upstream_name-val", "peer_address-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerIoDataPoint(ts, 1, "upstream_name-val", "peer_address-val", AttributeDirectionReceived)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerRequestsDataPoint(ts, 1, "upstream_name-val", "peer_address-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerResponseTimeDataPoint(ts, 1, "upstream_name-val", "peer_address-val")
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerResponsesDataPoint(ts, 1, "upstream_name-val", "peer_address-val", AttributeStatusRange1xx)
+
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordNginxUpstreamPeerStateDataPoint(ts, 1, "upstream_name-val", "peer_address-val", AttributePeerStateUp)
+
 			res := pcommon.NewResource()
 			metrics := mb.Emit(WithResource(res))
 
@@ -156,6 +196,203 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "nginx.server_zone.io":
+					assert.False(t, validatedMetrics["nginx.server_zone.io"], "Found a duplicate in the metrics slice: nginx.server_zone.io")
+					validatedMetrics["nginx.server_zone.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of bytes received from or sent to clients by the server zone. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.zone.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "received", attrVal.Str())
+				case "nginx.server_zone.requests":
+					assert.False(t, validatedMetrics["nginx.server_zone.requests"], "Found a duplicate in the metrics slice: nginx.server_zone.requests")
+					validatedMetrics["nginx.server_zone.requests"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of client requests received by the server zone. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "requests", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.zone.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone_name-val", attrVal.Str())
+				case "nginx.server_zone.response_time":
+					assert.False(t, validatedMetrics["nginx.server_zone.response_time"], "Found a duplicate in the metrics slice: nginx.server_zone.response_time")
+					validatedMetrics["nginx.server_zone.response_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average time to process the requests of the server zone. Only reported by the VTS module.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.zone.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone_name-val", attrVal.Str())
+				case "nginx.server_zone.responses":
+					assert.False(t, validatedMetrics["nginx.server_zone.responses"], "Found a duplicate in the metrics slice: nginx.server_zone.responses")
+					validatedMetrics["nginx.server_zone.responses"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of responses sent to clients by the server zone, by status code range. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "responses", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.zone.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "zone_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("http.response.status_code_range")
+					assert.True(t, ok)
+					assert.EqualValues(t, "1xx", attrVal.Str())
+				case "nginx.upstream.peer.fails":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.fails"], "Found a duplicate in the metrics slice: nginx.upstream.peer.fails")
+					validatedMetrics["nginx.upstream.peer.fails"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of unsuccessful attempts to communicate with the upstream server. Only reported by the NGINX Plus API.", ms.At(i).Description())
+					assert.Equal(t, "{attempts}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.upstream.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("nginx.upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer_address-val", attrVal.Str())
+				case "nginx.upstream.peer.io":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.io"], "Found a duplicate in the metrics slice: nginx.upstream.peer.io")
+					validatedMetrics["nginx.upstream.peer.io"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of bytes received from or sent to the upstream server. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.upstream.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("nginx.upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer_address-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("direction")
+					assert.True(t, ok)
+					assert.EqualValues(t, "received", attrVal.Str())
+				case "nginx.upstream.peer.requests":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.requests"], "Found a duplicate in the metrics slice: nginx.upstream.peer.requests")
+					validatedMetrics["nginx.upstream.peer.requests"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of client requests forwarded to the upstream server. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "requests", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.upstream.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("nginx.upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer_address-val", attrVal.Str())
+				case "nginx.upstream.peer.response_time":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.response_time"], "Found a duplicate in the metrics slice: nginx.upstream.peer.response_time")
+					validatedMetrics["nginx.upstream.peer.response_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The average time to get the full response from the upstream server. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.upstream.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("nginx.upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer_address-val", attrVal.Str())
+				case "nginx.upstream.peer.responses":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.responses"], "Found a duplicate in the metrics slice: nginx.upstream.peer.responses")
+					validatedMetrics["nginx.upstream.peer.responses"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of responses obtained from the upstream server, by status code range. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "responses", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.upstream.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("nginx.upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer_address-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("http.response.status_code_range")
+					assert.True(t, ok)
+					assert.EqualValues(t, "1xx", attrVal.Str())
+				case "nginx.upstream.peer.state":
+					assert.False(t, validatedMetrics["nginx.upstream.peer.state"], "Found a duplicate in the metrics slice: nginx.upstream.peer.state")
+					validatedMetrics["nginx.upstream.peer.state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The current state of the upstream server, 1 for its current state and 0 for the others. Only reported by the NGINX Plus API and VTS module.", ms.At(i).Description())
+					assert.Equal(t, "{state}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("nginx.upstream.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "upstream_name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("nginx.upstream.peer.address")
+					assert.True(t, ok)
+					assert.EqualValues(t, "peer_address-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "up", attrVal.Str())
 				}
 			}
 		})
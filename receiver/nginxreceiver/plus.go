@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// plusConnections is the response of the /connections endpoint of the NGINX Plus API.
+type plusConnections struct {
+	Accepted int64 `json:"accepted"`
+	Dropped  int64 `json:"dropped"`
+	Active   int64 `json:"active"`
+	Idle     int64 `json:"idle"`
+}
+
+// plusRequests is the response of the /http/requests endpoint of the NGINX Plus API.
+type plusRequests struct {
+	Total int64 `json:"total"`
+}
+
+type plusResponses struct {
+	Responses1xx int64 `json:"1xx"`
+	Responses2xx int64 `json:"2xx"`
+	Responses3xx int64 `json:"3xx"`
+	Responses4xx int64 `json:"4xx"`
+	Responses5xx int64 `json:"5xx"`
+}
+
+// plusServerZone is an entry of the /http/server_zones endpoint of the NGINX Plus API.
+type plusServerZone struct {
+	Requests  int64         `json:"requests"`
+	Responses plusResponses `json:"responses"`
+	Received  int64         `json:"received"`
+	Sent      int64         `json:"sent"`
+}
+
+type plusPeer struct {
+	Server       string        `json:"server"`
+	State        string        `json:"state"`
+	Requests     int64         `json:"requests"`
+	Responses    plusResponses `json:"responses"`
+	Received     int64         `json:"received"`
+	Sent         int64         `json:"sent"`
+	Fails        int64         `json:"fails"`
+	ResponseTime *int64        `json:"response_time"`
+}
+
+// plusUpstream is an entry of the /http/upstreams endpoint of the NGINX Plus API.
+type plusUpstream struct {
+	Peers []plusPeer `json:"peers"`
+}
+
+// scrapePlusAPI collects metrics from the NGINX Plus API, whose versioned base URL is the configured endpoint.
+func (r *nginxScraper) scrapePlusAPI(ctx context.Context, now pcommon.Timestamp) error {
+	base := strings.TrimSuffix(r.cfg.Endpoint, "/")
+
+	var connections plusConnections
+	if err := r.getJSON(ctx, base+"/connections", &connections); err != nil {
+		return err
+	}
+	var requests plusRequests
+	if err := r.getJSON(ctx, base+"/http/requests", &requests); err != nil {
+		return err
+	}
+	var serverZones map[string]plusServerZone
+	if err := r.getJSON(ctx, base+"/http/server_zones", &serverZones); err != nil {
+		return err
+	}
+	var upstreams map[string]plusUpstream
+	if err := r.getJSON(ctx, base+"/http/upstreams", &upstreams); err != nil {
+		return err
+	}
+
+	r.mb.RecordNginxRequestsDataPoint(now, requests.Total)
+	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, connections.Accepted)
+	// Dropped connections are the accepted connections that were not handled.
+	r.mb.RecordNginxConnectionsHandledDataPoint(now, connections.Accepted-connections.Dropped)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, connections.Active+connections.Idle, metadata.AttributeStateActive)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, connections.Idle, metadata.AttributeStateWaiting)
+
+	for name, zone := range serverZones {
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.Requests, name)
+		r.recordServerZoneResponses(now, name, zone.Responses)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Received, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.Sent, name, metadata.AttributeDirectionSent)
+	}
+
+	for name, upstream := range upstreams {
+		for _, peer := range upstream.Peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.Requests, name, peer.Server)
+			r.recordPeerResponses(now, name, peer.Server, peer.Responses)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Received, name, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.Sent, name, peer.Server, metadata.AttributeDirectionSent)
+			r.mb.RecordNginxUpstreamPeerFailsDataPoint(now, peer.Fails, name, peer.Server)
+			// The response time is only reported once the peer served a request.
+			if peer.ResponseTime != nil {
+				r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, *peer.ResponseTime, name, peer.Server)
+			}
+			r.recordPeerState(now, name, peer.Server, peer.State)
+		}
+	}
+	return nil
+}
+
+func (r *nginxScraper) recordServerZoneResponses(now pcommon.Timestamp, zone string, responses plusResponses) {
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses1xx, zone, metadata.AttributeStatusRange1xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses2xx, zone, metadata.AttributeStatusRange2xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses3xx, zone, metadata.AttributeStatusRange3xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses4xx, zone, metadata.AttributeStatusRange4xx)
+	r.mb.RecordNginxServerZoneResponsesDataPoint(now, responses.Responses5xx, zone, metadata.AttributeStatusRange5xx)
+}
+
+func (r *nginxScraper) recordPeerResponses(now pcommon.Timestamp, upstream, peer string, responses plusResponses) {
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses1xx, upstream, peer, metadata.AttributeStatusRange1xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses2xx, upstream, peer, metadata.AttributeStatusRange2xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses3xx, upstream, peer, metadata.AttributeStatusRange3xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses4xx, upstream, peer, metadata.AttributeStatusRange4xx)
+	r.mb.RecordNginxUpstreamPeerResponsesDataPoint(now, responses.Responses5xx, upstream, peer, metadata.AttributeStatusRange5xx)
+}
+
+// recordPeerState records 1 for the current state of the peer and 0 for the other states.
+func (r *nginxScraper) recordPeerState(now pcommon.Timestamp, upstream, peer, state string) {
+	if _, ok := metadata.MapAttributePeerState[state]; !ok {
+		r.settings.Logger.Debug("Unknown upstream peer state", zap.String("upstream", upstream), zap.String("peer", peer), zap.String("state", state))
+		return
+	}
+	for name, value := range metadata.MapAttributePeerState {
+		var val int64
+		if name == state {
+			val = 1
+		}
+		r.mb.RecordNginxUpstreamPeerStateDataPoint(now, val, upstream, peer, value)
+	}
+}
@@ -5,6 +5,8 @@ package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -49,24 +51,41 @@ func (r *nginxScraper) start(ctx context.Context, host component.Host) error {
 	return nil
 }
 
-func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
+func (r *nginxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	var err error
+	switch r.cfg.StatusModule {
+	case statusModulePlusAPI:
+		err = r.scrapePlusAPI(ctx, now)
+	case statusModuleVTS:
+		err = r.scrapeVTS(ctx, now)
+	default:
+		err = r.scrapeStubStatus(now)
+	}
+	if err != nil {
+		r.settings.Logger.Error("Failed to fetch nginx stats", zap.Error(err))
+		return pmetric.Metrics{}, err
+	}
+	return r.mb.Emit(), nil
+}
+
+func (r *nginxScraper) scrapeStubStatus(now pcommon.Timestamp) error {
 	// Init client in scrape method in case there are transient errors in the constructor.
 	if r.client == nil {
 		var err error
 		r.client, err = client.NewNginxClient(r.httpClient, r.cfg.ClientConfig.Endpoint)
 		if err != nil {
 			r.client = nil
-			return pmetric.Metrics{}, err
+			return err
 		}
 	}
 
 	stats, err := r.client.GetStubStats()
 	if err != nil {
-		r.settings.Logger.Error("Failed to fetch nginx stats", zap.Error(err))
-		return pmetric.Metrics{}, err
+		return err
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
 	r.mb.RecordNginxRequestsDataPoint(now, stats.Requests)
 	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, stats.Connections.Accepted)
 	r.mb.RecordNginxConnectionsHandledDataPoint(now, stats.Connections.Handled)
@@ -74,5 +93,26 @@ func (r *nginxScraper) scrape(context.Context) (pmetric.Metrics, error) {
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Reading, metadata.AttributeStateReading)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Writing, metadata.AttributeStateWriting)
 	r.mb.RecordNginxConnectionsCurrentDataPoint(now, stats.Connections.Waiting, metadata.AttributeStateWaiting)
-	return r.mb.Emit(), nil
+	return nil
+}
+
+// getJSON decodes the JSON document served at url into v.
+func (r *nginxScraper) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200 response from %s, got %d", url, resp.StatusCode)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+	return nil
 }
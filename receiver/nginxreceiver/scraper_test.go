@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -49,6 +50,91 @@ func TestScraper(t *testing.T) {
 		pmetrictest.IgnoreMetricsOrder()))
 }
 
+func TestScraperStatusModules(t *testing.T) {
+	// Serves the JSON documents of testdata/scraper at their path without extension.
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		data, err := os.ReadFile(filepath.Join("testdata", "scraper", req.URL.Path+".json"))
+		if err != nil {
+			rw.WriteHeader(404)
+			return
+		}
+		_, err = rw.Write(data)
+		require.NoError(t, err)
+	}))
+	defer nginxMock.Close()
+
+	testCases := []struct {
+		statusModule string
+		endpoint     string
+		expectedFile string
+	}{
+		{
+			statusModule: statusModulePlusAPI,
+			endpoint:     nginxMock.URL + "/plus_api/",
+			expectedFile: "expected_plus_api.yaml",
+		},
+		{
+			statusModule: statusModuleVTS,
+			endpoint:     nginxMock.URL + "/vts",
+			expectedFile: "expected_vts.yaml",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.statusModule, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Endpoint = tc.endpoint
+			cfg.StatusModule = tc.statusModule
+			require.NoError(t, component.ValidateConfig(cfg))
+
+			scraper := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+			require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+
+			actualMetrics, err := scraper.scrape(context.Background())
+			require.NoError(t, err)
+
+			expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", tc.expectedFile))
+			require.NoError(t, err)
+
+			require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+				pmetrictest.IgnoreStartTimestamp(),
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+				pmetrictest.IgnoreTimestamp(),
+				pmetrictest.IgnoreMetricsOrder()))
+		})
+	}
+}
+
+func TestScraperStatusModulesError(t *testing.T) {
+	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/9/connections" {
+			_, _ = rw.Write([]byte(`Bad API response`))
+			return
+		}
+		rw.WriteHeader(404)
+	}))
+	defer nginxMock.Close()
+
+	t.Run("plus_api parse error", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Endpoint = nginxMock.URL + "/api/9"
+		cfg.StatusModule = statusModulePlusAPI
+		sc := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+		require.NoError(t, sc.start(context.Background(), componenttest.NewNopHost()))
+		_, err := sc.scrape(context.Background())
+		require.ErrorContains(t, err, "failed to decode response from "+nginxMock.URL+"/api/9/connections")
+	})
+
+	t.Run("vts 404", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Endpoint = nginxMock.URL + "/status/format/json"
+		cfg.StatusModule = statusModuleVTS
+		sc := newNginxScraper(receivertest.NewNopCreateSettings(), cfg)
+		require.NoError(t, sc.start(context.Background(), componenttest.NewNopHost()))
+		_, err := sc.scrape(context.Background())
+		require.EqualError(t, err, "expected 200 response from "+nginxMock.URL+"/status/format/json, got 404")
+	})
+}
+
 func TestScraperError(t *testing.T) {
 	nginxMock := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.Path == "/status" {
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package nginxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/nginxreceiver/internal/metadata"
+)
+
+// vtsAllZones is the server zone aggregating the traffic of all the other zones.
+const vtsAllZones = "*"
+
+// vtsStatus is the JSON status page of the nginx-module-vts module.
+type vtsStatus struct {
+	Connections struct {
+		Active   int64 `json:"active"`
+		Reading  int64 `json:"reading"`
+		Writing  int64 `json:"writing"`
+		Waiting  int64 `json:"waiting"`
+		Accepted int64 `json:"accepted"`
+		Handled  int64 `json:"handled"`
+		Requests int64 `json:"requests"`
+	} `json:"connections"`
+	ServerZones   map[string]vtsServerZone `json:"serverZones"`
+	UpstreamZones map[string][]vtsPeer     `json:"upstreamZones"`
+}
+
+type vtsServerZone struct {
+	RequestCounter int64         `json:"requestCounter"`
+	InBytes        int64         `json:"inBytes"`
+	OutBytes       int64         `json:"outBytes"`
+	Responses      plusResponses `json:"responses"`
+	RequestMsec    int64         `json:"requestMsec"`
+}
+
+type vtsPeer struct {
+	Server         string        `json:"server"`
+	RequestCounter int64         `json:"requestCounter"`
+	InBytes        int64         `json:"inBytes"`
+	OutBytes       int64         `json:"outBytes"`
+	Responses      plusResponses `json:"responses"`
+	ResponseMsec   int64         `json:"responseMsec"`
+	Down           bool          `json:"down"`
+}
+
+// scrapeVTS collects metrics from the JSON status page of the nginx-module-vts module.
+func (r *nginxScraper) scrapeVTS(ctx context.Context, now pcommon.Timestamp) error {
+	var status vtsStatus
+	if err := r.getJSON(ctx, r.cfg.Endpoint, &status); err != nil {
+		return err
+	}
+
+	r.mb.RecordNginxRequestsDataPoint(now, status.Connections.Requests)
+	r.mb.RecordNginxConnectionsAcceptedDataPoint(now, status.Connections.Accepted)
+	r.mb.RecordNginxConnectionsHandledDataPoint(now, status.Connections.Handled)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Active, metadata.AttributeStateActive)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Reading, metadata.AttributeStateReading)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Writing, metadata.AttributeStateWriting)
+	r.mb.RecordNginxConnectionsCurrentDataPoint(now, status.Connections.Waiting, metadata.AttributeStateWaiting)
+
+	for name, zone := range status.ServerZones {
+		if name == vtsAllZones {
+			continue
+		}
+		r.mb.RecordNginxServerZoneRequestsDataPoint(now, zone.RequestCounter, name)
+		r.recordServerZoneResponses(now, name, zone.Responses)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.InBytes, name, metadata.AttributeDirectionReceived)
+		r.mb.RecordNginxServerZoneIoDataPoint(now, zone.OutBytes, name, metadata.AttributeDirectionSent)
+		r.mb.RecordNginxServerZoneResponseTimeDataPoint(now, zone.RequestMsec, name)
+	}
+
+	for name, peers := range status.UpstreamZones {
+		for _, peer := range peers {
+			r.mb.RecordNginxUpstreamPeerRequestsDataPoint(now, peer.RequestCounter, name, peer.Server)
+			r.recordPeerResponses(now, name, peer.Server, peer.Responses)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.InBytes, name, peer.Server, metadata.AttributeDirectionReceived)
+			r.mb.RecordNginxUpstreamPeerIoDataPoint(now, peer.OutBytes, name, peer.Server, metadata.AttributeDirectionSent)
+			r.mb.RecordNginxUpstreamPeerResponseTimeDataPoint(now, peer.ResponseMsec, name, peer.Server)
+			state := metadata.AttributePeerStateUp.String()
+			if peer.Down {
+				state = metadata.AttributePeerStateDown.String()
+			}
+			r.recordPeerState(now, name, peer.Server, state)
+		}
+	}
+	return nil
+}
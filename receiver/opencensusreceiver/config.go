@@ -4,8 +4,12 @@
 package opencensusreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver"
 
 import (
+	"fmt"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
+
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 )
 
 // Config defines configuration for OpenCensus receiver.
@@ -18,6 +22,20 @@ type Config struct {
 	// An empty list means that CORS is not enabled at all. A wildcard (*) can be
 	// used to match any origin or one or more characters of an origin.
 	CorsOrigins []string `mapstructure:"cors_allowed_origins"`
+
+	// SpanEvents configures how the time events of the OpenCensus spans are translated to span events.
+	SpanEvents SpanEventsConfig `mapstructure:"span_events"`
+}
+
+// SpanEventsConfig defines the translation of the annotations and message events of OpenCensus spans.
+type SpanEventsConfig struct {
+	// Annotations is "event" to translate annotations to span events, or "drop" to only count them
+	// in the dropped events count of the span.
+	Annotations internaldata.EventTranslation `mapstructure:"annotations"`
+	// MessageEvents is "event" to translate message events to span events with the legacy messaging
+	// attributes, "rpc_event" to use the RPC message attributes of the OpenTelemetry semantic conventions
+	// instead, or "drop" to only count them in the dropped events count of the span.
+	MessageEvents internaldata.EventTranslation `mapstructure:"message_events"`
 }
 
 func (cfg *Config) buildOptions() []ocOption {
@@ -27,6 +45,10 @@ func (cfg *Config) buildOptions() []ocOption {
 	}
 
 	opts = append(opts, withGRPCServerSettings(cfg.ServerConfig))
+	opts = append(opts, withTracesOptions(
+		internaldata.WithAnnotations(cfg.SpanEvents.Annotations),
+		internaldata.WithMessageEvents(cfg.SpanEvents.MessageEvents),
+	))
 
 	return opts
 }
@@ -35,5 +57,17 @@ var _ component.Config = (*Config)(nil)
 
 // Validate checks the receiver configuration is valid
 func (cfg *Config) Validate() error {
+	switch cfg.SpanEvents.Annotations {
+	case internaldata.EventTranslationEvent, internaldata.EventTranslationDrop:
+	default:
+		return fmt.Errorf("invalid span_events::annotations %q, must be one of %q or %q",
+			cfg.SpanEvents.Annotations, internaldata.EventTranslationEvent, internaldata.EventTranslationDrop)
+	}
+	switch cfg.SpanEvents.MessageEvents {
+	case internaldata.EventTranslationEvent, internaldata.EventTranslationRPCEvent, internaldata.EventTranslationDrop:
+	default:
+		return fmt.Errorf("invalid span_events::message_events %q, must be one of %q, %q or %q",
+			cfg.SpanEvents.MessageEvents, internaldata.EventTranslationEvent, internaldata.EventTranslationRPCEvent, internaldata.EventTranslationDrop)
+	}
 	return nil
 }
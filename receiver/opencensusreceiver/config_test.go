@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver/internal/metadata"
 )
 
@@ -26,8 +27,9 @@ func TestLoadConfig(t *testing.T) {
 	require.NoError(t, err)
 
 	tests := []struct {
-		id       component.ID
-		expected component.Config
+		id          component.ID
+		expected    component.Config
+		expectedErr string
 	}{
 		{
 			id: component.NewIDWithName(metadata.Type, "customname"),
@@ -39,6 +41,10 @@ func TestLoadConfig(t *testing.T) {
 					},
 					ReadBufferSize: 512 * 1024,
 				},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationEvent,
+					MessageEvents: internaldata.EventTranslationEvent,
+				},
 			},
 		},
 		{
@@ -64,6 +70,10 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationEvent,
+					MessageEvents: internaldata.EventTranslationEvent,
+				},
 			},
 		},
 		{
@@ -84,6 +94,10 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationEvent,
+					MessageEvents: internaldata.EventTranslationEvent,
+				},
 			},
 		},
 		{
@@ -102,6 +116,10 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationEvent,
+					MessageEvents: internaldata.EventTranslationEvent,
+				},
 			},
 		},
 		{
@@ -115,6 +133,10 @@ func TestLoadConfig(t *testing.T) {
 					ReadBufferSize: 512 * 1024,
 				},
 				CorsOrigins: []string{"https://*.test.com", "https://test.com"},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationEvent,
+					MessageEvents: internaldata.EventTranslationEvent,
+				},
 			},
 		},
 		{
@@ -127,8 +149,32 @@ func TestLoadConfig(t *testing.T) {
 					},
 					ReadBufferSize: 512 * 1024,
 				},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationEvent,
+					MessageEvents: internaldata.EventTranslationEvent,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "span_events"),
+			expected: &Config{
+				ServerConfig: configgrpc.ServerConfig{
+					NetAddr: confignet.AddrConfig{
+						Endpoint:  "0.0.0.0:55678",
+						Transport: confignet.TransportTypeTCP,
+					},
+					ReadBufferSize: 512 * 1024,
+				},
+				SpanEvents: SpanEventsConfig{
+					Annotations:   internaldata.EventTranslationDrop,
+					MessageEvents: internaldata.EventTranslationRPCEvent,
+				},
 			},
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalid_span_events"),
+			expectedErr: `invalid span_events::message_events "span", must be one of "event", "rpc_event" or "drop"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -140,6 +186,10 @@ func TestLoadConfig(t *testing.T) {
 			require.NoError(t, err)
 			require.NoError(t, component.UnmarshalConfig(sub, cfg))
 
+			if tt.expectedErr != "" {
+				assert.EqualError(t, component.ValidateConfig(cfg), tt.expectedErr)
+				return
+			}
 			assert.NoError(t, component.ValidateConfig(cfg))
 			assert.Equal(t, tt.expected, cfg)
 		})
@@ -14,6 +14,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/localhostgate"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sharedcomponent"
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver/internal/metadata"
 )
 
@@ -38,6 +39,10 @@ func createDefaultConfig() component.Config {
 			// We almost write 0 bytes, so no need to tune WriteBufferSize.
 			ReadBufferSize: 512 * 1024,
 		},
+		SpanEvents: SpanEventsConfig{
+			Annotations:   internaldata.EventTranslationEvent,
+			MessageEvents: internaldata.EventTranslationEvent,
+		},
 	}
 }
 
@@ -29,10 +29,11 @@ type Receiver struct {
 	agenttracepb.UnimplementedTraceServiceServer
 	nextConsumer consumer.Traces
 	obsrecv      *receiverhelper.ObsReport
+	options      []internaldata.TracesOption
 }
 
 // New creates a new opencensus.Receiver reference.
-func New(nextConsumer consumer.Traces, set receiver.CreateSettings) (*Receiver, error) {
+func New(nextConsumer consumer.Traces, set receiver.CreateSettings, options ...internaldata.TracesOption) (*Receiver, error) {
 
 	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
 		ReceiverID:             set.ID,
@@ -46,6 +47,7 @@ func New(nextConsumer consumer.Traces, set receiver.CreateSettings) (*Receiver,
 	return &Receiver{
 		nextConsumer: nextConsumer,
 		obsrecv:      obsrecv,
+		options:      options,
 	}, nil
 }
 
@@ -119,7 +121,7 @@ func (ocr *Receiver) processReceivedMsg(
 		resource = recv.Resource
 	}
 
-	td := internaldata.OCToTraces(lastNonNilNode, resource, recv.Spans)
+	td := internaldata.OCToTraces(lastNonNilNode, resource, recv.Spans, ocr.options...)
 	err := ocr.sendToNextConsumer(longLivedRPCCtx, td)
 	return lastNonNilNode, resource, err
 }
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver/internal/ocmetrics"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/opencensusreceiver/internal/octrace"
 )
@@ -37,6 +38,7 @@ type ocReceiver struct {
 	gatewayMux         *gatewayruntime.ServeMux
 	corsOrigins        []string
 	grpcServerSettings configgrpc.ServerConfig
+	tracesOptions      []internaldata.TracesOption
 	cancel             context.CancelFunc
 
 	traceReceiver   *octrace.Receiver
@@ -94,7 +96,7 @@ func (ocr *ocReceiver) Start(ctx context.Context, host component.Host) error {
 	hasConsumer := false
 	if ocr.traceConsumer != nil {
 		hasConsumer = true
-		ocr.traceReceiver, err = octrace.New(ocr.traceConsumer, ocr.settings)
+		ocr.traceReceiver, err = octrace.New(ocr.traceConsumer, ocr.settings, ocr.tracesOptions...)
 		if err != nil {
 			return err
 		}
@@ -5,6 +5,8 @@ package opencensusreceiver // import "github.com/open-telemetry/opentelemetry-co
 
 import (
 	"go.opentelemetry.io/collector/config/configgrpc"
+
+	internaldata "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/opencensus"
 )
 
 // ocOption interface defines for configuration settings to be applied to receivers.
@@ -39,3 +41,14 @@ func withGRPCServerSettings(settings configgrpc.ServerConfig) ocOption {
 func (gsvo grpcServerSettings) withReceiver(ocr *ocReceiver) {
 	ocr.grpcServerSettings = configgrpc.ServerConfig(gsvo)
 }
+
+type tracesOptions []internaldata.TracesOption
+
+// withTracesOptions is an option to customize the translation of the received spans.
+func withTracesOptions(opts ...internaldata.TracesOption) ocOption {
+	return tracesOptions(opts)
+}
+
+func (to tracesOptions) withReceiver(ocr *ocReceiver) {
+	ocr.tracesOptions = to
+}
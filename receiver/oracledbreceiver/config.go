@@ -24,6 +24,15 @@ var (
 	errEmptyPassword = errors.New("password must be set")
 	errEmptyService  = errors.New("service must be specified")
 	errEmptyUsername = errors.New("username must be set")
+	errBadSource     = fmt.Errorf("active_session_sampling::source must be %q or %q", activeSessionSourceSession, activeSessionSourceASH)
+	errBadTopSQL     = errors.New("active_session_sampling::top_sql_count must be positive")
+)
+
+const (
+	// activeSessionSourceSession samples v$session at every scrape.
+	activeSessionSourceSession = "session"
+	// activeSessionSourceASH reads the samples of v$active_session_history taken since the previous scrape.
+	activeSessionSourceASH = "ash"
 )
 
 type Config struct {
@@ -34,6 +43,17 @@ type Config struct {
 	Username                       string `mapstructure:"username"`
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	ActiveSessionSampling          ActiveSessionSamplingConfig `mapstructure:"active_session_sampling"`
+}
+
+// ActiveSessionSamplingConfig configures the sampling of the active sessions reported by the
+// oracledb.active_sessions and oracledb.sql.active_sessions metrics.
+type ActiveSessionSamplingConfig struct {
+	// Source is either session, to sample v$session at every scrape, or ash, to read the samples of
+	// v$active_session_history. Querying v$active_session_history requires the Oracle Diagnostics Pack.
+	Source string `mapstructure:"source"`
+	// TopSQLCount is the number of most active statements reported by oracledb.sql.active_sessions.
+	TopSQLCount int `mapstructure:"top_sql_count"`
 }
 
 func (c Config) Validate() error {
@@ -80,5 +100,12 @@ func (c Config) Validate() error {
 			allErrs = multierr.Append(allErrs, fmt.Errorf("%w: %s", errBadDataSource, err.Error()))
 		}
 	}
+
+	if c.ActiveSessionSampling.Source != activeSessionSourceSession && c.ActiveSessionSampling.Source != activeSessionSourceASH {
+		allErrs = multierr.Append(allErrs, errBadSource)
+	}
+	if c.ActiveSessionSampling.TopSQLCount <= 0 {
+		allErrs = multierr.Append(allErrs, errBadTopSQL)
+	}
 	return allErrs
 }
@@ -28,6 +28,7 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for oracledb metrics.
 type MetricsConfig struct {
+	OracledbActiveSessions        MetricConfig `mapstructure:"oracledb.active_sessions"`
 	OracledbConsistentGets        MetricConfig `mapstructure:"oracledb.consistent_gets"`
 	OracledbCPUTime               MetricConfig `mapstructure:"oracledb.cpu_time"`
 	OracledbDbBlockGets           MetricConfig `mapstructure:"oracledb.db_block_gets"`
@@ -49,16 +50,21 @@ type MetricsConfig struct {
 	OracledbProcessesUsage        MetricConfig `mapstructure:"oracledb.processes.usage"`
 	OracledbSessionsLimit         MetricConfig `mapstructure:"oracledb.sessions.limit"`
 	OracledbSessionsUsage         MetricConfig `mapstructure:"oracledb.sessions.usage"`
+	OracledbSQLActiveSessions     MetricConfig `mapstructure:"oracledb.sql.active_sessions"`
 	OracledbTablespaceSizeLimit   MetricConfig `mapstructure:"oracledb.tablespace_size.limit"`
 	OracledbTablespaceSizeUsage   MetricConfig `mapstructure:"oracledb.tablespace_size.usage"`
 	OracledbTransactionsLimit     MetricConfig `mapstructure:"oracledb.transactions.limit"`
 	OracledbTransactionsUsage     MetricConfig `mapstructure:"oracledb.transactions.usage"`
 	OracledbUserCommits           MetricConfig `mapstructure:"oracledb.user_commits"`
 	OracledbUserRollbacks         MetricConfig `mapstructure:"oracledb.user_rollbacks"`
+	OracledbWaitClassTime         MetricConfig `mapstructure:"oracledb.wait_class.time"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		OracledbActiveSessions: MetricConfig{
+			Enabled: false,
+		},
 		OracledbConsistentGets: MetricConfig{
 			Enabled: false,
 		},
@@ -122,6 +128,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		OracledbSessionsUsage: MetricConfig{
 			Enabled: true,
 		},
+		OracledbSQLActiveSessions: MetricConfig{
+			Enabled: false,
+		},
 		OracledbTablespaceSizeLimit: MetricConfig{
 			Enabled: true,
 		},
@@ -140,6 +149,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		OracledbUserRollbacks: MetricConfig{
 			Enabled: true,
 		},
+		OracledbWaitClassTime: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
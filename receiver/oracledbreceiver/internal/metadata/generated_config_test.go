@@ -25,6 +25,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					OracledbActiveSessions:        MetricConfig{Enabled: true},
 					OracledbConsistentGets:        MetricConfig{Enabled: true},
 					OracledbCPUTime:               MetricConfig{Enabled: true},
 					OracledbDbBlockGets:           MetricConfig{Enabled: true},
@@ -46,12 +47,14 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					OracledbProcessesUsage:        MetricConfig{Enabled: true},
 					OracledbSessionsLimit:         MetricConfig{Enabled: true},
 					OracledbSessionsUsage:         MetricConfig{Enabled: true},
+					OracledbSQLActiveSessions:     MetricConfig{Enabled: true},
 					OracledbTablespaceSizeLimit:   MetricConfig{Enabled: true},
 					OracledbTablespaceSizeUsage:   MetricConfig{Enabled: true},
 					OracledbTransactionsLimit:     MetricConfig{Enabled: true},
 					OracledbTransactionsUsage:     MetricConfig{Enabled: true},
 					OracledbUserCommits:           MetricConfig{Enabled: true},
 					OracledbUserRollbacks:         MetricConfig{Enabled: true},
+					OracledbWaitClassTime:         MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					OracledbInstanceName: ResourceAttributeConfig{Enabled: true},
@@ -62,6 +65,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					OracledbActiveSessions:        MetricConfig{Enabled: false},
 					OracledbConsistentGets:        MetricConfig{Enabled: false},
 					OracledbCPUTime:               MetricConfig{Enabled: false},
 					OracledbDbBlockGets:           MetricConfig{Enabled: false},
@@ -83,12 +87,14 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					OracledbProcessesUsage:        MetricConfig{Enabled: false},
 					OracledbSessionsLimit:         MetricConfig{Enabled: false},
 					OracledbSessionsUsage:         MetricConfig{Enabled: false},
+					OracledbSQLActiveSessions:     MetricConfig{Enabled: false},
 					OracledbTablespaceSizeLimit:   MetricConfig{Enabled: false},
 					OracledbTablespaceSizeUsage:   MetricConfig{Enabled: false},
 					OracledbTransactionsLimit:     MetricConfig{Enabled: false},
 					OracledbTransactionsUsage:     MetricConfig{Enabled: false},
 					OracledbUserCommits:           MetricConfig{Enabled: false},
 					OracledbUserRollbacks:         MetricConfig{Enabled: false},
+					OracledbWaitClassTime:         MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					OracledbInstanceName: ResourceAttributeConfig{Enabled: false},
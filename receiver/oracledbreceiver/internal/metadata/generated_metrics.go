@@ -14,6 +14,57 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+type metricOracledbActiveSessions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.active_sessions metric with initial data.
+func (m *metricOracledbActiveSessions) init() {
+	m.data.SetName("oracledb.active_sessions")
+	m.data.SetDescription("Number of active foreground sessions sampled per wait class, averaged over the collection interval with the ash source.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbActiveSessions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("wait_class", waitClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbActiveSessions) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbActiveSessions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbActiveSessions(cfg MetricConfig) metricOracledbActiveSessions {
+	m := metricOracledbActiveSessions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbConsistentGets struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1068,6 +1119,57 @@ func newMetricOracledbSessionsUsage(cfg MetricConfig) metricOracledbSessionsUsag
 	return m
 }
 
+type metricOracledbSQLActiveSessions struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.sql.active_sessions metric with initial data.
+func (m *metricOracledbSQLActiveSessions) init() {
+	m.data.SetName("oracledb.sql.active_sessions")
+	m.data.SetDescription("Number of active foreground sessions sampled executing the most active statements, averaged over the collection interval with the ash source.")
+	m.data.SetUnit("{sessions}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbSQLActiveSessions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, sqlIDAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("sql_id", sqlIDAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbSQLActiveSessions) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbSQLActiveSessions) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbSQLActiveSessions(cfg MetricConfig) metricOracledbSQLActiveSessions {
+	m := metricOracledbSQLActiveSessions{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbTablespaceSizeLimit struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1370,6 +1472,59 @@ func newMetricOracledbUserRollbacks(cfg MetricConfig) metricOracledbUserRollback
 	return m
 }
 
+type metricOracledbWaitClassTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.wait_class.time metric with initial data.
+func (m *metricOracledbWaitClassTime) init() {
+	m.data.SetName("oracledb.wait_class.time")
+	m.data.SetDescription("Cumulative time waited by the sessions per non-idle wait class, in seconds")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbWaitClassTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("wait_class", waitClassAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbWaitClassTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbWaitClassTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbWaitClassTime(cfg MetricConfig) metricOracledbWaitClassTime {
+	m := metricOracledbWaitClassTime{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -1380,6 +1535,7 @@ type MetricsBuilder struct {
 	buildInfo                           component.BuildInfo  // contains version information.
 	resourceAttributeIncludeFilter      map[string]filter.Filter
 	resourceAttributeExcludeFilter      map[string]filter.Filter
+	metricOracledbActiveSessions        metricOracledbActiveSessions
 	metricOracledbConsistentGets        metricOracledbConsistentGets
 	metricOracledbCPUTime               metricOracledbCPUTime
 	metricOracledbDbBlockGets           metricOracledbDbBlockGets
@@ -1401,12 +1557,14 @@ type MetricsBuilder struct {
 	metricOracledbProcessesUsage        metricOracledbProcessesUsage
 	metricOracledbSessionsLimit         metricOracledbSessionsLimit
 	metricOracledbSessionsUsage         metricOracledbSessionsUsage
+	metricOracledbSQLActiveSessions     metricOracledbSQLActiveSessions
 	metricOracledbTablespaceSizeLimit   metricOracledbTablespaceSizeLimit
 	metricOracledbTablespaceSizeUsage   metricOracledbTablespaceSizeUsage
 	metricOracledbTransactionsLimit     metricOracledbTransactionsLimit
 	metricOracledbTransactionsUsage     metricOracledbTransactionsUsage
 	metricOracledbUserCommits           metricOracledbUserCommits
 	metricOracledbUserRollbacks         metricOracledbUserRollbacks
+	metricOracledbWaitClassTime         metricOracledbWaitClassTime
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1425,6 +1583,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		startTime:                           pcommon.NewTimestampFromTime(time.Now()),
 		metricsBuffer:                       pmetric.NewMetrics(),
 		buildInfo:                           settings.BuildInfo,
+		metricOracledbActiveSessions:        newMetricOracledbActiveSessions(mbc.Metrics.OracledbActiveSessions),
 		metricOracledbConsistentGets:        newMetricOracledbConsistentGets(mbc.Metrics.OracledbConsistentGets),
 		metricOracledbCPUTime:               newMetricOracledbCPUTime(mbc.Metrics.OracledbCPUTime),
 		metricOracledbDbBlockGets:           newMetricOracledbDbBlockGets(mbc.Metrics.OracledbDbBlockGets),
@@ -1446,12 +1605,14 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricOracledbProcessesUsage:        newMetricOracledbProcessesUsage(mbc.Metrics.OracledbProcessesUsage),
 		metricOracledbSessionsLimit:         newMetricOracledbSessionsLimit(mbc.Metrics.OracledbSessionsLimit),
 		metricOracledbSessionsUsage:         newMetricOracledbSessionsUsage(mbc.Metrics.OracledbSessionsUsage),
+		metricOracledbSQLActiveSessions:     newMetricOracledbSQLActiveSessions(mbc.Metrics.OracledbSQLActiveSessions),
 		metricOracledbTablespaceSizeLimit:   newMetricOracledbTablespaceSizeLimit(mbc.Metrics.OracledbTablespaceSizeLimit),
 		metricOracledbTablespaceSizeUsage:   newMetricOracledbTablespaceSizeUsage(mbc.Metrics.OracledbTablespaceSizeUsage),
 		metricOracledbTransactionsLimit:     newMetricOracledbTransactionsLimit(mbc.Metrics.OracledbTransactionsLimit),
 		metricOracledbTransactionsUsage:     newMetricOracledbTransactionsUsage(mbc.Metrics.OracledbTransactionsUsage),
 		metricOracledbUserCommits:           newMetricOracledbUserCommits(mbc.Metrics.OracledbUserCommits),
 		metricOracledbUserRollbacks:         newMetricOracledbUserRollbacks(mbc.Metrics.OracledbUserRollbacks),
+		metricOracledbWaitClassTime:         newMetricOracledbWaitClassTime(mbc.Metrics.OracledbWaitClassTime),
 		resourceAttributeIncludeFilter:      make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:      make(map[string]filter.Filter),
 	}
@@ -1522,6 +1683,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetName("otelcol/oracledbreceiver")
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricOracledbActiveSessions.emit(ils.Metrics())
 	mb.metricOracledbConsistentGets.emit(ils.Metrics())
 	mb.metricOracledbCPUTime.emit(ils.Metrics())
 	mb.metricOracledbDbBlockGets.emit(ils.Metrics())
@@ -1543,12 +1705,14 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricOracledbProcessesUsage.emit(ils.Metrics())
 	mb.metricOracledbSessionsLimit.emit(ils.Metrics())
 	mb.metricOracledbSessionsUsage.emit(ils.Metrics())
+	mb.metricOracledbSQLActiveSessions.emit(ils.Metrics())
 	mb.metricOracledbTablespaceSizeLimit.emit(ils.Metrics())
 	mb.metricOracledbTablespaceSizeUsage.emit(ils.Metrics())
 	mb.metricOracledbTransactionsLimit.emit(ils.Metrics())
 	mb.metricOracledbTransactionsUsage.emit(ils.Metrics())
 	mb.metricOracledbUserCommits.emit(ils.Metrics())
 	mb.metricOracledbUserRollbacks.emit(ils.Metrics())
+	mb.metricOracledbWaitClassTime.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -1580,6 +1744,11 @@ func (mb *MetricsBuilder) Emit(rmo ...ResourceMetricsOption) pmetric.Metrics {
 	return metrics
 }
 
+// RecordOracledbActiveSessionsDataPoint adds a data point to oracledb.active_sessions metric.
+func (mb *MetricsBuilder) RecordOracledbActiveSessionsDataPoint(ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	mb.metricOracledbActiveSessions.recordDataPoint(mb.startTime, ts, val, waitClassAttributeValue)
+}
+
 // RecordOracledbConsistentGetsDataPoint adds a data point to oracledb.consistent_gets metric.
 func (mb *MetricsBuilder) RecordOracledbConsistentGetsDataPoint(ts pcommon.Timestamp, inputVal string) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -1785,6 +1954,11 @@ func (mb *MetricsBuilder) RecordOracledbSessionsUsageDataPoint(ts pcommon.Timest
 	return nil
 }
 
+// RecordOracledbSQLActiveSessionsDataPoint adds a data point to oracledb.sql.active_sessions metric.
+func (mb *MetricsBuilder) RecordOracledbSQLActiveSessionsDataPoint(ts pcommon.Timestamp, val float64, sqlIDAttributeValue string) {
+	mb.metricOracledbSQLActiveSessions.recordDataPoint(mb.startTime, ts, val, sqlIDAttributeValue)
+}
+
 // RecordOracledbTablespaceSizeLimitDataPoint adds a data point to oracledb.tablespace_size.limit metric.
 func (mb *MetricsBuilder) RecordOracledbTablespaceSizeLimitDataPoint(ts pcommon.Timestamp, val int64, tablespaceNameAttributeValue string) {
 	mb.metricOracledbTablespaceSizeLimit.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
@@ -1835,6 +2009,11 @@ func (mb *MetricsBuilder) RecordOracledbUserRollbacksDataPoint(ts pcommon.Timest
 	return nil
 }
 
+// RecordOracledbWaitClassTimeDataPoint adds a data point to oracledb.wait_class.time metric.
+func (mb *MetricsBuilder) RecordOracledbWaitClassTimeDataPoint(ts pcommon.Timestamp, val float64, waitClassAttributeValue string) {
+	mb.metricOracledbWaitClassTime.recordDataPoint(mb.startTime, ts, val, waitClassAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
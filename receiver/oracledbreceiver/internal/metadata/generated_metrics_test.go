@@ -68,6 +68,9 @@ func TestMetricsBuilder(t *testing.T) {
 			defaultMetricsCount := 0
 			allMetricsCount := 0
 
+			allMetricsCount++
+			mb.RecordOracledbActiveSessionsDataPoint(ts, 1, "wait_class-val")
+
 			allMetricsCount++
 			mb.RecordOracledbConsistentGetsDataPoint(ts, "1")
 
@@ -150,6 +153,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordOracledbSessionsUsageDataPoint(ts, "1", "session_type-val", "session_status-val")
 
+			allMetricsCount++
+			mb.RecordOracledbSQLActiveSessionsDataPoint(ts, 1, "sql_id-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordOracledbTablespaceSizeLimitDataPoint(ts, 1, "tablespace_name-val")
@@ -174,6 +180,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordOracledbUserRollbacksDataPoint(ts, "1")
 
+			allMetricsCount++
+			mb.RecordOracledbWaitClassTimeDataPoint(ts, 1, "wait_class-val")
+
 			rb := mb.NewResourceBuilder()
 			rb.SetOracledbInstanceName("oracledb.instance.name-val")
 			res := rb.Emit()
@@ -198,6 +207,21 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for i := 0; i < ms.Len(); i++ {
 				switch ms.At(i).Name() {
+				case "oracledb.active_sessions":
+					assert.False(t, validatedMetrics["oracledb.active_sessions"], "Found a duplicate in the metrics slice: oracledb.active_sessions")
+					validatedMetrics["oracledb.active_sessions"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of active foreground sessions sampled per wait class, averaged over the collection interval with the ash source.", ms.At(i).Description())
+					assert.Equal(t, "{sessions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("wait_class")
+					assert.True(t, ok)
+					assert.EqualValues(t, "wait_class-val", attrVal.Str())
 				case "oracledb.consistent_gets":
 					assert.False(t, validatedMetrics["oracledb.consistent_gets"], "Found a duplicate in the metrics slice: oracledb.consistent_gets")
 					validatedMetrics["oracledb.consistent_gets"] = true
@@ -478,6 +502,21 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("session_status")
 					assert.True(t, ok)
 					assert.EqualValues(t, "session_status-val", attrVal.Str())
+				case "oracledb.sql.active_sessions":
+					assert.False(t, validatedMetrics["oracledb.sql.active_sessions"], "Found a duplicate in the metrics slice: oracledb.sql.active_sessions")
+					validatedMetrics["oracledb.sql.active_sessions"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of active foreground sessions sampled executing the most active statements, averaged over the collection interval with the ash source.", ms.At(i).Description())
+					assert.Equal(t, "{sessions}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("sql_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "sql_id-val", attrVal.Str())
 				case "oracledb.tablespace_size.limit":
 					assert.False(t, validatedMetrics["oracledb.tablespace_size.limit"], "Found a duplicate in the metrics slice: oracledb.tablespace_size.limit")
 					validatedMetrics["oracledb.tablespace_size.limit"] = true
@@ -560,6 +599,23 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "oracledb.wait_class.time":
+					assert.False(t, validatedMetrics["oracledb.wait_class.time"], "Found a duplicate in the metrics slice: oracledb.wait_class.time")
+					validatedMetrics["oracledb.wait_class.time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Cumulative time waited by the sessions per non-idle wait class, in seconds", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("wait_class")
+					assert.True(t, ok)
+					assert.EqualValues(t, "wait_class-val", attrVal.Str())
 				}
 			}
 		})
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -42,6 +43,19 @@ const (
 		select um.TABLESPACE_NAME, um.USED_SPACE, um.TABLESPACE_SIZE, ts.BLOCK_SIZE
 		FROM DBA_TABLESPACE_USAGE_METRICS um INNER JOIN DBA_TABLESPACES ts
 		ON um.TABLESPACE_NAME = ts.TABLESPACE_NAME`
+	// The session running the query is not sampled.
+	sessionSamplesSQL = `
+		select CASE WHEN STATE = 'WAITING' THEN WAIT_CLASS ELSE 'CPU' END as WAIT_CLASS, SQL_ID, count(*) as VALUE
+		FROM v$session
+		WHERE STATUS = 'ACTIVE' AND TYPE = 'USER' AND (STATE != 'WAITING' OR WAIT_CLASS != 'Idle')
+		AND SID != SYS_CONTEXT('USERENV', 'SID')
+		GROUP BY CASE WHEN STATE = 'WAITING' THEN WAIT_CLASS ELSE 'CPU' END, SQL_ID`
+	ashSamplesSQLFormat = `
+		select CASE WHEN SESSION_STATE = 'ON CPU' THEN 'CPU' ELSE WAIT_CLASS END as WAIT_CLASS, SQL_ID, count(*) as VALUE
+		FROM v$active_session_history
+		WHERE SESSION_TYPE = 'FOREGROUND' AND SAMPLE_TIME > SYSTIMESTAMP - NUMTODSINTERVAL(%d, 'SECOND')
+		GROUP BY CASE WHEN SESSION_STATE = 'ON CPU' THEN 'CPU' ELSE WAIT_CLASS END, SQL_ID`
+	waitClassTimeSQL = "select WAIT_CLASS, TIME_WAITED from v$system_wait_class where WAIT_CLASS != 'Idle'"
 )
 
 type dbProviderFunc func() (*sql.DB, error)
@@ -53,6 +67,8 @@ type scraper struct {
 	tablespaceUsageClient      dbClient
 	systemResourceLimitsClient dbClient
 	sessionCountClient         dbClient
+	activeSessionsClient       dbClient
+	waitClassTimeClient        dbClient
 	db                         *sql.DB
 	clientProviderFunc         clientProviderFunc
 	mb                         *metadata.MetricsBuilder
@@ -63,17 +79,19 @@ type scraper struct {
 	scrapeCfg                  scraperhelper.ControllerConfig
 	startTime                  pcommon.Timestamp
 	metricsBuilderConfig       metadata.MetricsBuilderConfig
+	activeSessionSampling      ActiveSessionSamplingConfig
 }
 
-func newScraper(metricsBuilder *metadata.MetricsBuilder, metricsBuilderConfig metadata.MetricsBuilderConfig, scrapeCfg scraperhelper.ControllerConfig, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, instanceName string) (scraperhelper.Scraper, error) {
+func newScraper(metricsBuilder *metadata.MetricsBuilder, metricsBuilderConfig metadata.MetricsBuilderConfig, scrapeCfg scraperhelper.ControllerConfig, activeSessionSampling ActiveSessionSamplingConfig, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, instanceName string) (scraperhelper.Scraper, error) {
 	s := &scraper{
-		mb:                   metricsBuilder,
-		metricsBuilderConfig: metricsBuilderConfig,
-		scrapeCfg:            scrapeCfg,
-		logger:               logger,
-		dbProviderFunc:       providerFunc,
-		clientProviderFunc:   clientProviderFunc,
-		instanceName:         instanceName,
+		mb:                    metricsBuilder,
+		metricsBuilderConfig:  metricsBuilderConfig,
+		scrapeCfg:             scrapeCfg,
+		activeSessionSampling: activeSessionSampling,
+		logger:                logger,
+		dbProviderFunc:        providerFunc,
+		clientProviderFunc:    clientProviderFunc,
+		instanceName:          instanceName,
 	}
 	return scraperhelper.NewScraper(metadata.Type.String(), s.scrape, scraperhelper.WithShutdown(s.shutdown), scraperhelper.WithStart(s.start))
 }
@@ -89,9 +107,24 @@ func (s *scraper) start(context.Context, component.Host) error {
 	s.sessionCountClient = s.clientProviderFunc(s.db, sessionCountSQL, s.logger)
 	s.systemResourceLimitsClient = s.clientProviderFunc(s.db, systemResourceLimitsSQL, s.logger)
 	s.tablespaceUsageClient = s.clientProviderFunc(s.db, tablespaceUsageSQL, s.logger)
+	s.activeSessionsClient = s.clientProviderFunc(s.db, s.activeSessionsSQL(), s.logger)
+	s.waitClassTimeClient = s.clientProviderFunc(s.db, waitClassTimeSQL, s.logger)
 	return nil
 }
 
+// activeSessionsSQL returns the query of the active session samples. The samples of v$active_session_history
+// are read over the collection interval, taken every second.
+func (s *scraper) activeSessionsSQL() string {
+	if s.activeSessionSampling.Source != activeSessionSourceASH {
+		return sessionSamplesSQL
+	}
+	return fmt.Sprintf(ashSamplesSQLFormat, s.ashWindowSeconds())
+}
+
+func (s *scraper) ashWindowSeconds() int64 {
+	return max(int64(s.scrapeCfg.CollectionInterval/time.Second), 1)
+}
+
 func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	s.logger.Debug("Begin scrape")
 
@@ -321,6 +354,28 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		}
 	}
 
+	if s.metricsBuilderConfig.Metrics.OracledbActiveSessions.Enabled ||
+		s.metricsBuilderConfig.Metrics.OracledbSQLActiveSessions.Enabled {
+		scrapeErrors = append(scrapeErrors, s.recordActiveSessions(ctx)...)
+	}
+
+	if s.metricsBuilderConfig.Metrics.OracledbWaitClassTime.Enabled {
+		rows, err := s.waitClassTimeClient.metricRows(ctx)
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", waitClassTimeSQL, err))
+		}
+		now := pcommon.NewTimestampFromTime(time.Now())
+		for _, row := range rows {
+			value, err := strconv.ParseFloat(row["TIME_WAITED"], 64)
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, fmt.Errorf("failed to parse float64 for OracledbWaitClassTime, value was %s: %w", row["TIME_WAITED"], err))
+				continue
+			}
+			// divide by 100 as the value is expressed in hundredths of a second
+			s.mb.RecordOracledbWaitClassTimeDataPoint(now, value/100, row["WAIT_CLASS"])
+		}
+	}
+
 	rb := s.mb.NewResourceBuilder()
 	rb.SetOracledbInstanceName(s.instanceName)
 	out := s.mb.Emit(metadata.WithResource(rb.Emit()))
@@ -331,6 +386,57 @@ func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	return out, nil
 }
 
+// recordActiveSessions records the active sessions sampled per wait class, and the top statements they execute.
+// The samples of v$active_session_history are averaged over the collection interval.
+func (s *scraper) recordActiveSessions(ctx context.Context) []error {
+	rows, err := s.activeSessionsClient.metricRows(ctx)
+	if err != nil {
+		return []error{fmt.Errorf("error executing active session samples query: %w", err)}
+	}
+
+	var errs []error
+	waitClasses := map[string]float64{}
+	statements := map[string]float64{}
+	for _, row := range rows {
+		value, err := strconv.ParseFloat(row["VALUE"], 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse float64 for OracledbActiveSessions, value was %s: %w", row["VALUE"], err))
+			continue
+		}
+		waitClasses[row["WAIT_CLASS"]] += value
+		if sqlID := row["SQL_ID"]; sqlID != "" {
+			statements[sqlID] += value
+		}
+	}
+
+	divisor := 1.0
+	if s.activeSessionSampling.Source == activeSessionSourceASH {
+		divisor = float64(s.ashWindowSeconds())
+	}
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for waitClass, value := range waitClasses {
+		s.mb.RecordOracledbActiveSessionsDataPoint(now, value/divisor, waitClass)
+	}
+
+	sqlIDs := make([]string, 0, len(statements))
+	for sqlID := range statements {
+		sqlIDs = append(sqlIDs, sqlID)
+	}
+	sort.Slice(sqlIDs, func(i, j int) bool {
+		if statements[sqlIDs[i]] != statements[sqlIDs[j]] {
+			return statements[sqlIDs[i]] > statements[sqlIDs[j]]
+		}
+		return sqlIDs[i] < sqlIDs[j]
+	})
+	if len(sqlIDs) > s.activeSessionSampling.TopSQLCount {
+		sqlIDs = sqlIDs[:s.activeSessionSampling.TopSQLCount]
+	}
+	for _, sqlID := range sqlIDs {
+		s.mb.RecordOracledbSQLActiveSessionsDataPoint(now, statements[sqlID]/divisor, sqlID)
+	}
+	return errs
+}
+
 func (s *scraper) shutdown(_ context.Context) error {
 	if s.db == nil {
 		return nil
@@ -7,7 +7,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
@@ -167,3 +170,98 @@ func TestScraper_Scrape(t *testing.T) {
 	}
 
 }
+
+func TestScraper_ActiveSessions(t *testing.T) {
+	samples := []metricRow{
+		{"WAIT_CLASS": "CPU", "SQL_ID": "7ztv2z24kw0s0", "VALUE": "6"},
+		{"WAIT_CLASS": "User I/O", "SQL_ID": "7ztv2z24kw0s0", "VALUE": "4"},
+		{"WAIT_CLASS": "User I/O", "SQL_ID": "g0bggfqrddc4w", "VALUE": "8"},
+		{"WAIT_CLASS": "Concurrency", "SQL_ID": "5wrcv6c1cbx3w", "VALUE": "2"},
+		{"WAIT_CLASS": "CPU", "SQL_ID": "", "VALUE": "2"},
+	}
+	tests := []struct {
+		name               string
+		source             string
+		expectedSQL        string
+		expectedWaitClass  map[string]float64
+		expectedStatements map[string]float64
+	}{
+		{
+			name:               "session",
+			source:             activeSessionSourceSession,
+			expectedSQL:        sessionSamplesSQL,
+			expectedWaitClass:  map[string]float64{"CPU": 8, "User I/O": 12, "Concurrency": 2},
+			expectedStatements: map[string]float64{"7ztv2z24kw0s0": 10, "g0bggfqrddc4w": 8},
+		},
+		{
+			name:               "ash",
+			source:             activeSessionSourceASH,
+			expectedSQL:        fmt.Sprintf(ashSamplesSQLFormat, 10),
+			expectedWaitClass:  map[string]float64{"CPU": 0.8, "User I/O": 1.2, "Concurrency": 0.2},
+			expectedStatements: map[string]float64{"7ztv2z24kw0s0": 1, "g0bggfqrddc4w": 0.8},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := metadata.DefaultMetricsBuilderConfig()
+			cfg.Metrics.OracledbActiveSessions.Enabled = true
+			cfg.Metrics.OracledbSQLActiveSessions.Enabled = true
+			cfg.Metrics.OracledbWaitClassTime.Enabled = true
+
+			scrpr := scraper{
+				logger: zap.NewNop(),
+				mb:     metadata.NewMetricsBuilder(cfg, receivertest.NewNopCreateSettings()),
+				dbProviderFunc: func() (*sql.DB, error) {
+					return nil, nil
+				},
+				clientProviderFunc: func(_ *sql.DB, s string, _ *zap.Logger) dbClient {
+					switch s {
+					case test.expectedSQL:
+						return &fakeDbClient{Responses: [][]metricRow{samples}}
+					case waitClassTimeSQL:
+						return &fakeDbClient{Responses: [][]metricRow{{
+							{"WAIT_CLASS": "User I/O", "TIME_WAITED": "12345"},
+							{"WAIT_CLASS": "Commit", "TIME_WAITED": "250"},
+						}}}
+					}
+					return &fakeDbClient{Responses: [][]metricRow{queryResponses[s]}}
+				},
+				scrapeCfg:             scraperhelper.ControllerConfig{CollectionInterval: 10 * time.Second},
+				activeSessionSampling: ActiveSessionSamplingConfig{Source: test.source, TopSQLCount: 2},
+				metricsBuilderConfig:  cfg,
+			}
+			require.NoError(t, scrpr.start(context.Background(), componenttest.NewNopHost()))
+			defer func() {
+				assert.NoError(t, scrpr.shutdown(context.Background()))
+			}()
+			m, err := scrpr.scrape(context.Background())
+			require.NoError(t, err)
+
+			waitClasses := map[string]float64{}
+			statements := map[string]float64{}
+			waitTimes := map[string]float64{}
+			metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				switch metric.Name() {
+				case "oracledb.active_sessions":
+					collectDataPoints(metric.Gauge().DataPoints(), "wait_class", waitClasses)
+				case "oracledb.sql.active_sessions":
+					collectDataPoints(metric.Gauge().DataPoints(), "sql_id", statements)
+				case "oracledb.wait_class.time":
+					collectDataPoints(metric.Sum().DataPoints(), "wait_class", waitTimes)
+				}
+			}
+			assert.InDeltaMapValues(t, test.expectedWaitClass, waitClasses, 1e-9)
+			assert.InDeltaMapValues(t, test.expectedStatements, statements, 1e-9)
+			assert.Equal(t, map[string]float64{"User I/O": 123.45, "Commit": 2.5}, waitTimes)
+		})
+	}
+}
+
+func collectDataPoints(dps pmetric.NumberDataPointSlice, attribute string, values map[string]float64) {
+	for i := 0; i < dps.Len(); i++ {
+		key, _ := dps.At(i).Attributes().Get(attribute)
+		values[key.Str()] = dps.At(i).DoubleValue()
+	}
+}
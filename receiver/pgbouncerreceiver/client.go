@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	// register the postgres driver
+	_ "github.com/lib/pq"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+// adminDatabase is the virtual database of the PgBouncer admin console.
+const adminDatabase = "pgbouncer"
+
+type client interface {
+	Close() error
+	// getPools returns the rows of SHOW POOLS, one per database and user.
+	getPools(ctx context.Context) ([]poolStats, error)
+	// getStats returns the rows of SHOW STATS, one per database.
+	getStats(ctx context.Context) ([]databaseStats, error)
+	// getClients returns the rows of SHOW CLIENTS, one per client connection.
+	getClients(ctx context.Context) ([]clientStats, error)
+}
+
+type poolStats struct {
+	database       string
+	user           string
+	clientActive   int64
+	clientWaiting  int64
+	serverActive   int64
+	serverIdle     int64
+	serverUsed     int64
+	serverTested   int64
+	serverLogin    int64
+	maxWaitSeconds float64
+}
+
+type databaseStats struct {
+	database string
+	// The totals are cumulative since PgBouncer started, the times are in microseconds.
+	transactions int64
+	queries      int64
+	received     int64
+	sent         int64
+	waitTime     int64
+	avgXactTime  int64
+	avgQueryTime int64
+}
+
+type clientStats struct {
+	database        string
+	user            string
+	applicationName string
+}
+
+type pgBouncerClient struct {
+	db *sql.DB
+}
+
+var _ client = (*pgBouncerClient)(nil)
+
+func newPgBouncerClient(cfg *Config) (client, error) {
+	connStr, err := connectionString(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return nil, err
+	}
+	// The admin console only supports one query at a time per connection, and no transactions.
+	db.SetMaxOpenConns(1)
+	return &pgBouncerClient{db: db}, nil
+}
+
+func sslConnectionString(tls configtls.ClientConfig) string {
+	if tls.Insecure {
+		return "sslmode='disable'"
+	}
+
+	conn := ""
+
+	if tls.InsecureSkipVerify {
+		conn += "sslmode='require'"
+	} else {
+		conn += "sslmode='verify-full'"
+	}
+
+	if tls.CAFile != "" {
+		conn += fmt.Sprintf(" sslrootcert=%s", quote(tls.CAFile))
+	}
+
+	if tls.KeyFile != "" {
+		conn += fmt.Sprintf(" sslkey=%s", quote(tls.KeyFile))
+	}
+
+	if tls.CertFile != "" {
+		conn += fmt.Sprintf(" sslcert=%s", quote(tls.CertFile))
+	}
+
+	return conn
+}
+
+func connectionString(cfg *Config) (string, error) {
+	host, port, err := net.SplitHostPort(cfg.Endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if cfg.Transport == confignet.TransportTypeUnix {
+		// lib/pg expects a unix socket host to start with a "/" and appends the appropriate .s.PGSQL.port internally
+		host = fmt.Sprintf("/%s", host)
+	}
+
+	return fmt.Sprintf("port=%s host=%s user=%s password=%s dbname=%s %s",
+		port, quote(host), quote(cfg.Username), quote(string(cfg.Password)), adminDatabase, sslConnectionString(cfg.ClientConfig)), nil
+}
+
+// quote quotes a value of a libpq connection string.
+func quote(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+func (c *pgBouncerClient) Close() error {
+	return c.db.Close()
+}
+
+func (c *pgBouncerClient) getPools(ctx context.Context) ([]poolStats, error) {
+	rows, err := c.show(ctx, "POOLS")
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]poolStats, 0, len(rows))
+	for _, row := range rows {
+		pools = append(pools, poolStats{
+			database:      row["database"],
+			user:          row["user"],
+			clientActive:  parseInt(row["cl_active"]),
+			clientWaiting: parseInt(row["cl_waiting"]),
+			serverActive:  parseInt(row["sv_active"]),
+			serverIdle:    parseInt(row["sv_idle"]),
+			serverUsed:    parseInt(row["sv_used"]),
+			serverTested:  parseInt(row["sv_tested"]),
+			serverLogin:   parseInt(row["sv_login"]),
+			// maxwait_us is the microseconds part of the wait time, since PgBouncer 1.8.
+			maxWaitSeconds: float64(parseInt(row["maxwait"])) + float64(parseInt(row["maxwait_us"]))/1e6,
+		})
+	}
+	return pools, nil
+}
+
+func (c *pgBouncerClient) getStats(ctx context.Context) ([]databaseStats, error) {
+	rows, err := c.show(ctx, "STATS")
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]databaseStats, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, databaseStats{
+			database:     row["database"],
+			transactions: parseInt(row["total_xact_count"]),
+			queries:      parseInt(row["total_query_count"]),
+			received:     parseInt(row["total_received"]),
+			sent:         parseInt(row["total_sent"]),
+			waitTime:     parseInt(row["total_wait_time"]),
+			avgXactTime:  parseInt(row["avg_xact_time"]),
+			avgQueryTime: parseInt(row["avg_query_time"]),
+		})
+	}
+	return stats, nil
+}
+
+func (c *pgBouncerClient) getClients(ctx context.Context) ([]clientStats, error) {
+	rows, err := c.show(ctx, "CLIENTS")
+	if err != nil {
+		return nil, err
+	}
+	clients := make([]clientStats, 0, len(rows))
+	for _, row := range rows {
+		clients = append(clients, clientStats{
+			database:        row["database"],
+			user:            row["user"],
+			applicationName: row["application_name"],
+		})
+	}
+	return clients, nil
+}
+
+// show runs a SHOW command of the admin console and returns its rows by column name. The columns
+// differ between the versions of PgBouncer, the missing ones are read as empty strings.
+func (c *pgBouncerClient) show(ctx context.Context, command string) ([]map[string]string, error) {
+	// The admin console only supports the simple query protocol, used by lib/pq for queries without arguments.
+	rows, err := c.db.QueryContext(ctx, "SHOW "+command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run SHOW %s: %w", command, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var out []map[string]string
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to read SHOW %s: %w", command, err)
+		}
+		row := make(map[string]string, len(columns))
+		for i, column := range columns {
+			row[column] = values[i].String
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+func parseInt(value string) int64 {
+	i, _ := strconv.ParseInt(value, 10, 64)
+	return i
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
+)
+
+func TestConnectionString(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		modify   func(*Config)
+		expected string
+	}{
+		{
+			desc:     "default",
+			modify:   func(*Config) {},
+			expected: `port=6432 host='localhost' user='otel' password='p@ss w\'rd' dbname=pgbouncer sslmode='disable'`,
+		},
+		{
+			desc: "unix socket with TLS",
+			modify: func(cfg *Config) {
+				cfg.AddrConfig = confignet.AddrConfig{Endpoint: "var/run/postgresql:6432", Transport: confignet.TransportTypeUnix}
+				cfg.ClientConfig = configtls.ClientConfig{Config: configtls.Config{CAFile: "/etc/ca.crt"}}
+			},
+			expected: `port=6432 host='/var/run/postgresql' user='otel' password='p@ss w\'rd' dbname=pgbouncer sslmode='verify-full' sslrootcert='/etc/ca.crt'`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Username = "otel"
+			cfg.Password = `p@ss w'rd`
+			tc.modify(cfg)
+			connStr, err := connectionString(cfg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, connStr)
+		})
+	}
+}
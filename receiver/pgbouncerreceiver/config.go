@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver/internal/metadata"
+)
+
+// Errors for invalid config parameters.
+const (
+	ErrNoUsername          = "invalid config: missing username"
+	ErrNotSupported        = "invalid config: field '%s' not supported"
+	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
+	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+)
+
+// Config defines the configuration of the PgBouncer receiver.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	// Username is the user connecting to the admin console, it must be listed in admin_users or stats_users.
+	Username                      string                         `mapstructure:"username"`
+	Password                      configopaque.String            `mapstructure:"password"`
+	confignet.AddrConfig          `mapstructure:",squash"`       // provides Endpoint and Transport
+	configtls.ClientConfig        `mapstructure:"tls,omitempty"` // provides SSL details
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var err error
+	if cfg.Username == "" {
+		err = multierr.Append(err, errors.New(ErrNoUsername))
+	}
+
+	// The lib/pq module does not support overriding ServerName or specifying supported TLS versions
+	if cfg.ServerName != "" {
+		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "ServerName"))
+	}
+	if cfg.MaxVersion != "" {
+		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "MaxVersion"))
+	}
+	if cfg.MinVersion != "" {
+		err = multierr.Append(err, fmt.Errorf(ErrNotSupported, "MinVersion"))
+	}
+
+	switch cfg.Transport {
+	case confignet.TransportTypeTCP, confignet.TransportTypeUnix:
+		if _, _, endpointErr := net.SplitHostPort(cfg.Endpoint); endpointErr != nil {
+			err = multierr.Append(err, errors.New(ErrHostPort))
+		}
+	default:
+		err = multierr.Append(err, errors.New(ErrTransportsSupported))
+	}
+
+	return err
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver/internal/metadata"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		modify      func(*Config)
+		expectedErr error
+	}{
+		{
+			desc:   "valid config",
+			modify: func(*Config) {},
+		},
+		{
+			desc: "missing username",
+			modify: func(cfg *Config) {
+				cfg.Username = ""
+			},
+			expectedErr: errors.New(ErrNoUsername),
+		},
+		{
+			desc: "unsupported TLS fields",
+			modify: func(cfg *Config) {
+				cfg.ServerName = "pgbouncer"
+				cfg.MinVersion = "1.2"
+			},
+			expectedErr: multierr.Combine(
+				fmt.Errorf(ErrNotSupported, "ServerName"),
+				fmt.Errorf(ErrNotSupported, "MinVersion"),
+			),
+		},
+		{
+			desc: "unsupported transport",
+			modify: func(cfg *Config) {
+				cfg.Transport = "udp"
+			},
+			expectedErr: errors.New(ErrTransportsSupported),
+		},
+		{
+			desc: "invalid endpoint",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "localhost"
+			},
+			expectedErr: errors.New(ErrHostPort),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Username = "otel"
+			tc.modify(cfg)
+			err := component.ValidateConfig(cfg)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr.Error())
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("PGBOUNCER_PASSWORD", "env-secret")
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	t.Run("minimal", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "minimal").String())
+		require.NoError(t, err)
+		require.NoError(t, component.UnmarshalConfig(sub, cfg))
+		require.NoError(t, component.ValidateConfig(cfg))
+
+		expected := createDefaultConfig().(*Config)
+		expected.Username = "otel"
+		expected.Password = "${env:PGBOUNCER_PASSWORD}"
+		require.Equal(t, expected, cfg)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		cfg := createDefaultConfig().(*Config)
+		sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "all").String())
+		require.NoError(t, err)
+		require.NoError(t, component.UnmarshalConfig(sub, cfg))
+		require.NoError(t, component.ValidateConfig(cfg))
+
+		expected := createDefaultConfig().(*Config)
+		expected.AddrConfig = confignet.AddrConfig{
+			Endpoint:  "pgbouncer:6432",
+			Transport: confignet.TransportTypeTCP,
+		}
+		expected.Username = "otel"
+		expected.Password = "secret"
+		expected.CollectionInterval = 30 * time.Second
+		expected.ClientConfig = configtls.ClientConfig{
+			Config: configtls.Config{
+				CAFile: "/home/otel/authorities.crt",
+			},
+		}
+		require.True(t, cfg.Metrics.PgbouncerClientConnections.Enabled)
+		expected.MetricsBuilderConfig = cfg.MetricsBuilderConfig
+		require.Equal(t, expected, cfg)
+	})
+}
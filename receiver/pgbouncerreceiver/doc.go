@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package pgbouncerreceiver scrapes the PgBouncer admin console.
+package pgbouncerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver"
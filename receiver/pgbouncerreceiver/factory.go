@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver/internal/metadata"
+)
+
+// NewFactory creates a factory for the PgBouncer receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+}
+
+func createDefaultConfig() component.Config {
+	cfg := scraperhelper.NewDefaultControllerConfig()
+	cfg.CollectionInterval = 10 * time.Second
+
+	return &Config{
+		ControllerConfig: cfg,
+		AddrConfig: confignet.AddrConfig{
+			Endpoint:  "localhost:6432",
+			Transport: confignet.TransportTypeTCP,
+		},
+		// TLS is disabled by default on the client side of PgBouncer.
+		ClientConfig: configtls.ClientConfig{
+			Insecure: true,
+		},
+		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+	}
+}
+
+func createMetricsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	rConf component.Config,
+	consumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	cfg := rConf.(*Config)
+
+	ps := newPgBouncerScraper(params, cfg)
+	scraper, err := scraperhelper.NewScraper(metadata.Type.String(), ps.scrape,
+		scraperhelper.WithStart(ps.start),
+		scraperhelper.WithShutdown(ps.shutdown))
+	if err != nil {
+		return nil, err
+	}
+
+	return scraperhelper.NewScraperControllerReceiver(
+		&cfg.ControllerConfig, params, consumer,
+		scraperhelper.AddScraper(scraper),
+	)
+}
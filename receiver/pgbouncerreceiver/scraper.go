@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/pgbouncerreceiver/internal/metadata"
+)
+
+// microsecondsPerSecond converts the times reported by SHOW STATS to seconds.
+const microsecondsPerSecond = 1e6
+
+type pgBouncerScraper struct {
+	logger    *zap.Logger
+	config    *Config
+	newClient func(*Config) (client, error)
+	client    client
+	mb        *metadata.MetricsBuilder
+}
+
+func newPgBouncerScraper(settings receiver.CreateSettings, config *Config) *pgBouncerScraper {
+	return &pgBouncerScraper{
+		logger:    settings.Logger,
+		config:    config,
+		newClient: newPgBouncerClient,
+		mb:        metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
+	}
+}
+
+func (p *pgBouncerScraper) start(context.Context, component.Host) error {
+	c, err := p.newClient(p.config)
+	if err != nil {
+		return fmt.Errorf("failed to create the PgBouncer client: %w", err)
+	}
+	p.client = c
+	return nil
+}
+
+func (p *pgBouncerScraper) shutdown(context.Context) error {
+	if p.client != nil {
+		return p.client.Close()
+	}
+	return nil
+}
+
+// databaseMetrics holds the rows of the SHOW commands of a database.
+type databaseMetrics struct {
+	pools   []poolStats
+	stats   *databaseStats
+	clients map[clientStats]int64
+}
+
+// scrape runs the SHOW commands of the admin console and emits their metrics per database.
+func (p *pgBouncerScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	var errs scrapererror.ScrapeErrors
+	databases := map[string]*databaseMetrics{}
+	database := func(name string) *databaseMetrics {
+		if _, ok := databases[name]; !ok {
+			databases[name] = &databaseMetrics{clients: map[clientStats]int64{}}
+		}
+		return databases[name]
+	}
+
+	pools, err := p.client.getPools(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+	}
+	for _, pool := range pools {
+		db := database(pool.database)
+		db.pools = append(db.pools, pool)
+	}
+
+	stats, err := p.client.getStats(ctx)
+	if err != nil {
+		errs.AddPartial(1, err)
+	}
+	for i := range stats {
+		database(stats[i].database).stats = &stats[i]
+	}
+
+	if p.config.MetricsBuilderConfig.Metrics.PgbouncerClientConnections.Enabled {
+		clients, err := p.client.getClients(ctx)
+		if err != nil {
+			errs.AddPartial(1, err)
+		}
+		for _, client := range clients {
+			database(client.database).clients[client]++
+		}
+	}
+
+	names := make([]string, 0, len(databases))
+	for name := range databases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for _, name := range names {
+		p.recordDatabase(now, databases[name])
+		rb := p.mb.NewResourceBuilder()
+		rb.SetPgbouncerDatabaseName(name)
+		p.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+	return p.mb.Emit(), errs.Combine()
+}
+
+func (p *pgBouncerScraper) recordDatabase(now pcommon.Timestamp, db *databaseMetrics) {
+	for _, pool := range db.pools {
+		p.mb.RecordPgbouncerPoolClientConnectionsDataPoint(now, pool.clientActive, pool.user, metadata.AttributeClientStateActive)
+		p.mb.RecordPgbouncerPoolClientConnectionsDataPoint(now, pool.clientWaiting, pool.user, metadata.AttributeClientStateWaiting)
+		p.mb.RecordPgbouncerPoolServerConnectionsDataPoint(now, pool.serverActive, pool.user, metadata.AttributeServerStateActive)
+		p.mb.RecordPgbouncerPoolServerConnectionsDataPoint(now, pool.serverIdle, pool.user, metadata.AttributeServerStateIdle)
+		p.mb.RecordPgbouncerPoolServerConnectionsDataPoint(now, pool.serverUsed, pool.user, metadata.AttributeServerStateUsed)
+		p.mb.RecordPgbouncerPoolServerConnectionsDataPoint(now, pool.serverTested, pool.user, metadata.AttributeServerStateTested)
+		p.mb.RecordPgbouncerPoolServerConnectionsDataPoint(now, pool.serverLogin, pool.user, metadata.AttributeServerStateLogin)
+		p.mb.RecordPgbouncerPoolMaxWaitDataPoint(now, pool.maxWaitSeconds, pool.user)
+	}
+
+	if stats := db.stats; stats != nil {
+		p.mb.RecordPgbouncerTransactionsDataPoint(now, stats.transactions)
+		p.mb.RecordPgbouncerQueriesDataPoint(now, stats.queries)
+		p.mb.RecordPgbouncerNetworkIoDataPoint(now, stats.received, metadata.AttributeDirectionReceived)
+		p.mb.RecordPgbouncerNetworkIoDataPoint(now, stats.sent, metadata.AttributeDirectionSent)
+		p.mb.RecordPgbouncerClientWaitTimeDataPoint(now, float64(stats.waitTime)/microsecondsPerSecond)
+		p.mb.RecordPgbouncerTransactionDurationAverageDataPoint(now, float64(stats.avgXactTime)/microsecondsPerSecond)
+		p.mb.RecordPgbouncerQueryDurationAverageDataPoint(now, float64(stats.avgQueryTime)/microsecondsPerSecond)
+	}
+
+	for client, count := range db.clients {
+		p.mb.RecordPgbouncerClientConnectionsDataPoint(now, count, client.user, client.applicationName)
+	}
+}
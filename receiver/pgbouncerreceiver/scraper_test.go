@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pgbouncerreceiver
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+type fakeClient struct {
+	poolsErr error
+}
+
+func (fakeClient) Close() error {
+	return nil
+}
+
+func (c fakeClient) getPools(context.Context) ([]poolStats, error) {
+	if c.poolsErr != nil {
+		return nil, c.poolsErr
+	}
+	return []poolStats{
+		{database: "app", user: "app_rw", clientActive: 12, clientWaiting: 3, serverActive: 10, serverIdle: 2, serverUsed: 1, maxWaitSeconds: 1.25},
+		{database: "app", user: "app_ro", clientActive: 4, serverActive: 4, serverIdle: 6},
+		{database: "pgbouncer", user: "pgbouncer", clientActive: 1},
+	}, nil
+}
+
+func (fakeClient) getStats(context.Context) ([]databaseStats, error) {
+	return []databaseStats{
+		{database: "app", transactions: 1200, queries: 4800, received: 1048576, sent: 8388608, waitTime: 3500000, avgXactTime: 12500, avgQueryTime: 2500},
+		{database: "pgbouncer", transactions: 10, queries: 10},
+	}, nil
+}
+
+func (fakeClient) getClients(context.Context) ([]clientStats, error) {
+	return []clientStats{
+		{database: "app", user: "app_rw", applicationName: "checkout"},
+		{database: "app", user: "app_rw", applicationName: "checkout"},
+		{database: "app", user: "app_ro", applicationName: "reporting"},
+	}, nil
+}
+
+func newTestScraper(t *testing.T, c client, enableClients bool) *pgBouncerScraper {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.PgbouncerClientConnections.Enabled = enableClients
+	scraper := newPgBouncerScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.newClient = func(*Config) (client, error) {
+		return c, nil
+	}
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		require.NoError(t, scraper.shutdown(context.Background()))
+	})
+	return scraper
+}
+
+func TestScrape(t *testing.T) {
+	scraper := newTestScraper(t, fakeClient{}, true)
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	expectedMetrics, err := golden.ReadMetrics(filepath.Join("testdata", "scraper", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreTimestamp()))
+}
+
+func TestScrapePartialError(t *testing.T) {
+	scraper := newTestScraper(t, fakeClient{poolsErr: errors.New("connection refused")}, false)
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.ErrorContains(t, err, "connection refused")
+
+	// The stats are still reported.
+	require.Equal(t, 2, actualMetrics.ResourceMetrics().Len())
+	require.Equal(t, 6, actualMetrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().Len())
+}
+
+func TestStartError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	scraper := newPgBouncerScraper(receivertest.NewNopCreateSettings(), cfg)
+	scraper.newClient = func(*Config) (client, error) {
+		return nil, errors.New("invalid endpoint")
+	}
+	require.ErrorContains(t, scraper.start(context.Background(), componenttest.NewNopHost()), "invalid endpoint")
+	require.NoError(t, scraper.shutdown(context.Background()))
+}
@@ -34,11 +34,21 @@ type MetricsConfig struct {
 	ContainerCPUUsagePercpu                      MetricConfig `mapstructure:"container.cpu.usage.percpu"`
 	ContainerCPUUsageSystem                      MetricConfig `mapstructure:"container.cpu.usage.system"`
 	ContainerCPUUsageTotal                       MetricConfig `mapstructure:"container.cpu.usage.total"`
+	ContainerHealthFailingStreak                 MetricConfig `mapstructure:"container.health.failing_streak"`
+	ContainerHealthStatus                        MetricConfig `mapstructure:"container.health.status"`
 	ContainerMemoryPercent                       MetricConfig `mapstructure:"container.memory.percent"`
 	ContainerMemoryUsageLimit                    MetricConfig `mapstructure:"container.memory.usage.limit"`
 	ContainerMemoryUsageTotal                    MetricConfig `mapstructure:"container.memory.usage.total"`
 	ContainerNetworkIoUsageRxBytes               MetricConfig `mapstructure:"container.network.io.usage.rx_bytes"`
 	ContainerNetworkIoUsageTxBytes               MetricConfig `mapstructure:"container.network.io.usage.tx_bytes"`
+	PodmanPodBlockioIoServiceBytesRecursiveRead  MetricConfig `mapstructure:"podman.pod.blockio.io_service_bytes_recursive.read"`
+	PodmanPodBlockioIoServiceBytesRecursiveWrite MetricConfig `mapstructure:"podman.pod.blockio.io_service_bytes_recursive.write"`
+	PodmanPodContainers                          MetricConfig `mapstructure:"podman.pod.containers"`
+	PodmanPodCPUPercent                          MetricConfig `mapstructure:"podman.pod.cpu.percent"`
+	PodmanPodCPUUsageTotal                       MetricConfig `mapstructure:"podman.pod.cpu.usage.total"`
+	PodmanPodMemoryUsageTotal                    MetricConfig `mapstructure:"podman.pod.memory.usage.total"`
+	PodmanPodNetworkIoUsageRxBytes               MetricConfig `mapstructure:"podman.pod.network.io.usage.rx_bytes"`
+	PodmanPodNetworkIoUsageTxBytes               MetricConfig `mapstructure:"podman.pod.network.io.usage.tx_bytes"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -61,6 +71,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerCPUUsageTotal: MetricConfig{
 			Enabled: true,
 		},
+		ContainerHealthFailingStreak: MetricConfig{
+			Enabled: false,
+		},
+		ContainerHealthStatus: MetricConfig{
+			Enabled: false,
+		},
 		ContainerMemoryPercent: MetricConfig{
 			Enabled: true,
 		},
@@ -76,6 +92,30 @@ func DefaultMetricsConfig() MetricsConfig {
 		ContainerNetworkIoUsageTxBytes: MetricConfig{
 			Enabled: true,
 		},
+		PodmanPodBlockioIoServiceBytesRecursiveRead: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodBlockioIoServiceBytesRecursiveWrite: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodContainers: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodCPUPercent: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodCPUUsageTotal: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodMemoryUsageTotal: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodNetworkIoUsageRxBytes: MetricConfig{
+			Enabled: false,
+		},
+		PodmanPodNetworkIoUsageTxBytes: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
@@ -111,6 +151,8 @@ type ResourceAttributesConfig struct {
 	ContainerImageName ResourceAttributeConfig `mapstructure:"container.image.name"`
 	ContainerName      ResourceAttributeConfig `mapstructure:"container.name"`
 	ContainerRuntime   ResourceAttributeConfig `mapstructure:"container.runtime"`
+	PodmanPodID        ResourceAttributeConfig `mapstructure:"podman.pod.id"`
+	PodmanPodName      ResourceAttributeConfig `mapstructure:"podman.pod.name"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
@@ -127,6 +169,12 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		ContainerRuntime: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		PodmanPodID: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		PodmanPodName: ResourceAttributeConfig{
+			Enabled: true,
+		},
 	}
 }
 
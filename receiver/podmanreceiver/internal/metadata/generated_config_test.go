@@ -31,17 +31,29 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerCPUUsagePercpu:                      MetricConfig{Enabled: true},
 					ContainerCPUUsageSystem:                      MetricConfig{Enabled: true},
 					ContainerCPUUsageTotal:                       MetricConfig{Enabled: true},
+					ContainerHealthFailingStreak:                 MetricConfig{Enabled: true},
+					ContainerHealthStatus:                        MetricConfig{Enabled: true},
 					ContainerMemoryPercent:                       MetricConfig{Enabled: true},
 					ContainerMemoryUsageLimit:                    MetricConfig{Enabled: true},
 					ContainerMemoryUsageTotal:                    MetricConfig{Enabled: true},
 					ContainerNetworkIoUsageRxBytes:               MetricConfig{Enabled: true},
 					ContainerNetworkIoUsageTxBytes:               MetricConfig{Enabled: true},
+					PodmanPodBlockioIoServiceBytesRecursiveRead:  MetricConfig{Enabled: true},
+					PodmanPodBlockioIoServiceBytesRecursiveWrite: MetricConfig{Enabled: true},
+					PodmanPodContainers:                          MetricConfig{Enabled: true},
+					PodmanPodCPUPercent:                          MetricConfig{Enabled: true},
+					PodmanPodCPUUsageTotal:                       MetricConfig{Enabled: true},
+					PodmanPodMemoryUsageTotal:                    MetricConfig{Enabled: true},
+					PodmanPodNetworkIoUsageRxBytes:               MetricConfig{Enabled: true},
+					PodmanPodNetworkIoUsageTxBytes:               MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:        ResourceAttributeConfig{Enabled: true},
 					ContainerImageName: ResourceAttributeConfig{Enabled: true},
 					ContainerName:      ResourceAttributeConfig{Enabled: true},
 					ContainerRuntime:   ResourceAttributeConfig{Enabled: true},
+					PodmanPodID:        ResourceAttributeConfig{Enabled: true},
+					PodmanPodName:      ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -55,17 +67,29 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					ContainerCPUUsagePercpu:                      MetricConfig{Enabled: false},
 					ContainerCPUUsageSystem:                      MetricConfig{Enabled: false},
 					ContainerCPUUsageTotal:                       MetricConfig{Enabled: false},
+					ContainerHealthFailingStreak:                 MetricConfig{Enabled: false},
+					ContainerHealthStatus:                        MetricConfig{Enabled: false},
 					ContainerMemoryPercent:                       MetricConfig{Enabled: false},
 					ContainerMemoryUsageLimit:                    MetricConfig{Enabled: false},
 					ContainerMemoryUsageTotal:                    MetricConfig{Enabled: false},
 					ContainerNetworkIoUsageRxBytes:               MetricConfig{Enabled: false},
 					ContainerNetworkIoUsageTxBytes:               MetricConfig{Enabled: false},
+					PodmanPodBlockioIoServiceBytesRecursiveRead:  MetricConfig{Enabled: false},
+					PodmanPodBlockioIoServiceBytesRecursiveWrite: MetricConfig{Enabled: false},
+					PodmanPodContainers:                          MetricConfig{Enabled: false},
+					PodmanPodCPUPercent:                          MetricConfig{Enabled: false},
+					PodmanPodCPUUsageTotal:                       MetricConfig{Enabled: false},
+					PodmanPodMemoryUsageTotal:                    MetricConfig{Enabled: false},
+					PodmanPodNetworkIoUsageRxBytes:               MetricConfig{Enabled: false},
+					PodmanPodNetworkIoUsageTxBytes:               MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:        ResourceAttributeConfig{Enabled: false},
 					ContainerImageName: ResourceAttributeConfig{Enabled: false},
 					ContainerName:      ResourceAttributeConfig{Enabled: false},
 					ContainerRuntime:   ResourceAttributeConfig{Enabled: false},
+					PodmanPodID:        ResourceAttributeConfig{Enabled: false},
+					PodmanPodName:      ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -106,6 +130,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				ContainerImageName: ResourceAttributeConfig{Enabled: true},
 				ContainerName:      ResourceAttributeConfig{Enabled: true},
 				ContainerRuntime:   ResourceAttributeConfig{Enabled: true},
+				PodmanPodID:        ResourceAttributeConfig{Enabled: true},
+				PodmanPodName:      ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
@@ -115,6 +141,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				ContainerImageName: ResourceAttributeConfig{Enabled: false},
 				ContainerName:      ResourceAttributeConfig{Enabled: false},
 				ContainerRuntime:   ResourceAttributeConfig{Enabled: false},
+				PodmanPodID:        ResourceAttributeConfig{Enabled: false},
+				PodmanPodName:      ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
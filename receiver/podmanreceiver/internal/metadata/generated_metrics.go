@@ -12,6 +12,36 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeHealthStatus specifies the a value health_status attribute.
+type AttributeHealthStatus int
+
+const (
+	_ AttributeHealthStatus = iota
+	AttributeHealthStatusHealthy
+	AttributeHealthStatusUnhealthy
+	AttributeHealthStatusStarting
+)
+
+// String returns the string representation of the AttributeHealthStatus.
+func (av AttributeHealthStatus) String() string {
+	switch av {
+	case AttributeHealthStatusHealthy:
+		return "healthy"
+	case AttributeHealthStatusUnhealthy:
+		return "unhealthy"
+	case AttributeHealthStatusStarting:
+		return "starting"
+	}
+	return ""
+}
+
+// MapAttributeHealthStatus is a helper map of string to AttributeHealthStatus attribute value.
+var MapAttributeHealthStatus = map[string]AttributeHealthStatus{
+	"healthy":   AttributeHealthStatusHealthy,
+	"unhealthy": AttributeHealthStatusUnhealthy,
+	"starting":  AttributeHealthStatusStarting,
+}
+
 type metricContainerBlockioIoServiceBytesRecursiveRead struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -318,6 +348,106 @@ func newMetricContainerCPUUsageTotal(cfg MetricConfig) metricContainerCPUUsageTo
 	return m
 }
 
+type metricContainerHealthFailingStreak struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.health.failing_streak metric with initial data.
+func (m *metricContainerHealthFailingStreak) init() {
+	m.data.SetName("container.health.failing_streak")
+	m.data.SetDescription("Number of consecutive failed healthchecks of the container, only for containers with a healthcheck.")
+	m.data.SetUnit("{checks}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricContainerHealthFailingStreak) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerHealthFailingStreak) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerHealthFailingStreak) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerHealthFailingStreak(cfg MetricConfig) metricContainerHealthFailingStreak {
+	m := metricContainerHealthFailingStreak{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricContainerHealthStatus struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills container.health.status metric with initial data.
+func (m *metricContainerHealthStatus) init() {
+	m.data.SetName("container.health.status")
+	m.data.SetDescription("Whether the health status of the container is the one of the attribute, only for containers with a healthcheck.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricContainerHealthStatus) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, healthStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("health_status", healthStatusAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricContainerHealthStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricContainerHealthStatus) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricContainerHealthStatus(cfg MetricConfig) metricContainerHealthStatus {
+	m := metricContainerHealthStatus{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricContainerMemoryPercent struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -571,6 +701,410 @@ func newMetricContainerNetworkIoUsageTxBytes(cfg MetricConfig) metricContainerNe
 	return m
 }
 
+type metricPodmanPodBlockioIoServiceBytesRecursiveRead struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.blockio.io_service_bytes_recursive.read metric with initial data.
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveRead) init() {
+	m.data.SetName("podman.pod.blockio.io_service_bytes_recursive.read")
+	m.data.SetDescription("Number of bytes transferred from the disk by the containers of the pod")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveRead) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveRead) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveRead) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodBlockioIoServiceBytesRecursiveRead(cfg MetricConfig) metricPodmanPodBlockioIoServiceBytesRecursiveRead {
+	m := metricPodmanPodBlockioIoServiceBytesRecursiveRead{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodBlockioIoServiceBytesRecursiveWrite struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.blockio.io_service_bytes_recursive.write metric with initial data.
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveWrite) init() {
+	m.data.SetName("podman.pod.blockio.io_service_bytes_recursive.write")
+	m.data.SetDescription("Number of bytes transferred to the disk by the containers of the pod")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveWrite) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveWrite) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodBlockioIoServiceBytesRecursiveWrite) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodBlockioIoServiceBytesRecursiveWrite(cfg MetricConfig) metricPodmanPodBlockioIoServiceBytesRecursiveWrite {
+	m := metricPodmanPodBlockioIoServiceBytesRecursiveWrite{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodContainers struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.containers metric with initial data.
+func (m *metricPodmanPodContainers) init() {
+	m.data.SetName("podman.pod.containers")
+	m.data.SetDescription("Number of running containers in the pod.")
+	m.data.SetUnit("{containers}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricPodmanPodContainers) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodContainers) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodContainers) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodContainers(cfg MetricConfig) metricPodmanPodContainers {
+	m := metricPodmanPodContainers{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodCPUPercent struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.cpu.percent metric with initial data.
+func (m *metricPodmanPodCPUPercent) init() {
+	m.data.SetName("podman.pod.cpu.percent")
+	m.data.SetDescription("Percent of CPU used by the containers of the pod.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricPodmanPodCPUPercent) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodCPUPercent) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodCPUPercent) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodCPUPercent(cfg MetricConfig) metricPodmanPodCPUPercent {
+	m := metricPodmanPodCPUPercent{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodCPUUsageTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.cpu.usage.total metric with initial data.
+func (m *metricPodmanPodCPUUsageTotal) init() {
+	m.data.SetName("podman.pod.cpu.usage.total")
+	m.data.SetDescription("Total CPU time consumed by the containers of the pod.")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPodmanPodCPUUsageTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodCPUUsageTotal) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodCPUUsageTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodCPUUsageTotal(cfg MetricConfig) metricPodmanPodCPUUsageTotal {
+	m := metricPodmanPodCPUUsageTotal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodMemoryUsageTotal struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.memory.usage.total metric with initial data.
+func (m *metricPodmanPodMemoryUsageTotal) init() {
+	m.data.SetName("podman.pod.memory.usage.total")
+	m.data.SetDescription("Memory usage of the containers of the pod.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPodmanPodMemoryUsageTotal) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodMemoryUsageTotal) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodMemoryUsageTotal) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodMemoryUsageTotal(cfg MetricConfig) metricPodmanPodMemoryUsageTotal {
+	m := metricPodmanPodMemoryUsageTotal{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodNetworkIoUsageRxBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.network.io.usage.rx_bytes metric with initial data.
+func (m *metricPodmanPodNetworkIoUsageRxBytes) init() {
+	m.data.SetName("podman.pod.network.io.usage.rx_bytes")
+	m.data.SetDescription("Bytes received by the pod.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPodmanPodNetworkIoUsageRxBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodNetworkIoUsageRxBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodNetworkIoUsageRxBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodNetworkIoUsageRxBytes(cfg MetricConfig) metricPodmanPodNetworkIoUsageRxBytes {
+	m := metricPodmanPodNetworkIoUsageRxBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricPodmanPodNetworkIoUsageTxBytes struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills podman.pod.network.io.usage.tx_bytes metric with initial data.
+func (m *metricPodmanPodNetworkIoUsageTxBytes) init() {
+	m.data.SetName("podman.pod.network.io.usage.tx_bytes")
+	m.data.SetDescription("Bytes sent by the pod.")
+	m.data.SetUnit("By")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricPodmanPodNetworkIoUsageTxBytes) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricPodmanPodNetworkIoUsageTxBytes) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricPodmanPodNetworkIoUsageTxBytes) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricPodmanPodNetworkIoUsageTxBytes(cfg MetricConfig) metricPodmanPodNetworkIoUsageTxBytes {
+	m := metricPodmanPodNetworkIoUsageTxBytes{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -587,11 +1121,21 @@ type MetricsBuilder struct {
 	metricContainerCPUUsagePercpu                      metricContainerCPUUsagePercpu
 	metricContainerCPUUsageSystem                      metricContainerCPUUsageSystem
 	metricContainerCPUUsageTotal                       metricContainerCPUUsageTotal
+	metricContainerHealthFailingStreak                 metricContainerHealthFailingStreak
+	metricContainerHealthStatus                        metricContainerHealthStatus
 	metricContainerMemoryPercent                       metricContainerMemoryPercent
 	metricContainerMemoryUsageLimit                    metricContainerMemoryUsageLimit
 	metricContainerMemoryUsageTotal                    metricContainerMemoryUsageTotal
 	metricContainerNetworkIoUsageRxBytes               metricContainerNetworkIoUsageRxBytes
 	metricContainerNetworkIoUsageTxBytes               metricContainerNetworkIoUsageTxBytes
+	metricPodmanPodBlockioIoServiceBytesRecursiveRead  metricPodmanPodBlockioIoServiceBytesRecursiveRead
+	metricPodmanPodBlockioIoServiceBytesRecursiveWrite metricPodmanPodBlockioIoServiceBytesRecursiveWrite
+	metricPodmanPodContainers                          metricPodmanPodContainers
+	metricPodmanPodCPUPercent                          metricPodmanPodCPUPercent
+	metricPodmanPodCPUUsageTotal                       metricPodmanPodCPUUsageTotal
+	metricPodmanPodMemoryUsageTotal                    metricPodmanPodMemoryUsageTotal
+	metricPodmanPodNetworkIoUsageRxBytes               metricPodmanPodNetworkIoUsageRxBytes
+	metricPodmanPodNetworkIoUsageTxBytes               metricPodmanPodNetworkIoUsageTxBytes
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -616,11 +1160,21 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricContainerCPUUsagePercpu:                      newMetricContainerCPUUsagePercpu(mbc.Metrics.ContainerCPUUsagePercpu),
 		metricContainerCPUUsageSystem:                      newMetricContainerCPUUsageSystem(mbc.Metrics.ContainerCPUUsageSystem),
 		metricContainerCPUUsageTotal:                       newMetricContainerCPUUsageTotal(mbc.Metrics.ContainerCPUUsageTotal),
+		metricContainerHealthFailingStreak:                 newMetricContainerHealthFailingStreak(mbc.Metrics.ContainerHealthFailingStreak),
+		metricContainerHealthStatus:                        newMetricContainerHealthStatus(mbc.Metrics.ContainerHealthStatus),
 		metricContainerMemoryPercent:                       newMetricContainerMemoryPercent(mbc.Metrics.ContainerMemoryPercent),
 		metricContainerMemoryUsageLimit:                    newMetricContainerMemoryUsageLimit(mbc.Metrics.ContainerMemoryUsageLimit),
 		metricContainerMemoryUsageTotal:                    newMetricContainerMemoryUsageTotal(mbc.Metrics.ContainerMemoryUsageTotal),
 		metricContainerNetworkIoUsageRxBytes:               newMetricContainerNetworkIoUsageRxBytes(mbc.Metrics.ContainerNetworkIoUsageRxBytes),
 		metricContainerNetworkIoUsageTxBytes:               newMetricContainerNetworkIoUsageTxBytes(mbc.Metrics.ContainerNetworkIoUsageTxBytes),
+		metricPodmanPodBlockioIoServiceBytesRecursiveRead:  newMetricPodmanPodBlockioIoServiceBytesRecursiveRead(mbc.Metrics.PodmanPodBlockioIoServiceBytesRecursiveRead),
+		metricPodmanPodBlockioIoServiceBytesRecursiveWrite: newMetricPodmanPodBlockioIoServiceBytesRecursiveWrite(mbc.Metrics.PodmanPodBlockioIoServiceBytesRecursiveWrite),
+		metricPodmanPodContainers:                          newMetricPodmanPodContainers(mbc.Metrics.PodmanPodContainers),
+		metricPodmanPodCPUPercent:                          newMetricPodmanPodCPUPercent(mbc.Metrics.PodmanPodCPUPercent),
+		metricPodmanPodCPUUsageTotal:                       newMetricPodmanPodCPUUsageTotal(mbc.Metrics.PodmanPodCPUUsageTotal),
+		metricPodmanPodMemoryUsageTotal:                    newMetricPodmanPodMemoryUsageTotal(mbc.Metrics.PodmanPodMemoryUsageTotal),
+		metricPodmanPodNetworkIoUsageRxBytes:               newMetricPodmanPodNetworkIoUsageRxBytes(mbc.Metrics.PodmanPodNetworkIoUsageRxBytes),
+		metricPodmanPodNetworkIoUsageTxBytes:               newMetricPodmanPodNetworkIoUsageTxBytes(mbc.Metrics.PodmanPodNetworkIoUsageTxBytes),
 		resourceAttributeIncludeFilter:                     make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:                     make(map[string]filter.Filter),
 	}
@@ -648,6 +1202,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 	if mbc.ResourceAttributes.ContainerRuntime.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["container.runtime"] = filter.CreateFilter(mbc.ResourceAttributes.ContainerRuntime.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.PodmanPodID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["podman.pod.id"] = filter.CreateFilter(mbc.ResourceAttributes.PodmanPodID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.PodmanPodID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["podman.pod.id"] = filter.CreateFilter(mbc.ResourceAttributes.PodmanPodID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.PodmanPodName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["podman.pod.name"] = filter.CreateFilter(mbc.ResourceAttributes.PodmanPodName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.PodmanPodName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["podman.pod.name"] = filter.CreateFilter(mbc.ResourceAttributes.PodmanPodName.MetricsExclude)
+	}
 
 	for _, op := range options {
 		op(mb)
@@ -715,11 +1281,21 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricContainerCPUUsagePercpu.emit(ils.Metrics())
 	mb.metricContainerCPUUsageSystem.emit(ils.Metrics())
 	mb.metricContainerCPUUsageTotal.emit(ils.Metrics())
+	mb.metricContainerHealthFailingStreak.emit(ils.Metrics())
+	mb.metricContainerHealthStatus.emit(ils.Metrics())
 	mb.metricContainerMemoryPercent.emit(ils.Metrics())
 	mb.metricContainerMemoryUsageLimit.emit(ils.Metrics())
 	mb.metricContainerMemoryUsageTotal.emit(ils.Metrics())
 	mb.metricContainerNetworkIoUsageRxBytes.emit(ils.Metrics())
 	mb.metricContainerNetworkIoUsageTxBytes.emit(ils.Metrics())
+	mb.metricPodmanPodBlockioIoServiceBytesRecursiveRead.emit(ils.Metrics())
+	mb.metricPodmanPodBlockioIoServiceBytesRecursiveWrite.emit(ils.Metrics())
+	mb.metricPodmanPodContainers.emit(ils.Metrics())
+	mb.metricPodmanPodCPUPercent.emit(ils.Metrics())
+	mb.metricPodmanPodCPUUsageTotal.emit(ils.Metrics())
+	mb.metricPodmanPodMemoryUsageTotal.emit(ils.Metrics())
+	mb.metricPodmanPodNetworkIoUsageRxBytes.emit(ils.Metrics())
+	mb.metricPodmanPodNetworkIoUsageTxBytes.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -781,6 +1357,16 @@ func (mb *MetricsBuilder) RecordContainerCPUUsageTotalDataPoint(ts pcommon.Times
 	mb.metricContainerCPUUsageTotal.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordContainerHealthFailingStreakDataPoint adds a data point to container.health.failing_streak metric.
+func (mb *MetricsBuilder) RecordContainerHealthFailingStreakDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricContainerHealthFailingStreak.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordContainerHealthStatusDataPoint adds a data point to container.health.status metric.
+func (mb *MetricsBuilder) RecordContainerHealthStatusDataPoint(ts pcommon.Timestamp, val int64, healthStatusAttributeValue AttributeHealthStatus) {
+	mb.metricContainerHealthStatus.recordDataPoint(mb.startTime, ts, val, healthStatusAttributeValue.String())
+}
+
 // RecordContainerMemoryPercentDataPoint adds a data point to container.memory.percent metric.
 func (mb *MetricsBuilder) RecordContainerMemoryPercentDataPoint(ts pcommon.Timestamp, val float64) {
 	mb.metricContainerMemoryPercent.recordDataPoint(mb.startTime, ts, val)
@@ -806,6 +1392,46 @@ func (mb *MetricsBuilder) RecordContainerNetworkIoUsageTxBytesDataPoint(ts pcomm
 	mb.metricContainerNetworkIoUsageTxBytes.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordPodmanPodBlockioIoServiceBytesRecursiveReadDataPoint adds a data point to podman.pod.blockio.io_service_bytes_recursive.read metric.
+func (mb *MetricsBuilder) RecordPodmanPodBlockioIoServiceBytesRecursiveReadDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodBlockioIoServiceBytesRecursiveRead.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodBlockioIoServiceBytesRecursiveWriteDataPoint adds a data point to podman.pod.blockio.io_service_bytes_recursive.write metric.
+func (mb *MetricsBuilder) RecordPodmanPodBlockioIoServiceBytesRecursiveWriteDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodBlockioIoServiceBytesRecursiveWrite.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodContainersDataPoint adds a data point to podman.pod.containers metric.
+func (mb *MetricsBuilder) RecordPodmanPodContainersDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodContainers.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodCPUPercentDataPoint adds a data point to podman.pod.cpu.percent metric.
+func (mb *MetricsBuilder) RecordPodmanPodCPUPercentDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricPodmanPodCPUPercent.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodCPUUsageTotalDataPoint adds a data point to podman.pod.cpu.usage.total metric.
+func (mb *MetricsBuilder) RecordPodmanPodCPUUsageTotalDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodCPUUsageTotal.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodMemoryUsageTotalDataPoint adds a data point to podman.pod.memory.usage.total metric.
+func (mb *MetricsBuilder) RecordPodmanPodMemoryUsageTotalDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodMemoryUsageTotal.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodNetworkIoUsageRxBytesDataPoint adds a data point to podman.pod.network.io.usage.rx_bytes metric.
+func (mb *MetricsBuilder) RecordPodmanPodNetworkIoUsageRxBytesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodNetworkIoUsageRxBytes.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordPodmanPodNetworkIoUsageTxBytesDataPoint adds a data point to podman.pod.network.io.usage.tx_bytes metric.
+func (mb *MetricsBuilder) RecordPodmanPodNetworkIoUsageTxBytesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricPodmanPodNetworkIoUsageTxBytes.recordDataPoint(mb.startTime, ts, val)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -92,6 +92,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordContainerCPUUsageTotalDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordContainerHealthFailingStreakDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordContainerHealthStatusDataPoint(ts, 1, AttributeHealthStatusHealthy)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordContainerMemoryPercentDataPoint(ts, 1)
@@ -112,11 +118,37 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordContainerNetworkIoUsageTxBytesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordPodmanPodBlockioIoServiceBytesRecursiveReadDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodBlockioIoServiceBytesRecursiveWriteDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodContainersDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodCPUPercentDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodCPUUsageTotalDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodMemoryUsageTotalDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodNetworkIoUsageRxBytesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordPodmanPodNetworkIoUsageTxBytesDataPoint(ts, 1)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetContainerID("container.id-val")
 			rb.SetContainerImageName("container.image.name-val")
 			rb.SetContainerName("container.name-val")
 			rb.SetContainerRuntime("container.runtime-val")
+			rb.SetPodmanPodID("podman.pod.id-val")
+			rb.SetPodmanPodName("podman.pod.name-val")
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 
@@ -224,6 +256,33 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "container.health.failing_streak":
+					assert.False(t, validatedMetrics["container.health.failing_streak"], "Found a duplicate in the metrics slice: container.health.failing_streak")
+					validatedMetrics["container.health.failing_streak"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of consecutive failed healthchecks of the container, only for containers with a healthcheck.", ms.At(i).Description())
+					assert.Equal(t, "{checks}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "container.health.status":
+					assert.False(t, validatedMetrics["container.health.status"], "Found a duplicate in the metrics slice: container.health.status")
+					validatedMetrics["container.health.status"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Whether the health status of the container is the one of the attribute, only for containers with a healthcheck.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("health_status")
+					assert.True(t, ok)
+					assert.EqualValues(t, "healthy", attrVal.Str())
 				case "container.memory.percent":
 					assert.False(t, validatedMetrics["container.memory.percent"], "Found a duplicate in the metrics slice: container.memory.percent")
 					validatedMetrics["container.memory.percent"] = true
@@ -292,6 +351,114 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.blockio.io_service_bytes_recursive.read":
+					assert.False(t, validatedMetrics["podman.pod.blockio.io_service_bytes_recursive.read"], "Found a duplicate in the metrics slice: podman.pod.blockio.io_service_bytes_recursive.read")
+					validatedMetrics["podman.pod.blockio.io_service_bytes_recursive.read"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of bytes transferred from the disk by the containers of the pod", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.blockio.io_service_bytes_recursive.write":
+					assert.False(t, validatedMetrics["podman.pod.blockio.io_service_bytes_recursive.write"], "Found a duplicate in the metrics slice: podman.pod.blockio.io_service_bytes_recursive.write")
+					validatedMetrics["podman.pod.blockio.io_service_bytes_recursive.write"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Number of bytes transferred to the disk by the containers of the pod", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.containers":
+					assert.False(t, validatedMetrics["podman.pod.containers"], "Found a duplicate in the metrics slice: podman.pod.containers")
+					validatedMetrics["podman.pod.containers"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of running containers in the pod.", ms.At(i).Description())
+					assert.Equal(t, "{containers}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.cpu.percent":
+					assert.False(t, validatedMetrics["podman.pod.cpu.percent"], "Found a duplicate in the metrics slice: podman.pod.cpu.percent")
+					validatedMetrics["podman.pod.cpu.percent"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Percent of CPU used by the containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "podman.pod.cpu.usage.total":
+					assert.False(t, validatedMetrics["podman.pod.cpu.usage.total"], "Found a duplicate in the metrics slice: podman.pod.cpu.usage.total")
+					validatedMetrics["podman.pod.cpu.usage.total"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Total CPU time consumed by the containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.memory.usage.total":
+					assert.False(t, validatedMetrics["podman.pod.memory.usage.total"], "Found a duplicate in the metrics slice: podman.pod.memory.usage.total")
+					validatedMetrics["podman.pod.memory.usage.total"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Memory usage of the containers of the pod.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, false, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.network.io.usage.rx_bytes":
+					assert.False(t, validatedMetrics["podman.pod.network.io.usage.rx_bytes"], "Found a duplicate in the metrics slice: podman.pod.network.io.usage.rx_bytes")
+					validatedMetrics["podman.pod.network.io.usage.rx_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes received by the pod.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "podman.pod.network.io.usage.tx_bytes":
+					assert.False(t, validatedMetrics["podman.pod.network.io.usage.tx_bytes"], "Found a duplicate in the metrics slice: podman.pod.network.io.usage.tx_bytes")
+					validatedMetrics["podman.pod.network.io.usage.tx_bytes"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "Bytes sent by the pod.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				}
 			}
 		})
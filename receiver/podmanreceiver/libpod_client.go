@@ -96,6 +96,27 @@ func (c *libpodClient) list(ctx context.Context, options url.Values) ([]containe
 	return report, nil
 }
 
+func (c *libpodClient) inspect(ctx context.Context, cid string) (containerInspect, error) {
+	var report containerInspect
+	resp, err := c.request(ctx, "/containers/"+url.PathEscape(cid)+"/json", nil)
+	if err != nil {
+		return report, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return report, fmt.Errorf("inspect response was %d", resp.StatusCode)
+	}
+
+	bytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return report, err
+	}
+
+	err = json.Unmarshal(bytes, &report)
+	return report, err
+}
+
 func (c *libpodClient) ping(ctx context.Context) error {
 	resp, err := c.request(ctx, "/_ping", nil)
 	if err != nil {
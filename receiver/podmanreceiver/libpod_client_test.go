@@ -254,3 +254,40 @@ loop:
 	assert.Equal(t, expectedEvents, actualEvents)
 
 }
+
+func TestInspect(t *testing.T) {
+	// inspect sample, reduced to the state of the container
+	inspectExample := `{"Id":"aa3e2040dee2","State":{"OciVersion":"1.1.0","Status":"running","Running":true,"Pid":7892,"Health":{"Status":"unhealthy","FailingStreak":2,"Log":[]}}}`
+
+	listener, addr := tmpSock(t)
+	defer listener.Close()
+	defer os.Remove(addr)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/containers/aa3e2040dee2/json") {
+			_, err := w.Write([]byte(inspectExample))
+			assert.NoError(t, err)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	srv.Listener = listener
+	srv.Start()
+	defer srv.Close()
+
+	config := &Config{
+		Endpoint: fmt.Sprintf("unix://%s", addr),
+	}
+
+	cli, err := newLibpodClient(zap.NewNop(), config)
+	assert.NotNil(t, cli)
+	assert.NoError(t, err)
+
+	report, err := cli.inspect(context.Background(), "aa3e2040dee2")
+	assert.NoError(t, err)
+	assert.Equal(t, &containerHealth{Status: "unhealthy", FailingStreak: 2}, report.State.Health)
+	assert.Nil(t, report.State.Healthcheck)
+
+	_, err = cli.inspect(context.Background(), "unknown")
+	assert.EqualError(t, err, "inspect response was 404")
+}
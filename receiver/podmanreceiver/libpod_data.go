@@ -34,6 +34,22 @@ type container struct {
 	Status     string
 }
 
+// containerInspect is the subset of the container inspect report used by the receiver.
+type containerInspect struct {
+	State containerState
+}
+
+type containerState struct {
+	Health *containerHealth
+	// Healthcheck is the health of the container reported by Podman versions older than 4.
+	Healthcheck *containerHealth
+}
+
+type containerHealth struct {
+	Status        string
+	FailingStreak int64
+}
+
 type event struct {
 	ID     string
 	Status string
@@ -21,6 +21,7 @@ type PodmanClient interface {
 	ping(context.Context) error
 	stats(context.Context, url.Values) ([]containerStats, error)
 	list(context.Context, url.Values) ([]container, error)
+	inspect(context.Context, string) (containerInspect, error)
 	events(context.Context, url.Values) (<-chan event, <-chan error)
 }
 
@@ -178,6 +179,22 @@ func (pc *ContainerScraper) fetchContainerStats(ctx context.Context, c container
 	return stats[0], nil
 }
 
+// fetchContainerHealth will query the health of the container, which is nil if the container has no healthcheck.
+func (pc *ContainerScraper) fetchContainerHealth(ctx context.Context, c container) (*containerHealth, error) {
+	report, err := pc.client.inspect(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	health := report.State.Health
+	if health == nil {
+		health = report.State.Healthcheck
+	}
+	if health == nil || health.Status == "" {
+		return nil, nil
+	}
+	return health, nil
+}
+
 func (pc *ContainerScraper) persistContainer(c container) {
 	pc.logger.Debug("Monitoring Podman container", zap.String("id", c.ID))
 	pc.containersLock.Lock()
@@ -26,10 +26,11 @@ import (
 )
 
 type MockClient struct {
-	PingF   func(context.Context) error
-	StatsF  func(context.Context, url.Values) ([]containerStats, error)
-	ListF   func(context.Context, url.Values) ([]container, error)
-	EventsF func(context.Context, url.Values) (<-chan event, <-chan error)
+	PingF    func(context.Context) error
+	StatsF   func(context.Context, url.Values) ([]containerStats, error)
+	ListF    func(context.Context, url.Values) ([]container, error)
+	InspectF func(context.Context, string) (containerInspect, error)
+	EventsF  func(context.Context, url.Values) (<-chan event, <-chan error)
 }
 
 func (c *MockClient) ping(ctx context.Context) error {
@@ -44,6 +45,10 @@ func (c *MockClient) list(ctx context.Context, options url.Values) ([]container,
 	return c.ListF(ctx, options)
 }
 
+func (c *MockClient) inspect(ctx context.Context, cid string) (containerInspect, error) {
+	return c.InspectF(ctx, cid)
+}
+
 func (c *MockClient) events(ctx context.Context, options url.Values) (<-chan event, <-chan error) {
 	return c.EventsF(ctx, options)
 }
@@ -58,6 +63,9 @@ var baseClient = MockClient{
 	ListF: func(context.Context, url.Values) ([]container, error) {
 		return nil, nil
 	},
+	InspectF: func(context.Context, string) (containerInspect, error) {
+		return containerInspect{}, nil
+	},
 	EventsF: func(context.Context, url.Values) (<-chan event, <-chan error) {
 		return nil, nil
 	},
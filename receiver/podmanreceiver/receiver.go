@@ -100,12 +100,43 @@ func (r *metricsReceiver) shutdown(context.Context) error {
 type result struct {
 	container      container
 	containerStats containerStats
+	health         *containerHealth
 	err            error
 }
 
+// podStats aggregates the stats of the containers of a pod.
+type podStats struct {
+	name        string
+	containers  int64
+	cpuNano     uint64
+	cpu         float64
+	memUsage    uint64
+	netInput    uint64
+	netOutput   uint64
+	blockInput  uint64
+	blockOutput uint64
+}
+
+func (p *podStats) add(stats *containerStats) {
+	p.containers++
+	p.cpuNano += stats.CPUNano
+	p.cpu += stats.CPU
+	p.memUsage += stats.MemUsage
+	// The containers of a pod share its network namespace, so they all report the network stats of the pod.
+	p.netInput = max(p.netInput, stats.NetInput)
+	p.netOutput = max(p.netOutput, stats.NetOutput)
+	p.blockInput += stats.BlockInput
+	p.blockOutput += stats.BlockOutput
+}
+
+func (r *metricsReceiver) healthMetricsEnabled() bool {
+	return r.config.Metrics.ContainerHealthStatus.Enabled || r.config.Metrics.ContainerHealthFailingStreak.Enabled
+}
+
 func (r *metricsReceiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	containers := r.scraper.getContainers()
 	results := make(chan result, len(containers))
+	fetchHealth := r.healthMetricsEnabled()
 
 	wg := &sync.WaitGroup{}
 	wg.Add(len(containers))
@@ -113,7 +144,11 @@ func (r *metricsReceiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		go func(c container) {
 			defer wg.Done()
 			stats, err := r.scraper.fetchContainerStats(ctx, c)
-			results <- result{container: c, containerStats: stats, err: err}
+			res := result{container: c, containerStats: stats, err: err}
+			if err == nil && fetchHealth {
+				res.health, res.err = r.scraper.fetchContainerHealth(ctx, c)
+			}
+			results <- res
 		}(c)
 	}
 
@@ -123,28 +158,65 @@ func (r *metricsReceiver) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	var errs error
 	now := pcommon.NewTimestampFromTime(time.Now())
 
+	pods := map[string]*podStats{}
 	for res := range results {
 		if res.err != nil {
 			// Don't know the number of failed metrics, but one container fetch is a partial error.
 			errs = multierr.Append(errs, scrapererror.NewPartialScrapeError(res.err, 0))
 			continue
 		}
-		r.recordContainerStats(now, res.container, &res.containerStats)
+		r.recordContainerStats(now, res.container, &res.containerStats, res.health)
+
+		if res.container.Pod != "" {
+			pod, ok := pods[res.container.Pod]
+			if !ok {
+				pod = &podStats{name: res.container.PodName}
+				pods[res.container.Pod] = pod
+			}
+			pod.add(&res.containerStats)
+		}
+	}
+
+	for id, pod := range pods {
+		r.recordPodStats(now, id, pod)
 	}
 	return r.mb.Emit(), errs
 }
 
-func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, container container, stats *containerStats) {
+func (r *metricsReceiver) recordContainerStats(now pcommon.Timestamp, container container, stats *containerStats, health *containerHealth) {
 	r.recordCPUMetrics(now, stats)
 	r.recordNetworkMetrics(now, stats)
 	r.recordMemoryMetrics(now, stats)
 	r.recordIOMetrics(now, stats)
+	r.recordHealthMetrics(now, health)
 
 	rb := r.mb.NewResourceBuilder()
 	rb.SetContainerRuntime("podman")
 	rb.SetContainerName(stats.Name)
 	rb.SetContainerID(stats.ContainerID)
 	rb.SetContainerImageName(container.Image)
+	if container.Pod != "" {
+		rb.SetPodmanPodID(container.Pod)
+		rb.SetPodmanPodName(container.PodName)
+	}
+
+	r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func (r *metricsReceiver) recordPodStats(now pcommon.Timestamp, id string, pod *podStats) {
+	r.mb.RecordPodmanPodContainersDataPoint(now, pod.containers)
+	r.mb.RecordPodmanPodCPUUsageTotalDataPoint(now, int64(toSecondsWithNanosecondPrecision(pod.cpuNano)))
+	r.mb.RecordPodmanPodCPUPercentDataPoint(now, pod.cpu)
+	r.mb.RecordPodmanPodMemoryUsageTotalDataPoint(now, int64(pod.memUsage))
+	r.mb.RecordPodmanPodNetworkIoUsageRxBytesDataPoint(now, int64(pod.netOutput))
+	r.mb.RecordPodmanPodNetworkIoUsageTxBytesDataPoint(now, int64(pod.netInput))
+	r.mb.RecordPodmanPodBlockioIoServiceBytesRecursiveReadDataPoint(now, int64(pod.blockInput))
+	r.mb.RecordPodmanPodBlockioIoServiceBytesRecursiveWriteDataPoint(now, int64(pod.blockOutput))
+
+	rb := r.mb.NewResourceBuilder()
+	rb.SetContainerRuntime("podman")
+	rb.SetPodmanPodID(id)
+	rb.SetPodmanPodName(pod.name)
 
 	r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
 }
@@ -175,6 +247,20 @@ func (r *metricsReceiver) recordIOMetrics(now pcommon.Timestamp, stats *containe
 	r.mb.RecordContainerBlockioIoServiceBytesRecursiveWriteDataPoint(now, int64(stats.BlockOutput))
 }
 
+func (r *metricsReceiver) recordHealthMetrics(now pcommon.Timestamp, health *containerHealth) {
+	if health == nil {
+		return
+	}
+	for status := range metadata.MapAttributeHealthStatus {
+		var val int64
+		if status == health.Status {
+			val = 1
+		}
+		r.mb.RecordContainerHealthStatusDataPoint(now, val, metadata.MapAttributeHealthStatus[status])
+	}
+	r.mb.RecordContainerHealthFailingStreakDataPoint(now, health.FailingStreak)
+}
+
 // nanoseconds to seconds conversion truncating the fractional part
 func toSecondsWithNanosecondPrecision(nanoseconds uint64) uint64 {
 	return nanoseconds / 1e9
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
@@ -100,6 +101,124 @@ func (c mockClient) list(context.Context, url.Values) ([]container, error) {
 	return []container{{ID: "c1", Image: "localimage"}}, nil
 }
 
+func (c mockClient) inspect(context.Context, string) (containerInspect, error) {
+	return containerInspect{}, nil
+}
+
 func (c mockClient) events(context.Context, url.Values) (<-chan event, <-chan error) {
 	return nil, nil
 }
+
+func TestScrapePodAndHealthMetrics(t *testing.T) {
+	cfg := createDefaultConfig()
+	cfg.CollectionInterval = 100 * time.Millisecond
+	cfg.Metrics.ContainerHealthStatus.Enabled = true
+	cfg.Metrics.ContainerHealthFailingStreak.Enabled = true
+	cfg.Metrics.PodmanPodContainers.Enabled = true
+	cfg.Metrics.PodmanPodCPUUsageTotal.Enabled = true
+	cfg.Metrics.PodmanPodMemoryUsageTotal.Enabled = true
+	cfg.Metrics.PodmanPodNetworkIoUsageRxBytes.Enabled = true
+
+	stats := map[string]containerStats{
+		"c1": {ContainerID: "c1", Name: "web", CPUNano: 2e9, MemUsage: 100, NetOutput: 10},
+		"c2": {ContainerID: "c2", Name: "db", CPUNano: 3e9, MemUsage: 200, NetOutput: 10},
+		"c3": {ContainerID: "c3", Name: "standalone", CPUNano: 1e9, MemUsage: 50, NetOutput: 5},
+	}
+	client := baseClient
+	client.ListF = func(context.Context, url.Values) ([]container, error) {
+		return []container{
+			{ID: "c1", Image: "nginx", Pod: "p1", PodName: "app"},
+			{ID: "c2", Image: "postgres", Pod: "p1", PodName: "app"},
+			{ID: "c3", Image: "redis"},
+		}, nil
+	}
+	client.StatsF = func(_ context.Context, options url.Values) ([]containerStats, error) {
+		return []containerStats{stats[options.Get("containers")]}, nil
+	}
+	client.InspectF = func(_ context.Context, cid string) (containerInspect, error) {
+		if cid == "c1" {
+			return containerInspect{State: containerState{Health: &containerHealth{Status: "unhealthy", FailingStreak: 3}}}, nil
+		}
+		return containerInspect{}, nil
+	}
+	factory := func(*zap.Logger, *Config) (PodmanClient, error) {
+		return &client, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r := newMetricsReceiver(receivertest.NewNopCreateSettings(), cfg, factory)
+	require.NoError(t, r.start(ctx, componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.shutdown(ctx)) }()
+
+	md, err := r.scrape(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, md.ResourceMetrics().Len())
+
+	var podFound, healthFound bool
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		attrs := rm.Resource().Attributes().AsRaw()
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+
+		if _, ok := attrs["container.id"]; !ok {
+			podFound = true
+			assert.Equal(t, map[string]any{
+				"container.runtime": "podman",
+				"podman.pod.id":     "p1",
+				"podman.pod.name":   "app",
+			}, attrs)
+			values := map[string]int64{}
+			for j := 0; j < metrics.Len(); j++ {
+				m := metrics.At(j)
+				switch m.Type() {
+				case pmetric.MetricTypeGauge:
+					values[m.Name()] = m.Gauge().DataPoints().At(0).IntValue()
+				case pmetric.MetricTypeSum:
+					values[m.Name()] = m.Sum().DataPoints().At(0).IntValue()
+				}
+			}
+			assert.Equal(t, map[string]int64{
+				"podman.pod.containers":                2,
+				"podman.pod.cpu.usage.total":           5,
+				"podman.pod.memory.usage.total":        300,
+				"podman.pod.network.io.usage.rx_bytes": 10,
+			}, values)
+			continue
+		}
+
+		switch attrs["container.id"] {
+		case "c1", "c2":
+			assert.Equal(t, "p1", attrs["podman.pod.id"])
+			assert.Equal(t, "app", attrs["podman.pod.name"])
+		case "c3":
+			assert.NotContains(t, attrs, "podman.pod.id")
+			assert.NotContains(t, attrs, "podman.pod.name")
+		}
+
+		for j := 0; j < metrics.Len(); j++ {
+			m := metrics.At(j)
+			switch m.Name() {
+			case "container.health.status":
+				require.Equal(t, "c1", attrs["container.id"])
+				healthFound = true
+				dps := m.Gauge().DataPoints()
+				require.Equal(t, 3, dps.Len())
+				for k := 0; k < dps.Len(); k++ {
+					status, _ := dps.At(k).Attributes().Get("health_status")
+					expected := int64(0)
+					if status.Str() == "unhealthy" {
+						expected = 1
+					}
+					assert.Equal(t, expected, dps.At(k).IntValue(), status.Str())
+				}
+			case "container.health.failing_streak":
+				require.Equal(t, "c1", attrs["container.id"])
+				assert.Equal(t, int64(3), m.Gauge().DataPoints().At(0).IntValue())
+			}
+		}
+	}
+	assert.True(t, podFound)
+	assert.True(t, healthFound)
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -55,6 +56,7 @@ type client interface {
 	getLatestWalAgeSeconds(ctx context.Context) (int64, error)
 	getMaxConnections(ctx context.Context) (int64, error)
 	getIndexStats(ctx context.Context, database string) (map[indexIdentifer]indexStat, error)
+	getQueryStats(ctx context.Context, databases []string, limit int) ([]queryStats, error)
 	listDatabases(ctx context.Context) ([]string, error)
 }
 
@@ -487,6 +489,66 @@ func (c *postgreSQLClient) getMaxConnections(ctx context.Context) (int64, error)
 	return maxConns, err
 }
 
+type queryStats struct {
+	database         string
+	queryID          string
+	query            string
+	calls            int64
+	totalExecTime    float64
+	rows             int64
+	sharedBlocksHit  int64
+	sharedBlocksRead int64
+}
+
+// getQueryStats returns the statistics of the queries with the highest total execution time, aggregated
+// per database and query ID. It requires the pg_stat_statements extension.
+func (c *postgreSQLClient) getQueryStats(ctx context.Context, databases []string, limit int) ([]queryStats, error) {
+	var version int64
+	if err := c.client.QueryRowContext(ctx, "SELECT current_setting('server_version_num')::integer;").Scan(&version); err != nil {
+		return nil, fmt.Errorf("unable to determine the server version: %w", err)
+	}
+	// The execution time columns were renamed in PostgreSQL 13.
+	execTimeColumn := "total_exec_time"
+	if version < 130000 {
+		execTimeColumn = "total_time"
+	}
+
+	query := fmt.Sprintf(`SELECT d.datname, s.queryid, min(s.query), sum(s.calls), sum(s.%[1]s), sum(s.rows),
+	sum(s.shared_blks_hit), sum(s.shared_blks_read)
+	FROM pg_stat_statements s
+	JOIN pg_database d ON d.oid = s.dbid
+	WHERE s.queryid IS NOT NULL`, execTimeColumn)
+	if len(databases) > 0 {
+		var queryDatabases []string
+		for _, db := range databases {
+			queryDatabases = append(queryDatabases, fmt.Sprintf("'%s'", db))
+		}
+		query += fmt.Sprintf(" AND d.datname IN (%s)", strings.Join(queryDatabases, ","))
+	}
+	query += fmt.Sprintf(" GROUP BY d.datname, s.queryid ORDER BY sum(s.%s) DESC LIMIT %d;", execTimeColumn, limit)
+
+	rows, err := c.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pg_stat_statements: %w", err)
+	}
+	defer rows.Close()
+	var qs []queryStats
+	var errs []error
+	for rows.Next() {
+		var stat queryStats
+		var queryID int64
+		err = rows.Scan(&stat.database, &queryID, &stat.query, &stat.calls, &stat.totalExecTime, &stat.rows,
+			&stat.sharedBlocksHit, &stat.sharedBlocksRead)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		stat.queryID = strconv.FormatInt(queryID, 10)
+		qs = append(qs, stat)
+	}
+	return qs, multierr.Combine(errs...)
+}
+
 type replicationStats struct {
 	clientAddr   string
 	pendingBytes int64
@@ -25,6 +25,7 @@ const (
 	ErrNotSupported        = "invalid config: field '%s' not supported"
 	ErrTransportsSupported = "invalid config: 'transport' must be 'tcp' or 'unix'"
 	ErrHostPort            = "invalid config: 'endpoint' must be in the form <host>:<port> no matter what 'transport' is configured"
+	ErrQueryTopN           = "invalid config: 'query_statistics.top_n' must be positive"
 )
 
 type Config struct {
@@ -36,6 +37,7 @@ type Config struct {
 	confignet.AddrConfig           `mapstructure:",squash"`       // provides Endpoint and Transport
 	configtls.ClientConfig         `mapstructure:"tls,omitempty"` // provides SSL details
 	ConnectionPool                 `mapstructure:"connection_pool,omitempty"`
+	QueryStatistics                QueryStatistics `mapstructure:"query_statistics"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
 }
 
@@ -46,6 +48,12 @@ type ConnectionPool struct {
 	MaxOpen     *int           `mapstructure:"max_open,omitempty"`
 }
 
+// QueryStatistics configures the collection of the postgresql.query.* metrics from pg_stat_statements.
+type QueryStatistics struct {
+	// TopN is the maximum number of queries, with the highest total execution time, reported per scrape.
+	TopN int `mapstructure:"top_n"`
+}
+
 func (cfg *Config) Validate() error {
 	var err error
 	if cfg.Username == "" {
@@ -76,5 +84,9 @@ func (cfg *Config) Validate() error {
 		err = multierr.Append(err, errors.New(ErrTransportsSupported))
 	}
 
+	if cfg.QueryStatistics.TopN <= 0 {
+		err = multierr.Append(err, errors.New(ErrQueryTopN))
+	}
+
 	return err
 }
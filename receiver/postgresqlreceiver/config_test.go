@@ -89,6 +89,17 @@ func TestValidate(t *testing.T) {
 				fmt.Errorf(ErrNotSupported, "MinVersion"),
 			),
 		},
+		{
+			desc: "invalid query statistics top n",
+			defaultConfigModifier: func(cfg *Config) {
+				cfg.Username = "otel"
+				cfg.Password = "otel"
+				cfg.QueryStatistics.TopN = 0
+			},
+			expected: multierr.Combine(
+				errors.New(ErrQueryTopN),
+			),
+		},
 		{
 			desc: "no error",
 			defaultConfigModifier: func(cfg *Config) {
@@ -175,6 +186,7 @@ func TestLoadConfig(t *testing.T) {
 			MaxIdle:     ptr(5),
 			MaxOpen:     ptr(10),
 		}
+		expected.QueryStatistics = QueryStatistics{TopN: 20}
 
 		require.Equal(t, expected, cfg)
 	})
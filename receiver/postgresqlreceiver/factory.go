@@ -17,6 +17,9 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/postgresqlreceiver/internal/metadata"
 )
 
+// defaultQueryTopN is the default maximum number of queries reported by the postgresql.query.* metrics.
+const defaultQueryTopN = 100
+
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
@@ -38,6 +41,9 @@ func createDefaultConfig() component.Config {
 			Insecure:           false,
 			InsecureSkipVerify: true,
 		},
+		QueryStatistics: QueryStatistics{
+			TopN: defaultQueryTopN,
+		},
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
 	}
 }
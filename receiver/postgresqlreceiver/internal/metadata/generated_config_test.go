@@ -41,6 +41,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					PostgresqlIndexScans:               MetricConfig{Enabled: true},
 					PostgresqlIndexSize:                MetricConfig{Enabled: true},
 					PostgresqlOperations:               MetricConfig{Enabled: true},
+					PostgresqlQueryCalls:               MetricConfig{Enabled: true},
+					PostgresqlQueryExecTime:            MetricConfig{Enabled: true},
+					PostgresqlQueryMeanExecTime:        MetricConfig{Enabled: true},
+					PostgresqlQueryRows:                MetricConfig{Enabled: true},
+					PostgresqlQuerySharedBlocks:        MetricConfig{Enabled: true},
 					PostgresqlReplicationDataDelay:     MetricConfig{Enabled: true},
 					PostgresqlRollbacks:                MetricConfig{Enabled: true},
 					PostgresqlRows:                     MetricConfig{Enabled: true},
@@ -81,6 +86,11 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					PostgresqlIndexScans:               MetricConfig{Enabled: false},
 					PostgresqlIndexSize:                MetricConfig{Enabled: false},
 					PostgresqlOperations:               MetricConfig{Enabled: false},
+					PostgresqlQueryCalls:               MetricConfig{Enabled: false},
+					PostgresqlQueryExecTime:            MetricConfig{Enabled: false},
+					PostgresqlQueryMeanExecTime:        MetricConfig{Enabled: false},
+					PostgresqlQueryRows:                MetricConfig{Enabled: false},
+					PostgresqlQuerySharedBlocks:        MetricConfig{Enabled: false},
 					PostgresqlReplicationDataDelay:     MetricConfig{Enabled: false},
 					PostgresqlRollbacks:                MetricConfig{Enabled: false},
 					PostgresqlRows:                     MetricConfig{Enabled: false},
@@ -130,6 +130,21 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordPostgresqlOperationsDataPoint(ts, 1, AttributeOperationIns)
 
+			allMetricsCount++
+			mb.RecordPostgresqlQueryCallsDataPoint(ts, 1, "query_id-val", "query-val")
+
+			allMetricsCount++
+			mb.RecordPostgresqlQueryExecTimeDataPoint(ts, 1, "query_id-val", "query-val")
+
+			allMetricsCount++
+			mb.RecordPostgresqlQueryMeanExecTimeDataPoint(ts, 1, "query_id-val", "query-val")
+
+			allMetricsCount++
+			mb.RecordPostgresqlQueryRowsDataPoint(ts, 1, "query_id-val", "query-val")
+
+			allMetricsCount++
+			mb.RecordPostgresqlQuerySharedBlocksDataPoint(ts, 1, "query_id-val", "query-val", AttributeSharedBlockTypeHit)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordPostgresqlReplicationDataDelayDataPoint(ts, 1, "replication_client-val")
@@ -440,6 +455,107 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("operation")
 					assert.True(t, ok)
 					assert.EqualValues(t, "ins", attrVal.Str())
+				case "postgresql.query.calls":
+					assert.False(t, validatedMetrics["postgresql.query.calls"], "Found a duplicate in the metrics slice: postgresql.query.calls")
+					validatedMetrics["postgresql.query.calls"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of times the query was executed.", ms.At(i).Description())
+					assert.Equal(t, "{calls}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("query_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query-val", attrVal.Str())
+				case "postgresql.query.exec_time":
+					assert.False(t, validatedMetrics["postgresql.query.exec_time"], "Found a duplicate in the metrics slice: postgresql.query.exec_time")
+					validatedMetrics["postgresql.query.exec_time"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total time spent executing the query.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("query_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query-val", attrVal.Str())
+				case "postgresql.query.mean_exec_time":
+					assert.False(t, validatedMetrics["postgresql.query.mean_exec_time"], "Found a duplicate in the metrics slice: postgresql.query.mean_exec_time")
+					validatedMetrics["postgresql.query.mean_exec_time"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The mean time spent executing the query.", ms.At(i).Description())
+					assert.Equal(t, "ms", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("query_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query-val", attrVal.Str())
+				case "postgresql.query.rows":
+					assert.False(t, validatedMetrics["postgresql.query.rows"], "Found a duplicate in the metrics slice: postgresql.query.rows")
+					validatedMetrics["postgresql.query.rows"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The total number of rows retrieved or affected by the query.", ms.At(i).Description())
+					assert.Equal(t, "{rows}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("query_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query-val", attrVal.Str())
+				case "postgresql.query.shared_blocks":
+					assert.False(t, validatedMetrics["postgresql.query.shared_blocks"], "Found a duplicate in the metrics slice: postgresql.query.shared_blocks")
+					validatedMetrics["postgresql.query.shared_blocks"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of shared blocks accessed by the query.", ms.At(i).Description())
+					assert.Equal(t, "{blocks}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("query_id")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query_id-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("query")
+					assert.True(t, ok)
+					assert.EqualValues(t, "query-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "hit", attrVal.Str())
 				case "postgresql.replication.data_delay":
 					assert.False(t, validatedMetrics["postgresql.replication.data_delay"], "Found a duplicate in the metrics slice: postgresql.replication.data_delay")
 					validatedMetrics["postgresql.replication.data_delay"] = true
@@ -154,6 +154,7 @@ func (p *postgreSQLScraper) scrape(ctx context.Context) (pmetric.Metrics, error)
 		p.recordDatabase(now, database, r, numTables)
 		p.collectIndexes(ctx, now, dbClient, database, &errs)
 	}
+	p.collectQueryStats(ctx, now, listClient, databases, &errs)
 
 	p.mb.RecordPostgresqlDatabaseCountDataPoint(now, int64(len(databases)))
 	p.collectBGWriterStats(ctx, now, listClient, &errs)
@@ -284,6 +285,56 @@ func (p *postgreSQLScraper) collectIndexes(
 	}
 }
 
+// collectQueryStats records the postgresql.query.* metrics of the databases, only when at least one of
+// them is enabled as it relies on the pg_stat_statements extension.
+func (p *postgreSQLScraper) collectQueryStats(
+	ctx context.Context,
+	now pcommon.Timestamp,
+	client client,
+	databases []string,
+	errs *errsMux,
+) {
+	metrics := p.config.Metrics
+	if !metrics.PostgresqlQueryCalls.Enabled && !metrics.PostgresqlQueryExecTime.Enabled &&
+		!metrics.PostgresqlQueryMeanExecTime.Enabled && !metrics.PostgresqlQueryRows.Enabled &&
+		!metrics.PostgresqlQuerySharedBlocks.Enabled {
+		return
+	}
+	if len(databases) == 0 {
+		return
+	}
+
+	qs, err := client.getQueryStats(ctx, databases, p.config.QueryStatistics.TopN)
+	if err != nil {
+		p.logger.Error("Errors encountered while fetching query statistics", zap.Error(err))
+		errs.addPartial(err)
+	}
+
+	statsByDatabase := map[string][]queryStats{}
+	for _, stat := range qs {
+		statsByDatabase[stat.database] = append(statsByDatabase[stat.database], stat)
+	}
+	for _, database := range databases {
+		stats, ok := statsByDatabase[database]
+		if !ok {
+			continue
+		}
+		for _, stat := range stats {
+			p.mb.RecordPostgresqlQueryCallsDataPoint(now, stat.calls, stat.queryID, stat.query)
+			p.mb.RecordPostgresqlQueryExecTimeDataPoint(now, stat.totalExecTime, stat.queryID, stat.query)
+			if stat.calls > 0 {
+				p.mb.RecordPostgresqlQueryMeanExecTimeDataPoint(now, stat.totalExecTime/float64(stat.calls), stat.queryID, stat.query)
+			}
+			p.mb.RecordPostgresqlQueryRowsDataPoint(now, stat.rows, stat.queryID, stat.query)
+			p.mb.RecordPostgresqlQuerySharedBlocksDataPoint(now, stat.sharedBlocksHit, stat.queryID, stat.query, metadata.AttributeSharedBlockTypeHit)
+			p.mb.RecordPostgresqlQuerySharedBlocksDataPoint(now, stat.sharedBlocksRead, stat.queryID, stat.query, metadata.AttributeSharedBlockTypeRead)
+		}
+		rb := p.mb.NewResourceBuilder()
+		rb.SetPostgresqlDatabaseName(database)
+		p.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+}
+
 func (p *postgreSQLScraper) collectBGWriterStats(
 	ctx context.Context,
 	now pcommon.Timestamp,
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -118,6 +120,62 @@ func TestScraperNoDatabaseSingle(t *testing.T) {
 	runTest(false, "expected.yaml", "expected_default_metrics.yaml")
 }
 
+func TestScraperQueryStats(t *testing.T) {
+	factory := new(mockClientFactory)
+	factory.initMocks([]string{"otel", "open"})
+
+	cfg := createDefaultConfig().(*Config)
+	require.False(t, cfg.Metrics.PostgresqlQueryCalls.Enabled)
+	cfg.Metrics.PostgresqlQueryCalls.Enabled = true
+	cfg.Metrics.PostgresqlQueryExecTime.Enabled = true
+	cfg.Metrics.PostgresqlQueryMeanExecTime.Enabled = true
+	cfg.Metrics.PostgresqlQueryRows.Enabled = true
+	cfg.Metrics.PostgresqlQuerySharedBlocks.Enabled = true
+
+	scraper := newPostgreSQLScraper(receivertest.NewNopCreateSettings(), cfg, factory)
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	queryMetrics := map[string]map[string]pmetric.NumberDataPointSlice{}
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		rm := actualMetrics.ResourceMetrics().At(i)
+		database, _ := rm.Resource().Attributes().Get("postgresql.database.name")
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			metric := metrics.At(j)
+			if !strings.HasPrefix(metric.Name(), "postgresql.query.") {
+				continue
+			}
+			if _, ok := queryMetrics[database.Str()]; !ok {
+				queryMetrics[database.Str()] = map[string]pmetric.NumberDataPointSlice{}
+			}
+			if metric.Type() == pmetric.MetricTypeGauge {
+				queryMetrics[database.Str()][metric.Name()] = metric.Gauge().DataPoints()
+			} else {
+				queryMetrics[database.Str()][metric.Name()] = metric.Sum().DataPoints()
+			}
+		}
+	}
+
+	require.Len(t, queryMetrics, 2)
+	otel := queryMetrics["otel"]
+	require.Len(t, otel, 5)
+	calls := otel["postgresql.query.calls"].At(0)
+	assert.Equal(t, int64(10), calls.IntValue())
+	queryID, _ := calls.Attributes().Get("query_id")
+	assert.Equal(t, "-1234567890", queryID.Str())
+	query, _ := calls.Attributes().Get("query")
+	assert.Equal(t, "SELECT * FROM table1 WHERE id = $1", query.Str())
+	assert.Equal(t, 25.5, otel["postgresql.query.exec_time"].At(0).DoubleValue())
+	assert.Equal(t, 2.55, otel["postgresql.query.mean_exec_time"].At(0).DoubleValue())
+	assert.Equal(t, int64(20), otel["postgresql.query.rows"].At(0).IntValue())
+	require.Equal(t, 2, otel["postgresql.query.shared_blocks"].Len())
+
+	open := queryMetrics["open"]
+	assert.Equal(t, int64(11), open["postgresql.query.calls"].At(0).IntValue())
+	assert.Equal(t, 51.0, open["postgresql.query.exec_time"].At(0).DoubleValue())
+}
+
 func TestScraperNoDatabaseMultipleWithoutPreciseLag(t *testing.T) {
 	factory := mockClientFactory{}
 	factory.initMocks([]string{"otel", "open", "telemetry"})
@@ -360,6 +418,11 @@ func (m *mockClient) getReplicationStats(ctx context.Context) ([]replicationStat
 	return args.Get(0).([]replicationStats), args.Error(1)
 }
 
+func (m *mockClient) getQueryStats(_ context.Context, databases []string, limit int) ([]queryStats, error) {
+	args := m.Called(databases, limit)
+	return args.Get(0).([]queryStats), args.Error(1)
+}
+
 func (m *mockClient) listDatabases(_ context.Context) ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
@@ -425,6 +488,20 @@ func (m *mockClient) initMocks(database string, schema string, databases []strin
 			maxWritten:           11,
 		}, nil)
 		m.On("getMaxConnections", mock.Anything).Return(int64(100), nil)
+		var qs []queryStats
+		for idx, db := range databases {
+			qs = append(qs, queryStats{
+				database:         db,
+				queryID:          fmt.Sprintf("%d", -1234567890+idx),
+				query:            "SELECT * FROM table1 WHERE id = $1",
+				calls:            int64(idx + 10),
+				totalExecTime:    float64(idx+1) * 25.5,
+				rows:             int64(idx + 20),
+				sharedBlocksHit:  int64(idx + 30),
+				sharedBlocksRead: int64(idx + 5),
+			})
+		}
+		m.On("getQueryStats", databases, defaultQueryTopN).Return(qs, nil)
 		m.On("getLatestWalAgeSeconds", mock.Anything).Return(int64(3600), nil)
 		m.On("getDatabaseLocks", mock.Anything).Return([]databaseLocks{
 			{
@@ -37,6 +37,10 @@ type Config struct {
 	// ReportExtraScrapeMetrics - enables reporting of additional metrics for Prometheus client like scrape_body_size_bytes
 	ReportExtraScrapeMetrics bool `mapstructure:"report_extra_scrape_metrics"`
 
+	// ScrapeHealthMetrics configures the up, scrape_duration_seconds, scrape_samples_scraped,
+	// scrape_samples_post_metric_relabeling and scrape_series_added metrics reported for every scrape.
+	ScrapeHealthMetrics ScrapeHealthMetricsConfig `mapstructure:"scrape_health_metrics"`
+
 	TargetAllocator *TargetAllocator `mapstructure:"target_allocator"`
 }
 
@@ -48,6 +52,13 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// ScrapeHealthMetricsConfig configures the metrics reporting the health of the scrapes.
+type ScrapeHealthMetricsConfig struct {
+	// Enabled reports the scrape health metrics of every target with its resource, including
+	// for the failed scrapes. Enabled by default.
+	Enabled bool `mapstructure:"enabled"`
+}
+
 type TargetAllocator struct {
 	confighttp.ClientConfig `mapstructure:",squash"`
 	Interval                time.Duration         `mapstructure:"interval"`
@@ -46,6 +46,9 @@ func createDefaultConfig() component.Config {
 		PrometheusConfig: &PromConfig{
 			GlobalConfig: promconfig.DefaultGlobalConfig,
 		},
+		ScrapeHealthMetrics: ScrapeHealthMetricsConfig{
+			Enabled: true,
+		},
 	}
 }
 
@@ -24,6 +24,7 @@ type appendable struct {
 	trimSuffixes           bool
 	startTimeMetricRegex   *regexp.Regexp
 	externalLabels         labels.Labels
+	health                 *scrapeHealth
 
 	settings receiver.CreateSettings
 	obsrecv  *receiverhelper.ObsReport
@@ -39,7 +40,8 @@ func NewAppendable(
 	useCreatedMetric bool,
 	enableNativeHistograms bool,
 	externalLabels labels.Labels,
-	trimSuffixes bool) (storage.Appendable, error) {
+	trimSuffixes bool,
+	reportScrapeHealthMetrics bool) (storage.Appendable, error) {
 	var metricAdjuster MetricsAdjuster
 	if !useStartTimeMetric {
 		metricAdjuster = NewInitialPointAdjuster(set.Logger, gcInterval, useCreatedMetric)
@@ -62,9 +64,10 @@ func NewAppendable(
 		externalLabels:         externalLabels,
 		obsrecv:                obsrecv,
 		trimSuffixes:           trimSuffixes,
+		health:                 newScrapeHealth(reportScrapeHealthMetrics),
 	}, nil
 }
 
 func (o *appendable) Appender(ctx context.Context) storage.Appender {
-	return newTransaction(ctx, o.metricAdjuster, o.sink, o.externalLabels, o.settings, o.obsrecv, o.trimSuffixes, o.enableNativeHistograms)
+	return newTransaction(ctx, o.metricAdjuster, o.sink, o.externalLabels, o.settings, o.obsrecv, o.trimSuffixes, o.enableNativeHistograms, o.health)
 }
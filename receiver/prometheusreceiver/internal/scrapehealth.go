@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/prometheusreceiver/internal"
+
+import (
+	"sync"
+
+	"github.com/prometheus/prometheus/model/labels"
+)
+
+// scrapeHealth handles the metrics reported by Prometheus for every scrape of a target:
+// up, scrape_duration_seconds, scrape_samples_scraped, scrape_samples_post_metric_relabeling
+// and scrape_series_added.
+type scrapeHealth struct {
+	// enabled reports the scrape health metrics, they are dropped otherwise.
+	enabled bool
+	// targetInfos holds the labels of the last target_info metric of each target, so that the
+	// scrape health metrics of its failed scrapes have the same resource as its other metrics.
+	targetInfos sync.Map
+}
+
+type targetKey struct {
+	job      string
+	instance string
+}
+
+func newScrapeHealth(enabled bool) *scrapeHealth {
+	return &scrapeHealth{enabled: enabled}
+}
+
+func isScrapeHealthMetric(metricName string) bool {
+	_, ok := internalMetricMetadata[metricName]
+	return ok
+}
+
+// update records the target_info labels of a successful scrape, and forgets them once the
+// target is gone. It returns the labels recorded for the target when the scrape failed.
+func (h *scrapeHealth) update(key targetKey, up float64, targetInfo labels.Labels) (labels.Labels, bool) {
+	if !h.enabled {
+		return labels.EmptyLabels(), false
+	}
+	switch {
+	case up == 1 && !targetInfo.IsEmpty():
+		h.targetInfos.Store(key, targetInfo)
+	case up == 0:
+		if ls, ok := h.targetInfos.Load(key); ok {
+			return ls.(labels.Labels), true
+		}
+	case up != 1:
+		// The stale marker of up is appended when the target goes away.
+		h.targetInfos.Delete(key)
+	}
+	return labels.EmptyLabels(), false
+}
@@ -45,6 +45,12 @@ type transaction struct {
 	buildInfo              component.BuildInfo
 	metricAdjuster         MetricsAdjuster
 	obsrecv                *receiverhelper.ObsReport
+	health                 *scrapeHealth
+	target                 targetKey
+	// up is the value of the up metric of the scrape, if hasUp.
+	up         float64
+	hasUp      bool
+	targetInfo labels.Labels
 	// Used as buffer to calculate series ref hash.
 	bufBytes []byte
 }
@@ -64,7 +70,8 @@ func newTransaction(
 	settings receiver.CreateSettings,
 	obsrecv *receiverhelper.ObsReport,
 	trimSuffixes bool,
-	enableNativeHistograms bool) *transaction {
+	enableNativeHistograms bool,
+	health *scrapeHealth) *transaction {
 	return &transaction{
 		ctx:                    ctx,
 		families:               make(map[scopeID]map[string]*metricFamily),
@@ -77,6 +84,8 @@ func newTransaction(
 		logger:                 settings.Logger,
 		buildInfo:              settings.BuildInfo,
 		obsrecv:                obsrecv,
+		health:                 health,
+		targetInfo:             labels.EmptyLabels(),
 		bufBytes:               make([]byte, 0, 1024),
 		scopeAttributes:        make(map[scopeID]pcommon.Map),
 	}
@@ -132,9 +141,16 @@ func (t *transaction) Append(_ storage.SeriesRef, ls labels.Labels, atMs int64,
 				zap.Stringer("target_labels", ls))
 		}
 	}
+	if metricName == scrapeUpMetricName {
+		t.up, t.hasUp = val, true
+	}
+	if !t.health.enabled && isScrapeHealthMetric(metricName) {
+		return 0, nil
+	}
 
 	// For the `target_info` metric we need to convert it to resource attributes.
 	if metricName == prometheus.TargetInfoMetricName {
+		t.targetInfo = ls
 		t.AddTargetInfo(ls)
 		return 0, nil
 	}
@@ -370,6 +386,7 @@ func (t *transaction) initTransaction(labels labels.Labels) error {
 		return errNoJobInstance
 	}
 	t.nodeResource = CreateResource(job, instance, target.DiscoveredLabels())
+	t.target = targetKey{job: job, instance: instance}
 	t.isNew = false
 	return nil
 }
@@ -379,6 +396,13 @@ func (t *transaction) Commit() error {
 		return nil
 	}
 
+	// The metrics of a failed scrape have the resource attributes of the last target_info of the target.
+	if t.hasUp {
+		if ls, ok := t.health.update(t.target, t.up, t.targetInfo); ok {
+			t.AddTargetInfo(ls)
+		}
+	}
+
 	ctx := t.obsrecv.StartMetricsOp(t.ctx)
 	md, err := t.getMetrics(t.nodeResource)
 	if err != nil {
@@ -301,6 +301,7 @@ func (r *pReceiver) initPrometheusComponents(ctx context.Context, logger log.Log
 		enableNativeHistogramsGate.IsEnabled(),
 		r.cfg.PrometheusConfig.GlobalConfig.ExternalLabels,
 		r.cfg.TrimMetricSuffixes,
+		r.cfg.ScrapeHealthMetrics.Enabled,
 	)
 	if err != nil {
 		return err
@@ -653,6 +653,7 @@ func testComponent(t *testing.T, targets []*testData, alterConfig func(*Config),
 	config := &Config{
 		PrometheusConfig:     cfg,
 		StartTimeMetricRegex: "",
+		ScrapeHealthMetrics:  ScrapeHealthMetricsConfig{Enabled: true},
 	}
 	if alterConfig != nil {
 		alterConfig(config)
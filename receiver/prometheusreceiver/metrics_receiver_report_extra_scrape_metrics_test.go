@@ -56,6 +56,7 @@ func testScraperMetrics(t *testing.T, targets []*testData, reportExtraScrapeMetr
 		UseStartTimeMetric:       false,
 		StartTimeMetricRegex:     "",
 		ReportExtraScrapeMetrics: reportExtraScrapeMetrics,
+		ScrapeHealthMetrics:      ScrapeHealthMetricsConfig{Enabled: true},
 	}, cms)
 
 	require.NoError(t, receiver.Start(ctx, componenttest.NewNopHost()))
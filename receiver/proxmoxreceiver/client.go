@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package proxmoxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/models"
+)
+
+const (
+	// apiPath is the prefix of the paths of the JSON API
+	apiPath = "/api2/json"
+	// clusterStatusPath is the path to the cluster status endpoint
+	clusterStatusPath = apiPath + "/cluster/status"
+	// resourcesPath is the path to the cluster resources endpoint, listing the nodes and guests
+	resourcesPath = apiPath + "/cluster/resources"
+	// tasksPath is the path to the endpoint listing the recent tasks of the cluster
+	tasksPath = apiPath + "/cluster/tasks"
+)
+
+type client interface {
+	// GetClusterStatus calls "/cluster/status" endpoint to get the cluster and its nodes
+	GetClusterStatus(ctx context.Context) ([]models.ClusterStatus, error)
+	// GetResources calls "/cluster/resources" endpoint to get the nodes and guests of the cluster
+	GetResources(ctx context.Context) ([]models.Resource, error)
+	// GetTasks calls "/cluster/tasks" endpoint to get the recent tasks of the cluster
+	GetTasks(ctx context.Context) ([]models.Task, error)
+}
+
+var _ client = (*proxmoxClient)(nil)
+
+type proxmoxClient struct {
+	client        *http.Client
+	hostEndpoint  string
+	authorization string
+	logger        *zap.Logger
+}
+
+func newClient(ctx context.Context, cfg *Config, host component.Host, settings component.TelemetrySettings) (client, error) {
+	httpClient, err := cfg.ToClient(ctx, host, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP Client: %w", err)
+	}
+
+	return &proxmoxClient{
+		client:        httpClient,
+		hostEndpoint:  strings.TrimSuffix(cfg.Endpoint, "/"),
+		authorization: "PVEAPIToken=" + cfg.TokenID + "=" + string(cfg.TokenSecret),
+		logger:        settings.Logger,
+	}, nil
+}
+
+func (c *proxmoxClient) GetClusterStatus(ctx context.Context) ([]models.ClusterStatus, error) {
+	var resp models.Response[[]models.ClusterStatus]
+	if err := c.get(ctx, clusterStatusPath, &resp); err != nil {
+		c.logger.Debug("Failed to retrieve cluster status", zap.Error(err))
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *proxmoxClient) GetResources(ctx context.Context) ([]models.Resource, error) {
+	var resp models.Response[[]models.Resource]
+	if err := c.get(ctx, resourcesPath, &resp); err != nil {
+		c.logger.Debug("Failed to retrieve cluster resources", zap.Error(err))
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *proxmoxClient) GetTasks(ctx context.Context) ([]models.Task, error) {
+	var resp models.Response[[]models.Task]
+	if err := c.get(ctx, tasksPath, &resp); err != nil {
+		c.logger.Debug("Failed to retrieve cluster tasks", zap.Error(err))
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (c *proxmoxClient) get(ctx context.Context, path string, respObj any) error {
+	// Construct endpoint and create request
+	url := c.hostEndpoint + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create get request for path %s: %w", path, err)
+	}
+
+	// Set API token authentication
+	req.Header.Set("Authorization", c.authorization)
+
+	// Make request
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make http request: %w", err)
+	}
+
+	// Defer body close
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Warn("failed to close response body", zap.Error(closeErr))
+		}
+	}()
+
+	// Check for OK status code
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Debug("Proxmox API non-200", zap.Int("status_code", resp.StatusCode))
+
+		// Attempt to extract the error payload
+		payloadData, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.logger.Debug("failed to read payload error message", zap.Error(err))
+		} else {
+			c.logger.Debug("Proxmox API Error", zap.ByteString("api_error", payloadData))
+		}
+
+		return fmt.Errorf("non 200 code returned %d", resp.StatusCode)
+	}
+
+	// Decode the payload into the passed in response object
+	if err := json.NewDecoder(resp.Body).Decode(respObj); err != nil {
+		return fmt.Errorf("failed to decode response payload: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package proxmoxreceiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configtls"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/models"
+)
+
+const (
+	clusterStatusAPIResponseFile    = "cluster_status.json"
+	clusterResourcesAPIResponseFile = "cluster_resources.json"
+	clusterTasksAPIResponseFile     = "cluster_tasks.json"
+
+	testTokenID     = "monitoring@pve!otel"
+	testTokenSecret = "01234567-89ab-cdef-0123-456789abcdef"
+)
+
+func TestNewClient(t *testing.T) {
+	cfg := &Config{
+		ClientConfig: confighttp.ClientConfig{
+			Endpoint: defaultEndpoint,
+			TLSSetting: configtls.ClientConfig{
+				Config: configtls.Config{
+					CAFile: "/non/existent",
+				},
+			},
+		},
+	}
+	ac, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.Nil(t, ac)
+	require.ErrorContains(t, err, "failed to create HTTP Client")
+
+	cfg = &Config{
+		ClientConfig: confighttp.ClientConfig{Endpoint: defaultEndpoint + "/"},
+		TokenID:      testTokenID,
+		TokenSecret:  testTokenSecret,
+	}
+	ac, err = newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	actualClient, ok := ac.(*proxmoxClient)
+	require.True(t, ok)
+	require.Equal(t, defaultEndpoint, actualClient.hostEndpoint)
+	require.Equal(t, "PVEAPIToken=monitoring@pve!otel=01234567-89ab-cdef-0123-456789abcdef", actualClient.authorization)
+	require.NotNil(t, actualClient.client)
+}
+
+func TestGetClusterStatus(t *testing.T) {
+	tc := createTestClient(t, newMockServer(t, map[string]string{clusterStatusPath: clusterStatusAPIResponseFile}).URL)
+
+	status, err := tc.GetClusterStatus(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []models.ClusterStatus{
+		{Type: "cluster", Name: "homelab"},
+		{Type: "node", Name: "pve1", Online: 1},
+		{Type: "node", Name: "pve2"},
+	}, status)
+}
+
+func TestGetResources(t *testing.T) {
+	tc := createTestClient(t, newMockServer(t, map[string]string{resourcesPath: clusterResourcesAPIResponseFile}).URL)
+
+	resources, err := tc.GetResources(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resources, 7)
+	require.Equal(t, models.Resource{
+		ID: "qemu/100", Type: "qemu", Node: "pve1", VMID: 100, Name: "web", Status: "running",
+		Uptime: 3600, CPU: 0.25, MaxCPU: 4, Mem: 2147483648, MaxMem: 4294967296, MaxDisk: 34359738368,
+		NetIn: 1048576, NetOut: 2097152, DiskRead: 524288000, DiskWrite: 104857600,
+	}, resources[2])
+}
+
+func TestGetTasks(t *testing.T) {
+	tc := createTestClient(t, newMockServer(t, map[string]string{tasksPath: clusterTasksAPIResponseFile}).URL)
+
+	tasks, err := tc.GetTasks(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tasks, 4)
+	require.Equal(t, models.Task{
+		UPID:      "UPID:pve1:00001235:0000ABCE:664E0000:vzdump:100:root@pam:",
+		Node:      "pve1",
+		Type:      "vzdump",
+		ID:        "100",
+		Status:    "job errors",
+		StartTime: 1716432000,
+		EndTime:   1716432300,
+	}, tasks[1])
+}
+
+func TestGetErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == resourcesPath {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("{invalid"))
+	}))
+	t.Cleanup(ts.Close)
+	tc := createTestClient(t, ts.URL)
+
+	_, err := tc.GetResources(context.Background())
+	require.EqualError(t, err, "non 200 code returned 401")
+	_, err = tc.GetTasks(context.Background())
+	require.ErrorContains(t, err, "failed to decode response payload")
+}
+
+// newMockServer serves the API responses of testdata for the given paths, checking the requests are
+// authenticated with the test API token.
+func newMockServer(t *testing.T, responses map[string]string) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "PVEAPIToken="+testTokenID+"="+testTokenSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		file, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", "apiresponses", file))
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func createTestClient(t *testing.T, endpoint string) client {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = endpoint
+	cfg.TokenID = testTokenID
+	cfg.TokenSecret = testTokenSecret
+	tc, err := newClient(context.Background(), cfg, componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	return tc
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package proxmoxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/metadata"
+)
+
+// Predefined error responses for configuration validation failures
+var (
+	errMissingTokenID     = errors.New(`"token_id" not specified in config`)
+	errInvalidTokenID     = errors.New(`"token_id" must be in the form of <user>@<realm>!<token name>`)
+	errMissingTokenSecret = errors.New(`"token_secret" not specified in config`)
+
+	errInvalidEndpoint = errors.New(`"endpoint" must be in the form of <scheme>://<hostname>:<port>`)
+)
+
+const defaultEndpoint = "https://localhost:8006"
+
+// Config defines the configuration for the various elements of the receiver agent.
+type Config struct {
+	scraperhelper.ControllerConfig `mapstructure:",squash"`
+	confighttp.ClientConfig        `mapstructure:",squash"`
+	// TokenID is the ID of the API token, such as monitoring@pve!otel. The token needs the PVEAuditor role.
+	TokenID                       string              `mapstructure:"token_id"`
+	TokenSecret                   configopaque.String `mapstructure:"token_secret"`
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+}
+
+// Validate validates the configuration by checking for missing or invalid fields
+func (cfg *Config) Validate() error {
+	var err []error
+	switch {
+	case cfg.TokenID == "":
+		err = append(err, errMissingTokenID)
+	case !strings.Contains(cfg.TokenID, "@") || !strings.Contains(cfg.TokenID, "!"):
+		err = append(err, errInvalidTokenID)
+	}
+
+	if cfg.TokenSecret == "" {
+		err = append(err, errMissingTokenSecret)
+	}
+
+	u, parseErr := url.Parse(cfg.Endpoint)
+	switch {
+	case parseErr != nil:
+		err = append(err, fmt.Errorf("%s: %w", errInvalidEndpoint.Error(), parseErr))
+	case u.Scheme != "http" && u.Scheme != "https", u.Host == "":
+		err = append(err, errInvalidEndpoint)
+	}
+
+	return errors.Join(err...)
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package proxmoxreceiver
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/metadata"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         *Config
+		expectedErr error
+	}{
+		{
+			desc: "missing token id, token secret and unsupported scheme",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "ftp://pve.example.com:8006",
+				},
+			},
+			expectedErr: errors.Join(
+				errMissingTokenID,
+				errMissingTokenSecret,
+				errInvalidEndpoint,
+			),
+		},
+		{
+			desc: "invalid token id",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: defaultEndpoint,
+				},
+				TokenID:     "monitoring",
+				TokenSecret: "secret",
+			},
+			expectedErr: errInvalidTokenID,
+		},
+		{
+			desc: "endpoint without scheme",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: "localhost:8006",
+				},
+				TokenID:     "monitoring@pve!otel",
+				TokenSecret: "secret",
+			},
+			expectedErr: errInvalidEndpoint,
+		},
+		{
+			desc: "no error",
+			cfg: &Config{
+				ClientConfig: confighttp.ClientConfig{
+					Endpoint: defaultEndpoint,
+				},
+				TokenID:     "monitoring@pve!otel",
+				TokenSecret: "secret",
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			actualErr := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, actualErr, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewID(metadata.Type).String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	expected := factory.CreateDefaultConfig().(*Config)
+	expected.Endpoint = "https://pve.example.com:8006"
+	expected.CollectionInterval = time.Minute
+	expected.TokenID = "monitoring@pve!otel"
+	expected.TokenSecret = "01234567-89ab-cdef-0123-456789abcdef"
+	expected.TLSSetting.InsecureSkipVerify = true
+	require.Equal(t, expected, cfg)
+
+	cfg = factory.CreateDefaultConfig()
+	sub, err = cm.Sub(component.NewIDWithName(metadata.Type, "invalid").String())
+	require.NoError(t, err)
+	require.NoError(t, component.UnmarshalConfig(sub, cfg))
+	require.EqualError(t, component.ValidateConfig(cfg), errors.Join(errInvalidTokenID, errMissingTokenSecret, errInvalidEndpoint).Error())
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package proxmoxreceiver scrapes the Proxmox VE API for the metrics of the nodes, virtual machines
+// and containers of a cluster.
+package proxmoxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver"
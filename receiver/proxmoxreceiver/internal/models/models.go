@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/models"
+
+// Response is the envelope of the responses of the Proxmox VE API.
+type Response[T any] struct {
+	Data T `json:"data"`
+}
+
+// ClusterStatus is an entry of the /cluster/status endpoint, describing either the cluster or one of
+// its nodes.
+type ClusterStatus struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Online int    `json:"online"`
+}
+
+// Resource is an entry of the /cluster/resources endpoint.
+type Resource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Node string `json:"node"`
+	// The following fields are only set for guests
+	VMID     int64  `json:"vmid"`
+	Name     string `json:"name"`
+	Template int    `json:"template"`
+
+	Status    string  `json:"status"`
+	Uptime    int64   `json:"uptime"`
+	CPU       float64 `json:"cpu"`
+	MaxCPU    float64 `json:"maxcpu"`
+	Mem       int64   `json:"mem"`
+	MaxMem    int64   `json:"maxmem"`
+	Disk      int64   `json:"disk"`
+	MaxDisk   int64   `json:"maxdisk"`
+	NetIn     int64   `json:"netin"`
+	NetOut    int64   `json:"netout"`
+	DiskRead  int64   `json:"diskread"`
+	DiskWrite int64   `json:"diskwrite"`
+}
+
+// Task is an entry of the /cluster/tasks endpoint, listing the recent tasks of the cluster.
+type Task struct {
+	UPID string `json:"upid"`
+	Node string `json:"node"`
+	Type string `json:"type"`
+	// ID is the ID of the object of the task, such as the VMID of the guest backed up.
+	ID string `json:"id"`
+	// Status is "OK" for the tasks which succeeded, and the error for the others. It is empty while the
+	// task is running.
+	Status    string `json:"status"`
+	StartTime int64  `json:"starttime"`
+	EndTime   int64  `json:"endtime"`
+}
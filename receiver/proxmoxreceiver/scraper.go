@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package proxmoxreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver"
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/proxmoxreceiver/internal/models"
+)
+
+var errClientNotInit = errors.New("client not initialized")
+
+// Types of the entries of the cluster endpoints
+const (
+	clusterType = "cluster"
+	nodeType    = "node"
+	qemuType    = "qemu"
+	lxcType     = "lxc"
+	backupType  = "vzdump"
+)
+
+const (
+	nodeOnline   = "online"
+	guestRunning = "running"
+	taskOK       = "OK"
+)
+
+// proxmoxScraper handles scraping of Proxmox VE metrics
+type proxmoxScraper struct {
+	client   client
+	logger   *zap.Logger
+	cfg      *Config
+	settings component.TelemetrySettings
+	mb       *metadata.MetricsBuilder
+}
+
+// newScraper creates a new scraper
+func newScraper(cfg *Config, settings receiver.CreateSettings) *proxmoxScraper {
+	return &proxmoxScraper{
+		logger:   settings.Logger,
+		cfg:      cfg,
+		settings: settings.TelemetrySettings,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+	}
+}
+
+// start starts the scraper by creating a new HTTP Client on the scraper
+func (p *proxmoxScraper) start(ctx context.Context, host component.Host) (err error) {
+	p.client, err = newClient(ctx, p.cfg, host, p.settings)
+	return
+}
+
+// scrape collects metrics from the Proxmox VE API
+func (p *proxmoxScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	// Validate we don't attempt to scrape without initializing the client
+	if p.client == nil {
+		return pmetric.NewMetrics(), errClientNotInit
+	}
+
+	resources, err := p.client.GetResources(ctx)
+	if err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	var errs scrapererror.ScrapeErrors
+	// the resources are still reported without the cluster name when it can't be retrieved
+	clusterName, err := p.clusterName(ctx)
+	if err != nil {
+		errs.AddPartial(0, err)
+	}
+	backups, err := p.lastBackups(ctx)
+	if err != nil {
+		errs.AddPartial(len(backups), err)
+	}
+
+	for _, resource := range resources {
+		switch resource.Type {
+		case nodeType:
+			p.collectNode(resource, backups[resource.Node], clusterName, now)
+		case qemuType, lxcType:
+			// the templates can't be started
+			if resource.Template == 0 {
+				p.collectGuest(resource, clusterName, now)
+			}
+		}
+	}
+
+	return p.mb.Emit(), errs.Combine()
+}
+
+// clusterName returns the name of the cluster, or an empty string for a standalone node
+func (p *proxmoxScraper) clusterName(ctx context.Context) (string, error) {
+	status, err := p.client.GetClusterStatus(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, entry := range status {
+		if entry.Type == clusterType {
+			return entry.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// lastBackups returns the last finished backup task of each node
+func (p *proxmoxScraper) lastBackups(ctx context.Context) (map[string]models.Task, error) {
+	backups := map[string]models.Task{}
+	metrics := p.cfg.Metrics
+	if !metrics.ProxmoxNodeBackupStatus.Enabled && !metrics.ProxmoxNodeBackupDuration.Enabled && !metrics.ProxmoxNodeBackupAge.Enabled {
+		return backups, nil
+	}
+
+	tasks, err := p.client.GetTasks(ctx)
+	if err != nil {
+		return backups, err
+	}
+	for _, task := range tasks {
+		// the running tasks have no status yet
+		if task.Type != backupType || task.Status == "" {
+			continue
+		}
+		if last, ok := backups[task.Node]; !ok || task.EndTime > last.EndTime {
+			backups[task.Node] = task
+		}
+	}
+	return backups, nil
+}
+
+// collectNode collects the metrics of a node
+func (p *proxmoxScraper) collectNode(node models.Resource, backup models.Task, clusterName string, now pcommon.Timestamp) {
+	p.mb.RecordProxmoxNodeUpDataPoint(now, boolToInt64(node.Status == nodeOnline))
+	// the usage of the offline nodes isn't known
+	if node.Status == nodeOnline {
+		p.mb.RecordProxmoxNodeUptimeDataPoint(now, node.Uptime)
+		p.mb.RecordProxmoxNodeCPUUtilizationDataPoint(now, node.CPU)
+		p.mb.RecordProxmoxNodeCPUCountDataPoint(now, int64(node.MaxCPU))
+		p.mb.RecordProxmoxNodeMemoryUsageDataPoint(now, node.Mem)
+		p.mb.RecordProxmoxNodeMemoryLimitDataPoint(now, node.MaxMem)
+		p.mb.RecordProxmoxNodeDiskUsageDataPoint(now, node.Disk)
+		p.mb.RecordProxmoxNodeDiskLimitDataPoint(now, node.MaxDisk)
+	}
+
+	if backup.UPID != "" {
+		p.mb.RecordProxmoxNodeBackupStatusDataPoint(now, boolToInt64(backup.Status == taskOK))
+		p.mb.RecordProxmoxNodeBackupDurationDataPoint(now, backup.EndTime-backup.StartTime)
+		age := now.AsTime().Sub(time.Unix(backup.EndTime, 0))
+		p.mb.RecordProxmoxNodeBackupAgeDataPoint(now, max(int64(age.Seconds()), 0))
+	}
+
+	rb := p.mb.NewResourceBuilder()
+	if clusterName != "" {
+		rb.SetProxmoxClusterName(clusterName)
+	}
+	rb.SetProxmoxNodeName(node.Node)
+	p.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// collectGuest collects the metrics of a virtual machine or container
+func (p *proxmoxScraper) collectGuest(guest models.Resource, clusterName string, now pcommon.Timestamp) {
+	running := guest.Status == guestRunning
+	p.mb.RecordProxmoxGuestUpDataPoint(now, boolToInt64(running))
+	p.mb.RecordProxmoxGuestCPUCountDataPoint(now, int64(guest.MaxCPU))
+	p.mb.RecordProxmoxGuestMemoryLimitDataPoint(now, guest.MaxMem)
+	p.mb.RecordProxmoxGuestDiskLimitDataPoint(now, guest.MaxDisk)
+	if running {
+		p.mb.RecordProxmoxGuestUptimeDataPoint(now, guest.Uptime)
+		p.mb.RecordProxmoxGuestCPUUtilizationDataPoint(now, guest.CPU)
+		p.mb.RecordProxmoxGuestMemoryUsageDataPoint(now, guest.Mem)
+		p.mb.RecordProxmoxGuestDiskIoDataPoint(now, guest.DiskRead, metadata.AttributeDiskDirectionRead)
+		p.mb.RecordProxmoxGuestDiskIoDataPoint(now, guest.DiskWrite, metadata.AttributeDiskDirectionWrite)
+		p.mb.RecordProxmoxGuestNetworkIoDataPoint(now, guest.NetIn, metadata.AttributeNetworkDirectionReceive)
+		p.mb.RecordProxmoxGuestNetworkIoDataPoint(now, guest.NetOut, metadata.AttributeNetworkDirectionTransmit)
+		// the disk usage of the virtual machines is only known with the guest agent
+		if guest.Type == lxcType || guest.Disk > 0 {
+			p.mb.RecordProxmoxGuestDiskUsageDataPoint(now, guest.Disk)
+		}
+	}
+
+	rb := p.mb.NewResourceBuilder()
+	if clusterName != "" {
+		rb.SetProxmoxClusterName(clusterName)
+	}
+	rb.SetProxmoxNodeName(guest.Node)
+	rb.SetProxmoxGuestID(guest.VMID)
+	rb.SetProxmoxGuestName(guest.Name)
+	rb.SetProxmoxGuestType(guest.Type)
+	p.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package proxmoxreceiver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+var allResponses = map[string]string{
+	clusterStatusPath: clusterStatusAPIResponseFile,
+	resourcesPath:     clusterResourcesAPIResponseFile,
+	tasksPath:         clusterTasksAPIResponseFile,
+}
+
+func newTestScraper(t *testing.T, cfg *Config, responses map[string]string) *proxmoxScraper {
+	cfg.Endpoint = newMockServer(t, responses).URL
+	cfg.TokenID = testTokenID
+	cfg.TokenSecret = testTokenSecret
+	scraper := newScraper(cfg, receivertest.NewNopCreateSettings())
+	require.NoError(t, scraper.start(context.Background(), componenttest.NewNopHost()))
+	return scraper
+}
+
+func TestScraperScrape(t *testing.T) {
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), allResponses)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	goldenPath := filepath.Join("testdata", "expected_metrics", "metrics_golden.yaml")
+	expectedMetrics, err := golden.ReadMetrics(goldenPath)
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, actualMetrics,
+		pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+		// the age of the last backup depends on the time of the scrape
+		pmetrictest.IgnoreMetricValues("proxmox.node.backup.age"),
+	))
+}
+
+func TestScraperScrapeNilClient(t *testing.T) {
+	scraper := newScraper(createDefaultConfig().(*Config), receivertest.NewNopCreateSettings())
+
+	_, err := scraper.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInit)
+}
+
+func TestScraperScrapeResourcesFailure(t *testing.T) {
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), map[string]string{})
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "non 200 code returned 404")
+	require.Zero(t, actualMetrics.DataPointCount())
+}
+
+func TestScraperScrapePartialFailure(t *testing.T) {
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), map[string]string{
+		resourcesPath: clusterResourcesAPIResponseFile,
+	})
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+
+	// the resources are reported without the cluster name and the backups
+	require.Equal(t, 5, actualMetrics.ResourceMetrics().Len())
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		rm := actualMetrics.ResourceMetrics().At(i)
+		_, ok := rm.Resource().Attributes().Get("proxmox.cluster.name")
+		require.False(t, ok)
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			require.NotContains(t, metrics.At(j).Name(), "backup")
+		}
+	}
+}
+
+func TestScraperScrapeBackupMetricsDisabled(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.ProxmoxNodeBackupStatus.Enabled = false
+	cfg.Metrics.ProxmoxNodeBackupDuration.Enabled = false
+	cfg.Metrics.ProxmoxNodeBackupAge.Enabled = false
+	// the tasks aren't requested
+	scraper := newTestScraper(t, cfg, map[string]string{
+		clusterStatusPath: clusterStatusAPIResponseFile,
+		resourcesPath:     clusterResourcesAPIResponseFile,
+	})
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 5, actualMetrics.ResourceMetrics().Len())
+}
+
+func TestScraperScrapeBackupAge(t *testing.T) {
+	scraper := newTestScraper(t, createDefaultConfig().(*Config), allResponses)
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+	var found bool
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		metrics := actualMetrics.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			m := metrics.At(j)
+			if m.Name() != "proxmox.node.backup.age" {
+				continue
+			}
+			found = true
+			dp := m.Gauge().DataPoints().At(0)
+			require.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+			// the last backup of the fixture ended at 1716519000
+			require.Equal(t, dp.Timestamp().AsTime().Unix()-1716519000, dp.IntValue())
+		}
+	}
+	require.True(t, found)
+}
@@ -6,6 +6,7 @@ package rabbitmqreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,12 +17,25 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
 )
 
-// queuePath is the path to queues endpoint
-const queuePath = "/api/queues"
+const (
+	// queuePath is the path to queues endpoint
+	queuePath = "/api/queues"
+	// shovelsPath is the path to shovels endpoint, provided by the rabbitmq_shovel_management plugin
+	shovelsPath = "/api/shovels"
+	// federationLinksPath is the path to federation links endpoint, provided by the rabbitmq_federation_management plugin
+	federationLinksPath = "/api/federation-links"
+)
+
+// errNotFound is returned when the requested endpoint doesn't exist, e.g. because the plugin providing it is not enabled.
+var errNotFound = errors.New("endpoint not found")
 
 type client interface {
 	// GetQueues calls "/api/queues" endpoint to get list of queues for the target node
 	GetQueues(ctx context.Context) ([]*models.Queue, error)
+	// GetShovels calls "/api/shovels" endpoint to get list of shovels
+	GetShovels(ctx context.Context) ([]*models.Shovel, error)
+	// GetFederationLinks calls "/api/federation-links" endpoint to get list of federation links
+	GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error)
 }
 
 var _ client = (*rabbitmqClient)(nil)
@@ -66,6 +80,28 @@ func (c *rabbitmqClient) GetQueues(ctx context.Context) ([]*models.Queue, error)
 	return queues, nil
 }
 
+func (c *rabbitmqClient) GetShovels(ctx context.Context) ([]*models.Shovel, error) {
+	var shovels []*models.Shovel
+
+	if err := c.get(ctx, shovelsPath, &shovels); err != nil {
+		c.logger.Debug("Failed to retrieve shovels", zap.Error(err))
+		return nil, err
+	}
+
+	return shovels, nil
+}
+
+func (c *rabbitmqClient) GetFederationLinks(ctx context.Context) ([]*models.FederationLink, error) {
+	var links []*models.FederationLink
+
+	if err := c.get(ctx, federationLinksPath, &links); err != nil {
+		c.logger.Debug("Failed to retrieve federation links", zap.Error(err))
+		return nil, err
+	}
+
+	return links, nil
+}
+
 func (c *rabbitmqClient) get(ctx context.Context, path string, respObj any) error {
 	// Construct endpoint and create request
 	url := c.hostEndpoint + path
@@ -90,6 +126,10 @@ func (c *rabbitmqClient) get(ctx context.Context, path string, respObj any) erro
 		}
 	}()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", errNotFound, path)
+	}
+
 	// Check for OK status code
 	if resp.StatusCode != http.StatusOK {
 		c.logger.Debug("rabbitMQ API non-200", zap.Error(err), zap.Int("status_code", resp.StatusCode))
@@ -24,7 +24,9 @@ import (
 )
 
 const (
-	queuesAPIResponseFile = "get_queues_response.json"
+	queuesAPIResponseFile          = "get_queues_response.json"
+	shovelsAPIResponseFile         = "get_shovels_response.json"
+	federationLinksAPIResponseFile = "get_federation_links_response.json"
 )
 
 func TestNewClient(t *testing.T) {
@@ -159,6 +161,106 @@ func TestGetQueuesDetails(t *testing.T) {
 	}
 }
 
+func TestGetShovels(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Plugin not enabled",
+			testFunc: func(t *testing.T) {
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				shovels, err := tc.GetShovels(context.Background())
+				require.Nil(t, shovels)
+				require.ErrorIs(t, err, errNotFound)
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, shovelsAPIResponseFile)
+
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, shovelsPath, r.URL.Path)
+					_, err := w.Write(data)
+					require.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				var expected []*models.Shovel
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				shovels, err := tc.GetShovels(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, shovels)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestGetFederationLinks(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "Plugin not enabled",
+			testFunc: func(t *testing.T) {
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				links, err := tc.GetFederationLinks(context.Background())
+				require.Nil(t, links)
+				require.ErrorIs(t, err, errNotFound)
+			},
+		},
+		{
+			desc: "Successful call",
+			testFunc: func(t *testing.T) {
+				data := loadAPIResponseData(t, federationLinksAPIResponseFile)
+
+				ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, federationLinksPath, r.URL.Path)
+					_, err := w.Write(data)
+					require.NoError(t, err)
+				}))
+				defer ts.Close()
+
+				tc := createTestClient(t, ts.URL)
+
+				var expected []*models.FederationLink
+				err := json.Unmarshal(data, &expected)
+				require.NoError(t, err)
+
+				links, err := tc.GetFederationLinks(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, expected, links)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
 func createTestClient(t *testing.T, baseEndpoint string) client {
 	t.Helper()
 	cfg := createDefaultConfig().(*Config)
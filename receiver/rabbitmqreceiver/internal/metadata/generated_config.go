@@ -29,11 +29,16 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 // MetricsConfig provides config for rabbitmq metrics.
 type MetricsConfig struct {
 	RabbitmqConsumerCount       MetricConfig `mapstructure:"rabbitmq.consumer.count"`
+	RabbitmqConsumerUtilization MetricConfig `mapstructure:"rabbitmq.consumer.utilization"`
+	RabbitmqFederationLinkState MetricConfig `mapstructure:"rabbitmq.federation.link.state"`
 	RabbitmqMessageAcknowledged MetricConfig `mapstructure:"rabbitmq.message.acknowledged"`
 	RabbitmqMessageCurrent      MetricConfig `mapstructure:"rabbitmq.message.current"`
 	RabbitmqMessageDelivered    MetricConfig `mapstructure:"rabbitmq.message.delivered"`
 	RabbitmqMessageDropped      MetricConfig `mapstructure:"rabbitmq.message.dropped"`
+	RabbitmqMessageHeadAge      MetricConfig `mapstructure:"rabbitmq.message.head_age"`
 	RabbitmqMessagePublished    MetricConfig `mapstructure:"rabbitmq.message.published"`
+	RabbitmqMessageReturned     MetricConfig `mapstructure:"rabbitmq.message.returned"`
+	RabbitmqShovelState         MetricConfig `mapstructure:"rabbitmq.shovel.state"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -41,6 +46,12 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqConsumerCount: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqConsumerUtilization: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqFederationLinkState: MetricConfig{
+			Enabled: false,
+		},
 		RabbitmqMessageAcknowledged: MetricConfig{
 			Enabled: true,
 		},
@@ -53,9 +64,18 @@ func DefaultMetricsConfig() MetricsConfig {
 		RabbitmqMessageDropped: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqMessageHeadAge: MetricConfig{
+			Enabled: false,
+		},
 		RabbitmqMessagePublished: MetricConfig{
 			Enabled: true,
 		},
+		RabbitmqMessageReturned: MetricConfig{
+			Enabled: false,
+		},
+		RabbitmqShovelState: MetricConfig{
+			Enabled: false,
+		},
 	}
 }
 
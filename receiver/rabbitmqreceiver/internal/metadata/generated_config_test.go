@@ -26,11 +26,16 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					RabbitmqConsumerCount:       MetricConfig{Enabled: true},
+					RabbitmqConsumerUtilization: MetricConfig{Enabled: true},
+					RabbitmqFederationLinkState: MetricConfig{Enabled: true},
 					RabbitmqMessageAcknowledged: MetricConfig{Enabled: true},
 					RabbitmqMessageCurrent:      MetricConfig{Enabled: true},
 					RabbitmqMessageDelivered:    MetricConfig{Enabled: true},
 					RabbitmqMessageDropped:      MetricConfig{Enabled: true},
+					RabbitmqMessageHeadAge:      MetricConfig{Enabled: true},
 					RabbitmqMessagePublished:    MetricConfig{Enabled: true},
+					RabbitmqMessageReturned:     MetricConfig{Enabled: true},
+					RabbitmqShovelState:         MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: true},
@@ -44,11 +49,16 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
 					RabbitmqConsumerCount:       MetricConfig{Enabled: false},
+					RabbitmqConsumerUtilization: MetricConfig{Enabled: false},
+					RabbitmqFederationLinkState: MetricConfig{Enabled: false},
 					RabbitmqMessageAcknowledged: MetricConfig{Enabled: false},
 					RabbitmqMessageCurrent:      MetricConfig{Enabled: false},
 					RabbitmqMessageDelivered:    MetricConfig{Enabled: false},
 					RabbitmqMessageDropped:      MetricConfig{Enabled: false},
+					RabbitmqMessageHeadAge:      MetricConfig{Enabled: false},
 					RabbitmqMessagePublished:    MetricConfig{Enabled: false},
+					RabbitmqMessageReturned:     MetricConfig{Enabled: false},
+					RabbitmqShovelState:         MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					RabbitmqNodeName:  ResourceAttributeConfig{Enabled: false},
@@ -12,6 +12,66 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 )
 
+// AttributeFederationLinkState specifies the a value federation.link.state attribute.
+type AttributeFederationLinkState int
+
+const (
+	_ AttributeFederationLinkState = iota
+	AttributeFederationLinkStateStarting
+	AttributeFederationLinkStateRunning
+	AttributeFederationLinkStateShutdown
+	AttributeFederationLinkStateError
+)
+
+// String returns the string representation of the AttributeFederationLinkState.
+func (av AttributeFederationLinkState) String() string {
+	switch av {
+	case AttributeFederationLinkStateStarting:
+		return "starting"
+	case AttributeFederationLinkStateRunning:
+		return "running"
+	case AttributeFederationLinkStateShutdown:
+		return "shutdown"
+	case AttributeFederationLinkStateError:
+		return "error"
+	}
+	return ""
+}
+
+// MapAttributeFederationLinkState is a helper map of string to AttributeFederationLinkState attribute value.
+var MapAttributeFederationLinkState = map[string]AttributeFederationLinkState{
+	"starting": AttributeFederationLinkStateStarting,
+	"running":  AttributeFederationLinkStateRunning,
+	"shutdown": AttributeFederationLinkStateShutdown,
+	"error":    AttributeFederationLinkStateError,
+}
+
+// AttributeFederationType specifies the a value federation.type attribute.
+type AttributeFederationType int
+
+const (
+	_ AttributeFederationType = iota
+	AttributeFederationTypeExchange
+	AttributeFederationTypeQueue
+)
+
+// String returns the string representation of the AttributeFederationType.
+func (av AttributeFederationType) String() string {
+	switch av {
+	case AttributeFederationTypeExchange:
+		return "exchange"
+	case AttributeFederationTypeQueue:
+		return "queue"
+	}
+	return ""
+}
+
+// MapAttributeFederationType is a helper map of string to AttributeFederationType attribute value.
+var MapAttributeFederationType = map[string]AttributeFederationType{
+	"exchange": AttributeFederationTypeExchange,
+	"queue":    AttributeFederationTypeQueue,
+}
+
 // AttributeMessageState specifies the a value message.state attribute.
 type AttributeMessageState int
 
@@ -38,6 +98,36 @@ var MapAttributeMessageState = map[string]AttributeMessageState{
 	"unacknowledged": AttributeMessageStateUnacknowledged,
 }
 
+// AttributeShovelState specifies the a value shovel.state attribute.
+type AttributeShovelState int
+
+const (
+	_ AttributeShovelState = iota
+	AttributeShovelStateStarting
+	AttributeShovelStateRunning
+	AttributeShovelStateTerminated
+)
+
+// String returns the string representation of the AttributeShovelState.
+func (av AttributeShovelState) String() string {
+	switch av {
+	case AttributeShovelStateStarting:
+		return "starting"
+	case AttributeShovelStateRunning:
+		return "running"
+	case AttributeShovelStateTerminated:
+		return "terminated"
+	}
+	return ""
+}
+
+// MapAttributeShovelState is a helper map of string to AttributeShovelState attribute value.
+var MapAttributeShovelState = map[string]AttributeShovelState{
+	"starting":   AttributeShovelStateStarting,
+	"running":    AttributeShovelStateRunning,
+	"terminated": AttributeShovelStateTerminated,
+}
+
 type metricRabbitmqConsumerCount struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -89,6 +179,109 @@ func newMetricRabbitmqConsumerCount(cfg MetricConfig) metricRabbitmqConsumerCoun
 	return m
 }
 
+type metricRabbitmqConsumerUtilization struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.consumer.utilization metric with initial data.
+func (m *metricRabbitmqConsumerUtilization) init() {
+	m.data.SetName("rabbitmq.consumer.utilization")
+	m.data.SetDescription("The fraction of time the consumers of the queue are able to receive new messages.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqConsumerUtilization) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqConsumerUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqConsumerUtilization) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqConsumerUtilization(cfg MetricConfig) metricRabbitmqConsumerUtilization {
+	m := metricRabbitmqConsumerUtilization{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqFederationLinkState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.federation.link.state metric with initial data.
+func (m *metricRabbitmqFederationLinkState) init() {
+	m.data.SetName("rabbitmq.federation.link.state")
+	m.data.SetDescription("The state of the federation link, 1 for the current state and 0 for the others.")
+	m.data.SetUnit("{state}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqFederationLinkState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationTypeAttributeValue string, federationResourceAttributeValue string, federationLinkStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("federation.upstream", federationUpstreamAttributeValue)
+	dp.Attributes().PutStr("federation.type", federationTypeAttributeValue)
+	dp.Attributes().PutStr("federation.resource", federationResourceAttributeValue)
+	dp.Attributes().PutStr("state", federationLinkStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqFederationLinkState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqFederationLinkState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqFederationLinkState(cfg MetricConfig) metricRabbitmqFederationLinkState {
+	m := metricRabbitmqFederationLinkState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessageAcknowledged struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -295,6 +488,55 @@ func newMetricRabbitmqMessageDropped(cfg MetricConfig) metricRabbitmqMessageDrop
 	return m
 }
 
+type metricRabbitmqMessageHeadAge struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.message.head_age metric with initial data.
+func (m *metricRabbitmqMessageHeadAge) init() {
+	m.data.SetName("rabbitmq.message.head_age")
+	m.data.SetDescription("The age of the message at the head of the queue.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricRabbitmqMessageHeadAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqMessageHeadAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqMessageHeadAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqMessageHeadAge(cfg MetricConfig) metricRabbitmqMessageHeadAge {
+	m := metricRabbitmqMessageHeadAge{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRabbitmqMessagePublished struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -346,6 +588,109 @@ func newMetricRabbitmqMessagePublished(cfg MetricConfig) metricRabbitmqMessagePu
 	return m
 }
 
+type metricRabbitmqMessageReturned struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.message.returned metric with initial data.
+func (m *metricRabbitmqMessageReturned) init() {
+	m.data.SetName("rabbitmq.message.returned")
+	m.data.SetDescription("The number of messages returned to publishers as unroutable.")
+	m.data.SetUnit("{messages}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+}
+
+func (m *metricRabbitmqMessageReturned) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqMessageReturned) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqMessageReturned) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqMessageReturned(cfg MetricConfig) metricRabbitmqMessageReturned {
+	m := metricRabbitmqMessageReturned{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRabbitmqShovelState struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills rabbitmq.shovel.state metric with initial data.
+func (m *metricRabbitmqShovelState) init() {
+	m.data.SetName("rabbitmq.shovel.state")
+	m.data.SetDescription("The state of the shovel, 1 for the current state and 0 for the others.")
+	m.data.SetUnit("{state}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRabbitmqShovelState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, shovelNameAttributeValue string, shovelStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("shovel.name", shovelNameAttributeValue)
+	dp.Attributes().PutStr("state", shovelStateAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRabbitmqShovelState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRabbitmqShovelState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRabbitmqShovelState(cfg MetricConfig) metricRabbitmqShovelState {
+	m := metricRabbitmqShovelState{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
@@ -357,11 +702,16 @@ type MetricsBuilder struct {
 	resourceAttributeIncludeFilter    map[string]filter.Filter
 	resourceAttributeExcludeFilter    map[string]filter.Filter
 	metricRabbitmqConsumerCount       metricRabbitmqConsumerCount
+	metricRabbitmqConsumerUtilization metricRabbitmqConsumerUtilization
+	metricRabbitmqFederationLinkState metricRabbitmqFederationLinkState
 	metricRabbitmqMessageAcknowledged metricRabbitmqMessageAcknowledged
 	metricRabbitmqMessageCurrent      metricRabbitmqMessageCurrent
 	metricRabbitmqMessageDelivered    metricRabbitmqMessageDelivered
 	metricRabbitmqMessageDropped      metricRabbitmqMessageDropped
+	metricRabbitmqMessageHeadAge      metricRabbitmqMessageHeadAge
 	metricRabbitmqMessagePublished    metricRabbitmqMessagePublished
+	metricRabbitmqMessageReturned     metricRabbitmqMessageReturned
+	metricRabbitmqShovelState         metricRabbitmqShovelState
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -381,11 +731,16 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricsBuffer:                     pmetric.NewMetrics(),
 		buildInfo:                         settings.BuildInfo,
 		metricRabbitmqConsumerCount:       newMetricRabbitmqConsumerCount(mbc.Metrics.RabbitmqConsumerCount),
+		metricRabbitmqConsumerUtilization: newMetricRabbitmqConsumerUtilization(mbc.Metrics.RabbitmqConsumerUtilization),
+		metricRabbitmqFederationLinkState: newMetricRabbitmqFederationLinkState(mbc.Metrics.RabbitmqFederationLinkState),
 		metricRabbitmqMessageAcknowledged: newMetricRabbitmqMessageAcknowledged(mbc.Metrics.RabbitmqMessageAcknowledged),
 		metricRabbitmqMessageCurrent:      newMetricRabbitmqMessageCurrent(mbc.Metrics.RabbitmqMessageCurrent),
 		metricRabbitmqMessageDelivered:    newMetricRabbitmqMessageDelivered(mbc.Metrics.RabbitmqMessageDelivered),
 		metricRabbitmqMessageDropped:      newMetricRabbitmqMessageDropped(mbc.Metrics.RabbitmqMessageDropped),
+		metricRabbitmqMessageHeadAge:      newMetricRabbitmqMessageHeadAge(mbc.Metrics.RabbitmqMessageHeadAge),
 		metricRabbitmqMessagePublished:    newMetricRabbitmqMessagePublished(mbc.Metrics.RabbitmqMessagePublished),
+		metricRabbitmqMessageReturned:     newMetricRabbitmqMessageReturned(mbc.Metrics.RabbitmqMessageReturned),
+		metricRabbitmqShovelState:         newMetricRabbitmqShovelState(mbc.Metrics.RabbitmqShovelState),
 		resourceAttributeIncludeFilter:    make(map[string]filter.Filter),
 		resourceAttributeExcludeFilter:    make(map[string]filter.Filter),
 	}
@@ -469,11 +824,16 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricRabbitmqConsumerCount.emit(ils.Metrics())
+	mb.metricRabbitmqConsumerUtilization.emit(ils.Metrics())
+	mb.metricRabbitmqFederationLinkState.emit(ils.Metrics())
 	mb.metricRabbitmqMessageAcknowledged.emit(ils.Metrics())
 	mb.metricRabbitmqMessageCurrent.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDelivered.emit(ils.Metrics())
 	mb.metricRabbitmqMessageDropped.emit(ils.Metrics())
+	mb.metricRabbitmqMessageHeadAge.emit(ils.Metrics())
 	mb.metricRabbitmqMessagePublished.emit(ils.Metrics())
+	mb.metricRabbitmqMessageReturned.emit(ils.Metrics())
+	mb.metricRabbitmqShovelState.emit(ils.Metrics())
 
 	for _, op := range rmo {
 		op(rm)
@@ -510,6 +870,16 @@ func (mb *MetricsBuilder) RecordRabbitmqConsumerCountDataPoint(ts pcommon.Timest
 	mb.metricRabbitmqConsumerCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqConsumerUtilizationDataPoint adds a data point to rabbitmq.consumer.utilization metric.
+func (mb *MetricsBuilder) RecordRabbitmqConsumerUtilizationDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqConsumerUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqFederationLinkStateDataPoint adds a data point to rabbitmq.federation.link.state metric.
+func (mb *MetricsBuilder) RecordRabbitmqFederationLinkStateDataPoint(ts pcommon.Timestamp, val int64, federationUpstreamAttributeValue string, federationTypeAttributeValue AttributeFederationType, federationResourceAttributeValue string, federationLinkStateAttributeValue AttributeFederationLinkState) {
+	mb.metricRabbitmqFederationLinkState.recordDataPoint(mb.startTime, ts, val, federationUpstreamAttributeValue, federationTypeAttributeValue.String(), federationResourceAttributeValue, federationLinkStateAttributeValue.String())
+}
+
 // RecordRabbitmqMessageAcknowledgedDataPoint adds a data point to rabbitmq.message.acknowledged metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessageAcknowledgedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRabbitmqMessageAcknowledged.recordDataPoint(mb.startTime, ts, val)
@@ -530,11 +900,26 @@ func (mb *MetricsBuilder) RecordRabbitmqMessageDroppedDataPoint(ts pcommon.Times
 	mb.metricRabbitmqMessageDropped.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqMessageHeadAgeDataPoint adds a data point to rabbitmq.message.head_age metric.
+func (mb *MetricsBuilder) RecordRabbitmqMessageHeadAgeDataPoint(ts pcommon.Timestamp, val float64) {
+	mb.metricRabbitmqMessageHeadAge.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRabbitmqMessagePublishedDataPoint adds a data point to rabbitmq.message.published metric.
 func (mb *MetricsBuilder) RecordRabbitmqMessagePublishedDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRabbitmqMessagePublished.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRabbitmqMessageReturnedDataPoint adds a data point to rabbitmq.message.returned metric.
+func (mb *MetricsBuilder) RecordRabbitmqMessageReturnedDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricRabbitmqMessageReturned.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRabbitmqShovelStateDataPoint adds a data point to rabbitmq.shovel.state metric.
+func (mb *MetricsBuilder) RecordRabbitmqShovelStateDataPoint(ts pcommon.Timestamp, val int64, shovelNameAttributeValue string, shovelStateAttributeValue AttributeShovelState) {
+	mb.metricRabbitmqShovelState.recordDataPoint(mb.startTime, ts, val, shovelNameAttributeValue, shovelStateAttributeValue.String())
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
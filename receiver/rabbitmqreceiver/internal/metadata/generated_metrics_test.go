@@ -72,6 +72,12 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqConsumerCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqConsumerUtilizationDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRabbitmqFederationLinkStateDataPoint(ts, 1, "federation.upstream-val", AttributeFederationTypeExchange, "federation.resource-val", AttributeFederationLinkStateStarting)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRabbitmqMessageAcknowledgedDataPoint(ts, 1)
@@ -88,10 +94,19 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRabbitmqMessageDroppedDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqMessageHeadAgeDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRabbitmqMessagePublishedDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRabbitmqMessageReturnedDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordRabbitmqShovelStateDataPoint(ts, 1, "shovel.name-val", AttributeShovelStateStarting)
+
 			rb := mb.NewResourceBuilder()
 			rb.SetRabbitmqNodeName("rabbitmq.node.name-val")
 			rb.SetRabbitmqQueueName("rabbitmq.queue.name-val")
@@ -132,6 +147,42 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.consumer.utilization":
+					assert.False(t, validatedMetrics["rabbitmq.consumer.utilization"], "Found a duplicate in the metrics slice: rabbitmq.consumer.utilization")
+					validatedMetrics["rabbitmq.consumer.utilization"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The fraction of time the consumers of the queue are able to receive new messages.", ms.At(i).Description())
+					assert.Equal(t, "1", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+				case "rabbitmq.federation.link.state":
+					assert.False(t, validatedMetrics["rabbitmq.federation.link.state"], "Found a duplicate in the metrics slice: rabbitmq.federation.link.state")
+					validatedMetrics["rabbitmq.federation.link.state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The state of the federation link, 1 for the current state and 0 for the others.", ms.At(i).Description())
+					assert.Equal(t, "{state}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("federation.upstream")
+					assert.True(t, ok)
+					assert.EqualValues(t, "federation.upstream-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("federation.type")
+					assert.True(t, ok)
+					assert.EqualValues(t, "exchange", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("federation.resource")
+					assert.True(t, ok)
+					assert.EqualValues(t, "federation.resource-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "starting", attrVal.Str())
 				case "rabbitmq.message.acknowledged":
 					assert.False(t, validatedMetrics["rabbitmq.message.acknowledged"], "Found a duplicate in the metrics slice: rabbitmq.message.acknowledged")
 					validatedMetrics["rabbitmq.message.acknowledged"] = true
@@ -191,6 +242,18 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.message.head_age":
+					assert.False(t, validatedMetrics["rabbitmq.message.head_age"], "Found a duplicate in the metrics slice: rabbitmq.message.head_age")
+					validatedMetrics["rabbitmq.message.head_age"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The age of the message at the head of the queue.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
 				case "rabbitmq.message.published":
 					assert.False(t, validatedMetrics["rabbitmq.message.published"], "Found a duplicate in the metrics slice: rabbitmq.message.published")
 					validatedMetrics["rabbitmq.message.published"] = true
@@ -205,6 +268,38 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.message.returned":
+					assert.False(t, validatedMetrics["rabbitmq.message.returned"], "Found a duplicate in the metrics slice: rabbitmq.message.returned")
+					validatedMetrics["rabbitmq.message.returned"] = true
+					assert.Equal(t, pmetric.MetricTypeSum, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Sum().DataPoints().Len())
+					assert.Equal(t, "The number of messages returned to publishers as unroutable.", ms.At(i).Description())
+					assert.Equal(t, "{messages}", ms.At(i).Unit())
+					assert.Equal(t, true, ms.At(i).Sum().IsMonotonic())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Sum().AggregationTemporality())
+					dp := ms.At(i).Sum().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "rabbitmq.shovel.state":
+					assert.False(t, validatedMetrics["rabbitmq.shovel.state"], "Found a duplicate in the metrics slice: rabbitmq.shovel.state")
+					validatedMetrics["rabbitmq.shovel.state"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The state of the shovel, 1 for the current state and 0 for the others.", ms.At(i).Description())
+					assert.Equal(t, "{state}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("shovel.name")
+					assert.True(t, ok)
+					assert.EqualValues(t, "shovel.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, "starting", attrVal.Str())
 				}
 			}
 		})
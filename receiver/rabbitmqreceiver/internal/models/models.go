@@ -15,6 +15,33 @@ type Queue struct {
 	UnacknowledgedMessages int64 `json:"messages_unacknowledged"`
 	ReadyMessages          int64 `json:"messages_ready"`
 
+	// ConsumerUtilisation is named ConsumerCapacity since RabbitMQ 3.12.
+	ConsumerUtilisation *float64 `json:"consumer_utilisation"`
+	ConsumerCapacity    *float64 `json:"consumer_capacity"`
+	// HeadMessageTimestamp is the timestamp property of the message at the head of the queue, in seconds.
+	HeadMessageTimestamp *int64 `json:"head_message_timestamp"`
+
 	// Embedded Metrics
 	MessageStats map[string]any `json:"message_stats"`
 }
+
+// Shovel represents a shovel in the API response
+type Shovel struct {
+	Name  string `json:"name"`
+	VHost string `json:"vhost"`
+	Node  string `json:"node"`
+	Type  string `json:"type"`
+	State string `json:"state"`
+}
+
+// FederationLink represents a federation link in the API response
+type FederationLink struct {
+	Upstream string `json:"upstream"`
+	VHost    string `json:"vhost"`
+	Node     string `json:"node"`
+	// Type is either exchange or queue, the name of which is in Exchange or Queue.
+	Type     string `json:"type"`
+	Exchange string `json:"exchange"`
+	Queue    string `json:"queue"`
+	Status   string `json:"status"`
+}
@@ -22,10 +22,11 @@ var errClientNotInit = errors.New("client not initialized")
 
 // Names of metrics in message_stats
 const (
-	deliverStat        = "deliver"
-	publishStat        = "publish"
-	ackStat            = "ack"
-	dropUnroutableStat = "drop_unroutable"
+	deliverStat          = "deliver"
+	publishStat          = "publish"
+	ackStat              = "ack"
+	dropUnroutableStat   = "drop_unroutable"
+	returnUnroutableStat = "return_unroutable"
 )
 
 // Metrics to gather from queue message_stats structure
@@ -34,6 +35,7 @@ var messageStatMetrics = []string{
 	publishStat,
 	ackStat,
 	dropUnroutableStat,
+	returnUnroutableStat,
 }
 
 // rabbitmqScraper handles scraping of RabbitMQ metrics
@@ -82,7 +84,78 @@ func (r *rabbitmqScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		r.collectQueue(queue, now)
 	}
 
-	return r.mb.Emit(), nil
+	var errs error
+	if r.cfg.Metrics.RabbitmqShovelState.Enabled {
+		errs = errors.Join(errs, r.collectShovels(ctx, now))
+	}
+	if r.cfg.Metrics.RabbitmqFederationLinkState.Enabled {
+		errs = errors.Join(errs, r.collectFederationLinks(ctx, now))
+	}
+
+	return r.mb.Emit(), errs
+}
+
+// collectShovels collects the state of the shovels
+func (r *rabbitmqScraper) collectShovels(ctx context.Context, now pcommon.Timestamp) error {
+	shovels, err := r.client.GetShovels(ctx)
+	if errors.Is(err, errNotFound) {
+		r.logger.Debug("shovels not available, the rabbitmq_shovel_management plugin may not be enabled")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, shovel := range shovels {
+		if _, ok := metadata.MapAttributeShovelState[shovel.State]; !ok {
+			r.logger.Debug("unknown shovel state", zap.String("Shovel", shovel.Name), zap.String("State", shovel.State))
+			continue
+		}
+		for name, state := range metadata.MapAttributeShovelState {
+			r.mb.RecordRabbitmqShovelStateDataPoint(now, boolToInt64(name == shovel.State), shovel.Name, state)
+		}
+		rb := r.mb.NewResourceBuilder()
+		rb.SetRabbitmqNodeName(shovel.Node)
+		rb.SetRabbitmqVhostName(shovel.VHost)
+		r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+	return nil
+}
+
+// collectFederationLinks collects the state of the federation links
+func (r *rabbitmqScraper) collectFederationLinks(ctx context.Context, now pcommon.Timestamp) error {
+	links, err := r.client.GetFederationLinks(ctx)
+	if errors.Is(err, errNotFound) {
+		r.logger.Debug("federation links not available, the rabbitmq_federation_management plugin may not be enabled")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		linkType, ok := metadata.MapAttributeFederationType[link.Type]
+		if !ok {
+			r.logger.Debug("unknown federation link type", zap.String("Upstream", link.Upstream), zap.String("Type", link.Type))
+			continue
+		}
+		if _, ok = metadata.MapAttributeFederationLinkState[link.Status]; !ok {
+			r.logger.Debug("unknown federation link state", zap.String("Upstream", link.Upstream), zap.String("State", link.Status))
+			continue
+		}
+		resource := link.Exchange
+		if linkType == metadata.AttributeFederationTypeQueue {
+			resource = link.Queue
+		}
+		for name, state := range metadata.MapAttributeFederationLinkState {
+			r.mb.RecordRabbitmqFederationLinkStateDataPoint(now, boolToInt64(name == link.Status), link.Upstream, linkType, resource, state)
+		}
+		rb := r.mb.NewResourceBuilder()
+		rb.SetRabbitmqNodeName(link.Node)
+		rb.SetRabbitmqVhostName(link.VHost)
+		r.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+	}
+	return nil
 }
 
 // collectQueue collects metrics
@@ -91,6 +164,17 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 	r.mb.RecordRabbitmqMessageCurrentDataPoint(now, queue.UnacknowledgedMessages, metadata.AttributeMessageStateUnacknowledged)
 	r.mb.RecordRabbitmqMessageCurrentDataPoint(now, queue.ReadyMessages, metadata.AttributeMessageStateReady)
 
+	// consumer_utilisation was renamed consumer_capacity in RabbitMQ 3.12
+	if queue.ConsumerCapacity != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *queue.ConsumerCapacity)
+	} else if queue.ConsumerUtilisation != nil {
+		r.mb.RecordRabbitmqConsumerUtilizationDataPoint(now, *queue.ConsumerUtilisation)
+	}
+	if queue.HeadMessageTimestamp != nil {
+		headAge := now.AsTime().Sub(time.Unix(*queue.HeadMessageTimestamp, 0))
+		r.mb.RecordRabbitmqMessageHeadAgeDataPoint(now, max(headAge.Seconds(), 0))
+	}
+
 	for _, messageStatMetric := range messageStatMetrics {
 		// Get metric value
 		val, ok := queue.MessageStats[messageStatMetric]
@@ -117,6 +201,8 @@ func (r *rabbitmqScraper) collectQueue(queue *models.Queue, now pcommon.Timestam
 			r.mb.RecordRabbitmqMessageAcknowledgedDataPoint(now, val64)
 		case dropUnroutableStat:
 			r.mb.RecordRabbitmqMessageDroppedDataPoint(now, val64)
+		case returnUnroutableStat:
+			r.mb.RecordRabbitmqMessageReturnedDataPoint(now, val64)
 		}
 	}
 	rb := r.mb.NewResourceBuilder()
@@ -137,3 +223,10 @@ func convertValToInt64(val any) (int64, bool) {
 
 	return int64(f64Val), true
 }
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
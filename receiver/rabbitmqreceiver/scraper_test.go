@@ -9,7 +9,9 @@ import (
 	"errors"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/mocks"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/rabbitmqreceiver/internal/models"
 )
@@ -150,3 +153,107 @@ func TestScaperScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestScraperScrapeOptionalMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqMessageReturned.Enabled = true
+	cfg.Metrics.RabbitmqConsumerUtilization.Enabled = true
+	cfg.Metrics.RabbitmqMessageHeadAge.Enabled = true
+	cfg.Metrics.RabbitmqShovelState.Enabled = true
+	cfg.Metrics.RabbitmqFederationLinkState.Enabled = true
+
+	utilisation, capacity := 0.25, 0.75
+	headTimestamp := time.Now().Add(-time.Minute).Unix()
+	queues := []*models.Queue{
+		{
+			Name:                 "orders",
+			Node:                 "rabbit@node",
+			VHost:                "dev",
+			ConsumerUtilisation:  &utilisation,
+			ConsumerCapacity:     &capacity,
+			HeadMessageTimestamp: &headTimestamp,
+			MessageStats:         map[string]any{returnUnroutableStat: float64(3)},
+		},
+	}
+
+	var shovels []*models.Shovel
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, shovelsAPIResponseFile), &shovels))
+	var links []*models.FederationLink
+	require.NoError(t, json.Unmarshal(loadAPIResponseData(t, federationLinksAPIResponseFile), &links))
+
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetQueues", mock.Anything).Return(queues, nil)
+	mockClient.On("GetShovels", mock.Anything).Return(shovels, nil)
+	mockClient.On("GetFederationLinks", mock.Anything).Return(links, nil)
+
+	scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
+	scraper.client = &mockClient
+
+	actualMetrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	// Shovels and federation links are emitted as one resource each.
+	dataPoints := map[string][]pmetric.NumberDataPoint{}
+	for i := 0; i < actualMetrics.ResourceMetrics().Len(); i++ {
+		ms := actualMetrics.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < ms.Len(); j++ {
+			m := ms.At(j)
+			var dps pmetric.NumberDataPointSlice
+			if m.Type() == pmetric.MetricTypeSum {
+				dps = m.Sum().DataPoints()
+			} else {
+				dps = m.Gauge().DataPoints()
+			}
+			for k := 0; k < dps.Len(); k++ {
+				dataPoints[m.Name()] = append(dataPoints[m.Name()], dps.At(k))
+			}
+		}
+	}
+
+	assert.Equal(t, int64(3), dataPoints["rabbitmq.message.returned"][0].IntValue())
+	// consumer_capacity takes precedence over the deprecated consumer_utilisation.
+	assert.Equal(t, 0.75, dataPoints["rabbitmq.consumer.utilization"][0].DoubleValue())
+	assert.InDelta(t, 60, dataPoints["rabbitmq.message.head_age"][0].DoubleValue(), 5)
+
+	// Each state is reported, with 1 for the current state of the shovel or link.
+	active := map[string]string{}
+	shovelStates := dataPoints["rabbitmq.shovel.state"]
+	require.Len(t, shovelStates, len(shovels)*len(metadata.MapAttributeShovelState))
+	for _, dp := range shovelStates {
+		if dp.IntValue() == 1 {
+			name, _ := dp.Attributes().Get("shovel.name")
+			state, _ := dp.Attributes().Get("state")
+			active[name.Str()] = state.Str()
+		}
+	}
+	assert.Equal(t, map[string]string{"orders-to-dc2": "running", "audit-to-archive": "starting"}, active)
+
+	active = map[string]string{}
+	linkStates := dataPoints["rabbitmq.federation.link.state"]
+	require.Len(t, linkStates, len(links)*len(metadata.MapAttributeFederationLinkState))
+	for _, dp := range linkStates {
+		if dp.IntValue() == 1 {
+			resource, _ := dp.Attributes().Get("federation.resource")
+			state, _ := dp.Attributes().Get("state")
+			active[resource.Str()] = state.Str()
+		}
+	}
+	assert.Equal(t, map[string]string{"events": "running", "orders": "error"}, active)
+}
+
+func TestScraperScrapePluginsNotEnabled(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RabbitmqShovelState.Enabled = true
+	cfg.Metrics.RabbitmqFederationLinkState.Enabled = true
+
+	mockClient := mocks.MockClient{}
+	mockClient.On("GetQueues", mock.Anything).Return([]*models.Queue{}, nil)
+	mockClient.On("GetShovels", mock.Anything).Return(nil, errNotFound)
+	mockClient.On("GetFederationLinks", mock.Anything).Return(nil, errors.New("some api error"))
+
+	scraper := newScraper(zap.NewNop(), cfg, receivertest.NewNopCreateSettings())
+	scraper.client = &mockClient
+
+	_, err := scraper.scrape(context.Background())
+	require.EqualError(t, err, "some api error")
+}
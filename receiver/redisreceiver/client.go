@@ -13,6 +13,10 @@ import (
 type client interface {
 	// retrieves a string of key/value pairs of redis metadata
 	retrieveInfo() (string, error)
+	// retrieves the latest count entries of the slow log
+	retrieveSlowLog(count int64) ([]redis.SlowLog, error)
+	// retrieves the latency spikes of every event tracked by the latency monitor
+	retrieveLatencyHistory() (map[string][]latencySample, error)
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
@@ -44,6 +48,37 @@ func (c *redisClient) retrieveInfo() (string, error) {
 	return c.client.Info(context.Background(), "all").Result()
 }
 
+// Retrieve the latest entries of the Redis SLOWLOG.
+func (c *redisClient) retrieveSlowLog(count int64) ([]redis.SlowLog, error) {
+	return c.client.SlowLogGet(context.Background(), count).Result()
+}
+
+// Retrieve the events tracked by the Redis latency monitor with LATENCY LATEST,
+// then the spikes of each of them with LATENCY HISTORY.
+func (c *redisClient) retrieveLatencyHistory() (map[string][]latencySample, error) {
+	ctx := context.Background()
+	latest, err := c.client.Do(ctx, "LATENCY", "LATEST").Slice()
+	if err != nil {
+		return nil, err
+	}
+	events, err := parseLatencyLatestEvents(latest)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(map[string][]latencySample, len(events))
+	for _, event := range events {
+		reply, err := c.client.Do(ctx, "LATENCY", "HISTORY", event).Slice()
+		if err != nil {
+			return nil, err
+		}
+		if history[event], err = parseLatencyHistory(reply); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
 // close client to release connention pool.
 func (c *redisClient) close() error {
 	return c.client.Close()
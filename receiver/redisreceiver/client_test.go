@@ -9,7 +9,9 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,6 +35,39 @@ func (fakeClient) retrieveInfo() (string, error) {
 	return readFile("info")
 }
 
+func (fakeClient) retrieveSlowLog(int64) ([]redis.SlowLog, error) {
+	// Latest entries first, like SLOWLOG GET.
+	return []redis.SlowLog{
+		{
+			ID:         12,
+			Time:       time.Unix(1718186402, 0),
+			Duration:   25 * time.Millisecond,
+			Args:       []string{"KEYS", "*"},
+			ClientAddr: "127.0.0.1:52144",
+			ClientName: "worker",
+		},
+		{
+			ID:         11,
+			Time:       time.Unix(1718186401, 0),
+			Duration:   12500 * time.Microsecond,
+			Args:       []string{"HGETALL", "user:1"},
+			ClientAddr: "127.0.0.1:52140",
+		},
+	}, nil
+}
+
+func (fakeClient) retrieveLatencyHistory() (map[string][]latencySample, error) {
+	return map[string][]latencySample{
+		"command": {
+			{time: time.Unix(1718186400, 0), latency: 251 * time.Millisecond},
+			{time: time.Unix(1718186410, 0), latency: 1020 * time.Millisecond},
+		},
+		"fast-command": {
+			{time: time.Unix(1718186405, 0), latency: 120 * time.Millisecond},
+		},
+	}, nil
+}
+
 func (fakeClient) close() error {
 	return nil
 }
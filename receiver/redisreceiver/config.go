@@ -4,8 +4,10 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configopaque"
@@ -36,6 +38,34 @@ type Config struct {
 	TLS configtls.ClientConfig `mapstructure:"tls,omitempty"`
 
 	MetricsBuilderConfig metadata.MetricsBuilderConfig `mapstructure:",squash"`
+
+	// SlowLog configures the collection of the SLOWLOG entries as logs.
+	SlowLog SlowLogConfig `mapstructure:"slowlog"`
+}
+
+// SlowLogConfig defines the collection of the SLOWLOG entries by the logs receiver.
+type SlowLogConfig struct {
+	// Enabled enables the collection of the SLOWLOG entries.
+	Enabled bool `mapstructure:"enabled"`
+	// CollectionInterval is the interval at which the SLOWLOG is read.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// MaxEntries is the number of latest entries read at every collection. Entries logged
+	// between two collections beyond this number are missed.
+	MaxEntries int64 `mapstructure:"max_entries"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	if !cfg.SlowLog.Enabled {
+		return nil
+	}
+	if cfg.SlowLog.CollectionInterval <= 0 {
+		return errors.New("slowlog::collection_interval must be positive")
+	}
+	if cfg.SlowLog.MaxEntries <= 0 {
+		return errors.New("slowlog::max_entries must be positive")
+	}
+	return nil
 }
 
 // configInfo holds configuration information to be used as resource/metrics attributes.
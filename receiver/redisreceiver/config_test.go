@@ -45,7 +45,51 @@ func TestConfig(t *testing.T) {
 				InitialDelay:       time.Second,
 			},
 			MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+			SlowLog: SlowLogConfig{
+				Enabled:            true,
+				CollectionInterval: 30 * time.Second,
+				MaxEntries:         64,
+			},
 		},
 		cfg,
 	)
 }
+
+func TestValidateSlowLog(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		cfg         SlowLogConfig
+		expectedErr string
+	}{
+		{
+			desc: "disabled",
+			cfg:  SlowLogConfig{},
+		},
+		{
+			desc: "valid",
+			cfg:  SlowLogConfig{Enabled: true, CollectionInterval: time.Second, MaxEntries: 10},
+		},
+		{
+			desc:        "invalid collection interval",
+			cfg:         SlowLogConfig{Enabled: true, MaxEntries: 10},
+			expectedErr: "slowlog::collection_interval must be positive",
+		},
+		{
+			desc:        "invalid max entries",
+			cfg:         SlowLogConfig{Enabled: true, CollectionInterval: time.Second},
+			expectedErr: "slowlog::max_entries must be positive",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.SlowLog = tc.cfg
+			err := cfg.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -22,7 +22,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		metadata.Type,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability))
+		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability))
 }
 
 func createDefaultConfig() component.Config {
@@ -37,6 +38,10 @@ func createDefaultConfig() component.Config {
 		},
 		ControllerConfig:     scs,
 		MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+		SlowLog: SlowLogConfig{
+			CollectionInterval: 10 * time.Second,
+			MaxEntries:         128,
+		},
 	}
 }
 
@@ -47,6 +47,7 @@ type MetricsConfig struct {
 	RedisKeysExpired                       MetricConfig `mapstructure:"redis.keys.expired"`
 	RedisKeyspaceHits                      MetricConfig `mapstructure:"redis.keyspace.hits"`
 	RedisKeyspaceMisses                    MetricConfig `mapstructure:"redis.keyspace.misses"`
+	RedisLatencySpike                      MetricConfig `mapstructure:"redis.latency.spike"`
 	RedisLatestFork                        MetricConfig `mapstructure:"redis.latest_fork"`
 	RedisMaxmemory                         MetricConfig `mapstructure:"redis.maxmemory"`
 	RedisMemoryFragmentationRatio          MetricConfig `mapstructure:"redis.memory.fragmentation_ratio"`
@@ -124,6 +125,9 @@ func DefaultMetricsConfig() MetricsConfig {
 		RedisKeyspaceMisses: MetricConfig{
 			Enabled: true,
 		},
+		RedisLatencySpike: MetricConfig{
+			Enabled: false,
+		},
 		RedisLatestFork: MetricConfig{
 			Enabled: true,
 		},
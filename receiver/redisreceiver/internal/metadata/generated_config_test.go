@@ -44,6 +44,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                       MetricConfig{Enabled: true},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: true},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: true},
+					RedisLatencySpike:                      MetricConfig{Enabled: true},
 					RedisLatestFork:                        MetricConfig{Enabled: true},
 					RedisMaxmemory:                         MetricConfig{Enabled: true},
 					RedisMemoryFragmentationRatio:          MetricConfig{Enabled: true},
@@ -91,6 +92,7 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					RedisKeysExpired:                       MetricConfig{Enabled: false},
 					RedisKeyspaceHits:                      MetricConfig{Enabled: false},
 					RedisKeyspaceMisses:                    MetricConfig{Enabled: false},
+					RedisLatencySpike:                      MetricConfig{Enabled: false},
 					RedisLatestFork:                        MetricConfig{Enabled: false},
 					RedisMaxmemory:                         MetricConfig{Enabled: false},
 					RedisMemoryFragmentationRatio:          MetricConfig{Enabled: false},
@@ -1080,6 +1080,57 @@ func newMetricRedisKeyspaceMisses(cfg MetricConfig) metricRedisKeyspaceMisses {
 	return m
 }
 
+type metricRedisLatencySpike struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latency.spike metric with initial data.
+func (m *metricRedisLatencySpike) init() {
+	m.data.SetName("redis.latency.spike")
+	m.data.SetDescription("Latency spike recorded by the Redis latency monitor, a data point is recorded at the time of every spike")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencySpike) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencySpike) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencySpike) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencySpike(cfg MetricConfig) metricRedisLatencySpike {
+	m := metricRedisLatencySpike{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricRedisLatestFork struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1907,6 +1958,7 @@ type MetricsBuilder struct {
 	metricRedisKeysExpired                       metricRedisKeysExpired
 	metricRedisKeyspaceHits                      metricRedisKeyspaceHits
 	metricRedisKeyspaceMisses                    metricRedisKeyspaceMisses
+	metricRedisLatencySpike                      metricRedisLatencySpike
 	metricRedisLatestFork                        metricRedisLatestFork
 	metricRedisMaxmemory                         metricRedisMaxmemory
 	metricRedisMemoryFragmentationRatio          metricRedisMemoryFragmentationRatio
@@ -1960,6 +2012,7 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.CreateSetting
 		metricRedisKeysExpired:                       newMetricRedisKeysExpired(mbc.Metrics.RedisKeysExpired),
 		metricRedisKeyspaceHits:                      newMetricRedisKeyspaceHits(mbc.Metrics.RedisKeyspaceHits),
 		metricRedisKeyspaceMisses:                    newMetricRedisKeyspaceMisses(mbc.Metrics.RedisKeyspaceMisses),
+		metricRedisLatencySpike:                      newMetricRedisLatencySpike(mbc.Metrics.RedisLatencySpike),
 		metricRedisLatestFork:                        newMetricRedisLatestFork(mbc.Metrics.RedisLatestFork),
 		metricRedisMaxmemory:                         newMetricRedisMaxmemory(mbc.Metrics.RedisMaxmemory),
 		metricRedisMemoryFragmentationRatio:          newMetricRedisMemoryFragmentationRatio(mbc.Metrics.RedisMemoryFragmentationRatio),
@@ -2077,6 +2130,7 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricRedisKeysExpired.emit(ils.Metrics())
 	mb.metricRedisKeyspaceHits.emit(ils.Metrics())
 	mb.metricRedisKeyspaceMisses.emit(ils.Metrics())
+	mb.metricRedisLatencySpike.emit(ils.Metrics())
 	mb.metricRedisLatestFork.emit(ils.Metrics())
 	mb.metricRedisMaxmemory.emit(ils.Metrics())
 	mb.metricRedisMemoryFragmentationRatio.emit(ils.Metrics())
@@ -2219,6 +2273,11 @@ func (mb *MetricsBuilder) RecordRedisKeyspaceMissesDataPoint(ts pcommon.Timestam
 	mb.metricRedisKeyspaceMisses.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisLatencySpikeDataPoint adds a data point to redis.latency.spike metric.
+func (mb *MetricsBuilder) RecordRedisLatencySpikeDataPoint(ts pcommon.Timestamp, val float64, eventAttributeValue string) {
+	mb.metricRedisLatencySpike.recordDataPoint(mb.startTime, ts, val, eventAttributeValue)
+}
+
 // RecordRedisLatestForkDataPoint adds a data point to redis.latest_fork metric.
 func (mb *MetricsBuilder) RecordRedisLatestForkDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricRedisLatestFork.recordDataPoint(mb.startTime, ts, val)
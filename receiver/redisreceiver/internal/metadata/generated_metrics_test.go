@@ -141,6 +141,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordRedisKeyspaceMissesDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordRedisLatencySpikeDataPoint(ts, 1, "event-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordRedisLatestForkDataPoint(ts, 1)
@@ -504,6 +507,21 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "redis.latency.spike":
+					assert.False(t, validatedMetrics["redis.latency.spike"], "Found a duplicate in the metrics slice: redis.latency.spike")
+					validatedMetrics["redis.latency.spike"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Latency spike recorded by the Redis latency monitor, a data point is recorded at the time of every spike", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+					assert.Equal(t, float64(1), dp.DoubleValue())
+					attrVal, ok := dp.Attributes().Get("event")
+					assert.True(t, ok)
+					assert.EqualValues(t, "event-val", attrVal.Str())
 				case "redis.latest_fork":
 					assert.False(t, validatedMetrics["redis.latest_fork"], "Found a duplicate in the metrics slice: redis.latest_fork")
 					validatedMetrics["redis.latest_fork"] = true
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"time"
+)
+
+// latencySample is a latency spike reported by LATENCY HISTORY.
+type latencySample struct {
+	time    time.Time
+	latency time.Duration
+}
+
+// parseLatencyLatestEvents returns the event names of a LATENCY LATEST reply, an array
+// holding an array per event, e.g. [["command", 1718186400, 251, 1020]].
+func parseLatencyLatestEvents(reply []any) ([]string, error) {
+	events := make([]string, 0, len(reply))
+	for _, item := range reply {
+		entry, ok := item.([]any)
+		if !ok || len(entry) == 0 {
+			return nil, fmt.Errorf("unexpected LATENCY LATEST entry %v", item)
+		}
+		event, ok := entry[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected LATENCY LATEST event name %v", entry[0])
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// parseLatencyHistory parses a LATENCY HISTORY reply, an array holding a pair of
+// unix timestamp and latency in milliseconds per spike, e.g. [[1718186400, 251]].
+func parseLatencyHistory(reply []any) ([]latencySample, error) {
+	samples := make([]latencySample, 0, len(reply))
+	for _, item := range reply {
+		entry, ok := item.([]any)
+		if !ok || len(entry) != 2 {
+			return nil, fmt.Errorf("unexpected LATENCY HISTORY entry %v", item)
+		}
+		timestamp, ok := entry[0].(int64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected LATENCY HISTORY timestamp %v", entry[0])
+		}
+		latency, ok := entry[1].(int64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected LATENCY HISTORY latency %v", entry[1])
+		}
+		samples = append(samples, latencySample{
+			time:    time.Unix(timestamp, 0),
+			latency: time.Duration(latency) * time.Millisecond,
+		})
+	}
+	return samples, nil
+}
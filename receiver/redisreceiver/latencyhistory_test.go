@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLatencyLatestEvents(t *testing.T) {
+	events, err := parseLatencyLatestEvents([]any{
+		[]any{"command", int64(1718186400), int64(251), int64(1020)},
+		[]any{"fast-command", int64(1718186405), int64(12), int64(12)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"command", "fast-command"}, events)
+}
+
+func TestParseLatencyHistory(t *testing.T) {
+	samples, err := parseLatencyHistory([]any{
+		[]any{int64(1718186400), int64(251)},
+		[]any{int64(1718186405), int64(1020)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []latencySample{
+		{time: time.Unix(1718186400, 0), latency: 251 * time.Millisecond},
+		{time: time.Unix(1718186405, 0), latency: 1020 * time.Millisecond},
+	}, samples)
+}
+
+func TestParseMalformedLatency(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() error
+	}{
+		{
+			name: "latest entry not an array",
+			parse: func() error {
+				_, err := parseLatencyLatestEvents([]any{"command"})
+				return err
+			},
+		},
+		{
+			name: "latest event name not a string",
+			parse: func() error {
+				_, err := parseLatencyLatestEvents([]any{[]any{int64(1)}})
+				return err
+			},
+		},
+		{
+			name: "history entry too short",
+			parse: func() error {
+				_, err := parseLatencyHistory([]any{[]any{int64(1718186400)}})
+				return err
+			},
+		},
+		{
+			name: "history latency not an integer",
+			parse: func() error {
+				_, err := parseLatencyHistory([]any{[]any{int64(1718186400), "251"}})
+				return err
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Error(t, test.parse())
+		})
+	}
+}
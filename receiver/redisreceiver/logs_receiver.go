@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// slowLogReceiver periodically emits the new SLOWLOG entries as log records.
+type slowLogReceiver struct {
+	cfg        *Config
+	settings   receiver.CreateSettings
+	consumer   consumer.Logs
+	client     client
+	configInfo configInfo
+
+	// lastTime and lastID identify the last entry emitted. The IDs are reset when Redis restarts,
+	// so the entries are ordered by time first.
+	lastTime time.Time
+	lastID   int64
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	set receiver.CreateSettings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	oCfg := cfg.(*Config)
+
+	configInfo, err := newConfigInfo(oCfg)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := newRedisOptions(oCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &slowLogReceiver{
+		cfg:        oCfg,
+		settings:   set,
+		consumer:   consumer,
+		client:     newRedisClient(opts),
+		configInfo: configInfo,
+		lastID:     -1,
+	}, nil
+}
+
+// Start begins the collection of the SLOWLOG entries logged from now on. The receiver does nothing
+// if the collection is disabled, a message is logged at the INFO level in that case.
+func (r *slowLogReceiver) Start(context.Context, component.Host) error {
+	if !r.cfg.SlowLog.Enabled {
+		r.settings.Logger.Info("No SLOWLOG entries will be collected from Redis: slowlog is not enabled.")
+		return nil
+	}
+	r.lastTime = time.Now().Truncate(time.Second)
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go r.run(ctx)
+	return nil
+}
+
+func (r *slowLogReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.SlowLog.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		logs, err := r.collect()
+		if err != nil {
+			r.settings.Logger.Error("Failed to collect the Redis SLOWLOG", zap.Error(err))
+			continue
+		}
+		if logs.LogRecordCount() == 0 {
+			continue
+		}
+		if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			r.settings.Logger.Error("Failed to consume the Redis SLOWLOG entries", zap.Error(err))
+		}
+	}
+}
+
+func (r *slowLogReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.client != nil {
+		return r.client.close()
+	}
+	return nil
+}
+
+// collect returns a log record per SLOWLOG entry logged after the last one emitted, the oldest first.
+func (r *slowLogReceiver) collect() (plog.Logs, error) {
+	logs := plog.NewLogs()
+	entries, err := r.client.retrieveSlowLog(r.cfg.SlowLog.MaxEntries)
+	if err != nil {
+		return logs, fmt.Errorf("failed to retrieve the slow log: %w", err)
+	}
+
+	resourceLogs := logs.ResourceLogs().AppendEmpty()
+	rb := metadata.NewResourceBuilder(r.cfg.MetricsBuilderConfig.ResourceAttributes)
+	rb.SetServerAddress(r.configInfo.Address)
+	rb.SetServerPort(r.configInfo.Port)
+	rb.Emit().MoveTo(resourceLogs.Resource())
+	scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+	scopeLogs.Scope().SetName("otelcol/redisreceiver")
+	scopeLogs.Scope().SetVersion(r.settings.BuildInfo.Version)
+
+	observed := pcommon.NewTimestampFromTime(time.Now())
+	lastTime, lastID := r.lastTime, r.lastID
+	// SLOWLOG GET returns the latest entries first.
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Time.Before(lastTime) || (entry.Time.Equal(lastTime) && entry.ID <= lastID) {
+			continue
+		}
+		if entry.Time.After(r.lastTime) || (entry.Time.Equal(r.lastTime) && entry.ID > r.lastID) {
+			r.lastTime, r.lastID = entry.Time, entry.ID
+		}
+
+		record := scopeLogs.LogRecords().AppendEmpty()
+		record.SetTimestamp(pcommon.NewTimestampFromTime(entry.Time))
+		record.SetObservedTimestamp(observed)
+		record.SetSeverityNumber(plog.SeverityNumberInfo)
+		record.Body().SetStr(strings.Join(entry.Args, " "))
+
+		attrs := record.Attributes()
+		attrs.PutStr("db.system", "redis")
+		attrs.PutInt("redis.slowlog.id", entry.ID)
+		attrs.PutDouble("redis.slowlog.duration", entry.Duration.Seconds())
+		if len(entry.Args) > 0 {
+			attrs.PutStr("redis.slowlog.command", strings.ToLower(entry.Args[0]))
+		}
+		if entry.ClientAddr != "" {
+			attrs.PutStr("redis.slowlog.client.address", entry.ClientAddr)
+		}
+		if entry.ClientName != "" {
+			attrs.PutStr("redis.slowlog.client.name", entry.ClientName)
+		}
+	}
+	return logs, nil
+}
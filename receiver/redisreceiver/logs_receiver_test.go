@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redisreceiver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+func newTestSlowLogReceiver(t *testing.T) *slowLogReceiver {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.SlowLog.Enabled = true
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	receiver := r.(*slowLogReceiver)
+	receiver.client = newFakeClient()
+	return receiver
+}
+
+func TestSlowLogCollect(t *testing.T) {
+	r := newTestSlowLogReceiver(t)
+	r.lastTime = time.Unix(1718186400, 0)
+
+	logs, err := r.collect()
+	require.NoError(t, err)
+	expected, err := golden.ReadLogs(filepath.Join("testdata", "logs", "expectedSlowLog.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, plogtest.CompareLogs(expected, logs, plogtest.IgnoreObservedTimestamp()))
+
+	// The entries were already emitted.
+	logs, err = r.collect()
+	require.NoError(t, err)
+	require.Equal(t, 0, logs.LogRecordCount())
+}
+
+func TestSlowLogCollectSinceLastEntry(t *testing.T) {
+	r := newTestSlowLogReceiver(t)
+	r.lastTime, r.lastID = time.Unix(1718186401, 0), 11
+
+	logs, err := r.collect()
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.LogRecordCount())
+	record := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, "KEYS *", record.Body().Str())
+}
+
+func TestSlowLogDisabled(t *testing.T) {
+	r := newTestSlowLogReceiver(t)
+	r.cfg.SlowLog.Enabled = false
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Nil(t, r.cancel)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
@@ -29,11 +29,21 @@ type redisScraper struct {
 	mb         *metadata.MetricsBuilder
 	uptime     time.Duration
 	configInfo configInfo
+	// latencyWatermarks holds the time of the last latency spike recorded for every event.
+	latencyWatermarks map[string]time.Time
 }
 
 const redisMaxDbs = 16 // Maximum possible number of redis databases
 
 func newRedisScraper(cfg *Config, settings receiver.CreateSettings) (scraperhelper.Scraper, error) {
+	opts, err := newRedisOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+}
+
+func newRedisOptions(cfg *Config) (*redis.Options, error) {
 	opts := &redis.Options{
 		Addr:     cfg.Endpoint,
 		Username: cfg.Username,
@@ -45,7 +55,7 @@ func newRedisScraper(cfg *Config, settings receiver.CreateSettings) (scraperhelp
 	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(context.Background()); err != nil {
 		return nil, err
 	}
-	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+	return opts, nil
 }
 
 func newRedisScraperWithClient(client client, settings receiver.CreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
@@ -60,6 +70,9 @@ func newRedisScraperWithClient(client client, settings receiver.CreateSettings,
 		mb:         metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
 		configInfo: configInfo,
 	}
+	if cfg.MetricsBuilderConfig.Metrics.RedisLatencySpike.Enabled {
+		rs.latencyWatermarks = map[string]time.Time{}
+	}
 	return scraperhelper.NewScraper(
 		metadata.Type.String(),
 		rs.Scrape,
@@ -100,6 +113,7 @@ func (rs *redisScraper) Scrape(context.Context) (pmetric.Metrics, error) {
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordRoleMetrics(now, inf)
 	rs.recordCmdMetrics(now, inf)
+	rs.recordLatencySpikes()
 	rb := rs.mb.NewResourceBuilder()
 	rb.SetRedisVersion(rs.getRedisVersion(inf))
 	rb.SetServerAddress(rs.configInfo.Address)
@@ -234,3 +248,28 @@ func (rs *redisScraper) recordCmdLatencyMetrics(ts pcommon.Timestamp, cmd, val s
 		}
 	}
 }
+
+// recordLatencySpikes records the spikes reported by the latency monitor since the previous scrape,
+// at the time of the spikes. Nothing is queried unless the redis.latency.spike metric is enabled.
+func (rs *redisScraper) recordLatencySpikes() {
+	if rs.latencyWatermarks == nil {
+		return
+	}
+	history, err := rs.client.retrieveLatencyHistory()
+	if err != nil {
+		rs.settings.Logger.Warn("failed to retrieve latency history", zap.Error(err))
+		return
+	}
+	for event, samples := range history {
+		last := rs.latencyWatermarks[event]
+		for _, sample := range samples {
+			if !sample.time.After(last) {
+				continue
+			}
+			rs.mb.RecordRedisLatencySpikeDataPoint(pcommon.NewTimestampFromTime(sample.time), sample.latency.Seconds(), event)
+			if sample.time.After(rs.latencyWatermarks[event]) {
+				rs.latencyWatermarks[event] = sample.time
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"go.uber.org/zap"
 
@@ -36,6 +37,55 @@ func TestRedisRunnable(t *testing.T) {
 	assert.Equal(t, "otelcol/redisreceiver", il.Name())
 }
 
+func TestRedisLatencySpikes(t *testing.T) {
+	settings := receivertest.NewNopCreateSettings()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.MetricsBuilderConfig.Metrics.RedisLatencySpike.Enabled = true
+	runner, err := newRedisScraperWithClient(newFakeClient(), settings, cfg)
+	require.NoError(t, err)
+
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	spikes, ok := findMetric(md, "redis.latency.spike")
+	require.True(t, ok)
+	require.Equal(t, 3, spikes.Gauge().DataPoints().Len())
+	for i := 0; i < spikes.Gauge().DataPoints().Len(); i++ {
+		dp := spikes.Gauge().DataPoints().At(i)
+		event, ok := dp.Attributes().Get("event")
+		require.True(t, ok)
+		switch dp.Timestamp().AsTime().Unix() {
+		case 1718186400:
+			assert.Equal(t, "command", event.Str())
+			assert.Equal(t, 0.251, dp.DoubleValue())
+		case 1718186410:
+			assert.Equal(t, "command", event.Str())
+			assert.Equal(t, 1.02, dp.DoubleValue())
+		case 1718186405:
+			assert.Equal(t, "fast-command", event.Str())
+			assert.Equal(t, 0.12, dp.DoubleValue())
+		default:
+			t.Errorf("unexpected spike at %v", dp.Timestamp())
+		}
+	}
+
+	// The spikes were already recorded.
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	_, ok = findMetric(md, "redis.latency.spike")
+	assert.False(t, ok)
+}
+
+func findMetric(md pmetric.Metrics, name string) (pmetric.Metric, bool) {
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		if metrics.At(i).Name() == name {
+			return metrics.At(i), true
+		}
+	}
+	return pmetric.Metric{}, false
+}
+
 func TestNewReceiver_invalid_endpoint(t *testing.T) {
 	c := createDefaultConfig().(*Config)
 	_, err := createMetricsReceiver(context.Background(), receivertest.NewNopCreateSettings(), c, nil)
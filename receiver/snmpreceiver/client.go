@@ -95,6 +95,11 @@ func newClient(cfg *Config, logger *zap.Logger) (client, error) {
 	// Set goSNMP target based on config
 	goSNMP.SetTarget(snmpURL.Hostname())
 
+	// Set the number of rows of the GETBULK requests, gosnmp has its own default when not set
+	if cfg.MaxRepetitions > 0 {
+		goSNMP.SetMaxRepetitions(cfg.MaxRepetitions)
+	}
+
 	if goSNMP.GetVersion() == gosnmp.Version3 {
 		// Set goSNMP v3 configs
 		setV3ClientConfigs(goSNMP, cfg)
@@ -34,9 +34,10 @@ func TestNewClient(t *testing.T) {
 		{
 			desc: "Valid v2c configuration",
 			cfg: &Config{
-				Version:   "v2c",
-				Endpoint:  "udp://localhost:161",
-				Community: "public",
+				Version:        "v2c",
+				Endpoint:       "udp://localhost:161",
+				Community:      "public",
+				MaxRepetitions: 20,
 			},
 			host:        componenttest.NewNopHost(),
 			settings:    componenttest.NewNopTelemetrySettings(),
@@ -86,6 +87,7 @@ func compareConfigToClient(t *testing.T, client *snmpClient, cfg *Config) {
 	require.True(t, strings.Contains(cfg.Endpoint, client.client.GetTarget()))
 	require.True(t, strings.Contains(cfg.Endpoint, strconv.FormatInt(int64(client.client.GetPort()), 10)))
 	require.True(t, strings.Contains(cfg.Endpoint, client.client.GetTransport()))
+	require.Equal(t, cfg.MaxRepetitions, client.client.GetMaxRepetitions())
 	switch cfg.Version {
 	case "v1":
 		require.Equal(t, gosnmp.Version1, client.client.GetVersion())
@@ -5,6 +5,7 @@ package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collecto
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"strings"
 	"time"
@@ -23,6 +24,7 @@ const (
 	defaultSecurityLevel      = "no_auth_no_priv"
 	defaultAuthType           = "MD5"
 	defaultPrivacyType        = "DES"
+	defaultMaxRepetitions     = 50
 )
 
 var (
@@ -68,6 +70,7 @@ var (
 	errBadPrivacyType       = errors.New("privacy_type must be either DES, AES, AES192, AES192C, AES256, AES256C")
 	errEmptyPrivacyPassword = errors.New("privacy_password must be specified when security_level is auth_priv")
 	errMetricRequired       = errors.New("must have at least one config under metrics")
+	errBadMaxRepetitions    = errors.New("max_repetitions must be at most 2147483647")
 )
 
 // Config defines the configuration for the various elements of the receiver.
@@ -120,6 +123,16 @@ type Config struct {
 	// Only valid for version “v3” and if "auth_priv" is selected for SecurityLevel
 	PrivacyPassword configopaque.String `mapstructure:"privacy_password"`
 
+	// MaxRepetitions is the number of rows requested by each GETBULK request walking the column OIDs.
+	// Only valid for versions "v2c" and "v3", version "v1" walks with GETNEXT requests.
+	// Default: 50
+	MaxRepetitions uint32 `mapstructure:"max_repetitions"`
+
+	// MIBs are the MIB files, or directories of MIB files, loaded to decode the indexes of the
+	// column OIDs. When the table entry of a column OID and its INDEX objects are defined by the
+	// loaded MIBs, the indexed_value_prefix attributes get the decoded index instead of the raw one.
+	MIBs []string `mapstructure:"mibs"`
+
 	// ResourceAttributes defines what resource attributes will be used for this receiver and is composed
 	// of resource attribute names along with their resource attribute configurations
 	ResourceAttributes map[string]*ResourceAttributeConfig `mapstructure:"resource_attributes"`
@@ -249,6 +262,9 @@ func (cfg *Config) Validate() error {
 	if strings.ToUpper(cfg.Version) == "V3" {
 		combinedErr = errors.Join(combinedErr, validateSecurity(cfg))
 	}
+	if cfg.MaxRepetitions > math.MaxInt32 {
+		combinedErr = errors.Join(combinedErr, errBadMaxRepetitions)
+	}
 	combinedErr = errors.Join(combinedErr, validateMetricConfigs(cfg))
 
 	return combinedErr
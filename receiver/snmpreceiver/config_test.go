@@ -130,6 +130,15 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 	expectedConfigV3NoPrivacyPassword.AuthPassword = "p"
 	expectedConfigV3NoPrivacyPassword.Metrics = metrics
 
+	expectedConfigBulkMIBs := factory.CreateDefaultConfig().(*Config)
+	expectedConfigBulkMIBs.MaxRepetitions = 20
+	expectedConfigBulkMIBs.MIBs = []string{"testdata/mibs"}
+	expectedConfigBulkMIBs.Metrics = metrics
+
+	expectedConfigBadMaxRepetitions := factory.CreateDefaultConfig().(*Config)
+	expectedConfigBadMaxRepetitions.MaxRepetitions = 3000000000
+	expectedConfigBadMaxRepetitions.Metrics = metrics
+
 	testCases := []testCase{
 		{
 			name:        "NoEndpointUsesDefault",
@@ -239,6 +248,18 @@ func TestLoadConfigConnectionConfigs(t *testing.T) {
 			expectedCfg: expectedConfigSimple,
 			expectedErr: "",
 		},
+		{
+			name:        "GoodV2CBulkMIBsNoErrors",
+			nameVal:     "v2c_bulk_mibs",
+			expectedCfg: expectedConfigBulkMIBs,
+			expectedErr: "",
+		},
+		{
+			name:        "BadMaxRepetitionsErrors",
+			nameVal:     "bad_max_repetitions",
+			expectedCfg: expectedConfigBadMaxRepetitions,
+			expectedErr: errBadMaxRepetitions.Error(),
+		},
 		{
 			name:        "GoodV3ConnectionNoErrors",
 			nameVal:     "v3_connection_good",
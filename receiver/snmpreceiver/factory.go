@@ -35,12 +35,13 @@ func createDefaultConfig() component.Config {
 			CollectionInterval: defaultCollectionInterval,
 			Timeout:            defaultTimeout,
 		},
-		Endpoint:      defaultEndpoint,
-		Version:       defaultVersion,
-		Community:     defaultCommunity,
-		SecurityLevel: defaultSecurityLevel,
-		AuthType:      defaultAuthType,
-		PrivacyType:   defaultPrivacyType,
+		Endpoint:       defaultEndpoint,
+		Version:        defaultVersion,
+		Community:      defaultCommunity,
+		SecurityLevel:  defaultSecurityLevel,
+		AuthType:       defaultAuthType,
+		PrivacyType:    defaultPrivacyType,
+		MaxRepetitions: defaultMaxRepetitions,
 	}
 }
 
@@ -38,12 +38,13 @@ func TestNewFactory(t *testing.T) {
 						CollectionInterval: defaultCollectionInterval,
 						Timeout:            defaultTimeout,
 					},
-					Endpoint:      defaultEndpoint,
-					Version:       defaultVersion,
-					Community:     defaultCommunity,
-					SecurityLevel: "no_auth_no_priv",
-					AuthType:      "MD5",
-					PrivacyType:   "DES",
+					Endpoint:       defaultEndpoint,
+					Version:        defaultVersion,
+					Community:      defaultCommunity,
+					SecurityLevel:  "no_auth_no_priv",
+					AuthType:       "MD5",
+					PrivacyType:    "DES",
+					MaxRepetitions: defaultMaxRepetitions,
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
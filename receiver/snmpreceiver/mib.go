@@ -0,0 +1,577 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Kinds of SNMP values an index object can have, which determine how the
+// index is encoded in the instance OID of a table column.
+const (
+	indexKindUnknown = iota
+	indexKindInteger
+	indexKindIPAddress
+	indexKindOctets
+	indexKindOID
+)
+
+// mibWellKnownOIDs are the OIDs defined by RFC1155-SMI and SNMPv2-SMI, so that
+// they don't need to be loaded.
+var mibWellKnownOIDs = map[string]string{
+	"ccitt":           ".0",
+	"zeroDotZero":     ".0.0",
+	"iso":             ".1",
+	"joint-iso-ccitt": ".2",
+	"org":             ".1.3",
+	"dod":             ".1.3.6",
+	"internet":        ".1.3.6.1",
+	"directory":       ".1.3.6.1.1",
+	"mgmt":            ".1.3.6.1.2",
+	"mib-2":           ".1.3.6.1.2.1",
+	"transmission":    ".1.3.6.1.2.1.10",
+	"experimental":    ".1.3.6.1.3",
+	"private":         ".1.3.6.1.4",
+	"enterprises":     ".1.3.6.1.4.1",
+	"security":        ".1.3.6.1.5",
+	"snmpV2":          ".1.3.6.1.6",
+	"snmpDomains":     ".1.3.6.1.6.1",
+	"snmpProxys":      ".1.3.6.1.6.2",
+	"snmpModules":     ".1.3.6.1.6.3",
+}
+
+// mibWellKnownTypes are the base types of the SMI and the most common textual
+// conventions, so that they don't need to be loaded.
+var mibWellKnownTypes = map[string]*mibType{
+	"INTEGER":           {kind: indexKindInteger},
+	"Integer32":         {kind: indexKindInteger},
+	"Unsigned32":        {kind: indexKindInteger},
+	"Gauge32":           {kind: indexKindInteger},
+	"Gauge":             {kind: indexKindInteger},
+	"Counter32":         {kind: indexKindInteger},
+	"Counter":           {kind: indexKindInteger},
+	"TimeTicks":         {kind: indexKindInteger},
+	"IpAddress":         {kind: indexKindIPAddress},
+	"NetworkAddress":    {kind: indexKindIPAddress},
+	"OCTET STRING":      {kind: indexKindOctets},
+	"Opaque":            {kind: indexKindOctets},
+	"BITS":              {kind: indexKindOctets},
+	"OBJECT IDENTIFIER": {kind: indexKindOID},
+	"DisplayString":     {kind: indexKindOctets, hint: "255a"},
+	"PhysAddress":       {kind: indexKindOctets, hint: "1x:"},
+	"MacAddress":        {kind: indexKindOctets, hint: "1x:", size: 6},
+	"SnmpAdminString":   {kind: indexKindOctets, hint: "255t"},
+}
+
+// mibMacros are the macros defining objects with an OID.
+var mibMacros = map[string]bool{
+	"OBJECT-TYPE":        true,
+	"OBJECT-IDENTITY":    true,
+	"MODULE-IDENTITY":    true,
+	"NOTIFICATION-TYPE":  true,
+	"OBJECT-GROUP":       true,
+	"NOTIFICATION-GROUP": true,
+	"MODULE-COMPLIANCE":  true,
+	"AGENT-CAPABILITIES": true,
+}
+
+// mibType is the syntax of an object, or a type defined by a MIB.
+type mibType struct {
+	// name is the referenced type, empty for the base types.
+	name string
+	kind int
+	// hint is the DISPLAY-HINT of a textual convention.
+	hint string
+	// size is the size of a fixed-size OCTET STRING.
+	size int
+}
+
+// mibObject is an object defined by a MIB.
+type mibObject struct {
+	name   string
+	module string
+	oid    string
+	parent string
+	subIDs []string
+	syntax *mibType
+	// index holds the objects the rows of a table entry are indexed by.
+	index    []string
+	implied  bool
+	augments string
+}
+
+// mibModules holds the objects and types of the loaded MIB modules, used to
+// turn the index of the instances of table columns into readable values.
+type mibModules struct {
+	objects map[string]*mibObject
+	byOID   map[string]*mibObject
+	types   map[string]*mibType
+}
+
+// loadMIBs parses the MIB modules in the given files and directories.
+func loadMIBs(paths []string) (*mibModules, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	m := &mibModules{
+		objects: map[string]*mibObject{},
+		byOID:   map[string]*mibObject{},
+		types:   map[string]*mibType{},
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load MIBs: %w", err)
+		}
+		files := []string{path}
+		if info.IsDir() {
+			entries, err := os.ReadDir(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load MIBs: %w", err)
+			}
+			files = files[:0]
+			for _, entry := range entries {
+				if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+					files = append(files, filepath.Join(path, entry.Name()))
+				}
+			}
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load MIBs: %w", err)
+			}
+			if err := m.parse(tokenizeMIB(string(data))); err != nil {
+				return nil, fmt.Errorf("failed to load MIB %q: %w", file, err)
+			}
+		}
+	}
+
+	for _, obj := range m.objects {
+		if oid := m.resolveOID(obj, 0); oid != "" {
+			obj.oid = oid
+			m.byOID[oid] = obj
+		}
+	}
+	return m, nil
+}
+
+// tokenizeMIB splits a MIB into tokens, dropping the comments.
+func tokenizeMIB(text string) []string {
+	var tokens []string
+	runes := []rune(text)
+	isIdentifier := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+	}
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			// Comments end with the line or with another "--".
+			i += 2
+			for i < len(runes) && runes[i] != '\n' && (runes[i] != '-' || i+1 >= len(runes) || runes[i+1] != '-') {
+				i++
+			}
+			if i < len(runes) && runes[i] == '-' {
+				i++
+			}
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:min(j+1, len(runes))]))
+			i = j
+		case r == ':' && i+2 < len(runes) && runes[i+1] == ':' && runes[i+2] == '=':
+			tokens = append(tokens, "::=")
+			i += 2
+		case r == '.' && i+1 < len(runes) && runes[i+1] == '.':
+			tokens = append(tokens, "..")
+			i++
+		case isIdentifier(r):
+			j := i + 1
+			for j < len(runes) && isIdentifier(runes[j]) && (runes[j] != '-' || j+1 >= len(runes) || runes[j+1] != '-') {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j - 1
+		default:
+			tokens = append(tokens, string(r))
+		}
+	}
+	return tokens
+}
+
+// parse records the objects and types defined by a tokenized MIB.
+func (m *mibModules) parse(tokens []string) error {
+	var module string
+	for i := 0; i < len(tokens); i++ {
+		switch {
+		case tokens[i] == "DEFINITIONS" && i > 0:
+			module = tokens[i-1]
+		case tokens[i] == "MACRO":
+			// Skip the definitions of the macros themselves.
+			for i < len(tokens) && tokens[i] != "END" {
+				i++
+			}
+		case mibMacros[tokens[i]] && i > 0 && isMIBValueName(tokens[i-1]):
+			obj := &mibObject{name: tokens[i-1], module: module}
+			j := i + 1
+			for ; j < len(tokens) && tokens[j] != "::="; j++ {
+				switch tokens[j] {
+				case "SYNTAX":
+					obj.syntax, j = parseMIBSyntax(tokens, j+1)
+					j--
+				case "INDEX":
+					obj.index, obj.implied, j = parseMIBIndex(tokens, j+1)
+				case "AUGMENTS":
+					if j+2 < len(tokens) && tokens[j+1] == "{" {
+						obj.augments = tokens[j+2]
+					}
+				}
+			}
+			if err := m.addObject(obj, tokens, j+1); err != nil {
+				return err
+			}
+			i = j
+		case tokens[i] == "OBJECT" && i > 0 && i+2 < len(tokens) && tokens[i+1] == "IDENTIFIER" &&
+			tokens[i+2] == "::=" && isMIBValueName(tokens[i-1]):
+			obj := &mibObject{name: tokens[i-1], module: module}
+			if err := m.addObject(obj, tokens, i+3); err != nil {
+				return err
+			}
+			i += 2
+		case tokens[i] == "::=" && i > 0 && i+1 < len(tokens) && isMIBTypeName(tokens[i-1]) && tokens[i+1] != "BEGIN":
+			var typ *mibType
+			var hint string
+			j := i + 1
+			if tokens[j] == "TEXTUAL-CONVENTION" {
+				for ; j < len(tokens) && tokens[j] != "SYNTAX"; j++ {
+					if tokens[j] == "DISPLAY-HINT" && j+1 < len(tokens) {
+						hint = strings.Trim(tokens[j+1], `"`)
+					}
+				}
+				j++
+			}
+			typ, j = parseMIBSyntax(tokens, j)
+			if typ != nil {
+				typ.hint = hint
+				m.types[tokens[i-1]] = typ
+			}
+			i = j - 1
+		}
+	}
+	return nil
+}
+
+// addObject records an object with the OID value starting at the given token.
+func (m *mibModules) addObject(obj *mibObject, tokens []string, start int) error {
+	if start >= len(tokens) || tokens[start] != "{" {
+		return fmt.Errorf("invalid OID value of %q", obj.name)
+	}
+	for j := start + 1; j < len(tokens) && tokens[j] != "}"; j++ {
+		first := j == start+1
+		switch {
+		case j+3 < len(tokens) && tokens[j+1] == "(" && tokens[j+3] == ")":
+			// name(number) form, e.g. "iso(1)", a value starting with it is relative to the root.
+			if first {
+				obj.parent = "."
+			}
+			obj.subIDs = append(obj.subIDs, tokens[j+2])
+			j += 3
+		case isMIBNumber(tokens[j]):
+			if first {
+				obj.parent = "."
+			}
+			obj.subIDs = append(obj.subIDs, tokens[j])
+		case first:
+			obj.parent = tokens[j]
+		default:
+			return fmt.Errorf("invalid OID value of %q", obj.name)
+		}
+	}
+	if obj.parent == "" {
+		return fmt.Errorf("invalid OID value of %q", obj.name)
+	}
+	m.objects[obj.name] = obj
+	if obj.module != "" {
+		m.objects[obj.module+"::"+obj.name] = obj
+	}
+	return nil
+}
+
+// resolveOID returns the numeric OID of an object, or an empty string if its
+// parents aren't known.
+func (m *mibModules) resolveOID(obj *mibObject, depth int) string {
+	if obj.oid != "" {
+		return obj.oid
+	}
+	// Guards against loops between invalid definitions.
+	if depth > 128 {
+		return ""
+	}
+	var parentOID string
+	switch parent, ok := m.objects[obj.parent]; {
+	case obj.parent == ".":
+	case mibWellKnownOIDs[obj.parent] != "":
+		parentOID = mibWellKnownOIDs[obj.parent]
+	case ok:
+		parentOID = m.resolveOID(parent, depth+1)
+		if parentOID == "" {
+			return ""
+		}
+	default:
+		return ""
+	}
+	if len(obj.subIDs) == 0 {
+		return parentOID
+	}
+	return parentOID + "." + strings.Join(obj.subIDs, ".")
+}
+
+// parseMIBSyntax parses the syntax starting at the given token, and returns
+// the index of the token following it.
+func parseMIBSyntax(tokens []string, i int) (*mibType, int) {
+	// Skip tags, e.g. "[APPLICATION 0] IMPLICIT".
+	if i < len(tokens) && tokens[i] == "[" {
+		for i < len(tokens) && tokens[i] != "]" {
+			i++
+		}
+		i++
+	}
+	if i < len(tokens) && tokens[i] == "IMPLICIT" {
+		i++
+	}
+	if i >= len(tokens) {
+		return nil, i
+	}
+
+	typ := &mibType{name: tokens[i]}
+	if i+1 < len(tokens) && (tokens[i] == "OCTET" && tokens[i+1] == "STRING" || tokens[i] == "OBJECT" && tokens[i+1] == "IDENTIFIER") {
+		typ.name = tokens[i] + " " + tokens[i+1]
+		i++
+	}
+	i++
+	if base, ok := mibWellKnownTypes[typ.name]; ok && base.hint == "" && base.size == 0 {
+		typ.kind, typ.name = base.kind, ""
+	}
+
+	// Skip the ranges and enumerations, recording the size of fixed-size strings.
+	if i < len(tokens) && (tokens[i] == "(" || tokens[i] == "{") {
+		if i+5 < len(tokens) && tokens[i] == "(" && tokens[i+1] == "SIZE" && tokens[i+2] == "(" &&
+			isMIBNumber(tokens[i+3]) && tokens[i+4] == ")" && tokens[i+5] == ")" {
+			typ.size, _ = strconv.Atoi(tokens[i+3])
+		}
+		depth := 0
+		for ; i < len(tokens); i++ {
+			switch tokens[i] {
+			case "(", "{":
+				depth++
+			case ")", "}":
+				depth--
+			}
+			if depth == 0 {
+				i++
+				break
+			}
+		}
+	}
+	return typ, i
+}
+
+// parseMIBIndex parses the objects of an INDEX clause starting at the given
+// token, and returns the index of its last token.
+func parseMIBIndex(tokens []string, i int) ([]string, bool, int) {
+	if i >= len(tokens) || tokens[i] != "{" {
+		return nil, false, i
+	}
+	var index []string
+	var implied bool
+	for i++; i < len(tokens) && tokens[i] != "}"; i++ {
+		switch tokens[i] {
+		case ",":
+		case "IMPLIED":
+			implied = true
+		default:
+			index = append(index, tokens[i])
+		}
+	}
+	return index, implied, i
+}
+
+func isMIBValueName(token string) bool {
+	return token != "" && unicode.IsLower(rune(token[0]))
+}
+
+func isMIBTypeName(token string) bool {
+	return token != "" && unicode.IsUpper(rune(token[0]))
+}
+
+func isMIBNumber(token string) bool {
+	_, err := strconv.ParseUint(token, 10, 64)
+	return err == nil
+}
+
+// resolveType follows the textual conventions of a syntax down to its base
+// type, keeping the first display hint and size found on the way.
+func (m *mibModules) resolveType(typ *mibType) mibType {
+	resolved := *typ
+	for depth := 0; resolved.kind == indexKindUnknown && resolved.name != "" && depth < 32; depth++ {
+		next, ok := m.types[resolved.name]
+		if !ok {
+			next, ok = mibWellKnownTypes[resolved.name]
+		}
+		if !ok {
+			return resolved
+		}
+		if resolved.hint == "" {
+			resolved.hint = next.hint
+		}
+		if resolved.size == 0 {
+			resolved.size = next.size
+		}
+		resolved.kind, resolved.name = next.kind, next.name
+	}
+	return resolved
+}
+
+// indexName returns the readable form of the index of an instance of a table
+// column, decoded according to the INDEX clause of its table entry. The index
+// is returned as is if it can't be decoded.
+func (m *mibModules) indexName(columnOID string, indexString string) string {
+	if m == nil {
+		return indexString
+	}
+	column, ok := m.byOID[columnOID]
+	if !ok {
+		return indexString
+	}
+	entry, ok := m.objects[column.parent]
+	if !ok {
+		return indexString
+	}
+	if augmented, ok := m.objects[entry.augments]; ok {
+		entry = augmented
+	}
+	if len(entry.index) == 0 {
+		return indexString
+	}
+
+	subIDs := strings.Split(strings.TrimPrefix(indexString, "."), ".")
+	var parts []string
+	for i, name := range entry.index {
+		obj, ok := m.objects[name]
+		if !ok || obj.syntax == nil {
+			return indexString
+		}
+		implied := entry.implied && i == len(entry.index)-1
+		part, rest, ok := decodeIndex(m.resolveType(obj.syntax), implied, subIDs)
+		if !ok {
+			return indexString
+		}
+		parts = append(parts, part)
+		subIDs = rest
+	}
+	if len(subIDs) > 0 {
+		return indexString
+	}
+	return "." + strings.Join(parts, ".")
+}
+
+// decodeIndex decodes the value of an index object from the sub-identifiers of
+// an instance OID, as described in RFC 2578 section 7.7, and returns the
+// remaining sub-identifiers.
+func decodeIndex(typ mibType, implied bool, subIDs []string) (string, []string, bool) {
+	switch typ.kind {
+	case indexKindInteger:
+		if len(subIDs) < 1 {
+			return "", nil, false
+		}
+		return subIDs[0], subIDs[1:], true
+	case indexKindIPAddress:
+		if len(subIDs) < 4 {
+			return "", nil, false
+		}
+		return strings.Join(subIDs[:4], "."), subIDs[4:], true
+	case indexKindOctets, indexKindOID:
+		n := typ.size
+		switch {
+		case n > 0 && typ.kind == indexKindOctets:
+		case implied:
+			n = len(subIDs)
+		default:
+			if len(subIDs) < 1 {
+				return "", nil, false
+			}
+			var err error
+			if n, err = strconv.Atoi(subIDs[0]); err != nil {
+				return "", nil, false
+			}
+			subIDs = subIDs[1:]
+		}
+		if n > len(subIDs) {
+			return "", nil, false
+		}
+		if typ.kind == indexKindOID {
+			return strings.Join(subIDs[:n], "."), subIDs[n:], true
+		}
+		octets := make([]byte, n)
+		for i, subID := range subIDs[:n] {
+			b, err := strconv.ParseUint(subID, 10, 8)
+			if err != nil {
+				return "", nil, false
+			}
+			octets[i] = byte(b)
+		}
+		return formatOctets(octets, typ.hint), subIDs[n:], true
+	}
+	return "", nil, false
+}
+
+// formatOctets formats an OCTET STRING according to the simple display hints:
+// text, hexadecimal and decimal. Without hint, the octets are formatted as text
+// if they are printable.
+func formatOctets(octets []byte, hint string) string {
+	format := "a"
+	switch {
+	case strings.ContainsAny(hint, "at"):
+	case strings.Contains(hint, "x"):
+		format = "x"
+	case strings.Contains(hint, "d"):
+		format = "d"
+	case hint == "":
+		for _, b := range octets {
+			if b < 0x20 || b > 0x7e {
+				format = "x"
+				break
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for i, b := range octets {
+		switch format {
+		case "a":
+			sb.WriteByte(b)
+		case "x":
+			if i > 0 {
+				sb.WriteByte(':')
+			}
+			fmt.Fprintf(&sb, "%02x", b)
+		case "d":
+			if i > 0 {
+				sb.WriteByte('.')
+			}
+			sb.WriteString(strconv.Itoa(int(b)))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package snmpreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/snmpreceiver"
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMIBs(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		testFunc func(*testing.T)
+	}{
+		{
+			desc: "No MIBs",
+			testFunc: func(t *testing.T) {
+				mibs, err := loadMIBs(nil)
+				require.NoError(t, err)
+				require.Nil(t, mibs)
+				require.Equal(t, ".3", mibs.indexName(".1.3.6.1.2.1.2.2.1.10", ".3"))
+			},
+		},
+		{
+			desc: "Missing MIB",
+			testFunc: func(t *testing.T) {
+				_, err := loadMIBs([]string{filepath.Join("testdata", "mibs", "MISSING-MIB.txt")})
+				require.ErrorContains(t, err, "failed to load MIBs")
+			},
+		},
+		{
+			desc: "Directory of MIBs",
+			testFunc: func(t *testing.T) {
+				mibs, err := loadMIBs([]string{filepath.Join("testdata", "mibs")})
+				require.NoError(t, err)
+				require.Equal(t, ".1.3.6.1.4.1.99999", mibs.objects["testMIB"].oid)
+				require.Equal(t, ".1.3.6.1.4.1.99999.1.1.1.2", mibs.objects["TEST-MIB::testIfInOctets"].oid)
+				require.Equal(t, []string{"testPeerAddress", "testPeerPort", "testPeerMac"}, mibs.objects["testPeerEntry"].index)
+				require.Equal(t, "255a", mibs.types["TestName"].hint)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, tc.testFunc)
+	}
+}
+
+func TestMIBIndexName(t *testing.T) {
+	mibs, err := loadMIBs([]string{filepath.Join("testdata", "mibs", "TEST-MIB.txt")})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc         string
+		columnOID    string
+		indexString  string
+		expectedName string
+	}{
+		{
+			desc:         "Integer index",
+			columnOID:    ".1.3.6.1.4.1.99999.1.1.1.2",
+			indexString:  ".3",
+			expectedName: ".3",
+		},
+		{
+			desc:         "Augmented table index",
+			columnOID:    ".1.3.6.1.4.1.99999.1.2.1.1",
+			indexString:  ".7",
+			expectedName: ".7",
+		},
+		{
+			desc:         "Textual convention string index",
+			columnOID:    ".1.3.6.1.4.1.99999.1.3.1.2",
+			indexString:  ".4.101.116.104.48",
+			expectedName: ".eth0",
+		},
+		{
+			desc:         "Implied string index",
+			columnOID:    ".1.3.6.1.4.1.99999.1.4.1.2",
+			indexString:  ".97.100.109.105.110",
+			expectedName: ".admin",
+		},
+		{
+			desc:         "Multiple objects index",
+			columnOID:    ".1.3.6.1.4.1.99999.1.5.1.4",
+			indexString:  ".10.0.0.1.179.0.17.34.51.68.85",
+			expectedName: ".10.0.0.1.179.00:11:22:33:44:55",
+		},
+		{
+			desc:         "Index too short is kept",
+			columnOID:    ".1.3.6.1.4.1.99999.1.3.1.2",
+			indexString:  ".4.101.116",
+			expectedName: ".4.101.116",
+		},
+		{
+			desc:         "Index too long is kept",
+			columnOID:    ".1.3.6.1.4.1.99999.1.1.1.2",
+			indexString:  ".3.1",
+			expectedName: ".3.1",
+		},
+		{
+			desc:         "Unknown column is kept",
+			columnOID:    ".1.3.6.1.2.1.2.2.1.10",
+			indexString:  ".4.101.116.104.48",
+			expectedName: ".4.101.116.104.48",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.expectedName, mibs.indexName(tc.columnOID, tc.indexString))
+		})
+	}
+}
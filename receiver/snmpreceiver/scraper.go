@@ -41,6 +41,7 @@ type snmpScraper struct {
 	cfg       *Config
 	settings  receiver.CreateSettings
 	startTime pcommon.Timestamp
+	mibs      *mibModules
 }
 
 type indexedAttributeValues map[string]string
@@ -56,6 +57,9 @@ func newScraper(logger *zap.Logger, cfg *Config, settings receiver.CreateSetting
 
 // start gets the client ready
 func (s *snmpScraper) start(_ context.Context, _ component.Host) (err error) {
+	if s.mibs, err = loadMIBs(s.cfg.MIBs); err != nil {
+		return err
+	}
 	s.client, err = newClient(s.cfg, s.logger)
 	s.startTime = pcommon.NewTimestampFromTime(time.Now())
 	return err
@@ -164,7 +168,7 @@ func (s *snmpScraper) scalarDataToMetric(
 	dataPointAttributes := getScalarDataPointAttributes(configHelper, data.oid)
 
 	// Get resource attributes
-	resourceAttributes, err := getResourceAttributes(configHelper, data.oid, "0", map[string]indexedAttributeValues{}, scalarResourceAttributes)
+	resourceAttributes, err := getResourceAttributes(configHelper, data.oid, "0", "0", map[string]indexedAttributeValues{}, scalarResourceAttributes)
 	if err != nil {
 		return fmt.Errorf(errMsgOIDResourceAttributeEmptyValue, metricName, err)
 	}
@@ -204,15 +208,17 @@ func (s *snmpScraper) indexedDataToMetric(
 	metricName := configHelper.getMetricName(data.columnOID)
 
 	indexString := strings.TrimPrefix(data.oid, data.columnOID)
+	// The index decoded with the loaded MIBs, if any
+	indexName := s.mibs.indexName(data.columnOID, indexString)
 
 	// Get data point attributes
-	dataPointAttributes, err := getIndexedDataPointAttributes(configHelper, data.columnOID, indexString, columnOIDIndexedAttributeValues)
+	dataPointAttributes, err := getIndexedDataPointAttributes(configHelper, data.columnOID, indexString, indexName, columnOIDIndexedAttributeValues)
 	if err != nil {
 		return fmt.Errorf(errMsgOIDAttributeEmptyValue, metricName, err)
 	}
 
 	// Get resource attributes
-	resourceAttributes, err := getResourceAttributes(configHelper, data.columnOID, indexString, columnOIDIndexedResourceAttributeValues, columnOIDScalarResourceAttributeValues)
+	resourceAttributes, err := getResourceAttributes(configHelper, data.columnOID, indexString, indexName, columnOIDIndexedResourceAttributeValues, columnOIDScalarResourceAttributeValues)
 	if err != nil {
 		return fmt.Errorf(errMsgOIDResourceAttributeEmptyValue, metricName, err)
 	}
@@ -296,13 +302,14 @@ func getScalarDataPointAttributes(configHelper *configHelper, oid string) map[st
 // different places.
 // Enum attribute value - comes from the metric config's attribute data
 // Indexed prefix attribute value - comes from the current SNMP data's index and the attribute
-// config's prefix value
+// config's prefix value, the index is decoded with the loaded MIBs if possible
 // Indexed OID attribute value - comes from the previously collected indexed attribute data
 // using the current index and attribute config to access the correct value
 func getIndexedDataPointAttributes(
 	configHelper *configHelper,
 	columnOID string,
 	indexString string,
+	indexName string,
 	columnOIDIndexedAttributeValues map[string]indexedAttributeValues,
 ) (map[string]string, error) {
 	datapointAttributes := map[string]string{}
@@ -321,7 +328,7 @@ func getIndexedDataPointAttributes(
 		oid := configHelper.getAttributeConfigOID(attributeName)
 		switch {
 		case prefix != "":
-			attributeValue = prefix + indexString
+			attributeValue = prefix + indexName
 		case oid != "":
 			attributeValue = columnOIDIndexedAttributeValues[oid][indexString]
 		default:
@@ -340,12 +347,13 @@ func getIndexedDataPointAttributes(
 
 // getResourceAttributes creates a map of key/values for all related resource attributes. Keys
 // will come directly from the metric config's resource attribute values. Values will come
-// from the related attribute config's prefix value plus the index OR the previously collected
+// from the related attribute config's prefix value plus the decoded index OR the previously collected
 // resource attribute indexed data.
 func getResourceAttributes(
 	configHelper *configHelper,
 	columnOID string,
 	indexString string,
+	indexName string,
 	columnOIDIndexedResourceAttributeValues map[string]indexedAttributeValues,
 	columnOIDScalarResourceAttributeValues map[string]string,
 ) (map[string]string, error) {
@@ -357,7 +365,7 @@ func getResourceAttributes(
 		scalarOid := configHelper.getResourceAttributeConfigScalarOID(attributeName)
 		switch {
 		case prefix != "":
-			resourceAttributes[attributeName] = prefix + indexString
+			resourceAttributes[attributeName] = prefix + indexName
 		case oid != "":
 			attributeValue := columnOIDIndexedResourceAttributeValues[oid][indexString]
 
@@ -6,8 +6,12 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -106,9 +110,10 @@ func (receiver *logsReceiver) Start(ctx context.Context, host component.Host) er
 func (receiver *logsReceiver) createQueryReceivers() error {
 	receiver.queryReceivers = nil
 	for i, query := range receiver.config.Queries {
-		if len(query.Logs) == 0 {
+		if !query.HasLogs() {
 			continue
 		}
+		query.SQL = query.Statement(receiver.config.Driver)
 		id := fmt.Sprintf("query-%d: %s", i, query.SQL)
 		queryReceiver := newLogsQueryReceiver(
 			id,
@@ -209,9 +214,15 @@ type logsQueryReceiver struct {
 	db            *sql.DB
 	client        sqlquery.DbClient
 	trackingValue string
+	// trackingValues is the current value of the composite tracking key, one per tracking column.
+	trackingValues []any
+	// rowHashes holds the hash of every row of the previous result set by diff key.
+	rowHashes map[string]string
 	// TODO: Extract persistence into its own component
-	storageClient           storage.Client
-	trackingValueStorageKey string
+	storageClient            storage.Client
+	trackingValueStorageKey  string
+	trackingValuesStorageKey string
+	rowHashesStorageKey      string
 }
 
 func newLogsQueryReceiver(
@@ -234,6 +245,8 @@ func newLogsQueryReceiver(
 	}
 	queryReceiver.trackingValue = queryReceiver.query.TrackingStartValue
 	queryReceiver.trackingValueStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValue")
+	queryReceiver.trackingValuesStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "trackingValues")
+	queryReceiver.rowHashesStorageKey = fmt.Sprintf("%s.%s", queryReceiver.id, "rowHashes")
 	return queryReceiver
 }
 
@@ -250,6 +263,11 @@ func (queryReceiver *logsQueryReceiver) start(ctx context.Context) error {
 	queryReceiver.client = queryReceiver.createClient(sqlquery.DbWrapper{Db: queryReceiver.db}, queryReceiver.query.SQL, queryReceiver.logger, queryReceiver.telemetry)
 
 	queryReceiver.trackingValue = queryReceiver.retrieveTrackingValue(ctx)
+	queryReceiver.trackingValues, err = queryReceiver.retrieveTrackingValues(ctx)
+	if err != nil {
+		return err
+	}
+	queryReceiver.rowHashes = queryReceiver.retrieveRowHashes(ctx)
 
 	return nil
 }
@@ -271,36 +289,287 @@ func (queryReceiver *logsQueryReceiver) retrieveTrackingValue(ctx context.Contex
 
 }
 
+// retrieveTrackingValues retrieves the values of the composite tracking key from storage, if storage is
+// configured and holds values for all the tracking columns. Otherwise, it returns the configured start values.
+func (queryReceiver *logsQueryReceiver) retrieveTrackingValues(ctx context.Context) ([]any, error) {
+	columns := queryReceiver.query.TrackingColumns
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	stored := make([]string, len(columns))
+	for i, column := range columns {
+		stored[i] = column.StartValue
+	}
+	if queryReceiver.storageClient != nil {
+		storedBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.trackingValuesStorageKey)
+		if err == nil && storedBytes != nil {
+			var fromStorage []string
+			if err = json.Unmarshal(storedBytes, &fromStorage); err != nil || len(fromStorage) != len(columns) {
+				queryReceiver.logger.Warn("Ignoring stored tracking values not matching the tracking columns",
+					zap.String("query", queryReceiver.id), zap.ByteString("stored", storedBytes))
+			} else {
+				stored = fromStorage
+			}
+		}
+	}
+
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		value, err := column.Type.Parse(stored[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of tracking column %q: %w", column.Name, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// retrieveRowHashes retrieves the hashes of the rows of the previous result set from storage, if storage
+// is configured. Otherwise, every row of the first result set is considered new.
+func (queryReceiver *logsQueryReceiver) retrieveRowHashes(ctx context.Context) map[string]string {
+	rowHashes := map[string]string{}
+	if len(queryReceiver.query.DiffKeyColumns) == 0 || queryReceiver.storageClient == nil {
+		return rowHashes
+	}
+
+	storedBytes, err := queryReceiver.storageClient.Get(ctx, queryReceiver.rowHashesStorageKey)
+	if err != nil || storedBytes == nil {
+		return rowHashes
+	}
+	if err = json.Unmarshal(storedBytes, &rowHashes); err != nil {
+		queryReceiver.logger.Warn("Ignoring invalid stored row hashes", zap.String("query", queryReceiver.id), zap.Error(err))
+		return map[string]string{}
+	}
+	return rowHashes
+}
+
 func (queryReceiver *logsQueryReceiver) collect(ctx context.Context) (plog.Logs, error) {
 	logs := plog.NewLogs()
 
+	if len(queryReceiver.query.ResultSets) > 0 {
+		return queryReceiver.collectResultSets(ctx)
+	}
+
 	var rows []sqlquery.StringMap
 	var err error
 	observedAt := pcommon.NewTimestampFromTime(time.Now())
-	if queryReceiver.query.TrackingColumn != "" {
+	switch {
+	case len(queryReceiver.trackingValues) > 0:
+		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.trackingValues...)
+	case queryReceiver.query.TrackingColumn != "":
 		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.trackingValue)
-	} else {
-		rows, err = queryReceiver.client.QueryRows(ctx)
+	default:
+		rows, err = queryReceiver.client.QueryRows(ctx, queryReceiver.query.Args()...)
 	}
 	if err != nil {
 		return logs, fmt.Errorf("error getting rows: %w", err)
 	}
 
 	var errs []error
+	var changes []string
+	if len(queryReceiver.query.DiffKeyColumns) > 0 {
+		rows, changes, err = queryReceiver.diffRows(ctx, rows)
+		errs = append(errs, err)
+	}
 	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
 	for logsConfigIndex, logsConfig := range queryReceiver.query.Logs {
-		for _, row := range rows {
+		for i, row := range rows {
 			logRecord := scopeLogs.AppendEmpty()
 			rowToLog(row, logsConfig, logRecord)
 			logRecord.SetObservedTimestamp(observedAt)
+			if changes != nil {
+				logRecord.Attributes().PutStr(rowChangeAttribute, changes[i])
+			}
 			if logsConfigIndex == 0 {
 				errs = append(errs, queryReceiver.storeTrackingValue(ctx, row))
 			}
 		}
 	}
+	errs = append(errs, queryReceiver.storeTrackingValues(ctx, rows))
+	return logs, errors.Join(errs...)
+}
+
+// collectResultSets creates logs from every result set returned by the query. Tracking is not
+// supported for queries with multiple result sets.
+func (queryReceiver *logsQueryReceiver) collectResultSets(ctx context.Context) (plog.Logs, error) {
+	logs := plog.NewLogs()
+
+	observedAt := pcommon.NewTimestampFromTime(time.Now())
+	resultSets, err := queryReceiver.client.QueryResultSets(ctx, queryReceiver.query.Args()...)
+	if err != nil {
+		return logs, fmt.Errorf("error getting rows: %w", err)
+	}
+
+	var errs []error
+	scopeLogs := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i, resultSet := range queryReceiver.query.ResultSets {
+		if len(resultSet.Logs) == 0 {
+			continue
+		}
+		if i >= len(resultSets) {
+			errs = append(errs, fmt.Errorf("result set %d was not returned by the query", i))
+			continue
+		}
+		for _, logsConfig := range resultSet.Logs {
+			for _, row := range resultSets[i] {
+				logRecord := scopeLogs.AppendEmpty()
+				rowToLog(row, logsConfig, logRecord)
+				logRecord.SetObservedTimestamp(observedAt)
+			}
+		}
+	}
 	return logs, errors.Join(errs...)
 }
 
+const (
+	rowChangeAttribute = "sqlquery.row.change"
+	rowChangeInsert    = "insert"
+	rowChangeUpdate    = "update"
+)
+
+// diffRows returns the rows which are new or changed since the previous result set, along with the kind
+// of each change, and persists the hashes of the rows when storage is configured. Rows missing a key
+// column are skipped. Deleted rows are forgotten, a row inserted again with the same key is new.
+func (queryReceiver *logsQueryReceiver) diffRows(ctx context.Context, rows []sqlquery.StringMap) ([]sqlquery.StringMap, []string, error) {
+	var errs []error
+	var changedRows []sqlquery.StringMap
+	var changes []string
+	rowHashes := make(map[string]string, len(rows))
+	for _, row := range rows {
+		key, err := diffKey(queryReceiver.query.DiffKeyColumns, row)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		hash := rowHash(row)
+		rowHashes[key] = hash
+		switch previous, ok := queryReceiver.rowHashes[key]; {
+		case !ok:
+			changes = append(changes, rowChangeInsert)
+		case previous != hash:
+			changes = append(changes, rowChangeUpdate)
+		default:
+			continue
+		}
+		changedRows = append(changedRows, row)
+	}
+
+	// The result sets are the same if no row changed and no row was deleted.
+	unchanged := len(changedRows) == 0 && len(rowHashes) == len(queryReceiver.rowHashes)
+	queryReceiver.rowHashes = rowHashes
+	if unchanged || queryReceiver.storageClient == nil {
+		return changedRows, changes, errors.Join(errs...)
+	}
+
+	storedBytes, err := json.Marshal(rowHashes)
+	if err == nil {
+		err = queryReceiver.storageClient.Set(ctx, queryReceiver.rowHashesStorageKey, storedBytes)
+	}
+	return changedRows, changes, errors.Join(append(errs, err)...)
+}
+
+func diffKey(columns []string, row sqlquery.StringMap) (string, error) {
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		value, ok := row[column]
+		if !ok {
+			return "", fmt.Errorf("diff key column %q not found in row", column)
+		}
+		values[i] = value
+	}
+	key, err := json.Marshal(values)
+	return string(key), err
+}
+
+// rowHash returns a hash of all the columns of a row.
+func rowHash(row sqlquery.StringMap) string {
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	h := fnv.New64a()
+	for _, column := range columns {
+		_, _ = h.Write([]byte(column))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(row[column]))
+		_, _ = h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+// storeTrackingValues advances the composite tracking key to the greatest key of the rows, compared
+// column by column, and persists it when storage is configured. Unlike a single tracking column, the
+// rows don't need to be sorted.
+func (queryReceiver *logsQueryReceiver) storeTrackingValues(ctx context.Context, rows []sqlquery.StringMap) error {
+	columns := queryReceiver.query.TrackingColumns
+	if len(columns) == 0 {
+		return nil
+	}
+
+	var errs []error
+	advanced := false
+	for _, row := range rows {
+		values, err := trackingKey(columns, row)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if compareTrackingKeys(columns, values, queryReceiver.trackingValues) > 0 {
+			queryReceiver.trackingValues = values
+			advanced = true
+		}
+	}
+	if !advanced || queryReceiver.storageClient == nil {
+		return errors.Join(errs...)
+	}
+
+	stored := make([]string, len(columns))
+	for i, column := range columns {
+		stored[i] = formatTrackingValue(column.Type, queryReceiver.trackingValues[i])
+	}
+	storedBytes, err := json.Marshal(stored)
+	if err == nil {
+		err = queryReceiver.storageClient.Set(ctx, queryReceiver.trackingValuesStorageKey, storedBytes)
+	}
+	return errors.Join(append(errs, err)...)
+}
+
+func trackingKey(columns []sqlquery.TrackingColumn, row sqlquery.StringMap) ([]any, error) {
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		raw, ok := row[column.Name]
+		if !ok {
+			return nil, fmt.Errorf("tracking column %q not found in row", column.Name)
+		}
+		value, err := column.Type.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of tracking column %q: %w", column.Name, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// compareTrackingKeys compares two composite keys lexicographically.
+func compareTrackingKeys(columns []sqlquery.TrackingColumn, a, b []any) int {
+	for i, column := range columns {
+		if c := column.Type.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func formatTrackingValue(columnType sqlquery.TrackingColumnType, value any) string {
+	if t, ok := value.(time.Time); ok && columnType == sqlquery.TrackingColumnTypeTimestamp {
+		return t.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(value)
+}
+
 func (queryReceiver *logsQueryReceiver) storeTrackingValue(ctx context.Context, row sqlquery.StringMap) error {
 	if queryReceiver.query.TrackingColumn == "" {
 		return nil
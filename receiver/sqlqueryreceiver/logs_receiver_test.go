@@ -5,13 +5,19 @@ package sqlqueryreceiver // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/storage/storagetest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlqueryreceiver/internal/metadata"
 )
 
 func TestLogsQueryReceiver_Collect(t *testing.T) {
@@ -51,3 +57,213 @@ func TestLogsQueryReceiver_Collect(t *testing.T) {
 		"Observed timestamps of all log records collected in a single scrape should be equal",
 	)
 }
+
+func TestLogsQueryReceiver_TrackingColumns(t *testing.T) {
+	query := sqlquery.Query{
+		SQL: "select * from test_logs where (updated_at, log_id) > (?, ?) order by updated_at, log_id",
+		TrackingColumns: []sqlquery.TrackingColumn{
+			{Name: "updated_at", Type: sqlquery.TrackingColumnTypeTimestamp, StartValue: "2024-01-01T00:00:00Z"},
+			{Name: "log_id", Type: sqlquery.TrackingColumnTypeInt},
+		},
+		Logs: []sqlquery.LogsCfg{{BodyColumn: "log_body"}},
+	}
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "")
+	newReceiver := func(fakeClient *sqlquery.FakeDBClient) *logsQueryReceiver {
+		return newLogsQueryReceiver(
+			"test",
+			query,
+			func() (*sql.DB, error) { return nil, nil },
+			func(sqlquery.Db, string, *zap.Logger, sqlquery.TelemetryConfig) sqlquery.DbClient { return fakeClient },
+			zap.NewNop(),
+			sqlquery.TelemetryConfig{},
+			storageClient,
+		)
+	}
+
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				// log_id 9 sorts before 10 only when compared as an integer.
+				{"updated_at": "2024-01-02T00:00:00Z", "log_id": "10", "log_body": "a"},
+				{"updated_at": "2024-01-02T00:00:00Z", "log_id": "9", "log_body": "b"},
+				{"updated_at": "2024-01-01T12:00:00Z", "log_id": "11", "log_body": "c"},
+			},
+			{},
+		},
+	}
+	queryReceiver := newReceiver(fakeClient)
+	require.NoError(t, queryReceiver.start(context.Background()))
+
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, logs.LogRecordCount())
+	_, err = queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+
+	startValue := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastValue := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, [][]any{{startValue, int64(0)}, {lastValue, int64(10)}}, fakeClient.Args)
+
+	stored, err := storageClient.Get(context.Background(), "test.trackingValues")
+	require.NoError(t, err)
+	assert.JSONEq(t, `["2024-01-02T00:00:00Z", "10"]`, string(stored))
+
+	// A restarted receiver resumes from the stored values.
+	fakeClient = &sqlquery.FakeDBClient{StringMaps: [][]sqlquery.StringMap{{}}}
+	queryReceiver = newReceiver(fakeClient)
+	require.NoError(t, queryReceiver.start(context.Background()))
+	_, err = queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{{lastValue, int64(10)}}, fakeClient.Args)
+}
+
+func TestLogsQueryReceiver_TrackingColumnsFractionalSeconds(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{
+				{"updated_at": "2024-01-02T00:00:00.25Z", "log_body": "a"},
+				// MySQL returns DATETIME columns as text unless parseTime is set.
+				{"updated_at": "2024-01-02 00:00:00.5", "log_body": "b"},
+			},
+			{},
+		},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			TrackingColumns: []sqlquery.TrackingColumn{{Name: "updated_at", Type: sqlquery.TrackingColumnTypeTimestamp}},
+			Logs:            []sqlquery.LogsCfg{{BodyColumn: "log_body"}},
+		},
+		trackingValues: []any{time.Time{}},
+	}
+
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, logs.LogRecordCount())
+	_, err = queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+
+	// The rows of the same second as the last row are not queried again.
+	lastValue := time.Date(2024, 1, 2, 0, 0, 0, 500000000, time.UTC)
+	assert.Equal(t, []any{lastValue}, fakeClient.Args[1])
+}
+
+func TestLogsQueryReceiver_TrackingColumnsInvalidValue(t *testing.T) {
+	queryReceiver := logsQueryReceiver{
+		client: &sqlquery.FakeDBClient{
+			StringMaps: [][]sqlquery.StringMap{
+				{{"log_id": "not a number", "log_body": "a"}, {"log_id": "3", "log_body": "b"}},
+			},
+		},
+		query: sqlquery.Query{
+			TrackingColumns: []sqlquery.TrackingColumn{{Name: "log_id", Type: sqlquery.TrackingColumnTypeInt}},
+			Logs:            []sqlquery.LogsCfg{{BodyColumn: "log_body"}},
+		},
+		trackingValues: []any{int64(0)},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.ErrorContains(t, err, `invalid value of tracking column "log_id"`)
+	assert.Equal(t, 2, logs.LogRecordCount())
+	assert.Equal(t, []any{int64(3)}, queryReceiver.trackingValues)
+}
+
+func TestLogsQueryReceiver_CollectResultSets(t *testing.T) {
+	fakeClient := &sqlquery.FakeDBClient{
+		ResultSets: [][][]sqlquery.StringMap{{
+			{{"col1": "42"}},
+			{{"message": "job failed"}, {"message": "job succeeded"}},
+		}},
+	}
+	queryReceiver := logsQueryReceiver{
+		client: fakeClient,
+		query: sqlquery.Query{
+			Procedure:  "dbo.job_history",
+			Parameters: []string{"nightly"},
+			ResultSets: []sqlquery.ResultSetCfg{
+				{},
+				{Logs: []sqlquery.LogsCfg{{BodyColumn: "message"}}},
+			},
+		},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{{"nightly"}}, fakeClient.Args)
+	require.Equal(t, 2, logs.LogRecordCount())
+	logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	assert.Equal(t, "job failed", logRecords.At(0).Body().Str())
+	assert.Equal(t, "job succeeded", logRecords.At(1).Body().Str())
+}
+
+func TestLogsQueryReceiver_DiffKeyColumns(t *testing.T) {
+	query := sqlquery.Query{
+		SQL:            "select * from audit",
+		DiffKeyColumns: []string{"id"},
+		Logs:           []sqlquery.LogsCfg{{BodyColumn: "status"}},
+	}
+	storageClient := storagetest.NewInMemoryClient(component.KindReceiver, component.NewID(metadata.Type), "")
+	newReceiver := func(fakeClient *sqlquery.FakeDBClient) *logsQueryReceiver {
+		return newLogsQueryReceiver(
+			"test",
+			query,
+			func() (*sql.DB, error) { return nil, nil },
+			func(sqlquery.Db, string, *zap.Logger, sqlquery.TelemetryConfig) sqlquery.DbClient { return fakeClient },
+			zap.NewNop(),
+			sqlquery.TelemetryConfig{},
+			storageClient,
+		)
+	}
+	collect := func(t *testing.T, queryReceiver *logsQueryReceiver) map[string]string {
+		logs, err := queryReceiver.collect(context.Background())
+		require.NoError(t, err)
+		changes := map[string]string{}
+		logRecords := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+		for i := 0; i < logRecords.Len(); i++ {
+			change, ok := logRecords.At(i).Attributes().Get("sqlquery.row.change")
+			require.True(t, ok)
+			changes[logRecords.At(i).Body().Str()] = change.Str()
+		}
+		return changes
+	}
+
+	queryReceiver := newReceiver(&sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"id": "1", "status": "created"}, {"id": "2", "status": "pending"}},
+			{{"id": "1", "status": "created"}, {"id": "2", "status": "pending"}},
+			{{"id": "1", "status": "created"}, {"id": "2", "status": "approved"}, {"id": "3", "status": "rejected"}},
+		},
+	})
+	require.NoError(t, queryReceiver.start(context.Background()))
+	assert.Equal(t, map[string]string{"created": "insert", "pending": "insert"}, collect(t, queryReceiver))
+	assert.Empty(t, collect(t, queryReceiver))
+	assert.Equal(t, map[string]string{"approved": "update", "rejected": "insert"}, collect(t, queryReceiver))
+
+	// A restarted receiver compares the result set against the stored one, rows deleted meanwhile are forgotten.
+	queryReceiver = newReceiver(&sqlquery.FakeDBClient{
+		StringMaps: [][]sqlquery.StringMap{
+			{{"id": "1", "status": "created"}, {"id": "2", "status": "shipped"}},
+			{{"id": "1", "status": "created"}, {"id": "2", "status": "shipped"}, {"id": "3", "status": "rejected"}},
+		},
+	})
+	require.NoError(t, queryReceiver.start(context.Background()))
+	assert.Equal(t, map[string]string{"shipped": "update"}, collect(t, queryReceiver))
+	assert.Equal(t, map[string]string{"rejected": "insert"}, collect(t, queryReceiver))
+}
+
+func TestLogsQueryReceiver_DiffKeyColumnsMissingColumn(t *testing.T) {
+	queryReceiver := logsQueryReceiver{
+		client: &sqlquery.FakeDBClient{
+			StringMaps: [][]sqlquery.StringMap{
+				{{"status": "created"}, {"id": "2", "status": "pending"}},
+			},
+		},
+		query: sqlquery.Query{
+			DiffKeyColumns: []string{"id"},
+			Logs:           []sqlquery.LogsCfg{{BodyColumn: "status"}},
+		},
+		rowHashes: map[string]string{},
+	}
+	logs, err := queryReceiver.collect(context.Background())
+	assert.ErrorContains(t, err, `diff key column "id" not found in row`)
+	require.Equal(t, 1, logs.LogRecordCount())
+	assert.Equal(t, "pending", logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
@@ -4,7 +4,9 @@
 package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -25,6 +27,28 @@ type Config struct {
 	Port     uint                `mapstructure:"port"`
 	Server   string              `mapstructure:"server"`
 	Username string              `mapstructure:"username"`
+
+	// QuerySample configures the sampling of the most expensive queries, emitted as logs.
+	QuerySample QuerySampleConfig `mapstructure:"query_sample"`
+}
+
+const (
+	querySampleOrderByCPU      = "cpu"
+	querySampleOrderByDuration = "duration"
+)
+
+// QuerySampleConfig defines the periodic sampling of sys.dm_exec_query_stats by the logs receiver.
+type QuerySampleConfig struct {
+	// Enabled enables the query sampling. It requires a direct connection to the SQL Server instance.
+	Enabled bool `mapstructure:"enabled"`
+	// CollectionInterval is the interval between two samples. Only the queries executed since the
+	// previous sample are considered.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// TopN is the maximum number of queries emitted per sample.
+	TopN int `mapstructure:"top_n"`
+	// OrderBy is the statistic used to rank the queries, "cpu" for the total worker time or
+	// "duration" for the total elapsed time.
+	OrderBy string `mapstructure:"order_by"`
 }
 
 func (cfg *Config) Validate() error {
@@ -40,5 +64,20 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	if cfg.QuerySample.Enabled {
+		if !directDBConnectionEnabled(cfg) {
+			return errors.New("query_sample requires a direct connection to the SQL Server instance: [server, port, username, password] must be configured")
+		}
+		if cfg.QuerySample.CollectionInterval <= 0 {
+			return errors.New("query_sample::collection_interval must be positive")
+		}
+		if cfg.QuerySample.TopN <= 0 {
+			return errors.New("query_sample::top_n must be positive")
+		}
+		if cfg.QuerySample.OrderBy != querySampleOrderByCPU && cfg.QuerySample.OrderBy != querySampleOrderByDuration {
+			return fmt.Errorf("query_sample::order_by must be %q or %q, got %q", querySampleOrderByCPU, querySampleOrderByDuration, cfg.QuerySample.OrderBy)
+		}
+	}
+
 	return nil
 }
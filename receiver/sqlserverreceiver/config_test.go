@@ -6,6 +6,7 @@ package sqlserverreceiver
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -63,6 +64,53 @@ func TestValidate(t *testing.T) {
 			},
 			expectedSuccess: true,
 		},
+		{
+			desc: "valid config with query sample",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Server:           "0.0.0.0",
+				Username:         "sa",
+				Password:         "password",
+				Port:             1433,
+				QuerySample: QuerySampleConfig{
+					Enabled:            true,
+					CollectionInterval: time.Minute,
+					TopN:               20,
+					OrderBy:            querySampleOrderByDuration,
+				},
+			},
+			expectedSuccess: true,
+		},
+		{
+			desc: "invalid config with query sample without direct connection",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				QuerySample: QuerySampleConfig{
+					Enabled:            true,
+					CollectionInterval: time.Minute,
+					TopN:               20,
+					OrderBy:            querySampleOrderByCPU,
+				},
+			},
+			expectedSuccess: false,
+		},
+		{
+			desc: "invalid config with query sample ordered by an unknown statistic",
+			cfg: &Config{
+				ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+				Server:           "0.0.0.0",
+				Username:         "sa",
+				Password:         "password",
+				Port:             1433,
+				QuerySample: QuerySampleConfig{
+					Enabled:            true,
+					CollectionInterval: time.Minute,
+					TopN:               20,
+					OrderBy:            "reads",
+				},
+			},
+			expectedSuccess: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -41,6 +41,11 @@ func TestCreateMetricsReceiver(t *testing.T) {
 						InitialDelay:       time.Second,
 					},
 					MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig(),
+					QuerySample: QuerySampleConfig{
+						CollectionInterval: time.Minute,
+						TopN:               20,
+						OrderBy:            querySampleOrderByCPU,
+					},
 				}
 
 				require.Equal(t, expectedCfg, factory.CreateDefaultConfig())
@@ -0,0 +1,204 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/sqlquery"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/sqlserverreceiver/internal/metadata"
+)
+
+// querySampleReceiver periodically emits the most expensive queries of sys.dm_exec_query_stats as log records.
+type querySampleReceiver struct {
+	cfg                *Config
+	settings           receiver.CreateSettings
+	consumer           consumer.Logs
+	dbProviderFunc     sqlquery.DbProviderFunc
+	clientProviderFunc sqlquery.ClientProviderFunc
+	db                 *sql.DB
+	client             sqlquery.DbClient
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.CreateSettings,
+	receiverCfg component.Config,
+	logsConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	cfg, ok := receiverCfg.(*Config)
+	if !ok {
+		return nil, errConfigNotSQLServer
+	}
+	return &querySampleReceiver{
+		cfg:      cfg,
+		settings: params,
+		consumer: logsConsumer,
+		dbProviderFunc: func() (*sql.DB, error) {
+			return sql.Open("sqlserver", getDBConnectionString(cfg))
+		},
+		clientProviderFunc: sqlquery.NewDbClient,
+	}, nil
+}
+
+// Start begins the query sampling. Like the metrics scrapers, the receiver does nothing if the sampling
+// is disabled, a message is logged at the INFO level in that case.
+func (r *querySampleReceiver) Start(context.Context, component.Host) error {
+	if !r.cfg.QuerySample.Enabled {
+		r.settings.Logger.Info("No query samples will be collected from the SQL Server: query_sample is not enabled.")
+		return nil
+	}
+
+	var err error
+	r.db, err = r.dbProviderFunc()
+	if err != nil {
+		return fmt.Errorf("failed to open Db connection: %w", err)
+	}
+	query := getSQLServerQueryStatsQuery(r.cfg.InstanceName, r.cfg.QuerySample.TopN, r.cfg.QuerySample.OrderBy, r.cfg.QuerySample.CollectionInterval)
+	r.client = r.clientProviderFunc(sqlquery.DbWrapper{Db: r.db}, query, r.settings.Logger, sqlquery.TelemetryConfig{})
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.wg.Add(1)
+	go r.run(ctx)
+	return nil
+}
+
+func (r *querySampleReceiver) run(ctx context.Context) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.QuerySample.CollectionInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		logs, err := r.collect(ctx)
+		if err != nil {
+			r.settings.Logger.Error("Failed to sample the SQL Server queries", zap.Error(err))
+		}
+		if logs.LogRecordCount() == 0 {
+			continue
+		}
+		if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+			r.settings.Logger.Error("Failed to consume the SQL Server query samples", zap.Error(err))
+		}
+	}
+}
+
+func (r *querySampleReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.db != nil {
+		return r.db.Close()
+	}
+	return nil
+}
+
+// collect returns a log record per sampled query. The log records are grouped by database, in the
+// order of the ranking.
+func (r *querySampleReceiver) collect(ctx context.Context) (plog.Logs, error) {
+	const computerNameKey = "computer_name"
+	const databaseNameKey = "database_name"
+	const queryTextKey = "query_text"
+
+	logs := plog.NewLogs()
+	rows, err := r.client.QueryRows(ctx)
+	if err != nil {
+		if !errors.Is(err, sqlquery.ErrNullValueWarning) {
+			return logs, fmt.Errorf("querySampleReceiver: %w", err)
+		}
+		r.settings.Logger.Warn("problems encountered getting query sample rows", zap.Error(err))
+	}
+
+	var errs []error
+	now := pcommon.NewTimestampFromTime(time.Now())
+	records := map[string]plog.LogRecordSlice{}
+	for i, row := range rows {
+		databaseRecords, ok := records[row[databaseNameKey]]
+		if !ok {
+			resourceLogs := logs.ResourceLogs().AppendEmpty()
+			rb := metadata.NewResourceBuilder(r.cfg.ResourceAttributes)
+			rb.SetSqlserverComputerName(row[computerNameKey])
+			rb.SetSqlserverInstanceName(row[instanceNameKey])
+			rb.SetSqlserverDatabaseName(row[databaseNameKey])
+			rb.Emit().MoveTo(resourceLogs.Resource())
+
+			scopeLogs := resourceLogs.ScopeLogs().AppendEmpty()
+			scopeLogs.Scope().SetName("otelcol/sqlserverreceiver")
+			scopeLogs.Scope().SetVersion(r.settings.BuildInfo.Version)
+			databaseRecords = scopeLogs.LogRecords()
+			records[row[databaseNameKey]] = databaseRecords
+		}
+
+		record := databaseRecords.AppendEmpty()
+		record.SetTimestamp(now)
+		record.SetObservedTimestamp(now)
+		record.SetSeverityNumber(plog.SeverityNumberInfo)
+		record.Body().SetStr(row[queryTextKey])
+		if err := setQuerySampleAttributes(row, record.Attributes()); err != nil {
+			errs = append(errs, fmt.Errorf("row %d: %w", i, err))
+		}
+	}
+
+	return logs, errors.Join(errs...)
+}
+
+// setQuerySampleAttributes sets the identifiers and the statistics of a sampled query. The CPU and
+// elapsed times are converted from microseconds to seconds.
+func setQuerySampleAttributes(row sqlquery.StringMap, attrs pcommon.Map) error {
+	attrs.PutStr("db.system", "mssql")
+	attrs.PutStr("sqlserver.query.hash", row["query_hash"])
+	attrs.PutStr("sqlserver.query.plan_hash", row["query_plan_hash"])
+	attrs.PutStr("sqlserver.query.plan_handle", row["plan_handle"])
+
+	var errs []error
+	for column, attribute := range map[string]string{
+		"execution_count":      "sqlserver.query.execution_count",
+		"total_logical_reads":  "sqlserver.query.logical_reads",
+		"total_physical_reads": "sqlserver.query.physical_reads",
+		"total_logical_writes": "sqlserver.query.logical_writes",
+		"total_rows":           "sqlserver.query.rows",
+	} {
+		val, err := strconv.ParseInt(row[column], 10, 64)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		attrs.PutInt(attribute, val)
+	}
+	for column, attribute := range map[string]string{
+		"total_worker_time_us":  "sqlserver.query.cpu_time",
+		"total_elapsed_time_us": "sqlserver.query.duration",
+	} {
+		val, err := strconv.ParseFloat(row[column], 64)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		attrs.PutDouble(attribute, val/1e6)
+	}
+	return errors.Join(errs...)
+}
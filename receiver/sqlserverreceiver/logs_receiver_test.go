@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sqlserverreceiver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+func TestQuerySampleCollect(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "sa"
+	cfg.Password = "password"
+	cfg.Port = 1433
+	cfg.Server = "0.0.0.0"
+	cfg.QuerySample.Enabled = true
+	cfg.MetricsBuilderConfig.ResourceAttributes.SqlserverInstanceName.Enabled = true
+	require.NoError(t, cfg.Validate())
+
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	sampler := r.(*querySampleReceiver)
+	require.NoError(t, sampler.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, sampler.Shutdown(context.Background())) }()
+
+	sampler.client = mockClient{
+		instanceName: cfg.InstanceName,
+		SQL:          getSQLServerQueryStatsQuery(cfg.InstanceName, 20, querySampleOrderByCPU, time.Minute),
+	}
+
+	actualLogs, err := sampler.collect(context.Background())
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "expectedQuerySamples.yaml")
+	// Uncomment line below to re-generate expected logs.
+	// golden.WriteLogs(t, expectedFile, actualLogs)
+	expectedLogs, err := golden.ReadLogs(expectedFile)
+	require.NoError(t, err)
+
+	assert.NoError(t, plogtest.CompareLogs(expectedLogs, actualLogs,
+		plogtest.IgnoreTimestamp(),
+		plogtest.IgnoreObservedTimestamp()))
+}
+
+func TestQuerySampleInvalidQuery(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "sa"
+	cfg.Password = "password"
+	cfg.Port = 1433
+	cfg.Server = "0.0.0.0"
+	cfg.QuerySample.Enabled = true
+
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	sampler := r.(*querySampleReceiver)
+	sampler.client = mockClient{SQL: "Invalid SQL query"}
+
+	actualLogs, err := sampler.collect(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, 0, actualLogs.LogRecordCount())
+}
+
+func TestQuerySampleDisabled(t *testing.T) {
+	r, err := createLogsReceiver(context.Background(), receivertest.NewNopCreateSettings(), createDefaultConfig(), consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Nil(t, r.(*querySampleReceiver).db)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
@@ -6,6 +6,7 @@ package sqlserverreceiver // import "github.com/open-telemetry/opentelemetry-col
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 // Direct access to queries is not recommended: The receiver allows filtering based on
@@ -333,3 +334,47 @@ func getSQLServerPropertiesQuery(instanceName string) string {
 
 	return fmt.Sprintf(sqlServerProperties, "")
 }
+
+// sqlServerQueryStatsQuery returns the statements with the highest total CPU or elapsed time among the ones
+// executed during the lookback period. The statistics are the cumulative ones of the cached plan.
+const sqlServerQueryStatsQuery = `
+SET DEADLOCK_PRIORITY -10;
+SELECT TOP(%d)
+	 REPLACE(@@SERVERNAME,'\',':') AS [sql_instance]
+	,HOST_NAME() AS [computer_name]
+	,DB_NAME(CONVERT(int, pa.[value])) AS [database_name]
+	,CONVERT(varchar(18), qs.[query_hash], 1) AS [query_hash]
+	,CONVERT(varchar(18), qs.[query_plan_hash], 1) AS [query_plan_hash]
+	,CONVERT(varchar(130), qs.[plan_handle], 1) AS [plan_handle]
+	,SUBSTRING(st.[text], (qs.[statement_start_offset] / 2) + 1,
+		((CASE qs.[statement_end_offset] WHEN -1 THEN DATALENGTH(st.[text]) ELSE qs.[statement_end_offset] END
+			- qs.[statement_start_offset]) / 2) + 1) AS [query_text]
+	,qs.[execution_count]
+	,qs.[total_worker_time] AS [total_worker_time_us]
+	,qs.[total_elapsed_time] AS [total_elapsed_time_us]
+	,qs.[total_logical_reads]
+	,qs.[total_physical_reads]
+	,qs.[total_logical_writes]
+	,qs.[total_rows]
+FROM sys.dm_exec_query_stats AS qs
+CROSS APPLY sys.dm_exec_sql_text(qs.[sql_handle]) AS st
+OUTER APPLY (
+	SELECT [value] FROM sys.dm_exec_plan_attributes(qs.[plan_handle]) WHERE [attribute] = 'dbid'
+) AS pa
+WHERE qs.[last_execution_time] >= DATEADD(SECOND, -%d, GETDATE())%s
+ORDER BY qs.[%s] DESC
+`
+
+func getSQLServerQueryStatsQuery(instanceName string, topN int, orderBy string, lookback time.Duration) string {
+	var whereClause string
+	if instanceName != "" {
+		whereClause = fmt.Sprintf("\n\tAND @@SERVERNAME = '%s'", instanceName)
+	}
+
+	orderByColumn := "total_worker_time"
+	if orderBy == querySampleOrderByDuration {
+		orderByColumn = "total_elapsed_time"
+	}
+
+	return fmt.Sprintf(sqlServerQueryStatsQuery, topN, int64(lookback.Seconds()), whereClause, orderByColumn)
+}
@@ -8,6 +8,7 @@ import (
 	"path"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -55,6 +56,22 @@ func TestQueryContents(t *testing.T) {
 			getQuery:                 getSQLServerPropertiesQuery,
 			expectedQueryValFilename: "propertyQueryWithInstanceName.txt",
 		},
+		{
+			name:         "Test query stats query without instance name",
+			instanceName: "",
+			getQuery: func(instanceName string) string {
+				return getSQLServerQueryStatsQuery(instanceName, 20, querySampleOrderByCPU, time.Minute)
+			},
+			expectedQueryValFilename: "queryStatsQueryWithoutInstanceName.txt",
+		},
+		{
+			name:         "Test query stats query with instance name",
+			instanceName: "instanceName",
+			getQuery: func(instanceName string) string {
+				return getSQLServerQueryStatsQuery(instanceName, 50, querySampleOrderByDuration, 5*time.Minute)
+			},
+			expectedQueryValFilename: "queryStatsQueryWithInstanceName.txt",
+		},
 	}
 
 	for _, tt := range queryTests {
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -162,6 +163,8 @@ func (mc mockClient) QueryRows(context.Context, ...any) ([]sqlquery.StringMap, e
 		queryResults, err = readFile("perfCounterQueryData.txt")
 	case getSQLServerPropertiesQuery(mc.instanceName):
 		queryResults, err = readFile("propertyQueryData.txt")
+	case getSQLServerQueryStatsQuery(mc.instanceName, 20, querySampleOrderByCPU, time.Minute):
+		queryResults, err = readFile("queryStatsQueryData.txt")
 	default:
 		return nil, fmt.Errorf("No valid query found")
 	}
@@ -171,3 +174,11 @@ func (mc mockClient) QueryRows(context.Context, ...any) ([]sqlquery.StringMap, e
 	}
 	return queryResults, nil
 }
+
+func (mc mockClient) QueryResultSets(ctx context.Context, args ...any) ([][]sqlquery.StringMap, error) {
+	queryResults, err := mc.QueryRows(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	return [][]sqlquery.StringMap{queryResults}, nil
+}
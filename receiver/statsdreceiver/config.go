@@ -5,6 +5,7 @@ package statsdreceiver // import "github.com/open-telemetry/opentelemetry-collec
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lightstep/go-expohisto/structure"
@@ -12,16 +13,19 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/protocol"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
 )
 
 // Config defines configuration for StatsD receiver.
 type Config struct {
-	NetAddr               confignet.AddrConfig             `mapstructure:",squash"`
-	AggregationInterval   time.Duration                    `mapstructure:"aggregation_interval"`
-	EnableMetricType      bool                             `mapstructure:"enable_metric_type"`
-	EnableSimpleTags      bool                             `mapstructure:"enable_simple_tags"`
-	IsMonotonicCounter    bool                             `mapstructure:"is_monotonic_counter"`
-	TimerHistogramMapping []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
+	NetAddr                confignet.AddrConfig             `mapstructure:",squash"`
+	AggregationInterval    time.Duration                    `mapstructure:"aggregation_interval"`
+	EnableMetricType       bool                             `mapstructure:"enable_metric_type"`
+	EnableSimpleTags       bool                             `mapstructure:"enable_simple_tags"`
+	IsMonotonicCounter     bool                             `mapstructure:"is_monotonic_counter"`
+	EnableContainerTagging bool                             `mapstructure:"enable_container_tagging"`
+	EnableOriginDetection  bool                             `mapstructure:"enable_origin_detection"`
+	TimerHistogramMapping  []protocol.TimerHistogramMapping `mapstructure:"timer_histogram_mapping"`
 }
 
 func (c *Config) Validate() error {
@@ -31,6 +35,10 @@ func (c *Config) Validate() error {
 		errs = multierr.Append(errs, fmt.Errorf("aggregation_interval must be a positive duration"))
 	}
 
+	if c.EnableOriginDetection && transport.NewTransport(strings.ToLower(string(c.NetAddr.Transport))) != transport.UnixGram {
+		errs = multierr.Append(errs, fmt.Errorf("enable_origin_detection is only supported with the unixgram transport"))
+	}
+
 	var TimerHistogramMappingMissingObjectName bool
 	for _, eachMap := range c.TimerHistogramMapping {
 
@@ -41,7 +41,8 @@ func TestLoadConfig(t *testing.T) {
 					Endpoint:  "localhost:12345",
 					Transport: confignet.TransportTypeUDP6,
 				},
-				AggregationInterval: 70 * time.Second,
+				AggregationInterval:    70 * time.Second,
+				EnableContainerTagging: true,
 				TimerHistogramMapping: []protocol.TimerHistogramMapping{
 					{
 						StatsdType:   "histogram",
@@ -94,6 +95,7 @@ func TestValidate(t *testing.T) {
 		statsdTypeNotSupportErr        = "statsd_type is not a supported mapping for histogram and timing metrics: %s"
 		observerTypeNotSupportErr      = "observer_type is not supported for histogram and timing metrics: %s"
 		invalidHistogramErr            = "histogram configuration requires observer_type: histogram"
+		originDetectionTransportErr    = "enable_origin_detection is only supported with the unixgram transport"
 	)
 
 	tests := []test{
@@ -163,6 +165,15 @@ func TestValidate(t *testing.T) {
 			},
 			expectedErr: invalidHistogramErr,
 		},
+		{
+			name: "originDetectionWithoutUnixgram",
+			cfg: &Config{
+				NetAddr:               confignet.AddrConfig{Endpoint: "localhost:8125", Transport: confignet.TransportTypeUDP},
+				AggregationInterval:   20 * time.Second,
+				EnableOriginDetection: true,
+			},
+			expectedErr: originDetectionTransportErr,
+		},
 		{
 			name: "negativeAggregationInterval",
 			cfg: &Config{
@@ -76,20 +76,42 @@ var defaultObserverCategory = ObserverCategory{
 	method: DefaultObserverType,
 }
 
+// containerTags maps the DogStatsD tags identifying the workload which sent a metric,
+// including the container ID field of the datagram, to resource attributes.
+var containerTags = map[attribute.Key]string{
+	semconv.AttributeContainerID: semconv.AttributeContainerID,
+	"container_id":               semconv.AttributeContainerID,
+	"container_name":             semconv.AttributeContainerName,
+	"dd.internal.entity_id":      semconv.AttributeK8SPodUID,
+	"kube_namespace":             semconv.AttributeK8SNamespaceName,
+	"pod_name":                   semconv.AttributeK8SPodName,
+	"kube_container_name":        semconv.AttributeK8SContainerName,
+	"kube_deployment":            semconv.AttributeK8SDeploymentName,
+}
+
 // StatsDParser supports the Parse method for parsing StatsD messages with Tags.
 type StatsDParser struct {
-	instrumentsByAddress map[netAddr]*instruments
-	enableMetricType     bool
-	enableSimpleTags     bool
-	isMonotonicCounter   bool
-	timerEvents          ObserverCategory
-	histogramEvents      ObserverCategory
-	lastIntervalTime     time.Time
-	BuildInfo            component.BuildInfo
+	instrumentsByAddress   map[instrumentsKey]*instruments
+	enableMetricType       bool
+	enableSimpleTags       bool
+	isMonotonicCounter     bool
+	enableContainerTagging bool
+	timerEvents            ObserverCategory
+	histogramEvents        ObserverCategory
+	lastIntervalTime       time.Time
+	BuildInfo              component.BuildInfo
+}
+
+// instrumentsKey identifies the metrics aggregated together, those of a client and of
+// the workload which sent them.
+type instrumentsKey struct {
+	addr     netAddr
+	resource attribute.Distinct
 }
 
 type instruments struct {
 	addr                   net.Addr
+	resource               attribute.Set
 	gauges                 map[statsDMetricDescription]pmetric.ScopeMetrics
 	counters               map[statsDMetricDescription]pmetric.ScopeMetrics
 	summaries              map[statsDMetricDescription]summaryMetric
@@ -97,9 +119,10 @@ type instruments struct {
 	timersAndDistributions []pmetric.ScopeMetrics
 }
 
-func newInstruments(addr net.Addr) *instruments {
+func newInstruments(addr net.Addr, resource attribute.Set) *instruments {
 	return &instruments{
 		addr:       addr,
+		resource:   resource,
 		gauges:     make(map[statsDMetricDescription]pmetric.ScopeMetrics),
 		counters:   make(map[statsDMetricDescription]pmetric.ScopeMetrics),
 		summaries:  make(map[statsDMetricDescription]summaryMetric),
@@ -156,10 +179,10 @@ func (t MetricType) FullName() TypeName {
 
 func (p *StatsDParser) resetState(when time.Time) {
 	p.lastIntervalTime = when
-	p.instrumentsByAddress = make(map[netAddr]*instruments)
+	p.instrumentsByAddress = make(map[instrumentsKey]*instruments)
 }
 
-func (p *StatsDParser) Initialize(enableMetricType bool, enableSimpleTags bool, isMonotonicCounter bool, sendTimerHistogram []TimerHistogramMapping) error {
+func (p *StatsDParser) Initialize(enableMetricType bool, enableSimpleTags bool, isMonotonicCounter bool, enableContainerTagging bool, sendTimerHistogram []TimerHistogramMapping) error {
 	p.resetState(timeNowFunc())
 
 	p.histogramEvents = defaultObserverCategory
@@ -167,6 +190,7 @@ func (p *StatsDParser) Initialize(enableMetricType bool, enableSimpleTags bool,
 	p.enableMetricType = enableMetricType
 	p.enableSimpleTags = enableSimpleTags
 	p.isMonotonicCounter = isMonotonicCounter
+	p.enableContainerTagging = enableContainerTagging
 	// Note: validation occurs in ("../".Config).validate()
 	for _, eachMap := range sendTimerHistogram {
 		switch eachMap.StatsdType {
@@ -202,6 +226,9 @@ func (p *StatsDParser) GetMetrics() []BatchMetrics {
 			Metrics: pmetric.NewMetrics(),
 		}
 		rm := batch.Metrics.ResourceMetrics().AppendEmpty()
+		for _, kv := range instrument.resource.ToSlice() {
+			rm.Resource().Attributes().PutStr(string(kv.Key), kv.Value.AsString())
+		}
 		for _, metric := range instrument.gauges {
 			p.copyMetricAndScope(rm, metric)
 		}
@@ -279,11 +306,19 @@ func (p *StatsDParser) Aggregate(line string, addr net.Addr) error {
 		return err
 	}
 
-	addrKey := newNetAddr(addr)
-	instrument, ok := p.instrumentsByAddress[addrKey]
+	var resource attribute.Set
+	if p.enableContainerTagging {
+		resource, parsedMetric.description.attrs = splitContainerTags(parsedMetric.description.attrs)
+	}
+
+	key := instrumentsKey{addr: newNetAddr(addr)}
+	if resource.Len() != 0 {
+		key.resource = resource.Equivalent()
+	}
+	instrument, ok := p.instrumentsByAddress[key]
 	if !ok {
-		instrument = newInstruments(addr)
-		p.instrumentsByAddress[addrKey] = instrument
+		instrument = newInstruments(addr, resource)
+		p.instrumentsByAddress[key] = instrument
 	}
 
 	switch parsedMetric.description.metricType {
@@ -478,6 +513,32 @@ func parseMessageToMetric(line string, enableMetricType bool, enableSimpleTags b
 	return result, nil
 }
 
+// splitContainerTags moves the tags identifying the workload out of the attributes of a
+// metric, into resource attributes.
+func splitContainerTags(attrs attribute.Set) (resource attribute.Set, rest attribute.Set) {
+	var resourceKVs, restKVs []attribute.KeyValue
+	for _, kv := range attrs.ToSlice() {
+		key, ok := containerTags[kv.Key]
+		if !ok {
+			restKVs = append(restKVs, kv)
+			continue
+		}
+		value := kv.Value.AsString()
+		if key == semconv.AttributeContainerID {
+			// Recent clients prefix the container ID field with its kind.
+			value = strings.TrimPrefix(value, "ci-")
+		}
+		resourceKVs = append(resourceKVs, attribute.String(key, value))
+	}
+	if len(resourceKVs) == 0 {
+		return resource, attrs
+	}
+	if len(restKVs) != 0 {
+		rest = attribute.NewSet(restKVs...)
+	}
+	return attribute.NewSet(resourceKVs...), rest
+}
+
 type netAddr struct {
 	Network string
 	String  string
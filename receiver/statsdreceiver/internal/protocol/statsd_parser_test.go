@@ -1040,10 +1040,10 @@ func TestStatsDParser_Aggregate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(false, false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -1149,7 +1149,7 @@ func TestStatsDParser_AggregateByAddress(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(true, false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			for i, addr := range tt.addresses {
 				for _, line := range tt.input[i] {
@@ -1157,7 +1157,7 @@ func TestStatsDParser_AggregateByAddress(t *testing.T) {
 				}
 			}
 			for i, addr := range tt.addresses {
-				addrKey := newNetAddr(addr)
+				addrKey := instrumentsKey{addr: newNetAddr(addr)}
 				assert.Equal(t, tt.expectedGauges[i], p.instrumentsByAddress[addrKey].gauges)
 			}
 		})
@@ -1257,10 +1257,10 @@ func TestStatsDParser_AggregateWithMetricType(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(true, false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -1327,10 +1327,10 @@ func TestStatsDParser_AggregateWithIsMonotonicCounter(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, true, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+			assert.NoError(t, p.Initialize(false, false, true, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 			p.lastIntervalTime = time.Unix(611, 0)
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -1449,9 +1449,9 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}))
+			assert.NoError(t, p.Initialize(false, false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "summary"}, {StatsdType: "histogram", ObserverType: "summary"}}))
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-			addrKey := newNetAddr(addr)
+			addrKey := instrumentsKey{addr: newNetAddr(addr)}
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
 			}
@@ -1466,15 +1466,15 @@ func TestStatsDParser_AggregateTimerWithSummary(t *testing.T) {
 
 func TestStatsDParser_Initialize(t *testing.T) {
 	p := &StatsDParser{}
-	assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+	assert.NoError(t, p.Initialize(true, false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
 	teststatsdDMetricdescription := statsDMetricDescription{
 		name:       "test",
 		metricType: "g",
 		attrs:      *attribute.EmptySet(),
 	}
 	addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
-	addrKey := newNetAddr(addr)
-	instrument := newInstruments(addr)
+	addrKey := instrumentsKey{addr: newNetAddr(addr)}
+	instrument := newInstruments(addr, attribute.Set{})
 	instrument.gauges[teststatsdDMetricdescription] = pmetric.ScopeMetrics{}
 	p.instrumentsByAddress[addrKey] = instrument
 	assert.Equal(t, 1, len(p.instrumentsByAddress))
@@ -1485,8 +1485,8 @@ func TestStatsDParser_Initialize(t *testing.T) {
 
 func TestStatsDParser_GetMetricsWithMetricType(t *testing.T) {
 	p := &StatsDParser{}
-	assert.NoError(t, p.Initialize(true, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
-	instrument := newInstruments(nil)
+	assert.NoError(t, p.Initialize(true, false, false, false, []TimerHistogramMapping{{StatsdType: "timer", ObserverType: "gauge"}, {StatsdType: "histogram", ObserverType: "gauge"}}))
+	instrument := newInstruments(nil, attribute.Set{})
 	instrument.gauges[testDescription("statsdTestMetric1", "g",
 		[]string{"mykey", "metric_type"}, []string{"myvalue", "gauge"})] = buildGaugeMetric(
 		testStatsDMetric(
@@ -1541,7 +1541,7 @@ func TestStatsDParser_GetMetricsWithMetricType(t *testing.T) {
 			weights: []float64{1, 1, 1, 1},
 		},
 	}
-	p.instrumentsByAddress[netAddr{}] = instrument
+	p.instrumentsByAddress[instrumentsKey{}] = instrument
 	metrics := p.GetMetrics()[0].Metrics
 	assert.Equal(t, 5, metrics.ResourceMetrics().At(0).ScopeMetrics().Len())
 }
@@ -1598,7 +1598,7 @@ func TestStatsDParser_Mappings(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			p := &StatsDParser{}
 
-			assert.NoError(t, p.Initialize(false, false, false, tc.mapping))
+			assert.NoError(t, p.Initialize(false, false, false, false, tc.mapping))
 
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			assert.NoError(t, p.Aggregate("H:10|h", addr))
@@ -1632,7 +1632,7 @@ func TestStatsDParser_ScopeIsIncluded(t *testing.T) {
 	}
 	testAddress, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 
-	err := p.Initialize(true, false, false,
+	err := p.Initialize(true, false, false, false,
 		[]TimerHistogramMapping{
 			{StatsdType: "timer", ObserverType: "summary"},
 			{StatsdType: "histogram", ObserverType: "histogram"},
@@ -1902,7 +1902,7 @@ func TestStatsDParser_AggregateTimerWithHistogram(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var err error
 			p := &StatsDParser{}
-			assert.NoError(t, p.Initialize(false, false, false, tt.mapping))
+			assert.NoError(t, p.Initialize(false, false, false, false, tt.mapping))
 			addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
 			for _, line := range tt.input {
 				err = p.Aggregate(line, addr)
@@ -1913,3 +1913,45 @@ func TestStatsDParser_AggregateTimerWithHistogram(t *testing.T) {
 		})
 	}
 }
+
+func TestStatsDParser_AggregateWithContainerTagging(t *testing.T) {
+	p := &StatsDParser{}
+	require.NoError(t, p.Initialize(false, false, false, true, nil))
+	addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+
+	require.NoError(t, p.Aggregate("test.metric:1|c|#mykey:myvalue,kube_namespace:default,pod_name:app-1|c:ci-abc", addr))
+	require.NoError(t, p.Aggregate("test.metric:2|c|#mykey:myvalue,kube_namespace:default,pod_name:app-1|c:ci-abc", addr))
+	require.NoError(t, p.Aggregate("test.metric:5|c|#mykey:myvalue,dd.internal.entity_id:pod-uid,kube_container_name:app", addr))
+	require.NoError(t, p.Aggregate("test.metric:7|c|#mykey:myvalue", addr))
+
+	batches := p.GetMetrics()
+	require.Len(t, batches, 3)
+
+	expected := map[int64]map[string]any{
+		3: {"container.id": "abc", "k8s.namespace.name": "default", "k8s.pod.name": "app-1"},
+		5: {"k8s.pod.uid": "pod-uid", "k8s.container.name": "app"},
+		7: {},
+	}
+	for _, batch := range batches {
+		rm := batch.Metrics.ResourceMetrics()
+		require.Equal(t, 1, rm.Len())
+		dp := rm.At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+		assert.Equal(t, expected[dp.IntValue()], rm.At(0).Resource().Attributes().AsRaw())
+		assert.Equal(t, map[string]any{"mykey": "myvalue"}, dp.Attributes().AsRaw())
+	}
+}
+
+func TestStatsDParser_AggregateWithoutContainerTagging(t *testing.T) {
+	p := &StatsDParser{}
+	require.NoError(t, p.Initialize(false, false, false, false, nil))
+	addr, _ := net.ResolveUDPAddr("udp", "1.2.3.4:5678")
+
+	require.NoError(t, p.Aggregate("test.metric:1|c|#kube_namespace:default|c:abc", addr))
+
+	batches := p.GetMetrics()
+	require.Len(t, batches, 1)
+	rm := batches[0].Metrics.ResourceMetrics().At(0)
+	assert.Equal(t, 0, rm.Resource().Attributes().Len())
+	dp := rm.ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, map[string]any{"kube_namespace": "default", "container.id": "abc"}, dp.Attributes().AsRaw())
+}
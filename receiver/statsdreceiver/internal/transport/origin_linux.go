@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"syscall"
+)
+
+// peerCredentialsSize is the size of the control message holding the credentials of the peer.
+var peerCredentialsSize = syscall.CmsgSpace(syscall.SizeofUcred)
+
+// procRoot is where the proc filesystem of the host is mounted.
+var procRoot = "/proc"
+
+// containerIDRegex matches the 64 hexadecimal characters ID of a container at the end of
+// a cgroup path, as written by docker, containerd and cri-o: /docker/<id>,
+// /kubepods/.../cri-containerd-<id>.scope or /kubepods/.../crio-<id>.scope.
+var containerIDRegex = regexp.MustCompile(`([0-9a-f]{64})(?:\.scope)?$`)
+
+// enablePeerCredentials makes the kernel attach the credentials of the peer to each datagram.
+func enablePeerCredentials(conn *net.UnixConn) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var sockErr error
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_PASSCRED, 1)
+	}); err != nil {
+		return err
+	}
+	return sockErr
+}
+
+// peerPID returns the PID of the peer from the control messages of a datagram.
+func peerPID(oob []byte) (int32, bool) {
+	msgs, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		return 0, false
+	}
+	for i := range msgs {
+		creds, err := syscall.ParseUnixCredentials(&msgs[i])
+		if err == nil && creds.Pid != 0 {
+			return creds.Pid, true
+		}
+	}
+	return 0, false
+}
+
+// containerIDFromPID returns the ID of the container of a process, read from its cgroups.
+// It returns an empty string for the processes not running in a container.
+func containerIDFromPID(pid int32) string {
+	data, err := os.ReadFile(filepath.Join(procRoot, strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// Each line is hierarchy-ID:controller-list:cgroup-path.
+		parts := bytes.SplitN(scanner.Bytes(), []byte(":"), 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if matches := containerIDRegex.FindSubmatch(parts[2]); matches != nil {
+			return string(matches[1])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !linux
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
+
+import (
+	"errors"
+	"net"
+)
+
+const peerCredentialsSize = 0
+
+func enablePeerCredentials(*net.UnixConn) error {
+	return errors.New("origin detection is only supported on linux")
+}
+
+func peerPID([]byte) (int32, bool) {
+	return 0, false
+}
+
+func containerIDFromPID(int32) string {
+	return ""
+}
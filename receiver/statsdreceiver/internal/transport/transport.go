@@ -21,6 +21,8 @@ const (
 	TCP  Transport = "tcp"
 	TCP4 Transport = "tcp4"
 	TCP6 Transport = "tcp6"
+
+	UnixGram Transport = "unixgram"
 )
 
 // NewTransport creates a Transport based on the transport string or returns an empty Transport.
@@ -31,6 +33,8 @@ func NewTransport(ts string) Transport {
 		return trans
 	case TCP, TCP4, TCP6:
 		return trans
+	case UnixGram:
+		return trans
 	}
 	return Transport("")
 }
@@ -38,7 +42,7 @@ func NewTransport(ts string) Transport {
 // String casts the transport to a String if the Transport is supported. Return an empty Transport overwise.
 func (trans Transport) String() string {
 	switch trans {
-	case UDP, UDP4, UDP6, TCP, TCP4, TCP6:
+	case UDP, UDP4, UDP6, TCP, TCP4, TCP6, UnixGram:
 		return string(trans)
 	}
 	return ""
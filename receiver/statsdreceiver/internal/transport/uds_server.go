@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transport // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/statsdreceiver/internal/transport"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/consumer"
+)
+
+// originCacheTTL is how long the container of a process is cached, a process
+// does not change container but its PID can be reused.
+const originCacheTTL = time.Minute
+
+type udsServer struct {
+	conn            *net.UnixConn
+	path            string
+	transport       Transport
+	originDetection bool
+	origins         map[int32]origin
+}
+
+type origin struct {
+	containerID string
+	expires     time.Time
+}
+
+// Ensure that Server is implemented on UDS Server.
+var _ (Server) = (*udsServer)(nil)
+
+// NewUDSServer creates a transport.Server using a unix datagram socket as its transport.
+// With origin detection, the container of the client is detected from the credentials
+// of the peer of each datagram, and set as the container ID field of its metrics which
+// do not already have one.
+func NewUDSServer(transport Transport, address string, originDetection bool) (Server, error) {
+	if transport != UnixGram {
+		return nil, fmt.Errorf("NewUDSServer with %s: %w", transport.String(), ErrUnsupportedPacketTransport)
+	}
+
+	conn, err := net.ListenUnixgram(transport.String(), &net.UnixAddr{Name: address, Net: transport.String()})
+	if err != nil {
+		return nil, fmt.Errorf("starting to listen %s socket: %w", transport.String(), err)
+	}
+
+	if originDetection {
+		if err = enablePeerCredentials(conn); err != nil {
+			return nil, errors.Join(err, conn.Close(), os.Remove(address))
+		}
+	}
+
+	return &udsServer{
+		conn:            conn,
+		path:            address,
+		transport:       transport,
+		originDetection: originDetection,
+		origins:         map[int32]origin{},
+	}, nil
+}
+
+// ListenAndServe starts the server ready to receive metrics.
+func (u *udsServer) ListenAndServe(
+	nextConsumer consumer.Metrics,
+	reporter Reporter,
+	transferChan chan<- Metric,
+) error {
+	if nextConsumer == nil || reporter == nil {
+		return errNilListenAndServeParameters
+	}
+
+	buf := make([]byte, 65527)
+	oob := make([]byte, peerCredentialsSize)
+	for {
+		n, oobn, _, _, err := u.conn.ReadMsgUnix(buf, oob)
+		if n > 0 {
+			bufCopy := make([]byte, n)
+			copy(bufCopy, buf)
+			var containerID string
+			if u.originDetection {
+				containerID = u.containerID(oob[:oobn])
+			}
+			u.handlePacket(bufCopy, containerID, transferChan)
+		}
+		if err != nil {
+			reporter.OnDebugf("%s Transport (%s) - ReadMsgUnix error: %v",
+				u.transport,
+				u.conn.LocalAddr(),
+				err)
+			var netErr net.Error
+			if errors.As(err, &netErr) {
+				if netErr.Timeout() {
+					continue
+				}
+			}
+			return err
+		}
+	}
+}
+
+// Close closes the server and removes its socket.
+func (u *udsServer) Close() error {
+	return errors.Join(u.conn.Close(), os.Remove(u.path))
+}
+
+// containerID returns the container of the peer whose credentials are in the control messages.
+func (u *udsServer) containerID(oob []byte) string {
+	pid, ok := peerPID(oob)
+	if !ok {
+		return ""
+	}
+
+	now := time.Now()
+	if o, ok := u.origins[pid]; ok && now.Before(o.expires) {
+		return o.containerID
+	}
+	if len(u.origins) > 10000 {
+		// Drop the cache rather than growing without bound with short-lived processes.
+		u.origins = map[int32]origin{}
+	}
+	containerID := containerIDFromPID(pid)
+	u.origins[pid] = origin{containerID: containerID, expires: now.Add(originCacheTTL)}
+	return containerID
+}
+
+// handlePacket is helper that parses the buffer and split it line by line to be parsed upstream.
+func (u *udsServer) handlePacket(
+	data []byte,
+	containerID string,
+	transferChan chan<- Metric,
+) {
+	buf := bytes.NewBuffer(data)
+	for {
+		bytes, err := buf.ReadBytes((byte)('\n'))
+		if errors.Is(err, io.EOF) {
+			if len(bytes) == 0 {
+				// Completed without errors.
+				break
+			}
+		}
+		line := strings.TrimSpace(string(bytes))
+		if line == "" {
+			continue
+		}
+		if containerID != "" && !strings.Contains(line, "|c:") {
+			line += "|c:" + containerID
+		}
+		transferChan <- Metric{line, u.conn.LocalAddr()}
+	}
+}
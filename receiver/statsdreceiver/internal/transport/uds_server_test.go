@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package transport
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+const testContainerID = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestUDSServer(t *testing.T) {
+	tests := []struct {
+		name            string
+		originDetection bool
+		cgroup          string
+		sent            string
+		expected        []string
+	}{
+		{
+			name:     "without origin detection",
+			sent:     "test.metric:42|c\ntest.metric:1|g|#key:value",
+			expected: []string{"test.metric:42|c", "test.metric:1|g|#key:value"},
+		},
+		{
+			name:            "origin detected",
+			originDetection: true,
+			cgroup:          "0::/kubepods.slice/kubepods-pod1.slice/cri-containerd-" + testContainerID + ".scope\n",
+			sent:            "test.metric:42|c\ntest.metric:1|g|c:other",
+			expected:        []string{"test.metric:42|c|c:" + testContainerID, "test.metric:1|g|c:other"},
+		},
+		{
+			name:            "not in a container",
+			originDetection: true,
+			cgroup:          "0::/user.slice/user-1000.slice/session-1.scope\n",
+			sent:            "test.metric:42|c",
+			expected:        []string{"test.metric:42|c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			procDir := filepath.Join(root, strconv.Itoa(os.Getpid()))
+			require.NoError(t, os.MkdirAll(procDir, 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(procDir, "cgroup"), []byte(tt.cgroup), 0o600))
+			oldProcRoot := procRoot
+			procRoot = root
+			defer func() { procRoot = oldProcRoot }()
+
+			path := filepath.Join(root, "statsd.sock")
+			srv, err := NewUDSServer(UnixGram, path, tt.originDetection)
+			require.NoError(t, err)
+
+			transferChan := make(chan Metric, 10)
+			done := make(chan error)
+			go func() {
+				done <- srv.ListenAndServe(new(consumertest.MetricsSink), NewMockReporter(1), transferChan)
+			}()
+
+			conn, err := net.Dial("unixgram", path)
+			require.NoError(t, err)
+			_, err = conn.Write([]byte(tt.sent))
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+
+			var received []string
+			for range tt.expected {
+				select {
+				case m := <-transferChan:
+					received = append(received, m.Raw)
+					assert.NotNil(t, m.Addr)
+				case <-time.After(10 * time.Second):
+					t.Fatal("timed out waiting for the metrics")
+				}
+			}
+			assert.Equal(t, tt.expected, received)
+
+			require.NoError(t, srv.Close())
+			assert.Error(t, <-done)
+			_, err = os.Stat(path)
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestContainerIDFromPID(t *testing.T) {
+	tests := []struct {
+		name     string
+		cgroup   string
+		expected string
+	}{
+		{
+			name:     "docker cgroup v1",
+			cgroup:   "12:pids:/docker/" + testContainerID + "\n11:memory:/docker/" + testContainerID + "\n",
+			expected: testContainerID,
+		},
+		{
+			name:     "kubernetes cgroup v1",
+			cgroup:   "4:cpu,cpuacct:/kubepods/besteffort/pod1234/" + testContainerID + "\n",
+			expected: testContainerID,
+		},
+		{
+			name:     "cri-o cgroup v2",
+			cgroup:   "0::/kubepods.slice/kubepods-besteffort.slice/crio-" + testContainerID + ".scope\n",
+			expected: testContainerID,
+		},
+		{
+			name:   "host process",
+			cgroup: "0::/init.scope\n",
+		},
+		{
+			name:   "malformed",
+			cgroup: strings.Repeat("x", 10),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(root, "42"), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(root, "42", "cgroup"), []byte(tt.cgroup), 0o600))
+			oldProcRoot := procRoot
+			procRoot = root
+			defer func() { procRoot = oldProcRoot }()
+
+			assert.Equal(t, tt.expected, containerIDFromPID(42))
+		})
+	}
+
+	assert.Empty(t, containerIDFromPID(-1))
+}
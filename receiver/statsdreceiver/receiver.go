@@ -65,13 +65,14 @@ func newReceiver(
 }
 
 func buildTransportServer(config Config) (transport.Server, error) {
-	// TODO: Add unix socket transport implementations
 	trans := transport.NewTransport(strings.ToLower(string(config.NetAddr.Transport)))
 	switch trans {
 	case transport.UDP, transport.UDP4, transport.UDP6:
 		return transport.NewUDPServer(trans, config.NetAddr.Endpoint)
 	case transport.TCP, transport.TCP4, transport.TCP6:
 		return transport.NewTCPServer(trans, config.NetAddr.Endpoint)
+	case transport.UnixGram:
+		return transport.NewUDSServer(trans, config.NetAddr.Endpoint, config.EnableOriginDetection)
 	}
 
 	return nil, fmt.Errorf("unsupported transport %q", string(config.NetAddr.Transport))
@@ -91,6 +92,7 @@ func (r *statsdReceiver) Start(ctx context.Context, _ component.Host) error {
 		r.config.EnableMetricType,
 		r.config.EnableSimpleTags,
 		r.config.IsMonotonicCounter,
+		r.config.EnableContainerTagging,
 		r.config.TimerHistogramMapping,
 	)
 	if err != nil {
@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/event"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/performance"
@@ -28,6 +30,7 @@ type vcenterClient struct {
 	pc        *property.Collector
 	pm        *performance.Manager
 	vm        *view.Manager
+	em        *event.Manager
 	cfg       *Config
 }
 
@@ -74,6 +77,7 @@ func (vc *vcenterClient) EnsureConnection(ctx context.Context) error {
 	vc.finder = find.NewFinder(vc.vimDriver)
 	vc.pm = performance.NewManager(vc.vimDriver)
 	vc.vm = view.NewManager(vc.vimDriver)
+	vc.em = event.NewManager(vc.vimDriver)
 	return nil
 }
 
@@ -284,3 +288,56 @@ func (vc *vcenterClient) PerfMetricsQuery(
 		resultsByRef: resultsByRef,
 	}, nil
 }
+
+// EventCollector returns a collector of the events of the vSphere SDK created since the given time
+func (vc *vcenterClient) EventCollector(ctx context.Context, since time.Time) (*event.HistoryCollector, error) {
+	collector, err := vc.em.CreateCollectorForEvents(ctx, vt.EventFilterSpec{
+		Entity: &vt.EventFilterSpecByEntity{
+			Entity:    vc.vimDriver.ServiceContent.RootFolder,
+			Recursion: vt.EventFilterSpecRecursionOptionAll,
+		},
+		Time: &vt.EventFilterSpecByTime{
+			BeginTime: vt.NewTime(since),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create event collector: %w", err)
+	}
+	return collector, nil
+}
+
+// TriggeredAlarms returns the alarms currently triggered on the entities of the vSphere SDK
+func (vc *vcenterClient) TriggeredAlarms(ctx context.Context) ([]vt.AlarmState, error) {
+	var rootFolder mo.Folder
+	err := vc.pc.RetrieveOne(ctx, vc.vimDriver.ServiceContent.RootFolder, []string{"triggeredAlarmState"}, &rootFolder)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve triggered alarms: %w", err)
+	}
+	return rootFolder.TriggeredAlarmState, nil
+}
+
+// EntityNames returns the names of the managed entities keyed by MoRef value
+func (vc *vcenterClient) EntityNames(ctx context.Context, refs []vt.ManagedObjectReference) (map[string]string, error) {
+	var entities []mo.ManagedEntity
+	if err := vc.pc.Retrieve(ctx, refs, []string{"name"}, &entities); err != nil {
+		return nil, fmt.Errorf("unable to retrieve entity names: %w", err)
+	}
+	names := make(map[string]string, len(entities))
+	for _, entity := range entities {
+		names[entity.Self.Value] = entity.Name
+	}
+	return names, nil
+}
+
+// AlarmNames returns the names of the alarms keyed by MoRef value
+func (vc *vcenterClient) AlarmNames(ctx context.Context, refs []vt.ManagedObjectReference) (map[string]string, error) {
+	var alarms []mo.Alarm
+	if err := vc.pc.Retrieve(ctx, refs, []string{"info.name"}, &alarms); err != nil {
+		return nil, fmt.Errorf("unable to retrieve alarm names: %w", err)
+	}
+	names := make(map[string]string, len(alarms))
+	for _, alarm := range alarms {
+		names[alarm.Self.Value] = alarm.Info.Name
+	}
+	return names, nil
+}
@@ -49,3 +49,21 @@ func TestCreateMetricsReceiver(t *testing.T) {
 		t.Run(testCase.desc, testCase.testFn)
 	}
 }
+
+func TestCreateLogsReceiver(t *testing.T) {
+	_, err := createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		createDefaultConfig(),
+		consumertest.NewNop(),
+	)
+	require.NoError(t, err)
+
+	_, err = createLogsReceiver(
+		context.Background(),
+		receivertest.NewNopCreateSettings(),
+		nil,
+		consumertest.NewNop(),
+	)
+	require.ErrorIs(t, err, errConfigNotVcenter)
+}
@@ -0,0 +1,390 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver"
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/event"
+	vt "github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+)
+
+// eventPageSize is the maximum number of events read from the event collector at once.
+const eventPageSize = 100
+
+const scopeName = "otelcol/vcenterreceiver"
+
+const (
+	eventDomain    = "vcenter"
+	alarmEventName = "vcenter.alarm"
+
+	attributeEventDomain         = "event.domain"
+	attributeEventName           = "event.name"
+	attributeEventKey            = "vcenter.event.key"
+	attributeEventType           = "vcenter.event.type"
+	attributeUserName            = "vcenter.user.name"
+	attributeEntityName          = "vcenter.entity.name"
+	attributeEntityType          = "vcenter.entity.type"
+	attributeDatacenterName      = "vcenter.datacenter.name"
+	attributeClusterName         = "vcenter.cluster.name"
+	attributeHostName            = "vcenter.host.name"
+	attributeVMName              = "vcenter.vm.name"
+	attributeDatastoreName       = "vcenter.datastore.name"
+	attributeAlarmKey            = "vcenter.alarm.key"
+	attributeAlarmName           = "vcenter.alarm.name"
+	attributeAlarmStatus         = "vcenter.alarm.status"
+	attributeAlarmAcknowledged   = "vcenter.alarm.acknowledged"
+	attributeAlarmPreviousStatus = "vcenter.alarm.previous_status"
+)
+
+// vcenterLogsReceiver polls the event manager and the triggered alarms of vCenter and emits
+// the events and the alarm status changes as log records.
+type vcenterLogsReceiver struct {
+	config   *Config
+	logger   *zap.Logger
+	consumer consumer.Logs
+	client   *vcenterClient
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+
+	// collector reads the events created since lastEventTime, it is created again when the session is lost.
+	collector     *event.HistoryCollector
+	lastEventTime time.Time
+	lastEventKey  int32
+	// alarms are the statuses of the triggered alarms of the previous poll, keyed by alarm state key.
+	alarms map[string]alarmStatus
+}
+
+type alarmStatus struct {
+	state vt.AlarmState
+	name  string
+}
+
+func newLogsReceiver(settings receiver.CreateSettings, config *Config, consumer consumer.Logs) *vcenterLogsReceiver {
+	return &vcenterLogsReceiver{
+		config:   config,
+		logger:   settings.Logger,
+		consumer: consumer,
+		client:   newVcenterClient(config),
+	}
+}
+
+func (r *vcenterLogsReceiver) Start(_ context.Context, _ component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.lastEventTime = time.Now()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.pollLoop(ctx)
+	}()
+	return nil
+}
+
+func (r *vcenterLogsReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.collector != nil {
+		_ = r.collector.Destroy(ctx)
+	}
+	return r.client.Disconnect(ctx)
+}
+
+func (r *vcenterLogsReceiver) pollLoop(ctx context.Context) {
+	ticker := time.NewTicker(r.config.CollectionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			logs, err := r.poll(ctx)
+			if err != nil {
+				r.logger.Error("Error polling vCenter events and alarms", zap.Error(err))
+			}
+			if logs.LogRecordCount() == 0 {
+				continue
+			}
+			if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+				r.logger.Error("Error consuming vCenter events and alarms", zap.Error(err))
+			}
+		}
+	}
+}
+
+// poll reads the events created since the previous poll and the changes of the triggered alarms.
+// The log records collected before an error are returned with it.
+func (r *vcenterLogsReceiver) poll(ctx context.Context) (plog.Logs, error) {
+	logs := plog.NewLogs()
+	sl := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+
+	if err := r.client.EnsureConnection(ctx); err != nil {
+		// The collector belongs to the lost session.
+		r.collector = nil
+		return logs, fmt.Errorf("unable to connect to vSphere SDK: %w", err)
+	}
+
+	if err := r.pollEvents(ctx, sl.LogRecords()); err != nil {
+		return logs, err
+	}
+	return logs, r.pollAlarms(ctx, sl.LogRecords())
+}
+
+func (r *vcenterLogsReceiver) pollEvents(ctx context.Context, records plog.LogRecordSlice) error {
+	if r.collector == nil {
+		collector, err := r.client.EventCollector(ctx, r.lastEventTime)
+		if err != nil {
+			return err
+		}
+		r.collector = collector
+	}
+
+	for {
+		events, err := r.collector.ReadNextEvents(ctx, eventPageSize)
+		if err != nil {
+			r.collector = nil
+			return fmt.Errorf("unable to read events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		for _, e := range events {
+			base := e.GetEvent()
+			// A collector created again after a lost session starts from the time of the last event,
+			// which was already emitted, and possibly other events of the same time.
+			if !base.CreatedTime.After(r.lastEventTime) && base.Key <= r.lastEventKey {
+				continue
+			}
+			eventToLogRecord(e, records.AppendEmpty())
+			if base.CreatedTime.After(r.lastEventTime) {
+				r.lastEventTime = base.CreatedTime
+			}
+			r.lastEventKey = base.Key
+		}
+	}
+}
+
+func (r *vcenterLogsReceiver) pollAlarms(ctx context.Context, records plog.LogRecordSlice) error {
+	states, err := r.client.TriggeredAlarms(ctx)
+	if err != nil {
+		return err
+	}
+
+	entityNames, alarmNames := r.alarmReferenceNames(ctx, states)
+	now := pcommon.NewTimestampFromTime(time.Now())
+
+	alarms := make(map[string]alarmStatus, len(states))
+	for _, state := range states {
+		name := alarmNames[state.Alarm.Value]
+		if name == "" {
+			name = state.Alarm.Value
+		}
+		current := alarmStatus{state: state, name: name}
+		alarms[state.Key] = current
+
+		previous, ok := r.alarms[state.Key]
+		if ok && previous.state.OverallStatus == state.OverallStatus {
+			continue
+		}
+		lr := records.AppendEmpty()
+		alarmToLogRecord(current, entityNames[state.Entity.Value], lr)
+		if ok {
+			lr.Attributes().PutStr(attributeAlarmPreviousStatus, string(previous.state.OverallStatus))
+		}
+	}
+
+	// The alarms which are not triggered anymore were cleared.
+	for key, previous := range r.alarms {
+		if _, ok := alarms[key]; ok {
+			continue
+		}
+		cleared := previous
+		cleared.state.OverallStatus = vt.ManagedEntityStatusGreen
+		cleared.state.Time = now.AsTime()
+		lr := records.AppendEmpty()
+		alarmToLogRecord(cleared, entityNames[previous.state.Entity.Value], lr)
+		lr.Attributes().PutStr(attributeAlarmPreviousStatus, string(previous.state.OverallStatus))
+	}
+
+	r.alarms = alarms
+	return nil
+}
+
+// alarmReferenceNames returns the names of the entities and alarms of the alarm states, keyed by MoRef value.
+// The names which can't be retrieved are left out.
+func (r *vcenterLogsReceiver) alarmReferenceNames(ctx context.Context, states []vt.AlarmState) (map[string]string, map[string]string) {
+	var entityRefs, alarmRefs []vt.ManagedObjectReference
+	for _, state := range states {
+		entityRefs = append(entityRefs, state.Entity)
+		alarmRefs = append(alarmRefs, state.Alarm)
+	}
+	for _, previous := range r.alarms {
+		entityRefs = append(entityRefs, previous.state.Entity)
+	}
+	if len(entityRefs) == 0 {
+		return nil, nil
+	}
+
+	entityNames, err := r.client.EntityNames(ctx, entityRefs)
+	if err != nil {
+		r.logger.Debug("Unable to retrieve the names of the entities of the triggered alarms", zap.Error(err))
+	}
+	var alarmNames map[string]string
+	if len(alarmRefs) > 0 {
+		if alarmNames, err = r.client.AlarmNames(ctx, alarmRefs); err != nil {
+			r.logger.Debug("Unable to retrieve the names of the triggered alarms", zap.Error(err))
+		}
+	}
+	return entityNames, alarmNames
+}
+
+func eventToLogRecord(e vt.BaseEvent, lr plog.LogRecord) {
+	base := e.GetEvent()
+	eventType := eventTypeName(e)
+
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(base.CreatedTime))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.Body().SetStr(base.FullFormattedMessage)
+
+	severity := eventSeverity(e, eventType)
+	lr.SetSeverityNumber(severity)
+	lr.SetSeverityText(severity.String())
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeEventDomain, eventDomain)
+	attrs.PutStr(attributeEventName, eventType)
+	attrs.PutStr(attributeEventType, eventType)
+	attrs.PutInt(attributeEventKey, int64(base.Key))
+	if base.UserName != "" {
+		attrs.PutStr(attributeUserName, base.UserName)
+	}
+
+	// The entity of the event is its most specific entity argument.
+	var entityName, entityType string
+	setEntity := func(key, name, typ string) {
+		attrs.PutStr(key, name)
+		if entityName == "" {
+			entityName, entityType = name, typ
+		}
+	}
+	if base.Vm != nil {
+		setEntity(attributeVMName, base.Vm.Name, "VirtualMachine")
+	}
+	if base.Host != nil {
+		setEntity(attributeHostName, base.Host.Name, "HostSystem")
+	}
+	if base.Ds != nil {
+		setEntity(attributeDatastoreName, base.Ds.Name, "Datastore")
+	}
+	if base.ComputeResource != nil {
+		setEntity(attributeClusterName, base.ComputeResource.Name, "ComputeResource")
+	}
+	if base.Datacenter != nil {
+		setEntity(attributeDatacenterName, base.Datacenter.Name, "Datacenter")
+	}
+	if entityName != "" {
+		attrs.PutStr(attributeEntityName, entityName)
+		attrs.PutStr(attributeEntityType, entityType)
+	}
+}
+
+// eventTypeName returns the type of the event, e.g. VmMigratedEvent. Extended events are identified by their type ID.
+func eventTypeName(e vt.BaseEvent) string {
+	switch ev := e.(type) {
+	case *vt.EventEx:
+		return ev.EventTypeId
+	case *vt.ExtendedEvent:
+		return ev.EventTypeId
+	}
+	return reflect.Indirect(reflect.ValueOf(e)).Type().Name()
+}
+
+func eventSeverity(e vt.BaseEvent, eventType string) plog.SeverityNumber {
+	switch ev := e.(type) {
+	case *vt.EventEx:
+		return severityFromEventSeverity(ev.Severity)
+	case *vt.AlarmStatusChangedEvent:
+		return severityFromStatus(vt.ManagedEntityStatus(ev.To))
+	case *vt.GeneralHostWarningEvent, *vt.GeneralVmWarningEvent:
+		return plog.SeverityNumberWarn
+	case *vt.GeneralHostErrorEvent, *vt.GeneralVmErrorEvent:
+		return plog.SeverityNumberError
+	}
+	// Failures such as HA host failures (DasHostFailedEvent) or failed migrations (VmFailedMigrateEvent)
+	// have no severity, but are named consistently.
+	if strings.Contains(eventType, "Failed") || strings.Contains(eventType, "Failure") {
+		return plog.SeverityNumberError
+	}
+	return plog.SeverityNumberInfo
+}
+
+func severityFromEventSeverity(severity string) plog.SeverityNumber {
+	switch vt.EventEventSeverity(severity) {
+	case vt.EventEventSeverityError:
+		return plog.SeverityNumberError
+	case vt.EventEventSeverityWarning:
+		return plog.SeverityNumberWarn
+	}
+	return plog.SeverityNumberInfo
+}
+
+func severityFromStatus(status vt.ManagedEntityStatus) plog.SeverityNumber {
+	switch status {
+	case vt.ManagedEntityStatusRed:
+		return plog.SeverityNumberError
+	case vt.ManagedEntityStatusYellow:
+		return plog.SeverityNumberWarn
+	}
+	return plog.SeverityNumberInfo
+}
+
+func alarmToLogRecord(alarm alarmStatus, entityName string, lr plog.LogRecord) {
+	state := alarm.state
+	lr.SetTimestamp(pcommon.NewTimestampFromTime(state.Time))
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+
+	entity := entityName
+	if entity == "" {
+		entity = state.Entity.Value
+	}
+	if state.OverallStatus == vt.ManagedEntityStatusGreen {
+		lr.Body().SetStr(fmt.Sprintf("Alarm '%s' on %s cleared", alarm.name, entity))
+	} else {
+		lr.Body().SetStr(fmt.Sprintf("Alarm '%s' on %s changed to %s", alarm.name, entity, state.OverallStatus))
+	}
+
+	severity := severityFromStatus(state.OverallStatus)
+	lr.SetSeverityNumber(severity)
+	lr.SetSeverityText(severity.String())
+
+	attrs := lr.Attributes()
+	attrs.PutStr(attributeEventDomain, eventDomain)
+	attrs.PutStr(attributeEventName, alarmEventName)
+	attrs.PutStr(attributeAlarmKey, state.Key)
+	attrs.PutStr(attributeAlarmName, alarm.name)
+	attrs.PutStr(attributeAlarmStatus, string(state.OverallStatus))
+	if state.Acknowledged != nil {
+		attrs.PutBool(attributeAlarmAcknowledged, *state.Acknowledged)
+	}
+	if entityName != "" {
+		attrs.PutStr(attributeEntityName, entityName)
+	}
+	attrs.PutStr(attributeEntityType, state.Entity.Type)
+}
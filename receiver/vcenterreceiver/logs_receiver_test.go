@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vcenterreceiver // import github.com/open-telemetry/opentelemetry-collector-contrib/receiver/vcenterreceiver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware/govmomi/event"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func newSimulatorLogsReceiver(ctx context.Context, t *testing.T, c *vim25.Client) *vcenterLogsReceiver {
+	cfg := createDefaultConfig().(*Config)
+	pw, _ := simulator.DefaultLogin.Password()
+	cfg.Username = simulator.DefaultLogin.Username()
+	cfg.Password = configopaque.String(pw)
+	cfg.Endpoint = fmt.Sprintf("%s://%s", c.URL().Scheme, c.URL().Host)
+	cfg.Insecure = true
+
+	r := newLogsReceiver(receivertest.NewNopCreateSettings(), cfg, new(consumertest.LogsSink))
+	// Connect beforehand, so that the login event of the receiver is not emitted.
+	require.NoError(t, r.client.EnsureConnection(ctx))
+	r.lastEventTime = time.Now()
+	return r
+}
+
+func logRecords(logs plog.Logs) []plog.LogRecord {
+	var records []plog.LogRecord
+	lrs := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < lrs.Len(); i++ {
+		records = append(records, lrs.At(i))
+	}
+	return records
+}
+
+func TestLogsReceiverEvents(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		r := newSimulatorLogsReceiver(ctx, t, c)
+
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		require.NoError(t, err)
+		vmArg := &types.VmEventArgument{
+			EntityEventArgument: types.EntityEventArgument{Name: "DC0_H0_VM0"},
+			Vm:                  vm.Reference(),
+		}
+
+		// Events created before the receiver started are not emitted
+		logs, err := r.poll(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, logs.LogRecordCount())
+
+		em := event.NewManager(c)
+		require.NoError(t, em.PostEvent(ctx, &types.VmMigratedEvent{
+			VmEvent: types.VmEvent{Event: types.Event{
+				Vm:         vmArg,
+				Host:       &types.HostEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0_H0"}},
+				Datacenter: &types.DatacenterEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0"}},
+			}},
+			SourceHost:       types.HostEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0_H1"}},
+			SourceDatacenter: &types.DatacenterEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "DC0"}},
+			SourceDatastore:  &types.DatastoreEventArgument{EntityEventArgument: types.EntityEventArgument{Name: "LocalDS_0"}},
+		}))
+		require.NoError(t, em.PostEvent(ctx, &types.VmFailedMigrateEvent{
+			VmEvent: types.VmEvent{Event: types.Event{Vm: vmArg}},
+		}))
+		require.NoError(t, em.PostEvent(ctx, &types.EventEx{
+			Event:       types.Event{Vm: vmArg},
+			EventTypeId: "com.vmware.vc.HA.VmRestartedByHAEvent",
+			Severity:    string(types.EventEventSeverityWarning),
+		}))
+
+		logs, err = r.poll(ctx)
+		require.NoError(t, err)
+		records := logRecords(logs)
+		require.Len(t, records, 3)
+		assert.Equal(t, "otelcol/vcenterreceiver", logs.ResourceLogs().At(0).ScopeLogs().At(0).Scope().Name())
+
+		migrated := records[0]
+		assert.Equal(t, plog.SeverityNumberInfo, migrated.SeverityNumber())
+		attrs := migrated.Attributes().AsRaw()
+		assert.Equal(t, "vcenter", attrs["event.domain"])
+		assert.Equal(t, "VmMigratedEvent", attrs["event.name"])
+		assert.Equal(t, "VmMigratedEvent", attrs["vcenter.event.type"])
+		assert.Equal(t, "DC0_H0_VM0", attrs["vcenter.vm.name"])
+		assert.Equal(t, "DC0_H0", attrs["vcenter.host.name"])
+		assert.Equal(t, "DC0", attrs["vcenter.datacenter.name"])
+		assert.Equal(t, "DC0_H0_VM0", attrs["vcenter.entity.name"])
+		assert.Equal(t, "VirtualMachine", attrs["vcenter.entity.type"])
+		assert.Contains(t, attrs, "vcenter.user.name")
+
+		assert.Equal(t, plog.SeverityNumberError, records[1].SeverityNumber())
+		assert.Equal(t, "VmFailedMigrateEvent", records[1].Attributes().AsRaw()["event.name"])
+
+		assert.Equal(t, plog.SeverityNumberWarn, records[2].SeverityNumber())
+		assert.Equal(t, "com.vmware.vc.HA.VmRestartedByHAEvent", records[2].Attributes().AsRaw()["event.name"])
+
+		// Events are only emitted once
+		logs, err = r.poll(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, logs.LogRecordCount())
+
+		// Events aren't emitted again when the collector is created again
+		r.collector = nil
+		logs, err = r.poll(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, logs.LogRecordCount())
+	})
+}
+
+func TestLogsReceiverAlarms(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		r := newSimulatorLogsReceiver(ctx, t, c)
+
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		require.NoError(t, err)
+		rootFolder := simulator.Map.Get(c.ServiceContent.RootFolder)
+		setTriggeredAlarms := func(states ...types.AlarmState) {
+			simulator.Map.Update(rootFolder, []types.PropertyChange{{Name: "triggeredAlarmState", Val: states}})
+		}
+		acknowledged := false
+		alarm := types.AlarmState{
+			Key:           "alarm-1.vm-1",
+			Entity:        vm.Reference(),
+			Alarm:         types.ManagedObjectReference{Type: "Alarm", Value: "alarm-1"},
+			OverallStatus: types.ManagedEntityStatusYellow,
+			Time:          time.Now(),
+			Acknowledged:  &acknowledged,
+		}
+
+		// Triggered alarms are emitted
+		setTriggeredAlarms(alarm)
+		logs, err := r.poll(ctx)
+		require.NoError(t, err)
+		records := logRecords(logs)
+		require.Len(t, records, 1)
+		assert.Equal(t, plog.SeverityNumberWarn, records[0].SeverityNumber())
+		assert.Equal(t, "Alarm 'alarm-1' on DC0_H0_VM0 changed to yellow", records[0].Body().Str())
+		assert.Equal(t, map[string]any{
+			"event.domain":               "vcenter",
+			"event.name":                 "vcenter.alarm",
+			"vcenter.alarm.key":          "alarm-1.vm-1",
+			"vcenter.alarm.name":         "alarm-1",
+			"vcenter.alarm.status":       "yellow",
+			"vcenter.alarm.acknowledged": false,
+			"vcenter.entity.name":        "DC0_H0_VM0",
+			"vcenter.entity.type":        "VirtualMachine",
+		}, records[0].Attributes().AsRaw())
+
+		// Unchanged alarms are not emitted again
+		logs, err = r.poll(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, logs.LogRecordCount())
+
+		// Status changes are emitted
+		alarm.OverallStatus = types.ManagedEntityStatusRed
+		setTriggeredAlarms(alarm)
+		logs, err = r.poll(ctx)
+		require.NoError(t, err)
+		records = logRecords(logs)
+		require.Len(t, records, 1)
+		assert.Equal(t, plog.SeverityNumberError, records[0].SeverityNumber())
+		assert.Equal(t, "yellow", records[0].Attributes().AsRaw()["vcenter.alarm.previous_status"])
+
+		// Cleared alarms are emitted
+		setTriggeredAlarms()
+		logs, err = r.poll(ctx)
+		require.NoError(t, err)
+		records = logRecords(logs)
+		require.Len(t, records, 1)
+		assert.Equal(t, plog.SeverityNumberInfo, records[0].SeverityNumber())
+		assert.Equal(t, "Alarm 'alarm-1' on DC0_H0_VM0 cleared", records[0].Body().Str())
+		assert.Equal(t, "green", records[0].Attributes().AsRaw()["vcenter.alarm.status"])
+		assert.Equal(t, "red", records[0].Attributes().AsRaw()["vcenter.alarm.previous_status"])
+	})
+}
@@ -5,6 +5,7 @@ package windowsperfcountersreceiver // import "github.com/open-telemetry/opentel
 
 import (
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
@@ -16,6 +17,11 @@ type Config struct {
 
 	MetricMetaData map[string]MetricConfig `mapstructure:"metrics"`
 	PerfCounters   []ObjectConfig          `mapstructure:"perfcounters"`
+
+	// InstanceRefreshInterval is how often the instances of the perf counters configured
+	// with the "*" instance are enumerated again, to scrape the instances created after
+	// the start. Zero disables the periodic enumeration.
+	InstanceRefreshInterval time.Duration `mapstructure:"instance_refresh_interval"`
 }
 
 // MetricsConfig defines the configuration for a metric to be created.
@@ -59,6 +65,10 @@ func (c *Config) Validate() error {
 		errs = multierr.Append(errs, fmt.Errorf("collection_interval must be a positive duration"))
 	}
 
+	if c.InstanceRefreshInterval < 0 {
+		errs = multierr.Append(errs, fmt.Errorf("instance_refresh_interval must not be negative"))
+	}
+
 	if len(c.PerfCounters) == 0 {
 		errs = multierr.Append(errs, fmt.Errorf("must specify at least one perf counter"))
 	}
@@ -24,6 +24,7 @@ const (
 	noObjectNameErr               = "must specify object name for all perf counters"
 	noCountersErr                 = `perf counter for object "%s" does not specify any counters`
 	emptyInstanceErr              = `perf counter for object "%s" includes an empty instance`
+	negativeRefreshIntervalErr    = "instance_refresh_interval must not be negative"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -63,6 +64,7 @@ func TestLoadConfig(t *testing.T) {
 					CollectionInterval: 30 * time.Second,
 					InitialDelay:       time.Second,
 				},
+				InstanceRefreshInterval: 5 * time.Minute,
 				PerfCounters: []ObjectConfig{
 					{
 						Object:   "object1",
@@ -102,6 +104,7 @@ func TestLoadConfig(t *testing.T) {
 					CollectionInterval: 60 * time.Second,
 					InitialDelay:       time.Second,
 				},
+				InstanceRefreshInterval: defaultInstanceRefreshInterval,
 				PerfCounters: []ObjectConfig{
 					{
 						Object:   "object",
@@ -117,6 +120,7 @@ func TestLoadConfig(t *testing.T) {
 					CollectionInterval: 60 * time.Second,
 					InitialDelay:       time.Second,
 				},
+				InstanceRefreshInterval: defaultInstanceRefreshInterval,
 				PerfCounters: []ObjectConfig{
 					{
 						Object:   "object",
@@ -139,6 +143,7 @@ func TestLoadConfig(t *testing.T) {
 					CollectionInterval: 60 * time.Second,
 					InitialDelay:       time.Second,
 				},
+				InstanceRefreshInterval: defaultInstanceRefreshInterval,
 				PerfCounters: []ObjectConfig{
 					{
 						Object:   "object",
@@ -164,6 +169,7 @@ func TestLoadConfig(t *testing.T) {
 					CollectionInterval: 60 * time.Second,
 					InitialDelay:       time.Second,
 				},
+				InstanceRefreshInterval: defaultInstanceRefreshInterval,
 				PerfCounters: []ObjectConfig{
 					{
 						Object:   "object",
@@ -183,6 +189,10 @@ func TestLoadConfig(t *testing.T) {
 			id:          component.NewIDWithName(metadata.Type, "negative-collection-interval"),
 			expectedErr: fmt.Sprintf("collection_interval must be a positive duration; %s", negativeCollectionIntervalErr),
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "negative-instance-refresh-interval"),
+			expectedErr: negativeRefreshIntervalErr,
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "noperfcounters"),
 			expectedErr: noPerfCountersErr,
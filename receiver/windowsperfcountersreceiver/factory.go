@@ -4,6 +4,8 @@
 package windowsperfcountersreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/windowsperfcountersreceiver"
 
 import (
+	"time"
+
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -13,6 +15,8 @@ import (
 
 // This file implements Factory for WindowsPerfCounters receiver.
 
+const defaultInstanceRefreshInterval = time.Minute
+
 // NewFactory creates a new factory for windows perf counters receiver.
 func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
@@ -24,6 +28,7 @@ func NewFactory() receiver.Factory {
 // createDefaultConfig creates the default configuration for receiver.
 func createDefaultConfig() component.Config {
 	return &Config{
-		ControllerConfig: scraperhelper.NewDefaultControllerConfig(),
+		ControllerConfig:        scraperhelper.NewDefaultControllerConfig(),
+		InstanceRefreshInterval: defaultInstanceRefreshInterval,
 	}
 }
@@ -7,6 +7,9 @@ package windowsperfcountersreceiver // import "github.com/open-telemetry/opentel
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -19,11 +22,17 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
 )
 
-const instanceLabelName = "instance"
+const (
+	instanceLabelName = "instance"
+	wildcardInstance  = "*"
+)
 
 type perfCounterMetricWatcher struct {
 	winperfcounters.PerfCounterWatcher
 	MetricRep
+
+	// object is the index of the configuration of the perf counter object.
+	object int
 }
 
 type newWatcherFunc func(string, string, string) (winperfcounters.PerfCounterWatcher, error)
@@ -34,12 +43,23 @@ type scraper struct {
 	settings component.TelemetrySettings
 	watchers []perfCounterMetricWatcher
 
+	// instances are the last enumerated instances of the objects configured with the
+	// wildcard instance, by index of the configuration of the object.
+	instances   map[int][]string
+	lastRefresh time.Time
+
 	// for mocking
-	newWatcher newWatcherFunc
+	newWatcher         newWatcherFunc
+	expandWildcardPath func(string) ([]string, error)
 }
 
 func newScraper(cfg *Config, settings component.TelemetrySettings) *scraper {
-	return &scraper{cfg: cfg, settings: settings, newWatcher: winperfcounters.NewWatcher}
+	return &scraper{
+		cfg:                cfg,
+		settings:           settings,
+		newWatcher:         winperfcounters.NewWatcher,
+		expandWildcardPath: winperfcounters.ExpandWildCardPath,
+	}
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
@@ -48,6 +68,13 @@ func (s *scraper) start(context.Context, component.Host) error {
 		s.settings.Logger.Warn("some performance counters could not be initialized", zap.Error(err))
 	}
 	s.watchers = watchers
+	s.instances = map[int][]string{}
+	for i, objCfg := range s.cfg.PerfCounters {
+		if isWildcard(objCfg) {
+			s.instances[i], _ = s.enumerateInstances(objCfg)
+		}
+	}
+	s.lastRefresh = time.Now()
 	return nil
 }
 
@@ -55,34 +82,147 @@ func (s *scraper) initWatchers() ([]perfCounterMetricWatcher, error) {
 	var errs error
 	var watchers []perfCounterMetricWatcher
 
-	for _, objCfg := range s.cfg.PerfCounters {
-		for _, instance := range instancesFromConfig(objCfg) {
-			for _, counterCfg := range objCfg.Counters {
-				pcw, err := s.newWatcher(objCfg.Object, instance, counterCfg.Name)
-				if err != nil {
-					errs = multierr.Append(errs, err)
-					continue
-				}
+	for i, objCfg := range s.cfg.PerfCounters {
+		objWatchers, err := s.initObjectWatchers(i, objCfg)
+		errs = multierr.Append(errs, err)
+		watchers = append(watchers, objWatchers...)
+	}
 
-				watcher := perfCounterMetricWatcher{
-					PerfCounterWatcher: pcw,
-					MetricRep:          MetricRep{Name: pcw.Path()},
-				}
-				if counterCfg.MetricRep.Name != "" {
-					watcher.MetricRep.Name = counterCfg.MetricRep.Name
-					if counterCfg.MetricRep.Attributes != nil {
-						watcher.MetricRep.Attributes = counterCfg.MetricRep.Attributes
-					}
-				}
+	return watchers, errs
+}
+
+func (s *scraper) initObjectWatchers(object int, objCfg ObjectConfig) ([]perfCounterMetricWatcher, error) {
+	var errs error
+	var watchers []perfCounterMetricWatcher
+
+	for _, instance := range instancesFromConfig(objCfg) {
+		for _, counterCfg := range objCfg.Counters {
+			pcw, err := s.newWatcher(objCfg.Object, instance, counterCfg.Name)
+			if err != nil {
+				errs = multierr.Append(errs, err)
+				continue
+			}
 
-				watchers = append(watchers, watcher)
+			watcher := perfCounterMetricWatcher{
+				PerfCounterWatcher: pcw,
+				MetricRep:          MetricRep{Name: pcw.Path()},
+				object:             object,
 			}
+			if counterCfg.MetricRep.Name != "" {
+				watcher.MetricRep.Name = counterCfg.MetricRep.Name
+				if counterCfg.MetricRep.Attributes != nil {
+					watcher.MetricRep.Attributes = counterCfg.MetricRep.Attributes
+				}
+			}
+
+			watchers = append(watchers, watcher)
 		}
 	}
 
 	return watchers, errs
 }
 
+// refreshInstances enumerates the instances of the objects configured with the wildcard
+// instance, and recreates the watchers of the objects whose instances changed. The watchers
+// of the objects in missing are recreated even if their instances look unchanged.
+func (s *scraper) refreshInstances(missing map[int]bool) {
+	s.lastRefresh = time.Now()
+
+	for i, objCfg := range s.cfg.PerfCounters {
+		if !isWildcard(objCfg) {
+			continue
+		}
+
+		instances, err := s.enumerateInstances(objCfg)
+		if err != nil {
+			s.settings.Logger.Warn("failed to enumerate the instances of a performance counter object",
+				zap.String("object", objCfg.Object), zap.Error(err))
+			continue
+		}
+
+		added, removed := diffInstances(s.instances[i], instances)
+		if len(added) == 0 && len(removed) == 0 && !missing[i] {
+			continue
+		}
+		s.instances[i] = instances
+		if len(added) != 0 {
+			s.settings.Logger.Info("found new instances of a performance counter object",
+				zap.String("object", objCfg.Object), zap.Strings("instances", added))
+		}
+		if len(removed) != 0 {
+			s.settings.Logger.Info("instances of a performance counter object were removed",
+				zap.String("object", objCfg.Object), zap.Strings("instances", removed))
+		}
+
+		if err := s.resetObjectWatchers(i, objCfg); err != nil {
+			s.settings.Logger.Warn("some performance counters could not be initialized", zap.Error(err))
+		}
+	}
+}
+
+// resetObjectWatchers replaces the watchers of an object with new ones, which include the
+// instances of the object that exist now.
+func (s *scraper) resetObjectWatchers(object int, objCfg ObjectConfig) error {
+	var errs error
+	watchers := s.watchers[:0]
+	for _, watcher := range s.watchers {
+		if watcher.object != object {
+			watchers = append(watchers, watcher)
+			continue
+		}
+		errs = multierr.Append(errs, watcher.Close())
+	}
+
+	objWatchers, err := s.initObjectWatchers(object, objCfg)
+	s.watchers = append(watchers, objWatchers...)
+	return multierr.Append(errs, err)
+}
+
+// enumerateInstances returns the sorted names of the instances of an object, as found by
+// expanding the path of its first counter.
+func (s *scraper) enumerateInstances(objCfg ObjectConfig) ([]string, error) {
+	if len(objCfg.Counters) == 0 {
+		return nil, nil
+	}
+	paths, err := s.expandWildcardPath(fmt.Sprintf(`\%s(%s)\%s`, objCfg.Object, wildcardInstance, objCfg.Counters[0].Name))
+	if err != nil {
+		return nil, err
+	}
+
+	// The expanded paths may be prefixed with the name of the computer.
+	prefix, suffix := fmt.Sprintf(`\%s(`, objCfg.Object), fmt.Sprintf(`)\%s`, objCfg.Counters[0].Name)
+	instances := make([]string, 0, len(paths))
+	for _, path := range paths {
+		start := strings.Index(path, prefix)
+		if start < 0 || !strings.HasSuffix(path, suffix) || start+len(prefix) > len(path)-len(suffix) {
+			continue
+		}
+		instances = append(instances, path[start+len(prefix):len(path)-len(suffix)])
+	}
+	sort.Strings(instances)
+	return instances, nil
+}
+
+// diffInstances returns the instances of current which are not in previous, and those of
+// previous which are not in current. Both slices must be sorted.
+func diffInstances(previous, current []string) (added, removed []string) {
+	i, j := 0, 0
+	for i < len(previous) || j < len(current) {
+		switch {
+		case j == len(current) || (i < len(previous) && previous[i] < current[j]):
+			removed = append(removed, previous[i])
+			i++
+		case i == len(previous) || current[j] < previous[i]:
+			added = append(added, current[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return added, removed
+}
+
 func (s *scraper) shutdown(context.Context) error {
 	var errs error
 	for _, watcher := range s.watchers {
@@ -126,11 +266,15 @@ func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
 	}
 
 	scrapeFailures := 0
+	missing := map[int]bool{}
 	for _, watcher := range s.watchers {
 		counterVals, err := watcher.ScrapeData()
 		if err != nil {
 			errs = multierr.Append(errs, err)
 			scrapeFailures += 1
+			if winperfcounters.IsInstanceNotFound(err) {
+				missing[watcher.object] = true
+			}
 			continue
 		}
 
@@ -151,6 +295,11 @@ func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
 	if scrapeFailures != 0 && scrapeFailures != len(s.watchers) {
 		errs = scrapererror.NewPartialScrapeError(errs, scrapeFailures)
 	}
+
+	// Pick up the instances created or removed since the last enumeration for the next scrape.
+	if len(missing) != 0 || (s.cfg.InstanceRefreshInterval > 0 && time.Since(s.lastRefresh) >= s.cfg.InstanceRefreshInterval) {
+		s.refreshInstances(missing)
+	}
 	return md, errs
 }
 
@@ -182,11 +331,18 @@ func instancesFromConfig(oc ObjectConfig) []string {
 		return []string{""}
 	}
 
-	for _, instance := range oc.Instances {
-		if instance == "*" {
-			return []string{"*"}
-		}
+	if isWildcard(oc) {
+		return []string{wildcardInstance}
 	}
 
 	return oc.Instances
 }
+
+func isWildcard(oc ObjectConfig) bool {
+	for _, instance := range oc.Instances {
+		if instance == wildcardInstance {
+			return true
+		}
+	}
+	return false
+}
@@ -460,3 +460,80 @@ func TestScrape(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshInstances(t *testing.T) {
+	cfg := &Config{
+		PerfCounters: []ObjectConfig{
+			{Object: "Web Service", Instances: []string{"*"}, Counters: []CounterConfig{{Name: "Current Connections"}}},
+			{Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes"}}},
+		},
+		InstanceRefreshInterval: time.Minute,
+	}
+
+	paths := [][]string{
+		{`\Web Service(site1)\Current Connections`},
+		{`\Web Service(site1)\Current Connections`},
+		{`\\host\Web Service(site2)\Current Connections`, `\\host\Web Service(site3)\Current Connections`},
+	}
+	expansions := 0
+	var created []string
+	core, obs := observer.New(zapcore.InfoLevel)
+	s := &scraper{
+		cfg:      cfg,
+		settings: componenttest.NewNopTelemetrySettings(),
+		newWatcher: func(object, instance, counter string) (winperfcounters.PerfCounterWatcher, error) {
+			created = append(created, object)
+			return &mockPerfCounter{path: object, counterValues: []winperfcounters.CounterValue{{Value: 1}}}, nil
+		},
+		expandWildcardPath: func(path string) ([]string, error) {
+			assert.Equal(t, `\Web Service(*)\Current Connections`, path)
+			expansions++
+			return paths[expansions-1], nil
+		},
+	}
+	s.settings.Logger = zap.New(core)
+
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, []string{"Web Service", "Memory"}, created)
+	assert.Equal(t, []string{"site1"}, s.instances[0])
+
+	// The instances did not change, so the watchers are kept.
+	s.lastRefresh = time.Now().Add(-time.Hour)
+	_, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, expansions)
+	assert.Len(t, created, 2)
+	assert.Equal(t, 0, obs.Len())
+
+	// The refresh is not due yet.
+	_, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, expansions)
+
+	s.lastRefresh = time.Now().Add(-time.Hour)
+	_, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, expansions)
+	assert.Equal(t, []string{"Web Service", "Memory", "Web Service"}, created)
+	assert.Equal(t, []string{"site2", "site3"}, s.instances[0])
+	require.Len(t, s.watchers, 2)
+	assert.Equal(t, "Memory", s.watchers[0].Path())
+	assert.Equal(t, "Web Service", s.watchers[1].Path())
+
+	logs := obs.All()
+	require.Len(t, logs, 2)
+	assert.Equal(t, "found new instances of a performance counter object", logs[0].Message)
+	assert.Equal(t, []any{"site2", "site3"}, logs[0].ContextMap()["instances"])
+	assert.Equal(t, "instances of a performance counter object were removed", logs[1].Message)
+	assert.Equal(t, []any{"site1"}, logs[1].ContextMap()["instances"])
+}
+
+func TestDiffInstances(t *testing.T) {
+	added, removed := diffInstances([]string{"a", "b", "d"}, []string{"b", "c", "d", "e"})
+	assert.Equal(t, []string{"c", "e"}, added)
+	assert.Equal(t, []string{"a"}, removed)
+
+	added, removed = diffInstances(nil, []string{"a"})
+	assert.Equal(t, []string{"a"}, added)
+	assert.Empty(t, removed)
+}